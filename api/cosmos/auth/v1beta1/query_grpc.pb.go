@@ -29,6 +29,7 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_FeeDenomPriceTable_FullMethodName   = "/cosmos.auth.v1beta1.Query/FeeDenomPriceTable"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +73,11 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// FeeDenomPriceTable queries the alternative fee denominations accepted by the
+	// chain and their price in the native fee denomination.
+	//
+	// Since: cosmos-sdk 0.48
+	FeeDenomPriceTable(ctx context.Context, in *QueryFeeDenomPriceTableRequest, opts ...grpc.CallOption) (*QueryFeeDenomPriceTableResponse, error)
 }
 
 type queryClient struct {
@@ -172,6 +178,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) FeeDenomPriceTable(ctx context.Context, in *QueryFeeDenomPriceTableRequest, opts ...grpc.CallOption) (*QueryFeeDenomPriceTableResponse, error) {
+	out := new(QueryFeeDenomPriceTableResponse)
+	err := c.cc.Invoke(ctx, Query_FeeDenomPriceTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +228,11 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// FeeDenomPriceTable queries the alternative fee denominations accepted by the
+	// chain and their price in the native fee denomination.
+	//
+	// Since: cosmos-sdk 0.48
+	FeeDenomPriceTable(context.Context, *QueryFeeDenomPriceTableRequest) (*QueryFeeDenomPriceTableResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -250,6 +270,9 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) FeeDenomPriceTable(context.Context, *QueryFeeDenomPriceTableRequest) (*QueryFeeDenomPriceTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDenomPriceTable not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -443,6 +466,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeDenomPriceTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDenomPriceTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDenomPriceTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeDenomPriceTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDenomPriceTable(ctx, req.(*QueryFeeDenomPriceTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -490,6 +531,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "FeeDenomPriceTable",
+			Handler:    _Query_FeeDenomPriceTable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName             = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateFeeDenomPriceTable_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateFeeDenomPriceTable"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +32,12 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeDenomPriceTable defines a (governance) operation for updating the
+	// table of alternative fee denominations. The authority defaults to the x/gov
+	// module account.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateFeeDenomPriceTable(ctx context.Context, in *MsgUpdateFeeDenomPriceTable, opts ...grpc.CallOption) (*MsgUpdateFeeDenomPriceTableResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +57,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateFeeDenomPriceTable(ctx context.Context, in *MsgUpdateFeeDenomPriceTable, opts ...grpc.CallOption) (*MsgUpdateFeeDenomPriceTableResponse, error) {
+	out := new(MsgUpdateFeeDenomPriceTableResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeeDenomPriceTable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +75,12 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeDenomPriceTable defines a (governance) operation for updating the
+	// table of alternative fee denominations. The authority defaults to the x/gov
+	// module account.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateFeeDenomPriceTable(context.Context, *MsgUpdateFeeDenomPriceTable) (*MsgUpdateFeeDenomPriceTableResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +91,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateFeeDenomPriceTable(context.Context, *MsgUpdateFeeDenomPriceTable) (*MsgUpdateFeeDenomPriceTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeDenomPriceTable not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +125,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateFeeDenomPriceTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeDenomPriceTable)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeDenomPriceTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeeDenomPriceTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeDenomPriceTable(ctx, req.(*MsgUpdateFeeDenomPriceTable))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +154,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateFeeDenomPriceTable",
+			Handler:    _Msg_UpdateFeeDenomPriceTable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName                    = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName               = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_UpdateParams_FullMethodName            = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName          = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_WithdrawFeeDenomReserve_FullMethodName = "/cosmos.bank.v1beta1.Msg/WithdrawFeeDenomReserve"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,6 +46,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// WithdrawFeeDenomReserve is a governance operation for withdrawing coins
+	// from the fee denom reserve module account, e.g. the fees paid in
+	// alternative denominations, so that they can be swapped for native fee
+	// coins to fund the reserve again.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawFeeDenomReserve(ctx context.Context, in *MsgWithdrawFeeDenomReserve, opts ...grpc.CallOption) (*MsgWithdrawFeeDenomReserveResponse, error)
 }
 
 type msgClient struct {
@@ -91,6 +99,15 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) WithdrawFeeDenomReserve(ctx context.Context, in *MsgWithdrawFeeDenomReserve, opts ...grpc.CallOption) (*MsgWithdrawFeeDenomReserveResponse, error) {
+	out := new(MsgWithdrawFeeDenomReserveResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawFeeDenomReserve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -111,6 +128,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// WithdrawFeeDenomReserve is a governance operation for withdrawing coins
+	// from the fee denom reserve module account, e.g. the fees paid in
+	// alternative denominations, so that they can be swapped for native fee
+	// coins to fund the reserve again.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawFeeDenomReserve(context.Context, *MsgWithdrawFeeDenomReserve) (*MsgWithdrawFeeDenomReserveResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -130,6 +154,9 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) WithdrawFeeDenomReserve(context.Context, *MsgWithdrawFeeDenomReserve) (*MsgWithdrawFeeDenomReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeeDenomReserve not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -215,6 +242,24 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawFeeDenomReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeeDenomReserve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeeDenomReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawFeeDenomReserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeeDenomReserve(ctx, req.(*MsgWithdrawFeeDenomReserve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -238,6 +283,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "WithdrawFeeDenomReserve",
+			Handler:    _Msg_WithdrawFeeDenomReserve_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -19,13 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SetWithdrawAddress_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
-	Msg_WithdrawDelegatorReward_FullMethodName     = "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward"
-	Msg_WithdrawValidatorCommission_FullMethodName = "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission"
-	Msg_FundCommunityPool_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
-	Msg_UpdateParams_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
-	Msg_CommunityPoolSpend_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
-	Msg_DepositValidatorRewardsPool_FullMethodName = "/cosmos.distribution.v1beta1.Msg/DepositValidatorRewardsPool"
+	Msg_SetWithdrawAddress_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
+	Msg_WithdrawDelegatorReward_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward"
+	Msg_WithdrawValidatorCommission_FullMethodName       = "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission"
+	Msg_FundCommunityPool_FullMethodName                 = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
+	Msg_UpdateParams_FullMethodName                      = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
+	Msg_CommunityPoolSpend_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
+	Msg_DepositValidatorRewardsPool_FullMethodName       = "/cosmos.distribution.v1beta1.Msg/DepositValidatorRewardsPool"
+	Msg_WithdrawTokenizeShareRecordReward_FullMethodName = "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward"
+	Msg_SetAutoCompound_FullMethodName                   = "/cosmos.distribution.v1beta1.Msg/SetAutoCompound"
 )
 
 // MsgClient is the client API for Msg service.
@@ -61,6 +63,16 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegations of all the tokenize share records owned by an address.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// SetAutoCompound defines a method to enable or disable the auto-compounding
+	// of the rewards of a delegation.
+	//
+	// Since: cosmos-sdk 0.48
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -134,6 +146,24 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawTokenizeShareRecordReward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -167,6 +197,16 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegations of all the tokenize share records owned by an address.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// SetAutoCompound defines a method to enable or disable the auto-compounding
+	// of the rewards of a delegation.
+	//
+	// Since: cosmos-sdk 0.48
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -195,6 +235,12 @@ func (UnimplementedMsgServer) CommunityPoolSpend(context.Context, *MsgCommunityP
 func (UnimplementedMsgServer) DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
+func (UnimplementedMsgServer) SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -334,6 +380,42 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawTokenizeShareRecordReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -369,6 +451,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -165,17 +165,69 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*GovernanceDelegation
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GovernanceDelegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GovernanceDelegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(GovernanceDelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(GovernanceDelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                      protoreflect.MessageDescriptor
-	fd_GenesisState_starting_proposal_id protoreflect.FieldDescriptor
-	fd_GenesisState_deposits             protoreflect.FieldDescriptor
-	fd_GenesisState_votes                protoreflect.FieldDescriptor
-	fd_GenesisState_proposals            protoreflect.FieldDescriptor
-	fd_GenesisState_deposit_params       protoreflect.FieldDescriptor
-	fd_GenesisState_voting_params        protoreflect.FieldDescriptor
-	fd_GenesisState_tally_params         protoreflect.FieldDescriptor
-	fd_GenesisState_params               protoreflect.FieldDescriptor
-	fd_GenesisState_constitution         protoreflect.FieldDescriptor
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_starting_proposal_id   protoreflect.FieldDescriptor
+	fd_GenesisState_deposits               protoreflect.FieldDescriptor
+	fd_GenesisState_votes                  protoreflect.FieldDescriptor
+	fd_GenesisState_proposals              protoreflect.FieldDescriptor
+	fd_GenesisState_deposit_params         protoreflect.FieldDescriptor
+	fd_GenesisState_voting_params          protoreflect.FieldDescriptor
+	fd_GenesisState_tally_params           protoreflect.FieldDescriptor
+	fd_GenesisState_params                 protoreflect.FieldDescriptor
+	fd_GenesisState_constitution           protoreflect.FieldDescriptor
+	fd_GenesisState_governance_delegations protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -190,6 +242,7 @@ func init() {
 	fd_GenesisState_tally_params = md_GenesisState.Fields().ByName("tally_params")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_constitution = md_GenesisState.Fields().ByName("constitution")
+	fd_GenesisState_governance_delegations = md_GenesisState.Fields().ByName("governance_delegations")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -311,6 +364,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.GovernanceDelegations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.GovernanceDelegations})
+		if !f(fd_GenesisState_governance_delegations, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -344,6 +403,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "cosmos.gov.v1.GenesisState.constitution":
 		return x.Constitution != ""
+	case "cosmos.gov.v1.GenesisState.governance_delegations":
+		return len(x.GovernanceDelegations) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -378,6 +439,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "cosmos.gov.v1.GenesisState.constitution":
 		x.Constitution = ""
+	case "cosmos.gov.v1.GenesisState.governance_delegations":
+		x.GovernanceDelegations = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -430,6 +493,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "cosmos.gov.v1.GenesisState.constitution":
 		value := x.Constitution
 		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.GenesisState.governance_delegations":
+		if len(x.GovernanceDelegations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.GovernanceDelegations}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -474,6 +543,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Params = value.Message().Interface().(*Params)
 	case "cosmos.gov.v1.GenesisState.constitution":
 		x.Constitution = value.Interface().(string)
+	case "cosmos.gov.v1.GenesisState.governance_delegations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.GovernanceDelegations = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -532,6 +605,12 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cosmos.gov.v1.GenesisState.governance_delegations":
+		if x.GovernanceDelegations == nil {
+			x.GovernanceDelegations = []*GovernanceDelegation{}
+		}
+		value := &_GenesisState_10_list{list: &x.GovernanceDelegations}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.gov.v1.GenesisState.starting_proposal_id":
 		panic(fmt.Errorf("field starting_proposal_id of message cosmos.gov.v1.GenesisState is not mutable"))
 	case "cosmos.gov.v1.GenesisState.constitution":
@@ -574,6 +653,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "cosmos.gov.v1.GenesisState.constitution":
 		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.GenesisState.governance_delegations":
+		list := []*GovernanceDelegation{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GenesisState"))
@@ -684,6 +766,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.GovernanceDelegations) > 0 {
+			for _, e := range x.GovernanceDelegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -713,6 +801,22 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.GovernanceDelegations) > 0 {
+			for iNdEx := len(x.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GovernanceDelegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
 		if len(x.Constitution) > 0 {
 			i -= len(x.Constitution)
 			copy(dAtA[i:], x.Constitution)
@@ -1175,6 +1279,40 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				}
 				x.Constitution = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GovernanceDelegations = append(x.GovernanceDelegations, &GovernanceDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GovernanceDelegations[len(x.GovernanceDelegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1265,6 +1403,11 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// governance_delegations defines all the governance delegations present at
+	// genesis.
+	//
+	// Since: cosmos-sdk 0.48
+	GovernanceDelegations []*GovernanceDelegation `protobuf:"bytes,10,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1353,6 +1496,13 @@ func (x *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (x *GenesisState) GetGovernanceDelegations() []*GovernanceDelegation {
+	if x != nil {
+		return x.GovernanceDelegations
+	}
+	return nil
+}
+
 var File_cosmos_gov_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_cosmos_gov_v1_genesis_proto_rawDesc = []byte{
@@ -1360,7 +1510,7 @@ var file_cosmos_gov_v1_genesis_proto_rawDesc = []byte{
 	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x76, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
 	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69,
 	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72,
@@ -1392,17 +1542,23 @@ var file_cosmos_gov_v1_genesis_proto_rawDesc = []byte{
 	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
 	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
 	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47,
-	0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47,
-	0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47,
-	0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x16, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67,
+	0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47,
+	0x58, 0xaa, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1419,14 +1575,15 @@ func file_cosmos_gov_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_cosmos_gov_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_cosmos_gov_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),  // 0: cosmos.gov.v1.GenesisState
-	(*Deposit)(nil),       // 1: cosmos.gov.v1.Deposit
-	(*Vote)(nil),          // 2: cosmos.gov.v1.Vote
-	(*Proposal)(nil),      // 3: cosmos.gov.v1.Proposal
-	(*DepositParams)(nil), // 4: cosmos.gov.v1.DepositParams
-	(*VotingParams)(nil),  // 5: cosmos.gov.v1.VotingParams
-	(*TallyParams)(nil),   // 6: cosmos.gov.v1.TallyParams
-	(*Params)(nil),        // 7: cosmos.gov.v1.Params
+	(*GenesisState)(nil),         // 0: cosmos.gov.v1.GenesisState
+	(*Deposit)(nil),              // 1: cosmos.gov.v1.Deposit
+	(*Vote)(nil),                 // 2: cosmos.gov.v1.Vote
+	(*Proposal)(nil),             // 3: cosmos.gov.v1.Proposal
+	(*DepositParams)(nil),        // 4: cosmos.gov.v1.DepositParams
+	(*VotingParams)(nil),         // 5: cosmos.gov.v1.VotingParams
+	(*TallyParams)(nil),          // 6: cosmos.gov.v1.TallyParams
+	(*Params)(nil),               // 7: cosmos.gov.v1.Params
+	(*GovernanceDelegation)(nil), // 8: cosmos.gov.v1.GovernanceDelegation
 }
 var file_cosmos_gov_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: cosmos.gov.v1.GenesisState.deposits:type_name -> cosmos.gov.v1.Deposit
@@ -1436,11 +1593,12 @@ var file_cosmos_gov_v1_genesis_proto_depIdxs = []int32{
 	5, // 4: cosmos.gov.v1.GenesisState.voting_params:type_name -> cosmos.gov.v1.VotingParams
 	6, // 5: cosmos.gov.v1.GenesisState.tally_params:type_name -> cosmos.gov.v1.TallyParams
 	7, // 6: cosmos.gov.v1.GenesisState.params:type_name -> cosmos.gov.v1.Params
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	8, // 7: cosmos.gov.v1.GenesisState.governance_delegations:type_name -> cosmos.gov.v1.GovernanceDelegation
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_gov_v1_genesis_proto_init() }
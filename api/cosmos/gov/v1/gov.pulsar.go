@@ -487,81 +487,28 @@ func (x *fastReflection_WeightedVoteOption) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Deposit_3_list)(nil)
-
-type _Deposit_3_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Deposit_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Deposit_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Deposit_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Deposit_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Deposit_3_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Deposit_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Deposit_3_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Deposit_3_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Deposit             protoreflect.MessageDescriptor
-	fd_Deposit_proposal_id protoreflect.FieldDescriptor
-	fd_Deposit_depositor   protoreflect.FieldDescriptor
-	fd_Deposit_amount      protoreflect.FieldDescriptor
+	md_WeightedVoteChoice        protoreflect.MessageDescriptor
+	fd_WeightedVoteChoice_choice protoreflect.FieldDescriptor
+	fd_WeightedVoteChoice_weight protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_Deposit = File_cosmos_gov_v1_gov_proto.Messages().ByName("Deposit")
-	fd_Deposit_proposal_id = md_Deposit.Fields().ByName("proposal_id")
-	fd_Deposit_depositor = md_Deposit.Fields().ByName("depositor")
-	fd_Deposit_amount = md_Deposit.Fields().ByName("amount")
+	md_WeightedVoteChoice = File_cosmos_gov_v1_gov_proto.Messages().ByName("WeightedVoteChoice")
+	fd_WeightedVoteChoice_choice = md_WeightedVoteChoice.Fields().ByName("choice")
+	fd_WeightedVoteChoice_weight = md_WeightedVoteChoice.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_Deposit)(nil)
+var _ protoreflect.Message = (*fastReflection_WeightedVoteChoice)(nil)
 
-type fastReflection_Deposit Deposit
+type fastReflection_WeightedVoteChoice WeightedVoteChoice
 
-func (x *Deposit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Deposit)(x)
+func (x *WeightedVoteChoice) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WeightedVoteChoice)(x)
 }
 
-func (x *Deposit) slowProtoReflect() protoreflect.Message {
+func (x *WeightedVoteChoice) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,43 +520,43 @@ func (x *Deposit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Deposit_messageType fastReflection_Deposit_messageType
-var _ protoreflect.MessageType = fastReflection_Deposit_messageType{}
+var _fastReflection_WeightedVoteChoice_messageType fastReflection_WeightedVoteChoice_messageType
+var _ protoreflect.MessageType = fastReflection_WeightedVoteChoice_messageType{}
 
-type fastReflection_Deposit_messageType struct{}
+type fastReflection_WeightedVoteChoice_messageType struct{}
 
-func (x fastReflection_Deposit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Deposit)(nil)
+func (x fastReflection_WeightedVoteChoice_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WeightedVoteChoice)(nil)
 }
-func (x fastReflection_Deposit_messageType) New() protoreflect.Message {
-	return new(fastReflection_Deposit)
+func (x fastReflection_WeightedVoteChoice_messageType) New() protoreflect.Message {
+	return new(fastReflection_WeightedVoteChoice)
 }
-func (x fastReflection_Deposit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Deposit
+func (x fastReflection_WeightedVoteChoice_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WeightedVoteChoice
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Deposit) Descriptor() protoreflect.MessageDescriptor {
-	return md_Deposit
+func (x *fastReflection_WeightedVoteChoice) Descriptor() protoreflect.MessageDescriptor {
+	return md_WeightedVoteChoice
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Deposit) Type() protoreflect.MessageType {
-	return _fastReflection_Deposit_messageType
+func (x *fastReflection_WeightedVoteChoice) Type() protoreflect.MessageType {
+	return _fastReflection_WeightedVoteChoice_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Deposit) New() protoreflect.Message {
-	return new(fastReflection_Deposit)
+func (x *fastReflection_WeightedVoteChoice) New() protoreflect.Message {
+	return new(fastReflection_WeightedVoteChoice)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
-	return (*Deposit)(x)
+func (x *fastReflection_WeightedVoteChoice) Interface() protoreflect.ProtoMessage {
+	return (*WeightedVoteChoice)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -617,22 +564,16 @@ func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_Deposit_proposal_id, value) {
-			return
-		}
-	}
-	if x.Depositor != "" {
-		value := protoreflect.ValueOfString(x.Depositor)
-		if !f(fd_Deposit_depositor, value) {
+func (x *fastReflection_WeightedVoteChoice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Choice != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Choice)
+		if !f(fd_WeightedVoteChoice_choice, value) {
 			return
 		}
 	}
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_Deposit_3_list{list: &x.Amount})
-		if !f(fd_Deposit_amount, value) {
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_WeightedVoteChoice_weight, value) {
 			return
 		}
 	}
@@ -649,19 +590,17 @@ func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_WeightedVoteChoice) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Deposit.proposal_id":
-		return x.ProposalId != uint64(0)
-	case "cosmos.gov.v1.Deposit.depositor":
-		return x.Depositor != ""
-	case "cosmos.gov.v1.Deposit.amount":
-		return len(x.Amount) != 0
+	case "cosmos.gov.v1.WeightedVoteChoice.choice":
+		return x.Choice != uint32(0)
+	case "cosmos.gov.v1.WeightedVoteChoice.weight":
+		return x.Weight != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.WeightedVoteChoice"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.WeightedVoteChoice does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -671,19 +610,17 @@ func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_WeightedVoteChoice) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Deposit.proposal_id":
-		x.ProposalId = uint64(0)
-	case "cosmos.gov.v1.Deposit.depositor":
-		x.Depositor = ""
-	case "cosmos.gov.v1.Deposit.amount":
-		x.Amount = nil
+	case "cosmos.gov.v1.WeightedVoteChoice.choice":
+		x.Choice = uint32(0)
+	case "cosmos.gov.v1.WeightedVoteChoice.weight":
+		x.Weight = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.WeightedVoteChoice"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.WeightedVoteChoice does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -693,25 +630,19 @@ func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WeightedVoteChoice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.Deposit.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gov.v1.Deposit.depositor":
-		value := x.Depositor
+	case "cosmos.gov.v1.WeightedVoteChoice.choice":
+		value := x.Choice
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.gov.v1.WeightedVoteChoice.weight":
+		value := x.Weight
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Deposit.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_Deposit_3_list{})
-		}
-		listValue := &_Deposit_3_list{list: &x.Amount}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.WeightedVoteChoice"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.WeightedVoteChoice does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -725,21 +656,17 @@ func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_WeightedVoteChoice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Deposit.proposal_id":
-		x.ProposalId = value.Uint()
-	case "cosmos.gov.v1.Deposit.depositor":
-		x.Depositor = value.Interface().(string)
-	case "cosmos.gov.v1.Deposit.amount":
-		lv := value.List()
-		clv := lv.(*_Deposit_3_list)
-		x.Amount = *clv.list
+	case "cosmos.gov.v1.WeightedVoteChoice.choice":
+		x.Choice = uint32(value.Uint())
+	case "cosmos.gov.v1.WeightedVoteChoice.weight":
+		x.Weight = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.WeightedVoteChoice"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.WeightedVoteChoice does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -753,53 +680,44 @@ func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WeightedVoteChoice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Deposit.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
-		}
-		value := &_Deposit_3_list{list: &x.Amount}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Deposit.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.Deposit is not mutable"))
-	case "cosmos.gov.v1.Deposit.depositor":
-		panic(fmt.Errorf("field depositor of message cosmos.gov.v1.Deposit is not mutable"))
+	case "cosmos.gov.v1.WeightedVoteChoice.choice":
+		panic(fmt.Errorf("field choice of message cosmos.gov.v1.WeightedVoteChoice is not mutable"))
+	case "cosmos.gov.v1.WeightedVoteChoice.weight":
+		panic(fmt.Errorf("field weight of message cosmos.gov.v1.WeightedVoteChoice is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.WeightedVoteChoice"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.WeightedVoteChoice does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Deposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_WeightedVoteChoice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Deposit.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gov.v1.Deposit.depositor":
+	case "cosmos.gov.v1.WeightedVoteChoice.choice":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.gov.v1.WeightedVoteChoice.weight":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Deposit.amount":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Deposit_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.WeightedVoteChoice"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.WeightedVoteChoice does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_WeightedVoteChoice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Deposit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.WeightedVoteChoice", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -807,7 +725,7 @@ func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_WeightedVoteChoice) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -818,7 +736,7 @@ func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_WeightedVoteChoice) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -830,7 +748,7 @@ func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Deposit) IsValid() bool {
+func (x *fastReflection_WeightedVoteChoice) IsValid() bool {
 	return x != nil
 }
 
@@ -840,9 +758,9 @@ func (x *fastReflection_Deposit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_WeightedVoteChoice) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*WeightedVoteChoice)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -854,19 +772,13 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		if x.Choice != 0 {
+			n += 1 + runtime.Sov(uint64(x.Choice))
 		}
-		l = len(x.Depositor)
+		l = len(x.Weight)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -877,7 +789,7 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*WeightedVoteChoice)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -896,31 +808,15 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Depositor) > 0 {
-			i -= len(x.Depositor)
-			copy(dAtA[i:], x.Depositor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if x.Choice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Choice))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -935,7 +831,7 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Deposit)
+		x := input.Message.Interface().(*WeightedVoteChoice)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -967,17 +863,17 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WeightedVoteChoice: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WeightedVoteChoice: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
 				}
-				x.ProposalId = 0
+				x.Choice = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -987,14 +883,14 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					x.Choice |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1022,41 +918,7 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Depositor = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1093,154 +955,79 @@ func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Proposal_2_list)(nil)
+var _ protoreflect.List = (*_ProposalOption_2_list)(nil)
 
-type _Proposal_2_list struct {
+type _ProposalOption_2_list struct {
 	list *[]*anypb.Any
 }
 
-func (x *_Proposal_2_list) Len() int {
+func (x *_ProposalOption_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Proposal_2_list) Get(i int) protoreflect.Value {
+func (x *_ProposalOption_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Proposal_2_list) Set(i int, value protoreflect.Value) {
+func (x *_ProposalOption_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Proposal_2_list) Append(value protoreflect.Value) {
+func (x *_ProposalOption_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Proposal_2_list) AppendMutable() protoreflect.Value {
+func (x *_ProposalOption_2_list) AppendMutable() protoreflect.Value {
 	v := new(anypb.Any)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Proposal_2_list) Truncate(n int) {
+func (x *_ProposalOption_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Proposal_2_list) NewElement() protoreflect.Value {
+func (x *_ProposalOption_2_list) NewElement() protoreflect.Value {
 	v := new(anypb.Any)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Proposal_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_Proposal_7_list)(nil)
-
-type _Proposal_7_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Proposal_7_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Proposal_7_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Proposal_7_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Proposal_7_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Proposal_7_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Proposal_7_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Proposal_7_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Proposal_7_list) IsValid() bool {
+func (x *_ProposalOption_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Proposal                    protoreflect.MessageDescriptor
-	fd_Proposal_id                 protoreflect.FieldDescriptor
-	fd_Proposal_messages           protoreflect.FieldDescriptor
-	fd_Proposal_status             protoreflect.FieldDescriptor
-	fd_Proposal_final_tally_result protoreflect.FieldDescriptor
-	fd_Proposal_submit_time        protoreflect.FieldDescriptor
-	fd_Proposal_deposit_end_time   protoreflect.FieldDescriptor
-	fd_Proposal_total_deposit      protoreflect.FieldDescriptor
-	fd_Proposal_voting_start_time  protoreflect.FieldDescriptor
-	fd_Proposal_voting_end_time    protoreflect.FieldDescriptor
-	fd_Proposal_metadata           protoreflect.FieldDescriptor
-	fd_Proposal_title              protoreflect.FieldDescriptor
-	fd_Proposal_summary            protoreflect.FieldDescriptor
-	fd_Proposal_proposer           protoreflect.FieldDescriptor
-	fd_Proposal_expedited          protoreflect.FieldDescriptor
+	md_ProposalOption          protoreflect.MessageDescriptor
+	fd_ProposalOption_title    protoreflect.FieldDescriptor
+	fd_ProposalOption_messages protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_Proposal = File_cosmos_gov_v1_gov_proto.Messages().ByName("Proposal")
-	fd_Proposal_id = md_Proposal.Fields().ByName("id")
-	fd_Proposal_messages = md_Proposal.Fields().ByName("messages")
-	fd_Proposal_status = md_Proposal.Fields().ByName("status")
-	fd_Proposal_final_tally_result = md_Proposal.Fields().ByName("final_tally_result")
-	fd_Proposal_submit_time = md_Proposal.Fields().ByName("submit_time")
-	fd_Proposal_deposit_end_time = md_Proposal.Fields().ByName("deposit_end_time")
-	fd_Proposal_total_deposit = md_Proposal.Fields().ByName("total_deposit")
-	fd_Proposal_voting_start_time = md_Proposal.Fields().ByName("voting_start_time")
-	fd_Proposal_voting_end_time = md_Proposal.Fields().ByName("voting_end_time")
-	fd_Proposal_metadata = md_Proposal.Fields().ByName("metadata")
-	fd_Proposal_title = md_Proposal.Fields().ByName("title")
-	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
-	fd_Proposal_proposer = md_Proposal.Fields().ByName("proposer")
-	fd_Proposal_expedited = md_Proposal.Fields().ByName("expedited")
+	md_ProposalOption = File_cosmos_gov_v1_gov_proto.Messages().ByName("ProposalOption")
+	fd_ProposalOption_title = md_ProposalOption.Fields().ByName("title")
+	fd_ProposalOption_messages = md_ProposalOption.Fields().ByName("messages")
 }
 
-var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
+var _ protoreflect.Message = (*fastReflection_ProposalOption)(nil)
 
-type fastReflection_Proposal Proposal
+type fastReflection_ProposalOption ProposalOption
 
-func (x *Proposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Proposal)(x)
+func (x *ProposalOption) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProposalOption)(x)
 }
 
-func (x *Proposal) slowProtoReflect() protoreflect.Message {
+func (x *ProposalOption) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1252,43 +1039,43 @@ func (x *Proposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
-var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
+var _fastReflection_ProposalOption_messageType fastReflection_ProposalOption_messageType
+var _ protoreflect.MessageType = fastReflection_ProposalOption_messageType{}
 
-type fastReflection_Proposal_messageType struct{}
+type fastReflection_ProposalOption_messageType struct{}
 
-func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Proposal)(nil)
+func (x fastReflection_ProposalOption_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProposalOption)(nil)
 }
-func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
+func (x fastReflection_ProposalOption_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProposalOption)
 }
-func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
+func (x fastReflection_ProposalOption_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalOption
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
+func (x *fastReflection_ProposalOption) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalOption
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
-	return _fastReflection_Proposal_messageType
+func (x *fastReflection_ProposalOption) Type() protoreflect.MessageType {
+	return _fastReflection_ProposalOption_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Proposal) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
+func (x *fastReflection_ProposalOption) New() protoreflect.Message {
+	return new(fastReflection_ProposalOption)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
-	return (*Proposal)(x)
+func (x *fastReflection_ProposalOption) Interface() protoreflect.ProtoMessage {
+	return (*ProposalOption)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1296,88 +1083,16 @@ func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_Proposal_id, value) {
-			return
-		}
-	}
-	if len(x.Messages) != 0 {
-		value := protoreflect.ValueOfList(&_Proposal_2_list{list: &x.Messages})
-		if !f(fd_Proposal_messages, value) {
-			return
-		}
-	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_Proposal_status, value) {
-			return
-		}
-	}
-	if x.FinalTallyResult != nil {
-		value := protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
-		if !f(fd_Proposal_final_tally_result, value) {
-			return
-		}
-	}
-	if x.SubmitTime != nil {
-		value := protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
-		if !f(fd_Proposal_submit_time, value) {
-			return
-		}
-	}
-	if x.DepositEndTime != nil {
-		value := protoreflect.ValueOfMessage(x.DepositEndTime.ProtoReflect())
-		if !f(fd_Proposal_deposit_end_time, value) {
-			return
-		}
-	}
-	if len(x.TotalDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_Proposal_7_list{list: &x.TotalDeposit})
-		if !f(fd_Proposal_total_deposit, value) {
-			return
-		}
-	}
-	if x.VotingStartTime != nil {
-		value := protoreflect.ValueOfMessage(x.VotingStartTime.ProtoReflect())
-		if !f(fd_Proposal_voting_start_time, value) {
-			return
-		}
-	}
-	if x.VotingEndTime != nil {
-		value := protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
-		if !f(fd_Proposal_voting_end_time, value) {
-			return
-		}
-	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_Proposal_metadata, value) {
-			return
-		}
-	}
+func (x *fastReflection_ProposalOption) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Title != "" {
 		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_Proposal_title, value) {
-			return
-		}
-	}
-	if x.Summary != "" {
-		value := protoreflect.ValueOfString(x.Summary)
-		if !f(fd_Proposal_summary, value) {
-			return
-		}
-	}
-	if x.Proposer != "" {
-		value := protoreflect.ValueOfString(x.Proposer)
-		if !f(fd_Proposal_proposer, value) {
+		if !f(fd_ProposalOption_title, value) {
 			return
 		}
 	}
-	if x.Expedited != false {
-		value := protoreflect.ValueOfBool(x.Expedited)
-		if !f(fd_Proposal_expedited, value) {
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_ProposalOption_2_list{list: &x.Messages})
+		if !f(fd_ProposalOption_messages, value) {
 			return
 		}
 	}
@@ -1394,41 +1109,17 @@ func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ProposalOption) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Proposal.id":
-		return x.Id != uint64(0)
-	case "cosmos.gov.v1.Proposal.messages":
-		return len(x.Messages) != 0
-	case "cosmos.gov.v1.Proposal.status":
-		return x.Status != 0
-	case "cosmos.gov.v1.Proposal.final_tally_result":
-		return x.FinalTallyResult != nil
-	case "cosmos.gov.v1.Proposal.submit_time":
-		return x.SubmitTime != nil
-	case "cosmos.gov.v1.Proposal.deposit_end_time":
-		return x.DepositEndTime != nil
-	case "cosmos.gov.v1.Proposal.total_deposit":
-		return len(x.TotalDeposit) != 0
-	case "cosmos.gov.v1.Proposal.voting_start_time":
-		return x.VotingStartTime != nil
-	case "cosmos.gov.v1.Proposal.voting_end_time":
-		return x.VotingEndTime != nil
-	case "cosmos.gov.v1.Proposal.metadata":
-		return x.Metadata != ""
-	case "cosmos.gov.v1.Proposal.title":
+	case "cosmos.gov.v1.ProposalOption.title":
 		return x.Title != ""
-	case "cosmos.gov.v1.Proposal.summary":
-		return x.Summary != ""
-	case "cosmos.gov.v1.Proposal.proposer":
-		return x.Proposer != ""
-	case "cosmos.gov.v1.Proposal.expedited":
-		return x.Expedited != false
+	case "cosmos.gov.v1.ProposalOption.messages":
+		return len(x.Messages) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalOption"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalOption does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1438,41 +1129,17 @@ func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ProposalOption) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Proposal.id":
-		x.Id = uint64(0)
-	case "cosmos.gov.v1.Proposal.messages":
-		x.Messages = nil
-	case "cosmos.gov.v1.Proposal.status":
-		x.Status = 0
-	case "cosmos.gov.v1.Proposal.final_tally_result":
-		x.FinalTallyResult = nil
-	case "cosmos.gov.v1.Proposal.submit_time":
-		x.SubmitTime = nil
-	case "cosmos.gov.v1.Proposal.deposit_end_time":
-		x.DepositEndTime = nil
-	case "cosmos.gov.v1.Proposal.total_deposit":
-		x.TotalDeposit = nil
-	case "cosmos.gov.v1.Proposal.voting_start_time":
-		x.VotingStartTime = nil
-	case "cosmos.gov.v1.Proposal.voting_end_time":
-		x.VotingEndTime = nil
-	case "cosmos.gov.v1.Proposal.metadata":
-		x.Metadata = ""
-	case "cosmos.gov.v1.Proposal.title":
+	case "cosmos.gov.v1.ProposalOption.title":
 		x.Title = ""
-	case "cosmos.gov.v1.Proposal.summary":
-		x.Summary = ""
-	case "cosmos.gov.v1.Proposal.proposer":
-		x.Proposer = ""
-	case "cosmos.gov.v1.Proposal.expedited":
-		x.Expedited = false
+	case "cosmos.gov.v1.ProposalOption.messages":
+		x.Messages = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalOption"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalOption does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1482,61 +1149,22 @@ func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalOption) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.Proposal.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gov.v1.Proposal.messages":
-		if len(x.Messages) == 0 {
-			return protoreflect.ValueOfList(&_Proposal_2_list{})
-		}
-		listValue := &_Proposal_2_list{list: &x.Messages}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Proposal.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.gov.v1.Proposal.final_tally_result":
-		value := x.FinalTallyResult
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.submit_time":
-		value := x.SubmitTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.deposit_end_time":
-		value := x.DepositEndTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.total_deposit":
-		if len(x.TotalDeposit) == 0 {
-			return protoreflect.ValueOfList(&_Proposal_7_list{})
-		}
-		listValue := &_Proposal_7_list{list: &x.TotalDeposit}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Proposal.voting_start_time":
-		value := x.VotingStartTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.voting_end_time":
-		value := x.VotingEndTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Proposal.title":
+	case "cosmos.gov.v1.ProposalOption.title":
 		value := x.Title
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Proposal.summary":
-		value := x.Summary
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Proposal.proposer":
-		value := x.Proposer
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Proposal.expedited":
-		value := x.Expedited
-		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.ProposalOption.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_ProposalOption_2_list{})
+		}
+		listValue := &_ProposalOption_2_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalOption"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalOption does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1550,45 +1178,19 @@ func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ProposalOption) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Proposal.id":
-		x.Id = value.Uint()
-	case "cosmos.gov.v1.Proposal.messages":
+	case "cosmos.gov.v1.ProposalOption.title":
+		x.Title = value.Interface().(string)
+	case "cosmos.gov.v1.ProposalOption.messages":
 		lv := value.List()
-		clv := lv.(*_Proposal_2_list)
+		clv := lv.(*_ProposalOption_2_list)
 		x.Messages = *clv.list
-	case "cosmos.gov.v1.Proposal.status":
-		x.Status = (ProposalStatus)(value.Enum())
-	case "cosmos.gov.v1.Proposal.final_tally_result":
-		x.FinalTallyResult = value.Message().Interface().(*TallyResult)
-	case "cosmos.gov.v1.Proposal.submit_time":
-		x.SubmitTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.gov.v1.Proposal.deposit_end_time":
-		x.DepositEndTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.gov.v1.Proposal.total_deposit":
-		lv := value.List()
-		clv := lv.(*_Proposal_7_list)
-		x.TotalDeposit = *clv.list
-	case "cosmos.gov.v1.Proposal.voting_start_time":
-		x.VotingStartTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.gov.v1.Proposal.voting_end_time":
-		x.VotingEndTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.gov.v1.Proposal.metadata":
-		x.Metadata = value.Interface().(string)
-	case "cosmos.gov.v1.Proposal.title":
-		x.Title = value.Interface().(string)
-	case "cosmos.gov.v1.Proposal.summary":
-		x.Summary = value.Interface().(string)
-	case "cosmos.gov.v1.Proposal.proposer":
-		x.Proposer = value.Interface().(string)
-	case "cosmos.gov.v1.Proposal.expedited":
-		x.Expedited = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalOption"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalOption does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1602,122 +1204,49 @@ func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalOption) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Proposal.messages":
+	case "cosmos.gov.v1.ProposalOption.messages":
 		if x.Messages == nil {
 			x.Messages = []*anypb.Any{}
 		}
-		value := &_Proposal_2_list{list: &x.Messages}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Proposal.final_tally_result":
-		if x.FinalTallyResult == nil {
-			x.FinalTallyResult = new(TallyResult)
-		}
-		return protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.submit_time":
-		if x.SubmitTime == nil {
-			x.SubmitTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.deposit_end_time":
-		if x.DepositEndTime == nil {
-			x.DepositEndTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.DepositEndTime.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.total_deposit":
-		if x.TotalDeposit == nil {
-			x.TotalDeposit = []*v1beta1.Coin{}
-		}
-		value := &_Proposal_7_list{list: &x.TotalDeposit}
+		value := &_ProposalOption_2_list{list: &x.Messages}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Proposal.voting_start_time":
-		if x.VotingStartTime == nil {
-			x.VotingStartTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.VotingStartTime.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.voting_end_time":
-		if x.VotingEndTime == nil {
-			x.VotingEndTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.id":
-		panic(fmt.Errorf("field id of message cosmos.gov.v1.Proposal is not mutable"))
-	case "cosmos.gov.v1.Proposal.status":
-		panic(fmt.Errorf("field status of message cosmos.gov.v1.Proposal is not mutable"))
-	case "cosmos.gov.v1.Proposal.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.gov.v1.Proposal is not mutable"))
-	case "cosmos.gov.v1.Proposal.title":
-		panic(fmt.Errorf("field title of message cosmos.gov.v1.Proposal is not mutable"))
-	case "cosmos.gov.v1.Proposal.summary":
-		panic(fmt.Errorf("field summary of message cosmos.gov.v1.Proposal is not mutable"))
-	case "cosmos.gov.v1.Proposal.proposer":
-		panic(fmt.Errorf("field proposer of message cosmos.gov.v1.Proposal is not mutable"))
-	case "cosmos.gov.v1.Proposal.expedited":
-		panic(fmt.Errorf("field expedited of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.ProposalOption.title":
+		panic(fmt.Errorf("field title of message cosmos.gov.v1.ProposalOption is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalOption"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalOption does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalOption) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Proposal.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gov.v1.Proposal.messages":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_Proposal_2_list{list: &list})
-	case "cosmos.gov.v1.Proposal.status":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.gov.v1.Proposal.final_tally_result":
-		m := new(TallyResult)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.submit_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.deposit_end_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.total_deposit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Proposal_7_list{list: &list})
-	case "cosmos.gov.v1.Proposal.voting_start_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.voting_end_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Proposal.metadata":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Proposal.title":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Proposal.summary":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Proposal.proposer":
+	case "cosmos.gov.v1.ProposalOption.title":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Proposal.expedited":
-		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.ProposalOption.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_ProposalOption_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalOption"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalOption does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ProposalOption) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Proposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ProposalOption", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1725,7 +1254,7 @@ func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ProposalOption) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1736,7 +1265,7 @@ func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ProposalOption) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1748,7 +1277,7 @@ func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Proposal) IsValid() bool {
+func (x *fastReflection_ProposalOption) IsValid() bool {
 	return x != nil
 }
 
@@ -1758,9 +1287,9 @@ func (x *fastReflection_Proposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ProposalOption) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*ProposalOption)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1772,8 +1301,9 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if len(x.Messages) > 0 {
 			for _, e := range x.Messages {
@@ -1781,54 +1311,6 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
-		}
-		if x.FinalTallyResult != nil {
-			l = options.Size(x.FinalTallyResult)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.SubmitTime != nil {
-			l = options.Size(x.SubmitTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.DepositEndTime != nil {
-			l = options.Size(x.DepositEndTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.TotalDeposit) > 0 {
-			for _, e := range x.TotalDeposit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.VotingStartTime != nil {
-			l = options.Size(x.VotingStartTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.VotingEndTime != nil {
-			l = options.Size(x.VotingEndTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Metadata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Title)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Summary)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Proposer)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Expedited {
-			n += 2
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1839,7 +1321,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*ProposalOption)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1858,155 +1340,28 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Expedited {
-			i--
-			if x.Expedited {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
 			i--
-			dAtA[i] = 0x70
-		}
-		if len(x.Proposer) > 0 {
-			i -= len(x.Proposer)
-			copy(dAtA[i:], x.Proposer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.Summary) > 0 {
-			i -= len(x.Summary)
-			copy(dAtA[i:], x.Summary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if len(x.Title) > 0 {
-			i -= len(x.Title)
-			copy(dAtA[i:], x.Title)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if x.VotingEndTime != nil {
-			encoded, err := options.Marshal(x.VotingEndTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if x.VotingStartTime != nil {
-			encoded, err := options.Marshal(x.VotingStartTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.TotalDeposit) > 0 {
-			for iNdEx := len(x.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TotalDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3a
-			}
-		}
-		if x.DepositEndTime != nil {
-			encoded, err := options.Marshal(x.DepositEndTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.SubmitTime != nil {
-			encoded, err := options.Marshal(x.SubmitTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.FinalTallyResult != nil {
-			encoded, err := options.Marshal(x.FinalTallyResult)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Messages) > 0 {
-			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Messages[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2019,7 +1374,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*ProposalOption)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2051,36 +1406,17 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalOption: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalOption: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2090,48 +1426,27 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Messages = append(x.Messages, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Title = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-				}
-				x.Status = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Status |= ProposalStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2158,88 +1473,2213 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.FinalTallyResult == nil {
-					x.FinalTallyResult = &TallyResult{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalTallyResult); err != nil {
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.SubmitTime == nil {
-					x.SubmitTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Deposit_3_list)(nil)
+
+type _Deposit_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Deposit_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Deposit_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Deposit_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Deposit_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Deposit_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Deposit_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Deposit_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Deposit_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Deposit             protoreflect.MessageDescriptor
+	fd_Deposit_proposal_id protoreflect.FieldDescriptor
+	fd_Deposit_depositor   protoreflect.FieldDescriptor
+	fd_Deposit_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_Deposit = File_cosmos_gov_v1_gov_proto.Messages().ByName("Deposit")
+	fd_Deposit_proposal_id = md_Deposit.Fields().ByName("proposal_id")
+	fd_Deposit_depositor = md_Deposit.Fields().ByName("depositor")
+	fd_Deposit_amount = md_Deposit.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_Deposit)(nil)
+
+type fastReflection_Deposit Deposit
+
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Deposit)(x)
+}
+
+func (x *Deposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Deposit_messageType fastReflection_Deposit_messageType
+var _ protoreflect.MessageType = fastReflection_Deposit_messageType{}
+
+type fastReflection_Deposit_messageType struct{}
+
+func (x fastReflection_Deposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Deposit)(nil)
+}
+func (x fastReflection_Deposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_Deposit)
+}
+func (x fastReflection_Deposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deposit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Deposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deposit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Deposit) Type() protoreflect.MessageType {
+	return _fastReflection_Deposit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Deposit) New() protoreflect.Message {
+	return new(fastReflection_Deposit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Deposit) Interface() protoreflect.ProtoMessage {
+	return (*Deposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Deposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_Deposit_proposal_id, value) {
+			return
+		}
+	}
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_Deposit_depositor, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_Deposit_3_list{list: &x.Amount})
+		if !f(fd_Deposit_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Deposit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Deposit.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.Deposit.depositor":
+		return x.Depositor != ""
+	case "cosmos.gov.v1.Deposit.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deposit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Deposit.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.Deposit.depositor":
+		x.Depositor = ""
+	case "cosmos.gov.v1.Deposit.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Deposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.Deposit.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.Deposit.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Deposit.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_Deposit_3_list{})
+		}
+		listValue := &_Deposit_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Deposit.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.Deposit.depositor":
+		x.Depositor = value.Interface().(string)
+	case "cosmos.gov.v1.Deposit.amount":
+		lv := value.List()
+		clv := lv.(*_Deposit_3_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Deposit.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_Deposit_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Deposit.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.Deposit is not mutable"))
+	case "cosmos.gov.v1.Deposit.depositor":
+		panic(fmt.Errorf("field depositor of message cosmos.gov.v1.Deposit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Deposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Deposit.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.Deposit.depositor":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Deposit.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Deposit_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Deposit"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Deposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Deposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Deposit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Deposit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deposit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Deposit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Deposit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Deposit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Deposit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Deposit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Proposal_2_list)(nil)
+
+type _Proposal_2_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Proposal_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Proposal_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_2_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_2_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Proposal_7_list)(nil)
+
+type _Proposal_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Proposal_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Proposal_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Proposal_16_list)(nil)
+
+type _Proposal_16_list struct {
+	list *[]*ProposalOption
+}
+
+func (x *_Proposal_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Proposal_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalOption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalOption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_16_list) AppendMutable() protoreflect.Value {
+	v := new(ProposalOption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_16_list) NewElement() protoreflect.Value {
+	v := new(ProposalOption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Proposal                    protoreflect.MessageDescriptor
+	fd_Proposal_id                 protoreflect.FieldDescriptor
+	fd_Proposal_messages           protoreflect.FieldDescriptor
+	fd_Proposal_status             protoreflect.FieldDescriptor
+	fd_Proposal_final_tally_result protoreflect.FieldDescriptor
+	fd_Proposal_submit_time        protoreflect.FieldDescriptor
+	fd_Proposal_deposit_end_time   protoreflect.FieldDescriptor
+	fd_Proposal_total_deposit      protoreflect.FieldDescriptor
+	fd_Proposal_voting_start_time  protoreflect.FieldDescriptor
+	fd_Proposal_voting_end_time    protoreflect.FieldDescriptor
+	fd_Proposal_metadata           protoreflect.FieldDescriptor
+	fd_Proposal_title              protoreflect.FieldDescriptor
+	fd_Proposal_summary            protoreflect.FieldDescriptor
+	fd_Proposal_proposer           protoreflect.FieldDescriptor
+	fd_Proposal_expedited          protoreflect.FieldDescriptor
+	fd_Proposal_optimistic         protoreflect.FieldDescriptor
+	fd_Proposal_options            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_Proposal = File_cosmos_gov_v1_gov_proto.Messages().ByName("Proposal")
+	fd_Proposal_id = md_Proposal.Fields().ByName("id")
+	fd_Proposal_messages = md_Proposal.Fields().ByName("messages")
+	fd_Proposal_status = md_Proposal.Fields().ByName("status")
+	fd_Proposal_final_tally_result = md_Proposal.Fields().ByName("final_tally_result")
+	fd_Proposal_submit_time = md_Proposal.Fields().ByName("submit_time")
+	fd_Proposal_deposit_end_time = md_Proposal.Fields().ByName("deposit_end_time")
+	fd_Proposal_total_deposit = md_Proposal.Fields().ByName("total_deposit")
+	fd_Proposal_voting_start_time = md_Proposal.Fields().ByName("voting_start_time")
+	fd_Proposal_voting_end_time = md_Proposal.Fields().ByName("voting_end_time")
+	fd_Proposal_metadata = md_Proposal.Fields().ByName("metadata")
+	fd_Proposal_title = md_Proposal.Fields().ByName("title")
+	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
+	fd_Proposal_proposer = md_Proposal.Fields().ByName("proposer")
+	fd_Proposal_expedited = md_Proposal.Fields().ByName("expedited")
+	fd_Proposal_optimistic = md_Proposal.Fields().ByName("optimistic")
+	fd_Proposal_options = md_Proposal.Fields().ByName("options")
+}
+
+var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
+
+type fastReflection_Proposal Proposal
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Proposal)(x)
+}
+
+func (x *Proposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
+var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
+
+type fastReflection_Proposal_messageType struct{}
+
+func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Proposal)(nil)
+}
+func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
+}
+func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
+	return _fastReflection_Proposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Proposal) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
+	return (*Proposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Proposal_id, value) {
+			return
+		}
+	}
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_2_list{list: &x.Messages})
+		if !f(fd_Proposal_messages, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Proposal_status, value) {
+			return
+		}
+	}
+	if x.FinalTallyResult != nil {
+		value := protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
+		if !f(fd_Proposal_final_tally_result, value) {
+			return
+		}
+	}
+	if x.SubmitTime != nil {
+		value := protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
+		if !f(fd_Proposal_submit_time, value) {
+			return
+		}
+	}
+	if x.DepositEndTime != nil {
+		value := protoreflect.ValueOfMessage(x.DepositEndTime.ProtoReflect())
+		if !f(fd_Proposal_deposit_end_time, value) {
+			return
+		}
+	}
+	if len(x.TotalDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_7_list{list: &x.TotalDeposit})
+		if !f(fd_Proposal_total_deposit, value) {
+			return
+		}
+	}
+	if x.VotingStartTime != nil {
+		value := protoreflect.ValueOfMessage(x.VotingStartTime.ProtoReflect())
+		if !f(fd_Proposal_voting_start_time, value) {
+			return
+		}
+	}
+	if x.VotingEndTime != nil {
+		value := protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
+		if !f(fd_Proposal_voting_end_time, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_Proposal_metadata, value) {
+			return
+		}
+	}
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_Proposal_title, value) {
+			return
+		}
+	}
+	if x.Summary != "" {
+		value := protoreflect.ValueOfString(x.Summary)
+		if !f(fd_Proposal_summary, value) {
+			return
+		}
+	}
+	if x.Proposer != "" {
+		value := protoreflect.ValueOfString(x.Proposer)
+		if !f(fd_Proposal_proposer, value) {
+			return
+		}
+	}
+	if x.Expedited != false {
+		value := protoreflect.ValueOfBool(x.Expedited)
+		if !f(fd_Proposal_expedited, value) {
+			return
+		}
+	}
+	if x.Optimistic != false {
+		value := protoreflect.ValueOfBool(x.Optimistic)
+		if !f(fd_Proposal_optimistic, value) {
+			return
+		}
+	}
+	if len(x.Options) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_16_list{list: &x.Options})
+		if !f(fd_Proposal_options, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Proposal.id":
+		return x.Id != uint64(0)
+	case "cosmos.gov.v1.Proposal.messages":
+		return len(x.Messages) != 0
+	case "cosmos.gov.v1.Proposal.status":
+		return x.Status != 0
+	case "cosmos.gov.v1.Proposal.final_tally_result":
+		return x.FinalTallyResult != nil
+	case "cosmos.gov.v1.Proposal.submit_time":
+		return x.SubmitTime != nil
+	case "cosmos.gov.v1.Proposal.deposit_end_time":
+		return x.DepositEndTime != nil
+	case "cosmos.gov.v1.Proposal.total_deposit":
+		return len(x.TotalDeposit) != 0
+	case "cosmos.gov.v1.Proposal.voting_start_time":
+		return x.VotingStartTime != nil
+	case "cosmos.gov.v1.Proposal.voting_end_time":
+		return x.VotingEndTime != nil
+	case "cosmos.gov.v1.Proposal.metadata":
+		return x.Metadata != ""
+	case "cosmos.gov.v1.Proposal.title":
+		return x.Title != ""
+	case "cosmos.gov.v1.Proposal.summary":
+		return x.Summary != ""
+	case "cosmos.gov.v1.Proposal.proposer":
+		return x.Proposer != ""
+	case "cosmos.gov.v1.Proposal.expedited":
+		return x.Expedited != false
+	case "cosmos.gov.v1.Proposal.optimistic":
+		return x.Optimistic != false
+	case "cosmos.gov.v1.Proposal.options":
+		return len(x.Options) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Proposal.id":
+		x.Id = uint64(0)
+	case "cosmos.gov.v1.Proposal.messages":
+		x.Messages = nil
+	case "cosmos.gov.v1.Proposal.status":
+		x.Status = 0
+	case "cosmos.gov.v1.Proposal.final_tally_result":
+		x.FinalTallyResult = nil
+	case "cosmos.gov.v1.Proposal.submit_time":
+		x.SubmitTime = nil
+	case "cosmos.gov.v1.Proposal.deposit_end_time":
+		x.DepositEndTime = nil
+	case "cosmos.gov.v1.Proposal.total_deposit":
+		x.TotalDeposit = nil
+	case "cosmos.gov.v1.Proposal.voting_start_time":
+		x.VotingStartTime = nil
+	case "cosmos.gov.v1.Proposal.voting_end_time":
+		x.VotingEndTime = nil
+	case "cosmos.gov.v1.Proposal.metadata":
+		x.Metadata = ""
+	case "cosmos.gov.v1.Proposal.title":
+		x.Title = ""
+	case "cosmos.gov.v1.Proposal.summary":
+		x.Summary = ""
+	case "cosmos.gov.v1.Proposal.proposer":
+		x.Proposer = ""
+	case "cosmos.gov.v1.Proposal.expedited":
+		x.Expedited = false
+	case "cosmos.gov.v1.Proposal.optimistic":
+		x.Optimistic = false
+	case "cosmos.gov.v1.Proposal.options":
+		x.Options = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.Proposal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.Proposal.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_2_list{})
+		}
+		listValue := &_Proposal_2_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Proposal.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.gov.v1.Proposal.final_tally_result":
+		value := x.FinalTallyResult
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.submit_time":
+		value := x.SubmitTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.deposit_end_time":
+		value := x.DepositEndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.total_deposit":
+		if len(x.TotalDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_7_list{})
+		}
+		listValue := &_Proposal_7_list{list: &x.TotalDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Proposal.voting_start_time":
+		value := x.VotingStartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.voting_end_time":
+		value := x.VotingEndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Proposal.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Proposal.summary":
+		value := x.Summary
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Proposal.proposer":
+		value := x.Proposer
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Proposal.expedited":
+		value := x.Expedited
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Proposal.optimistic":
+		value := x.Optimistic
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Proposal.options":
+		if len(x.Options) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_16_list{})
+		}
+		listValue := &_Proposal_16_list{list: &x.Options}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Proposal.id":
+		x.Id = value.Uint()
+	case "cosmos.gov.v1.Proposal.messages":
+		lv := value.List()
+		clv := lv.(*_Proposal_2_list)
+		x.Messages = *clv.list
+	case "cosmos.gov.v1.Proposal.status":
+		x.Status = (ProposalStatus)(value.Enum())
+	case "cosmos.gov.v1.Proposal.final_tally_result":
+		x.FinalTallyResult = value.Message().Interface().(*TallyResult)
+	case "cosmos.gov.v1.Proposal.submit_time":
+		x.SubmitTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.gov.v1.Proposal.deposit_end_time":
+		x.DepositEndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.gov.v1.Proposal.total_deposit":
+		lv := value.List()
+		clv := lv.(*_Proposal_7_list)
+		x.TotalDeposit = *clv.list
+	case "cosmos.gov.v1.Proposal.voting_start_time":
+		x.VotingStartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.gov.v1.Proposal.voting_end_time":
+		x.VotingEndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.gov.v1.Proposal.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.gov.v1.Proposal.title":
+		x.Title = value.Interface().(string)
+	case "cosmos.gov.v1.Proposal.summary":
+		x.Summary = value.Interface().(string)
+	case "cosmos.gov.v1.Proposal.proposer":
+		x.Proposer = value.Interface().(string)
+	case "cosmos.gov.v1.Proposal.expedited":
+		x.Expedited = value.Bool()
+	case "cosmos.gov.v1.Proposal.optimistic":
+		x.Optimistic = value.Bool()
+	case "cosmos.gov.v1.Proposal.options":
+		lv := value.List()
+		clv := lv.(*_Proposal_16_list)
+		x.Options = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Proposal.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
+		}
+		value := &_Proposal_2_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Proposal.final_tally_result":
+		if x.FinalTallyResult == nil {
+			x.FinalTallyResult = new(TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.submit_time":
+		if x.SubmitTime == nil {
+			x.SubmitTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.deposit_end_time":
+		if x.DepositEndTime == nil {
+			x.DepositEndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.DepositEndTime.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.total_deposit":
+		if x.TotalDeposit == nil {
+			x.TotalDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Proposal_7_list{list: &x.TotalDeposit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Proposal.voting_start_time":
+		if x.VotingStartTime == nil {
+			x.VotingStartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.VotingStartTime.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.voting_end_time":
+		if x.VotingEndTime == nil {
+			x.VotingEndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.options":
+		if x.Options == nil {
+			x.Options = []*ProposalOption{}
+		}
+		value := &_Proposal_16_list{list: &x.Options}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Proposal.id":
+		panic(fmt.Errorf("field id of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.status":
+		panic(fmt.Errorf("field status of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.title":
+		panic(fmt.Errorf("field title of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.summary":
+		panic(fmt.Errorf("field summary of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.proposer":
+		panic(fmt.Errorf("field proposer of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.expedited":
+		panic(fmt.Errorf("field expedited of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.optimistic":
+		panic(fmt.Errorf("field optimistic of message cosmos.gov.v1.Proposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Proposal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.Proposal.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Proposal_2_list{list: &list})
+	case "cosmos.gov.v1.Proposal.status":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.gov.v1.Proposal.final_tally_result":
+		m := new(TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.submit_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.deposit_end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.total_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Proposal_7_list{list: &list})
+	case "cosmos.gov.v1.Proposal.voting_start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.voting_end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.metadata":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Proposal.title":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Proposal.summary":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Proposal.proposer":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Proposal.expedited":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Proposal.optimistic":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Proposal.options":
+		list := []*ProposalOption{}
+		return protoreflect.ValueOfList(&_Proposal_16_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Proposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Proposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.FinalTallyResult != nil {
+			l = options.Size(x.FinalTallyResult)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SubmitTime != nil {
+			l = options.Size(x.SubmitTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DepositEndTime != nil {
+			l = options.Size(x.DepositEndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TotalDeposit) > 0 {
+			for _, e := range x.TotalDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.VotingStartTime != nil {
+			l = options.Size(x.VotingStartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VotingEndTime != nil {
+			l = options.Size(x.VotingEndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Summary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Proposer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expedited {
+			n += 2
+		}
+		if x.Optimistic {
+			n += 2
+		}
+		if len(x.Options) > 0 {
+			for _, e := range x.Options {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Options) > 0 {
+			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Options[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.Optimistic {
+			i--
+			if x.Optimistic {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.Expedited {
+			i--
+			if x.Expedited {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.Proposer) > 0 {
+			i -= len(x.Proposer)
+			copy(dAtA[i:], x.Proposer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposer)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.Summary) > 0 {
+			i -= len(x.Summary)
+			copy(dAtA[i:], x.Summary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.VotingEndTime != nil {
+			encoded, err := options.Marshal(x.VotingEndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.VotingStartTime != nil {
+			encoded, err := options.Marshal(x.VotingStartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.TotalDeposit) > 0 {
+			for iNdEx := len(x.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.DepositEndTime != nil {
+			encoded, err := options.Marshal(x.DepositEndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.SubmitTime != nil {
+			encoded, err := options.Marshal(x.SubmitTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.FinalTallyResult != nil {
+			encoded, err := options.Marshal(x.FinalTallyResult)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ProposalStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FinalTallyResult == nil {
+					x.FinalTallyResult = &TallyResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalTallyResult); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SubmitTime == nil {
+					x.SubmitTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositEndTime == nil {
+					x.DepositEndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositEndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalDeposit = append(x.TotalDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalDeposit[len(x.TotalDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingStartTime == nil {
+					x.VotingStartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingStartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingEndTime == nil {
+					x.VotingEndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingEndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Summary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Expedited = bool(v != 0)
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Optimistic", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.DepositEndTime == nil {
-					x.DepositEndTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositEndTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 7:
+				x.Optimistic = bool(v != 0)
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2266,52 +3706,592 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TotalDeposit = append(x.TotalDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalDeposit[len(x.TotalDeposit)-1]); err != nil {
+				x.Options = append(x.Options, &ProposalOption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options[len(x.Options)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if msglen < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TallyResult_5_list)(nil)
+
+type _TallyResult_5_list struct {
+	list *[]string
+}
+
+func (x *_TallyResult_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TallyResult_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TallyResult_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TallyResult_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TallyResult_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TallyResult at list field OptionCounts as it is not of Message kind"))
+}
+
+func (x *_TallyResult_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TallyResult_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TallyResult_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TallyResult                    protoreflect.MessageDescriptor
+	fd_TallyResult_yes_count          protoreflect.FieldDescriptor
+	fd_TallyResult_abstain_count      protoreflect.FieldDescriptor
+	fd_TallyResult_no_count           protoreflect.FieldDescriptor
+	fd_TallyResult_no_with_veto_count protoreflect.FieldDescriptor
+	fd_TallyResult_option_counts      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_TallyResult = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyResult")
+	fd_TallyResult_yes_count = md_TallyResult.Fields().ByName("yes_count")
+	fd_TallyResult_abstain_count = md_TallyResult.Fields().ByName("abstain_count")
+	fd_TallyResult_no_count = md_TallyResult.Fields().ByName("no_count")
+	fd_TallyResult_no_with_veto_count = md_TallyResult.Fields().ByName("no_with_veto_count")
+	fd_TallyResult_option_counts = md_TallyResult.Fields().ByName("option_counts")
+}
+
+var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
+
+type fastReflection_TallyResult TallyResult
+
+func (x *TallyResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallyResult)(x)
+}
+
+func (x *TallyResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
+var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
+
+type fastReflection_TallyResult_messageType struct{}
+
+func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallyResult)(nil)
+}
+func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
+}
+func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
+	return _fastReflection_TallyResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TallyResult) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
+	return (*TallyResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.YesCount != "" {
+		value := protoreflect.ValueOfString(x.YesCount)
+		if !f(fd_TallyResult_yes_count, value) {
+			return
+		}
+	}
+	if x.AbstainCount != "" {
+		value := protoreflect.ValueOfString(x.AbstainCount)
+		if !f(fd_TallyResult_abstain_count, value) {
+			return
+		}
+	}
+	if x.NoCount != "" {
+		value := protoreflect.ValueOfString(x.NoCount)
+		if !f(fd_TallyResult_no_count, value) {
+			return
+		}
+	}
+	if x.NoWithVetoCount != "" {
+		value := protoreflect.ValueOfString(x.NoWithVetoCount)
+		if !f(fd_TallyResult_no_with_veto_count, value) {
+			return
+		}
+	}
+	if len(x.OptionCounts) != 0 {
+		value := protoreflect.ValueOfList(&_TallyResult_5_list{list: &x.OptionCounts})
+		if !f(fd_TallyResult_option_counts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		return x.YesCount != ""
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		return x.AbstainCount != ""
+	case "cosmos.gov.v1.TallyResult.no_count":
+		return x.NoCount != ""
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		return x.NoWithVetoCount != ""
+	case "cosmos.gov.v1.TallyResult.option_counts":
+		return len(x.OptionCounts) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		x.YesCount = ""
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		x.AbstainCount = ""
+	case "cosmos.gov.v1.TallyResult.no_count":
+		x.NoCount = ""
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		x.NoWithVetoCount = ""
+	case "cosmos.gov.v1.TallyResult.option_counts":
+		x.OptionCounts = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		value := x.YesCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		value := x.AbstainCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyResult.no_count":
+		value := x.NoCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		value := x.NoWithVetoCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyResult.option_counts":
+		if len(x.OptionCounts) == 0 {
+			return protoreflect.ValueOfList(&_TallyResult_5_list{})
+		}
+		listValue := &_TallyResult_5_list{list: &x.OptionCounts}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		x.YesCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		x.AbstainCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.no_count":
+		x.NoCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		x.NoWithVetoCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.option_counts":
+		lv := value.List()
+		clv := lv.(*_TallyResult_5_list)
+		x.OptionCounts = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyResult.option_counts":
+		if x.OptionCounts == nil {
+			x.OptionCounts = []string{}
+		}
+		value := &_TallyResult_5_list{list: &x.OptionCounts}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		panic(fmt.Errorf("field yes_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		panic(fmt.Errorf("field abstain_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.TallyResult.no_count":
+		panic(fmt.Errorf("field no_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		panic(fmt.Errorf("field no_with_veto_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyResult.no_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyResult.option_counts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TallyResult_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TallyResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TallyResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.YesCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AbstainCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NoCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NoWithVetoCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.OptionCounts) > 0 {
+			for _, s := range x.OptionCounts {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TallyResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OptionCounts) > 0 {
+			for iNdEx := len(x.OptionCounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.OptionCounts[iNdEx])
+				copy(dAtA[i:], x.OptionCounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptionCounts[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.NoWithVetoCount) > 0 {
+			i -= len(x.NoWithVetoCount)
+			copy(dAtA[i:], x.NoWithVetoCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoWithVetoCount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NoCount) > 0 {
+			i -= len(x.NoCount)
+			copy(dAtA[i:], x.NoCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoCount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.AbstainCount) > 0 {
+			i -= len(x.AbstainCount)
+			copy(dAtA[i:], x.AbstainCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AbstainCount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.YesCount) > 0 {
+			i -= len(x.YesCount)
+			copy(dAtA[i:], x.YesCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YesCount)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TallyResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingStartTime == nil {
-					x.VotingStartTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingStartTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 9:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2321,31 +4301,27 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingEndTime == nil {
-					x.VotingEndTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingEndTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.YesCount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 10:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2373,11 +4349,11 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
+				x.AbstainCount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 11:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2405,11 +4381,11 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Title = string(dAtA[iNdEx:postIndex])
+				x.NoCount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 12:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2437,11 +4413,11 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Summary = string(dAtA[iNdEx:postIndex])
+				x.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 13:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionCounts", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2469,28 +4445,8 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Proposer = string(dAtA[iNdEx:postIndex])
+				x.OptionCounts = append(x.OptionCounts, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 14:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Expedited = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2526,33 +4482,137 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Vote_4_list)(nil)
+
+type _Vote_4_list struct {
+	list *[]*WeightedVoteOption
+}
+
+func (x *_Vote_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Vote_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Vote_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Vote_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Vote_4_list) AppendMutable() protoreflect.Value {
+	v := new(WeightedVoteOption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Vote_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Vote_4_list) NewElement() protoreflect.Value {
+	v := new(WeightedVoteOption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Vote_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Vote_6_list)(nil)
+
+type _Vote_6_list struct {
+	list *[]*WeightedVoteChoice
+}
+
+func (x *_Vote_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Vote_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Vote_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteChoice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Vote_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteChoice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Vote_6_list) AppendMutable() protoreflect.Value {
+	v := new(WeightedVoteChoice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Vote_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Vote_6_list) NewElement() protoreflect.Value {
+	v := new(WeightedVoteChoice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Vote_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_TallyResult                    protoreflect.MessageDescriptor
-	fd_TallyResult_yes_count          protoreflect.FieldDescriptor
-	fd_TallyResult_abstain_count      protoreflect.FieldDescriptor
-	fd_TallyResult_no_count           protoreflect.FieldDescriptor
-	fd_TallyResult_no_with_veto_count protoreflect.FieldDescriptor
+	md_Vote             protoreflect.MessageDescriptor
+	fd_Vote_proposal_id protoreflect.FieldDescriptor
+	fd_Vote_voter       protoreflect.FieldDescriptor
+	fd_Vote_options     protoreflect.FieldDescriptor
+	fd_Vote_metadata    protoreflect.FieldDescriptor
+	fd_Vote_choices     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_TallyResult = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyResult")
-	fd_TallyResult_yes_count = md_TallyResult.Fields().ByName("yes_count")
-	fd_TallyResult_abstain_count = md_TallyResult.Fields().ByName("abstain_count")
-	fd_TallyResult_no_count = md_TallyResult.Fields().ByName("no_count")
-	fd_TallyResult_no_with_veto_count = md_TallyResult.Fields().ByName("no_with_veto_count")
+	md_Vote = File_cosmos_gov_v1_gov_proto.Messages().ByName("Vote")
+	fd_Vote_proposal_id = md_Vote.Fields().ByName("proposal_id")
+	fd_Vote_voter = md_Vote.Fields().ByName("voter")
+	fd_Vote_options = md_Vote.Fields().ByName("options")
+	fd_Vote_metadata = md_Vote.Fields().ByName("metadata")
+	fd_Vote_choices = md_Vote.Fields().ByName("choices")
 }
 
-var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
+var _ protoreflect.Message = (*fastReflection_Vote)(nil)
 
-type fastReflection_TallyResult TallyResult
+type fastReflection_Vote Vote
 
-func (x *TallyResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TallyResult)(x)
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Vote)(x)
 }
 
-func (x *TallyResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
+func (x *Vote) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2563,43 +4623,43 @@ func (x *TallyResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
-var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
+var _fastReflection_Vote_messageType fastReflection_Vote_messageType
+var _ protoreflect.MessageType = fastReflection_Vote_messageType{}
 
-type fastReflection_TallyResult_messageType struct{}
+type fastReflection_Vote_messageType struct{}
 
-func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TallyResult)(nil)
-}
-func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x fastReflection_Vote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Vote)(nil)
 }
-func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x fastReflection_Vote_messageType) New() protoreflect.Message {
+	return new(fastReflection_Vote)
+}
+func (x fastReflection_Vote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x *fastReflection_Vote) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
-	return _fastReflection_TallyResult_messageType
+func (x *fastReflection_Vote) Type() protoreflect.MessageType {
+	return _fastReflection_Vote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TallyResult) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x *fastReflection_Vote) New() protoreflect.Message {
+	return new(fastReflection_Vote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
-	return (*TallyResult)(x)
+func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
+	return (*Vote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2607,28 +4667,34 @@ func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.YesCount != "" {
-		value := protoreflect.ValueOfString(x.YesCount)
-		if !f(fd_TallyResult_yes_count, value) {
+func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_Vote_proposal_id, value) {
 			return
 		}
 	}
-	if x.AbstainCount != "" {
-		value := protoreflect.ValueOfString(x.AbstainCount)
-		if !f(fd_TallyResult_abstain_count, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_Vote_voter, value) {
 			return
 		}
 	}
-	if x.NoCount != "" {
-		value := protoreflect.ValueOfString(x.NoCount)
-		if !f(fd_TallyResult_no_count, value) {
+	if len(x.Options) != 0 {
+		value := protoreflect.ValueOfList(&_Vote_4_list{list: &x.Options})
+		if !f(fd_Vote_options, value) {
 			return
 		}
 	}
-	if x.NoWithVetoCount != "" {
-		value := protoreflect.ValueOfString(x.NoWithVetoCount)
-		if !f(fd_TallyResult_no_with_veto_count, value) {
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_Vote_metadata, value) {
+			return
+		}
+	}
+	if len(x.Choices) != 0 {
+		value := protoreflect.ValueOfList(&_Vote_6_list{list: &x.Choices})
+		if !f(fd_Vote_choices, value) {
 			return
 		}
 	}
@@ -2645,21 +4711,23 @@ func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		return x.YesCount != ""
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		return x.AbstainCount != ""
-	case "cosmos.gov.v1.TallyResult.no_count":
-		return x.NoCount != ""
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		return x.NoWithVetoCount != ""
+	case "cosmos.gov.v1.Vote.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.Vote.voter":
+		return x.Voter != ""
+	case "cosmos.gov.v1.Vote.options":
+		return len(x.Options) != 0
+	case "cosmos.gov.v1.Vote.metadata":
+		return x.Metadata != ""
+	case "cosmos.gov.v1.Vote.choices":
+		return len(x.Choices) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2669,21 +4737,23 @@ func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		x.YesCount = ""
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		x.AbstainCount = ""
-	case "cosmos.gov.v1.TallyResult.no_count":
-		x.NoCount = ""
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		x.NoWithVetoCount = ""
+	case "cosmos.gov.v1.Vote.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.Vote.voter":
+		x.Voter = ""
+	case "cosmos.gov.v1.Vote.options":
+		x.Options = nil
+	case "cosmos.gov.v1.Vote.metadata":
+		x.Metadata = ""
+	case "cosmos.gov.v1.Vote.choices":
+		x.Choices = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2693,25 +4763,34 @@ func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		value := x.YesCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		value := x.AbstainCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyResult.no_count":
-		value := x.NoCount
+	case "cosmos.gov.v1.Vote.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.Vote.voter":
+		value := x.Voter
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		value := x.NoWithVetoCount
+	case "cosmos.gov.v1.Vote.options":
+		if len(x.Options) == 0 {
+			return protoreflect.ValueOfList(&_Vote_4_list{})
+		}
+		listValue := &_Vote_4_list{list: &x.Options}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Vote.metadata":
+		value := x.Metadata
 		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Vote.choices":
+		if len(x.Choices) == 0 {
+			return protoreflect.ValueOfList(&_Vote_6_list{})
+		}
+		listValue := &_Vote_6_list{list: &x.Choices}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2725,21 +4804,27 @@ func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		x.YesCount = value.Interface().(string)
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		x.AbstainCount = value.Interface().(string)
-	case "cosmos.gov.v1.TallyResult.no_count":
-		x.NoCount = value.Interface().(string)
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		x.NoWithVetoCount = value.Interface().(string)
+	case "cosmos.gov.v1.Vote.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.Vote.voter":
+		x.Voter = value.Interface().(string)
+	case "cosmos.gov.v1.Vote.options":
+		lv := value.List()
+		clv := lv.(*_Vote_4_list)
+		x.Options = *clv.list
+	case "cosmos.gov.v1.Vote.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.gov.v1.Vote.choices":
+		lv := value.List()
+		clv := lv.(*_Vote_6_list)
+		x.Choices = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2753,52 +4838,66 @@ func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		panic(fmt.Errorf("field yes_count of message cosmos.gov.v1.TallyResult is not mutable"))
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		panic(fmt.Errorf("field abstain_count of message cosmos.gov.v1.TallyResult is not mutable"))
-	case "cosmos.gov.v1.TallyResult.no_count":
-		panic(fmt.Errorf("field no_count of message cosmos.gov.v1.TallyResult is not mutable"))
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		panic(fmt.Errorf("field no_with_veto_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.Vote.options":
+		if x.Options == nil {
+			x.Options = []*WeightedVoteOption{}
+		}
+		value := &_Vote_4_list{list: &x.Options}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Vote.choices":
+		if x.Choices == nil {
+			x.Choices = []*WeightedVoteChoice{}
+		}
+		value := &_Vote_6_list{list: &x.Choices}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Vote.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.Vote.voter":
+		panic(fmt.Errorf("field voter of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.Vote.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.gov.v1.Vote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyResult.no_count":
+	case "cosmos.gov.v1.Vote.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.Vote.voter":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+	case "cosmos.gov.v1.Vote.options":
+		list := []*WeightedVoteOption{}
+		return protoreflect.ValueOfList(&_Vote_4_list{list: &list})
+	case "cosmos.gov.v1.Vote.metadata":
 		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Vote.choices":
+		list := []*WeightedVoteChoice{}
+		return protoreflect.ValueOfList(&_Vote_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Vote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2806,7 +4905,7 @@ func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2817,7 +4916,7 @@ func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2829,7 +4928,7 @@ func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TallyResult) IsValid() bool {
+func (x *fastReflection_Vote) IsValid() bool {
 	return x != nil
 }
 
@@ -2839,9 +4938,9 @@ func (x *fastReflection_TallyResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*Vote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2853,22 +4952,29 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.YesCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.AbstainCount)
+		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.NoCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Options) > 0 {
+			for _, e := range x.Options {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.NoWithVetoCount)
+		l = len(x.Metadata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Choices) > 0 {
+			for _, e := range x.Choices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2879,7 +4985,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*Vote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2898,33 +5004,56 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NoWithVetoCount) > 0 {
-			i -= len(x.NoWithVetoCount)
-			copy(dAtA[i:], x.NoWithVetoCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoWithVetoCount)))
+		if len(x.Choices) > 0 {
+			for iNdEx := len(x.Choices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Choices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2a
 		}
-		if len(x.NoCount) > 0 {
-			i -= len(x.NoCount)
-			copy(dAtA[i:], x.NoCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoCount)))
-			i--
-			dAtA[i] = 0x1a
+		if len(x.Options) > 0 {
+			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Options[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
-		if len(x.AbstainCount) > 0 {
-			i -= len(x.AbstainCount)
-			copy(dAtA[i:], x.AbstainCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AbstainCount)))
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.YesCount) > 0 {
-			i -= len(x.YesCount)
-			copy(dAtA[i:], x.YesCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YesCount)))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2937,7 +5066,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*Vote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2969,15 +5098,34 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3005,13 +5153,13 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.YesCount = string(dAtA[iNdEx:postIndex])
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3021,27 +5169,29 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AbstainCount = string(dAtA[iNdEx:postIndex])
+				x.Options = append(x.Options, &WeightedVoteOption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options[len(x.Options)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3069,13 +5219,13 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NoCount = string(dAtA[iNdEx:postIndex])
+				x.Metadata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Choices", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3085,23 +5235,25 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+				x.Choices = append(x.Choices, &WeightedVoteChoice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Choices[len(x.Choices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3138,84 +5290,80 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Vote_4_list)(nil)
+var _ protoreflect.List = (*_DepositParams_1_list)(nil)
 
-type _Vote_4_list struct {
-	list *[]*WeightedVoteOption
+type _DepositParams_1_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_Vote_4_list) Len() int {
+func (x *_DepositParams_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Vote_4_list) Get(i int) protoreflect.Value {
+func (x *_DepositParams_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Vote_4_list) Set(i int, value protoreflect.Value) {
+func (x *_DepositParams_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Vote_4_list) Append(value protoreflect.Value) {
+func (x *_DepositParams_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Vote_4_list) AppendMutable() protoreflect.Value {
-	v := new(WeightedVoteOption)
+func (x *_DepositParams_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Vote_4_list) Truncate(n int) {
+func (x *_DepositParams_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Vote_4_list) NewElement() protoreflect.Value {
-	v := new(WeightedVoteOption)
+func (x *_DepositParams_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Vote_4_list) IsValid() bool {
+func (x *_DepositParams_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Vote             protoreflect.MessageDescriptor
-	fd_Vote_proposal_id protoreflect.FieldDescriptor
-	fd_Vote_voter       protoreflect.FieldDescriptor
-	fd_Vote_options     protoreflect.FieldDescriptor
-	fd_Vote_metadata    protoreflect.FieldDescriptor
+	md_DepositParams                    protoreflect.MessageDescriptor
+	fd_DepositParams_min_deposit        protoreflect.FieldDescriptor
+	fd_DepositParams_max_deposit_period protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_Vote = File_cosmos_gov_v1_gov_proto.Messages().ByName("Vote")
-	fd_Vote_proposal_id = md_Vote.Fields().ByName("proposal_id")
-	fd_Vote_voter = md_Vote.Fields().ByName("voter")
-	fd_Vote_options = md_Vote.Fields().ByName("options")
-	fd_Vote_metadata = md_Vote.Fields().ByName("metadata")
+	md_DepositParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("DepositParams")
+	fd_DepositParams_min_deposit = md_DepositParams.Fields().ByName("min_deposit")
+	fd_DepositParams_max_deposit_period = md_DepositParams.Fields().ByName("max_deposit_period")
 }
 
-var _ protoreflect.Message = (*fastReflection_Vote)(nil)
+var _ protoreflect.Message = (*fastReflection_DepositParams)(nil)
 
-type fastReflection_Vote Vote
+type fastReflection_DepositParams DepositParams
 
-func (x *Vote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Vote)(x)
+func (x *DepositParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositParams)(x)
 }
 
-func (x *Vote) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
+func (x *DepositParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3226,43 +5374,43 @@ func (x *Vote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Vote_messageType fastReflection_Vote_messageType
-var _ protoreflect.MessageType = fastReflection_Vote_messageType{}
+var _fastReflection_DepositParams_messageType fastReflection_DepositParams_messageType
+var _ protoreflect.MessageType = fastReflection_DepositParams_messageType{}
 
-type fastReflection_Vote_messageType struct{}
+type fastReflection_DepositParams_messageType struct{}
 
-func (x fastReflection_Vote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Vote)(nil)
+func (x fastReflection_DepositParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositParams)(nil)
 }
-func (x fastReflection_Vote_messageType) New() protoreflect.Message {
-	return new(fastReflection_Vote)
+func (x fastReflection_DepositParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositParams)
 }
-func (x fastReflection_Vote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vote
+func (x fastReflection_DepositParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Vote) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vote
+func (x *fastReflection_DepositParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Vote) Type() protoreflect.MessageType {
-	return _fastReflection_Vote_messageType
+func (x *fastReflection_DepositParams) Type() protoreflect.MessageType {
+	return _fastReflection_DepositParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Vote) New() protoreflect.Message {
-	return new(fastReflection_Vote)
+func (x *fastReflection_DepositParams) New() protoreflect.Message {
+	return new(fastReflection_DepositParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
-	return (*Vote)(x)
+func (x *fastReflection_DepositParams) Interface() protoreflect.ProtoMessage {
+	return (*DepositParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3270,28 +5418,16 @@ func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_Vote_proposal_id, value) {
-			return
-		}
-	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_Vote_voter, value) {
-			return
-		}
-	}
-	if len(x.Options) != 0 {
-		value := protoreflect.ValueOfList(&_Vote_4_list{list: &x.Options})
-		if !f(fd_Vote_options, value) {
+func (x *fastReflection_DepositParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_DepositParams_1_list{list: &x.MinDeposit})
+		if !f(fd_DepositParams_min_deposit, value) {
 			return
 		}
 	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_Vote_metadata, value) {
+	if x.MaxDepositPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+		if !f(fd_DepositParams_max_deposit_period, value) {
 			return
 		}
 	}
@@ -3308,45 +5444,37 @@ func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DepositParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		return x.ProposalId != uint64(0)
-	case "cosmos.gov.v1.Vote.voter":
-		return x.Voter != ""
-	case "cosmos.gov.v1.Vote.options":
-		return len(x.Options) != 0
-	case "cosmos.gov.v1.Vote.metadata":
-		return x.Metadata != ""
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		return len(x.MinDeposit) != 0
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		return x.MaxDepositPeriod != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // Clear clears the field such that a subsequent Has call reports false.
 //
 // Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		x.ProposalId = uint64(0)
-	case "cosmos.gov.v1.Vote.voter":
-		x.Voter = ""
-	case "cosmos.gov.v1.Vote.options":
-		x.Options = nil
-	case "cosmos.gov.v1.Vote.metadata":
-		x.Metadata = ""
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DepositParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		x.MinDeposit = nil
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		x.MaxDepositPeriod = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3356,28 +5484,22 @@ func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gov.v1.Vote.voter":
-		value := x.Voter
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Vote.options":
-		if len(x.Options) == 0 {
-			return protoreflect.ValueOfList(&_Vote_4_list{})
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		if len(x.MinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_DepositParams_1_list{})
 		}
-		listValue := &_Vote_4_list{list: &x.Options}
+		listValue := &_DepositParams_1_list{list: &x.MinDeposit}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Vote.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		value := x.MaxDepositPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3391,23 +5513,19 @@ func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DepositParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		x.ProposalId = value.Uint()
-	case "cosmos.gov.v1.Vote.voter":
-		x.Voter = value.Interface().(string)
-	case "cosmos.gov.v1.Vote.options":
+	case "cosmos.gov.v1.DepositParams.min_deposit":
 		lv := value.List()
-		clv := lv.(*_Vote_4_list)
-		x.Options = *clv.list
-	case "cosmos.gov.v1.Vote.metadata":
-		x.Metadata = value.Interface().(string)
+		clv := lv.(*_DepositParams_1_list)
+		x.MinDeposit = *clv.list
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3421,57 +5539,53 @@ func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.options":
-		if x.Options == nil {
-			x.Options = []*WeightedVoteOption{}
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		if x.MinDeposit == nil {
+			x.MinDeposit = []*v1beta1.Coin{}
 		}
-		value := &_Vote_4_list{list: &x.Options}
+		value := &_DepositParams_1_list{list: &x.MinDeposit}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Vote.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.Vote is not mutable"))
-	case "cosmos.gov.v1.Vote.voter":
-		panic(fmt.Errorf("field voter of message cosmos.gov.v1.Vote is not mutable"))
-	case "cosmos.gov.v1.Vote.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		if x.MaxDepositPeriod == nil {
+			x.MaxDepositPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Vote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gov.v1.Vote.voter":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Vote.options":
-		list := []*WeightedVoteOption{}
-		return protoreflect.ValueOfList(&_Vote_4_list{list: &list})
-	case "cosmos.gov.v1.Vote.metadata":
-		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.DepositParams.min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_DepositParams_1_list{list: &list})
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DepositParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Vote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.DepositParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3479,7 +5593,7 @@ func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DepositParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3490,7 +5604,7 @@ func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DepositParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3502,7 +5616,7 @@ func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Vote) IsValid() bool {
+func (x *fastReflection_DepositParams) IsValid() bool {
 	return x != nil
 }
 
@@ -3512,9 +5626,9 @@ func (x *fastReflection_Vote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*DepositParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3526,21 +5640,14 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
-		}
-		l = len(x.Voter)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Options) > 0 {
-			for _, e := range x.Options {
+		if len(x.MinDeposit) > 0 {
+			for _, e := range x.MinDeposit {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		l = len(x.Metadata)
-		if l > 0 {
+		if x.MaxDepositPeriod != nil {
+			l = options.Size(x.MaxDepositPeriod)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3553,7 +5660,7 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*DepositParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3572,16 +5679,23 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+		if x.MaxDepositPeriod != nil {
+			encoded, err := options.Marshal(x.MaxDepositPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x12
 		}
-		if len(x.Options) > 0 {
-			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Options[iNdEx])
+		if len(x.MinDeposit) > 0 {
+			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3592,21 +5706,9 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x22
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3618,98 +5720,47 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Vote)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-				}
-				x.ProposalId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+		x := input.Message.Interface().(*DepositParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3736,16 +5787,16 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Options = append(x.Options, &WeightedVoteOption{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options[len(x.Options)-1]); err != nil {
+				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3755,23 +5806,27 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
+				if x.MaxDepositPeriod == nil {
+					x.MaxDepositPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3808,80 +5863,27 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DepositParams_1_list)(nil)
-
-type _DepositParams_1_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_DepositParams_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_DepositParams_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_DepositParams_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_DepositParams_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_DepositParams_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DepositParams_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_DepositParams_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_DepositParams_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_DepositParams                    protoreflect.MessageDescriptor
-	fd_DepositParams_min_deposit        protoreflect.FieldDescriptor
-	fd_DepositParams_max_deposit_period protoreflect.FieldDescriptor
+	md_VotingParams               protoreflect.MessageDescriptor
+	fd_VotingParams_voting_period protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_DepositParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("DepositParams")
-	fd_DepositParams_min_deposit = md_DepositParams.Fields().ByName("min_deposit")
-	fd_DepositParams_max_deposit_period = md_DepositParams.Fields().ByName("max_deposit_period")
+	md_VotingParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("VotingParams")
+	fd_VotingParams_voting_period = md_VotingParams.Fields().ByName("voting_period")
 }
 
-var _ protoreflect.Message = (*fastReflection_DepositParams)(nil)
+var _ protoreflect.Message = (*fastReflection_VotingParams)(nil)
 
-type fastReflection_DepositParams DepositParams
+type fastReflection_VotingParams VotingParams
 
-func (x *DepositParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DepositParams)(x)
+func (x *VotingParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VotingParams)(x)
 }
 
-func (x *DepositParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
+func (x *VotingParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3892,43 +5894,43 @@ func (x *DepositParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DepositParams_messageType fastReflection_DepositParams_messageType
-var _ protoreflect.MessageType = fastReflection_DepositParams_messageType{}
+var _fastReflection_VotingParams_messageType fastReflection_VotingParams_messageType
+var _ protoreflect.MessageType = fastReflection_VotingParams_messageType{}
 
-type fastReflection_DepositParams_messageType struct{}
+type fastReflection_VotingParams_messageType struct{}
 
-func (x fastReflection_DepositParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DepositParams)(nil)
+func (x fastReflection_VotingParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VotingParams)(nil)
 }
-func (x fastReflection_DepositParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_DepositParams)
+func (x fastReflection_VotingParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_VotingParams)
 }
-func (x fastReflection_DepositParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DepositParams
+func (x fastReflection_VotingParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DepositParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_DepositParams
+func (x *fastReflection_VotingParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DepositParams) Type() protoreflect.MessageType {
-	return _fastReflection_DepositParams_messageType
+func (x *fastReflection_VotingParams) Type() protoreflect.MessageType {
+	return _fastReflection_VotingParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DepositParams) New() protoreflect.Message {
-	return new(fastReflection_DepositParams)
+func (x *fastReflection_VotingParams) New() protoreflect.Message {
+	return new(fastReflection_VotingParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DepositParams) Interface() protoreflect.ProtoMessage {
-	return (*DepositParams)(x)
+func (x *fastReflection_VotingParams) Interface() protoreflect.ProtoMessage {
+	return (*VotingParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3936,16 +5938,10 @@ func (x *fastReflection_DepositParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DepositParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.MinDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_DepositParams_1_list{list: &x.MinDeposit})
-		if !f(fd_DepositParams_min_deposit, value) {
-			return
-		}
-	}
-	if x.MaxDepositPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
-		if !f(fd_DepositParams_max_deposit_period, value) {
+func (x *fastReflection_VotingParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+		if !f(fd_VotingParams_voting_period, value) {
 			return
 		}
 	}
@@ -3962,17 +5958,15 @@ func (x *fastReflection_DepositParams) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DepositParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_VotingParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		return len(x.MinDeposit) != 0
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		return x.MaxDepositPeriod != nil
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		return x.VotingPeriod != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3982,17 +5976,15 @@ func (x *fastReflection_DepositParams) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_VotingParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		x.MinDeposit = nil
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		x.MaxDepositPeriod = nil
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		x.VotingPeriod = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4002,22 +5994,16 @@ func (x *fastReflection_DepositParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DepositParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VotingParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		if len(x.MinDeposit) == 0 {
-			return protoreflect.ValueOfList(&_DepositParams_1_list{})
-		}
-		listValue := &_DepositParams_1_list{list: &x.MinDeposit}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		value := x.MaxDepositPeriod
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		value := x.VotingPeriod
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4031,19 +6017,15 @@ func (x *fastReflection_DepositParams) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_VotingParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		lv := value.List()
-		clv := lv.(*_DepositParams_1_list)
-		x.MinDeposit = *clv.list
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4053,57 +6035,48 @@ func (x *fastReflection_DepositParams) Set(fd protoreflect.FieldDescriptor, valu
 // For a field belonging to a oneof, it implicitly clears any other field
 // that may be currently set within the same oneof.
 // For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		if x.MinDeposit == nil {
-			x.MinDeposit = []*v1beta1.Coin{}
-		}
-		value := &_DepositParams_1_list{list: &x.MinDeposit}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		if x.MaxDepositPeriod == nil {
-			x.MaxDepositPeriod = new(durationpb.Duration)
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		if x.VotingPeriod == nil {
+			x.VotingPeriod = new(durationpb.Duration)
 		}
-		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DepositParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_VotingParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_DepositParams_1_list{list: &list})
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
+	case "cosmos.gov.v1.VotingParams.voting_period":
 		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DepositParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_VotingParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.DepositParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.VotingParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4111,7 +6084,7 @@ func (x *fastReflection_DepositParams) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DepositParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_VotingParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4122,7 +6095,7 @@ func (x *fastReflection_DepositParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_VotingParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4134,7 +6107,7 @@ func (x *fastReflection_DepositParams) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DepositParams) IsValid() bool {
+func (x *fastReflection_VotingParams) IsValid() bool {
 	return x != nil
 }
 
@@ -4144,9 +6117,9 @@ func (x *fastReflection_DepositParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DepositParams)
+		x := input.Message.Interface().(*VotingParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4158,14 +6131,8 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.MinDeposit) > 0 {
-			for _, e := range x.MinDeposit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.MaxDepositPeriod != nil {
-			l = options.Size(x.MaxDepositPeriod)
+		if x.VotingPeriod != nil {
+			l = options.Size(x.VotingPeriod)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4178,7 +6145,7 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DepositParams)
+		x := input.Message.Interface().(*VotingParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4197,8 +6164,8 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxDepositPeriod != nil {
-			encoded, err := options.Marshal(x.MaxDepositPeriod)
+		if x.VotingPeriod != nil {
+			encoded, err := options.Marshal(x.VotingPeriod)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4209,23 +6176,7 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.MinDeposit) > 0 {
-			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4238,7 +6189,7 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DepositParams)
+		x := input.Message.Interface().(*VotingParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4270,49 +6221,15 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4339,10 +6256,10 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MaxDepositPeriod == nil {
-					x.MaxDepositPeriod = &durationpb.Duration{}
+				if x.VotingPeriod == nil {
+					x.VotingPeriod = &durationpb.Duration{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4382,26 +6299,30 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_VotingParams               protoreflect.MessageDescriptor
-	fd_VotingParams_voting_period protoreflect.FieldDescriptor
+	md_TallyParams                protoreflect.MessageDescriptor
+	fd_TallyParams_quorum         protoreflect.FieldDescriptor
+	fd_TallyParams_threshold      protoreflect.FieldDescriptor
+	fd_TallyParams_veto_threshold protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_VotingParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("VotingParams")
-	fd_VotingParams_voting_period = md_VotingParams.Fields().ByName("voting_period")
+	md_TallyParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyParams")
+	fd_TallyParams_quorum = md_TallyParams.Fields().ByName("quorum")
+	fd_TallyParams_threshold = md_TallyParams.Fields().ByName("threshold")
+	fd_TallyParams_veto_threshold = md_TallyParams.Fields().ByName("veto_threshold")
 }
 
-var _ protoreflect.Message = (*fastReflection_VotingParams)(nil)
+var _ protoreflect.Message = (*fastReflection_TallyParams)(nil)
 
-type fastReflection_VotingParams VotingParams
+type fastReflection_TallyParams TallyParams
 
-func (x *VotingParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VotingParams)(x)
+func (x *TallyParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallyParams)(x)
 }
 
-func (x *VotingParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
+func (x *TallyParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4412,43 +6333,43 @@ func (x *VotingParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_VotingParams_messageType fastReflection_VotingParams_messageType
-var _ protoreflect.MessageType = fastReflection_VotingParams_messageType{}
+var _fastReflection_TallyParams_messageType fastReflection_TallyParams_messageType
+var _ protoreflect.MessageType = fastReflection_TallyParams_messageType{}
 
-type fastReflection_VotingParams_messageType struct{}
+type fastReflection_TallyParams_messageType struct{}
 
-func (x fastReflection_VotingParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VotingParams)(nil)
+func (x fastReflection_TallyParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallyParams)(nil)
 }
-func (x fastReflection_VotingParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_VotingParams)
+func (x fastReflection_TallyParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallyParams)
 }
-func (x fastReflection_VotingParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VotingParams
+func (x fastReflection_TallyParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_VotingParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_VotingParams
+func (x *fastReflection_TallyParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VotingParams) Type() protoreflect.MessageType {
-	return _fastReflection_VotingParams_messageType
+func (x *fastReflection_TallyParams) Type() protoreflect.MessageType {
+	return _fastReflection_TallyParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VotingParams) New() protoreflect.Message {
-	return new(fastReflection_VotingParams)
+func (x *fastReflection_TallyParams) New() protoreflect.Message {
+	return new(fastReflection_TallyParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VotingParams) Interface() protoreflect.ProtoMessage {
-	return (*VotingParams)(x)
+func (x *fastReflection_TallyParams) Interface() protoreflect.ProtoMessage {
+	return (*TallyParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4456,10 +6377,22 @@ func (x *fastReflection_VotingParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VotingParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.VotingPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-		if !f(fd_VotingParams_voting_period, value) {
+func (x *fastReflection_TallyParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Quorum != "" {
+		value := protoreflect.ValueOfString(x.Quorum)
+		if !f(fd_TallyParams_quorum, value) {
+			return
+		}
+	}
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_TallyParams_threshold, value) {
+			return
+		}
+	}
+	if x.VetoThreshold != "" {
+		value := protoreflect.ValueOfString(x.VetoThreshold)
+		if !f(fd_TallyParams_veto_threshold, value) {
 			return
 		}
 	}
@@ -4476,15 +6409,19 @@ func (x *fastReflection_VotingParams) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_VotingParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TallyParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		return x.VotingPeriod != nil
+	case "cosmos.gov.v1.TallyParams.quorum":
+		return x.Quorum != ""
+	case "cosmos.gov.v1.TallyParams.threshold":
+		return x.Threshold != ""
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		return x.VetoThreshold != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4494,15 +6431,19 @@ func (x *fastReflection_VotingParams) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TallyParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		x.VotingPeriod = nil
+	case "cosmos.gov.v1.TallyParams.quorum":
+		x.Quorum = ""
+	case "cosmos.gov.v1.TallyParams.threshold":
+		x.Threshold = ""
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		x.VetoThreshold = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4512,16 +6453,22 @@ func (x *fastReflection_VotingParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VotingParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallyParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		value := x.VotingPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.TallyParams.quorum":
+		value := x.Quorum
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyParams.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		value := x.VetoThreshold
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4535,15 +6482,19 @@ func (x *fastReflection_VotingParams) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TallyParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.TallyParams.quorum":
+		x.Quorum = value.Interface().(string)
+	case "cosmos.gov.v1.TallyParams.threshold":
+		x.Threshold = value.Interface().(string)
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		x.VetoThreshold = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4557,44 +6508,48 @@ func (x *fastReflection_VotingParams) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallyParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		if x.VotingPeriod == nil {
-			x.VotingPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	case "cosmos.gov.v1.TallyParams.quorum":
+		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.TallyParams.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.TallyParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VotingParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallyParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.TallyParams.quorum":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyParams.threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VotingParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TallyParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.VotingParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4602,7 +6557,7 @@ func (x *fastReflection_VotingParams) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VotingParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TallyParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4613,7 +6568,7 @@ func (x *fastReflection_VotingParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TallyParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4625,7 +6580,7 @@ func (x *fastReflection_VotingParams) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VotingParams) IsValid() bool {
+func (x *fastReflection_TallyParams) IsValid() bool {
 	return x != nil
 }
 
@@ -4635,9 +6590,9 @@ func (x *fastReflection_VotingParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VotingParams)
+		x := input.Message.Interface().(*TallyParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4649,8 +6604,16 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.VotingPeriod != nil {
-			l = options.Size(x.VotingPeriod)
+		l = len(x.Quorum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoThreshold)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4663,7 +6626,7 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VotingParams)
+		x := input.Message.Interface().(*TallyParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4682,17 +6645,24 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.VotingPeriod != nil {
-			encoded, err := options.Marshal(x.VotingPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.VetoThreshold) > 0 {
+			i -= len(x.VetoThreshold)
+			copy(dAtA[i:], x.VetoThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Quorum) > 0 {
+			i -= len(x.Quorum)
+			copy(dAtA[i:], x.Quorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4707,7 +6677,7 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VotingParams)
+		x := input.Message.Interface().(*TallyParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4739,17 +6709,17 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4759,27 +6729,87 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingPeriod == nil {
-					x.VotingPeriod = &durationpb.Duration{}
+				x.Quorum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4800,47 +6830,276 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_1_list)(nil)
+
+type _Params_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_16_list)(nil)
+
+type _Params_16_list struct {
+	list *[]*MessageBasedParams
+}
+
+func (x *_Params_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MessageBasedParams)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MessageBasedParams)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_16_list) AppendMutable() protoreflect.Value {
+	v := new(MessageBasedParams)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_16_list) NewElement() protoreflect.Value {
+	v := new(MessageBasedParams)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_18_list)(nil)
+
+type _Params_18_list struct {
+	list *[]string
+}
+
+func (x *_Params_18_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
+}
+
+func (x *_Params_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_18_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field OptimisticAuthorizedAddresses as it is not of Message kind"))
+}
+
+func (x *_Params_18_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_18_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_18_list) IsValid() bool {
+	return x.list != nil
 }
 
 var (
-	md_TallyParams                protoreflect.MessageDescriptor
-	fd_TallyParams_quorum         protoreflect.FieldDescriptor
-	fd_TallyParams_threshold      protoreflect.FieldDescriptor
-	fd_TallyParams_veto_threshold protoreflect.FieldDescriptor
+	md_Params                                 protoreflect.MessageDescriptor
+	fd_Params_min_deposit                     protoreflect.FieldDescriptor
+	fd_Params_max_deposit_period              protoreflect.FieldDescriptor
+	fd_Params_voting_period                   protoreflect.FieldDescriptor
+	fd_Params_quorum                          protoreflect.FieldDescriptor
+	fd_Params_threshold                       protoreflect.FieldDescriptor
+	fd_Params_veto_threshold                  protoreflect.FieldDescriptor
+	fd_Params_min_initial_deposit_ratio       protoreflect.FieldDescriptor
+	fd_Params_proposal_cancel_ratio           protoreflect.FieldDescriptor
+	fd_Params_proposal_cancel_dest            protoreflect.FieldDescriptor
+	fd_Params_expedited_voting_period         protoreflect.FieldDescriptor
+	fd_Params_expedited_threshold             protoreflect.FieldDescriptor
+	fd_Params_expedited_min_deposit           protoreflect.FieldDescriptor
+	fd_Params_burn_vote_quorum                protoreflect.FieldDescriptor
+	fd_Params_burn_proposal_deposit_prevote   protoreflect.FieldDescriptor
+	fd_Params_burn_vote_veto                  protoreflect.FieldDescriptor
+	fd_Params_message_based_params            protoreflect.FieldDescriptor
+	fd_Params_optimistic_rejected_threshold   protoreflect.FieldDescriptor
+	fd_Params_optimistic_authorized_addresses protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_TallyParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyParams")
-	fd_TallyParams_quorum = md_TallyParams.Fields().ByName("quorum")
-	fd_TallyParams_threshold = md_TallyParams.Fields().ByName("threshold")
-	fd_TallyParams_veto_threshold = md_TallyParams.Fields().ByName("veto_threshold")
+	md_Params = File_cosmos_gov_v1_gov_proto.Messages().ByName("Params")
+	fd_Params_min_deposit = md_Params.Fields().ByName("min_deposit")
+	fd_Params_max_deposit_period = md_Params.Fields().ByName("max_deposit_period")
+	fd_Params_voting_period = md_Params.Fields().ByName("voting_period")
+	fd_Params_quorum = md_Params.Fields().ByName("quorum")
+	fd_Params_threshold = md_Params.Fields().ByName("threshold")
+	fd_Params_veto_threshold = md_Params.Fields().ByName("veto_threshold")
+	fd_Params_min_initial_deposit_ratio = md_Params.Fields().ByName("min_initial_deposit_ratio")
+	fd_Params_proposal_cancel_ratio = md_Params.Fields().ByName("proposal_cancel_ratio")
+	fd_Params_proposal_cancel_dest = md_Params.Fields().ByName("proposal_cancel_dest")
+	fd_Params_expedited_voting_period = md_Params.Fields().ByName("expedited_voting_period")
+	fd_Params_expedited_threshold = md_Params.Fields().ByName("expedited_threshold")
+	fd_Params_expedited_min_deposit = md_Params.Fields().ByName("expedited_min_deposit")
+	fd_Params_burn_vote_quorum = md_Params.Fields().ByName("burn_vote_quorum")
+	fd_Params_burn_proposal_deposit_prevote = md_Params.Fields().ByName("burn_proposal_deposit_prevote")
+	fd_Params_burn_vote_veto = md_Params.Fields().ByName("burn_vote_veto")
+	fd_Params_message_based_params = md_Params.Fields().ByName("message_based_params")
+	fd_Params_optimistic_rejected_threshold = md_Params.Fields().ByName("optimistic_rejected_threshold")
+	fd_Params_optimistic_authorized_addresses = md_Params.Fields().ByName("optimistic_authorized_addresses")
 }
 
-var _ protoreflect.Message = (*fastReflection_TallyParams)(nil)
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
 
-type fastReflection_TallyParams TallyParams
+type fastReflection_Params Params
 
-func (x *TallyParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TallyParams)(x)
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
 }
 
-func (x *TallyParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4851,43 +7110,43 @@ func (x *TallyParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TallyParams_messageType fastReflection_TallyParams_messageType
-var _ protoreflect.MessageType = fastReflection_TallyParams_messageType{}
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
 
-type fastReflection_TallyParams_messageType struct{}
+type fastReflection_Params_messageType struct{}
 
-func (x fastReflection_TallyParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TallyParams)(nil)
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
 }
-func (x fastReflection_TallyParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_TallyParams)
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
 }
-func (x fastReflection_TallyParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyParams
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TallyParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyParams
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TallyParams) Type() protoreflect.MessageType {
-	return _fastReflection_TallyParams_messageType
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TallyParams) New() protoreflect.Message {
-	return new(fastReflection_TallyParams)
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TallyParams) Interface() protoreflect.ProtoMessage {
-	return (*TallyParams)(x)
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4895,22 +7154,112 @@ func (x *fastReflection_TallyParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TallyParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.MinDeposit})
+		if !f(fd_Params_min_deposit, value) {
+			return
+		}
+	}
+	if x.MaxDepositPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+		if !f(fd_Params_max_deposit_period, value) {
+			return
+		}
+	}
+	if x.VotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+		if !f(fd_Params_voting_period, value) {
+			return
+		}
+	}
 	if x.Quorum != "" {
 		value := protoreflect.ValueOfString(x.Quorum)
-		if !f(fd_TallyParams_quorum, value) {
+		if !f(fd_Params_quorum, value) {
+			return
+		}
+	}
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_Params_threshold, value) {
+			return
+		}
+	}
+	if x.VetoThreshold != "" {
+		value := protoreflect.ValueOfString(x.VetoThreshold)
+		if !f(fd_Params_veto_threshold, value) {
+			return
+		}
+	}
+	if x.MinInitialDepositRatio != "" {
+		value := protoreflect.ValueOfString(x.MinInitialDepositRatio)
+		if !f(fd_Params_min_initial_deposit_ratio, value) {
+			return
+		}
+	}
+	if x.ProposalCancelRatio != "" {
+		value := protoreflect.ValueOfString(x.ProposalCancelRatio)
+		if !f(fd_Params_proposal_cancel_ratio, value) {
+			return
+		}
+	}
+	if x.ProposalCancelDest != "" {
+		value := protoreflect.ValueOfString(x.ProposalCancelDest)
+		if !f(fd_Params_proposal_cancel_dest, value) {
+			return
+		}
+	}
+	if x.ExpeditedVotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
+		if !f(fd_Params_expedited_voting_period, value) {
+			return
+		}
+	}
+	if x.ExpeditedThreshold != "" {
+		value := protoreflect.ValueOfString(x.ExpeditedThreshold)
+		if !f(fd_Params_expedited_threshold, value) {
+			return
+		}
+	}
+	if len(x.ExpeditedMinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.ExpeditedMinDeposit})
+		if !f(fd_Params_expedited_min_deposit, value) {
+			return
+		}
+	}
+	if x.BurnVoteQuorum != false {
+		value := protoreflect.ValueOfBool(x.BurnVoteQuorum)
+		if !f(fd_Params_burn_vote_quorum, value) {
+			return
+		}
+	}
+	if x.BurnProposalDepositPrevote != false {
+		value := protoreflect.ValueOfBool(x.BurnProposalDepositPrevote)
+		if !f(fd_Params_burn_proposal_deposit_prevote, value) {
 			return
 		}
 	}
-	if x.Threshold != "" {
-		value := protoreflect.ValueOfString(x.Threshold)
-		if !f(fd_TallyParams_threshold, value) {
+	if x.BurnVoteVeto != false {
+		value := protoreflect.ValueOfBool(x.BurnVoteVeto)
+		if !f(fd_Params_burn_vote_veto, value) {
 			return
 		}
 	}
-	if x.VetoThreshold != "" {
-		value := protoreflect.ValueOfString(x.VetoThreshold)
-		if !f(fd_TallyParams_veto_threshold, value) {
+	if len(x.MessageBasedParams) != 0 {
+		value := protoreflect.ValueOfList(&_Params_16_list{list: &x.MessageBasedParams})
+		if !f(fd_Params_message_based_params, value) {
+			return
+		}
+	}
+	if x.OptimisticRejectedThreshold != "" {
+		value := protoreflect.ValueOfString(x.OptimisticRejectedThreshold)
+		if !f(fd_Params_optimistic_rejected_threshold, value) {
+			return
+		}
+	}
+	if len(x.OptimisticAuthorizedAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_Params_18_list{list: &x.OptimisticAuthorizedAddresses})
+		if !f(fd_Params_optimistic_authorized_addresses, value) {
 			return
 		}
 	}
@@ -4927,19 +7276,49 @@ func (x *fastReflection_TallyParams) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TallyParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
+	case "cosmos.gov.v1.Params.min_deposit":
+		return len(x.MinDeposit) != 0
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		return x.MaxDepositPeriod != nil
+	case "cosmos.gov.v1.Params.voting_period":
+		return x.VotingPeriod != nil
+	case "cosmos.gov.v1.Params.quorum":
 		return x.Quorum != ""
-	case "cosmos.gov.v1.TallyParams.threshold":
+	case "cosmos.gov.v1.Params.threshold":
 		return x.Threshold != ""
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
+	case "cosmos.gov.v1.Params.veto_threshold":
 		return x.VetoThreshold != ""
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		return x.MinInitialDepositRatio != ""
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		return x.ProposalCancelRatio != ""
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		return x.ProposalCancelDest != ""
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		return x.ExpeditedVotingPeriod != nil
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		return x.ExpeditedThreshold != ""
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		return len(x.ExpeditedMinDeposit) != 0
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		return x.BurnVoteQuorum != false
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		return x.BurnProposalDepositPrevote != false
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		return x.BurnVoteVeto != false
+	case "cosmos.gov.v1.Params.message_based_params":
+		return len(x.MessageBasedParams) != 0
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		return x.OptimisticRejectedThreshold != ""
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		return len(x.OptimisticAuthorizedAddresses) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4949,19 +7328,49 @@ func (x *fastReflection_TallyParams) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
+	case "cosmos.gov.v1.Params.min_deposit":
+		x.MinDeposit = nil
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		x.MaxDepositPeriod = nil
+	case "cosmos.gov.v1.Params.voting_period":
+		x.VotingPeriod = nil
+	case "cosmos.gov.v1.Params.quorum":
 		x.Quorum = ""
-	case "cosmos.gov.v1.TallyParams.threshold":
+	case "cosmos.gov.v1.Params.threshold":
 		x.Threshold = ""
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
+	case "cosmos.gov.v1.Params.veto_threshold":
 		x.VetoThreshold = ""
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		x.MinInitialDepositRatio = ""
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		x.ProposalCancelRatio = ""
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		x.ProposalCancelDest = ""
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		x.ExpeditedVotingPeriod = nil
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		x.ExpeditedThreshold = ""
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		x.ExpeditedMinDeposit = nil
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		x.BurnVoteQuorum = false
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		x.BurnProposalDepositPrevote = false
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		x.BurnVoteVeto = false
+	case "cosmos.gov.v1.Params.message_based_params":
+		x.MessageBasedParams = nil
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		x.OptimisticRejectedThreshold = ""
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		x.OptimisticAuthorizedAddresses = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4971,22 +7380,79 @@ func (x *fastReflection_TallyParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TallyParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
+	case "cosmos.gov.v1.Params.min_deposit":
+		if len(x.MinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Params_1_list{})
+		}
+		listValue := &_Params_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		value := x.MaxDepositPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.voting_period":
+		value := x.VotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.quorum":
 		value := x.Quorum
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyParams.threshold":
+	case "cosmos.gov.v1.Params.threshold":
 		value := x.Threshold
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
+	case "cosmos.gov.v1.Params.veto_threshold":
 		value := x.VetoThreshold
 		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		value := x.MinInitialDepositRatio
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		value := x.ProposalCancelRatio
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		value := x.ProposalCancelDest
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		value := x.ExpeditedVotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		value := x.ExpeditedThreshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		if len(x.ExpeditedMinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.ExpeditedMinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		value := x.BurnVoteQuorum
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		value := x.BurnProposalDepositPrevote
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		value := x.BurnVoteVeto
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.message_based_params":
+		if len(x.MessageBasedParams) == 0 {
+			return protoreflect.ValueOfList(&_Params_16_list{})
+		}
+		listValue := &_Params_16_list{list: &x.MessageBasedParams}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		value := x.OptimisticRejectedThreshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		if len(x.OptimisticAuthorizedAddresses) == 0 {
+			return protoreflect.ValueOfList(&_Params_18_list{})
+		}
+		listValue := &_Params_18_list{list: &x.OptimisticAuthorizedAddresses}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5000,19 +7466,57 @@ func (x *fastReflection_TallyParams) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
+	case "cosmos.gov.v1.Params.min_deposit":
+		lv := value.List()
+		clv := lv.(*_Params_1_list)
+		x.MinDeposit = *clv.list
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.voting_period":
+		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.quorum":
 		x.Quorum = value.Interface().(string)
-	case "cosmos.gov.v1.TallyParams.threshold":
+	case "cosmos.gov.v1.Params.threshold":
 		x.Threshold = value.Interface().(string)
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
+	case "cosmos.gov.v1.Params.veto_threshold":
 		x.VetoThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		x.MinInitialDepositRatio = value.Interface().(string)
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		x.ProposalCancelRatio = value.Interface().(string)
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		x.ProposalCancelDest = value.Interface().(string)
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		x.ExpeditedVotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		x.ExpeditedThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.ExpeditedMinDeposit = *clv.list
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		x.BurnVoteQuorum = value.Bool()
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		x.BurnProposalDepositPrevote = value.Bool()
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		x.BurnVoteVeto = value.Bool()
+	case "cosmos.gov.v1.Params.message_based_params":
+		lv := value.List()
+		clv := lv.(*_Params_16_list)
+		x.MessageBasedParams = *clv.list
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		x.OptimisticRejectedThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		lv := value.List()
+		clv := lv.(*_Params_18_list)
+		x.OptimisticAuthorizedAddresses = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5026,48 +7530,140 @@ func (x *fastReflection_TallyParams) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.TallyParams is not mutable"))
-	case "cosmos.gov.v1.TallyParams.threshold":
-		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.TallyParams is not mutable"))
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.Params.min_deposit":
+		if x.MinDeposit == nil {
+			x.MinDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Params_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		if x.MaxDepositPeriod == nil {
+			x.MaxDepositPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.voting_period":
+		if x.VotingPeriod == nil {
+			x.VotingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		if x.ExpeditedVotingPeriod == nil {
+			x.ExpeditedVotingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		if x.ExpeditedMinDeposit == nil {
+			x.ExpeditedMinDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Params_12_list{list: &x.ExpeditedMinDeposit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.message_based_params":
+		if x.MessageBasedParams == nil {
+			x.MessageBasedParams = []*MessageBasedParams{}
+		}
+		value := &_Params_16_list{list: &x.MessageBasedParams}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		if x.OptimisticAuthorizedAddresses == nil {
+			x.OptimisticAuthorizedAddresses = []string{}
+		}
+		value := &_Params_18_list{list: &x.OptimisticAuthorizedAddresses}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.quorum":
+		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.veto_threshold":
+		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		panic(fmt.Errorf("field min_initial_deposit_ratio of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		panic(fmt.Errorf("field proposal_cancel_ratio of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		panic(fmt.Errorf("field proposal_cancel_dest of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		panic(fmt.Errorf("field expedited_threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		panic(fmt.Errorf("field burn_vote_quorum of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		panic(fmt.Errorf("field burn_proposal_deposit_prevote of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		panic(fmt.Errorf("field burn_vote_veto of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		panic(fmt.Errorf("field optimistic_rejected_threshold of message cosmos.gov.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TallyParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
+	case "cosmos.gov.v1.Params.min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.quorum":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyParams.threshold":
+	case "cosmos.gov.v1.Params.threshold":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
+	case "cosmos.gov.v1.Params.veto_threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.message_based_params":
+		list := []*MessageBasedParams{}
+		return protoreflect.ValueOfList(&_Params_16_list{list: &list})
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
 		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_18_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TallyParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Params", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5075,7 +7671,7 @@ func (x *fastReflection_TallyParams) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TallyParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5086,7 +7682,7 @@ func (x *fastReflection_TallyParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5098,7 +7694,7 @@ func (x *fastReflection_TallyParams) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TallyParams) IsValid() bool {
+func (x *fastReflection_Params) IsValid() bool {
 	return x != nil
 }
 
@@ -5108,9 +7704,9 @@ func (x *fastReflection_TallyParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TallyParams)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5122,6 +7718,20 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if len(x.MinDeposit) > 0 {
+			for _, e := range x.MinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxDepositPeriod != nil {
+			l = options.Size(x.MaxDepositPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VotingPeriod != nil {
+			l = options.Size(x.VotingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		l = len(x.Quorum)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -5134,6 +7744,57 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.MinInitialDepositRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposalCancelRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposalCancelDest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpeditedVotingPeriod != nil {
+			l = options.Size(x.ExpeditedVotingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpeditedThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ExpeditedMinDeposit) > 0 {
+			for _, e := range x.ExpeditedMinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BurnVoteQuorum {
+			n += 2
+		}
+		if x.BurnProposalDepositPrevote {
+			n += 2
+		}
+		if x.BurnVoteVeto {
+			n += 2
+		}
+		if len(x.MessageBasedParams) > 0 {
+			for _, e := range x.MessageBasedParams {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.OptimisticRejectedThreshold)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.OptimisticAuthorizedAddresses) > 0 {
+			for _, s := range x.OptimisticAuthorizedAddresses {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5144,7 +7805,7 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TallyParams)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5163,26 +7824,196 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.OptimisticAuthorizedAddresses) > 0 {
+			for iNdEx := len(x.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.OptimisticAuthorizedAddresses[iNdEx])
+				copy(dAtA[i:], x.OptimisticAuthorizedAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptimisticAuthorizedAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.OptimisticRejectedThreshold) > 0 {
+			i -= len(x.OptimisticRejectedThreshold)
+			copy(dAtA[i:], x.OptimisticRejectedThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptimisticRejectedThreshold)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.MessageBasedParams) > 0 {
+			for iNdEx := len(x.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MessageBasedParams[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.BurnVoteVeto {
+			i--
+			if x.BurnVoteVeto {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.BurnProposalDepositPrevote {
+			i--
+			if x.BurnProposalDepositPrevote {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.BurnVoteQuorum {
+			i--
+			if x.BurnVoteQuorum {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.ExpeditedMinDeposit) > 0 {
+			for iNdEx := len(x.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExpeditedMinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.ExpeditedThreshold) > 0 {
+			i -= len(x.ExpeditedThreshold)
+			copy(dAtA[i:], x.ExpeditedThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpeditedThreshold)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.ExpeditedVotingPeriod != nil {
+			encoded, err := options.Marshal(x.ExpeditedVotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ProposalCancelDest) > 0 {
+			i -= len(x.ProposalCancelDest)
+			copy(dAtA[i:], x.ProposalCancelDest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelDest)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ProposalCancelRatio) > 0 {
+			i -= len(x.ProposalCancelRatio)
+			copy(dAtA[i:], x.ProposalCancelRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelRatio)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.MinInitialDepositRatio) > 0 {
+			i -= len(x.MinInitialDepositRatio)
+			copy(dAtA[i:], x.MinInitialDepositRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinInitialDepositRatio)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.VetoThreshold) > 0 {
 			i -= len(x.VetoThreshold)
 			copy(dAtA[i:], x.VetoThreshold)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x32
 		}
 		if len(x.Threshold) > 0 {
 			i -= len(x.Threshold)
 			copy(dAtA[i:], x.Threshold)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
 		}
 		if len(x.Quorum) > 0 {
 			i -= len(x.Quorum)
 			copy(dAtA[i:], x.Quorum)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
+		}
+		if x.VotingPeriod != nil {
+			encoded, err := options.Marshal(x.VotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MaxDepositPeriod != nil {
+			encoded, err := options.Marshal(x.MaxDepositPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MinDeposit) > 0 {
+			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5195,7 +8026,7 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TallyParams)
+		x := input.Message.Interface().(*Params)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5214,28 +8045,362 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxDepositPeriod == nil {
+					x.MaxDepositPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingPeriod == nil {
+					x.VotingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quorum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpeditedVotingPeriod == nil {
+					x.ExpeditedVotingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedVotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5263,11 +8428,139 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Quorum = string(dAtA[iNdEx:postIndex])
+				x.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpeditedMinDeposit = append(x.ExpeditedMinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedMinDeposit[len(x.ExpeditedMinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnVoteQuorum = bool(v != 0)
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnProposalDepositPrevote = bool(v != 0)
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnVoteVeto = bool(v != 0)
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MessageBasedParams = append(x.MessageBasedParams, &MessageBasedParams{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MessageBasedParams[len(x.MessageBasedParams)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 2:
+			case 17:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5295,11 +8588,11 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Threshold = string(dAtA[iNdEx:postIndex])
+				x.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5327,7 +8620,7 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				x.OptimisticAuthorizedAddresses = append(x.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5364,157 +8657,35 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Params_1_list)(nil)
-
-type _Params_1_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Params_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Params_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Params_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Params_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Params_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Params_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Params_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_Params_12_list)(nil)
-
-type _Params_12_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Params_12_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Params_12_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Params_12_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Params_12_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Params_12_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Params_12_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Params_12_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Params                               protoreflect.MessageDescriptor
-	fd_Params_min_deposit                   protoreflect.FieldDescriptor
-	fd_Params_max_deposit_period            protoreflect.FieldDescriptor
-	fd_Params_voting_period                 protoreflect.FieldDescriptor
-	fd_Params_quorum                        protoreflect.FieldDescriptor
-	fd_Params_threshold                     protoreflect.FieldDescriptor
-	fd_Params_veto_threshold                protoreflect.FieldDescriptor
-	fd_Params_min_initial_deposit_ratio     protoreflect.FieldDescriptor
-	fd_Params_proposal_cancel_ratio         protoreflect.FieldDescriptor
-	fd_Params_proposal_cancel_dest          protoreflect.FieldDescriptor
-	fd_Params_expedited_voting_period       protoreflect.FieldDescriptor
-	fd_Params_expedited_threshold           protoreflect.FieldDescriptor
-	fd_Params_expedited_min_deposit         protoreflect.FieldDescriptor
-	fd_Params_burn_vote_quorum              protoreflect.FieldDescriptor
-	fd_Params_burn_proposal_deposit_prevote protoreflect.FieldDescriptor
-	fd_Params_burn_vote_veto                protoreflect.FieldDescriptor
+	md_MessageBasedParams                protoreflect.MessageDescriptor
+	fd_MessageBasedParams_msg_type_url   protoreflect.FieldDescriptor
+	fd_MessageBasedParams_voting_period  protoreflect.FieldDescriptor
+	fd_MessageBasedParams_quorum         protoreflect.FieldDescriptor
+	fd_MessageBasedParams_threshold      protoreflect.FieldDescriptor
+	fd_MessageBasedParams_veto_threshold protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_Params = File_cosmos_gov_v1_gov_proto.Messages().ByName("Params")
-	fd_Params_min_deposit = md_Params.Fields().ByName("min_deposit")
-	fd_Params_max_deposit_period = md_Params.Fields().ByName("max_deposit_period")
-	fd_Params_voting_period = md_Params.Fields().ByName("voting_period")
-	fd_Params_quorum = md_Params.Fields().ByName("quorum")
-	fd_Params_threshold = md_Params.Fields().ByName("threshold")
-	fd_Params_veto_threshold = md_Params.Fields().ByName("veto_threshold")
-	fd_Params_min_initial_deposit_ratio = md_Params.Fields().ByName("min_initial_deposit_ratio")
-	fd_Params_proposal_cancel_ratio = md_Params.Fields().ByName("proposal_cancel_ratio")
-	fd_Params_proposal_cancel_dest = md_Params.Fields().ByName("proposal_cancel_dest")
-	fd_Params_expedited_voting_period = md_Params.Fields().ByName("expedited_voting_period")
-	fd_Params_expedited_threshold = md_Params.Fields().ByName("expedited_threshold")
-	fd_Params_expedited_min_deposit = md_Params.Fields().ByName("expedited_min_deposit")
-	fd_Params_burn_vote_quorum = md_Params.Fields().ByName("burn_vote_quorum")
-	fd_Params_burn_proposal_deposit_prevote = md_Params.Fields().ByName("burn_proposal_deposit_prevote")
-	fd_Params_burn_vote_veto = md_Params.Fields().ByName("burn_vote_veto")
+	md_MessageBasedParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("MessageBasedParams")
+	fd_MessageBasedParams_msg_type_url = md_MessageBasedParams.Fields().ByName("msg_type_url")
+	fd_MessageBasedParams_voting_period = md_MessageBasedParams.Fields().ByName("voting_period")
+	fd_MessageBasedParams_quorum = md_MessageBasedParams.Fields().ByName("quorum")
+	fd_MessageBasedParams_threshold = md_MessageBasedParams.Fields().ByName("threshold")
+	fd_MessageBasedParams_veto_threshold = md_MessageBasedParams.Fields().ByName("veto_threshold")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_MessageBasedParams)(nil)
 
-type fastReflection_Params Params
+type fastReflection_MessageBasedParams MessageBasedParams
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *MessageBasedParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MessageBasedParams)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
+func (x *MessageBasedParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5525,43 +8696,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_MessageBasedParams_messageType fastReflection_MessageBasedParams_messageType
+var _ protoreflect.MessageType = fastReflection_MessageBasedParams_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_MessageBasedParams_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_MessageBasedParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MessageBasedParams)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_MessageBasedParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MessageBasedParams)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_MessageBasedParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageBasedParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_MessageBasedParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageBasedParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_MessageBasedParams) Type() protoreflect.MessageType {
+	return _fastReflection_MessageBasedParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_MessageBasedParams) New() protoreflect.Message {
+	return new(fastReflection_MessageBasedParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_MessageBasedParams) Interface() protoreflect.ProtoMessage {
+	return (*MessageBasedParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5569,94 +8740,34 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.MinDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.MinDeposit})
-		if !f(fd_Params_min_deposit, value) {
-			return
-		}
-	}
-	if x.MaxDepositPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
-		if !f(fd_Params_max_deposit_period, value) {
+func (x *fastReflection_MessageBasedParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_MessageBasedParams_msg_type_url, value) {
 			return
 		}
 	}
 	if x.VotingPeriod != nil {
 		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-		if !f(fd_Params_voting_period, value) {
+		if !f(fd_MessageBasedParams_voting_period, value) {
 			return
 		}
 	}
 	if x.Quorum != "" {
 		value := protoreflect.ValueOfString(x.Quorum)
-		if !f(fd_Params_quorum, value) {
+		if !f(fd_MessageBasedParams_quorum, value) {
 			return
 		}
 	}
 	if x.Threshold != "" {
 		value := protoreflect.ValueOfString(x.Threshold)
-		if !f(fd_Params_threshold, value) {
+		if !f(fd_MessageBasedParams_threshold, value) {
 			return
 		}
 	}
 	if x.VetoThreshold != "" {
 		value := protoreflect.ValueOfString(x.VetoThreshold)
-		if !f(fd_Params_veto_threshold, value) {
-			return
-		}
-	}
-	if x.MinInitialDepositRatio != "" {
-		value := protoreflect.ValueOfString(x.MinInitialDepositRatio)
-		if !f(fd_Params_min_initial_deposit_ratio, value) {
-			return
-		}
-	}
-	if x.ProposalCancelRatio != "" {
-		value := protoreflect.ValueOfString(x.ProposalCancelRatio)
-		if !f(fd_Params_proposal_cancel_ratio, value) {
-			return
-		}
-	}
-	if x.ProposalCancelDest != "" {
-		value := protoreflect.ValueOfString(x.ProposalCancelDest)
-		if !f(fd_Params_proposal_cancel_dest, value) {
-			return
-		}
-	}
-	if x.ExpeditedVotingPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
-		if !f(fd_Params_expedited_voting_period, value) {
-			return
-		}
-	}
-	if x.ExpeditedThreshold != "" {
-		value := protoreflect.ValueOfString(x.ExpeditedThreshold)
-		if !f(fd_Params_expedited_threshold, value) {
-			return
-		}
-	}
-	if len(x.ExpeditedMinDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.ExpeditedMinDeposit})
-		if !f(fd_Params_expedited_min_deposit, value) {
-			return
-		}
-	}
-	if x.BurnVoteQuorum != false {
-		value := protoreflect.ValueOfBool(x.BurnVoteQuorum)
-		if !f(fd_Params_burn_vote_quorum, value) {
-			return
-		}
-	}
-	if x.BurnProposalDepositPrevote != false {
-		value := protoreflect.ValueOfBool(x.BurnProposalDepositPrevote)
-		if !f(fd_Params_burn_proposal_deposit_prevote, value) {
-			return
-		}
-	}
-	if x.BurnVoteVeto != false {
-		value := protoreflect.ValueOfBool(x.BurnVoteVeto)
-		if !f(fd_Params_burn_vote_veto, value) {
+		if !f(fd_MessageBasedParams_veto_threshold, value) {
 			return
 		}
 	}
@@ -5665,51 +8776,31 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // Has reports whether a field is populated.
 //
 // Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		return len(x.MinDeposit) != 0
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		return x.MaxDepositPeriod != nil
-	case "cosmos.gov.v1.Params.voting_period":
-		return x.VotingPeriod != nil
-	case "cosmos.gov.v1.Params.quorum":
-		return x.Quorum != ""
-	case "cosmos.gov.v1.Params.threshold":
-		return x.Threshold != ""
-	case "cosmos.gov.v1.Params.veto_threshold":
-		return x.VetoThreshold != ""
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		return x.MinInitialDepositRatio != ""
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		return x.ProposalCancelRatio != ""
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		return x.ProposalCancelDest != ""
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		return x.ExpeditedVotingPeriod != nil
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		return x.ExpeditedThreshold != ""
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		return len(x.ExpeditedMinDeposit) != 0
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		return x.BurnVoteQuorum != false
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		return x.BurnProposalDepositPrevote != false
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		return x.BurnVoteVeto != false
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MessageBasedParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.MessageBasedParams.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "cosmos.gov.v1.MessageBasedParams.voting_period":
+		return x.VotingPeriod != nil
+	case "cosmos.gov.v1.MessageBasedParams.quorum":
+		return x.Quorum != ""
+	case "cosmos.gov.v1.MessageBasedParams.threshold":
+		return x.Threshold != ""
+	case "cosmos.gov.v1.MessageBasedParams.veto_threshold":
+		return x.VetoThreshold != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MessageBasedParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.MessageBasedParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5719,43 +8810,23 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MessageBasedParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		x.MinDeposit = nil
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		x.MaxDepositPeriod = nil
-	case "cosmos.gov.v1.Params.voting_period":
+	case "cosmos.gov.v1.MessageBasedParams.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "cosmos.gov.v1.MessageBasedParams.voting_period":
 		x.VotingPeriod = nil
-	case "cosmos.gov.v1.Params.quorum":
+	case "cosmos.gov.v1.MessageBasedParams.quorum":
 		x.Quorum = ""
-	case "cosmos.gov.v1.Params.threshold":
+	case "cosmos.gov.v1.MessageBasedParams.threshold":
 		x.Threshold = ""
-	case "cosmos.gov.v1.Params.veto_threshold":
+	case "cosmos.gov.v1.MessageBasedParams.veto_threshold":
 		x.VetoThreshold = ""
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		x.MinInitialDepositRatio = ""
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		x.ProposalCancelRatio = ""
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		x.ProposalCancelDest = ""
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		x.ExpeditedVotingPeriod = nil
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		x.ExpeditedThreshold = ""
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		x.ExpeditedMinDeposit = nil
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		x.BurnVoteQuorum = false
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		x.BurnProposalDepositPrevote = false
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		x.BurnVoteVeto = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MessageBasedParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.MessageBasedParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5765,64 +8836,28 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessageBasedParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		if len(x.MinDeposit) == 0 {
-			return protoreflect.ValueOfList(&_Params_1_list{})
-		}
-		listValue := &_Params_1_list{list: &x.MinDeposit}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		value := x.MaxDepositPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Params.voting_period":
+	case "cosmos.gov.v1.MessageBasedParams.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.MessageBasedParams.voting_period":
 		value := x.VotingPeriod
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Params.quorum":
+	case "cosmos.gov.v1.MessageBasedParams.quorum":
 		value := x.Quorum
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.threshold":
+	case "cosmos.gov.v1.MessageBasedParams.threshold":
 		value := x.Threshold
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.veto_threshold":
+	case "cosmos.gov.v1.MessageBasedParams.veto_threshold":
 		value := x.VetoThreshold
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		value := x.MinInitialDepositRatio
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		value := x.ProposalCancelRatio
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		value := x.ProposalCancelDest
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		value := x.ExpeditedVotingPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		value := x.ExpeditedThreshold
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		if len(x.ExpeditedMinDeposit) == 0 {
-			return protoreflect.ValueOfList(&_Params_12_list{})
-		}
-		listValue := &_Params_12_list{list: &x.ExpeditedMinDeposit}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		value := x.BurnVoteQuorum
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		value := x.BurnProposalDepositPrevote
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		value := x.BurnVoteVeto
-		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MessageBasedParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.MessageBasedParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5836,47 +8871,23 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MessageBasedParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		lv := value.List()
-		clv := lv.(*_Params_1_list)
-		x.MinDeposit = *clv.list
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.gov.v1.Params.voting_period":
+	case "cosmos.gov.v1.MessageBasedParams.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "cosmos.gov.v1.MessageBasedParams.voting_period":
 		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.gov.v1.Params.quorum":
+	case "cosmos.gov.v1.MessageBasedParams.quorum":
 		x.Quorum = value.Interface().(string)
-	case "cosmos.gov.v1.Params.threshold":
+	case "cosmos.gov.v1.MessageBasedParams.threshold":
 		x.Threshold = value.Interface().(string)
-	case "cosmos.gov.v1.Params.veto_threshold":
+	case "cosmos.gov.v1.MessageBasedParams.veto_threshold":
 		x.VetoThreshold = value.Interface().(string)
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		x.MinInitialDepositRatio = value.Interface().(string)
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		x.ProposalCancelRatio = value.Interface().(string)
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		x.ProposalCancelDest = value.Interface().(string)
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		x.ExpeditedVotingPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		x.ExpeditedThreshold = value.Interface().(string)
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		lv := value.List()
-		clv := lv.(*_Params_12_list)
-		x.ExpeditedMinDeposit = *clv.list
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		x.BurnVoteQuorum = value.Bool()
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		x.BurnProposalDepositPrevote = value.Bool()
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		x.BurnVoteVeto = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MessageBasedParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.MessageBasedParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5890,118 +8901,60 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessageBasedParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		if x.MinDeposit == nil {
-			x.MinDeposit = []*v1beta1.Coin{}
-		}
-		value := &_Params_1_list{list: &x.MinDeposit}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		if x.MaxDepositPeriod == nil {
-			x.MaxDepositPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
-	case "cosmos.gov.v1.Params.voting_period":
+	case "cosmos.gov.v1.MessageBasedParams.voting_period":
 		if x.VotingPeriod == nil {
 			x.VotingPeriod = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		if x.ExpeditedVotingPeriod == nil {
-			x.ExpeditedVotingPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		if x.ExpeditedMinDeposit == nil {
-			x.ExpeditedMinDeposit = []*v1beta1.Coin{}
-		}
-		value := &_Params_12_list{list: &x.ExpeditedMinDeposit}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Params.quorum":
-		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.threshold":
-		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.veto_threshold":
-		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		panic(fmt.Errorf("field min_initial_deposit_ratio of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		panic(fmt.Errorf("field proposal_cancel_ratio of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		panic(fmt.Errorf("field proposal_cancel_dest of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		panic(fmt.Errorf("field expedited_threshold of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		panic(fmt.Errorf("field burn_vote_quorum of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		panic(fmt.Errorf("field burn_proposal_deposit_prevote of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		panic(fmt.Errorf("field burn_vote_veto of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.MessageBasedParams.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message cosmos.gov.v1.MessageBasedParams is not mutable"))
+	case "cosmos.gov.v1.MessageBasedParams.quorum":
+		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.MessageBasedParams is not mutable"))
+	case "cosmos.gov.v1.MessageBasedParams.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.MessageBasedParams is not mutable"))
+	case "cosmos.gov.v1.MessageBasedParams.veto_threshold":
+		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.MessageBasedParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MessageBasedParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.MessageBasedParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessageBasedParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Params.voting_period":
+	case "cosmos.gov.v1.MessageBasedParams.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.MessageBasedParams.voting_period":
 		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Params.quorum":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.threshold":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.veto_threshold":
+	case "cosmos.gov.v1.MessageBasedParams.quorum":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+	case "cosmos.gov.v1.MessageBasedParams.threshold":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_threshold":
+	case "cosmos.gov.v1.MessageBasedParams.veto_threshold":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.MessageBasedParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.MessageBasedParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MessageBasedParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.MessageBasedParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6009,7 +8962,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MessageBasedParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6020,7 +8973,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MessageBasedParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6032,7 +8985,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_MessageBasedParams) IsValid() bool {
 	return x != nil
 }
 
@@ -6042,9 +8995,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MessageBasedParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*MessageBasedParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6056,14 +9009,8 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.MinDeposit) > 0 {
-			for _, e := range x.MinDeposit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.MaxDepositPeriod != nil {
-			l = options.Size(x.MaxDepositPeriod)
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.VotingPeriod != nil {
@@ -6080,42 +9027,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}
 		l = len(x.VetoThreshold)
 		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MinInitialDepositRatio)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposalCancelRatio)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposalCancelDest)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ExpeditedVotingPeriod != nil {
-			l = options.Size(x.ExpeditedVotingPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpeditedThreshold)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.ExpeditedMinDeposit) > 0 {
-			for _, e := range x.ExpeditedMinDeposit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.BurnVoteQuorum {
-			n += 2
-		}
-		if x.BurnProposalDepositPrevote {
-			n += 2
-		}
-		if x.BurnVoteVeto {
-			n += 2
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6127,7 +9039,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*MessageBasedParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6146,114 +9058,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BurnVoteVeto {
-			i--
-			if x.BurnVoteVeto {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x78
-		}
-		if x.BurnProposalDepositPrevote {
-			i--
-			if x.BurnProposalDepositPrevote {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x70
-		}
-		if x.BurnVoteQuorum {
-			i--
-			if x.BurnVoteQuorum {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x68
-		}
-		if len(x.ExpeditedMinDeposit) > 0 {
-			for iNdEx := len(x.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ExpeditedMinDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x62
-			}
-		}
-		if len(x.ExpeditedThreshold) > 0 {
-			i -= len(x.ExpeditedThreshold)
-			copy(dAtA[i:], x.ExpeditedThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpeditedThreshold)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if x.ExpeditedVotingPeriod != nil {
-			encoded, err := options.Marshal(x.ExpeditedVotingPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.ProposalCancelDest) > 0 {
-			i -= len(x.ProposalCancelDest)
-			copy(dAtA[i:], x.ProposalCancelDest)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelDest)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.ProposalCancelRatio) > 0 {
-			i -= len(x.ProposalCancelRatio)
-			copy(dAtA[i:], x.ProposalCancelRatio)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelRatio)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.MinInitialDepositRatio) > 0 {
-			i -= len(x.MinInitialDepositRatio)
-			copy(dAtA[i:], x.MinInitialDepositRatio)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinInitialDepositRatio)))
-			i--
-			dAtA[i] = 0x3a
-		}
 		if len(x.VetoThreshold) > 0 {
 			i -= len(x.VetoThreshold)
 			copy(dAtA[i:], x.VetoThreshold)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x2a
 		}
 		if len(x.Threshold) > 0 {
 			i -= len(x.Threshold)
 			copy(dAtA[i:], x.Threshold)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x22
 		}
 		if len(x.Quorum) > 0 {
 			i -= len(x.Quorum)
 			copy(dAtA[i:], x.Quorum)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1a
 		}
 		if x.VotingPeriod != nil {
 			encoded, err := options.Marshal(x.VotingPeriod)
@@ -6267,37 +9091,14 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if x.MaxDepositPeriod != nil {
-			encoded, err := options.Marshal(x.MaxDepositPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.MinDeposit) > 0 {
-			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6310,7 +9111,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*MessageBasedParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6342,17 +9143,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6362,63 +9163,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.MaxDepositPeriod == nil {
-					x.MaxDepositPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 				}
@@ -6454,7 +9217,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 				}
@@ -6486,7 +9249,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.Quorum = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 				}
@@ -6518,7 +9281,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.Threshold = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 5:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 				}
@@ -6550,73 +9313,429 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GovernanceDelegation                        protoreflect.MessageDescriptor
+	fd_GovernanceDelegation_delegator_address      protoreflect.FieldDescriptor
+	fd_GovernanceDelegation_representative_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_GovernanceDelegation = File_cosmos_gov_v1_gov_proto.Messages().ByName("GovernanceDelegation")
+	fd_GovernanceDelegation_delegator_address = md_GovernanceDelegation.Fields().ByName("delegator_address")
+	fd_GovernanceDelegation_representative_address = md_GovernanceDelegation.Fields().ByName("representative_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_GovernanceDelegation)(nil)
+
+type fastReflection_GovernanceDelegation GovernanceDelegation
+
+func (x *GovernanceDelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GovernanceDelegation)(x)
+}
+
+func (x *GovernanceDelegation) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GovernanceDelegation_messageType fastReflection_GovernanceDelegation_messageType
+var _ protoreflect.MessageType = fastReflection_GovernanceDelegation_messageType{}
+
+type fastReflection_GovernanceDelegation_messageType struct{}
+
+func (x fastReflection_GovernanceDelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GovernanceDelegation)(nil)
+}
+func (x fastReflection_GovernanceDelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_GovernanceDelegation)
+}
+func (x fastReflection_GovernanceDelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GovernanceDelegation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GovernanceDelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_GovernanceDelegation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GovernanceDelegation) Type() protoreflect.MessageType {
+	return _fastReflection_GovernanceDelegation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GovernanceDelegation) New() protoreflect.Message {
+	return new(fastReflection_GovernanceDelegation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GovernanceDelegation) Interface() protoreflect.ProtoMessage {
+	return (*GovernanceDelegation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GovernanceDelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_GovernanceDelegation_delegator_address, value) {
+			return
+		}
+	}
+	if x.RepresentativeAddress != "" {
+		value := protoreflect.ValueOfString(x.RepresentativeAddress)
+		if !f(fd_GovernanceDelegation_representative_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GovernanceDelegation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.GovernanceDelegation.delegator_address":
+		return x.DelegatorAddress != ""
+	case "cosmos.gov.v1.GovernanceDelegation.representative_address":
+		return x.RepresentativeAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GovernanceDelegation"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.GovernanceDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GovernanceDelegation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.GovernanceDelegation.delegator_address":
+		x.DelegatorAddress = ""
+	case "cosmos.gov.v1.GovernanceDelegation.representative_address":
+		x.RepresentativeAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GovernanceDelegation"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.GovernanceDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GovernanceDelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.GovernanceDelegation.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.GovernanceDelegation.representative_address":
+		value := x.RepresentativeAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GovernanceDelegation"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.GovernanceDelegation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GovernanceDelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.GovernanceDelegation.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "cosmos.gov.v1.GovernanceDelegation.representative_address":
+		x.RepresentativeAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GovernanceDelegation"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.GovernanceDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GovernanceDelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.GovernanceDelegation.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message cosmos.gov.v1.GovernanceDelegation is not mutable"))
+	case "cosmos.gov.v1.GovernanceDelegation.representative_address":
+		panic(fmt.Errorf("field representative_address of message cosmos.gov.v1.GovernanceDelegation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GovernanceDelegation"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.GovernanceDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GovernanceDelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.GovernanceDelegation.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.GovernanceDelegation.representative_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.GovernanceDelegation"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.GovernanceDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GovernanceDelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.GovernanceDelegation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GovernanceDelegation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GovernanceDelegation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GovernanceDelegation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GovernanceDelegation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GovernanceDelegation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RepresentativeAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GovernanceDelegation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RepresentativeAddress) > 0 {
+			i -= len(x.RepresentativeAddress)
+			copy(dAtA[i:], x.RepresentativeAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RepresentativeAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GovernanceDelegation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 9:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6644,47 +9763,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.ExpeditedVotingPeriod == nil {
-					x.ExpeditedVotingPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedVotingPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 11:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepresentativeAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6712,102 +9795,8 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 12:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpeditedMinDeposit = append(x.ExpeditedMinDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedMinDeposit[len(x.ExpeditedMinDeposit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.RepresentativeAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 13:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.BurnVoteQuorum = bool(v != 0)
-			case 14:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.BurnProposalDepositPrevote = bool(v != 0)
-			case 15:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.BurnVoteVeto = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6980,59 +9969,157 @@ func (ProposalStatus) Type() protoreflect.EnumType {
 	return &file_cosmos_gov_v1_gov_proto_enumTypes[1]
 }
 
-func (x ProposalStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x ProposalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProposalStatus.Descriptor instead.
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+}
+
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// option defines the valid vote options, it must not contain duplicate vote options.
+	Option VoteOption `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1.VoteOption" json:"option,omitempty"`
+	// weight is the vote weight associated with the vote option.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *WeightedVoteOption) Reset() {
+	*x = WeightedVoteOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeightedVoteOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedVoteOption) ProtoMessage() {}
+
+// Deprecated: Use WeightedVoteOption.ProtoReflect.Descriptor instead.
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WeightedVoteOption) GetOption() VoteOption {
+	if x != nil {
+		return x.Option
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+func (x *WeightedVoteOption) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+// WeightedVoteChoice defines a unit of vote for vote split among the options of
+// a multiple-choice proposal.
+//
+// Since: cosmos-sdk 0.48
+type WeightedVoteChoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// choice is the index of the chosen proposal option, it must not contain
+	// duplicate choices.
+	Choice uint32 `protobuf:"varint,1,opt,name=choice,proto3" json:"choice,omitempty"`
+	// weight is the vote weight associated with the choice.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *WeightedVoteChoice) Reset() {
+	*x = WeightedVoteChoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeightedVoteChoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedVoteChoice) ProtoMessage() {}
+
+// Deprecated: Use WeightedVoteChoice.ProtoReflect.Descriptor instead.
+func (*WeightedVoteChoice) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WeightedVoteChoice) GetChoice() uint32 {
+	if x != nil {
+		return x.Choice
+	}
+	return 0
 }
 
-// Deprecated: Use ProposalStatus.Descriptor instead.
-func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+func (x *WeightedVoteChoice) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
 }
 
-// WeightedVoteOption defines a unit of vote for vote split.
-type WeightedVoteOption struct {
+// ProposalOption defines an option of a multiple-choice proposal.
+//
+// Since: cosmos-sdk 0.48
+type ProposalOption struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// option defines the valid vote options, it must not contain duplicate vote options.
-	Option VoteOption `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1.VoteOption" json:"option,omitempty"`
-	// weight is the vote weight associated with the vote option.
-	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// title is the title of the option.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// messages are the arbitrary messages to be executed if the option wins.
+	Messages []*anypb.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *WeightedVoteOption) Reset() {
-	*x = WeightedVoteOption{}
+func (x *ProposalOption) Reset() {
+	*x = ProposalOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[0]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WeightedVoteOption) String() string {
+func (x *ProposalOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WeightedVoteOption) ProtoMessage() {}
+func (*ProposalOption) ProtoMessage() {}
 
-// Deprecated: Use WeightedVoteOption.ProtoReflect.Descriptor instead.
-func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ProposalOption.ProtoReflect.Descriptor instead.
+func (*ProposalOption) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *WeightedVoteOption) GetOption() VoteOption {
+func (x *ProposalOption) GetTitle() string {
 	if x != nil {
-		return x.Option
+		return x.Title
 	}
-	return VoteOption_VOTE_OPTION_UNSPECIFIED
+	return ""
 }
 
-func (x *WeightedVoteOption) GetWeight() string {
+func (x *ProposalOption) GetMessages() []*anypb.Any {
 	if x != nil {
-		return x.Weight
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
 // Deposit defines an amount deposited by an account address to an active
@@ -7053,7 +10140,7 @@ type Deposit struct {
 func (x *Deposit) Reset() {
 	*x = Deposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[1]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7067,7 +10154,7 @@ func (*Deposit) ProtoMessage() {}
 
 // Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Deposit) GetProposalId() uint64 {
@@ -7135,12 +10222,23 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// optimistic defines if the proposal is optimistic. An optimistic proposal
+	// passes at the end of its voting period unless enough No votes are cast.
+	//
+	// Since: cosmos-sdk 0.48
+	Optimistic bool `protobuf:"varint,15,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
+	// options are the options of a multiple-choice proposal. A multiple-choice
+	// proposal has no messages of its own, the messages of the winning option
+	// are executed instead.
+	//
+	// Since: cosmos-sdk 0.48
+	Options []*ProposalOption `protobuf:"bytes,16,rep,name=options,proto3" json:"options,omitempty"`
 }
 
 func (x *Proposal) Reset() {
 	*x = Proposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[2]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7154,7 +10252,7 @@ func (*Proposal) ProtoMessage() {}
 
 // Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Proposal) GetId() uint64 {
@@ -7255,6 +10353,20 @@ func (x *Proposal) GetExpedited() bool {
 	return false
 }
 
+func (x *Proposal) GetOptimistic() bool {
+	if x != nil {
+		return x.Optimistic
+	}
+	return false
+}
+
+func (x *Proposal) GetOptions() []*ProposalOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	state         protoimpl.MessageState
@@ -7269,12 +10381,17 @@ type TallyResult struct {
 	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
 	// no_with_veto_count is the number of no with veto votes on a proposal.
 	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+	// option_counts are the number of votes on each option of a
+	// multiple-choice proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	OptionCounts []string `protobuf:"bytes,5,rep,name=option_counts,json=optionCounts,proto3" json:"option_counts,omitempty"`
 }
 
 func (x *TallyResult) Reset() {
 	*x = TallyResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7288,7 +10405,7 @@ func (*TallyResult) ProtoMessage() {}
 
 // Deprecated: Use TallyResult.ProtoReflect.Descriptor instead.
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TallyResult) GetYesCount() string {
@@ -7319,6 +10436,13 @@ func (x *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+func (x *TallyResult) GetOptionCounts() []string {
+	if x != nil {
+		return x.OptionCounts
+	}
+	return nil
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -7334,12 +10458,16 @@ type Vote struct {
 	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
 	// metadata is any  arbitrary metadata to attached to the vote.
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// choices is the weighted vote choices on a multiple-choice proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	Choices []*WeightedVoteChoice `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
 }
 
 func (x *Vote) Reset() {
 	*x = Vote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7353,7 +10481,7 @@ func (*Vote) ProtoMessage() {}
 
 // Deprecated: Use Vote.ProtoReflect.Descriptor instead.
 func (*Vote) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Vote) GetProposalId() uint64 {
@@ -7384,6 +10512,13 @@ func (x *Vote) GetMetadata() string {
 	return ""
 }
 
+func (x *Vote) GetChoices() []*WeightedVoteChoice {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 //
 // Deprecated: Do not use.
@@ -7402,7 +10537,7 @@ type DepositParams struct {
 func (x *DepositParams) Reset() {
 	*x = DepositParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7416,7 +10551,7 @@ func (*DepositParams) ProtoMessage() {}
 
 // Deprecated: Use DepositParams.ProtoReflect.Descriptor instead.
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DepositParams) GetMinDeposit() []*v1beta1.Coin {
@@ -7448,7 +10583,7 @@ type VotingParams struct {
 func (x *VotingParams) Reset() {
 	*x = VotingParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7462,7 +10597,7 @@ func (*VotingParams) ProtoMessage() {}
 
 // Deprecated: Use VotingParams.ProtoReflect.Descriptor instead.
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *VotingParams) GetVotingPeriod() *durationpb.Duration {
@@ -7493,7 +10628,7 @@ type TallyParams struct {
 func (x *TallyParams) Reset() {
 	*x = TallyParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7507,7 +10642,7 @@ func (*TallyParams) ProtoMessage() {}
 
 // Deprecated: Use TallyParams.ProtoReflect.Descriptor instead.
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TallyParams) GetQuorum() string {
@@ -7546,15 +10681,15 @@ type Params struct {
 	MaxDepositPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *durationpb.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -7573,7 +10708,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.48
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []*v1beta1.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit,omitempty"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -7581,12 +10716,27 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// Voting parameter overrides, keyed by message type URL. A proposal uses the
+	// strictest parameters among its messages' overrides and the default ones.
+	//
+	// Since: cosmos-sdk 0.48
+	MessageBasedParams []*MessageBasedParams `protobuf:"bytes,16,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params,omitempty"`
+	// Minimum proportion of No votes to total bonded stake for an optimistic
+	// proposal to be rejected. Default value: 0.1.
+	//
+	// Since: cosmos-sdk 0.48
+	OptimisticRejectedThreshold string `protobuf:"bytes,17,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
+	// Addresses allowed to submit optimistic proposals. If empty, optimistic
+	// proposals are disabled.
+	//
+	// Since: cosmos-sdk 0.48
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,18,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7600,7 +10750,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Params) GetMinDeposit() []*v1beta1.Coin {
@@ -7708,6 +10858,156 @@ func (x *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (x *Params) GetMessageBasedParams() []*MessageBasedParams {
+	if x != nil {
+		return x.MessageBasedParams
+	}
+	return nil
+}
+
+func (x *Params) GetOptimisticRejectedThreshold() string {
+	if x != nil {
+		return x.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetOptimisticAuthorizedAddresses() []string {
+	if x != nil {
+		return x.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
+// MessageBasedParams defines voting parameters overriding the default ones for
+// proposals containing a given message type. Empty fields inherit the default
+// parameters.
+//
+// Since: cosmos-sdk 0.48
+type MessageBasedParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// msg_type_url is the type URL of the message the parameters apply to.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Duration of the voting period.
+	VotingPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold string `protobuf:"bytes,5,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+}
+
+func (x *MessageBasedParams) Reset() {
+	*x = MessageBasedParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageBasedParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageBasedParams) ProtoMessage() {}
+
+// Deprecated: Use MessageBasedParams.ProtoReflect.Descriptor instead.
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MessageBasedParams) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *MessageBasedParams) GetVotingPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.VotingPeriod
+	}
+	return nil
+}
+
+func (x *MessageBasedParams) GetQuorum() string {
+	if x != nil {
+		return x.Quorum
+	}
+	return ""
+}
+
+func (x *MessageBasedParams) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *MessageBasedParams) GetVetoThreshold() string {
+	if x != nil {
+		return x.VetoThreshold
+	}
+	return ""
+}
+
+// GovernanceDelegation defines a delegation of the governance voting power of
+// an account to a representative, voting on its behalf unless the delegator
+// votes directly.
+//
+// Since: cosmos-sdk 0.48
+type GovernanceDelegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delegator_address is the address delegating its voting power.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// representative_address is the address voting on behalf of the delegator.
+	RepresentativeAddress string `protobuf:"bytes,2,opt,name=representative_address,json=representativeAddress,proto3" json:"representative_address,omitempty"`
+}
+
+func (x *GovernanceDelegation) Reset() {
+	*x = GovernanceDelegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GovernanceDelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovernanceDelegation) ProtoMessage() {}
+
+// Deprecated: Use GovernanceDelegation.ProtoReflect.Descriptor instead.
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GovernanceDelegation) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *GovernanceDelegation) GetRepresentativeAddress() string {
+	if x != nil {
+		return x.RepresentativeAddress
+	}
+	return ""
+}
+
 var File_cosmos_gov_v1_gov_proto protoreflect.FileDescriptor
 
 var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
@@ -7732,7 +11032,18 @@ var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x26, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x07, 0x44, 0x65,
+	0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x54, 0x0a, 0x12, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x58, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x07, 0x44, 0x65,
 	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
 	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
 	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
@@ -7742,7 +11053,7 @@ var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x05, 0x0a,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb8, 0x06, 0x0a,
 	0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
@@ -7788,156 +11099,214 @@ var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
 	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
 	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x22, 0xd7,
-	0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b,
-	0x0a, 0x09, 0x79, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x52, 0x08, 0x79, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x61,
-	0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x29, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x52, 0x07, 0x6e, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x12, 0x6e,
-	0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56,
-	0x65, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74,
-	0x65, 0x72, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76,
-	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x03, 0x10,
-	0x04, 0x22, 0xdd, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x15, 0x6d, 0x69, 0x6e,
-	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x6d,
-	0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x24, 0xea, 0xde, 0x1f, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d,
-	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x02, 0x18,
-	0x01, 0x22, 0x58, 0x0a, 0x0c, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x44, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69,
-	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e,
-	0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x9e, 0x01, 0x0a, 0x0b,
-	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x71,
-	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x71, 0x75, 0x6f,
-	0x72, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x12, 0x35, 0x0a, 0x0e, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x65, 0x74, 0x6f, 0x54,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xd3, 0x07, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x4d,
-	0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x44, 0x0a,
-	0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x74,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x0e, 0x76, 0x65, 0x74,
-	0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0x52, 0x0d, 0x76, 0x65, 0x74, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x12, 0x49, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x16, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x42, 0x0a, 0x15, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
-	0x4a, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x65,
-	0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x15, 0x65,
-	0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x12, 0x3f, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65,
-	0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x37,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x79, 0x65, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x79, 0x65, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x62, 0x73,
+	0x74, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x6e, 0x6f, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x6e, 0x6f, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x12, 0x6e, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x76, 0x65, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x0f, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x33, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xdd, 0x01, 0x0a,
+	0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x59,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x1d,
+	0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0a, 0x6d,
+	0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x6d, 0x0a, 0x12, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x24, 0xea, 0xde, 0x1f, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x58, 0x0a, 0x0c,
+	0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x44, 0x0a, 0x0d,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x9e, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2c,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x54, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x58, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74,
-	0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0c,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65,
-	0x64, 0x69, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
-	0x28, 0x0a, 0x10, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x6f,
-	0x72, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x56,
-	0x6f, 0x74, 0x65, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x41, 0x0a, 0x1d, 0x62, 0x75, 0x72,
-	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x1a, 0x62, 0x75, 0x72, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e,
-	0x62, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x56, 0x65,
-	0x74, 0x6f, 0x2a, 0x89, 0x01, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13,
-	0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45,
-	0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e,
-	0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x10, 0x03,
-	0x12, 0x1c, 0x0a, 0x18, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4e, 0x4f, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x56, 0x45, 0x54, 0x4f, 0x10, 0x04, 0x2a, 0xce,
-	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x50, 0x45,
-	0x52, 0x49, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53,
-	0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47,
-	0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f,
-	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53,
-	0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41,
-	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x42,
-	0x99, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
-	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76,
-	0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x63, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x0e,
+	0x76, 0x65, 0x74, 0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x65, 0x74, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xe9, 0x09, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d,
+	0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01,
+	0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26,
+	0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06,
+	0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x0e, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x65,
+	0x74, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x49, 0x0a, 0x19, 0x6d,
+	0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x16,
+	0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x42, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x4a, 0x0a, 0x14, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x64, 0x65,
+	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x04, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x3f, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x65, 0x78,
+	0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x58, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
+	0x4d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x75,
+	0x72, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x51, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x12, 0x41, 0x0a, 0x1d, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x72,
+	0x65, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x62, 0x75, 0x72,
+	0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x5f,
+	0x76, 0x6f, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x62, 0x75, 0x72, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x74, 0x6f, 0x12, 0x5e, 0x0a,
+	0x14, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x52, 0x0a,
+	0x1d, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x1b, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x60, 0x0a, 0x1f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x1d, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73,
+	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x44, 0x0a, 0x0d,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0x98, 0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x35, 0x0a, 0x0e, 0x76, 0x65, 0x74, 0x6f,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x0d, 0x76, 0x65, 0x74, 0x6f, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22,
+	0xae, 0x01, 0x0a, 0x14, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x4f, 0x0a, 0x16, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x15, 0x72, 0x65, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x2a, 0x89, 0x01, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f,
+	0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45, 0x53, 0x10,
+	0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x4f,
+	0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x10, 0x03, 0x12, 0x1c,
+	0x0a, 0x18, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x56, 0x45, 0x54, 0x4f, 0x10, 0x04, 0x2a, 0xce, 0x01, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49,
+	0x4f, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50,
+	0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f,
+	0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x42, 0x99, 0x01,
+	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76,
+	0x2e, 0x76, 0x31, 0x42, 0x08, 0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b,
+	0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -7953,49 +11322,58 @@ func file_cosmos_gov_v1_gov_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_gov_v1_gov_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cosmos_gov_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cosmos_gov_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_cosmos_gov_v1_gov_proto_goTypes = []interface{}{
 	(VoteOption)(0),               // 0: cosmos.gov.v1.VoteOption
 	(ProposalStatus)(0),           // 1: cosmos.gov.v1.ProposalStatus
 	(*WeightedVoteOption)(nil),    // 2: cosmos.gov.v1.WeightedVoteOption
-	(*Deposit)(nil),               // 3: cosmos.gov.v1.Deposit
-	(*Proposal)(nil),              // 4: cosmos.gov.v1.Proposal
-	(*TallyResult)(nil),           // 5: cosmos.gov.v1.TallyResult
-	(*Vote)(nil),                  // 6: cosmos.gov.v1.Vote
-	(*DepositParams)(nil),         // 7: cosmos.gov.v1.DepositParams
-	(*VotingParams)(nil),          // 8: cosmos.gov.v1.VotingParams
-	(*TallyParams)(nil),           // 9: cosmos.gov.v1.TallyParams
-	(*Params)(nil),                // 10: cosmos.gov.v1.Params
-	(*v1beta1.Coin)(nil),          // 11: cosmos.base.v1beta1.Coin
-	(*anypb.Any)(nil),             // 12: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 14: google.protobuf.Duration
+	(*WeightedVoteChoice)(nil),    // 3: cosmos.gov.v1.WeightedVoteChoice
+	(*ProposalOption)(nil),        // 4: cosmos.gov.v1.ProposalOption
+	(*Deposit)(nil),               // 5: cosmos.gov.v1.Deposit
+	(*Proposal)(nil),              // 6: cosmos.gov.v1.Proposal
+	(*TallyResult)(nil),           // 7: cosmos.gov.v1.TallyResult
+	(*Vote)(nil),                  // 8: cosmos.gov.v1.Vote
+	(*DepositParams)(nil),         // 9: cosmos.gov.v1.DepositParams
+	(*VotingParams)(nil),          // 10: cosmos.gov.v1.VotingParams
+	(*TallyParams)(nil),           // 11: cosmos.gov.v1.TallyParams
+	(*Params)(nil),                // 12: cosmos.gov.v1.Params
+	(*MessageBasedParams)(nil),    // 13: cosmos.gov.v1.MessageBasedParams
+	(*GovernanceDelegation)(nil),  // 14: cosmos.gov.v1.GovernanceDelegation
+	(*anypb.Any)(nil),             // 15: google.protobuf.Any
+	(*v1beta1.Coin)(nil),          // 16: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 18: google.protobuf.Duration
 }
 var file_cosmos_gov_v1_gov_proto_depIdxs = []int32{
 	0,  // 0: cosmos.gov.v1.WeightedVoteOption.option:type_name -> cosmos.gov.v1.VoteOption
-	11, // 1: cosmos.gov.v1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
-	12, // 2: cosmos.gov.v1.Proposal.messages:type_name -> google.protobuf.Any
-	1,  // 3: cosmos.gov.v1.Proposal.status:type_name -> cosmos.gov.v1.ProposalStatus
-	5,  // 4: cosmos.gov.v1.Proposal.final_tally_result:type_name -> cosmos.gov.v1.TallyResult
-	13, // 5: cosmos.gov.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
-	13, // 6: cosmos.gov.v1.Proposal.deposit_end_time:type_name -> google.protobuf.Timestamp
-	11, // 7: cosmos.gov.v1.Proposal.total_deposit:type_name -> cosmos.base.v1beta1.Coin
-	13, // 8: cosmos.gov.v1.Proposal.voting_start_time:type_name -> google.protobuf.Timestamp
-	13, // 9: cosmos.gov.v1.Proposal.voting_end_time:type_name -> google.protobuf.Timestamp
-	2,  // 10: cosmos.gov.v1.Vote.options:type_name -> cosmos.gov.v1.WeightedVoteOption
-	11, // 11: cosmos.gov.v1.DepositParams.min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	14, // 12: cosmos.gov.v1.DepositParams.max_deposit_period:type_name -> google.protobuf.Duration
-	14, // 13: cosmos.gov.v1.VotingParams.voting_period:type_name -> google.protobuf.Duration
-	11, // 14: cosmos.gov.v1.Params.min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	14, // 15: cosmos.gov.v1.Params.max_deposit_period:type_name -> google.protobuf.Duration
-	14, // 16: cosmos.gov.v1.Params.voting_period:type_name -> google.protobuf.Duration
-	14, // 17: cosmos.gov.v1.Params.expedited_voting_period:type_name -> google.protobuf.Duration
-	11, // 18: cosmos.gov.v1.Params.expedited_min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	15, // 1: cosmos.gov.v1.ProposalOption.messages:type_name -> google.protobuf.Any
+	16, // 2: cosmos.gov.v1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	15, // 3: cosmos.gov.v1.Proposal.messages:type_name -> google.protobuf.Any
+	1,  // 4: cosmos.gov.v1.Proposal.status:type_name -> cosmos.gov.v1.ProposalStatus
+	7,  // 5: cosmos.gov.v1.Proposal.final_tally_result:type_name -> cosmos.gov.v1.TallyResult
+	17, // 6: cosmos.gov.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
+	17, // 7: cosmos.gov.v1.Proposal.deposit_end_time:type_name -> google.protobuf.Timestamp
+	16, // 8: cosmos.gov.v1.Proposal.total_deposit:type_name -> cosmos.base.v1beta1.Coin
+	17, // 9: cosmos.gov.v1.Proposal.voting_start_time:type_name -> google.protobuf.Timestamp
+	17, // 10: cosmos.gov.v1.Proposal.voting_end_time:type_name -> google.protobuf.Timestamp
+	4,  // 11: cosmos.gov.v1.Proposal.options:type_name -> cosmos.gov.v1.ProposalOption
+	2,  // 12: cosmos.gov.v1.Vote.options:type_name -> cosmos.gov.v1.WeightedVoteOption
+	3,  // 13: cosmos.gov.v1.Vote.choices:type_name -> cosmos.gov.v1.WeightedVoteChoice
+	16, // 14: cosmos.gov.v1.DepositParams.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	18, // 15: cosmos.gov.v1.DepositParams.max_deposit_period:type_name -> google.protobuf.Duration
+	18, // 16: cosmos.gov.v1.VotingParams.voting_period:type_name -> google.protobuf.Duration
+	16, // 17: cosmos.gov.v1.Params.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	18, // 18: cosmos.gov.v1.Params.max_deposit_period:type_name -> google.protobuf.Duration
+	18, // 19: cosmos.gov.v1.Params.voting_period:type_name -> google.protobuf.Duration
+	18, // 20: cosmos.gov.v1.Params.expedited_voting_period:type_name -> google.protobuf.Duration
+	16, // 21: cosmos.gov.v1.Params.expedited_min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	13, // 22: cosmos.gov.v1.Params.message_based_params:type_name -> cosmos.gov.v1.MessageBasedParams
+	18, // 23: cosmos.gov.v1.MessageBasedParams.voting_period:type_name -> google.protobuf.Duration
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_gov_v1_gov_proto_init() }
@@ -8017,7 +11395,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Deposit); i {
+			switch v := v.(*WeightedVoteChoice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8029,7 +11407,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Proposal); i {
+			switch v := v.(*ProposalOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8041,7 +11419,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TallyResult); i {
+			switch v := v.(*Deposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8053,7 +11431,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Vote); i {
+			switch v := v.(*Proposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8065,7 +11443,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositParams); i {
+			switch v := v.(*TallyResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8077,7 +11455,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VotingParams); i {
+			switch v := v.(*Vote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8089,7 +11467,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TallyParams); i {
+			switch v := v.(*DepositParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8101,6 +11479,30 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotingParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TallyParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -8112,6 +11514,30 @@ func file_cosmos_gov_v1_gov_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageBasedParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GovernanceDelegation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8119,7 +11545,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_gov_v1_gov_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   9,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,15 +21,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName         = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName             = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName            = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                 = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName               = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName              = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName             = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName          = "/cosmos.gov.v1.Query/TallyResult"
+	Query_GovernanceDelegation_FullMethodName = "/cosmos.gov.v1.Query/GovernanceDelegation"
+	Query_Constituents_FullMethodName         = "/cosmos.gov.v1.Query/Constituents"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +56,15 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// GovernanceDelegation queries the governance representative of a delegator.
+	//
+	// Since: cosmos-sdk 0.48
+	GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error)
+	// Constituents queries the delegators having delegated their voting power to
+	// a governance representative.
+	//
+	// Since: cosmos-sdk 0.48
+	Constituents(ctx context.Context, in *QueryConstituentsRequest, opts ...grpc.CallOption) (*QueryConstituentsResponse, error)
 }
 
 type queryClient struct {
@@ -145,6 +156,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error) {
+	out := new(QueryGovernanceDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_GovernanceDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Constituents(ctx context.Context, in *QueryConstituentsRequest, opts ...grpc.CallOption) (*QueryConstituentsResponse, error) {
+	out := new(QueryConstituentsResponse)
+	err := c.cc.Invoke(ctx, Query_Constituents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -167,6 +196,15 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// GovernanceDelegation queries the governance representative of a delegator.
+	//
+	// Since: cosmos-sdk 0.48
+	GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error)
+	// Constituents queries the delegators having delegated their voting power to
+	// a governance representative.
+	//
+	// Since: cosmos-sdk 0.48
+	Constituents(context.Context, *QueryConstituentsRequest) (*QueryConstituentsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -201,6 +239,12 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceDelegation not implemented")
+}
+func (UnimplementedQueryServer) Constituents(context.Context, *QueryConstituentsRequest) (*QueryConstituentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Constituents not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -376,6 +420,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GovernanceDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernanceDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceDelegation(ctx, req.(*QueryGovernanceDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Constituents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConstituentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Constituents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Constituents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Constituents(ctx, req.(*QueryConstituentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +499,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "GovernanceDelegation",
+			Handler:    _Query_GovernanceDelegation_Handler,
+		},
+		{
+			MethodName: "Constituents",
+			Handler:    _Query_Constituents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -21,13 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SubmitProposal_FullMethodName    = "/cosmos.gov.v1.Msg/SubmitProposal"
-	Msg_ExecLegacyContent_FullMethodName = "/cosmos.gov.v1.Msg/ExecLegacyContent"
-	Msg_Vote_FullMethodName              = "/cosmos.gov.v1.Msg/Vote"
-	Msg_VoteWeighted_FullMethodName      = "/cosmos.gov.v1.Msg/VoteWeighted"
-	Msg_Deposit_FullMethodName           = "/cosmos.gov.v1.Msg/Deposit"
-	Msg_UpdateParams_FullMethodName      = "/cosmos.gov.v1.Msg/UpdateParams"
-	Msg_CancelProposal_FullMethodName    = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_SubmitProposal_FullMethodName       = "/cosmos.gov.v1.Msg/SubmitProposal"
+	Msg_ExecLegacyContent_FullMethodName    = "/cosmos.gov.v1.Msg/ExecLegacyContent"
+	Msg_Vote_FullMethodName                 = "/cosmos.gov.v1.Msg/Vote"
+	Msg_VoteWeighted_FullMethodName         = "/cosmos.gov.v1.Msg/VoteWeighted"
+	Msg_VoteChoice_FullMethodName           = "/cosmos.gov.v1.Msg/VoteChoice"
+	Msg_Deposit_FullMethodName              = "/cosmos.gov.v1.Msg/Deposit"
+	Msg_UpdateParams_FullMethodName         = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_CancelProposal_FullMethodName       = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_DelegateGovernance_FullMethodName   = "/cosmos.gov.v1.Msg/DelegateGovernance"
+	Msg_UndelegateGovernance_FullMethodName = "/cosmos.gov.v1.Msg/UndelegateGovernance"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,6 +46,11 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
+	// VoteChoice defines a method to add a vote on a specific multiple-choice
+	// proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	VoteChoice(ctx context.Context, in *MsgVoteChoice, opts ...grpc.CallOption) (*MsgVoteChoiceResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/gov module
@@ -54,6 +62,16 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// DelegateGovernance defines a method to delegate the voting power of an
+	// account to a governance representative.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to remove the governance delegation
+	// of an account.
+	//
+	// Since: cosmos-sdk 0.48
+	UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error)
 }
 
 type msgClient struct {
@@ -100,6 +118,15 @@ func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts
 	return out, nil
 }
 
+func (c *msgClient) VoteChoice(ctx context.Context, in *MsgVoteChoice, opts ...grpc.CallOption) (*MsgVoteChoiceResponse, error) {
+	out := new(MsgVoteChoiceResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteChoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
 	out := new(MsgDepositResponse)
 	err := c.cc.Invoke(ctx, Msg_Deposit_FullMethodName, in, out, opts...)
@@ -127,6 +154,24 @@ func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, o
 	return out, nil
 }
 
+func (c *msgClient) DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error) {
+	out := new(MsgDelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateGovernance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error) {
+	out := new(MsgUndelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateGovernance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -140,6 +185,11 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
+	// VoteChoice defines a method to add a vote on a specific multiple-choice
+	// proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	VoteChoice(context.Context, *MsgVoteChoice) (*MsgVoteChoiceResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/gov module
@@ -151,6 +201,16 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// DelegateGovernance defines a method to delegate the voting power of an
+	// account to a governance representative.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegateGovernance(context.Context, *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to remove the governance delegation
+	// of an account.
+	//
+	// Since: cosmos-sdk 0.48
+	UndelegateGovernance(context.Context, *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -170,6 +230,9 @@ func (UnimplementedMsgServer) Vote(context.Context, *MsgVote) (*MsgVoteResponse,
 func (UnimplementedMsgServer) VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
 }
+func (UnimplementedMsgServer) VoteChoice(context.Context, *MsgVoteChoice) (*MsgVoteChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteChoice not implemented")
+}
 func (UnimplementedMsgServer) Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -179,6 +242,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
 }
+func (UnimplementedMsgServer) DelegateGovernance(context.Context, *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGovernance not implemented")
+}
+func (UnimplementedMsgServer) UndelegateGovernance(context.Context, *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGovernance not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -264,6 +333,24 @@ func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteChoice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteChoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteChoice(ctx, req.(*MsgVoteChoice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeposit)
 	if err := dec(in); err != nil {
@@ -318,6 +405,42 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateGovernance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGovernance(ctx, req.(*MsgDelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateGovernance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGovernance(ctx, req.(*MsgUndelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -341,6 +464,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VoteWeighted",
 			Handler:    _Msg_VoteWeighted_Handler,
 		},
+		{
+			MethodName: "VoteChoice",
+			Handler:    _Msg_VoteChoice_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
@@ -353,6 +480,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelProposal",
 			Handler:    _Msg_CancelProposal_Handler,
 		},
+		{
+			MethodName: "DelegateGovernance",
+			Handler:    _Msg_DelegateGovernance_Handler,
+		},
+		{
+			MethodName: "UndelegateGovernance",
+			Handler:    _Msg_UndelegateGovernance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
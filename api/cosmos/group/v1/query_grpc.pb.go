@@ -35,6 +35,8 @@ const (
 	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
 	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
 	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_ProposalExecutionLogs_FullMethodName  = "/cosmos.group.v1.Query/ProposalExecutionLogs"
+	Query_GroupHierarchy_FullMethodName         = "/cosmos.group.v1.Query/GroupHierarchy"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +77,16 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ProposalExecutionLogs queries the automatic execution logs of a proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalExecutionLogs(ctx context.Context, in *QueryProposalExecutionLogsRequest, opts ...grpc.CallOption) (*QueryProposalExecutionLogsResponse, error)
+	// GroupHierarchy walks the hierarchy of a group, returning the sub-groups
+	// whose policies are members of the group (recursively), and the parent
+	// groups its policies are members of (recursively).
+	//
+	// Since: cosmos-sdk 0.48
+	GroupHierarchy(ctx context.Context, in *QueryGroupHierarchyRequest, opts ...grpc.CallOption) (*QueryGroupHierarchyResponse, error)
 }
 
 type queryClient struct {
@@ -211,6 +223,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ProposalExecutionLogs(ctx context.Context, in *QueryProposalExecutionLogsRequest, opts ...grpc.CallOption) (*QueryProposalExecutionLogsResponse, error) {
+	out := new(QueryProposalExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalExecutionLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupHierarchy(ctx context.Context, in *QueryGroupHierarchyRequest, opts ...grpc.CallOption) (*QueryGroupHierarchyResponse, error) {
+	out := new(QueryGroupHierarchyResponse)
+	err := c.cc.Invoke(ctx, Query_GroupHierarchy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -249,6 +279,16 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ProposalExecutionLogs queries the automatic execution logs of a proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalExecutionLogs(context.Context, *QueryProposalExecutionLogsRequest) (*QueryProposalExecutionLogsResponse, error)
+	// GroupHierarchy walks the hierarchy of a group, returning the sub-groups
+	// whose policies are members of the group (recursively), and the parent
+	// groups its policies are members of (recursively).
+	//
+	// Since: cosmos-sdk 0.48
+	GroupHierarchy(context.Context, *QueryGroupHierarchyRequest) (*QueryGroupHierarchyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -298,6 +338,12 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) ProposalExecutionLogs(context.Context, *QueryProposalExecutionLogsRequest) (*QueryProposalExecutionLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecutionLogs not implemented")
+}
+func (UnimplementedQueryServer) GroupHierarchy(context.Context, *QueryGroupHierarchyRequest) (*QueryGroupHierarchyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupHierarchy not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -563,6 +609,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalExecutionLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecutionLogs(ctx, req.(*QueryProposalExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupHierarchyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GroupHierarchy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupHierarchy(ctx, req.(*QueryGroupHierarchyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -626,6 +708,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ProposalExecutionLogs",
+			Handler:    _Query_ProposalExecutionLogs_Handler,
+		},
+		{
+			MethodName: "GroupHierarchy",
+			Handler:    _Query_GroupHierarchy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
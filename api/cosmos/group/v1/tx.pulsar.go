@@ -8826,136 +8826,2334 @@ func (x *fastReflection_MsgUpdateGroupPolicyMetadataResponse) ProtoMethods() *pr
 	}
 }
 
+var (
+	md_MsgUpdateGroupPolicyAutoExecution                      protoreflect.MessageDescriptor
+	fd_MsgUpdateGroupPolicyAutoExecution_admin                protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupPolicyAutoExecution_group_policy_address protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupPolicyAutoExecution_auto_execution       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_tx_proto_init()
+	md_MsgUpdateGroupPolicyAutoExecution = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgUpdateGroupPolicyAutoExecution")
+	fd_MsgUpdateGroupPolicyAutoExecution_admin = md_MsgUpdateGroupPolicyAutoExecution.Fields().ByName("admin")
+	fd_MsgUpdateGroupPolicyAutoExecution_group_policy_address = md_MsgUpdateGroupPolicyAutoExecution.Fields().ByName("group_policy_address")
+	fd_MsgUpdateGroupPolicyAutoExecution_auto_execution = md_MsgUpdateGroupPolicyAutoExecution.Fields().ByName("auto_execution")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupPolicyAutoExecution)(nil)
+
+type fastReflection_MsgUpdateGroupPolicyAutoExecution MsgUpdateGroupPolicyAutoExecution
+
+func (x *MsgUpdateGroupPolicyAutoExecution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupPolicyAutoExecution)(x)
+}
+
+func (x *MsgUpdateGroupPolicyAutoExecution) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateGroupPolicyAutoExecution_messageType fastReflection_MsgUpdateGroupPolicyAutoExecution_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupPolicyAutoExecution_messageType{}
+
+type fastReflection_MsgUpdateGroupPolicyAutoExecution_messageType struct{}
+
+func (x fastReflection_MsgUpdateGroupPolicyAutoExecution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupPolicyAutoExecution)(nil)
+}
+func (x fastReflection_MsgUpdateGroupPolicyAutoExecution_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupPolicyAutoExecution)
+}
+func (x fastReflection_MsgUpdateGroupPolicyAutoExecution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupPolicyAutoExecution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupPolicyAutoExecution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupPolicyAutoExecution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupPolicyAutoExecution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupPolicyAutoExecution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_MsgUpdateGroupPolicyAutoExecution_admin, value) {
+			return
+		}
+	}
+	if x.GroupPolicyAddress != "" {
+		value := protoreflect.ValueOfString(x.GroupPolicyAddress)
+		if !f(fd_MsgUpdateGroupPolicyAutoExecution_group_policy_address, value) {
+			return
+		}
+	}
+	if x.AutoExecution != nil {
+		value := protoreflect.ValueOfMessage(x.AutoExecution.ProtoReflect())
+		if !f(fd_MsgUpdateGroupPolicyAutoExecution_auto_execution, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.admin":
+		return x.Admin != ""
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.group_policy_address":
+		return x.GroupPolicyAddress != ""
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.auto_execution":
+		return x.AutoExecution != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.admin":
+		x.Admin = ""
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.group_policy_address":
+		x.GroupPolicyAddress = ""
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.auto_execution":
+		x.AutoExecution = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.group_policy_address":
+		value := x.GroupPolicyAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.auto_execution":
+		value := x.AutoExecution
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.admin":
+		x.Admin = value.Interface().(string)
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.group_policy_address":
+		x.GroupPolicyAddress = value.Interface().(string)
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.auto_execution":
+		x.AutoExecution = value.Message().Interface().(*AutoExecution)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.auto_execution":
+		if x.AutoExecution == nil {
+			x.AutoExecution = new(AutoExecution)
+		}
+		return protoreflect.ValueOfMessage(x.AutoExecution.ProtoReflect())
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.admin":
+		panic(fmt.Errorf("field admin of message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution is not mutable"))
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.group_policy_address":
+		panic(fmt.Errorf("field group_policy_address of message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.admin":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.group_policy_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.auto_execution":
+		m := new(AutoExecution)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyAutoExecution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GroupPolicyAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoExecution != nil {
+			l = options.Size(x.AutoExecution)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyAutoExecution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AutoExecution != nil {
+			encoded, err := options.Marshal(x.AutoExecution)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.GroupPolicyAddress) > 0 {
+			i -= len(x.GroupPolicyAddress)
+			copy(dAtA[i:], x.GroupPolicyAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPolicyAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyAutoExecution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupPolicyAutoExecution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupPolicyAutoExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoExecution", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AutoExecution == nil {
+					x.AutoExecution = &AutoExecution{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoExecution); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateGroupPolicyAutoExecutionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_tx_proto_init()
+	md_MsgUpdateGroupPolicyAutoExecutionResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgUpdateGroupPolicyAutoExecutionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse)(nil)
+
+type fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse MsgUpdateGroupPolicyAutoExecutionResponse
+
+func (x *MsgUpdateGroupPolicyAutoExecutionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse)(x)
+}
+
+func (x *MsgUpdateGroupPolicyAutoExecutionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse_messageType fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse_messageType{}
+
+type fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse)(nil)
+}
+func (x fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse)
+}
+func (x fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupPolicyAutoExecutionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupPolicyAutoExecutionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupPolicyAutoExecutionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateGroupPolicyAutoExecutionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyAutoExecutionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyAutoExecutionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyAutoExecutionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupPolicyAutoExecutionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupPolicyAutoExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var _ protoreflect.List = (*_MsgSubmitProposal_2_list)(nil)
 
-type _MsgSubmitProposal_2_list struct {
+type _MsgSubmitProposal_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgSubmitProposal_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitProposal_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSubmitProposal_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitProposal_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitProposal_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitProposal at list field Proposers as it is not of Message kind"))
+}
+
+func (x *_MsgSubmitProposal_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitProposal_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSubmitProposal_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSubmitProposal_4_list)(nil)
+
+type _MsgSubmitProposal_4_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgSubmitProposal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitProposal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitProposal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitProposal_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitProposal_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSubmitProposal                      protoreflect.MessageDescriptor
+	fd_MsgSubmitProposal_group_policy_address protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_proposers            protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_metadata             protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_messages             protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_exec                 protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_title                protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_summary              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_tx_proto_init()
+	md_MsgSubmitProposal = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitProposal")
+	fd_MsgSubmitProposal_group_policy_address = md_MsgSubmitProposal.Fields().ByName("group_policy_address")
+	fd_MsgSubmitProposal_proposers = md_MsgSubmitProposal.Fields().ByName("proposers")
+	fd_MsgSubmitProposal_metadata = md_MsgSubmitProposal.Fields().ByName("metadata")
+	fd_MsgSubmitProposal_messages = md_MsgSubmitProposal.Fields().ByName("messages")
+	fd_MsgSubmitProposal_exec = md_MsgSubmitProposal.Fields().ByName("exec")
+	fd_MsgSubmitProposal_title = md_MsgSubmitProposal.Fields().ByName("title")
+	fd_MsgSubmitProposal_summary = md_MsgSubmitProposal.Fields().ByName("summary")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitProposal)(nil)
+
+type fastReflection_MsgSubmitProposal MsgSubmitProposal
+
+func (x *MsgSubmitProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposal)(x)
+}
+
+func (x *MsgSubmitProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitProposal_messageType fastReflection_MsgSubmitProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitProposal_messageType{}
+
+type fastReflection_MsgSubmitProposal_messageType struct{}
+
+func (x fastReflection_MsgSubmitProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposal)(nil)
+}
+func (x fastReflection_MsgSubmitProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposal)
+}
+func (x fastReflection_MsgSubmitProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitProposal) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitProposal) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupPolicyAddress != "" {
+		value := protoreflect.ValueOfString(x.GroupPolicyAddress)
+		if !f(fd_MsgSubmitProposal_group_policy_address, value) {
+			return
+		}
+	}
+	if len(x.Proposers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{list: &x.Proposers})
+		if !f(fd_MsgSubmitProposal_proposers, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_MsgSubmitProposal_metadata, value) {
+			return
+		}
+	}
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &x.Messages})
+		if !f(fd_MsgSubmitProposal_messages, value) {
+			return
+		}
+	}
+	if x.Exec != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Exec))
+		if !f(fd_MsgSubmitProposal_exec, value) {
+			return
+		}
+	}
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_MsgSubmitProposal_title, value) {
+			return
+		}
+	}
+	if x.Summary != "" {
+		value := protoreflect.ValueOfString(x.Summary)
+		if !f(fd_MsgSubmitProposal_summary, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		return x.GroupPolicyAddress != ""
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		return len(x.Proposers) != 0
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		return x.Metadata != ""
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		return len(x.Messages) != 0
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		return x.Exec != 0
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		return x.Title != ""
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		return x.Summary != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		x.GroupPolicyAddress = ""
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		x.Proposers = nil
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		x.Metadata = ""
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		x.Messages = nil
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		x.Exec = 0
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		x.Title = ""
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		x.Summary = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		value := x.GroupPolicyAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		if len(x.Proposers) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{})
+		}
+		listValue := &_MsgSubmitProposal_2_list{list: &x.Proposers}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{})
+		}
+		listValue := &_MsgSubmitProposal_4_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		value := x.Exec
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		value := x.Summary
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		x.GroupPolicyAddress = value.Interface().(string)
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitProposal_2_list)
+		x.Proposers = *clv.list
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitProposal_4_list)
+		x.Messages = *clv.list
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		x.Exec = (Exec)(value.Enum())
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		x.Title = value.Interface().(string)
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		x.Summary = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		if x.Proposers == nil {
+			x.Proposers = []string{}
+		}
+		value := &_MsgSubmitProposal_2_list{list: &x.Proposers}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
+		}
+		value := &_MsgSubmitProposal_4_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		panic(fmt.Errorf("field group_policy_address of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		panic(fmt.Errorf("field exec of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		panic(fmt.Errorf("field title of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		panic(fmt.Errorf("field summary of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{list: &list})
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &list})
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.GroupPolicyAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Proposers) > 0 {
+			for _, s := range x.Proposers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Exec != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exec))
+		}
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Summary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Summary) > 0 {
+			i -= len(x.Summary)
+			copy(dAtA[i:], x.Summary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Exec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exec))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Proposers) > 0 {
+			for iNdEx := len(x.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proposers[iNdEx])
+				copy(dAtA[i:], x.Proposers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposers[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.GroupPolicyAddress) > 0 {
+			i -= len(x.GroupPolicyAddress)
+			copy(dAtA[i:], x.GroupPolicyAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPolicyAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposers = append(x.Proposers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+				}
+				x.Exec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exec |= Exec(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Summary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitProposalResponse             protoreflect.MessageDescriptor
+	fd_MsgSubmitProposalResponse_proposal_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_tx_proto_init()
+	md_MsgSubmitProposalResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitProposalResponse")
+	fd_MsgSubmitProposalResponse_proposal_id = md_MsgSubmitProposalResponse.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitProposalResponse)(nil)
+
+type fastReflection_MsgSubmitProposalResponse MsgSubmitProposalResponse
+
+func (x *MsgSubmitProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposalResponse)(x)
+}
+
+func (x *MsgSubmitProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitProposalResponse_messageType fastReflection_MsgSubmitProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitProposalResponse_messageType{}
+
+type fastReflection_MsgSubmitProposalResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposalResponse)(nil)
+}
+func (x fastReflection_MsgSubmitProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposalResponse)
+}
+func (x fastReflection_MsgSubmitProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitProposalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitProposalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_MsgSubmitProposalResponse_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		return x.ProposalId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		x.ProposalId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		x.ProposalId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgSubmitProposalResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitProposalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitProposalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitProposalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSubmitParentVoteProposal_2_list)(nil)
+
+type _MsgSubmitParentVoteProposal_2_list struct {
 	list *[]string
 }
 
-func (x *_MsgSubmitProposal_2_list) Len() int {
+func (x *_MsgSubmitParentVoteProposal_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgSubmitProposal_2_list) Get(i int) protoreflect.Value {
+func (x *_MsgSubmitParentVoteProposal_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_MsgSubmitProposal_2_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgSubmitParentVoteProposal_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgSubmitProposal_2_list) Append(value protoreflect.Value) {
+func (x *_MsgSubmitParentVoteProposal_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgSubmitProposal_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitProposal at list field Proposers as it is not of Message kind"))
+func (x *_MsgSubmitParentVoteProposal_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitParentVoteProposal at list field Proposers as it is not of Message kind"))
 }
 
-func (x *_MsgSubmitProposal_2_list) Truncate(n int) {
+func (x *_MsgSubmitParentVoteProposal_2_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgSubmitProposal_2_list) NewElement() protoreflect.Value {
+func (x *_MsgSubmitParentVoteProposal_2_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_MsgSubmitProposal_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_MsgSubmitProposal_4_list)(nil)
-
-type _MsgSubmitProposal_4_list struct {
-	list *[]*anypb.Any
-}
-
-func (x *_MsgSubmitProposal_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgSubmitProposal_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgSubmitProposal_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgSubmitProposal_4_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgSubmitProposal_4_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_4_list) IsValid() bool {
+func (x *_MsgSubmitParentVoteProposal_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgSubmitProposal                      protoreflect.MessageDescriptor
-	fd_MsgSubmitProposal_group_policy_address protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_proposers            protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_metadata             protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_messages             protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_exec                 protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_title                protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_summary              protoreflect.FieldDescriptor
+	md_MsgSubmitParentVoteProposal                      protoreflect.MessageDescriptor
+	fd_MsgSubmitParentVoteProposal_group_policy_address protoreflect.FieldDescriptor
+	fd_MsgSubmitParentVoteProposal_proposers            protoreflect.FieldDescriptor
+	fd_MsgSubmitParentVoteProposal_parent_proposal_id   protoreflect.FieldDescriptor
+	fd_MsgSubmitParentVoteProposal_option               protoreflect.FieldDescriptor
+	fd_MsgSubmitParentVoteProposal_metadata             protoreflect.FieldDescriptor
+	fd_MsgSubmitParentVoteProposal_vote_metadata        protoreflect.FieldDescriptor
+	fd_MsgSubmitParentVoteProposal_exec                 protoreflect.FieldDescriptor
+	fd_MsgSubmitParentVoteProposal_title                protoreflect.FieldDescriptor
+	fd_MsgSubmitParentVoteProposal_summary              protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgSubmitProposal = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitProposal")
-	fd_MsgSubmitProposal_group_policy_address = md_MsgSubmitProposal.Fields().ByName("group_policy_address")
-	fd_MsgSubmitProposal_proposers = md_MsgSubmitProposal.Fields().ByName("proposers")
-	fd_MsgSubmitProposal_metadata = md_MsgSubmitProposal.Fields().ByName("metadata")
-	fd_MsgSubmitProposal_messages = md_MsgSubmitProposal.Fields().ByName("messages")
-	fd_MsgSubmitProposal_exec = md_MsgSubmitProposal.Fields().ByName("exec")
-	fd_MsgSubmitProposal_title = md_MsgSubmitProposal.Fields().ByName("title")
-	fd_MsgSubmitProposal_summary = md_MsgSubmitProposal.Fields().ByName("summary")
+	md_MsgSubmitParentVoteProposal = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitParentVoteProposal")
+	fd_MsgSubmitParentVoteProposal_group_policy_address = md_MsgSubmitParentVoteProposal.Fields().ByName("group_policy_address")
+	fd_MsgSubmitParentVoteProposal_proposers = md_MsgSubmitParentVoteProposal.Fields().ByName("proposers")
+	fd_MsgSubmitParentVoteProposal_parent_proposal_id = md_MsgSubmitParentVoteProposal.Fields().ByName("parent_proposal_id")
+	fd_MsgSubmitParentVoteProposal_option = md_MsgSubmitParentVoteProposal.Fields().ByName("option")
+	fd_MsgSubmitParentVoteProposal_metadata = md_MsgSubmitParentVoteProposal.Fields().ByName("metadata")
+	fd_MsgSubmitParentVoteProposal_vote_metadata = md_MsgSubmitParentVoteProposal.Fields().ByName("vote_metadata")
+	fd_MsgSubmitParentVoteProposal_exec = md_MsgSubmitParentVoteProposal.Fields().ByName("exec")
+	fd_MsgSubmitParentVoteProposal_title = md_MsgSubmitParentVoteProposal.Fields().ByName("title")
+	fd_MsgSubmitParentVoteProposal_summary = md_MsgSubmitParentVoteProposal.Fields().ByName("summary")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitProposal)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSubmitParentVoteProposal)(nil)
 
-type fastReflection_MsgSubmitProposal MsgSubmitProposal
+type fastReflection_MsgSubmitParentVoteProposal MsgSubmitParentVoteProposal
 
-func (x *MsgSubmitProposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposal)(x)
+func (x *MsgSubmitParentVoteProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitParentVoteProposal)(x)
 }
 
-func (x *MsgSubmitProposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[18]
+func (x *MsgSubmitParentVoteProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8966,43 +11164,43 @@ func (x *MsgSubmitProposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitProposal_messageType fastReflection_MsgSubmitProposal_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitProposal_messageType{}
+var _fastReflection_MsgSubmitParentVoteProposal_messageType fastReflection_MsgSubmitParentVoteProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitParentVoteProposal_messageType{}
 
-type fastReflection_MsgSubmitProposal_messageType struct{}
+type fastReflection_MsgSubmitParentVoteProposal_messageType struct{}
 
-func (x fastReflection_MsgSubmitProposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposal)(nil)
+func (x fastReflection_MsgSubmitParentVoteProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitParentVoteProposal)(nil)
 }
-func (x fastReflection_MsgSubmitProposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposal)
+func (x fastReflection_MsgSubmitParentVoteProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitParentVoteProposal)
 }
-func (x fastReflection_MsgSubmitProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposal
+func (x fastReflection_MsgSubmitParentVoteProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitParentVoteProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitProposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposal
+func (x *fastReflection_MsgSubmitParentVoteProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitParentVoteProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitProposal) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitProposal_messageType
+func (x *fastReflection_MsgSubmitParentVoteProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitParentVoteProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitProposal) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposal)
+func (x *fastReflection_MsgSubmitParentVoteProposal) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitParentVoteProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitProposal) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitProposal)(x)
+func (x *fastReflection_MsgSubmitParentVoteProposal) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitParentVoteProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9010,46 +11208,58 @@ func (x *fastReflection_MsgSubmitProposal) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgSubmitParentVoteProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.GroupPolicyAddress != "" {
 		value := protoreflect.ValueOfString(x.GroupPolicyAddress)
-		if !f(fd_MsgSubmitProposal_group_policy_address, value) {
+		if !f(fd_MsgSubmitParentVoteProposal_group_policy_address, value) {
 			return
 		}
 	}
 	if len(x.Proposers) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{list: &x.Proposers})
-		if !f(fd_MsgSubmitProposal_proposers, value) {
+		value := protoreflect.ValueOfList(&_MsgSubmitParentVoteProposal_2_list{list: &x.Proposers})
+		if !f(fd_MsgSubmitParentVoteProposal_proposers, value) {
+			return
+		}
+	}
+	if x.ParentProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParentProposalId)
+		if !f(fd_MsgSubmitParentVoteProposal_parent_proposal_id, value) {
+			return
+		}
+	}
+	if x.Option != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Option))
+		if !f(fd_MsgSubmitParentVoteProposal_option, value) {
 			return
 		}
 	}
 	if x.Metadata != "" {
 		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_MsgSubmitProposal_metadata, value) {
+		if !f(fd_MsgSubmitParentVoteProposal_metadata, value) {
 			return
 		}
 	}
-	if len(x.Messages) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &x.Messages})
-		if !f(fd_MsgSubmitProposal_messages, value) {
+	if x.VoteMetadata != "" {
+		value := protoreflect.ValueOfString(x.VoteMetadata)
+		if !f(fd_MsgSubmitParentVoteProposal_vote_metadata, value) {
 			return
 		}
 	}
 	if x.Exec != 0 {
 		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Exec))
-		if !f(fd_MsgSubmitProposal_exec, value) {
+		if !f(fd_MsgSubmitParentVoteProposal_exec, value) {
 			return
 		}
 	}
 	if x.Title != "" {
 		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_MsgSubmitProposal_title, value) {
+		if !f(fd_MsgSubmitParentVoteProposal_title, value) {
 			return
 		}
 	}
 	if x.Summary != "" {
 		value := protoreflect.ValueOfString(x.Summary)
-		if !f(fd_MsgSubmitProposal_summary, value) {
+		if !f(fd_MsgSubmitParentVoteProposal_summary, value) {
 			return
 		}
 	}
@@ -9066,27 +11276,31 @@ func (x *fastReflection_MsgSubmitProposal) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitProposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSubmitParentVoteProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.group_policy_address":
 		return x.GroupPolicyAddress != ""
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.proposers":
 		return len(x.Proposers) != 0
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.parent_proposal_id":
+		return x.ParentProposalId != uint64(0)
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.option":
+		return x.Option != 0
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.metadata":
 		return x.Metadata != ""
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		return len(x.Messages) != 0
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.vote_metadata":
+		return x.VoteMetadata != ""
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.exec":
 		return x.Exec != 0
-	case "cosmos.group.v1.MsgSubmitProposal.title":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.title":
 		return x.Title != ""
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.summary":
 		return x.Summary != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9096,27 +11310,31 @@ func (x *fastReflection_MsgSubmitProposal) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSubmitParentVoteProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.group_policy_address":
 		x.GroupPolicyAddress = ""
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.proposers":
 		x.Proposers = nil
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.parent_proposal_id":
+		x.ParentProposalId = uint64(0)
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.option":
+		x.Option = 0
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.metadata":
 		x.Metadata = ""
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		x.Messages = nil
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.vote_metadata":
+		x.VoteMetadata = ""
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.exec":
 		x.Exec = 0
-	case "cosmos.group.v1.MsgSubmitProposal.title":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.title":
 		x.Title = ""
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.summary":
 		x.Summary = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9126,40 +11344,43 @@ func (x *fastReflection_MsgSubmitProposal) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitParentVoteProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.group_policy_address":
 		value := x.GroupPolicyAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.proposers":
 		if len(x.Proposers) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{})
+			return protoreflect.ValueOfList(&_MsgSubmitParentVoteProposal_2_list{})
 		}
-		listValue := &_MsgSubmitProposal_2_list{list: &x.Proposers}
+		listValue := &_MsgSubmitParentVoteProposal_2_list{list: &x.Proposers}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.parent_proposal_id":
+		value := x.ParentProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.option":
+		value := x.Option
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.metadata":
 		value := x.Metadata
 		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		if len(x.Messages) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{})
-		}
-		listValue := &_MsgSubmitProposal_4_list{list: &x.Messages}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.vote_metadata":
+		value := x.VoteMetadata
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.exec":
 		value := x.Exec
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.group.v1.MsgSubmitProposal.title":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.title":
 		value := x.Title
 		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.summary":
 		value := x.Summary
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9173,31 +11394,33 @@ func (x *fastReflection_MsgSubmitProposal) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSubmitParentVoteProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.group_policy_address":
 		x.GroupPolicyAddress = value.Interface().(string)
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.proposers":
 		lv := value.List()
-		clv := lv.(*_MsgSubmitProposal_2_list)
+		clv := lv.(*_MsgSubmitParentVoteProposal_2_list)
 		x.Proposers = *clv.list
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.parent_proposal_id":
+		x.ParentProposalId = value.Uint()
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.option":
+		x.Option = (VoteOption)(value.Enum())
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.metadata":
 		x.Metadata = value.Interface().(string)
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		lv := value.List()
-		clv := lv.(*_MsgSubmitProposal_4_list)
-		x.Messages = *clv.list
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.vote_metadata":
+		x.VoteMetadata = value.Interface().(string)
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.exec":
 		x.Exec = (Exec)(value.Enum())
-	case "cosmos.group.v1.MsgSubmitProposal.title":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.title":
 		x.Title = value.Interface().(string)
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.summary":
 		x.Summary = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9211,74 +11434,77 @@ func (x *fastReflection_MsgSubmitProposal) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitParentVoteProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.proposers":
 		if x.Proposers == nil {
 			x.Proposers = []string{}
 		}
-		value := &_MsgSubmitProposal_2_list{list: &x.Proposers}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		if x.Messages == nil {
-			x.Messages = []*anypb.Any{}
-		}
-		value := &_MsgSubmitProposal_4_list{list: &x.Messages}
+		value := &_MsgSubmitParentVoteProposal_2_list{list: &x.Proposers}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
-		panic(fmt.Errorf("field group_policy_address of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
-		panic(fmt.Errorf("field exec of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
-	case "cosmos.group.v1.MsgSubmitProposal.title":
-		panic(fmt.Errorf("field title of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
-		panic(fmt.Errorf("field summary of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.group_policy_address":
+		panic(fmt.Errorf("field group_policy_address of message cosmos.group.v1.MsgSubmitParentVoteProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.parent_proposal_id":
+		panic(fmt.Errorf("field parent_proposal_id of message cosmos.group.v1.MsgSubmitParentVoteProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.option":
+		panic(fmt.Errorf("field option of message cosmos.group.v1.MsgSubmitParentVoteProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.group.v1.MsgSubmitParentVoteProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.vote_metadata":
+		panic(fmt.Errorf("field vote_metadata of message cosmos.group.v1.MsgSubmitParentVoteProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.exec":
+		panic(fmt.Errorf("field exec of message cosmos.group.v1.MsgSubmitParentVoteProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.title":
+		panic(fmt.Errorf("field title of message cosmos.group.v1.MsgSubmitParentVoteProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.summary":
+		panic(fmt.Errorf("field summary of message cosmos.group.v1.MsgSubmitParentVoteProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitParentVoteProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.group_policy_address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.proposers":
 		list := []string{}
-		return protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{list: &list})
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		return protoreflect.ValueOfList(&_MsgSubmitParentVoteProposal_2_list{list: &list})
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.parent_proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.option":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.metadata":
 		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &list})
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.vote_metadata":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.exec":
 		return protoreflect.ValueOfEnum(0)
-	case "cosmos.group.v1.MsgSubmitProposal.title":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.title":
 		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposal.summary":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSubmitParentVoteProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitProposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitParentVoteProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9286,7 +11512,7 @@ func (x *fastReflection_MsgSubmitProposal) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitProposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSubmitParentVoteProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9297,7 +11523,7 @@ func (x *fastReflection_MsgSubmitProposal) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSubmitParentVoteProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9309,7 +11535,7 @@ func (x *fastReflection_MsgSubmitProposal) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitProposal) IsValid() bool {
+func (x *fastReflection_MsgSubmitParentVoteProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -9319,9 +11545,9 @@ func (x *fastReflection_MsgSubmitProposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSubmitParentVoteProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitProposal)
+		x := input.Message.Interface().(*MsgSubmitParentVoteProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9343,15 +11569,19 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.ParentProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParentProposalId))
+		}
+		if x.Option != 0 {
+			n += 1 + runtime.Sov(uint64(x.Option))
+		}
 		l = len(x.Metadata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Messages) > 0 {
-			for _, e := range x.Messages {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.VoteMetadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Exec != 0 {
 			n += 1 + runtime.Sov(uint64(x.Exec))
@@ -9374,7 +11604,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposal)
+		x := input.Message.Interface().(*MsgSubmitParentVoteProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9398,42 +11628,43 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], x.Summary)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x4a
 		}
 		if len(x.Title) > 0 {
 			i -= len(x.Title)
 			copy(dAtA[i:], x.Title)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x42
 		}
 		if x.Exec != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exec))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x38
 		}
-		if len(x.Messages) > 0 {
-			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Messages[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
+		if len(x.VoteMetadata) > 0 {
+			i -= len(x.VoteMetadata)
+			copy(dAtA[i:], x.VoteMetadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VoteMetadata)))
+			i--
+			dAtA[i] = 0x32
 		}
 		if len(x.Metadata) > 0 {
 			i -= len(x.Metadata)
 			copy(dAtA[i:], x.Metadata)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
+		}
+		if x.Option != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Option))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ParentProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParentProposalId))
+			i--
+			dAtA[i] = 0x18
 		}
 		if len(x.Proposers) > 0 {
 			for iNdEx := len(x.Proposers) - 1; iNdEx >= 0; iNdEx-- {
@@ -9462,7 +11693,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposal)
+		x := input.Message.Interface().(*MsgSubmitParentVoteProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9494,10 +11725,10 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitParentVoteProposal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitParentVoteProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -9565,6 +11796,44 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 				x.Proposers = append(x.Proposers, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParentProposalId", wireType)
+				}
+				x.ParentProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParentProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				}
+				x.Option = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Option |= VoteOption(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
@@ -9596,11 +11865,11 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 				}
 				x.Metadata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteMetadata", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9610,27 +11879,25 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Messages = append(x.Messages, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.VoteMetadata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 7:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
 				}
@@ -9649,7 +11916,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 6:
+			case 8:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 				}
@@ -9681,7 +11948,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 				}
 				x.Title = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 9:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 				}
@@ -9749,26 +12016,26 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSubmitProposalResponse             protoreflect.MessageDescriptor
-	fd_MsgSubmitProposalResponse_proposal_id protoreflect.FieldDescriptor
+	md_MsgSubmitParentVoteProposalResponse             protoreflect.MessageDescriptor
+	fd_MsgSubmitParentVoteProposalResponse_proposal_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgSubmitProposalResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitProposalResponse")
-	fd_MsgSubmitProposalResponse_proposal_id = md_MsgSubmitProposalResponse.Fields().ByName("proposal_id")
+	md_MsgSubmitParentVoteProposalResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitParentVoteProposalResponse")
+	fd_MsgSubmitParentVoteProposalResponse_proposal_id = md_MsgSubmitParentVoteProposalResponse.Fields().ByName("proposal_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSubmitParentVoteProposalResponse)(nil)
 
-type fastReflection_MsgSubmitProposalResponse MsgSubmitProposalResponse
+type fastReflection_MsgSubmitParentVoteProposalResponse MsgSubmitParentVoteProposalResponse
 
-func (x *MsgSubmitProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposalResponse)(x)
+func (x *MsgSubmitParentVoteProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitParentVoteProposalResponse)(x)
 }
 
-func (x *MsgSubmitProposalResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[19]
+func (x *MsgSubmitParentVoteProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9779,43 +12046,43 @@ func (x *MsgSubmitProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitProposalResponse_messageType fastReflection_MsgSubmitProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitProposalResponse_messageType{}
+var _fastReflection_MsgSubmitParentVoteProposalResponse_messageType fastReflection_MsgSubmitParentVoteProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitParentVoteProposalResponse_messageType{}
 
-type fastReflection_MsgSubmitProposalResponse_messageType struct{}
+type fastReflection_MsgSubmitParentVoteProposalResponse_messageType struct{}
 
-func (x fastReflection_MsgSubmitProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposalResponse)(nil)
+func (x fastReflection_MsgSubmitParentVoteProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitParentVoteProposalResponse)(nil)
 }
-func (x fastReflection_MsgSubmitProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposalResponse)
+func (x fastReflection_MsgSubmitParentVoteProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitParentVoteProposalResponse)
 }
-func (x fastReflection_MsgSubmitProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposalResponse
+func (x fastReflection_MsgSubmitParentVoteProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitParentVoteProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposalResponse
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitParentVoteProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitProposalResponse_messageType
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitParentVoteProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposalResponse)
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitParentVoteProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitProposalResponse)(x)
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitParentVoteProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9823,10 +12090,10 @@ func (x *fastReflection_MsgSubmitProposalResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_MsgSubmitProposalResponse_proposal_id, value) {
+		if !f(fd_MsgSubmitParentVoteProposalResponse_proposal_id, value) {
 			return
 		}
 	}
@@ -9843,15 +12110,15 @@ func (x *fastReflection_MsgSubmitProposalResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposalResponse.proposal_id":
 		return x.ProposalId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9861,15 +12128,15 @@ func (x *fastReflection_MsgSubmitProposalResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposalResponse.proposal_id":
 		x.ProposalId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9879,16 +12146,16 @@ func (x *fastReflection_MsgSubmitProposalResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposalResponse.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9902,15 +12169,15 @@ func (x *fastReflection_MsgSubmitProposalResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposalResponse.proposal_id":
 		x.ProposalId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9924,40 +12191,40 @@ func (x *fastReflection_MsgSubmitProposalResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgSubmitProposalResponse is not mutable"))
+	case "cosmos.group.v1.MsgSubmitParentVoteProposalResponse.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgSubmitParentVoteProposalResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+	case "cosmos.group.v1.MsgSubmitParentVoteProposalResponse.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitParentVoteProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitParentVoteProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitParentVoteProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9965,7 +12232,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9976,7 +12243,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9988,7 +12255,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitProposalResponse) IsValid() bool {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9998,9 +12265,9 @@ func (x *fastReflection_MsgSubmitProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSubmitParentVoteProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		x := input.Message.Interface().(*MsgSubmitParentVoteProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10025,7 +12292,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		x := input.Message.Interface().(*MsgSubmitParentVoteProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10060,7 +12327,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		x := input.Message.Interface().(*MsgSubmitParentVoteProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10092,10 +12359,10 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitParentVoteProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitParentVoteProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -10174,7 +12441,7 @@ func (x *MsgWithdrawProposal) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgWithdrawProposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[20]
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10638,7 +12905,7 @@ func (x *MsgWithdrawProposalResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgWithdrawProposalResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[21]
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11004,7 +13271,7 @@ func (x *MsgVote) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgVote) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[22]
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11622,7 +13889,7 @@ func (x *MsgVoteResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgVoteResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[23]
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11982,7 +14249,7 @@ func (x *MsgExec) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgExec) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[24]
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12448,7 +14715,7 @@ func (x *MsgExecResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[25]
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12854,7 +15121,7 @@ func (x *MsgLeaveGroup) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgLeaveGroup) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[26]
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13318,7 +15585,7 @@ func (x *MsgLeaveGroupResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgLeaveGroupResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[27]
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14498,45 +16765,131 @@ func (x *MsgUpdateGroupPolicyMetadata) GetAdmin() string {
 	return ""
 }
 
-func (x *MsgUpdateGroupPolicyMetadata) GetGroupPolicyAddress() string {
+func (x *MsgUpdateGroupPolicyMetadata) GetGroupPolicyAddress() string {
+	if x != nil {
+		return x.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (x *MsgUpdateGroupPolicyMetadata) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+// MsgUpdateGroupPolicyMetadataResponse is the Msg/UpdateGroupPolicyMetadata response type.
+type MsgUpdateGroupPolicyMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateGroupPolicyMetadataResponse) Reset() {
+	*x = MsgUpdateGroupPolicyMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateGroupPolicyMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateGroupPolicyMetadataResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateGroupPolicyMetadataResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateGroupPolicyMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{17}
+}
+
+// MsgUpdateGroupPolicyAutoExecution is the Msg/UpdateGroupPolicyAutoExecution request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateGroupPolicyAutoExecution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// auto_execution is the updated group policy automatic execution settings.
+	AutoExecution *AutoExecution `protobuf:"bytes,3,opt,name=auto_execution,json=autoExecution,proto3" json:"auto_execution,omitempty"`
+}
+
+func (x *MsgUpdateGroupPolicyAutoExecution) Reset() {
+	*x = MsgUpdateGroupPolicyAutoExecution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateGroupPolicyAutoExecution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateGroupPolicyAutoExecution) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateGroupPolicyAutoExecution.ProtoReflect.Descriptor instead.
+func (*MsgUpdateGroupPolicyAutoExecution) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgUpdateGroupPolicyAutoExecution) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *MsgUpdateGroupPolicyAutoExecution) GetGroupPolicyAddress() string {
 	if x != nil {
 		return x.GroupPolicyAddress
 	}
 	return ""
 }
 
-func (x *MsgUpdateGroupPolicyMetadata) GetMetadata() string {
+func (x *MsgUpdateGroupPolicyAutoExecution) GetAutoExecution() *AutoExecution {
 	if x != nil {
-		return x.Metadata
+		return x.AutoExecution
 	}
-	return ""
+	return nil
 }
 
-// MsgUpdateGroupPolicyMetadataResponse is the Msg/UpdateGroupPolicyMetadata response type.
-type MsgUpdateGroupPolicyMetadataResponse struct {
+// MsgUpdateGroupPolicyAutoExecutionResponse is the Msg/UpdateGroupPolicyAutoExecution response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateGroupPolicyAutoExecutionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateGroupPolicyMetadataResponse) Reset() {
-	*x = MsgUpdateGroupPolicyMetadataResponse{}
+func (x *MsgUpdateGroupPolicyAutoExecutionResponse) Reset() {
+	*x = MsgUpdateGroupPolicyAutoExecutionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[17]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateGroupPolicyMetadataResponse) String() string {
+func (x *MsgUpdateGroupPolicyAutoExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateGroupPolicyMetadataResponse) ProtoMessage() {}
+func (*MsgUpdateGroupPolicyAutoExecutionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateGroupPolicyMetadataResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateGroupPolicyMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use MsgUpdateGroupPolicyAutoExecutionResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateGroupPolicyAutoExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
@@ -14571,7 +16924,7 @@ type MsgSubmitProposal struct {
 func (x *MsgSubmitProposal) Reset() {
 	*x = MsgSubmitProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[18]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14585,7 +16938,7 @@ func (*MsgSubmitProposal) ProtoMessage() {}
 
 // Deprecated: Use MsgSubmitProposal.ProtoReflect.Descriptor instead.
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{18}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MsgSubmitProposal) GetGroupPolicyAddress() string {
@@ -14650,7 +17003,7 @@ type MsgSubmitProposalResponse struct {
 func (x *MsgSubmitProposalResponse) Reset() {
 	*x = MsgSubmitProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[19]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14664,7 +17017,7 @@ func (*MsgSubmitProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgSubmitProposalResponse.ProtoReflect.Descriptor instead.
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{19}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MsgSubmitProposalResponse) GetProposalId() uint64 {
@@ -14674,6 +17027,163 @@ func (x *MsgSubmitProposalResponse) GetProposalId() uint64 {
 	return 0
 }
 
+// MsgSubmitParentVoteProposal is the Msg/SubmitParentVoteProposal request type.
+// It submits an internal proposal on a sub-DAO group policy, which, once
+// executed, casts the group policy's vote on a proposal of a parent group.
+//
+// Since: cosmos-sdk 0.48
+type MsgSubmitParentVoteProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group_policy_address is the account address of the sub-DAO group policy,
+	// which must be a member of the parent proposal's group.
+	GroupPolicyAddress string `protobuf:"bytes,1,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// proposers are the account addresses of the proposers.
+	// Proposers signatures will be counted as yes votes.
+	Proposers []string `protobuf:"bytes,2,rep,name=proposers,proto3" json:"proposers,omitempty"`
+	// parent_proposal_id is the unique ID of the parent group's proposal to vote on.
+	ParentProposalId uint64 `protobuf:"varint,3,opt,name=parent_proposal_id,json=parentProposalId,proto3" json:"parent_proposal_id,omitempty"`
+	// option is the vote option the group policy casts on the parent proposal.
+	Option VoteOption `protobuf:"varint,4,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// metadata is any arbitrary metadata attached to the internal proposal.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// vote_metadata is any arbitrary metadata attached to the vote on the parent
+	// proposal.
+	VoteMetadata string `protobuf:"bytes,6,opt,name=vote_metadata,json=voteMetadata,proto3" json:"vote_metadata,omitempty"`
+	// exec defines the mode of execution of the internal proposal,
+	// whether it should be executed immediately on creation or not.
+	// If so, proposers signatures are considered as Yes votes.
+	Exec Exec `protobuf:"varint,7,opt,name=exec,proto3,enum=cosmos.group.v1.Exec" json:"exec,omitempty"`
+	// title is the title of the internal proposal.
+	Title string `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the internal proposal.
+	Summary string `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *MsgSubmitParentVoteProposal) Reset() {
+	*x = MsgSubmitParentVoteProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitParentVoteProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitParentVoteProposal) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitParentVoteProposal.ProtoReflect.Descriptor instead.
+func (*MsgSubmitParentVoteProposal) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MsgSubmitParentVoteProposal) GetGroupPolicyAddress() string {
+	if x != nil {
+		return x.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (x *MsgSubmitParentVoteProposal) GetProposers() []string {
+	if x != nil {
+		return x.Proposers
+	}
+	return nil
+}
+
+func (x *MsgSubmitParentVoteProposal) GetParentProposalId() uint64 {
+	if x != nil {
+		return x.ParentProposalId
+	}
+	return 0
+}
+
+func (x *MsgSubmitParentVoteProposal) GetOption() VoteOption {
+	if x != nil {
+		return x.Option
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+func (x *MsgSubmitParentVoteProposal) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *MsgSubmitParentVoteProposal) GetVoteMetadata() string {
+	if x != nil {
+		return x.VoteMetadata
+	}
+	return ""
+}
+
+func (x *MsgSubmitParentVoteProposal) GetExec() Exec {
+	if x != nil {
+		return x.Exec
+	}
+	return Exec_EXEC_UNSPECIFIED
+}
+
+func (x *MsgSubmitParentVoteProposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MsgSubmitParentVoteProposal) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+// MsgSubmitParentVoteProposalResponse is the Msg/SubmitParentVoteProposal response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSubmitParentVoteProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the unique ID of the internal proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (x *MsgSubmitParentVoteProposalResponse) Reset() {
+	*x = MsgSubmitParentVoteProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitParentVoteProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitParentVoteProposalResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitParentVoteProposalResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitParentVoteProposalResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MsgSubmitParentVoteProposalResponse) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
 // MsgWithdrawProposal is the Msg/WithdrawProposal request type.
 type MsgWithdrawProposal struct {
 	state         protoimpl.MessageState
@@ -14689,7 +17199,7 @@ type MsgWithdrawProposal struct {
 func (x *MsgWithdrawProposal) Reset() {
 	*x = MsgWithdrawProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[20]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14703,7 +17213,7 @@ func (*MsgWithdrawProposal) ProtoMessage() {}
 
 // Deprecated: Use MsgWithdrawProposal.ProtoReflect.Descriptor instead.
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{20}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *MsgWithdrawProposal) GetProposalId() uint64 {
@@ -14730,7 +17240,7 @@ type MsgWithdrawProposalResponse struct {
 func (x *MsgWithdrawProposalResponse) Reset() {
 	*x = MsgWithdrawProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[21]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14744,7 +17254,7 @@ func (*MsgWithdrawProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgWithdrawProposalResponse.ProtoReflect.Descriptor instead.
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{21}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{25}
 }
 
 // MsgVote is the Msg/Vote request type.
@@ -14769,7 +17279,7 @@ type MsgVote struct {
 func (x *MsgVote) Reset() {
 	*x = MsgVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[22]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14783,7 +17293,7 @@ func (*MsgVote) ProtoMessage() {}
 
 // Deprecated: Use MsgVote.ProtoReflect.Descriptor instead.
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{22}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MsgVote) GetProposalId() uint64 {
@@ -14831,7 +17341,7 @@ type MsgVoteResponse struct {
 func (x *MsgVoteResponse) Reset() {
 	*x = MsgVoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[23]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14845,7 +17355,7 @@ func (*MsgVoteResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgVoteResponse.ProtoReflect.Descriptor instead.
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{23}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{27}
 }
 
 // MsgExec is the Msg/Exec request type.
@@ -14863,7 +17373,7 @@ type MsgExec struct {
 func (x *MsgExec) Reset() {
 	*x = MsgExec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[24]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14877,7 +17387,7 @@ func (*MsgExec) ProtoMessage() {}
 
 // Deprecated: Use MsgExec.ProtoReflect.Descriptor instead.
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{24}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MsgExec) GetProposalId() uint64 {
@@ -14907,7 +17417,7 @@ type MsgExecResponse struct {
 func (x *MsgExecResponse) Reset() {
 	*x = MsgExecResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[25]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14921,7 +17431,7 @@ func (*MsgExecResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgExecResponse.ProtoReflect.Descriptor instead.
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{25}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *MsgExecResponse) GetResult() ProposalExecutorResult {
@@ -14946,7 +17456,7 @@ type MsgLeaveGroup struct {
 func (x *MsgLeaveGroup) Reset() {
 	*x = MsgLeaveGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[26]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14960,7 +17470,7 @@ func (*MsgLeaveGroup) ProtoMessage() {}
 
 // Deprecated: Use MsgLeaveGroup.ProtoReflect.Descriptor instead.
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{26}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MsgLeaveGroup) GetAddress() string {
@@ -14987,7 +17497,7 @@ type MsgLeaveGroupResponse struct {
 func (x *MsgLeaveGroupResponse) Reset() {
 	*x = MsgLeaveGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[27]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15001,7 +17511,7 @@ func (*MsgLeaveGroupResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgLeaveGroupResponse.ProtoReflect.Descriptor instead.
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{27}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{31}
 }
 
 var File_cosmos_group_v1_tx_proto protoreflect.FileDescriptor
@@ -15198,194 +17708,265 @@ var file_cosmos_group_v1_tx_proto_rawDesc = []byte{
 	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x26, 0x0a, 0x24, 0x4d, 0x73, 0x67, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
 	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xe1, 0x02, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x14, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x22, 0xa8, 0x02, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x4a, 0x0a, 0x14, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12,
 	0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x08,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x29,
-	0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x3a, 0x39, 0x88, 0xa0, 0x1f, 0x00, 0x82,
-	0xe7, 0xb0, 0x2a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x22, 0x3c, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x49, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x73, 0x73, 0x12, 0x50, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x75,
+	0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x29, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe1, 0x02, 0x0a, 0x11, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x4a,
+	0x0a, 0x14, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
 	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a,
-	0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74,
-	0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x04, 0x65, 0x78, 0x65,
+	0x63, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x3a, 0x39, 0x88, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x3c, 0x0a, 0x19,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xbf, 0x03, 0x0a, 0x1b, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x14, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
 	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
 	0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x3a, 0x27,
-	0x82, 0xe7, 0xb0, 0x2a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f,
-	0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x56, 0x6f,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x07, 0x4d,
-	0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x3a, 0x2a, 0x82,
-	0xe7, 0xb0, 0x2a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x6f, 0x74, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x04, 0x65,
+	0x78, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x46, 0x0a, 0x23,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56,
+	0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x49, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x56,
+	0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63,
+	0x3a, 0x27, 0x82, 0xe7, 0xb0, 0x2a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
 	0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2f, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x22, 0x52, 0x0a, 0x0f, 0x4d, 0x73, 0x67,
-	0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8f, 0x01,
-	0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x3a, 0x2f,
-	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2f, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22,
-	0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x2a, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63,
-	0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x54,
-	0x52, 0x59, 0x10, 0x01, 0x32, 0xca, 0x0b, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x57, 0x0a, 0x0b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x27, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x2c, 0x2e,
+	0x70, 0x2f, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x73, 0x67,
+	0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x01, 0x0a,
+	0x07, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x3a,
+	0x2a, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x22, 0x52, 0x0a, 0x0f, 0x4d,
+	0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x8f, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x2a, 0x0a, 0x04, 0x45, 0x78,
+	0x65, 0x63, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x45, 0x43,
+	0x5f, 0x54, 0x52, 0x59, 0x10, 0x01, 0x32, 0xdd, 0x0d, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x57,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x13, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2f, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x11,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x31, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78,
-	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a,
+	0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
 	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65,
-	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x33, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x1a, 0x3b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81,
-	0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x35, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x60, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x2c,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2f, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x31, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69,
+	0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x78, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x1f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x33,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x04,
-	0x56, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x1a, 0x20,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x1a, 0x3b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x81, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x42, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78,
-	0x65, 0x63, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a,
-	0x01, 0x42, 0xa6, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
-	0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x35, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x90, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x75,
+	0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3a, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x2a, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x18, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x1a, 0x34, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x24, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56,
+	0x6f, 0x74, 0x65, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x18, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0a, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a,
+	0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa6, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x07,
+	0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -15401,7 +17982,7 @@ func file_cosmos_group_v1_tx_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_group_v1_tx_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cosmos_group_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_cosmos_group_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_cosmos_group_v1_tx_proto_goTypes = []interface{}{
 	(Exec)(0),                                          // 0: cosmos.group.v1.Exec
 	(*MsgCreateGroup)(nil),                             // 1: cosmos.group.v1.MsgCreateGroup
@@ -15422,66 +18003,78 @@ var file_cosmos_group_v1_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateGroupPolicyDecisionPolicyResponse)(nil), // 16: cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse
 	(*MsgUpdateGroupPolicyMetadata)(nil),               // 17: cosmos.group.v1.MsgUpdateGroupPolicyMetadata
 	(*MsgUpdateGroupPolicyMetadataResponse)(nil),       // 18: cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse
-	(*MsgSubmitProposal)(nil),                          // 19: cosmos.group.v1.MsgSubmitProposal
-	(*MsgSubmitProposalResponse)(nil),                  // 20: cosmos.group.v1.MsgSubmitProposalResponse
-	(*MsgWithdrawProposal)(nil),                        // 21: cosmos.group.v1.MsgWithdrawProposal
-	(*MsgWithdrawProposalResponse)(nil),                // 22: cosmos.group.v1.MsgWithdrawProposalResponse
-	(*MsgVote)(nil),                                    // 23: cosmos.group.v1.MsgVote
-	(*MsgVoteResponse)(nil),                            // 24: cosmos.group.v1.MsgVoteResponse
-	(*MsgExec)(nil),                                    // 25: cosmos.group.v1.MsgExec
-	(*MsgExecResponse)(nil),                            // 26: cosmos.group.v1.MsgExecResponse
-	(*MsgLeaveGroup)(nil),                              // 27: cosmos.group.v1.MsgLeaveGroup
-	(*MsgLeaveGroupResponse)(nil),                      // 28: cosmos.group.v1.MsgLeaveGroupResponse
-	(*MemberRequest)(nil),                              // 29: cosmos.group.v1.MemberRequest
-	(*anypb.Any)(nil),                                  // 30: google.protobuf.Any
-	(VoteOption)(0),                                    // 31: cosmos.group.v1.VoteOption
-	(ProposalExecutorResult)(0),                        // 32: cosmos.group.v1.ProposalExecutorResult
+	(*MsgUpdateGroupPolicyAutoExecution)(nil),          // 19: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution
+	(*MsgUpdateGroupPolicyAutoExecutionResponse)(nil),  // 20: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse
+	(*MsgSubmitProposal)(nil),                          // 21: cosmos.group.v1.MsgSubmitProposal
+	(*MsgSubmitProposalResponse)(nil),                  // 22: cosmos.group.v1.MsgSubmitProposalResponse
+	(*MsgSubmitParentVoteProposal)(nil),                // 23: cosmos.group.v1.MsgSubmitParentVoteProposal
+	(*MsgSubmitParentVoteProposalResponse)(nil),        // 24: cosmos.group.v1.MsgSubmitParentVoteProposalResponse
+	(*MsgWithdrawProposal)(nil),                        // 25: cosmos.group.v1.MsgWithdrawProposal
+	(*MsgWithdrawProposalResponse)(nil),                // 26: cosmos.group.v1.MsgWithdrawProposalResponse
+	(*MsgVote)(nil),                                    // 27: cosmos.group.v1.MsgVote
+	(*MsgVoteResponse)(nil),                            // 28: cosmos.group.v1.MsgVoteResponse
+	(*MsgExec)(nil),                                    // 29: cosmos.group.v1.MsgExec
+	(*MsgExecResponse)(nil),                            // 30: cosmos.group.v1.MsgExecResponse
+	(*MsgLeaveGroup)(nil),                              // 31: cosmos.group.v1.MsgLeaveGroup
+	(*MsgLeaveGroupResponse)(nil),                      // 32: cosmos.group.v1.MsgLeaveGroupResponse
+	(*MemberRequest)(nil),                              // 33: cosmos.group.v1.MemberRequest
+	(*anypb.Any)(nil),                                  // 34: google.protobuf.Any
+	(*AutoExecution)(nil),                              // 35: cosmos.group.v1.AutoExecution
+	(VoteOption)(0),                                    // 36: cosmos.group.v1.VoteOption
+	(ProposalExecutorResult)(0),                        // 37: cosmos.group.v1.ProposalExecutorResult
 }
 var file_cosmos_group_v1_tx_proto_depIdxs = []int32{
-	29, // 0: cosmos.group.v1.MsgCreateGroup.members:type_name -> cosmos.group.v1.MemberRequest
-	29, // 1: cosmos.group.v1.MsgUpdateGroupMembers.member_updates:type_name -> cosmos.group.v1.MemberRequest
-	30, // 2: cosmos.group.v1.MsgCreateGroupPolicy.decision_policy:type_name -> google.protobuf.Any
-	29, // 3: cosmos.group.v1.MsgCreateGroupWithPolicy.members:type_name -> cosmos.group.v1.MemberRequest
-	30, // 4: cosmos.group.v1.MsgCreateGroupWithPolicy.decision_policy:type_name -> google.protobuf.Any
-	30, // 5: cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy.decision_policy:type_name -> google.protobuf.Any
-	30, // 6: cosmos.group.v1.MsgSubmitProposal.messages:type_name -> google.protobuf.Any
-	0,  // 7: cosmos.group.v1.MsgSubmitProposal.exec:type_name -> cosmos.group.v1.Exec
-	31, // 8: cosmos.group.v1.MsgVote.option:type_name -> cosmos.group.v1.VoteOption
-	0,  // 9: cosmos.group.v1.MsgVote.exec:type_name -> cosmos.group.v1.Exec
-	32, // 10: cosmos.group.v1.MsgExecResponse.result:type_name -> cosmos.group.v1.ProposalExecutorResult
-	1,  // 11: cosmos.group.v1.Msg.CreateGroup:input_type -> cosmos.group.v1.MsgCreateGroup
-	3,  // 12: cosmos.group.v1.Msg.UpdateGroupMembers:input_type -> cosmos.group.v1.MsgUpdateGroupMembers
-	5,  // 13: cosmos.group.v1.Msg.UpdateGroupAdmin:input_type -> cosmos.group.v1.MsgUpdateGroupAdmin
-	7,  // 14: cosmos.group.v1.Msg.UpdateGroupMetadata:input_type -> cosmos.group.v1.MsgUpdateGroupMetadata
-	9,  // 15: cosmos.group.v1.Msg.CreateGroupPolicy:input_type -> cosmos.group.v1.MsgCreateGroupPolicy
-	13, // 16: cosmos.group.v1.Msg.CreateGroupWithPolicy:input_type -> cosmos.group.v1.MsgCreateGroupWithPolicy
-	11, // 17: cosmos.group.v1.Msg.UpdateGroupPolicyAdmin:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyAdmin
-	15, // 18: cosmos.group.v1.Msg.UpdateGroupPolicyDecisionPolicy:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy
-	17, // 19: cosmos.group.v1.Msg.UpdateGroupPolicyMetadata:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyMetadata
-	19, // 20: cosmos.group.v1.Msg.SubmitProposal:input_type -> cosmos.group.v1.MsgSubmitProposal
-	21, // 21: cosmos.group.v1.Msg.WithdrawProposal:input_type -> cosmos.group.v1.MsgWithdrawProposal
-	23, // 22: cosmos.group.v1.Msg.Vote:input_type -> cosmos.group.v1.MsgVote
-	25, // 23: cosmos.group.v1.Msg.Exec:input_type -> cosmos.group.v1.MsgExec
-	27, // 24: cosmos.group.v1.Msg.LeaveGroup:input_type -> cosmos.group.v1.MsgLeaveGroup
-	2,  // 25: cosmos.group.v1.Msg.CreateGroup:output_type -> cosmos.group.v1.MsgCreateGroupResponse
-	4,  // 26: cosmos.group.v1.Msg.UpdateGroupMembers:output_type -> cosmos.group.v1.MsgUpdateGroupMembersResponse
-	6,  // 27: cosmos.group.v1.Msg.UpdateGroupAdmin:output_type -> cosmos.group.v1.MsgUpdateGroupAdminResponse
-	8,  // 28: cosmos.group.v1.Msg.UpdateGroupMetadata:output_type -> cosmos.group.v1.MsgUpdateGroupMetadataResponse
-	10, // 29: cosmos.group.v1.Msg.CreateGroupPolicy:output_type -> cosmos.group.v1.MsgCreateGroupPolicyResponse
-	14, // 30: cosmos.group.v1.Msg.CreateGroupWithPolicy:output_type -> cosmos.group.v1.MsgCreateGroupWithPolicyResponse
-	12, // 31: cosmos.group.v1.Msg.UpdateGroupPolicyAdmin:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyAdminResponse
-	16, // 32: cosmos.group.v1.Msg.UpdateGroupPolicyDecisionPolicy:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse
-	18, // 33: cosmos.group.v1.Msg.UpdateGroupPolicyMetadata:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse
-	20, // 34: cosmos.group.v1.Msg.SubmitProposal:output_type -> cosmos.group.v1.MsgSubmitProposalResponse
-	22, // 35: cosmos.group.v1.Msg.WithdrawProposal:output_type -> cosmos.group.v1.MsgWithdrawProposalResponse
-	24, // 36: cosmos.group.v1.Msg.Vote:output_type -> cosmos.group.v1.MsgVoteResponse
-	26, // 37: cosmos.group.v1.Msg.Exec:output_type -> cosmos.group.v1.MsgExecResponse
-	28, // 38: cosmos.group.v1.Msg.LeaveGroup:output_type -> cosmos.group.v1.MsgLeaveGroupResponse
-	25, // [25:39] is the sub-list for method output_type
-	11, // [11:25] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	33, // 0: cosmos.group.v1.MsgCreateGroup.members:type_name -> cosmos.group.v1.MemberRequest
+	33, // 1: cosmos.group.v1.MsgUpdateGroupMembers.member_updates:type_name -> cosmos.group.v1.MemberRequest
+	34, // 2: cosmos.group.v1.MsgCreateGroupPolicy.decision_policy:type_name -> google.protobuf.Any
+	33, // 3: cosmos.group.v1.MsgCreateGroupWithPolicy.members:type_name -> cosmos.group.v1.MemberRequest
+	34, // 4: cosmos.group.v1.MsgCreateGroupWithPolicy.decision_policy:type_name -> google.protobuf.Any
+	34, // 5: cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy.decision_policy:type_name -> google.protobuf.Any
+	35, // 6: cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution.auto_execution:type_name -> cosmos.group.v1.AutoExecution
+	34, // 7: cosmos.group.v1.MsgSubmitProposal.messages:type_name -> google.protobuf.Any
+	0,  // 8: cosmos.group.v1.MsgSubmitProposal.exec:type_name -> cosmos.group.v1.Exec
+	36, // 9: cosmos.group.v1.MsgSubmitParentVoteProposal.option:type_name -> cosmos.group.v1.VoteOption
+	0,  // 10: cosmos.group.v1.MsgSubmitParentVoteProposal.exec:type_name -> cosmos.group.v1.Exec
+	36, // 11: cosmos.group.v1.MsgVote.option:type_name -> cosmos.group.v1.VoteOption
+	0,  // 12: cosmos.group.v1.MsgVote.exec:type_name -> cosmos.group.v1.Exec
+	37, // 13: cosmos.group.v1.MsgExecResponse.result:type_name -> cosmos.group.v1.ProposalExecutorResult
+	1,  // 14: cosmos.group.v1.Msg.CreateGroup:input_type -> cosmos.group.v1.MsgCreateGroup
+	3,  // 15: cosmos.group.v1.Msg.UpdateGroupMembers:input_type -> cosmos.group.v1.MsgUpdateGroupMembers
+	5,  // 16: cosmos.group.v1.Msg.UpdateGroupAdmin:input_type -> cosmos.group.v1.MsgUpdateGroupAdmin
+	7,  // 17: cosmos.group.v1.Msg.UpdateGroupMetadata:input_type -> cosmos.group.v1.MsgUpdateGroupMetadata
+	9,  // 18: cosmos.group.v1.Msg.CreateGroupPolicy:input_type -> cosmos.group.v1.MsgCreateGroupPolicy
+	13, // 19: cosmos.group.v1.Msg.CreateGroupWithPolicy:input_type -> cosmos.group.v1.MsgCreateGroupWithPolicy
+	11, // 20: cosmos.group.v1.Msg.UpdateGroupPolicyAdmin:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyAdmin
+	15, // 21: cosmos.group.v1.Msg.UpdateGroupPolicyDecisionPolicy:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy
+	17, // 22: cosmos.group.v1.Msg.UpdateGroupPolicyMetadata:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyMetadata
+	19, // 23: cosmos.group.v1.Msg.UpdateGroupPolicyAutoExecution:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution
+	21, // 24: cosmos.group.v1.Msg.SubmitProposal:input_type -> cosmos.group.v1.MsgSubmitProposal
+	23, // 25: cosmos.group.v1.Msg.SubmitParentVoteProposal:input_type -> cosmos.group.v1.MsgSubmitParentVoteProposal
+	25, // 26: cosmos.group.v1.Msg.WithdrawProposal:input_type -> cosmos.group.v1.MsgWithdrawProposal
+	27, // 27: cosmos.group.v1.Msg.Vote:input_type -> cosmos.group.v1.MsgVote
+	29, // 28: cosmos.group.v1.Msg.Exec:input_type -> cosmos.group.v1.MsgExec
+	31, // 29: cosmos.group.v1.Msg.LeaveGroup:input_type -> cosmos.group.v1.MsgLeaveGroup
+	2,  // 30: cosmos.group.v1.Msg.CreateGroup:output_type -> cosmos.group.v1.MsgCreateGroupResponse
+	4,  // 31: cosmos.group.v1.Msg.UpdateGroupMembers:output_type -> cosmos.group.v1.MsgUpdateGroupMembersResponse
+	6,  // 32: cosmos.group.v1.Msg.UpdateGroupAdmin:output_type -> cosmos.group.v1.MsgUpdateGroupAdminResponse
+	8,  // 33: cosmos.group.v1.Msg.UpdateGroupMetadata:output_type -> cosmos.group.v1.MsgUpdateGroupMetadataResponse
+	10, // 34: cosmos.group.v1.Msg.CreateGroupPolicy:output_type -> cosmos.group.v1.MsgCreateGroupPolicyResponse
+	14, // 35: cosmos.group.v1.Msg.CreateGroupWithPolicy:output_type -> cosmos.group.v1.MsgCreateGroupWithPolicyResponse
+	12, // 36: cosmos.group.v1.Msg.UpdateGroupPolicyAdmin:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyAdminResponse
+	16, // 37: cosmos.group.v1.Msg.UpdateGroupPolicyDecisionPolicy:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse
+	18, // 38: cosmos.group.v1.Msg.UpdateGroupPolicyMetadata:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse
+	20, // 39: cosmos.group.v1.Msg.UpdateGroupPolicyAutoExecution:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse
+	22, // 40: cosmos.group.v1.Msg.SubmitProposal:output_type -> cosmos.group.v1.MsgSubmitProposalResponse
+	24, // 41: cosmos.group.v1.Msg.SubmitParentVoteProposal:output_type -> cosmos.group.v1.MsgSubmitParentVoteProposalResponse
+	26, // 42: cosmos.group.v1.Msg.WithdrawProposal:output_type -> cosmos.group.v1.MsgWithdrawProposalResponse
+	28, // 43: cosmos.group.v1.Msg.Vote:output_type -> cosmos.group.v1.MsgVoteResponse
+	30, // 44: cosmos.group.v1.Msg.Exec:output_type -> cosmos.group.v1.MsgExecResponse
+	32, // 45: cosmos.group.v1.Msg.LeaveGroup:output_type -> cosmos.group.v1.MsgLeaveGroupResponse
+	30, // [30:46] is the sub-list for method output_type
+	14, // [14:30] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_group_v1_tx_proto_init() }
@@ -15708,7 +18301,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitProposal); i {
+			switch v := v.(*MsgUpdateGroupPolicyAutoExecution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15720,7 +18313,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitProposalResponse); i {
+			switch v := v.(*MsgUpdateGroupPolicyAutoExecutionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15732,7 +18325,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgWithdrawProposal); i {
+			switch v := v.(*MsgSubmitProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15744,7 +18337,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgWithdrawProposalResponse); i {
+			switch v := v.(*MsgSubmitProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15756,7 +18349,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgVote); i {
+			switch v := v.(*MsgSubmitParentVoteProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15768,7 +18361,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgVoteResponse); i {
+			switch v := v.(*MsgSubmitParentVoteProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15780,7 +18373,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExec); i {
+			switch v := v.(*MsgWithdrawProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15792,7 +18385,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExecResponse); i {
+			switch v := v.(*MsgWithdrawProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15804,7 +18397,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgLeaveGroup); i {
+			switch v := v.(*MsgVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15816,6 +18409,54 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgVoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExecResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgLeaveGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgLeaveGroupResponse); i {
 			case 0:
 				return &v.state
@@ -15834,7 +18475,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_group_v1_tx_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   28,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,7 +30,9 @@ const (
 	Msg_UpdateGroupPolicyAdmin_FullMethodName          = "/cosmos.group.v1.Msg/UpdateGroupPolicyAdmin"
 	Msg_UpdateGroupPolicyDecisionPolicy_FullMethodName = "/cosmos.group.v1.Msg/UpdateGroupPolicyDecisionPolicy"
 	Msg_UpdateGroupPolicyMetadata_FullMethodName       = "/cosmos.group.v1.Msg/UpdateGroupPolicyMetadata"
+	Msg_UpdateGroupPolicyAutoExecution_FullMethodName  = "/cosmos.group.v1.Msg/UpdateGroupPolicyAutoExecution"
 	Msg_SubmitProposal_FullMethodName                  = "/cosmos.group.v1.Msg/SubmitProposal"
+	Msg_SubmitParentVoteProposal_FullMethodName        = "/cosmos.group.v1.Msg/SubmitParentVoteProposal"
 	Msg_WithdrawProposal_FullMethodName                = "/cosmos.group.v1.Msg/WithdrawProposal"
 	Msg_Vote_FullMethodName                            = "/cosmos.group.v1.Msg/Vote"
 	Msg_Exec_FullMethodName                            = "/cosmos.group.v1.Msg/Exec"
@@ -59,8 +61,18 @@ type MsgClient interface {
 	UpdateGroupPolicyDecisionPolicy(ctx context.Context, in *MsgUpdateGroupPolicyDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdateGroupPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyAutoExecution updates a group policy automatic execution
+	// settings.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateGroupPolicyAutoExecution(ctx context.Context, in *MsgUpdateGroupPolicyAutoExecution, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyAutoExecutionResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
+	// SubmitParentVoteProposal submits a new internal proposal on a group policy
+	// to vote on a proposal of a parent group the group policy is a member of.
+	//
+	// Since: cosmos-sdk 0.48
+	SubmitParentVoteProposal(ctx context.Context, in *MsgSubmitParentVoteProposal, opts ...grpc.CallOption) (*MsgSubmitParentVoteProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
@@ -160,6 +172,15 @@ func (c *msgClient) UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdate
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyAutoExecution(ctx context.Context, in *MsgUpdateGroupPolicyAutoExecution, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyAutoExecutionResponse, error) {
+	out := new(MsgUpdateGroupPolicyAutoExecutionResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateGroupPolicyAutoExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
 	out := new(MsgSubmitProposalResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitProposal_FullMethodName, in, out, opts...)
@@ -169,6 +190,15 @@ func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, o
 	return out, nil
 }
 
+func (c *msgClient) SubmitParentVoteProposal(ctx context.Context, in *MsgSubmitParentVoteProposal, opts ...grpc.CallOption) (*MsgSubmitParentVoteProposalResponse, error) {
+	out := new(MsgSubmitParentVoteProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitParentVoteProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error) {
 	out := new(MsgWithdrawProposalResponse)
 	err := c.cc.Invoke(ctx, Msg_WithdrawProposal_FullMethodName, in, out, opts...)
@@ -227,8 +257,18 @@ type MsgServer interface {
 	UpdateGroupPolicyDecisionPolicy(context.Context, *MsgUpdateGroupPolicyDecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyAutoExecution updates a group policy automatic execution
+	// settings.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateGroupPolicyAutoExecution(context.Context, *MsgUpdateGroupPolicyAutoExecution) (*MsgUpdateGroupPolicyAutoExecutionResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
+	// SubmitParentVoteProposal submits a new internal proposal on a group policy
+	// to vote on a proposal of a parent group the group policy is a member of.
+	//
+	// Since: cosmos-sdk 0.48
+	SubmitParentVoteProposal(context.Context, *MsgSubmitParentVoteProposal) (*MsgSubmitParentVoteProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
@@ -271,9 +311,15 @@ func (UnimplementedMsgServer) UpdateGroupPolicyDecisionPolicy(context.Context, *
 func (UnimplementedMsgServer) UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyMetadata not implemented")
 }
+func (UnimplementedMsgServer) UpdateGroupPolicyAutoExecution(context.Context, *MsgUpdateGroupPolicyAutoExecution) (*MsgUpdateGroupPolicyAutoExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyAutoExecution not implemented")
+}
 func (UnimplementedMsgServer) SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
 }
+func (UnimplementedMsgServer) SubmitParentVoteProposal(context.Context, *MsgSubmitParentVoteProposal) (*MsgSubmitParentVoteProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitParentVoteProposal not implemented")
+}
 func (UnimplementedMsgServer) WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawProposal not implemented")
 }
@@ -461,6 +507,24 @@ func _Msg_UpdateGroupPolicyMetadata_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupPolicyAutoExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyAutoExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyAutoExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateGroupPolicyAutoExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyAutoExecution(ctx, req.(*MsgUpdateGroupPolicyAutoExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitProposal)
 	if err := dec(in); err != nil {
@@ -479,6 +543,24 @@ func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitParentVoteProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitParentVoteProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitParentVoteProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitParentVoteProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitParentVoteProposal(ctx, req.(*MsgSubmitParentVoteProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawProposal)
 	if err := dec(in); err != nil {
@@ -594,10 +676,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupPolicyMetadata",
 			Handler:    _Msg_UpdateGroupPolicyMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyAutoExecution",
+			Handler:    _Msg_UpdateGroupPolicyAutoExecution_Handler,
+		},
 		{
 			MethodName: "SubmitProposal",
 			Handler:    _Msg_SubmitProposal_Handler,
 		},
+		{
+			MethodName: "SubmitParentVoteProposal",
+			Handler:    _Msg_SubmitParentVoteProposal_Handler,
+		},
 		{
 			MethodName: "WithdrawProposal",
 			Handler:    _Msg_WithdrawProposal_Handler,
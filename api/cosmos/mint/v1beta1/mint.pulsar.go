@@ -500,13 +500,17 @@ func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_mint_denom            protoreflect.FieldDescriptor
-	fd_Params_inflation_rate_change protoreflect.FieldDescriptor
-	fd_Params_inflation_max         protoreflect.FieldDescriptor
-	fd_Params_inflation_min         protoreflect.FieldDescriptor
-	fd_Params_goal_bonded           protoreflect.FieldDescriptor
-	fd_Params_blocks_per_year       protoreflect.FieldDescriptor
+	md_Params                           protoreflect.MessageDescriptor
+	fd_Params_mint_denom                protoreflect.FieldDescriptor
+	fd_Params_inflation_rate_change     protoreflect.FieldDescriptor
+	fd_Params_inflation_max             protoreflect.FieldDescriptor
+	fd_Params_inflation_min             protoreflect.FieldDescriptor
+	fd_Params_goal_bonded               protoreflect.FieldDescriptor
+	fd_Params_blocks_per_year           protoreflect.FieldDescriptor
+	fd_Params_inflation_schedule        protoreflect.FieldDescriptor
+	fd_Params_halving_schedule          protoreflect.FieldDescriptor
+	fd_Params_piecewise_linear_schedule protoreflect.FieldDescriptor
+	fd_Params_tail_emission_schedule    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -518,6 +522,10 @@ func init() {
 	fd_Params_inflation_min = md_Params.Fields().ByName("inflation_min")
 	fd_Params_goal_bonded = md_Params.Fields().ByName("goal_bonded")
 	fd_Params_blocks_per_year = md_Params.Fields().ByName("blocks_per_year")
+	fd_Params_inflation_schedule = md_Params.Fields().ByName("inflation_schedule")
+	fd_Params_halving_schedule = md_Params.Fields().ByName("halving_schedule")
+	fd_Params_piecewise_linear_schedule = md_Params.Fields().ByName("piecewise_linear_schedule")
+	fd_Params_tail_emission_schedule = md_Params.Fields().ByName("tail_emission_schedule")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -621,6 +629,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.InflationSchedule != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.InflationSchedule))
+		if !f(fd_Params_inflation_schedule, value) {
+			return
+		}
+	}
+	if x.HalvingSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.HalvingSchedule.ProtoReflect())
+		if !f(fd_Params_halving_schedule, value) {
+			return
+		}
+	}
+	if x.PiecewiseLinearSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.PiecewiseLinearSchedule.ProtoReflect())
+		if !f(fd_Params_piecewise_linear_schedule, value) {
+			return
+		}
+	}
+	if x.TailEmissionSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.TailEmissionSchedule.ProtoReflect())
+		if !f(fd_Params_tail_emission_schedule, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -648,6 +680,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.GoalBonded != ""
 	case "cosmos.mint.v1beta1.Params.blocks_per_year":
 		return x.BlocksPerYear != uint64(0)
+	case "cosmos.mint.v1beta1.Params.inflation_schedule":
+		return x.InflationSchedule != 0
+	case "cosmos.mint.v1beta1.Params.halving_schedule":
+		return x.HalvingSchedule != nil
+	case "cosmos.mint.v1beta1.Params.piecewise_linear_schedule":
+		return x.PiecewiseLinearSchedule != nil
+	case "cosmos.mint.v1beta1.Params.tail_emission_schedule":
+		return x.TailEmissionSchedule != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
@@ -676,6 +716,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.GoalBonded = ""
 	case "cosmos.mint.v1beta1.Params.blocks_per_year":
 		x.BlocksPerYear = uint64(0)
+	case "cosmos.mint.v1beta1.Params.inflation_schedule":
+		x.InflationSchedule = 0
+	case "cosmos.mint.v1beta1.Params.halving_schedule":
+		x.HalvingSchedule = nil
+	case "cosmos.mint.v1beta1.Params.piecewise_linear_schedule":
+		x.PiecewiseLinearSchedule = nil
+	case "cosmos.mint.v1beta1.Params.tail_emission_schedule":
+		x.TailEmissionSchedule = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
@@ -710,6 +758,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.mint.v1beta1.Params.blocks_per_year":
 		value := x.BlocksPerYear
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.mint.v1beta1.Params.inflation_schedule":
+		value := x.InflationSchedule
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.mint.v1beta1.Params.halving_schedule":
+		value := x.HalvingSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.mint.v1beta1.Params.piecewise_linear_schedule":
+		value := x.PiecewiseLinearSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.mint.v1beta1.Params.tail_emission_schedule":
+		value := x.TailEmissionSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
@@ -742,6 +802,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.GoalBonded = value.Interface().(string)
 	case "cosmos.mint.v1beta1.Params.blocks_per_year":
 		x.BlocksPerYear = value.Uint()
+	case "cosmos.mint.v1beta1.Params.inflation_schedule":
+		x.InflationSchedule = (InflationScheduleType)(value.Enum())
+	case "cosmos.mint.v1beta1.Params.halving_schedule":
+		x.HalvingSchedule = value.Message().Interface().(*HalvingSchedule)
+	case "cosmos.mint.v1beta1.Params.piecewise_linear_schedule":
+		x.PiecewiseLinearSchedule = value.Message().Interface().(*PiecewiseLinearSchedule)
+	case "cosmos.mint.v1beta1.Params.tail_emission_schedule":
+		x.TailEmissionSchedule = value.Message().Interface().(*TailEmissionSchedule)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
@@ -762,6 +830,21 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.Params.halving_schedule":
+		if x.HalvingSchedule == nil {
+			x.HalvingSchedule = new(HalvingSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.HalvingSchedule.ProtoReflect())
+	case "cosmos.mint.v1beta1.Params.piecewise_linear_schedule":
+		if x.PiecewiseLinearSchedule == nil {
+			x.PiecewiseLinearSchedule = new(PiecewiseLinearSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.PiecewiseLinearSchedule.ProtoReflect())
+	case "cosmos.mint.v1beta1.Params.tail_emission_schedule":
+		if x.TailEmissionSchedule == nil {
+			x.TailEmissionSchedule = new(TailEmissionSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.TailEmissionSchedule.ProtoReflect())
 	case "cosmos.mint.v1beta1.Params.mint_denom":
 		panic(fmt.Errorf("field mint_denom of message cosmos.mint.v1beta1.Params is not mutable"))
 	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
@@ -774,6 +857,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field goal_bonded of message cosmos.mint.v1beta1.Params is not mutable"))
 	case "cosmos.mint.v1beta1.Params.blocks_per_year":
 		panic(fmt.Errorf("field blocks_per_year of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.inflation_schedule":
+		panic(fmt.Errorf("field inflation_schedule of message cosmos.mint.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
@@ -799,6 +884,17 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "cosmos.mint.v1beta1.Params.blocks_per_year":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.mint.v1beta1.Params.inflation_schedule":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.mint.v1beta1.Params.halving_schedule":
+		m := new(HalvingSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.mint.v1beta1.Params.piecewise_linear_schedule":
+		m := new(PiecewiseLinearSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.mint.v1beta1.Params.tail_emission_schedule":
+		m := new(TailEmissionSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
@@ -891,6 +987,21 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.BlocksPerYear != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlocksPerYear))
 		}
+		if x.InflationSchedule != 0 {
+			n += 1 + runtime.Sov(uint64(x.InflationSchedule))
+		}
+		if x.HalvingSchedule != nil {
+			l = options.Size(x.HalvingSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PiecewiseLinearSchedule != nil {
+			l = options.Size(x.PiecewiseLinearSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TailEmissionSchedule != nil {
+			l = options.Size(x.TailEmissionSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -920,6 +1031,53 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.TailEmissionSchedule != nil {
+			encoded, err := options.Marshal(x.TailEmissionSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.PiecewiseLinearSchedule != nil {
+			encoded, err := options.Marshal(x.PiecewiseLinearSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.HalvingSchedule != nil {
+			encoded, err := options.Marshal(x.HalvingSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.InflationSchedule != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InflationSchedule))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.BlocksPerYear != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksPerYear))
 			i--
@@ -1188,6 +1346,133 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+				}
+				x.InflationSchedule = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InflationSchedule |= InflationScheduleType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HalvingSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.HalvingSchedule == nil {
+					x.HalvingSchedule = &HalvingSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HalvingSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PiecewiseLinearSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PiecewiseLinearSchedule == nil {
+					x.PiecewiseLinearSchedule = &PiecewiseLinearSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PiecewiseLinearSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TailEmissionSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TailEmissionSchedule == nil {
+					x.TailEmissionSchedule = &TailEmissionSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TailEmissionSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1223,127 +1508,2350 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/mint/v1beta1/mint.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_HalvingSchedule                           protoreflect.MessageDescriptor
+	fd_HalvingSchedule_start_height              protoreflect.FieldDescriptor
+	fd_HalvingSchedule_epoch_blocks              protoreflect.FieldDescriptor
+	fd_HalvingSchedule_initial_annual_provisions protoreflect.FieldDescriptor
+	fd_HalvingSchedule_max_supply                protoreflect.FieldDescriptor
 )
 
-// Minter represents the minting state.
-type Minter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_cosmos_mint_v1beta1_mint_proto_init()
+	md_HalvingSchedule = File_cosmos_mint_v1beta1_mint_proto.Messages().ByName("HalvingSchedule")
+	fd_HalvingSchedule_start_height = md_HalvingSchedule.Fields().ByName("start_height")
+	fd_HalvingSchedule_epoch_blocks = md_HalvingSchedule.Fields().ByName("epoch_blocks")
+	fd_HalvingSchedule_initial_annual_provisions = md_HalvingSchedule.Fields().ByName("initial_annual_provisions")
+	fd_HalvingSchedule_max_supply = md_HalvingSchedule.Fields().ByName("max_supply")
+}
 
-	// current annual inflation rate
-	Inflation string `protobuf:"bytes,1,opt,name=inflation,proto3" json:"inflation,omitempty"`
-	// current annual expected provisions
-	AnnualProvisions string `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3" json:"annual_provisions,omitempty"`
+var _ protoreflect.Message = (*fastReflection_HalvingSchedule)(nil)
+
+type fastReflection_HalvingSchedule HalvingSchedule
+
+func (x *HalvingSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_HalvingSchedule)(x)
 }
 
-func (x *Minter) Reset() {
-	*x = Minter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[0]
+func (x *HalvingSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Minter) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_HalvingSchedule_messageType fastReflection_HalvingSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_HalvingSchedule_messageType{}
 
-func (*Minter) ProtoMessage() {}
+type fastReflection_HalvingSchedule_messageType struct{}
 
-// Deprecated: Use Minter.ProtoReflect.Descriptor instead.
-func (*Minter) Descriptor() ([]byte, []int) {
-	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_HalvingSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_HalvingSchedule)(nil)
 }
-
-func (x *Minter) GetInflation() string {
-	if x != nil {
-		return x.Inflation
-	}
-	return ""
+func (x fastReflection_HalvingSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_HalvingSchedule)
 }
-
-func (x *Minter) GetAnnualProvisions() string {
-	if x != nil {
-		return x.AnnualProvisions
-	}
-	return ""
+func (x fastReflection_HalvingSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_HalvingSchedule
 }
 
-// Params defines the parameters for the x/mint module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// type of coin to mint
-	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
-	// maximum annual change in inflation rate
-	InflationRateChange string `protobuf:"bytes,2,opt,name=inflation_rate_change,json=inflationRateChange,proto3" json:"inflation_rate_change,omitempty"`
-	// maximum inflation rate
-	InflationMax string `protobuf:"bytes,3,opt,name=inflation_max,json=inflationMax,proto3" json:"inflation_max,omitempty"`
-	// minimum inflation rate
-	InflationMin string `protobuf:"bytes,4,opt,name=inflation_min,json=inflationMin,proto3" json:"inflation_min,omitempty"`
-	// goal of percent bonded atoms
-	GoalBonded string `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3" json:"goal_bonded,omitempty"`
-	// expected blocks per year
-	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_HalvingSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_HalvingSchedule
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_HalvingSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_HalvingSchedule_messageType
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_HalvingSchedule) New() protoreflect.Message {
+	return new(fastReflection_HalvingSchedule)
 }
 
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_HalvingSchedule) Interface() protoreflect.ProtoMessage {
+	return (*HalvingSchedule)(x)
 }
 
-func (x *Params) GetMintDenom() string {
-	if x != nil {
-		return x.MintDenom
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_HalvingSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_HalvingSchedule_start_height, value) {
+			return
+		}
 	}
-	return ""
-}
-
-func (x *Params) GetInflationRateChange() string {
-	if x != nil {
-		return x.InflationRateChange
+	if x.EpochBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochBlocks)
+		if !f(fd_HalvingSchedule_epoch_blocks, value) {
+			return
+		}
 	}
-	return ""
-}
-
-func (x *Params) GetInflationMax() string {
-	if x != nil {
-		return x.InflationMax
+	if x.InitialAnnualProvisions != "" {
+		value := protoreflect.ValueOfString(x.InitialAnnualProvisions)
+		if !f(fd_HalvingSchedule_initial_annual_provisions, value) {
+			return
+		}
+	}
+	if x.MaxSupply != "" {
+		value := protoreflect.ValueOfString(x.MaxSupply)
+		if !f(fd_HalvingSchedule_max_supply, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *Params) GetInflationMin() string {
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_HalvingSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.HalvingSchedule.start_height":
+		return x.StartHeight != int64(0)
+	case "cosmos.mint.v1beta1.HalvingSchedule.epoch_blocks":
+		return x.EpochBlocks != uint64(0)
+	case "cosmos.mint.v1beta1.HalvingSchedule.initial_annual_provisions":
+		return x.InitialAnnualProvisions != ""
+	case "cosmos.mint.v1beta1.HalvingSchedule.max_supply":
+		return x.MaxSupply != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.HalvingSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.HalvingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HalvingSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.HalvingSchedule.start_height":
+		x.StartHeight = int64(0)
+	case "cosmos.mint.v1beta1.HalvingSchedule.epoch_blocks":
+		x.EpochBlocks = uint64(0)
+	case "cosmos.mint.v1beta1.HalvingSchedule.initial_annual_provisions":
+		x.InitialAnnualProvisions = ""
+	case "cosmos.mint.v1beta1.HalvingSchedule.max_supply":
+		x.MaxSupply = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.HalvingSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.HalvingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_HalvingSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.mint.v1beta1.HalvingSchedule.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.mint.v1beta1.HalvingSchedule.epoch_blocks":
+		value := x.EpochBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.mint.v1beta1.HalvingSchedule.initial_annual_provisions":
+		value := x.InitialAnnualProvisions
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.HalvingSchedule.max_supply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.HalvingSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.HalvingSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HalvingSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.HalvingSchedule.start_height":
+		x.StartHeight = value.Int()
+	case "cosmos.mint.v1beta1.HalvingSchedule.epoch_blocks":
+		x.EpochBlocks = value.Uint()
+	case "cosmos.mint.v1beta1.HalvingSchedule.initial_annual_provisions":
+		x.InitialAnnualProvisions = value.Interface().(string)
+	case "cosmos.mint.v1beta1.HalvingSchedule.max_supply":
+		x.MaxSupply = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.HalvingSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.HalvingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HalvingSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.HalvingSchedule.start_height":
+		panic(fmt.Errorf("field start_height of message cosmos.mint.v1beta1.HalvingSchedule is not mutable"))
+	case "cosmos.mint.v1beta1.HalvingSchedule.epoch_blocks":
+		panic(fmt.Errorf("field epoch_blocks of message cosmos.mint.v1beta1.HalvingSchedule is not mutable"))
+	case "cosmos.mint.v1beta1.HalvingSchedule.initial_annual_provisions":
+		panic(fmt.Errorf("field initial_annual_provisions of message cosmos.mint.v1beta1.HalvingSchedule is not mutable"))
+	case "cosmos.mint.v1beta1.HalvingSchedule.max_supply":
+		panic(fmt.Errorf("field max_supply of message cosmos.mint.v1beta1.HalvingSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.HalvingSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.HalvingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_HalvingSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.HalvingSchedule.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.mint.v1beta1.HalvingSchedule.epoch_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.mint.v1beta1.HalvingSchedule.initial_annual_provisions":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.HalvingSchedule.max_supply":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.HalvingSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.HalvingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_HalvingSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.mint.v1beta1.HalvingSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_HalvingSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HalvingSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_HalvingSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_HalvingSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*HalvingSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.EpochBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochBlocks))
+		}
+		l = len(x.InitialAnnualProvisions)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*HalvingSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxSupply) > 0 {
+			i -= len(x.MaxSupply)
+			copy(dAtA[i:], x.MaxSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.InitialAnnualProvisions) > 0 {
+			i -= len(x.InitialAnnualProvisions)
+			copy(dAtA[i:], x.InitialAnnualProvisions)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InitialAnnualProvisions)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EpochBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*HalvingSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HalvingSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HalvingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+				}
+				x.EpochBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialAnnualProvisions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InitialAnnualProvisions = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InflationPoint           protoreflect.MessageDescriptor
+	fd_InflationPoint_height    protoreflect.FieldDescriptor
+	fd_InflationPoint_inflation protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_mint_v1beta1_mint_proto_init()
+	md_InflationPoint = File_cosmos_mint_v1beta1_mint_proto.Messages().ByName("InflationPoint")
+	fd_InflationPoint_height = md_InflationPoint.Fields().ByName("height")
+	fd_InflationPoint_inflation = md_InflationPoint.Fields().ByName("inflation")
+}
+
+var _ protoreflect.Message = (*fastReflection_InflationPoint)(nil)
+
+type fastReflection_InflationPoint InflationPoint
+
+func (x *InflationPoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationPoint)(x)
+}
+
+func (x *InflationPoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InflationPoint_messageType fastReflection_InflationPoint_messageType
+var _ protoreflect.MessageType = fastReflection_InflationPoint_messageType{}
+
+type fastReflection_InflationPoint_messageType struct{}
+
+func (x fastReflection_InflationPoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationPoint)(nil)
+}
+func (x fastReflection_InflationPoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationPoint)
+}
+func (x fastReflection_InflationPoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationPoint
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InflationPoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationPoint
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InflationPoint) Type() protoreflect.MessageType {
+	return _fastReflection_InflationPoint_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InflationPoint) New() protoreflect.Message {
+	return new(fastReflection_InflationPoint)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InflationPoint) Interface() protoreflect.ProtoMessage {
+	return (*InflationPoint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InflationPoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_InflationPoint_height, value) {
+			return
+		}
+	}
+	if x.Inflation != "" {
+		value := protoreflect.ValueOfString(x.Inflation)
+		if !f(fd_InflationPoint_inflation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InflationPoint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.InflationPoint.height":
+		return x.Height != int64(0)
+	case "cosmos.mint.v1beta1.InflationPoint.inflation":
+		return x.Inflation != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.InflationPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.InflationPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPoint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.InflationPoint.height":
+		x.Height = int64(0)
+	case "cosmos.mint.v1beta1.InflationPoint.inflation":
+		x.Inflation = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.InflationPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.InflationPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InflationPoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.mint.v1beta1.InflationPoint.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.mint.v1beta1.InflationPoint.inflation":
+		value := x.Inflation
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.InflationPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.InflationPoint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.InflationPoint.height":
+		x.Height = value.Int()
+	case "cosmos.mint.v1beta1.InflationPoint.inflation":
+		x.Inflation = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.InflationPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.InflationPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.InflationPoint.height":
+		panic(fmt.Errorf("field height of message cosmos.mint.v1beta1.InflationPoint is not mutable"))
+	case "cosmos.mint.v1beta1.InflationPoint.inflation":
+		panic(fmt.Errorf("field inflation of message cosmos.mint.v1beta1.InflationPoint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.InflationPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.InflationPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InflationPoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.InflationPoint.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.mint.v1beta1.InflationPoint.inflation":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.InflationPoint"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.InflationPoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InflationPoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.mint.v1beta1.InflationPoint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InflationPoint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationPoint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InflationPoint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InflationPoint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InflationPoint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Inflation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InflationPoint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Inflation) > 0 {
+			i -= len(x.Inflation)
+			copy(dAtA[i:], x.Inflation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Inflation)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InflationPoint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationPoint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Inflation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PiecewiseLinearSchedule_1_list)(nil)
+
+type _PiecewiseLinearSchedule_1_list struct {
+	list *[]*InflationPoint
+}
+
+func (x *_PiecewiseLinearSchedule_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PiecewiseLinearSchedule_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PiecewiseLinearSchedule_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationPoint)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PiecewiseLinearSchedule_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationPoint)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PiecewiseLinearSchedule_1_list) AppendMutable() protoreflect.Value {
+	v := new(InflationPoint)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PiecewiseLinearSchedule_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PiecewiseLinearSchedule_1_list) NewElement() protoreflect.Value {
+	v := new(InflationPoint)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PiecewiseLinearSchedule_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PiecewiseLinearSchedule        protoreflect.MessageDescriptor
+	fd_PiecewiseLinearSchedule_points protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_mint_v1beta1_mint_proto_init()
+	md_PiecewiseLinearSchedule = File_cosmos_mint_v1beta1_mint_proto.Messages().ByName("PiecewiseLinearSchedule")
+	fd_PiecewiseLinearSchedule_points = md_PiecewiseLinearSchedule.Fields().ByName("points")
+}
+
+var _ protoreflect.Message = (*fastReflection_PiecewiseLinearSchedule)(nil)
+
+type fastReflection_PiecewiseLinearSchedule PiecewiseLinearSchedule
+
+func (x *PiecewiseLinearSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PiecewiseLinearSchedule)(x)
+}
+
+func (x *PiecewiseLinearSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PiecewiseLinearSchedule_messageType fastReflection_PiecewiseLinearSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_PiecewiseLinearSchedule_messageType{}
+
+type fastReflection_PiecewiseLinearSchedule_messageType struct{}
+
+func (x fastReflection_PiecewiseLinearSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PiecewiseLinearSchedule)(nil)
+}
+func (x fastReflection_PiecewiseLinearSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_PiecewiseLinearSchedule)
+}
+func (x fastReflection_PiecewiseLinearSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PiecewiseLinearSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PiecewiseLinearSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_PiecewiseLinearSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PiecewiseLinearSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_PiecewiseLinearSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PiecewiseLinearSchedule) New() protoreflect.Message {
+	return new(fastReflection_PiecewiseLinearSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PiecewiseLinearSchedule) Interface() protoreflect.ProtoMessage {
+	return (*PiecewiseLinearSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PiecewiseLinearSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Points) != 0 {
+		value := protoreflect.ValueOfList(&_PiecewiseLinearSchedule_1_list{list: &x.Points})
+		if !f(fd_PiecewiseLinearSchedule_points, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PiecewiseLinearSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.PiecewiseLinearSchedule.points":
+		return len(x.Points) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.PiecewiseLinearSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.PiecewiseLinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PiecewiseLinearSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.PiecewiseLinearSchedule.points":
+		x.Points = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.PiecewiseLinearSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.PiecewiseLinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PiecewiseLinearSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.mint.v1beta1.PiecewiseLinearSchedule.points":
+		if len(x.Points) == 0 {
+			return protoreflect.ValueOfList(&_PiecewiseLinearSchedule_1_list{})
+		}
+		listValue := &_PiecewiseLinearSchedule_1_list{list: &x.Points}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.PiecewiseLinearSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.PiecewiseLinearSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PiecewiseLinearSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.PiecewiseLinearSchedule.points":
+		lv := value.List()
+		clv := lv.(*_PiecewiseLinearSchedule_1_list)
+		x.Points = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.PiecewiseLinearSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.PiecewiseLinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PiecewiseLinearSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.PiecewiseLinearSchedule.points":
+		if x.Points == nil {
+			x.Points = []*InflationPoint{}
+		}
+		value := &_PiecewiseLinearSchedule_1_list{list: &x.Points}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.PiecewiseLinearSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.PiecewiseLinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PiecewiseLinearSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.PiecewiseLinearSchedule.points":
+		list := []*InflationPoint{}
+		return protoreflect.ValueOfList(&_PiecewiseLinearSchedule_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.PiecewiseLinearSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.PiecewiseLinearSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PiecewiseLinearSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.mint.v1beta1.PiecewiseLinearSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PiecewiseLinearSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PiecewiseLinearSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PiecewiseLinearSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PiecewiseLinearSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PiecewiseLinearSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Points) > 0 {
+			for _, e := range x.Points {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PiecewiseLinearSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Points) > 0 {
+			for iNdEx := len(x.Points) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Points[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PiecewiseLinearSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PiecewiseLinearSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PiecewiseLinearSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Points = append(x.Points, &InflationPoint{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Points[len(x.Points)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TailEmissionSchedule                   protoreflect.MessageDescriptor
+	fd_TailEmissionSchedule_start_height      protoreflect.FieldDescriptor
+	fd_TailEmissionSchedule_initial_inflation protoreflect.FieldDescriptor
+	fd_TailEmissionSchedule_annual_reduction  protoreflect.FieldDescriptor
+	fd_TailEmissionSchedule_tail_inflation    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_mint_v1beta1_mint_proto_init()
+	md_TailEmissionSchedule = File_cosmos_mint_v1beta1_mint_proto.Messages().ByName("TailEmissionSchedule")
+	fd_TailEmissionSchedule_start_height = md_TailEmissionSchedule.Fields().ByName("start_height")
+	fd_TailEmissionSchedule_initial_inflation = md_TailEmissionSchedule.Fields().ByName("initial_inflation")
+	fd_TailEmissionSchedule_annual_reduction = md_TailEmissionSchedule.Fields().ByName("annual_reduction")
+	fd_TailEmissionSchedule_tail_inflation = md_TailEmissionSchedule.Fields().ByName("tail_inflation")
+}
+
+var _ protoreflect.Message = (*fastReflection_TailEmissionSchedule)(nil)
+
+type fastReflection_TailEmissionSchedule TailEmissionSchedule
+
+func (x *TailEmissionSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TailEmissionSchedule)(x)
+}
+
+func (x *TailEmissionSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TailEmissionSchedule_messageType fastReflection_TailEmissionSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_TailEmissionSchedule_messageType{}
+
+type fastReflection_TailEmissionSchedule_messageType struct{}
+
+func (x fastReflection_TailEmissionSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TailEmissionSchedule)(nil)
+}
+func (x fastReflection_TailEmissionSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_TailEmissionSchedule)
+}
+func (x fastReflection_TailEmissionSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TailEmissionSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TailEmissionSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_TailEmissionSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TailEmissionSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_TailEmissionSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TailEmissionSchedule) New() protoreflect.Message {
+	return new(fastReflection_TailEmissionSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TailEmissionSchedule) Interface() protoreflect.ProtoMessage {
+	return (*TailEmissionSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TailEmissionSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_TailEmissionSchedule_start_height, value) {
+			return
+		}
+	}
+	if x.InitialInflation != "" {
+		value := protoreflect.ValueOfString(x.InitialInflation)
+		if !f(fd_TailEmissionSchedule_initial_inflation, value) {
+			return
+		}
+	}
+	if x.AnnualReduction != "" {
+		value := protoreflect.ValueOfString(x.AnnualReduction)
+		if !f(fd_TailEmissionSchedule_annual_reduction, value) {
+			return
+		}
+	}
+	if x.TailInflation != "" {
+		value := protoreflect.ValueOfString(x.TailInflation)
+		if !f(fd_TailEmissionSchedule_tail_inflation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TailEmissionSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.start_height":
+		return x.StartHeight != int64(0)
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.initial_inflation":
+		return x.InitialInflation != ""
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.annual_reduction":
+		return x.AnnualReduction != ""
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.tail_inflation":
+		return x.TailInflation != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.TailEmissionSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.TailEmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TailEmissionSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.start_height":
+		x.StartHeight = int64(0)
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.initial_inflation":
+		x.InitialInflation = ""
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.annual_reduction":
+		x.AnnualReduction = ""
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.tail_inflation":
+		x.TailInflation = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.TailEmissionSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.TailEmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TailEmissionSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.initial_inflation":
+		value := x.InitialInflation
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.annual_reduction":
+		value := x.AnnualReduction
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.tail_inflation":
+		value := x.TailInflation
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.TailEmissionSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.TailEmissionSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TailEmissionSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.start_height":
+		x.StartHeight = value.Int()
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.initial_inflation":
+		x.InitialInflation = value.Interface().(string)
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.annual_reduction":
+		x.AnnualReduction = value.Interface().(string)
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.tail_inflation":
+		x.TailInflation = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.TailEmissionSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.TailEmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TailEmissionSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.start_height":
+		panic(fmt.Errorf("field start_height of message cosmos.mint.v1beta1.TailEmissionSchedule is not mutable"))
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.initial_inflation":
+		panic(fmt.Errorf("field initial_inflation of message cosmos.mint.v1beta1.TailEmissionSchedule is not mutable"))
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.annual_reduction":
+		panic(fmt.Errorf("field annual_reduction of message cosmos.mint.v1beta1.TailEmissionSchedule is not mutable"))
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.tail_inflation":
+		panic(fmt.Errorf("field tail_inflation of message cosmos.mint.v1beta1.TailEmissionSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.TailEmissionSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.TailEmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TailEmissionSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.initial_inflation":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.annual_reduction":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.TailEmissionSchedule.tail_inflation":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.TailEmissionSchedule"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.TailEmissionSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TailEmissionSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.mint.v1beta1.TailEmissionSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TailEmissionSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TailEmissionSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TailEmissionSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TailEmissionSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TailEmissionSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		l = len(x.InitialInflation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AnnualReduction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TailInflation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TailEmissionSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TailInflation) > 0 {
+			i -= len(x.TailInflation)
+			copy(dAtA[i:], x.TailInflation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TailInflation)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.AnnualReduction) > 0 {
+			i -= len(x.AnnualReduction)
+			copy(dAtA[i:], x.AnnualReduction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnualReduction)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.InitialInflation) > 0 {
+			i -= len(x.InitialInflation)
+			copy(dAtA[i:], x.InitialInflation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InitialInflation)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TailEmissionSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TailEmissionSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TailEmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialInflation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InitialInflation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnualReduction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AnnualReduction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TailInflation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TailInflation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/mint/v1beta1/mint.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InflationScheduleType defines the schedule of the inflation of the mint
+// denom.
+//
+// Since: cosmos-sdk 0.48
+type InflationScheduleType int32
+
+const (
+	// UNSPECIFIED defines an inflation rate adjusted towards the goal bonded
+	// ratio by the InflationCalculationFn of the module.
+	InflationScheduleType_INFLATION_SCHEDULE_TYPE_UNSPECIFIED InflationScheduleType = 0
+	// HALVING defines fixed annual provisions halved every epoch, up to a cap on
+	// the supply of the mint denom.
+	InflationScheduleType_INFLATION_SCHEDULE_TYPE_HALVING InflationScheduleType = 1
+	// PIECEWISE_LINEAR defines an inflation rate interpolated linearly between
+	// target inflation rates.
+	InflationScheduleType_INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR InflationScheduleType = 2
+	// TAIL_EMISSION defines an inflation rate decreasing every year down to a
+	// tail inflation rate.
+	InflationScheduleType_INFLATION_SCHEDULE_TYPE_TAIL_EMISSION InflationScheduleType = 3
+)
+
+// Enum value maps for InflationScheduleType.
+var (
+	InflationScheduleType_name = map[int32]string{
+		0: "INFLATION_SCHEDULE_TYPE_UNSPECIFIED",
+		1: "INFLATION_SCHEDULE_TYPE_HALVING",
+		2: "INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR",
+		3: "INFLATION_SCHEDULE_TYPE_TAIL_EMISSION",
+	}
+	InflationScheduleType_value = map[string]int32{
+		"INFLATION_SCHEDULE_TYPE_UNSPECIFIED":      0,
+		"INFLATION_SCHEDULE_TYPE_HALVING":          1,
+		"INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR": 2,
+		"INFLATION_SCHEDULE_TYPE_TAIL_EMISSION":    3,
+	}
+)
+
+func (x InflationScheduleType) Enum() *InflationScheduleType {
+	p := new(InflationScheduleType)
+	*p = x
+	return p
+}
+
+func (x InflationScheduleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InflationScheduleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_mint_v1beta1_mint_proto_enumTypes[0].Descriptor()
+}
+
+func (InflationScheduleType) Type() protoreflect.EnumType {
+	return &file_cosmos_mint_v1beta1_mint_proto_enumTypes[0]
+}
+
+func (x InflationScheduleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InflationScheduleType.Descriptor instead.
+func (InflationScheduleType) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{0}
+}
+
+// Minter represents the minting state.
+type Minter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// current annual inflation rate
+	Inflation string `protobuf:"bytes,1,opt,name=inflation,proto3" json:"inflation,omitempty"`
+	// current annual expected provisions
+	AnnualProvisions string `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3" json:"annual_provisions,omitempty"`
+}
+
+func (x *Minter) Reset() {
+	*x = Minter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Minter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Minter) ProtoMessage() {}
+
+// Deprecated: Use Minter.ProtoReflect.Descriptor instead.
+func (*Minter) Descriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Minter) GetInflation() string {
+	if x != nil {
+		return x.Inflation
+	}
+	return ""
+}
+
+func (x *Minter) GetAnnualProvisions() string {
+	if x != nil {
+		return x.AnnualProvisions
+	}
+	return ""
+}
+
+// Params defines the parameters for the x/mint module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type of coin to mint
+	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	// maximum annual change in inflation rate
+	InflationRateChange string `protobuf:"bytes,2,opt,name=inflation_rate_change,json=inflationRateChange,proto3" json:"inflation_rate_change,omitempty"`
+	// maximum inflation rate
+	InflationMax string `protobuf:"bytes,3,opt,name=inflation_max,json=inflationMax,proto3" json:"inflation_max,omitempty"`
+	// minimum inflation rate
+	InflationMin string `protobuf:"bytes,4,opt,name=inflation_min,json=inflationMin,proto3" json:"inflation_min,omitempty"`
+	// goal of percent bonded atoms
+	GoalBonded string `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3" json:"goal_bonded,omitempty"`
+	// expected blocks per year
+	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// inflation_schedule defines the schedule of the inflation of the mint denom.
+	//
+	// Since: cosmos-sdk 0.48
+	InflationSchedule InflationScheduleType `protobuf:"varint,7,opt,name=inflation_schedule,json=inflationSchedule,proto3,enum=cosmos.mint.v1beta1.InflationScheduleType" json:"inflation_schedule,omitempty"`
+	// halving_schedule defines the parameters of the halving schedule. It must
+	// be set if and only if the inflation schedule is the halving schedule.
+	//
+	// Since: cosmos-sdk 0.48
+	HalvingSchedule *HalvingSchedule `protobuf:"bytes,8,opt,name=halving_schedule,json=halvingSchedule,proto3" json:"halving_schedule,omitempty"`
+	// piecewise_linear_schedule defines the parameters of the piecewise-linear
+	// schedule. It must be set if and only if the inflation schedule is the
+	// piecewise-linear schedule.
+	//
+	// Since: cosmos-sdk 0.48
+	PiecewiseLinearSchedule *PiecewiseLinearSchedule `protobuf:"bytes,9,opt,name=piecewise_linear_schedule,json=piecewiseLinearSchedule,proto3" json:"piecewise_linear_schedule,omitempty"`
+	// tail_emission_schedule defines the parameters of the tail emission
+	// schedule. It must be set if and only if the inflation schedule is the tail
+	// emission schedule.
+	//
+	// Since: cosmos-sdk 0.48
+	TailEmissionSchedule *TailEmissionSchedule `protobuf:"bytes,10,opt,name=tail_emission_schedule,json=tailEmissionSchedule,proto3" json:"tail_emission_schedule,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Params) GetMintDenom() string {
+	if x != nil {
+		return x.MintDenom
+	}
+	return ""
+}
+
+func (x *Params) GetInflationRateChange() string {
+	if x != nil {
+		return x.InflationRateChange
+	}
+	return ""
+}
+
+func (x *Params) GetInflationMax() string {
+	if x != nil {
+		return x.InflationMax
+	}
+	return ""
+}
+
+func (x *Params) GetInflationMin() string {
 	if x != nil {
 		return x.InflationMin
 	}
@@ -1364,6 +3872,258 @@ func (x *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (x *Params) GetInflationSchedule() InflationScheduleType {
+	if x != nil {
+		return x.InflationSchedule
+	}
+	return InflationScheduleType_INFLATION_SCHEDULE_TYPE_UNSPECIFIED
+}
+
+func (x *Params) GetHalvingSchedule() *HalvingSchedule {
+	if x != nil {
+		return x.HalvingSchedule
+	}
+	return nil
+}
+
+func (x *Params) GetPiecewiseLinearSchedule() *PiecewiseLinearSchedule {
+	if x != nil {
+		return x.PiecewiseLinearSchedule
+	}
+	return nil
+}
+
+func (x *Params) GetTailEmissionSchedule() *TailEmissionSchedule {
+	if x != nil {
+		return x.TailEmissionSchedule
+	}
+	return nil
+}
+
+// HalvingSchedule defines an inflation schedule minting fixed annual
+// provisions, halved at the end of every epoch, until the supply of the mint
+// denom reaches a cap.
+//
+// Since: cosmos-sdk 0.48
+type HalvingSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_height is the height at which the first epoch starts.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// epoch_blocks is the number of blocks of an epoch.
+	EpochBlocks uint64 `protobuf:"varint,2,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	// initial_annual_provisions is the annual provisions of the first epoch.
+	InitialAnnualProvisions string `protobuf:"bytes,3,opt,name=initial_annual_provisions,json=initialAnnualProvisions,proto3" json:"initial_annual_provisions,omitempty"`
+	// max_supply is the cap on the supply of the mint denom.
+	MaxSupply string `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+}
+
+func (x *HalvingSchedule) Reset() {
+	*x = HalvingSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HalvingSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HalvingSchedule) ProtoMessage() {}
+
+// Deprecated: Use HalvingSchedule.ProtoReflect.Descriptor instead.
+func (*HalvingSchedule) Descriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HalvingSchedule) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *HalvingSchedule) GetEpochBlocks() uint64 {
+	if x != nil {
+		return x.EpochBlocks
+	}
+	return 0
+}
+
+func (x *HalvingSchedule) GetInitialAnnualProvisions() string {
+	if x != nil {
+		return x.InitialAnnualProvisions
+	}
+	return ""
+}
+
+func (x *HalvingSchedule) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+// InflationPoint defines a target inflation rate at a height.
+//
+// Since: cosmos-sdk 0.48
+type InflationPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Inflation string `protobuf:"bytes,2,opt,name=inflation,proto3" json:"inflation,omitempty"`
+}
+
+func (x *InflationPoint) Reset() {
+	*x = InflationPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationPoint) ProtoMessage() {}
+
+// Deprecated: Use InflationPoint.ProtoReflect.Descriptor instead.
+func (*InflationPoint) Descriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InflationPoint) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *InflationPoint) GetInflation() string {
+	if x != nil {
+		return x.Inflation
+	}
+	return ""
+}
+
+// PiecewiseLinearSchedule defines an inflation rate interpolated linearly
+// between target inflation rates at increasing heights. The inflation rate is
+// the one of the first point before it, and the one of the last point after
+// it.
+//
+// Since: cosmos-sdk 0.48
+type PiecewiseLinearSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*InflationPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *PiecewiseLinearSchedule) Reset() {
+	*x = PiecewiseLinearSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PiecewiseLinearSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PiecewiseLinearSchedule) ProtoMessage() {}
+
+// Deprecated: Use PiecewiseLinearSchedule.ProtoReflect.Descriptor instead.
+func (*PiecewiseLinearSchedule) Descriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PiecewiseLinearSchedule) GetPoints() []*InflationPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// TailEmissionSchedule defines an inflation rate decreasing by a constant
+// fraction every year, down to a constant tail inflation rate.
+//
+// Since: cosmos-sdk 0.48
+type TailEmissionSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_height is the height at which the first year starts.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// initial_inflation is the inflation rate of the first year.
+	InitialInflation string `protobuf:"bytes,2,opt,name=initial_inflation,json=initialInflation,proto3" json:"initial_inflation,omitempty"`
+	// annual_reduction is the fraction by which the inflation rate decreases
+	// every year.
+	AnnualReduction string `protobuf:"bytes,3,opt,name=annual_reduction,json=annualReduction,proto3" json:"annual_reduction,omitempty"`
+	// tail_inflation is the inflation rate below which the inflation rate does
+	// not decrease.
+	TailInflation string `protobuf:"bytes,4,opt,name=tail_inflation,json=tailInflation,proto3" json:"tail_inflation,omitempty"`
+}
+
+func (x *TailEmissionSchedule) Reset() {
+	*x = TailEmissionSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TailEmissionSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TailEmissionSchedule) ProtoMessage() {}
+
+// Deprecated: Use TailEmissionSchedule.ProtoReflect.Descriptor instead.
+func (*TailEmissionSchedule) Descriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TailEmissionSchedule) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *TailEmissionSchedule) GetInitialInflation() string {
+	if x != nil {
+		return x.InitialInflation
+	}
+	return ""
+}
+
+func (x *TailEmissionSchedule) GetAnnualReduction() string {
+	if x != nil {
+		return x.AnnualReduction
+	}
+	return ""
+}
+
+func (x *TailEmissionSchedule) GetTailInflation() string {
+	if x != nil {
+		return x.TailInflation
+	}
+	return ""
+}
+
 var File_cosmos_mint_v1beta1_mint_proto protoreflect.FileDescriptor
 
 var file_cosmos_mint_v1beta1_mint_proto_rawDesc = []byte{
@@ -1387,7 +4147,7 @@ var file_cosmos_mint_v1beta1_mint_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
 	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x10, 0x61, 0x6e, 0x6e, 0x75, 0x61,
-	0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x99, 0x04, 0x0a, 0x06,
+	0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x07, 0x0a, 0x06,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64,
 	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74,
 	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x75, 0x0a, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
@@ -1419,22 +4179,124 @@ var file_cosmos_mint_v1beta1_mint_proto_rawDesc = []byte{
 	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64,
 	0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x79,
 	0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x50, 0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74,
-	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
+	0x73, 0x50, 0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x12, 0x59, 0x0a, 0x12, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x11, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x42, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02,
-	0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4d, 0x69,
-	0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
-	0x3a, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x31, 0x2e, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x0f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73,
+	0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x69,
+	0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x17, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65,
+	0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x5f,
+	0x0a, 0x16, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x14, 0x74, 0x61, 0x69, 0x6c, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x3a,
+	0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xa7,
+	0x02, 0x0a, 0x0f, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x7d, 0x0a, 0x19, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x5f, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0x89, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x5f, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x17, 0x50, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73,
+	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x46, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x81, 0x03, 0x0a, 0x14, 0x54, 0x61, 0x69, 0x6c,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x6e, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x10, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0f, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x68, 0x0a, 0x0e, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x74, 0x61,
+	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0xcd, 0x02, 0x0a, 0x15,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x23, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x20,
+	0x8a, 0x9d, 0x20, 0x1c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x12, 0x41, 0x0a, 0x1f, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43,
+	0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x41, 0x4c, 0x56,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x49, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x48, 0x61, 0x6c, 0x76,
+	0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a, 0x28, 0x49, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x49, 0x45, 0x43, 0x45, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10,
+	0x02, 0x1a, 0x24, 0x8a, 0x9d, 0x20, 0x20, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73,
+	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x12, 0x4c, 0x0a, 0x25, 0x49, 0x4e, 0x46, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x45, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x03, 0x1a, 0x21, 0x8a, 0x9d, 0x20, 0x1d, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x69, 0x6c, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xc4, 0x01, 0x0a, 0x17,
+	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x69,
+	0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4d, 0x69, 0x6e, 0x74,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5c, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1449,17 +4311,28 @@ func file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP() []byte {
 	return file_cosmos_mint_v1beta1_mint_proto_rawDescData
 }
 
-var file_cosmos_mint_v1beta1_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_mint_v1beta1_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cosmos_mint_v1beta1_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_cosmos_mint_v1beta1_mint_proto_goTypes = []interface{}{
-	(*Minter)(nil), // 0: cosmos.mint.v1beta1.Minter
-	(*Params)(nil), // 1: cosmos.mint.v1beta1.Params
+	(InflationScheduleType)(0),      // 0: cosmos.mint.v1beta1.InflationScheduleType
+	(*Minter)(nil),                  // 1: cosmos.mint.v1beta1.Minter
+	(*Params)(nil),                  // 2: cosmos.mint.v1beta1.Params
+	(*HalvingSchedule)(nil),         // 3: cosmos.mint.v1beta1.HalvingSchedule
+	(*InflationPoint)(nil),          // 4: cosmos.mint.v1beta1.InflationPoint
+	(*PiecewiseLinearSchedule)(nil), // 5: cosmos.mint.v1beta1.PiecewiseLinearSchedule
+	(*TailEmissionSchedule)(nil),    // 6: cosmos.mint.v1beta1.TailEmissionSchedule
 }
 var file_cosmos_mint_v1beta1_mint_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: cosmos.mint.v1beta1.Params.inflation_schedule:type_name -> cosmos.mint.v1beta1.InflationScheduleType
+	3, // 1: cosmos.mint.v1beta1.Params.halving_schedule:type_name -> cosmos.mint.v1beta1.HalvingSchedule
+	5, // 2: cosmos.mint.v1beta1.Params.piecewise_linear_schedule:type_name -> cosmos.mint.v1beta1.PiecewiseLinearSchedule
+	6, // 3: cosmos.mint.v1beta1.Params.tail_emission_schedule:type_name -> cosmos.mint.v1beta1.TailEmissionSchedule
+	4, // 4: cosmos.mint.v1beta1.PiecewiseLinearSchedule.points:type_name -> cosmos.mint.v1beta1.InflationPoint
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_mint_v1beta1_mint_proto_init() }
@@ -1492,19 +4365,68 @@ func file_cosmos_mint_v1beta1_mint_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_mint_v1beta1_mint_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HalvingSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_mint_v1beta1_mint_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_mint_v1beta1_mint_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PiecewiseLinearSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_mint_v1beta1_mint_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TailEmissionSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_mint_v1beta1_mint_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cosmos_mint_v1beta1_mint_proto_goTypes,
 		DependencyIndexes: file_cosmos_mint_v1beta1_mint_proto_depIdxs,
+		EnumInfos:         file_cosmos_mint_v1beta1_mint_proto_enumTypes,
 		MessageInfos:      file_cosmos_mint_v1beta1_mint_proto_msgTypes,
 	}.Build()
 	File_cosmos_mint_v1beta1_mint_proto = out.File
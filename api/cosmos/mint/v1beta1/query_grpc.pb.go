@@ -22,6 +22,7 @@ const (
 	Query_Params_FullMethodName           = "/cosmos.mint.v1beta1.Query/Params"
 	Query_Inflation_FullMethodName        = "/cosmos.mint.v1beta1.Query/Inflation"
 	Query_AnnualProvisions_FullMethodName = "/cosmos.mint.v1beta1.Query/AnnualProvisions"
+	Query_ProjectedSupply_FullMethodName  = "/cosmos.mint.v1beta1.Query/ProjectedSupply"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,11 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedSupply returns the projected supply of the mint denom over time
+	// under the current parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +77,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error) {
+	out := new(QueryProjectedSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +96,11 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedSupply returns the projected supply of the mint denom over time
+	// under the current parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	ProjectedSupply(context.Context, *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +117,9 @@ func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationReques
 func (UnimplementedQueryServer) AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (UnimplementedQueryServer) ProjectedSupply(context.Context, *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedSupply not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +187,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedSupply(ctx, req.(*QueryProjectedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +224,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "ProjectedSupply",
+			Handler:    _Query_ProjectedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
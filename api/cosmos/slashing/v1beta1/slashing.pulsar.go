@@ -25,6 +25,7 @@ var (
 	fd_ValidatorSigningInfo_jailed_until          protoreflect.FieldDescriptor
 	fd_ValidatorSigningInfo_tombstoned            protoreflect.FieldDescriptor
 	fd_ValidatorSigningInfo_missed_blocks_counter protoreflect.FieldDescriptor
+	fd_ValidatorSigningInfo_downtime_jail_count   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -36,6 +37,7 @@ func init() {
 	fd_ValidatorSigningInfo_jailed_until = md_ValidatorSigningInfo.Fields().ByName("jailed_until")
 	fd_ValidatorSigningInfo_tombstoned = md_ValidatorSigningInfo.Fields().ByName("tombstoned")
 	fd_ValidatorSigningInfo_missed_blocks_counter = md_ValidatorSigningInfo.Fields().ByName("missed_blocks_counter")
+	fd_ValidatorSigningInfo_downtime_jail_count = md_ValidatorSigningInfo.Fields().ByName("downtime_jail_count")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValidatorSigningInfo)(nil)
@@ -139,6 +141,12 @@ func (x *fastReflection_ValidatorSigningInfo) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.DowntimeJailCount != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DowntimeJailCount)
+		if !f(fd_ValidatorSigningInfo_downtime_jail_count, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -166,6 +174,8 @@ func (x *fastReflection_ValidatorSigningInfo) Has(fd protoreflect.FieldDescripto
 		return x.Tombstoned != false
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		return x.MissedBlocksCounter != int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_jail_count":
+		return x.DowntimeJailCount != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -194,6 +204,8 @@ func (x *fastReflection_ValidatorSigningInfo) Clear(fd protoreflect.FieldDescrip
 		x.Tombstoned = false
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		x.MissedBlocksCounter = int64(0)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_jail_count":
+		x.DowntimeJailCount = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -228,6 +240,9 @@ func (x *fastReflection_ValidatorSigningInfo) Get(descriptor protoreflect.FieldD
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		value := x.MissedBlocksCounter
 		return protoreflect.ValueOfInt64(value)
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_jail_count":
+		value := x.DowntimeJailCount
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -260,6 +275,8 @@ func (x *fastReflection_ValidatorSigningInfo) Set(fd protoreflect.FieldDescripto
 		x.Tombstoned = value.Bool()
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		x.MissedBlocksCounter = value.Int()
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_jail_count":
+		x.DowntimeJailCount = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -295,6 +312,8 @@ func (x *fastReflection_ValidatorSigningInfo) Mutable(fd protoreflect.FieldDescr
 		panic(fmt.Errorf("field tombstoned of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		panic(fmt.Errorf("field missed_blocks_counter of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_jail_count":
+		panic(fmt.Errorf("field downtime_jail_count of message cosmos.slashing.v1beta1.ValidatorSigningInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -321,6 +340,8 @@ func (x *fastReflection_ValidatorSigningInfo) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfBool(false)
 	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.missed_blocks_counter":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.slashing.v1beta1.ValidatorSigningInfo.downtime_jail_count":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorSigningInfo"))
@@ -410,6 +431,9 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 		if x.MissedBlocksCounter != 0 {
 			n += 1 + runtime.Sov(uint64(x.MissedBlocksCounter))
 		}
+		if x.DowntimeJailCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.DowntimeJailCount))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -439,6 +463,11 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DowntimeJailCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DowntimeJailCount))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.MissedBlocksCounter != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedBlocksCounter))
 			i--
@@ -679,6 +708,25 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailCount", wireType)
+				}
+				x.DowntimeJailCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DowntimeJailCount |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -715,12 +763,17 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_Params                            protoreflect.MessageDescriptor
-	fd_Params_signed_blocks_window       protoreflect.FieldDescriptor
-	fd_Params_min_signed_per_window      protoreflect.FieldDescriptor
-	fd_Params_downtime_jail_duration     protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_double_sign protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_downtime    protoreflect.FieldDescriptor
+	md_Params                                    protoreflect.MessageDescriptor
+	fd_Params_signed_blocks_window               protoreflect.FieldDescriptor
+	fd_Params_min_signed_per_window              protoreflect.FieldDescriptor
+	fd_Params_downtime_jail_duration             protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_double_sign         protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_downtime            protoreflect.FieldDescriptor
+	fd_Params_downtime_lookback_period           protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_downtime_multiplier protoreflect.FieldDescriptor
+	fd_Params_max_slash_fraction_downtime        protoreflect.FieldDescriptor
+	fd_Params_downtime_jail_duration_multiplier  protoreflect.FieldDescriptor
+	fd_Params_max_downtime_jail_duration         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -731,6 +784,11 @@ func init() {
 	fd_Params_downtime_jail_duration = md_Params.Fields().ByName("downtime_jail_duration")
 	fd_Params_slash_fraction_double_sign = md_Params.Fields().ByName("slash_fraction_double_sign")
 	fd_Params_slash_fraction_downtime = md_Params.Fields().ByName("slash_fraction_downtime")
+	fd_Params_downtime_lookback_period = md_Params.Fields().ByName("downtime_lookback_period")
+	fd_Params_slash_fraction_downtime_multiplier = md_Params.Fields().ByName("slash_fraction_downtime_multiplier")
+	fd_Params_max_slash_fraction_downtime = md_Params.Fields().ByName("max_slash_fraction_downtime")
+	fd_Params_downtime_jail_duration_multiplier = md_Params.Fields().ByName("downtime_jail_duration_multiplier")
+	fd_Params_max_downtime_jail_duration = md_Params.Fields().ByName("max_downtime_jail_duration")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -828,6 +886,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DowntimeLookbackPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.DowntimeLookbackPeriod.ProtoReflect())
+		if !f(fd_Params_downtime_lookback_period, value) {
+			return
+		}
+	}
+	if len(x.SlashFractionDowntimeMultiplier) != 0 {
+		value := protoreflect.ValueOfBytes(x.SlashFractionDowntimeMultiplier)
+		if !f(fd_Params_slash_fraction_downtime_multiplier, value) {
+			return
+		}
+	}
+	if len(x.MaxSlashFractionDowntime) != 0 {
+		value := protoreflect.ValueOfBytes(x.MaxSlashFractionDowntime)
+		if !f(fd_Params_max_slash_fraction_downtime, value) {
+			return
+		}
+	}
+	if len(x.DowntimeJailDurationMultiplier) != 0 {
+		value := protoreflect.ValueOfBytes(x.DowntimeJailDurationMultiplier)
+		if !f(fd_Params_downtime_jail_duration_multiplier, value) {
+			return
+		}
+	}
+	if x.MaxDowntimeJailDuration != nil {
+		value := protoreflect.ValueOfMessage(x.MaxDowntimeJailDuration.ProtoReflect())
+		if !f(fd_Params_max_downtime_jail_duration, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -853,6 +941,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.SlashFractionDoubleSign) != 0
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		return len(x.SlashFractionDowntime) != 0
+	case "cosmos.slashing.v1beta1.Params.downtime_lookback_period":
+		return x.DowntimeLookbackPeriod != nil
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		return len(x.SlashFractionDowntimeMultiplier) != 0
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		return len(x.MaxSlashFractionDowntime) != 0
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		return len(x.DowntimeJailDurationMultiplier) != 0
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		return x.MaxDowntimeJailDuration != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -879,6 +977,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SlashFractionDoubleSign = nil
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		x.SlashFractionDowntime = nil
+	case "cosmos.slashing.v1beta1.Params.downtime_lookback_period":
+		x.DowntimeLookbackPeriod = nil
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		x.SlashFractionDowntimeMultiplier = nil
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		x.MaxSlashFractionDowntime = nil
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		x.DowntimeJailDurationMultiplier = nil
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		x.MaxDowntimeJailDuration = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -910,6 +1018,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		value := x.SlashFractionDowntime
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.downtime_lookback_period":
+		value := x.DowntimeLookbackPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		value := x.SlashFractionDowntimeMultiplier
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		value := x.MaxSlashFractionDowntime
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		value := x.DowntimeJailDurationMultiplier
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		value := x.MaxDowntimeJailDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -940,6 +1063,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SlashFractionDoubleSign = value.Bytes()
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		x.SlashFractionDowntime = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.downtime_lookback_period":
+		x.DowntimeLookbackPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		x.SlashFractionDowntimeMultiplier = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		x.MaxSlashFractionDowntime = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		x.DowntimeJailDurationMultiplier = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		x.MaxDowntimeJailDuration = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -965,6 +1098,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.DowntimeJailDuration = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.DowntimeJailDuration.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.downtime_lookback_period":
+		if x.DowntimeLookbackPeriod == nil {
+			x.DowntimeLookbackPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DowntimeLookbackPeriod.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		if x.MaxDowntimeJailDuration == nil {
+			x.MaxDowntimeJailDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxDowntimeJailDuration.ProtoReflect())
 	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
 		panic(fmt.Errorf("field signed_blocks_window of message cosmos.slashing.v1beta1.Params is not mutable"))
 	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
@@ -973,6 +1116,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field slash_fraction_double_sign of message cosmos.slashing.v1beta1.Params is not mutable"))
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		panic(fmt.Errorf("field slash_fraction_downtime of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		panic(fmt.Errorf("field slash_fraction_downtime_multiplier of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		panic(fmt.Errorf("field max_slash_fraction_downtime of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		panic(fmt.Errorf("field downtime_jail_duration_multiplier of message cosmos.slashing.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -997,6 +1146,18 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBytes(nil)
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.downtime_lookback_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime_multiplier":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.max_slash_fraction_downtime":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.downtime_jail_duration_multiplier":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.max_downtime_jail_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -1085,6 +1246,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DowntimeLookbackPeriod != nil {
+			l = options.Size(x.DowntimeLookbackPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashFractionDowntimeMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxSlashFractionDowntime)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DowntimeJailDurationMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxDowntimeJailDuration != nil {
+			l = options.Size(x.MaxDowntimeJailDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1114,6 +1295,55 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxDowntimeJailDuration != nil {
+			encoded, err := options.Marshal(x.MaxDowntimeJailDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.DowntimeJailDurationMultiplier) > 0 {
+			i -= len(x.DowntimeJailDurationMultiplier)
+			copy(dAtA[i:], x.DowntimeJailDurationMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DowntimeJailDurationMultiplier)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.MaxSlashFractionDowntime) > 0 {
+			i -= len(x.MaxSlashFractionDowntime)
+			copy(dAtA[i:], x.MaxSlashFractionDowntime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSlashFractionDowntime)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.SlashFractionDowntimeMultiplier) > 0 {
+			i -= len(x.SlashFractionDowntimeMultiplier)
+			copy(dAtA[i:], x.SlashFractionDowntimeMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashFractionDowntimeMultiplier)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.DowntimeLookbackPeriod != nil {
+			encoded, err := options.Marshal(x.DowntimeLookbackPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.SlashFractionDowntime) > 0 {
 			i -= len(x.SlashFractionDowntime)
 			copy(dAtA[i:], x.SlashFractionDowntime)
@@ -1360,6 +1590,180 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					x.SlashFractionDowntime = []byte{}
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeLookbackPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DowntimeLookbackPeriod == nil {
+					x.DowntimeLookbackPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimeLookbackPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeMultiplier", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashFractionDowntimeMultiplier = append(x.SlashFractionDowntimeMultiplier[:0], dAtA[iNdEx:postIndex]...)
+				if x.SlashFractionDowntimeMultiplier == nil {
+					x.SlashFractionDowntimeMultiplier = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFractionDowntime", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSlashFractionDowntime = append(x.MaxSlashFractionDowntime[:0], dAtA[iNdEx:postIndex]...)
+				if x.MaxSlashFractionDowntime == nil {
+					x.MaxSlashFractionDowntime = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDurationMultiplier", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DowntimeJailDurationMultiplier = append(x.DowntimeJailDurationMultiplier[:0], dAtA[iNdEx:postIndex]...)
+				if x.DowntimeJailDurationMultiplier == nil {
+					x.DowntimeJailDurationMultiplier = []byte{}
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxDowntimeJailDuration == nil {
+					x.MaxDowntimeJailDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDowntimeJailDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1431,6 +1835,12 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// A counter of the successive downtime jailings of the validator, each
+	// within the downtime_lookback_period of the end of the previous one. It
+	// determines the escalation of the downtime penalties.
+	//
+	// Since: cosmos-sdk 0.48
+	DowntimeJailCount int64 `protobuf:"varint,7,opt,name=downtime_jail_count,json=downtimeJailCount,proto3" json:"downtime_jail_count,omitempty"`
 }
 
 func (x *ValidatorSigningInfo) Reset() {
@@ -1495,6 +1905,13 @@ func (x *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (x *ValidatorSigningInfo) GetDowntimeJailCount() int64 {
+	if x != nil {
+		return x.DowntimeJailCount
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1506,6 +1923,30 @@ type Params struct {
 	DowntimeJailDuration    *durationpb.Duration `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty"`
 	SlashFractionDoubleSign []byte               `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3" json:"slash_fraction_double_sign,omitempty"`
 	SlashFractionDowntime   []byte               `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3" json:"slash_fraction_downtime,omitempty"`
+	// downtime_lookback_period is the period after the end of a downtime jailing
+	// within which a new downtime of the validator is a repeat offense. Zero
+	// disables the escalation of the downtime penalties.
+	//
+	// Since: cosmos-sdk 0.48
+	DowntimeLookbackPeriod *durationpb.Duration `protobuf:"bytes,6,opt,name=downtime_lookback_period,json=downtimeLookbackPeriod,proto3" json:"downtime_lookback_period,omitempty"`
+	// slash_fraction_downtime_multiplier multiplies the downtime slash fraction
+	// for each repeat offense.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashFractionDowntimeMultiplier []byte `protobuf:"bytes,7,opt,name=slash_fraction_downtime_multiplier,json=slashFractionDowntimeMultiplier,proto3" json:"slash_fraction_downtime_multiplier,omitempty"`
+	// max_slash_fraction_downtime caps the escalated downtime slash fraction.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxSlashFractionDowntime []byte `protobuf:"bytes,8,opt,name=max_slash_fraction_downtime,json=maxSlashFractionDowntime,proto3" json:"max_slash_fraction_downtime,omitempty"`
+	// downtime_jail_duration_multiplier multiplies the downtime jail duration for
+	// each repeat offense.
+	//
+	// Since: cosmos-sdk 0.48
+	DowntimeJailDurationMultiplier []byte `protobuf:"bytes,9,opt,name=downtime_jail_duration_multiplier,json=downtimeJailDurationMultiplier,proto3" json:"downtime_jail_duration_multiplier,omitempty"`
+	// max_downtime_jail_duration caps the escalated downtime jail duration.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxDowntimeJailDuration *durationpb.Duration `protobuf:"bytes,10,opt,name=max_downtime_jail_duration,json=maxDowntimeJailDuration,proto3" json:"max_downtime_jail_duration,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1563,6 +2004,41 @@ func (x *Params) GetSlashFractionDowntime() []byte {
 	return nil
 }
 
+func (x *Params) GetDowntimeLookbackPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.DowntimeLookbackPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetSlashFractionDowntimeMultiplier() []byte {
+	if x != nil {
+		return x.SlashFractionDowntimeMultiplier
+	}
+	return nil
+}
+
+func (x *Params) GetMaxSlashFractionDowntime() []byte {
+	if x != nil {
+		return x.MaxSlashFractionDowntime
+	}
+	return nil
+}
+
+func (x *Params) GetDowntimeJailDurationMultiplier() []byte {
+	if x != nil {
+		return x.DowntimeJailDurationMultiplier
+	}
+	return nil
+}
+
+func (x *Params) GetMaxDowntimeJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDowntimeJailDuration
+	}
+	return nil
+}
+
 var File_cosmos_slashing_v1beta1_slashing_proto protoreflect.FileDescriptor
 
 var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
@@ -1578,7 +2054,7 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
 	0x3b, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
@@ -1598,7 +2074,10 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
 	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
 	0x13, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x65, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc5, 0x04, 0x0a, 0x06, 0x50,
+	0x6e, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc8, 0x09, 0x0a, 0x06, 0x50,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
@@ -1632,25 +2111,65 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x9a, 0xe7,
 	0xb0, 0x2a, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x64, 0x65, 0x63, 0x5f, 0x62, 0x79,
 	0x74, 0x65, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46,
-	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a,
-	0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x78, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x42, 0xe8, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x42, 0x0d, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x6c,
-	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03,
-	0x43, 0x53, 0x58, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x17,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x62, 0x0a, 0x18, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde,
+	0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x64, 0x6f, 0x77,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x95, 0x01, 0x0a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x9a, 0xe7, 0xb0, 0x2a, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x64, 0x65, 0x63, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1f, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x87, 0x01, 0x0a, 0x1b,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x9a, 0xe7, 0xb0, 0x2a, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x64, 0x65, 0x63,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x6d, 0x61, 0x78,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x21, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x9a, 0xe7, 0xb0, 0x2a, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x64, 0x65, 0x63,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1e, 0x64, 0x6f, 0x77,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x1a, 0x6d,
+	0x61, 0x78, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6a, 0x61, 0x69, 0x6c,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00,
+	0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x44, 0x6f,
+	0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xe8, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x1b, 0x63, 0x6f,
+	0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0d, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1675,11 +2194,13 @@ var file_cosmos_slashing_v1beta1_slashing_proto_goTypes = []interface{}{
 var file_cosmos_slashing_v1beta1_slashing_proto_depIdxs = []int32{
 	2, // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
 	3, // 1: cosmos.slashing.v1beta1.Params.downtime_jail_duration:type_name -> google.protobuf.Duration
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: cosmos.slashing.v1beta1.Params.downtime_lookback_period:type_name -> google.protobuf.Duration
+	3, // 3: cosmos.slashing.v1beta1.Params.max_downtime_jail_duration:type_name -> google.protobuf.Duration
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_slashing_v1beta1_slashing_proto_init() }
@@ -270,16 +270,330 @@ func (x *_GenesisState_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*ConsPubKeyRotationHistory
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConsPubKeyRotationHistory)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConsPubKeyRotationHistory)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(ConsPubKeyRotationHistory)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(ConsPubKeyRotationHistory)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*TokenizeShareRecord
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenizeShareRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenizeShareRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(TokenizeShareRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(TokenizeShareRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*SlashRecord
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(SlashRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(SlashRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*SlashedDelegation
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashedDelegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashedDelegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(SlashedDelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(SlashedDelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*SlashedUnbondingEntry
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashedUnbondingEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashedUnbondingEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(SlashedUnbondingEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(SlashedUnbondingEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*SlashedDelegationShares
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashedDelegationShares)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashedDelegationShares)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(SlashedDelegationShares)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(SlashedDelegationShares)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                       protoreflect.MessageDescriptor
-	fd_GenesisState_params                protoreflect.FieldDescriptor
-	fd_GenesisState_last_total_power      protoreflect.FieldDescriptor
-	fd_GenesisState_last_validator_powers protoreflect.FieldDescriptor
-	fd_GenesisState_validators            protoreflect.FieldDescriptor
-	fd_GenesisState_delegations           protoreflect.FieldDescriptor
-	fd_GenesisState_unbonding_delegations protoreflect.FieldDescriptor
-	fd_GenesisState_redelegations         protoreflect.FieldDescriptor
-	fd_GenesisState_exported              protoreflect.FieldDescriptor
+	md_GenesisState                               protoreflect.MessageDescriptor
+	fd_GenesisState_params                        protoreflect.FieldDescriptor
+	fd_GenesisState_last_total_power              protoreflect.FieldDescriptor
+	fd_GenesisState_last_validator_powers         protoreflect.FieldDescriptor
+	fd_GenesisState_validators                    protoreflect.FieldDescriptor
+	fd_GenesisState_delegations                   protoreflect.FieldDescriptor
+	fd_GenesisState_unbonding_delegations         protoreflect.FieldDescriptor
+	fd_GenesisState_redelegations                 protoreflect.FieldDescriptor
+	fd_GenesisState_exported                      protoreflect.FieldDescriptor
+	fd_GenesisState_rotation_history              protoreflect.FieldDescriptor
+	fd_GenesisState_tokenize_share_records        protoreflect.FieldDescriptor
+	fd_GenesisState_last_tokenize_share_record_id protoreflect.FieldDescriptor
+	fd_GenesisState_slash_records                 protoreflect.FieldDescriptor
+	fd_GenesisState_last_slash_record_id          protoreflect.FieldDescriptor
+	fd_GenesisState_slashed_delegations           protoreflect.FieldDescriptor
+	fd_GenesisState_slashed_unbonding_entries     protoreflect.FieldDescriptor
+	fd_GenesisState_slashed_delegation_shares     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -293,6 +607,14 @@ func init() {
 	fd_GenesisState_unbonding_delegations = md_GenesisState.Fields().ByName("unbonding_delegations")
 	fd_GenesisState_redelegations = md_GenesisState.Fields().ByName("redelegations")
 	fd_GenesisState_exported = md_GenesisState.Fields().ByName("exported")
+	fd_GenesisState_rotation_history = md_GenesisState.Fields().ByName("rotation_history")
+	fd_GenesisState_tokenize_share_records = md_GenesisState.Fields().ByName("tokenize_share_records")
+	fd_GenesisState_last_tokenize_share_record_id = md_GenesisState.Fields().ByName("last_tokenize_share_record_id")
+	fd_GenesisState_slash_records = md_GenesisState.Fields().ByName("slash_records")
+	fd_GenesisState_last_slash_record_id = md_GenesisState.Fields().ByName("last_slash_record_id")
+	fd_GenesisState_slashed_delegations = md_GenesisState.Fields().ByName("slashed_delegations")
+	fd_GenesisState_slashed_unbonding_entries = md_GenesisState.Fields().ByName("slashed_unbonding_entries")
+	fd_GenesisState_slashed_delegation_shares = md_GenesisState.Fields().ByName("slashed_delegation_shares")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -408,6 +730,54 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.RotationHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.RotationHistory})
+		if !f(fd_GenesisState_rotation_history, value) {
+			return
+		}
+	}
+	if len(x.TokenizeShareRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.TokenizeShareRecords})
+		if !f(fd_GenesisState_tokenize_share_records, value) {
+			return
+		}
+	}
+	if x.LastTokenizeShareRecordId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastTokenizeShareRecordId)
+		if !f(fd_GenesisState_last_tokenize_share_record_id, value) {
+			return
+		}
+	}
+	if len(x.SlashRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.SlashRecords})
+		if !f(fd_GenesisState_slash_records, value) {
+			return
+		}
+	}
+	if x.LastSlashRecordId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastSlashRecordId)
+		if !f(fd_GenesisState_last_slash_record_id, value) {
+			return
+		}
+	}
+	if len(x.SlashedDelegations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.SlashedDelegations})
+		if !f(fd_GenesisState_slashed_delegations, value) {
+			return
+		}
+	}
+	if len(x.SlashedUnbondingEntries) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.SlashedUnbondingEntries})
+		if !f(fd_GenesisState_slashed_unbonding_entries, value) {
+			return
+		}
+	}
+	if len(x.SlashedDelegationShares) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.SlashedDelegationShares})
+		if !f(fd_GenesisState_slashed_delegation_shares, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -439,6 +809,22 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Redelegations) != 0
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		return x.Exported != false
+	case "cosmos.staking.v1beta1.GenesisState.rotation_history":
+		return len(x.RotationHistory) != 0
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		return len(x.TokenizeShareRecords) != 0
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		return x.LastTokenizeShareRecordId != uint64(0)
+	case "cosmos.staking.v1beta1.GenesisState.slash_records":
+		return len(x.SlashRecords) != 0
+	case "cosmos.staking.v1beta1.GenesisState.last_slash_record_id":
+		return x.LastSlashRecordId != uint64(0)
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegations":
+		return len(x.SlashedDelegations) != 0
+	case "cosmos.staking.v1beta1.GenesisState.slashed_unbonding_entries":
+		return len(x.SlashedUnbondingEntries) != 0
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegation_shares":
+		return len(x.SlashedDelegationShares) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -471,6 +857,22 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Redelegations = nil
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		x.Exported = false
+	case "cosmos.staking.v1beta1.GenesisState.rotation_history":
+		x.RotationHistory = nil
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		x.TokenizeShareRecords = nil
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		x.LastTokenizeShareRecordId = uint64(0)
+	case "cosmos.staking.v1beta1.GenesisState.slash_records":
+		x.SlashRecords = nil
+	case "cosmos.staking.v1beta1.GenesisState.last_slash_record_id":
+		x.LastSlashRecordId = uint64(0)
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegations":
+		x.SlashedDelegations = nil
+	case "cosmos.staking.v1beta1.GenesisState.slashed_unbonding_entries":
+		x.SlashedUnbondingEntries = nil
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegation_shares":
+		x.SlashedDelegationShares = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -526,6 +928,48 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		value := x.Exported
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.staking.v1beta1.GenesisState.rotation_history":
+		if len(x.RotationHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.RotationHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		if len(x.TokenizeShareRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.TokenizeShareRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		value := x.LastTokenizeShareRecordId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.staking.v1beta1.GenesisState.slash_records":
+		if len(x.SlashRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.SlashRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.last_slash_record_id":
+		value := x.LastSlashRecordId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegations":
+		if len(x.SlashedDelegations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.SlashedDelegations}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.slashed_unbonding_entries":
+		if len(x.SlashedUnbondingEntries) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.SlashedUnbondingEntries}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegation_shares":
+		if len(x.SlashedDelegationShares) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.SlashedDelegationShares}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -572,6 +1016,34 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Redelegations = *clv.list
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		x.Exported = value.Bool()
+	case "cosmos.staking.v1beta1.GenesisState.rotation_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.RotationHistory = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.TokenizeShareRecords = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		x.LastTokenizeShareRecordId = value.Uint()
+	case "cosmos.staking.v1beta1.GenesisState.slash_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.SlashRecords = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.last_slash_record_id":
+		x.LastSlashRecordId = value.Uint()
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.SlashedDelegations = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.slashed_unbonding_entries":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.SlashedUnbondingEntries = *clv.list
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegation_shares":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.SlashedDelegationShares = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -627,10 +1099,50 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_7_list{list: &x.Redelegations}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.rotation_history":
+		if x.RotationHistory == nil {
+			x.RotationHistory = []*ConsPubKeyRotationHistory{}
+		}
+		value := &_GenesisState_9_list{list: &x.RotationHistory}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		if x.TokenizeShareRecords == nil {
+			x.TokenizeShareRecords = []*TokenizeShareRecord{}
+		}
+		value := &_GenesisState_10_list{list: &x.TokenizeShareRecords}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.slash_records":
+		if x.SlashRecords == nil {
+			x.SlashRecords = []*SlashRecord{}
+		}
+		value := &_GenesisState_12_list{list: &x.SlashRecords}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegations":
+		if x.SlashedDelegations == nil {
+			x.SlashedDelegations = []*SlashedDelegation{}
+		}
+		value := &_GenesisState_14_list{list: &x.SlashedDelegations}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.slashed_unbonding_entries":
+		if x.SlashedUnbondingEntries == nil {
+			x.SlashedUnbondingEntries = []*SlashedUnbondingEntry{}
+		}
+		value := &_GenesisState_15_list{list: &x.SlashedUnbondingEntries}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegation_shares":
+		if x.SlashedDelegationShares == nil {
+			x.SlashedDelegationShares = []*SlashedDelegationShares{}
+		}
+		value := &_GenesisState_16_list{list: &x.SlashedDelegationShares}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.staking.v1beta1.GenesisState.last_total_power":
 		panic(fmt.Errorf("field last_total_power of message cosmos.staking.v1beta1.GenesisState is not mutable"))
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		panic(fmt.Errorf("field exported of message cosmos.staking.v1beta1.GenesisState is not mutable"))
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		panic(fmt.Errorf("field last_tokenize_share_record_id of message cosmos.staking.v1beta1.GenesisState is not mutable"))
+	case "cosmos.staking.v1beta1.GenesisState.last_slash_record_id":
+		panic(fmt.Errorf("field last_slash_record_id of message cosmos.staking.v1beta1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -666,6 +1178,28 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
 	case "cosmos.staking.v1beta1.GenesisState.exported":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.staking.v1beta1.GenesisState.rotation_history":
+		list := []*ConsPubKeyRotationHistory{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.tokenize_share_records":
+		list := []*TokenizeShareRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.last_tokenize_share_record_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.staking.v1beta1.GenesisState.slash_records":
+		list := []*SlashRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.last_slash_record_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegations":
+		list := []*SlashedDelegation{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.slashed_unbonding_entries":
+		list := []*SlashedUnbondingEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "cosmos.staking.v1beta1.GenesisState.slashed_delegation_shares":
+		list := []*SlashedDelegationShares{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.staking.v1beta1.GenesisState"))
@@ -776,6 +1310,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.Exported {
 			n += 2
 		}
+		if len(x.RotationHistory) > 0 {
+			for _, e := range x.RotationHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TokenizeShareRecords) > 0 {
+			for _, e := range x.TokenizeShareRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LastTokenizeShareRecordId != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastTokenizeShareRecordId))
+		}
+		if len(x.SlashRecords) > 0 {
+			for _, e := range x.SlashRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LastSlashRecordId != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastSlashRecordId))
+		}
+		if len(x.SlashedDelegations) > 0 {
+			for _, e := range x.SlashedDelegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SlashedUnbondingEntries) > 0 {
+			for _, e := range x.SlashedUnbondingEntries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SlashedDelegationShares) > 0 {
+			for _, e := range x.SlashedDelegationShares {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -805,6 +1381,114 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.SlashedDelegationShares) > 0 {
+			for iNdEx := len(x.SlashedDelegationShares) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashedDelegationShares[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.SlashedUnbondingEntries) > 0 {
+			for iNdEx := len(x.SlashedUnbondingEntries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashedUnbondingEntries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.SlashedDelegations) > 0 {
+			for iNdEx := len(x.SlashedDelegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashedDelegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.LastSlashRecordId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastSlashRecordId))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.SlashRecords) > 0 {
+			for iNdEx := len(x.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.LastTokenizeShareRecordId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastTokenizeShareRecordId))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.TokenizeShareRecords) > 0 {
+			for iNdEx := len(x.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TokenizeShareRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.RotationHistory) > 0 {
+			for iNdEx := len(x.RotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RotationHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
 		if x.Exported {
 			i--
 			if x.Exported {
@@ -1225,6 +1909,248 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Exported = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RotationHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RotationHistory = append(x.RotationHistory, &ConsPubKeyRotationHistory{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RotationHistory[len(x.RotationHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenizeShareRecords = append(x.TokenizeShareRecords, &TokenizeShareRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenizeShareRecords[len(x.TokenizeShareRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+				}
+				x.LastTokenizeShareRecordId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashRecords = append(x.SlashRecords, &SlashRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashRecords[len(x.SlashRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastSlashRecordId", wireType)
+				}
+				x.LastSlashRecordId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastSlashRecordId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedDelegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedDelegations = append(x.SlashedDelegations, &SlashedDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashedDelegations[len(x.SlashedDelegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedUnbondingEntries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedUnbondingEntries = append(x.SlashedUnbondingEntries, &SlashedUnbondingEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashedUnbondingEntries[len(x.SlashedUnbondingEntries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedDelegationShares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedDelegationShares = append(x.SlashedDelegationShares, &SlashedDelegationShares{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashedDelegationShares[len(x.SlashedDelegationShares)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1764,6 +2690,43 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []*Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
 	Exported      bool            `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// rotation_history defines the consensus public key rotations of the
+	// validators.
+	//
+	// Since: cosmos-sdk 0.48
+	RotationHistory []*ConsPubKeyRotationHistory `protobuf:"bytes,9,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history,omitempty"`
+	// tokenize_share_records defines the tokenized delegations.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecords []*TokenizeShareRecord `protobuf:"bytes,10,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records,omitempty"`
+	// last_tokenize_share_record_id is the id of the last created tokenize
+	// share record.
+	//
+	// Since: cosmos-sdk 0.48
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,11,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// slash_records defines the recorded slashes.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecords []*SlashRecord `protobuf:"bytes,12,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records,omitempty"`
+	// last_slash_record_id is the id of the last created slash record.
+	//
+	// Since: cosmos-sdk 0.48
+	LastSlashRecordId uint64 `protobuf:"varint,13,opt,name=last_slash_record_id,json=lastSlashRecordId,proto3" json:"last_slash_record_id,omitempty"`
+	// slashed_delegations defines the tokens burned from the delegations of the
+	// redelegations slashed by the recorded slashes.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashedDelegations []*SlashedDelegation `protobuf:"bytes,14,rep,name=slashed_delegations,json=slashedDelegations,proto3" json:"slashed_delegations,omitempty"`
+	// slashed_unbonding_entries defines the tokens burned from each unbonding
+	// delegation entry by the recorded slashes.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashedUnbondingEntries []*SlashedUnbondingEntry `protobuf:"bytes,15,rep,name=slashed_unbonding_entries,json=slashedUnbondingEntries,proto3" json:"slashed_unbonding_entries,omitempty"`
+	// slashed_delegation_shares defines the shares held at the recorded slashes
+	// by the delegations modified since.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashedDelegationShares []*SlashedDelegationShares `protobuf:"bytes,16,rep,name=slashed_delegation_shares,json=slashedDelegationShares,proto3" json:"slashed_delegation_shares,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1842,6 +2805,62 @@ func (x *GenesisState) GetExported() bool {
 	return false
 }
 
+func (x *GenesisState) GetRotationHistory() []*ConsPubKeyRotationHistory {
+	if x != nil {
+		return x.RotationHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTokenizeShareRecords() []*TokenizeShareRecord {
+	if x != nil {
+		return x.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if x != nil {
+		return x.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSlashRecords() []*SlashRecord {
+	if x != nil {
+		return x.SlashRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLastSlashRecordId() uint64 {
+	if x != nil {
+		return x.LastSlashRecordId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSlashedDelegations() []*SlashedDelegation {
+	if x != nil {
+		return x.SlashedDelegations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSlashedUnbondingEntries() []*SlashedUnbondingEntry {
+	if x != nil {
+		return x.SlashedUnbondingEntries
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSlashedDelegationShares() []*SlashedDelegationShares {
+	if x != nil {
+		return x.SlashedDelegationShares
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	state         protoimpl.MessageState
@@ -1901,7 +2920,7 @@ var file_cosmos_staking_v1beta1_genesis_proto_rawDesc = []byte{
 	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69,
-	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x0b, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
 	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x70, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
@@ -1943,28 +2962,75 @@ var file_cosmos_staking_v1beta1_genesis_proto_rawDesc = []byte{
 	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x64, 0x65,
 	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
 	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x42,
-	0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58, 0xaa, 0x02, 0x16, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53,
-	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
-	0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x74,
-	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x67, 0x0a, 0x10, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x6c,
+	0x0a, 0x16, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x1d,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x19, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a,
+	0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x53,
+	0x0a, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
+	0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x49, 0x64, 0x12, 0x65, 0x0a, 0x13, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x74, 0x0a, 0x19, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x55,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x76, 0x0a, 0x19, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x10,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74,
+	0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x17, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x61, 0x73,
+	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12,
+	0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8,
+	0xa0, 0x1f, 0x00, 0x42, 0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x58,
+	0xaa, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0xe2, 0x02, 0x22, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x61, 0x6b,
+	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x3a, 0x3a, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1981,26 +3047,38 @@ func file_cosmos_staking_v1beta1_genesis_proto_rawDescGZIP() []byte {
 
 var file_cosmos_staking_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_cosmos_staking_v1beta1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),        // 0: cosmos.staking.v1beta1.GenesisState
-	(*LastValidatorPower)(nil),  // 1: cosmos.staking.v1beta1.LastValidatorPower
-	(*Params)(nil),              // 2: cosmos.staking.v1beta1.Params
-	(*Validator)(nil),           // 3: cosmos.staking.v1beta1.Validator
-	(*Delegation)(nil),          // 4: cosmos.staking.v1beta1.Delegation
-	(*UnbondingDelegation)(nil), // 5: cosmos.staking.v1beta1.UnbondingDelegation
-	(*Redelegation)(nil),        // 6: cosmos.staking.v1beta1.Redelegation
+	(*GenesisState)(nil),              // 0: cosmos.staking.v1beta1.GenesisState
+	(*LastValidatorPower)(nil),        // 1: cosmos.staking.v1beta1.LastValidatorPower
+	(*Params)(nil),                    // 2: cosmos.staking.v1beta1.Params
+	(*Validator)(nil),                 // 3: cosmos.staking.v1beta1.Validator
+	(*Delegation)(nil),                // 4: cosmos.staking.v1beta1.Delegation
+	(*UnbondingDelegation)(nil),       // 5: cosmos.staking.v1beta1.UnbondingDelegation
+	(*Redelegation)(nil),              // 6: cosmos.staking.v1beta1.Redelegation
+	(*ConsPubKeyRotationHistory)(nil), // 7: cosmos.staking.v1beta1.ConsPubKeyRotationHistory
+	(*TokenizeShareRecord)(nil),       // 8: cosmos.staking.v1beta1.TokenizeShareRecord
+	(*SlashRecord)(nil),               // 9: cosmos.staking.v1beta1.SlashRecord
+	(*SlashedDelegation)(nil),         // 10: cosmos.staking.v1beta1.SlashedDelegation
+	(*SlashedUnbondingEntry)(nil),     // 11: cosmos.staking.v1beta1.SlashedUnbondingEntry
+	(*SlashedDelegationShares)(nil),   // 12: cosmos.staking.v1beta1.SlashedDelegationShares
 }
 var file_cosmos_staking_v1beta1_genesis_proto_depIdxs = []int32{
-	2, // 0: cosmos.staking.v1beta1.GenesisState.params:type_name -> cosmos.staking.v1beta1.Params
-	1, // 1: cosmos.staking.v1beta1.GenesisState.last_validator_powers:type_name -> cosmos.staking.v1beta1.LastValidatorPower
-	3, // 2: cosmos.staking.v1beta1.GenesisState.validators:type_name -> cosmos.staking.v1beta1.Validator
-	4, // 3: cosmos.staking.v1beta1.GenesisState.delegations:type_name -> cosmos.staking.v1beta1.Delegation
-	5, // 4: cosmos.staking.v1beta1.GenesisState.unbonding_delegations:type_name -> cosmos.staking.v1beta1.UnbondingDelegation
-	6, // 5: cosmos.staking.v1beta1.GenesisState.redelegations:type_name -> cosmos.staking.v1beta1.Redelegation
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	2,  // 0: cosmos.staking.v1beta1.GenesisState.params:type_name -> cosmos.staking.v1beta1.Params
+	1,  // 1: cosmos.staking.v1beta1.GenesisState.last_validator_powers:type_name -> cosmos.staking.v1beta1.LastValidatorPower
+	3,  // 2: cosmos.staking.v1beta1.GenesisState.validators:type_name -> cosmos.staking.v1beta1.Validator
+	4,  // 3: cosmos.staking.v1beta1.GenesisState.delegations:type_name -> cosmos.staking.v1beta1.Delegation
+	5,  // 4: cosmos.staking.v1beta1.GenesisState.unbonding_delegations:type_name -> cosmos.staking.v1beta1.UnbondingDelegation
+	6,  // 5: cosmos.staking.v1beta1.GenesisState.redelegations:type_name -> cosmos.staking.v1beta1.Redelegation
+	7,  // 6: cosmos.staking.v1beta1.GenesisState.rotation_history:type_name -> cosmos.staking.v1beta1.ConsPubKeyRotationHistory
+	8,  // 7: cosmos.staking.v1beta1.GenesisState.tokenize_share_records:type_name -> cosmos.staking.v1beta1.TokenizeShareRecord
+	9,  // 8: cosmos.staking.v1beta1.GenesisState.slash_records:type_name -> cosmos.staking.v1beta1.SlashRecord
+	10, // 9: cosmos.staking.v1beta1.GenesisState.slashed_delegations:type_name -> cosmos.staking.v1beta1.SlashedDelegation
+	11, // 10: cosmos.staking.v1beta1.GenesisState.slashed_unbonding_entries:type_name -> cosmos.staking.v1beta1.SlashedUnbondingEntry
+	12, // 11: cosmos.staking.v1beta1.GenesisState.slashed_delegation_shares:type_name -> cosmos.staking.v1beta1.SlashedDelegationShares
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_staking_v1beta1_genesis_proto_init() }
@@ -33,6 +33,18 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_TokenizeShareRecordByID_FullMethodName       = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordByID"
+	Query_TokenizeShareRecordByDenom_FullMethodName    = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordByDenom"
+	Query_TokenizeShareRecordsOwned_FullMethodName     = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsOwned"
+	Query_TotalLiquidStaked_FullMethodName             = "/cosmos.staking.v1beta1.Query/TotalLiquidStaked"
+	Query_UnbondingQueue_FullMethodName                = "/cosmos.staking.v1beta1.Query/UnbondingQueue"
+	Query_RedelegationQueue_FullMethodName             = "/cosmos.staking.v1beta1.Query/RedelegationQueue"
+	Query_SlashRecord_FullMethodName                   = "/cosmos.staking.v1beta1.Query/SlashRecord"
+	Query_SlashRecords_FullMethodName                  = "/cosmos.staking.v1beta1.Query/SlashRecords"
+	Query_ValidatorSlashRecords_FullMethodName         = "/cosmos.staking.v1beta1.Query/ValidatorSlashRecords"
+	Query_SlashRecordDelegation_FullMethodName         = "/cosmos.staking.v1beta1.Query/SlashRecordDelegation"
+	Query_SlashRecordDelegations_FullMethodName        = "/cosmos.staking.v1beta1.Query/SlashRecordDelegations"
+	Query_SlashRecordUnbondingEntries_FullMethodName   = "/cosmos.staking.v1beta1.Query/SlashRecordUnbondingEntries"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +104,79 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecordByID queries a tokenize share record by its id.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecordByID(ctx context.Context, in *QueryTokenizeShareRecordByIDRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIDResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by its share
+	// token denom.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByDenomResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// address.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the total amount of tokenized bond tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// UnbondingQueue queries the unbonding delegations queue, ordered by
+	// completion time.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegations queue, ordered by completion
+	// time.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error)
+	// SlashRecord queries a slash record by its id.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecord(ctx context.Context, in *QuerySlashRecordRequest, opts ...grpc.CallOption) (*QuerySlashRecordResponse, error)
+	// SlashRecords queries all slash records.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecords(ctx context.Context, in *QuerySlashRecordsRequest, opts ...grpc.CallOption) (*QuerySlashRecordsResponse, error)
+	// ValidatorSlashRecords queries the slash records of a validator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorSlashRecords(ctx context.Context, in *QueryValidatorSlashRecordsRequest, opts ...grpc.CallOption) (*QueryValidatorSlashRecordsResponse, error)
+	// SlashRecordDelegation queries the tokens burned by a recorded slash from
+	// the delegation of a delegator to the slashed validator.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecordDelegation(ctx context.Context, in *QuerySlashRecordDelegationRequest, opts ...grpc.CallOption) (*QuerySlashRecordDelegationResponse, error)
+	// SlashRecordDelegations queries the tokens burned by a recorded slash from
+	// the delegation of each slashed redelegation to its destination validator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecordDelegations(ctx context.Context, in *QuerySlashRecordDelegationsRequest, opts ...grpc.CallOption) (*QuerySlashRecordDelegationsResponse, error)
+	// SlashRecordUnbondingEntries queries the tokens burned from each unbonding
+	// delegation entry by a recorded slash.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecordUnbondingEntries(ctx context.Context, in *QuerySlashRecordUnbondingEntriesRequest, opts ...grpc.CallOption) (*QuerySlashRecordUnbondingEntriesResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +313,114 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecordByID(ctx context.Context, in *QueryTokenizeShareRecordByIDRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIDResponse, error) {
+	out := new(QueryTokenizeShareRecordByIDResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordByID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByDenomResponse, error) {
+	out := new(QueryTokenizeShareRecordByDenomResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordByDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	out := new(QueryTokenizeShareRecordsOwnedResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordsOwned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, Query_TotalLiquidStaked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error) {
+	out := new(QueryUnbondingQueueResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error) {
+	out := new(QueryRedelegationQueueResponse)
+	err := c.cc.Invoke(ctx, Query_RedelegationQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashRecord(ctx context.Context, in *QuerySlashRecordRequest, opts ...grpc.CallOption) (*QuerySlashRecordResponse, error) {
+	out := new(QuerySlashRecordResponse)
+	err := c.cc.Invoke(ctx, Query_SlashRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashRecords(ctx context.Context, in *QuerySlashRecordsRequest, opts ...grpc.CallOption) (*QuerySlashRecordsResponse, error) {
+	out := new(QuerySlashRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_SlashRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSlashRecords(ctx context.Context, in *QueryValidatorSlashRecordsRequest, opts ...grpc.CallOption) (*QueryValidatorSlashRecordsResponse, error) {
+	out := new(QueryValidatorSlashRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSlashRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashRecordDelegation(ctx context.Context, in *QuerySlashRecordDelegationRequest, opts ...grpc.CallOption) (*QuerySlashRecordDelegationResponse, error) {
+	out := new(QuerySlashRecordDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_SlashRecordDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashRecordDelegations(ctx context.Context, in *QuerySlashRecordDelegationsRequest, opts ...grpc.CallOption) (*QuerySlashRecordDelegationsResponse, error) {
+	out := new(QuerySlashRecordDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_SlashRecordDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashRecordUnbondingEntries(ctx context.Context, in *QuerySlashRecordUnbondingEntriesRequest, opts ...grpc.CallOption) (*QuerySlashRecordUnbondingEntriesResponse, error) {
+	out := new(QuerySlashRecordUnbondingEntriesResponse)
+	err := c.cc.Invoke(ctx, Query_SlashRecordUnbondingEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +478,79 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecordByID queries a tokenize share record by its id.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecordByID(context.Context, *QueryTokenizeShareRecordByIDRequest) (*QueryTokenizeShareRecordByIDResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by its share
+	// token denom.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordByDenomResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// address.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// TotalLiquidStaked queries the total amount of tokenized bond tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
+	// UnbondingQueue queries the unbonding delegations queue, ordered by
+	// completion time.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegations queue, ordered by completion
+	// time.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error)
+	// SlashRecord queries a slash record by its id.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecord(context.Context, *QuerySlashRecordRequest) (*QuerySlashRecordResponse, error)
+	// SlashRecords queries all slash records.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecords(context.Context, *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error)
+	// ValidatorSlashRecords queries the slash records of a validator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorSlashRecords(context.Context, *QueryValidatorSlashRecordsRequest) (*QueryValidatorSlashRecordsResponse, error)
+	// SlashRecordDelegation queries the tokens burned by a recorded slash from
+	// the delegation of a delegator to the slashed validator.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecordDelegation(context.Context, *QuerySlashRecordDelegationRequest) (*QuerySlashRecordDelegationResponse, error)
+	// SlashRecordDelegations queries the tokens burned by a recorded slash from
+	// the delegation of each slashed redelegation to its destination validator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecordDelegations(context.Context, *QuerySlashRecordDelegationsRequest) (*QuerySlashRecordDelegationsResponse, error)
+	// SlashRecordUnbondingEntries queries the tokens burned from each unbonding
+	// delegation entry by a recorded slash.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecordUnbondingEntries(context.Context, *QuerySlashRecordUnbondingEntriesRequest) (*QuerySlashRecordUnbondingEntriesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +600,42 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) TokenizeShareRecordByID(context.Context, *QueryTokenizeShareRecordByIDRequest) (*QueryTokenizeShareRecordByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordByID not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordByDenom not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordsOwned not implemented")
+}
+func (UnimplementedQueryServer) TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
+func (UnimplementedQueryServer) UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingQueue not implemented")
+}
+func (UnimplementedQueryServer) RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationQueue not implemented")
+}
+func (UnimplementedQueryServer) SlashRecord(context.Context, *QuerySlashRecordRequest) (*QuerySlashRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRecord not implemented")
+}
+func (UnimplementedQueryServer) SlashRecords(context.Context, *QuerySlashRecordsRequest) (*QuerySlashRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRecords not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSlashRecords(context.Context, *QueryValidatorSlashRecordsRequest) (*QueryValidatorSlashRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashRecords not implemented")
+}
+func (UnimplementedQueryServer) SlashRecordDelegation(context.Context, *QuerySlashRecordDelegationRequest) (*QuerySlashRecordDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRecordDelegation not implemented")
+}
+func (UnimplementedQueryServer) SlashRecordDelegations(context.Context, *QuerySlashRecordDelegationsRequest) (*QuerySlashRecordDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRecordDelegations not implemented")
+}
+func (UnimplementedQueryServer) SlashRecordUnbondingEntries(context.Context, *QuerySlashRecordUnbondingEntriesRequest) (*QuerySlashRecordUnbondingEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRecordUnbondingEntries not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +901,222 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecordByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordByID(ctx, req.(*QueryTokenizeShareRecordByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordByDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, req.(*QueryTokenizeShareRecordByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordsOwned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, req.(*QueryTokenizeShareRecordsOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalLiquidStaked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingQueue(ctx, req.(*QueryUnbondingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedelegationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationQueue(ctx, req.(*QueryRedelegationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRecord(ctx, req.(*QuerySlashRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRecords(ctx, req.(*QuerySlashRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSlashRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSlashRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSlashRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSlashRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSlashRecords(ctx, req.(*QueryValidatorSlashRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashRecordDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRecordDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRecordDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashRecordDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRecordDelegation(ctx, req.(*QuerySlashRecordDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashRecordDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRecordDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRecordDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashRecordDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRecordDelegations(ctx, req.(*QuerySlashRecordDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashRecordUnbondingEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRecordUnbondingEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashRecordUnbondingEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashRecordUnbondingEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashRecordUnbondingEntries(ctx, req.(*QuerySlashRecordUnbondingEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +1180,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecordByID",
+			Handler:    _Query_TokenizeShareRecordByID_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordByDenom",
+			Handler:    _Query_TokenizeShareRecordByDenom_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsOwned",
+			Handler:    _Query_TokenizeShareRecordsOwned_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
+		{
+			MethodName: "UnbondingQueue",
+			Handler:    _Query_UnbondingQueue_Handler,
+		},
+		{
+			MethodName: "RedelegationQueue",
+			Handler:    _Query_RedelegationQueue_Handler,
+		},
+		{
+			MethodName: "SlashRecord",
+			Handler:    _Query_SlashRecord_Handler,
+		},
+		{
+			MethodName: "SlashRecords",
+			Handler:    _Query_SlashRecords_Handler,
+		},
+		{
+			MethodName: "ValidatorSlashRecords",
+			Handler:    _Query_ValidatorSlashRecords_Handler,
+		},
+		{
+			MethodName: "SlashRecordDelegation",
+			Handler:    _Query_SlashRecordDelegation_Handler,
+		},
+		{
+			MethodName: "SlashRecordDelegations",
+			Handler:    _Query_SlashRecordDelegations_Handler,
+		},
+		{
+			MethodName: "SlashRecordUnbondingEntries",
+			Handler:    _Query_SlashRecordUnbondingEntries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
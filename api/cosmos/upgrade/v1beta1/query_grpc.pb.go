@@ -23,6 +23,9 @@ const (
 	Query_AppliedPlan_FullMethodName            = "/cosmos.upgrade.v1beta1.Query/AppliedPlan"
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
+	Query_PendingPlans_FullMethodName           = "/cosmos.upgrade.v1beta1.Query/PendingPlans"
+	Query_UpgradeReadiness_FullMethodName       = "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness"
+	Query_Params_FullMethodName                 = "/cosmos.upgrade.v1beta1.Query/Params"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
 )
 
@@ -46,6 +49,20 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// PendingPlans queries the current upgrade plan followed by the queued
+	// upgrade plans.
+	//
+	// Since: cosmos-sdk 0.48
+	PendingPlans(ctx context.Context, in *QueryPendingPlansRequest, opts ...grpc.CallOption) (*QueryPendingPlansResponse, error)
+	// UpgradeReadiness queries the readiness signals for a pending upgrade and
+	// the fraction of the bonded voting power they represent.
+	//
+	// Since: cosmos-sdk 0.48
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
+	// Params queries the x/upgrade module parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -97,6 +114,33 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) PendingPlans(ctx context.Context, in *QueryPendingPlansRequest, opts ...grpc.CallOption) (*QueryPendingPlansResponse, error) {
+	out := new(QueryPendingPlansResponse)
+	err := c.cc.Invoke(ctx, Query_PendingPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, Query_UpgradeReadiness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error) {
 	out := new(QueryAuthorityResponse)
 	err := c.cc.Invoke(ctx, Query_Authority_FullMethodName, in, out, opts...)
@@ -126,6 +170,20 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// PendingPlans queries the current upgrade plan followed by the queued
+	// upgrade plans.
+	//
+	// Since: cosmos-sdk 0.48
+	PendingPlans(context.Context, *QueryPendingPlansRequest) (*QueryPendingPlansResponse, error)
+	// UpgradeReadiness queries the readiness signals for a pending upgrade and
+	// the fraction of the bonded voting power they represent.
+	//
+	// Since: cosmos-sdk 0.48
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
+	// Params queries the x/upgrade module parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -149,6 +207,15 @@ func (UnimplementedQueryServer) UpgradedConsensusState(context.Context, *QueryUp
 func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (UnimplementedQueryServer) PendingPlans(context.Context, *QueryPendingPlansRequest) (*QueryPendingPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPlans not implemented")
+}
+func (UnimplementedQueryServer) UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
@@ -237,6 +304,60 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPlans(ctx, req.(*QueryPendingPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpgradeReadiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAuthorityRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +399,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "PendingPlans",
+			Handler:    _Query_PendingPlans_Handler,
+		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
@@ -21,8 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SoftwareUpgrade_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/SoftwareUpgrade"
-	Msg_CancelUpgrade_FullMethodName   = "/cosmos.upgrade.v1beta1.Msg/CancelUpgrade"
+	Msg_SoftwareUpgrade_FullMethodName    = "/cosmos.upgrade.v1beta1.Msg/SoftwareUpgrade"
+	Msg_CancelUpgrade_FullMethodName      = "/cosmos.upgrade.v1beta1.Msg/CancelUpgrade"
+	Msg_QueueUpgrade_FullMethodName       = "/cosmos.upgrade.v1beta1.Msg/QueueUpgrade"
+	Msg_SignalUpgradeReady_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReady"
+	Msg_UpdateParams_FullMethodName       = "/cosmos.upgrade.v1beta1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +41,22 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// QueueUpgrade is a governance operation for queueing a software upgrade
+	// after the current plan and the other queued plans, without overwriting
+	// them.
+	//
+	// Since: cosmos-sdk 0.48
+	QueueUpgrade(ctx context.Context, in *MsgQueueUpgrade, opts ...grpc.CallOption) (*MsgQueueUpgradeResponse, error)
+	// SignalUpgradeReady is an operation for a validator operator to signal
+	// that the binary handling a pending upgrade is installed.
+	//
+	// Since: cosmos-sdk 0.48
+	SignalUpgradeReady(ctx context.Context, in *MsgSignalUpgradeReady, opts ...grpc.CallOption) (*MsgSignalUpgradeReadyResponse, error)
+	// UpdateParams is a governance operation for updating the x/upgrade module
+	// parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -66,6 +85,33 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) QueueUpgrade(ctx context.Context, in *MsgQueueUpgrade, opts ...grpc.CallOption) (*MsgQueueUpgradeResponse, error) {
+	out := new(MsgQueueUpgradeResponse)
+	err := c.cc.Invoke(ctx, Msg_QueueUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SignalUpgradeReady(ctx context.Context, in *MsgSignalUpgradeReady, opts ...grpc.CallOption) (*MsgSignalUpgradeReadyResponse, error) {
+	out := new(MsgSignalUpgradeReadyResponse)
+	err := c.cc.Invoke(ctx, Msg_SignalUpgradeReady_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -79,6 +125,22 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// QueueUpgrade is a governance operation for queueing a software upgrade
+	// after the current plan and the other queued plans, without overwriting
+	// them.
+	//
+	// Since: cosmos-sdk 0.48
+	QueueUpgrade(context.Context, *MsgQueueUpgrade) (*MsgQueueUpgradeResponse, error)
+	// SignalUpgradeReady is an operation for a validator operator to signal
+	// that the binary handling a pending upgrade is installed.
+	//
+	// Since: cosmos-sdk 0.48
+	SignalUpgradeReady(context.Context, *MsgSignalUpgradeReady) (*MsgSignalUpgradeReadyResponse, error)
+	// UpdateParams is a governance operation for updating the x/upgrade module
+	// parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -92,6 +154,15 @@ func (UnimplementedMsgServer) SoftwareUpgrade(context.Context, *MsgSoftwareUpgra
 func (UnimplementedMsgServer) CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (UnimplementedMsgServer) QueueUpgrade(context.Context, *MsgQueueUpgrade) (*MsgQueueUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueUpgrade not implemented")
+}
+func (UnimplementedMsgServer) SignalUpgradeReady(context.Context, *MsgSignalUpgradeReady) (*MsgSignalUpgradeReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalUpgradeReady not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -141,6 +212,60 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_QueueUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgQueueUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).QueueUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_QueueUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).QueueUpgrade(ctx, req.(*MsgQueueUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SignalUpgradeReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalUpgradeReady)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalUpgradeReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SignalUpgradeReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalUpgradeReady(ctx, req.(*MsgSignalUpgradeReady))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -156,6 +281,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "QueueUpgrade",
+			Handler:    _Msg_QueueUpgrade_Handler,
+		},
+		{
+			MethodName: "SignalUpgradeReady",
+			Handler:    _Msg_SignalUpgradeReady_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
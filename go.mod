@@ -163,6 +163,8 @@ require (
 
 // Below are the short-lived replace of the Cosmos SDK
 replace (
+	// TODO: remove after the next api release.
+	cosmossdk.io/api => ./api
 	// TODO: remove me after collections 0.2. is released.
 	cosmossdk.io/collections => ./collections
 	cosmossdk.io/store => ./store
@@ -3,12 +3,14 @@ package simapp
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"cosmossdk.io/x/evidence"
 	"cosmossdk.io/x/upgrade"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
@@ -16,14 +18,17 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -287,3 +292,44 @@ func TestProtoAnnotations(t *testing.T) {
 	err = msgservice.ValidateProtoAnnotations(r)
 	require.NoError(t, err)
 }
+
+func TestFeeDenomConversion(t *testing.T) {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	priv := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(priv.PubKey().Address().Bytes(), priv.PubKey(), 0, 0)
+	reserveAddr := authtypes.NewModuleAddress(authtypes.FeeDenomReserveName)
+	app := SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{acc},
+		banktypes.Balance{
+			Address: acc.GetAddress().String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000000000), sdk.NewInt64Coin("ibc/atom", 1000)),
+		},
+		banktypes.Balance{
+			Address: reserveAddr.String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		},
+	)
+
+	ctx := app.NewContext(false, cmtproto.Header{})
+	table := authtypes.NewFeeDenomPriceTable(sdk.DefaultBondDenom, authtypes.NewFeeDenomPrice("ibc/atom", sdkmath.LegacyNewDec(2)))
+	require.NoError(t, app.AccountKeeper.SetFeeDenomPriceTable(ctx, table))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 50))
+	msg := banktypes.NewMsgSend(acc.GetAddress(), acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	accNum := app.AccountKeeper.GetAccount(ctx, acc.GetAddress()).GetAccountNumber()
+	tx, err := simtestutil.GenSignedMockTx(rand.New(rand.NewSource(1)), app.TxConfig(), []sdk.Msg{msg}, fee,
+		simtestutil.DefaultGenTxGas, ctx.ChainID(), []uint64{accNum}, []uint64{0}, priv)
+	require.NoError(t, err)
+
+	// the fee paid in the alternative denom is swapped into the native denom
+	// through the fee denom reserve
+	_, _, err = app.SimDeliver(app.TxConfig().TxEncoder(), tx)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), sdk.NewInt64Coin("ibc/atom", 50)), app.BankKeeper.GetAllBalances(ctx, reserveAddr))
+	feeCollectorAddr := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), app.BankKeeper.GetAllBalances(ctx, feeCollectorAddr))
+}
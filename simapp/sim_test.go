@@ -27,6 +27,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -195,6 +196,10 @@ func TestAppImportExport(t *testing.T) {
 		authzkeeper.StoreKey:   {authzkeeper.GrantQueuePrefix},
 		feegrant.StoreKey:      {feegrant.FeeAllowanceQueueKeyPrefix},
 		slashingtypes.StoreKey: {slashingtypes.ValidatorMissedBlockBitmapKeyPrefix},
+		distrtypes.StoreKey: {
+			distrtypes.AutoCompoundCursorKey, distrtypes.VoteSetHashKey, distrtypes.LastVoteSetKey, distrtypes.RewardsAccumulatorKey,
+			distrtypes.ValidatorRewardsCheckpointPrefix, distrtypes.ValidatorRewardsCheckpointConsAddrPrefix,
+		},
 	}
 
 	storeKeys := app.GetStoreKeys()
@@ -83,12 +83,14 @@ func (s *E2ETestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000","withdraw_addr_enabled":true,"auto_compound_interval":"100","auto_compound_max_gas":"1000000"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
-			`base_proposer_reward: "0.000000000000000000"
+			`auto_compound_interval: "100"
+auto_compound_max_gas: "1000000"
+base_proposer_reward: "0.000000000000000000"
 bonus_proposer_reward: "0.000000000000000000"
 community_tax: "0.020000000000000000"
 withdraw_addr_enabled: true`,
@@ -332,8 +332,12 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 			pulsar: &bankapi.MsgMultiSend{},
 		},
 		"slashing/params/empty_dec": {
-			gogo:   &slashingtypes.Params{DowntimeJailDuration: 1e9 + 7},
-			pulsar: &slashingapi.Params{DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7}},
+			gogo: &slashingtypes.Params{DowntimeJailDuration: 1e9 + 7},
+			pulsar: &slashingapi.Params{
+				DowntimeJailDuration:    &durationpb.Duration{Seconds: 1, Nanos: 7},
+				DowntimeLookbackPeriod:  &durationpb.Duration{},
+				MaxDowntimeJailDuration: &durationpb.Duration{},
+			},
 		},
 		// This test cases demonstrates the expected contract and proper way to set a cosmos.Dec field represented
 		// as bytes in protobuf message, namely:
@@ -344,8 +348,10 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 				MinSignedPerWindow:   math.LegacyNewDec(10),
 			},
 			pulsar: &slashingapi.Params{
-				DowntimeJailDuration: &durationpb.Duration{Seconds: 1, Nanos: 7},
-				MinSignedPerWindow:   dec10bz,
+				DowntimeJailDuration:    &durationpb.Duration{Seconds: 1, Nanos: 7},
+				DowntimeLookbackPeriod:  &durationpb.Duration{},
+				MaxDowntimeJailDuration: &durationpb.Duration{},
+				MinSignedPerWindow:      dec10bz,
 			},
 		},
 		"staking/create_validator": {
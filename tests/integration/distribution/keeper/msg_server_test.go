@@ -71,6 +71,7 @@ func initFixture(t testing.TB) *fixture {
 		distrtypes.ModuleName:          {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -724,10 +725,12 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:         communityTax,
+					BaseProposerReward:   math.LegacyZeroDec(),
+					BonusProposerReward:  math.LegacyZeroDec(),
+					WithdrawAddrEnabled:  withdrawAddrEnabled,
+					AutoCompoundInterval: distrtypes.DefaultAutoCompoundInterval,
+					AutoCompoundMaxGas:   distrtypes.DefaultAutoCompoundMaxGas,
 				},
 			},
 			expErr: false,
@@ -942,3 +945,163 @@ func TestMsgDepositValidatorRewardsPool(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+
+	f.distrKeeper.SetParams(ctx, distrtypes.DefaultParams())
+	f.distrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+	f.stakingKeeper.SetParams(ctx, stakingtypes.DefaultParams())
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+
+	initTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, int64(1000))
+	f.bankKeeper.MintCoins(ctx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens.MulRaw(3))))
+
+	valAddr := sdk.ValAddress(PKS[0].Address())
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	owner := sdk.AccAddress(PKS[2].Address())
+	newOwner := sdk.AccAddress([]byte("newOwner____________"))
+	f.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, sdk.AccAddress(valAddr), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens)))
+	f.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, delAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens)))
+
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	tstaking.CreateValidator(valAddr, valConsPk0, sdk.NewInt(100), true)
+	tstaking.Delegate(delAddr, valAddr, sdk.NewInt(100))
+
+	// the delegator tokenizes all its delegation, whose rewards accrue to the owner
+	_, err := f.stakingKeeper.TokenizeShares(ctx, delAddr, valAddr, sdk.NewInt(100), owner)
+	assert.NilError(t, err)
+
+	record, err := f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.NilError(t, err)
+
+	// allocate rewards to the validator in the next block, half of them are
+	// owed to the tokenized delegation
+	allocate := func() {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		val := f.stakingKeeper.Validator(ctx, valAddr)
+		assert.NilError(t, f.distrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 100))))
+	}
+
+	allocate()
+
+	res, err := msgServer.WithdrawTokenizeShareRecordReward(ctx, distrtypes.NewMsgWithdrawTokenizeShareRecordReward(owner))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), res.Amount)
+	assert.DeepEqual(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), f.bankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+	assert.Assert(t, f.bankKeeper.GetBalance(ctx, record.GetModuleAddress(), sdk.DefaultBondDenom).IsZero())
+
+	// the pending rewards are withdrawn to the previous owner on transfer
+	allocate()
+
+	assert.NilError(t, f.stakingKeeper.TransferTokenizeShareRecord(ctx, record.Id, owner, newOwner))
+	assert.DeepEqual(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), f.bankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+
+	allocate()
+
+	_, err = msgServer.WithdrawTokenizeShareRecordReward(ctx, distrtypes.NewMsgWithdrawTokenizeShareRecordReward(newOwner))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), f.bankKeeper.GetBalance(ctx, newOwner, sdk.DefaultBondDenom))
+	assert.DeepEqual(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), f.bankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+
+	params := distrtypes.DefaultParams()
+	params.AutoCompoundInterval = 10
+	f.distrKeeper.SetParams(ctx, params)
+	f.distrKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+	f.stakingKeeper.SetParams(ctx, stakingtypes.DefaultParams())
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+
+	initTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, int64(1000))
+	f.bankKeeper.MintCoins(ctx, distrtypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens.MulRaw(3))))
+
+	valAddr := sdk.ValAddress(PKS[0].Address())
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	otherAddr := sdk.AccAddress(PKS[2].Address())
+	f.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, sdk.AccAddress(valAddr), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens)))
+	f.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, delAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens)))
+
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	tstaking.CreateValidator(valAddr, valConsPk0, sdk.NewInt(100), true)
+	tstaking.Delegate(delAddr, valAddr, sdk.NewInt(100))
+
+	// only existing delegations can be auto-compounded
+	_, err := msgServer.SetAutoCompound(ctx, distrtypes.NewMsgSetAutoCompound(otherAddr, valAddr, true))
+	assert.ErrorIs(t, err, distrtypes.ErrNoDelegationExists)
+
+	_, err = msgServer.SetAutoCompound(ctx, distrtypes.NewMsgSetAutoCompound(delAddr, valAddr, true))
+	assert.NilError(t, err)
+	enabled, err := f.distrKeeper.HasAutoCompound(ctx, delAddr, valAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, enabled)
+
+	// allocate rewards to the validator, half of them are owed to the delegator
+	ctx = ctx.WithBlockHeight(9)
+	val := f.stakingKeeper.Validator(ctx, valAddr)
+	assert.NilError(t, f.distrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 100))))
+
+	balance := f.bankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+
+	// the rewards are not compounded outside of the interval
+	assert.NilError(t, f.distrKeeper.AutoCompoundRewards(ctx))
+	delegation := f.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	assert.DeepEqual(t, math.LegacyNewDec(100), delegation.GetShares())
+
+	// a delegation whose compounding exceeds the gas limit is skipped
+	params.AutoCompoundMaxGas = 1
+	f.distrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(10)
+	assert.NilError(t, f.distrKeeper.AutoCompoundRewards(ctx))
+	delegation = f.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	assert.DeepEqual(t, math.LegacyNewDec(100), delegation.GetShares())
+
+	params.AutoCompoundMaxGas = distrtypes.DefaultAutoCompoundMaxGas
+	f.distrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	assert.NilError(t, f.distrKeeper.AutoCompoundRewards(ctx))
+
+	delegation = f.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	assert.DeepEqual(t, math.LegacyNewDec(150), delegation.GetShares())
+	assert.DeepEqual(t, balance, f.bankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+
+	events := ctx.EventManager().Events()
+	var found bool
+	for _, event := range events {
+		found = found || event.Type == distrtypes.EventTypeAutoCompound
+	}
+	assert.Assert(t, found)
+
+	_, err = msgServer.SetAutoCompound(ctx, distrtypes.NewMsgSetAutoCompound(delAddr, valAddr, false))
+	assert.NilError(t, err)
+	enabled, err = f.distrKeeper.HasAutoCompound(ctx, delAddr, valAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, !enabled)
+
+	_, err = msgServer.SetAutoCompound(ctx, distrtypes.NewMsgSetAutoCompound(delAddr, valAddr, true))
+	assert.NilError(t, err)
+
+	// a delegator with another withdraw address is removed from
+	// auto-compounding, and cannot enable it again
+	assert.NilError(t, f.distrKeeper.SetWithdrawAddr(ctx, delAddr, otherAddr))
+	ctx = ctx.WithBlockHeight(30)
+	assert.NilError(t, f.distrKeeper.AutoCompoundRewards(ctx))
+	enabled, err = f.distrKeeper.HasAutoCompound(ctx, delAddr, valAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, !enabled)
+
+	_, err = msgServer.SetAutoCompound(ctx, distrtypes.NewMsgSetAutoCompound(delAddr, valAddr, true))
+	assert.ErrorIs(t, err, distrtypes.ErrAutoCompoundWithdrawAddr)
+}
@@ -280,6 +280,168 @@ func TestHandleDoubleSign_TooOld(t *testing.T) {
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())) == false)
 }
 
+func TestHandleDoubleSign_RotatedConsPubKey(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.slashingKeeper.Hooks()))
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, oldPubKey := valAddresses[0], pubkeys[0]
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(operatorAddr, oldPubKey, power, true)
+	f.stakingKeeper.EndBlocker(ctx)
+
+	// rotate the consensus key of the validator
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(operatorAddr, newPubKey)
+	assert.NilError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(f.stakingKeeper).RotateConsPubKey(ctx, msg)
+	assert.NilError(t, err)
+	f.stakingKeeper.EndBlocker(ctx)
+
+	oldTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	// evidence of a double sign committed with the old key
+	nci := NewCometInfo(abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Misbehavior{{
+			Validator: abci.Validator{Address: oldPubKey.Address(), Power: power},
+			Type:      abci.MisbehaviorType_DUPLICATE_VOTE,
+			Time:      time.Now().UTC(),
+			Height:    1,
+		}},
+	})
+	f.evidenceKeeper.BeginBlocker(ctx.WithCometInfo(nci))
+
+	// the validator should be slashed, jailed and tombstoned under its new key
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(newPubKey.Address())))
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+}
+
+func TestHandleLightClientAttack(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	for i := 0; i < 2; i++ {
+		tstaking.CreateValidatorWithValPower(valAddresses[i], pubkeys[i], power, true)
+		f.slashingKeeper.AddPubkey(ctx, pubkeys[i])
+		consAddr := sdk.ConsAddress(pubkeys[i].Address())
+		info := slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0))
+		f.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	}
+	f.stakingKeeper.EndBlocker(ctx)
+
+	// the validator set of CometBFT at the common height is the one tracked
+	// ValidatorUpdateDelay blocks before it
+	f.stakingKeeper.TrackHistoricalInfo(ctx)
+
+	// the third validator is bonded in the end block of the common height, so
+	// it is part of the tracked validator set of the next height only
+	commonTime := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(commonTime)
+	f.stakingKeeper.TrackHistoricalInfo(ctx)
+	tstaking.Ctx = ctx
+	tstaking.CreateValidatorWithValPower(valAddresses[2], pubkeys[2], power, true)
+	f.slashingKeeper.AddPubkey(ctx, pubkeys[2])
+	consAddr := sdk.ConsAddress(pubkeys[2].Address())
+	f.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0)))
+	f.stakingKeeper.EndBlocker(ctx)
+
+	oldTokens := f.stakingKeeper.Validator(ctx, valAddresses[0]).GetTokens()
+
+	misbehavior := func(pubKey cryptotypes.PubKey, t time.Time) abci.Misbehavior {
+		return abci.Misbehavior{
+			Validator:        abci.Validator{Address: pubKey.Address(), Power: power},
+			Type:             abci.MisbehaviorType_LIGHT_CLIENT_ATTACK,
+			Time:             t,
+			Height:           2,
+			TotalVotingPower: 2 * power,
+		}
+	}
+	nci := NewCometInfo(abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Misbehavior{
+			// the third validator was not bonded at the common height
+			misbehavior(pubkeys[0], commonTime),
+			misbehavior(pubkeys[2], commonTime),
+			// an attack at a time which is not the time of the common height
+			misbehavior(pubkeys[1], commonTime.Add(time.Second)),
+		},
+	})
+
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(commonTime.Add(time.Minute))
+	f.evidenceKeeper.BeginBlocker(ctx.WithCometInfo(nci))
+
+	// the first validator should be slashed, jailed and tombstoned
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, valAddresses[0]).IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(pubkeys[0].Address())))
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, valAddresses[0]).GetTokens().LT(oldTokens))
+
+	// the second and third validators should be left untouched
+	for i := 1; i < 3; i++ {
+		assert.Assert(t, !f.stakingKeeper.Validator(ctx, valAddresses[i]).IsJailed())
+		assert.Assert(t, !f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(pubkeys[i].Address())))
+		assert.Assert(t, f.stakingKeeper.Validator(ctx, valAddresses[i]).GetTokens().Equal(oldTokens))
+	}
+
+	evidences := f.evidenceKeeper.GetAllEvidence(ctx)
+	assert.Equal(t, 1, len(evidences))
+	attack, ok := evidences[0].(*evidencetypes.LightClientAttack)
+	assert.Assert(t, ok)
+	assert.Equal(t, int64(2), attack.CommonHeight)
+	assert.Equal(t, 2, len(attack.ByzantineValidators))
+}
+
+func TestHandleLightClientAttack_NoHistoricalInfo(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	f.slashingKeeper.AddPubkey(ctx, val)
+	consAddr := sdk.ConsAddress(val.Address())
+	f.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0)))
+	f.stakingKeeper.EndBlocker(ctx)
+
+	oldTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	nci := NewCometInfo(abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Misbehavior{{
+			Validator:        abci.Validator{Address: val.Address(), Power: power},
+			Type:             abci.MisbehaviorType_LIGHT_CLIENT_ATTACK,
+			Time:             time.Now().UTC(),
+			Height:           1,
+			TotalVotingPower: power,
+		}},
+	})
+	f.evidenceKeeper.BeginBlocker(ctx.WithBlockHeight(2).WithCometInfo(nci))
+
+	// without historical info the attack is handled as an equivocation
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, consAddr))
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+
+	evidences := f.evidenceKeeper.GetAllEvidence(ctx)
+	assert.Equal(t, 1, len(evidences))
+	equivocation, ok := evidences[0].(*evidencetypes.Equivocation)
+	assert.Assert(t, ok)
+	assert.Equal(t, int64(1), equivocation.Height)
+	assert.Equal(t, consAddr.String(), equivocation.ConsensusAddress)
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
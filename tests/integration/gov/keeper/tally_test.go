@@ -7,6 +7,7 @@ import (
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -143,6 +144,43 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
 }
 
+func TestTallyMessageBasedParams(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
+
+	// 6/11 Yes votes pass with the default threshold, but not with the
+	// stricter threshold of the MsgSend override
+	params, err := app.GovKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.MessageBasedParams = []v1.MessageBasedParams{{
+		MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		Threshold:  "0.66",
+	}}
+	assert.NilError(t, app.GovKeeper.Params.Set(ctx, params))
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	assert.Assert(t, ok)
+	passes, burnDeposits, tallyResults, _ := app.GovKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
+}
+
 func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
@@ -293,6 +331,76 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
 }
 
+func TestTallyGovernanceDelegation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		constituentVote *v1.VoteOption
+		expPass         bool
+		expYes, expNo   int64
+	}{
+		"representative votes on behalf of its constituent": {
+			expPass: false,
+			expYes:  18,
+			expNo:   30,
+		},
+		"constituent direct vote overrides the representative": {
+			constituentVote: func() *v1.VoteOption { o := v1.OptionYes; return &o }(),
+			expPass:         true,
+			expYes:          48,
+			expNo:           0,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			f := initFixture(t)
+
+			app, ctx := f.app, f.ctx
+
+			addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+			representative, constituent := addrs[3], addrs[4]
+
+			delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+			val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+			assert.Assert(t, found)
+
+			_, err := app.StakingKeeper.Delegate(ctx, constituent, delTokens, stakingtypes.Unbonded, val1, true)
+			assert.NilError(t, err)
+
+			app.StakingKeeper.EndBlocker(ctx)
+
+			assert.NilError(t, app.GovKeeper.DelegateGovernance(ctx, constituent, representative))
+
+			tp := TestProposal
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+			assert.NilError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, representative, v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			if tc.constituentVote != nil {
+				assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, constituent, v1.NewNonSplitVoteOption(*tc.constituentVote), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			assert.Assert(t, ok)
+			passes, burnDeposits, tallyResults, err := app.GovKeeper.Tally(ctx, proposal)
+			assert.NilError(t, err)
+
+			assert.Equal(t, tc.expPass, passes)
+			assert.Assert(t, burnDeposits == false)
+			assert.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, tc.expYes).String(), tallyResults.YesCount)
+			assert.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, tc.expNo).String(), tallyResults.NoCount)
+		})
+	}
+}
+
 func TestTallyDelgatorInherit(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
@@ -501,3 +609,153 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	assert.Assert(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyOptimistic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rejectedThreshold string
+		noVoters          []int
+		expPass           bool
+		expErr            bool
+	}{
+		"no votes": {
+			rejectedThreshold: "0.1",
+			expPass:           true,
+		},
+		"no votes reach the rejected threshold": {
+			rejectedThreshold: "0.1",
+			noVoters:          []int{0},
+			expPass:           false,
+		},
+		"no votes below the rejected threshold": {
+			rejectedThreshold: "0.5",
+			noVoters:          []int{2},
+			expPass:           true,
+		},
+		"no votes above the rejected threshold": {
+			rejectedThreshold: "0.5",
+			noVoters:          []int{0, 1},
+			expPass:           false,
+		},
+		"unset rejected threshold": {
+			rejectedThreshold: "",
+			expErr:            true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			f := initFixture(t)
+
+			app, ctx := f.app, f.ctx
+
+			addrs, _ := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+			params, err := app.GovKeeper.Params.Get(ctx)
+			assert.NilError(t, err)
+			params.OptimisticRejectedThreshold = tc.rejectedThreshold
+			params.OptimisticAuthorizedAddresses = []string{addrs[3].String()}
+			assert.NilError(t, app.GovKeeper.Params.Set(ctx, params))
+
+			tp := TestProposal
+			proposal, err := app.GovKeeper.SubmitOptimisticProposal(ctx, tp, "", "test", "description", addrs[3])
+			assert.NilError(t, err)
+			proposalID := proposal.Id
+
+			for _, i := range tc.noVoters {
+				assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[i], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			assert.Assert(t, ok)
+			passes, burnDeposits, _, err := app.GovKeeper.Tally(ctx, proposal)
+			if tc.expErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+
+			assert.Equal(t, tc.expPass, passes)
+			assert.Assert(t, burnDeposits == false)
+		})
+	}
+}
+
+func TestTallyMultipleChoice(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		delegatorVotes bool
+		expCounts      []int64
+		expWinner      uint32
+	}{
+		"delegator inherits validator choices": {
+			expCounts: []int64{8, 40},
+			expWinner: 1,
+		},
+		"delegator overrides validator choices": {
+			delegatorVotes: true,
+			expCounts:      []int64{38, 10},
+			expWinner:      0,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			f := initFixture(t)
+
+			app, ctx := f.app, f.ctx
+
+			addrs, vals := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+			delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+			val3, found := app.StakingKeeper.GetValidator(ctx, vals[2])
+			assert.Assert(t, found)
+
+			_, err := app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val3, true)
+			assert.NilError(t, err)
+
+			app.StakingKeeper.EndBlocker(ctx)
+
+			optionA, err := v1.NewProposalOption("Option A", TestProposal)
+			assert.NilError(t, err)
+			optionB, err := v1.NewProposalOption("Option B", TestProposal)
+			assert.NilError(t, err)
+
+			proposal, err := app.GovKeeper.SubmitMultipleChoiceProposal(ctx, []*v1.ProposalOption{optionA, optionB}, "", "test", "description", addrs[0])
+			assert.NilError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			split := v1.WeightedVoteChoices{
+				v1.NewWeightedVoteChoice(0, sdk.NewDecWithPrec(5, 1)),
+				v1.NewWeightedVoteChoice(1, sdk.NewDecWithPrec(5, 1)),
+			}
+			assert.NilError(t, app.GovKeeper.AddChoiceVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteChoice(0), ""))
+			assert.NilError(t, app.GovKeeper.AddChoiceVote(ctx, proposalID, addrs[1], split, ""))
+			assert.NilError(t, app.GovKeeper.AddChoiceVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteChoice(1), ""))
+			if tc.delegatorVotes {
+				assert.NilError(t, app.GovKeeper.AddChoiceVote(ctx, proposalID, addrs[3], v1.NewNonSplitVoteChoice(0), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			assert.Assert(t, ok)
+			passes, burnDeposits, tallyResults, _ := app.GovKeeper.Tally(ctx, proposal)
+
+			assert.Assert(t, passes)
+			assert.Assert(t, burnDeposits == false)
+			assert.Equal(t, len(tc.expCounts), len(tallyResults.OptionCounts))
+			for i, count := range tc.expCounts {
+				assert.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, count).String(), tallyResults.OptionCounts[i])
+			}
+
+			winner, hasWinner := tallyResults.WinningOption()
+			assert.Assert(t, hasWinner)
+			assert.Equal(t, tc.expWinner, winner)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -423,3 +424,81 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	f.stakingKeeper.EndBlocker(f.ctx)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test a validator being down again within the downtime lookback period
+// Ensure that the downtime penalties escalate and reset after the lookback period
+func TestHandleRepeatDowntime(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	params := f.slashingKeeper.GetParams(f.ctx)
+	params.DowntimeLookbackPeriod = time.Hour
+	assert.NilError(t, f.slashingKeeper.SetParams(f.ctx, params))
+
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := f.valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, f.ctx, f.stakingKeeper)
+
+	f.slashingKeeper.AddPubkey(f.ctx, val)
+
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, f.ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0))
+	f.slashingKeeper.SetValidatorSigningInfo(f.ctx, consAddr, info)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	f.stakingKeeper.EndBlocker(f.ctx)
+
+	// 1000 first blocks OK
+	height := int64(0)
+	for ; height < f.slashingKeeper.SignedBlocksWindow(f.ctx); height++ {
+		f.ctx = f.ctx.WithBlockHeight(height)
+		f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, true)
+	}
+
+	// downtime misses enough blocks at the given time for the validator to be
+	// jailed and returns its signing info
+	downtime := func(blockTime time.Time) slashingtypes.ValidatorSigningInfo {
+		f.ctx = f.ctx.WithBlockTime(blockTime)
+		maxMissed := f.slashingKeeper.SignedBlocksWindow(f.ctx) - f.slashingKeeper.MinSignedPerWindow(f.ctx)
+		for end := height + maxMissed + 1; height < end; height++ {
+			f.ctx = f.ctx.WithBlockHeight(height)
+			f.slashingKeeper.HandleValidatorSignature(f.ctx, val.Address(), power, false)
+		}
+		f.stakingKeeper.EndBlocker(f.ctx)
+		tstaking.CheckValidator(addr, stakingtypes.Unbonding, true)
+
+		info, found := f.slashingKeeper.GetValidatorSigningInfo(f.ctx, consAddr)
+		assert.Assert(t, found)
+		return info
+	}
+
+	// rejoin unjails the validator and bonds it again
+	rejoin := func() {
+		f.stakingKeeper.Unjail(f.ctx, consAddr)
+		f.stakingKeeper.EndBlocker(f.ctx)
+		tstaking.CheckValidator(addr, stakingtypes.Bonded, false)
+	}
+
+	// the first downtime is penalized with the base penalties
+	t0 := time.Unix(1000, 0)
+	info = downtime(t0)
+	assert.Equal(t, int64(1), info.DowntimeJailCount)
+	assert.Assert(t, t0.Add(params.DowntimeJailDuration).Equal(info.JailedUntil))
+	tokens := f.stakingKeeper.Validator(f.ctx, addr).GetTokens()
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(f.ctx, power).Sub(f.stakingKeeper.TokensFromConsensusPower(f.ctx, 1)), tokens)
+
+	// a downtime within the lookback period is penalized more heavily
+	rejoin()
+	t1 := info.JailedUntil.Add(params.DowntimeLookbackPeriod)
+	info = downtime(t1)
+	assert.Equal(t, int64(2), info.DowntimeJailCount)
+	assert.Assert(t, t1.Add(2*params.DowntimeJailDuration).Equal(info.JailedUntil))
+	expBurned := math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(f.ctx, power)).Mul(params.SlashFractionDowntime.MulInt64(2)).TruncateInt()
+	assert.DeepEqual(t, tokens.Sub(expBurned), f.stakingKeeper.Validator(f.ctx, addr).GetTokens())
+
+	// the penalties reset after the lookback period
+	rejoin()
+	info = downtime(info.JailedUntil.Add(params.DowntimeLookbackPeriod + time.Second))
+	assert.Equal(t, int64(1), info.DowntimeJailCount)
+}
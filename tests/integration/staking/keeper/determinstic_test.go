@@ -402,7 +402,7 @@ func TestGRPCValidatorDelegations(t *testing.T) {
 		ValidatorAddr: validator.OperatorAddress,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.ValidatorDelegations, 14475, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.ValidatorDelegations, 15258, false)
 }
 
 func TestGRPCValidatorUnbondingDelegations(t *testing.T) {
@@ -481,7 +481,7 @@ func TestGRPCDelegation(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Delegation, 4635, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Delegation, 4896, false)
 }
 
 func TestGRPCUnbondingDelegation(t *testing.T) {
@@ -554,7 +554,7 @@ func TestGRPCDelegatorDelegations(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.DelegatorDelegations, 4238, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.DelegatorDelegations, 4499, false)
 }
 
 func TestGRPCDelegatorValidator(t *testing.T) {
@@ -733,7 +733,7 @@ func TestGRPCPool(t *testing.T) {
 
 	f = initDeterministicFixture(t) // reset
 	getStaticValidator(f, t)
-	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6242, false)
+	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6503, false)
 }
 
 func TestGRPCRedelegations(t *testing.T) {
@@ -807,12 +807,18 @@ func TestGRPCParams(t *testing.T) {
 
 	rapid.Check(t, func(rt *rapid.T) {
 		params := stakingtypes.Params{
-			BondDenom:         rapid.StringMatching(sdk.DefaultCoinDenomRegex()).Draw(rt, "bond-denom"),
-			UnbondingTime:     durationGenerator().Draw(rt, "duration"),
-			MaxValidators:     rapid.Uint32Min(1).Draw(rt, "max-validators"),
-			MaxEntries:        rapid.Uint32Min(1).Draw(rt, "max-entries"),
-			HistoricalEntries: rapid.Uint32Min(1).Draw(rt, "historical-entries"),
-			MinCommissionRate: sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
+			BondDenom:                   rapid.StringMatching(sdk.DefaultCoinDenomRegex()).Draw(rt, "bond-denom"),
+			UnbondingTime:               durationGenerator().Draw(rt, "duration"),
+			MaxValidators:               rapid.Uint32Min(1).Draw(rt, "max-validators"),
+			MaxEntries:                  rapid.Uint32Min(1).Draw(rt, "max-entries"),
+			HistoricalEntries:           rapid.Uint32Min(1).Draw(rt, "historical-entries"),
+			MinCommissionRate:           sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
+			KeyRotationFee:              sdk.NewInt64Coin(sdk.DefaultBondDenom, rapid.Int64Min(0).Draw(rt, "key-rotation-fee")),
+			GlobalLiquidStakingCap:      sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "global-liquid-staking-cap"), 2),
+			ValidatorLiquidStakingCap:   sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "validator-liquid-staking-cap"), 2),
+			MaxValidatorPowerRatio:      sdk.NewDecWithPrec(rapid.Int64Range(1, 100).Draw(rt, "max-validator-power-ratio"), 2),
+			MaxQueueCompletionsPerBlock: rapid.Uint32().Draw(rt, "max-queue-completions-per-block"),
+			SlashReversalWindow:         durationGenerator().Draw(rt, "slash-reversal-window"),
 		}
 
 		err := f.stakingKeeper.SetParams(f.ctx, params)
@@ -822,16 +828,22 @@ func TestGRPCParams(t *testing.T) {
 	})
 
 	params := stakingtypes.Params{
-		BondDenom:         "denom",
-		UnbondingTime:     time.Hour,
-		MaxValidators:     85,
-		MaxEntries:        5,
-		HistoricalEntries: 5,
-		MinCommissionRate: sdk.NewDecWithPrec(5, 2),
+		BondDenom:                   "denom",
+		UnbondingTime:               time.Hour,
+		MaxValidators:               85,
+		MaxEntries:                  5,
+		HistoricalEntries:           5,
+		MinCommissionRate:           sdk.NewDecWithPrec(5, 2),
+		KeyRotationFee:              sdk.NewInt64Coin("denom", 1000000),
+		GlobalLiquidStakingCap:      sdk.NewDecWithPrec(25, 2),
+		ValidatorLiquidStakingCap:   sdk.NewDecWithPrec(50, 2),
+		MaxValidatorPowerRatio:      sdk.NewDecWithPrec(20, 2),
+		MaxQueueCompletionsPerBlock: 100,
+		SlashReversalWindow:         time.Hour,
 	}
 
 	err := f.stakingKeeper.SetParams(f.ctx, params)
 	assert.NilError(t, err)
 
-	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1114, false)
+	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1369, false)
 }
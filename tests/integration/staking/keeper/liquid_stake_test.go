@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+	delAddr, owner, newOwner := addrs[0], addrs[2], addrs[3]
+	valAddr := valAddrs[0]
+
+	delegation, found := f.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	assert.Assert(t, found)
+
+	tokenizeAmount := f.stakingKeeper.TokensFromConsensusPower(ctx, 4)
+	res, err := msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeAmount), owner))
+	assert.NilError(t, err)
+
+	record, err := f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, owner.String(), record.Owner)
+	assert.Equal(t, valAddr.String(), record.Validator)
+	assert.Equal(t, uint64(1), f.stakingKeeper.GetLastTokenizeShareRecordID(ctx))
+	assert.DeepEqual(t, sdk.NewCoin(record.GetShareTokenDenom(), tokenizeAmount), res.Amount)
+	assert.DeepEqual(t, res.Amount, f.bankKeeper.GetBalance(ctx, delAddr, record.GetShareTokenDenom()))
+
+	// the tokenized shares are moved to the delegation of the record
+	recordDelegation, found := f.stakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokenizeAmount), recordDelegation.Shares)
+
+	remaining, found := f.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, delegation.Shares.Sub(math.LegacyNewDecFromInt(tokenizeAmount)), remaining.Shares)
+
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokenizeAmount), f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+	assert.DeepEqual(t, tokenizeAmount, f.stakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	byDenom, err := f.stakingKeeper.GetTokenizeShareRecordByDenom(ctx, record.GetShareTokenDenom())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, record, byDenom)
+	assert.DeepEqual(t, []types.TokenizeShareRecord{record}, f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+
+	// only the owner can transfer the record
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, types.NewMsgTransferTokenizeShareRecord(record.Id, delAddr, newOwner))
+	assert.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, types.NewMsgTransferTokenizeShareRecord(record.Id, owner, newOwner))
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)))
+	assert.Equal(t, 1, len(f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, newOwner)))
+
+	// share tokens are transferable and redeemable by their holder
+	halfAmount := tokenizeAmount.QuoRaw(2)
+	assert.NilError(t, f.bankKeeper.SendCoins(ctx, delAddr, addrs[4], sdk.NewCoins(sdk.NewCoin(record.GetShareTokenDenom(), halfAmount))))
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(addrs[4], sdk.NewCoin(record.GetShareTokenDenom(), halfAmount)))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoin(bondDenom, halfAmount), redeemRes.Amount)
+
+	redeemed, found := f.stakingKeeper.GetDelegation(ctx, addrs[4], valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(halfAmount), redeemed.Shares)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokenizeAmount.Sub(halfAmount)), f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+
+	// redeeming more share tokens than owned fails
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(addrs[4], sdk.NewCoin(record.GetShareTokenDenom(), halfAmount)))
+	assert.ErrorContains(t, err, "insufficient funds")
+
+	// redeeming the last share tokens removes the record
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(delAddr, sdk.NewCoin(record.GetShareTokenDenom(), tokenizeAmount.Sub(halfAmount))))
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	assert.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+	_, found = f.stakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	assert.Assert(t, !found)
+
+	final, found := f.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, delegation.Shares.Sub(math.LegacyNewDecFromInt(halfAmount)), final.Shares)
+	assert.Assert(t, f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+	assert.Assert(t, f.stakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	assert.Assert(t, f.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+	delAddr, valAddr := addrs[0], valAddrs[0]
+
+	// 6 of the 15 bonded powers can be tokenized globally, and 5 of the 10
+	// powers of the validator
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 15), f.stakingKeeper.TotalBondedTokens(ctx))
+
+	params := f.stakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(4, 1)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	tokenize := func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, power int64) error {
+		amount := sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, power))
+		_, err := msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr, valAddr, amount, delAddr))
+		return err
+	}
+
+	assert.ErrorIs(t, tokenize(delAddr, valAddr, 6), types.ErrValidatorLiquidStakingCapExceeded)
+	assert.NilError(t, tokenize(delAddr, valAddr, 3))
+	assert.ErrorIs(t, tokenize(delAddr, valAddr, 3), types.ErrValidatorLiquidStakingCapExceeded)
+	assert.NilError(t, tokenize(delAddr, valAddr, 2))
+
+	// the global cap also applies to the other validators
+	otherDelegation := f.stakingKeeper.GetValidatorDelegations(ctx, valAddrs[1])[0]
+	otherDelAddr := sdk.MustAccAddressFromBech32(otherDelegation.DelegatorAddress)
+	assert.ErrorIs(t, tokenize(otherDelAddr, valAddrs[1], 2), types.ErrGlobalLiquidStakingCapExceeded)
+	assert.NilError(t, tokenize(otherDelAddr, valAddrs[1], 1))
+}
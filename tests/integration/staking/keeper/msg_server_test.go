@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	"gotest.tools/v3/assert"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -168,3 +169,84 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxValidatorPowerRatio(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+
+	// the first validator has 10 of the 15 bonded powers
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 15), f.stakingKeeper.TotalBondedTokens(ctx))
+
+	params := f.stakingKeeper.GetParams(ctx)
+	params.MaxValidatorPowerRatio = math.LegacyNewDecWithPrec(75, 2)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	coin := func(power int64) sdk.Coin {
+		return sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, power))
+	}
+
+	// 16 of 21 powers exceed the ratio, 11 of 16 do not
+	_, err := msgServer.Delegate(ctx, types.NewMsgDelegate(addrs[0], valAddrs[0], coin(6)))
+	assert.ErrorIs(t, err, types.ErrValidatorPowerRatioExceeded)
+
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(addrs[0], valAddrs[0], coin(1)))
+	assert.NilError(t, err)
+
+	// redelegated bonded tokens are already counted in the bonded tokens: 15 of
+	// 16 powers exceed the ratio
+	_, err = msgServer.BeginRedelegate(ctx, types.NewMsgBeginRedelegate(addrs[1], valAddrs[1], valAddrs[0], coin(4)))
+	assert.ErrorIs(t, err, types.ErrValidatorPowerRatioExceeded)
+
+	// the ratio is enforced by the keeper too
+	validator, found := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+	_, err = f.stakingKeeper.Delegate(ctx, addrs[0], coin(6).Amount, types.Unbonded, validator, true)
+	assert.ErrorIs(t, err, types.ErrValidatorPowerRatioExceeded)
+
+	// a new validator cannot hold more than the ratio either
+	newValAddr := sdk.ValAddress(addrs[3])
+	msg, err := types.NewMsgCreateValidator(
+		newValAddr, PKs[3], coin(60), types.NewDescription("NewVal", "", "", "", ""),
+		types.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	assert.NilError(t, err)
+
+	_, err = msgServer.CreateValidator(ctx, msg)
+	assert.ErrorIs(t, err, types.ErrValidatorPowerRatioExceeded)
+	_, found = f.stakingKeeper.GetValidator(ctx, newValAddr)
+	assert.Assert(t, !found)
+}
+
+func TestUpdateParamsMinCommissionRate(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+
+	_, _, vals := createValidators(t, f, []int64{10, 20, 5})
+
+	minRate := math.LegacyNewDecWithPrec(5, 2)
+	params := f.stakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = minRate
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: f.stakingKeeper.GetAuthority(),
+		Params:    params,
+	})
+	assert.NilError(t, err)
+
+	// the validators created with a lower commission rate are raised to the min
+	for _, val := range vals {
+		validator, found := f.stakingKeeper.GetValidator(ctx, val.GetOperator())
+		assert.Assert(t, found)
+		assert.DeepEqual(t, minRate, validator.Commission.Rate)
+		assert.Assert(t, validator.Commission.MaxRate.GTE(minRate))
+	}
+}
@@ -0,0 +1,372 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestSlashRecordAndReverseSlash(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	bondDenom := f.stakingKeeper.BondDenom(ctx)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+	delAddr, valAddr := addrs[0], valAddrs[0]
+
+	// unbond 2 of the 10 tokens bonded to the validator at the infraction height
+	_, _, err := f.stakingKeeper.Undelegate(ctx, delAddr, valAddr, math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 2)))
+	assert.NilError(t, err)
+
+	validator, found := f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.Assert(t, found)
+	consAddr, err := validator.GetConsAddr()
+	assert.NilError(t, err)
+
+	supply := f.bankKeeper.GetSupply(ctx, bondDenom)
+
+	ctx = ctx.WithBlockHeight(12)
+	burned := f.stakingKeeper.Slash(ctx, consAddr, 10, 10, math.LegacyNewDecWithPrec(5, 1))
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 4), burned)
+
+	// the slash is recorded with the tokens burned from the validator and from
+	// the unbonding delegation entry, without an entry per delegation
+	record, err := f.stakingKeeper.GetSlashRecord(ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), f.stakingKeeper.GetLastSlashRecordID(ctx))
+	assert.Equal(t, valAddr.String(), record.ValidatorAddress)
+	assert.Equal(t, int64(10), record.InfractionHeight)
+	assert.Equal(t, int64(12), record.Height)
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 5), record.Burned)
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 4), record.ValidatorBurned)
+	assert.DeepEqual(t, validator.DelegatorShares, record.DelegatorShares)
+	assert.Equal(t, 0, len(f.stakingKeeper.GetSlashedDelegations(ctx, record.Id)))
+	assert.Equal(t, 0, len(f.stakingKeeper.GetSlashedDelegationShares(ctx, record.Id)))
+
+	// the tokens burned from the delegation are derived from its shares
+	amount, err := f.stakingKeeper.GetSlashRecordDelegation(ctx, record, delAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 4), amount)
+	entries := f.stakingKeeper.GetSlashedUnbondingEntries(ctx, record.Id)
+	assert.Equal(t, 1, len(entries))
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 1), entries[0].Amount)
+	assert.DeepEqual(t, []types.SlashRecord{record}, f.stakingKeeper.GetValidatorSlashRecords(ctx, valAddr))
+	assert.Equal(t, 0, len(f.stakingKeeper.GetValidatorSlashRecords(ctx, valAddrs[1])))
+
+	// only the authority can reverse a slash
+	_, err = msgServer.ReverseSlash(ctx, types.NewMsgReverseSlash(delAddr.String(), record.Id))
+	assert.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	res, err := msgServer.ReverseSlash(ctx, types.NewMsgReverseSlash(f.stakingKeeper.GetAuthority(), record.Id))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, record.Burned, res.Restored)
+
+	// the burned tokens are minted back to the delegation and the unbonding
+	// delegation entry
+	assert.DeepEqual(t, supply, f.bankKeeper.GetSupply(ctx, bondDenom))
+
+	validator, found = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 8), validator.Tokens)
+
+	delegation, found := f.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 8), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	ubd, found := f.stakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 2), ubd.Entries[0].Balance)
+
+	record, err = f.stakingKeeper.GetSlashRecord(ctx, record.Id)
+	assert.NilError(t, err)
+	assert.Assert(t, record.Reversed)
+	assert.Equal(t, int64(12), record.ReversalHeight)
+
+	// a slash can only be reversed once
+	_, err = msgServer.ReverseSlash(ctx, types.NewMsgReverseSlash(f.stakingKeeper.GetAuthority(), record.Id))
+	assert.ErrorIs(t, err, types.ErrSlashAlreadyReversed)
+
+	_, err = msgServer.ReverseSlash(ctx, types.NewMsgReverseSlash(f.stakingKeeper.GetAuthority(), 2))
+	assert.ErrorIs(t, err, types.ErrSlashRecordNotExists)
+}
+
+func TestReverseSlashDelegatorsChanged(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+	valAddr, leavingAddr, joiningAddr := valAddrs[0], addrs[2], addrs[3]
+
+	validator, found := f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.Assert(t, found)
+	_, err := f.stakingKeeper.Delegate(ctx, leavingAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 10), types.Unbonded, validator, true)
+	assert.NilError(t, err)
+	consAddr, err := validator.GetConsAddr()
+	assert.NilError(t, err)
+
+	ctx = ctx.WithBlockHeight(12)
+	burned := f.stakingKeeper.Slash(ctx, consAddr, 10, 20, math.LegacyNewDecWithPrec(5, 1))
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 10), burned)
+
+	record, err := f.stakingKeeper.GetSlashRecord(ctx, 1)
+	assert.NilError(t, err)
+
+	// a delegator leaves and another one joins before the reversal, the shares
+	// they held at the slash are recorded
+	delegation, found := f.stakingKeeper.GetDelegation(ctx, leavingAddr, valAddr)
+	assert.Assert(t, found)
+	leavingShares := delegation.Shares
+	_, _, err = f.stakingKeeper.Undelegate(ctx, leavingAddr, valAddr, delegation.Shares)
+	assert.NilError(t, err)
+
+	validator, found = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.Assert(t, found)
+	_, err = f.stakingKeeper.Delegate(ctx, joiningAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 10), types.Unbonded, validator, true)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []types.SlashedDelegationShares{
+		types.NewSlashedDelegationShares(record.Id, leavingAddr.String(), valAddr.String(), leavingShares),
+		types.NewSlashedDelegationShares(record.Id, joiningAddr.String(), valAddr.String(), math.LegacyZeroDec()),
+	}, f.stakingKeeper.GetSlashedDelegationShares(ctx, record.Id))
+
+	// the tokens burned from each delegation are derived from the shares it
+	// held at the slash
+	querier := keeper.NewQuerier(f.stakingKeeper)
+	for _, tc := range []struct {
+		delAddr sdk.AccAddress
+		power   int64
+	}{
+		{addrs[0], 5},
+		{leavingAddr, 5},
+		{joiningAddr, 0},
+	} {
+		res, err := querier.SlashRecordDelegation(ctx, &types.QuerySlashRecordDelegationRequest{Id: record.Id, DelegatorAddr: tc.delAddr.String()})
+		assert.NilError(t, err)
+		assert.DeepEqual(t, types.NewSlashedDelegation(record.Id, tc.delAddr.String(), valAddr.String(), f.stakingKeeper.TokensFromConsensusPower(ctx, tc.power)), res.Delegation)
+	}
+
+	_, err = querier.SlashRecordDelegation(ctx, &types.QuerySlashRecordDelegationRequest{Id: record.Id + 1, DelegatorAddr: leavingAddr.String()})
+	assert.ErrorContains(t, err, types.ErrSlashRecordNotExists.Error())
+
+	restored, err := f.stakingKeeper.ReverseSlash(ctx, record.Id)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, burned, restored)
+
+	// the burned tokens are refunded to the delegators they were burned from
+	validator, found = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 25), validator.Tokens)
+
+	for _, tc := range []struct {
+		delAddr sdk.AccAddress
+		power   int64
+	}{
+		{addrs[0], 10},
+		{leavingAddr, 5},
+		{joiningAddr, 10},
+	} {
+		delegation, found := f.stakingKeeper.GetDelegation(ctx, tc.delAddr, valAddr)
+		assert.Assert(t, found)
+		assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, tc.power), validator.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+}
+
+func TestReverseSlashRoundingRemainder(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+	valAddr := valAddrs[0]
+
+	for _, delAddr := range addrs[2:4] {
+		validator, found := f.stakingKeeper.GetValidator(ctx, valAddr)
+		assert.Assert(t, found)
+		_, err := f.stakingKeeper.Delegate(ctx, delAddr, math.NewInt(3), types.Unbonded, validator, true)
+		assert.NilError(t, err)
+	}
+
+	validator, found := f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.Assert(t, found)
+	consAddr, err := validator.GetConsAddr()
+	assert.NilError(t, err)
+
+	ctx = ctx.WithBlockHeight(12)
+	burned := f.stakingKeeper.Slash(ctx, consAddr, 12, 10, math.LegacyNewDecWithPrec(5, 1))
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 5), burned)
+
+	// the tokens burned from each delegation are truncated: 3 tokens less than
+	// half of the validator delegation and 1 token from each delegation of 3
+	// tokens, the remainder of 1 token stays burned
+	restored, err := f.stakingKeeper.ReverseSlash(ctx, 1)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, burned.SubRaw(1), restored)
+
+	validator, found = f.stakingKeeper.GetValidator(ctx, valAddr)
+	assert.Assert(t, found)
+	assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 10).AddRaw(5), validator.Tokens)
+}
+
+func TestSlashRecordRedelegations(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+	srcAddr, dstAddr := valAddrs[0], valAddrs[1]
+
+	// two delegators redelegate after the infraction
+	for _, delAddr := range addrs[2:4] {
+		validator, found := f.stakingKeeper.GetValidator(ctx, srcAddr)
+		assert.Assert(t, found)
+		shares, err := f.stakingKeeper.Delegate(ctx, delAddr, f.stakingKeeper.TokensFromConsensusPower(ctx, 4), types.Unbonded, validator, true)
+		assert.NilError(t, err)
+
+		_, err = f.stakingKeeper.BeginRedelegation(ctx.WithBlockHeight(11), delAddr, srcAddr, dstAddr, shares)
+		assert.NilError(t, err)
+	}
+
+	validator, found := f.stakingKeeper.GetValidator(ctx, srcAddr)
+	assert.Assert(t, found)
+	consAddr, err := validator.GetConsAddr()
+	assert.NilError(t, err)
+
+	ctx = ctx.WithBlockHeight(12)
+	f.stakingKeeper.Slash(ctx, consAddr, 10, 18, math.LegacyNewDecWithPrec(5, 1))
+
+	// the tokens burned from the delegation of each redelegation to its
+	// destination validator are recorded, and can be queried page by page
+	querier := keeper.NewQuerier(f.stakingKeeper)
+	res, err := querier.SlashRecordDelegations(ctx, &types.QuerySlashRecordDelegationsRequest{
+		Id:         1,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Delegations))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	delegations := res.Delegations
+
+	res, err = querier.SlashRecordDelegations(ctx, &types.QuerySlashRecordDelegationsRequest{
+		Id:         1,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Delegations))
+	assert.Assert(t, res.Pagination.NextKey == nil)
+	delegations = append(delegations, res.Delegations...)
+
+	for _, delegation := range delegations {
+		assert.Equal(t, dstAddr.String(), delegation.ValidatorAddress)
+		assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 2), delegation.Amount)
+	}
+
+	_, err = querier.SlashRecordDelegations(ctx, &types.QuerySlashRecordDelegationsRequest{Id: 2})
+	assert.ErrorContains(t, err, types.ErrSlashRecordNotExists.Error())
+
+	// the tokens burned from the redelegations are delegated back to their
+	// destination validator
+	_, err = f.stakingKeeper.ReverseSlash(ctx, 1)
+	assert.NilError(t, err)
+
+	dst, found := f.stakingKeeper.GetValidator(ctx, dstAddr)
+	assert.Assert(t, found)
+	for _, delAddr := range addrs[2:4] {
+		delegation, found := f.stakingKeeper.GetDelegation(ctx, delAddr, dstAddr)
+		assert.Assert(t, found)
+		assert.DeepEqual(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 4), dst.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+}
+
+func TestReverseSlashWindowExpired(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+
+	_, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+
+	validator, found := f.stakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+	consAddr, err := validator.GetConsAddr()
+	assert.NilError(t, err)
+
+	f.stakingKeeper.Slash(ctx, consAddr, 10, 10, math.LegacyNewDecWithPrec(1, 1))
+
+	// the slash cannot be reversed after the window
+	window := f.stakingKeeper.SlashReversalWindow(ctx)
+	_, err = msgServer.ReverseSlash(ctx.WithBlockTime(ctx.BlockTime().Add(window+time.Second)), types.NewMsgReverseSlash(f.stakingKeeper.GetAuthority(), 1))
+	assert.ErrorIs(t, err, types.ErrSlashReversalWindowExpired)
+
+	// nor at all when the window is zero
+	params := f.stakingKeeper.GetParams(ctx)
+	params.SlashReversalWindow = 0
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	_, err = msgServer.ReverseSlash(ctx, types.NewMsgReverseSlash(f.stakingKeeper.GetAuthority(), 1))
+	assert.ErrorIs(t, err, types.ErrSlashReversalWindowExpired)
+}
+
+func TestPruneExpiredSlashRecords(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	window := f.stakingKeeper.SlashReversalWindow(ctx)
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 5})
+
+	for i, valAddr := range valAddrs[:2] {
+		validator, found := f.stakingKeeper.GetValidator(ctx, valAddr)
+		assert.Assert(t, found)
+		consAddr, err := validator.GetConsAddr()
+		assert.NilError(t, err)
+
+		f.stakingKeeper.Slash(ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(i)*time.Hour)), consAddr, 10, 10, math.LegacyNewDecWithPrec(1, 1))
+	}
+	assert.Equal(t, 2, len(f.stakingKeeper.GetAllSlashRecords(ctx)))
+
+	// the delegator of both validators undelegates after the slashes
+	for _, valAddr := range valAddrs[:2] {
+		_, _, err := f.stakingKeeper.Undelegate(ctx, addrs[0], valAddr, math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 1)))
+		assert.NilError(t, err)
+	}
+	assert.Equal(t, 2, len(f.stakingKeeper.GetAllSlashedDelegationShares(ctx)))
+
+	// the records are kept while they can be reversed
+	f.stakingKeeper.PruneExpiredSlashRecords(ctx.WithBlockTime(ctx.BlockTime().Add(window)))
+	assert.Equal(t, 2, len(f.stakingKeeper.GetAllSlashRecords(ctx)))
+
+	f.stakingKeeper.PruneExpiredSlashRecords(ctx.WithBlockTime(ctx.BlockTime().Add(window + time.Second)))
+	records := f.stakingKeeper.GetAllSlashRecords(ctx)
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, uint64(2), records[0].Id)
+	assert.Equal(t, 0, len(f.stakingKeeper.GetValidatorSlashRecords(ctx, valAddrs[0])))
+
+	_, err := f.stakingKeeper.GetSlashRecord(ctx, 1)
+	assert.ErrorIs(t, err, types.ErrSlashRecordNotExists)
+	assert.Equal(t, 0, len(f.stakingKeeper.GetSlashedDelegationShares(ctx, 1)))
+	assert.Equal(t, 1, len(f.stakingKeeper.GetSlashedDelegationShares(ctx, 2)))
+
+	// all the records are pruned when the window is zero
+	params := f.stakingKeeper.GetParams(ctx)
+	params.SlashReversalWindow = 0
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+
+	f.stakingKeeper.PruneExpiredSlashRecords(ctx)
+	assert.Equal(t, 0, len(f.stakingKeeper.GetAllSlashRecords(ctx)))
+	assert.Equal(t, 0, len(f.stakingKeeper.GetAllSlashedDelegationShares(ctx)))
+	assert.Equal(t, 0, len(f.stakingKeeper.GetValidatorSlashRecords(ctx, valAddrs[1])))
+}
@@ -28,6 +28,14 @@ const (
 	EnvInterval             = "DAEMON_POLL_INTERVAL"
 	EnvPreupgradeMaxRetries = "DAEMON_PREUPGRADE_MAX_RETRIES"
 	EnvDisableLogs          = "COSMOVISOR_DISABLE_LOGS"
+	EnvTrustedKeys          = "DAEMON_TRUSTED_KEYS"
+	EnvRequireSignedBin     = "DAEMON_REQUIRE_SIGNED_BINARIES"
+	EnvRollbackMaxFailures  = "DAEMON_ROLLBACK_MAX_FAILURES"
+	EnvRollbackBlocks       = "DAEMON_ROLLBACK_BLOCKS"
+	EnvRollbackTimeout      = "DAEMON_ROLLBACK_TIMEOUT"
+	EnvRPCAddress           = "DAEMON_RPC_ADDRESS"
+	EnvGRPCAddress          = "DAEMON_GRPC_ADDRESS"
+	EnvStatusAddress        = "DAEMON_STATUS_ADDRESS"
 )
 
 const (
@@ -37,6 +45,17 @@ const (
 	currentLink = "current"
 )
 
+// defaultRollbackBlocks is the default number of blocks after the upgrade height
+// within which a failed start of the upgraded binary counts towards a rollback.
+const defaultRollbackBlocks = 10
+
+// defaultRollbackTimeout is the default time after an upgrade during which a failed
+// start of the upgraded binary counts towards a rollback.
+const defaultRollbackTimeout = 24 * time.Hour
+
+// defaultRPCAddress is the default CometBFT RPC address of the node.
+const defaultRPCAddress = "http://localhost:26657"
+
 // must be the same as x/upgrade/types.UpgradeInfoFilename
 const defaultFilename = "upgrade-info.json"
 
@@ -52,6 +71,14 @@ type Config struct {
 	DataBackupPath        string
 	PreupgradeMaxRetries  int
 	DisableLogs           bool
+	TrustedKeys           []string
+	RequireSignedBinaries bool
+	RollbackMaxFailures   int
+	RollbackBlocks        int64
+	RollbackTimeout       time.Duration
+	RPCAddress            string
+	GRPCAddress           string
+	StatusAddress         string
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -143,12 +170,19 @@ func GetConfigFromEnv() (*Config, error) {
 		Home:           os.Getenv(EnvHome),
 		Name:           os.Getenv(EnvName),
 		DataBackupPath: os.Getenv(EnvDataBackupPath),
+		RPCAddress:     os.Getenv(EnvRPCAddress),
+		GRPCAddress:    os.Getenv(EnvGRPCAddress),
+		StatusAddress:  os.Getenv(EnvStatusAddress),
 	}
 
 	if cfg.DataBackupPath == "" {
 		cfg.DataBackupPath = cfg.Home
 	}
 
+	if cfg.RPCAddress == "" {
+		cfg.RPCAddress = defaultRPCAddress
+	}
+
 	var err error
 	if cfg.AllowDownloadBinaries, err = booleanOption(EnvDownloadBin, false); err != nil {
 		errs = append(errs, err)
@@ -162,6 +196,15 @@ func GetConfigFromEnv() (*Config, error) {
 	if cfg.DisableLogs, err = booleanOption(EnvDisableLogs, false); err != nil {
 		errs = append(errs, err)
 	}
+	if cfg.RequireSignedBinaries, err = booleanOption(EnvRequireSignedBin, false); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, key := range strings.Split(os.Getenv(EnvTrustedKeys), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			cfg.TrustedKeys = append(cfg.TrustedKeys, key)
+		}
+	}
 
 	interval := os.Getenv(EnvInterval)
 	if interval != "" {
@@ -191,6 +234,28 @@ func GetConfigFromEnv() (*Config, error) {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
 	}
 
+	envRollbackMaxFailuresVal := os.Getenv(EnvRollbackMaxFailures)
+	if cfg.RollbackMaxFailures, err = strconv.Atoi(envRollbackMaxFailuresVal); err != nil && envRollbackMaxFailuresVal != "" {
+		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvRollbackMaxFailures, err))
+	}
+
+	cfg.RollbackBlocks = defaultRollbackBlocks
+	if envRollbackBlocksVal := os.Getenv(EnvRollbackBlocks); envRollbackBlocksVal != "" {
+		if cfg.RollbackBlocks, err = strconv.ParseInt(envRollbackBlocksVal, 10, 64); err != nil {
+			errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvRollbackBlocks, err))
+		}
+	}
+
+	cfg.RollbackTimeout = defaultRollbackTimeout
+	if rollbackTimeout := os.Getenv(EnvRollbackTimeout); rollbackTimeout != "" {
+		val, err := parseEnvDuration(rollbackTimeout)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid: %s: %w", EnvRollbackTimeout, err))
+		} else {
+			cfg.RollbackTimeout = val
+		}
+	}
+
 	errs = append(errs, cfg.validate()...)
 
 	if len(errs) > 0 {
@@ -252,6 +317,28 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	// validate the trusted keys
+	if _, err := LoadTrustedKeys(cfg.TrustedKeys); err != nil {
+		errs = append(errs, fmt.Errorf("invalid %s: %w", EnvTrustedKeys, err))
+	}
+	if cfg.RequireSignedBinaries && len(cfg.TrustedKeys) == 0 {
+		errs = append(errs, fmt.Errorf("%s requires %s to be set", EnvRequireSignedBin, EnvTrustedKeys))
+	}
+
+	// validate the rollback options
+	if cfg.RollbackMaxFailures < 0 {
+		errs = append(errs, fmt.Errorf("%s must not be negative", EnvRollbackMaxFailures))
+	}
+	if cfg.RollbackEnabled() && cfg.RollbackBlocks <= 0 {
+		errs = append(errs, fmt.Errorf("%s must be greater than 0", EnvRollbackBlocks))
+	}
+	if cfg.RollbackEnabled() && cfg.RollbackTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("%s must be greater than 0", EnvRollbackTimeout))
+	}
+	if cfg.RollbackEnabled() && cfg.UnsafeSkipBackup {
+		errs = append(errs, fmt.Errorf("%s requires the data backup, %s must not be set", EnvRollbackMaxFailures, EnvSkipBackup))
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -275,6 +362,11 @@ func (cfg *Config) validate() []error {
 	return errs
 }
 
+// VerifiesBinaries returns true if the signatures of the downloaded binaries are verified.
+func (cfg *Config) VerifiesBinaries() bool {
+	return len(cfg.TrustedKeys) > 0 || cfg.RequireSignedBinaries
+}
+
 // SetCurrentUpgrade sets the named upgrade to be the current link, returns error if this binary doesn't exist
 func (cfg *Config) SetCurrentUpgrade(u upgradetypes.Plan) (rerr error) {
 	// ensure named upgrade exists
@@ -374,6 +466,14 @@ func (cfg Config) DetailString() string {
 		{EnvDataBackupPath, cfg.DataBackupPath},
 		{EnvPreupgradeMaxRetries, fmt.Sprintf("%d", cfg.PreupgradeMaxRetries)},
 		{EnvDisableLogs, fmt.Sprintf("%t", cfg.DisableLogs)},
+		{EnvTrustedKeys, strings.Join(cfg.TrustedKeys, ",")},
+		{EnvRequireSignedBin, fmt.Sprintf("%t", cfg.RequireSignedBinaries)},
+		{EnvRollbackMaxFailures, fmt.Sprintf("%d", cfg.RollbackMaxFailures)},
+		{EnvRollbackBlocks, fmt.Sprintf("%d", cfg.RollbackBlocks)},
+		{EnvRollbackTimeout, cfg.RollbackTimeout.String()},
+		{EnvRPCAddress, cfg.RPCAddress},
+		{EnvGRPCAddress, cfg.GRPCAddress},
+		{EnvStatusAddress, cfg.StatusAddress},
 	}
 
 	derivedEntries := []struct{ name, value string }{
@@ -204,6 +204,26 @@ func (s *argsTestSuite) TestValidate() {
 			cfg:   Config{Home: absPath, Name: "bind", UnsafeSkipBackup: true, DataBackupPath: relPath},
 			valid: true,
 		},
+		"happy with rollback": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, RollbackMaxFailures: 3, RollbackBlocks: 10, RollbackTimeout: time.Hour},
+			valid: true,
+		},
+		"rollback with skip data backup": {
+			cfg:   Config{Home: absPath, Name: "bind", UnsafeSkipBackup: true, RollbackMaxFailures: 3, RollbackBlocks: 10, RollbackTimeout: time.Hour},
+			valid: false,
+		},
+		"rollback without blocks": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, RollbackMaxFailures: 3},
+			valid: false,
+		},
+		"rollback without timeout": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, RollbackMaxFailures: 3, RollbackBlocks: 10},
+			valid: false,
+		},
+		"negative rollback max failures": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, RollbackMaxFailures: -1},
+			valid: false,
+		},
 		"missing home": {
 			cfg:   Config{Name: "bind"},
 			valid: false,
@@ -383,6 +403,9 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 			DataBackupPath:        dataBackupPath,
 			PreupgradeMaxRetries:  preupgradeMaxRetries,
 			DisableLogs:           disableLogs,
+			RollbackBlocks:        defaultRollbackBlocks,
+			RollbackTimeout:       defaultRollbackTimeout,
+			RPCAddress:            defaultRPCAddress,
 		}
 	}
 
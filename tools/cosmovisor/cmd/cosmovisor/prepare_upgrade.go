@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/tools/cosmovisor"
+	"cosmossdk.io/x/upgrade/plan"
+)
+
+// GRPCAddressFlag defines the flag of the gRPC address of the node
+var GRPCAddressFlag = "grpc-address"
+
+// defaultGRPCAddress is the default gRPC address of a node.
+const defaultGRPCAddress = "localhost:9090"
+
+func NewPrepareUpgradeCmd() *cobra.Command {
+	prepareUpgradeCmd := &cobra.Command{
+		Use:   "prepare-upgrade",
+		Short: "Download and verify the binary of the upgrade pending on chain, before the upgrade height is reached.",
+		Long: fmt.Sprintf(`Download and verify the binary of the upgrade pending on chain, before the upgrade height is reached.
+
+The pending upgrade plan is queried from the gRPC server of the node, set with the --%s flag
+or the %s env variable (default %s). The binary is downloaded as instructed by the plan
+info, and its signature is verified if trusted keys are configured.`, GRPCAddressFlag, cosmovisor.EnvGRPCAddress, defaultGRPCAddress),
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := cosmovisor.GetConfigFromEnv()
+			if err != nil {
+				return err
+			}
+
+			grpcAddress, err := cmd.Flags().GetString(GRPCAddressFlag)
+			if err != nil {
+				return err
+			}
+			if grpcAddress == "" {
+				grpcAddress = cfg.GRPCAddress
+			}
+			if grpcAddress == "" {
+				grpcAddress = defaultGRPCAddress
+			}
+
+			logger := cmd.Context().Value(log.ContextKey).(log.Logger)
+			if cfg.DisableLogs {
+				logger = log.NewCustomLogger(zerolog.Nop())
+			}
+
+			return prepareUpgrade(cmd, logger.Impl().(*zerolog.Logger), cfg, grpcAddress)
+		},
+	}
+
+	prepareUpgradeCmd.Flags().String(GRPCAddressFlag, "", "gRPC address of the node")
+
+	return prepareUpgradeCmd
+}
+
+func prepareUpgrade(cmd *cobra.Command, logger *zerolog.Logger, cfg *cosmovisor.Config, grpcAddress string) error {
+	p, err := cosmovisor.QueryPendingUpgrade(cmd.Context(), grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	if p == nil {
+		fmt.Fprintln(cmd.OutOrStdout(), "no upgrade pending")
+		return nil
+	}
+
+	if err := plan.EnsureBinary(cfg.UpgradeBin(p.Name)); err == nil {
+		fmt.Fprintf(cmd.OutOrStdout(), "binary of upgrade %q at height %d already present: %s\n", p.Name, p.Height, cfg.UpgradeBin(p.Name))
+		return nil
+	}
+
+	logger.Info().Str("upgrade", p.Name).Int64("height", p.Height).Msg("preparing upgrade")
+	if err := cosmovisor.DownloadUpgradeBinary(logger, cfg, *p); err != nil {
+		return fmt.Errorf("failed to prepare upgrade %q: %w", p.Name, err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "binary of upgrade %q at height %d prepared: %s\n", p.Name, p.Height, cfg.UpgradeBin(p.Name))
+	return nil
+}
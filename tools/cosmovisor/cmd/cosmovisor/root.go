@@ -16,6 +16,8 @@ func NewRootCmd() *cobra.Command {
 		runCmd,
 		configCmd,
 		NewVersionCmd(),
+		NewStatusCmd(),
+		NewPrepareUpgradeCmd(),
 	)
 
 	return rootCmd
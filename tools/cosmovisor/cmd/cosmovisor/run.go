@@ -40,6 +40,16 @@ func Run(cmd *cobra.Command, args []string, options ...RunOption) error {
 		return err
 	}
 
+	if cfg.StatusAddress != "" {
+		server, err := cosmovisor.NewStatusServer(logger.Impl().(*zerolog.Logger), cfg)
+		if err != nil {
+			return err
+		}
+		defer server.Close()
+
+		go server.Serve()
+	}
+
 	doUpgrade, err := launcher.Run(args, runCfg.StdOut, runCfg.StdErr)
 	// if RestartAfterUpgrade, we launch after a successful upgrade (given that condition launcher.Run returns nil)
 	for cfg.RestartAfterUpgrade && err == nil && doUpgrade {
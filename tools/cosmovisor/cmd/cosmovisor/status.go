@@ -0,0 +1,38 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func NewStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:          "status",
+		Short:        "Display the current binary, the pending upgrade, the last restart, the verification results of the downloaded upgrade binaries and the rollback state of the latest upgrade.",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := cosmovisor.GetConfigFromEnv()
+			if err != nil {
+				return err
+			}
+
+			status, err := cosmovisor.GetStatus(cmd.Context(), cfg)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(status, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -50,6 +51,15 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		return false, fmt.Errorf("current binary is invalid: %w", err)
 	}
 
+	if err := l.checkRollback(); err != nil {
+		return false, err
+	}
+
+	restart, err := l.cfg.recordStart(bin)
+	if err != nil {
+		return false, fmt.Errorf("error while recording the start of %s: %w", bin, err)
+	}
+
 	l.logger.Info().Str("path", bin).Strs("args", args).Msg("running app")
 	cmd := exec.Command(bin, args...)
 	cmd.Stdout = stdout
@@ -58,23 +68,54 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		return false, fmt.Errorf("launching process %s %s failed: %w", bin, strings.Join(args, " "), err)
 	}
 
+	// the committed block height of the node tells whether an upgraded binary started successfully
+	done := make(chan struct{})
+	var watcherStopped <-chan struct{}
+	if l.cfg.RollbackEnabled() {
+		watcherStopped = l.watchUpgrade(done)
+	}
+
+	var terminated atomic.Bool
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGQUIT, syscall.SIGTERM)
 	go func() {
 		sig := <-sigs
+		terminated.Store(true)
 		if err := cmd.Process.Signal(sig); err != nil {
 			l.logger.Fatal().Err(err).Str("bin", bin).Msg("terminated")
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
+	needsUpdate, err := l.WaitForUpgradeOrExit(cmd)
+	close(done)
+	if watcherStopped != nil {
+		<-watcherStopped
+	}
+	if err != nil && !terminated.Load() {
+		restart.ExitError = err.Error()
+		if werr := l.cfg.writeLastRestart(restart); werr != nil {
+			l.logger.Error().Err(werr).Msg("failed to record the exit error")
+		}
+	}
+	if l.cfg.RollbackEnabled() && !terminated.Load() {
+		if rerr := l.checkStart(err); rerr != nil {
+			return false, rerr
+		}
+	}
+	if err != nil || !needsUpdate {
 		return false, err
 	}
 
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
 		l.cfg.WaitRestartDelay()
 
-		if err := l.doBackup(); err != nil {
+		backupPath, err := l.doBackup()
+		if err != nil {
+			return false, err
+		}
+
+		previousDir, err := l.cfg.currentDir()
+		if err != nil {
 			return false, err
 		}
 
@@ -86,6 +127,12 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 			return false, err
 		}
 
+		if l.cfg.RollbackEnabled() {
+			if err := l.trackUpgrade(l.fw.currentInfo.Name, l.fw.currentInfo.Height, previousDir, backupPath); err != nil {
+				return false, err
+			}
+		}
+
 		return true, nil
 	}
 
@@ -130,34 +177,37 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
-func (l Launcher) doBackup() error {
+// doBackup takes a backup of the data directory, unless `UNSAFE_SKIP_BACKUP` is set,
+// and returns its path.
+func (l Launcher) doBackup() (string, error) {
+	var dst string
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
 		// check if upgrade-info.json is not empty.
 		var uInfo upgradetypes.Plan
 		upgradeInfoFile, err := os.ReadFile(filepath.Join(l.cfg.Home, "data", "upgrade-info.json"))
 		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+			return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
 		}
 
 		if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
-			return err
+			return "", err
 		}
 
 		if uInfo.Name == "" {
-			return fmt.Errorf("upgrade-info.json is empty")
+			return "", fmt.Errorf("upgrade-info.json is empty")
 		}
 
 		// a destination directory, Format YYYY-MM-DD
 		st := time.Now()
 		stStr := fmt.Sprintf("%d-%d-%d", st.Year(), st.Month(), st.Day())
-		dst := filepath.Join(l.cfg.DataBackupPath, fmt.Sprintf("data"+"-backup-%s", stStr))
+		dst = filepath.Join(l.cfg.DataBackupPath, fmt.Sprintf("data"+"-backup-%s", stStr))
 
 		l.logger.Info().Time("backup start time", st).Msg("starting to take backup of data directory")
 
 		// copy the $DAEMON_HOME/data to a backup dir
 		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+			return "", fmt.Errorf("error while taking data backup: %w", err)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for backup process
@@ -165,7 +215,7 @@ func (l Launcher) doBackup() error {
 		l.logger.Info().Str("backup saved at", dst).Time("backup completion time", et).TimeDiff("time taken to complete backup", et, st).Msg("backup completed")
 	}
 
-	return nil
+	return dst, nil
 }
 
 // doPreUpgrade runs the pre-upgrade command defined by the application and handles respective error codes.
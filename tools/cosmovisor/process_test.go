@@ -6,8 +6,12 @@ package cosmovisor_test
 import (
 	"bytes"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -168,6 +172,173 @@ func (s *processTestSuite) TestLaunchProcessWithDownloads() {
 	require.Equal(cfg.UpgradeBin("chain3"), currentBin)
 }
 
+// TestLaunchProcessWithRollback checks that an upgrade is rolled back after the upgraded
+// binary repeatedly failed to start within the rollback window.
+func (s *processTestSuite) TestLaunchProcessWithRollback() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	// the binaries write their state under the home directory
+	s.T().Setenv(cosmovisor.EnvHome, home)
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: home, RollbackMaxFailures: 2, RollbackBlocks: 10, RollbackTimeout: time.Hour}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmovisor")
+	upgradeFile := cfg.UpgradeInfoFilePath()
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	doUpgrade, err := launcher.Run([]string{upgradeFile}, newBuffer(), newBuffer())
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	restart, err := cfg.LastRestart()
+	require.NoError(err)
+	require.Equal(cosmovisor.RestartReasonStart, restart.Reason)
+	require.Equal(cfg.GenesisBin(), restart.Bin)
+	require.Empty(restart.ExitError)
+
+	state, err := cfg.RollbackState()
+	require.NoError(err)
+	require.Equal("chain2", state.Upgrade)
+	require.Equal(int64(49), state.Height)
+	require.Equal(filepath.Join(cfg.Root(), "genesis"), state.PreviousDir)
+	require.DirExists(state.BackupPath)
+
+	// the first failed start is counted
+	_, err = launcher.Run([]string{upgradeFile, "fail"}, newBuffer(), newBuffer())
+	require.Error(err)
+	require.NotErrorIs(err, cosmovisor.ErrUpgradeRolledBack)
+	state, err = cfg.RollbackState()
+	require.NoError(err)
+	require.Equal(1, state.Failures)
+	require.False(state.RolledBack)
+
+	restart, err = cfg.LastRestart()
+	require.NoError(err)
+	require.Equal(cosmovisor.RestartReasonUpgrade, restart.Reason)
+	require.Equal(cfg.UpgradeBin("chain2"), restart.Bin)
+	require.Equal("exit status 1", restart.ExitError)
+
+	// the second one rolls the upgrade back
+	_, err = launcher.Run([]string{upgradeFile, "fail"}, newBuffer(), newBuffer())
+	require.ErrorIs(err, cosmovisor.ErrUpgradeRolledBack)
+	state, err = cfg.RollbackState()
+	require.NoError(err)
+	require.Equal(2, state.Failures)
+	require.True(state.RolledBack)
+
+	restart, err = cfg.LastRestart()
+	require.NoError(err)
+	require.Equal(cosmovisor.RestartReasonFailure, restart.Reason)
+	require.Equal("exit status 1", restart.Error)
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.GenesisBin(), currentBin)
+
+	// the data is restored, but the validator state of the upgraded binary is kept
+	bz, err := os.ReadFile(filepath.Join(home, "data", "state"))
+	require.NoError(err)
+	require.Equal("genesis\n", string(bz))
+	bz, err = os.ReadFile(filepath.Join(home, "data", "priv_validator_state.json"))
+	require.NoError(err)
+	require.Equal("{\"height\":\"49\"}\n", string(bz))
+
+	// cosmovisor refuses to run until the rollback state is removed
+	stdout := newBuffer()
+	_, err = launcher.Run([]string{upgradeFile}, stdout, newBuffer())
+	require.ErrorIs(err, cosmovisor.ErrUpgradeRolledBack)
+	require.Equal("", stdout.String())
+}
+
+// TestLaunchProcessWithConfirmedUpgrade checks that an upgrade is no longer rolled back
+// once the node committed a block past the rollback window, even though the upgraded
+// binary does not log any block height.
+func (s *processTestSuite) TestLaunchProcessWithConfirmedUpgrade() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	// the binaries write their state under the home directory
+	s.T().Setenv(cosmovisor.EnvHome, home)
+
+	// the CometBFT RPC of the node reports its committed height
+	var height atomic.Int64
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"sync_info":{"latest_block_height":"%d"}}}`, height.Load())
+	}))
+	defer rpc.Close()
+
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20 * time.Millisecond, DataBackupPath: home, RollbackMaxFailures: 1, RollbackBlocks: 10, RollbackTimeout: time.Hour, RPCAddress: rpc.URL}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmovisor")
+	upgradeFile := cfg.UpgradeInfoFilePath()
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	doUpgrade, err := launcher.Run([]string{upgradeFile}, newBuffer(), newBuffer())
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	// still within the rollback window
+	height.Store(59)
+	_, err = launcher.Run([]string{upgradeFile, "run"}, newBuffer(), newBuffer())
+	require.NoError(err)
+	state, err := cfg.RollbackState()
+	require.NoError(err)
+	require.NotNil(state)
+	require.Equal(0, state.Failures)
+
+	// past the rollback window, the upgrade is confirmed while the binary runs, so
+	// its failure is not counted
+	height.Store(60)
+	_, err = launcher.Run([]string{upgradeFile, "run", "fail"}, newBuffer(), newBuffer())
+	require.Error(err)
+	require.NotErrorIs(err, cosmovisor.ErrUpgradeRolledBack)
+	state, err = cfg.RollbackState()
+	require.NoError(err)
+	require.Nil(state)
+
+	// later failures are not rolled back
+	_, err = launcher.Run([]string{upgradeFile, "fail"}, newBuffer(), newBuffer())
+	require.Error(err)
+	require.NotErrorIs(err, cosmovisor.ErrUpgradeRolledBack)
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+}
+
+// TestLaunchProcessWithRollbackTimeout checks that the failures of an upgraded binary
+// are no longer counted once the rollback timeout elapsed.
+func (s *processTestSuite) TestLaunchProcessWithRollbackTimeout() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	// the binaries write their state under the home directory
+	s.T().Setenv(cosmovisor.EnvHome, home)
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: home, RollbackMaxFailures: 1, RollbackBlocks: 10, RollbackTimeout: time.Nanosecond}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmovisor")
+	upgradeFile := cfg.UpgradeInfoFilePath()
+
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	doUpgrade, err := launcher.Run([]string{upgradeFile}, newBuffer(), newBuffer())
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	_, err = launcher.Run([]string{upgradeFile, "fail"}, newBuffer(), newBuffer())
+	require.Error(err)
+	require.NotErrorIs(err, cosmovisor.ErrUpgradeRolledBack)
+	state, err := cfg.RollbackState()
+	require.NoError(err)
+	require.Nil(state)
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+}
+
 // TestSkipUpgrade tests heights that are identified to be skipped and return if upgrade height matches the skip heights
 func TestSkipUpgrade(t *testing.T) {
 	cases := []struct {
@@ -0,0 +1,273 @@
+package cosmovisor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/otiai10/copy"
+)
+
+const (
+	rollbackFilename = "rollback.json"
+
+	// privValidatorStateFile is kept when restoring a data backup, so a validator
+	// never signs again at a height it already signed with the upgraded binary.
+	privValidatorStateFile = "priv_validator_state.json"
+
+	// rpcQueryTimeout bounds the queries of the committed block height of the node.
+	rpcQueryTimeout = 5 * time.Second
+)
+
+// ErrUpgradeRolledBack is returned when an upgrade was rolled back after repeated failed starts.
+var ErrUpgradeRolledBack = errors.New("upgrade rolled back")
+
+// RollbackState tracks the starts of the binary of the latest upgrade, so it can be
+// rolled back if it repeatedly fails to start.
+type RollbackState struct {
+	// Upgrade and Height are the name and height of the upgrade plan.
+	Upgrade string `json:"upgrade"`
+	Height  int64  `json:"height"`
+	// PreviousDir is the directory the current link pointed to before the upgrade.
+	PreviousDir string `json:"previous_dir"`
+	// BackupPath is the data backup taken before the upgrade.
+	BackupPath string `json:"backup_path"`
+	// UpgradeTime is the time of the upgrade, failed starts are no longer counted
+	// once the rollback timeout elapsed.
+	UpgradeTime time.Time `json:"upgrade_time"`
+	// Failures is the number of failed starts within the rollback window.
+	Failures int `json:"failures"`
+	// LastError is the error of the latest failed start.
+	LastError string `json:"last_error,omitempty"`
+	// RolledBack is set once the upgrade has been rolled back. Cosmovisor refuses
+	// to run until the state file is removed.
+	RolledBack bool      `json:"rolled_back"`
+	Time       time.Time `json:"time"`
+}
+
+// RollbackEnabled returns true if failed upgrades are rolled back.
+func (cfg *Config) RollbackEnabled() bool {
+	return cfg.RollbackMaxFailures > 0
+}
+
+// RollbackStatePath is the path to the rollback state of the latest upgrade.
+func (cfg *Config) RollbackStatePath() string {
+	return filepath.Join(cfg.Root(), rollbackFilename)
+}
+
+// RollbackState returns the rollback state of the latest upgrade, or nil if none is tracked.
+func (cfg *Config) RollbackState() (*RollbackState, error) {
+	bz, err := os.ReadFile(cfg.RollbackStatePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var state RollbackState
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return nil, fmt.Errorf("invalid rollback state %s: %w", cfg.RollbackStatePath(), err)
+	}
+
+	return &state, nil
+}
+
+func (cfg *Config) writeRollbackState(state *RollbackState) error {
+	state.Time = time.Now().UTC()
+	bz, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(cfg.RollbackStatePath(), bz, 0o600)
+}
+
+// currentDir returns the directory the current link points to, genesis if it is not set.
+func (cfg *Config) currentDir() (string, error) {
+	bin, err := cfg.CurrentBin()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Dir(filepath.Dir(bin)), nil
+}
+
+// checkRollback returns an error if the latest upgrade was rolled back, so the
+// previous binary is not started only to halt at the upgrade height again.
+func (l Launcher) checkRollback() error {
+	state, err := l.cfg.RollbackState()
+	if err != nil || state == nil || !state.RolledBack {
+		return err
+	}
+
+	return fmt.Errorf("%w: upgrade %q failed to start %d times: %s; install a working binary in %s and remove %s to retry the upgrade",
+		ErrUpgradeRolledBack, state.Upgrade, state.Failures, state.LastError, l.cfg.UpgradeDir(state.Upgrade), l.cfg.RollbackStatePath())
+}
+
+// trackUpgrade starts tracking the starts of the binary of the given upgrade.
+func (l Launcher) trackUpgrade(upgrade string, height int64, previousDir, backupPath string) error {
+	return l.cfg.writeRollbackState(&RollbackState{
+		Upgrade:     upgrade,
+		Height:      height,
+		PreviousDir: previousDir,
+		BackupPath:  backupPath,
+		UpgradeTime: time.Now().UTC(),
+	})
+}
+
+// checkStart updates the rollback state after the process exited with the given error.
+// Unless the upgrade was confirmed by watchUpgrade, or the rollback timeout elapsed, a
+// failed start is counted, and the upgrade is rolled back when the maximum number of
+// failures is reached.
+func (l Launcher) checkStart(exitErr error) error {
+	state, err := l.cfg.RollbackState()
+	if err != nil || state == nil || state.RolledBack {
+		return err
+	}
+
+	if time.Since(state.UpgradeTime) > l.cfg.RollbackTimeout {
+		l.logger.Info().Str("upgrade", state.Upgrade).Dur("timeout", l.cfg.RollbackTimeout).Msg("rollback timeout elapsed, rollback no longer possible")
+		return os.Remove(l.cfg.RollbackStatePath())
+	}
+
+	if exitErr == nil {
+		return nil
+	}
+
+	state.Failures++
+	state.LastError = exitErr.Error()
+	l.logger.Error().Err(exitErr).Str("upgrade", state.Upgrade).Int("failures", state.Failures).Int("max failures", l.cfg.RollbackMaxFailures).Msg("upgraded binary failed to start")
+
+	if state.Failures < l.cfg.RollbackMaxFailures {
+		return l.cfg.writeRollbackState(state)
+	}
+
+	if err := l.rollback(state); err != nil {
+		return fmt.Errorf("rollback of upgrade %q failed: %w", state.Upgrade, err)
+	}
+
+	state.RolledBack = true
+	if err := l.cfg.writeRollbackState(state); err != nil {
+		return err
+	}
+
+	return l.checkRollback()
+}
+
+// rollback restores the data backup taken before the upgrade and switches the
+// current link back to the previous binary.
+func (l Launcher) rollback(state *RollbackState) error {
+	l.logger.Warn().Str("upgrade", state.Upgrade).Str("backup", state.BackupPath).Str("binary", state.PreviousDir).Msg("rolling back upgrade")
+
+	if _, err := os.Stat(state.BackupPath); err != nil {
+		return fmt.Errorf("data backup not found: %w", err)
+	}
+
+	dataDir := filepath.Join(l.cfg.Home, "data")
+	privValState, err := os.ReadFile(filepath.Join(dataDir, privValidatorStateFile))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.RemoveAll(dataDir); err != nil {
+		return fmt.Errorf("error while removing data directory: %w", err)
+	}
+	if err := copy.Copy(state.BackupPath, dataDir); err != nil {
+		return fmt.Errorf("error while restoring data backup: %w", err)
+	}
+	if privValState != nil {
+		if err := os.WriteFile(filepath.Join(dataDir, privValidatorStateFile), privValState, 0o600); err != nil {
+			return err
+		}
+	}
+
+	link := filepath.Join(l.cfg.Root(), currentLink)
+	if err := os.Remove(link); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove existing link: %w", err)
+	}
+	if err := os.Symlink(state.PreviousDir, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+	l.cfg.currentUpgrade.Name = ""
+
+	l.logger.Info().Str("upgrade", state.Upgrade).Msg("upgrade rolled back")
+	return nil
+}
+
+// rpcStatus is the part of the CometBFT RPC /status response holding the latest
+// block height committed by the node.
+type rpcStatus struct {
+	Result struct {
+		SyncInfo struct {
+			LatestBlockHeight int64 `json:"latest_block_height,string"`
+		} `json:"sync_info"`
+	} `json:"result"`
+}
+
+// queryCommittedHeight returns the latest block height committed by the node, as
+// reported by its CometBFT RPC.
+func queryCommittedHeight(client *http.Client, rpcAddress string) (int64, error) {
+	resp, err := client.Get(strings.TrimSuffix(rpcAddress, "/") + "/status")
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected RPC status response: %s", resp.Status)
+	}
+
+	var status rpcStatus
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, fmt.Errorf("invalid RPC status response: %w", err)
+	}
+
+	return status.Result.SyncInfo.LatestBlockHeight, nil
+}
+
+// watchUpgrade polls the committed block height of the node while the upgraded binary
+// runs, and confirms the tracked upgrade once the height passed the rollback window.
+// It stops when done is closed, and closes the returned channel once it stopped.
+func (l Launcher) watchUpgrade(done <-chan struct{}) <-chan struct{} {
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		state, err := l.cfg.RollbackState()
+		if err != nil || state == nil || state.RolledBack || l.cfg.RPCAddress == "" {
+			return
+		}
+
+		client := &http.Client{Timeout: rpcQueryTimeout}
+		ticker := time.NewTicker(l.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			// the RPC is not served until the node started
+			height, err := queryCommittedHeight(client, l.cfg.RPCAddress)
+			if err != nil || height <= state.Height+l.cfg.RollbackBlocks {
+				continue
+			}
+
+			l.logger.Info().Str("upgrade", state.Upgrade).Int64("height", height).Msg("upgrade confirmed, rollback no longer possible")
+			if err := os.Remove(l.cfg.RollbackStatePath()); err != nil {
+				l.logger.Error().Err(err).Msg("could not remove the rollback state")
+			}
+			return
+		}
+	}()
+
+	return stopped
+}
@@ -0,0 +1,263 @@
+package cosmovisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/x/upgrade/plan"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+const (
+	restartFilename = "restart.json"
+
+	// grpcTimeout bounds the query of the pending upgrade plan.
+	grpcTimeout = 5 * time.Second
+)
+
+// Reasons the binary was (re)started by cosmovisor.
+const (
+	// RestartReasonStart is the start of the binary by cosmovisor.
+	RestartReasonStart = "start"
+	// RestartReasonUpgrade is the first start of a binary after an upgrade switched to it.
+	RestartReasonUpgrade = "upgrade"
+	// RestartReasonFailure is the start of the binary after it exited with an error.
+	RestartReasonFailure = "failure"
+)
+
+// RestartInfo records the latest start of the binary and why it was (re)started.
+type RestartInfo struct {
+	Reason string `json:"reason"`
+	// Error is the error the binary previously exited with, for a restart after a failure.
+	Error string    `json:"error,omitempty"`
+	Bin   string    `json:"bin"`
+	Time  time.Time `json:"time"`
+	// ExitError is the error the binary exited with, empty while it runs or after a successful exit.
+	ExitError string `json:"exit_error,omitempty"`
+}
+
+// PendingUpgrade is the upgrade plan scheduled on chain.
+type PendingUpgrade struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info,omitempty"`
+	// Prepared is true if the binary of the upgrade is already installed.
+	Prepared bool `json:"prepared"`
+}
+
+// Status is the status of cosmovisor and the binary it runs.
+type Status struct {
+	// CurrentUpgrade is the name of the upgrade of the current binary, genesis if none.
+	CurrentUpgrade string `json:"current_upgrade"`
+	CurrentBin     string `json:"current_bin"`
+	// PendingUpgrade is the upgrade plan scheduled on chain, nil if none is scheduled or
+	// it could not be queried, in which case PendingUpgradeError is set.
+	PendingUpgrade      *PendingUpgrade      `json:"pending_upgrade,omitempty"`
+	PendingUpgradeError string               `json:"pending_upgrade_error,omitempty"`
+	LastRestart         *RestartInfo         `json:"last_restart,omitempty"`
+	Verifications       []VerificationResult `json:"verifications"`
+	Rollback            *RollbackState       `json:"rollback,omitempty"`
+}
+
+// GetStatus returns the status of cosmovisor. The pending upgrade plan is queried from
+// the node if a gRPC address is configured.
+func GetStatus(ctx context.Context, cfg *Config) (Status, error) {
+	bin, err := cfg.CurrentBin()
+	if err != nil {
+		return Status{}, err
+	}
+
+	// the genesis binary has no upgrade info
+	currentUpgrade := genesisDir
+	if u, err := cfg.UpgradeInfo(); err == nil {
+		currentUpgrade = u.Name
+	}
+
+	status := Status{CurrentUpgrade: currentUpgrade, CurrentBin: bin}
+
+	if cfg.GRPCAddress != "" {
+		if p, err := QueryPendingUpgrade(ctx, cfg.GRPCAddress); err != nil {
+			status.PendingUpgradeError = err.Error()
+		} else if p != nil {
+			status.PendingUpgrade = &PendingUpgrade{
+				Name:     p.Name,
+				Height:   p.Height,
+				Info:     p.Info,
+				Prepared: plan.EnsureBinary(cfg.UpgradeBin(p.Name)) == nil,
+			}
+		}
+	}
+
+	if status.LastRestart, err = cfg.LastRestart(); err != nil {
+		return Status{}, err
+	}
+
+	if status.Verifications, err = cfg.VerificationResults(); err != nil {
+		return Status{}, err
+	}
+
+	if status.Rollback, err = cfg.RollbackState(); err != nil {
+		return Status{}, err
+	}
+
+	return status, nil
+}
+
+// QueryPendingUpgrade queries the upgrade plan scheduled on chain from the gRPC server of
+// the node. It returns nil if no upgrade is scheduled. The name of the plan is normalized
+// to lower case, as in the upgrade-info.json file.
+func QueryPendingUpgrade(ctx context.Context, grpcAddress string) (*upgradetypes.Plan, error) {
+	ctx, cancel := context.WithTimeout(ctx, grpcTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", grpcAddress, err)
+	}
+	defer conn.Close()
+
+	res, err := upgradetypes.NewQueryClient(conn).CurrentPlan(ctx, &upgradetypes.QueryCurrentPlanRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the pending upgrade plan: %w", err)
+	}
+
+	if res.Plan == nil {
+		return nil, nil
+	}
+
+	p := *res.Plan
+	p.Name = strings.ToLower(p.Name)
+	return &p, nil
+}
+
+// LastRestart returns the latest start of the binary, or nil if it was never started.
+func (cfg *Config) LastRestart() (*RestartInfo, error) {
+	bz, err := os.ReadFile(filepath.Join(cfg.Root(), restartFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var info RestartInfo
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return nil, fmt.Errorf("invalid restart info %s: %w", restartFilename, err)
+	}
+
+	return &info, nil
+}
+
+func (cfg *Config) writeLastRestart(info *RestartInfo) error {
+	bz, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(cfg.Root(), restartFilename), bz, 0o600)
+}
+
+// recordStart records the start of the given binary. It is a restart after an upgrade if
+// the binary changed since the previous start, or after a failure if the previous start
+// exited with an error.
+func (cfg *Config) recordStart(bin string) (*RestartInfo, error) {
+	prev, err := cfg.LastRestart()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &RestartInfo{Reason: RestartReasonStart, Bin: bin, Time: time.Now().UTC()}
+	switch {
+	case prev == nil:
+	case prev.Bin != bin:
+		info.Reason = RestartReasonUpgrade
+	case prev.ExitError != "":
+		info.Reason = RestartReasonFailure
+		info.Error = prev.ExitError
+	}
+
+	return info, cfg.writeLastRestart(info)
+}
+
+// StatusServer serves the status of cosmovisor over HTTP.
+type StatusServer struct {
+	logger *zerolog.Logger
+	// cfg is a copy of the config, as the launcher updates its config
+	cfg      Config
+	listener net.Listener
+	server   *http.Server
+}
+
+// NewStatusServer listens on the configured status address. The status is served on
+// /status, and /health reports whether the latest upgrade was rolled back.
+func NewStatusServer(logger *zerolog.Logger, cfg *Config) (*StatusServer, error) {
+	listener, err := net.Listen("tcp", cfg.StatusAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", cfg.StatusAddress, err)
+	}
+
+	s := &StatusServer{logger: logger, cfg: *cfg, listener: listener}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", s.handleStatus)
+	mux.HandleFunc("/health", s.handleHealth)
+	s.server = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	return s, nil
+}
+
+// Addr returns the address the server listens on.
+func (s *StatusServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Serve serves the status until the server is closed.
+func (s *StatusServer) Serve() {
+	s.logger.Info().Str("address", s.Addr()).Msg("serving cosmovisor status")
+	if err := s.server.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		s.logger.Error().Err(err).Msg("status server stopped")
+	}
+}
+
+// Close stops the server.
+func (s *StatusServer) Close() error {
+	return s.server.Close()
+}
+
+func (s *StatusServer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	// the config caches the current upgrade, use a fresh copy
+	cfg := s.cfg
+	status, err := GetStatus(r.Context(), &cfg)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		s.logger.Error().Err(err).Msg("failed to write status")
+	}
+}
+
+func (s *StatusServer) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	state, err := s.cfg.RollbackState()
+	switch {
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	case state != nil && state.RolledBack:
+		http.Error(w, fmt.Sprintf("upgrade %q rolled back", state.Upgrade), http.StatusServiceUnavailable)
+	default:
+		fmt.Fprintln(w, "ok")
+	}
+}
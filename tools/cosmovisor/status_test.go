@@ -0,0 +1,127 @@
+//go:build linux
+// +build linux
+
+package cosmovisor_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/tools/cosmovisor"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+type queryServer struct {
+	upgradetypes.UnimplementedQueryServer
+
+	plan *upgradetypes.Plan
+}
+
+func (q *queryServer) CurrentPlan(context.Context, *upgradetypes.QueryCurrentPlanRequest) (*upgradetypes.QueryCurrentPlanResponse, error) {
+	return &upgradetypes.QueryCurrentPlanResponse{Plan: q.plan}, nil
+}
+
+// startQueryServer serves the given pending upgrade plan over gRPC and returns the server address.
+func startQueryServer(t *testing.T, plan *upgradetypes.Plan) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	upgradetypes.RegisterQueryServer(server, &queryServer{plan: plan})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestQueryPendingUpgrade(t *testing.T) {
+	addr := startQueryServer(t, &upgradetypes.Plan{Name: "Chain2", Height: 49, Info: "info"})
+	p, err := cosmovisor.QueryPendingUpgrade(context.Background(), addr)
+	require.NoError(t, err)
+	require.Equal(t, "chain2", p.Name)
+	require.Equal(t, int64(49), p.Height)
+	require.Equal(t, "info", p.Info)
+
+	addr = startQueryServer(t, nil)
+	p, err = cosmovisor.QueryPendingUpgrade(context.Background(), addr)
+	require.NoError(t, err)
+	require.Nil(t, p)
+}
+
+func TestGetStatus(t *testing.T) {
+	// binaries from testdata/validate directory
+	home := copyTestData(t, "validate")
+
+	cases := map[string]struct {
+		plan       *upgradetypes.Plan
+		expPending *cosmovisor.PendingUpgrade
+	}{
+		"no pending upgrade": {},
+		"prepared upgrade": {
+			plan:       &upgradetypes.Plan{Name: "chain2", Height: 49},
+			expPending: &cosmovisor.PendingUpgrade{Name: "chain2", Height: 49, Prepared: true},
+		},
+		"unprepared upgrade": {
+			plan:       &upgradetypes.Plan{Name: "chain4", Height: 49},
+			expPending: &cosmovisor.PendingUpgrade{Name: "chain4", Height: 49, Prepared: false},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			cfg := &cosmovisor.Config{Home: home, Name: "dummyd", GRPCAddress: startQueryServer(t, tc.plan)}
+			status, err := cosmovisor.GetStatus(context.Background(), cfg)
+			require.NoError(t, err)
+			require.Equal(t, "genesis", status.CurrentUpgrade)
+			require.Equal(t, cfg.GenesisBin(), status.CurrentBin)
+			require.Equal(t, tc.expPending, status.PendingUpgrade)
+			require.Empty(t, status.PendingUpgradeError)
+		})
+	}
+}
+
+func TestStatusServer(t *testing.T) {
+	// binaries from testdata/validate directory
+	home := copyTestData(t, "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", StatusAddress: "127.0.0.1:0"}
+	logger := log.NewTestLogger(t).Impl().(*zerolog.Logger)
+
+	server, err := cosmovisor.NewStatusServer(logger, cfg)
+	require.NoError(t, err)
+	go server.Serve()
+	t.Cleanup(func() { require.NoError(t, server.Close()) })
+
+	res, err := http.Get("http://" + server.Addr() + "/status")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var status cosmovisor.Status
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&status))
+	require.Equal(t, "genesis", status.CurrentUpgrade)
+	require.Equal(t, cfg.GenesisBin(), status.CurrentBin)
+
+	res, err = http.Get("http://" + server.Addr() + "/health")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	// an upgrade was rolled back
+	require.NoError(t, os.WriteFile(cfg.RollbackStatePath(), []byte(`{"upgrade":"chain2","rolled_back":true}`), 0o600))
+	res, err = http.Get("http://" + server.Addr() + "/health")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+}
@@ -28,6 +28,17 @@ func UpgradeBinary(logger *zerolog.Logger, cfg *Config, p upgradetypes.Plan) err
 		return fmt.Errorf("binary not present, downloading disabled: %w", err)
 	}
 
+	if err := DownloadUpgradeBinary(logger, cfg, p); err != nil {
+		return err
+	}
+
+	return cfg.SetCurrentUpgrade(p)
+}
+
+// DownloadUpgradeBinary downloads the binary of the given upgrade plan into its upgrade
+// directory, as instructed by the plan info. The signature of the binary is verified if
+// trusted keys are configured.
+func DownloadUpgradeBinary(logger *zerolog.Logger, cfg *Config, p upgradetypes.Plan) error {
 	// if the dir is there already, don't download either
 	switch fi, err := os.Stat(cfg.UpgradeDir(p.Name)); {
 	case fi != nil: // The directory exists, do not overwrite.
@@ -56,7 +67,11 @@ func UpgradeBinary(logger *zerolog.Logger, cfg *Config, p upgradetypes.Plan) err
 
 	// If not there, then we try to download it... maybe
 	logger.Info().Msg("no upgrade binary found, beginning to download it")
-	if err := plan.DownloadUpgrade(cfg.UpgradeDir(p.Name), url, cfg.Name); err != nil {
+	if cfg.VerifiesBinaries() {
+		if err := downloadVerifiedUpgrade(logger, cfg, p, url); err != nil {
+			return err
+		}
+	} else if err := plan.DownloadUpgrade(cfg.UpgradeDir(p.Name), url, cfg.Name); err != nil {
 		return fmt.Errorf("cannot download binary. %w", err)
 	}
 	logger.Info().Msg("downloading binary complete")
@@ -66,7 +81,7 @@ func UpgradeBinary(logger *zerolog.Logger, cfg *Config, p upgradetypes.Plan) err
 		return fmt.Errorf("downloaded binary doesn't check out: %w", err)
 	}
 
-	return cfg.SetCurrentUpgrade(p)
+	return nil
 }
 
 func GetBinaryURL(binaries plan.BinaryDownloadURLMap) (string, error) {
@@ -4,6 +4,10 @@
 package cosmovisor_test
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -199,6 +203,202 @@ func (s *upgradeTestSuite) TestUpgradeBinary() {
 	}
 }
 
+func (s *upgradeTestSuite) TestDownloadUpgradeBinary() {
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor").Impl().(*zerolog.Logger)
+	home := copyTestData(s.T(), "download")
+	cfg := &cosmovisor.Config{Home: home, Name: "autod"}
+
+	// sha256sum ./testdata/repo/raw_binary/autod
+	url, err := filepath.Abs("./testdata/repo/raw_binary/autod")
+	s.Require().NoError(err)
+	plan := upgradetypes.Plan{
+		Name: "amazonas",
+		Info: fmt.Sprintf(`{"binaries":{"%s": "%s?checksum=sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"}}`, cosmovisor.OSArch(), url),
+	}
+
+	// the binary is prepared, but the current binary is not switched
+	s.Require().NoError(cosmovisor.DownloadUpgradeBinary(logger, cfg, plan))
+	s.Require().FileExists(cfg.UpgradeBin("amazonas"))
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.GenesisBin(), currentBin)
+
+	// the prepared binary is used by the upgrade
+	s.Require().NoError(cosmovisor.UpgradeBinary(logger, cfg, plan))
+	currentBin, err = cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.UpgradeBin("amazonas"), currentBin)
+}
+
+func (s *upgradeTestSuite) TestUpgradeBinaryVerified() {
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor").Impl().(*zerolog.Logger)
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	s.Require().NoError(err)
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	s.Require().NoError(err)
+
+	bin, err := os.ReadFile("./testdata/repo/raw_binary/autod")
+	s.Require().NoError(err)
+
+	cases := map[string]struct {
+		sig         []byte
+		requireSig  bool
+		canDownload bool
+		verified    bool
+	}{
+		"signed binary": {
+			sig:         minisignSignature(priv, bin, true, "timestamp:1 file:autod"),
+			canDownload: true,
+			verified:    true,
+		},
+		"binary signed by an untrusted key": {
+			sig:         minisignSignature(otherPriv, bin, true, "timestamp:1 file:autod"),
+			canDownload: false,
+		},
+		"unsigned binary": {
+			canDownload: true,
+			verified:    false,
+		},
+		"unsigned binary with signed binaries required": {
+			requireSig:  true,
+			canDownload: false,
+		},
+	}
+
+	for label, tc := range cases {
+		s.Run(label, func() {
+			home := copyTestData(s.T(), "download")
+
+			// serve the binary and its signature from a local repository
+			repo := s.T().TempDir()
+			s.Require().NoError(os.WriteFile(filepath.Join(repo, "autod"), bin, 0o600))
+			if tc.sig != nil {
+				s.Require().NoError(os.WriteFile(filepath.Join(repo, "autod.minisig"), tc.sig, 0o600))
+			}
+			keyFile := filepath.Join(repo, "minisign.pub")
+			s.Require().NoError(os.WriteFile(keyFile, minisignPublicKey(pub), 0o600))
+
+			cfg := &cosmovisor.Config{
+				Home:                  home,
+				Name:                  "autod",
+				AllowDownloadBinaries: true,
+				TrustedKeys:           []string{keyFile},
+				RequireSignedBinaries: tc.requireSig,
+			}
+
+			// sha256sum ./testdata/repo/raw_binary/autod
+			url := filepath.Join(repo, "autod") + "?checksum=sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"
+			plan := upgradetypes.Plan{
+				Name: "amazonas",
+				Info: fmt.Sprintf(`{"binaries":{"%s": "%s"}}`, cosmovisor.OSArch(), url),
+			}
+
+			err := cosmovisor.UpgradeBinary(logger, cfg, plan)
+			results, rerr := cfg.VerificationResults()
+			s.Require().NoError(rerr)
+			s.Require().Len(results, 1)
+			s.Require().Equal("amazonas", results[0].Upgrade)
+			s.Require().Equal(tc.verified, results[0].Verified)
+
+			if !tc.canDownload {
+				s.Require().Error(err)
+				s.Require().NoDirExists(cfg.UpgradeDir("amazonas"))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal("e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d", results[0].ArtifactSHA256)
+			s.Require().Equal(results[0].ArtifactSHA256, results[0].BinarySHA256)
+			if tc.verified {
+				s.Require().Equal("0807060504030201", results[0].Key)
+			} else {
+				s.Require().Contains(results[0].Error, "no signature")
+			}
+
+			currentBin, err := cfg.CurrentBin()
+			s.Require().NoError(err)
+			s.Require().Equal(cfg.UpgradeBin("amazonas"), currentBin)
+		})
+	}
+}
+
+func (s *upgradeTestSuite) TestUpgradeBinaryVerifiedArchive() {
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor").Impl().(*zerolog.Logger)
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	s.Require().NoError(err)
+
+	archive, err := os.ReadFile("./testdata/repo/chain3-zip_dir/autod.zip")
+	s.Require().NoError(err)
+	bin, err := os.ReadFile("./testdata/repo/chain3-zip_dir/bin/autod")
+	s.Require().NoError(err)
+
+	cases := map[string]struct {
+		sig         []byte
+		canDownload bool
+	}{
+		"signed archive": {
+			sig:         minisignSignature(priv, archive, true, "timestamp:1 file:autod.zip"),
+			canDownload: true,
+		},
+		"signed archive content": {
+			sig:         minisignSignature(priv, bin, true, "timestamp:1 file:autod"),
+			canDownload: false,
+		},
+	}
+
+	for label, tc := range cases {
+		s.Run(label, func() {
+			home := copyTestData(s.T(), "download")
+
+			// serve the archive and its signature from a local repository
+			repo := s.T().TempDir()
+			s.Require().NoError(os.WriteFile(filepath.Join(repo, "autod.zip"), archive, 0o600))
+			s.Require().NoError(os.WriteFile(filepath.Join(repo, "autod.zip.minisig"), tc.sig, 0o600))
+			keyFile := filepath.Join(repo, "minisign.pub")
+			s.Require().NoError(os.WriteFile(keyFile, minisignPublicKey(pub), 0o600))
+
+			cfg := &cosmovisor.Config{
+				Home:                  home,
+				Name:                  "autod",
+				AllowDownloadBinaries: true,
+				TrustedKeys:           []string{keyFile},
+				RequireSignedBinaries: true,
+			}
+
+			// sha256sum ./testdata/repo/chain3-zip_dir/autod.zip
+			url := filepath.Join(repo, "autod.zip") + "?checksum=sha256:8951f52a0aea8617de0ae459a20daf704c29d259c425e60d520e363df0f166b4"
+			plan := upgradetypes.Plan{
+				Name: "amazonas",
+				Info: fmt.Sprintf(`{"binaries":{"%s": "%s"}}`, cosmovisor.OSArch(), url),
+			}
+
+			err := cosmovisor.UpgradeBinary(logger, cfg, plan)
+			results, rerr := cfg.VerificationResults()
+			s.Require().NoError(rerr)
+			s.Require().Len(results, 1)
+			s.Require().Equal("8951f52a0aea8617de0ae459a20daf704c29d259c425e60d520e363df0f166b4", results[0].ArtifactSHA256)
+
+			if !tc.canDownload {
+				s.Require().Error(err)
+				s.Require().False(results[0].Verified)
+				s.Require().NoDirExists(cfg.UpgradeDir("amazonas"))
+				return
+			}
+
+			// the signature is verified on the archive, which is unpacked once installed
+			s.Require().NoError(err)
+			s.Require().True(results[0].Verified)
+			installed, err := os.ReadFile(cfg.UpgradeBin("amazonas"))
+			s.Require().NoError(err)
+			s.Require().Equal(bin, installed)
+			checksum := sha256.Sum256(bin)
+			s.Require().Equal(hex.EncodeToString(checksum[:]), results[0].BinarySHA256)
+		})
+	}
+}
+
 func (s *upgradeTestSuite) TestOsArch() {
 	// all download tests will fail if we are not on linux...
 	s.Require().Equal("linux/amd64", cosmovisor.OSArch())
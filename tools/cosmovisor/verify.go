@@ -0,0 +1,423 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	neturl "net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-getter"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/blake2b"
+
+	"cosmossdk.io/x/upgrade/plan"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+const (
+	// signatureExtension is appended to the path of a binary URL to get the URL of its detached signature.
+	signatureExtension = ".minisig"
+
+	verificationDir = "verification"
+
+	minisignUntrustedComment = "untrusted comment:"
+	minisignTrustedComment   = "trusted comment: "
+)
+
+var (
+	// minisignAlgorithm signs the file itself, minisignHashedAlgorithm signs its BLAKE2b-512 hash.
+	minisignAlgorithm       = []byte("Ed")
+	minisignHashedAlgorithm = []byte("ED")
+)
+
+// TrustedKey is an ed25519 public key trusted to sign the upgrade binaries.
+// It is either a minisign public key, or a raw base64 encoded ed25519 public key.
+type TrustedKey struct {
+	// Path is the file the key was loaded from.
+	Path string
+	// ID is the minisign key ID, nil for a raw ed25519 public key.
+	ID     []byte
+	PubKey ed25519.PublicKey
+}
+
+// String returns the key ID for a minisign key, or the key itself for a raw ed25519 key.
+func (k TrustedKey) String() string {
+	if k.ID != nil {
+		// minisign displays the little endian key ID
+		id := make([]byte, len(k.ID))
+		for i := range k.ID {
+			id[i] = k.ID[len(k.ID)-1-i]
+		}
+		return strings.ToUpper(hex.EncodeToString(id))
+	}
+
+	return base64.StdEncoding.EncodeToString(k.PubKey)
+}
+
+// ParseTrustedKey parses a minisign public key file, or a raw base64 encoded ed25519 public key.
+func ParseTrustedKey(bz []byte) (TrustedKey, error) {
+	line, _, err := nextLine(string(bz), true)
+	if err != nil {
+		return TrustedKey{}, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(line)
+	if err != nil {
+		return TrustedKey{}, fmt.Errorf("invalid public key encoding: %w", err)
+	}
+
+	switch {
+	case len(key) == ed25519.PublicKeySize:
+		return TrustedKey{PubKey: key}, nil
+
+	case len(key) == 2+8+ed25519.PublicKeySize && bytes.Equal(key[:2], minisignAlgorithm):
+		return TrustedKey{ID: key[2:10], PubKey: key[10:]}, nil
+
+	default:
+		return TrustedKey{}, errors.New("public key is neither a minisign nor an ed25519 public key")
+	}
+}
+
+// LoadTrustedKeys loads the public keys in the given files.
+func LoadTrustedKeys(paths []string) ([]TrustedKey, error) {
+	keys := make([]TrustedKey, 0, len(paths))
+	for _, p := range paths {
+		bz, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := ParseTrustedKey(bz)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+
+		key.Path = p
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// VerifySignature verifies the detached signature of data with the trusted keys, and returns
+// the key which made it. The signature is either a minisign signature, or a raw base64
+// encoded ed25519 signature.
+func VerifySignature(keys []TrustedKey, data, sig []byte) (TrustedKey, error) {
+	line, rest, err := nextLine(string(sig), true)
+	if err != nil {
+		return TrustedKey{}, err
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(line)
+	if err != nil {
+		return TrustedKey{}, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	if len(bz) == ed25519.SignatureSize {
+		for _, key := range keys {
+			if key.ID == nil && ed25519.Verify(key.PubKey, data, bz) {
+				return key, nil
+			}
+		}
+
+		return TrustedKey{}, errors.New("signature not made by any trusted ed25519 key")
+	}
+
+	if len(bz) != 2+8+ed25519.SignatureSize {
+		return TrustedKey{}, errors.New("signature is neither a minisign nor an ed25519 signature")
+	}
+
+	algorithm, keyID, signature := bz[:2], bz[2:10], bz[10:]
+	switch {
+	case bytes.Equal(algorithm, minisignAlgorithm):
+	case bytes.Equal(algorithm, minisignHashedAlgorithm):
+		hash := blake2b.Sum512(data)
+		data = hash[:]
+	default:
+		return TrustedKey{}, fmt.Errorf("unsupported minisign signature algorithm %q", algorithm)
+	}
+
+	var key *TrustedKey
+	for i := range keys {
+		if bytes.Equal(keys[i].ID, keyID) {
+			key = &keys[i]
+			break
+		}
+	}
+	if key == nil {
+		return TrustedKey{}, errors.New("signature not made by any trusted minisign key")
+	}
+
+	if !ed25519.Verify(key.PubKey, data, signature) {
+		return TrustedKey{}, fmt.Errorf("invalid signature for key %s", key)
+	}
+
+	// the global signature authenticates the trusted comment
+	comment, rest, err := nextLine(rest, false)
+	if err != nil || !strings.HasPrefix(comment, minisignTrustedComment) {
+		return TrustedKey{}, errors.New("missing minisign trusted comment")
+	}
+
+	line, _, err = nextLine(rest, false)
+	if err != nil {
+		return TrustedKey{}, errors.New("missing minisign global signature")
+	}
+
+	globalSignature, err := base64.StdEncoding.DecodeString(line)
+	if err != nil {
+		return TrustedKey{}, fmt.Errorf("invalid global signature encoding: %w", err)
+	}
+
+	message := append(signature[:len(signature):len(signature)], strings.TrimPrefix(comment, minisignTrustedComment)...)
+	if !ed25519.Verify(key.PubKey, message, globalSignature) {
+		return TrustedKey{}, fmt.Errorf("invalid global signature for key %s", key)
+	}
+
+	return *key, nil
+}
+
+// nextLine returns the first non-empty line of s, optionally skipping the minisign
+// untrusted comments, along with the remaining lines.
+func nextLine(s string, skipUntrustedComments bool) (line, rest string, err error) {
+	for s != "" {
+		line, s, _ = strings.Cut(s, "\n")
+		line = strings.TrimSpace(line)
+		if line == "" || (skipUntrustedComments && strings.HasPrefix(line, minisignUntrustedComment)) {
+			continue
+		}
+
+		return line, s, nil
+	}
+
+	return "", "", errors.New("unexpected end of file")
+}
+
+// VerificationResult records the verification of a downloaded upgrade binary.
+type VerificationResult struct {
+	Upgrade      string    `json:"upgrade"`
+	URL          string    `json:"url"`
+	SignatureURL string    `json:"signature_url"`
+	Time         time.Time `json:"time"`
+	// Verified is true if the signature was made by a trusted key.
+	Verified bool `json:"verified"`
+	// Key is the trusted key which made the signature.
+	Key string `json:"key,omitempty"`
+	// Error is the reason the binary was not verified.
+	Error string `json:"error,omitempty"`
+	// ArtifactSHA256 is the checksum of the downloaded file, BinarySHA256 the checksum of the
+	// installed binary. They can be compared with the checksums of reproducible builds.
+	ArtifactSHA256 string `json:"artifact_sha256,omitempty"`
+	BinarySHA256   string `json:"binary_sha256,omitempty"`
+}
+
+// VerificationResultPath is the path to the verification result of the named upgrade.
+func (cfg *Config) VerificationResultPath(upgradeName string) string {
+	return filepath.Join(cfg.Root(), verificationDir, neturl.PathEscape(upgradeName)+".json")
+}
+
+// VerificationResults returns the recorded verification results, ordered by upgrade name.
+func (cfg *Config) VerificationResults() ([]VerificationResult, error) {
+	entries, err := os.ReadDir(filepath.Join(cfg.Root(), verificationDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var results []VerificationResult
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		bz, err := os.ReadFile(filepath.Join(cfg.Root(), verificationDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var result VerificationResult
+		if err := json.Unmarshal(bz, &result); err != nil {
+			return nil, fmt.Errorf("invalid verification result %s: %w", entry.Name(), err)
+		}
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Upgrade < results[j].Upgrade })
+	return results, nil
+}
+
+func (cfg *Config) writeVerificationResult(result VerificationResult) error {
+	if err := os.MkdirAll(filepath.Join(cfg.Root(), verificationDir), 0o755); err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(cfg.VerificationResultPath(result.Upgrade), bz, 0o600)
+}
+
+// SignatureURL returns the URL of the detached signature of the binary at the given URL,
+// which is the binary URL path with the .minisig extension, without the query.
+func SignatureURL(binaryURL string) (string, error) {
+	u, err := neturl.Parse(binaryURL)
+	if err != nil {
+		return "", err
+	}
+
+	u.Path += signatureExtension
+	u.RawPath = ""
+	u.RawQuery = ""
+	return u.String(), nil
+}
+
+// downloadVerifiedUpgrade downloads the file at the given url and verifies its detached
+// signature with the trusted keys, before installing the binary it contains in the
+// upgrade directory. Downloads without a signature are refused if signed binaries are
+// required. The verification result is logged and recorded for the status command.
+func downloadVerifiedUpgrade(logger *zerolog.Logger, cfg *Config, p upgradetypes.Plan, url string) error {
+	result := VerificationResult{Upgrade: p.Name, URL: url, Time: time.Now().UTC()}
+
+	err := verifyAndInstall(cfg, p, url, &result)
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	if werr := cfg.writeVerificationResult(result); werr != nil {
+		logger.Error().Err(werr).Msg("could not record the binary verification result")
+	}
+
+	event := logger.Info()
+	if err != nil {
+		event = logger.Error().Err(err)
+	} else if !result.Verified {
+		event = logger.Warn().Str("reason", result.Error)
+	}
+	event.Str("upgrade", p.Name).
+		Bool("verified", result.Verified).
+		Str("key", result.Key).
+		Str("artifact_sha256", result.ArtifactSHA256).
+		Str("binary_sha256", result.BinarySHA256).
+		Msg("binary verification")
+
+	return err
+}
+
+// rawURL returns the url with the go-getter archive parameter disabled, so that the file
+// is downloaded as is instead of being unpacked. The other parameters, such as the
+// checksum, are kept.
+func rawURL(u neturl.URL) string {
+	q := u.Query()
+	q.Set("archive", "false")
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func verifyAndInstall(cfg *Config, p upgradetypes.Plan, url string, result *VerificationResult) error {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return err
+	}
+
+	tempDir, err := os.MkdirTemp("", "cosmovisor-download")
+	if err != nil {
+		return fmt.Errorf("could not create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// keep the file name, which tells whether the file is an archive
+	name := path.Base(u.Path)
+	if name == "." || name == "/" {
+		name = "artifact"
+	}
+	// the artifact is downloaded as is, the signature being made on the archive rather
+	// than on its content, and is only unpacked when installed
+	artifact := filepath.Join(tempDir, name)
+	if err := getter.GetFile(artifact, rawURL(*u)); err != nil {
+		return fmt.Errorf("cannot download binary. %w", err)
+	}
+
+	data, err := os.ReadFile(artifact)
+	if err != nil {
+		return err
+	}
+	checksum := sha256.Sum256(data)
+	result.ArtifactSHA256 = hex.EncodeToString(checksum[:])
+
+	if result.SignatureURL, err = SignatureURL(url); err != nil {
+		return err
+	}
+	sigURL, err := neturl.Parse(result.SignatureURL)
+	if err != nil {
+		return err
+	}
+
+	sigFile := filepath.Join(tempDir, name+signatureExtension)
+	if err := getter.GetFile(sigFile, rawURL(*sigURL)); err != nil {
+		if cfg.RequireSignedBinaries {
+			return fmt.Errorf("signed binaries are required, cannot download signature: %w", err)
+		}
+		// the result records why the binary is not verified
+		result.Error = fmt.Sprintf("no signature: %s", err)
+	} else {
+		sig, err := os.ReadFile(sigFile)
+		if err != nil {
+			return err
+		}
+
+		keys, err := LoadTrustedKeys(cfg.TrustedKeys)
+		if err != nil {
+			return fmt.Errorf("cannot load trusted keys: %w", err)
+		}
+
+		key, err := VerifySignature(keys, data, sig)
+		if err != nil {
+			return fmt.Errorf("binary signature verification failed: %w", err)
+		}
+
+		result.Verified, result.Key = true, key.String()
+	}
+
+	// install the verified file, unpacking it if it is an archive, the checksum query
+	// parameter is checked again
+	local := neturl.URL{Scheme: "file", Path: artifact, RawQuery: u.RawQuery}
+	if err := plan.DownloadUpgrade(cfg.UpgradeDir(p.Name), local.String(), cfg.Name); err != nil {
+		return fmt.Errorf("cannot install binary. %w", err)
+	}
+
+	bin, err := os.ReadFile(cfg.UpgradeBin(p.Name))
+	if err != nil {
+		return err
+	}
+
+	// go-getter links local files instead of copying them, while the downloaded file is removed
+	if fi, err := os.Lstat(cfg.UpgradeBin(p.Name)); err != nil {
+		return err
+	} else if fi.Mode()&os.ModeSymlink != 0 {
+		if err := os.Remove(cfg.UpgradeBin(p.Name)); err != nil {
+			return err
+		}
+		if err := os.WriteFile(cfg.UpgradeBin(p.Name), bin, 0o755); err != nil { //nolint:gosec // the binary must be executable
+			return err
+		}
+	}
+
+	checksum = sha256.Sum256(bin)
+	result.BinarySHA256 = hex.EncodeToString(checksum[:])
+
+	return nil
+}
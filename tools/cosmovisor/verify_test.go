@@ -0,0 +1,179 @@
+package cosmovisor_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+var testKeyID = []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+// minisignPublicKey returns the minisign public key file of the given key.
+func minisignPublicKey(pub ed25519.PublicKey) []byte {
+	bz := append(append([]byte("Ed"), testKeyID...), pub...)
+	return []byte(fmt.Sprintf("untrusted comment: minisign public key 0807060504030201\n%s\n", base64.StdEncoding.EncodeToString(bz)))
+}
+
+// minisignSignature returns the minisign signature file of data, prehashed or not.
+func minisignSignature(priv ed25519.PrivateKey, data []byte, prehashed bool, trustedComment string) []byte {
+	algorithm := "Ed"
+	if prehashed {
+		algorithm = "ED"
+		hash := blake2b.Sum512(data)
+		data = hash[:]
+	}
+
+	sig := ed25519.Sign(priv, data)
+	globalSig := ed25519.Sign(priv, append(append([]byte{}, sig...), trustedComment...))
+
+	return []byte(fmt.Sprintf(
+		"untrusted comment: signature from minisign secret key\n%s\ntrusted comment: %s\n%s\n",
+		base64.StdEncoding.EncodeToString(append(append([]byte(algorithm), testKeyID...), sig...)),
+		trustedComment,
+		base64.StdEncoding.EncodeToString(globalSig),
+	))
+}
+
+func TestParseTrustedKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := cosmovisor.ParseTrustedKey(minisignPublicKey(pub))
+	require.NoError(t, err)
+	require.Equal(t, testKeyID, key.ID)
+	require.Equal(t, pub, key.PubKey)
+	require.Equal(t, "0807060504030201", key.String())
+
+	key, err = cosmovisor.ParseTrustedKey([]byte(base64.StdEncoding.EncodeToString(pub) + "\n"))
+	require.NoError(t, err)
+	require.Nil(t, key.ID)
+	require.Equal(t, pub, key.PubKey)
+
+	_, err = cosmovisor.ParseTrustedKey([]byte("untrusted comment: nothing else\n"))
+	require.ErrorContains(t, err, "unexpected end of file")
+
+	_, err = cosmovisor.ParseTrustedKey([]byte("not base64"))
+	require.ErrorContains(t, err, "invalid public key encoding")
+
+	_, err = cosmovisor.ParseTrustedKey([]byte(base64.StdEncoding.EncodeToString([]byte("too short"))))
+	require.ErrorContains(t, err, "neither a minisign nor an ed25519 public key")
+}
+
+func TestVerifySignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPub, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	minisignKey, err := cosmovisor.ParseTrustedKey(minisignPublicKey(pub))
+	require.NoError(t, err)
+	rawKey, err := cosmovisor.ParseTrustedKey([]byte(base64.StdEncoding.EncodeToString(otherPub)))
+	require.NoError(t, err)
+	keys := []cosmovisor.TrustedKey{minisignKey, rawKey}
+
+	data := []byte("binary")
+	rawSig := []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(otherPriv, data)))
+	minisignSig := minisignSignature(priv, data, true, "timestamp:1 file:autod")
+	tamperedComment := bytes.Replace(minisignSig, []byte("timestamp:1"), []byte("timestamp:2"), 1)
+	withoutComment := bytes.Join(bytes.SplitN(minisignSig, []byte("\n"), 3)[:2], []byte("\n"))
+
+	cases := map[string]struct {
+		keys   []cosmovisor.TrustedKey
+		data   []byte
+		sig    []byte
+		expKey cosmovisor.TrustedKey
+		errMsg string
+	}{
+		"minisign signature": {
+			keys:   keys,
+			data:   data,
+			sig:    minisignSignature(priv, data, false, "timestamp:1 file:autod"),
+			expKey: minisignKey,
+		},
+		"prehashed minisign signature": {
+			keys:   keys,
+			data:   data,
+			sig:    minisignSig,
+			expKey: minisignKey,
+		},
+		"raw ed25519 signature": {
+			keys:   keys,
+			data:   data,
+			sig:    rawSig,
+			expKey: rawKey,
+		},
+		"minisign signature of other data": {
+			keys:   keys,
+			data:   []byte("other binary"),
+			sig:    minisignSignature(priv, data, true, "timestamp:1 file:autod"),
+			errMsg: "invalid signature for key",
+		},
+		"raw ed25519 signature of other data": {
+			keys:   keys,
+			data:   []byte("other binary"),
+			sig:    rawSig,
+			errMsg: "signature not made by any trusted ed25519 key",
+		},
+		"untrusted minisign key": {
+			keys:   []cosmovisor.TrustedKey{rawKey},
+			data:   data,
+			sig:    minisignSignature(priv, data, true, "timestamp:1 file:autod"),
+			errMsg: "signature not made by any trusted minisign key",
+		},
+		"untrusted ed25519 key": {
+			keys:   []cosmovisor.TrustedKey{minisignKey},
+			data:   data,
+			sig:    rawSig,
+			errMsg: "signature not made by any trusted ed25519 key",
+		},
+		"tampered trusted comment": {
+			keys:   keys,
+			data:   data,
+			sig:    tamperedComment,
+			errMsg: "invalid global signature",
+		},
+		"missing trusted comment": {
+			keys:   keys,
+			data:   data,
+			sig:    withoutComment,
+			errMsg: "missing minisign",
+		},
+		"empty signature": {
+			keys:   keys,
+			data:   data,
+			sig:    []byte{},
+			errMsg: "unexpected end of file",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			key, err := cosmovisor.VerifySignature(tc.keys, tc.data, tc.sig)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expKey, key)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestSignatureURL(t *testing.T) {
+	url, err := cosmovisor.SignatureURL("https://example.com/v2/simd.zip?checksum=sha256:abcd")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/v2/simd.zip.minisig", url)
+
+	url, err = cosmovisor.SignatureURL("/tmp/simd?checksum=sha256:abcd")
+	require.NoError(t, err)
+	require.Equal(t, "/tmp/simd.minisig", url)
+}
@@ -256,10 +256,12 @@ func (e Events) GetAttributes(key string) ([]Attribute, bool) {
 const (
 	EventTypeTx = "tx"
 
-	AttributeKeyAccountSequence = "acc_seq"
-	AttributeKeySignature       = "signature"
-	AttributeKeyFee             = "fee"
-	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyAccountSequence        = "acc_seq"
+	AttributeKeySignature              = "signature"
+	AttributeKeyFee                    = "fee"
+	AttributeKeyFeePayer               = "fee_payer"
+	AttributeKeyNativeFee              = "native_fee"
+	AttributeKeyFeeDenomReserveBalance = "fee_denom_reserve_balance"
 
 	EventTypeMessage = "message"
 
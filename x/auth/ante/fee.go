@@ -1,6 +1,7 @@
 package ante
 
 import (
+	"context"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,6 +15,18 @@ import (
 // the effective fee should be deducted later, and the priority should be returned in abci response.
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 
+// FeeDenomConverter converts fees offered in alternative denominations into
+// their value in the chain's native fee denomination. It allows fees to be paid
+// in whitelisted denominations other than the ones set in the validators'
+// minimum gas prices.
+type FeeDenomConverter interface {
+	// ConvertFee returns the value of fee expressed in the native fee
+	// denomination, or fee unchanged if no alternative denominations are
+	// configured. It must return an error if fee contains a denomination that
+	// cannot be used to pay fees.
+	ConvertFee(ctx context.Context, fee sdk.Coins) (sdk.Coins, error)
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
@@ -22,19 +35,31 @@ type DeductFeeDecorator struct {
 	accountKeeper  AccountKeeper
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
-	txFeeChecker   TxFeeChecker
+	// txFeeChecker is nil for the default fee logic, checking the validator
+	// minimum gas prices
+	txFeeChecker TxFeeChecker
+	feeConverter FeeDenomConverter
 }
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
-	if tfc == nil {
-		tfc = checkTxFeeWithValidatorMinGasPrices
-	}
+	return NewDeductFeeDecoratorWithConverter(ak, bk, fk, tfc, nil)
+}
 
+// NewDeductFeeDecoratorWithConverter returns a DeductFeeDecorator which accepts
+// fees in the alternative denominations supported by the given FeeDenomConverter.
+// If no TxFeeChecker is provided, the validator minimum gas prices are checked
+// against both the offered and the converted fee.
+//
+// The fees paid in alternative denominations are swapped into the native fee
+// denomination: they are sent to the FeeDenomReserveName module account, which
+// sends their converted value to the fee collector.
+func NewDeductFeeDecoratorWithConverter(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker, fc FeeDenomConverter) DeductFeeDecorator {
 	return DeductFeeDecorator{
 		accountKeeper:  ak,
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
 		txFeeChecker:   tfc,
+		feeConverter:   fc,
 	}
 }
 
@@ -48,19 +73,35 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
 	}
 
-	var (
-		priority int64
-		err      error
-	)
+	var priority int64
 
+	// the fee is converted once for both the fee check and the deduction, this
+	// also makes sure every offered denomination can be accepted as fee in
+	// simulation mode where the fee check is skipped.
 	fee := feeTx.GetFee()
+	nativeFee, err := dfd.convertFee(ctx, fee)
+	if err != nil {
+		return ctx, err
+	}
+
 	if !simulate {
-		fee, priority, err = dfd.txFeeChecker(ctx, tx)
+		if dfd.txFeeChecker == nil {
+			priority, err = checkTxFee(ctx, feeTx.GetGas(), fee, nativeFee)
+		} else {
+			fee, priority, err = dfd.txFeeChecker(ctx, tx)
+			// the effective fee returned by a custom fee checker may differ
+			// from the offered one
+			if err == nil && !fee.Equal(feeTx.GetFee()) {
+				nativeFee, err = dfd.convertFee(ctx, fee)
+			}
+		}
+
 		if err != nil {
 			return ctx, err
 		}
 	}
-	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
+
+	if err := dfd.checkDeductFee(ctx, tx, fee, nativeFee); err != nil {
 		return ctx, err
 	}
 
@@ -69,7 +110,17 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(newCtx, tx, simulate)
 }
 
-func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+// convertFee returns the value of fee in the native fee denomination, or fee
+// unchanged if no FeeDenomConverter is set.
+func (dfd DeductFeeDecorator) convertFee(ctx sdk.Context, fee sdk.Coins) (sdk.Coins, error) {
+	if dfd.feeConverter == nil {
+		return fee, nil
+	}
+
+	return dfd.feeConverter.ConvertFee(ctx, fee)
+}
+
+func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee, nativeFee sdk.Coins) error {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
 		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
@@ -103,26 +154,74 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
-	// deduct the fees
+	// deduct the fees, the fees paid in the native denomination only are
+	// deducted as is
+	var reserveBalance sdk.Coins
 	if !fee.IsZero() {
-		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
+		var err error
+		if nativeFee.Equal(fee) {
+			err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
+		} else {
+			reserveBalance, err = dfd.swapFees(ctx, deductFeesFromAcc, fee, nativeFee)
+		}
+
 		if err != nil {
 			return err
 		}
 	}
 
-	events := sdk.Events{
-		sdk.NewEvent(
-			sdk.EventTypeTx,
-			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
-			sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
-		),
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
+	}
+	if !nativeFee.Equal(fee) {
+		attrs = append(attrs, sdk.NewAttribute(sdk.AttributeKeyNativeFee, nativeFee.String()))
 	}
-	ctx.EventManager().EmitEvents(events)
+	if !reserveBalance.IsZero() {
+		attrs = append(attrs, sdk.NewAttribute(sdk.AttributeKeyFeeDenomReserveBalance, reserveBalance.String()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx, attrs...))
 
 	return nil
 }
 
+// swapFees deducts fees paid in alternative denominations into the fee denom
+// reserve, which sends their value in the native fee denomination to the fee
+// collector. It returns the balance left in the reserve in the native fee
+// denominations, so operators can watch it and top the reserve up.
+func (dfd DeductFeeDecorator) swapFees(ctx sdk.Context, acc sdk.AccountI, fees, nativeFees sdk.Coins) (sdk.Coins, error) {
+	reserveAddr := dfd.accountKeeper.GetModuleAddress(types.FeeDenomReserveName)
+	if reserveAddr == nil {
+		return nil, fmt.Errorf("fee denom reserve module account (%s) has not been set", types.FeeDenomReserveName)
+	}
+
+	if !fees.IsValid() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
+	}
+
+	err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeDenomReserveName, fees)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	if nativeFees.IsZero() {
+		return nil, nil
+	}
+
+	err = dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, types.FeeDenomReserveName, types.FeeCollectorName, nativeFees)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "fee denom reserve cannot swap %s: %s", fees, err)
+	}
+
+	balance := sdk.NewCoins()
+	for _, coin := range nativeFees {
+		balance = balance.Add(dfd.bankKeeper.GetBalance(ctx, reserveAddr, coin.Denom))
+	}
+
+	return balance, nil
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc sdk.AccountI, fees sdk.Coins) error {
 	if !fees.IsValid() {
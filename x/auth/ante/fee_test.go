@@ -141,3 +141,88 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeesWithConverter(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	table := authtypes.NewFeeDenomPriceTable("stake", authtypes.NewFeeDenomPrice("ibc/atom", math.LegacyNewDec(2)))
+	require.NoError(t, s.accountKeeper.SetFeeDenomPriceTable(s.ctx, table))
+
+	// keys and addresses
+	accs := s.CreateTestAccounts(1)
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	gasLimit := uint64(100)
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+
+	dfd := ante.NewDeductFeeDecoratorWithConverter(s.accountKeeper, s.bankKeeper, nil, nil, s.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// min gas price of 1stake requires 100stake, i.e. 50ibc/atom
+	s.ctx = s.ctx.WithMinGasPrices([]sdk.DecCoin{sdk.NewDecCoinFromDec("stake", math.LegacyOneDec())})
+
+	// insufficient fee in alternative denom
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 49)))
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// non whitelisted denom is rejected, even in simulation mode
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("ibc/osmo", 1000)))
+	tx, err = s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+	_, err = antehandler(s.ctx, tx, true)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	// sufficient fee in alternative denom is swapped into the native denom
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 50))
+	s.txBuilder.SetFeeAmount(fee)
+	tx, err = s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeDenomReserveName, fee).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeDenomReserveName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Return(nil)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), s.accountKeeper.GetModuleAddress(authtypes.FeeDenomReserveName), "stake").Return(sdk.NewInt64Coin("stake", 900))
+
+	newCtx, err := antehandler(s.ctx.WithEventManager(sdk.NewEventManager()), tx, false)
+	require.NoError(t, err)
+	// priority is computed from the converted fee: 100stake / 100 gas
+	require.Equal(t, int64(1), newCtx.Priority())
+	// the remaining reserve balance is reported in the tx event
+	events := newCtx.EventManager().Events()
+	require.Len(t, events, 1)
+	attr, ok := events[0].GetAttribute(sdk.AttributeKeyFeeDenomReserveBalance)
+	require.True(t, ok)
+	require.Equal(t, "900stake", attr.Value)
+
+	// the swap fails if the reserve cannot pay the converted fee
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeDenomReserveName, fee).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeDenomReserveName, authtypes.FeeCollectorName, gomock.Any()).Return(sdkerrors.ErrInsufficientFunds)
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// min gas prices can be set in the alternative denom
+	s.ctx = s.ctx.WithMinGasPrices([]sdk.DecCoin{sdk.NewDecCoinFromDec("ibc/atom", math.LegacyNewDecWithPrec(5, 1))})
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeDenomReserveName, fee).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeDenomReserveName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), gomock.Any(), "stake").Return(sdk.NewInt64Coin("stake", 800))
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	// fees paid in the native denom are deducted as is
+	fee = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	s.txBuilder.SetFeeAmount(fee)
+	tx, err = s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	s.ctx = s.ctx.WithMinGasPrices([]sdk.DecCoin{sdk.NewDecCoinFromDec("stake", math.LegacyOneDec())})
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, fee).Return(nil)
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+}
@@ -71,6 +71,7 @@ func SetupTestSuite(t *testing.T, isCheckTx bool) *AnteTestSuite {
 
 	maccPerms := map[string][]string{
 		"fee_collector":          nil,
+		"fee_denom_reserve":      nil,
 		"mint":                   {"minter"},
 		"bonded_tokens_pool":     {"burner", "staking"},
 		"not_bonded_tokens_pool": {"burner", "staking"},
@@ -19,8 +19,18 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins,
 	}
 
 	feeCoins := feeTx.GetFee()
-	gas := feeTx.GetGas()
+	priority, err := checkTxFee(ctx, feeTx.GetGas(), feeCoins, feeCoins)
+	if err != nil {
+		return nil, 0, err
+	}
 
+	return feeCoins, priority, nil
+}
+
+// checkTxFee checks the validator minimum gas prices against the fee offered in
+// the tx and its value in the native fee denomination, and returns the tx
+// priority computed from the latter.
+func checkTxFee(ctx sdk.Context, gas uint64, feeCoins, nativeFeeCoins sdk.Coins) (int64, error) {
 	// Ensure that the provided fees meet a minimum threshold for the validator,
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
 	// is only ran on check tx.
@@ -37,14 +47,15 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins,
 				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 			}
 
-			if !feeCoins.IsAnyGTE(requiredFees) {
-				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			// the minimum gas prices may be set in the native fee denomination
+			// or in the alternative denominations offered
+			if !feeCoins.IsAnyGTE(requiredFees) && !nativeFeeCoins.IsAnyGTE(requiredFees) {
+				return 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
 	}
 
-	priority := getTxPriority(feeCoins, int64(gas))
-	return feeCoins, priority, nil
+	return getTxPriority(nativeFeeCoins, int64(gas)), nil
 }
 
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
@@ -23,8 +23,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "query account address by account number",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod: "FeeDenomPriceTable",
+					Use:       "fee-denom-price-table",
+					Short:     "query the alternative fee denominations and their price in the native fee denom",
+				},
 			},
 		},
-		// Tx is purposely left empty, as the only tx is MsgUpdateParams which is gov gated.
+		// Tx is purposely left empty, as the only txs are MsgUpdateParams and MsgUpdateFeeDenomPriceTable which are gov gated.
 	}
 }
@@ -14,6 +14,10 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		panic(err)
 	}
 
+	if err := ak.SetFeeDenomPriceTable(ctx, data.FeeDenomPriceTable); err != nil {
+		panic(err)
+	}
+
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
 		panic(err)
@@ -45,5 +49,7 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.FeeDenomPriceTable = ak.GetFeeDenomPriceTable(ctx)
+	return genState
 }
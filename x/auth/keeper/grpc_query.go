@@ -252,3 +252,13 @@ func (s queryServer) AccountInfo(goCtx context.Context, req *types.QueryAccountI
 		},
 	}, nil
 }
+
+// FeeDenomPriceTable returns the alternative fee denominations and their price
+// in the native fee denomination.
+func (s queryServer) FeeDenomPriceTable(ctx context.Context, req *types.QueryFeeDenomPriceTableRequest) (*types.QueryFeeDenomPriceTableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryFeeDenomPriceTableResponse{PriceTable: s.k.GetFeeDenomPriceTable(ctx)}, nil
+}
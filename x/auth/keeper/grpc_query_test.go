@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -521,3 +523,12 @@ func (suite *KeeperTestSuite) TestQueryAccountInfo() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(pkBz, res.Info.PubKey.Value)
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryFeeDenomPriceTable() {
+	table := types.NewFeeDenomPriceTable("stake", types.NewFeeDenomPrice("ibc/atom", sdkmath.LegacyNewDec(2)))
+	suite.Require().NoError(suite.accountKeeper.SetFeeDenomPriceTable(suite.ctx, table))
+
+	res, err := suite.queryClient.FeeDenomPriceTable(suite.ctx, &types.QueryFeeDenomPriceTableRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(table, res.PriceTable)
+}
@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -76,8 +77,9 @@ type AccountKeeper struct {
 	authority string
 
 	// State
-	Params        collections.Item[types.Params]
-	AccountNumber collections.Sequence
+	Params             collections.Item[types.Params]
+	AccountNumber      collections.Sequence
+	FeeDenomPriceTable collections.Item[types.FeeDenomPriceTable]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -100,15 +102,16 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	return AccountKeeper{
-		Codec:         authcodec.NewBech32Codec(bech32Prefix),
-		bech32Prefix:  bech32Prefix,
-		storeService:  storeService,
-		proto:         proto,
-		cdc:           cdc,
-		permAddrs:     permAddrs,
-		authority:     authority,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		Codec:              authcodec.NewBech32Codec(bech32Prefix),
+		bech32Prefix:       bech32Prefix,
+		storeService:       storeService,
+		proto:              proto,
+		cdc:                cdc,
+		permAddrs:          permAddrs,
+		authority:          authority,
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:      collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		FeeDenomPriceTable: collections.NewItem(sb, types.FeeDenomPriceTableKey, "fee_denom_price_table", codec.CollValue[types.FeeDenomPriceTable](cdc)),
 	}
 }
 
@@ -276,3 +279,29 @@ func (ak AccountKeeper) GetParams(ctx context.Context) (params types.Params) {
 	}
 	return params
 }
+
+// SetFeeDenomPriceTable sets the table of alternative fee denominations.
+func (ak AccountKeeper) SetFeeDenomPriceTable(ctx context.Context, table types.FeeDenomPriceTable) error {
+	return ak.FeeDenomPriceTable.Set(ctx, table)
+}
+
+// GetFeeDenomPriceTable gets the table of alternative fee denominations.
+func (ak AccountKeeper) GetFeeDenomPriceTable(ctx context.Context) (table types.FeeDenomPriceTable) {
+	table, err := ak.FeeDenomPriceTable.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	return table
+}
+
+// ConvertFee returns the value of fee expressed in the native fee denomination
+// using the governance managed fee denom price table. It implements the
+// ante.FeeDenomConverter interface, through which depinject provides the keeper
+// to the x/auth/tx module.
+//
+// The table is read without consuming gas, so that the txs paying fees in the
+// native denomination cost the same gas whether the conversion is enabled or not.
+func (ak AccountKeeper) ConvertFee(ctx context.Context, fee sdk.Coins) (sdk.Coins, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	return ak.GetFeeDenomPriceTable(sdkCtx).ConvertFee(fee)
+}
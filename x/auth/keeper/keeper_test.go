@@ -9,6 +9,7 @@ import (
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -153,6 +154,26 @@ func (suite *KeeperTestSuite) TestGetSetParams() {
 	suite.Require().Equal(params, actualParams)
 }
 
+func (suite *KeeperTestSuite) TestConvertFee() {
+	ctx := suite.ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ibc/atom", 10))
+
+	// the conversion is disabled by default
+	converted, err := suite.accountKeeper.ConvertFee(ctx, fee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee, converted)
+
+	table := types.NewFeeDenomPriceTable("stake", types.NewFeeDenomPrice("ibc/atom", sdkmath.LegacyNewDec(3)))
+	suite.Require().NoError(suite.accountKeeper.SetFeeDenomPriceTable(ctx, table))
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	converted, err = suite.accountKeeper.ConvertFee(ctx, fee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), converted)
+	// the price table is read without consuming gas
+	suite.Require().Equal(gasBefore, ctx.GasMeter().GasConsumed())
+}
+
 func (suite *KeeperTestSuite) TestSupply_ValidatePermissions() {
 	err := suite.accountKeeper.ValidatePermissions(multiPermAcc)
 	suite.Require().NoError(err)
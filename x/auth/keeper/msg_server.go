@@ -39,3 +39,20 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) UpdateFeeDenomPriceTable(goCtx context.Context, msg *types.MsgUpdateFeeDenomPriceTable) (*types.MsgUpdateFeeDenomPriceTableResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.ak.authority, msg.Authority)
+	}
+
+	if err := msg.PriceTable.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.ak.SetFeeDenomPriceTable(ctx, msg.PriceTable); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeeDenomPriceTableResponse{}, nil
+}
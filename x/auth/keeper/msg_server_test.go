@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -109,3 +111,64 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateFeeDenomPriceTable() {
+	validTable := types.NewFeeDenomPriceTable("stake", types.NewFeeDenomPrice("ibc/atom", sdkmath.LegacyNewDecWithPrec(25, 1)))
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateFeeDenomPriceTable
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name: "set invalid authority",
+			req: &types.MsgUpdateFeeDenomPriceTable{
+				Authority:  "foo",
+				PriceTable: validTable,
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "set prices without native denom",
+			req: &types.MsgUpdateFeeDenomPriceTable{
+				Authority:  s.accountKeeper.GetAuthority(),
+				PriceTable: types.NewFeeDenomPriceTable("", types.NewFeeDenomPrice("ibc/atom", sdkmath.LegacyOneDec())),
+			},
+			expectErr: true,
+			expErrMsg: "fee denom prices require a native denom",
+		},
+		{
+			name: "set non positive price",
+			req: &types.MsgUpdateFeeDenomPriceTable{
+				Authority:  s.accountKeeper.GetAuthority(),
+				PriceTable: types.NewFeeDenomPriceTable("stake", types.NewFeeDenomPrice("ibc/atom", sdkmath.LegacyZeroDec())),
+			},
+			expectErr: true,
+			expErrMsg: "price must be positive",
+		},
+		{
+			name: "set valid table",
+			req: &types.MsgUpdateFeeDenomPriceTable{
+				Authority:  s.accountKeeper.GetAuthority(),
+				PriceTable: validTable,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateFeeDenomPriceTable(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.req.PriceTable, s.accountKeeper.GetFeeDenomPriceTable(s.ctx))
+			}
+		})
+	}
+}
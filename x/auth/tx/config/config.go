@@ -45,6 +45,7 @@ type ModuleInputs struct {
 	BankKeeper             authtypes.BankKeeper               `optional:"true"`
 	AccountKeeper          ante.AccountKeeper                 `optional:"true"`
 	FeeGrantKeeper         ante.FeegrantKeeper                `optional:"true"`
+	FeeDenomConverter      ante.FeeDenomConverter             `optional:"true"`
 	CustomSignModeHandlers func() []txsigning.SignModeHandler `optional:"true"`
 }
 
@@ -128,11 +129,12 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:     in.AccountKeeper,
+			BankKeeper:        in.BankKeeper,
+			SignModeHandler:   txConfig.SignModeHandler(),
+			FeegrantKeeper:    in.FeeGrantKeeper,
+			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+			FeeDenomConverter: in.FeeDenomConverter,
 		},
 	)
 	if err != nil {
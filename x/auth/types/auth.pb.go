@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -244,63 +245,183 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+// FeeDenomPrice defines the price of an alternative fee denomination expressed
+// in units of the native fee denomination.
+//
+// Since: cosmos-sdk 0.48
+type FeeDenomPrice struct {
+	// denom is the alternative denomination that can be used to pay fees.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the amount of native fee denomination one unit of denom is worth.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *FeeDenomPrice) Reset()         { *m = FeeDenomPrice{} }
+func (m *FeeDenomPrice) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomPrice) ProtoMessage()    {}
+func (*FeeDenomPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *FeeDenomPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomPrice.Merge(m, src)
+}
+func (m *FeeDenomPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomPrice proto.InternalMessageInfo
+
+func (m *FeeDenomPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// FeeDenomPriceTable defines the governance managed set of alternative fee
+// denominations and their conversion rate into the native fee denomination.
+//
+// Since: cosmos-sdk 0.48
+type FeeDenomPriceTable struct {
+	// native_denom is the denomination fees are converted into. An empty
+	// native_denom disables fee denom conversion.
+	NativeDenom string `protobuf:"bytes,1,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty"`
+	// prices is the list of whitelisted alternative fee denominations.
+	Prices []FeeDenomPrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *FeeDenomPriceTable) Reset()         { *m = FeeDenomPriceTable{} }
+func (m *FeeDenomPriceTable) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomPriceTable) ProtoMessage()    {}
+func (*FeeDenomPriceTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *FeeDenomPriceTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomPriceTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomPriceTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomPriceTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomPriceTable.Merge(m, src)
+}
+func (m *FeeDenomPriceTable) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomPriceTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomPriceTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomPriceTable proto.InternalMessageInfo
+
+func (m *FeeDenomPriceTable) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+func (m *FeeDenomPriceTable) GetPrices() []FeeDenomPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*FeeDenomPrice)(nil), "cosmos.auth.v1beta1.FeeDenomPrice")
+	proto.RegisterType((*FeeDenomPriceTable)(nil), "cosmos.auth.v1beta1.FeeDenomPriceTable")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xeb, 0x46,
-	0x14, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x29,
-	0x2a, 0x76, 0x93, 0x8a, 0x4a, 0xcd, 0x8e, 0xa4, 0x55, 0x85, 0x28, 0x14, 0x39, 0x2a, 0x0b, 0x36,
-	0xd6, 0xd8, 0x19, 0xcc, 0x88, 0x8c, 0xc7, 0xf5, 0x8c, 0x51, 0xcc, 0xba, 0x0b, 0xd4, 0x55, 0xd5,
-	0x2f, 0xa0, 0xfd, 0x02, 0x16, 0x7c, 0x44, 0xd5, 0x15, 0xea, 0xa6, 0x6f, 0x15, 0x3d, 0x85, 0x05,
-	0xe8, 0xe9, 0x7d, 0xc4, 0x93, 0x67, 0x1c, 0x48, 0x78, 0xd9, 0x44, 0x9e, 0x73, 0xce, 0xbd, 0xf7,
-	0xdc, 0xe3, 0x89, 0x41, 0xd5, 0xa3, 0x8c, 0x50, 0x66, 0xc1, 0x98, 0x9f, 0x59, 0x17, 0x0d, 0x17,
-	0x71, 0xd8, 0x10, 0x07, 0x33, 0x8c, 0x28, 0xa7, 0xea, 0xaa, 0xe4, 0x4d, 0x01, 0x65, 0x7c, 0x65,
-	0x05, 0x12, 0x1c, 0x50, 0x4b, 0xfc, 0x4a, 0x5d, 0x65, 0x5d, 0xea, 0x1c, 0x71, 0xb2, 0xb2, 0x22,
-	0x49, 0x95, 0x7d, 0xea, 0x53, 0x89, 0xa7, 0x4f, 0xe3, 0x02, 0x9f, 0x52, 0xbf, 0x8f, 0x2c, 0x71,
-	0x72, 0xe3, 0x53, 0x0b, 0x06, 0x89, 0xa4, 0x6a, 0x7f, 0xe5, 0x41, 0xa9, 0x0d, 0x19, 0xda, 0xf5,
-	0x3c, 0x1a, 0x07, 0x5c, 0x6d, 0x82, 0x79, 0xd8, 0xeb, 0x45, 0x88, 0x31, 0x4d, 0x31, 0x94, 0xfa,
-	0x42, 0x5b, 0xfb, 0xef, 0x76, 0xbb, 0x9c, 0xcd, 0xd8, 0x95, 0x4c, 0x97, 0x47, 0x38, 0xf0, 0xed,
-	0xb1, 0x50, 0x3d, 0x06, 0xf3, 0x61, 0xec, 0x3a, 0xe7, 0x28, 0xd1, 0xf2, 0x86, 0x52, 0x2f, 0x35,
-	0xcb, 0xa6, 0x1c, 0x68, 0x8e, 0x07, 0x9a, 0xbb, 0x41, 0xd2, 0xde, 0x7c, 0x33, 0xd4, 0xcb, 0x61,
-	0xec, 0xf6, 0xb1, 0x97, 0x6a, 0xbf, 0xa2, 0x04, 0x73, 0x44, 0x42, 0x9e, 0xfc, 0xfd, 0x70, 0xb3,
-	0x05, 0x9e, 0x09, 0x7b, 0x2e, 0x8c, 0xdd, 0x7d, 0x94, 0xa8, 0x9f, 0x83, 0x65, 0x28, 0x6d, 0x39,
-	0x41, 0x4c, 0x5c, 0x14, 0x69, 0x05, 0x43, 0xa9, 0x17, 0xed, 0xa5, 0x0c, 0x3d, 0x14, 0xa0, 0x5a,
-	0x01, 0x1f, 0x32, 0xf4, 0x6b, 0x8c, 0x02, 0x0f, 0x69, 0x45, 0x21, 0x78, 0x3a, 0xb7, 0x3a, 0x57,
-	0xd7, 0x7a, 0xee, 0xf1, 0x5a, 0xcf, 0xfd, 0x7b, 0xbb, 0xfd, 0xd9, 0x8c, 0x78, 0xcd, 0x6c, 0xef,
-	0xbd, 0xdf, 0x1f, 0x6e, 0xb6, 0xd6, 0xa4, 0x60, 0x9b, 0xf5, 0xce, 0xad, 0x89, 0x4c, 0x6a, 0x6f,
-	0x15, 0xb0, 0x74, 0x40, 0x7b, 0x71, 0xff, 0x29, 0xa5, 0x3d, 0xb0, 0xe8, 0x42, 0x86, 0x9c, 0xcc,
-	0x88, 0x88, 0xaa, 0xd4, 0x34, 0xcc, 0x59, 0x13, 0x26, 0x3a, 0xb5, 0x8b, 0x77, 0x43, 0x5d, 0xb1,
-	0x4b, 0xee, 0x44, 0xe0, 0x2a, 0x28, 0x06, 0x90, 0x20, 0x91, 0xdc, 0x82, 0x2d, 0x9e, 0x55, 0x03,
-	0x94, 0x42, 0x14, 0x11, 0xcc, 0x18, 0xa6, 0x01, 0xd3, 0x0a, 0x46, 0xa1, 0xbe, 0x60, 0x4f, 0x42,
-	0xad, 0x93, 0x2b, 0xb9, 0x53, 0x6d, 0xd6, 0xc4, 0x29, 0xaf, 0x62, 0x33, 0x6d, 0x62, 0xb3, 0x29,
-	0xf6, 0xcf, 0x87, 0x9b, 0xad, 0x65, 0x22, 0x90, 0xf1, 0x32, 0xb5, 0xdf, 0x14, 0xf0, 0xb1, 0x14,
-	0x75, 0x22, 0xd4, 0x43, 0x01, 0xc7, 0xb0, 0xaf, 0xea, 0xa0, 0x94, 0xc9, 0x84, 0x5b, 0x71, 0x37,
-	0x6c, 0x20, 0xa1, 0xc3, 0xd4, 0xf3, 0x26, 0xf8, 0xa8, 0x87, 0x22, 0x7c, 0x01, 0x39, 0xa6, 0x41,
-	0xfa, 0x1a, 0x99, 0x96, 0x37, 0x0a, 0xf5, 0x45, 0x7b, 0xf9, 0x19, 0xde, 0x47, 0x09, 0x6b, 0x7d,
-	0x91, 0x1a, 0xda, 0x98, 0x30, 0xf4, 0x63, 0x44, 0xe3, 0x30, 0xf3, 0xf3, 0x3c, 0xb1, 0xf6, 0x7f,
-	0x1e, 0xcc, 0x1d, 0xc1, 0x08, 0x12, 0xa6, 0x9a, 0x60, 0x95, 0xc0, 0x81, 0x43, 0x10, 0xa1, 0x8e,
-	0x77, 0x06, 0x23, 0xe8, 0x71, 0x14, 0xc9, 0x0b, 0x5a, 0xb4, 0x57, 0x08, 0x1c, 0x1c, 0x20, 0x42,
-	0x3b, 0x4f, 0x84, 0x6a, 0x80, 0x45, 0x3e, 0x70, 0x18, 0xf6, 0x9d, 0x3e, 0x26, 0x98, 0x8b, 0x6c,
-	0x8b, 0x36, 0xe0, 0x83, 0x2e, 0xf6, 0x7f, 0x4a, 0x11, 0xf5, 0x6b, 0xf0, 0x89, 0x50, 0x5c, 0x22,
-	0xc7, 0xa3, 0x8c, 0x3b, 0x21, 0x8a, 0x1c, 0x37, 0xe1, 0x28, 0xbb, 0x61, 0x2b, 0xa9, 0xf4, 0x12,
-	0x75, 0x28, 0xe3, 0x47, 0x28, 0x6a, 0x27, 0x1c, 0xa9, 0x3f, 0x83, 0x4f, 0xd3, 0x86, 0x17, 0x28,
-	0xc2, 0xa7, 0x89, 0x2c, 0x42, 0xbd, 0xe6, 0xce, 0x4e, 0xe3, 0x3b, 0x79, 0xe9, 0xda, 0xda, 0x68,
-	0xa8, 0x97, 0xbb, 0xd8, 0x3f, 0x16, 0x8a, 0xb4, 0xf4, 0x87, 0xef, 0x05, 0x6f, 0x97, 0xd9, 0x14,
-	0x2a, 0xab, 0xd4, 0x5f, 0xc0, 0xfa, 0xcb, 0x86, 0x0c, 0x79, 0x61, 0x73, 0xe7, 0xdb, 0xf3, 0x86,
-	0xf6, 0x81, 0x68, 0x59, 0x19, 0x0d, 0xf5, 0xb5, 0xa9, 0x96, 0xdd, 0xb1, 0xc2, 0x5e, 0x63, 0x33,
-	0xf1, 0xd6, 0xc6, 0xe3, 0xb5, 0xae, 0xbc, 0x7c, 0xe7, 0x03, 0xf9, 0xcd, 0x91, 0x71, 0xb6, 0x3b,
-	0xff, 0x8c, 0xaa, 0xca, 0xdd, 0xa8, 0xaa, 0xbc, 0x1e, 0x55, 0x95, 0x3f, 0xee, 0xab, 0xb9, 0xbb,
-	0xfb, 0x6a, 0xee, 0xd5, 0x7d, 0x35, 0x77, 0xf2, 0xa5, 0x8f, 0xf9, 0x59, 0xec, 0x9a, 0x1e, 0x25,
-	0xd9, 0x77, 0xc5, 0x7a, 0xbf, 0x0b, 0x4f, 0x42, 0xc4, 0xdc, 0x39, 0xf1, 0xdf, 0xfe, 0xe6, 0x5d,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x84, 0x5b, 0x40, 0xd5, 0x04, 0x00, 0x00,
+	// 861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0x4e, 0x4a, 0xc6, 0x49, 0x20, 0x53, 0x13, 0xb6, 0x11, 0xf2, 0x3a, 0x16, 0xb4,
+	0x26, 0xc2, 0x6b, 0x62, 0x14, 0x24, 0x7c, 0x8b, 0x9d, 0x82, 0xaa, 0xd2, 0x12, 0x6d, 0xa0, 0x48,
+	0xbd, 0xac, 0x66, 0xd7, 0xaf, 0x9b, 0x51, 0x3c, 0x3b, 0xcb, 0xce, 0x6c, 0xe4, 0xed, 0x99, 0x43,
+	0xc5, 0x09, 0xf1, 0x0b, 0x02, 0x27, 0x2e, 0x48, 0x39, 0xe4, 0x47, 0x54, 0x9c, 0xa2, 0x5e, 0x40,
+	0x1c, 0x2c, 0xe4, 0x1c, 0x52, 0x21, 0x7e, 0x04, 0xda, 0x99, 0x75, 0x62, 0xb7, 0x56, 0x2f, 0xd6,
+	0xbe, 0xef, 0x7d, 0xef, 0xbd, 0x6f, 0xbe, 0x79, 0x1e, 0x54, 0xf1, 0xb9, 0x60, 0x5c, 0x34, 0x49,
+	0x22, 0x0f, 0x9b, 0xc7, 0xdb, 0x1e, 0x48, 0xb2, 0xad, 0x02, 0x3b, 0x8a, 0xb9, 0xe4, 0xf8, 0xa6,
+	0xce, 0xdb, 0x0a, 0xca, 0xf3, 0x1b, 0x6b, 0x84, 0xd1, 0x90, 0x37, 0xd5, 0xaf, 0xe6, 0x6d, 0xdc,
+	0xd2, 0x3c, 0x57, 0x45, 0xcd, 0xbc, 0x48, 0xa7, 0xca, 0x01, 0x0f, 0xb8, 0xc6, 0xb3, 0xaf, 0x71,
+	0x41, 0xc0, 0x79, 0xd0, 0x87, 0xa6, 0x8a, 0xbc, 0xe4, 0x49, 0x93, 0x84, 0xa9, 0x4e, 0xd5, 0x7e,
+	0x99, 0x43, 0xa5, 0x0e, 0x11, 0xb0, 0xeb, 0xfb, 0x3c, 0x09, 0x25, 0x6e, 0xa1, 0x1b, 0xa4, 0xd7,
+	0x8b, 0x41, 0x08, 0xd3, 0xa8, 0x1a, 0xf5, 0xa5, 0x8e, 0xf9, 0xe2, 0xac, 0x51, 0xce, 0x67, 0xec,
+	0xea, 0xcc, 0x81, 0x8c, 0x69, 0x18, 0x38, 0x63, 0x22, 0x7e, 0x84, 0x6e, 0x44, 0x89, 0xe7, 0x1e,
+	0x41, 0x6a, 0xce, 0x55, 0x8d, 0x7a, 0xa9, 0x55, 0xb6, 0xf5, 0x40, 0x7b, 0x3c, 0xd0, 0xde, 0x0d,
+	0xd3, 0xce, 0x9d, 0x7f, 0x87, 0x56, 0x39, 0x4a, 0xbc, 0x3e, 0xf5, 0x33, 0xee, 0xc7, 0x9c, 0x51,
+	0x09, 0x2c, 0x92, 0xe9, 0xaf, 0x97, 0xa7, 0x5b, 0xe8, 0x3a, 0xe1, 0x2c, 0x46, 0x89, 0x77, 0x1f,
+	0x52, 0xfc, 0x21, 0x5a, 0x25, 0x5a, 0x96, 0x1b, 0x26, 0xcc, 0x83, 0xd8, 0x9c, 0xaf, 0x1a, 0xf5,
+	0xa2, 0xb3, 0x92, 0xa3, 0x0f, 0x15, 0x88, 0x37, 0xd0, 0x5b, 0x02, 0xbe, 0x4f, 0x20, 0xf4, 0xc1,
+	0x2c, 0x2a, 0xc2, 0x55, 0xdc, 0xee, 0x3e, 0x3b, 0xb1, 0x0a, 0x2f, 0x4f, 0xac, 0xc2, 0x1f, 0x67,
+	0x8d, 0xf7, 0x67, 0xd8, 0x6b, 0xe7, 0xe7, 0xbe, 0xf7, 0xe3, 0xe5, 0xe9, 0xd6, 0xba, 0x26, 0x34,
+	0x44, 0xef, 0xa8, 0x39, 0xe1, 0x49, 0xed, 0x3f, 0x03, 0xad, 0x3c, 0xe0, 0xbd, 0xa4, 0x7f, 0xe5,
+	0xd2, 0x3d, 0xb4, 0xec, 0x11, 0x01, 0x6e, 0x2e, 0x44, 0x59, 0x55, 0x6a, 0x55, 0xed, 0x59, 0x13,
+	0x26, 0x3a, 0x75, 0x8a, 0xe7, 0x43, 0xcb, 0x70, 0x4a, 0xde, 0x84, 0xe1, 0x18, 0x15, 0x43, 0xc2,
+	0x40, 0x39, 0xb7, 0xe4, 0xa8, 0x6f, 0x5c, 0x45, 0xa5, 0x08, 0x62, 0x46, 0x85, 0xa0, 0x3c, 0x14,
+	0xe6, 0x7c, 0x75, 0xbe, 0xbe, 0xe4, 0x4c, 0x42, 0xed, 0xc7, 0xcf, 0xf4, 0x99, 0x6a, 0xb3, 0x26,
+	0x4e, 0x69, 0x55, 0x27, 0x33, 0x27, 0x4e, 0x36, 0x95, 0xfd, 0xf9, 0xf2, 0x74, 0x6b, 0x95, 0x29,
+	0x64, 0x7c, 0x98, 0xda, 0x0f, 0x06, 0x7a, 0x47, 0x93, 0xba, 0x31, 0xf4, 0x20, 0x94, 0x94, 0xf4,
+	0xb1, 0x85, 0x4a, 0x39, 0x4d, 0xa9, 0x55, 0xbb, 0xe1, 0x20, 0x0d, 0x3d, 0xcc, 0x34, 0xdf, 0x41,
+	0x6f, 0xf7, 0x20, 0xa6, 0xc7, 0x44, 0x52, 0x1e, 0x66, 0xd7, 0x28, 0xcc, 0xb9, 0xea, 0x7c, 0x7d,
+	0xd9, 0x59, 0xbd, 0x86, 0xef, 0x43, 0x2a, 0xda, 0xb7, 0x33, 0x41, 0x9b, 0x13, 0x82, 0xbe, 0x8c,
+	0x79, 0x12, 0xe5, 0x7a, 0xae, 0x27, 0xd6, 0xfe, 0x9c, 0x43, 0x8b, 0xfb, 0x24, 0x26, 0x4c, 0x60,
+	0x1b, 0xdd, 0x64, 0x64, 0xe0, 0x32, 0x60, 0xdc, 0xf5, 0x0f, 0x49, 0x4c, 0x7c, 0x09, 0xb1, 0x5e,
+	0xd0, 0xa2, 0xb3, 0xc6, 0xc8, 0xe0, 0x01, 0x30, 0xde, 0xbd, 0x4a, 0xe0, 0x2a, 0x5a, 0x96, 0x03,
+	0x57, 0xd0, 0xc0, 0xed, 0x53, 0x46, 0xa5, 0xf2, 0xb6, 0xe8, 0x20, 0x39, 0x38, 0xa0, 0xc1, 0x57,
+	0x19, 0x82, 0x3f, 0x41, 0xef, 0x2a, 0xc6, 0x53, 0x70, 0x7d, 0x2e, 0xa4, 0x1b, 0x41, 0xec, 0x7a,
+	0xa9, 0x84, 0x7c, 0xc3, 0xd6, 0x32, 0xea, 0x53, 0xe8, 0x72, 0x21, 0xf7, 0x21, 0xee, 0xa4, 0x12,
+	0xf0, 0xd7, 0xe8, 0xbd, 0xac, 0xe1, 0x31, 0xc4, 0xf4, 0x49, 0xaa, 0x8b, 0xa0, 0xd7, 0xda, 0xd9,
+	0xd9, 0xfe, 0x5c, 0x2f, 0x5d, 0xc7, 0x1c, 0x0d, 0xad, 0xf2, 0x01, 0x0d, 0x1e, 0x29, 0x46, 0x56,
+	0x7a, 0x77, 0x4f, 0xe5, 0x9d, 0xb2, 0x98, 0x42, 0x75, 0x15, 0xfe, 0x16, 0xdd, 0x7a, 0xb5, 0xa1,
+	0x00, 0x3f, 0x6a, 0xed, 0x7c, 0x76, 0xb4, 0x6d, 0x2e, 0xa8, 0x96, 0x1b, 0xa3, 0xa1, 0xb5, 0x3e,
+	0xd5, 0xf2, 0x60, 0xcc, 0x70, 0xd6, 0xc5, 0x4c, 0xbc, 0xbd, 0xf9, 0xf2, 0xc4, 0x32, 0x5e, 0xbd,
+	0xf3, 0x81, 0x7e, 0x73, 0xb4, 0x9d, 0xd9, 0x05, 0xaf, 0x7c, 0x01, 0xb0, 0x07, 0x21, 0x67, 0xfb,
+	0x31, 0xf5, 0x01, 0x97, 0xd1, 0x42, 0x2f, 0x8b, 0xf2, 0x7b, 0xd5, 0x01, 0xfe, 0x0e, 0x2d, 0x44,
+	0x59, 0x5a, 0xef, 0x66, 0x67, 0xf7, 0xf9, 0xd0, 0x2a, 0xfc, 0x3d, 0xb4, 0x6e, 0x07, 0x54, 0x1e,
+	0x26, 0x9e, 0xed, 0x73, 0x96, 0x3f, 0x3e, 0xcd, 0x89, 0x51, 0x32, 0x8d, 0x40, 0xd8, 0x7b, 0xe0,
+	0xbf, 0x38, 0x6b, 0xa0, 0x7c, 0x3b, 0xf7, 0xc0, 0xff, 0xed, 0xf2, 0x74, 0xcb, 0x70, 0x74, 0xbf,
+	0x76, 0x31, 0xd3, 0x58, 0xfb, 0xdd, 0x40, 0x78, 0x4a, 0xc6, 0x37, 0xc4, 0xeb, 0x03, 0xde, 0x44,
+	0xcb, 0x21, 0x91, 0xf4, 0x18, 0xdc, 0x49, 0x49, 0x25, 0x8d, 0x29, 0x32, 0xbe, 0x8b, 0x16, 0x55,
+	0x23, 0xbd, 0x62, 0xa5, 0x56, 0x6d, 0xe6, 0x1f, 0x6f, 0xaa, 0x77, 0x67, 0x29, 0x53, 0xaf, 0x55,
+	0xe4, 0xc5, 0xed, 0xc6, 0xd8, 0xaa, 0x0f, 0x5e, 0xb7, 0xea, 0x75, 0x61, 0x9d, 0xee, 0xf3, 0x51,
+	0xc5, 0x38, 0x1f, 0x55, 0x8c, 0x7f, 0x46, 0x15, 0xe3, 0xa7, 0x8b, 0x4a, 0xe1, 0xfc, 0xa2, 0x52,
+	0xf8, 0xeb, 0xa2, 0x52, 0x78, 0xfc, 0xd1, 0x1b, 0x1d, 0xc9, 0x3b, 0x2a, 0x63, 0xbc, 0x45, 0xf5,
+	0x24, 0x7e, 0xfa, 0xff, 0x00, 0x53, 0x90, 0x81, 0x96, 0x0c, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -339,6 +460,65 @@ func (this *Params) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FeeDenomPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDenomPrice)
+	if !ok {
+		that2, ok := that.(FeeDenomPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
+}
+func (this *FeeDenomPriceTable) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDenomPriceTable)
+	if !ok {
+		that2, ok := that.(FeeDenomPriceTable)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NativeDenom != that1.NativeDenom {
+		return false
+	}
+	if len(this.Prices) != len(that1.Prices) {
+		return false
+	}
+	for i := range this.Prices {
+		if !this.Prices[i].Equal(&that1.Prices[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -529,6 +709,90 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenomPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomPriceTable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomPriceTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomPriceTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -629,6 +893,40 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *FeeDenomPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *FeeDenomPriceTable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovAuth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1200,6 +1498,238 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeeDenomPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomPriceTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomPriceTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomPriceTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, FeeDenomPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,8 +23,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/auth/Params", nil)
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
+	cdc.RegisterConcrete(FeeDenomPriceTable{}, "cosmos-sdk/x/auth/FeeDenomPriceTable", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeeDenomPriceTable{}, "cosmos-sdk/MsgUpdateFeeDenomPriceTable")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -61,6 +63,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateFeeDenomPriceTable{},
 	)
 }
 
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFeeDenomPriceTable creates a new FeeDenomPriceTable instance.
+func NewFeeDenomPriceTable(nativeDenom string, prices ...FeeDenomPrice) FeeDenomPriceTable {
+	return FeeDenomPriceTable{
+		NativeDenom: nativeDenom,
+		Prices:      prices,
+	}
+}
+
+// NewFeeDenomPrice creates a new FeeDenomPrice instance.
+func NewFeeDenomPrice(denom string, price math.LegacyDec) FeeDenomPrice {
+	return FeeDenomPrice{
+		Denom: denom,
+		Price: price,
+	}
+}
+
+// DefaultFeeDenomPriceTable returns an empty price table, which disables fee
+// denom conversion.
+func DefaultFeeDenomPriceTable() FeeDenomPriceTable {
+	return FeeDenomPriceTable{}
+}
+
+// IsEnabled returns true if fees can be paid in alternative denominations.
+func (t FeeDenomPriceTable) IsEnabled() bool {
+	return t.NativeDenom != ""
+}
+
+// GetPrice returns the price of the given denomination in native fee denom,
+// and false if the denomination is not whitelisted.
+func (t FeeDenomPriceTable) GetPrice(denom string) (math.LegacyDec, bool) {
+	for _, p := range t.Prices {
+		if p.Denom == denom {
+			return p.Price, true
+		}
+	}
+
+	return math.LegacyDec{}, false
+}
+
+// ConvertFee returns the value of fee expressed in the native fee denomination.
+// Whitelisted alternative denominations are converted using their price,
+// rounding down, while native coins are kept as is. An error is returned if
+// fee contains a denomination which is neither native nor whitelisted.
+//
+// If the table is not enabled, fee is returned unchanged.
+func (t FeeDenomPriceTable) ConvertFee(fee sdk.Coins) (sdk.Coins, error) {
+	if !t.IsEnabled() {
+		return fee, nil
+	}
+
+	native := math.ZeroInt()
+	for _, coin := range fee {
+		if coin.Denom == t.NativeDenom {
+			native = native.Add(coin.Amount)
+			continue
+		}
+
+		price, ok := t.GetPrice(coin.Denom)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "denom %s cannot be used to pay fees", coin.Denom)
+		}
+
+		native = native.Add(price.MulInt(coin.Amount).TruncateInt())
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(t.NativeDenom, native)), nil
+}
+
+// Validate performs basic validation of the price table.
+func (t FeeDenomPriceTable) Validate() error {
+	if !t.IsEnabled() {
+		if len(t.Prices) > 0 {
+			return fmt.Errorf("fee denom prices require a native denom")
+		}
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(t.NativeDenom); err != nil {
+		return fmt.Errorf("invalid native fee denom: %w", err)
+	}
+
+	seen := make(map[string]bool, len(t.Prices))
+	for _, p := range t.Prices {
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+
+		if p.Denom == t.NativeDenom {
+			return fmt.Errorf("native fee denom %s cannot have a price", p.Denom)
+		}
+
+		if seen[p.Denom] {
+			return fmt.Errorf("duplicate fee denom price: %s", p.Denom)
+		}
+		seen[p.Denom] = true
+
+		if p.Price.IsNil() || !p.Price.IsPositive() {
+			return fmt.Errorf("fee denom %s price must be positive: %s", p.Denom, p.Price)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestFeeDenomPriceTable_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		table  types.FeeDenomPriceTable
+		expErr string
+	}{
+		{"default table", types.DefaultFeeDenomPriceTable(), ""},
+		{"native denom only", types.NewFeeDenomPriceTable("stake"), ""},
+		{"valid prices", types.NewFeeDenomPriceTable("stake", types.NewFeeDenomPrice("atom", math.LegacyNewDec(2)), types.NewFeeDenomPrice("osmo", math.LegacyNewDecWithPrec(5, 1))), ""},
+		{"prices without native denom", types.NewFeeDenomPriceTable("", types.NewFeeDenomPrice("atom", math.LegacyOneDec())), "require a native denom"},
+		{"invalid native denom", types.NewFeeDenomPriceTable("1"), "invalid native fee denom"},
+		{"invalid denom", types.NewFeeDenomPriceTable("stake", types.NewFeeDenomPrice("1", math.LegacyOneDec())), "invalid fee denom"},
+		{"native denom priced", types.NewFeeDenomPriceTable("stake", types.NewFeeDenomPrice("stake", math.LegacyOneDec())), "cannot have a price"},
+		{"duplicate denom", types.NewFeeDenomPriceTable("stake", types.NewFeeDenomPrice("atom", math.LegacyOneDec()), types.NewFeeDenomPrice("atom", math.LegacyOneDec())), "duplicate fee denom"},
+		{"zero price", types.NewFeeDenomPriceTable("stake", types.NewFeeDenomPrice("atom", math.LegacyZeroDec())), "must be positive"},
+		{"nil price", types.NewFeeDenomPriceTable("stake", types.FeeDenomPrice{Denom: "atom"}), "must be positive"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.table.Validate()
+			if tt.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.expErr)
+			}
+		})
+	}
+}
+
+func TestFeeDenomPriceTable_ConvertFee(t *testing.T) {
+	table := types.NewFeeDenomPriceTable("stake",
+		types.NewFeeDenomPrice("atom", math.LegacyNewDec(2)),
+		types.NewFeeDenomPrice("osmo", math.LegacyNewDecWithPrec(5, 1)),
+	)
+
+	tests := []struct {
+		name   string
+		table  types.FeeDenomPriceTable
+		fee    sdk.Coins
+		expFee sdk.Coins
+		expErr bool
+	}{
+		{"disabled table", types.DefaultFeeDenomPriceTable(), sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), false},
+		{"empty fee", table, sdk.NewCoins(), sdk.NewCoins(), false},
+		{"native fee", table, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), false},
+		{"alternative fee", table, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), false},
+		{"rounds down", table, sdk.NewCoins(sdk.NewInt64Coin("osmo", 11)), sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), false},
+		{"mixed fee", table, sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("osmo", 4), sdk.NewInt64Coin("stake", 3)), sdk.NewCoins(sdk.NewInt64Coin("stake", 7)), false},
+		{"unknown denom", table, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), nil, true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			fee, err := tt.table.ConvertFee(tt.fee)
+			if tt.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expFee, fee)
+		})
+	}
+}
@@ -44,7 +44,9 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), GenesisAccounts{})
+	genState := NewGenesisState(DefaultParams(), GenesisAccounts{})
+	genState.FeeDenomPriceTable = DefaultFeeDenomPriceTable()
+	return genState
 }
 
 // GetGenesisStateFromAppState returns x/auth GenesisState given raw application
@@ -66,6 +68,10 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := data.FeeDenomPriceTable.Validate(); err != nil {
+		return err
+	}
+
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
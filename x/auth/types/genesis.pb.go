@@ -31,6 +31,11 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// fee_denom_price_table defines the alternative fee denominations accepted
+	// by the chain.
+	//
+	// Since: cosmos-sdk 0.48
+	FeeDenomPriceTable FeeDenomPriceTable `protobuf:"bytes,3,opt,name=fee_denom_price_table,json=feeDenomPriceTable,proto3" json:"fee_denom_price_table"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +85,13 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetFeeDenomPriceTable() FeeDenomPriceTable {
+	if m != nil {
+		return m.FeeDenomPriceTable
+	}
+	return FeeDenomPriceTable{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +99,27 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcd, 0x4e, 0x02, 0x31,
+	0x10, 0x80, 0xb7, 0x92, 0x10, 0x5d, 0xbc, 0xb8, 0x62, 0x82, 0x98, 0x54, 0xf4, 0x22, 0x9a, 0xd8,
+	0x0a, 0xde, 0x4d, 0xfc, 0x89, 0x5e, 0x09, 0x7a, 0xf2, 0x42, 0xba, 0xcb, 0x50, 0x36, 0xb2, 0x9d,
+	0x0d, 0xed, 0x1a, 0x79, 0x0b, 0x1f, 0xc3, 0xa3, 0x8f, 0xc1, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0xc0,
+	0x6b, 0x98, 0x6d, 0x57, 0x63, 0x22, 0x97, 0x76, 0x32, 0xf3, 0x4d, 0xfb, 0xcd, 0xf8, 0x07, 0x11,
+	0xea, 0x04, 0x35, 0x17, 0x99, 0x19, 0xf2, 0xe7, 0x56, 0x08, 0x46, 0xb4, 0xb8, 0x04, 0x05, 0x3a,
+	0xd6, 0x2c, 0x1d, 0xa3, 0xc1, 0x60, 0xdb, 0x21, 0x2c, 0x47, 0x58, 0x81, 0xd4, 0x77, 0x25, 0xa2,
+	0x1c, 0x01, 0xb7, 0x48, 0x98, 0x0d, 0xb8, 0x50, 0x13, 0xc7, 0xd7, 0xab, 0x12, 0x25, 0xda, 0x90,
+	0xe7, 0x51, 0x91, 0xa5, 0xab, 0x3e, 0xb2, 0x4f, 0xba, 0xfa, 0x96, 0x48, 0x62, 0x85, 0xdc, 0x9e,
+	0x2e, 0x75, 0xb8, 0x24, 0xfe, 0xe6, 0x9d, 0x53, 0xb9, 0x37, 0xc2, 0x40, 0x70, 0xe1, 0x97, 0x53,
+	0x31, 0x16, 0x89, 0xae, 0x91, 0x06, 0x69, 0x56, 0xda, 0x7b, 0x6c, 0x85, 0x1a, 0xeb, 0x58, 0xe4,
+	0x6a, 0x63, 0xfa, 0xb9, 0xef, 0xbd, 0x2d, 0xdf, 0x4f, 0x48, 0xb7, 0xe8, 0x0a, 0xce, 0xfc, 0x75,
+	0x11, 0x45, 0x98, 0x29, 0xa3, 0x6b, 0x6b, 0x8d, 0x52, 0xb3, 0xd2, 0xae, 0x32, 0x37, 0x07, 0xfb,
+	0x99, 0x83, 0x5d, 0xaa, 0x49, 0xf7, 0x97, 0x0a, 0xc0, 0xdf, 0x19, 0x00, 0xf4, 0xfa, 0xa0, 0x30,
+	0xe9, 0xa5, 0xe3, 0x38, 0x82, 0x9e, 0x11, 0xe1, 0x08, 0x6a, 0x25, 0x2b, 0x70, 0xb4, 0x52, 0xe0,
+	0x16, 0xe0, 0x26, 0x6f, 0xe8, 0xe4, 0xfc, 0x43, 0x8e, 0xff, 0x95, 0x09, 0x06, 0xff, 0xcb, 0xd7,
+	0xd3, 0x39, 0x25, 0xb3, 0x39, 0x25, 0x5f, 0x73, 0x4a, 0x5e, 0x17, 0xd4, 0x9b, 0x2d, 0xa8, 0xf7,
+	0xb1, 0xa0, 0xde, 0xe3, 0xb1, 0x8c, 0xcd, 0x30, 0x0b, 0x59, 0x84, 0x09, 0x2f, 0x36, 0xe8, 0xae,
+	0x53, 0xdd, 0x7f, 0xe2, 0x2f, 0x6e, 0x9d, 0x66, 0x92, 0x82, 0x0e, 0xcb, 0x76, 0x86, 0xf3, 0xef,
+	0x01, 0x00, 0x29, 0xa2, 0xb2, 0xbb, 0xd3, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +142,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeDenomPriceTable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +204,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.FeeDenomPriceTable.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -284,6 +311,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomPriceTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDenomPriceTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
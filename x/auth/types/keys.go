@@ -14,6 +14,10 @@ const (
 
 	// FeeCollectorName the root string for the fee collector account address
 	FeeCollectorName = "fee_collector"
+
+	// FeeDenomReserveName the root string for the account address of the reserve
+	// swapping the fees paid in alternative denominations into the native fee denom
+	FeeDenomReserveName = "fee_denom_reserve"
 )
 
 var (
@@ -29,6 +33,9 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = []byte("accountNumber")
+
+	// FeeDenomPriceTableKey is the prefix for the fee denom price table key
+	FeeDenomPriceTableKey = collections.NewPrefix(3)
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
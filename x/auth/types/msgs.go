@@ -7,7 +7,9 @@ import (
 
 var (
 	_ sdk.Msg            = &MsgUpdateParams{}
+	_ sdk.Msg            = &MsgUpdateFeeDenomPriceTable{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgUpdateFeeDenomPriceTable{}
 )
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -20,3 +22,14 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateFeeDenomPriceTable) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateFeeDenomPriceTable message.
+func (msg MsgUpdateFeeDenomPriceTable) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -962,6 +962,93 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryFeeDenomPriceTableRequest is the Query/FeeDenomPriceTable request type.
+//
+// Since: cosmos-sdk 0.48
+type QueryFeeDenomPriceTableRequest struct {
+}
+
+func (m *QueryFeeDenomPriceTableRequest) Reset()         { *m = QueryFeeDenomPriceTableRequest{} }
+func (m *QueryFeeDenomPriceTableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenomPriceTableRequest) ProtoMessage()    {}
+func (*QueryFeeDenomPriceTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryFeeDenomPriceTableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenomPriceTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenomPriceTableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenomPriceTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenomPriceTableRequest.Merge(m, src)
+}
+func (m *QueryFeeDenomPriceTableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenomPriceTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenomPriceTableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenomPriceTableRequest proto.InternalMessageInfo
+
+// QueryFeeDenomPriceTableResponse is the Query/FeeDenomPriceTable response type.
+//
+// Since: cosmos-sdk 0.48
+type QueryFeeDenomPriceTableResponse struct {
+	// price_table is the current fee denom price table.
+	PriceTable FeeDenomPriceTable `protobuf:"bytes,1,opt,name=price_table,json=priceTable,proto3" json:"price_table"`
+}
+
+func (m *QueryFeeDenomPriceTableResponse) Reset()         { *m = QueryFeeDenomPriceTableResponse{} }
+func (m *QueryFeeDenomPriceTableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenomPriceTableResponse) ProtoMessage()    {}
+func (*QueryFeeDenomPriceTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryFeeDenomPriceTableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenomPriceTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenomPriceTableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenomPriceTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenomPriceTableResponse.Merge(m, src)
+}
+func (m *QueryFeeDenomPriceTableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenomPriceTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenomPriceTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenomPriceTableResponse proto.InternalMessageInfo
+
+func (m *QueryFeeDenomPriceTableResponse) GetPriceTable() FeeDenomPriceTable {
+	if m != nil {
+		return m.PriceTable
+	}
+	return FeeDenomPriceTable{}
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -983,79 +1070,86 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryFeeDenomPriceTableRequest)(nil), "cosmos.auth.v1beta1.QueryFeeDenomPriceTableRequest")
+	proto.RegisterType((*QueryFeeDenomPriceTableResponse)(nil), "cosmos.auth.v1beta1.QueryFeeDenomPriceTableResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1065 bytes of a gzipped FileDescriptorProto
+	// 1147 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x34, 0x2b, 0xf1, 0xe3, 0x54, 0xef, 0x02, 0xea, 0x61, 0x51, 0x70,
-	0x57, 0x08, 0x71, 0x20, 0x72, 0x62, 0x27, 0xb5, 0xd8, 0x78, 0xb2, 0xb1, 0x03, 0x1b, 0xaa, 0x5c,
-	0x90, 0x90, 0x7a, 0x41, 0x42, 0x82, 0x3f, 0x60, 0x0f, 0x88, 0xf3, 0x22, 0x95, 0x1b, 0x7f, 0xc0,
-	0x6a, 0x4f, 0x2b, 0xb8, 0x70, 0x42, 0xa8, 0x45, 0x82, 0x1b, 0xff, 0x02, 0xca, 0xcc, 0xb3, 0x63,
-	0xb7, 0x93, 0xc4, 0x85, 0x53, 0x9d, 0x99, 0xf7, 0xbe, 0xef, 0x33, 0x6f, 0x9e, 0xfd, 0x2d, 0x14,
-	0x9a, 0xd4, 0xeb, 0x50, 0x4f, 0x33, 0xfb, 0xfe, 0x91, 0xf6, 0xd9, 0x6e, 0xc3, 0xf6, 0xcd, 0x5d,
-	0xed, 0x61, 0xdf, 0xee, 0x0d, 0xd4, 0x6e, 0x8f, 0xfa, 0x94, 0xac, 0xf2, 0x00, 0x75, 0x14, 0xa0,
-	0x62, 0x80, 0xbc, 0x83, 0x59, 0x0d, 0xd3, 0xb3, 0x79, 0x74, 0x98, 0xdb, 0x35, 0xdb, 0x8e, 0x6b,
-	0xfa, 0x0e, 0x75, 0xb9, 0x80, 0x9c, 0x69, 0xd3, 0x36, 0x65, 0x8f, 0xda, 0xe8, 0x09, 0x57, 0x5f,
-	0x6e, 0x53, 0xda, 0x7e, 0x60, 0x6b, 0xec, 0x57, 0xa3, 0xdf, 0xd2, 0x4c, 0x17, 0x2b, 0xca, 0x6b,
-	0xb8, 0x65, 0x76, 0x1d, 0xcd, 0x74, 0x5d, 0xea, 0x33, 0x35, 0x0f, 0x77, 0xf3, 0x22, 0x60, 0x06,
-	0x87, 0xc2, 0x7c, 0xbf, 0xce, 0x2b, 0x22, 0x3c, 0xdf, 0xca, 0x61, 0x6a, 0x00, 0x1c, 0x3d, 0xa7,
-	0xf2, 0x09, 0x64, 0x3e, 0x18, 0xfd, 0xdc, 0x6f, 0x36, 0x69, 0xdf, 0xf5, 0x3d, 0xc3, 0x7e, 0xd8,
-	0xb7, 0x3d, 0x9f, 0xbc, 0x0b, 0x30, 0x3e, 0x52, 0x56, 0x2a, 0x4a, 0xa5, 0xe5, 0xca, 0xa6, 0x8a,
-	0xba, 0xa3, 0xf3, 0xab, 0x5c, 0x05, 0x51, 0xd4, 0x9a, 0xd9, 0xb6, 0x31, 0xd7, 0x88, 0x64, 0x2a,
-	0xa7, 0x12, 0xdc, 0xbc, 0x50, 0xc0, 0xeb, 0x52, 0xd7, 0xb3, 0x89, 0x01, 0x4b, 0x26, 0xae, 0x65,
-	0xa5, 0xe2, 0xb5, 0xd2, 0x72, 0x25, 0xa3, 0xf2, 0x16, 0xa8, 0x41, 0x77, 0xd4, 0x7d, 0x77, 0xa0,
-	0x17, 0x9f, 0x9d, 0x96, 0xd7, 0x04, 0xb7, 0xa1, 0xa2, 0xe2, 0x81, 0x11, 0xea, 0x90, 0xf7, 0x62,
-	0xd4, 0x73, 0x8c, 0x7a, 0x6b, 0x26, 0x35, 0x07, 0x8a, 0x61, 0x1f, 0xc2, 0x6a, 0x94, 0x3a, 0xe8,
-	0x4a, 0x05, 0x16, 0x4d, 0xcb, 0xea, 0xd9, 0x9e, 0xc7, 0x5a, 0x72, 0x5d, 0xcf, 0xfe, 0x72, 0x5a,
-	0xce, 0xa0, 0xfe, 0x3e, 0xdf, 0x39, 0xf4, 0x7b, 0x8e, 0xdb, 0x36, 0x82, 0xc0, 0xb7, 0x96, 0x4e,
-	0x1e, 0x17, 0x52, 0x7f, 0x3f, 0x2e, 0xa4, 0x94, 0xa3, 0x78, 0xaf, 0xc3, 0x4e, 0xd4, 0x60, 0x11,
-	0x4f, 0x80, 0x8d, 0xfe, 0xaf, 0x8d, 0x08, 0x64, 0x94, 0x0c, 0x10, 0x56, 0xa9, 0x66, 0xf6, 0xcc,
-	0x4e, 0x70, 0xa7, 0x4a, 0x0d, 0x0f, 0x15, 0xac, 0x62, 0xf9, 0x37, 0x61, 0xa1, 0xcb, 0x56, 0xb0,
-	0x7a, 0x4e, 0x15, 0x15, 0xe1, 0x49, 0xfa, 0xfc, 0xd3, 0xdf, 0x0b, 0x29, 0x03, 0x13, 0x94, 0x35,
-	0x90, 0x99, 0xe2, 0x3d, 0x6a, 0xf5, 0x1f, 0xd8, 0x17, 0x66, 0x48, 0xf9, 0x1c, 0x72, 0xc2, 0x5d,
-	0xac, 0xfb, 0x51, 0xc2, 0x01, 0xd8, 0x7c, 0x76, 0x5a, 0x56, 0x44, 0x48, 0x31, 0xdd, 0xc8, 0x18,
-	0x28, 0xb7, 0xa1, 0x70, 0xb9, 0xb0, 0x3e, 0x78, 0xdf, 0xec, 0x04, 0x33, 0x4a, 0x08, 0xcc, 0xbb,
-	0x66, 0xc7, 0xe6, 0xd7, 0x68, 0xb0, 0x67, 0xe5, 0x0b, 0x28, 0x4e, 0x4e, 0x43, 0xe8, 0x0f, 0x93,
-	0xdd, 0x55, 0x52, 0xe6, 0xf0, 0xc6, 0x6e, 0xc2, 0xaa, 0x6e, 0x37, 0x8f, 0xf6, 0x2a, 0xb5, 0x9e,
-	0xdd, 0x72, 0x1e, 0x05, 0x2d, 0x7c, 0x1b, 0x32, 0xf1, 0x65, 0xc4, 0xd8, 0x80, 0x1b, 0x0d, 0xb6,
-	0x5e, 0xef, 0xb2, 0x0d, 0x3c, 0x47, 0xba, 0x11, 0x09, 0x56, 0x74, 0xc8, 0xe1, 0x4c, 0xea, 0x03,
-	0xdf, 0xf6, 0xee, 0x53, 0x1c, 0x4d, 0x6c, 0xc1, 0x06, 0xdc, 0xc0, 0x19, 0xad, 0x37, 0x46, 0xfb,
-	0x4c, 0x23, 0x6d, 0xa4, 0xcd, 0x48, 0x8e, 0xf2, 0x0e, 0xac, 0x89, 0x35, 0x10, 0xe4, 0x55, 0x58,
-	0x09, 0x44, 0x3c, 0xb6, 0x83, 0x24, 0x81, 0x34, 0x0f, 0x57, 0xee, 0x86, 0x28, 0x7c, 0xe1, 0x3e,
-	0x65, 0x72, 0x01, 0x4a, 0x42, 0x95, 0x3b, 0x21, 0xcc, 0x05, 0x95, 0x71, 0x57, 0x66, 0x9f, 0xe8,
-	0x10, 0xf2, 0xd1, 0xb7, 0x30, 0x3c, 0xdd, 0xc1, 0xdd, 0xf1, 0x6c, 0xcc, 0x39, 0x16, 0xcb, 0xbd,
-	0xa6, 0xcf, 0x65, 0x25, 0x63, 0xce, 0xb1, 0xc8, 0x3a, 0x00, 0x5e, 0x55, 0xdd, 0xb1, 0xd8, 0x97,
-	0x65, 0xde, 0xb8, 0x8e, 0x2b, 0x07, 0x96, 0x62, 0xe1, 0xc4, 0x89, 0x44, 0x11, 0x6e, 0x1f, 0x5e,
-	0x0c, 0x14, 0x92, 0x7e, 0x43, 0x56, 0xcc, 0x98, 0x9c, 0x72, 0x0f, 0x5e, 0x8a, 0x56, 0x39, 0x70,
-	0x5b, 0xf4, 0x7f, 0x7c, 0x99, 0x94, 0x1a, 0x64, 0x2f, 0xcb, 0x21, 0x6d, 0x15, 0xe6, 0x1d, 0xb7,
-	0x45, 0x71, 0xc8, 0x8b, 0xc2, 0x4f, 0x82, 0x6e, 0x7a, 0xc1, 0x24, 0x1b, 0x2c, 0xba, 0xf2, 0x4f,
-	0x1a, 0x5e, 0x60, 0x92, 0xe4, 0x6b, 0x09, 0x96, 0x82, 0x37, 0x9e, 0x6c, 0x0b, 0xd3, 0x45, 0xbe,
-	0x23, 0xef, 0x24, 0x09, 0xe5, 0x8c, 0xca, 0xce, 0xc9, 0x5f, 0x4f, 0x76, 0xa4, 0x2f, 0x7f, 0xfd,
-	0xf3, 0xdb, 0xb9, 0x02, 0x59, 0xd7, 0x84, 0x0e, 0x19, 0x20, 0x7c, 0x27, 0xc1, 0x22, 0x0a, 0x90,
-	0xd2, 0xcc, 0x1a, 0x01, 0xcd, 0x76, 0x82, 0x48, 0x84, 0xa9, 0x8e, 0x61, 0xb6, 0xc9, 0xd6, 0x54,
-	0x18, 0xed, 0x18, 0x6f, 0x60, 0x48, 0x7e, 0x92, 0x80, 0x5c, 0x9e, 0x19, 0xb2, 0x37, 0xb3, 0xee,
-	0xe5, 0xb1, 0x95, 0xab, 0x57, 0x4b, 0xba, 0x02, 0x77, 0xf8, 0x4e, 0xd5, 0x1d, 0x4b, 0x3b, 0x76,
-	0xac, 0x21, 0xf9, 0x4a, 0x82, 0x05, 0xee, 0x08, 0x64, 0x6b, 0x72, 0xd9, 0x98, 0xfd, 0xc8, 0xa5,
-	0xd9, 0x81, 0xc8, 0x54, 0x1a, 0x33, 0xad, 0x93, 0x9c, 0x90, 0x89, 0x1b, 0x10, 0xf9, 0x41, 0x82,
-	0x95, 0xb8, 0xbd, 0x10, 0x6d, 0x72, 0x19, 0xa1, 0x4d, 0xc9, 0xaf, 0x27, 0x4f, 0x40, 0xbe, 0xdd,
-	0x31, 0xdf, 0x26, 0x79, 0x45, 0xc8, 0xd7, 0x61, 0x99, 0xf5, 0x70, 0xfe, 0x7e, 0x96, 0x60, 0x55,
-	0xe0, 0x2b, 0xa4, 0x9a, 0xb0, 0x78, 0xcc, 0xbd, 0xe4, 0xdb, 0x57, 0xcc, 0x42, 0xee, 0x37, 0xc6,
-	0xdc, 0x65, 0xf2, 0x5a, 0x12, 0x6e, 0xed, 0x78, 0xe4, 0x8c, 0x43, 0x72, 0x22, 0x41, 0x3a, 0x6a,
-	0x44, 0x13, 0xde, 0x21, 0x81, 0x85, 0x4d, 0x78, 0x87, 0x44, 0xae, 0xa6, 0x6c, 0x4c, 0xbd, 0x72,
-	0xee, 0x6d, 0xe4, 0x89, 0x04, 0x19, 0x91, 0x25, 0x11, 0xf1, 0x3d, 0x4e, 0x71, 0x40, 0x79, 0xf7,
-	0x0a, 0x19, 0x88, 0xb8, 0x37, 0xb5, 0x7b, 0x1c, 0x31, 0x7c, 0xbf, 0xb9, 0x0b, 0x0d, 0xc9, 0x8f,
-	0x63, 0xe4, 0x98, 0x71, 0x4d, 0x47, 0x16, 0x39, 0xe5, 0x74, 0x64, 0xa1, 0x2b, 0x2a, 0x55, 0x86,
-	0xac, 0x92, 0x5b, 0x89, 0x90, 0xb9, 0xff, 0x0e, 0xc9, 0xf7, 0x12, 0x2c, 0x47, 0x8c, 0x81, 0xdc,
-	0x9a, 0xf9, 0x75, 0x89, 0xd8, 0x91, 0x5c, 0x4e, 0x18, 0x9d, 0x7c, 0x30, 0x43, 0xf7, 0x75, 0x5b,
-	0x74, 0xfc, 0x01, 0xd5, 0xef, 0x3c, 0x3d, 0xcb, 0x4b, 0xcf, 0xcf, 0xf2, 0xd2, 0x1f, 0x67, 0x79,
-	0xe9, 0x9b, 0xf3, 0x7c, 0xea, 0xf9, 0x79, 0x3e, 0xf5, 0xdb, 0x79, 0x3e, 0xf5, 0xf1, 0x76, 0xdb,
-	0xf1, 0x8f, 0xfa, 0x0d, 0xb5, 0x49, 0x3b, 0x81, 0x20, 0xff, 0x53, 0xf6, 0xac, 0x4f, 0xb5, 0x47,
-	0x5c, 0xdd, 0x1f, 0x74, 0x6d, 0xaf, 0xb1, 0xc0, 0xfe, 0x77, 0xdb, 0xfb, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x1f, 0xa2, 0x5f, 0xef, 0x16, 0x0e, 0x00, 0x00,
+	0x14, 0xc7, 0x33, 0xdd, 0xb2, 0xed, 0xbe, 0x76, 0x8b, 0x34, 0xcd, 0x8a, 0xe0, 0xb6, 0x49, 0xe4,
+	0x42, 0x7f, 0xd1, 0xd8, 0xf4, 0xc7, 0x8a, 0x5f, 0xa7, 0x66, 0xcb, 0xa2, 0x1e, 0x76, 0x15, 0xdc,
+	0x0a, 0x21, 0x0e, 0x44, 0x4e, 0x3c, 0x49, 0x2d, 0x1a, 0x4f, 0x1a, 0x3b, 0xb0, 0xa5, 0xea, 0x05,
+	0x09, 0xa9, 0x17, 0x24, 0x24, 0xf8, 0x03, 0xf6, 0x80, 0x38, 0x70, 0x5a, 0xa4, 0x22, 0x71, 0xe0,
+	0x0f, 0x58, 0xed, 0x69, 0x05, 0x17, 0x4e, 0x08, 0xb5, 0x48, 0xf0, 0x67, 0xa0, 0xcc, 0x3c, 0x3b,
+	0x76, 0xe3, 0x24, 0x0e, 0x9c, 0x9a, 0xbe, 0x79, 0xef, 0xfb, 0x3e, 0xf3, 0xe6, 0xcd, 0x3c, 0x43,
+	0xae, 0xca, 0xdd, 0x06, 0x77, 0x75, 0xb3, 0xed, 0x1d, 0xea, 0x9f, 0x6e, 0x54, 0x98, 0x67, 0x6e,
+	0xe8, 0xc7, 0x6d, 0xd6, 0x3a, 0xd1, 0x9a, 0x2d, 0xee, 0x71, 0x3a, 0x2b, 0x1d, 0xb4, 0x8e, 0x83,
+	0x86, 0x0e, 0xca, 0x1a, 0x46, 0x55, 0x4c, 0x97, 0x49, 0xef, 0x20, 0xb6, 0x69, 0xd6, 0x6d, 0xc7,
+	0xf4, 0x6c, 0xee, 0x48, 0x01, 0x25, 0x5d, 0xe7, 0x75, 0x2e, 0x7e, 0xea, 0x9d, 0x5f, 0x68, 0x7d,
+	0xb9, 0xce, 0x79, 0xfd, 0x88, 0xe9, 0xe2, 0xbf, 0x4a, 0xbb, 0xa6, 0x9b, 0x0e, 0x66, 0x54, 0xe6,
+	0x71, 0xc9, 0x6c, 0xda, 0xba, 0xe9, 0x38, 0xdc, 0x13, 0x6a, 0x2e, 0xae, 0x66, 0xe3, 0x80, 0x05,
+	0x1c, 0x0a, 0xcb, 0xf5, 0xb2, 0xcc, 0x88, 0xf0, 0x72, 0x69, 0x0e, 0x43, 0x7d, 0xe0, 0xf0, 0x3e,
+	0xd5, 0x8f, 0x21, 0xfd, 0x7e, 0xe7, 0xdf, 0x9d, 0x6a, 0x95, 0xb7, 0x1d, 0xcf, 0x35, 0xd8, 0x71,
+	0x9b, 0xb9, 0x1e, 0xbd, 0x0f, 0xd0, 0xdd, 0x52, 0x86, 0xe4, 0xc9, 0xca, 0xd4, 0xe6, 0x92, 0x86,
+	0xba, 0x9d, 0xfd, 0x6b, 0x52, 0x05, 0x51, 0xb4, 0x92, 0x59, 0x67, 0x18, 0x6b, 0x84, 0x22, 0xd5,
+	0x0b, 0x02, 0x77, 0xae, 0x25, 0x70, 0x9b, 0xdc, 0x71, 0x19, 0x35, 0x60, 0xd2, 0x44, 0x5b, 0x86,
+	0xe4, 0x6f, 0xac, 0x4c, 0x6d, 0xa6, 0x35, 0x59, 0x02, 0xcd, 0xaf, 0x8e, 0xb6, 0xe3, 0x9c, 0x14,
+	0xf3, 0xcf, 0x2e, 0x0a, 0xf3, 0x31, 0xa7, 0xa1, 0xa1, 0xe2, 0x9e, 0x11, 0xe8, 0xd0, 0xf7, 0x22,
+	0xd4, 0x63, 0x82, 0x7a, 0x79, 0x28, 0xb5, 0x04, 0x8a, 0x60, 0xef, 0xc3, 0x6c, 0x98, 0xda, 0xaf,
+	0xca, 0x26, 0x4c, 0x98, 0x96, 0xd5, 0x62, 0xae, 0x2b, 0x4a, 0x72, 0xab, 0x98, 0xf9, 0xf5, 0xa2,
+	0x90, 0x46, 0xfd, 0x1d, 0xb9, 0xb2, 0xef, 0xb5, 0x6c, 0xa7, 0x6e, 0xf8, 0x8e, 0x6f, 0x4f, 0x9e,
+	0x3f, 0xce, 0xa5, 0xfe, 0x79, 0x9c, 0x4b, 0xa9, 0x87, 0xd1, 0x5a, 0x07, 0x95, 0x28, 0xc1, 0x04,
+	0xee, 0x00, 0x0b, 0xfd, 0x5f, 0x0b, 0xe1, 0xcb, 0xa8, 0x69, 0xa0, 0x22, 0x53, 0xc9, 0x6c, 0x99,
+	0x0d, 0xff, 0x4c, 0xd5, 0x12, 0xcc, 0x46, 0xac, 0x98, 0xfe, 0x2d, 0xb8, 0xd9, 0x14, 0x16, 0xcc,
+	0x3e, 0xa7, 0xc5, 0x25, 0x91, 0x41, 0xc5, 0xf1, 0xa7, 0x7f, 0xe4, 0x52, 0x06, 0x06, 0xa8, 0xf3,
+	0xa0, 0x08, 0xc5, 0x07, 0xdc, 0x6a, 0x1f, 0xb1, 0x6b, 0x3d, 0xa4, 0x7e, 0x06, 0x73, 0xb1, 0xab,
+	0x98, 0xf7, 0xc3, 0x84, 0x0d, 0xb0, 0xf4, 0xec, 0xa2, 0xa0, 0xc6, 0x21, 0x45, 0x74, 0x43, 0x6d,
+	0xa0, 0xde, 0x85, 0x5c, 0x6f, 0xe2, 0xe2, 0xc9, 0x43, 0xb3, 0xe1, 0xf7, 0x28, 0xa5, 0x30, 0xee,
+	0x98, 0x0d, 0x26, 0x8f, 0xd1, 0x10, 0xbf, 0xd5, 0xcf, 0x21, 0xdf, 0x3f, 0x0c, 0xa1, 0x3f, 0x48,
+	0x76, 0x56, 0x49, 0x99, 0x83, 0x13, 0xbb, 0x03, 0xb3, 0x45, 0x56, 0x3d, 0xdc, 0xda, 0x2c, 0xb5,
+	0x58, 0xcd, 0x7e, 0xe4, 0x97, 0xf0, 0x1d, 0x48, 0x47, 0xcd, 0x88, 0xb1, 0x08, 0xb7, 0x2b, 0xc2,
+	0x5e, 0x6e, 0x8a, 0x05, 0xdc, 0xc7, 0x74, 0x25, 0xe4, 0xac, 0x16, 0x61, 0x0e, 0x7b, 0xb2, 0x78,
+	0xe2, 0x31, 0xf7, 0x80, 0x63, 0x6b, 0x62, 0x09, 0x16, 0xe1, 0x36, 0xf6, 0x68, 0xb9, 0xd2, 0x59,
+	0x17, 0x1a, 0xd3, 0xc6, 0xb4, 0x19, 0x8a, 0x51, 0xdf, 0x85, 0xf9, 0x78, 0x0d, 0x04, 0x79, 0x15,
+	0x66, 0x7c, 0x11, 0x57, 0xac, 0x20, 0x89, 0x2f, 0x2d, 0xdd, 0xd5, 0xdd, 0x00, 0x45, 0x1a, 0x0e,
+	0xb8, 0x90, 0xf3, 0x51, 0x12, 0xaa, 0xdc, 0x0b, 0x60, 0xae, 0xa9, 0x74, 0xab, 0x32, 0x7c, 0x47,
+	0xfb, 0x90, 0x0d, 0xdf, 0xc2, 0x60, 0x77, 0x7b, 0xbb, 0xdd, 0xde, 0x18, 0xb3, 0x2d, 0x11, 0x7b,
+	0xa3, 0x38, 0x96, 0x21, 0xc6, 0x98, 0x6d, 0xd1, 0x05, 0x00, 0x3c, 0xaa, 0xb2, 0x6d, 0x89, 0x97,
+	0x65, 0xdc, 0xb8, 0x85, 0x96, 0x3d, 0x4b, 0xb5, 0x20, 0xd7, 0x57, 0x14, 0xe1, 0x76, 0xe0, 0x45,
+	0x5f, 0x21, 0xe9, 0x1b, 0x32, 0x63, 0x46, 0xe4, 0xd4, 0x07, 0xf0, 0x52, 0x38, 0xcb, 0x9e, 0x53,
+	0xe3, 0xff, 0xe3, 0x65, 0x52, 0x4b, 0x90, 0xe9, 0x95, 0x43, 0xda, 0x6d, 0x18, 0xb7, 0x9d, 0x1a,
+	0xc7, 0x26, 0xcf, 0xc7, 0x3e, 0x09, 0x45, 0xd3, 0xf5, 0x3b, 0xd9, 0x10, 0xde, 0x6a, 0x1e, 0x6b,
+	0x7b, 0x9f, 0xb1, 0x5d, 0xe6, 0xf0, 0x46, 0xa9, 0x65, 0x57, 0xd9, 0x81, 0x59, 0x39, 0xf2, 0xef,
+	0x9d, 0x7a, 0x0c, 0xb9, 0xbe, 0x1e, 0x98, 0xfa, 0x21, 0x4c, 0x35, 0x3b, 0xd6, 0xb2, 0xd7, 0x31,
+	0x67, 0x48, 0xf4, 0x15, 0x8f, 0x10, 0xf4, 0xaa, 0xe0, 0x03, 0x05, 0xcd, 0xc0, 0xb2, 0xf9, 0xc3,
+	0x0c, 0xbc, 0x20, 0x72, 0xd2, 0xaf, 0x08, 0x4c, 0xfa, 0xcf, 0x10, 0x5d, 0x8d, 0x55, 0x8c, 0x1b,
+	0x86, 0xca, 0x5a, 0x12, 0x57, 0x49, 0xaf, 0xae, 0x9d, 0xff, 0xfd, 0x64, 0x8d, 0x7c, 0xf1, 0xdb,
+	0x5f, 0xdf, 0x8c, 0xe5, 0xe8, 0x82, 0x1e, 0x3b, 0xb6, 0x7d, 0x84, 0x6f, 0x09, 0x4c, 0xa0, 0x00,
+	0x5d, 0x19, 0x9a, 0xc3, 0xa7, 0x59, 0x4d, 0xe0, 0x89, 0x30, 0xdb, 0x5d, 0x98, 0x55, 0xba, 0x3c,
+	0x10, 0x46, 0x3f, 0xc5, 0xb6, 0x38, 0xa3, 0x3f, 0x11, 0xa0, 0xbd, 0x8d, 0x4c, 0xb7, 0x86, 0xe6,
+	0xed, 0xbd, 0x4b, 0xca, 0xf6, 0x68, 0x41, 0x23, 0x70, 0x07, 0x17, 0xbd, 0x6c, 0x5b, 0xfa, 0xa9,
+	0x6d, 0x9d, 0xd1, 0x2f, 0x09, 0xdc, 0x94, 0x63, 0x8a, 0x2e, 0xf7, 0x4f, 0x1b, 0x99, 0x89, 0xca,
+	0xca, 0x70, 0x47, 0x64, 0x5a, 0xe9, 0x32, 0x2d, 0xd0, 0xb9, 0x58, 0x26, 0x39, 0x15, 0xe9, 0xf7,
+	0x04, 0x66, 0xa2, 0x33, 0x8f, 0xea, 0xfd, 0xd3, 0xc4, 0xce, 0x4e, 0xe5, 0xf5, 0xe4, 0x01, 0xc8,
+	0xb7, 0xd1, 0xe5, 0x5b, 0xa2, 0xaf, 0xc4, 0xf2, 0x35, 0x44, 0x64, 0x39, 0xe8, 0xbf, 0x5f, 0x08,
+	0xcc, 0xc6, 0x0c, 0x3b, 0xba, 0x9d, 0x30, 0x79, 0x64, 0xa4, 0x2a, 0x77, 0x47, 0x8c, 0x42, 0xee,
+	0x37, 0xbb, 0xdc, 0x05, 0xfa, 0x5a, 0x12, 0x6e, 0xfd, 0xb4, 0x33, 0xae, 0xcf, 0xe8, 0x39, 0x81,
+	0xe9, 0xf0, 0x74, 0xec, 0x73, 0x87, 0x62, 0xe6, 0xaa, 0xb2, 0x9a, 0xc0, 0x13, 0xf9, 0x16, 0x07,
+	0x1e, 0xb9, 0x1c, 0xb8, 0xf4, 0x09, 0x81, 0x74, 0xdc, 0x9c, 0xa4, 0xf1, 0xe7, 0x38, 0x60, 0x2c,
+	0x2b, 0x1b, 0x23, 0x44, 0x20, 0xe2, 0xd6, 0xc0, 0xea, 0x49, 0x44, 0xfd, 0x34, 0x32, 0x1a, 0xcf,
+	0xe8, 0x8f, 0x5d, 0xe4, 0xc8, 0x34, 0x1d, 0x8c, 0x1c, 0x37, 0xbe, 0x95, 0x8d, 0x11, 0x22, 0xfc,
+	0x1b, 0x2e, 0x90, 0x35, 0xba, 0x9e, 0x08, 0x59, 0x7e, 0x14, 0x9c, 0xd1, 0xef, 0x08, 0x4c, 0x85,
+	0xa6, 0x15, 0x5d, 0x1f, 0xfa, 0xba, 0x84, 0x66, 0xa4, 0x52, 0x48, 0xe8, 0x9d, 0xbc, 0x31, 0x83,
+	0x4f, 0x02, 0xa7, 0xc6, 0x43, 0x0f, 0xe8, 0xcf, 0x04, 0x68, 0xef, 0x68, 0x1a, 0xf4, 0x80, 0xf6,
+	0x1d, 0x98, 0xca, 0xf6, 0x68, 0x41, 0xc8, 0xfe, 0x46, 0x97, 0x7d, 0x9d, 0xae, 0xc5, 0xb2, 0xd7,
+	0x18, 0x2b, 0x5b, 0x9d, 0xf0, 0x72, 0x68, 0xda, 0x16, 0xef, 0x3d, 0xbd, 0xcc, 0x92, 0xe7, 0x97,
+	0x59, 0xf2, 0xe7, 0x65, 0x96, 0x7c, 0x7d, 0x95, 0x4d, 0x3d, 0xbf, 0xca, 0xa6, 0x7e, 0xbf, 0xca,
+	0xa6, 0x3e, 0x5a, 0xad, 0xdb, 0xde, 0x61, 0xbb, 0xa2, 0x55, 0x79, 0xc3, 0xd7, 0x93, 0x7f, 0x0a,
+	0xae, 0xf5, 0x89, 0xfe, 0x48, 0x8a, 0x7b, 0x27, 0x4d, 0xe6, 0x56, 0x6e, 0x8a, 0x6f, 0xe1, 0xad,
+	0x7f, 0x07, 0x00, 0x49, 0x2b, 0x87, 0x91, 0x66, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1107,6 +1201,11 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// FeeDenomPriceTable queries the alternative fee denominations accepted by the
+	// chain and their price in the native fee denomination.
+	//
+	// Since: cosmos-sdk 0.48
+	FeeDenomPriceTable(ctx context.Context, in *QueryFeeDenomPriceTableRequest, opts ...grpc.CallOption) (*QueryFeeDenomPriceTableResponse, error)
 }
 
 type queryClient struct {
@@ -1207,6 +1306,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) FeeDenomPriceTable(ctx context.Context, in *QueryFeeDenomPriceTableRequest, opts ...grpc.CallOption) (*QueryFeeDenomPriceTableResponse, error) {
+	out := new(QueryFeeDenomPriceTableResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/FeeDenomPriceTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1246,6 +1354,11 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// FeeDenomPriceTable queries the alternative fee denominations accepted by the
+	// chain and their price in the native fee denomination.
+	//
+	// Since: cosmos-sdk 0.48
+	FeeDenomPriceTable(context.Context, *QueryFeeDenomPriceTableRequest) (*QueryFeeDenomPriceTableResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1282,6 +1395,9 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) FeeDenomPriceTable(ctx context.Context, req *QueryFeeDenomPriceTableRequest) (*QueryFeeDenomPriceTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDenomPriceTable not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1467,6 +1583,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeDenomPriceTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDenomPriceTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDenomPriceTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/FeeDenomPriceTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDenomPriceTable(ctx, req.(*QueryFeeDenomPriceTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1511,6 +1645,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "FeeDenomPriceTable",
+			Handler:    _Query_FeeDenomPriceTable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2147,6 +2285,62 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeDenomPriceTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDenomPriceTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenomPriceTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDenomPriceTableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDenomPriceTableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenomPriceTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PriceTable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2414,6 +2608,26 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeDenomPriceTableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeDenomPriceTableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PriceTable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4031,6 +4245,139 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeDenomPriceTableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDenomPriceTableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDenomPriceTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDenomPriceTableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDenomPriceTableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDenomPriceTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
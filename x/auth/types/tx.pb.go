@@ -130,37 +130,144 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateFeeDenomPriceTable is the Msg/UpdateFeeDenomPriceTable request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateFeeDenomPriceTable struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// price_table defines the new fee denom price table.
+	//
+	// NOTE: The whole table must be supplied, it replaces the existing one.
+	PriceTable FeeDenomPriceTable `protobuf:"bytes,2,opt,name=price_table,json=priceTable,proto3" json:"price_table"`
+}
+
+func (m *MsgUpdateFeeDenomPriceTable) Reset()         { *m = MsgUpdateFeeDenomPriceTable{} }
+func (m *MsgUpdateFeeDenomPriceTable) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeDenomPriceTable) ProtoMessage()    {}
+func (*MsgUpdateFeeDenomPriceTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{2}
+}
+func (m *MsgUpdateFeeDenomPriceTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeDenomPriceTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeDenomPriceTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeDenomPriceTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeDenomPriceTable.Merge(m, src)
+}
+func (m *MsgUpdateFeeDenomPriceTable) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeDenomPriceTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeDenomPriceTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeDenomPriceTable proto.InternalMessageInfo
+
+func (m *MsgUpdateFeeDenomPriceTable) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateFeeDenomPriceTable) GetPriceTable() FeeDenomPriceTable {
+	if m != nil {
+		return m.PriceTable
+	}
+	return FeeDenomPriceTable{}
+}
+
+// MsgUpdateFeeDenomPriceTableResponse defines the response structure for
+// executing a MsgUpdateFeeDenomPriceTable message.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateFeeDenomPriceTableResponse struct {
+}
+
+func (m *MsgUpdateFeeDenomPriceTableResponse) Reset()         { *m = MsgUpdateFeeDenomPriceTableResponse{} }
+func (m *MsgUpdateFeeDenomPriceTableResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeDenomPriceTableResponse) ProtoMessage()    {}
+func (*MsgUpdateFeeDenomPriceTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{3}
+}
+func (m *MsgUpdateFeeDenomPriceTableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeDenomPriceTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeDenomPriceTableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeDenomPriceTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeDenomPriceTableResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeeDenomPriceTableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeDenomPriceTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeDenomPriceTableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeDenomPriceTableResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.auth.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateFeeDenomPriceTable)(nil), "cosmos.auth.v1beta1.MsgUpdateFeeDenomPriceTable")
+	proto.RegisterType((*MsgUpdateFeeDenomPriceTableResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateFeeDenomPriceTableResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/tx.proto", fileDescriptor_c2d62bd9c4c212e5) }
 
 var fileDescriptor_c2d62bd9c4c212e5 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79, 0x7d, 0x10, 0x0b, 0xa2, 0x54, 0x4a,
-	0x12, 0xa2, 0x34, 0x1e, 0x22, 0x01, 0xd5, 0x07, 0x91, 0x12, 0x87, 0xda, 0x91, 0x5b, 0x9c, 0xae,
-	0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x2a,
-	0x24, 0x87, 0xcd, 0x3d, 0x60, 0xeb, 0xc1, 0xf2, 0x4a, 0xfb, 0x19, 0xb9, 0xf8, 0x7d, 0x8b, 0xd3,
-	0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x85, 0xcc, 0xb8, 0x38,
-	0x41, 0x2a, 0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e,
-	0x6d, 0xd1, 0x15, 0x81, 0x3a, 0xc2, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28,
-	0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x8e, 0x8b, 0xad, 0x00, 0x6c, 0x82, 0x04, 0x93, 0x02,
-	0xa3, 0x06, 0xb7, 0x91, 0xb4, 0x1e, 0x16, 0xef, 0xea, 0x41, 0x2c, 0x71, 0xe2, 0x3c, 0x71, 0x4f,
-	0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x56, 0x26, 0x4d, 0xcf, 0x37, 0x68,
-	0x21, 0xcc, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x11, 0x62, 0x82, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05,
-	0xc4, 0x13, 0x68, 0xae, 0x55, 0x92, 0xe4, 0x12, 0x47, 0x13, 0x0a, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf,
-	0x2b, 0x4e, 0x35, 0x2a, 0xe0, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x4a, 0xe2, 0xe2, 0x41, 0xf1, 0x9f,
-	0x0a, 0x56, 0x77, 0xa1, 0x19, 0x22, 0xa5, 0x43, 0x8c, 0x2a, 0x98, 0x55, 0x52, 0xac, 0x0d, 0x20,
-	0xaf, 0x38, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x34, 0x36, 0xf5, 0x31, 0xfd, 0x56, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x1a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba,
-	0x2c, 0xd6, 0xf9, 0x4c, 0x02, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xbf, 0xcf, 0xd2, 0x40,
+	0x18, 0xc7, 0x7b, 0x1a, 0x49, 0x38, 0x4c, 0x8c, 0x95, 0x84, 0x52, 0x4c, 0xc5, 0xfa, 0x0b, 0x89,
+	0xf4, 0x04, 0x8d, 0x51, 0x06, 0x13, 0xd1, 0xb8, 0x91, 0x10, 0xd0, 0xc5, 0x85, 0xb4, 0xf4, 0x52,
+	0x1a, 0x6d, 0xaf, 0xe9, 0x1d, 0x04, 0x36, 0xe3, 0xe0, 0xe0, 0xe4, 0x9f, 0xe1, 0xc8, 0xe0, 0xee,
+	0xca, 0x48, 0x9c, 0x9c, 0x8c, 0x81, 0x18, 0xfe, 0x06, 0x37, 0xd3, 0xde, 0xf1, 0x43, 0x5a, 0xc9,
+	0xfb, 0xbe, 0x4b, 0xdb, 0x3c, 0x9f, 0xe7, 0xf9, 0x3e, 0xcf, 0xf7, 0xe9, 0x1d, 0xbc, 0x3a, 0x20,
+	0xd4, 0x23, 0x14, 0x99, 0x23, 0x36, 0x44, 0xe3, 0xba, 0x85, 0x99, 0x59, 0x47, 0x6c, 0x62, 0x04,
+	0x21, 0x61, 0x44, 0xbe, 0xc2, 0xa9, 0x11, 0x51, 0x43, 0x50, 0x35, 0xef, 0x10, 0x87, 0xc4, 0x1c,
+	0x45, 0x5f, 0x3c, 0x55, 0x2d, 0xf2, 0xd4, 0x3e, 0x07, 0xa2, 0x8e, 0xa3, 0x82, 0xe8, 0xe1, 0x51,
+	0x07, 0x8d, 0xeb, 0xd1, 0x4b, 0x80, 0xcb, 0xa6, 0xe7, 0xfa, 0x04, 0xc5, 0x4f, 0x11, 0xd2, 0xd2,
+	0xe6, 0x89, 0xdb, 0xc7, 0x5c, 0xff, 0x06, 0xe0, 0xa5, 0x36, 0x75, 0x5e, 0x07, 0xb6, 0xc9, 0x70,
+	0xc7, 0x0c, 0x4d, 0x8f, 0xca, 0x8f, 0x60, 0x36, 0xca, 0x20, 0xa1, 0xcb, 0xa6, 0x0a, 0x28, 0x83,
+	0x4a, 0xb6, 0xa5, 0x7c, 0xff, 0x5a, 0xcb, 0x8b, 0x21, 0x9e, 0xd9, 0x76, 0x88, 0x29, 0xed, 0xb1,
+	0xd0, 0xf5, 0x9d, 0xee, 0x2e, 0x55, 0x7e, 0x0a, 0x33, 0x41, 0xac, 0xa0, 0x9c, 0x2b, 0x83, 0x4a,
+	0xae, 0x51, 0x32, 0x52, 0xec, 0x1a, 0xbc, 0x49, 0x2b, 0x3b, 0xff, 0x79, 0x4d, 0xfa, 0xb2, 0x9e,
+	0x55, 0x41, 0x57, 0x54, 0x35, 0x1f, 0x7e, 0x58, 0xcf, 0xaa, 0x3b, 0xbd, 0x4f, 0xeb, 0x59, 0xf5,
+	0x3a, 0x57, 0xa8, 0x51, 0xfb, 0x2d, 0x9a, 0x70, 0x13, 0x07, 0xd3, 0xea, 0x45, 0x58, 0x38, 0x08,
+	0x75, 0x31, 0x0d, 0x88, 0x4f, 0xb1, 0xfe, 0x1b, 0xc0, 0xd2, 0x96, 0xbd, 0xc4, 0xf8, 0x05, 0xf6,
+	0x89, 0xd7, 0x09, 0xdd, 0x01, 0x7e, 0x65, 0x5a, 0xef, 0xf0, 0x99, 0x8d, 0xf6, 0x60, 0x2e, 0x88,
+	0x54, 0xfa, 0x2c, 0x92, 0x11, 0x6e, 0xef, 0xa4, 0xba, 0x4d, 0x76, 0xdd, 0x77, 0x0e, 0x83, 0x6d,
+	0xb8, 0xf9, 0x24, 0xe9, 0xfe, 0xf6, 0x9e, 0xfb, 0x23, 0x3e, 0xf4, 0x5b, 0xf0, 0xc6, 0x11, 0xbc,
+	0x59, 0x47, 0xe3, 0x0f, 0x80, 0xe7, 0xdb, 0xd4, 0x91, 0x2d, 0x78, 0xf1, 0x9f, 0xff, 0x7d, 0x33,
+	0x75, 0xf2, 0x83, 0xa5, 0xaa, 0xf7, 0x4e, 0x92, 0xb5, 0xe9, 0x25, 0x7f, 0x04, 0x50, 0xf9, 0xef,
+	0xde, 0xef, 0x1f, 0x97, 0x4a, 0x56, 0xa8, 0x8f, 0x4f, 0x5b, 0xb1, 0x19, 0x44, 0xbd, 0xf0, 0x3e,
+	0xda, 0x74, 0xeb, 0xf9, 0x7c, 0xa9, 0x81, 0xc5, 0x52, 0x03, 0xbf, 0x96, 0x1a, 0xf8, 0xbc, 0xd2,
+	0xa4, 0xc5, 0x4a, 0x93, 0x7e, 0xac, 0x34, 0xe9, 0xcd, 0x5d, 0xc7, 0x65, 0xc3, 0x91, 0x65, 0x0c,
+	0x88, 0x27, 0xae, 0x19, 0x4a, 0x1e, 0x3a, 0x36, 0x0d, 0x30, 0xb5, 0x32, 0xf1, 0x9d, 0x79, 0xf0,
+	0x77, 0x00, 0xca, 0x66, 0xe5, 0x72, 0xe5, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -180,6 +287,12 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeDenomPriceTable defines a (governance) operation for updating the
+	// table of alternative fee denominations. The authority defaults to the x/gov
+	// module account.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateFeeDenomPriceTable(ctx context.Context, in *MsgUpdateFeeDenomPriceTable, opts ...grpc.CallOption) (*MsgUpdateFeeDenomPriceTableResponse, error)
 }
 
 type msgClient struct {
@@ -199,6 +312,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateFeeDenomPriceTable(ctx context.Context, in *MsgUpdateFeeDenomPriceTable, opts ...grpc.CallOption) (*MsgUpdateFeeDenomPriceTableResponse, error) {
+	out := new(MsgUpdateFeeDenomPriceTableResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/UpdateFeeDenomPriceTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
@@ -206,6 +328,12 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeDenomPriceTable defines a (governance) operation for updating the
+	// table of alternative fee denominations. The authority defaults to the x/gov
+	// module account.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateFeeDenomPriceTable(context.Context, *MsgUpdateFeeDenomPriceTable) (*MsgUpdateFeeDenomPriceTableResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -215,6 +343,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateFeeDenomPriceTable(ctx context.Context, req *MsgUpdateFeeDenomPriceTable) (*MsgUpdateFeeDenomPriceTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeDenomPriceTable not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -238,6 +369,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateFeeDenomPriceTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeDenomPriceTable)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeDenomPriceTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/UpdateFeeDenomPriceTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeDenomPriceTable(ctx, req.(*MsgUpdateFeeDenomPriceTable))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -246,6 +395,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateFeeDenomPriceTable",
+			Handler:    _Msg_UpdateFeeDenomPriceTable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -314,6 +467,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateFeeDenomPriceTable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeDenomPriceTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeDenomPriceTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PriceTable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeDenomPriceTableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeDenomPriceTableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeDenomPriceTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -349,6 +565,30 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateFeeDenomPriceTable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PriceTable.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateFeeDenomPriceTableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -520,6 +760,171 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateFeeDenomPriceTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeDenomPriceTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeDenomPriceTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeDenomPriceTableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeDenomPriceTableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeDenomPriceTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,7 +19,10 @@ import (
 
 // Transaction command flags
 const (
-	FlagDelayed = "delayed"
+	FlagDelayed   = "delayed"
+	FlagCliffTime = "cliff-time"
+	FlagDest      = "dest"
+	FlagMerge     = "merge"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -36,6 +39,8 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewMsgCreateVestingAccountCmd(ac),
 		NewMsgCreatePermanentLockedAccountCmd(ac),
 		NewMsgCreatePeriodicVestingAccountCmd(ac),
+		NewMsgCreateClawbackVestingAccountCmd(ac),
+		NewMsgClawbackCmd(ac),
 	)
 
 	return txCmd
@@ -174,41 +179,134 @@ func NewMsgCreatePeriodicVestingAccountCmd(ac address.Codec) *cobra.Command {
 				return err
 			}
 
-			contents, err := os.ReadFile(args[1])
+			startTime, periods, err := readVestingPeriods(args[1])
 			if err != nil {
 				return err
 			}
 
-			var vestingData VestingData
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			msg.Merge, _ = cmd.Flags().GetBool(FlagMerge)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagMerge, false, "Merge the vesting schedule into the periodic vesting account if it already exists")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new vesting account funded with an allocation of tokens, whose unvested tokens can be clawed back.",
+		Long: `Create a new vesting account funded with an allocation of tokens vesting periodically.
+The periods json file has the same format as for create-periodic-vesting-account. No tokens vest
+before the cliff time, given as a UNIX epoch timestamp with the '--cliff-time' flag, which defaults
+to the start time. The sender is the funder of the account and can claw back its unvested tokens.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-			err = json.Unmarshal(contents, &vestingData)
+			toAddr, err := ac.StringToBytes(args[0])
 			if err != nil {
 				return err
 			}
 
-			var periods []types.Period
+			startTime, periods, err := readVestingPeriods(args[1])
+			if err != nil {
+				return err
+			}
 
-			for i, p := range vestingData.Periods {
+			cliffTime, _ := cmd.Flags().GetInt64(FlagCliffTime)
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
-				if err != nil {
-					return err
-				}
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, cliffTime, periods)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
-				}
+	cmd.Flags().Int64(FlagCliffTime, 0, "The time before which no tokens vest, as a UNIX epoch timestamp")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Claw back the unvested tokens of a clawback vesting account funded by the sender.
+The tokens are clawed back from the balance of the account first, then from its unbonding
+and bonded delegations, which are transferred without being unbonded. They are sent to the
+sender, or to the address given with the '--dest' flag.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
+			addr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			var destAddr []byte
+			if dest, _ := cmd.Flags().GetString(FlagDest); dest != "" {
+				if destAddr, err = ac.StringToBytes(dest); err != nil {
+					return err
+				}
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, destAddr)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagDest, "", "The address receiving the clawed back tokens, the sender if not set")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// readVestingPeriods reads the start time and the periods of a vesting
+// schedule from a json file.
+func readVestingPeriods(path string) (int64, []types.Period, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var vestingData VestingData
+
+	err = json.Unmarshal(contents, &vestingData)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var periods []types.Period
+
+	for i, p := range vestingData.Periods {
+
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if p.Length < 0 {
+			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+
+	return vestingData.StartTime, periods, nil
+}
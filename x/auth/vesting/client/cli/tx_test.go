@@ -245,6 +245,15 @@ func (s *CLITestSuite) TestNewMsgCreatePeriodicVestingAccountCmd() {
 			extraArgs,
 			false,
 		},
+		{
+			"valid transaction with merge",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[0].Address,
+			append(extraArgs, fmt.Sprintf("--%s=true", cli.FlagMerge)),
+			false,
+		},
 		{
 			"invalid to Address",
 			func() client.Context {
@@ -275,3 +284,150 @@ func (s *CLITestSuite) TestNewMsgCreatePeriodicVestingAccountCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestNewMsgCreateClawbackVestingAccountCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	cmd := cli.NewMsgCreateClawbackVestingAccountCmd(address.NewBech32Codec("cosmos"))
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+	}
+
+	testCases := []struct {
+		name      string
+		ctxGen    func() client.Context
+		to        sdk.AccAddress
+		extraArgs []string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[0].Address,
+			extraArgs,
+			false,
+		},
+		{
+			"valid transaction with a cliff",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[0].Address,
+			append(extraArgs, fmt.Sprintf("--%s=1625204920", cli.FlagCliffTime)),
+			false,
+		},
+		{
+			"invalid to Address",
+			func() client.Context {
+				return s.baseCtx
+			},
+			sdk.AccAddress{},
+			extraArgs,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.to.String(), "./test.json"}, tc.extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(tc.ctxGen(), cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestNewMsgClawbackCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	cmd := cli.NewMsgClawbackCmd(address.NewBech32Codec("cosmos"))
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+	}
+
+	testCases := []struct {
+		name      string
+		ctxGen    func() client.Context
+		addr      sdk.AccAddress
+		extraArgs []string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[1].Address,
+			extraArgs,
+			false,
+		},
+		{
+			"valid transaction with a destination",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[1].Address,
+			append(extraArgs, fmt.Sprintf("--%s=%s", cli.FlagDest, accounts[0].Address)),
+			false,
+		},
+		{
+			"invalid destination",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[1].Address,
+			append(extraArgs, fmt.Sprintf("--%s=invalid", cli.FlagDest)),
+			true,
+		},
+		{
+			"invalid address",
+			func() client.Context {
+				return s.baseCtx
+			},
+			sdk.AccAddress{},
+			extraArgs,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.addr.String()}, tc.extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(tc.ctxGen(), cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package vesting
 
 import (
+	"bytes"
 	"context"
+	stdmath "math"
 
 	"github.com/armon/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,12 +21,15 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper. The
+// StakingKeeper may be nil, in which case the delegations of clawback vesting
+// accounts are not clawed back.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -174,17 +180,119 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	var vestingAccount *types.PeriodicVestingAccount
 	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+		if !msg.Merge {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+		}
+
+		// the vesting schedule is merged into an existing periodic vesting account
+		var ok bool
+		if vestingAccount, ok = acc.(*types.PeriodicVestingAccount); !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists and is not a periodic vesting account", msg.ToAddress)
+		}
+
+		// only the account itself or its funder may merge grants, so that
+		// nobody else can fill its schedule up to MaxMergedVestingPeriods
+		if !bytes.Equal(from, to) {
+			if accFunder, err := s.AccountKeeper.StringToBytes(vestingAccount.FunderAddress); err != nil || !bytes.Equal(accFunder, from) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FromAddress, msg.ToAddress)
+			}
+		}
+	}
+
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	merged := vestingAccount != nil
+	if merged {
+		if err := vestingAccount.AddGrant(msg.StartTime, msg.VestingPeriods); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot merge the vesting schedule into account %s: %s", msg.ToAddress, err)
+		}
+	} else {
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount = types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+		vestingAccount.FunderAddress = msg.FromAddress
+	}
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		if !merged {
+			telemetry.IncrCounter(1, "new", "account")
+		}
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = s.BankKeeper.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
 	}
 
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	from, err := s.AccountKeeper.StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+
+	to, err := s.AccountKeeper.StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	cliffTime := msg.CliffTime
+	if cliffTime == 0 {
+		cliffTime = msg.StartTime
+	} else if cliffTime < msg.StartTime {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid cliff time of %d, must not be before the start time", cliffTime)
+	}
+
+	var totalCoins sdk.Coins
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	if err := validateAmount(totalCoins); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := s.BankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
 		return nil, err
 	}
 
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
 	baseAccount := authtypes.NewBaseAccountWithAddress(to)
 	baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
-	vestingAccount := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	vestingAccount := types.NewClawbackVestingAccount(baseAccount, from, totalCoins.Sort(), msg.StartTime, cliffTime, msg.VestingPeriods)
 
 	s.AccountKeeper.SetAccount(ctx, vestingAccount)
 
@@ -194,7 +302,7 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		for _, a := range totalCoins {
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
-					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
 					float32(a.Amount.Int64()),
 					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
 				)
@@ -206,7 +314,139 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
-	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	funder, err := s.AccountKeeper.StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'funder' address: %s", err)
+	}
+
+	addr, err := s.AccountKeeper.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = s.AccountKeeper.StringToBytes(msg.DestAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'dest' address: %s", err)
+		}
+	}
+
+	if bytes.Equal(dest, addr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot claw back the coins of %s to itself", msg.Address)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if s.BankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", sdk.AccAddress(dest))
+	}
+
+	vestingAccount, ok := s.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if accFunder, err := s.AccountKeeper.StringToBytes(vestingAccount.FunderAddress); err != nil || !bytes.Equal(accFunder, funder) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FunderAddress, msg.Address)
+	}
+
+	unvested := vestingAccount.Clawback(ctx.BlockTime())
+	if unvested.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s has no unvested coins", msg.Address)
+	}
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	// the unvested coins are clawed back from the balance of the account first,
+	// which is entirely spendable once the schedule is truncated
+	clawedBack := unvested.Min(s.BankKeeper.SpendableCoins(ctx, addr))
+	if !clawedBack.IsZero() {
+		if err := s.BankKeeper.SendCoins(ctx, addr, dest, clawedBack); err != nil {
+			return nil, err
+		}
+	}
+
+	// the remaining unvested coins were delegated, they cannot be clawed back
+	// without a staking keeper
+	if s.StakingKeeper == nil {
+		if remaining := unvested.Sub(clawedBack...); !remaining.IsZero() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot claw back the unvested coins %s of %s without a staking keeper", remaining, msg.Address)
+		}
+	} else {
+		bondDenom := s.StakingKeeper.BondDenom(ctx)
+		remaining := unvested.AmountOf(bondDenom).Sub(clawedBack.AmountOf(bondDenom))
+		if remaining.IsPositive() {
+			transferred, err := s.clawbackDelegations(ctx, addr, dest, remaining)
+			if err != nil {
+				return nil, err
+			}
+
+			if transferred.IsPositive() {
+				coins := sdk.NewCoins(sdk.NewCoin(bondDenom, transferred))
+
+				// the account is reloaded as the transfer of the delegations may
+				// have modified it, and no longer tracks the transferred tokens
+				vestingAccount = s.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+				vestingAccount.TrackUndelegation(coins)
+				s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+				clawedBack = clawedBack.Add(coins...)
+			}
+		}
+	}
+
+	defer func() {
+		for _, a := range clawedBack {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "clawback"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return &types.MsgClawbackResponse{Coins: clawedBack}, nil
+}
+
+// clawbackDelegations transfers up to the given amount of tokens unbonding or
+// delegated from an account to dest, starting with the unbonding tokens. It
+// returns the amount of tokens transferred.
+func (s msgServer) clawbackDelegations(ctx sdk.Context, addr, dest sdk.AccAddress, amount math.Int) (math.Int, error) {
+	transferred := math.ZeroInt()
+
+	for _, ubd := range s.StakingKeeper.GetUnbondingDelegations(ctx, addr, stdmath.MaxUint16) {
+		if transferred.GTE(amount) {
+			return transferred, nil
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		transferred = transferred.Add(s.StakingKeeper.TransferUnbonding(ctx, addr, dest, valAddr, amount.Sub(transferred)))
+	}
+
+	for _, delegation := range s.StakingKeeper.GetDelegatorDelegations(ctx, addr, stdmath.MaxUint16) {
+		if transferred.GTE(amount) {
+			return transferred, nil
+		}
+
+		amt, err := s.StakingKeeper.TransferDelegation(ctx, addr, dest, delegation.GetValidatorAddr(), amount.Sub(transferred))
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		transferred = transferred.Add(amt)
+	}
+
+	return transferred, nil
 }
 
 func validateAmount(amount sdk.Coins) error {
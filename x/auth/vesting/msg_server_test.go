@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtestutil "github.com/cosmos/cosmos-sdk/x/auth/vesting/testutil"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -37,6 +39,7 @@ type VestingTestSuite struct {
 	ctx           sdk.Context
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    *vestingtestutil.MockBankKeeper
+	stakingKeeper *vestingtestutil.MockStakingKeeper
 	msgServer     vestingtypes.MsgServer
 }
 
@@ -51,6 +54,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	ctrl := gomock.NewController(s.T())
 	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = vestingtestutil.NewMockStakingKeeper(ctrl)
 	s.accountKeeper = authkeeper.NewAccountKeeper(
 		encCfg.Codec,
 		storeService,
@@ -62,7 +66,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
-	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper)
+	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, s.stakingKeeper)
 }
 
 func (s *VestingTestSuite) TestCreateVestingAccount() {
@@ -379,6 +383,367 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 	}
 }
 
+func (s *VestingTestSuite) TestMergePeriodicVestingAccount() {
+	now := time.Now().Unix()
+
+	// the schedule cannot be merged into an account which is not a periodic vesting account
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr))
+	msg := vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, now, []vestingtypes.Period{{Length: 10, Amount: sdk.NewCoins(periodCoin)}})
+	msg.Merge = true
+	_, err := s.msgServer.CreatePeriodicVestingAccount(s.ctx, msg)
+	s.Require().ErrorContains(err, "is not a periodic vesting account")
+
+	s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).Times(4)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.NewCoins(periodCoin)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.NewCoins(fooCoin)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to2Addr, to2Addr, sdk.NewCoins(fooCoin)).Return(nil)
+
+	// the account is created if it does not exist
+	msg = vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to2Addr, now, []vestingtypes.Period{{Length: 10, Amount: sdk.NewCoins(periodCoin)}})
+	msg.Merge = true
+	_, err = s.msgServer.CreatePeriodicVestingAccount(s.ctx, msg)
+	s.Require().NoError(err)
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+
+	// a second grant is merged into the schedule of the account
+	msg = vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to2Addr, now+5, []vestingtypes.Period{{Length: 10, Amount: sdk.NewCoins(fooCoin)}})
+	msg.Merge = true
+	_, err = s.msgServer.CreatePeriodicVestingAccount(s.ctx, msg)
+	s.Require().NoError(err)
+
+	acc, ok = s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(now, acc.StartTime)
+	s.Require().Equal(now+15, acc.EndTime)
+	s.Require().Equal(sdk.NewCoins(periodCoin.Add(fooCoin)), acc.OriginalVesting)
+	s.Require().Equal(vestingtypes.Periods{
+		{Length: 10, Amount: sdk.NewCoins(periodCoin)},
+		{Length: 5, Amount: sdk.NewCoins(fooCoin)},
+	}, acc.GetVestingPeriods())
+
+	// a grant is not merged if the schedule would have too many periods
+	periods := make([]vestingtypes.Period, vestingtypes.MaxMergedVestingPeriods)
+	for i := range periods {
+		periods[i] = vestingtypes.Period{Length: 1, Amount: sdk.NewCoins(fooCoin)}
+	}
+	msg = vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to2Addr, now+15, periods)
+	msg.Merge = true
+	_, err = s.msgServer.CreatePeriodicVestingAccount(s.ctx, msg)
+	s.Require().ErrorContains(err, "more than the maximum")
+	s.Require().Equal(acc, s.accountKeeper.GetAccount(s.ctx, to2Addr))
+
+	// nobody but the funder and the account itself can merge grants, so the
+	// schedule cannot be filled up with dust periods by anyone else
+	msg = vestingtypes.NewMsgCreatePeriodicVestingAccount(to3Addr, to2Addr, now+15, []vestingtypes.Period{{Length: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("foo", 1))}})
+	msg.Merge = true
+	_, err = s.msgServer.CreatePeriodicVestingAccount(s.ctx, msg)
+	s.Require().ErrorContains(err, "is not the funder")
+	s.Require().Equal(acc, s.accountKeeper.GetAccount(s.ctx, to2Addr))
+
+	// the account can merge a grant of its own coins
+	msg = vestingtypes.NewMsgCreatePeriodicVestingAccount(to2Addr, to2Addr, now+15, []vestingtypes.Period{{Length: 10, Amount: sdk.NewCoins(fooCoin)}})
+	msg.Merge = true
+	_, err = s.msgServer.CreatePeriodicVestingAccount(s.ctx, msg)
+	s.Require().NoError(err)
+	acc, ok = s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(now+25, acc.EndTime)
+	s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+}
+
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	now := time.Now().Unix()
+	periods := []vestingtypes.Period{
+		{
+			Length: 10,
+			Amount: sdk.NewCoins(periodCoin),
+		},
+		{
+			Length: 20,
+			Amount: sdk.NewCoins(fooCoin),
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreateClawbackVestingAccount
+		expErr    bool
+		expErrMsg string
+		expCliff  int64
+	}{
+		{
+			name:      "empty from address",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount([]byte{}, to1Addr, now, 0, periods),
+			expErr:    true,
+			expErrMsg: "invalid 'from' address",
+		},
+		{
+			name:      "empty to address",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, []byte{}, now, 0, periods),
+			expErr:    true,
+			expErrMsg: "invalid 'to' address",
+		},
+		{
+			name:      "invalid start time",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, 0, 0, periods),
+			expErr:    true,
+			expErrMsg: "invalid start time",
+		},
+		{
+			name:      "cliff before start",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, now, now-1, periods),
+			expErr:    true,
+			expErrMsg: "invalid cliff time",
+		},
+		{
+			name: "invalid period",
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, now, 0, []vestingtypes.Period{
+				{
+					Length: 0,
+					Amount: sdk.NewCoins(periodCoin),
+				},
+			}),
+			expErr:    true,
+			expErrMsg: "invalid period",
+		},
+		{
+			name:      "no coins",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, now, 0, []vestingtypes.Period{{Length: 10}}),
+			expErr:    true,
+			expErrMsg: "invalid coins",
+		},
+		{
+			name: "create for existing account",
+			preRun: func() {
+				toAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr)
+				s.accountKeeper.SetAccount(s.ctx, toAcc)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, now, 0, periods),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+		{
+			name: "create a valid clawback vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, sdk.NewCoins(periodCoin.Add(fooCoin))).Return(nil)
+			},
+			input:    vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to2Addr, now, 0, periods),
+			expCliff: now,
+		},
+		{
+			name: "create a valid clawback vesting account with a cliff",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to3Addr, sdk.NewCoins(periodCoin.Add(fooCoin))).Return(nil)
+			},
+			input:    vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to3Addr, now, now+15, periods),
+			expCliff: now + 15,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreateClawbackVestingAccount(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			acc, ok := s.accountKeeper.GetAccount(s.ctx, sdk.MustAccAddressFromBech32(tc.input.ToAddress)).(*vestingtypes.ClawbackVestingAccount)
+			s.Require().True(ok)
+			s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+			s.Require().Equal(tc.expCliff, acc.CliffTime)
+			s.Require().Equal(now+30, acc.EndTime)
+			s.Require().Equal(sdk.NewCoins(periodCoin.Add(fooCoin)), acc.OriginalVesting)
+			s.Require().NoError(acc.Validate())
+		})
+	}
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	now := s.ctx.BlockTime().Unix()
+	quarter := sdk.NewInt64Coin("foo", 25)
+	periods := []vestingtypes.Period{
+		{Length: 50, Amount: sdk.NewCoins(quarter)},
+		{Length: 50, Amount: sdk.NewCoins(quarter)},
+		{Length: 50, Amount: sdk.NewCoins(quarter)},
+		{Length: 50, Amount: sdk.NewCoins(quarter)},
+	}
+
+	// newAccount sets a clawback vesting account of 100foo funded by fromAddr,
+	// of which two periods (50foo) are vested if the cliff passed.
+	newAccount := func(addr sdk.AccAddress, cliffTime int64, delegatedVesting sdk.Coins) {
+		baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr).(*authtypes.BaseAccount)
+		acc := vestingtypes.NewClawbackVestingAccount(baseAcc, fromAddr, sdk.NewCoins(fooCoin), now-100, cliffTime, periods)
+		acc.DelegatedVesting = delegatedVesting
+		s.accountKeeper.SetAccount(s.ctx, acc)
+	}
+
+	valAddr := sdk.ValAddress([]byte("val1_________________"))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgClawback
+		expErr    bool
+		expErrMsg string
+		expCoins  sdk.Coins
+		expVested sdk.Coins
+	}{
+		{
+			name:      "empty funder address",
+			input:     vestingtypes.NewMsgClawback([]byte{}, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "invalid 'funder' address",
+		},
+		{
+			name:      "destination is the account",
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, to1Addr),
+			expErr:    true,
+			expErrMsg: "to itself",
+		},
+		{
+			name: "blocked destination",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, to2Addr),
+			expErr:    true,
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name: "not a clawback vesting account",
+			preRun: func() {
+				s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr))
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "not a clawback vesting account",
+		},
+		{
+			name: "not the funder",
+			preRun: func() {
+				newAccount(to2Addr, now, nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgClawback(to3Addr, to2Addr, nil),
+			expErr:    true,
+			expErrMsg: "is not the funder",
+		},
+		{
+			name: "claw back the unvested coins from the balance",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+				s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), to2Addr).Return(sdk.NewCoins(fooCoin))
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to2Addr, to3Addr, sdk.NewCoins(sdk.NewInt64Coin("foo", 50))).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo")
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to2Addr, to3Addr),
+			expCoins:  sdk.NewCoins(sdk.NewInt64Coin("foo", 50)),
+			expVested: sdk.NewCoins(sdk.NewInt64Coin("foo", 50)),
+		},
+		{
+			name: "nothing left to claw back",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to2Addr, nil),
+			expErr:    true,
+			expErrMsg: "has no unvested coins",
+		},
+		{
+			name: "claw back everything before the cliff",
+			preRun: func() {
+				newAccount(to1Addr, now+1, nil)
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), to1Addr).Return(sdk.NewCoins(fooCoin))
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, fromAddr, sdk.NewCoins(fooCoin)).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo")
+			},
+			input:    vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil),
+			expCoins: sdk.NewCoins(fooCoin),
+		},
+		{
+			name: "claw back the unvested coins from the delegations",
+			preRun: func() {
+				newAccount(to3Addr, now, sdk.NewCoins(sdk.NewInt64Coin("foo", 40)))
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), to3Addr).Return(sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to3Addr, fromAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 10))).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo")
+				s.stakingKeeper.EXPECT().GetUnbondingDelegations(gomock.Any(), to3Addr, gomock.Any()).Return([]stakingtypes.UnbondingDelegation{
+					stakingtypes.NewUnbondingDelegation(to3Addr, valAddr, 1, s.ctx.BlockTime(), math.NewInt(15), 1),
+				})
+				s.stakingKeeper.EXPECT().TransferUnbonding(gomock.Any(), to3Addr, fromAddr, valAddr, math.NewInt(40)).Return(math.NewInt(15))
+				s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), to3Addr, gomock.Any()).Return([]stakingtypes.Delegation{
+					stakingtypes.NewDelegation(to3Addr, valAddr, math.LegacyNewDec(40)),
+				})
+				s.stakingKeeper.EXPECT().TransferDelegation(gomock.Any(), to3Addr, fromAddr, valAddr, math.NewInt(25)).Return(math.NewInt(25), nil)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to3Addr, nil),
+			expCoins:  sdk.NewCoins(sdk.NewInt64Coin("foo", 50)),
+			expVested: sdk.NewCoins(sdk.NewInt64Coin("foo", 50)),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			res, err := s.msgServer.Clawback(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expCoins, res.Coins)
+
+			// only the vested coins are left in the schedule, and none are locked
+			acc, ok := s.accountKeeper.GetAccount(s.ctx, sdk.MustAccAddressFromBech32(tc.input.Address)).(*vestingtypes.ClawbackVestingAccount)
+			s.Require().True(ok)
+			s.Require().Equal(tc.expVested, acc.OriginalVesting)
+			s.Require().True(acc.DelegatedVesting.IsZero())
+			s.Require().True(acc.DelegatedFree.IsZero())
+			s.Require().True(acc.LockedCoins(s.ctx.BlockTime()).IsZero())
+			s.Require().NoError(acc.Validate())
+		})
+	}
+}
+
+func (s *VestingTestSuite) TestClawbackWithoutStakingKeeper() {
+	now := s.ctx.BlockTime().Unix()
+	periods := []vestingtypes.Period{{Length: 100, Amount: sdk.NewCoins(fooCoin)}}
+	baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr).(*authtypes.BaseAccount)
+	acc := vestingtypes.NewClawbackVestingAccount(baseAcc, fromAddr, sdk.NewCoins(fooCoin), now, now, periods)
+	acc.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin("foo", 40))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// the delegated unvested coins cannot be clawed back
+	s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+	s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), to1Addr).Return(sdk.NewCoins(sdk.NewInt64Coin("foo", 60)))
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, fromAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 60))).Return(nil)
+
+	msgServer := vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, nil)
+	_, err := msgServer.Clawback(s.ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil))
+	s.Require().ErrorContains(err, "cannot claw back the unvested coins 40foo")
+}
+
 func TestVestingTestSuite(t *testing.T) {
 	suite.Run(t, new(VestingTestSuite))
 }
@@ -2,6 +2,8 @@ package types
 
 import (
 	"errors"
+	"fmt"
+	stdmath "math"
 	"time"
 
 	"cosmossdk.io/math"
@@ -11,6 +13,11 @@ import (
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 )
 
+// MaxMergedVestingPeriods is the maximum number of periods of a periodic
+// vesting account into which grants are merged. It bounds the growth of a
+// schedule topped up by repeated grants.
+const MaxMergedVestingPeriods = 100
+
 // Compile-time type assertions
 var (
 	_ sdk.AccountI                = (*BaseVestingAccount)(nil)
@@ -338,6 +345,74 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// AddGrant merges a grant of coins vesting according to the given periods from
+// the given start time into the vesting schedule of the account. The coins of
+// the existing schedule and of the grant vest at the same times as before. The
+// delegated vesting and delegated free coins are unchanged, so the coins of the
+// grant are locked in the balance of the account until they vest. An error is
+// returned, and the account left unchanged, if the merged schedule has more
+// than MaxMergedVestingPeriods periods.
+func (pva *PeriodicVestingAccount) AddGrant(grantStartTime int64, grantPeriods Periods) error {
+	startTime, endTime, periods := mergePeriods(pva.StartTime, grantStartTime, pva.VestingPeriods, grantPeriods)
+	if len(periods) > MaxMergedVestingPeriods {
+		return fmt.Errorf("merged vesting schedule has %d periods, more than the maximum of %d", len(periods), MaxMergedVestingPeriods)
+	}
+
+	for _, p := range grantPeriods {
+		pva.OriginalVesting = pva.OriginalVesting.Add(p.Amount...)
+	}
+	pva.StartTime, pva.EndTime, pva.VestingPeriods = startTime, endTime, periods
+
+	return nil
+}
+
+// mergePeriods merges two vesting schedules into a single schedule vesting the
+// coins of both at the same times. It returns the start time, end time and
+// periods of the merged schedule.
+func mergePeriods(startTime1, startTime2 int64, periods1, periods2 Periods) (int64, int64, Periods) {
+	startTime := startTime1
+	if startTime2 < startTime {
+		startTime = startTime2
+	}
+
+	// end1 and end2 track the end of the last merged period of each schedule
+	end1, end2 := startTime1, startTime2
+	endTime := startTime
+	var merged Periods
+	for i, j := 0, 0; i < len(periods1) || j < len(periods2); {
+		next1, next2 := int64(stdmath.MaxInt64), int64(stdmath.MaxInt64)
+		if i < len(periods1) {
+			next1 = end1 + periods1[i].Length
+		}
+		if j < len(periods2) {
+			next2 = end2 + periods2[j].Length
+		}
+
+		next := next1
+		if next2 < next {
+			next = next2
+		}
+
+		// the periods of both schedules ending at the same time are combined
+		var amount sdk.Coins
+		if next1 == next {
+			amount = amount.Add(periods1[i].Amount...)
+			end1 = next1
+			i++
+		}
+		if next2 == next {
+			amount = amount.Add(periods2[j].Amount...)
+			end2 = next2
+			j++
+		}
+
+		merged = append(merged, Period{Length: next - endTime, Amount: amount})
+		endTime = next
+	}
+
+	return startTime, endTime, merged
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -355,6 +430,11 @@ func (pva PeriodicVestingAccount) Validate() error {
 	if !originalVesting.Equal(pva.OriginalVesting) {
 		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
 	}
+	if pva.FunderAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(pva.FunderAddress); err != nil {
+			return fmt.Errorf("invalid funder address: %w", err)
+		}
+	}
 
 	return pva.BaseVestingAccount.Validate()
 }
@@ -486,3 +566,145 @@ func (plva PermanentLockedAccount) Validate() error {
 
 	return plva.BaseVestingAccount.Validate()
 }
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime, cliffTime int64, periods Periods) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         startTime + periods.TotalLength(),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		CliffTime:          cliffTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned. Coins vest as in a periodic vesting account, except that no
+// coins vest before the cliff.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() < cva.CliffTime || blockTime.Unix() <= cva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	// track the start time of the next period
+	currentPeriodStartTime := cva.StartTime
+
+	// for each period, if the period is over, add those coins as vested and check the next period.
+	for _, period := range cva.VestingPeriods {
+		x := blockTime.Unix() - currentPeriodStartTime
+		if x < period.Length {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount...)
+
+		// update the start time of the next period
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// Clawback truncates the vesting schedule to the periods vested at the given
+// time and returns the coins which had not vested yet. Since no coins vest
+// anymore, the delegated vesting coins become delegated free coins. It is the
+// callers responsibility to transfer the returned coins out of the account.
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time) sdk.Coins {
+	unvested := cva.GetVestingCoins(blockTime)
+
+	var periods Periods
+	if blockTime.Unix() >= cva.CliffTime {
+		endTime := cva.StartTime
+		for _, period := range cva.VestingPeriods {
+			if endTime+period.Length > blockTime.Unix() {
+				break
+			}
+
+			endTime += period.Length
+			periods = append(periods, period)
+		}
+	}
+
+	cva.VestingPeriods = periods
+	cva.OriginalVesting = periods.TotalAmount()
+	cva.EndTime = cva.StartTime + periods.TotalLength()
+	cva.DelegatedFree = cva.DelegatedFree.Add(cva.DelegatedVesting...)
+	cva.DelegatedVesting = sdk.NewCoins()
+
+	return unvested
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	if cva.CliffTime < cva.StartTime {
+		return errors.New("vesting cliff-time cannot be before start-time")
+	}
+	if _, err := sdk.AccAddressFromBech32(cva.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	endTime := cva.StartTime
+	originalVesting := sdk.NewCoins()
+	for _, p := range cva.VestingPeriods {
+		endTime += p.Length
+		originalVesting = originalVesting.Add(p.Amount...)
+	}
+	if endTime != cva.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !originalVesting.Equal(cva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
@@ -575,6 +575,86 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+	pva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+
+	// merge a grant starting later and ending after the schedule
+	require.NoError(t, pva.AddGrant(now.Add(6*time.Hour).Unix(), types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}))
+	require.NoError(t, pva.Validate())
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Add(30*time.Hour).Unix(), pva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 300)}, pva.OriginalVesting)
+	require.Equal(t, types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 125)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}, pva.GetVestingPeriods())
+
+	// the coins of both schedules vest at the same times as before
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 175)}, pva.GetVestedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 200)}, pva.GetVestedCoins(now.Add(24*time.Hour)))
+
+	// the delegations are unchanged
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+
+	// merge a grant starting earlier, whose period ends with the first period
+	require.NoError(t, pva.AddGrant(now.Add(-6*time.Hour).Unix(), types.Periods{
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 10)}},
+	}))
+	require.NoError(t, pva.Validate())
+	require.Equal(t, now.Add(-6*time.Hour).Unix(), pva.StartTime)
+	require.Equal(t, now.Add(30*time.Hour).Unix(), pva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1010), sdk.NewInt64Coin(stakeDenom, 300)}, pva.OriginalVesting)
+	require.Len(t, pva.VestingPeriods, 4)
+	require.Equal(t, types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 510), sdk.NewInt64Coin(stakeDenom, 50)}}, pva.VestingPeriods[0])
+	require.Nil(t, pva.GetVestedCoins(now.Add(6*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 510), sdk.NewInt64Coin(stakeDenom, 50)}, pva.GetVestedCoins(now.Add(12*time.Hour)))
+}
+
+func TestAddGrantMaxPeriods(t *testing.T) {
+	now := tmtime.Now()
+	bacc, origCoins := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), types.Periods{
+		types.Period{Length: 1, Amount: origCoins},
+	})
+
+	// grants are merged up to the maximum number of periods
+	for i := 1; i < types.MaxMergedVestingPeriods; i++ {
+		require.NoError(t, pva.AddGrant(now.Unix()+int64(i), types.Periods{
+			types.Period{Length: 1, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1)}},
+		}))
+	}
+	require.Len(t, pva.VestingPeriods, types.MaxMergedVestingPeriods)
+
+	// a grant whose periods end with existing periods is still merged
+	require.NoError(t, pva.AddGrant(now.Unix(), types.Periods{
+		types.Period{Length: 1, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1)}},
+	}))
+	require.Len(t, pva.VestingPeriods, types.MaxMergedVestingPeriods)
+
+	// a grant exceeding the maximum leaves the account unchanged
+	before := *pva
+	require.Error(t, pva.AddGrant(now.Unix(), types.Periods{
+		types.Period{Length: int64(types.MaxMergedVestingPeriods + 1), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1)}},
+	}))
+	require.Equal(t, before, *pva)
+	require.NoError(t, pva.Validate())
+}
+
 func TestGetVestedCoinsPermLockedVestingAcc(t *testing.T) {
 	now := tmtime.Now()
 	endTime := now.Add(1000 * 24 * time.Hour)
@@ -704,6 +784,85 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	funder := sdk.AccAddress([]byte("funder______________"))
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), now.Add(15*time.Hour).Unix(), periods)
+
+	// require no coins vested at the beginning of the vesting schedule
+	vestedCoins := cva.GetVestedCoins(now)
+	require.Nil(t, vestedCoins)
+
+	// require no coins vested after period 1 but before the cliff
+	vestedCoins = cva.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Nil(t, vestedCoins)
+
+	// require the coins of period 1 vested at the cliff
+	vestedCoins = cva.GetVestedCoins(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require 75% of coins vested after period 2
+	vestedCoins = cva.GetVestedCoins(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	vestedCoins = cva.GetVestedCoins(endTime)
+	require.Equal(t, origCoins, vestedCoins)
+
+	// require all the coins locked before the cliff
+	lockedCoins := cva.LockedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, origCoins, lockedCoins)
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+	funder := sdk.AccAddress([]byte("funder______________"))
+
+	// claw back after period 1, with vesting coins delegated
+	bacc, origCoins := initBaseAccount()
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), now.Unix(), periods)
+	cva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}, cva.DelegatedVesting)
+
+	unvested := cva.Clawback(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+	require.Equal(t, periods[:1], cva.GetVestingPeriods())
+	require.Equal(t, periods[0].Amount, cva.OriginalVesting)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), cva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}, cva.DelegatedFree)
+	require.True(t, cva.DelegatedVesting.IsZero())
+	require.True(t, cva.LockedCoins(now.Add(15*time.Hour)).IsZero())
+	require.NoError(t, cva.Validate())
+
+	// nothing is left to claw back
+	require.True(t, cva.Clawback(now.Add(16*time.Hour)).IsZero())
+
+	// claw back everything before the cliff
+	bacc, origCoins = initBaseAccount()
+	cva = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), now.Add(15*time.Hour).Unix(), periods)
+
+	unvested = cva.Clawback(now.Add(12 * time.Hour))
+	require.Equal(t, origCoins, unvested)
+	require.Empty(t, cva.VestingPeriods)
+	require.True(t, cva.OriginalVesting.IsZero())
+	require.Equal(t, now.Unix(), cva.EndTime)
+	require.True(t, cva.LockedCoins(now.Add(12*time.Hour)).IsZero())
+	require.NoError(t, cva.Validate())
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -759,6 +918,21 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, 50, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
+			false,
+		},
+		{
+			"invalid clawback vesting cliff time",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 10, 5, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
+			true,
+		},
+		{
+			"invalid clawback vesting funder",
+			types.NewClawbackVestingAccount(baseAcc, nil, initialVesting, 0, 0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
+			true,
+		},
 		{
 			"valid permanent locked vesting account",
 			types.NewPermanentLockedAccount(baseAcc, initialVesting),
@@ -853,6 +1027,25 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	funder := sdk.AccAddress([]byte("funder______________"))
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := s.accountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := s.accountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = s.accountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -360,3 +360,32 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgWithdrawFeeDenomReserve() {
+	reserveAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeDenomReserveName)
+	reserveCoins := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	suite.mockMintCoins(minterAcc)
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, minterAcc.Name, reserveCoins))
+	suite.mockSendCoinsFromModuleToModule(minterAcc, reserveAcc)
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToModule(suite.ctx, minterAcc.Name, reserveAcc.Name, reserveCoins))
+
+	// only the authority can withdraw from the reserve
+	_, err := suite.msgServer.WithdrawFeeDenomReserve(suite.ctx, banktypes.NewMsgWithdrawFeeDenomReserve(
+		baseAcc.Address, baseAcc.GetAddress(), sdk.NewCoins(newFooCoin(100)),
+	))
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	_, err = suite.msgServer.WithdrawFeeDenomReserve(suite.ctx, banktypes.NewMsgWithdrawFeeDenomReserve(
+		suite.bankKeeper.GetAuthority(), baseAcc.GetAddress(), sdk.Coins{},
+	))
+	suite.Require().ErrorContains(err, "invalid coins")
+
+	suite.mockSendCoinsFromModuleToAccount(reserveAcc, baseAcc.GetAddress())
+	_, err = suite.msgServer.WithdrawFeeDenomReserve(suite.ctx, banktypes.NewMsgWithdrawFeeDenomReserve(
+		suite.bankKeeper.GetAuthority(), baseAcc.GetAddress(), sdk.NewCoins(newFooCoin(100)),
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(50)), suite.bankKeeper.GetAllBalances(suite.ctx, reserveAcc.GetAddress()))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100)), suite.bankKeeper.GetAllBalances(suite.ctx, baseAcc.GetAddress()))
+}
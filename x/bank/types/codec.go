@@ -20,6 +20,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFeeDenomReserve{}, "cosmos-sdk/MsgWithdrawFeeDenomReserve")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
@@ -30,6 +31,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgMultiSend{},
 		&MsgUpdateParams{},
+		&MsgWithdrawFeeDenomReserve{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
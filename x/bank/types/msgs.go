@@ -9,10 +9,12 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgWithdrawFeeDenomReserve{}
 
 	_ legacytx.LegacyMsg = &MsgSend{}
 	_ legacytx.LegacyMsg = &MsgMultiSend{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgWithdrawFeeDenomReserve{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -85,3 +87,20 @@ func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgWithdrawFeeDenomReserve constructs a message to withdraw coins from the
+// fee denom reserve.
+func NewMsgWithdrawFeeDenomReserve(authority string, toAddr sdk.AccAddress, amount sdk.Coins) *MsgWithdrawFeeDenomReserve {
+	return &MsgWithdrawFeeDenomReserve{Authority: authority, ToAddress: toAddr.String(), Amount: amount}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgWithdrawFeeDenomReserve) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgWithdrawFeeDenomReserve.
+func (msg MsgWithdrawFeeDenomReserve) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
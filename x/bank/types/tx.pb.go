@@ -412,6 +412,112 @@ func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
 
+// MsgWithdrawFeeDenomReserve is the Msg/WithdrawFeeDenomReserve request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgWithdrawFeeDenomReserve struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// to_address is the recipient of the withdrawn coins.
+	ToAddress string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// amount defines the coins to withdraw from the fee denom reserve.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawFeeDenomReserve) Reset()         { *m = MsgWithdrawFeeDenomReserve{} }
+func (m *MsgWithdrawFeeDenomReserve) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeeDenomReserve) ProtoMessage()    {}
+func (*MsgWithdrawFeeDenomReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+}
+func (m *MsgWithdrawFeeDenomReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeeDenomReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeeDenomReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFeeDenomReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeeDenomReserve.Merge(m, src)
+}
+func (m *MsgWithdrawFeeDenomReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeeDenomReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeeDenomReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFeeDenomReserve proto.InternalMessageInfo
+
+func (m *MsgWithdrawFeeDenomReserve) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgWithdrawFeeDenomReserve) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgWithdrawFeeDenomReserve) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawFeeDenomReserveResponse defines the Msg/WithdrawFeeDenomReserve
+// response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgWithdrawFeeDenomReserveResponse struct {
+}
+
+func (m *MsgWithdrawFeeDenomReserveResponse) Reset()         { *m = MsgWithdrawFeeDenomReserveResponse{} }
+func (m *MsgWithdrawFeeDenomReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeeDenomReserveResponse) ProtoMessage()    {}
+func (*MsgWithdrawFeeDenomReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{9}
+}
+func (m *MsgWithdrawFeeDenomReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeeDenomReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeeDenomReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFeeDenomReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeeDenomReserveResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFeeDenomReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeeDenomReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeeDenomReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFeeDenomReserveResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
@@ -421,56 +527,63 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
 	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
+	proto.RegisterType((*MsgWithdrawFeeDenomReserve)(nil), "cosmos.bank.v1beta1.MsgWithdrawFeeDenomReserve")
+	proto.RegisterType((*MsgWithdrawFeeDenomReserveResponse)(nil), "cosmos.bank.v1beta1.MsgWithdrawFeeDenomReserveResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xd3, 0x50,
-	0x1c, 0x5f, 0x99, 0x8e, 0xec, 0x31, 0x25, 0x54, 0x22, 0xac, 0x90, 0x0e, 0x16, 0x43, 0x00, 0xa5,
-	0x15, 0x34, 0x9a, 0xcc, 0x68, 0x74, 0x28, 0x89, 0x26, 0x8b, 0x66, 0xc4, 0x83, 0x5e, 0x96, 0xd7,
-	0xf5, 0x51, 0x1a, 0xd6, 0xbe, 0xa6, 0xef, 0x95, 0xb0, 0x9b, 0x7a, 0x32, 0x9e, 0x3c, 0x7b, 0xe2,
-	0x68, 0x8c, 0x07, 0x0e, 0x1e, 0x4d, 0xbc, 0x72, 0x24, 0x9e, 0x3c, 0xa9, 0x81, 0x03, 0xfa, 0x5f,
-	0x98, 0xf7, 0xa3, 0xa5, 0x8c, 0x8d, 0x11, 0x2f, 0x6b, 0xf7, 0x3e, 0x3f, 0xbe, 0xef, 0xf3, 0xed,
-	0xf7, 0x3d, 0x30, 0xd9, 0xc4, 0xc4, 0xc3, 0xc4, 0xb4, 0xa0, 0xbf, 0x61, 0x6e, 0x2e, 0x5a, 0x88,
-	0xc2, 0x45, 0x93, 0x6e, 0x19, 0x41, 0x88, 0x29, 0x56, 0x2f, 0x09, 0xd4, 0x60, 0xa8, 0x21, 0x51,
-	0x6d, 0xd4, 0xc1, 0x0e, 0xe6, 0xb8, 0xc9, 0xde, 0x04, 0x55, 0xd3, 0x13, 0x23, 0x82, 0x12, 0xa3,
-	0x26, 0x76, 0xfd, 0x13, 0x78, 0xaa, 0x10, 0xf7, 0x15, 0x78, 0x51, 0xe0, 0x0d, 0x61, 0x2c, 0xeb,
-	0x0a, 0x68, 0x4c, 0x4a, 0x3d, 0xe2, 0x98, 0x9b, 0x8b, 0xec, 0x21, 0x81, 0x11, 0xe8, 0xb9, 0x3e,
-	0x36, 0xf9, 0xaf, 0x58, 0x2a, 0x7f, 0x1e, 0x00, 0x83, 0x35, 0xe2, 0xac, 0x22, 0xdf, 0x56, 0xef,
-	0x80, 0xc2, 0x5a, 0x88, 0xbd, 0x06, 0xb4, 0xed, 0x10, 0x11, 0x32, 0xae, 0x4c, 0x29, 0xb3, 0xf9,
-	0xea, 0xf8, 0xf7, 0x2f, 0x0b, 0xa3, 0xd2, 0xff, 0x81, 0x40, 0x56, 0x69, 0xe8, 0xfa, 0x4e, 0x7d,
-	0x88, 0xb1, 0xe5, 0x92, 0x7a, 0x1b, 0x00, 0x8a, 0x13, 0xe9, 0x40, 0x1f, 0x69, 0x9e, 0xe2, 0x58,
-	0xd8, 0x06, 0x39, 0xe8, 0xe1, 0xc8, 0xa7, 0xe3, 0xd9, 0xa9, 0xec, 0xec, 0xd0, 0x52, 0xd1, 0x48,
-	0x9a, 0x48, 0x50, 0xdc, 0x44, 0x63, 0x19, 0xbb, 0x7e, 0x75, 0x65, 0xf7, 0x67, 0x29, 0xf3, 0xe9,
-	0x57, 0x69, 0xd6, 0x71, 0xe9, 0x7a, 0x64, 0x19, 0x4d, 0xec, 0xc9, 0xe4, 0xf2, 0xb1, 0x40, 0xec,
-	0x0d, 0x93, 0xb6, 0x03, 0x44, 0xb8, 0x80, 0x7c, 0x38, 0xdc, 0x99, 0x2f, 0xb4, 0x90, 0x03, 0x9b,
-	0xed, 0x06, 0xeb, 0x2d, 0xf9, 0x78, 0xb8, 0x33, 0xaf, 0xd4, 0x65, 0xc1, 0xca, 0xf5, 0xb7, 0xdb,
-	0xa5, 0xcc, 0x9f, 0xed, 0x52, 0xe6, 0x0d, 0xe3, 0xa5, 0xb3, 0xbf, 0x3b, 0xdc, 0x99, 0x57, 0x53,
-	0x9e, 0xb2, 0x45, 0xe5, 0x11, 0x30, 0x2c, 0x5f, 0xeb, 0x88, 0x04, 0xd8, 0x27, 0xa8, 0xfc, 0x55,
-	0x01, 0x85, 0x1a, 0x71, 0x6a, 0x51, 0x8b, 0xba, 0xbc, 0x8d, 0x77, 0x41, 0xce, 0xf5, 0x83, 0x88,
-	0xb2, 0x06, 0xb2, 0x40, 0x9a, 0xd1, 0x65, 0x2a, 0x8c, 0xc7, 0x8c, 0x52, 0xcd, 0xb3, 0x44, 0x72,
-	0x53, 0x42, 0xa4, 0xde, 0x07, 0x83, 0x38, 0xa2, 0x5c, 0x3f, 0xc0, 0xf5, 0x13, 0x5d, 0xf5, 0x4f,
-	0x39, 0x27, 0x6d, 0x10, 0xcb, 0x2a, 0x57, 0xe3, 0x48, 0xd2, 0x92, 0x85, 0x19, 0x3b, 0x1e, 0x26,
-	0xd9, 0x6d, 0xf9, 0x32, 0x18, 0x4d, 0xff, 0x4f, 0x62, 0x7d, 0x53, 0x78, 0xd4, 0xe7, 0x81, 0x0d,
-	0x29, 0x7a, 0x06, 0x43, 0xe8, 0x11, 0xf5, 0x16, 0xc8, 0xc3, 0x88, 0xae, 0xe3, 0xd0, 0xa5, 0xed,
-	0xbe, 0xd3, 0x71, 0x44, 0x55, 0xef, 0x81, 0x5c, 0xc0, 0x1d, 0xf8, 0x5c, 0xf4, 0x4a, 0x24, 0x8a,
-	0x1c, 0x6b, 0x89, 0x50, 0x55, 0x6e, 0xb2, 0x30, 0x47, 0x7e, 0x2c, 0xcf, 0x74, 0x2a, 0xcf, 0x96,
-	0x38, 0x24, 0x1d, 0xbb, 0x2d, 0x17, 0xc1, 0x58, 0xc7, 0x52, 0x12, 0xee, 0xaf, 0x02, 0x46, 0xf8,
-	0x77, 0xa4, 0x2c, 0xf3, 0x23, 0x1f, 0x5a, 0x2d, 0x64, 0xff, 0x77, 0xbc, 0x65, 0x50, 0x20, 0xc8,
-	0xb7, 0x1b, 0x48, 0xf8, 0xc8, 0xcf, 0x36, 0xd5, 0x35, 0x64, 0xaa, 0x5e, 0x7d, 0x88, 0xa4, 0x8a,
-	0xcf, 0x80, 0xe1, 0x88, 0xa0, 0x86, 0x8d, 0xd6, 0x60, 0xd4, 0xa2, 0x8d, 0x35, 0x1c, 0xf2, 0xf3,
-	0x90, 0xaf, 0x5f, 0x88, 0x08, 0x7a, 0x28, 0x56, 0x57, 0x70, 0x58, 0x31, 0x4f, 0xf6, 0x62, 0xb2,
-	0x73, 0x50, 0xd3, 0xa9, 0xca, 0x13, 0xa0, 0x78, 0x62, 0x31, 0x6e, 0xc4, 0xd2, 0xeb, 0x2c, 0xc8,
-	0xd6, 0x88, 0xa3, 0x3e, 0x01, 0xe7, 0xf8, 0xec, 0x4e, 0x76, 0xdd, 0xb4, 0x1c, 0x79, 0xed, 0xca,
-	0x69, 0x68, 0xec, 0xa9, 0xbe, 0x00, 0xf9, 0xa3, 0xc3, 0x30, 0xdd, 0x4b, 0x92, 0x50, 0xb4, 0xb9,
-	0xbe, 0x94, 0xc4, 0xda, 0x02, 0x85, 0x63, 0x03, 0xd9, 0x73, 0x43, 0x69, 0x96, 0x76, 0xed, 0x2c,
-	0xac, 0xa4, 0xc6, 0x3a, 0xb8, 0xd8, 0x31, 0x17, 0x33, 0xbd, 0x63, 0xa7, 0x79, 0x9a, 0x71, 0x36,
-	0x5e, 0x5c, 0x49, 0x3b, 0xff, 0x8a, 0x4d, 0x79, 0x75, 0x79, 0x77, 0x5f, 0x57, 0xf6, 0xf6, 0x75,
-	0xe5, 0xf7, 0xbe, 0xae, 0xbc, 0x3f, 0xd0, 0x33, 0x7b, 0x07, 0x7a, 0xe6, 0xc7, 0x81, 0x9e, 0x79,
-	0x39, 0x77, 0xea, 0x3d, 0x27, 0xc7, 0x9e, 0x5f, 0x77, 0x56, 0x8e, 0x5f, 0xe7, 0x37, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x5b, 0x5b, 0x43, 0xa9, 0xa0, 0x06, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x4f, 0x13, 0x5b,
+	0x18, 0xed, 0x14, 0x5e, 0x49, 0x2f, 0x7d, 0x8f, 0x30, 0x8f, 0xbc, 0xd2, 0x81, 0xb4, 0x30, 0xe1,
+	0x11, 0x40, 0x99, 0x11, 0x34, 0x62, 0x6a, 0x34, 0x5a, 0x90, 0x44, 0x93, 0x46, 0x53, 0x62, 0x8c,
+	0x6e, 0x9a, 0x69, 0xe7, 0x32, 0x9d, 0xd0, 0x99, 0xdb, 0xcc, 0xbd, 0x83, 0x74, 0x67, 0x74, 0xa1,
+	0x71, 0xe5, 0xda, 0x15, 0x4b, 0x63, 0x5c, 0xb0, 0x70, 0x69, 0x62, 0xe2, 0x8a, 0x25, 0x71, 0xe5,
+	0x4a, 0x0d, 0x2c, 0xd0, 0xff, 0xc2, 0xdc, 0x1f, 0x1d, 0xa6, 0x3f, 0x06, 0x90, 0x9d, 0x1b, 0x66,
+	0xb8, 0xe7, 0x3b, 0xe7, 0xbb, 0xe7, 0xcc, 0x77, 0x6f, 0xc1, 0x78, 0x15, 0x61, 0x07, 0x61, 0xbd,
+	0x62, 0xb8, 0x1b, 0xfa, 0xe6, 0x42, 0x05, 0x12, 0x63, 0x41, 0x27, 0x5b, 0x5a, 0xc3, 0x43, 0x04,
+	0xc9, 0xff, 0x72, 0x54, 0xa3, 0xa8, 0x26, 0x50, 0x65, 0xc4, 0x42, 0x16, 0x62, 0xb8, 0x4e, 0xdf,
+	0x78, 0xa9, 0x92, 0x0d, 0x84, 0x30, 0x0c, 0x84, 0xaa, 0xc8, 0x76, 0xbb, 0xf0, 0x50, 0x23, 0xa6,
+	0xcb, 0xf1, 0x0c, 0xc7, 0xcb, 0x5c, 0x58, 0xf4, 0xe5, 0x50, 0x5a, 0x50, 0x1d, 0x6c, 0xe9, 0x9b,
+	0x0b, 0xf4, 0x21, 0x80, 0x61, 0xc3, 0xb1, 0x5d, 0xa4, 0xb3, 0xbf, 0x7c, 0x49, 0x7d, 0x17, 0x07,
+	0x03, 0x45, 0x6c, 0xad, 0x41, 0xd7, 0x94, 0xaf, 0x82, 0xd4, 0xba, 0x87, 0x9c, 0xb2, 0x61, 0x9a,
+	0x1e, 0xc4, 0x78, 0x54, 0x9a, 0x90, 0x66, 0x92, 0x85, 0xd1, 0xcf, 0xef, 0xe7, 0x47, 0x84, 0xfe,
+	0x4d, 0x8e, 0xac, 0x11, 0xcf, 0x76, 0xad, 0xd2, 0x20, 0xad, 0x16, 0x4b, 0xf2, 0x12, 0x00, 0x04,
+	0x05, 0xd4, 0xf8, 0x09, 0xd4, 0x24, 0x41, 0x2d, 0x62, 0x13, 0x24, 0x0c, 0x07, 0xf9, 0x2e, 0x19,
+	0xed, 0x9b, 0xe8, 0x9b, 0x19, 0x5c, 0xcc, 0x68, 0x41, 0x88, 0x18, 0xb6, 0x42, 0xd4, 0x96, 0x91,
+	0xed, 0x16, 0x56, 0x77, 0xbf, 0xe6, 0x62, 0x6f, 0xbf, 0xe5, 0x66, 0x2c, 0x9b, 0xd4, 0xfc, 0x8a,
+	0x56, 0x45, 0x8e, 0x70, 0x2e, 0x1e, 0xf3, 0xd8, 0xdc, 0xd0, 0x49, 0xb3, 0x01, 0x31, 0x23, 0xe0,
+	0xd7, 0x87, 0x3b, 0x73, 0xa9, 0x3a, 0xb4, 0x8c, 0x6a, 0xb3, 0x4c, 0xb3, 0xc5, 0x6f, 0x0e, 0x77,
+	0xe6, 0xa4, 0x92, 0x68, 0x98, 0xbf, 0xf0, 0x62, 0x3b, 0x17, 0xfb, 0xb1, 0x9d, 0x8b, 0x3d, 0xa5,
+	0x75, 0x61, 0xef, 0x2f, 0x0f, 0x77, 0xe6, 0xe4, 0x90, 0xa6, 0x88, 0x48, 0x1d, 0x06, 0x43, 0xe2,
+	0xb5, 0x04, 0x71, 0x03, 0xb9, 0x18, 0xaa, 0x1f, 0x24, 0x90, 0x2a, 0x62, 0xab, 0xe8, 0xd7, 0x89,
+	0xcd, 0x62, 0xbc, 0x06, 0x12, 0xb6, 0xdb, 0xf0, 0x09, 0x0d, 0x90, 0x1a, 0x52, 0xb4, 0x1e, 0x53,
+	0xa1, 0xdd, 0xa6, 0x25, 0x85, 0x24, 0x75, 0x24, 0x36, 0xc5, 0x49, 0xf2, 0x0d, 0x30, 0x80, 0x7c,
+	0xc2, 0xf8, 0x71, 0xc6, 0x1f, 0xeb, 0xc9, 0xbf, 0xeb, 0x93, 0x0e, 0x81, 0x16, 0x2d, 0x7f, 0xae,
+	0x65, 0x49, 0x48, 0x52, 0x33, 0xe9, 0x76, 0x33, 0xc1, 0x6e, 0xd5, 0xff, 0xc0, 0x48, 0xf8, 0xff,
+	0xc0, 0xd6, 0x47, 0x89, 0x59, 0xbd, 0xdf, 0x30, 0x0d, 0x02, 0xef, 0x19, 0x9e, 0xe1, 0x60, 0xf9,
+	0x32, 0x48, 0x1a, 0x3e, 0xa9, 0x21, 0xcf, 0x26, 0xcd, 0x13, 0xa7, 0xe3, 0xa8, 0x54, 0xbe, 0x0e,
+	0x12, 0x0d, 0xa6, 0xc0, 0xe6, 0x22, 0xca, 0x11, 0x6f, 0xd2, 0x16, 0x09, 0x67, 0xe5, 0x2f, 0x51,
+	0x33, 0x47, 0x7a, 0xd4, 0xcf, 0x64, 0xc8, 0xcf, 0x16, 0x3f, 0x24, 0x1d, 0xbb, 0x55, 0x33, 0x20,
+	0xdd, 0xb1, 0x14, 0x98, 0xfb, 0x29, 0x81, 0x61, 0xf6, 0x1d, 0x09, 0xf5, 0x7c, 0xcb, 0x35, 0x2a,
+	0x75, 0x68, 0x9e, 0xd9, 0xde, 0x32, 0x48, 0x61, 0xe8, 0x9a, 0x65, 0xc8, 0x75, 0xc4, 0x67, 0x9b,
+	0xe8, 0x69, 0x32, 0xd4, 0xaf, 0x34, 0x88, 0x43, 0xcd, 0xa7, 0xc1, 0x90, 0x8f, 0x61, 0xd9, 0x84,
+	0xeb, 0x86, 0x5f, 0x27, 0xe5, 0x75, 0xe4, 0xb1, 0xf3, 0x90, 0x2c, 0xfd, 0xed, 0x63, 0xb8, 0xc2,
+	0x57, 0x57, 0x91, 0x97, 0xd7, 0xbb, 0xb3, 0x18, 0xef, 0x1c, 0xd4, 0xb0, 0x2b, 0x75, 0x0c, 0x64,
+	0xba, 0x16, 0x83, 0x20, 0x3e, 0xc5, 0x81, 0x52, 0xc4, 0xd6, 0x03, 0x9b, 0xd4, 0x4c, 0xcf, 0x78,
+	0xbc, 0x0a, 0xe1, 0x0a, 0x74, 0x91, 0x53, 0x82, 0x18, 0x7a, 0x9b, 0xf0, 0xcc, 0x89, 0xfc, 0x89,
+	0x97, 0xc1, 0x95, 0xee, 0x60, 0xff, 0x6f, 0x0f, 0x36, 0x22, 0x25, 0x75, 0x0a, 0xa8, 0xd1, 0x68,
+	0x2b, 0xea, 0xc5, 0xe7, 0xfd, 0xa0, 0xaf, 0x88, 0x2d, 0xf9, 0x0e, 0xe8, 0x67, 0xd7, 0xc4, 0x78,
+	0xcf, 0xf9, 0x10, 0xb7, 0x8b, 0x32, 0x75, 0x1c, 0xda, 0xd2, 0x94, 0x1f, 0x82, 0xe4, 0xd1, 0xbd,
+	0x33, 0x19, 0x45, 0x09, 0x4a, 0x94, 0xd9, 0x13, 0x4b, 0x02, 0xe9, 0x0a, 0x48, 0xb5, 0x9d, 0xfd,
+	0xc8, 0x0d, 0x85, 0xab, 0x94, 0xf3, 0xa7, 0xa9, 0x0a, 0x7a, 0xd4, 0xc0, 0x3f, 0x1d, 0x47, 0x70,
+	0x3a, 0xda, 0x76, 0xb8, 0x4e, 0xd1, 0x4e, 0x57, 0x17, 0x74, 0x7a, 0x26, 0x81, 0x74, 0xd4, 0x90,
+	0xeb, 0x51, 0x5a, 0x11, 0x04, 0x65, 0xe9, 0x37, 0x09, 0xad, 0x5d, 0x28, 0x7f, 0x3d, 0xa1, 0x13,
+	0x57, 0x58, 0xde, 0xdd, 0xcf, 0x4a, 0x7b, 0xfb, 0x59, 0xe9, 0xfb, 0x7e, 0x56, 0x7a, 0x75, 0x90,
+	0x8d, 0xed, 0x1d, 0x64, 0x63, 0x5f, 0x0e, 0xb2, 0xb1, 0x47, 0xb3, 0xc7, 0xce, 0xb2, 0xb8, 0xe7,
+	0xd8, 0x48, 0x57, 0x12, 0xec, 0xf7, 0xfb, 0xe2, 0xaf, 0x01, 0x00, 0x3f, 0xd6, 0xc8, 0xa0, 0x91,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -501,6 +614,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// WithdrawFeeDenomReserve is a governance operation for withdrawing coins
+	// from the fee denom reserve module account, e.g. the fees paid in
+	// alternative denominations, so that they can be swapped for native fee
+	// coins to fund the reserve again.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawFeeDenomReserve(ctx context.Context, in *MsgWithdrawFeeDenomReserve, opts ...grpc.CallOption) (*MsgWithdrawFeeDenomReserveResponse, error)
 }
 
 type msgClient struct {
@@ -547,6 +667,15 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) WithdrawFeeDenomReserve(ctx context.Context, in *MsgWithdrawFeeDenomReserve, opts ...grpc.CallOption) (*MsgWithdrawFeeDenomReserveResponse, error) {
+	out := new(MsgWithdrawFeeDenomReserveResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/WithdrawFeeDenomReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
@@ -565,6 +694,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// WithdrawFeeDenomReserve is a governance operation for withdrawing coins
+	// from the fee denom reserve module account, e.g. the fees paid in
+	// alternative denominations, so that they can be swapped for native fee
+	// coins to fund the reserve again.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawFeeDenomReserve(context.Context, *MsgWithdrawFeeDenomReserve) (*MsgWithdrawFeeDenomReserveResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +719,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawFeeDenomReserve(ctx context.Context, req *MsgWithdrawFeeDenomReserve) (*MsgWithdrawFeeDenomReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeeDenomReserve not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -660,6 +799,24 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawFeeDenomReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeeDenomReserve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeeDenomReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/WithdrawFeeDenomReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeeDenomReserve(ctx, req.(*MsgWithdrawFeeDenomReserve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -680,6 +837,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "WithdrawFeeDenomReserve",
+			Handler:    _Msg_WithdrawFeeDenomReserve_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -972,6 +1133,80 @@ func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawFeeDenomReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeeDenomReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeeDenomReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFeeDenomReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeeDenomReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeeDenomReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1103,6 +1338,38 @@ func (m *MsgSetSendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawFeeDenomReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawFeeDenomReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1838,6 +2105,204 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawFeeDenomReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFeeDenomReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFeeDenomReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFeeDenomReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFeeDenomReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFeeDenomReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
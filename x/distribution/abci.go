@@ -10,7 +10,8 @@ import (
 )
 
 // BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block.
+// and distribute rewards for the previous block. It also compounds the rewards
+// of the delegations with auto-compounding enabled.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -29,5 +30,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
-	return nil
+
+	// compound the rewards of the delegations with auto-compounding enabled
+	return k.AutoCompoundRewards(ctx)
 }
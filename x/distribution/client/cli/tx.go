@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
@@ -42,6 +43,8 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 		NewSetWithdrawAddrCmd(ac),
 		NewFundCommunityPoolCmd(),
 		NewDepositValidatorRewardsPoolCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
+		NewSetAutoCompoundCmd(),
 	)
 
 	return distTxCmd
@@ -295,3 +298,80 @@ func NewDepositValidatorRewardsPoolCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewWithdrawTokenizeShareRecordRewardCmd returns a CLI command handler for
+// creating a MsgWithdrawTokenizeShareRecordReward transaction.
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Short: "Withdraw the rewards of the tokenize share records owned by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the delegations of all the tokenize share records owned by the sender.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a
+// MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [validator-addr] [enabled]",
+		Short: "Enable or disable the auto-compounding of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the periodic withdrawal and re-delegation of the rewards
+of a delegation to a validator. The delegator must be its own withdraw address.
+
+Example:
+$ %s tx distribution set-auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(clientCtx.GetFromAddress(), valAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,18 +1,28 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"fmt"
 
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // AllocateTokens performs reward and fee distribution to all validators based
 // on the F1 fee distribution specification.
+//
+// The rewards of the validators are not written each block. They accumulate as
+// a cumulative reward per unit of voting power, and the rewards of a validator
+// are settled lazily when they are accessed, or when its voting power changes.
+// A block in which the vote set is unchanged writes a constant number of
+// entries whatever the number of validators.
 func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bondedVotes []abci.VoteInfo) error {
 	// fetch and clear the collected fees for distribution, since this is
 	// called in BeginBlock, collected fees will be from the previous block
@@ -28,6 +38,12 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 		return err
 	}
 
+	// settle the rewards of the validators whose voting power changed since
+	// the last block, before allocating the fees of this block
+	if err := k.updateVoteSet(ctx, bondedVotes); err != nil {
+		return err
+	}
+
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
 	feePool, err := k.GetFeePool(ctx)
@@ -41,7 +57,6 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	}
 
 	// calculate fraction allocated to validators
-	remaining := feesCollected
 	communityTax, err := k.GetCommunityTax(ctx)
 	if err != nil {
 		return err
@@ -50,31 +65,295 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
 	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
 
-	// allocate tokens proportionally to voting power
-	//
-	// TODO: Consider parallelizing later
-	//
-	// Ref: https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
+	// allocate tokens proportionally to voting power, the reward of each unit
+	// of voting power being settled to the validators later on
+	var votesPower int64
 	for _, vote := range bondedVotes {
-		validator := k.stakingKeeper.ValidatorByConsAddr(sdkCtx, vote.Validator.Address)
+		votesPower += vote.Validator.Power
+	}
+
+	rewardPerPower := feeMultiplier.QuoDecTruncate(math.LegacyNewDec(totalPreviousPower))
+	allocated := rewardPerPower.MulDec(math.LegacyNewDec(votesPower))
+
+	acc, err := k.GetRewardsAccumulator(ctx)
+	if err != nil {
+		return err
+	}
+
+	acc.RewardsPerPower = acc.RewardsPerPower.Add(rewardPerPower...)
+	acc.Unsettled = acc.Unsettled.Add(allocated...)
+	if err := k.SetRewardsAccumulator(ctx, acc); err != nil {
+		return err
+	}
+
+	// allocate community funding
+	feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected.Sub(allocated)...)
+	return k.SetFeePool(ctx, feePool)
+}
+
+// updateVoteSet records the voting power of the validators of the given vote
+// set. The rewards of the validators which left the last vote set, or whose
+// voting power changed, are settled first. Only the checkpoints of these
+// validators, and of the ones which joined the vote set, are read and written:
+// the changes are found by comparing the vote set with the last one. It is a
+// no-op if the vote set is unchanged.
+func (k Keeper) updateVoteSet(ctx context.Context, votes []abci.VoteInfo) error {
+	voteSet := encodeVoteSet(votes)
+	hash := sha256.Sum256(voteSet)
+	lastHash, err := k.GetVoteSetHash(ctx)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(hash[:], lastHash) {
+		return nil
+	}
+
+	bz, err := k.GetLastVoteSet(ctx)
+	if err != nil {
+		return err
+	}
+	lastVotes, err := decodeVoteSet(bz)
+	if err != nil {
+		return err
+	}
+
+	powers := make(map[string]int64, len(votes))
+	for _, vote := range votes {
+		powers[string(vote.Validator.Address)] = vote.Validator.Power
+	}
+	lastPowers := make(map[string]int64, len(lastVotes))
+	for _, vote := range lastVotes {
+		lastPowers[string(vote.Address)] = vote.Power
+	}
+
+	acc, err := k.GetRewardsAccumulator(ctx)
+	if err != nil {
+		return err
+	}
 
-		// TODO: Consider micro-slashing for missing votes.
-		//
-		// Ref: https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
-		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
-		reward := feeMultiplier.MulDecTruncate(powerFraction)
+	// settle the rewards of the validators which left the vote set or whose
+	// voting power changed
+	checkpoints := make(map[string]types.ValidatorRewardsCheckpoint)
+	for _, vote := range lastVotes {
+		power, ok := powers[string(vote.Address)]
+		if ok && power == vote.Power {
+			continue
+		}
 
-		err := k.AllocateTokensToValidator(ctx, validator, reward)
+		consAddr := sdk.ConsAddress(vote.Address)
+		checkpoint, err := k.GetValidatorRewardsCheckpoint(ctx, consAddr)
 		if err != nil {
 			return err
 		}
 
-		remaining = remaining.Sub(reward)
+		if err := k.settleValidatorRewards(ctx, &acc, &checkpoint); err != nil {
+			return err
+		}
+
+		// the checkpoint of a validator whose voting power changed is
+		// overwritten below
+		if ok {
+			checkpoints[string(consAddr)] = checkpoint
+			continue
+		}
+
+		if err := k.deleteValidatorRewardsCheckpoint(ctx, consAddr, checkpoint); err != nil {
+			return err
+		}
 	}
 
-	// allocate community funding
-	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
-	return k.SetFeePool(ctx, feePool)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, vote := range votes {
+		lastPower, found := lastPowers[string(vote.Validator.Address)]
+		if found && lastPower == vote.Validator.Power {
+			continue
+		}
+
+		consAddr := sdk.ConsAddress(vote.Validator.Address)
+		checkpoint, ok := checkpoints[string(consAddr)]
+		if !ok {
+			// the rewards of an unknown validator are settled to the
+			// community pool
+			if validator := k.stakingKeeper.ValidatorByConsAddr(sdkCtx, consAddr); validator != nil {
+				checkpoint.ValidatorAddress = validator.GetOperator().String()
+				if err := k.SetValidatorRewardsCheckpointConsAddr(ctx, validator.GetOperator(), consAddr); err != nil {
+					return err
+				}
+			}
+		}
+
+		checkpoint.Power = vote.Validator.Power
+		checkpoint.RewardsPerPower = acc.RewardsPerPower
+		if err := k.SetValidatorRewardsCheckpoint(ctx, consAddr, checkpoint); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetRewardsAccumulator(ctx, acc); err != nil {
+		return err
+	}
+
+	if err := k.SetLastVoteSet(ctx, voteSet); err != nil {
+		return err
+	}
+
+	return k.SetVoteSetHash(ctx, hash[:])
+}
+
+// deleteValidatorRewardsCheckpoint deletes the rewards checkpoint of a
+// validator which left the last vote set.
+func (k Keeper) deleteValidatorRewardsCheckpoint(ctx context.Context, consAddr sdk.ConsAddress, checkpoint types.ValidatorRewardsCheckpoint) error {
+	if err := k.DeleteValidatorRewardsCheckpoint(ctx, consAddr); err != nil {
+		return err
+	}
+
+	if checkpoint.ValidatorAddress == "" {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(checkpoint.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	// the validator may have joined the vote set under another consensus
+	// address after a key rotation
+	current, err := k.GetValidatorRewardsCheckpointConsAddr(ctx, valAddr)
+	if err != nil || !current.Equals(consAddr) {
+		return err
+	}
+
+	return k.DeleteValidatorRewardsCheckpointConsAddr(ctx, valAddr)
+}
+
+// SettleValidatorRewards allocates to a validator the rewards accrued by its
+// voting power in the last vote set since they were last settled.
+func (k Keeper) SettleValidatorRewards(ctx context.Context, valAddr sdk.ValAddress) error {
+	consAddr, err := k.GetValidatorRewardsCheckpointConsAddr(ctx, valAddr)
+	if err != nil || consAddr == nil {
+		return err
+	}
+
+	return k.settleValidatorRewardsByConsAddr(ctx, consAddr)
+}
+
+// settleValidatorRewardsByConsAddr settles the rewards of the validator of
+// the last vote set with the given consensus address, if any.
+func (k Keeper) settleValidatorRewardsByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) error {
+	checkpoint, err := k.GetValidatorRewardsCheckpoint(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint.Power == 0 {
+		return nil
+	}
+
+	acc, err := k.GetRewardsAccumulator(ctx)
+	if err != nil {
+		return err
+	}
+
+	if acc.RewardsPerPower.Equal(checkpoint.RewardsPerPower) {
+		return nil
+	}
+
+	if err := k.settleValidatorRewards(ctx, &acc, &checkpoint); err != nil {
+		return err
+	}
+
+	if err := k.SetValidatorRewardsCheckpoint(ctx, consAddr, checkpoint); err != nil {
+		return err
+	}
+
+	return k.SetRewardsAccumulator(ctx, acc)
+}
+
+// SettleAllValidatorRewards settles the rewards of all the validators of the
+// last vote set.
+func (k Keeper) SettleAllValidatorRewards(ctx context.Context) error {
+	var consAddrs []sdk.ConsAddress
+	k.IterateValidatorRewardsCheckpoints(ctx, func(consAddr sdk.ConsAddress, _ types.ValidatorRewardsCheckpoint) (stop bool) {
+		consAddrs = append(consAddrs, consAddr)
+		return false
+	})
+
+	for _, consAddr := range consAddrs {
+		if err := k.settleValidatorRewardsByConsAddr(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// settleValidatorRewards allocates to the validator of a checkpoint the
+// rewards accrued since the checkpoint, and moves the checkpoint to the
+// current cumulative reward per unit of voting power. The rewards of a
+// validator which does not exist anymore go to the community pool.
+func (k Keeper) settleValidatorRewards(ctx context.Context, acc *types.RewardsAccumulator, checkpoint *types.ValidatorRewardsCheckpoint) error {
+	rewards := acc.RewardsPerPower.Sub(checkpoint.RewardsPerPower).MulDec(math.LegacyNewDec(checkpoint.Power))
+	acc.Unsettled = acc.Unsettled.Sub(rewards)
+	checkpoint.RewardsPerPower = acc.RewardsPerPower
+
+	if rewards.IsZero() {
+		return nil
+	}
+
+	var validator stakingtypes.ValidatorI
+	if checkpoint.ValidatorAddress != "" {
+		valAddr, err := sdk.ValAddressFromBech32(checkpoint.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		validator = k.stakingKeeper.Validator(sdk.UnwrapSDKContext(ctx), valAddr)
+	}
+
+	if validator == nil {
+		feePool, err := k.GetFeePool(ctx)
+		if err != nil {
+			return err
+		}
+
+		feePool.CommunityPool = feePool.CommunityPool.Add(rewards...)
+		return k.SetFeePool(ctx, feePool)
+	}
+
+	return k.AllocateTokensToValidator(ctx, validator, rewards)
+}
+
+// encodeVoteSet returns the addresses and voting powers of a vote set, each
+// encoded as the length prefixed address followed by the big endian power.
+func encodeVoteSet(votes []abci.VoteInfo) []byte {
+	var bz []byte
+	for _, vote := range votes {
+		bz = append(bz, address.MustLengthPrefix(vote.Validator.Address)...)
+		bz = append(bz, sdk.Uint64ToBigEndian(uint64(vote.Validator.Power))...)
+	}
+
+	return bz
+}
+
+// decodeVoteSet decodes the addresses and voting powers of a vote set encoded
+// with encodeVoteSet.
+func decodeVoteSet(bz []byte) ([]abci.Validator, error) {
+	var validators []abci.Validator
+	for len(bz) > 0 {
+		addrLen := int(bz[0])
+		if len(bz) < 1+addrLen+8 {
+			return nil, fmt.Errorf("invalid vote set encoding: %d bytes left", len(bz))
+		}
+
+		validators = append(validators, abci.Validator{
+			Address: bz[1 : 1+addrLen],
+			Power:   int64(sdk.BigEndianToUint64(bz[1+addrLen : 1+addrLen+8])),
+		})
+		bz = bz[1+addrLen+8:]
+	}
+
+	return validators, nil
 }
 
 // AllocateTokensToValidator allocate tokens to a particular validator,
@@ -12,6 +12,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -111,6 +112,7 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 	require.NoError(t, err)
 	val0.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
 	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr0).Return(val0).AnyTimes()
 
 	// create second validator with 0% commission
 	valAddr1 := sdk.ValAddress(valConsAddr1)
@@ -118,6 +120,7 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 	require.NoError(t, err)
 	val1.Commission = stakingtypes.NewCommission(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
 	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr1).Return(val1).AnyTimes()
 
 	abciValA := abci.Validator{
 		Address: valConsPk0.Address(),
@@ -174,6 +177,9 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 	}
 	distrKeeper.AllocateTokens(ctx, 200, votes)
 
+	// settle the rewards allocated to the validators
+	require.NoError(t, distrKeeper.SettleAllValidatorRewards(ctx))
+
 	// 98 outstanding rewards (100 less 2 to community pool)
 	val0OutstandingRewards, err = distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr0)
 	require.NoError(t, err)
@@ -245,6 +251,7 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	val0.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDec(0))
 	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr0).Return(val0).AnyTimes()
 
 	// create second validator with 10% commission
 	valAddr1 := sdk.ValAddress(valConsAddr1)
@@ -252,6 +259,7 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	val1.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDec(0))
 	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr1).Return(val1).AnyTimes()
 
 	// create third validator with 10% commission
 	valAddr2 := sdk.ValAddress(valConsAddr2)
@@ -259,6 +267,7 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	val2.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDec(0))
 	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk2)).Return(val2).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr2).Return(val2).AnyTimes()
 
 	abciValA := abci.Validator{
 		Address: valConsPk0.Address(),
@@ -323,6 +332,9 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	}
 	distrKeeper.AllocateTokens(ctx, 31, votes)
 
+	// settle the rewards allocated to the validators
+	require.NoError(t, distrKeeper.SettleAllValidatorRewards(ctx))
+
 	val0OutstandingRewards, err = distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr0)
 	require.NoError(t, err)
 	require.True(t, val0OutstandingRewards.Rewards.IsValid())
@@ -335,3 +347,197 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, val2OutstandingRewards.Rewards.IsValid())
 }
+
+// allocateTokensEagerly allocates the collected fees to the validators of the
+// vote set in the block, as AllocateTokens did before the rewards were settled
+// lazily.
+func allocateTokensEagerly(t *testing.T, ctx sdk.Context, k keeper.Keeper, sk *distrtestutil.MockStakingKeeper, fees sdk.Coins, totalPower int64, votes []abci.VoteInfo) {
+	t.Helper()
+
+	feesCollected := sdk.NewDecCoinsFromCoins(fees...)
+	feePool, err := k.GetFeePool(ctx)
+	require.NoError(t, err)
+
+	communityTax, err := k.GetCommunityTax(ctx)
+	require.NoError(t, err)
+
+	remaining := feesCollected
+	feeMultiplier := feesCollected.MulDecTruncate(math.LegacyOneDec().Sub(communityTax))
+	for _, vote := range votes {
+		validator := sk.ValidatorByConsAddr(ctx, vote.Validator.Address)
+		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPower))
+		reward := feeMultiplier.MulDecTruncate(powerFraction)
+		require.NoError(t, k.AllocateTokensToValidator(ctx, validator, reward))
+		remaining = remaining.Sub(reward)
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
+	require.NoError(t, k.SetFeePool(ctx, feePool))
+}
+
+func TestAllocateTokensLazyEquivalence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress()).Times(2)
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	// the lazy keeper runs AllocateTokens, the eager keeper the previous algorithm
+	newKeeper := func() (keeper.Keeper, sdk.Context) {
+		key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+		k := keeper.NewKeeper(
+			encCfg.Codec,
+			runtime.NewKVStoreService(key),
+			accountKeeper,
+			bankKeeper,
+			stakingKeeper,
+			"fee_collector",
+			authtypes.NewModuleAddress("gov").String(),
+		)
+		require.NoError(t, k.SetFeePool(testCtx.Ctx, disttypes.InitialFeePool()))
+		require.NoError(t, k.SetParams(testCtx.Ctx, disttypes.DefaultParams()))
+		return k, testCtx.Ctx
+	}
+	lazyKeeper, lazyCtx := newKeeper()
+	eagerKeeper, eagerCtx := newKeeper()
+
+	// validators with 50%, 10% and 0% commission
+	pks := []cryptotypes.PubKey{valConsPk0, valConsPk1, valConsPk2}
+	commissions := []math.LegacyDec{math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1), math.LegacyZeroDec()}
+	vals := make([]stakingtypes.Validator, len(pks))
+	for i, pk := range pks {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(commissions[i], math.LegacyOneDec(), math.LegacyZeroDec())
+		vals[i] = val
+
+		i := i
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).DoAndReturn(
+			func(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI { return vals[i] },
+		).AnyTimes()
+		stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).DoAndReturn(
+			func(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI { return vals[i] },
+		).AnyTimes()
+	}
+
+	var fees sdk.Coins
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).DoAndReturn(
+		func(sdk.Context, sdk.AccAddress) sdk.Coins { return fees },
+	).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, gomock.Any()).AnyTimes()
+
+	powers := []int64{100, 50, 7}
+	for height := int64(1); height <= 40; height++ {
+		switch height {
+		case 11:
+			// the voting power of a validator changes
+			powers[1] = 70
+		case 21:
+			// a validator leaves the vote set
+			powers[2] = 0
+		case 25:
+			// the commission rate of a validator changes
+			require.NoError(t, lazyKeeper.Hooks().BeforeValidatorModified(lazyCtx, vals[0].GetOperator()))
+			vals[0].Commission.Rate = math.LegacyNewDecWithPrec(2, 1)
+		case 31:
+			// a validator joins the vote set
+			powers[2] = 13
+		}
+
+		var (
+			votes      []abci.VoteInfo
+			totalPower int64
+		)
+		for i, pk := range pks {
+			if powers[i] == 0 {
+				continue
+			}
+			votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: powers[i]}})
+			totalPower += powers[i]
+		}
+
+		fees = sdk.NewCoins(
+			sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000+37*height)),
+			sdk.NewCoin("atom", math.NewInt(height%3)),
+		)
+		require.NoError(t, lazyKeeper.AllocateTokens(lazyCtx.WithBlockHeight(height), totalPower, votes))
+		allocateTokensEagerly(t, eagerCtx.WithBlockHeight(height), eagerKeeper, stakingKeeper, fees, totalPower, votes)
+
+		if height == 11 {
+			// only the checkpoint of the validator whose voting power changed
+			// is settled
+			checkpoint, err := lazyKeeper.GetValidatorRewardsCheckpoint(lazyCtx, sdk.ConsAddress(pks[0].Address()))
+			require.NoError(t, err)
+			require.True(t, checkpoint.RewardsPerPower.IsZero())
+			checkpoint, err = lazyKeeper.GetValidatorRewardsCheckpoint(lazyCtx, sdk.ConsAddress(pks[1].Address()))
+			require.NoError(t, err)
+			require.Equal(t, int64(70), checkpoint.Power)
+			require.False(t, checkpoint.RewardsPerPower.IsZero())
+		}
+
+		if height == 5 {
+			// the rewards are not allocated to the validators until settled
+			outstanding, err := lazyKeeper.GetValidatorOutstandingRewards(lazyCtx, vals[1].GetOperator())
+			require.NoError(t, err)
+			require.True(t, outstanding.Rewards.IsZero())
+
+			require.NoError(t, lazyKeeper.SettleValidatorRewards(lazyCtx, vals[1].GetOperator()))
+		}
+	}
+
+	require.NoError(t, lazyKeeper.SettleAllValidatorRewards(lazyCtx))
+
+	acc, err := lazyKeeper.GetRewardsAccumulator(lazyCtx)
+	require.NoError(t, err)
+	require.True(t, acc.Unsettled.IsZero())
+
+	// the rewards only differ by the truncation of each allocation
+	requireApproxEqual := func(expected, actual sdk.DecCoins) {
+		t.Helper()
+		require.Len(t, actual, len(expected))
+		for _, coin := range expected {
+			diff := coin.Amount.Sub(actual.AmountOf(coin.Denom)).Abs()
+			require.True(t, diff.LTE(math.LegacyNewDecWithPrec(1, 12)), "expected %s, got %s", expected, actual)
+		}
+	}
+
+	var lazyTotal, eagerTotal sdk.DecCoins
+	for _, val := range vals {
+		lazyOutstanding, err := lazyKeeper.GetValidatorOutstandingRewards(lazyCtx, val.GetOperator())
+		require.NoError(t, err)
+		eagerOutstanding, err := eagerKeeper.GetValidatorOutstandingRewards(eagerCtx, val.GetOperator())
+		require.NoError(t, err)
+		require.False(t, eagerOutstanding.Rewards.IsZero())
+		requireApproxEqual(eagerOutstanding.Rewards, lazyOutstanding.Rewards)
+
+		lazyCommission, err := lazyKeeper.GetValidatorAccumulatedCommission(lazyCtx, val.GetOperator())
+		require.NoError(t, err)
+		eagerCommission, err := eagerKeeper.GetValidatorAccumulatedCommission(eagerCtx, val.GetOperator())
+		require.NoError(t, err)
+		requireApproxEqual(eagerCommission.Commission, lazyCommission.Commission)
+
+		lazyCurrent, err := lazyKeeper.GetValidatorCurrentRewards(lazyCtx, val.GetOperator())
+		require.NoError(t, err)
+		eagerCurrent, err := eagerKeeper.GetValidatorCurrentRewards(eagerCtx, val.GetOperator())
+		require.NoError(t, err)
+		requireApproxEqual(eagerCurrent.Rewards, lazyCurrent.Rewards)
+
+		lazyTotal = lazyTotal.Add(lazyOutstanding.Rewards...)
+		eagerTotal = eagerTotal.Add(eagerOutstanding.Rewards...)
+	}
+
+	lazyPool, err := lazyKeeper.GetFeePoolCommunityCoins(lazyCtx)
+	require.NoError(t, err)
+	eagerPool, err := eagerKeeper.GetFeePoolCommunityCoins(eagerCtx)
+	require.NoError(t, err)
+	requireApproxEqual(eagerPool, lazyPool)
+
+	// no token is lost
+	require.Equal(t, eagerTotal.Add(eagerPool...), lazyTotal.Add(lazyPool...))
+}
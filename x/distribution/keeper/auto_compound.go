@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// autoCompoundBatchSize is the number of delegations loaded at once when
+// compounding rewards, so that the store is not written while iterated.
+const autoCompoundBatchSize = 100
+
+// HasAutoCompound returns whether the auto-compounding of the rewards of a
+// delegation is enabled.
+func (k Keeper) HasAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Has(types.GetAutoCompoundKey(delAddr, valAddr))
+}
+
+// SetAutoCompound enables the auto-compounding of the rewards of a delegation.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetAutoCompoundKey(delAddr, valAddr), []byte{})
+}
+
+// EnableAutoCompound enables the auto-compounding of the rewards of an
+// existing delegation. The delegator must be its own withdraw address, so that
+// the withdrawn rewards can be delegated.
+func (k Keeper) EnableAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.stakingKeeper.Delegation(sdkCtx, delAddr, valAddr) == nil {
+		return errorsmod.Wrapf(types.ErrNoDelegationExists, "delegator %s, validator %s", delAddr, valAddr)
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+	if !withdrawAddr.Equals(delAddr) {
+		return errorsmod.Wrapf(types.ErrAutoCompoundWithdrawAddr, "withdraw address %s", withdrawAddr)
+	}
+
+	return k.SetAutoCompound(ctx, delAddr, valAddr)
+}
+
+// DeleteAutoCompound disables the auto-compounding of the rewards of a
+// delegation.
+func (k Keeper) DeleteAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetAutoCompoundKey(delAddr, valAddr))
+}
+
+// IterateAutoCompounds iterates over the delegations with auto-compounding
+// enabled.
+func (k Keeper) IterateAutoCompounds(ctx context.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+	iter := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.AutoCompoundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := types.GetAutoCompoundAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// AutoCompoundRewards compounds the rewards of the delegations with
+// auto-compounding enabled. A round of compounding starts every
+// AutoCompoundInterval blocks and goes through the delegations in store order
+// until AutoCompoundMaxGas, the gas budget of a block, is consumed. An
+// unfinished round is resumed in the next block.
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	cursor, err := store.Get(types.AutoCompoundCursorKey)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundInterval == 0 {
+		// drop the unfinished round, if any, when auto-compounding is disabled
+		if cursor != nil {
+			return store.Delete(types.AutoCompoundCursorKey)
+		}
+		return nil
+	}
+
+	if cursor == nil {
+		if ctx.BlockHeight()%int64(params.AutoCompoundInterval) != 0 {
+			return nil
+		}
+		cursor = types.AutoCompoundPrefix
+	}
+
+	var gasUsed uint64
+	for {
+		keys, err := k.autoCompoundKeys(ctx, cursor, autoCompoundBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return store.Delete(types.AutoCompoundCursorKey)
+		}
+
+		for _, key := range keys {
+			if gasUsed >= params.AutoCompoundMaxGas {
+				return store.Set(types.AutoCompoundCursorKey, key)
+			}
+
+			delAddr, valAddr := types.GetAutoCompoundAddresses(key)
+			consumed, err := k.autoCompoundDelegation(ctx, delAddr, valAddr, params.AutoCompoundMaxGas-gasUsed)
+			if sdkerrors.ErrOutOfGas.Is(err) && gasUsed > 0 {
+				// retry the delegation with the whole gas limit in the next block
+				return store.Set(types.AutoCompoundCursorKey, key)
+			}
+			if err != nil {
+				k.Logger(ctx).Info("auto-compounding failed", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+			}
+
+			gasUsed += consumed
+		}
+
+		// resume after the last loaded key
+		cursor = append(bytes.Clone(keys[len(keys)-1]), 0x00)
+	}
+}
+
+// autoCompoundKeys returns up to limit keys of delegations with
+// auto-compounding enabled, starting from the given key.
+func (k Keeper) autoCompoundKeys(ctx context.Context, start []byte, limit int) ([][]byte, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(start, storetypes.PrefixEndBytes(types.AutoCompoundPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+
+	return keys, nil
+}
+
+// autoCompoundDelegation compounds the rewards of a delegation within the
+// given gas limit, and returns the gas consumed. The state is left unchanged
+// on failure.
+func (k Keeper) autoCompoundDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, gasLimit uint64) (gasUsed uint64, err error) {
+	// Caching context so that we don't update the store in case of failure.
+	cacheCtx, flush := ctx.CacheContext()
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = gasMeter.GasConsumedToLimit()
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+				return
+			}
+
+			err = errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+
+	amount, err := k.compoundRewards(cacheCtx.WithGasMeter(gasMeter), delAddr, valAddr)
+	if err != nil {
+		return gasMeter.GasConsumedToLimit(), err
+	}
+
+	flush()
+	if amount.IsPositive() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompound,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			),
+		)
+	}
+
+	return gasMeter.GasConsumedToLimit(), nil
+}
+
+// compoundRewards withdraws the rewards of a delegation and delegates the
+// rewards in the bond denom to the validator. The delegation is removed from
+// auto-compounding if it does not exist anymore, or if the delegator set
+// another withdraw address since auto-compounding was enabled. The delegation
+// is subject to the max validator power ratio of x/staking, like any other.
+func (k Keeper) compoundRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found || k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+		return sdk.NewCoin(bondDenom, math.ZeroInt()), k.DeleteAutoCompound(ctx, delAddr, valAddr)
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !withdrawAddr.Equals(delAddr) {
+		k.Logger(ctx).Info("auto-compounding disabled", "delegator", delAddr.String(), "validator", valAddr.String(), "withdraw_address", withdrawAddr.String())
+		return sdk.NewCoin(bondDenom, math.ZeroInt()), k.DeleteAutoCompound(ctx, delAddr, valAddr)
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	if amount.IsZero() {
+		return amount, nil
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return amount, nil
+}
@@ -88,6 +88,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, rec := range data.AutoCompoundRecords {
+		delegatorAddress, err := k.authKeeper.StringToBytes(rec.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(rec.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetAutoCompound(ctx, delegatorAddress, valAddr); err != nil {
+			panic(err)
+		}
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -109,6 +122,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	// settle the rewards allocated to the validators so that they are part of
+	// the exported outstanding rewards
+	if err := k.SettleAllValidatorRewards(ctx); err != nil {
+		panic(err)
+	}
+
 	feePool, err := k.GetFeePool(ctx)
 	if err != nil {
 		panic(err)
@@ -204,5 +223,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompounds := make([]types.AutoCompoundRecord, 0)
+	k.IterateAutoCompounds(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			autoCompounds = append(autoCompounds, types.AutoCompoundRecord{
+				DelegatorAddress: del.String(),
+				ValidatorAddress: val.String(),
+			})
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompounds)
 }
@@ -111,6 +111,11 @@ func (k Querier) ValidatorOutstandingRewards(c context.Context, req *types.Query
 		return nil, errors.Wrapf(types.ErrNoValidatorExists, valAdr.String())
 	}
 
+	// settle the rewards accrued since the last allocation to the validator
+	if err := k.SettleValidatorRewards(ctx, valAdr); err != nil {
+		return nil, err
+	}
+
 	rewards, err := k.GetValidatorOutstandingRewards(ctx, valAdr)
 	if err != nil {
 		return nil, err
@@ -140,6 +145,12 @@ func (k Querier) ValidatorCommission(c context.Context, req *types.QueryValidato
 	if validator == nil {
 		return nil, errors.Wrapf(types.ErrNoValidatorExists, valAdr.String())
 	}
+
+	// settle the rewards accrued since the last allocation to the validator
+	if err := k.SettleValidatorRewards(ctx, valAdr); err != nil {
+		return nil, err
+	}
+
 	commission, err := k.GetValidatorAccumulatedCommission(ctx, valAdr)
 	if err != nil {
 		return nil, err
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdkmath "cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -28,6 +29,11 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) er
 
 // AfterValidatorRemoved performs clean up after a validator is removed
 func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	// settle the last rewards of the validator, which go to the community pool
+	if err := h.k.SettleValidatorRewards(ctx, valAddr); err != nil {
+		return err
+	}
+
 	// fetch outstanding
 	outstanding, err := h.k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
 	if err != nil {
@@ -146,8 +152,9 @@ func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, f
 	return nil
 }
 
-func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
-	return nil
+// settle the rewards of the validator before its commission rate changes
+func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return h.k.SettleValidatorRewards(ctx, valAddr)
 }
 
 func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
@@ -158,10 +165,29 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// disable the auto-compounding of the removed delegation
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.DeleteAutoCompound(ctx, delAddr, valAddr)
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+func (h Hooks) AfterConsensusPubKeyUpdate(_ sdk.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
+	return nil
+}
+
+// BeforeTokenizeShareRecordOwnerChanged withdraws the pending rewards of the
+// tokenize share record delegation to the previous owner, and sets the new
+// owner as the withdraw address of the record so that the rewards of the
+// tokenized shares accrue to it.
+func (h Hooks) BeforeTokenizeShareRecordOwnerChanged(ctx sdk.Context, recordAddr sdk.AccAddress, valAddr sdk.ValAddress, owner sdk.AccAddress) error {
+	if del := h.k.stakingKeeper.Delegation(ctx, recordAddr, valAddr); del != nil {
+		if _, err := h.k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+			return err
+		}
+	}
+
+	return h.k.SetDelegatorWithdrawAddr(ctx, recordAddr, owner)
+}
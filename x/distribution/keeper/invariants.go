@@ -151,7 +151,13 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 			panic(err)
 		}
 
-		expectedInt, _ := expectedCoins.Add(communityPool...).TruncateDecimal()
+		// rewards allocated to the validators but not settled yet
+		acc, err := k.GetRewardsAccumulator(ctx)
+		if err != nil {
+			panic(err)
+		}
+
+		expectedInt, _ := expectedCoins.Add(communityPool...).Add(acc.Unsettled...).TruncateDecimal()
 
 		macc := k.GetDistributionAccount(ctx)
 		balances := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
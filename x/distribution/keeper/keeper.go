@@ -115,8 +115,41 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegations
+// of the tokenize share records owned by an address. The rewards are sent to
+// the withdraw address of the records, which is their owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range k.stakingKeeper.GetTokenizeShareRecordsByOwner(sdkCtx, owner) {
+		recordAddr := record.GetModuleAddress()
+		valAddr := record.GetValidator()
+
+		// records are removed along with their delegation, this only skips
+		// records whose delegation was fully slashed
+		if k.stakingKeeper.Delegation(sdkCtx, recordAddr, valAddr) == nil {
+			continue
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	// settle the rewards accrued since the last allocation to the validator
+	if err := k.SettleValidatorRewards(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
 	// fetch validator accumulated commission
 	accumCommission, err := k.GetValidatorAccumulatedCommission(ctx, valAddr)
 	if err != nil {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
@@ -75,6 +76,65 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount}, nil
 }
 
+func (k msgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	ownerAddress, err := k.authKeeper.StringToBytes(msg.OwnerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	amount, err := k.Keeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_tokenize_share_record_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
+
+func (k msgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.Enabled {
+		err = k.Keeper.EnableAutoCompound(ctx, delegatorAddress, valAddr)
+	} else {
+		err = k.DeleteAutoCompound(ctx, delegatorAddress, valAddr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
 	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if err != nil {
@@ -463,3 +463,126 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx context.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// get the hash of the last vote set
+func (k Keeper) GetVoteSetHash(ctx context.Context) ([]byte, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Get(types.VoteSetHashKey)
+}
+
+// set the hash of the last vote set
+func (k Keeper) SetVoteSetHash(ctx context.Context, hash []byte) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.VoteSetHashKey, hash)
+}
+
+// get the encoded addresses and voting powers of the last vote set
+func (k Keeper) GetLastVoteSet(ctx context.Context) ([]byte, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Get(types.LastVoteSetKey)
+}
+
+// set the encoded addresses and voting powers of the last vote set
+func (k Keeper) SetLastVoteSet(ctx context.Context, voteSet []byte) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if len(voteSet) == 0 {
+		return store.Delete(types.LastVoteSetKey)
+	}
+	return store.Set(types.LastVoteSetKey, voteSet)
+}
+
+// get the rewards allocated to the last vote set
+func (k Keeper) GetRewardsAccumulator(ctx context.Context) (acc types.RewardsAccumulator, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	b, err := store.Get(types.RewardsAccumulatorKey)
+	if err != nil {
+		return types.RewardsAccumulator{}, err
+	}
+
+	if b == nil {
+		return types.RewardsAccumulator{}, nil
+	}
+
+	err = k.cdc.Unmarshal(b, &acc)
+	return acc, err
+}
+
+// set the rewards allocated to the last vote set
+func (k Keeper) SetRewardsAccumulator(ctx context.Context, acc types.RewardsAccumulator) error {
+	store := k.storeService.OpenKVStore(ctx)
+	b, err := k.cdc.Marshal(&acc)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.RewardsAccumulatorKey, b)
+}
+
+// get the rewards checkpoint of a validator of the last vote set, with a zero
+// power if the validator is not part of it
+func (k Keeper) GetValidatorRewardsCheckpoint(ctx context.Context, consAddr sdk.ConsAddress) (checkpoint types.ValidatorRewardsCheckpoint, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	b, err := store.Get(types.GetValidatorRewardsCheckpointKey(consAddr))
+	if err != nil {
+		return types.ValidatorRewardsCheckpoint{}, err
+	}
+
+	if b == nil {
+		return types.ValidatorRewardsCheckpoint{}, nil
+	}
+
+	err = k.cdc.Unmarshal(b, &checkpoint)
+	return checkpoint, err
+}
+
+// set the rewards checkpoint of a validator of the last vote set
+func (k Keeper) SetValidatorRewardsCheckpoint(ctx context.Context, consAddr sdk.ConsAddress, checkpoint types.ValidatorRewardsCheckpoint) error {
+	store := k.storeService.OpenKVStore(ctx)
+	b, err := k.cdc.Marshal(&checkpoint)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetValidatorRewardsCheckpointKey(consAddr), b)
+}
+
+// delete the rewards checkpoint of a validator
+func (k Keeper) DeleteValidatorRewardsCheckpoint(ctx context.Context, consAddr sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetValidatorRewardsCheckpointKey(consAddr))
+}
+
+// iterate over the rewards checkpoints of the validators of the last vote set
+func (k Keeper) IterateValidatorRewardsCheckpoints(ctx context.Context, handler func(consAddr sdk.ConsAddress, checkpoint types.ValidatorRewardsCheckpoint) (stop bool)) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, types.ValidatorRewardsCheckpointPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var checkpoint types.ValidatorRewardsCheckpoint
+		k.cdc.MustUnmarshal(iter.Value(), &checkpoint)
+		consAddr := types.GetValidatorRewardsCheckpointAddress(iter.Key())
+		if handler(consAddr, checkpoint) {
+			break
+		}
+	}
+}
+
+// get the consensus address under which the rewards checkpoint of a validator
+// is stored, if any
+func (k Keeper) GetValidatorRewardsCheckpointConsAddr(ctx context.Context, val sdk.ValAddress) (sdk.ConsAddress, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Get(types.GetValidatorRewardsCheckpointConsAddrKey(val))
+}
+
+// set the consensus address under which the rewards checkpoint of a validator
+// is stored
+func (k Keeper) SetValidatorRewardsCheckpointConsAddr(ctx context.Context, val sdk.ValAddress, consAddr sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetValidatorRewardsCheckpointConsAddrKey(val), consAddr)
+}
+
+// delete the consensus address of the rewards checkpoint of a validator
+func (k Keeper) DeleteValidatorRewardsCheckpointConsAddr(ctx context.Context, val sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetValidatorRewardsCheckpointConsAddrKey(val))
+}
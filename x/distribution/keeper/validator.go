@@ -39,6 +39,11 @@ func (k Keeper) initializeValidator(ctx context.Context, val stakingtypes.Valida
 
 // increment validator period, returning the period just ended
 func (k Keeper) IncrementValidatorPeriod(ctx context.Context, val stakingtypes.ValidatorI) (uint64, error) {
+	// settle the rewards accrued since the last allocation to the validator
+	if err := k.SettleValidatorRewards(ctx, val.GetOperator()); err != nil {
+		return 0, err
+	}
+
 	// fetch current rewards
 	rewards, err := k.GetValidatorCurrentRewards(ctx, val.GetOperator())
 	if err != nil {
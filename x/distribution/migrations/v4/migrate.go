@@ -0,0 +1,39 @@
+package v4
+
+import (
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+const (
+	ModuleName = "distribution"
+)
+
+var (
+	VoteSetHashKey        = []byte{0x0C}
+	RewardsAccumulatorKey = []byte{0x0D}
+)
+
+// MigrateStore migrates the x/distribution module state from the consensus version 3 to
+// version 4. Specifically, it initializes the rewards accumulator, from which the rewards
+// of the validators are settled lazily. The rewards were allocated to the validators each
+// block up to version 3, so no reward is unsettled at the migration, and the voting power
+// of the validators is recorded in the next block.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+
+	bz, err := cdc.Marshal(&types.RewardsAccumulator{})
+	if err != nil {
+		return err
+	}
+
+	if err := store.Set(RewardsAccumulatorKey, bz); err != nil {
+		return err
+	}
+
+	// make sure the vote set of the next block is recorded
+	return store.Delete(VoteSetHashKey)
+}
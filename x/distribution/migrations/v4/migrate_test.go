@@ -0,0 +1,35 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	v4 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v4.ModuleName)
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	store.Set(v4.VoteSetHashKey, []byte("hash"))
+	require.NoError(t, v4.MigrateStore(ctx, storeService, cdc))
+
+	var res types.RewardsAccumulator
+	bz := store.Get(v4.RewardsAccumulatorKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.True(t, res.RewardsPerPower.IsZero())
+	require.True(t, res.Unsettled.IsZero())
+	require.False(t, store.Has(v4.VoteSetHashKey))
+}
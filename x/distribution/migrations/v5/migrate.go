@@ -0,0 +1,44 @@
+package v5
+
+import (
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+const (
+	ModuleName = "distribution"
+)
+
+var ParamsKey = []byte{0x09}
+
+// MigrateStore migrates the x/distribution module state from the consensus version 4 to
+// version 5. Specifically, it sets the auto-compounding params, which did not exist up to
+// version 4, to their default values.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	bz, err := store.Get(ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.AutoCompoundInterval = types.DefaultAutoCompoundInterval
+	params.AutoCompoundMaxGas = types.DefaultAutoCompoundMaxGas
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(ParamsKey, bz)
+}
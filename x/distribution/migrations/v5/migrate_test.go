@@ -0,0 +1,44 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	v5 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v5.ModuleName)
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// the params stored up to version 4 lack the auto-compounding params
+	legacyParams := types.Params{
+		CommunityTax:        sdkmath.LegacyNewDecWithPrec(5, 2),
+		BaseProposerReward:  sdkmath.LegacyZeroDec(),
+		BonusProposerReward: sdkmath.LegacyZeroDec(),
+		WithdrawAddrEnabled: false,
+	}
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&legacyParams))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeService, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &res))
+	require.Equal(t, legacyParams.CommunityTax, res.CommunityTax)
+	require.False(t, res.WithdrawAddrEnabled)
+	require.Equal(t, types.DefaultAutoCompoundInterval, res.AutoCompoundInterval)
+	require.Equal(t, types.DefaultAutoCompoundMaxGas, res.AutoCompoundMaxGas)
+}
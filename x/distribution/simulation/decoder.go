@@ -63,6 +63,26 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundPrefix):
+			delA, valA := types.GetAutoCompoundAddresses(kvA.Key)
+			delB, valB := types.GetAutoCompoundAddresses(kvB.Key)
+			return fmt.Sprintf("%v %v\n%v %v", delA, valA, delB, valB)
+
+		case bytes.Equal(kvA.Key[:1], types.VoteSetHashKey), bytes.Equal(kvA.Key[:1], types.LastVoteSetKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.RewardsAccumulatorKey):
+			var accA, accB types.RewardsAccumulator
+			cdc.MustUnmarshal(kvA.Value, &accA)
+			cdc.MustUnmarshal(kvB.Value, &accB)
+			return fmt.Sprintf("%v\n%v", accA, accB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorRewardsCheckpointPrefix):
+			var checkpointA, checkpointB types.ValidatorRewardsCheckpoint
+			cdc.MustUnmarshal(kvA.Value, &checkpointA)
+			cdc.MustUnmarshal(kvB.Value, &checkpointB)
+			return fmt.Sprintf("%v\n%v", checkpointA, checkpointB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
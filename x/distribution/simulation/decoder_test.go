@@ -38,6 +38,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, math.LegacyOneDec())
+	rewardsAccumulator := types.RewardsAccumulator{RewardsPerPower: decCoins, Unsettled: decCoins}
+	checkpoint := types.ValidatorRewardsCheckpoint{ValidatorAddress: valAddr1.String(), Power: 10, RewardsPerPower: decCoins}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -50,6 +52,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.RewardsAccumulatorKey, Value: cdc.MustMarshal(&rewardsAccumulator)},
+			{Key: types.GetValidatorRewardsCheckpointKey(consAddr1), Value: cdc.MustMarshal(&checkpoint)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -67,6 +71,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"RewardsAccumulator", fmt.Sprintf("%v\n%v", rewardsAccumulator, rewardsAccumulator)},
+		{"ValidatorRewardsCheckpoint", fmt.Sprintf("%v\n%v", checkpoint, checkpoint)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
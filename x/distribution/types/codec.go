@@ -24,6 +24,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "cosmos-sdk/distr/MsgCommunityPoolSpend")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTokenizeReward")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "cosmos-sdk/MsgSetAutoCompound")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params", nil)
 }
@@ -38,6 +40,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgCommunityPoolSpend{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgWithdrawTokenizeShareRecordReward{},
+		&MsgSetAutoCompound{},
 	)
 
 	registry.RegisterImplementations(
@@ -37,6 +37,19 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_interval defines the number of blocks between two
+	// compoundings of the rewards of the delegations with auto-compounding
+	// enabled. Zero disables auto-compounding.
+	//
+	// Since: cosmos-sdk 0.48
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// auto_compound_max_gas defines the gas budget of the compounding of rewards
+	// in a block. It is consumed without fees, on top of the block gas limit. The
+	// delegations which could not be compounded within this budget are
+	// compounded in the next blocks.
+	//
+	// Since: cosmos-sdk 0.48
+	AutoCompoundMaxGas uint64 `protobuf:"varint,6,opt,name=auto_compound_max_gas,json=autoCompoundMaxGas,proto3" json:"auto_compound_max_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +92,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundMaxGas() uint64 {
+	if m != nil {
+		return m.AutoCompoundMaxGas
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -622,6 +649,130 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// RewardsAccumulator tracks the rewards allocated to the validators of the
+// last vote set which are not settled to them yet.
+//
+// Since: cosmos-sdk 0.48
+type RewardsAccumulator struct {
+	// rewards_per_power is the cumulative reward allocated per unit of voting
+	// power.
+	RewardsPerPower github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards_per_power,json=rewardsPerPower,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_power"`
+	// unsettled is the total of the allocated rewards not settled yet.
+	Unsettled github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=unsettled,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"unsettled"`
+}
+
+func (m *RewardsAccumulator) Reset()         { *m = RewardsAccumulator{} }
+func (m *RewardsAccumulator) String() string { return proto.CompactTextString(m) }
+func (*RewardsAccumulator) ProtoMessage()    {}
+func (*RewardsAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *RewardsAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsAccumulator.Merge(m, src)
+}
+func (m *RewardsAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsAccumulator proto.InternalMessageInfo
+
+func (m *RewardsAccumulator) GetRewardsPerPower() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerPower
+	}
+	return nil
+}
+
+func (m *RewardsAccumulator) GetUnsettled() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Unsettled
+	}
+	return nil
+}
+
+// ValidatorRewardsCheckpoint represents the voting power of a validator of the
+// last vote set, and the cumulative reward per unit of voting power up to
+// which its rewards are settled.
+//
+// Since: cosmos-sdk 0.48
+type ValidatorRewardsCheckpoint struct {
+	ValidatorAddress string                                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Power            int64                                       `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	RewardsPerPower  github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=rewards_per_power,json=rewardsPerPower,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_power"`
+}
+
+func (m *ValidatorRewardsCheckpoint) Reset()         { *m = ValidatorRewardsCheckpoint{} }
+func (m *ValidatorRewardsCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardsCheckpoint) ProtoMessage()    {}
+func (*ValidatorRewardsCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *ValidatorRewardsCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardsCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardsCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsCheckpoint.Merge(m, src)
+}
+func (m *ValidatorRewardsCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardsCheckpoint proto.InternalMessageInfo
+
+func (m *ValidatorRewardsCheckpoint) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRewardsCheckpoint) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *ValidatorRewardsCheckpoint) GetRewardsPerPower() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerPower
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -635,6 +786,8 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*RewardsAccumulator)(nil), "cosmos.distribution.v1beta1.RewardsAccumulator")
+	proto.RegisterType((*ValidatorRewardsCheckpoint)(nil), "cosmos.distribution.v1beta1.ValidatorRewardsCheckpoint")
 }
 
 func init() {
@@ -642,70 +795,79 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x1d, 0x56, 0x2a,
-	0x84, 0x40, 0x6c, 0x52, 0x2e, 0x28, 0xe2, 0x12, 0xdb, 0xad, 0xe0, 0x42, 0xa3, 0x0d, 0xa2, 0x88,
-	0xcb, 0x6a, 0xbc, 0x3b, 0xb1, 0x47, 0xdd, 0x9d, 0x59, 0x66, 0x66, 0x9d, 0xe4, 0xc0, 0x3d, 0x70,
-	0x00, 0x6e, 0xa0, 0x9e, 0x2a, 0xb8, 0x54, 0x9c, 0x72, 0xc8, 0x8d, 0x7f, 0xa0, 0xe2, 0x54, 0x15,
-	0x09, 0x21, 0x0e, 0x29, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x84, 0x52,
-	0xa9, 0xb5, 0xb8, 0x24, 0x9e, 0xef, 0xcd, 0xbe, 0xef, 0x7d, 0xef, 0xbd, 0x79, 0x33, 0xb0, 0xe6,
-	0x73, 0x19, 0x71, 0x59, 0x0f, 0xa8, 0x54, 0x82, 0xb6, 0x13, 0x45, 0x39, 0xab, 0xf7, 0x56, 0xda,
-	0x44, 0xe1, 0x95, 0x13, 0x60, 0x2d, 0x16, 0x5c, 0x71, 0x74, 0xd5, 0xec, 0xaf, 0x9d, 0x30, 0xd9,
-	0xfd, 0xe5, 0x99, 0x0e, 0xef, 0xf0, 0x74, 0x5f, 0x5d, 0xff, 0x32, 0x9f, 0x94, 0x2b, 0x96, 0xa2,
-	0x8d, 0x25, 0xc9, 0x5c, 0xfb, 0x9c, 0x5a, 0x97, 0xe5, 0x79, 0x63, 0xf7, 0xcc, 0x87, 0xd6, 0xbf,
-	0x31, 0x5d, 0xc6, 0x11, 0x65, 0xbc, 0x9e, 0xfe, 0x35, 0x90, 0xf3, 0xe3, 0x08, 0x2c, 0xae, 0x63,
-	0x81, 0x23, 0x89, 0x36, 0xe1, 0x25, 0x9f, 0x47, 0x51, 0xc2, 0xa8, 0xda, 0xf1, 0x14, 0xde, 0x2e,
-	0x81, 0x05, 0xb0, 0x38, 0xd1, 0x58, 0x7b, 0x78, 0x50, 0x2d, 0xfc, 0x76, 0x50, 0x7d, 0xb5, 0x43,
-	0x55, 0x37, 0x69, 0xd7, 0x7c, 0x1e, 0x59, 0xaf, 0xf6, 0xdf, 0xb2, 0x0c, 0xee, 0xd6, 0xd5, 0x4e,
-	0x4c, 0x64, 0xad, 0x45, 0xfc, 0xc7, 0xfb, 0xcb, 0xd0, 0x92, 0xb6, 0x88, 0xff, 0xe0, 0x78, 0x6f,
-	0x09, 0xb8, 0x17, 0x33, 0xbf, 0x1f, 0xe2, 0x6d, 0x94, 0xc0, 0x19, 0x1d, 0xbb, 0x0e, 0x30, 0xe6,
-	0x92, 0x08, 0x4f, 0x90, 0x2d, 0x2c, 0x82, 0xd2, 0xb9, 0x94, 0xae, 0xf9, 0xdc, 0x74, 0x25, 0xe0,
-	0x22, 0x4d, 0xb0, 0x6e, 0xfd, 0xbb, 0xa9, 0x7b, 0xb4, 0x05, 0x67, 0xdb, 0x9c, 0x25, 0xf2, 0x1f,
-	0xbc, 0x23, 0x2f, 0x8e, 0x77, 0x3a, 0x65, 0x38, 0x45, 0x7c, 0x03, 0xce, 0x6e, 0x51, 0xd5, 0x0d,
-	0x04, 0xde, 0xf2, 0x70, 0x10, 0x08, 0x8f, 0x30, 0xdc, 0x0e, 0x49, 0x50, 0x1a, 0x5d, 0x00, 0x8b,
-	0xe7, 0xdd, 0xe9, 0xbe, 0x71, 0x2d, 0x08, 0xc4, 0x4d, 0x63, 0x5a, 0xbd, 0xfe, 0xc5, 0xf1, 0xde,
-	0xd2, 0xc2, 0x00, 0xf1, 0xf6, 0xc9, 0x96, 0x32, 0x25, 0x73, 0x7e, 0x01, 0xb0, 0xfc, 0x11, 0x0e,
-	0x69, 0x80, 0x15, 0x17, 0xef, 0x51, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0xb1, 0x44, 0x5f, 0x02,
-	0x78, 0xc5, 0x4f, 0xa2, 0x24, 0xc4, 0x8a, 0xf6, 0x88, 0xd5, 0xeb, 0x09, 0xac, 0x28, 0x2f, 0x81,
-	0x85, 0x91, 0xc5, 0x0b, 0x37, 0xae, 0xd9, 0x86, 0xad, 0xe9, 0x84, 0xf5, 0x1b, 0x4f, 0x2b, 0x6a,
-	0x72, 0xca, 0x1a, 0xef, 0xe8, 0x9c, 0xfc, 0xf0, 0xa4, 0xfa, 0xc6, 0xb3, 0xe5, 0x44, 0x7f, 0x23,
-	0x4d, 0xc5, 0x67, 0x73, 0x5a, 0x13, 0x8c, 0xab, 0x49, 0xd1, 0x6b, 0x70, 0x4a, 0x90, 0x4d, 0x22,
-	0x08, 0xf3, 0x89, 0xe7, 0xf3, 0x84, 0xa9, 0xb4, 0xea, 0x97, 0xdc, 0xc9, 0x0c, 0x6e, 0x6a, 0xd4,
-	0xf9, 0x1e, 0xc0, 0x2b, 0x99, 0xb0, 0x66, 0x22, 0x04, 0x61, 0xaa, 0xaf, 0x2a, 0x86, 0xe3, 0x46,
-	0x89, 0x1c, 0xb2, 0x88, 0x3e, 0x0d, 0x9a, 0x83, 0xc5, 0x98, 0x08, 0xca, 0x4d, 0x8f, 0x8e, 0xba,
-	0x76, 0xe5, 0x7c, 0x0b, 0x60, 0x25, 0x8b, 0x72, 0xcd, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51, 0x44,
-	0xa5, 0xa4, 0x9c, 0xa1, 0x1e, 0x84, 0x7e, 0xb6, 0x1a, 0x72, 0xbc, 0x03, 0x4c, 0xce, 0x57, 0x00,
-	0x5e, 0xcd, 0x42, 0xbb, 0x9d, 0x28, 0xa9, 0x30, 0x0b, 0x28, 0xeb, 0xfc, 0x6f, 0x49, 0x74, 0xee,
-	0x01, 0x38, 0x9d, 0x45, 0xb4, 0x11, 0x62, 0xd9, 0xbd, 0xd9, 0x23, 0x4c, 0xa1, 0xd7, 0xe1, 0x4b,
-	0xbd, 0x3e, 0xec, 0xd9, 0x34, 0x83, 0x34, 0xcd, 0x53, 0x19, 0xbe, 0x9e, 0xc2, 0xe8, 0x63, 0x78,
-	0x7e, 0x53, 0x60, 0x5f, 0x9f, 0x00, 0x3b, 0x2d, 0xde, 0x7d, 0x9e, 0x53, 0xeb, 0x66, 0xde, 0x9c,
-	0xcf, 0x01, 0x9c, 0x39, 0x23, 0x38, 0x89, 0x3e, 0x85, 0x73, 0x79, 0x74, 0x52, 0x1b, 0x3c, 0x92,
-	0x5a, 0x6c, 0xda, 0xde, 0xaa, 0x3d, 0x65, 0x82, 0xd7, 0xce, 0x70, 0xd9, 0x98, 0xd0, 0x21, 0x9b,
-	0xdc, 0xcc, 0xf4, 0xce, 0xa0, 0x74, 0x76, 0x01, 0x1c, 0xbf, 0x45, 0xc8, 0x3a, 0xe7, 0x21, 0xfa,
-	0x0c, 0x4e, 0xe6, 0x33, 0x39, 0xe6, 0x3c, 0x1c, 0x72, 0xb5, 0xf2, 0x1b, 0x40, 0xd3, 0x3b, 0xdf,
-	0x9c, 0x83, 0xe5, 0xe6, 0x20, 0xb2, 0x11, 0x13, 0x16, 0x98, 0xf9, 0x86, 0x43, 0x34, 0x03, 0xc7,
-	0x14, 0x55, 0x21, 0x31, 0x37, 0x85, 0x6b, 0x16, 0x68, 0x01, 0x5e, 0x08, 0x88, 0xf4, 0x05, 0x8d,
-	0xf3, 0x42, 0xb9, 0x83, 0x10, 0xba, 0x06, 0x27, 0x04, 0xf1, 0x69, 0x4c, 0x09, 0x53, 0x66, 0xfc,
-	0xba, 0x39, 0x80, 0x76, 0x60, 0x11, 0x47, 0xe9, 0x6c, 0x18, 0x4d, 0xb5, 0xce, 0x9f, 0xa9, 0x35,
-	0x15, 0x7a, 0xcb, 0x0a, 0x5d, 0x7c, 0x06, 0xa1, 0xa9, 0xca, 0x7b, 0xc7, 0x7b, 0x4b, 0x17, 0x43,
-	0xd2, 0xc1, 0xfe, 0x8e, 0xe7, 0xe7, 0xb2, 0x2d, 0xe1, 0xea, 0xe2, 0xee, 0xfd, 0x6a, 0xe1, 0xcf,
-	0xfb, 0xd5, 0xc2, 0x4f, 0xfb, 0xcb, 0x65, 0xcb, 0xda, 0xe1, 0xbd, 0x01, 0x52, 0xa6, 0x74, 0xcc,
-	0xc0, 0x79, 0x02, 0xe0, 0x6c, 0x8b, 0x68, 0x4f, 0xba, 0x7a, 0x0a, 0x0b, 0x45, 0x59, 0xe7, 0x7d,
-	0xb6, 0x99, 0xce, 0xb8, 0x58, 0x90, 0x1e, 0xe5, 0xfa, 0xaa, 0x19, 0x6c, 0xe7, 0xc9, 0x3e, 0x6c,
-	0xbb, 0xf9, 0x0e, 0x1c, 0x93, 0x0a, 0xdf, 0x25, 0xb6, 0x95, 0x5f, 0xc0, 0x3d, 0x6b, 0xfc, 0xa1,
-	0x16, 0x2c, 0x76, 0x09, 0xed, 0x74, 0x4d, 0x6e, 0x47, 0x1b, 0x6f, 0xfe, 0x75, 0x50, 0x9d, 0xf2,
-	0x05, 0xd1, 0x23, 0x98, 0x79, 0xc6, 0xf4, 0xdd, 0xf1, 0xde, 0xd2, 0x69, 0xcc, 0xe6, 0xc2, 0x2c,
-	0x9c, 0x3f, 0x00, 0x9c, 0xb7, 0x0a, 0x29, 0x67, 0x99, 0x56, 0x7b, 0xa9, 0x7d, 0x00, 0x2f, 0xe7,
-	0xe7, 0x42, 0xdf, 0x6a, 0x44, 0x4a, 0xfb, 0x60, 0x78, 0xe5, 0xf1, 0xfe, 0xf2, 0xcb, 0x36, 0xb4,
-	0x7c, 0x3a, 0x9a, 0x2d, 0x1b, 0x4a, 0xe8, 0x21, 0x94, 0x9f, 0x78, 0x8b, 0x23, 0x06, 0x8b, 0xd9,
-	0x33, 0x60, 0x98, 0x0d, 0x6e, 0x59, 0x56, 0x47, 0x75, 0xa5, 0x9d, 0x9f, 0x01, 0xbc, 0xfe, 0xef,
-	0xfd, 0x7d, 0x87, 0xaa, 0x6e, 0x8b, 0xc4, 0x5c, 0x52, 0x35, 0xa4, 0x56, 0x9f, 0x1b, 0x68, 0x75,
-	0x6d, 0xb2, 0x2b, 0x54, 0x82, 0xe3, 0x81, 0x21, 0x2e, 0x8d, 0xa5, 0x86, 0xfe, 0x72, 0xd5, 0xd9,
-	0xfd, 0xcf, 0xee, 0x6c, 0xdc, 0x7e, 0x70, 0x58, 0x01, 0x0f, 0x0f, 0x2b, 0xe0, 0xd1, 0x61, 0x05,
-	0xfc, 0x7e, 0x58, 0x01, 0x5f, 0x1f, 0x55, 0x0a, 0x8f, 0x8e, 0x2a, 0x85, 0x5f, 0x8f, 0x2a, 0x85,
-	0x4f, 0x56, 0x9e, 0x9a, 0xb3, 0x53, 0xef, 0x8c, 0x34, 0x85, 0xed, 0x62, 0xfa, 0x56, 0x7c, 0xfb,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xcc, 0x9a, 0x24, 0xde, 0x0a, 0x00, 0x00,
+	// 1143 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x24, 0x8e, 0xdb, 0x4c, 0x7f, 0xa4, 0x9d, 0x3a, 0xa9, 0xeb, 0x16, 0xdb, 0xac, 0x54,
+	0x30, 0x81, 0x38, 0xa4, 0x70, 0x40, 0x11, 0x97, 0xc6, 0x69, 0xa1, 0x07, 0xa8, 0xb5, 0x45, 0x14,
+	0x71, 0x59, 0x8d, 0x77, 0x27, 0xf6, 0xa8, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x1c, 0xb8, 0x70,
+	0xa1, 0x70, 0xa0, 0xdc, 0x40, 0x3d, 0x55, 0xc0, 0xa1, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53,
+	0x55, 0x24, 0x84, 0x38, 0xa4, 0x90, 0x1c, 0x82, 0xf8, 0x2b, 0xd0, 0xec, 0x8c, 0xd7, 0x4e, 0x48,
+	0x4b, 0xa5, 0xc6, 0x70, 0x49, 0x32, 0xef, 0xed, 0xbc, 0xef, 0x7d, 0x6f, 0xbe, 0x79, 0x6f, 0x02,
+	0x1b, 0x3e, 0x97, 0x11, 0x97, 0xf3, 0x01, 0x95, 0x4a, 0xd0, 0x76, 0xa2, 0x28, 0x67, 0xf3, 0xbd,
+	0x85, 0x36, 0x51, 0x78, 0x61, 0x8f, 0xb1, 0x11, 0x0b, 0xae, 0x38, 0x3a, 0x6f, 0xbe, 0x6f, 0xec,
+	0x71, 0xd9, 0xef, 0xcb, 0xc5, 0x0e, 0xef, 0xf0, 0xf4, 0xbb, 0x79, 0xfd, 0x97, 0xd9, 0x52, 0xae,
+	0x58, 0x88, 0x36, 0x96, 0x24, 0x0b, 0xed, 0x73, 0x6a, 0x43, 0x96, 0xcf, 0x19, 0xbf, 0x67, 0x36,
+	0xda, 0xf8, 0xc6, 0x75, 0x1a, 0x47, 0x94, 0xf1, 0xf9, 0xf4, 0xa7, 0x31, 0x39, 0x3f, 0xe4, 0x61,
+	0xa1, 0x85, 0x05, 0x8e, 0x24, 0x5a, 0x81, 0x27, 0x7c, 0x1e, 0x45, 0x09, 0xa3, 0x6a, 0xdd, 0x53,
+	0x78, 0xad, 0x04, 0x6a, 0xa0, 0x3e, 0xb9, 0x74, 0xf9, 0xc1, 0x56, 0x35, 0xf7, 0xdb, 0x56, 0xf5,
+	0xa5, 0x0e, 0x55, 0xdd, 0xa4, 0xdd, 0xf0, 0x79, 0x64, 0xa3, 0xda, 0x5f, 0x73, 0x32, 0xb8, 0x35,
+	0xaf, 0xd6, 0x63, 0x22, 0x1b, 0xcb, 0xc4, 0x7f, 0xb4, 0x39, 0x07, 0x2d, 0xe8, 0x32, 0xf1, 0xef,
+	0xef, 0x6e, 0xcc, 0x02, 0xf7, 0x78, 0x16, 0xf7, 0x03, 0xbc, 0x86, 0x12, 0x58, 0xd4, 0xb9, 0xeb,
+	0x04, 0x63, 0x2e, 0x89, 0xf0, 0x04, 0x59, 0xc5, 0x22, 0x28, 0x8d, 0xa5, 0x70, 0xcd, 0xe7, 0x86,
+	0x2b, 0x01, 0x17, 0x69, 0x80, 0x96, 0x8d, 0xef, 0xa6, 0xe1, 0xd1, 0x2a, 0x9c, 0x6e, 0x73, 0x96,
+	0xc8, 0x7f, 0xe0, 0x8e, 0x1f, 0x1e, 0xee, 0x99, 0x14, 0x61, 0x1f, 0xf0, 0x25, 0x38, 0xbd, 0x4a,
+	0x55, 0x37, 0x10, 0x78, 0xd5, 0xc3, 0x41, 0x20, 0x3c, 0xc2, 0x70, 0x3b, 0x24, 0x41, 0x29, 0x5f,
+	0x03, 0xf5, 0xa3, 0xee, 0x99, 0xbe, 0xf3, 0x72, 0x10, 0x88, 0x2b, 0xc6, 0x85, 0xde, 0x84, 0x33,
+	0x38, 0x51, 0xdc, 0xf3, 0x79, 0x14, 0xf3, 0x84, 0x05, 0x1e, 0x65, 0x8a, 0x88, 0x1e, 0x0e, 0x4b,
+	0x13, 0x35, 0x50, 0xcf, 0xbb, 0x45, 0xed, 0x6d, 0x5a, 0xe7, 0x35, 0xeb, 0x43, 0x0b, 0x70, 0x7a,
+	0xef, 0xae, 0x08, 0xaf, 0x79, 0x1d, 0x2c, 0x4b, 0x85, 0x74, 0x13, 0x1a, 0xde, 0xf4, 0x1e, 0x5e,
+	0x7b, 0x07, 0xcb, 0xc5, 0x8b, 0x5f, 0xee, 0x6e, 0xcc, 0xd6, 0x86, 0x18, 0xae, 0xed, 0xd5, 0xae,
+	0xd1, 0x86, 0xf3, 0x0b, 0x80, 0xe5, 0x0f, 0x71, 0x48, 0x03, 0xac, 0xb8, 0x78, 0x97, 0x4a, 0xc5,
+	0x05, 0xf5, 0x71, 0x68, 0x18, 0x4a, 0xf4, 0x15, 0x80, 0x67, 0xfd, 0x24, 0x4a, 0x42, 0xac, 0x68,
+	0x8f, 0xd8, 0xc2, 0x7a, 0x02, 0x2b, 0xca, 0x4b, 0xa0, 0x36, 0x5e, 0x3f, 0x76, 0xe9, 0x82, 0xbd,
+	0x19, 0x0d, 0x7d, 0x32, 0x7d, 0x85, 0xeb, 0xd2, 0x35, 0x39, 0x65, 0x4b, 0x6f, 0xe9, 0xe2, 0xff,
+	0xf8, 0xb8, 0xfa, 0xea, 0xb3, 0x15, 0x5f, 0xef, 0x91, 0x46, 0x5a, 0xd3, 0x03, 0x58, 0x93, 0x8c,
+	0xab, 0x41, 0xd1, 0xcb, 0x70, 0x4a, 0x90, 0x15, 0x22, 0x08, 0xf3, 0x89, 0xe7, 0xf3, 0x84, 0xa9,
+	0x54, 0x5e, 0x27, 0xdc, 0x93, 0x99, 0xb9, 0xa9, 0xad, 0xce, 0xf7, 0x00, 0x9e, 0xcd, 0x88, 0x35,
+	0x13, 0x21, 0x08, 0x53, 0x7d, 0x56, 0x31, 0x3c, 0x62, 0x98, 0xc8, 0x11, 0x93, 0xe8, 0xc3, 0xa0,
+	0x19, 0x58, 0x88, 0x89, 0xa0, 0xdc, 0x5c, 0x86, 0xbc, 0x6b, 0x57, 0xce, 0xb7, 0x00, 0x56, 0xb2,
+	0x2c, 0x2f, 0xfb, 0x96, 0x33, 0x09, 0x9a, 0x3c, 0x8a, 0xa8, 0x94, 0x94, 0x33, 0xd4, 0x83, 0xd0,
+	0xcf, 0x56, 0x23, 0xce, 0x77, 0x08, 0xc9, 0xb9, 0x03, 0xe0, 0xf9, 0x2c, 0xb5, 0xeb, 0x89, 0x92,
+	0x0a, 0xb3, 0x80, 0xb2, 0xce, 0xff, 0x56, 0x44, 0xe7, 0x2e, 0x80, 0x67, 0xb2, 0x8c, 0x6e, 0x84,
+	0x58, 0x76, 0xaf, 0xf4, 0x08, 0x53, 0xe8, 0x15, 0x78, 0xaa, 0xd7, 0x37, 0x7b, 0xb6, 0xcc, 0x20,
+	0x2d, 0xf3, 0x54, 0x66, 0x6f, 0xa5, 0x66, 0xf4, 0x11, 0x3c, 0xba, 0x22, 0xb0, 0xaf, 0x6f, 0x80,
+	0x6d, 0x4b, 0x6f, 0x3f, 0x4f, 0x7b, 0x70, 0xb3, 0x68, 0xce, 0x17, 0x00, 0x16, 0x0f, 0x48, 0x4e,
+	0xa2, 0x4f, 0xe0, 0xcc, 0x20, 0x3b, 0xa9, 0x1d, 0x1e, 0x49, 0x3d, 0xb6, 0x6c, 0xaf, 0x37, 0x9e,
+	0x32, 0x2a, 0x1a, 0x07, 0x84, 0x5c, 0x9a, 0xd4, 0x29, 0x9b, 0xda, 0x14, 0x7b, 0x07, 0x40, 0x3a,
+	0xb7, 0x01, 0x3c, 0x72, 0x95, 0x90, 0x16, 0xe7, 0x21, 0xfa, 0x14, 0x9e, 0x1c, 0x34, 0xff, 0x98,
+	0xf3, 0x70, 0xc4, 0xa7, 0x35, 0x18, 0x35, 0x1a, 0xde, 0xf9, 0x66, 0x0c, 0x96, 0x9b, 0xc3, 0x96,
+	0x1b, 0x31, 0x61, 0x81, 0x69, 0xa4, 0x38, 0x44, 0x45, 0x38, 0xa1, 0xa8, 0x0a, 0x89, 0x19, 0x49,
+	0xae, 0x59, 0xa0, 0x1a, 0x3c, 0x16, 0x10, 0xe9, 0x0b, 0x1a, 0x0f, 0x0e, 0xca, 0x1d, 0x36, 0xa1,
+	0x0b, 0x70, 0x52, 0x10, 0x9f, 0xc6, 0x94, 0x30, 0x65, 0xfa, 0xbc, 0x3b, 0x30, 0xa0, 0x75, 0x58,
+	0xc0, 0x51, 0xda, 0x1b, 0xf2, 0x29, 0xd7, 0x73, 0x07, 0x72, 0x4d, 0x89, 0x5e, 0xb5, 0x44, 0xeb,
+	0xcf, 0x40, 0x34, 0x65, 0x79, 0x77, 0x77, 0x63, 0xf6, 0x78, 0x48, 0x3a, 0xd8, 0x5f, 0xf7, 0xfc,
+	0x01, 0x6d, 0x0b, 0xb8, 0x58, 0xbf, 0x7d, 0xaf, 0x9a, 0xfb, 0xf3, 0x5e, 0x35, 0xf7, 0xd3, 0xe6,
+	0x5c, 0xd9, 0xa2, 0x76, 0x78, 0x6f, 0x08, 0x94, 0x29, 0x9d, 0x33, 0x70, 0x1e, 0x03, 0x38, 0xbd,
+	0x4c, 0x74, 0x24, 0x7d, 0x7a, 0x0a, 0x0b, 0x45, 0x59, 0xe7, 0x1a, 0x5b, 0x49, 0x7b, 0x5c, 0x2c,
+	0x48, 0x8f, 0x72, 0x3d, 0xd3, 0x86, 0xe5, 0x7c, 0xb2, 0x6f, 0xb6, 0x6a, 0xbe, 0x09, 0x27, 0xa4,
+	0xc2, 0xb7, 0x48, 0x69, 0xec, 0xb0, 0x06, 0xba, 0x89, 0x87, 0x96, 0x61, 0xa1, 0x4b, 0x68, 0xa7,
+	0x6b, 0x6a, 0x9b, 0x5f, 0x7a, 0xed, 0xaf, 0xad, 0xea, 0x94, 0x2f, 0x88, 0x6e, 0xc1, 0xcc, 0x33,
+	0xae, 0xef, 0x76, 0x37, 0x66, 0xf7, 0xdb, 0x6c, 0x2d, 0xcc, 0xc2, 0xf9, 0x03, 0xc0, 0x73, 0x96,
+	0x21, 0xe5, 0x2c, 0xe3, 0x6a, 0xa7, 0xe7, 0xfb, 0xf0, 0xf4, 0xe0, 0x5e, 0xe8, 0xf1, 0x49, 0xa4,
+	0xb4, 0x2f, 0x93, 0x17, 0x1f, 0x6d, 0xce, 0xbd, 0x60, 0x53, 0x1b, 0x74, 0x47, 0xf3, 0xc9, 0x0d,
+	0x25, 0x74, 0x13, 0x3a, 0xd5, 0xdb, 0x67, 0x47, 0x0c, 0x16, 0xb2, 0xf7, 0xc6, 0x28, 0x05, 0x6e,
+	0x51, 0x16, 0xf3, 0xfa, 0xa4, 0x9d, 0x9f, 0x01, 0xbc, 0xf8, 0x64, 0x7d, 0xdf, 0xa4, 0xaa, 0xbb,
+	0x4c, 0x62, 0x2e, 0xa9, 0x1a, 0x91, 0xd4, 0x67, 0x86, 0xa4, 0xae, 0x5d, 0x76, 0x85, 0x4a, 0xf0,
+	0x48, 0x60, 0x80, 0xd3, 0x87, 0xc5, 0xa4, 0xdb, 0x5f, 0x2e, 0x3a, 0xb7, 0xff, 0x55, 0x9d, 0xce,
+	0x9d, 0x31, 0x88, 0x6c, 0x9f, 0xcf, 0x86, 0x12, 0x17, 0xe8, 0x33, 0x00, 0x4f, 0xdb, 0x66, 0xac,
+	0x85, 0xe9, 0xc5, 0x7c, 0x95, 0x88, 0x11, 0xf7, 0x93, 0x29, 0x0b, 0xd8, 0x22, 0xa2, 0xa5, 0xe1,
+	0x90, 0x82, 0x93, 0x09, 0x93, 0x44, 0xa9, 0x90, 0x8c, 0xfa, 0xa8, 0x07, 0x40, 0xce, 0xe7, 0x63,
+	0x43, 0xef, 0x24, 0x5b, 0x9a, 0x66, 0x97, 0xf8, 0xb7, 0x62, 0x4e, 0x99, 0x3a, 0x74, 0x31, 0x17,
+	0xe1, 0x84, 0x29, 0xae, 0x16, 0xc4, 0xb8, 0x6b, 0x16, 0x4f, 0xa8, 0xff, 0xf8, 0x7f, 0x5a, 0xff,
+	0xa5, 0xeb, 0xf7, 0xb7, 0x2b, 0xe0, 0xc1, 0x76, 0x05, 0x3c, 0xdc, 0xae, 0x80, 0xdf, 0xb7, 0x2b,
+	0xe0, 0xeb, 0x9d, 0x4a, 0xee, 0xe1, 0x4e, 0x25, 0xf7, 0xeb, 0x4e, 0x25, 0xf7, 0xf1, 0xc2, 0x53,
+	0x01, 0xf6, 0xbd, 0x41, 0x53, 0xbc, 0x76, 0x21, 0xfd, 0x87, 0xe5, 0x8d, 0xbf, 0x07, 0x00, 0x20,
+	0x3b, 0x6f, 0x42, 0x63, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -739,6 +901,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.AutoCompoundMaxGas != that1.AutoCompoundMaxGas {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1046,6 +1214,78 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *RewardsAccumulator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardsAccumulator)
+	if !ok {
+		that2, ok := that.(RewardsAccumulator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.RewardsPerPower) != len(that1.RewardsPerPower) {
+		return false
+	}
+	for i := range this.RewardsPerPower {
+		if !this.RewardsPerPower[i].Equal(&that1.RewardsPerPower[i]) {
+			return false
+		}
+	}
+	if len(this.Unsettled) != len(that1.Unsettled) {
+		return false
+	}
+	for i := range this.Unsettled {
+		if !this.Unsettled[i].Equal(&that1.Unsettled[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ValidatorRewardsCheckpoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorRewardsCheckpoint)
+	if !ok {
+		that2, ok := that.(ValidatorRewardsCheckpoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if len(this.RewardsPerPower) != len(that1.RewardsPerPower) {
+		return false
+	}
+	for i := range this.RewardsPerPower {
+		if !this.RewardsPerPower[i].Equal(&that1.RewardsPerPower[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1066,6 +1306,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundMaxGas != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1582,6 +1832,106 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardsAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unsettled) > 0 {
+		for iNdEx := len(m.Unsettled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unsettled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardsPerPower) > 0 {
+		for iNdEx := len(m.RewardsPerPower) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerPower[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardsCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsPerPower) > 0 {
+		for iNdEx := len(m.RewardsPerPower) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerPower[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Power != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1608,6 +1958,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.AutoCompoundMaxGas != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxGas))
+	}
 	return n
 }
 
@@ -1813,31 +2169,74 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+func (m *RewardsAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardsPerPower) > 0 {
+		for _, e := range m.RewardsPerPower {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Unsettled) > 0 {
+		for _, e := range m.Unsettled {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRewardsCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovDistribution(uint64(m.Power))
+	}
+	if len(m.RewardsPerPower) > 0 {
+		for _, e := range m.RewardsPerPower {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
@@ -1970,6 +2369,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxGas", wireType)
+			}
+			m.AutoCompoundMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3264,6 +3701,259 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RewardsAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerPower = append(m.RewardsPerPower, types.DecCoin{})
+			if err := m.RewardsPerPower[len(m.RewardsPerPower)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsettled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unsettled = append(m.Unsettled, types.DecCoin{})
+			if err := m.Unsettled[len(m.Unsettled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardsCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardsCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardsCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerPower = append(m.RewardsPerPower, types.DecCoin{})
+			if err := m.RewardsPerPower[len(m.RewardsPerPower)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -326,6 +326,50 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// AutoCompoundRecord is a delegation with auto-compounding of its rewards
+// enabled, used for import/export via genesis json.
+//
+// Since: cosmos-sdk 0.48
+type AutoCompoundRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundRecord) Reset()         { *m = AutoCompoundRecord{} }
+func (m *AutoCompoundRecord) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundRecord) ProtoMessage()    {}
+func (*AutoCompoundRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
+}
+func (m *AutoCompoundRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundRecord.Merge(m, src)
+}
+func (m *AutoCompoundRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -348,13 +392,18 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_records defines the delegations with auto-compounding
+	// enabled at genesis.
+	//
+	// Since: cosmos-sdk 0.48
+	AutoCompoundRecords []AutoCompoundRecord `protobuf:"bytes,11,rep,name=auto_compound_records,json=autoCompoundRecords,proto3" json:"auto_compound_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,6 +440,7 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*AutoCompoundRecord)(nil), "cosmos.distribution.v1beta1.AutoCompoundRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -399,66 +449,69 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x12, 0x10, 0x54, 0x45, 0x20, 0x35, 0x6e, 0xcb, 0x8f, 0x03, 0x8d, 0x6c, 0x09,
-	0x04, 0x42, 0xb2, 0xc6, 0xbb, 0xe3, 0xf5, 0x08, 0x7b, 0xc7, 0x9a, 0x19, 0xaf, 0x01, 0x89, 0x03,
-	0xa7, 0x22, 0xc4, 0x81, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x8f, 0xe8, 0xb1,
-	0xaa, 0x38, 0x70, 0x02, 0xe4, 0x1c, 0x40, 0x1c, 0xf9, 0x0b, 0xd0, 0xce, 0xcc, 0xee, 0x8e, 0xb3,
-	0xdb, 0xad, 0x1b, 0xf0, 0xa5, 0x8d, 0x67, 0xde, 0x7b, 0xdf, 0xf7, 0xbd, 0xf7, 0xf6, 0xbd, 0x01,
-	0xcf, 0x7b, 0x94, 0x0f, 0x28, 0xaf, 0xfb, 0x84, 0x0b, 0x46, 0x3a, 0x23, 0x41, 0x68, 0x58, 0x8f,
-	0x76, 0x3b, 0x58, 0xa0, 0xdd, 0x7a, 0x80, 0x43, 0xcc, 0x09, 0x77, 0x87, 0x8c, 0x0a, 0x0a, 0xcf,
-	0x29, 0x53, 0xd7, 0x34, 0x75, 0xb5, 0xe9, 0xe6, 0x5a, 0x40, 0x03, 0x2a, 0xed, 0xea, 0xf1, 0x5f,
-	0xca, 0x65, 0xd3, 0xd1, 0xd1, 0x3b, 0x88, 0xe3, 0x34, 0xaa, 0x47, 0x49, 0xa8, 0xef, 0xdd, 0x32,
-	0xf4, 0x29, 0x1c, 0x65, 0xbf, 0xa1, 0xec, 0xdb, 0x0a, 0x48, 0xf3, 0x51, 0x57, 0xab, 0x68, 0x40,
-	0x42, 0x5a, 0x97, 0xff, 0xaa, 0xa3, 0x8b, 0x3f, 0x59, 0xe0, 0xec, 0x75, 0xdc, 0xc7, 0x01, 0x12,
-	0x94, 0x7d, 0x40, 0x44, 0xcf, 0x67, 0x68, 0xfc, 0x4e, 0xd8, 0xa5, 0xf0, 0x06, 0x58, 0xf5, 0x93,
-	0x8b, 0x36, 0xf2, 0x7d, 0x86, 0x39, 0xb7, 0xad, 0x2d, 0xeb, 0xf2, 0xf2, 0xbe, 0xfd, 0xf0, 0xde,
-	0xce, 0x9a, 0x8e, 0x7c, 0x4d, 0xdd, 0xb4, 0x04, 0x23, 0x61, 0xd0, 0x3c, 0x9d, 0xba, 0xe8, 0x73,
-	0xd8, 0x00, 0xa7, 0xc7, 0x3a, 0x6c, 0x1a, 0xa5, 0xfa, 0x98, 0x28, 0xcf, 0x24, 0x1e, 0xfa, 0xf8,
-	0xea, 0xd2, 0x57, 0x77, 0x6a, 0x95, 0xbf, 0xee, 0xd4, 0x2a, 0x17, 0x6f, 0x57, 0xc1, 0xf6, 0xfb,
-	0xa8, 0x4f, 0xfc, 0x18, 0xe3, 0xd6, 0x48, 0x70, 0x81, 0x42, 0x3f, 0xf6, 0xc1, 0x63, 0xc4, 0x7c,
-	0xde, 0xc4, 0x1e, 0x65, 0x3e, 0x7c, 0x0f, 0xac, 0x46, 0x89, 0xd1, 0x11, 0xee, 0xdb, 0x0f, 0xef,
-	0xed, 0x5c, 0xd0, 0xa8, 0x69, 0xa0, 0x23, 0x22, 0xa2, 0x23, 0xe7, 0xf0, 0xb6, 0x05, 0xce, 0xd0,
-	0x0c, 0xac, 0xcd, 0x14, 0x9a, 0x5d, 0xdd, 0x5a, 0xb8, 0xbc, 0xb2, 0x77, 0x5e, 0x97, 0xc8, 0x8d,
-	0x4b, 0x98, 0x54, 0xdb, 0xbd, 0x8e, 0xbd, 0x06, 0x25, 0xe1, 0xfe, 0x95, 0xfb, 0xbf, 0xd5, 0x2a,
-	0x3f, 0xfe, 0x5e, 0x7b, 0x31, 0x20, 0xa2, 0x37, 0xea, 0xb8, 0x1e, 0x1d, 0xe8, 0xaa, 0xe8, 0xff,
-	0x76, 0xb8, 0xff, 0x49, 0x5d, 0x7c, 0x36, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xff, 0xfc, 0xf9, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0xbf, 0x2d, 0x70, 0x29, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xbd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2f,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2c, 0xb0, 0x95, 0x06, 0x79, 0x9b, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x4f,
-	0x34, 0xf5, 0x2f, 0xf8, 0x31, 0x38, 0x99, 0x34, 0xc0, 0x82, 0x14, 0xff, 0xda, 0x6c, 0xe2, 0x73,
-	0xbc, 0x4d, 0xe1, 0x49, 0x48, 0x43, 0xf4, 0x2f, 0x16, 0xb8, 0x90, 0x3a, 0x37, 0x46, 0x8c, 0xe1,
-	0x50, 0xcc, 0x57, 0xf1, 0x87, 0x99, 0x32, 0x55, 0xd6, 0x57, 0x66, 0x53, 0x36, 0x4d, 0xee, 0x31,
-	0xb2, 0x7e, 0xa8, 0x82, 0x73, 0xe9, 0xc8, 0x69, 0x09, 0xc4, 0x04, 0x09, 0x83, 0x78, 0xe4, 0x68,
-	0x51, 0xff, 0xd3, 0xe0, 0x29, 0xcc, 0x4d, 0xf5, 0xf8, 0xb9, 0xe9, 0x80, 0xa7, 0xb9, 0x26, 0xdb,
-	0x26, 0x61, 0x97, 0xea, 0xda, 0xef, 0x95, 0x66, 0xa8, 0x50, 0xa7, 0x99, 0x9f, 0x53, 0xdc, 0xb8,
-	0x30, 0x92, 0xf4, 0x5d, 0x15, 0x6c, 0xa4, 0xd4, 0x5a, 0x7d, 0xc4, 0x7b, 0x37, 0x22, 0x99, 0xe1,
-	0x79, 0x75, 0x7a, 0x0f, 0x93, 0xa0, 0x27, 0x92, 0x4e, 0x57, 0xbf, 0x8c, 0x2f, 0x60, 0x61, 0xea,
-	0x0b, 0xa0, 0xe0, 0x6c, 0x86, 0xcf, 0x63, 0x76, 0x6d, 0x1c, 0xd3, 0xb3, 0x4f, 0xc8, 0x9c, 0xbc,
-	0x34, 0x5b, 0xd7, 0x64, 0xb2, 0xcc, 0x8c, 0x9c, 0x89, 0xf2, 0xf7, 0x46, 0x62, 0xbe, 0x59, 0x06,
-	0xa7, 0xde, 0x52, 0x3b, 0xb7, 0x25, 0x90, 0xc0, 0xf0, 0x26, 0x58, 0x1c, 0x22, 0x86, 0x06, 0x2a,
-	0x01, 0x2b, 0x7b, 0xcf, 0x95, 0x82, 0x1f, 0x48, 0x53, 0x13, 0x4f, 0x7b, 0xc3, 0x77, 0xc1, 0x52,
-	0x17, 0xe3, 0xf6, 0x90, 0xd2, 0xbe, 0x6e, 0xfe, 0x4b, 0xa5, 0x91, 0x6e, 0x62, 0x7c, 0x40, 0x69,
-	0x7f, 0xaa, 0xd9, 0xbb, 0xea, 0x0c, 0x8e, 0x81, 0x9d, 0xb5, 0x70, 0xba, 0xfe, 0xe2, 0xae, 0x89,
-	0x47, 0xc6, 0xc2, 0xec, 0x6d, 0x63, 0x6e, 0x64, 0x13, 0x69, 0xdd, 0x2f, 0xb2, 0xe0, 0xf1, 0xb7,
-	0x33, 0x64, 0x38, 0x22, 0x74, 0x24, 0x1f, 0x00, 0x43, 0xca, 0x31, 0x93, 0x45, 0x29, 0xfd, 0x76,
-	0x12, 0x97, 0x03, 0xed, 0x01, 0x3f, 0x2f, 0x5e, 0x77, 0x4f, 0x49, 0xea, 0x6f, 0xce, 0x56, 0xdd,
-	0x47, 0x2d, 0x67, 0x53, 0x46, 0xc1, 0x86, 0x83, 0xdf, 0x5b, 0x60, 0xdb, 0x68, 0xee, 0x6c, 0x1d,
-	0xb4, 0xbd, 0x74, 0x63, 0x70, 0x7b, 0x51, 0x52, 0xb9, 0xf6, 0x1f, 0xb6, 0x4e, 0x9e, 0x4d, 0x2d,
-	0x2a, 0x75, 0xe0, 0xf0, 0x6b, 0x0b, 0x9c, 0xcf, 0xa8, 0xf5, 0xd2, 0x71, 0x9e, 0x26, 0xe8, 0xa4,
-	0x64, 0xf5, 0xc6, 0x31, 0xd7, 0x41, 0x9e, 0xd1, 0x66, 0xf4, 0x48, 0x63, 0xf8, 0xa5, 0x05, 0x36,
-	0x32, 0x32, 0x9e, 0x9a, 0xc0, 0x29, 0x93, 0x25, 0xc9, 0xe4, 0xea, 0x71, 0xc6, 0x77, 0x9e, 0xc6,
-	0xb3, 0x51, 0xb1, 0x25, 0xfc, 0xc2, 0xec, 0xf3, 0xa9, 0xe9, 0xc8, 0xed, 0x65, 0xc9, 0xe0, 0xca,
-	0x93, 0x8f, 0xc7, 0x3c, 0x7e, 0xd6, 0xed, 0xa6, 0x1d, 0x87, 0x63, 0xb0, 0x5e, 0x38, 0x86, 0xb8,
-	0x0d, 0x24, 0xf8, 0xab, 0x4f, 0x3a, 0x87, 0xf2, 0xd0, 0x6b, 0x05, 0xd3, 0xc8, 0x58, 0x66, 0xfb,
-	0xb7, 0xee, 0x4e, 0x1c, 0xeb, 0xfe, 0xc4, 0xb1, 0x1e, 0x4c, 0x1c, 0xeb, 0x8f, 0x89, 0x63, 0x7d,
-	0x7b, 0xe8, 0x54, 0x1e, 0x1c, 0x3a, 0x95, 0x5f, 0x0f, 0x9d, 0xca, 0x47, 0xbb, 0xa5, 0x6f, 0xbe,
-	0x4f, 0xa7, 0xdf, 0xf4, 0xf2, 0x09, 0xd8, 0x59, 0x94, 0xef, 0xf2, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xbe, 0x75, 0xf7, 0x68, 0x75, 0x0c, 0x00, 0x00,
+	// 982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x21, 0x4d, 0xc6, 0x45, 0x34, 0xd3, 0x24, 0x6c, 0xd2, 0xd6, 0x4e, 0x4a, 0x0f,
+	0x05, 0x94, 0x35, 0x09, 0x08, 0xaa, 0x22, 0x90, 0x12, 0xb7, 0xe5, 0xc7, 0x81, 0x46, 0x89, 0x04,
+	0x02, 0x21, 0xad, 0xc6, 0xbb, 0x13, 0x7b, 0x84, 0xbd, 0x63, 0xcd, 0xcc, 0xae, 0x01, 0x89, 0x03,
+	0xa7, 0x22, 0x4e, 0x9c, 0x10, 0xc7, 0x8a, 0x53, 0x85, 0x84, 0xc4, 0xa1, 0xfc, 0x0f, 0x3d, 0x56,
+	0x15, 0x07, 0x4e, 0x80, 0x92, 0x03, 0x88, 0x23, 0x67, 0x0e, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xd9,
+	0xed, 0xc6, 0x09, 0x58, 0xe2, 0x92, 0xd8, 0x3b, 0xef, 0xc7, 0xf7, 0x7d, 0xef, 0xed, 0x7b, 0x63,
+	0xf0, 0xac, 0x47, 0x79, 0x9f, 0xf2, 0xa6, 0x4f, 0xb8, 0x60, 0xa4, 0x1d, 0x0a, 0x42, 0x83, 0x66,
+	0xb4, 0xd1, 0xc6, 0x02, 0x6d, 0x34, 0x3b, 0x38, 0xc0, 0x9c, 0x70, 0x67, 0xc0, 0xa8, 0xa0, 0xf0,
+	0x82, 0x32, 0x75, 0x4c, 0x53, 0x47, 0x9b, 0xae, 0x2c, 0x74, 0x68, 0x87, 0x4a, 0xbb, 0x66, 0xfc,
+	0x49, 0xb9, 0xac, 0xd4, 0x75, 0xf4, 0x36, 0xe2, 0x38, 0x8d, 0xea, 0x51, 0x12, 0xe8, 0x73, 0xa7,
+	0x2c, 0xfb, 0x48, 0x1e, 0x65, 0xbf, 0xac, 0xec, 0x5d, 0x95, 0x48, 0xe3, 0x51, 0x47, 0xf3, 0xa8,
+	0x4f, 0x02, 0xda, 0x94, 0x7f, 0xd5, 0xa3, 0xcb, 0xdf, 0x5b, 0x60, 0xf1, 0x06, 0xee, 0xe1, 0x0e,
+	0x12, 0x94, 0xbd, 0x47, 0x44, 0xd7, 0x67, 0x68, 0xf8, 0x56, 0xb0, 0x4f, 0xe1, 0x4d, 0x30, 0xef,
+	0x27, 0x07, 0x2e, 0xf2, 0x7d, 0x86, 0x39, 0xb7, 0xad, 0x55, 0xeb, 0xea, 0xdc, 0xb6, 0xfd, 0xe8,
+	0xfe, 0xfa, 0x82, 0x8e, 0xbc, 0xa5, 0x4e, 0xf6, 0x04, 0x23, 0x41, 0x67, 0xf7, 0x5c, 0xea, 0xa2,
+	0x9f, 0xc3, 0x16, 0x38, 0x37, 0xd4, 0x61, 0xd3, 0x28, 0xd5, 0x63, 0xa2, 0x3c, 0x95, 0x78, 0xe8,
+	0xc7, 0xd7, 0x67, 0xbf, 0xb8, 0xdb, 0xa8, 0xfc, 0x71, 0xb7, 0x51, 0xb9, 0x7c, 0xa7, 0x0a, 0xd6,
+	0xde, 0x45, 0x3d, 0xe2, 0xc7, 0x39, 0x6e, 0x87, 0x82, 0x0b, 0x14, 0xf8, 0xb1, 0x0f, 0x1e, 0x22,
+	0xe6, 0xf3, 0x5d, 0xec, 0x51, 0xe6, 0xc3, 0x77, 0xc0, 0x7c, 0x94, 0x18, 0x1d, 0xc1, 0xbe, 0xf6,
+	0xe8, 0xfe, 0xfa, 0x25, 0x9d, 0x35, 0x0d, 0x74, 0x84, 0x44, 0x74, 0xe4, 0x39, 0xbc, 0x63, 0x81,
+	0xf3, 0x34, 0x4b, 0xe6, 0x32, 0x95, 0xcd, 0xae, 0xae, 0x4e, 0x5d, 0xad, 0x6d, 0x5e, 0xd4, 0x25,
+	0x72, 0xe2, 0x12, 0x26, 0xd5, 0x76, 0x6e, 0x60, 0xaf, 0x45, 0x49, 0xb0, 0x7d, 0xed, 0xc1, 0x2f,
+	0x8d, 0xca, 0x77, 0xbf, 0x36, 0x9e, 0xef, 0x10, 0xd1, 0x0d, 0xdb, 0x8e, 0x47, 0xfb, 0xba, 0x2a,
+	0xfa, 0xdf, 0x3a, 0xf7, 0x3f, 0x6a, 0x8a, 0x4f, 0x06, 0x98, 0x27, 0x3e, 0xfc, 0xde, 0xef, 0x3f,
+	0x3c, 0x67, 0xed, 0x42, 0x9a, 0xe3, 0x67, 0x28, 0xf1, 0xa7, 0x05, 0xae, 0x64, 0x04, 0x3c, 0x2f,
+	0xec, 0x87, 0x3d, 0x24, 0xb0, 0xdf, 0xa2, 0xfd, 0x3e, 0xe1, 0x9c, 0xd0, 0x60, 0x42, 0x62, 0x74,
+	0x41, 0x0d, 0x65, 0xe9, 0x64, 0x31, 0x6b, 0x9b, 0xaf, 0x3a, 0x25, 0x9d, 0xef, 0x94, 0xe3, 0xdc,
+	0x9e, 0x8b, 0x25, 0x52, 0x9c, 0xcd, 0xd0, 0x06, 0xd9, 0xbf, 0x2c, 0xb0, 0x9a, 0x06, 0x79, 0x93,
+	0x70, 0x41, 0x19, 0xf1, 0x50, 0x6f, 0xb2, 0x55, 0x5f, 0x02, 0x33, 0x03, 0xcc, 0x08, 0x55, 0x1c,
+	0xa7, 0x77, 0xf5, 0x37, 0xf8, 0x21, 0x38, 0x93, 0x34, 0xc0, 0x94, 0x24, 0xff, 0xca, 0x78, 0xe4,
+	0x73, 0xb8, 0x4d, 0xe2, 0x49, 0x48, 0x83, 0xf4, 0x4f, 0x16, 0xb8, 0x94, 0x3a, 0xb7, 0x42, 0xc6,
+	0x70, 0x20, 0x26, 0xcb, 0xf8, 0xfd, 0x8c, 0x99, 0x2a, 0xeb, 0x4b, 0xe3, 0x31, 0x1b, 0x05, 0x77,
+	0x0c, 0xad, 0x6f, 0xab, 0xe0, 0x42, 0x3a, 0x72, 0xf6, 0x04, 0x62, 0x82, 0x04, 0x9d, 0x78, 0xe4,
+	0x68, 0x52, 0xff, 0xd1, 0xe0, 0x29, 0xd4, 0xa6, 0x7a, 0x7a, 0x6d, 0xda, 0xe0, 0x49, 0xae, 0xc1,
+	0xba, 0x24, 0xd8, 0xa7, 0xba, 0xf6, 0x9b, 0xa5, 0x0a, 0x15, 0xf2, 0x34, 0xf5, 0x39, 0xcb, 0x8d,
+	0x03, 0x43, 0xa4, 0xaf, 0xab, 0x60, 0x39, 0x85, 0xb6, 0xd7, 0x43, 0xbc, 0x7b, 0x33, 0x92, 0x0a,
+	0x4f, 0xaa, 0xd3, 0xbb, 0x98, 0x74, 0xba, 0x22, 0xe9, 0x74, 0xf5, 0xcd, 0x78, 0x03, 0xa6, 0x46,
+	0xde, 0x00, 0x0a, 0x16, 0xb3, 0xfc, 0x3c, 0x46, 0xe7, 0xe2, 0x18, 0x9e, 0x3d, 0x2d, 0x35, 0x79,
+	0x61, 0xbc, 0xae, 0xc9, 0x68, 0x99, 0x8a, 0x9c, 0x8f, 0xf2, 0xe7, 0x86, 0x30, 0x3f, 0x5a, 0x00,
+	0x6e, 0x85, 0x82, 0xb6, 0x68, 0x7f, 0x40, 0xc3, 0xc0, 0xff, 0x5f, 0x37, 0x8d, 0x81, 0xfb, 0xef,
+	0x39, 0x70, 0xf6, 0x0d, 0x75, 0x57, 0xd8, 0x13, 0x48, 0x60, 0x78, 0x0b, 0xcc, 0x0c, 0x10, 0x43,
+	0x7d, 0x05, 0xb3, 0xb6, 0xf9, 0x4c, 0xa9, 0x68, 0x3b, 0xd2, 0xd4, 0xd4, 0x49, 0x7b, 0xc3, 0xb7,
+	0xc1, 0xec, 0x3e, 0xc6, 0xee, 0x80, 0xd2, 0x9e, 0x7e, 0x69, 0xaf, 0x94, 0x46, 0xba, 0x85, 0xf1,
+	0x0e, 0xa5, 0xbd, 0x91, 0x97, 0x74, 0x5f, 0x3d, 0x83, 0x43, 0x60, 0x67, 0x2a, 0xa6, 0x6b, 0x3b,
+	0xee, 0xf6, 0x78, 0xd4, 0x4d, 0x8d, 0xdf, 0xee, 0xe6, 0x4d, 0xc2, 0xcc, 0xb4, 0xe4, 0x17, 0x59,
+	0xf0, 0xb8, 0x7c, 0x03, 0x86, 0x23, 0x42, 0x43, 0x79, 0x71, 0x19, 0x50, 0x8e, 0x99, 0x3d, 0x7d,
+	0x5c, 0xf9, 0x12, 0x97, 0x1d, 0xed, 0x01, 0x3f, 0x2d, 0x5e, 0xd3, 0x4f, 0x48, 0xe8, 0xaf, 0x8f,
+	0xd7, 0x95, 0x8f, 0xbb, 0x54, 0x98, 0x34, 0x0a, 0x36, 0x33, 0xfc, 0xc6, 0x02, 0x6b, 0x46, 0xef,
+	0x64, 0x6b, 0xcc, 0xf5, 0xd2, 0x4d, 0xc7, 0xed, 0x19, 0x09, 0x65, 0xeb, 0x5f, 0x6c, 0xcb, 0x3c,
+	0x9a, 0x46, 0x54, 0xea, 0xc0, 0xe1, 0x97, 0x16, 0xb8, 0x98, 0x41, 0xeb, 0xa6, 0x6b, 0x28, 0x15,
+	0xe8, 0x8c, 0x44, 0xf5, 0xda, 0x29, 0xd7, 0x58, 0x1e, 0xd1, 0x4a, 0xf4, 0x58, 0x63, 0xf8, 0xb9,
+	0x05, 0x96, 0x33, 0x30, 0x9e, 0xda, 0x1c, 0x29, 0x92, 0x59, 0x89, 0xe4, 0xfa, 0x69, 0xd6, 0x4e,
+	0x1e, 0xc6, 0xd3, 0x51, 0xb1, 0x25, 0xfc, 0xcc, 0xec, 0xf3, 0x91, 0xa9, 0xce, 0xed, 0x39, 0x89,
+	0xe0, 0xda, 0xc9, 0xc7, 0x7a, 0x3e, 0xff, 0x92, 0x5f, 0x64, 0xc7, 0xe1, 0x10, 0x2c, 0x15, 0x8e,
+	0x4f, 0x6e, 0x03, 0x99, 0xfc, 0xe5, 0x93, 0xce, 0xcf, 0x7c, 0xea, 0x85, 0x82, 0x29, 0xca, 0x61,
+	0x00, 0x16, 0x51, 0x28, 0xa8, 0xeb, 0xe9, 0xe1, 0xe9, 0x32, 0xe9, 0xc8, 0xed, 0x9a, 0xcc, 0xdb,
+	0x2c, 0xcd, 0x9b, 0x9f, 0xba, 0x23, 0x63, 0x1b, 0xe5, 0x8e, 0x8d, 0xf1, 0xb7, 0x7d, 0xfb, 0xde,
+	0x41, 0xdd, 0x7a, 0x70, 0x50, 0xb7, 0x1e, 0x1e, 0xd4, 0xad, 0xdf, 0x0e, 0xea, 0xd6, 0x57, 0x87,
+	0xf5, 0xca, 0xc3, 0xc3, 0x7a, 0xe5, 0xe7, 0xc3, 0x7a, 0xe5, 0x83, 0x8d, 0xd2, 0xbb, 0xf1, 0xc7,
+	0xa3, 0xbf, 0x7d, 0xe4, 0x55, 0xb9, 0x3d, 0x23, 0x7f, 0xbf, 0xbc, 0xf8, 0xcf, 0x00, 0x2f, 0x27,
+	0x77, 0xbe, 0x9d, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -764,6 +817,43 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -784,6 +874,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundRecords) > 0 {
+		for iNdEx := len(m.AutoCompoundRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1047,6 +1151,23 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1103,6 +1224,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundRecords) > 0 {
+		for _, e := range m.AutoCompoundRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2006,6 +2133,120 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2371,6 +2612,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundRecords = append(m.AutoCompoundRecords, AutoCompoundRecord{})
+			if err := m.AutoCompoundRecords[len(m.AutoCompoundRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,20 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: AutoCompound
+//
+// - 0x0B: AutoCompoundCursor
+//
+// - 0x0C: VoteSetHash
+//
+// - 0x0D: RewardsAccumulator
+//
+// - 0x0E<consAddrLen (1 Byte)><consAddr_Bytes>: ValidatorRewardsCheckpoint
+//
+// - 0x0F<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorRewardsCheckpointConsAddr
+//
+// - 0x10: LastVoteSet
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +68,16 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	AutoCompoundPrefix    = []byte{0x0A} // key for the delegations with auto-compounding enabled
+	AutoCompoundCursorKey = []byte{0x0B} // key for the next delegation to compound in the current round
+
+	VoteSetHashKey                   = []byte{0x0C} // key for the hash of the last vote set
+	RewardsAccumulatorKey            = []byte{0x0D} // key for the rewards allocated to the last vote set
+	ValidatorRewardsCheckpointPrefix = []byte{0x0E} // key for the validator rewards checkpoints of the last vote set
+
+	ValidatorRewardsCheckpointConsAddrPrefix = []byte{0x0F} // key for the consensus address of a validator rewards checkpoint
+	LastVoteSetKey                           = []byte{0x10} // key for the addresses and voting powers of the last vote set
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -138,6 +162,19 @@ func GetValidatorAccumulatedCommissionAddress(key []byte) (valAddr sdk.ValAddres
 	return sdk.ValAddress(addr)
 }
 
+// GetValidatorRewardsCheckpointAddress creates a consensus address from a validator's rewards checkpoint key.
+func GetValidatorRewardsCheckpointAddress(key []byte) (consAddr sdk.ConsAddress) {
+	// key is in the format:
+	// 0x0E<consAddrLen (1 Byte)><consAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.ConsAddress(addr)
+}
+
 // GetValidatorSlashEventAddressHeight creates the height from a validator's slash event key.
 func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, height uint64) {
 	// key is in the format:
@@ -190,6 +227,16 @@ func GetValidatorAccumulatedCommissionKey(v sdk.ValAddress) []byte {
 	return append(ValidatorAccumulatedCommissionPrefix, address.MustLengthPrefix(v.Bytes())...)
 }
 
+// GetValidatorRewardsCheckpointKey creates the key for a validator's rewards checkpoint.
+func GetValidatorRewardsCheckpointKey(consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorRewardsCheckpointPrefix, address.MustLengthPrefix(consAddr.Bytes())...)
+}
+
+// GetValidatorRewardsCheckpointConsAddrKey creates the key for the consensus address of a validator's rewards checkpoint.
+func GetValidatorRewardsCheckpointConsAddrKey(v sdk.ValAddress) []byte {
+	return append(ValidatorRewardsCheckpointConsAddrPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
 // GetValidatorSlashEventPrefix creates the prefix key for a validator's slash fractions.
 func GetValidatorSlashEventPrefix(v sdk.ValAddress) []byte {
 	return append(ValidatorSlashEventPrefix, address.MustLengthPrefix(v.Bytes())...)
@@ -214,3 +261,25 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundAddresses creates the addresses from an auto-compound key.
+func GetAutoCompoundAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
+// GetAutoCompoundKey creates the key of a delegation with auto-compounding
+// enabled.
+func GetAutoCompoundKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(append(AutoCompoundPrefix, address.MustLengthPrefix(d.Bytes())...), address.MustLengthPrefix(v.Bytes())...)
+}
@@ -13,6 +13,8 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
 
 	_ legacytx.LegacyMsg = (*MsgSetWithdrawAddress)(nil)
 	_ legacytx.LegacyMsg = (*MsgWithdrawDelegatorReward)(nil)
@@ -20,6 +22,8 @@ var (
 	_ legacytx.LegacyMsg = (*MsgUpdateParams)(nil)
 	_ legacytx.LegacyMsg = (*MsgCommunityPoolSpend)(nil)
 	_ legacytx.LegacyMsg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ legacytx.LegacyMsg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
+	_ legacytx.LegacyMsg = (*MsgSetAutoCompound)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -152,3 +156,50 @@ func (msg MsgDepositValidatorRewardsPool) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new
+// MsgWithdrawTokenizeShareRecordReward for the tokenize share records owned by
+// an address.
+func NewMsgWithdrawTokenizeShareRecordReward(owner sdk.AccAddress) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: owner.String(),
+	}
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the record owner.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawTokenizeShareRecordReward
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound enabling or disabling
+// the auto-compounding of the rewards of a delegation.
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the delegator.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that the
+// expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
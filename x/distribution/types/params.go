@@ -6,13 +6,24 @@ import (
 	"cosmossdk.io/math"
 )
 
+// Default parameter values
+const (
+	DefaultAutoCompoundInterval uint64 = 100
+	// DefaultAutoCompoundMaxGas is the gas budget of auto-compounding in a
+	// block. It is consumed on top of the block gas limit, as compounding runs
+	// in BeginBlock without fees, so it is kept low.
+	DefaultAutoCompoundMaxGas uint64 = 1_000_000
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:         math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:   math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:  math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:  true,
+		AutoCompoundInterval: DefaultAutoCompoundInterval,
+		AutoCompoundMaxGas:   DefaultAutoCompoundMaxGas,
 	}
 }
 
@@ -24,6 +35,10 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
+	if p.AutoCompoundInterval > 0 && p.AutoCompoundMaxGas == 0 {
+		return fmt.Errorf("auto-compound max gas must be positive when auto-compounding is enabled")
+	}
+
 	return nil
 }
 
@@ -653,6 +653,182 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of the delegations
+// of all the tokenize share records owned by an address.
+//
+// Since: cosmos-sdk 0.48
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the
+// Msg/WithdrawTokenizeShareRecordReward response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetAutoCompound enables or disables the auto-compounding of the rewards
+// of a delegation. The rewards in the bond denom are periodically withdrawn
+// and delegated to the validator.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Enabled          bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{16}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{17}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -668,6 +844,10 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompoundResponse")
 }
 
 func init() {
@@ -675,65 +855,75 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0xa4, 0xa2, 0x28, 0xb3, 0x2b, 0xed, 0x26, 0x2a, 0x6a, 0xeb, 0xdd, 0x75, 0x16, 0x17,
-	0x4a, 0x14, 0x51, 0x5b, 0x09, 0x08, 0x54, 0x73, 0x00, 0x92, 0x12, 0x89, 0x43, 0xa0, 0x4a, 0x05,
-	0x48, 0x5c, 0x2a, 0x27, 0x36, 0xee, 0x88, 0xda, 0x63, 0x79, 0x26, 0x49, 0x73, 0x03, 0x04, 0x12,
-	0xe2, 0x84, 0xc4, 0x8d, 0x4b, 0x2b, 0xf5, 0x52, 0x71, 0xca, 0xa1, 0x07, 0x7e, 0x42, 0x2f, 0x48,
-	0x55, 0x4f, 0x9c, 0x00, 0xa5, 0x42, 0x41, 0x82, 0xdf, 0x80, 0x90, 0xed, 0x89, 0x63, 0xc7, 0x8e,
-	0xdd, 0x96, 0x8a, 0x72, 0x69, 0xab, 0x99, 0xf7, 0xbe, 0xf9, 0xde, 0x37, 0xdf, 0xbc, 0xe7, 0xc2,
-	0x17, 0x3a, 0x98, 0x18, 0x98, 0x48, 0x2a, 0x22, 0xd4, 0x46, 0xed, 0x2e, 0x45, 0xd8, 0x94, 0x7a,
-	0x95, 0xb6, 0x46, 0x95, 0x8a, 0x44, 0x0f, 0x44, 0xcb, 0xc6, 0x14, 0x17, 0x1e, 0x79, 0x51, 0x62,
-	0x30, 0x4a, 0x64, 0x51, 0xdc, 0x92, 0x8e, 0x75, 0xec, 0xc6, 0x49, 0xce, 0x5f, 0x5e, 0x0a, 0xc7,
-	0x33, 0xe0, 0xb6, 0x42, 0x34, 0x1f, 0xb0, 0x83, 0x91, 0xc9, 0xf6, 0x57, 0xbd, 0xfd, 0x5d, 0x2f,
-	0x91, 0xe1, 0x7b, 0x5b, 0xcb, 0x2c, 0xd5, 0x20, 0xba, 0xd4, 0xab, 0x38, 0xbf, 0xd8, 0x46, 0x5e,
-	0x31, 0x90, 0x89, 0x25, 0xf7, 0x27, 0x5b, 0x12, 0x93, 0xf8, 0x87, 0xe8, 0xba, 0xf1, 0xc2, 0x9f,
-	0x00, 0x3e, 0xd7, 0x24, 0xfa, 0x8e, 0x46, 0x3f, 0x42, 0x74, 0x4f, 0xb5, 0x95, 0xfe, 0xdb, 0xaa,
-	0x6a, 0x6b, 0x84, 0x14, 0xde, 0x81, 0x79, 0x55, 0xdb, 0xd7, 0x74, 0x85, 0x62, 0x7b, 0x57, 0xf1,
-	0x16, 0x57, 0xc0, 0x53, 0x50, 0xca, 0xd5, 0x56, 0x2e, 0x4e, 0x37, 0x96, 0x18, 0x45, 0x16, 0xbe,
-	0x43, 0x6d, 0x64, 0xea, 0xad, 0x87, 0x7e, 0xca, 0x04, 0xa6, 0x0e, 0x1f, 0xf6, 0x19, 0xb2, 0x8f,
-	0x92, 0x4d, 0x41, 0x79, 0xd0, 0x0f, 0x73, 0x91, 0x1b, 0x5f, 0x1f, 0x15, 0x33, 0x7f, 0x1c, 0x15,
-	0x33, 0x5f, 0x8c, 0x87, 0xe5, 0x28, 0xad, 0x6f, 0xc6, 0xc3, 0xf2, 0x9a, 0x87, 0xb4, 0x41, 0xd4,
-	0x4f, 0xa5, 0x26, 0xd1, 0x9b, 0x58, 0x45, 0x9f, 0x0c, 0x66, 0x6a, 0x12, 0x8a, 0xf0, 0x49, 0x6c,
-	0xb1, 0x2d, 0x8d, 0x58, 0xd8, 0x24, 0x9a, 0xf0, 0x37, 0x80, 0x5c, 0x93, 0xe8, 0x93, 0xed, 0xad,
-	0xc9, 0x49, 0x2d, 0xad, 0xaf, 0xd8, 0xea, 0x6d, 0x69, 0xf2, 0x1e, 0xcc, 0xf7, 0x94, 0x7d, 0xa4,
-	0x86, 0x60, 0x3c, 0x51, 0x9e, 0xbf, 0x38, 0xdd, 0x78, 0xc2, 0x60, 0x3e, 0x9c, 0xc4, 0xcc, 0xe0,
-	0xf5, 0x66, 0xd6, 0xe5, 0x77, 0xd3, 0xe5, 0x59, 0x0f, 0xcb, 0x33, 0x53, 0x20, 0xc2, 0xa6, 0x57,
-	0xa1, 0x70, 0x08, 0xa0, 0x30, 0x5f, 0x80, 0x89, 0x4e, 0x85, 0x01, 0x5c, 0x54, 0x0c, 0xdc, 0x35,
-	0xe9, 0x0a, 0x78, 0xba, 0x50, 0xba, 0x57, 0x5d, 0x65, 0xbe, 0x13, 0x1d, 0x7b, 0x4f, 0x5e, 0x82,
-	0x58, 0xc7, 0xc8, 0xac, 0x35, 0xce, 0x7e, 0x29, 0x66, 0x7e, 0xf8, 0xb5, 0x58, 0xd2, 0x11, 0xdd,
-	0xeb, 0xb6, 0xc5, 0x0e, 0x36, 0x98, 0xbd, 0xa5, 0x00, 0x27, 0x3a, 0xb0, 0x34, 0xe2, 0x26, 0x90,
-	0xef, 0xc7, 0xc3, 0xf2, 0x7d, 0xe7, 0xd8, 0xce, 0x60, 0xd7, 0x79, 0x20, 0xe4, 0x64, 0x3c, 0x2c,
-	0x83, 0x16, 0x3b, 0x50, 0xf8, 0x11, 0x40, 0x3e, 0xc0, 0xd0, 0x17, 0xa9, 0x8e, 0x0d, 0x03, 0x11,
-	0x82, 0xb0, 0x19, 0xaf, 0x2f, 0xb8, 0xb9, 0xbe, 0x61, 0xfb, 0x45, 0xa0, 0x63, 0xec, 0x17, 0x60,
-	0x37, 0xe5, 0x25, 0x1c, 0x03, 0xb8, 0x9e, 0x4c, 0xfd, 0xff, 0x20, 0xf0, 0x57, 0x59, 0xb8, 0xd4,
-	0x24, 0x7a, 0xa3, 0x6b, 0xaa, 0x0e, 0xb1, 0xae, 0x89, 0xe8, 0x60, 0x1b, 0xe3, 0xfd, 0x3b, 0xe4,
-	0x54, 0x78, 0x0d, 0xe6, 0x54, 0xcd, 0xc2, 0x04, 0x51, 0x6c, 0xa7, 0xb6, 0x8f, 0x69, 0xa8, 0x2c,
-	0x07, 0x6f, 0x6e, 0xba, 0xee, 0xdc, 0x58, 0x31, 0x7c, 0x63, 0x91, 0x72, 0x05, 0x1e, 0x3e, 0x8e,
-	0x5b, 0xf7, 0x7b, 0xc5, 0x4f, 0x00, 0x3e, 0x68, 0x12, 0xfd, 0x03, 0x4b, 0x55, 0xa8, 0xb6, 0xad,
-	0xd8, 0x8a, 0x41, 0x1c, 0x9e, 0x4a, 0x97, 0xee, 0x61, 0x1b, 0xd1, 0x41, 0x6a, 0x63, 0x98, 0x86,
-	0x16, 0x1a, 0x70, 0xd1, 0x72, 0x11, 0xdc, 0xe2, 0xee, 0x55, 0xd7, 0xc4, 0x84, 0x09, 0x23, 0x7a,
-	0x87, 0xd5, 0x72, 0x8e, 0xc8, 0x4c, 0x27, 0x2f, 0x5b, 0x96, 0xdd, 0x3a, 0x7d, 0x5c, 0xa7, 0xce,
-	0x97, 0x02, 0x75, 0x86, 0xa6, 0xc2, 0x0c, 0x77, 0x61, 0x15, 0x2e, 0xcf, 0x2c, 0xf9, 0xa5, 0x1e,
-	0x67, 0xdd, 0x29, 0x11, 0xd2, 0x61, 0xc7, 0xd2, 0x4c, 0xf5, 0xc6, 0x05, 0x3f, 0x86, 0x39, 0x5b,
-	0xeb, 0x20, 0x0b, 0x69, 0x26, 0xf5, 0x2e, 0xb4, 0x35, 0x5d, 0x08, 0x38, 0x6d, 0xe1, 0x3f, 0x76,
-	0x9a, 0xbc, 0x19, 0x55, 0x70, 0x7d, 0x56, 0x41, 0x29, 0x56, 0x0b, 0x36, 0x5d, 0xa2, 0x1b, 0xbe,
-	0x8c, 0xbf, 0x67, 0xdd, 0xd6, 0xb5, 0xe5, 0xd9, 0xd0, 0x7f, 0xfe, 0x5e, 0x6f, 0x25, 0xee, 0x1b,
-	0x0b, 0x19, 0x1d, 0x5c, 0xd9, 0xe8, 0xb7, 0x3d, 0x52, 0xee, 0xf2, 0x06, 0xde, 0x9a, 0xff, 0x66,
-	0x5f, 0x8c, 0xbb, 0x89, 0xa9, 0x9c, 0x4c, 0x48, 0xa1, 0xe4, 0xb6, 0xd9, 0x04, 0x99, 0x27, 0x37,
-	0x52, 0xfd, 0xeb, 0x59, 0xb8, 0xd0, 0x24, 0x7a, 0xe1, 0x4b, 0x00, 0x0b, 0x31, 0xdf, 0x40, 0xd5,
-	0xc4, 0x67, 0x18, 0xfb, 0x29, 0xc1, 0xc9, 0xd7, 0xcf, 0xf1, 0xbb, 0xfe, 0x77, 0x00, 0x2e, 0xcf,
-	0xfb, 0xf6, 0x78, 0x3d, 0x0d, 0x77, 0x4e, 0x22, 0xf7, 0xe6, 0x0d, 0x13, 0x7d, 0x56, 0x87, 0x00,
-	0x3e, 0x4a, 0x1a, 0xb7, 0x6f, 0x5c, 0xf5, 0x80, 0x98, 0x64, 0xae, 0xfe, 0x2f, 0x92, 0x7d, 0x86,
-	0x9f, 0x03, 0x98, 0x8f, 0xce, 0xab, 0x4a, 0x1a, 0x74, 0x24, 0x85, 0xdb, 0xbc, 0x76, 0x8a, 0xcf,
-	0xc1, 0x86, 0xf7, 0x43, 0xa3, 0xe0, 0xe5, 0x34, 0xa8, 0x60, 0x34, 0xf7, 0xea, 0x75, 0xa2, 0xfd,
-	0x33, 0x1d, 0xdb, 0xc6, 0x34, 0xe5, 0x54, 0xdb, 0x46, 0x73, 0xd2, 0x6d, 0x3b, 0xbf, 0xaf, 0xb9,
-	0x06, 0x49, 0x6a, 0x6a, 0xa9, 0x06, 0x49, 0x48, 0x4e, 0x37, 0xc8, 0x15, 0xde, 0x39, 0xf7, 0xcc,
-	0x67, 0x4e, 0x8b, 0xa9, 0xbd, 0x7f, 0x32, 0xe2, 0xc1, 0xd9, 0x88, 0x07, 0xe7, 0x23, 0x1e, 0xfc,
-	0x36, 0xe2, 0xc1, 0xb7, 0x97, 0x7c, 0xe6, 0xfc, 0x92, 0xcf, 0xfc, 0x7c, 0xc9, 0x67, 0x3e, 0xae,
-	0x24, 0x36, 0xb0, 0x83, 0xf0, 0xf4, 0x74, 0xfb, 0x59, 0x7b, 0xd1, 0xfd, 0x2f, 0xea, 0x95, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x97, 0xa4, 0x34, 0x89, 0x37, 0x0e, 0x00, 0x00,
+	// 1079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xde, 0x49, 0x44, 0x20, 0xd3, 0xa0, 0x34, 0x56, 0x50, 0x36, 0x6e, 0xe2, 0x4d, 0xdd, 0x10,
+	0x56, 0x51, 0xb3, 0xd6, 0x06, 0x44, 0x55, 0x23, 0x04, 0xc9, 0x96, 0x48, 0x3d, 0x2c, 0x54, 0x1b,
+	0x7e, 0x48, 0x5c, 0x22, 0xef, 0x7a, 0x70, 0x46, 0x8d, 0x3d, 0x96, 0x67, 0x36, 0xdb, 0x85, 0x0b,
+	0x20, 0x10, 0x88, 0x13, 0x12, 0x37, 0x24, 0xd4, 0x4a, 0xbd, 0x94, 0x9e, 0x82, 0xd4, 0x03, 0x7f,
+	0x42, 0x2f, 0x48, 0x55, 0x4f, 0x9c, 0x00, 0x25, 0x42, 0x41, 0xe2, 0x7f, 0x40, 0xc8, 0xf6, 0xd8,
+	0xeb, 0x5f, 0xbb, 0xde, 0x4d, 0xaa, 0x36, 0x97, 0x36, 0x99, 0x79, 0xdf, 0x37, 0xdf, 0xfb, 0xe6,
+	0x79, 0xde, 0x53, 0xe0, 0x72, 0x8b, 0x50, 0x93, 0x50, 0x45, 0xc7, 0x94, 0x39, 0xb8, 0xd9, 0x66,
+	0x98, 0x58, 0xca, 0x7e, 0xb5, 0x89, 0x98, 0x56, 0x55, 0xd8, 0xad, 0x8a, 0xed, 0x10, 0x46, 0x84,
+	0x0b, 0x7e, 0x54, 0x25, 0x1a, 0x55, 0xe1, 0x51, 0xe2, 0xac, 0x41, 0x0c, 0xe2, 0xc5, 0x29, 0xee,
+	0x4f, 0x3e, 0x44, 0x94, 0x38, 0x71, 0x53, 0xa3, 0x28, 0x24, 0x6c, 0x11, 0x6c, 0xf1, 0xfd, 0x79,
+	0x7f, 0x7f, 0xc7, 0x07, 0x72, 0x7e, 0x7f, 0x6b, 0x8e, 0x43, 0x4d, 0x6a, 0x28, 0xfb, 0x55, 0xf7,
+	0x3f, 0xbe, 0x31, 0xa3, 0x99, 0xd8, 0x22, 0x8a, 0xf7, 0x2f, 0x5f, 0xaa, 0x0c, 0xd2, 0x1f, 0x93,
+	0xeb, 0xc5, 0xcb, 0xff, 0x02, 0xf8, 0x52, 0x9d, 0x1a, 0xdb, 0x88, 0x7d, 0x84, 0xd9, 0xae, 0xee,
+	0x68, 0x9d, 0x0d, 0x5d, 0x77, 0x10, 0xa5, 0xc2, 0x3b, 0x70, 0x46, 0x47, 0x7b, 0xc8, 0xd0, 0x18,
+	0x71, 0x76, 0x34, 0x7f, 0xb1, 0x08, 0x96, 0x40, 0x79, 0x72, 0xb3, 0xf8, 0xf8, 0xc1, 0xda, 0x2c,
+	0x97, 0xc8, 0xc3, 0xb7, 0x99, 0x83, 0x2d, 0xa3, 0x71, 0x3e, 0x84, 0x04, 0x34, 0x35, 0x78, 0xbe,
+	0xc3, 0x99, 0x43, 0x96, 0xb1, 0x1c, 0x96, 0xe9, 0x4e, 0x5c, 0x8b, 0xba, 0xf5, 0xed, 0x9d, 0x52,
+	0xe1, 0x9f, 0x3b, 0xa5, 0xc2, 0x97, 0xc7, 0x07, 0xab, 0x69, 0x59, 0xdf, 0x1d, 0x1f, 0xac, 0x5e,
+	0xf2, 0x99, 0xd6, 0xa8, 0x7e, 0x53, 0xa9, 0x53, 0xa3, 0x4e, 0x74, 0xfc, 0x49, 0x37, 0x91, 0x93,
+	0x5c, 0x82, 0x8b, 0x99, 0xc9, 0x36, 0x10, 0xb5, 0x89, 0x45, 0x91, 0xfc, 0x1f, 0x80, 0x62, 0x9d,
+	0x1a, 0xc1, 0xf6, 0xb5, 0xe0, 0xa4, 0x06, 0xea, 0x68, 0x8e, 0xfe, 0xa4, 0x3c, 0x79, 0x17, 0xce,
+	0xec, 0x6b, 0x7b, 0x58, 0x8f, 0xd1, 0xf8, 0xa6, 0x5c, 0x7c, 0xfc, 0x60, 0x6d, 0x91, 0xd3, 0x7c,
+	0x18, 0xc4, 0x24, 0xf8, 0xf6, 0x13, 0xeb, 0xea, 0xf5, 0x7c, 0x7b, 0x56, 0xe2, 0xf6, 0x24, 0x12,
+	0xc4, 0xc4, 0xf2, 0x33, 0x94, 0x6f, 0x03, 0x28, 0xf7, 0x37, 0x20, 0xf0, 0x49, 0xe8, 0xc2, 0x09,
+	0xcd, 0x24, 0x6d, 0x8b, 0x15, 0xc1, 0xd2, 0x78, 0xf9, 0xdc, 0xfa, 0x3c, 0xaf, 0xbb, 0x8a, 0x5b,
+	0xde, 0xc1, 0x97, 0x50, 0xa9, 0x11, 0x6c, 0x6d, 0x6e, 0x3d, 0xfc, 0xa3, 0x54, 0xb8, 0xff, 0x67,
+	0xa9, 0x6c, 0x60, 0xb6, 0xdb, 0x6e, 0x56, 0x5a, 0xc4, 0xe4, 0xe5, 0xad, 0x44, 0x34, 0xb1, 0xae,
+	0x8d, 0xa8, 0x07, 0xa0, 0x3f, 0x1e, 0x1f, 0xac, 0x4e, 0xb9, 0xc7, 0xb6, 0xba, 0x3b, 0xee, 0x07,
+	0x42, 0xef, 0x1d, 0x1f, 0xac, 0x82, 0x06, 0x3f, 0x50, 0xfe, 0x15, 0x40, 0x29, 0xa2, 0x30, 0x34,
+	0xa9, 0x46, 0x4c, 0x13, 0x53, 0x8a, 0x89, 0x95, 0xed, 0x2f, 0x38, 0xb9, 0xbf, 0xf1, 0xf2, 0x4b,
+	0x51, 0x67, 0x94, 0x5f, 0x44, 0x5d, 0x4f, 0x97, 0x7c, 0x17, 0xc0, 0x95, 0xc1, 0xd2, 0xcf, 0x82,
+	0xc1, 0x5f, 0x8f, 0xc1, 0xd9, 0x3a, 0x35, 0xb6, 0xda, 0x96, 0xee, 0x0a, 0x6b, 0x5b, 0x98, 0x75,
+	0x6f, 0x10, 0xb2, 0xf7, 0x0c, 0x35, 0x09, 0xaf, 0xc3, 0x49, 0x1d, 0xd9, 0x84, 0x62, 0x46, 0x9c,
+	0xdc, 0xe7, 0xa3, 0x17, 0xaa, 0xaa, 0xd1, 0x9b, 0xeb, 0xad, 0xbb, 0x37, 0x56, 0x8a, 0xdf, 0x58,
+	0x2a, 0x5d, 0x59, 0x82, 0x0b, 0x59, 0xeb, 0xe1, 0x5b, 0xf1, 0x1b, 0x80, 0xd3, 0x75, 0x6a, 0x7c,
+	0x60, 0xeb, 0x1a, 0x43, 0x37, 0x34, 0x47, 0x33, 0xa9, 0xab, 0x53, 0x6b, 0xb3, 0x5d, 0xe2, 0x60,
+	0xd6, 0xcd, 0x7d, 0x18, 0x7a, 0xa1, 0xc2, 0x16, 0x9c, 0xb0, 0x3d, 0x06, 0x2f, 0xb9, 0x73, 0xeb,
+	0x97, 0x2a, 0x03, 0x3a, 0x4c, 0xc5, 0x3f, 0x6c, 0x73, 0xd2, 0x35, 0x99, 0xfb, 0xe4, 0xa3, 0x55,
+	0xd5, 0xcb, 0x33, 0xe4, 0x75, 0xf3, 0x7c, 0x25, 0x92, 0x67, 0xac, 0x2b, 0x24, 0xb4, 0xcb, 0xf3,
+	0x70, 0x2e, 0xb1, 0x14, 0xa6, 0x7a, 0x77, 0xcc, 0xeb, 0x12, 0x31, 0x1f, 0xb6, 0x6d, 0x64, 0xe9,
+	0x27, 0x4e, 0x78, 0x01, 0x4e, 0x3a, 0xa8, 0x85, 0x6d, 0x8c, 0x2c, 0xe6, 0x5f, 0x68, 0xa3, 0xb7,
+	0x10, 0xa9, 0xb4, 0xf1, 0xa7, 0x5c, 0x69, 0xea, 0xd5, 0xb4, 0x83, 0x2b, 0x49, 0x07, 0x95, 0x4c,
+	0x2f, 0x78, 0x77, 0x49, 0x6f, 0x84, 0x36, 0xfe, 0x3d, 0xe6, 0x3d, 0x5d, 0xd7, 0xfc, 0x32, 0x0c,
+	0x3f, 0x7f, 0xff, 0x6d, 0xa5, 0xde, 0x37, 0x16, 0x2b, 0x74, 0x30, 0x74, 0xa1, 0x3f, 0xe9, 0x96,
+	0xf2, 0x2c, 0x6f, 0xe0, 0xed, 0xfe, 0xdf, 0xec, 0xcb, 0x59, 0x37, 0xd1, 0xb3, 0x93, 0x1b, 0x29,
+	0x97, 0xe1, 0x4a, 0x6c, 0x3d, 0x65, 0x73, 0x78, 0x23, 0xf7, 0x01, 0x5c, 0x8e, 0xbc, 0xc8, 0xef,
+	0x93, 0x9b, 0xc8, 0xc2, 0x9f, 0xa2, 0xed, 0x5d, 0xcd, 0x41, 0x0d, 0xd4, 0x22, 0x8e, 0xee, 0xa3,
+	0x84, 0x37, 0xe1, 0x8b, 0xa4, 0x63, 0xa1, 0xe1, 0xbb, 0xfe, 0x94, 0x17, 0x1e, 0x74, 0x90, 0x5a,
+	0x34, 0xa7, 0x38, 0x93, 0x9b, 0xd7, 0x72, 0x76, 0xf7, 0x08, 0xe4, 0xf0, 0xde, 0xfc, 0x33, 0x80,
+	0x97, 0x87, 0x11, 0x7b, 0x16, 0x9a, 0xc8, 0x37, 0x63, 0x50, 0xf0, 0x47, 0xad, 0x8d, 0x36, 0x23,
+	0x35, 0x62, 0xda, 0xa4, 0x6d, 0x9d, 0xd5, 0x01, 0x4a, 0x28, 0xc2, 0xe7, 0x91, 0xa5, 0x35, 0xf7,
+	0x90, 0x5e, 0x1c, 0x5f, 0x02, 0xe5, 0x17, 0x1a, 0xc1, 0xaf, 0xf1, 0x62, 0xcc, 0x1e, 0xad, 0x16,
+	0xe3, 0x97, 0x97, 0x48, 0x59, 0x5e, 0x80, 0x62, 0x7a, 0x35, 0xb8, 0xa2, 0xf5, 0x9f, 0x20, 0x1c,
+	0xaf, 0x53, 0x43, 0xf8, 0x0a, 0x40, 0x21, 0x63, 0x08, 0x5f, 0x1f, 0xd8, 0x07, 0x32, 0x67, 0x59,
+	0x51, 0x1d, 0x1d, 0x13, 0x56, 0xcc, 0x0f, 0x00, 0xce, 0xf5, 0x1b, 0x7e, 0xaf, 0xe4, 0xf1, 0xf6,
+	0x01, 0x8a, 0x6f, 0x9d, 0x10, 0x18, 0xaa, 0xba, 0x0d, 0xe0, 0x85, 0x41, 0xf3, 0xde, 0x1b, 0xc3,
+	0x1e, 0x90, 0x01, 0x16, 0x6b, 0xa7, 0x00, 0x87, 0x0a, 0xbf, 0x00, 0x70, 0x26, 0x3d, 0x30, 0x55,
+	0xf3, 0xa8, 0x53, 0x10, 0xf1, 0xea, 0xc8, 0x90, 0x50, 0x83, 0x03, 0xa7, 0x62, 0xb3, 0xc8, 0xe5,
+	0x3c, 0xaa, 0x68, 0xb4, 0xf8, 0xda, 0x28, 0xd1, 0xe1, 0x99, 0x6e, 0xd9, 0x66, 0x4c, 0x05, 0xb9,
+	0x65, 0x9b, 0xc6, 0x88, 0xea, 0xe8, 0x98, 0x58, 0x81, 0x0c, 0xea, 0xaa, 0xb9, 0x05, 0x32, 0x00,
+	0x2c, 0xd6, 0x4e, 0x01, 0x0e, 0x15, 0xfe, 0x02, 0xe0, 0xc5, 0xfc, 0x2e, 0xb3, 0x31, 0x6c, 0x2d,
+	0xf6, 0xa5, 0x10, 0xaf, 0x9f, 0x9a, 0x22, 0xd4, 0xfc, 0x19, 0x9c, 0x4e, 0xbe, 0xdf, 0xca, 0x10,
+	0x6f, 0x4b, 0x14, 0x20, 0x5e, 0x19, 0x11, 0x10, 0x1c, 0x2e, 0x3e, 0xf7, 0xb9, 0xdb, 0x4f, 0x36,
+	0xdf, 0xbb, 0x77, 0x28, 0x81, 0x87, 0x87, 0x12, 0x78, 0x74, 0x28, 0x81, 0xbf, 0x0e, 0x25, 0xf0,
+	0xfd, 0x91, 0x54, 0x78, 0x74, 0x24, 0x15, 0x7e, 0x3f, 0x92, 0x0a, 0x1f, 0x57, 0x07, 0x76, 0xab,
+	0x5b, 0xf1, 0x79, 0xd7, 0x6b, 0x5e, 0xcd, 0x09, 0xef, 0xef, 0x1e, 0xaf, 0xfe, 0x3f, 0x00, 0x2a,
+	0xe6, 0x63, 0xe1, 0xe9, 0x11, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -961,6 +1151,56 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgSetAutoCompoundResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompoundResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompoundResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1003,6 +1243,16 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegations of all the tokenize share records owned by an address.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// SetAutoCompound defines a method to enable or disable the auto-compounding
+	// of the rewards of a delegation.
+	//
+	// Since: cosmos-sdk 0.48
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -1076,6 +1326,24 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1107,6 +1375,16 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegations of all the tokenize share records owned by an address.
+	//
+	// Since: cosmos-sdk 0.48
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// SetAutoCompound defines a method to enable or disable the auto-compounding
+	// of the rewards of a delegation.
+	//
+	// Since: cosmos-sdk 0.48
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1134,6 +1412,12 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1265,6 +1549,42 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1297,6 +1617,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1781,58 +2109,195 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSetWithdrawAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
 
 func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
@@ -1994,6 +2459,63 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3255,6 +3777,356 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
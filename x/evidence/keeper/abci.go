@@ -13,23 +13,31 @@ import (
 )
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
-// misbehavior submitted by CometBFT. Currently, only equivocation is handled.
+// misbehavior submitted by CometBFT. Duplicate votes are handled as
+// equivocations, and light client attacks reported for each of their byzantine
+// validators are grouped and handled once all the evidence has been iterated.
 func (k Keeper) BeginBlocker(goCtx context.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	bi := k.cometInfo.GetCometBlockInfo(goCtx).GetEvidence()
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	var lightClientAttacks []comet.Evidence
 	for i := 0; i < bi.Len(); i++ {
 		switch bi.Get(i).Type() {
-		// It's still ongoing discussion how should we treat and slash attacks with
-		// premeditation. So for now we agree to treat them in the same way.
-		case comet.LightClientAttack, comet.DuplicateVote:
+		case comet.DuplicateVote:
 			evidence := types.FromABCIEvidence(bi.Get(i))
 			k.handleEquivocationEvidence(ctx, evidence)
 
+		case comet.LightClientAttack:
+			lightClientAttacks = append(lightClientAttacks, bi.Get(i))
+
 		default:
 			k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %x", bi.Get(i).Type()))
 		}
 	}
+
+	for _, evidence := range types.FromABCILightClientAttacks(lightClientAttacks) {
+		k.handleLightClientAttackEvidence(ctx, evidence)
+	}
 }
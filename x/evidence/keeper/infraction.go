@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/x/evidence/types"
 
@@ -21,17 +22,170 @@ import (
 // - the signing info does not exist (will panic)
 // - is already tombstoned
 //
-// TODO: Some of the invalid constraints listed above may need to be reconsidered
-// in the case of a lunatic attack.
+// Evidence submitted for a consensus key rotated by the validator is still
+// handled: the validator is found through its rotated consensus address and
+// slashed under its current consensus address.
 func (k Keeper) handleEquivocationEvidence(ctx sdk.Context, evidence *types.Equivocation) {
 	logger := k.Logger(ctx)
 	consAddr := evidence.GetConsensusAddress()
+	infractionHeight := evidence.GetHeight()
+	infractionTime := evidence.GetTime()
+
+	// Reject evidence if the double-sign is too old.
+	if k.isEvidenceExpired(ctx, infractionHeight, infractionTime) {
+		logger.Info(
+			"ignored equivocation; evidence too old",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return
+	}
+
+	if k.slashByzantineValidator(ctx, "equivocation", consAddr, evidence.GetValidatorPower(), infractionHeight, infractionTime) {
+		k.SetEvidence(ctx, evidence)
+	}
+}
+
+// handleLightClientAttackEvidence implements a light client attack evidence
+// handler. The evidence is validated against the historical info of the common
+// height, and each byzantine validator that was part of the validator set at
+// the common height is then slashed, jailed and tombstoned as for an
+// equivocation.
+//
+// The validator set of CometBFT at a height H is the staking validator set
+// after the end block of H-1-ValidatorUpdateDelay, which is the validator set
+// stored in the historical info of H-ValidatorUpdateDelay.
+//
+// The evidence is considered invalid if:
+// - the evidence is too old
+// - the time of the evidence is not the time of the block at the common height
+//
+// If the historical info required to validate the evidence does not exist, e.g.
+// it was pruned because of the HistoricalEntries staking parameter, each
+// byzantine validator is handled as an equivocation at the common height.
+//
+// A byzantine validator is ignored if it was not part of the validator set at
+// the common height, or if it cannot be slashed as an equivocating validator.
+func (k Keeper) handleLightClientAttackEvidence(ctx sdk.Context, evidence *types.LightClientAttack) {
+	logger := k.Logger(ctx)
+	commonHeight := evidence.GetHeight()
+	infractionTime := evidence.GetTime()
+
+	if k.isEvidenceExpired(ctx, commonHeight, infractionTime) {
+		logger.Info(
+			"ignored light client attack; evidence too old",
+			"common_height", commonHeight,
+			"infraction_time", infractionTime,
+		)
+		return
+	}
+
+	headerInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, commonHeight)
+	if !found {
+		logger.Info("historical info not found; handling light client attack as equivocations", "common_height", commonHeight)
+		k.handleLightClientAttackAsEquivocations(ctx, evidence)
+		return
+	}
+	if !headerInfo.Header.Time.Equal(infractionTime) {
+		logger.Info(
+			"ignored light client attack; time does not match the block at the common height",
+			"common_height", commonHeight,
+			"infraction_time", infractionTime,
+			"block_time", headerInfo.Header.Time,
+		)
+		return
+	}
+
+	// the validator set at the common height was stored ValidatorUpdateDelay
+	// blocks before it
+	valsetHeight := commonHeight - sdk.ValidatorUpdateDelay
+	valsetInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, valsetHeight)
+	if !found {
+		logger.Info("historical info not found; handling light client attack as equivocations", "common_height", commonHeight, "valset_height", valsetHeight)
+		k.handleLightClientAttackAsEquivocations(ctx, evidence)
+		return
+	}
+
+	bonded := make(map[string]bool, len(valsetInfo.Valset))
+	for _, validator := range valsetInfo.Valset {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			logger.Error("ignore historical validator; failed to get its consensus address", "validator", validator.OperatorAddress, "err", err)
+			continue
+		}
+		bonded[sdk.ConsAddress(consAddr).String()] = true
+	}
+
+	slashed := false
+	for _, byzantineValidator := range evidence.ByzantineValidators {
+		consAddr := byzantineValidator.GetConsensusAddress()
+		if !bonded[consAddr.String()] {
+			logger.Info(
+				"ignored light client attack validator; not in the validator set at the common height",
+				"validator", consAddr,
+				"common_height", commonHeight,
+			)
+			continue
+		}
+
+		if k.slashByzantineValidator(ctx, "light client attack", consAddr, byzantineValidator.Power, commonHeight, infractionTime) {
+			slashed = true
+		}
+	}
+
+	if slashed {
+		k.SetEvidence(ctx, evidence)
+	}
+}
+
+// handleLightClientAttackAsEquivocations handles each byzantine validator of a
+// light client attack as an equivocation at the common height. It is used when
+// the validator set at the common height is unknown.
+func (k Keeper) handleLightClientAttackAsEquivocations(ctx sdk.Context, evidence *types.LightClientAttack) {
+	for _, byzantineValidator := range evidence.ByzantineValidators {
+		k.handleEquivocationEvidence(ctx, &types.Equivocation{
+			Height:           evidence.CommonHeight,
+			Time:             evidence.Time,
+			Power:            byzantineValidator.Power,
+			ConsensusAddress: byzantineValidator.ConsensusAddress,
+		})
+	}
+}
+
+// isEvidenceExpired returns true if the evidence of an infraction at the given
+// height and time is stale, i.e. if the difference in time and number of
+// blocks is greater than the allowed parameters defined.
+func (k Keeper) isEvidenceExpired(ctx sdk.Context, infractionHeight int64, infractionTime time.Time) bool {
+	cp := ctx.ConsensusParams()
+	if cp.Evidence == nil {
+		return false
+	}
+
+	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
+	ageBlocks := ctx.BlockHeader().Height - infractionHeight
+
+	return ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks
+}
+
+// slashByzantineValidator slashes, jails and tombstones the validator of the
+// given consensus address for a double-sign infraction, and returns true if
+// it did. The validator is ignored if it is unbonded or does not exist, or is
+// already tombstoned.
+//
+// A validator which rotated its consensus key since the infraction is found
+// through its rotated consensus address and slashed under its current
+// consensus address.
+func (k Keeper) slashByzantineValidator(
+	ctx sdk.Context, infraction string, consAddr sdk.ConsAddress, power, infractionHeight int64, infractionTime time.Time,
+) bool {
+	logger := k.Logger(ctx)
 
 	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
 	if validator == nil || validator.IsUnbonded() {
 		// Defensive: Simulation doesn't take unbonding periods into account, and
 		// CometBFT might break this assumption at some point.
-		return
+		return false
 	}
 
 	if !validator.GetOperator().Empty() {
@@ -46,51 +200,35 @@ func (k Keeper) handleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 			// getting this coordination right, it is easier to relax the
 			// constraints and ignore evidence that cannot be handled.
 			logger.Error(fmt.Sprintf("ignore evidence; expected public key for validator %s not found", consAddr))
-			return
+			return false
 		}
 	}
 
-	// calculate the age of the evidence
-	infractionHeight := evidence.GetHeight()
-	infractionTime := evidence.GetTime()
-	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
-	ageBlocks := ctx.BlockHeader().Height - infractionHeight
-
-	// Reject evidence if the double-sign is too old. Evidence is considered stale
-	// if the difference in time and number of blocks is greater than the allowed
-	// parameters defined.
-	cp := ctx.ConsensusParams()
-	if cp.Evidence != nil {
-		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
-			logger.Info(
-				"ignored equivocation; evidence too old",
-				"validator", consAddr,
-				"infraction_height", infractionHeight,
-				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
-				"infraction_time", infractionTime,
-				"max_age_duration", cp.Evidence.MaxAgeDuration,
-			)
-			return
-		}
+	// The validator may have rotated its consensus key since the infraction, in
+	// which case its signing info is stored under its current consensus address.
+	currentConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ignore evidence; failed to get the consensus address of validator %s", consAddr), "err", err)
+		return false
 	}
 
-	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, currentConsAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
 
 	// ignore if the validator is already tombstoned
-	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+	if k.slashingKeeper.IsTombstoned(ctx, currentConsAddr) {
 		logger.Info(
-			"ignored equivocation; validator already tombstoned",
+			fmt.Sprintf("ignored %s; validator already tombstoned", infraction),
 			"validator", consAddr,
 			"infraction_height", infractionHeight,
 			"infraction_time", infractionTime,
 		)
-		return
+		return false
 	}
 
 	logger.Info(
-		"confirmed equivocation",
+		fmt.Sprintf("confirmed %s", infraction),
 		"validator", consAddr,
 		"infraction_height", infractionHeight,
 		"infraction_time", infractionTime,
@@ -110,19 +248,20 @@ func (k Keeper) handleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// to slash unbonding and rebonding delegations.
 	k.slashingKeeper.SlashWithInfractionReason(
 		ctx,
-		consAddr,
+		currentConsAddr,
 		k.slashingKeeper.SlashFractionDoubleSign(ctx),
-		evidence.GetValidatorPower(), distributionHeight,
+		power, distributionHeight,
 		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
 
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
 	if !validator.IsJailed() {
-		k.slashingKeeper.Jail(ctx, consAddr)
+		k.slashingKeeper.Jail(ctx, currentConsAddr)
 	}
 
-	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
-	k.slashingKeeper.Tombstone(ctx, consAddr)
-	k.SetEvidence(ctx, evidence)
+	k.slashingKeeper.JailUntil(ctx, currentConsAddr, types.DoubleSignJailEndTime)
+	k.slashingKeeper.Tombstone(ctx, currentConsAddr)
+
+	return true
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"cosmossdk.io/core/comet"
@@ -12,9 +13,15 @@ import (
 )
 
 // Evidence type constants
-const RouteEquivocation = "equivocation"
+const (
+	RouteEquivocation      = "equivocation"
+	RouteLightClientAttack = "light_client_attack"
+)
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &LightClientAttack{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -88,3 +95,118 @@ func FromABCIEvidence(e comet.Evidence) *Equivocation {
 		Time:             e.Time(),
 	}
 }
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.CommonHeight < 1 {
+		return fmt.Errorf("invalid light client attack common height: %d", e.CommonHeight)
+	}
+	if e.TotalPower < 1 {
+		return fmt.Errorf("invalid light client attack total power: %d", e.TotalPower)
+	}
+	if len(e.ByzantineValidators) == 0 {
+		return fmt.Errorf("invalid light client attack: no byzantine validators")
+	}
+
+	var byzantinePower int64
+	seen := make(map[string]bool, len(e.ByzantineValidators))
+	for _, v := range e.ByzantineValidators {
+		if v.ConsensusAddress == "" {
+			return fmt.Errorf("invalid light client attack validator consensus address: %s", v.ConsensusAddress)
+		}
+		if seen[v.ConsensusAddress] {
+			return fmt.Errorf("duplicate light client attack validator: %s", v.ConsensusAddress)
+		}
+		if v.Power < 1 {
+			return fmt.Errorf("invalid light client attack validator power: %d", v.Power)
+		}
+
+		seen[v.ConsensusAddress] = true
+		byzantinePower += v.Power
+	}
+	if byzantinePower > e.TotalPower {
+		return fmt.Errorf("light client attack byzantine power %d exceeds total power %d", byzantinePower, e.TotalPower)
+	}
+
+	return nil
+}
+
+// GetHeight returns the common height of the LightClientAttack.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.CommonHeight
+}
+
+// GetTime returns the time of the block at the common height of the
+// LightClientAttack.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetConsensusAddress returns the validator's consensus address at the common
+// height of the light client attack.
+func (v ByzantineValidator) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(v.ConsensusAddress)
+	return addr
+}
+
+// FromABCILightClientAttacks groups CometBFT light client attack Evidence
+// reported for the same common height, time and total power, one per byzantine
+// validator, into SDK Evidence using LightClientAttack as the concrete type.
+// The byzantine validators are sorted by consensus address.
+func FromABCILightClientAttacks(evidence []comet.Evidence) []*LightClientAttack {
+	type attackKey struct {
+		height     int64
+		time       time.Time
+		totalPower int64
+	}
+
+	var attacks []*LightClientAttack
+	indexes := make(map[attackKey]int)
+	for _, e := range evidence {
+		key := attackKey{height: e.Height(), time: e.Time().UTC(), totalPower: e.TotalVotingPower()}
+		i, ok := indexes[key]
+		if !ok {
+			i = len(attacks)
+			indexes[key] = i
+			attacks = append(attacks, &LightClientAttack{
+				CommonHeight: e.Height(),
+				Time:         e.Time(),
+				TotalPower:   e.TotalVotingPower(),
+			})
+		}
+
+		bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+		consAddr, err := sdk.Bech32ifyAddressBytes(bech32PrefixConsAddr, e.Validator().Address())
+		if err != nil {
+			panic(err)
+		}
+
+		attacks[i].ByzantineValidators = append(attacks[i].ByzantineValidators, ByzantineValidator{
+			ConsensusAddress: consAddr,
+			Power:            e.Validator().Power(),
+		})
+	}
+
+	for _, attack := range attacks {
+		sort.Slice(attack.ByzantineValidators, func(i, j int) bool {
+			return attack.ByzantineValidators[i].ConsensusAddress < attack.ByzantineValidators[j].ConsensusAddress
+		})
+	}
+
+	return attacks
+}
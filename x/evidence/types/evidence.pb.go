@@ -75,8 +75,103 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// LightClientAttack implements the Evidence interface and defines evidence of
+// a light client attack, in which a set of byzantine validators signed a block
+// conflicting with the block trusted by a light client at the common height.
+//
+// Since: cosmos-sdk 0.48
+type LightClientAttack struct {
+	// common_height is the height of the last block trusted by the attacked
+	// light client, at which the byzantine validators were bonded.
+	CommonHeight int64 `protobuf:"varint,1,opt,name=common_height,json=commonHeight,proto3" json:"common_height,omitempty"`
+	// time is the time of the block at the common height.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// total_power is the total power of the validator set at the common height.
+	TotalPower int64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// byzantine_validators are the validators which signed the conflicting block.
+	ByzantineValidators []ByzantineValidator `protobuf:"bytes,4,rep,name=byzantine_validators,json=byzantineValidators,proto3" json:"byzantine_validators"`
+}
+
+func (m *LightClientAttack) Reset()         { *m = LightClientAttack{} }
+func (m *LightClientAttack) String() string { return proto.CompactTextString(m) }
+func (*LightClientAttack) ProtoMessage()    {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
+// ByzantineValidator defines a validator taking part in a light client attack.
+//
+// Since: cosmos-sdk 0.48
+type ByzantineValidator struct {
+	// consensus_address is the validator consensus address at the common height.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// power is the validator power at the common height.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ByzantineValidator) Reset()         { *m = ByzantineValidator{} }
+func (m *ByzantineValidator) String() string { return proto.CompactTextString(m) }
+func (*ByzantineValidator) ProtoMessage()    {}
+func (*ByzantineValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *ByzantineValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ByzantineValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ByzantineValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ByzantineValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ByzantineValidator.Merge(m, src)
+}
+func (m *ByzantineValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ByzantineValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ByzantineValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ByzantineValidator proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*LightClientAttack)(nil), "cosmos.evidence.v1beta1.LightClientAttack")
+	proto.RegisterType((*ByzantineValidator)(nil), "cosmos.evidence.v1beta1.ByzantineValidator")
 }
 
 func init() {
@@ -84,31 +179,67 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x25, 0xa1, 0x52, 0x2f, 0xad, 0x44, 0x4c, 0x04, 0x26, 0x02, 0x3b, 0x0a, 0x08, 0x85,
+	0xa2, 0xda, 0x6a, 0xd9, 0x82, 0x18, 0x6a, 0x54, 0x89, 0x81, 0x01, 0x05, 0xc4, 0xc0, 0x62, 0x9d,
+	0xed, 0xc3, 0x3d, 0x25, 0xbe, 0x17, 0x7c, 0x97, 0x94, 0xf2, 0x0b, 0x10, 0x53, 0x7f, 0x42, 0xc7,
+	0x4e, 0xa8, 0x03, 0x3f, 0xa2, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0x19, 0xda, 0x9f, 0x81, 0x7c, 0xe7,
+	0xa4, 0x46, 0x81, 0x01, 0xa9, 0x8b, 0x75, 0xef, 0xbb, 0xf7, 0xdd, 0xf7, 0xde, 0xf7, 0x9e, 0xf1,
+	0x83, 0x08, 0x44, 0x0a, 0xc2, 0xa3, 0x13, 0x16, 0x53, 0x1e, 0x51, 0x6f, 0xb2, 0x15, 0x52, 0x49,
+	0xb6, 0x16, 0x80, 0x3b, 0xca, 0x40, 0x82, 0x79, 0x4b, 0xe7, 0xb9, 0x0b, 0xb8, 0xc8, 0x6b, 0x35,
+	0x48, 0xca, 0x38, 0x78, 0xea, 0xab, 0x73, 0x5b, 0xcd, 0x04, 0x12, 0x50, 0x47, 0x2f, 0x3f, 0x15,
+	0xa8, 0x93, 0x00, 0x24, 0x43, 0xea, 0xa9, 0x28, 0x1c, 0xbf, 0xf3, 0x24, 0x4b, 0xa9, 0x90, 0x24,
+	0x1d, 0x15, 0x09, 0xb7, 0xb5, 0x44, 0xa0, 0x99, 0x85, 0x9e, 0x0a, 0x3a, 0x17, 0x08, 0xaf, 0xed,
+	0xbe, 0x1f, 0xb3, 0x09, 0x44, 0x44, 0x32, 0xe0, 0xe6, 0x4d, 0xbc, 0xb2, 0x47, 0x59, 0xb2, 0x27,
+	0x2d, 0xd4, 0x46, 0xdd, 0x6a, 0xbf, 0x88, 0xcc, 0xa7, 0xb8, 0x96, 0x3f, 0x6b, 0x55, 0xda, 0xa8,
+	0x5b, 0xdf, 0x6e, 0xb9, 0x5a, 0xd3, 0x9d, 0x6b, 0xba, 0xaf, 0xe7, 0x9a, 0xfe, 0xfa, 0xe9, 0x0f,
+	0xc7, 0x38, 0xfc, 0xe9, 0xa0, 0xe3, 0xf3, 0x93, 0x0d, 0xd4, 0x57, 0x34, 0xb3, 0x89, 0xaf, 0x8d,
+	0x60, 0x9f, 0x66, 0x56, 0x55, 0xbd, 0xaa, 0x03, 0x73, 0x17, 0x37, 0x22, 0xe0, 0x82, 0x72, 0x31,
+	0x16, 0x01, 0x89, 0xe3, 0x8c, 0x0a, 0x61, 0xd5, 0xda, 0xa8, 0xbb, 0xea, 0x5b, 0xdf, 0xbe, 0x6e,
+	0x36, 0x8b, 0x52, 0x77, 0xf4, 0xcd, 0x2b, 0x99, 0x31, 0x9e, 0xf4, 0xaf, 0x2f, 0x28, 0x05, 0xde,
+	0xbb, 0xff, 0xe9, 0xc8, 0x31, 0x2e, 0x8e, 0x1c, 0xe3, 0xf3, 0xf9, 0xc9, 0x46, 0xe1, 0xe7, 0xa6,
+	0x88, 0x07, 0x5e, 0xb9, 0xb3, 0xce, 0x97, 0x0a, 0x6e, 0xbc, 0xc8, 0x7b, 0x79, 0x36, 0x64, 0x94,
+	0xcb, 0x1d, 0x29, 0x49, 0x34, 0x30, 0xef, 0xe1, 0xf5, 0x08, 0xd2, 0x14, 0x78, 0xf0, 0x47, 0xdb,
+	0x6b, 0x1a, 0x7c, 0x7e, 0x25, 0xcd, 0x3b, 0xb8, 0x2e, 0x41, 0x92, 0x61, 0x50, 0xb6, 0x00, 0x2b,
+	0xe8, 0xa5, 0xf2, 0x81, 0xe1, 0x66, 0x78, 0xf0, 0x91, 0x70, 0xc9, 0x38, 0x0d, 0x26, 0x64, 0xc8,
+	0x62, 0x22, 0x21, 0xcb, 0xad, 0xa8, 0x76, 0xeb, 0xdb, 0x8f, 0xdc, 0x7f, 0xac, 0x88, 0xeb, 0xcf,
+	0x49, 0x6f, 0xe6, 0x1c, 0x7f, 0x35, 0x2f, 0x40, 0x8b, 0xdf, 0x08, 0x97, 0xae, 0x45, 0xef, 0x61,
+	0xd9, 0xab, 0x3b, 0x25, 0xaf, 0x96, 0xac, 0xe9, 0xec, 0x63, 0x73, 0x59, 0xe0, 0xef, 0x33, 0x43,
+	0xff, 0x3b, 0xb3, 0xcb, 0x85, 0xa8, 0x94, 0x16, 0xa2, 0x57, 0xcb, 0xab, 0xf3, 0x9f, 0x1c, 0x4f,
+	0x6d, 0x74, 0x3a, 0xb5, 0xd1, 0xd9, 0xd4, 0x46, 0xbf, 0xa6, 0x36, 0x3a, 0x9c, 0xd9, 0xc6, 0xd9,
+	0xcc, 0x36, 0xbe, 0xcf, 0x6c, 0xe3, 0xed, 0x5d, 0xad, 0x20, 0xe2, 0x81, 0xcb, 0xc0, 0xfb, 0x70,
+	0xf9, 0x83, 0xc9, 0x83, 0x11, 0x15, 0xe1, 0x8a, 0x9a, 0xca, 0xe3, 0xdf, 0x03, 0x00, 0x0d, 0xd1,
+	0x64, 0x3d, 0x80, 0x03, 0x00, 0x00,
 }
 
+func (this *ByzantineValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ByzantineValidator)
+	if !ok {
+		that2, ok := that.(ByzantineValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +288,96 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ByzantineValidators) > 0 {
+		for iNdEx := len(m.ByzantineValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ByzantineValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.CommonHeight != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.CommonHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ByzantineValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ByzantineValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ByzantineValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +410,45 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommonHeight != 0 {
+		n += 1 + sovEvidence(uint64(m.CommonHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.TotalPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalPower))
+	}
+	if len(m.ByzantineValidators) > 0 {
+		for _, e := range m.ByzantineValidators {
+			l = e.Size()
+			n += 1 + l + sovEvidence(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ByzantineValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovEvidence(uint64(m.Power))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +608,262 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonHeight", wireType)
+			}
+			m.CommonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByzantineValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByzantineValidators = append(m.ByzantineValidators, ByzantineValidator{})
+			if err := m.ByzantineValidators[len(m.ByzantineValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ByzantineValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ByzantineValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ByzantineValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -82,6 +82,68 @@ func TestEvidenceAddressConversion(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
 }
 
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr1 := sdk.ConsAddress("foo_________________").String()
+	addr2 := sdk.ConsAddress("bar_________________").String()
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	validators := []types.ByzantineValidator{{addr1, 10}, {addr2, 20}}
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 30, validators}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 30, validators}, true},
+		{"invalid height", types.LightClientAttack{0, n, 30, validators}, true},
+		{"invalid total power", types.LightClientAttack{100, n, 0, validators}, true},
+		{"no byzantine validators", types.LightClientAttack{100, n, 30, nil}, true},
+		{"invalid address", types.LightClientAttack{100, n, 30, []types.ByzantineValidator{{"", 10}}}, true},
+		{"invalid power", types.LightClientAttack{100, n, 30, []types.ByzantineValidator{{addr1, 0}}}, true},
+		{"duplicate validator", types.LightClientAttack{100, n, 30, []types.ByzantineValidator{{addr1, 10}, {addr1, 10}}}, true},
+		{"byzantine power exceeds total power", types.LightClientAttack{100, n, 29, validators}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestFromABCILightClientAttacks(t *testing.T) {
+	n := time.Now().UTC()
+	val1 := validator{address: []byte("foo_________________"), power: 10}
+	val2 := validator{address: []byte("bar_________________"), power: 20}
+	val3 := validator{address: []byte("baz_________________"), power: 30}
+
+	attacks := types.FromABCILightClientAttacks([]comet.Evidence{
+		NewCometMisbehavior(10, 100, n, comet.LightClientAttack, val1),
+		NewCometMisbehavior(20, 100, n, comet.LightClientAttack, val3),
+		NewCometMisbehavior(10, 100, n, comet.LightClientAttack, val2),
+	})
+	require.Len(t, attacks, 2)
+
+	require.Equal(t, int64(10), attacks[0].GetHeight())
+	require.Equal(t, n, attacks[0].GetTime())
+	require.Equal(t, int64(100), attacks[0].TotalPower)
+	require.Equal(t, types.RouteLightClientAttack, attacks[0].Route())
+	require.Equal(t, []types.ByzantineValidator{
+		{ConsensusAddress: sdk.ConsAddress(val1.address).String(), Power: 10},
+		{ConsensusAddress: sdk.ConsAddress(val2.address).String(), Power: 20},
+	}, attacks[0].ByzantineValidators)
+	require.Equal(t, sdk.ConsAddress(val1.address), attacks[0].ByzantineValidators[0].GetConsensusAddress())
+	require.NoError(t, attacks[0].ValidateBasic())
+
+	require.Equal(t, int64(20), attacks[1].GetHeight())
+	require.Equal(t, []types.ByzantineValidator{
+		{ConsensusAddress: sdk.ConsAddress(val3.address).String(), Power: 30},
+	}, attacks[1].ByzantineValidators)
+	require.NotEqual(t, attacks[0].Hash(), attacks[1].Hash())
+}
+
 type Misbehavior struct {
 	height           int64
 	time             time.Time
@@ -18,6 +18,7 @@ type (
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
 		GetParams(ctx sdk.Context) (params stakingtypes.Params)
+		GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -108,6 +108,10 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			// message is logged.
 			cacheCtx, writeCache := ctx.CacheContext()
 			messages, err := proposal.GetMsgs()
+			// a multiple-choice proposal executes the messages of its winning option
+			if winner, ok := tallyResults.WinningOption(); ok && proposal.IsMultipleChoice() {
+				messages, err = proposal.Options[winner].GetMsgs()
+			}
 			if err != nil {
 				proposal.Status = v1.StatusFailed
 				tagValue = types.AttributeValueProposalFailed
@@ -156,7 +160,11 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			if err != nil {
 				return err
 			}
-			endTime := proposal.VotingStartTime.Add(*params.VotingPeriod)
+			votingParams, err := proposal.GetVotingParamsFromParams(params)
+			if err != nil {
+				return err
+			}
+			endTime := proposal.VotingStartTime.Add(*votingParams.VotingPeriod)
 			proposal.VotingEndTime = &endTime
 
 			err = keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
@@ -166,6 +174,10 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 
 			tagValue = types.AttributeValueExpeditedProposalRejected
 			logMsg = "expedited proposal converted to regular"
+		case proposal.Optimistic:
+			proposal.Status = v1.StatusRejected
+			tagValue = types.AttributeValueOptimisticProposalRejected
+			logMsg = "optimistic proposal rejected"
 		default:
 			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
@@ -187,6 +199,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			"proposal", proposal.Id,
 			"status", proposal.Status.String(),
 			"expedited", proposal.Expedited,
+			"optimistic", proposal.Optimistic,
 			"title", proposal.Title,
 			"results", logMsg,
 		)
@@ -688,3 +688,148 @@ func getDepositMultiplier(expedited bool) int64 {
 
 	return 1
 }
+
+func TestOptimisticProposalEndBlocker(t *testing.T) {
+	testcases := []struct {
+		name      string
+		voteNo    bool
+		expStatus v1.ProposalStatus
+		expResult string
+	}{
+		{
+			name:      "passes without enough no votes",
+			expStatus: v1.StatusPassed,
+			expResult: types.AttributeValueProposalPassed,
+		},
+		{
+			name:      "rejected with enough no votes",
+			voteNo:    true,
+			expStatus: v1.StatusRejected,
+			expResult: types.AttributeValueOptimisticProposalRejected,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+			header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+			proposer := addrs[0]
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			suite.StakingKeeper.EndBlocker(ctx)
+
+			params, _ := suite.GovKeeper.Params.Get(ctx)
+			params.OptimisticAuthorizedAddresses = []string{proposer.String()}
+			require.NoError(t, suite.GovKeeper.Params.Set(ctx, params))
+
+			proposal, err := suite.GovKeeper.SubmitOptimisticProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", proposer)
+			require.NoError(t, err)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+
+			if tc.voteNo {
+				err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), "")
+				require.NoError(t, err)
+			}
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+			events := ctx.EventManager().Events()
+			attr, eventOk := events.GetAttributes(types.AttributeKeyProposalResult)
+			require.True(t, eventOk)
+			require.Equal(t, tc.expResult, attr[len(attr)-1].Value)
+
+			proposal, err = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, proposal.Status)
+		})
+	}
+}
+
+func TestMultipleChoiceProposalEndBlocker(t *testing.T) {
+	testcases := []struct {
+		name         string
+		choices      []uint32
+		expStatus    v1.ProposalStatus
+		expRecipient int64
+	}{
+		{
+			name:         "winning option is executed",
+			choices:      []uint32{1, 1},
+			expStatus:    v1.StatusPassed,
+			expRecipient: 200,
+		},
+		{
+			name:      "tie is rejected",
+			choices:   []uint32{0, 1},
+			expStatus: v1.StatusRejected,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens)
+
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+			header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+			createValidators(t, stakingMsgSvr, ctx, valAddrs, []int64{10, 10})
+			suite.StakingKeeper.EndBlocker(ctx)
+
+			govAcct := suite.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+			recipient := addrs[2]
+			fund := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			require.NoError(t, suite.BankKeeper.SendCoinsFromAccountToModule(ctx, addrs[2], types.ModuleName, fund))
+			initialBalance := suite.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+
+			optionA, err := v1.NewProposalOption("Option A", []sdk.Msg{
+				banktypes.NewMsgSend(govAcct, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+			})
+			require.NoError(t, err)
+			optionB, err := v1.NewProposalOption("Option B", []sdk.Msg{
+				banktypes.NewMsgSend(govAcct, recipient, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))),
+			})
+			require.NoError(t, err)
+
+			proposal, err := suite.GovKeeper.SubmitMultipleChoiceProposal(ctx, []*v1.ProposalOption{optionA, optionB}, "", "title", "summary", addrs[0])
+			require.NoError(t, err)
+			require.True(t, proposal.IsMultipleChoice())
+			require.NoError(t, suite.GovKeeper.ActivateVotingPeriod(ctx, proposal))
+
+			for i, choice := range tc.choices {
+				err = suite.GovKeeper.AddChoiceVote(ctx, proposal.Id, addrs[i], v1.NewNonSplitVoteChoice(choice), "")
+				require.NoError(t, err)
+			}
+
+			params, _ := suite.GovKeeper.Params.Get(ctx)
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+			proposal, err = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, proposal.Status)
+
+			balance := suite.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+			require.Equal(t, initialBalance.Amount.AddRaw(tc.expRecipient), balance.Amount)
+		})
+	}
+}
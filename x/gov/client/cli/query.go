@@ -39,6 +39,8 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
 		GetCmdConstitution(),
+		GetCmdQueryGovernanceDelegation(ac),
+		GetCmdQueryConstituents(ac),
 	)
 
 	return govQueryCmd
@@ -675,3 +677,95 @@ func GetCmdConstitution() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryGovernanceDelegation implements the query governance delegation command.
+func GetCmdQueryGovernanceDelegation(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governance-delegation [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governance representative of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the representative a delegator has delegated its governance voting power to.
+
+Example:
+$ %s query gov governance-delegation cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovernanceDelegation(
+				cmd.Context(),
+				&v1.QueryGovernanceDelegationRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConstituents implements the query constituents command.
+func GetCmdQueryConstituents(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constituents [representative-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the constituents of a governance representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all delegators that have delegated their governance voting power to a representative.
+
+Example:
+$ %s query gov constituents cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Constituents(
+				cmd.Context(),
+				&v1.QueryConstituentsRequest{RepresentativeAddress: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "constituents")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -69,9 +69,12 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdDeposit(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdVoteChoice(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
 		NewCmdCancelProposal(),
+		NewCmdDelegateGovernance(),
+		NewCmdUndelegateGovernance(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -111,7 +114,19 @@ Where proposal.json contains:
   "deposit": "10stake"
   "title: "My proposal"
   "summary": "A short summary of my proposal",
-  "expedited": false
+  "expedited": false,
+  "optimistic": false
+}
+
+A multiple-choice proposal defines its options instead of messages, the
+messages of the winning option are executed:
+
+{
+  "options": [
+    {"title": "Option A", "messages": [...]},
+    {"title": "Option B", "messages": [...]}
+  ],
+  ...
 }
 
 metadata example: 
@@ -142,6 +157,12 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.Optimistic = proposal.Optimistic
+
+			msg.Options, err = proposal.parseOptions(clientCtx.Codec)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -406,3 +427,112 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 	return cmd
 }
+
+// NewCmdVoteChoice implements voting on a multiple-choice proposal.
+func NewCmdVoteChoice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-choice [proposal-id] [choices]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an option of an active multiple-choice proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active multiple-choice proposal. Choices are the
+indexes of the proposal options, either a single index or weighted indexes.
+
+Example:
+$ %s tx gov vote-choice 1 0 --from mykey
+$ %s tx gov vote-choice 1 0=0.6,2=0.4 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			choices, err := v1.WeightedVoteChoicesFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgVoteChoice(clientCtx.GetFromAddress(), proposalID, choices, metadata)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdDelegateGovernance implements delegating governance voting power to a representative.
+func NewCmdDelegateGovernance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-governance [representative]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate your governance voting power to a representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate your governance voting power to a representative account.
+Whenever the representative votes on a proposal and you did not vote yourself,
+your staked voting power is counted towards the representative's vote.
+
+Example:
+$ %s tx gov delegate-governance cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			representative, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgDelegateGovernance(clientCtx.GetFromAddress(), representative)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUndelegateGovernance implements removing a governance delegation.
+func NewCmdUndelegateGovernance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "undelegate-governance",
+		Args:    cobra.NoArgs,
+		Short:   "Remove your governance delegation to a representative",
+		Example: fmt.Sprintf(`$ %s tx gov undelegate-governance --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUndelegateGovernance(clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -82,12 +82,55 @@ func parseSubmitLegacyProposal(fs *pflag.FlagSet) (*legacyProposal, error) {
 // proposal defines the new Msg-based proposal.
 type proposal struct {
 	// Msgs defines an array of sdk.Msgs proto-JSON-encoded as Anys.
-	Messages  []json.RawMessage `json:"messages,omitempty"`
-	Metadata  string            `json:"metadata"`
-	Deposit   string            `json:"deposit"`
-	Title     string            `json:"title"`
-	Summary   string            `json:"summary"`
-	Expedited bool              `json:"expedited"`
+	Messages   []json.RawMessage `json:"messages,omitempty"`
+	Metadata   string            `json:"metadata"`
+	Deposit    string            `json:"deposit"`
+	Title      string            `json:"title"`
+	Summary    string            `json:"summary"`
+	Expedited  bool              `json:"expedited"`
+	Optimistic bool              `json:"optimistic"`
+	// Options defines the options of a multiple-choice proposal.
+	Options []proposalOption `json:"options,omitempty"`
+}
+
+// proposalOption defines an option of a multiple-choice proposal.
+type proposalOption struct {
+	Title    string            `json:"title"`
+	Messages []json.RawMessage `json:"messages,omitempty"`
+}
+
+// parseOptions parses the options of a multiple-choice proposal.
+func (p proposal) parseOptions(cdc codec.Codec) ([]*govv1.ProposalOption, error) {
+	options := make([]*govv1.ProposalOption, len(p.Options))
+	for i, option := range p.Options {
+		msgs, err := parseMsgs(cdc, option.Messages)
+		if err != nil {
+			return nil, err
+		}
+
+		options[i], err = govv1.NewProposalOption(option.Title, msgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return options, nil
+}
+
+// parseMsgs parses proto-JSON-encoded sdk.Msgs.
+func parseMsgs(cdc codec.Codec, msgsJSON []json.RawMessage) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msgsJSON))
+	for i, anyJSON := range msgsJSON {
+		var msg sdk.Msg
+		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
 }
 
 // parseSubmitProposal reads and parses the proposal.
@@ -104,15 +147,9 @@ func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk
 		return proposal, nil, nil, err
 	}
 
-	msgs := make([]sdk.Msg, len(proposal.Messages))
-	for i, anyJSON := range proposal.Messages {
-		var msg sdk.Msg
-		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
-		if err != nil {
-			return proposal, nil, nil, err
-		}
-
-		msgs[i] = msg
+	msgs, err := parseMsgs(cdc, proposal.Messages)
+	if err != nil {
+		return proposal, nil, nil, err
 	}
 
 	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
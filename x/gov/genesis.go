@@ -42,6 +42,12 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, *vote)
 	}
 
+	for _, delegation := range data.GovernanceDelegations {
+		if err := k.SetGovernanceDelegation(ctx, *delegation); err != nil {
+			panic(err)
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -86,6 +92,11 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 		return nil, err
 	}
 
+	governanceDelegations, err := k.GetAllGovernanceDelegations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var proposalsDeposits v1.Deposits
 	var proposalsVotes v1.Votes
 	for _, proposal := range proposals {
@@ -105,11 +116,12 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 	}
 
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		GovernanceDelegations: governanceDelegations,
 	}, nil
 }
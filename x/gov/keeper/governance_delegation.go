@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// DelegateGovernance delegates the governance voting power of delegator to
+// representative, replacing any previous governance delegation of delegator.
+// Delegations cannot be chained: a representative cannot delegate its voting
+// power, and a delegator cannot be a representative.
+func (keeper Keeper) DelegateGovernance(ctx context.Context, delegator, representative sdk.AccAddress) error {
+	if delegator.Equals(representative) {
+		return types.ErrInvalidGovDelegation.Wrap("cannot delegate to self")
+	}
+
+	has, err := keeper.GovernanceDelegations.Has(ctx, representative)
+	if err != nil {
+		return err
+	}
+	if has {
+		return types.ErrInvalidGovDelegation.Wrapf("representative %s has delegated its voting power", representative)
+	}
+
+	hasConstituents, err := keeper.HasConstituents(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if hasConstituents {
+		return types.ErrInvalidGovDelegation.Wrapf("delegator %s is a representative", delegator)
+	}
+
+	if err := keeper.removeGovernanceDelegation(ctx, delegator); err != nil && !errors.Is(err, types.ErrGovDelegationNotFound) {
+		return err
+	}
+
+	return keeper.SetGovernanceDelegation(ctx, v1.NewGovernanceDelegation(delegator, representative))
+}
+
+// UndelegateGovernance removes the governance delegation of delegator.
+func (keeper Keeper) UndelegateGovernance(ctx context.Context, delegator sdk.AccAddress) error {
+	return keeper.removeGovernanceDelegation(ctx, delegator)
+}
+
+// SetGovernanceDelegation stores a governance delegation, and indexes it by
+// representative.
+func (keeper Keeper) SetGovernanceDelegation(ctx context.Context, delegation v1.GovernanceDelegation) error {
+	delegator, err := keeper.authKeeper.StringToBytes(delegation.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	representative, err := keeper.authKeeper.StringToBytes(delegation.RepresentativeAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.GovernanceDelegations.Set(ctx, delegator, delegation); err != nil {
+		return err
+	}
+
+	return keeper.RepresentativeConstituents.Set(ctx, collections.Join(sdk.AccAddress(representative), sdk.AccAddress(delegator)))
+}
+
+// GetGovernanceDelegation returns the governance delegation of delegator.
+func (keeper Keeper) GetGovernanceDelegation(ctx context.Context, delegator sdk.AccAddress) (v1.GovernanceDelegation, error) {
+	delegation, err := keeper.GovernanceDelegations.Get(ctx, delegator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.GovernanceDelegation{}, types.ErrGovDelegationNotFound.Wrapf("delegator %s", delegator)
+	}
+
+	return delegation, err
+}
+
+// HasConstituents returns true if representative has been delegated some
+// governance voting power.
+func (keeper Keeper) HasConstituents(ctx context.Context, representative sdk.AccAddress) (bool, error) {
+	iter, err := keeper.RepresentativeConstituents.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](representative))
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		// no constituents
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
+
+// IterateConstituents iterates over the delegators having delegated their
+// governance voting power to representative, and performs a callback function.
+func (keeper Keeper) IterateConstituents(ctx context.Context, representative sdk.AccAddress, cb func(delegator sdk.AccAddress) error) error {
+	iter, err := keeper.RepresentativeConstituents.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](representative))
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		// no constituents
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+
+		if err := cb(key.K2()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAllGovernanceDelegations returns all the governance delegations.
+func (keeper Keeper) GetAllGovernanceDelegations(ctx context.Context) ([]*v1.GovernanceDelegation, error) {
+	iter, err := keeper.GovernanceDelegations.Iterate(ctx, nil)
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		// no governance delegations
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*v1.GovernanceDelegation, len(delegations))
+	for i := range delegations {
+		res[i] = &delegations[i]
+	}
+
+	return res, nil
+}
+
+// removeGovernanceDelegation deletes the governance delegation of delegator,
+// and its index entry.
+func (keeper Keeper) removeGovernanceDelegation(ctx context.Context, delegator sdk.AccAddress) error {
+	delegation, err := keeper.GetGovernanceDelegation(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	representative, err := keeper.authKeeper.StringToBytes(delegation.RepresentativeAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.GovernanceDelegations.Remove(ctx, delegator); err != nil {
+		return err
+	}
+
+	return keeper.RepresentativeConstituents.Remove(ctx, collections.Join(sdk.AccAddress(representative), delegator))
+}
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
@@ -307,6 +308,67 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// GovernanceDelegation returns the governance representative of a delegator
+func (q queryServer) GovernanceDelegation(ctx context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := q.k.GetGovernanceDelegation(ctx, delegator)
+	if err != nil {
+		if errors.IsOf(err, types.ErrGovDelegationNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governance delegation of %s not found", req.DelegatorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernanceDelegationResponse{GovernanceDelegation: &delegation}, nil
+}
+
+// Constituents returns the governance delegations to a representative
+func (q queryServer) Constituents(ctx context.Context, req *v1.QueryConstituentsRequest) (*v1.QueryConstituentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.RepresentativeAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty representative address")
+	}
+
+	representative, err := q.k.authKeeper.StringToBytes(req.RepresentativeAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	results, pageRes, err := query.CollectionFilteredPaginate[collections.Pair[sdk.AccAddress, sdk.AccAddress], collections.NoValue](
+		ctx, q.k.RepresentativeConstituents, req.Pagination, nil,
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](representative),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	delegations := make([]*v1.GovernanceDelegation, 0, len(results))
+	for _, res := range results {
+		delegation, err := q.k.GetGovernanceDelegation(ctx, res.Key.K2())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		delegations = append(delegations, &delegation)
+	}
+
+	return &v1.QueryConstituentsResponse{GovernanceDelegations: delegations, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
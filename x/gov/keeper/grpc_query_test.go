@@ -1641,3 +1641,44 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernanceDelegations() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	suite.Require().NoError(suite.govKeeper.DelegateGovernance(ctx, addrs[0], addrs[2]))
+	suite.Require().NoError(suite.govKeeper.DelegateGovernance(ctx, addrs[1], addrs[2]))
+
+	_, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{})
+	suite.Require().ErrorContains(err, "empty delegator address")
+
+	_, err = queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addrs[2].String()})
+	suite.Require().ErrorContains(err, "not found")
+
+	delegationRes, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[2].String(), delegationRes.GovernanceDelegation.RepresentativeAddress)
+
+	_, err = queryClient.Constituents(gocontext.Background(), &v1.QueryConstituentsRequest{})
+	suite.Require().ErrorContains(err, "empty representative address")
+
+	constituentsRes, err := queryClient.Constituents(gocontext.Background(), &v1.QueryConstituentsRequest{RepresentativeAddress: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(constituentsRes.GovernanceDelegations)
+
+	constituentsRes, err = queryClient.Constituents(gocontext.Background(), &v1.QueryConstituentsRequest{
+		RepresentativeAddress: addrs[2].String(),
+		Pagination:            &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(constituentsRes.GovernanceDelegations, 1)
+	suite.Require().Equal(uint64(2), constituentsRes.Pagination.Total)
+
+	constituentsRes, err = queryClient.Constituents(gocontext.Background(), &v1.QueryConstituentsRequest{
+		RepresentativeAddress: addrs[2].String(),
+		Pagination:            &query.PageRequest{Key: constituentsRes.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(constituentsRes.GovernanceDelegations, 1)
+	suite.Require().Equal(addrs[2].String(), constituentsRes.GovernanceDelegations[0].RepresentativeAddress)
+}
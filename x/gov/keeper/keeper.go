@@ -53,6 +53,11 @@ type Keeper struct {
 	Schema       collections.Schema
 	Constitution collections.Item[string]
 	Params       collections.Item[v1.Params]
+	// GovernanceDelegations maps delegators to their governance delegation.
+	GovernanceDelegations collections.Map[sdk.AccAddress, v1.GovernanceDelegation]
+	// RepresentativeConstituents indexes governance delegations by
+	// (representative, delegator).
+	RepresentativeConstituents collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -99,6 +104,14 @@ func NewKeeper(
 		authority:    authority,
 		Constitution: collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
 		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
+		GovernanceDelegations: collections.NewMap(
+			sb, types.GovernanceDelegationsKey, "governance_delegations",
+			sdk.AccAddressKey, codec.CollValue[v1.GovernanceDelegation](cdc),
+		),
+		RepresentativeConstituents: collections.NewKeySet(
+			sb, types.RepresentativeConstituentsKey, "representative_constituents",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
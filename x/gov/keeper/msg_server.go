@@ -48,7 +48,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 	}
 
 	// Check that either metadata or Msgs length is non nil.
-	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 {
+	if len(msg.Messages) == 0 && len(msg.Options) == 0 && len(msg.Metadata) == 0 {
 		return nil, errors.Wrap(govtypes.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
 	}
 
@@ -60,11 +60,33 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	initialDeposit := msg.GetInitialDeposit()
 
-	if err := k.validateInitialDeposit(ctx, initialDeposit, msg.Expedited); err != nil {
-		return nil, err
-	}
+	var proposal v1.Proposal
+	switch {
+	case len(msg.Options) > 0:
+		if msg.Expedited || msg.Optimistic {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "multiple-choice proposals cannot be expedited or optimistic")
+		}
+		if len(msg.Messages) > 0 {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalOptions, "multiple-choice proposals messages must be set in their options")
+		}
+		if err := k.validateInitialDeposit(ctx, initialDeposit, false); err != nil {
+			return nil, err
+		}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+		proposal, err = k.Keeper.SubmitMultipleChoiceProposal(ctx, msg.Options, msg.Metadata, msg.Title, msg.Summary, proposer)
+	case msg.Optimistic:
+		if msg.Expedited {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "optimistic proposals cannot be expedited")
+		}
+
+		proposal, err = k.Keeper.SubmitOptimisticProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	default:
+		if err := k.validateInitialDeposit(ctx, initialDeposit, msg.Expedited); err != nil {
+			return nil, err
+		}
+
+		proposal, err = k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +109,8 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	if votingStarted {
+	// optimistic proposals enter the voting period at submission
+	if votingStarted || proposal.Optimistic {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(govtypes.EventTypeSubmitProposal,
 				sdk.NewAttribute(govtypes.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.Id)),
@@ -235,6 +258,55 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	return &v1.MsgVoteWeightedResponse{}, nil
 }
 
+// VoteChoice implements the MsgServer.VoteChoice method.
+func (k msgServer) VoteChoice(goCtx context.Context, msg *v1.MsgVoteChoice) (*v1.MsgVoteChoiceResponse, error) {
+	accAddr, err := k.authKeeper.StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if len(msg.Choices) == 0 {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "vote choices cannot be empty")
+	}
+
+	totalWeight := math.LegacyNewDec(0)
+	usedChoices := make(map[uint32]bool)
+	for _, choice := range msg.Choices {
+		weight, err := sdk.NewDecFromStr(choice.Weight)
+		if err != nil {
+			return nil, errors.Wrapf(govtypes.ErrInvalidVote, "invalid weight: %s", err)
+		}
+		if !weight.IsPositive() {
+			return nil, errors.Wrap(govtypes.ErrInvalidVote, choice.String())
+		}
+		totalWeight = totalWeight.Add(weight)
+		if usedChoices[choice.Choice] {
+			return nil, errors.Wrap(govtypes.ErrInvalidVote, "duplicated vote choice")
+		}
+		usedChoices[choice.Choice] = true
+	}
+
+	if !totalWeight.Equal(math.LegacyNewDec(1)) {
+		return nil, errors.Wrapf(govtypes.ErrInvalidVote, "total weight must be 1.00, got %s", totalWeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err = k.Keeper.AddChoiceVote(ctx, msg.ProposalId, accAddr, msg.Choices, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{govtypes.ModuleName, "vote"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("proposal_id", strconv.FormatUint(msg.ProposalId, 10)),
+		},
+	)
+
+	return &v1.MsgVoteChoiceResponse{}, nil
+}
+
 // Deposit implements the MsgServer.Deposit method.
 func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDepositResponse, error) {
 	accAddr, err := k.authKeeper.StringToBytes(msg.Depositor)
@@ -290,6 +362,56 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// DelegateGovernance implements the MsgServer.DelegateGovernance method.
+func (k msgServer) DelegateGovernance(goCtx context.Context, msg *v1.MsgDelegateGovernance) (*v1.MsgDelegateGovernanceResponse, error) {
+	delegator, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	representative, err := k.authKeeper.StringToBytes(msg.RepresentativeAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid representative address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.DelegateGovernance(ctx, delegator, representative); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeDelegateGov,
+			sdk.NewAttribute(govtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(govtypes.AttributeKeyRepresentative, msg.RepresentativeAddress),
+		),
+	)
+
+	return &v1.MsgDelegateGovernanceResponse{}, nil
+}
+
+// UndelegateGovernance implements the MsgServer.UndelegateGovernance method.
+func (k msgServer) UndelegateGovernance(goCtx context.Context, msg *v1.MsgUndelegateGovernance) (*v1.MsgUndelegateGovernanceResponse, error) {
+	delegator, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UndelegateGovernance(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeUndelegateGov,
+			sdk.NewAttribute(govtypes.AttributeKeyDelegator, msg.DelegatorAddress),
+		),
+	)
+
+	return &v1.MsgUndelegateGovernanceResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
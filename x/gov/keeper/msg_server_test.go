@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -1619,6 +1620,48 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "invalid optimistic rejected threshold",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.OptimisticRejectedThreshold = "0"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "optimistic rejected threshold must be positive",
+		},
+		{
+			name: "invalid optimistic authorized address",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.OptimisticAuthorizedAddresses = []string{"invalid"}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid optimistic authorized address",
+		},
+		{
+			name: "duplicate optimistic authorized address",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.OptimisticAuthorizedAddresses = []string{authority, authority}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "duplicate optimistic authorized address",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1716,3 +1759,275 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposalReq() {
+	suite.reset()
+	addrs := suite.addrs
+	authorized, unauthorized := addrs[0], addrs[1]
+
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.OptimisticAuthorizedAddresses = []string{authorized.String()}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	cases := map[string]struct {
+		proposer  sdk.AccAddress
+		expedited bool
+		expErrMsg string
+	}{
+		"unauthorized proposer": {
+			proposer:  unauthorized,
+			expErrMsg: "is not allowed to submit optimistic proposals",
+		},
+		"expedited optimistic proposal": {
+			proposer:  authorized,
+			expedited: true,
+			expErrMsg: "optimistic proposals cannot be expedited",
+		},
+		"authorized proposer": {
+			proposer: authorized,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			msg, err := v1.NewMsgSubmitProposal(TestProposal, nil, tc.proposer.String(), "", "Proposal", "description of proposal", tc.expedited)
+			suite.Require().NoError(err)
+			msg.Optimistic = true
+
+			res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, err := suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+			suite.Require().NoError(err)
+			suite.Require().True(proposal.Optimistic)
+			suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+
+			// only No votes are allowed on optimistic proposals
+			_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(unauthorized, proposal.Id, v1.OptionYes, ""))
+			suite.Require().ErrorIs(err, types.ErrInvalidVote)
+			_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(unauthorized, proposal.Id, v1.OptionNo, ""))
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposalReq() {
+	suite.reset()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	optionA, err := v1.NewProposalOption("Option A", TestProposal[:1])
+	suite.Require().NoError(err)
+	optionB, err := v1.NewProposalOption("Option B", TestProposal[1:])
+	suite.Require().NoError(err)
+	emptyTitleOption, err := v1.NewProposalOption("", nil)
+	suite.Require().NoError(err)
+
+	cases := map[string]struct {
+		preRun    func(msg *v1.MsgSubmitProposal)
+		options   []*v1.ProposalOption
+		expErrMsg string
+	}{
+		"single option": {
+			options:   []*v1.ProposalOption{optionA},
+			expErrMsg: "got 1 options, expected between 2 and 10",
+		},
+		"empty option title": {
+			options:   []*v1.ProposalOption{optionA, emptyTitleOption},
+			expErrMsg: "option 1 title cannot be empty",
+		},
+		"messages outside of options": {
+			preRun: func(msg *v1.MsgSubmitProposal) {
+				suite.Require().NoError(msg.SetMsgs(TestProposal))
+			},
+			options:   []*v1.ProposalOption{optionA, optionB},
+			expErrMsg: "multiple-choice proposals messages must be set in their options",
+		},
+		"expedited multiple-choice proposal": {
+			preRun: func(msg *v1.MsgSubmitProposal) {
+				msg.Expedited = true
+			},
+			options:   []*v1.ProposalOption{optionA, optionB},
+			expErrMsg: "multiple-choice proposals cannot be expedited or optimistic",
+		},
+		"valid multiple-choice proposal": {
+			options: []*v1.ProposalOption{optionA, optionB},
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			msg, err := v1.NewMsgSubmitProposal(nil, nil, proposer.String(), "", "Proposal", "description of proposal", false)
+			suite.Require().NoError(err)
+			msg.Options = tc.options
+			if tc.preRun != nil {
+				tc.preRun(msg)
+			}
+
+			res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, err := suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+			suite.Require().NoError(err)
+			suite.Require().True(proposal.IsMultipleChoice())
+			suite.Require().Len(proposal.Options, 2)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVoteChoiceReq() {
+	suite.reset()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	minDeposit, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+
+	optionA, err := v1.NewProposalOption("Option A", TestProposal[:1])
+	suite.Require().NoError(err)
+	optionB, err := v1.NewProposalOption("Option B", TestProposal[1:])
+	suite.Require().NoError(err)
+
+	msg, err := v1.NewMsgSubmitProposal(nil, minDeposit.MinDeposit, proposer.String(), "", "Proposal", "description of proposal", false)
+	suite.Require().NoError(err)
+	msg.Options = []*v1.ProposalOption{optionA, optionB}
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	multipleChoiceID := res.ProposalId
+
+	msg, err = v1.NewMsgSubmitProposal(TestProposal, minDeposit.MinDeposit, proposer.String(), "", "Proposal", "description of proposal", false)
+	suite.Require().NoError(err)
+	res, err = suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	regularID := res.ProposalId
+
+	cases := map[string]struct {
+		msg       *v1.MsgVoteChoice
+		expErrMsg string
+	}{
+		"empty choices": {
+			msg:       v1.NewMsgVoteChoice(proposer, multipleChoiceID, nil, ""),
+			expErrMsg: "vote choices cannot be empty",
+		},
+		"duplicated choice": {
+			msg: v1.NewMsgVoteChoice(proposer, multipleChoiceID, v1.WeightedVoteChoices{
+				v1.NewWeightedVoteChoice(0, sdkmath.LegacyNewDecWithPrec(5, 1)),
+				v1.NewWeightedVoteChoice(0, sdkmath.LegacyNewDecWithPrec(5, 1)),
+			}, ""),
+			expErrMsg: "duplicated vote choice",
+		},
+		"total weight lower than one": {
+			msg: v1.NewMsgVoteChoice(proposer, multipleChoiceID, v1.WeightedVoteChoices{
+				v1.NewWeightedVoteChoice(0, sdkmath.LegacyNewDecWithPrec(5, 1)),
+			}, ""),
+			expErrMsg: "total weight must be 1.00",
+		},
+		"unknown choice": {
+			msg:       v1.NewMsgVoteChoice(proposer, multipleChoiceID, v1.NewNonSplitVoteChoice(2), ""),
+			expErrMsg: "invalid vote option",
+		},
+		"not a multiple-choice proposal": {
+			msg:       v1.NewMsgVoteChoice(proposer, regularID, v1.NewNonSplitVoteChoice(0), ""),
+			expErrMsg: "is not a multiple-choice proposal",
+		},
+		"valid weighted choices": {
+			msg: v1.NewMsgVoteChoice(proposer, multipleChoiceID, v1.WeightedVoteChoices{
+				v1.NewWeightedVoteChoice(0, sdkmath.LegacyNewDecWithPrec(6, 1)),
+				v1.NewWeightedVoteChoice(1, sdkmath.LegacyNewDecWithPrec(4, 1)),
+			}, ""),
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			_, err := suite.msgSrvr.VoteChoice(suite.ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+
+	// regular votes are rejected on multiple-choice proposals
+	_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, multipleChoiceID, v1.OptionYes, ""))
+	suite.Require().ErrorContains(err, "multiple-choice proposals can only be voted with choices")
+}
+
+func (suite *KeeperTestSuite) TestDelegateGovernanceReq() {
+	suite.reset()
+	addrs := suite.addrs
+
+	cases := map[string]struct {
+		preRun    func()
+		msg       *v1.MsgDelegateGovernance
+		expErrMsg string
+	}{
+		"self delegation": {
+			msg:       v1.NewMsgDelegateGovernance(addrs[0], addrs[0]),
+			expErrMsg: "cannot delegate to self",
+		},
+		"valid delegation": {
+			msg: v1.NewMsgDelegateGovernance(addrs[0], addrs[1]),
+		},
+		"redelegation to another representative": {
+			msg: v1.NewMsgDelegateGovernance(addrs[0], addrs[2]),
+		},
+		"representative cannot delegate": {
+			msg:       v1.NewMsgDelegateGovernance(addrs[2], addrs[1]),
+			expErrMsg: "delegator " + addrs[2].String() + " is a representative",
+		},
+		"cannot delegate to a delegator": {
+			msg:       v1.NewMsgDelegateGovernance(addrs[1], addrs[0]),
+			expErrMsg: "representative " + addrs[0].String() + " has delegated its voting power",
+		},
+	}
+
+	// cases are order dependent
+	for _, name := range []string{
+		"self delegation",
+		"valid delegation",
+		"redelegation to another representative",
+		"representative cannot delegate",
+		"cannot delegate to a delegator",
+	} {
+		tc := cases[name]
+		suite.Run(name, func() {
+			_, err := suite.msgSrvr.DelegateGovernance(suite.ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			delegation, err := suite.govKeeper.GetGovernanceDelegation(suite.ctx, addrs[0])
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.msg.RepresentativeAddress, delegation.RepresentativeAddress)
+		})
+	}
+
+	// the previous representative has no constituents anymore
+	hasConstituents, err := suite.govKeeper.HasConstituents(suite.ctx, addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().False(hasConstituents)
+
+	_, err = suite.msgSrvr.UndelegateGovernance(suite.ctx, v1.NewMsgUndelegateGovernance(addrs[0]))
+	suite.Require().NoError(err)
+	_, err = suite.govKeeper.GetGovernanceDelegation(suite.ctx, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrGovDelegationNotFound)
+	hasConstituents, err = suite.govKeeper.HasConstituents(suite.ctx, addrs[2])
+	suite.Require().NoError(err)
+	suite.Require().False(hasConstituents)
+
+	_, err = suite.msgSrvr.UndelegateGovernance(suite.ctx, v1.NewMsgUndelegateGovernance(addrs[0]))
+	suite.Require().ErrorIs(err, types.ErrGovDelegationNotFound)
+}
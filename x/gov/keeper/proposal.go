@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
@@ -18,6 +17,55 @@ import (
 
 // SubmitProposal creates a new proposal given an array of messages
 func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, nil, metadata, title, summary, proposer, expedited, false)
+}
+
+// SubmitOptimisticProposal creates a new optimistic proposal given an array of
+// messages. The proposer must be allowed by the optimistic authorized addresses
+// param. Optimistic proposals skip the deposit period and enter their voting
+// period right away.
+func (keeper Keeper) SubmitOptimisticProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if !params.IsOptimisticAuthorized(proposer.String()) {
+		return v1.Proposal{}, types.ErrInvalidProposer.Wrapf("%s is not allowed to submit optimistic proposals", proposer)
+	}
+
+	proposal, err := keeper.submitProposal(ctx, messages, nil, metadata, title, summary, proposer, false, true)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if err := keeper.ActivateVotingPeriod(ctx, proposal); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	return keeper.GetProposal(ctx, proposal.Id)
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple-choice proposal given
+// its options. The messages of the option winning the vote are executed.
+func (keeper Keeper) SubmitMultipleChoiceProposal(ctx context.Context, options []*v1.ProposalOption, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	if len(options) < 2 || len(options) > v1.MaxProposalOptions {
+		return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalOptions, "got %d options, expected between 2 and %d", len(options), v1.MaxProposalOptions)
+	}
+
+	for i, option := range options {
+		if option == nil || option.Title == "" {
+			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalOptions, "option %d title cannot be empty", i)
+		}
+		if err := keeper.assertMetadataLength(option.Title); err != nil {
+			return v1.Proposal{}, err
+		}
+	}
+
+	return keeper.submitProposal(ctx, nil, options, metadata, title, summary, proposer, false, false)
+}
+
+func (keeper Keeper) submitProposal(ctx context.Context, messages []sdk.Msg, options []*v1.ProposalOption, metadata, title, summary string, proposer sdk.AccAddress, expedited, optimistic bool) (v1.Proposal, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
@@ -37,51 +85,23 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 	}
 
 	// Will hold a comma-separated string of all Msg type URLs.
-	msgsStr := ""
-
-	// Loop through all messages and confirm that each has a handler and the gov module account
-	// as the only signer
-	for _, msg := range messages {
-		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
-
-		// perform a basic validation of the message
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
-			}
-		}
-
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, signers[0].String())
-		}
+	msgsStr, err := keeper.validateProposalMsgs(ctx, messages)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
 
-		// use the msg service router to see that there is a valid route for that message.
-		handler := keeper.router.Handler(msg)
-		if handler == nil {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	// the messages of every option of a multiple-choice proposal must be valid
+	for _, option := range options {
+		optionMsgs, err := option.GetMsgs()
+		if err != nil {
+			return v1.Proposal{}, err
 		}
 
-		// Only if it's a MsgExecLegacyContent do we try to execute the
-		// proposal in a cached context.
-		// For other Msgs, we do not verify the proposal messages any further.
-		// They may fail upon execution.
-		// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
-		if msg, ok := msg.(*v1.MsgExecLegacyContent); ok {
-			cacheCtx, _ := sdkCtx.CacheContext()
-			if _, err := handler(cacheCtx, msg); err != nil {
-				if errors.Is(types.ErrNoProposalHandlerExists, err) {
-					return v1.Proposal{}, err
-				}
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalContent, err.Error())
-			}
+		optionMsgsStr, err := keeper.validateProposalMsgs(ctx, optionMsgs)
+		if err != nil {
+			return v1.Proposal{}, err
 		}
-
+		msgsStr += optionMsgsStr
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -94,6 +114,16 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 		return v1.Proposal{}, err
 	}
 
+	// Expedited proposals use the expedited voting params, so they cannot
+	// contain messages whose voting params are overridden.
+	if expedited {
+		for _, msg := range messages {
+			if _, ok := params.MessageBasedParamsOf(sdk.MsgTypeURL(msg)); ok {
+				return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalType, "message %s has specific voting params and cannot be expedited", sdk.MsgTypeURL(msg))
+			}
+		}
+	}
+
 	submitTime := sdkCtx.BlockHeader().Time
 	depositPeriod := params.MaxDepositPeriod
 
@@ -101,6 +131,8 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.Optimistic = optimistic
+	proposal.Options = options
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -120,6 +152,56 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 	return proposal, nil
 }
 
+// validateProposalMsgs confirms that each proposal message is valid, has a
+// handler and the gov module account as the only signer. It returns a
+// comma-prefixed string of the messages type URLs.
+func (keeper Keeper) validateProposalMsgs(ctx context.Context, messages []sdk.Msg) (string, error) {
+	msgsStr := ""
+	for _, msg := range messages {
+		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
+
+		// perform a basic validation of the message
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return "", errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+			}
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return "", types.ErrInvalidSigner
+		}
+
+		// assert that the governance module account is the only signer of the messages
+		if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
+			return "", errorsmod.Wrapf(types.ErrInvalidSigner, signers[0].String())
+		}
+
+		// use the msg service router to see that there is a valid route for that message.
+		handler := keeper.router.Handler(msg)
+		if handler == nil {
+			return "", errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		}
+
+		// Only if it's a MsgExecLegacyContent do we try to execute the
+		// proposal in a cached context.
+		// For other Msgs, we do not verify the proposal messages any further.
+		// They may fail upon execution.
+		// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
+		if msg, ok := msg.(*v1.MsgExecLegacyContent); ok {
+			cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+			if _, err := handler(cacheCtx, msg); err != nil {
+				if errors.Is(types.ErrNoProposalHandlerExists, err) {
+					return "", err
+				}
+				return "", errorsmod.Wrap(types.ErrInvalidProposalContent, err.Error())
+			}
+		}
+	}
+
+	return msgsStr, nil
+}
+
 // CancelProposal will cancel proposal before the voting period ends
 func (keeper Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer string) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -369,18 +451,16 @@ func (keeper Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Propo
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	startTime := sdkCtx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	var votingPeriod *time.Duration
 	params, err := keeper.Params.Get(ctx)
 	if err != nil {
 		return err
 	}
 
-	if proposal.Expedited {
-		votingPeriod = params.ExpeditedVotingPeriod
-	} else {
-		votingPeriod = params.VotingPeriod
+	votingParams, err := proposal.GetVotingParamsFromParams(params)
+	if err != nil {
+		return err
 	}
-	endTime := proposal.VotingStartTime.Add(*votingPeriod)
+	endTime := proposal.VotingStartTime.Add(*votingParams.VotingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
 	err = keeper.SetProposal(ctx, proposal)
@@ -183,6 +183,31 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalMessageBasedParams() {
+	suite.reset()
+	votingPeriod := 7 * 24 * time.Hour
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.MessageBasedParams = []v1.MessageBasedParams{{
+		MsgTypeUrl:   sdk.MsgTypeURL(&v1.MsgExecLegacyContent{}),
+		VotingPeriod: &votingPeriod,
+		Threshold:    "0.66",
+	}}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	// expedited proposals cannot contain messages with specific voting params
+	_, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], true)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalType)
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], false)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal))
+
+	proposal, err = suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposal.VotingStartTime.Add(votingPeriod), *proposal.VotingEndTime)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
@@ -21,6 +21,12 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 	results[v1.OptionNo] = math.LegacyZeroDec()
 	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
 
+	// per option results of a multiple-choice proposal
+	optionResults := make([]math.LegacyDec, len(proposal.Options))
+	for i := range optionResults {
+		optionResults[i] = math.LegacyZeroDec()
+	}
+
 	totalVotingPower := math.LegacyZeroDec()
 	currValidators := make(map[string]v1.ValidatorGovInfo)
 
@@ -38,20 +44,26 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 		return false
 	})
 
-	err = keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) error {
-		// if validator, just record it in the map
-		voter, err := keeper.authKeeper.StringToBytes(vote.Voter)
-		if err != nil {
-			return err
+	// addVotingPower splits the voting power among the vote options or choices
+	addVotingPower := func(options v1.WeightedVoteOptions, choices v1.WeightedVoteChoices, votingPower math.LegacyDec) {
+		for _, option := range options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
 		}
-
-		valAddrStr := sdk.ValAddress(voter).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
+		for _, choice := range choices {
+			if int(choice.Choice) >= len(optionResults) {
+				continue
+			}
+			weight, _ := math.LegacyNewDecFromStr(choice.Weight)
+			optionResults[choice.Choice] = optionResults[choice.Choice].Add(votingPower.Mul(weight))
 		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
+	// tallyDelegations adds the voting power of the voter's delegations to the
+	// vote, and deducts it from the delegated-to validators.
+	tallyDelegations := func(voter sdk.AccAddress, vote v1.Vote) {
 		keeper.sk.IterateDelegations(sdkCtx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
 
@@ -63,17 +75,42 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
+				addVotingPower(vote.Options, vote.Choices, votingPower)
 			}
 
 			return false
 		})
+	}
+
+	// voters who voted directly, and governance representatives votes
+	voted := make(map[string]bool)
+	var representativeVotes []v1.Vote
+
+	err = keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) error {
+		// if validator, just record it in the map
+		voter, err := keeper.authKeeper.StringToBytes(vote.Voter)
+		if err != nil {
+			return err
+		}
+		voted[string(voter)] = true
+
+		valAddrStr := sdk.ValAddress(voter).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			val.Choices = vote.Choices
+			currValidators[valAddrStr] = val
+		}
+
+		// iterate over all delegations from voter, deduct from any delegated-to validators
+		tallyDelegations(voter, vote)
+
+		isRepresentative, err := keeper.HasConstituents(ctx, voter)
+		if err != nil {
+			return err
+		}
+		if isRepresentative {
+			representativeVotes = append(representativeVotes, vote)
+		}
 
 		return keeper.deleteVote(ctx, vote.ProposalId, voter)
 	})
@@ -82,21 +119,34 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 		return false, false, tallyResults, err
 	}
 
+	// governance representatives vote on behalf of their constituents who did
+	// not vote directly
+	for _, vote := range representativeVotes {
+		representative, err := keeper.authKeeper.StringToBytes(vote.Voter)
+		if err != nil {
+			return false, false, tallyResults, err
+		}
+
+		err = keeper.IterateConstituents(ctx, representative, func(constituent sdk.AccAddress) error {
+			if !voted[string(constituent)] {
+				tallyDelegations(constituent, vote)
+			}
+			return nil
+		})
+		if err != nil {
+			return false, false, tallyResults, err
+		}
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if len(val.Vote) == 0 {
+		if len(val.Vote) == 0 && len(val.Choices) == 0 {
 			continue
 		}
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
-		}
-		totalVotingPower = totalVotingPower.Add(votingPower)
+		addVotingPower(val.Vote, val.Choices, votingPower)
 	}
 
 	params, err := keeper.Params.Get(ctx)
@@ -104,6 +154,15 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 		return false, false, tallyResults, err
 	}
 	tallyResults = v1.NewTallyResultFromMap(results)
+	for _, optionResult := range optionResults {
+		tallyResults.OptionCounts = append(tallyResults.OptionCounts, optionResult.TruncateInt().String())
+	}
+
+	// the strictest params among the proposal messages overrides apply
+	votingParams, err := proposal.GetVotingParamsFromParams(params)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
@@ -111,34 +170,46 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 		return false, false, tallyResults, nil
 	}
 
+	// An optimistic proposal passes unless the No votes reach the rejected
+	// threshold of the total bonded stake
+	if proposal.Optimistic {
+		rejectedThreshold, err := math.LegacyNewDecFromStr(params.OptimisticRejectedThreshold)
+		if err != nil {
+			return false, false, tallyResults, err
+		}
+
+		percentNo := results[v1.OptionNo].Quo(math.LegacyNewDecFromInt(keeper.sk.TotalBondedTokens(sdkCtx)))
+		return percentNo.LT(rejectedThreshold), false, tallyResults, nil
+	}
+
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(keeper.sk.TotalBondedTokens(sdkCtx)))
-	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
+	quorum, _ := math.LegacyNewDecFromStr(votingParams.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
 	}
 
+	// A multiple-choice proposal passes if an option has strictly more votes
+	// than any other
+	if proposal.IsMultipleChoice() {
+		_, hasWinner := tallyResults.WinningOption()
+		return hasWinner, false, tallyResults, nil
+	}
+
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
 		return false, false, tallyResults, nil
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
-	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
+	vetoThreshold, _ := math.LegacyNewDecFromStr(votingParams.VetoThreshold)
 	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
 		return false, params.BurnVoteVeto, tallyResults, nil
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	// For expedited 2/3
-	var thresholdStr string
-	if proposal.Expedited {
-		thresholdStr = params.GetExpeditedThreshold()
-	} else {
-		thresholdStr = params.GetThreshold()
-	}
-
-	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
+	threshold, _ := math.LegacyNewDecFromStr(votingParams.Threshold)
 
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults, nil
@@ -15,43 +15,91 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string) error {
+	proposal, err := keeper.getVotableProposal(ctx, proposalID, metadata)
+	if err != nil {
+		return err
+	}
+
+	for _, option := range options {
+		if !v1.ValidWeightedVoteOption(*option) {
+			return errors.Wrap(types.ErrInvalidVote, option.String())
+		}
+	}
+
+	if proposal.IsMultipleChoice() {
+		return errors.Wrap(types.ErrInvalidVote, "multiple-choice proposals can only be voted with choices")
+	}
+
+	// optimistic proposals pass unless vetoed, so only No votes are meaningful
+	if proposal.Optimistic {
+		for _, option := range options {
+			if option.Option != v1.OptionNo {
+				return errors.Wrapf(types.ErrInvalidVote, "optimistic proposals can only be voted %s", v1.OptionNo)
+			}
+		}
+	}
+
+	return keeper.castVote(ctx, v1.NewVote(proposalID, voterAddr, options, metadata), voterAddr, options.String())
+}
+
+// AddChoiceVote adds a vote on a specific multiple-choice proposal
+func (keeper Keeper) AddChoiceVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, choices v1.WeightedVoteChoices, metadata string) error {
+	proposal, err := keeper.getVotableProposal(ctx, proposalID, metadata)
+	if err != nil {
+		return err
+	}
+
+	if !proposal.IsMultipleChoice() {
+		return errors.Wrapf(types.ErrInvalidVote, "proposal %d is not a multiple-choice proposal", proposalID)
+	}
+
+	for _, choice := range choices {
+		if !choice.IsValid(len(proposal.Options)) {
+			return errors.Wrap(types.ErrInvalidVote, choice.String())
+		}
+	}
+
+	return keeper.castVote(ctx, v1.NewChoiceVote(proposalID, voterAddr, choices, metadata), voterAddr, choices.String())
+}
+
+// getVotableProposal returns the proposal if it is in voting period and the
+// vote metadata is valid.
+func (keeper Keeper) getVotableProposal(ctx context.Context, proposalID uint64, metadata string) (v1.Proposal, error) {
 	// Check if proposal is in voting period.
 	store := keeper.storeService.OpenKVStore(ctx)
 	inVotingPeriod, err := store.Has(types.VotingPeriodProposalKey(proposalID))
 	if err != nil {
-		return err
+		return v1.Proposal{}, err
 	}
 
 	if !inVotingPeriod {
-		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+		return v1.Proposal{}, errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
 	err = keeper.assertMetadataLength(metadata)
 	if err != nil {
-		return err
+		return v1.Proposal{}, err
 	}
 
-	for _, option := range options {
-		if !v1.ValidWeightedVoteOption(*option) {
-			return errors.Wrap(types.ErrInvalidVote, option.String())
-		}
-	}
+	return keeper.GetProposal(ctx, proposalID)
+}
 
-	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
-	err = keeper.SetVote(ctx, vote)
+// castVote stores the vote, calls the hooks and emits the vote event.
+func (keeper Keeper) castVote(ctx context.Context, vote v1.Vote, voterAddr sdk.AccAddress, option string) error {
+	err := keeper.SetVote(ctx, vote)
 	if err != nil {
 		return err
 	}
 
 	// called after a vote on a proposal is cast
-	keeper.Hooks().AfterProposalVote(ctx, proposalID, voterAddr)
+	keeper.Hooks().AfterProposalVote(ctx, vote.ProposalId, voterAddr)
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyOption, option),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", vote.ProposalId)),
 		),
 	)
 
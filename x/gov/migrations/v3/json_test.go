@@ -85,6 +85,7 @@ func TestMigrateJSON(t *testing.T) {
 		]
 	},
 	"deposits": [],
+	"governance_delegations": [],
 	"params": null,
 	"proposals": [
 		{
@@ -94,6 +95,7 @@ func TestMigrateJSON(t *testing.T) {
 				"abstain_count": "0",
 				"no_count": "0",
 				"no_with_veto_count": "0",
+				"option_counts": [],
 				"yes_count": "0"
 			},
 			"id": "1",
@@ -109,6 +111,8 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"optimistic": false,
+			"options": [],
 			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
@@ -132,6 +136,7 @@ func TestMigrateJSON(t *testing.T) {
 	},
 	"votes": [
 		{
+			"choices": [],
 			"metadata": "",
 			"options": [
 				{
@@ -143,6 +148,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voter": "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"
 		},
 		{
+			"choices": [],
 			"metadata": "",
 			"options": [
 				{
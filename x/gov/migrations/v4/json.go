@@ -29,6 +29,8 @@ func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
+		defaultParams.OptimisticRejectedThreshold,
+		defaultParams.OptimisticAuthorizedAddresses,
 	)
 
 	return &v1.GenesisState{
@@ -59,6 +59,7 @@ func TestMigrateJSON(t *testing.T) {
 	"constitution": "",
 	"deposit_params": null,
 	"deposits": [],
+	"governance_delegations": [],
 	"params": {
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
@@ -72,6 +73,7 @@ func TestMigrateJSON(t *testing.T) {
 		"expedited_threshold": "0.667000000000000000",
 		"expedited_voting_period": "86400s",
 		"max_deposit_period": "172800s",
+		"message_based_params": [],
 		"min_deposit": [
 			{
 				"amount": "10000000",
@@ -79,6 +81,8 @@ func TestMigrateJSON(t *testing.T) {
 			}
 		],
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"optimistic_authorized_addresses": [],
+		"optimistic_rejected_threshold": "0.100000000000000000",
 		"proposal_cancel_dest": "",
 		"proposal_cancel_ratio": "0.500000000000000000",
 		"quorum": "0.334000000000000000",
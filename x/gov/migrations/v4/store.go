@@ -42,6 +42,8 @@ func migrateParams(ctx sdk.Context, store storetypes.KVStore, legacySubspace exp
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
+		defaultParams.OptimisticRejectedThreshold,
+		defaultParams.OptimisticAuthorizedAddresses,
 	)
 
 	bz, err := cdc.Marshal(&params)
@@ -0,0 +1,37 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v4 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v4"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The
+// migration includes:
+//
+// Addition of the optimistic rejected threshold parameter that is set to its default value.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	paramsBz, err := store.Get(v4.ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var params govv1.Params
+	err = cdc.Unmarshal(paramsBz, &params)
+	if err != nil {
+		return err
+	}
+
+	params.OptimisticRejectedThreshold = govv1.DefaultOptimisticRejectedThreshold.String()
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(v4.ParamsKey, bz)
+}
@@ -0,0 +1,44 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v4 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v4"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	// the params stored up to v5 lack the optimistic rejected threshold
+	oldParams := v1.DefaultParams()
+	oldParams.Quorum = "0.5"
+	oldParams.OptimisticRejectedThreshold = ""
+	store.Set(v4.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	// Run migrations.
+	storeService := runtime.NewKVStoreService(govKey)
+	err := v6.MigrateStore(ctx, storeService, cdc)
+	require.NoError(t, err)
+
+	// Check params
+	var params v1.Params
+	bz := store.Get(v4.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &params))
+	require.Equal(t, "0.5", params.Quorum)
+	require.Equal(t, v1.DefaultOptimisticRejectedThreshold.String(), params.OptimisticRejectedThreshold)
+	require.NoError(t, params.ValidateBasic())
+}
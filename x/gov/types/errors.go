@@ -29,4 +29,7 @@ var (
 	ErrInvalidProposal         = errors.Register(ModuleName, 21, "invalid proposal")
 	ErrDepositNotFound         = errors.Register(ModuleName, 22, "deposit is not found")
 	ErrVoteNotFound            = errors.Register(ModuleName, 23, "vote is not found")
+	ErrInvalidGovDelegation    = errors.Register(ModuleName, 24, "invalid governance delegation")
+	ErrGovDelegationNotFound   = errors.Register(ModuleName, 25, "governance delegation is not found")
+	ErrInvalidProposalOptions  = errors.Register(ModuleName, 26, "invalid proposal options")
 )
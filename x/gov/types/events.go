@@ -8,21 +8,26 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeDelegateGov      = "delegate_governance"
+	EventTypeUndelegateGov    = "undelegate_governance"
 
-	AttributeKeyProposalResult              = "proposal_result"
-	AttributeKeyOption                      = "option"
-	AttributeKeyProposalID                  = "proposal_id"
-	AttributeKeyProposalMessages            = "proposal_messages" // Msg type_urls in the proposal
-	AttributeKeyVotingPeriodStart           = "voting_period_start"
-	AttributeKeyProposalLog                 = "proposal_log"                // log of proposal execution
-	AttributeValueProposalDropped           = "proposal_dropped"            // didn't meet min deposit
-	AttributeValueProposalPassed            = "proposal_passed"             // met vote quorum
-	AttributeValueProposalRejected          = "proposal_rejected"           // didn't meet vote quorum
-	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't meet expedited vote quorum
-	AttributeValueProposalFailed            = "proposal_failed"             // error on proposal handler
-	AttributeValueProposalCanceled          = "proposal_canceled"           // error on proposal handler
+	AttributeKeyProposalResult               = "proposal_result"
+	AttributeKeyOption                       = "option"
+	AttributeKeyProposalID                   = "proposal_id"
+	AttributeKeyProposalMessages             = "proposal_messages" // Msg type_urls in the proposal
+	AttributeKeyVotingPeriodStart            = "voting_period_start"
+	AttributeKeyProposalLog                  = "proposal_log"                 // log of proposal execution
+	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
+	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
+	AttributeValueProposalRejected           = "proposal_rejected"            // didn't meet vote quorum
+	AttributeValueExpeditedProposalRejected  = "expedited_proposal_rejected"  // didn't meet expedited vote quorum
+	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
+	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // met optimistic rejected threshold
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
 	AttributeSignalDescription = "signal_description"
+	AttributeKeyDelegator      = "delegator"
+	AttributeKeyRepresentative = "representative"
 )
@@ -40,6 +40,12 @@ const (
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
 // - 0x30: Params
+//
+// - 0x31: Constitution
+//
+// - 0x32<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: GovernanceDelegation
+//
+// - 0x33<representativeAddrLen (1 Byte)><representativeAddr_Bytes><delegatorAddr_Bytes>: []byte{}
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -56,6 +62,14 @@ var (
 
 	// ConstitutionKey is the key string used to store the chain's constitution
 	ConstitutionKey = collections.NewPrefix(49)
+
+	// GovernanceDelegationsKey is the prefix of the governance delegations,
+	// keyed by delegator address.
+	GovernanceDelegationsKey = collections.NewPrefix(50)
+
+	// RepresentativeConstituentsKey is the prefix of the governance
+	// delegations index by representative address.
+	RepresentativeConstituentsKey = collections.NewPrefix(51)
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
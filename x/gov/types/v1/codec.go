@@ -18,8 +18,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDeposit{}, "cosmos-sdk/v1/MsgDeposit")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/v1/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteChoice{}, "cosmos-sdk/v1/MsgVoteChoice")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateGovernance{}, "cosmos-sdk/v1/MsgDelegateGovernance")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateGovernance{}, "cosmos-sdk/v1/MsgUndelegateGovernance")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -28,9 +31,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSubmitProposal{},
 		&MsgVote{},
 		&MsgVoteWeighted{},
+		&MsgVoteChoice{},
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
+		&MsgDelegateGovernance{},
+		&MsgUndelegateGovernance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
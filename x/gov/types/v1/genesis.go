@@ -2,6 +2,7 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
@@ -33,7 +34,37 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
-	return data.Params.ValidateBasic()
+	if err := data.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return validateGovernanceDelegations(data.GovernanceDelegations)
+}
+
+// validateGovernanceDelegations checks that the governance delegations are
+// valid, unique per delegator, and not chained.
+func validateGovernanceDelegations(delegations []*GovernanceDelegation) error {
+	delegators := make(map[string]bool, len(delegations))
+	representatives := make(map[string]bool, len(delegations))
+	for _, delegation := range delegations {
+		if err := delegation.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if delegators[delegation.DelegatorAddress] {
+			return fmt.Errorf("duplicate governance delegation for delegator %s", delegation.DelegatorAddress)
+		}
+		delegators[delegation.DelegatorAddress] = true
+		representatives[delegation.RepresentativeAddress] = true
+	}
+
+	for representative := range representatives {
+		if delegators[representative] {
+			return fmt.Errorf("representative %s cannot delegate its voting power", representative)
+		}
+	}
+
+	return nil
 }
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -6,8 +6,8 @@ package v1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -176,6 +176,122 @@ func (m *WeightedVoteOption) GetWeight() string {
 	return ""
 }
 
+// WeightedVoteChoice defines a unit of vote for vote split among the options of
+// a multiple-choice proposal.
+//
+// Since: cosmos-sdk 0.48
+type WeightedVoteChoice struct {
+	// choice is the index of the chosen proposal option, it must not contain
+	// duplicate choices.
+	Choice uint32 `protobuf:"varint,1,opt,name=choice,proto3" json:"choice,omitempty"`
+	// weight is the vote weight associated with the choice.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedVoteChoice) Reset()         { *m = WeightedVoteChoice{} }
+func (m *WeightedVoteChoice) String() string { return proto.CompactTextString(m) }
+func (*WeightedVoteChoice) ProtoMessage()    {}
+func (*WeightedVoteChoice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+func (m *WeightedVoteChoice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteChoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteChoice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteChoice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteChoice.Merge(m, src)
+}
+func (m *WeightedVoteChoice) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteChoice) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteChoice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteChoice proto.InternalMessageInfo
+
+func (m *WeightedVoteChoice) GetChoice() uint32 {
+	if m != nil {
+		return m.Choice
+	}
+	return 0
+}
+
+func (m *WeightedVoteChoice) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+// ProposalOption defines an option of a multiple-choice proposal.
+//
+// Since: cosmos-sdk 0.48
+type ProposalOption struct {
+	// title is the title of the option.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// messages are the arbitrary messages to be executed if the option wins.
+	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ProposalOption) Reset()         { *m = ProposalOption{} }
+func (m *ProposalOption) String() string { return proto.CompactTextString(m) }
+func (*ProposalOption) ProtoMessage()    {}
+func (*ProposalOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
+}
+func (m *ProposalOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalOption.Merge(m, src)
+}
+func (m *ProposalOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalOption proto.InternalMessageInfo
+
+func (m *ProposalOption) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ProposalOption) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
@@ -184,14 +300,14 @@ type Deposit struct {
 	// depositor defines the deposit addresses from the proposals.
 	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	// amount to be deposited by depositor.
-	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+	Amount []types1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
 }
 
 func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +350,7 @@ func (m *Deposit) GetDepositor() string {
 	return ""
 }
 
-func (m *Deposit) GetAmount() []types.Coin {
+func (m *Deposit) GetAmount() []types1.Coin {
 	if m != nil {
 		return m.Amount
 	}
@@ -246,7 +362,7 @@ type Proposal struct {
 	// id defines the unique id of the proposal.
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// messages are the arbitrary messages to be executed if the proposal passes.
-	Messages []*types1.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
 	// status defines the proposal status.
 	Status ProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"status,omitempty"`
 	// final_tally_result is the final tally result of the proposal. When
@@ -258,7 +374,7 @@ type Proposal struct {
 	// deposit_end_time is the end time for deposition.
 	DepositEndTime *time.Time `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3,stdtime" json:"deposit_end_time,omitempty"`
 	// total_deposit is the total deposit on the proposal.
-	TotalDeposit []types.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit"`
+	TotalDeposit []types1.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit"`
 	// voting_start_time is the starting time to vote on a proposal.
 	VotingStartTime *time.Time `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time,omitempty"`
 	// voting_end_time is the end time of voting on a proposal.
@@ -281,13 +397,24 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// optimistic defines if the proposal is optimistic. An optimistic proposal
+	// passes at the end of its voting period unless enough No votes are cast.
+	//
+	// Since: cosmos-sdk 0.48
+	Optimistic bool `protobuf:"varint,15,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
+	// options are the options of a multiple-choice proposal. A multiple-choice
+	// proposal has no messages of its own, the messages of the winning option
+	// are executed instead.
+	//
+	// Since: cosmos-sdk 0.48
+	Options []*ProposalOption `protobuf:"bytes,16,rep,name=options,proto3" json:"options,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{2}
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,7 +450,7 @@ func (m *Proposal) GetId() uint64 {
 	return 0
 }
 
-func (m *Proposal) GetMessages() []*types1.Any {
+func (m *Proposal) GetMessages() []*types.Any {
 	if m != nil {
 		return m.Messages
 	}
@@ -358,7 +485,7 @@ func (m *Proposal) GetDepositEndTime() *time.Time {
 	return nil
 }
 
-func (m *Proposal) GetTotalDeposit() []types.Coin {
+func (m *Proposal) GetTotalDeposit() []types1.Coin {
 	if m != nil {
 		return m.TotalDeposit
 	}
@@ -414,6 +541,20 @@ func (m *Proposal) GetExpedited() bool {
 	return false
 }
 
+func (m *Proposal) GetOptimistic() bool {
+	if m != nil {
+		return m.Optimistic
+	}
+	return false
+}
+
+func (m *Proposal) GetOptions() []*ProposalOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -424,13 +565,18 @@ type TallyResult struct {
 	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
 	// no_with_veto_count is the number of no with veto votes on a proposal.
 	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+	// option_counts are the number of votes on each option of a
+	// multiple-choice proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	OptionCounts []string `protobuf:"bytes,5,rep,name=option_counts,json=optionCounts,proto3" json:"option_counts,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,6 +633,13 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+func (m *TallyResult) GetOptionCounts() []string {
+	if m != nil {
+		return m.OptionCounts
+	}
+	return nil
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -498,13 +651,17 @@ type Vote struct {
 	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
 	// metadata is any  arbitrary metadata to attached to the vote.
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// choices is the weighted vote choices on a multiple-choice proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	Choices []*WeightedVoteChoice `protobuf:"bytes,6,rep,name=choices,proto3" json:"choices,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,12 +718,19 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+func (m *Vote) GetChoices() []*WeightedVoteChoice {
+	if m != nil {
+		return m.Choices
+	}
+	return nil
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 //
 // Deprecated: Do not use.
 type DepositParams struct {
 	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	MinDeposit []types1.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
 	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	// months.
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
@@ -576,7 +740,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +769,7 @@ func (m *DepositParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 
-func (m *DepositParams) GetMinDeposit() []types.Coin {
+func (m *DepositParams) GetMinDeposit() []types1.Coin {
 	if m != nil {
 		return m.MinDeposit
 	}
@@ -631,7 +795,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +849,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -740,21 +904,21 @@ func (m *TallyParams) GetVetoThreshold() string {
 // Since: cosmos-sdk 0.47
 type Params struct {
 	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	MinDeposit []types1.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
 	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	// months.
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -773,21 +937,36 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.48
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
-	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
+	//  Minimum expedited deposit for a proposal to enter voting period.
+	ExpeditedMinDeposit []types1.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
 	// burn deposits if the proposal does not enter voting period
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// Voting parameter overrides, keyed by message type URL. A proposal uses the
+	// strictest parameters among its messages' overrides and the default ones.
+	//
+	// Since: cosmos-sdk 0.48
+	MessageBasedParams []MessageBasedParams `protobuf:"bytes,16,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params"`
+	// Minimum proportion of No votes to total bonded stake for an optimistic
+	// proposal to be rejected. Default value: 0.1.
+	//
+	// Since: cosmos-sdk 0.48
+	OptimisticRejectedThreshold string `protobuf:"bytes,17,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
+	// Addresses allowed to submit optimistic proposals. If empty, optimistic
+	// proposals are disabled.
+	//
+	// Since: cosmos-sdk 0.48
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,18,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,7 +995,7 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *Params) GetMinDeposit() []types.Coin {
+func (m *Params) GetMinDeposit() []types1.Coin {
 	if m != nil {
 		return m.MinDeposit
 	}
@@ -893,7 +1072,7 @@ func (m *Params) GetExpeditedThreshold() string {
 	return ""
 }
 
-func (m *Params) GetExpeditedMinDeposit() []types.Coin {
+func (m *Params) GetExpeditedMinDeposit() []types1.Coin {
 	if m != nil {
 		return m.ExpeditedMinDeposit
 	}
@@ -921,10 +1100,180 @@ func (m *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (m *Params) GetMessageBasedParams() []MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticRejectedThreshold() string {
+	if m != nil {
+		return m.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetOptimisticAuthorizedAddresses() []string {
+	if m != nil {
+		return m.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
+// MessageBasedParams defines voting parameters overriding the default ones for
+// proposals containing a given message type. Empty fields inherit the default
+// parameters.
+//
+// Since: cosmos-sdk 0.48
+type MessageBasedParams struct {
+	// msg_type_url is the type URL of the message the parameters apply to.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,2,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold string `protobuf:"bytes,5,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+}
+
+func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
+func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParams) ProtoMessage()    {}
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+}
+func (m *MessageBasedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+
+func (m *MessageBasedParams) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *MessageBasedParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+// GovernanceDelegation defines a delegation of the governance voting power of
+// an account to a representative, voting on its behalf unless the delegator
+// votes directly.
+//
+// Since: cosmos-sdk 0.48
+type GovernanceDelegation struct {
+	// delegator_address is the address delegating its voting power.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// representative_address is the address voting on behalf of the delegator.
+	RepresentativeAddress string `protobuf:"bytes,2,opt,name=representative_address,json=representativeAddress,proto3" json:"representative_address,omitempty"`
+}
+
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernanceDelegation) GetRepresentativeAddress() string {
+	if m != nil {
+		return m.RepresentativeAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
+	proto.RegisterType((*WeightedVoteChoice)(nil), "cosmos.gov.v1.WeightedVoteChoice")
+	proto.RegisterType((*ProposalOption)(nil), "cosmos.gov.v1.ProposalOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
@@ -933,99 +1282,119 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*GovernanceDelegation)(nil), "cosmos.gov.v1.GovernanceDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x36, 0x75, 0xd7, 0xd1, 0xc5, 0xca, 0xd8, 0x89, 0x69, 0x27, 0x96, 0x1c, 0x21, 0x08, 0xfc,
-	0xe7, 0x22, 0xfd, 0x4e, 0x9a, 0x2e, 0x9a, 0x02, 0x85, 0x64, 0x31, 0x0d, 0x8d, 0xc4, 0x52, 0x29,
-	0x45, 0x4e, 0xba, 0x21, 0x28, 0x71, 0x22, 0x13, 0x15, 0x39, 0x2a, 0x39, 0x52, 0xac, 0x47, 0xe8,
-	0x2e, 0xcb, 0xae, 0x8a, 0x2e, 0xbb, 0xec, 0x22, 0xe8, 0x33, 0x64, 0x55, 0x04, 0xe9, 0xa2, 0xdd,
-	0x34, 0x29, 0x92, 0x45, 0x81, 0x3c, 0x45, 0xc1, 0xe1, 0x50, 0x94, 0x64, 0xb5, 0xb6, 0xb3, 0xb1,
-	0xc5, 0x73, 0xbe, 0xef, 0x9b, 0x33, 0xe7, 0x32, 0x43, 0xc2, 0x5a, 0x97, 0x38, 0x26, 0x71, 0xca,
-	0x3d, 0x32, 0x2a, 0x8f, 0x76, 0xdc, 0x7f, 0xa5, 0x81, 0x4d, 0x28, 0x41, 0x19, 0xcf, 0x51, 0x72,
-	0x2d, 0xa3, 0x9d, 0x8d, 0x3c, 0xc7, 0x75, 0x34, 0x07, 0x97, 0x47, 0x3b, 0x1d, 0x4c, 0xb5, 0x9d,
-	0x72, 0x97, 0x18, 0x96, 0x07, 0xdf, 0x58, 0xed, 0x91, 0x1e, 0x61, 0x3f, 0xcb, 0xee, 0x2f, 0x6e,
-	0x2d, 0xf4, 0x08, 0xe9, 0xf5, 0x71, 0x99, 0x3d, 0x75, 0x86, 0x4f, 0xcb, 0xd4, 0x30, 0xb1, 0x43,
-	0x35, 0x73, 0xc0, 0x01, 0xeb, 0xf3, 0x00, 0xcd, 0x1a, 0x73, 0x57, 0x7e, 0xde, 0xa5, 0x0f, 0x6d,
-	0x8d, 0x1a, 0xc4, 0x5f, 0x71, 0xdd, 0x8b, 0x48, 0xf5, 0x16, 0xe5, 0xd1, 0x7a, 0xae, 0x73, 0x9a,
-	0x69, 0x58, 0xa4, 0xcc, 0xfe, 0x7a, 0xa6, 0x22, 0x01, 0x74, 0x80, 0x8d, 0xde, 0x21, 0xc5, 0x7a,
-	0x9b, 0x50, 0x5c, 0x1f, 0xb8, 0x4a, 0x68, 0x07, 0x62, 0x84, 0xfd, 0x12, 0x85, 0x2d, 0x61, 0x3b,
-	0x7b, 0x6b, 0xbd, 0x34, 0xb3, 0xeb, 0x52, 0x00, 0x55, 0x38, 0x10, 0x5d, 0x85, 0xd8, 0x33, 0x26,
-	0x24, 0x86, 0xb6, 0x84, 0xed, 0x64, 0x35, 0xfb, 0xfa, 0xc5, 0x4d, 0xe0, 0xac, 0x1a, 0xee, 0x2a,
-	0xdc, 0x5b, 0xfc, 0x51, 0x80, 0x78, 0x0d, 0x0f, 0x88, 0x63, 0x50, 0x54, 0x80, 0xd4, 0xc0, 0x26,
-	0x03, 0xe2, 0x68, 0x7d, 0xd5, 0xd0, 0xd9, 0x5a, 0x11, 0x05, 0x7c, 0x93, 0xac, 0xa3, 0x4f, 0x21,
-	0xa9, 0x7b, 0x58, 0x62, 0x73, 0x5d, 0xf1, 0xf5, 0x8b, 0x9b, 0xab, 0x5c, 0xb7, 0xa2, 0xeb, 0x36,
-	0x76, 0x9c, 0x26, 0xb5, 0x0d, 0xab, 0xa7, 0x04, 0x50, 0xf4, 0x39, 0xc4, 0x34, 0x93, 0x0c, 0x2d,
-	0x2a, 0x86, 0xb7, 0xc2, 0xdb, 0xa9, 0x20, 0x7e, 0xb7, 0x4c, 0x25, 0x5e, 0xa6, 0xd2, 0x2e, 0x31,
-	0xac, 0x6a, 0xf2, 0xe5, 0x9b, 0xc2, 0xd2, 0x4f, 0x7f, 0xff, 0x7c, 0x4d, 0x50, 0x38, 0xa7, 0xf8,
-	0x36, 0x0a, 0x89, 0x06, 0x0f, 0x02, 0x65, 0x21, 0x34, 0x09, 0x2d, 0x64, 0xe8, 0xe8, 0xff, 0x90,
-	0x30, 0xb1, 0xe3, 0x68, 0x3d, 0xec, 0x88, 0x21, 0x26, 0xbe, 0x5a, 0xf2, 0x2a, 0x52, 0xf2, 0x2b,
-	0x52, 0xaa, 0x58, 0x63, 0x65, 0x82, 0x42, 0x77, 0x20, 0xe6, 0x50, 0x8d, 0x0e, 0x1d, 0x31, 0xcc,
-	0x92, 0xb9, 0x39, 0x97, 0x4c, 0x7f, 0xa9, 0x26, 0x03, 0x29, 0x1c, 0x8c, 0xee, 0x03, 0x7a, 0x6a,
-	0x58, 0x5a, 0x5f, 0xa5, 0x5a, 0xbf, 0x3f, 0x56, 0x6d, 0xec, 0x0c, 0xfb, 0x54, 0x8c, 0x6c, 0x09,
-	0xdb, 0xa9, 0x5b, 0x1b, 0x73, 0x12, 0x2d, 0x17, 0xa2, 0x30, 0x84, 0x92, 0x63, 0xac, 0x29, 0x0b,
-	0xaa, 0x40, 0xca, 0x19, 0x76, 0x4c, 0x83, 0xaa, 0x6e, 0x9b, 0x89, 0x51, 0x2e, 0x31, 0x1f, 0x75,
-	0xcb, 0xef, 0xc1, 0x6a, 0xe4, 0xf9, 0xdb, 0x82, 0xa0, 0x80, 0x47, 0x72, 0xcd, 0x68, 0x0f, 0x72,
-	0x3c, 0xbb, 0x2a, 0xb6, 0x74, 0x4f, 0x27, 0x76, 0x4a, 0x9d, 0x2c, 0x67, 0x4a, 0x96, 0xce, 0xb4,
-	0x64, 0xc8, 0x50, 0x42, 0xb5, 0xbe, 0xca, 0xed, 0x62, 0xfc, 0x0c, 0x35, 0x4a, 0x33, 0xaa, 0xdf,
-	0x40, 0x0f, 0xe0, 0xdc, 0x88, 0x50, 0xc3, 0xea, 0xa9, 0x0e, 0xd5, 0x6c, 0xbe, 0xbf, 0xc4, 0x29,
-	0xe3, 0x5a, 0xf6, 0xa8, 0x4d, 0x97, 0xc9, 0x02, 0xbb, 0x0f, 0xdc, 0x14, 0xec, 0x31, 0x79, 0x4a,
-	0xad, 0x8c, 0x47, 0xf4, 0xb7, 0xb8, 0xe1, 0x36, 0x09, 0xd5, 0x74, 0x8d, 0x6a, 0x22, 0xb8, 0x6d,
-	0xab, 0x4c, 0x9e, 0xd1, 0x2a, 0x44, 0xa9, 0x41, 0xfb, 0x58, 0x4c, 0x31, 0x87, 0xf7, 0x80, 0x44,
-	0x88, 0x3b, 0x43, 0xd3, 0xd4, 0xec, 0xb1, 0x98, 0x66, 0x76, 0xff, 0x11, 0x7d, 0x02, 0x09, 0x6f,
-	0x22, 0xb0, 0x2d, 0x66, 0x4e, 0x18, 0x81, 0x09, 0x12, 0x5d, 0x82, 0x24, 0x3e, 0x1a, 0x60, 0xdd,
-	0xa0, 0x58, 0x17, 0xb3, 0x5b, 0xc2, 0x76, 0x42, 0x09, 0x0c, 0xc5, 0xdf, 0x05, 0x48, 0x4d, 0x77,
-	0xc8, 0x75, 0x48, 0x8e, 0xb1, 0xa3, 0x76, 0xd9, 0xc8, 0x08, 0xc7, 0xe6, 0x57, 0xb6, 0xa8, 0x92,
-	0x18, 0x63, 0x67, 0xd7, 0xf5, 0xa3, 0xdb, 0x90, 0xd1, 0x3a, 0x0e, 0xd5, 0x0c, 0x8b, 0x13, 0x42,
-	0x0b, 0x09, 0x69, 0x0e, 0xf2, 0x48, 0xff, 0x83, 0x84, 0x45, 0x38, 0x3e, 0xbc, 0x10, 0x1f, 0xb7,
-	0x88, 0x07, 0xbd, 0x0b, 0xc8, 0x22, 0xea, 0x33, 0x83, 0x1e, 0xaa, 0x23, 0x4c, 0x7d, 0x52, 0x64,
-	0x21, 0x69, 0xd9, 0x22, 0x07, 0x06, 0x3d, 0x6c, 0x63, 0xea, 0x91, 0x8b, 0xbf, 0x08, 0x10, 0x71,
-	0x4f, 0xa7, 0x93, 0xcf, 0x96, 0x12, 0x44, 0x47, 0x84, 0xe2, 0x93, 0xcf, 0x15, 0x0f, 0x86, 0xee,
-	0x42, 0xdc, 0x3b, 0xea, 0x1c, 0x31, 0xc2, 0x1a, 0xf6, 0xf2, 0xdc, 0x10, 0x1e, 0x3f, 0x47, 0x15,
-	0x9f, 0x31, 0xd3, 0x10, 0xd1, 0xd9, 0x86, 0xd8, 0x8b, 0x24, 0xc2, 0xb9, 0x48, 0xf1, 0x4f, 0x01,
-	0x32, 0xbc, 0xad, 0x1b, 0x9a, 0xad, 0x99, 0x0e, 0x7a, 0x02, 0x29, 0xd3, 0xb0, 0x26, 0x53, 0x22,
-	0x9c, 0x34, 0x25, 0x9b, 0xee, 0x94, 0x7c, 0x78, 0x53, 0x38, 0x3f, 0xc5, 0xba, 0x41, 0x4c, 0x83,
-	0x62, 0x73, 0x40, 0xc7, 0x0a, 0x98, 0x86, 0xe5, 0xcf, 0x8d, 0x09, 0xc8, 0xd4, 0x8e, 0x7c, 0x90,
-	0x3a, 0xc0, 0xb6, 0x41, 0x74, 0x96, 0x08, 0x77, 0x85, 0xf9, 0x66, 0xaf, 0xf1, 0x0b, 0xa6, 0x7a,
-	0xe5, 0xc3, 0x9b, 0xc2, 0xa5, 0xe3, 0xc4, 0x60, 0x91, 0xef, 0xdd, 0x59, 0xc8, 0x99, 0xda, 0x91,
-	0xbf, 0x13, 0xe6, 0xff, 0x2c, 0x24, 0x0a, 0xc5, 0xc7, 0x90, 0x6e, 0xb3, 0x19, 0xe1, 0xbb, 0xab,
-	0x01, 0x9f, 0x19, 0x7f, 0x75, 0xe1, 0xa4, 0xd5, 0x23, 0x4c, 0x3d, 0xed, 0xb1, 0xa6, 0x94, 0x7f,
-	0xf0, 0x9b, 0x99, 0x2b, 0x5f, 0x85, 0xd8, 0xb7, 0x43, 0x62, 0x0f, 0xcd, 0x05, 0x9d, 0xcc, 0x6e,
-	0x22, 0xcf, 0x8b, 0x6e, 0x40, 0x92, 0x1e, 0xda, 0xd8, 0x39, 0x24, 0x7d, 0xfd, 0x5f, 0x2e, 0xad,
-	0x00, 0x80, 0xee, 0x40, 0x96, 0x75, 0x63, 0x40, 0x09, 0x2f, 0xa4, 0x64, 0x5c, 0x54, 0xcb, 0x07,
-	0xb1, 0x00, 0x7f, 0x8b, 0x43, 0x8c, 0xc7, 0x26, 0x9d, 0xb1, 0xa6, 0x53, 0x27, 0xdf, 0x74, 0xfd,
-	0x1e, 0x7e, 0x5c, 0xfd, 0x22, 0x8b, 0xeb, 0x73, 0xbc, 0x16, 0xe1, 0x8f, 0xa8, 0xc5, 0x54, 0xde,
-	0x23, 0xa7, 0xcf, 0x7b, 0xf4, 0xec, 0x79, 0x8f, 0x9d, 0x22, 0xef, 0x48, 0x86, 0x75, 0x37, 0xd1,
-	0x86, 0x65, 0x50, 0x23, 0xb8, 0x6a, 0x54, 0x16, 0xbe, 0x18, 0x5f, 0xa8, 0x70, 0xc1, 0x34, 0x2c,
-	0xd9, 0xc3, 0xf3, 0xf4, 0x28, 0x2e, 0x1a, 0x55, 0xe1, 0xfc, 0xe4, 0x24, 0xe9, 0x6a, 0x56, 0x17,
-	0xf7, 0xb9, 0x4c, 0x62, 0xa1, 0xcc, 0x8a, 0x0f, 0xde, 0x65, 0x58, 0x4f, 0x63, 0x0f, 0x56, 0xe7,
-	0x35, 0x74, 0xec, 0x50, 0x76, 0xbf, 0xfc, 0xd7, 0xd9, 0x83, 0x66, 0xc5, 0x6a, 0xd8, 0xa1, 0xe8,
-	0x00, 0xd6, 0x26, 0x27, 0xb9, 0x3a, 0x5b, 0x37, 0x38, 0x5d, 0xdd, 0xce, 0x4f, 0xf8, 0xed, 0xe9,
-	0x02, 0x7e, 0x01, 0x2b, 0x81, 0x70, 0x90, 0xef, 0xd4, 0xc2, 0x6d, 0xa2, 0x09, 0x34, 0x48, 0xfa,
-	0x63, 0x08, 0x94, 0xd5, 0xe9, 0x3e, 0x4f, 0x9f, 0xa1, 0xcf, 0x83, 0x18, 0x1e, 0x06, 0x0d, 0xbf,
-	0x0d, 0xb9, 0xce, 0xd0, 0xb6, 0xdc, 0xed, 0x62, 0x95, 0x77, 0x59, 0x86, 0xdd, 0x6a, 0x59, 0xd7,
-	0xee, 0x1e, 0xb9, 0x5f, 0x79, 0xdd, 0x55, 0x81, 0x4d, 0x86, 0x9c, 0xa4, 0x7b, 0x32, 0x24, 0x36,
-	0x76, 0xd9, 0xfc, 0x32, 0xdc, 0x70, 0x41, 0xfe, 0x9b, 0x97, 0x3f, 0x0d, 0x1e, 0x02, 0x5d, 0x81,
-	0x6c, 0xb0, 0x98, 0xdb, 0x56, 0xe2, 0x32, 0xe3, 0xa4, 0xfd, 0xa5, 0xdc, 0xeb, 0xe6, 0xda, 0x77,
-	0x02, 0xc0, 0xd4, 0x2b, 0xf3, 0x45, 0x58, 0x6b, 0xd7, 0x5b, 0x92, 0x5a, 0x6f, 0xb4, 0xe4, 0xfa,
-	0xbe, 0xfa, 0x68, 0xbf, 0xd9, 0x90, 0x76, 0xe5, 0x7b, 0xb2, 0x54, 0xcb, 0x2d, 0xa1, 0x15, 0x58,
-	0x9e, 0x76, 0x3e, 0x91, 0x9a, 0x39, 0x01, 0xad, 0xc1, 0xca, 0xb4, 0xb1, 0x52, 0x6d, 0xb6, 0x2a,
-	0xf2, 0x7e, 0x2e, 0x84, 0x10, 0x64, 0xa7, 0x1d, 0xfb, 0xf5, 0x5c, 0x18, 0x5d, 0x02, 0x71, 0xd6,
-	0xa6, 0x1e, 0xc8, 0xad, 0xfb, 0x6a, 0x5b, 0x6a, 0xd5, 0x73, 0x91, 0x6b, 0xbf, 0x0a, 0x90, 0x9d,
-	0x7d, 0x8d, 0x44, 0x05, 0xb8, 0xd8, 0x50, 0xea, 0x8d, 0x7a, 0xb3, 0xf2, 0x40, 0x6d, 0xb6, 0x2a,
-	0xad, 0x47, 0xcd, 0xb9, 0x98, 0x8a, 0x90, 0x9f, 0x07, 0xd4, 0xa4, 0x46, 0xbd, 0x29, 0xb7, 0xd4,
-	0x86, 0xa4, 0xc8, 0xf5, 0x5a, 0x4e, 0x40, 0x97, 0x61, 0x73, 0x1e, 0xd3, 0xae, 0xb7, 0xe4, 0xfd,
-	0x2f, 0x7d, 0x48, 0x08, 0x6d, 0xc0, 0x85, 0x79, 0x48, 0xa3, 0xd2, 0x6c, 0x4a, 0x35, 0x2f, 0xe8,
-	0x79, 0x9f, 0x22, 0xed, 0x49, 0xbb, 0x2d, 0xa9, 0x96, 0x8b, 0x2c, 0x62, 0xde, 0xab, 0xc8, 0x0f,
-	0xa4, 0x5a, 0x2e, 0x5a, 0x95, 0x5e, 0xbe, 0xcb, 0x0b, 0xaf, 0xde, 0xe5, 0x85, 0xbf, 0xde, 0xe5,
-	0x85, 0xe7, 0xef, 0xf3, 0x4b, 0xaf, 0xde, 0xe7, 0x97, 0xfe, 0x78, 0x9f, 0x5f, 0xfa, 0xfa, 0x7a,
-	0xcf, 0xa0, 0x87, 0xc3, 0x4e, 0xa9, 0x4b, 0x4c, 0xfe, 0x71, 0xc3, 0xff, 0xdd, 0x74, 0xf4, 0x6f,
-	0xca, 0x47, 0xec, 0x83, 0x8d, 0x8e, 0x07, 0xd8, 0x71, 0xbf, 0xc6, 0x62, 0x6c, 0x02, 0x6e, 0xff,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xba, 0xe0, 0x97, 0xe3, 0xce, 0x0d, 0x00, 0x00,
+	// 1680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x14, 0x45, 0x3e, 0x89, 0xf4, 0x7a, 0x2c, 0xdb, 0x6b, 0xd9, 0xa2, 0x14, 0x22,
+	0x08, 0x54, 0x27, 0x26, 0x2b, 0xa7, 0x69, 0x81, 0xba, 0x40, 0x41, 0x89, 0x9b, 0x98, 0x86, 0x2d,
+	0xb2, 0x4b, 0x5a, 0x76, 0x7a, 0xe8, 0x76, 0xc5, 0x9d, 0x50, 0xd3, 0x72, 0x77, 0xd8, 0x9d, 0x21,
+	0x63, 0xf6, 0x1b, 0x04, 0xe8, 0x21, 0xc7, 0x9e, 0x8a, 0x1e, 0x7b, 0x2a, 0x7a, 0xc8, 0xa1, 0x1f,
+	0x21, 0xa7, 0x22, 0xc8, 0xa9, 0x97, 0xba, 0x85, 0x7d, 0x28, 0x9a, 0x6b, 0xbf, 0x40, 0xb1, 0x33,
+	0xb3, 0x7f, 0x48, 0xd1, 0x25, 0x95, 0x5c, 0xa4, 0xdd, 0x37, 0xbf, 0xdf, 0x9b, 0xf7, 0xff, 0x91,
+	0x84, 0x9b, 0x7d, 0xca, 0x3c, 0xca, 0xea, 0x03, 0x3a, 0xa9, 0x4f, 0x0e, 0xc3, 0x7f, 0xb5, 0x51,
+	0x40, 0x39, 0x45, 0x25, 0x79, 0x50, 0x0b, 0x25, 0x93, 0xc3, 0x9d, 0x8a, 0xc2, 0x9d, 0x39, 0x0c,
+	0xd7, 0x27, 0x87, 0x67, 0x98, 0x3b, 0x87, 0xf5, 0x3e, 0x25, 0xbe, 0x84, 0xef, 0x6c, 0x0f, 0xe8,
+	0x80, 0x8a, 0xc7, 0x7a, 0xf8, 0xa4, 0xa4, 0x7b, 0x03, 0x4a, 0x07, 0x43, 0x5c, 0x17, 0x6f, 0x67,
+	0xe3, 0x4f, 0xea, 0x9c, 0x78, 0x98, 0x71, 0xc7, 0x1b, 0x29, 0xc0, 0xad, 0x79, 0x80, 0xe3, 0x4f,
+	0xd5, 0x51, 0x65, 0xfe, 0xc8, 0x1d, 0x07, 0x0e, 0x27, 0x34, 0xba, 0xf1, 0x96, 0xb4, 0xc8, 0x96,
+	0x97, 0x2a, 0x6b, 0xe5, 0xd1, 0x55, 0xc7, 0x23, 0x3e, 0xad, 0x8b, 0xbf, 0x52, 0x54, 0xa5, 0x80,
+	0x9e, 0x61, 0x32, 0x38, 0xe7, 0xd8, 0x3d, 0xa5, 0x1c, 0xb7, 0x47, 0xa1, 0x26, 0x74, 0x08, 0x79,
+	0x2a, 0x9e, 0x0c, 0x6d, 0x5f, 0x3b, 0x28, 0xdf, 0xbf, 0x55, 0x9b, 0xf1, 0xba, 0x96, 0x40, 0x2d,
+	0x05, 0x44, 0xef, 0x40, 0xfe, 0x53, 0xa1, 0xc8, 0xc8, 0xec, 0x6b, 0x07, 0xc5, 0xa3, 0xf2, 0xd7,
+	0x5f, 0xdc, 0x03, 0xc5, 0x6a, 0xe2, 0xbe, 0xa5, 0x4e, 0xab, 0xbd, 0xd9, 0x0b, 0x8f, 0xcf, 0x29,
+	0xe9, 0x63, 0x74, 0x03, 0xf2, 0x7d, 0xf1, 0x24, 0x2e, 0x2c, 0x59, 0xea, 0x6d, 0x65, 0xad, 0xcf,
+	0xa1, 0xdc, 0x09, 0xe8, 0x88, 0x32, 0x67, 0xa8, 0x5c, 0xd8, 0x86, 0x75, 0x4e, 0xf8, 0x50, 0x2a,
+	0x2c, 0x5a, 0xf2, 0x05, 0x7d, 0x1f, 0x0a, 0x1e, 0x66, 0xcc, 0x19, 0x60, 0x66, 0x64, 0xf6, 0xb3,
+	0x07, 0x9b, 0xf7, 0xb7, 0x6b, 0x32, 0x9e, 0xb5, 0x28, 0x9e, 0xb5, 0x86, 0x3f, 0xb5, 0x62, 0x54,
+	0xf5, 0x8f, 0x1a, 0x6c, 0x34, 0xf1, 0x88, 0x32, 0xc2, 0xd1, 0x1e, 0x6c, 0x8e, 0xd4, 0x2d, 0x36,
+	0x71, 0x85, 0xe6, 0x9c, 0x05, 0x91, 0xa8, 0xe5, 0xa2, 0x1f, 0x42, 0xd1, 0x95, 0x58, 0x1a, 0x28,
+	0x8b, 0x8d, 0xaf, 0xbf, 0xb8, 0xb7, 0xad, 0x2c, 0x6e, 0xb8, 0x6e, 0x80, 0x19, 0xeb, 0xf2, 0x80,
+	0xf8, 0x03, 0x2b, 0x81, 0xa2, 0x9f, 0x40, 0xde, 0xf1, 0xe8, 0xd8, 0xe7, 0x46, 0x56, 0x18, 0x15,
+	0xc7, 0x3b, 0x2c, 0xab, 0x9a, 0x2a, 0xab, 0xda, 0x31, 0x25, 0xfe, 0x51, 0xf1, 0xcb, 0x97, 0x7b,
+	0x6b, 0x7f, 0xfa, 0xf7, 0x5f, 0xee, 0x6a, 0x96, 0xe2, 0x54, 0xff, 0x9a, 0x87, 0x42, 0xe4, 0x3d,
+	0x2a, 0x43, 0x26, 0x36, 0x2d, 0x43, 0xdc, 0xcb, 0x7b, 0x8c, 0x3e, 0x80, 0x3c, 0xe3, 0x0e, 0x1f,
+	0x33, 0x23, 0x2b, 0x92, 0xbf, 0x3b, 0x97, 0xfc, 0xe8, 0xaa, 0xae, 0x00, 0x59, 0x0a, 0x8c, 0x1e,
+	0x02, 0xfa, 0x84, 0xf8, 0xce, 0xd0, 0xe6, 0xce, 0x70, 0x38, 0xb5, 0x03, 0xcc, 0xc6, 0x43, 0x6e,
+	0xe4, 0xf6, 0xb5, 0x83, 0xcd, 0xfb, 0x3b, 0x73, 0x2a, 0x7a, 0x21, 0xc4, 0x12, 0x08, 0x4b, 0x17,
+	0xac, 0x94, 0x04, 0x35, 0x60, 0x93, 0x8d, 0xcf, 0x3c, 0xc2, 0xed, 0xb0, 0x2d, 0x8c, 0x75, 0xa5,
+	0x62, 0xde, 0xea, 0x5e, 0xd4, 0x33, 0x47, 0xb9, 0xcf, 0xff, 0xb9, 0xa7, 0x59, 0x20, 0x49, 0xa1,
+	0x18, 0x3d, 0x02, 0x5d, 0x45, 0xd7, 0xc6, 0xbe, 0x2b, 0xf5, 0xe4, 0x57, 0xd4, 0x53, 0x56, 0x4c,
+	0xd3, 0x77, 0x85, 0xae, 0x16, 0x94, 0x38, 0xe5, 0xce, 0xd0, 0x56, 0x72, 0x63, 0xe3, 0x12, 0x39,
+	0xda, 0x12, 0xd4, 0xa8, 0x80, 0x1e, 0xc3, 0xd5, 0x09, 0xe5, 0xc4, 0x1f, 0xd8, 0x8c, 0x3b, 0x81,
+	0xf2, 0xaf, 0xb0, 0xa2, 0x5d, 0x57, 0x24, 0xb5, 0x1b, 0x32, 0x85, 0x61, 0x0f, 0x41, 0x89, 0x12,
+	0x1f, 0x8b, 0x2b, 0xea, 0x2a, 0x49, 0x62, 0xe4, 0xe2, 0x4e, 0x58, 0x24, 0xdc, 0x71, 0x1d, 0xee,
+	0x18, 0x20, 0xfa, 0x25, 0x7e, 0x4f, 0x1a, 0x69, 0x33, 0xdd, 0x48, 0x06, 0x6c, 0xb0, 0xb1, 0xe7,
+	0x39, 0xc1, 0xd4, 0xd8, 0x12, 0xf2, 0xe8, 0x15, 0xfd, 0x00, 0x0a, 0xb2, 0x23, 0x70, 0x60, 0x94,
+	0x96, 0xb4, 0x40, 0x8c, 0x44, 0x77, 0xa0, 0x88, 0x5f, 0x8c, 0xb0, 0x4b, 0x38, 0x76, 0x8d, 0xf2,
+	0xbe, 0x76, 0x50, 0xb0, 0x12, 0x01, 0xaa, 0x00, 0x84, 0x63, 0xc6, 0x23, 0x8c, 0x93, 0xbe, 0x71,
+	0x45, 0x1c, 0xa7, 0x24, 0xe8, 0x47, 0xb0, 0x21, 0xc7, 0x10, 0x33, 0x74, 0x91, 0x9c, 0x37, 0xd5,
+	0xac, 0x1a, 0x5a, 0x11, 0xba, 0xfa, 0xbb, 0x0c, 0x6c, 0xa6, 0x4b, 0xef, 0x5d, 0x28, 0x4e, 0x31,
+	0xb3, 0xfb, 0xa2, 0x17, 0xb5, 0x0b, 0x23, 0xa7, 0xe5, 0x73, 0xab, 0x30, 0xc5, 0xec, 0x38, 0x3c,
+	0x47, 0xef, 0x43, 0xc9, 0x39, 0x63, 0xdc, 0x21, 0xbe, 0x22, 0x64, 0x16, 0x12, 0xb6, 0x14, 0x48,
+	0x92, 0xbe, 0x07, 0x05, 0x9f, 0x2a, 0x7c, 0x76, 0x21, 0x7e, 0xc3, 0xa7, 0x12, 0xfa, 0x00, 0x90,
+	0x4f, 0xed, 0x4f, 0x09, 0x3f, 0xb7, 0x27, 0x98, 0x47, 0xa4, 0xdc, 0x42, 0xd2, 0x15, 0x9f, 0x3e,
+	0x23, 0xfc, 0xfc, 0x14, 0x73, 0x1a, 0x1b, 0x27, 0x9d, 0x94, 0x34, 0x66, 0xac, 0xef, 0x67, 0x17,
+	0x19, 0x27, 0x41, 0x82, 0xc3, 0xaa, 0xff, 0xd5, 0x20, 0x17, 0x4e, 0xe5, 0xe5, 0x93, 0xae, 0x06,
+	0xeb, 0x13, 0xca, 0xf1, 0xf2, 0x29, 0x27, 0x61, 0xe8, 0x41, 0x92, 0xa1, 0x9c, 0xc8, 0xd0, 0x5b,
+	0x73, 0x19, 0xba, 0xb8, 0x85, 0xe2, 0x2c, 0xcd, 0x94, 0xe7, 0xfa, 0x5c, 0x79, 0x3e, 0x80, 0x0d,
+	0xb9, 0x2b, 0x98, 0x91, 0x5f, 0xaa, 0x58, 0x6e, 0x1b, 0x2b, 0x62, 0x3c, 0xca, 0x15, 0xb2, 0x7a,
+	0xae, 0xfa, 0x0f, 0x0d, 0x4a, 0xaa, 0x43, 0x3b, 0x4e, 0xe0, 0x78, 0x0c, 0x7d, 0x0c, 0x9b, 0x1e,
+	0xf1, 0xe3, 0x86, 0xd7, 0x96, 0x35, 0xfc, 0x6e, 0xd8, 0xf0, 0xdf, 0xbc, 0xdc, 0xbb, 0x9e, 0x62,
+	0xbd, 0x47, 0x3d, 0xc2, 0xb1, 0x37, 0xe2, 0x53, 0x0b, 0x3c, 0xe2, 0x47, 0x23, 0xc0, 0x03, 0xe4,
+	0x39, 0x2f, 0x22, 0x90, 0x3d, 0xc2, 0x01, 0xa1, 0xae, 0x88, 0x62, 0x78, 0xc3, 0x7c, 0xdf, 0x36,
+	0xd5, 0x6e, 0x3f, 0x7a, 0xfb, 0x9b, 0x97, 0x7b, 0x77, 0x2e, 0x12, 0x93, 0x4b, 0x7e, 0x1f, 0xb6,
+	0xb5, 0xee, 0x39, 0x2f, 0x22, 0x4f, 0xc4, 0xf9, 0x8f, 0x33, 0x86, 0x56, 0x7d, 0x0e, 0x5b, 0xa7,
+	0xa2, 0xdd, 0x95, 0x77, 0x4d, 0x50, 0xed, 0x1f, 0xdd, 0xae, 0x2d, 0xbb, 0x3d, 0x27, 0xb4, 0x6f,
+	0x49, 0x56, 0x4a, 0xf3, 0x1f, 0x34, 0xd5, 0x3e, 0x4a, 0xf3, 0x3b, 0x90, 0xff, 0xcd, 0x98, 0x06,
+	0x63, 0xcf, 0xd0, 0x16, 0xaf, 0x6b, 0x79, 0x8a, 0xde, 0x83, 0x22, 0x3f, 0x0f, 0x30, 0x3b, 0xa7,
+	0x43, 0xf7, 0x0d, 0x9b, 0x3d, 0x01, 0xa0, 0x0f, 0xa0, 0x2c, 0xea, 0x3f, 0xa1, 0x64, 0x17, 0x52,
+	0x4a, 0x21, 0xaa, 0x17, 0x81, 0x84, 0x81, 0xff, 0x29, 0x42, 0x5e, 0xd9, 0x66, 0x5e, 0x32, 0xa7,
+	0xa9, 0x21, 0x9e, 0xce, 0xdf, 0x93, 0x6f, 0x97, 0xbf, 0xdc, 0xe2, 0xfc, 0x5c, 0xcc, 0x45, 0xf6,
+	0x5b, 0xe4, 0x22, 0x15, 0xf7, 0xdc, 0xea, 0x71, 0x5f, 0xbf, 0x7c, 0xdc, 0xf3, 0x2b, 0xc4, 0x1d,
+	0xb5, 0xe0, 0x56, 0x18, 0x68, 0xe2, 0x13, 0x4e, 0x92, 0xad, 0x69, 0x0b, 0xf3, 0x8d, 0x8d, 0x85,
+	0x1a, 0x6e, 0x78, 0xc4, 0x6f, 0x49, 0xbc, 0x0a, 0x8f, 0x15, 0xa2, 0xd1, 0x11, 0x5c, 0x8f, 0xc7,
+	0x50, 0xdf, 0xf1, 0xfb, 0x78, 0xa8, 0xd4, 0x14, 0x16, 0xaa, 0xb9, 0x16, 0x81, 0x8f, 0x05, 0x56,
+	0xea, 0x78, 0x04, 0xdb, 0xf3, 0x3a, 0x5c, 0xcc, 0xb8, 0x51, 0x5c, 0x32, 0xb8, 0xd0, 0xac, 0xb2,
+	0x26, 0x66, 0x1c, 0x3d, 0x83, 0x9b, 0xf1, 0x52, 0xb2, 0x67, 0xf3, 0x06, 0xab, 0xe5, 0xed, 0x7a,
+	0xcc, 0x3f, 0x4d, 0x27, 0xf0, 0xa7, 0x70, 0x2d, 0x51, 0x9c, 0xc4, 0x7b, 0x73, 0xa1, 0x9b, 0x28,
+	0x86, 0x26, 0x41, 0x7f, 0x0e, 0x89, 0x66, 0x3b, 0x5d, 0xe7, 0x5b, 0x97, 0xa8, 0xf3, 0xc4, 0x86,
+	0x27, 0x49, 0xc1, 0x1f, 0x80, 0x7e, 0x36, 0x0e, 0xfc, 0xd0, 0x5d, 0x6c, 0xab, 0x2a, 0x2b, 0x89,
+	0x0d, 0x5c, 0x0e, 0xe5, 0xe1, 0x58, 0xfd, 0x99, 0xac, 0xae, 0x06, 0xec, 0x0a, 0x64, 0x1c, 0xee,
+	0xb8, 0x49, 0x02, 0x1c, 0xb2, 0xd5, 0x5e, 0xdf, 0x09, 0x41, 0xd1, 0x42, 0x8e, 0xba, 0x41, 0x22,
+	0xd0, 0xdb, 0x50, 0x4e, 0x2e, 0x0b, 0xcb, 0x4a, 0x2d, 0xfb, 0xad, 0xe8, 0xaa, 0x70, 0xc1, 0xa1,
+	0x5f, 0xc0, 0xb6, 0xfa, 0xb4, 0x6a, 0x87, 0xfe, 0xb8, 0xf6, 0x48, 0xb4, 0xb8, 0xa1, 0x2f, 0x5c,
+	0x00, 0x4f, 0x24, 0xf4, 0x28, 0x44, 0xca, 0x59, 0x90, 0xf6, 0x19, 0x79, 0x17, 0x8e, 0x91, 0x05,
+	0xbb, 0xc9, 0x87, 0x0b, 0x3b, 0xc0, 0xbf, 0xc2, 0xfd, 0xd9, 0xbc, 0x5c, 0x5d, 0x98, 0x97, 0xdb,
+	0x09, 0xc9, 0x52, 0x9c, 0x24, 0x41, 0xbf, 0x84, 0xbd, 0x94, 0x4e, 0x67, 0xcc, 0xcf, 0x69, 0x40,
+	0x7e, 0x8b, 0x5d, 0xdb, 0x91, 0x55, 0x87, 0x99, 0x81, 0xf6, 0xb3, 0xff, 0xb7, 0x22, 0x53, 0x46,
+	0x35, 0x62, 0x7e, 0x23, 0xa2, 0x57, 0x3f, 0xcb, 0x00, 0xba, 0xe8, 0x2b, 0xda, 0x87, 0x2d, 0x8f,
+	0x0d, 0x6c, 0x3e, 0x1d, 0x61, 0x7b, 0x1c, 0x0c, 0xd5, 0xf7, 0x21, 0xf0, 0xd8, 0xa0, 0x37, 0x1d,
+	0xe1, 0xa7, 0xc1, 0xf0, 0xe2, 0x0c, 0xca, 0x7c, 0xb7, 0x19, 0x94, 0x5d, 0x7d, 0x06, 0xe5, 0x2e,
+	0x3f, 0x83, 0xd6, 0x57, 0x98, 0x41, 0xd5, 0x3f, 0x6b, 0xb0, 0xfd, 0x11, 0x9d, 0xe0, 0xc0, 0x0f,
+	0x9b, 0xb7, 0x89, 0x87, 0x78, 0x20, 0x2c, 0x47, 0x26, 0x5c, 0x75, 0xe5, 0x1b, 0x0d, 0xa2, 0xd0,
+	0xab, 0x65, 0xf5, 0xe6, 0xc0, 0xeb, 0x31, 0x45, 0xc9, 0x51, 0x1b, 0x6e, 0x04, 0x78, 0x14, 0x60,
+	0x86, 0x7d, 0xee, 0x70, 0x32, 0xc1, 0xb1, 0xae, 0x65, 0x9f, 0x87, 0xae, 0xcf, 0xf2, 0xd4, 0xe1,
+	0xdd, 0xcf, 0x34, 0x80, 0xd4, 0x17, 0xf0, 0xdb, 0x70, 0xf3, 0xb4, 0xdd, 0x33, 0xed, 0x76, 0xa7,
+	0xd7, 0x6a, 0x9f, 0xd8, 0x4f, 0x4f, 0xba, 0x1d, 0xf3, 0xb8, 0xf5, 0x61, 0xcb, 0x6c, 0xea, 0x6b,
+	0xe8, 0x1a, 0x5c, 0x49, 0x1f, 0x7e, 0x6c, 0x76, 0x75, 0x0d, 0xdd, 0x84, 0x6b, 0x69, 0x61, 0xe3,
+	0xa8, 0xdb, 0x6b, 0xb4, 0x4e, 0xf4, 0x0c, 0x42, 0x50, 0x4e, 0x1f, 0x9c, 0xb4, 0xf5, 0x2c, 0xba,
+	0x03, 0xc6, 0xac, 0xcc, 0x7e, 0xd6, 0xea, 0x3d, 0xb4, 0x4f, 0xcd, 0x5e, 0x5b, 0xcf, 0xdd, 0xfd,
+	0x9b, 0x06, 0xe5, 0xd9, 0x2f, 0x79, 0x68, 0x0f, 0x6e, 0x77, 0xac, 0x76, 0xa7, 0xdd, 0x6d, 0x3c,
+	0xb6, 0xbb, 0xbd, 0x46, 0xef, 0x69, 0x77, 0xce, 0xa6, 0x2a, 0x54, 0xe6, 0x01, 0x4d, 0xb3, 0xd3,
+	0xee, 0xb6, 0x7a, 0x76, 0xc7, 0xb4, 0x5a, 0xed, 0xa6, 0xae, 0xa1, 0xb7, 0x60, 0x77, 0x1e, 0x73,
+	0xda, 0xee, 0xb5, 0x4e, 0x3e, 0x8a, 0x20, 0x19, 0xb4, 0x03, 0x37, 0xe6, 0x21, 0x9d, 0x46, 0xb7,
+	0x6b, 0x36, 0xa5, 0xd1, 0xf3, 0x67, 0x96, 0xf9, 0xc8, 0x3c, 0xee, 0x99, 0x4d, 0x3d, 0xb7, 0x88,
+	0xf9, 0x61, 0xa3, 0xf5, 0xd8, 0x6c, 0xea, 0xeb, 0x47, 0xe6, 0x97, 0xaf, 0x2a, 0xda, 0x57, 0xaf,
+	0x2a, 0xda, 0xbf, 0x5e, 0x55, 0xb4, 0xcf, 0x5f, 0x57, 0xd6, 0xbe, 0x7a, 0x5d, 0x59, 0xfb, 0xfb,
+	0xeb, 0xca, 0xda, 0xcf, 0xdf, 0x1d, 0x10, 0x7e, 0x3e, 0x3e, 0xab, 0xf5, 0xa9, 0xa7, 0x7e, 0x2a,
+	0x51, 0xff, 0xee, 0x31, 0xf7, 0xd7, 0xf5, 0x17, 0xe2, 0xe7, 0x9f, 0xb0, 0x73, 0x58, 0xf8, 0xdb,
+	0x4e, 0x5e, 0x34, 0xc2, 0xfb, 0xff, 0x1b, 0x00, 0xc7, 0x48, 0xd8, 0x73, 0x1c, 0x12, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1063,7 +1432,7 @@ func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
+func (m *WeightedVoteChoice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1073,46 +1442,32 @@ func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *WeightedVoteChoice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WeightedVoteChoice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+	if m.Choice != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Choice))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
+func (m *ProposalOption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1122,24 +1477,143 @@ func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposalOption) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProposalOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Expedited {
-		i--
-		if m.Expedited {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Optimistic {
+		i--
+		if m.Optimistic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
 		dAtA[i] = 0x70
 	}
 	if len(m.Proposer) > 0 {
@@ -1283,6 +1757,15 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionCounts) > 0 {
+		for iNdEx := len(m.OptionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionCounts[iNdEx])
+			copy(dAtA[i:], m.OptionCounts[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptionCounts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.NoWithVetoCount) > 0 {
 		i -= len(m.NoWithVetoCount)
 		copy(dAtA[i:], m.NoWithVetoCount)
@@ -1334,6 +1817,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Choices) > 0 {
+		for iNdEx := len(m.Choices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Choices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1514,6 +2011,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
+			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.OptimisticRejectedThreshold) > 0 {
+		i -= len(m.OptimisticRejectedThreshold)
+		copy(dAtA[i:], m.OptimisticRejectedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if m.BurnVoteVeto {
 		i--
 		if m.BurnVoteVeto {
@@ -1654,6 +2187,104 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VotingPeriod != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RepresentativeAddress) > 0 {
+		i -= len(m.RepresentativeAddress)
+		copy(dAtA[i:], m.RepresentativeAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.RepresentativeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1681,36 +2312,31 @@ func (m *WeightedVoteOption) Size() (n int) {
 	return n
 }
 
-func (m *Deposit) Size() (n int) {
+func (m *WeightedVoteChoice) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
+	if m.Choice != 0 {
+		n += 1 + sovGov(uint64(m.Choice))
 	}
-	l = len(m.Depositor)
+	l = len(m.Weight)
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *Proposal) Size() (n int) {
+func (m *ProposalOption) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
 	}
 	if len(m.Messages) > 0 {
 		for _, e := range m.Messages {
@@ -1718,11 +2344,51 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
-	if m.Status != 0 {
-		n += 1 + sovGov(uint64(m.Status))
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.FinalTallyResult != nil {
-		l = m.FinalTallyResult.Size()
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
 		n += 1 + l + sovGov(uint64(l))
 	}
 	if m.SubmitTime != nil {
@@ -1766,6 +2432,15 @@ func (m *Proposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.Optimistic {
+		n += 2
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1791,6 +2466,12 @@ func (m *TallyResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.OptionCounts) > 0 {
+		for _, s := range m.OptionCounts {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1817,6 +2498,12 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.Choices) > 0 {
+		for _, e := range m.Choices {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1940,6 +2627,68 @@ func (m *Params) Size() (n int) {
 	if m.BurnVoteVeto {
 		n += 2
 	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.OptimisticRejectedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for _, s := range m.OptimisticAuthorizedAddresses {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageBasedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.RepresentativeAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2050,7 +2799,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *WeightedVoteChoice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2073,17 +2822,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedVoteChoice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedVoteChoice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
 			}
-			m.ProposalId = 0
+			m.Choice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2093,14 +2842,14 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.Choice |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2128,41 +2877,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2185,7 +2900,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *ProposalOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2208,17 +2923,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2228,11 +2943,24 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
@@ -2262,16 +2990,66 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types1.Any{})
+			m.Messages = append(m.Messages, &types.Any{})
 			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.Status = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2281,16 +3059,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2300,31 +3078,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalTallyResult == nil {
-				m.FinalTallyResult = &TallyResult{}
-			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2351,15 +3125,207 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitTime == nil {
-				m.SubmitTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
 			}
 			var msglen int
@@ -2423,7 +3389,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
 			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2648,6 +3614,60 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optimistic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optimistic = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &ProposalOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2826,16 +3846,48 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			}
 			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionCounts", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionCounts = append(m.OptionCounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -2993,6 +4045,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Choices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Choices = append(m.Choices, &WeightedVoteChoice{})
+			if err := m.Choices[len(m.Choices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3072,7 +4158,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
 			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3424,7 +4510,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
 			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3790,7 +4876,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types1.Coin{})
 			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3855,6 +4941,432 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, MessageBasedParams{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepresentativeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepresentativeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGovernanceDelegation creates a new GovernanceDelegation instance.
+func NewGovernanceDelegation(delegator, representative sdk.AccAddress) GovernanceDelegation {
+	return GovernanceDelegation{
+		DelegatorAddress:      delegator.String(),
+		RepresentativeAddress: representative.String(),
+	}
+}
+
+// ValidateBasic performs basic validation on a governance delegation.
+func (d GovernanceDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.RepresentativeAddress); err != nil {
+		return fmt.Errorf("invalid representative address: %w", err)
+	}
+
+	if d.DelegatorAddress == d.RepresentativeAddress {
+		return fmt.Errorf("delegator %s cannot delegate to self", d.DelegatorAddress)
+	}
+
+	return nil
+}
@@ -10,9 +10,9 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _, _, _, _, _, _ legacytx.LegacyMsg                 = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgVoteChoice{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgDelegateGovernance{}, &MsgUndelegateGovernance{}
+	_, _, _, _, _, _, _, _, _, _ legacytx.LegacyMsg                 = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgVoteChoice{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgDelegateGovernance{}, &MsgUndelegateGovernance{}
+	_, _                         codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -72,7 +72,11 @@ func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+	if err := sdktx.UnpackInterfaces(unpacker, m.Messages); err != nil {
+		return err
+	}
+
+	return ProposalOptions(m.Options).UnpackInterfaces(unpacker)
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -126,6 +130,24 @@ func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{voter}
 }
 
+// NewMsgVoteChoice creates a message to cast a vote on an active
+// multiple-choice proposal
+func NewMsgVoteChoice(voter sdk.AccAddress, proposalID uint64, choices WeightedVoteChoices, metadata string) *MsgVoteChoice {
+	return &MsgVoteChoice{proposalID, voter.String(), choices, metadata}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgVoteChoice) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgVoteChoice.
+func (msg MsgVoteChoice) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
 // NewMsgExecLegacyContent creates a new MsgExecLegacyContent instance.
 func NewMsgExecLegacyContent(content *codectypes.Any, authority string) *MsgExecLegacyContent {
 	return &MsgExecLegacyContent{
@@ -193,3 +215,42 @@ func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
 	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
 	return []sdk.AccAddress{proposer}
 }
+
+// NewMsgDelegateGovernance creates a new MsgDelegateGovernance instance.
+func NewMsgDelegateGovernance(delegator, representative sdk.AccAddress) *MsgDelegateGovernance {
+	return &MsgDelegateGovernance{
+		DelegatorAddress:      delegator.String(),
+		RepresentativeAddress: representative.String(),
+	}
+}
+
+// GetSignBytes implements Msg
+func (msg MsgDelegateGovernance) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgDelegateGovernance) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgUndelegateGovernance creates a new MsgUndelegateGovernance instance.
+func NewMsgUndelegateGovernance(delegator sdk.AccAddress) *MsgUndelegateGovernance {
+	return &MsgUndelegateGovernance{
+		DelegatorAddress: delegator.String(),
+	}
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUndelegateGovernance) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUndelegateGovernance) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
@@ -18,18 +18,19 @@ const (
 
 // Default governance params
 var (
-	DefaultMinDepositTokens          = sdkmath.NewInt(10000000)
-	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
-	DefaultQuorum                    = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultThreshold                 = sdkmath.LegacyNewDecWithPrec(5, 1)
-	DefaultExpeditedThreshold        = sdkmath.LegacyNewDecWithPrec(667, 3)
-	DefaultVetoThreshold             = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio    = sdkmath.LegacyZeroDec()
-	DefaultProposalCancelRatio       = sdkmath.LegacyMustNewDecFromStr("0.5")
-	DefaultProposalCancelDestAddress = ""
-	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositTokens            = sdkmath.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens   = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
+	DefaultQuorum                      = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultThreshold                   = sdkmath.LegacyNewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold          = sdkmath.LegacyNewDecWithPrec(667, 3)
+	DefaultVetoThreshold               = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio      = sdkmath.LegacyZeroDec()
+	DefaultProposalCancelRatio         = sdkmath.LegacyMustNewDecFromStr("0.5")
+	DefaultProposalCancelDestAddress   = ""
+	DefaultBurnProposalPrevote         = false // set to false to replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteQuorom              = false // set to false to  replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteVeto                = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultOptimisticRejectedThreshold = sdkmath.LegacyNewDecWithPrec(1, 1)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -60,23 +61,26 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 func NewParams(
 	minDeposit, expeditedminDeposit sdk.Coins, maxDepositPeriod, votingPeriod, expeditedVotingPeriod time.Duration,
 	quorum, threshold, expeditedThreshold, vetoThreshold, minInitialDepositRatio, proposalCancelRatio, proposalCancelDest string, burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
+	optimisticRejectedThreshold string, optimisticAuthorizedAddresses []string,
 ) Params {
 	return Params{
-		MinDeposit:                 minDeposit,
-		ExpeditedMinDeposit:        expeditedminDeposit,
-		MaxDepositPeriod:           &maxDepositPeriod,
-		VotingPeriod:               &votingPeriod,
-		ExpeditedVotingPeriod:      &expeditedVotingPeriod,
-		Quorum:                     quorum,
-		Threshold:                  threshold,
-		ExpeditedThreshold:         expeditedThreshold,
-		VetoThreshold:              vetoThreshold,
-		MinInitialDepositRatio:     minInitialDepositRatio,
-		ProposalCancelRatio:        proposalCancelRatio,
-		ProposalCancelDest:         proposalCancelDest,
-		BurnProposalDepositPrevote: burnProposalDeposit,
-		BurnVoteQuorum:             burnVoteQuorum,
-		BurnVoteVeto:               burnVoteVeto,
+		MinDeposit:                    minDeposit,
+		ExpeditedMinDeposit:           expeditedminDeposit,
+		MaxDepositPeriod:              &maxDepositPeriod,
+		VotingPeriod:                  &votingPeriod,
+		ExpeditedVotingPeriod:         &expeditedVotingPeriod,
+		Quorum:                        quorum,
+		Threshold:                     threshold,
+		ExpeditedThreshold:            expeditedThreshold,
+		VetoThreshold:                 vetoThreshold,
+		MinInitialDepositRatio:        minInitialDepositRatio,
+		ProposalCancelRatio:           proposalCancelRatio,
+		ProposalCancelDest:            proposalCancelDest,
+		BurnProposalDepositPrevote:    burnProposalDeposit,
+		BurnVoteQuorum:                burnVoteQuorum,
+		BurnVoteVeto:                  burnVoteVeto,
+		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
+		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
 	}
 }
 
@@ -98,6 +102,8 @@ func DefaultParams() Params {
 		DefaultBurnProposalPrevote,
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
+		DefaultOptimisticRejectedThreshold.String(),
+		nil,
 	)
 }
 
@@ -215,5 +221,176 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	optimisticRejectedThreshold, err := sdkmath.LegacyNewDecFromStr(p.OptimisticRejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+	}
+	if !optimisticRejectedThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+	}
+	if optimisticRejectedThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+	}
+
+	authorizedAddresses := make(map[string]bool, len(p.OptimisticAuthorizedAddresses))
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid optimistic authorized address: %s", addr)
+		}
+		if authorizedAddresses[addr] {
+			return fmt.Errorf("duplicate optimistic authorized address: %s", addr)
+		}
+		authorizedAddresses[addr] = true
+	}
+
+	msgTypeURLs := make(map[string]bool, len(p.MessageBasedParams))
+	for _, msgParams := range p.MessageBasedParams {
+		if msgTypeURLs[msgParams.MsgTypeUrl] {
+			return fmt.Errorf("duplicate message based params for %s", msgParams.MsgTypeUrl)
+		}
+		msgTypeURLs[msgParams.MsgTypeUrl] = true
+
+		if err := msgParams.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid message based params for %s: %w", msgParams.MsgTypeUrl, err)
+		}
+	}
+
+	return nil
+}
+
+// IsOptimisticAuthorized returns true if the given address is allowed to
+// submit optimistic proposals.
+func (p Params) IsOptimisticAuthorized(addr string) bool {
+	for _, authorized := range p.OptimisticAuthorizedAddresses {
+		if authorized == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MessageBasedParamsOf returns the voting parameter overrides of the given
+// message type, and false if there are none.
+func (p Params) MessageBasedParamsOf(msgTypeURL string) (MessageBasedParams, bool) {
+	for _, msgParams := range p.MessageBasedParams {
+		if msgParams.MsgTypeUrl == msgTypeURL {
+			return msgParams, true
+		}
+	}
+
+	return MessageBasedParams{}, false
+}
+
+// StrictestVotingParams returns the strictest voting parameters among the
+// regular ones and the overrides of the given message types: the longest
+// voting period, the highest quorum and threshold, and the lowest veto
+// threshold. The returned MsgTypeUrl is empty.
+func (p Params) StrictestVotingParams(msgTypeURLs ...string) (MessageBasedParams, error) {
+	strictest := MessageBasedParams{
+		VotingPeriod:  p.VotingPeriod,
+		Quorum:        p.Quorum,
+		Threshold:     p.Threshold,
+		VetoThreshold: p.VetoThreshold,
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		msgParams, ok := p.MessageBasedParamsOf(msgTypeURL)
+		if !ok {
+			continue
+		}
+
+		if msgParams.VotingPeriod != nil && (strictest.VotingPeriod == nil || *msgParams.VotingPeriod > *strictest.VotingPeriod) {
+			strictest.VotingPeriod = msgParams.VotingPeriod
+		}
+
+		var err error
+		if strictest.Quorum, err = maxDecString(strictest.Quorum, msgParams.Quorum); err != nil {
+			return MessageBasedParams{}, fmt.Errorf("invalid quorum of %s: %w", msgTypeURL, err)
+		}
+		if strictest.Threshold, err = maxDecString(strictest.Threshold, msgParams.Threshold); err != nil {
+			return MessageBasedParams{}, fmt.Errorf("invalid threshold of %s: %w", msgTypeURL, err)
+		}
+		if msgParams.VetoThreshold != "" {
+			// The lower the veto threshold, the easier it is to veto.
+			current, err := sdkmath.LegacyNewDecFromStr(strictest.VetoThreshold)
+			if err != nil {
+				return MessageBasedParams{}, fmt.Errorf("invalid veto threshold: %w", err)
+			}
+			override, err := sdkmath.LegacyNewDecFromStr(msgParams.VetoThreshold)
+			if err != nil {
+				return MessageBasedParams{}, fmt.Errorf("invalid veto threshold of %s: %w", msgTypeURL, err)
+			}
+			if override.LT(current) {
+				strictest.VetoThreshold = msgParams.VetoThreshold
+			}
+		}
+	}
+
+	return strictest, nil
+}
+
+// maxDecString returns the greatest of two decimal strings, override being
+// ignored if empty.
+func maxDecString(current, override string) (string, error) {
+	if override == "" {
+		return current, nil
+	}
+
+	currentDec, err := sdkmath.LegacyNewDecFromStr(current)
+	if err != nil {
+		return "", err
+	}
+	overrideDec, err := sdkmath.LegacyNewDecFromStr(override)
+	if err != nil {
+		return "", err
+	}
+	if overrideDec.GT(currentDec) {
+		return override, nil
+	}
+
+	return current, nil
+}
+
+// ValidateBasic performs basic validation on message based voting parameters.
+func (p MessageBasedParams) ValidateBasic() error {
+	if p.MsgTypeUrl == "" {
+		return fmt.Errorf("message type url cannot be empty")
+	}
+
+	if p.VotingPeriod != nil && p.VotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("voting period must be positive: %s", p.VotingPeriod)
+	}
+
+	if p.Quorum != "" {
+		quorum, err := sdkmath.LegacyNewDecFromStr(p.Quorum)
+		if err != nil {
+			return fmt.Errorf("invalid quorum string: %w", err)
+		}
+		if quorum.IsNegative() || quorum.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("quorum must be between 0 and 1: %s", quorum)
+		}
+	}
+
+	if p.Threshold != "" {
+		threshold, err := sdkmath.LegacyNewDecFromStr(p.Threshold)
+		if err != nil {
+			return fmt.Errorf("invalid threshold string: %w", err)
+		}
+		if !threshold.IsPositive() || threshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("vote threshold must be positive and at most 1: %s", threshold)
+		}
+	}
+
+	if p.VetoThreshold != "" {
+		vetoThreshold, err := sdkmath.LegacyNewDecFromStr(p.VetoThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid vetoThreshold string: %w", err)
+		}
+		if !vetoThreshold.IsPositive() || vetoThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("veto threshold must be positive and at most 1: %s", vetoThreshold)
+		}
+	}
+
 	return nil
 }
@@ -22,6 +22,10 @@ const (
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
 )
 
+// MaxProposalOptions is the maximum number of options of a multiple-choice
+// proposal.
+const MaxProposalOptions = 10
+
 // NewProposal creates a new Proposal instance
 func NewProposal(messages []sdk.Msg, id uint64, submitTime, depositEndTime time.Time, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (Proposal, error) {
 	msgs, err := sdktx.SetMsgs(messages)
@@ -53,6 +57,11 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
+// IsMultipleChoice returns true if the proposal is a multiple-choice proposal.
+func (p Proposal) IsMultipleChoice() bool {
+	return len(p.Options) > 0
+}
+
 // GetMinDepositFromParams returns min expedited deposit from the gov params if
 // the proposal is expedited. Otherwise, returns the regular min deposit from
 // gov params.
@@ -63,9 +72,68 @@ func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
 	return params.MinDeposit
 }
 
+// GetVotingParamsFromParams returns the voting parameters applying to the
+// proposal: the expedited ones from the gov params if the proposal is
+// expedited. Otherwise, returns the strictest parameters among the regular
+// ones and the overrides of the proposal messages types.
+func (p Proposal) GetVotingParamsFromParams(params Params) (MessageBasedParams, error) {
+	if p.Expedited {
+		return MessageBasedParams{
+			VotingPeriod:  params.ExpeditedVotingPeriod,
+			Quorum:        params.Quorum,
+			Threshold:     params.ExpeditedThreshold,
+			VetoThreshold: params.VetoThreshold,
+		}, nil
+	}
+
+	msgTypeURLs := make([]string, 0, len(p.Messages))
+	for _, msg := range p.Messages {
+		msgTypeURLs = append(msgTypeURLs, msg.TypeUrl)
+	}
+	for _, option := range p.Options {
+		for _, msg := range option.Messages {
+			msgTypeURLs = append(msgTypeURLs, msg.TypeUrl)
+		}
+	}
+
+	return params.StrictestVotingParams(msgTypeURLs...)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	return sdktx.UnpackInterfaces(unpacker, p.Messages)
+	if err := sdktx.UnpackInterfaces(unpacker, p.Messages); err != nil {
+		return err
+	}
+
+	return ProposalOptions(p.Options).UnpackInterfaces(unpacker)
+}
+
+// NewProposalOption creates a new ProposalOption instance
+func NewProposalOption(title string, messages []sdk.Msg) (*ProposalOption, error) {
+	msgs, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProposalOption{Title: title, Messages: msgs}, nil
+}
+
+// GetMsgs returns the proposal option messages
+func (o ProposalOption) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(o.Messages, "sdk.MsgProposal")
+}
+
+// ProposalOptions is an array of proposal options
+type ProposalOptions []*ProposalOption
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (o ProposalOptions) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, option := range o {
+		if err := sdktx.UnpackInterfaces(unpacker, option.Messages); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Proposals is an array of proposal
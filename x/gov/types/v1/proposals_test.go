@@ -80,3 +80,192 @@ func TestProposalGetMinDepositFromParams(t *testing.T) {
 		require.Equal(t, tc.expectedMinDeposit, actualMinDeposit[0].Amount)
 	}
 }
+
+func TestProposalGetVotingParamsFromParams(t *testing.T) {
+	msgTypeURL := sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})
+	votingPeriod := 7 * 24 * time.Hour
+	shortVotingPeriod := time.Hour
+
+	params := v1.DefaultParams()
+	params.MessageBasedParams = []v1.MessageBasedParams{
+		{
+			MsgTypeUrl:    msgTypeURL,
+			VotingPeriod:  &votingPeriod,
+			Threshold:     "0.66",
+			VetoThreshold: "0.5",
+		},
+		{
+			MsgTypeUrl:   sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+			VotingPeriod: &shortVotingPeriod,
+			Quorum:       "0.4",
+			Threshold:    "0.1",
+		},
+	}
+	require.NoError(t, params.ValidateBasic())
+
+	msgContent, err := v1.NewLegacyContent(v1beta1.NewTextProposal("Proposal", "testing proposal"), "cosmos1govacct")
+	require.NoError(t, err)
+	msgUpdateParams := &v1.MsgUpdateParams{Authority: "cosmos1govacct", Params: v1.DefaultParams()}
+
+	testcases := map[string]struct {
+		msgs      []sdk.Msg
+		expedited bool
+		expected  v1.MessageBasedParams
+	}{
+		"no message": {
+			expected: v1.MessageBasedParams{
+				VotingPeriod:  params.VotingPeriod,
+				Quorum:        params.Quorum,
+				Threshold:     params.Threshold,
+				VetoThreshold: params.VetoThreshold,
+			},
+		},
+		"expedited": {
+			expedited: true,
+			expected: v1.MessageBasedParams{
+				VotingPeriod:  params.ExpeditedVotingPeriod,
+				Quorum:        params.Quorum,
+				Threshold:     params.ExpeditedThreshold,
+				VetoThreshold: params.VetoThreshold,
+			},
+		},
+		"single message with overrides": {
+			msgs: []sdk.Msg{msgContent},
+			expected: v1.MessageBasedParams{
+				VotingPeriod:  &votingPeriod,
+				Quorum:        params.Quorum,
+				Threshold:     "0.66",
+				VetoThreshold: params.VetoThreshold,
+			},
+		},
+		"less strict overrides are ignored": {
+			msgs: []sdk.Msg{msgUpdateParams},
+			expected: v1.MessageBasedParams{
+				VotingPeriod:  params.VotingPeriod,
+				Quorum:        "0.4",
+				Threshold:     params.Threshold,
+				VetoThreshold: params.VetoThreshold,
+			},
+		},
+		"strictest among messages": {
+			msgs: []sdk.Msg{msgUpdateParams, msgContent},
+			expected: v1.MessageBasedParams{
+				VotingPeriod:  &votingPeriod,
+				Quorum:        "0.4",
+				Threshold:     "0.66",
+				VetoThreshold: params.VetoThreshold,
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			proposal, err := v1.NewProposal(tc.msgs, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), tc.expedited)
+			require.NoError(t, err)
+
+			votingParams, err := proposal.GetVotingParamsFromParams(params)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, votingParams)
+		})
+	}
+}
+
+func TestMessageBasedParamsValidateBasic(t *testing.T) {
+	negativePeriod := -time.Hour
+
+	testcases := map[string]struct {
+		msgParams []v1.MessageBasedParams
+		expErr    string
+	}{
+		"valid": {
+			msgParams: []v1.MessageBasedParams{{MsgTypeUrl: "/cosmos.gov.v1.MsgExecLegacyContent", Threshold: "0.66"}},
+		},
+		"empty type url": {
+			msgParams: []v1.MessageBasedParams{{Threshold: "0.66"}},
+			expErr:    "message type url cannot be empty",
+		},
+		"duplicate type url": {
+			msgParams: []v1.MessageBasedParams{
+				{MsgTypeUrl: "/cosmos.gov.v1.MsgExecLegacyContent", Threshold: "0.66"},
+				{MsgTypeUrl: "/cosmos.gov.v1.MsgExecLegacyContent", Quorum: "0.5"},
+			},
+			expErr: "duplicate message based params",
+		},
+		"negative voting period": {
+			msgParams: []v1.MessageBasedParams{{MsgTypeUrl: "/cosmos.gov.v1.MsgExecLegacyContent", VotingPeriod: &negativePeriod}},
+			expErr:    "voting period must be positive",
+		},
+		"quorum too large": {
+			msgParams: []v1.MessageBasedParams{{MsgTypeUrl: "/cosmos.gov.v1.MsgExecLegacyContent", Quorum: "1.1"}},
+			expErr:    "quorum must be between 0 and 1",
+		},
+		"zero threshold": {
+			msgParams: []v1.MessageBasedParams{{MsgTypeUrl: "/cosmos.gov.v1.MsgExecLegacyContent", Threshold: "0"}},
+			expErr:    "vote threshold must be positive",
+		},
+		"invalid veto threshold": {
+			msgParams: []v1.MessageBasedParams{{MsgTypeUrl: "/cosmos.gov.v1.MsgExecLegacyContent", VetoThreshold: "abc"}},
+			expErr:    "invalid vetoThreshold string",
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			params := v1.DefaultParams()
+			params.MessageBasedParams = tc.msgParams
+			err := params.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestTallyResultWinningOption(t *testing.T) {
+	testCases := []struct {
+		name         string
+		optionCounts []string
+		expWinner    uint32
+		expOk        bool
+	}{
+		{"no options", nil, 0, false},
+		{"no votes", []string{"0", "0", "0"}, 0, false},
+		{"single winner", []string{"10", "30", "20"}, 1, true},
+		{"tied options", []string{"30", "10", "30"}, 0, false},
+		{"tie below the winner", []string{"10", "10", "30"}, 2, true},
+		{"invalid count", []string{"10", "abc"}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tally := v1.EmptyTallyResult()
+			tally.OptionCounts = tc.optionCounts
+
+			winner, ok := tally.WinningOption()
+			require.Equal(t, tc.expOk, ok)
+			if tc.expOk {
+				require.Equal(t, tc.expWinner, winner)
+			}
+		})
+	}
+}
+
+func TestWeightedVoteChoicesFromString(t *testing.T) {
+	choices, err := v1.WeightedVoteChoicesFromString("2")
+	require.NoError(t, err)
+	require.Equal(t, v1.NewNonSplitVoteChoice(2), choices)
+
+	choices, err = v1.WeightedVoteChoicesFromString("0=0.6,1=0.4")
+	require.NoError(t, err)
+	require.Equal(t, v1.WeightedVoteChoices{
+		v1.NewWeightedVoteChoice(0, math.LegacyNewDecWithPrec(6, 1)),
+		v1.NewWeightedVoteChoice(1, math.LegacyNewDecWithPrec(4, 1)),
+	}, choices)
+
+	_, err = v1.WeightedVoteChoicesFromString("a=1")
+	require.Error(t, err)
+	_, err = v1.WeightedVoteChoicesFromString("0=0.5,1")
+	require.Error(t, err)
+}
@@ -975,6 +975,221 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryGovernanceDelegationRequest is the request type for the
+// Query/GovernanceDelegation RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryGovernanceDelegationRequest struct {
+	// delegator_address defines the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryGovernanceDelegationRequest) Reset()         { *m = QueryGovernanceDelegationRequest{} }
+func (m *QueryGovernanceDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGovernanceDelegationRequest) ProtoMessage()    {}
+func (*QueryGovernanceDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{18}
+}
+func (m *QueryGovernanceDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovernanceDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovernanceDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGovernanceDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovernanceDelegationRequest.Merge(m, src)
+}
+func (m *QueryGovernanceDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovernanceDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovernanceDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGovernanceDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryGovernanceDelegationRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// QueryGovernanceDelegationResponse is the response type for the
+// Query/GovernanceDelegation RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryGovernanceDelegationResponse struct {
+	// governance_delegation defines the governance delegation of the delegator.
+	GovernanceDelegation *GovernanceDelegation `protobuf:"bytes,1,opt,name=governance_delegation,json=governanceDelegation,proto3" json:"governance_delegation,omitempty"`
+}
+
+func (m *QueryGovernanceDelegationResponse) Reset()         { *m = QueryGovernanceDelegationResponse{} }
+func (m *QueryGovernanceDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGovernanceDelegationResponse) ProtoMessage()    {}
+func (*QueryGovernanceDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{19}
+}
+func (m *QueryGovernanceDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGovernanceDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGovernanceDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGovernanceDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGovernanceDelegationResponse.Merge(m, src)
+}
+func (m *QueryGovernanceDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGovernanceDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGovernanceDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGovernanceDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryGovernanceDelegationResponse) GetGovernanceDelegation() *GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegation
+	}
+	return nil
+}
+
+// QueryConstituentsRequest is the request type for the Query/Constituents RPC
+// method.
+//
+// Since: cosmos-sdk 0.48
+type QueryConstituentsRequest struct {
+	// representative_address defines the address of the governance representative.
+	RepresentativeAddress string `protobuf:"bytes,1,opt,name=representative_address,json=representativeAddress,proto3" json:"representative_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConstituentsRequest) Reset()         { *m = QueryConstituentsRequest{} }
+func (m *QueryConstituentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConstituentsRequest) ProtoMessage()    {}
+func (*QueryConstituentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{20}
+}
+func (m *QueryConstituentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConstituentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConstituentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConstituentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConstituentsRequest.Merge(m, src)
+}
+func (m *QueryConstituentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConstituentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConstituentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConstituentsRequest proto.InternalMessageInfo
+
+func (m *QueryConstituentsRequest) GetRepresentativeAddress() string {
+	if m != nil {
+		return m.RepresentativeAddress
+	}
+	return ""
+}
+
+func (m *QueryConstituentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryConstituentsResponse is the response type for the Query/Constituents RPC
+// method.
+//
+// Since: cosmos-sdk 0.48
+type QueryConstituentsResponse struct {
+	// governance_delegations defines the governance delegations to the
+	// representative.
+	GovernanceDelegations []*GovernanceDelegation `protobuf:"bytes,1,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConstituentsResponse) Reset()         { *m = QueryConstituentsResponse{} }
+func (m *QueryConstituentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConstituentsResponse) ProtoMessage()    {}
+func (*QueryConstituentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{21}
+}
+func (m *QueryConstituentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConstituentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConstituentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConstituentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConstituentsResponse.Merge(m, src)
+}
+func (m *QueryConstituentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConstituentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConstituentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConstituentsResponse proto.InternalMessageInfo
+
+func (m *QueryConstituentsResponse) GetGovernanceDelegations() []*GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegations
+	}
+	return nil
+}
+
+func (m *QueryConstituentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -994,76 +1209,93 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryGovernanceDelegationRequest)(nil), "cosmos.gov.v1.QueryGovernanceDelegationRequest")
+	proto.RegisterType((*QueryGovernanceDelegationResponse)(nil), "cosmos.gov.v1.QueryGovernanceDelegationResponse")
+	proto.RegisterType((*QueryConstituentsRequest)(nil), "cosmos.gov.v1.QueryConstituentsRequest")
+	proto.RegisterType((*QueryConstituentsResponse)(nil), "cosmos.gov.v1.QueryConstituentsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x37, 0x97, 0x66, 0x4f, 0x2e, 0xc0, 0xe9, 0x25, 0xae, 0xdb, 0x6e, 0x83, 0x43, 0x93,
-	0x40, 0x89, 0xcd, 0xa6, 0x37, 0x09, 0x8a, 0x50, 0xd3, 0x92, 0x82, 0xc4, 0x43, 0x70, 0x2b, 0x1e,
-	0x78, 0x89, 0x9c, 0xac, 0x65, 0x2c, 0x36, 0x1e, 0x77, 0x67, 0x76, 0x45, 0x48, 0x23, 0xa4, 0x4a,
-	0x5c, 0x9e, 0x00, 0x89, 0x0a, 0xf8, 0x21, 0xfc, 0x08, 0x1e, 0x2b, 0x78, 0xe1, 0x11, 0x25, 0xfc,
-	0x10, 0xe4, 0x99, 0x63, 0xaf, 0xed, 0x78, 0x6f, 0x55, 0xd5, 0xa7, 0x95, 0x67, 0xbe, 0xf3, 0x9d,
-	0xef, 0x9c, 0x39, 0x73, 0xce, 0x2c, 0x9c, 0xdf, 0x65, 0x7c, 0x8f, 0x71, 0xdb, 0x67, 0x1d, 0xbb,
-	0x53, 0xb7, 0x1f, 0xb5, 0xbd, 0xd6, 0xbe, 0x15, 0xb5, 0x98, 0x60, 0x38, 0xa7, 0xb6, 0x2c, 0x9f,
-	0x75, 0xac, 0x4e, 0xdd, 0x78, 0x8b, 0x90, 0x3b, 0x2e, 0xf7, 0x14, 0xce, 0xee, 0xd4, 0x77, 0x3c,
-	0xe1, 0xd6, 0xed, 0xc8, 0xf5, 0x83, 0xd0, 0x15, 0x01, 0x0b, 0x95, 0xa9, 0x71, 0xd1, 0x67, 0xcc,
-	0x6f, 0x7a, 0xb6, 0x1b, 0x05, 0xb6, 0x1b, 0x86, 0x4c, 0xc8, 0x4d, 0x4e, 0xbb, 0x0b, 0x79, 0x9f,
-	0x31, 0xbf, 0xda, 0x20, 0x31, 0xdb, 0xf2, 0xcb, 0x26, 0xf7, 0xf2, 0xc3, 0x34, 0x40, 0xff, 0x34,
-	0xf6, 0x79, 0x97, 0x85, 0x5c, 0x04, 0xa2, 0x1d, 0xf3, 0x39, 0xde, 0xa3, 0xb6, 0xc7, 0x85, 0xf9,
-	0x01, 0x9c, 0x2f, 0xd9, 0xe3, 0x11, 0x0b, 0xb9, 0x87, 0x26, 0xcc, 0xee, 0x66, 0xd6, 0x75, 0x6d,
-	0x51, 0x5b, 0xad, 0x3a, 0xb9, 0x35, 0xf3, 0x16, 0x9c, 0x91, 0x04, 0x5b, 0x2d, 0x16, 0x31, 0xee,
-	0x36, 0x89, 0x18, 0x2f, 0xc3, 0x4c, 0x44, 0x4b, 0xdb, 0x41, 0x43, 0x9a, 0x4e, 0x38, 0x90, 0x2c,
-	0x7d, 0xdc, 0x30, 0x3f, 0x81, 0xb3, 0x05, 0x43, 0xf2, 0x7a, 0x0d, 0xa6, 0x13, 0x98, 0x34, 0x9b,
-	0x59, 0x5f, 0xb0, 0x72, 0xe9, 0xb4, 0x52, 0x93, 0x14, 0x68, 0xfe, 0x54, 0x29, 0xd0, 0xf1, 0x44,
-	0xc8, 0x26, 0xbc, 0x92, 0x0a, 0xe1, 0xc2, 0x15, 0x6d, 0x2e, 0x59, 0xe7, 0xd7, 0x2f, 0xf5, 0x60,
-	0x7d, 0x20, 0x41, 0xce, 0x7c, 0x94, 0xfb, 0x46, 0x0b, 0x26, 0x3b, 0x4c, 0x78, 0x2d, 0xbd, 0x12,
-	0x67, 0x61, 0x43, 0xff, 0xeb, 0x8f, 0xb5, 0x33, 0x44, 0x70, 0xa7, 0xd1, 0x68, 0x79, 0x9c, 0x3f,
-	0x10, 0xad, 0x20, 0xf4, 0x1d, 0x05, 0xc3, 0x9b, 0x50, 0x6d, 0x78, 0x11, 0xe3, 0x81, 0x60, 0x2d,
-	0x7d, 0x7c, 0x80, 0x4d, 0x17, 0x8a, 0x9b, 0x00, 0xdd, 0x9a, 0xd0, 0x27, 0x64, 0x02, 0x96, 0x13,
-	0xa9, 0x71, 0x01, 0x59, 0xaa, 0xd0, 0xa8, 0x80, 0xac, 0x2d, 0xd7, 0xf7, 0x28, 0x56, 0x27, 0x63,
-	0x69, 0xfe, 0xae, 0xc1, 0xb9, 0x62, 0x46, 0x28, 0xc3, 0x37, 0xa0, 0x9a, 0x04, 0x17, 0x27, 0x63,
-	0xbc, 0x5f, 0x8a, 0xbb, 0x48, 0xbc, 0x9f, 0x53, 0x56, 0x91, 0xca, 0x56, 0x06, 0x2a, 0x53, 0x3e,
-	0x73, 0xd2, 0x76, 0xe1, 0x55, 0xa9, 0xec, 0x33, 0x26, 0xbc, 0x61, 0xeb, 0x65, 0xd4, 0xfc, 0x9b,
-	0xb7, 0xe1, 0xb5, 0x8c, 0x13, 0x8a, 0x7c, 0x05, 0x26, 0xe2, 0x5d, 0xaa, 0xab, 0xd3, 0x85, 0xa0,
-	0x25, 0x54, 0x02, 0xcc, 0xc7, 0x19, 0x6b, 0x3e, 0xb4, 0xc6, 0xcd, 0x92, 0x0c, 0x3d, 0xcf, 0xd9,
-	0xfd, 0xa0, 0x01, 0x66, 0xdd, 0x93, 0xfa, 0x37, 0x55, 0x0a, 0x92, 0x33, 0x2b, 0x95, 0xaf, 0x10,
-	0x2f, 0xee, 0xac, 0x6e, 0x90, 0x92, 0x2d, 0xb7, 0xe5, 0xee, 0xe5, 0x32, 0x21, 0x17, 0xb6, 0xc5,
-	0x7e, 0xe4, 0x51, 0x63, 0x00, 0xb5, 0xf4, 0x70, 0x3f, 0xf2, 0xcc, 0x5f, 0x2b, 0x70, 0x3a, 0x67,
-	0x47, 0x21, 0xdc, 0x83, 0xb9, 0x0e, 0x13, 0x41, 0xe8, 0x6f, 0x2b, 0x30, 0x9d, 0xc4, 0x85, 0x93,
-	0xa1, 0x04, 0xa1, 0xaf, 0x6c, 0x37, 0x2a, 0xba, 0xe6, 0xcc, 0x76, 0x32, 0x2b, 0x78, 0x1f, 0xe6,
-	0xe9, 0xc2, 0x24, 0x34, 0x2a, 0xc2, 0x8b, 0x05, 0x9a, 0x7b, 0x0a, 0x94, 0xe1, 0x99, 0x6b, 0x64,
-	0x97, 0xf0, 0x0e, 0xcc, 0x0a, 0xb7, 0xd9, 0xdc, 0x4f, 0x68, 0xc6, 0x25, 0x8d, 0x51, 0xa0, 0x79,
-	0x18, 0x43, 0x32, 0x24, 0x33, 0xa2, 0xbb, 0x80, 0x6b, 0x30, 0x45, 0xc6, 0xea, 0xae, 0x9e, 0x2d,
-	0xde, 0x24, 0x95, 0x00, 0x02, 0x99, 0x21, 0xe5, 0x85, 0xa4, 0x0d, 0x5d, 0x5a, 0xb9, 0x76, 0x52,
-	0x19, 0xba, 0x9d, 0x98, 0x1f, 0x51, 0x7f, 0x4e, 0xfd, 0xd1, 0x41, 0xbc, 0x03, 0xa7, 0x08, 0x44,
-	0x47, 0x70, 0xae, 0x3c, 0x77, 0x4e, 0x02, 0x33, 0xbf, 0xc9, 0x33, 0xbd, 0xfc, 0x5b, 0xf1, 0x54,
-	0xa3, 0x1e, 0xdf, 0x55, 0x40, 0xc1, 0xac, 0xc3, 0x34, 0xa9, 0x4c, 0xee, 0x46, 0xaf, 0x68, 0x52,
-	0xdc, 0x8b, 0xbb, 0x21, 0xef, 0xc2, 0x82, 0x54, 0x25, 0xab, 0xc4, 0xf1, 0x78, 0xbb, 0x29, 0x46,
-	0x18, 0x82, 0xfa, 0x49, 0xdb, 0xf4, 0x84, 0x26, 0x65, 0x9d, 0xd1, 0xf9, 0x94, 0x16, 0x25, 0x99,
-	0x28, 0xe0, 0xfa, 0x71, 0x15, 0x26, 0x25, 0x1d, 0x7e, 0xa7, 0xc1, 0x6c, 0x76, 0xa4, 0xe3, 0x4a,
-	0xc1, 0xba, 0xd7, 0x83, 0xc0, 0x58, 0x1d, 0x0c, 0x54, 0xfa, 0xcc, 0xa5, 0x27, 0x7f, 0xff, 0xf7,
-	0x4b, 0xe5, 0x12, 0x5e, 0xb0, 0xf3, 0x6f, 0x92, 0xec, 0xf3, 0x00, 0xbf, 0xd5, 0x60, 0x3a, 0x99,
-	0x25, 0xb8, 0x54, 0xc6, 0x5d, 0x78, 0x38, 0x18, 0x6f, 0xf4, 0x07, 0x91, 0x73, 0x4b, 0x3a, 0x5f,
-	0xc5, 0xe5, 0x82, 0xf3, 0x74, 0x5a, 0xd9, 0x07, 0x99, 0xcc, 0x1f, 0xe2, 0xd7, 0x50, 0x4d, 0xe7,
-	0x20, 0xf6, 0x75, 0x91, 0xd4, 0xb5, 0x71, 0x65, 0x00, 0x8a, 0x94, 0x2c, 0x4a, 0x25, 0x06, 0xea,
-	0xbd, 0x94, 0xe0, 0xf7, 0x1a, 0x4c, 0xc4, 0xbd, 0x19, 0x2f, 0x97, 0x31, 0x66, 0x86, 0xa0, 0xb1,
-	0xd8, 0x1b, 0x40, 0xde, 0x6e, 0x4b, 0x6f, 0x37, 0xf1, 0xfa, 0x70, 0x71, 0xdb, 0x72, 0x1a, 0xd8,
-	0x07, 0x72, 0x24, 0x1e, 0xe2, 0x13, 0x0d, 0x26, 0xe5, 0x48, 0xc1, 0x9e, 0x9e, 0xd2, 0xf0, 0x5f,
-	0xef, 0x83, 0x20, 0x31, 0xd7, 0xa5, 0x18, 0x0b, 0xdf, 0x1e, 0x45, 0x0c, 0x3e, 0x86, 0x29, 0x6a,
-	0x9d, 0xa5, 0x2e, 0x72, 0x83, 0xc6, 0x30, 0xfb, 0x41, 0x48, 0xc6, 0x55, 0x29, 0xe3, 0x0a, 0x2e,
-	0x15, 0x65, 0x48, 0x98, 0x7d, 0x90, 0x99, 0x54, 0x87, 0xf8, 0x9b, 0x06, 0xa7, 0xa8, 0x19, 0x60,
-	0x29, 0x79, 0xbe, 0x31, 0x1b, 0x4b, 0x7d, 0x31, 0xa4, 0xe0, 0xae, 0x54, 0xf0, 0x3e, 0xbe, 0x37,
-	0x64, 0x22, 0x92, 0x26, 0x64, 0x1f, 0xa4, 0x8d, 0xfa, 0x10, 0x7f, 0xd4, 0x60, 0x3a, 0xe9, 0x6c,
-	0xd8, 0xcf, 0x2d, 0xef, 0x7b, 0x55, 0x8a, 0xcd, 0xd1, 0xbc, 0x25, 0xc5, 0xd5, 0xd1, 0x1e, 0x51,
-	0x1c, 0x3e, 0xd5, 0x60, 0x26, 0xd3, 0x65, 0x70, 0xb9, 0xcc, 0xdd, 0xc9, 0xae, 0x67, 0xac, 0x0c,
-	0xc4, 0x3d, 0x67, 0xfd, 0xc8, 0x2e, 0xb7, 0xf1, 0xe1, 0x9f, 0x47, 0x35, 0xed, 0xd9, 0x51, 0x4d,
-	0xfb, 0xf7, 0xa8, 0xa6, 0xfd, 0x7c, 0x5c, 0x1b, 0x7b, 0x76, 0x5c, 0x1b, 0xfb, 0xe7, 0xb8, 0x36,
-	0xf6, 0xf9, 0x55, 0x3f, 0x10, 0x5f, 0xb4, 0x77, 0xac, 0x5d, 0xb6, 0x97, 0x30, 0xaa, 0x9f, 0x35,
-	0xde, 0xf8, 0xd2, 0xfe, 0x4a, 0xd2, 0xc7, 0x55, 0xc0, 0xe3, 0x7f, 0x5f, 0x53, 0xf2, 0xcf, 0xd1,
-	0xb5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x34, 0xfb, 0x7f, 0x51, 0xc6, 0x0d, 0x00, 0x00,
+	// 1226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x37, 0x1f, 0x4d, 0x5e, 0x3e, 0x68, 0xa7, 0xd9, 0x64, 0xe3, 0xb6, 0xdb, 0xd4, 0xa1,
+	0x49, 0xa0, 0xc4, 0x6e, 0xd2, 0x2f, 0x41, 0x8b, 0x50, 0xd2, 0x34, 0x01, 0xa9, 0x12, 0x61, 0x5b,
+	0x21, 0xd4, 0xcb, 0xca, 0xd9, 0x1d, 0x19, 0x8b, 0x8d, 0xc7, 0xf5, 0x78, 0x57, 0x84, 0xed, 0x0a,
+	0xa9, 0x12, 0x1f, 0x27, 0x40, 0xa2, 0x02, 0xfe, 0x0b, 0x0e, 0x70, 0xe4, 0xc0, 0x91, 0x63, 0x05,
+	0x1c, 0x38, 0xa2, 0x84, 0x3f, 0x04, 0x79, 0xe6, 0x79, 0xd7, 0x76, 0xbc, 0xde, 0x4d, 0x15, 0xf5,
+	0x94, 0x7a, 0xe6, 0xf7, 0x7e, 0xef, 0xf7, 0xde, 0xbc, 0x99, 0xf7, 0xba, 0x30, 0x57, 0x61, 0x7c,
+	0x8f, 0x71, 0xc3, 0x62, 0x0d, 0xa3, 0xb1, 0x6a, 0x3c, 0xae, 0x53, 0x6f, 0x5f, 0x77, 0x3d, 0xe6,
+	0x33, 0x32, 0x29, 0xb7, 0x74, 0x8b, 0x35, 0xf4, 0xc6, 0xaa, 0xfa, 0x3a, 0x22, 0x77, 0x4d, 0x4e,
+	0x25, 0xce, 0x68, 0xac, 0xee, 0x52, 0xdf, 0x5c, 0x35, 0x5c, 0xd3, 0xb2, 0x1d, 0xd3, 0xb7, 0x99,
+	0x23, 0x4d, 0xd5, 0xf3, 0x16, 0x63, 0x56, 0x8d, 0x1a, 0xa6, 0x6b, 0x1b, 0xa6, 0xe3, 0x30, 0x5f,
+	0x6c, 0x72, 0xdc, 0x9d, 0x8d, 0xfb, 0x0c, 0xf8, 0xe5, 0x06, 0x8a, 0x29, 0x8b, 0x2f, 0x03, 0xdd,
+	0x8b, 0x0f, 0x4d, 0x85, 0xc2, 0x07, 0x81, 0xcf, 0xbb, 0xcc, 0xe1, 0xbe, 0xed, 0xd7, 0x03, 0xbe,
+	0x12, 0x7d, 0x5c, 0xa7, 0xdc, 0xd7, 0xde, 0x81, 0xb9, 0x94, 0x3d, 0xee, 0x32, 0x87, 0x53, 0xa2,
+	0xc1, 0x44, 0x25, 0xb2, 0x5e, 0x50, 0xe6, 0x95, 0xe5, 0xb1, 0x52, 0x6c, 0x4d, 0xbb, 0x05, 0xd3,
+	0x82, 0x60, 0xc7, 0x63, 0x2e, 0xe3, 0x66, 0x0d, 0x89, 0xc9, 0x45, 0x18, 0x77, 0x71, 0xa9, 0x6c,
+	0x57, 0x85, 0xe9, 0x50, 0x09, 0xc2, 0xa5, 0xf7, 0xaa, 0xda, 0x7d, 0xc8, 0x27, 0x0c, 0xd1, 0xeb,
+	0x35, 0x18, 0x0d, 0x61, 0xc2, 0x6c, 0x7c, 0x6d, 0x56, 0x8f, 0xa5, 0x53, 0x6f, 0x9b, 0xb4, 0x81,
+	0xda, 0xb7, 0xb9, 0x04, 0x1d, 0x0f, 0x85, 0x6c, 0xc1, 0x2b, 0x6d, 0x21, 0xdc, 0x37, 0xfd, 0x3a,
+	0x17, 0xac, 0x53, 0x6b, 0x17, 0xba, 0xb0, 0x3e, 0x10, 0xa0, 0xd2, 0x94, 0x1b, 0xfb, 0x26, 0x3a,
+	0x0c, 0x37, 0x98, 0x4f, 0xbd, 0x42, 0x2e, 0xc8, 0xc2, 0x46, 0xe1, 0xcf, 0x5f, 0x57, 0xa6, 0x91,
+	0x60, 0xbd, 0x5a, 0xf5, 0x28, 0xe7, 0x0f, 0x7c, 0xcf, 0x76, 0xac, 0x92, 0x84, 0x91, 0x9b, 0x30,
+	0x56, 0xa5, 0x2e, 0xe3, 0xb6, 0xcf, 0xbc, 0xc2, 0x60, 0x0f, 0x9b, 0x0e, 0x94, 0x6c, 0x01, 0x74,
+	0x6a, 0xa2, 0x30, 0x24, 0x12, 0xb0, 0x18, 0x4a, 0x0d, 0x0a, 0x48, 0x97, 0x85, 0x86, 0x05, 0xa4,
+	0xef, 0x98, 0x16, 0xc5, 0x58, 0x4b, 0x11, 0x4b, 0xed, 0x27, 0x05, 0x66, 0x92, 0x19, 0xc1, 0x0c,
+	0xdf, 0x80, 0xb1, 0x30, 0xb8, 0x20, 0x19, 0x83, 0x59, 0x29, 0xee, 0x20, 0xc9, 0x76, 0x4c, 0x59,
+	0x4e, 0x28, 0x5b, 0xea, 0xa9, 0x4c, 0xfa, 0x8c, 0x49, 0xab, 0xc0, 0x69, 0xa1, 0xec, 0x43, 0xe6,
+	0xd3, 0x7e, 0xeb, 0xe5, 0xb8, 0xf9, 0xd7, 0xee, 0xc0, 0x99, 0x88, 0x13, 0x8c, 0x7c, 0x09, 0x86,
+	0x82, 0x5d, 0xac, 0xab, 0xb3, 0x89, 0xa0, 0x05, 0x54, 0x00, 0xb4, 0x27, 0x11, 0x6b, 0xde, 0xb7,
+	0xc6, 0xad, 0x94, 0x0c, 0xbd, 0xc8, 0xd9, 0x7d, 0xad, 0x00, 0x89, 0xba, 0x47, 0xf5, 0xaf, 0xc9,
+	0x14, 0x84, 0x67, 0x96, 0x2a, 0x5f, 0x22, 0x4e, 0xee, 0xac, 0x6e, 0xa0, 0x92, 0x1d, 0xd3, 0x33,
+	0xf7, 0x62, 0x99, 0x10, 0x0b, 0x65, 0x7f, 0xdf, 0xa5, 0xf8, 0x30, 0x80, 0x5c, 0x7a, 0xb8, 0xef,
+	0x52, 0xed, 0x87, 0x1c, 0x9c, 0x8d, 0xd9, 0x61, 0x08, 0x9b, 0x30, 0xd9, 0x60, 0xbe, 0xed, 0x58,
+	0x65, 0x09, 0xc6, 0x93, 0x38, 0x77, 0x34, 0x14, 0xdb, 0xb1, 0xa4, 0xed, 0x46, 0xae, 0xa0, 0x94,
+	0x26, 0x1a, 0x91, 0x15, 0xb2, 0x0d, 0x53, 0x78, 0x61, 0x42, 0x1a, 0x19, 0xe1, 0xf9, 0x04, 0xcd,
+	0xa6, 0x04, 0x45, 0x78, 0x26, 0xab, 0xd1, 0x25, 0xb2, 0x0e, 0x13, 0xbe, 0x59, 0xab, 0xed, 0x87,
+	0x34, 0x83, 0x82, 0x46, 0x4d, 0xd0, 0x3c, 0x0c, 0x20, 0x11, 0x92, 0x71, 0xbf, 0xb3, 0x40, 0x56,
+	0x60, 0x04, 0x8d, 0xe5, 0x5d, 0xcd, 0x27, 0x6f, 0x92, 0x4c, 0x00, 0x82, 0x34, 0x07, 0xf3, 0x82,
+	0xd2, 0xfa, 0x2e, 0xad, 0xd8, 0x73, 0x92, 0xeb, 0xfb, 0x39, 0xd1, 0xde, 0x85, 0xe9, 0xb8, 0x3f,
+	0x3c, 0x88, 0xab, 0x70, 0x0a, 0x41, 0x78, 0x04, 0x33, 0xe9, 0xb9, 0x2b, 0x85, 0x30, 0xed, 0xf3,
+	0x38, 0xd3, 0xcb, 0xbf, 0x15, 0xcf, 0x14, 0xc8, 0x27, 0x14, 0x60, 0x30, 0x6b, 0x30, 0x8a, 0x2a,
+	0xc3, 0xbb, 0xd1, 0x2d, 0x9a, 0x36, 0xee, 0xe4, 0x6e, 0xc8, 0x5b, 0x30, 0x2b, 0x54, 0x89, 0x2a,
+	0x29, 0x51, 0x5e, 0xaf, 0xf9, 0xc7, 0x68, 0x82, 0x85, 0xa3, 0xb6, 0xed, 0x13, 0x1a, 0x16, 0x75,
+	0x56, 0x50, 0xba, 0x17, 0x25, 0x9a, 0x48, 0xa0, 0x66, 0xc3, 0xbc, 0x60, 0xdb, 0x66, 0x0d, 0xea,
+	0x39, 0xa6, 0x53, 0xa1, 0x9b, 0xb4, 0x46, 0x2d, 0x33, 0xd2, 0xf0, 0xc9, 0x3d, 0x38, 0x53, 0x95,
+	0x8b, 0xcc, 0x2b, 0x9b, 0xb2, 0x6a, 0x0a, 0x4a, 0x8f, 0x7a, 0x3a, 0xdd, 0x36, 0xc1, 0x75, 0xad,
+	0x05, 0x97, 0x32, 0x5c, 0x61, 0x04, 0x1f, 0x41, 0xde, 0x6a, 0xef, 0x97, 0xab, 0x6d, 0x00, 0x46,
+	0xb4, 0x90, 0x88, 0x28, 0x95, 0x6b, 0xda, 0x4a, 0x59, 0xd5, 0x7e, 0x51, 0x12, 0x33, 0x0d, 0x75,
+	0x3a, 0x05, 0xf9, 0x3e, 0xcc, 0x78, 0xd4, 0xf5, 0x28, 0xa7, 0x4e, 0x30, 0x3c, 0x35, 0x68, 0xdf,
+	0x71, 0xe6, 0xe3, 0x76, 0xb8, 0x79, 0x62, 0x05, 0xfc, 0xbb, 0x02, 0x73, 0x29, 0xaa, 0x31, 0x5b,
+	0x8f, 0x60, 0x26, 0x35, 0x5b, 0x61, 0x49, 0xf7, 0x95, 0xae, 0x7c, 0x5a, 0xba, 0x4e, 0xae, 0xd8,
+	0xd7, 0xfe, 0x9e, 0x80, 0x61, 0x11, 0x02, 0xf9, 0x52, 0x81, 0x89, 0xe8, 0xd4, 0x48, 0x96, 0x12,
+	0xfa, 0xba, 0xcd, 0x9c, 0xea, 0x72, 0x6f, 0xa0, 0xf4, 0xac, 0x2d, 0x3c, 0xfd, 0xeb, 0xbf, 0xef,
+	0x73, 0x17, 0xc8, 0x39, 0x23, 0x3e, 0xf6, 0x46, 0x27, 0x50, 0xf2, 0x85, 0x02, 0xa3, 0xe1, 0xb8,
+	0x42, 0x16, 0xd2, 0xb8, 0x13, 0xb3, 0xa9, 0xfa, 0x6a, 0x36, 0x08, 0x9d, 0xeb, 0xc2, 0xf9, 0x32,
+	0x59, 0x4c, 0x38, 0x6f, 0x0f, 0x44, 0x46, 0x33, 0x72, 0xb9, 0x5b, 0xe4, 0x33, 0x18, 0x0b, 0x39,
+	0x38, 0xc9, 0x74, 0x11, 0x56, 0xaa, 0x7a, 0xb9, 0x07, 0x0a, 0x95, 0xcc, 0x0b, 0x25, 0x2a, 0x29,
+	0x74, 0x53, 0x42, 0xbe, 0x52, 0x60, 0x28, 0x68, 0xff, 0xe4, 0x62, 0x1a, 0x63, 0x64, 0xce, 0x52,
+	0xe7, 0xbb, 0x03, 0xd0, 0xdb, 0x1d, 0xe1, 0xed, 0x26, 0xb9, 0xde, 0x5f, 0xdc, 0x86, 0x18, 0x38,
+	0x8c, 0x66, 0xf0, 0xc7, 0x6b, 0x91, 0xa7, 0x0a, 0x0c, 0x07, 0x74, 0x9c, 0x74, 0xf5, 0xd4, 0x0e,
+	0xff, 0x52, 0x06, 0x02, 0xc5, 0x5c, 0x17, 0x62, 0x74, 0xf2, 0xc6, 0x71, 0xc4, 0x90, 0x27, 0x30,
+	0x82, 0xdd, 0x39, 0xd5, 0x45, 0x6c, 0x96, 0x51, 0xb5, 0x2c, 0x08, 0xca, 0xb8, 0x22, 0x64, 0x5c,
+	0x26, 0x0b, 0x49, 0x19, 0x02, 0x66, 0x34, 0x23, 0xc3, 0x50, 0x8b, 0xfc, 0xa8, 0xc0, 0x29, 0xec,
+	0x37, 0x24, 0x95, 0x3c, 0xde, 0xfb, 0xd5, 0x85, 0x4c, 0x0c, 0x2a, 0xb8, 0x2b, 0x14, 0xbc, 0x4d,
+	0x6e, 0xf7, 0x99, 0x88, 0xb0, 0xcf, 0x19, 0x4d, 0xfc, 0x17, 0xf3, 0x5a, 0xe4, 0x1b, 0x05, 0x46,
+	0x91, 0x98, 0x93, 0x2c, 0xb7, 0x3c, 0xf3, 0xaa, 0x24, 0xfb, 0xaf, 0x76, 0x4b, 0x88, 0x5b, 0x25,
+	0xc6, 0x31, 0xc5, 0x91, 0x67, 0x0a, 0x8c, 0x47, 0x1a, 0x19, 0x59, 0x4c, 0x73, 0x77, 0xb4, 0xb1,
+	0xaa, 0x4b, 0x3d, 0x71, 0x2f, 0x58, 0x3f, 0xa2, 0x91, 0x92, 0xdf, 0x14, 0x98, 0x4e, 0x7b, 0x5e,
+	0x89, 0x91, 0xe6, 0x37, 0xa3, 0xdd, 0xaa, 0x57, 0xfb, 0x37, 0x40, 0xc5, 0xeb, 0x42, 0xf1, 0x6d,
+	0xf2, 0xa6, 0x71, 0xe4, 0xbf, 0xfa, 0x29, 0xbd, 0xc1, 0x68, 0xe2, 0x47, 0xa7, 0x9b, 0xb7, 0xc8,
+	0xcf, 0x91, 0xa7, 0x99, 0x3a, 0x3e, 0xcf, 0x7e, 0x9a, 0x23, 0xad, 0x53, 0x5d, 0xee, 0x0d, 0x44,
+	0x99, 0xf7, 0x85, 0xcc, 0x2d, 0xb2, 0x99, 0x90, 0x19, 0xef, 0xa0, 0xdc, 0x68, 0xa6, 0xb7, 0xe2,
+	0x96, 0x51, 0x89, 0xb0, 0x6e, 0xdc, 0xfb, 0xe3, 0xa0, 0xa8, 0x3c, 0x3f, 0x28, 0x2a, 0xff, 0x1e,
+	0x14, 0x95, 0xef, 0x0e, 0x8b, 0x03, 0xcf, 0x0f, 0x8b, 0x03, 0xff, 0x1c, 0x16, 0x07, 0x1e, 0x5d,
+	0xb1, 0x6c, 0xff, 0xe3, 0xfa, 0xae, 0x5e, 0x61, 0x7b, 0xa1, 0x27, 0xf9, 0x67, 0x85, 0x57, 0x3f,
+	0x31, 0x3e, 0x15, 0x6e, 0x83, 0x6b, 0xc7, 0x83, 0x5f, 0x54, 0x46, 0xc4, 0x0f, 0x1e, 0xd7, 0xfe,
+	0x1f, 0x00, 0x7c, 0xa1, 0x4c, 0xa6, 0x9a, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1096,6 +1328,15 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// GovernanceDelegation queries the governance representative of a delegator.
+	//
+	// Since: cosmos-sdk 0.48
+	GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error)
+	// Constituents queries the delegators having delegated their voting power to
+	// a governance representative.
+	//
+	// Since: cosmos-sdk 0.48
+	Constituents(ctx context.Context, in *QueryConstituentsRequest, opts ...grpc.CallOption) (*QueryConstituentsResponse, error)
 }
 
 type queryClient struct {
@@ -1187,6 +1428,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error) {
+	out := new(QueryGovernanceDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/GovernanceDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Constituents(ctx context.Context, in *QueryConstituentsRequest, opts ...grpc.CallOption) (*QueryConstituentsResponse, error) {
+	out := new(QueryConstituentsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/Constituents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1207,6 +1466,15 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// GovernanceDelegation queries the governance representative of a delegator.
+	//
+	// Since: cosmos-sdk 0.48
+	GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error)
+	// Constituents queries the delegators having delegated their voting power to
+	// a governance representative.
+	//
+	// Since: cosmos-sdk 0.48
+	Constituents(context.Context, *QueryConstituentsRequest) (*QueryConstituentsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1240,6 +1508,12 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) GovernanceDelegation(ctx context.Context, req *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceDelegation not implemented")
+}
+func (*UnimplementedQueryServer) Constituents(ctx context.Context, req *QueryConstituentsRequest) (*QueryConstituentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Constituents not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1407,6 +1681,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GovernanceDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/GovernanceDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceDelegation(ctx, req.(*QueryGovernanceDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Constituents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConstituentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Constituents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/Constituents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Constituents(ctx, req.(*QueryConstituentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1447,6 +1757,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "GovernanceDelegation",
+			Handler:    _Query_GovernanceDelegation_Handler,
+		},
+		{
+			MethodName: "Constituents",
+			Handler:    _Query_Constituents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -2153,69 +2471,225 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGovernanceDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryConstitutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGovernanceDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConstitutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGovernanceDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Constitution)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+func (m *QueryGovernanceDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGovernanceDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovernanceDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Proposal != nil {
-		l = m.Proposal.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.GovernanceDelegation != nil {
+		{
+			size, err := m.GovernanceDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryConstituentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConstituentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConstituentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RepresentativeAddress) > 0 {
+		i -= len(m.RepresentativeAddress)
+		copy(dAtA[i:], m.RepresentativeAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RepresentativeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConstituentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConstituentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConstituentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConstitutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConstitutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.ProposalStatus != 0 {
 		n += 1 + sovQuery(uint64(m.ProposalStatus))
@@ -2445,6 +2919,68 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGovernanceDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGovernanceDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovernanceDelegation != nil {
+		l = m.GovernanceDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConstituentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RepresentativeAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConstituentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GovernanceDelegations) > 0 {
+		for _, e := range m.GovernanceDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4282,6 +4818,412 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGovernanceDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGovernanceDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGovernanceDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGovernanceDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGovernanceDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGovernanceDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GovernanceDelegation == nil {
+				m.GovernanceDelegation = &GovernanceDelegation{}
+			}
+			if err := m.GovernanceDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConstituentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConstituentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConstituentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepresentativeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepresentativeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConstituentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConstituentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConstituentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceDelegations = append(m.GovernanceDelegations, &GovernanceDelegation{})
+			if err := m.GovernanceDelegations[len(m.GovernanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,6 +12,7 @@ type ValidatorGovInfo struct {
 	DelegatorShares     math.LegacyDec      // Total outstanding delegator shares
 	DelegatorDeductions math.LegacyDec      // Delegator deductions from validator's delegators voting independently
 	Vote                WeightedVoteOptions // Vote of the validator
+	Choices             WeightedVoteChoices // Vote of the validator on a multiple-choice proposal
 }
 
 // NewValidatorGovInfo creates a ValidatorGovInfo instance
@@ -54,8 +55,43 @@ func EmptyTallyResult() TallyResult {
 
 // Equals returns if two tally results are equal.
 func (tr TallyResult) Equals(comp TallyResult) bool {
+	if len(tr.OptionCounts) != len(comp.OptionCounts) {
+		return false
+	}
+	for i, count := range tr.OptionCounts {
+		if count != comp.OptionCounts[i] {
+			return false
+		}
+	}
+
 	return tr.YesCount == comp.YesCount &&
 		tr.AbstainCount == comp.AbstainCount &&
 		tr.NoCount == comp.NoCount &&
 		tr.NoWithVetoCount == comp.NoWithVetoCount
 }
+
+// WinningOption returns the index of the option with the most votes of a
+// multiple-choice proposal, and false if there are no votes or if several
+// options are tied.
+func (tr TallyResult) WinningOption() (uint32, bool) {
+	var (
+		winner   uint32
+		maxCount = math.ZeroInt()
+		tied     bool
+	)
+	for i, count := range tr.OptionCounts {
+		c, ok := math.NewIntFromString(count)
+		if !ok {
+			return 0, false
+		}
+
+		switch {
+		case c.GT(maxCount):
+			winner, maxCount, tied = uint32(i), c, false
+		case c.Equal(maxCount):
+			tied = true
+		}
+	}
+
+	return winner, !tied && maxCount.IsPositive()
+}
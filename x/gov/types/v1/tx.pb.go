@@ -60,6 +60,17 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Expedited bool `protobuf:"varint,7,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// optimistic defines if the proposal is optimistic. Only addresses allowed
+	// by the optimistic_authorized_addresses param can submit optimistic
+	// proposals, which cannot be expedited.
+	//
+	// Since: cosmos-sdk 0.48
+	Optimistic bool `protobuf:"varint,8,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
+	// options defines the options of a multiple-choice proposal, in which case
+	// messages must be empty.
+	//
+	// Since: cosmos-sdk 0.48
+	Options []*ProposalOption `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -144,6 +155,20 @@ func (m *MsgSubmitProposal) GetExpedited() bool {
 	return false
 }
 
+func (m *MsgSubmitProposal) GetOptimistic() bool {
+	if m != nil {
+		return m.Optimistic
+	}
+	return false
+}
+
+func (m *MsgSubmitProposal) GetOptions() []*ProposalOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -503,6 +528,120 @@ func (m *MsgVoteWeightedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteWeightedResponse proto.InternalMessageInfo
 
+// MsgVoteChoice defines a message to cast a vote on a multiple-choice proposal.
+//
+// Since: cosmos-sdk 0.48
+type MsgVoteChoice struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	// voter is the voter address for the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// choices defines the weighted choices among the proposal options.
+	Choices []*WeightedVoteChoice `protobuf:"bytes,3,rep,name=choices,proto3" json:"choices,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgVoteChoice) Reset()         { *m = MsgVoteChoice{} }
+func (m *MsgVoteChoice) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteChoice) ProtoMessage()    {}
+func (*MsgVoteChoice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{8}
+}
+func (m *MsgVoteChoice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteChoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteChoice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteChoice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteChoice.Merge(m, src)
+}
+func (m *MsgVoteChoice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteChoice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteChoice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteChoice proto.InternalMessageInfo
+
+func (m *MsgVoteChoice) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVoteChoice) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgVoteChoice) GetChoices() []*WeightedVoteChoice {
+	if m != nil {
+		return m.Choices
+	}
+	return nil
+}
+
+func (m *MsgVoteChoice) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// MsgVoteChoiceResponse defines the Msg/VoteChoice response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgVoteChoiceResponse struct {
+}
+
+func (m *MsgVoteChoiceResponse) Reset()         { *m = MsgVoteChoiceResponse{} }
+func (m *MsgVoteChoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteChoiceResponse) ProtoMessage()    {}
+func (*MsgVoteChoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{9}
+}
+func (m *MsgVoteChoiceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteChoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteChoiceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteChoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteChoiceResponse.Merge(m, src)
+}
+func (m *MsgVoteChoiceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteChoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteChoiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteChoiceResponse proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal.
 type MsgDeposit struct {
 	// proposal_id defines the unique id of the proposal.
@@ -517,7 +656,7 @@ func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{8}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{10}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +714,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{9}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{11}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +759,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{10}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{12}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +813,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{11}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{13}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,7 +854,7 @@ func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
 func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelProposal) ProtoMessage()    {}
 func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{12}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{14}
 }
 func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +913,7 @@ func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResp
 func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelProposalResponse) ProtoMessage()    {}
 func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{13}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{15}
 }
 func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -824,6 +963,191 @@ func (m *MsgCancelProposalResponse) GetCanceledHeight() uint64 {
 	return 0
 }
 
+// MsgDelegateGovernance is the Msg/DelegateGovernance request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgDelegateGovernance struct {
+	// delegator_address is the address delegating its voting power.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// representative_address is the address voting on behalf of the delegator.
+	RepresentativeAddress string `protobuf:"bytes,2,opt,name=representative_address,json=representativeAddress,proto3" json:"representative_address,omitempty"`
+}
+
+func (m *MsgDelegateGovernance) Reset()         { *m = MsgDelegateGovernance{} }
+func (m *MsgDelegateGovernance) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGovernance) ProtoMessage()    {}
+func (*MsgDelegateGovernance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{16}
+}
+func (m *MsgDelegateGovernance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGovernance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGovernance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGovernance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGovernance.Merge(m, src)
+}
+func (m *MsgDelegateGovernance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGovernance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGovernance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGovernance proto.InternalMessageInfo
+
+func (m *MsgDelegateGovernance) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgDelegateGovernance) GetRepresentativeAddress() string {
+	if m != nil {
+		return m.RepresentativeAddress
+	}
+	return ""
+}
+
+// MsgDelegateGovernanceResponse defines the response structure for executing a
+// MsgDelegateGovernance message.
+//
+// Since: cosmos-sdk 0.48
+type MsgDelegateGovernanceResponse struct {
+}
+
+func (m *MsgDelegateGovernanceResponse) Reset()         { *m = MsgDelegateGovernanceResponse{} }
+func (m *MsgDelegateGovernanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGovernanceResponse) ProtoMessage()    {}
+func (*MsgDelegateGovernanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{17}
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGovernanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGovernanceResponse.Merge(m, src)
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGovernanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGovernanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGovernanceResponse proto.InternalMessageInfo
+
+// MsgUndelegateGovernance is the Msg/UndelegateGovernance request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUndelegateGovernance struct {
+	// delegator_address is the address removing its governance delegation.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *MsgUndelegateGovernance) Reset()         { *m = MsgUndelegateGovernance{} }
+func (m *MsgUndelegateGovernance) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGovernance) ProtoMessage()    {}
+func (*MsgUndelegateGovernance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{18}
+}
+func (m *MsgUndelegateGovernance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGovernance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGovernance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGovernance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGovernance.Merge(m, src)
+}
+func (m *MsgUndelegateGovernance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGovernance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGovernance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGovernance proto.InternalMessageInfo
+
+func (m *MsgUndelegateGovernance) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// MsgUndelegateGovernanceResponse defines the response structure for executing a
+// MsgUndelegateGovernance message.
+//
+// Since: cosmos-sdk 0.48
+type MsgUndelegateGovernanceResponse struct {
+}
+
+func (m *MsgUndelegateGovernanceResponse) Reset()         { *m = MsgUndelegateGovernanceResponse{} }
+func (m *MsgUndelegateGovernanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGovernanceResponse) ProtoMessage()    {}
+func (*MsgUndelegateGovernanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{19}
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGovernanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGovernanceResponse.Merge(m, src)
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGovernanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGovernanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -833,84 +1157,104 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.gov.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos.gov.v1.MsgVoteWeighted")
 	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "cosmos.gov.v1.MsgVoteWeightedResponse")
+	proto.RegisterType((*MsgVoteChoice)(nil), "cosmos.gov.v1.MsgVoteChoice")
+	proto.RegisterType((*MsgVoteChoiceResponse)(nil), "cosmos.gov.v1.MsgVoteChoiceResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos.gov.v1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "cosmos.gov.v1.MsgDepositResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.gov.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.gov.v1.MsgCancelProposal")
 	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.gov.v1.MsgCancelProposalResponse")
+	proto.RegisterType((*MsgDelegateGovernance)(nil), "cosmos.gov.v1.MsgDelegateGovernance")
+	proto.RegisterType((*MsgDelegateGovernanceResponse)(nil), "cosmos.gov.v1.MsgDelegateGovernanceResponse")
+	proto.RegisterType((*MsgUndelegateGovernance)(nil), "cosmos.gov.v1.MsgUndelegateGovernance")
+	proto.RegisterType((*MsgUndelegateGovernanceResponse)(nil), "cosmos.gov.v1.MsgUndelegateGovernanceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1042 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xe6, 0xc3, 0x4e, 0x26, 0x4d, 0xa2, 0xac, 0xdc, 0x76, 0xbd, 0x2a, 0x6b, 0x77, 0x8b,
-	0xc0, 0x4a, 0xc8, 0x2e, 0x0e, 0xb4, 0x42, 0xa6, 0x42, 0xaa, 0x43, 0x05, 0x95, 0x30, 0x54, 0x5b,
-	0x28, 0x12, 0x42, 0x8a, 0xc6, 0xde, 0x61, 0xb3, 0xc2, 0xbb, 0xb3, 0xf2, 0x8c, 0xad, 0xf8, 0x86,
-	0x38, 0xf6, 0xd4, 0x3f, 0x83, 0x63, 0x0e, 0xbd, 0xf5, 0xc4, 0xad, 0xe2, 0x54, 0x71, 0xe2, 0x54,
-	0x50, 0x22, 0x08, 0xe2, 0x9f, 0x00, 0xcd, 0xc7, 0xae, 0xf7, 0x2b, 0x1f, 0x70, 0xe0, 0x62, 0xed,
-	0xfc, 0xde, 0xc7, 0xbc, 0xf7, 0x7b, 0xf3, 0xde, 0x33, 0xb8, 0x36, 0xc0, 0x24, 0xc0, 0xc4, 0xf6,
-	0xf0, 0xc4, 0x9e, 0xb4, 0x6d, 0x7a, 0x68, 0x45, 0x23, 0x4c, 0xb1, 0xba, 0x26, 0x70, 0xcb, 0xc3,
-	0x13, 0x6b, 0xd2, 0xd6, 0x0d, 0xa9, 0xd6, 0x87, 0x04, 0xd9, 0x93, 0x76, 0x1f, 0x51, 0xd8, 0xb6,
-	0x07, 0xd8, 0x0f, 0x85, 0xba, 0x7e, 0x3d, 0xeb, 0x86, 0x59, 0x09, 0x41, 0xcd, 0xc3, 0x1e, 0xe6,
-	0x9f, 0x36, 0xfb, 0x92, 0x68, 0x5d, 0xa8, 0xef, 0x0b, 0x81, 0xbc, 0x4a, 0x8a, 0x3c, 0x8c, 0xbd,
-	0x21, 0xb2, 0xf9, 0xa9, 0x3f, 0xfe, 0xc6, 0x86, 0xe1, 0x34, 0x77, 0x49, 0x40, 0x3c, 0x76, 0x49,
-	0x40, 0x3c, 0x29, 0xd8, 0x84, 0x81, 0x1f, 0x62, 0x9b, 0xff, 0x4a, 0xa8, 0x91, 0x77, 0x43, 0xfd,
-	0x00, 0x11, 0x0a, 0x83, 0x48, 0x28, 0x98, 0xa7, 0xf3, 0x60, 0xb3, 0x47, 0xbc, 0x47, 0xe3, 0x7e,
-	0xe0, 0xd3, 0x87, 0x23, 0x1c, 0x61, 0x02, 0x87, 0xea, 0xdb, 0x60, 0x39, 0x40, 0x84, 0x40, 0x0f,
-	0x11, 0x4d, 0x69, 0x2e, 0xb4, 0x56, 0x77, 0x6b, 0x96, 0xf0, 0x64, 0xc5, 0x9e, 0xac, 0x7b, 0xe1,
-	0xd4, 0x49, 0xb4, 0xd4, 0x1e, 0xd8, 0xf0, 0x43, 0x9f, 0xfa, 0x70, 0xb8, 0xef, 0xa2, 0x08, 0x13,
-	0x9f, 0x6a, 0xf3, 0xdc, 0xb0, 0x6e, 0xc9, 0xbc, 0x18, 0x67, 0x96, 0xe4, 0xcc, 0xda, 0xc3, 0x7e,
-	0xd8, 0x5d, 0x79, 0xf1, 0xaa, 0x31, 0xf7, 0xc3, 0xe9, 0xd1, 0x96, 0xe2, 0xac, 0x4b, 0xe3, 0x0f,
-	0x85, 0xad, 0xfa, 0x2e, 0x58, 0x8e, 0x78, 0x30, 0x68, 0xa4, 0x2d, 0x34, 0x95, 0xd6, 0x4a, 0x57,
-	0xfb, 0xf9, 0xd9, 0x4e, 0x4d, 0xba, 0xba, 0xe7, 0xba, 0x23, 0x44, 0xc8, 0x23, 0x3a, 0xf2, 0x43,
-	0xcf, 0x49, 0x34, 0x55, 0x9d, 0x85, 0x4d, 0xa1, 0x0b, 0x29, 0xd4, 0x16, 0x99, 0x95, 0x93, 0x9c,
-	0xd5, 0x1a, 0x58, 0xa2, 0x3e, 0x1d, 0x22, 0x6d, 0x89, 0x0b, 0xc4, 0x41, 0xd5, 0x40, 0x95, 0x8c,
-	0x83, 0x00, 0x8e, 0xa6, 0x5a, 0x85, 0xe3, 0xf1, 0x51, 0xbd, 0x01, 0x56, 0xd0, 0x61, 0x84, 0x5c,
-	0x9f, 0x22, 0x57, 0xab, 0x36, 0x95, 0xd6, 0xb2, 0x33, 0x03, 0x3a, 0xed, 0xef, 0x4f, 0x8f, 0xb6,
-	0x92, 0x8b, 0x9f, 0x9c, 0x1e, 0x6d, 0x35, 0x44, 0x6c, 0x3b, 0xc4, 0xfd, 0x96, 0x55, 0xa5, 0xc0,
-	0xa9, 0x79, 0x17, 0xd4, 0x0b, 0xa0, 0x83, 0x48, 0x84, 0x43, 0x82, 0xd4, 0x06, 0x58, 0x8d, 0x24,
-	0xb6, 0xef, 0xbb, 0x9a, 0xd2, 0x54, 0x5a, 0x8b, 0x0e, 0x88, 0xa1, 0x07, 0xae, 0xf9, 0x5c, 0x01,
-	0xb5, 0x1e, 0xf1, 0xee, 0x1f, 0xa2, 0xc1, 0x27, 0xc8, 0x83, 0x83, 0xe9, 0x1e, 0x0e, 0x29, 0x0a,
-	0xa9, 0xfa, 0x29, 0xa8, 0x0e, 0xc4, 0x27, 0xb7, 0x3a, 0xa3, 0x52, 0x5d, 0xe3, 0xa7, 0x67, 0x3b,
-	0x7a, 0xe6, 0x31, 0xc7, 0x85, 0xe0, 0xb6, 0x4e, 0xec, 0x84, 0xe5, 0x0d, 0xc7, 0xf4, 0x00, 0x8f,
-	0x7c, 0x3a, 0xd5, 0xe6, 0x39, 0x27, 0x33, 0xa0, 0x73, 0x9b, 0xe5, 0x3d, 0x3b, 0xb3, 0xc4, 0xcd,
-	0x42, 0xe2, 0x85, 0x20, 0x4d, 0x03, 0xdc, 0x28, 0xc3, 0xe3, 0xf4, 0xcd, 0xdf, 0x15, 0x50, 0xed,
-	0x11, 0xef, 0x31, 0xa6, 0x48, 0xbd, 0x5d, 0x42, 0x45, 0xb7, 0xf6, 0xd7, 0xab, 0x46, 0x1a, 0x16,
-	0xaf, 0x26, 0x45, 0x90, 0x6a, 0x81, 0xa5, 0x09, 0xa6, 0x68, 0x24, 0x62, 0x3e, 0xe7, 0xb9, 0x08,
-	0x35, 0xb5, 0x0d, 0x2a, 0x38, 0xa2, 0x3e, 0x0e, 0xf9, 0xfb, 0x5a, 0x9f, 0xbd, 0x53, 0xc1, 0x8e,
-	0xc5, 0x62, 0xf9, 0x8c, 0x2b, 0x38, 0x52, 0xf1, 0xbc, 0xe7, 0xd5, 0x79, 0x9d, 0x11, 0x23, 0x5c,
-	0x33, 0x52, 0xae, 0x16, 0x48, 0x61, 0xfe, 0xcc, 0x4d, 0xb0, 0x21, 0x3f, 0x93, 0xd4, 0xff, 0x56,
-	0x12, 0xec, 0x4b, 0xe4, 0x7b, 0x07, 0x14, 0xb9, 0xff, 0x17, 0x05, 0xef, 0x83, 0xaa, 0xc8, 0x8c,
-	0x68, 0x0b, 0xbc, 0x57, 0x6f, 0xe6, 0x38, 0x88, 0x03, 0x4a, 0x71, 0x11, 0x5b, 0x9c, 0x4b, 0xc6,
-	0x5b, 0x59, 0x32, 0x5e, 0x2b, 0x25, 0x23, 0x76, 0x6e, 0xd6, 0xc1, 0xf5, 0x1c, 0x94, 0x90, 0xf3,
-	0x87, 0x02, 0x40, 0x8f, 0x78, 0xf1, 0x54, 0xf8, 0x8f, 0xbc, 0xdc, 0x01, 0x2b, 0x72, 0x26, 0xe1,
-	0x8b, 0xb9, 0x99, 0xa9, 0xaa, 0x77, 0x41, 0x05, 0x06, 0x78, 0x1c, 0x52, 0x49, 0xcf, 0xe5, 0x46,
-	0x99, 0xb4, 0xe9, 0x6c, 0xf3, 0x56, 0x49, 0xbc, 0x31, 0x22, 0xb4, 0x02, 0x11, 0x32, 0x33, 0xb3,
-	0x06, 0xd4, 0xd9, 0x29, 0x49, 0xff, 0xb9, 0x78, 0x1b, 0x5f, 0x44, 0x2e, 0xa4, 0xe8, 0x21, 0x1c,
-	0xc1, 0x80, 0xb0, 0x64, 0x66, 0xfd, 0xa9, 0x5c, 0x94, 0x4c, 0xa2, 0xaa, 0xbe, 0x07, 0x2a, 0x11,
-	0xf7, 0xc0, 0x19, 0x58, 0xdd, 0xbd, 0x9a, 0xab, 0xb5, 0x70, 0x9f, 0x49, 0x44, 0xe8, 0x77, 0xee,
-	0x14, 0x7b, 0xfe, 0x56, 0x2a, 0x91, 0xc3, 0x78, 0xdb, 0xe5, 0x22, 0x95, 0x75, 0x4d, 0x43, 0x49,
-	0x62, 0x4f, 0x14, 0xbe, 0x75, 0xf6, 0x60, 0x38, 0x40, 0xc3, 0xd4, 0xd6, 0x29, 0x29, 0xef, 0x46,
-	0xae, 0xbc, 0x99, 0xca, 0xa6, 0xd7, 0xc4, 0xfc, 0x65, 0xd7, 0x44, 0x67, 0x2d, 0x33, 0xbc, 0xcd,
-	0x1f, 0x15, 0x3e, 0x99, 0xb3, 0xc1, 0x24, 0x93, 0xf9, 0xdf, 0x07, 0xf5, 0x00, 0xac, 0x0d, 0xb8,
-	0x2f, 0xe4, 0xee, 0xb3, 0x75, 0x2b, 0x09, 0xd7, 0x0b, 0x73, 0xf9, 0xf3, 0x78, 0x17, 0x77, 0x97,
-	0x19, 0xeb, 0x4f, 0x7f, 0x6d, 0x28, 0xce, 0x95, 0xd8, 0x94, 0x09, 0xd5, 0x37, 0xc1, 0x46, 0xe2,
-	0xea, 0x80, 0x37, 0x07, 0x9f, 0x56, 0x8b, 0xce, 0x7a, 0x0c, 0x7f, 0xcc, 0xd1, 0xdd, 0x3f, 0x17,
-	0xc1, 0x42, 0x8f, 0x78, 0xea, 0xd7, 0x60, 0x3d, 0xb7, 0xca, 0x9b, 0xb9, 0x3a, 0x17, 0x76, 0x90,
-	0xde, 0xba, 0x48, 0x23, 0xe1, 0x02, 0x81, 0xcd, 0xe2, 0x02, 0xba, 0x55, 0x34, 0x2f, 0x28, 0xe9,
-	0xdb, 0x97, 0x50, 0x4a, 0xae, 0xf9, 0x00, 0x2c, 0xf2, 0x4d, 0x70, 0xad, 0x68, 0xc4, 0x70, 0xdd,
-	0x28, 0xc7, 0x13, 0xfb, 0xc7, 0xe0, 0x4a, 0x66, 0x9c, 0x9e, 0xa1, 0x1f, 0xcb, 0xf5, 0x37, 0xce,
-	0x97, 0x27, 0x7e, 0x3f, 0x02, 0xd5, 0x78, 0x12, 0xd5, 0x8b, 0x26, 0x52, 0xa4, 0xdf, 0x3c, 0x53,
-	0x94, 0x0e, 0x30, 0xd3, 0xd3, 0x25, 0x01, 0xa6, 0xe5, 0x65, 0x01, 0x96, 0xb5, 0x15, 0xab, 0x7e,
-	0xae, 0xa5, 0x4a, 0xaa, 0x9f, 0xd5, 0x28, 0xab, 0x7e, 0x79, 0x27, 0xe8, 0x4b, 0xdf, 0xb1, 0xb1,
-	0xd0, 0xbd, 0xff, 0xe2, 0xd8, 0x50, 0x5e, 0x1e, 0x1b, 0xca, 0x6f, 0xc7, 0x86, 0xf2, 0xf4, 0xc4,
-	0x98, 0x7b, 0x79, 0x62, 0xcc, 0xfd, 0x72, 0x62, 0xcc, 0x7d, 0xb5, 0xed, 0xf9, 0xf4, 0x60, 0xdc,
-	0xb7, 0x06, 0x38, 0x90, 0x7f, 0x66, 0xed, 0xc2, 0x9c, 0xa0, 0xd3, 0x08, 0x11, 0xf6, 0xd7, 0xb9,
-	0xc2, 0xdb, 0xe0, 0x9d, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xda, 0xbe, 0x64, 0x9b, 0x7a, 0x0b,
-	0x00, 0x00,
+	// 1275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xe6, 0x97, 0x93, 0xd7, 0x26, 0x21, 0x2b, 0xa7, 0xdd, 0x2c, 0xad, 0x9d, 0x6e, 0x4b,
+	0x89, 0xd2, 0x76, 0x4d, 0x0a, 0x2d, 0xc8, 0x54, 0x15, 0x75, 0x5a, 0x95, 0x4a, 0x98, 0x56, 0x5b,
+	0x28, 0x12, 0xaa, 0x14, 0x6d, 0xbc, 0xc3, 0x7a, 0x85, 0x77, 0x67, 0xb5, 0x33, 0xb6, 0xe2, 0x1b,
+	0xe2, 0xd8, 0x53, 0x0f, 0xfc, 0x05, 0x9c, 0x38, 0xf6, 0xd0, 0x5b, 0x2f, 0x70, 0xab, 0x38, 0x55,
+	0x9c, 0x38, 0x15, 0xd4, 0x0a, 0x22, 0x71, 0xe1, 0x0f, 0xe0, 0x00, 0x9a, 0xd9, 0xd9, 0xb1, 0xf7,
+	0x47, 0x6c, 0x83, 0x44, 0x2f, 0xd6, 0xce, 0x7b, 0xdf, 0x7b, 0x33, 0xdf, 0x37, 0x6f, 0xe6, 0x8d,
+	0xe1, 0x58, 0x0b, 0x13, 0x1f, 0x93, 0x9a, 0x8b, 0x7b, 0xb5, 0xde, 0x76, 0x8d, 0xee, 0x9b, 0x61,
+	0x84, 0x29, 0x56, 0x97, 0x62, 0xbb, 0xe9, 0xe2, 0x9e, 0xd9, 0xdb, 0xd6, 0x2b, 0x02, 0xb6, 0x67,
+	0x13, 0x54, 0xeb, 0x6d, 0xef, 0x21, 0x6a, 0x6f, 0xd7, 0x5a, 0xd8, 0x0b, 0x62, 0xb8, 0x7e, 0x3c,
+	0x9d, 0x86, 0x45, 0xc5, 0x8e, 0xb2, 0x8b, 0x5d, 0xcc, 0x3f, 0x6b, 0xec, 0x4b, 0x58, 0xd7, 0x63,
+	0xf8, 0x6e, 0xec, 0x10, 0x53, 0x09, 0x97, 0x8b, 0xb1, 0xdb, 0x41, 0x35, 0x3e, 0xda, 0xeb, 0x7e,
+	0x51, 0xb3, 0x83, 0x7e, 0x66, 0x12, 0x9f, 0xb8, 0x6c, 0x12, 0x9f, 0xb8, 0xc2, 0xb1, 0x6a, 0xfb,
+	0x5e, 0x80, 0x6b, 0xfc, 0x57, 0x98, 0xaa, 0xd9, 0x34, 0xd4, 0xf3, 0x11, 0xa1, 0xb6, 0x1f, 0xc6,
+	0x00, 0xe3, 0xfb, 0x19, 0x58, 0x6d, 0x12, 0xf7, 0x6e, 0x77, 0xcf, 0xf7, 0xe8, 0x9d, 0x08, 0x87,
+	0x98, 0xd8, 0x1d, 0xf5, 0x2d, 0x58, 0xf0, 0x11, 0x21, 0xb6, 0x8b, 0x88, 0xa6, 0x6c, 0xcc, 0x6c,
+	0x1e, 0xb9, 0x58, 0x36, 0xe3, 0x4c, 0x66, 0x92, 0xc9, 0xbc, 0x16, 0xf4, 0x2d, 0x89, 0x52, 0x9b,
+	0xb0, 0xe2, 0x05, 0x1e, 0xf5, 0xec, 0xce, 0xae, 0x83, 0x42, 0x4c, 0x3c, 0xaa, 0x4d, 0xf3, 0xc0,
+	0x75, 0x53, 0xf0, 0x62, 0x9a, 0x99, 0x42, 0x33, 0x73, 0x07, 0x7b, 0x41, 0x63, 0xf1, 0xe9, 0xf3,
+	0xea, 0xd4, 0x77, 0x07, 0x8f, 0xb6, 0x14, 0x6b, 0x59, 0x04, 0x5f, 0x8f, 0x63, 0xd5, 0x77, 0x60,
+	0x21, 0xe4, 0x8b, 0x41, 0x91, 0x36, 0xb3, 0xa1, 0x6c, 0x2e, 0x36, 0xb4, 0x9f, 0x1e, 0x5f, 0x28,
+	0x8b, 0x54, 0xd7, 0x1c, 0x27, 0x42, 0x84, 0xdc, 0xa5, 0x91, 0x17, 0xb8, 0x96, 0x44, 0xaa, 0x3a,
+	0x5b, 0x36, 0xb5, 0x1d, 0x9b, 0xda, 0xda, 0x2c, 0x8b, 0xb2, 0xe4, 0x58, 0x2d, 0xc3, 0x1c, 0xf5,
+	0x68, 0x07, 0x69, 0x73, 0xdc, 0x11, 0x0f, 0x54, 0x0d, 0x4a, 0xa4, 0xeb, 0xfb, 0x76, 0xd4, 0xd7,
+	0xe6, 0xb9, 0x3d, 0x19, 0xaa, 0x27, 0x60, 0x11, 0xed, 0x87, 0xc8, 0xf1, 0x28, 0x72, 0xb4, 0xd2,
+	0x86, 0xb2, 0xb9, 0x60, 0x0d, 0x0c, 0x6a, 0x05, 0x00, 0x87, 0xd4, 0xf3, 0x3d, 0x42, 0xbd, 0x96,
+	0xb6, 0xc0, 0xdd, 0x43, 0x16, 0xf5, 0x5d, 0x28, 0xb1, 0x11, 0x0e, 0x88, 0xb6, 0xc8, 0x65, 0x38,
+	0x69, 0xa6, 0x2a, 0xc9, 0x4c, 0xa4, 0xbe, 0xcd, 0x51, 0x56, 0x82, 0xae, 0x6f, 0x7f, 0x7d, 0xf0,
+	0x68, 0x4b, 0x32, 0x7a, 0x70, 0xf0, 0x68, 0xab, 0x1a, 0x07, 0x5e, 0x20, 0xce, 0x97, 0x6c, 0xbb,
+	0x73, 0x9b, 0x65, 0x5c, 0x81, 0xf5, 0x9c, 0xd1, 0x42, 0x24, 0xc4, 0x01, 0x41, 0x6a, 0x15, 0x8e,
+	0x84, 0xc2, 0xb6, 0xeb, 0x39, 0x9a, 0xb2, 0xa1, 0x6c, 0xce, 0x5a, 0x90, 0x98, 0x6e, 0x39, 0xc6,
+	0x13, 0x05, 0xca, 0x4d, 0xe2, 0xde, 0xd8, 0x47, 0xad, 0x8f, 0x90, 0x6b, 0xb7, 0xfa, 0x3b, 0x38,
+	0xa0, 0x28, 0xa0, 0xea, 0xc7, 0x50, 0x6a, 0xc5, 0x9f, 0x3c, 0xea, 0x90, 0x12, 0x68, 0x54, 0x7e,
+	0x7c, 0x7c, 0x41, 0x4f, 0x71, 0x4b, 0x76, 0x98, 0xc7, 0x5a, 0x49, 0x12, 0x26, 0xa8, 0xdd, 0xa5,
+	0x6d, 0x1c, 0x79, 0xb4, 0xaf, 0x4d, 0x73, 0xb1, 0x07, 0x86, 0xfa, 0x25, 0xc6, 0x7b, 0x30, 0x66,
+	0xc4, 0x8d, 0x1c, 0xf1, 0xdc, 0x22, 0x8d, 0x0a, 0x9c, 0x28, 0xb2, 0x27, 0xf4, 0x8d, 0xdf, 0x14,
+	0x28, 0x35, 0x89, 0x7b, 0x0f, 0x53, 0xa4, 0x5e, 0x2a, 0x90, 0xa2, 0x51, 0xfe, 0xe3, 0x79, 0x75,
+	0xd8, 0x1c, 0x97, 0xe3, 0x90, 0x40, 0xaa, 0x09, 0x73, 0x3d, 0x4c, 0x51, 0xa4, 0x4d, 0x8f, 0xa9,
+	0xc3, 0x18, 0xa6, 0x6e, 0xc3, 0x7c, 0xbc, 0x99, 0xbc, 0x70, 0x97, 0x07, 0x07, 0x40, 0xec, 0x3c,
+	0x5b, 0x8b, 0xd8, 0x75, 0x01, 0x1c, 0x55, 0xb7, 0xf5, 0x33, 0x4c, 0x98, 0x38, 0x35, 0x13, 0x65,
+	0x2d, 0x27, 0x0a, 0xcb, 0x67, 0xac, 0xc2, 0x8a, 0xf8, 0x94, 0xd4, 0xff, 0x56, 0xa4, 0xed, 0x33,
+	0xe4, 0xb9, 0x6d, 0x56, 0xb6, 0xaf, 0x48, 0x82, 0xf7, 0x07, 0xd5, 0x3f, 0xc3, 0xab, 0xff, 0x54,
+	0x46, 0x83, 0x64, 0x41, 0x43, 0x5a, 0x24, 0x11, 0x23, 0xc5, 0x38, 0x9f, 0x16, 0xe3, 0x64, 0xa1,
+	0x18, 0x49, 0x72, 0x63, 0x1d, 0x8e, 0x67, 0x4c, 0x52, 0x9c, 0xbf, 0x14, 0x58, 0x12, 0xbe, 0x9d,
+	0x36, 0xf6, 0x5a, 0xe8, 0x15, 0x4a, 0xd3, 0xe2, 0x13, 0x4e, 0x22, 0x4d, 0xbc, 0x34, 0x2b, 0x89,
+	0x18, 0x29, 0xcd, 0x56, 0x5a, 0x9a, 0xd7, 0x0b, 0xa5, 0x89, 0x13, 0x1a, 0xc7, 0x61, 0x2d, 0x65,
+	0x90, 0xb2, 0xfc, 0xae, 0x00, 0x34, 0x89, 0x9b, 0xdc, 0xc2, 0xff, 0x51, 0x93, 0xcb, 0xb0, 0x28,
+	0x7a, 0x00, 0x1e, 0xaf, 0xcb, 0x00, 0xaa, 0x5e, 0x81, 0x79, 0xdb, 0xc7, 0xdd, 0x80, 0x0a, 0x69,
+	0x26, 0x6b, 0x1d, 0x22, 0xa6, 0x7e, 0x8e, 0xdf, 0x20, 0x32, 0x1b, 0x13, 0x41, 0xcb, 0x89, 0x20,
+	0x98, 0x19, 0x65, 0x50, 0x07, 0x23, 0x49, 0xff, 0x49, 0x7c, 0x64, 0x3e, 0x0d, 0x1d, 0x9b, 0xa2,
+	0x3b, 0x76, 0x64, 0xfb, 0x84, 0x91, 0x19, 0x5c, 0x5b, 0xca, 0x38, 0x32, 0x12, 0xaa, 0xbe, 0x07,
+	0xf3, 0x21, 0xcf, 0xc0, 0x15, 0x38, 0x72, 0x71, 0x2d, 0xdb, 0x00, 0xb8, 0x33, 0x45, 0x24, 0xc6,
+	0xd7, 0x2f, 0xe7, 0xaf, 0xc2, 0xd3, 0x43, 0x44, 0xf6, 0x93, 0xd7, 0x45, 0x66, 0xa5, 0xa2, 0xdc,
+	0x87, 0x4d, 0x92, 0xd8, 0x03, 0x85, 0x77, 0xf9, 0x1d, 0x3b, 0x68, 0xa1, 0xce, 0x50, 0x97, 0x2f,
+	0xd8, 0xde, 0x95, 0xcc, 0xf6, 0xa6, 0x76, 0x76, 0xb8, 0x2d, 0x4f, 0x4f, 0xda, 0x96, 0xeb, 0x4b,
+	0xa9, 0x9e, 0x66, 0xfc, 0xa0, 0xc0, 0x7a, 0x6e, 0x31, 0xb2, 0x61, 0xfd, 0xfb, 0x45, 0xdd, 0x82,
+	0xa5, 0x16, 0xcf, 0x85, 0x9c, 0x5d, 0xf6, 0xbc, 0x11, 0x82, 0xeb, 0xb9, 0x76, 0xf5, 0x49, 0xf2,
+	0xf6, 0x69, 0x2c, 0x30, 0xd5, 0x1f, 0xfe, 0x52, 0x55, 0xac, 0xa3, 0x49, 0x28, 0x73, 0xaa, 0x6f,
+	0xc2, 0x8a, 0x4c, 0xd5, 0xe6, 0x07, 0x91, 0x5f, 0xe2, 0xb3, 0xd6, 0x72, 0x62, 0xfe, 0x90, 0x5b,
+	0x8d, 0x3f, 0x15, 0x7e, 0x84, 0xae, 0xa3, 0x0e, 0x72, 0x6d, 0x8a, 0x6e, 0xe2, 0x1e, 0x8a, 0x02,
+	0x06, 0x51, 0x6f, 0xc0, 0xaa, 0x13, 0x5b, 0x71, 0xb4, 0x6b, 0xc7, 0x8a, 0x8c, 0xad, 0x9b, 0xd7,
+	0x64, 0x88, 0xb0, 0xab, 0xb7, 0xe1, 0x58, 0x84, 0xc2, 0x08, 0x11, 0x14, 0x50, 0x9b, 0x7a, 0x3d,
+	0x24, 0x73, 0x8d, 0xd3, 0x7d, 0x2d, 0x1d, 0x27, 0x9c, 0xf5, 0xab, 0x6c, 0x13, 0xf2, 0x4b, 0xcb,
+	0x56, 0x57, 0x72, 0x4c, 0xb2, 0xbc, 0x8c, 0x2a, 0x9c, 0x2c, 0x74, 0xc8, 0x1a, 0xfb, 0x56, 0x89,
+	0xeb, 0x2f, 0x70, 0xfe, 0x2f, 0x51, 0xea, 0x1f, 0x1c, 0xce, 0xe1, 0x8d, 0x1c, 0x87, 0xa2, 0x85,
+	0x18, 0xa7, 0xa0, 0x7a, 0x88, 0x2b, 0xe1, 0x71, 0xf1, 0x9b, 0x12, 0xcc, 0x34, 0x89, 0xab, 0xde,
+	0x87, 0xe5, 0xcc, 0xab, 0x78, 0x23, 0x73, 0x84, 0x73, 0xaf, 0x2e, 0x7d, 0x73, 0x1c, 0x42, 0x96,
+	0x39, 0x82, 0xd5, 0xfc, 0x93, 0xeb, 0x74, 0x3e, 0x3c, 0x07, 0xd2, 0xcf, 0x4d, 0x00, 0x92, 0xd3,
+	0x5c, 0x85, 0x59, 0xfe, 0xf6, 0x39, 0x96, 0x0f, 0x62, 0x76, 0xbd, 0x52, 0x6c, 0x97, 0xf1, 0xf7,
+	0xe0, 0x68, 0xea, 0x01, 0x71, 0x08, 0x3e, 0xf1, 0xeb, 0x67, 0x47, 0xfb, 0x65, 0xde, 0x3b, 0x00,
+	0x43, 0xbd, 0xf7, 0x44, 0x71, 0x54, 0xec, 0xd5, 0xcf, 0x8c, 0xf2, 0xca, 0x8c, 0x37, 0xa1, 0x94,
+	0xb4, 0xad, 0xf5, 0x7c, 0x80, 0x70, 0xe9, 0xa7, 0x0e, 0x75, 0x0d, 0x53, 0x4e, 0x35, 0x80, 0x02,
+	0xca, 0xc3, 0x7e, 0xfd, 0xec, 0x68, 0xbf, 0xcc, 0x7b, 0x1f, 0x96, 0x33, 0xf7, 0x6f, 0x41, 0x3d,
+	0xa5, 0x11, 0xfa, 0xe6, 0x38, 0x84, 0xcc, 0xde, 0x06, 0xb5, 0xe0, 0x32, 0x3a, 0x53, 0x44, 0x37,
+	0x8b, 0xd2, 0xcf, 0x4f, 0x82, 0x92, 0x33, 0x05, 0x50, 0x2e, 0x3c, 0xe3, 0x45, 0x3a, 0x14, 0xe0,
+	0x74, 0x73, 0x32, 0x5c, 0x32, 0x9f, 0x3e, 0xf7, 0x15, 0xeb, 0x8e, 0x8d, 0x1b, 0x4f, 0x5f, 0x54,
+	0x94, 0x67, 0x2f, 0x2a, 0xca, 0xaf, 0x2f, 0x2a, 0xca, 0xc3, 0x97, 0x95, 0xa9, 0x67, 0x2f, 0x2b,
+	0x53, 0x3f, 0xbf, 0xac, 0x4c, 0x7d, 0x7e, 0xce, 0xf5, 0x68, 0xbb, 0xbb, 0x67, 0xb6, 0xb0, 0x2f,
+	0xfe, 0x43, 0xd7, 0x72, 0xed, 0x92, 0xf6, 0x43, 0x44, 0xd8, 0x3f, 0xf6, 0x79, 0xde, 0x0d, 0xde,
+	0xfe, 0x67, 0x00, 0x74, 0x7e, 0xba, 0xe2, 0xf1, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -934,6 +1278,11 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
+	// VoteChoice defines a method to add a vote on a specific multiple-choice
+	// proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	VoteChoice(ctx context.Context, in *MsgVoteChoice, opts ...grpc.CallOption) (*MsgVoteChoiceResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/gov module
@@ -945,6 +1294,16 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// DelegateGovernance defines a method to delegate the voting power of an
+	// account to a governance representative.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to remove the governance delegation
+	// of an account.
+	//
+	// Since: cosmos-sdk 0.48
+	UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error)
 }
 
 type msgClient struct {
@@ -991,6 +1350,15 @@ func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts
 	return out, nil
 }
 
+func (c *msgClient) VoteChoice(ctx context.Context, in *MsgVoteChoice, opts ...grpc.CallOption) (*MsgVoteChoiceResponse, error) {
+	out := new(MsgVoteChoiceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/VoteChoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
 	out := new(MsgDepositResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/Deposit", in, out, opts...)
@@ -1018,6 +1386,24 @@ func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, o
 	return out, nil
 }
 
+func (c *msgClient) DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error) {
+	out := new(MsgDelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/DelegateGovernance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error) {
+	out := new(MsgUndelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/UndelegateGovernance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1029,6 +1415,11 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// VoteWeighted defines a method to add a weighted vote on a specific proposal.
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
+	// VoteChoice defines a method to add a vote on a specific multiple-choice
+	// proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	VoteChoice(context.Context, *MsgVoteChoice) (*MsgVoteChoiceResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
 	// UpdateParams defines a governance operation for updating the x/gov module
@@ -1040,6 +1431,16 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// DelegateGovernance defines a method to delegate the voting power of an
+	// account to a governance representative.
+	//
+	// Since: cosmos-sdk 0.48
+	DelegateGovernance(context.Context, *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to remove the governance delegation
+	// of an account.
+	//
+	// Since: cosmos-sdk 0.48
+	UndelegateGovernance(context.Context, *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1058,6 +1459,9 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
 }
+func (*UnimplementedMsgServer) VoteChoice(ctx context.Context, req *MsgVoteChoice) (*MsgVoteChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteChoice not implemented")
+}
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -1067,6 +1471,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
 }
+func (*UnimplementedMsgServer) DelegateGovernance(ctx context.Context, req *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGovernance not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateGovernance(ctx context.Context, req *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGovernance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1144,6 +1554,24 @@ func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteChoice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/VoteChoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteChoice(ctx, req.(*MsgVoteChoice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeposit)
 	if err := dec(in); err != nil {
@@ -1198,8 +1626,44 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.gov.v1.Msg",
+func _Msg_DelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/DelegateGovernance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGovernance(ctx, req.(*MsgDelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/UndelegateGovernance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGovernance(ctx, req.(*MsgUndelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -1218,6 +1682,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VoteWeighted",
 			Handler:    _Msg_VoteWeighted_Handler,
 		},
+		{
+			MethodName: "VoteChoice",
+			Handler:    _Msg_VoteChoice_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
@@ -1230,6 +1698,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelProposal",
 			Handler:    _Msg_CancelProposal_Handler,
 		},
+		{
+			MethodName: "DelegateGovernance",
+			Handler:    _Msg_DelegateGovernance_Handler,
+		},
+		{
+			MethodName: "UndelegateGovernance",
+			Handler:    _Msg_UndelegateGovernance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1255,6 +1731,30 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Optimistic {
+		i--
+		if m.Optimistic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Expedited {
 		i--
 		if m.Expedited {
@@ -1566,6 +2066,85 @@ func (m *MsgVoteWeightedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVoteChoice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteChoice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteChoice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Choices) > 0 {
+		for iNdEx := len(m.Choices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Choices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteChoiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1777,6 +2356,119 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelegateGovernance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateGovernance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RepresentativeAddress) > 0 {
+		i -= len(m.RepresentativeAddress)
+		copy(dAtA[i:], m.RepresentativeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RepresentativeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateGovernanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateGovernance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGovernance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateGovernanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1825,6 +2517,15 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.Optimistic {
+		n += 2
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1933,6 +2634,41 @@ func (m *MsgVoteWeightedResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteChoice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Choices) > 0 {
+		for _, e := range m.Choices {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteChoiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2021,10 +2757,58 @@ func (m *MsgCancelProposalResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
+func (m *MsgDelegateGovernance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RepresentativeAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateGovernanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateGovernance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateGovernanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
@@ -2272,6 +3056,60 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optimistic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optimistic = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &ProposalOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2949,7 +3787,7 @@ func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteChoice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2972,10 +3810,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteChoice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteChoice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2999,7 +3837,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3027,11 +3865,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Choices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3058,11 +3896,43 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Choices = append(m.Choices, &WeightedVoteChoice{})
+			if err := m.Choices[len(m.Choices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3084,7 +3954,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteChoiceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3107,10 +3977,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteChoiceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteChoiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3134,7 +4004,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3157,15 +4027,34 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3193,11 +4082,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3224,7 +4113,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3249,7 +4139,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,10 +4162,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3299,7 +4189,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3322,17 +4212,17 @@ func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3342,16 +4232,29 @@ func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3361,23 +4264,24 @@ func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3400,7 +4304,7 @@ func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3423,34 +4327,185 @@ func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanceledTime", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3521,6 +4576,302 @@ func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateGovernance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGovernance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepresentativeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepresentativeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateGovernanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGovernanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGovernance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGovernance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGovernanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGovernanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
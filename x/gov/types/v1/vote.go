@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/math"
@@ -22,9 +23,14 @@ func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOption
 	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options, Metadata: metadata}
 }
 
+// NewChoiceVote creates a new Vote instance on a multiple-choice proposal
+func NewChoiceVote(proposalID uint64, voter sdk.AccAddress, choices WeightedVoteChoices, metadata string) Vote {
+	return Vote{ProposalId: proposalID, Voter: voter.String(), Choices: choices, Metadata: metadata}
+}
+
 // Empty returns whether a vote is empty.
 func (v Vote) Empty() bool {
-	return v.ProposalId == 0 || v.Voter == "" || len(v.Options) == 0
+	return v.ProposalId == 0 || v.Voter == "" || (len(v.Options) == 0 && len(v.Choices) == 0)
 }
 
 // Votes is a collection of Vote objects
@@ -100,6 +106,69 @@ func (v WeightedVoteOptions) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+// NewWeightedVoteChoice creates a new WeightedVoteChoice instance.
+func NewWeightedVoteChoice(choice uint32, weight sdk.Dec) *WeightedVoteChoice {
+	return &WeightedVoteChoice{Choice: choice, Weight: weight.String()}
+}
+
+// NewNonSplitVoteChoice creates a single choice vote with weight 1
+func NewNonSplitVoteChoice(choice uint32) WeightedVoteChoices {
+	return WeightedVoteChoices{NewWeightedVoteChoice(choice, math.LegacyNewDec(1))}
+}
+
+// IsValid returns true if the choice weight is valid and the choice is among
+// the given number of proposal options, and false otherwise.
+func (w *WeightedVoteChoice) IsValid(numOptions int) bool {
+	weight, err := math.LegacyNewDecFromStr(w.Weight)
+	if err != nil {
+		return false
+	}
+
+	if !weight.IsPositive() || weight.GT(math.LegacyNewDec(1)) {
+		return false
+	}
+
+	return int(w.Choice) < numOptions
+}
+
+// WeightedVoteChoices describes array of WeightedVoteChoices
+type WeightedVoteChoices []*WeightedVoteChoice
+
+func (v WeightedVoteChoices) String() (out string) {
+	for _, choice := range v {
+		out += choice.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
+// WeightedVoteChoicesFromString returns weighted vote choices from a string of
+// comma separated choice=weight pairs. A single choice without weight is given
+// a weight of 1. It returns an error if the string is invalid.
+func WeightedVoteChoicesFromString(str string) (WeightedVoteChoices, error) {
+	if choice, err := strconv.ParseUint(str, 10, 32); err == nil {
+		return NewNonSplitVoteChoice(uint32(choice)), nil
+	}
+
+	choices := WeightedVoteChoices{}
+	for _, choice := range strings.Split(str, ",") {
+		fields := strings.Split(choice, "=")
+		index, err := strconv.ParseUint(fields[0], 10, 32)
+		if err != nil {
+			return choices, fmt.Errorf("'%s' is not a valid vote choice: %w", fields[0], err)
+		}
+		if len(fields) < 2 {
+			return choices, fmt.Errorf("weight field does not exist for %s choice", fields[0])
+		}
+		weight, err := math.LegacyNewDecFromStr(fields[1])
+		if err != nil {
+			return choices, err
+		}
+		choices = append(choices, NewWeightedVoteChoice(uint32(index), weight))
+	}
+	return choices, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
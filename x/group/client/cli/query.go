@@ -35,6 +35,8 @@ func QueryCmd(name string) *cobra.Command {
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
 		QueryGroupsCmd(),
+		QueryProposalExecutionLogsCmd(),
+		QueryGroupHierarchyCmd(),
 	)
 
 	return queryCmd
@@ -554,3 +556,87 @@ func QueryGroupsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryProposalExecutionLogsCmd creates a CLI command for Query/ProposalExecutionLogs.
+func QueryProposalExecutionLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-execution-logs [proposal-id]",
+		Short: "Query for the automatic execution logs of a proposal with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalExecutionLogs(cmd.Context(), &group.QueryProposalExecutionLogsRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "proposal-execution-logs")
+
+	return cmd
+}
+
+// QueryGroupHierarchyCmd creates a CLI command for Query/GroupHierarchy.
+func QueryGroupHierarchyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group-hierarchy [group-id]",
+		Short: "Query for the sub-groups and parent groups of a group",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxDepth, err := cmd.Flags().GetUint32(FlagMaxDepth)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GroupHierarchy(cmd.Context(), &group.QueryGroupHierarchyRequest{
+				GroupId:  groupID,
+				MaxDepth: maxDepth,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagMaxDepth, 0, "Maximum depth to walk in each direction (0 for the module maximum)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -21,6 +21,7 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+	FlagMaxDepth           = "max-depth"
 )
 
 var errZeroGroupID = errors.New("group id cannot be 0")
@@ -45,8 +46,10 @@ func TxCmd(name string, ac address.Codec) *cobra.Command {
 		MsgUpdateGroupPolicyAdminCmd(),
 		MsgUpdateGroupPolicyDecisionPolicyCmd(ac),
 		MsgUpdateGroupPolicyMetadataCmd(),
+		MsgUpdateGroupPolicyAutoExecutionCmd(),
 		MsgWithdrawProposalCmd(),
 		MsgSubmitProposalCmd(),
+		MsgSubmitParentVoteProposalCmd(),
 		MsgVoteCmd(),
 		MsgExecCmd(),
 		MsgLeaveGroupCmd(),
@@ -566,6 +569,62 @@ func MsgUpdateGroupPolicyMetadataCmd() *cobra.Command {
 	return cmd
 }
 
+// MsgUpdateGroupPolicyAutoExecutionCmd creates a CLI command for Msg/UpdateGroupPolicyAutoExecution.
+func MsgUpdateGroupPolicyAutoExecutionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-auto-execution [admin] [group-policy-account] [enabled] [gas-limit] [max-retries]",
+		Short: "Update a group policy automatic execution settings",
+		Long: `Update a group policy automatic execution settings.
+
+When enabled, accepted proposals are executed at the end of their voting period
+with at most [gas-limit] gas, and failed executions are retried up to
+[max-retries] times in the following blocks.`,
+		Example: fmt.Sprintf("%s tx group update-group-policy-auto-execution [admin] [group-policy-account] true 500000 3", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxRetries, err := strconv.ParseUint(args[4], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUpdateGroupPolicyAutoExecution{
+				Admin:              clientCtx.GetFromAddress().String(),
+				GroupPolicyAddress: args[1],
+				AutoExecution: group.AutoExecution{
+					Enabled:    enabled,
+					GasLimit:   gasLimit,
+					MaxRetries: uint32(maxRetries),
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgSubmitProposalCmd creates a CLI command for Msg/SubmitProposal.
 func MsgSubmitProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -702,6 +761,69 @@ Parameters:
 	return cmd
 }
 
+// MsgSubmitParentVoteProposalCmd creates a CLI command for Msg/SubmitParentVoteProposal.
+func MsgSubmitParentVoteProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-parent-vote-proposal [group-policy-account] [proposer] [parent-proposal-id] [vote-option] [vote-metadata]",
+		Short: "Submit a proposal for a group policy to vote on a parent group's proposal",
+		Long: `Submit an internal proposal on a group policy which is a member of another
+group. Once executed, the internal proposal casts the group policy's vote on the
+parent group's proposal.
+
+Parameters:
+			group-policy-account: account address of the sub-DAO group policy
+			proposer: proposer account address
+			parent-proposal-id: unique ID of the parent group's proposal
+			vote-option: choice of the group policy
+				VOTE_OPTION_NO: no
+				VOTE_OPTION_YES: yes
+				VOTE_OPTION_ABSTAIN: abstain
+				VOTE_OPTION_NO_WITH_VETO: no-with-veto
+			vote-metadata: metadata for the vote on the parent proposal
+`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			parentProposalID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			voteOption, err := group.VoteOptionFromString(args[3])
+			if err != nil {
+				return err
+			}
+
+			execStr, _ := cmd.Flags().GetString(FlagExec)
+
+			msg := &group.MsgSubmitParentVoteProposal{
+				GroupPolicyAddress: args[0],
+				Proposers:          []string{clientCtx.GetFromAddress().String()},
+				ParentProposalId:   parentProposalID,
+				Option:             voteOption,
+				VoteMetadata:       args[4],
+				Exec:               execFromString(execStr),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to 1 to try to execute the internal proposal immediately after creation (proposers signatures are considered as Yes votes)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgVoteCmd creates a CLI command for Msg/Vote.
 func MsgVoteCmd() *cobra.Command {
 	cmd := &cobra.Command{
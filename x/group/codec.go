@@ -18,6 +18,7 @@ func RegisterLegacyAminoCodec(cdc *codectypes.LegacyAmino) {
 	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
 	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
 	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+	cdc.RegisterConcrete(&TokenWeightedDecisionPolicy{}, "cosmos-sdk/TokenWeightedDecisionPolicy", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers")
@@ -28,6 +29,8 @@ func RegisterLegacyAminoCodec(cdc *codectypes.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyAdmin{}, "cosmos-sdk/MsgUpdateGroupPolicyAdmin")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyDecisionPolicy{}, "cosmos-sdk/MsgUpdateGroupDecisionPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyMetadata{}, "cosmos-sdk/MsgUpdateGroupPolicyMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyAutoExecution{}, "cosmos-sdk/MsgUpdateGroupAutoExecution")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitParentVoteProposal{}, "cosmos-sdk/group/MsgSubmitParentVote")
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "cosmos-sdk/group/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawProposal{}, "cosmos-sdk/group/MsgWithdrawProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/group/MsgVote")
@@ -47,6 +50,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateGroupPolicyAdmin{},
 		&MsgUpdateGroupPolicyDecisionPolicy{},
 		&MsgUpdateGroupPolicyMetadata{},
+		&MsgUpdateGroupPolicyAutoExecution{},
+		&MsgSubmitParentVoteProposal{},
 		&MsgSubmitProposal{},
 		&MsgWithdrawProposal{},
 		&MsgVote{},
@@ -61,6 +66,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*DecisionPolicy)(nil),
 		&ThresholdDecisionPolicy{},
 		&PercentageDecisionPolicy{},
+		&TokenWeightedDecisionPolicy{},
 	)
 }
 
@@ -9,6 +9,27 @@ type Config struct {
 	MaxExecutionPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the group module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// MaxAutoExecutionGasLimit defines the max gas limit a group policy can set
+	// for the automatic execution of its proposals.
+	MaxAutoExecutionGasLimit uint64
+	// MaxAutoExecutionRetries defines the max number of times a group policy can
+	// retry the automatic execution of a failed proposal.
+	MaxAutoExecutionRetries uint32
+	// MaxAutoExecutionBlockGas defines the total gas budget, across all
+	// proposals and retries, that automatic execution can use in a single
+	// block. Each attempt needs its group policy's gas limit to fit in the
+	// remaining budget, and only the gas it consumed is charged against it, so
+	// it must be at least MaxAutoExecutionGasLimit.
+	MaxAutoExecutionBlockGas uint64
+	// MaxAutoExecutionsPerBlock defines the max number of automatic execution
+	// attempts in a single block. Proposals left over are executed in the
+	// following blocks.
+	MaxAutoExecutionsPerBlock uint32
+	// ExecutionLogRetention defines how long the automatic execution logs are
+	// kept after their attempt, including after their proposal is pruned. The
+	// logs count the automatic execution attempts of pending proposals, so it
+	// must be at least MaxExecutionPeriod.
+	ExecutionLogRetention time.Duration
 }
 
 // DefaultConfig returns the default config for group.
@@ -16,5 +37,11 @@ func DefaultConfig() Config {
 	return Config{
 		MaxExecutionPeriod: 2 * time.Hour * 24 * 7, // Two weeks.
 		MaxMetadataLen:     255,
+
+		MaxAutoExecutionGasLimit:  10_000_000,
+		MaxAutoExecutionRetries:   5,
+		MaxAutoExecutionBlockGas:  30_000_000,
+		MaxAutoExecutionsPerBlock: 10,
+		ExecutionLogRetention:     2 * time.Hour * 24 * 7, // Two weeks.
 	}
 }
@@ -464,6 +464,73 @@ func (m *EventLeaveGroup) GetAddress() string {
 	return ""
 }
 
+// EventSubmitParentVoteProposal is an event emitted when an internal proposal
+// to vote on a parent group's proposal is submitted.
+//
+// Since: cosmos-sdk 0.48
+type EventSubmitParentVoteProposal struct {
+	// proposal_id is the unique ID of the internal proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// parent_proposal_id is the unique ID of the parent group's proposal.
+	ParentProposalId uint64 `protobuf:"varint,2,opt,name=parent_proposal_id,json=parentProposalId,proto3" json:"parent_proposal_id,omitempty"`
+	// group_policy_address is the account address of the voting group policy.
+	GroupPolicyAddress string `protobuf:"bytes,3,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+}
+
+func (m *EventSubmitParentVoteProposal) Reset()         { *m = EventSubmitParentVoteProposal{} }
+func (m *EventSubmitParentVoteProposal) String() string { return proto.CompactTextString(m) }
+func (*EventSubmitParentVoteProposal) ProtoMessage()    {}
+func (*EventSubmitParentVoteProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{9}
+}
+func (m *EventSubmitParentVoteProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSubmitParentVoteProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSubmitParentVoteProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSubmitParentVoteProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubmitParentVoteProposal.Merge(m, src)
+}
+func (m *EventSubmitParentVoteProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSubmitParentVoteProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubmitParentVoteProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubmitParentVoteProposal proto.InternalMessageInfo
+
+func (m *EventSubmitParentVoteProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventSubmitParentVoteProposal) GetParentProposalId() uint64 {
+	if m != nil {
+		return m.ParentProposalId
+	}
+	return 0
+}
+
+func (m *EventSubmitParentVoteProposal) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1.EventUpdateGroup")
@@ -474,36 +541,41 @@ func init() {
 	proto.RegisterType((*EventVote)(nil), "cosmos.group.v1.EventVote")
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
+	proto.RegisterType((*EventSubmitParentVoteProposal)(nil), "cosmos.group.v1.EventSubmitParentVoteProposal")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2f, 0xca, 0x2f, 0x2d, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd,
-	0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0xc8, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0x24, 0x21, 0x02, 0xf1, 0x60, 0x69, 0x7d, 0xa8, 0x2c, 0x98, 0x23, 0x25, 0x8d,
-	0x6e, 0x52, 0x49, 0x65, 0x41, 0x2a, 0x54, 0x52, 0x49, 0x97, 0x4b, 0xc0, 0x15, 0x64, 0xb0, 0x73,
-	0x51, 0x6a, 0x62, 0x49, 0xaa, 0x3b, 0x48, 0x89, 0x90, 0x24, 0x17, 0x07, 0x58, 0x6d, 0x7c, 0x66,
-	0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x3b, 0x98, 0xef, 0x99, 0x02, 0x57, 0x1e, 0x5a,
-	0x90, 0x42, 0x8c, 0x72, 0x1f, 0x2e, 0x31, 0x74, 0xd3, 0x03, 0xf2, 0x73, 0x32, 0x93, 0x2b, 0x85,
-	0x8c, 0xb8, 0xd8, 0x13, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0xc1, 0x7a, 0x38, 0x9d, 0x24, 0x2e,
-	0x6d, 0xd1, 0x15, 0x81, 0xba, 0xdb, 0x11, 0x22, 0x13, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x04,
-	0x53, 0x08, 0x37, 0x0d, 0xc9, 0x72, 0x0a, 0x4c, 0x33, 0xe3, 0x12, 0x06, 0x9b, 0x16, 0x5c, 0x9a,
-	0x94, 0x9b, 0x59, 0x12, 0x50, 0x94, 0x5f, 0x90, 0x5f, 0x9c, 0x98, 0x23, 0x24, 0xcf, 0xc5, 0x5d,
-	0x00, 0x65, 0x23, 0x3c, 0xc4, 0x05, 0x13, 0xf2, 0x4c, 0x51, 0xb2, 0xe0, 0x12, 0x05, 0xeb, 0x0b,
-	0xcf, 0x2c, 0xc9, 0x48, 0x29, 0x4a, 0x2c, 0x27, 0x5e, 0xa7, 0x0e, 0x17, 0x27, 0x58, 0x67, 0x58,
-	0x7e, 0x49, 0x2a, 0x61, 0xd5, 0x8d, 0x8c, 0x50, 0xe5, 0xae, 0x15, 0xa9, 0xc9, 0x04, 0x95, 0x0b,
-	0xd9, 0x73, 0xb1, 0x15, 0xa5, 0x16, 0x97, 0xe6, 0x94, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x19,
-	0xa9, 0xeb, 0xa1, 0x25, 0x11, 0x3d, 0x98, 0x43, 0x41, 0xe6, 0x95, 0x96, 0xe4, 0x17, 0x05, 0x81,
-	0x95, 0x07, 0x41, 0xb5, 0x09, 0x09, 0x71, 0xb1, 0xe4, 0xe4, 0xa7, 0x17, 0x4b, 0x30, 0x83, 0x02,
-	0x30, 0x08, 0xcc, 0x56, 0x4a, 0xe0, 0xe2, 0x07, 0x3b, 0xc1, 0x27, 0x35, 0xb1, 0x8c, 0x60, 0x6c,
-	0x23, 0xc7, 0x02, 0x13, 0x91, 0xb1, 0xe0, 0x64, 0x77, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x2a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xd0, 0xf4,
-	0x0c, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x2b, 0x20, 0xc9, 0x39, 0x89, 0x0d, 0x9c, 0x8c, 0x8d,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xc0, 0x98, 0xf8, 0x2f, 0x03, 0x00, 0x00,
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xb6, 0xa5, 0xb5, 0x4f, 0xb0, 0x65, 0xac, 0x92, 0x56, 0x8d, 0x65, 0x11, 0xec,
+	0xa1, 0x9b, 0xd0, 0x0a, 0xe2, 0x49, 0xb1, 0x52, 0xa4, 0xd2, 0xc3, 0x92, 0xa2, 0x82, 0x97, 0x98,
+	0xcd, 0x0c, 0x69, 0x30, 0xdb, 0x19, 0x66, 0x26, 0xb1, 0x3d, 0xfa, 0x0d, 0xfc, 0x30, 0x9e, 0xfc,
+	0x04, 0x1e, 0x8b, 0x27, 0x8f, 0xb2, 0xfb, 0x45, 0x24, 0x2f, 0x93, 0xdd, 0xb0, 0x97, 0x04, 0x3c,
+	0xed, 0xcc, 0xfc, 0x7f, 0xef, 0xbf, 0xff, 0xcc, 0x9b, 0x07, 0x0f, 0x63, 0xa1, 0x27, 0x42, 0xfb,
+	0x89, 0x12, 0xb9, 0xf4, 0x8b, 0x43, 0x9f, 0x17, 0xfc, 0xd2, 0x68, 0x4f, 0x2a, 0x61, 0x04, 0xdd,
+	0xac, 0x54, 0x0f, 0x55, 0xaf, 0x38, 0xdc, 0xdd, 0xa9, 0x0e, 0x42, 0x94, 0x7d, 0xab, 0xe2, 0x66,
+	0xf7, 0xc1, 0xb2, 0x93, 0xb9, 0x96, 0xdc, 0x8a, 0x83, 0x21, 0x6c, 0x9d, 0x94, 0xc6, 0x6f, 0x14,
+	0x8f, 0x0c, 0x7f, 0x5b, 0x22, 0x74, 0x07, 0x6e, 0x21, 0x1b, 0xa6, 0xcc, 0x21, 0x7b, 0x64, 0x7f,
+	0x35, 0x58, 0xc7, 0xfd, 0x29, 0x9b, 0xe3, 0xef, 0x25, 0xeb, 0x82, 0x9f, 0xc1, 0xfd, 0x65, 0xf7,
+	0x91, 0xc8, 0xd2, 0xf8, 0x9a, 0x1e, 0xc1, 0x7a, 0xc4, 0x98, 0xe2, 0x5a, 0x63, 0xcd, 0xc6, 0xb1,
+	0xf3, 0xfb, 0xc7, 0x70, 0xdb, 0xe6, 0x7e, 0x5d, 0x29, 0xe7, 0x46, 0xa5, 0x97, 0x49, 0x50, 0x83,
+	0x73, 0xb7, 0xc6, 0x9f, 0xff, 0x87, 0xdb, 0x73, 0xb8, 0x8b, 0x6e, 0xe7, 0xf9, 0x78, 0x92, 0x9a,
+	0x91, 0x12, 0x52, 0xe8, 0x28, 0xa3, 0x8f, 0xe1, 0xb6, 0xb4, 0xeb, 0xc5, 0x07, 0x41, 0x7d, 0x74,
+	0xca, 0x06, 0x2f, 0xe0, 0x1e, 0xd6, 0x7d, 0x4c, 0xcd, 0x05, 0x53, 0xd1, 0xd7, 0xee, 0x95, 0x07,
+	0xb0, 0x81, 0x95, 0x1f, 0x84, 0xe1, 0xed, 0xf4, 0x37, 0x62, 0xf1, 0x93, 0x2b, 0x1e, 0xb7, 0xe2,
+	0xf4, 0x15, 0xac, 0x29, 0xae, 0xf3, 0xcc, 0x38, 0xfd, 0x3d, 0xb2, 0x7f, 0xe7, 0xe8, 0xa9, 0xb7,
+	0xf4, 0x44, 0xbc, 0x3a, 0x68, 0xe9, 0x97, 0x1b, 0xa1, 0x02, 0xc4, 0x03, 0x5b, 0x46, 0x29, 0xac,
+	0x66, 0x22, 0xd1, 0xce, 0x4a, 0x79, 0x81, 0x01, 0xae, 0x07, 0x9f, 0x61, 0x13, 0x23, 0x9c, 0xf1,
+	0xa8, 0x68, 0xed, 0x76, 0xb3, 0x0b, 0xfd, 0xae, 0x5d, 0xf8, 0x49, 0xe0, 0x51, 0xb3, 0x0d, 0x91,
+	0xb2, 0x17, 0xd4, 0xf9, 0x5a, 0xe9, 0x01, 0x50, 0x89, 0x65, 0x61, 0x93, 0xeb, 0x23, 0xb7, 0x55,
+	0x29, 0xa3, 0x05, 0xfd, 0x0e, 0xb6, 0xab, 0xfc, 0x12, 0x9f, 0x4e, 0x58, 0x27, 0x5e, 0x69, 0x49,
+	0x4c, 0x93, 0xc5, 0x7b, 0xb3, 0xca, 0xf1, 0xcb, 0x5f, 0x53, 0x97, 0xdc, 0x4c, 0x5d, 0xf2, 0x77,
+	0xea, 0x92, 0xef, 0x33, 0xb7, 0x77, 0x33, 0x73, 0x7b, 0x7f, 0x66, 0x6e, 0xef, 0xd3, 0x93, 0x24,
+	0x35, 0x17, 0xf9, 0xd8, 0x8b, 0xc5, 0xc4, 0x0e, 0xa3, 0xfd, 0x19, 0x6a, 0xf6, 0xc5, 0xbf, 0xaa,
+	0x66, 0x71, 0xbc, 0x86, 0x33, 0xf8, 0xec, 0xdf, 0x00, 0x6f, 0x3d, 0x69, 0x7c, 0xec, 0x03, 0x00,
+	0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -781,6 +853,46 @@ func (m *EventLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSubmitParentVoteProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSubmitParentVoteProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSubmitParentVoteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ParentProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ParentProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -913,6 +1025,25 @@ func (m *EventLeaveGroup) Size() (n int) {
 	return n
 }
 
+func (m *EventSubmitParentVoteProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.ParentProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ParentProposalId))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1649,6 +1780,126 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSubmitParentVoteProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubmitParentVoteProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubmitParentVoteProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentProposalId", wireType)
+			}
+			m.ParentProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -79,6 +79,26 @@ func (s GenesisState) Validate() error {
 			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, snapshot := range s.ProposalWeightSnapshots {
+
+		if err := snapshot.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "ProposalWeightSnapshot validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[snapshot.ProposalId]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", snapshot.ProposalId))
+		}
+	}
+
+	// execution logs outlive their proposal, so they don't need to reference an
+	// existing proposal.
+	for _, l := range s.ProposalExecutionLogs {
+		if err := l.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "ProposalExecutionLog validation failed")
+		}
+	}
 	return nil
 }
 
@@ -43,6 +43,14 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// proposal_weight_snapshots is the list of proposals' voting weight snapshots.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalWeightSnapshots []*ProposalWeightSnapshot `protobuf:"bytes,9,rep,name=proposal_weight_snapshots,json=proposalWeightSnapshots,proto3" json:"proposal_weight_snapshots,omitempty"`
+	// proposal_execution_logs is the list of proposals' automatic execution logs.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalExecutionLogs []*ProposalExecutionLog `protobuf:"bytes,10,rep,name=proposal_execution_logs,json=proposalExecutionLogs,proto3" json:"proposal_execution_logs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +142,20 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetProposalWeightSnapshots() []*ProposalWeightSnapshot {
+	if m != nil {
+		return m.ProposalWeightSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalExecutionLogs() []*ProposalExecutionLog {
+	if m != nil {
+		return m.ProposalExecutionLogs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.group.v1.GenesisState")
 }
@@ -141,29 +163,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/genesis.proto", fileDescriptor_cc6105fe3ef99f06) }
 
 var fileDescriptor_cc6105fe3ef99f06 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0xfa, 0x40,
-	0x10, 0xc7, 0xe9, 0x8f, 0x3f, 0x3f, 0x58, 0xfe, 0x68, 0x36, 0x31, 0xa9, 0xa0, 0x0d, 0x1a, 0x0f,
-	0x24, 0xc6, 0x36, 0xe0, 0xc1, 0x9b, 0x89, 0x5e, 0x88, 0x07, 0x13, 0x52, 0x12, 0x0f, 0x5e, 0x0c,
-	0xe0, 0x58, 0x1b, 0x29, 0x53, 0x3a, 0x0b, 0x91, 0xb7, 0xf0, 0x09, 0x7c, 0x1e, 0x8f, 0x1c, 0x3d,
-	0x1a, 0x78, 0x11, 0xc3, 0x6c, 0x49, 0x0d, 0x70, 0xda, 0xdd, 0xd9, 0xcf, 0x77, 0x3e, 0x93, 0x8c,
-	0x38, 0x1e, 0x20, 0x05, 0x48, 0x8e, 0x17, 0xe1, 0x24, 0x74, 0xa6, 0x4d, 0xc7, 0x83, 0x11, 0x90,
-	0x4f, 0x76, 0x18, 0xa1, 0x42, 0xb9, 0xa7, 0xbf, 0x6d, 0xfe, 0xb6, 0xa7, 0xcd, 0x6a, 0x6d, 0x93,
-	0x57, 0xb3, 0x10, 0x62, 0xfa, 0xf4, 0x33, 0x2d, 0x4a, 0x6d, 0x9d, 0xef, 0xaa, 0x9e, 0x02, 0x59,
-	0x13, 0x05, 0x06, 0x9f, 0x08, 0xc6, 0xa6, 0x51, 0x37, 0x1a, 0x19, 0x37, 0xcf, 0x85, 0x2e, 0x8c,
-	0x65, 0x4b, 0xe4, 0xf8, 0x4e, 0xe6, 0xbf, 0x7a, 0xba, 0x51, 0x6c, 0x55, 0xed, 0x0d, 0x99, 0xdd,
-	0x5e, 0x5d, 0xee, 0x46, 0x2f, 0xe8, 0xc6, 0xa4, 0xbc, 0x11, 0x65, 0xdd, 0x30, 0x80, 0xa0, 0x0f,
-	0x11, 0x99, 0x69, 0x8e, 0x1e, 0xed, 0x8e, 0xde, 0x33, 0xe4, 0x96, 0xbc, 0xe4, 0x41, 0xb2, 0x21,
-	0xf6, 0x75, 0x8b, 0x10, 0x87, 0xfe, 0x60, 0xc6, 0xa3, 0x65, 0x78, 0xb4, 0x0a, 0xd7, 0x3b, 0x5c,
-	0x5e, 0x0d, 0xd8, 0x16, 0x95, 0x3f, 0xa4, 0x0f, 0x64, 0x66, 0xd9, 0x56, 0xdf, 0x6d, 0xd3, 0x41,
-	0x1e, 0xb7, 0x9c, 0x74, 0xf2, 0x81, 0xe4, 0x89, 0x28, 0x85, 0x11, 0x86, 0x48, 0xbd, 0x21, 0xeb,
-	0x72, 0xac, 0x2b, 0xae, 0x6b, 0x2b, 0xd7, 0x95, 0x28, 0xac, 0x9f, 0x64, 0xfe, 0x67, 0xcd, 0xe1,
-	0x96, 0xa6, 0x13, 0x13, 0x6e, 0xc2, 0xca, 0x73, 0x91, 0x9d, 0xa2, 0x02, 0x32, 0xf3, 0x1c, 0x3a,
-	0xd8, 0x0a, 0x3d, 0xa0, 0x02, 0x57, 0x33, 0xb7, 0xd7, 0x5f, 0x0b, 0xcb, 0x98, 0x2f, 0x2c, 0xe3,
-	0x67, 0x61, 0x19, 0x1f, 0x4b, 0x2b, 0x35, 0x5f, 0x5a, 0xa9, 0xef, 0xa5, 0x95, 0x7a, 0x3c, 0xf3,
-	0x7c, 0xf5, 0x3a, 0xe9, 0xdb, 0x03, 0x0c, 0x9c, 0x78, 0xc5, 0xfa, 0xb8, 0xa0, 0xe7, 0x37, 0xe7,
-	0x5d, 0xef, 0xbb, 0x9f, 0xe3, 0x3d, 0x5f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x99, 0x5b, 0x30,
-	0xc4, 0x36, 0x02, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x51, 0xab, 0x12, 0x41,
+	0x14, 0xc7, 0xdd, 0xae, 0xd7, 0xae, 0x73, 0xbd, 0xb7, 0x18, 0xb8, 0x34, 0x6a, 0x2d, 0x16, 0x45,
+	0x42, 0xb4, 0x8b, 0xf6, 0xd0, 0x5b, 0x50, 0x10, 0x12, 0x14, 0xc8, 0x0a, 0x05, 0x41, 0x2c, 0xba,
+	0x4d, 0xeb, 0x92, 0xbb, 0x67, 0xdc, 0x33, 0x9a, 0x7e, 0x8b, 0x3e, 0x56, 0x8f, 0x3e, 0xf6, 0x18,
+	0xeb, 0x17, 0x09, 0xcf, 0xac, 0x7a, 0xd1, 0xf5, 0x69, 0x77, 0xfe, 0xf3, 0xfb, 0x9f, 0xdf, 0x79,
+	0x18, 0xf6, 0x28, 0x00, 0x8c, 0x01, 0xdd, 0x30, 0x85, 0x99, 0x72, 0xe7, 0x1d, 0x37, 0x94, 0x89,
+	0xc4, 0x08, 0x1d, 0x95, 0x82, 0x06, 0x7e, 0xcf, 0x5c, 0x3b, 0x74, 0xed, 0xcc, 0x3b, 0x8d, 0xe6,
+	0x21, 0xaf, 0x97, 0x4a, 0xe6, 0xf4, 0x93, 0xac, 0xcc, 0x6a, 0x3d, 0xd3, 0x1f, 0xe8, 0xa1, 0x96,
+	0xbc, 0xc9, 0xaa, 0x04, 0xfa, 0x28, 0xa7, 0xc2, 0x6a, 0x59, 0xed, 0xb2, 0x77, 0x41, 0xc1, 0x40,
+	0x4e, 0x79, 0x97, 0x55, 0xe8, 0x1f, 0xc5, 0x9d, 0xd6, 0x59, 0xfb, 0xb2, 0xdb, 0x70, 0x0e, 0x64,
+	0x4e, 0x6f, 0xf3, 0xf3, 0x21, 0xf9, 0x01, 0x5e, 0x4e, 0xf2, 0xb7, 0xec, 0xca, 0x0c, 0x8c, 0x65,
+	0x3c, 0x92, 0x29, 0x8a, 0x33, 0xaa, 0x3e, 0x2c, 0xae, 0x7e, 0x22, 0xc8, 0xab, 0x85, 0xfb, 0x03,
+	0xf2, 0x36, 0xbb, 0x6f, 0x46, 0x28, 0x98, 0x44, 0xc1, 0x92, 0x56, 0x2b, 0xd3, 0x6a, 0xd7, 0x94,
+	0xf7, 0x29, 0xde, 0x2c, 0xd8, 0x63, 0xd7, 0xb7, 0xc8, 0x48, 0xa2, 0x38, 0x27, 0x5b, 0xab, 0xd8,
+	0x66, 0x8a, 0xb4, 0xee, 0xd5, 0x7e, 0x52, 0x24, 0x91, 0x3f, 0x66, 0x35, 0x95, 0x82, 0x02, 0x1c,
+	0x4e, 0x48, 0x57, 0x21, 0xdd, 0xe5, 0x36, 0xdb, 0xb8, 0x5e, 0xb3, 0xea, 0xf6, 0x88, 0xe2, 0x2e,
+	0x69, 0xea, 0x47, 0x9a, 0x7e, 0x4e, 0x78, 0x7b, 0x96, 0xbf, 0x60, 0xe7, 0x73, 0xd0, 0x12, 0xc5,
+	0x05, 0x95, 0x6e, 0x8e, 0x4a, 0x9f, 0x41, 0x4b, 0xcf, 0x30, 0x3c, 0x60, 0xf5, 0xdd, 0x22, 0xbf,
+	0x64, 0x14, 0x8e, 0xb5, 0x8f, 0xc9, 0x50, 0xe1, 0x18, 0x34, 0x8a, 0x2a, 0x0d, 0x78, 0x7e, 0xd2,
+	0xfa, 0x85, 0x0a, 0x83, 0x9c, 0xf7, 0x1e, 0xa8, 0xc2, 0x1c, 0xf9, 0x37, 0xb6, 0xbb, 0xf2, 0xe5,
+	0x42, 0x06, 0x33, 0x1d, 0x41, 0xe2, 0x4f, 0x20, 0x44, 0xc1, 0x48, 0xf1, 0xec, 0xa4, 0xe2, 0xfd,
+	0x16, 0xff, 0x08, 0xa1, 0x77, 0xa3, 0x0a, 0x52, 0x7c, 0xf7, 0xe6, 0x4f, 0x66, 0x5b, 0xab, 0xcc,
+	0xb6, 0xfe, 0x65, 0xb6, 0xf5, 0x7b, 0x6d, 0x97, 0x56, 0x6b, 0xbb, 0xf4, 0x77, 0x6d, 0x97, 0xbe,
+	0x3e, 0x0d, 0x23, 0x3d, 0x9e, 0x8d, 0x9c, 0x00, 0x62, 0x37, 0x7f, 0xa6, 0xe6, 0xf3, 0x12, 0xbf,
+	0xff, 0x74, 0x17, 0xe6, 0xcd, 0x8e, 0x2a, 0xf4, 0x56, 0x5f, 0xfd, 0x1f, 0x00, 0x84, 0x76, 0x6a,
+	0xdc, 0xfa, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +212,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalExecutionLogs) > 0 {
+		for iNdEx := len(m.ProposalExecutionLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalExecutionLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ProposalWeightSnapshots) > 0 {
+		for iNdEx := len(m.ProposalWeightSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalWeightSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +384,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ProposalWeightSnapshots) > 0 {
+		for _, e := range m.ProposalWeightSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalExecutionLogs) > 0 {
+		for _, e := range m.ProposalExecutionLogs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +661,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalWeightSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalWeightSnapshots = append(m.ProposalWeightSnapshots, &ProposalWeightSnapshot{})
+			if err := m.ProposalWeightSnapshots[len(m.ProposalWeightSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalExecutionLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalExecutionLogs = append(m.ProposalExecutionLogs, &ProposalExecutionLog{})
+			if err := m.ProposalExecutionLogs[len(m.ProposalExecutionLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
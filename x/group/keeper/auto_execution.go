@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+)
+
+// ExecuteAcceptedProposals executes the accepted proposals whose voting period
+// has ended and whose group policy has automatic execution enabled. Each
+// execution attempt is gas limited and recorded in an execution log. Failed
+// executions are retried in the following blocks, up to the group policy's max
+// retries.
+//
+// The work done per block is bounded by the MaxAutoExecutionsPerBlock and
+// MaxAutoExecutionBlockGas configs: every attempt, retries included, needs its
+// gas limit to fit in the remaining block budget, and only the gas it consumed
+// is charged against it. Proposals that don't fit in the budget stay in state
+// and are executed in the following blocks.
+func (k Keeper) ExecuteAcceptedProposals(ctx sdk.Context) error {
+	candidates, err := k.autoExecutionCandidates(ctx, k.config.MaxAutoExecutionsPerBlock)
+	if err != nil {
+		return err
+	}
+
+	gasLeft := k.config.MaxAutoExecutionBlockGas
+	for _, c := range candidates {
+		if c.autoExecution.GasLimit > gasLeft {
+			continue
+		}
+
+		gasUsed, err := k.autoExecuteProposal(ctx, c.proposal, c.policyInfo, c.decisionPolicy, c.autoExecution, c.attempt)
+		if err != nil {
+			return errorsmod.Wrapf(err, "auto execute proposal %d", c.proposal.Id)
+		}
+		gasLeft -= gasUsed
+	}
+
+	return nil
+}
+
+// autoExecutionCandidate is a proposal ready for an automatic execution
+// attempt.
+type autoExecutionCandidate struct {
+	proposal       group.Proposal
+	policyInfo     group.GroupPolicyInfo
+	decisionPolicy group.DecisionPolicy
+	autoExecution  group.AutoExecution
+	attempt        uint64
+}
+
+// autoExecutionCandidates returns up to `limit` proposals ready for an
+// automatic execution attempt, by voting period end.
+func (k Keeper) autoExecutionCandidates(ctx sdk.Context, limit uint32) ([]autoExecutionCandidate, error) {
+	var candidates []autoExecutionCandidate
+	if limit == 0 {
+		return candidates, nil
+	}
+
+	timeBytes := sdk.FormatTimeBytes(ctx.BlockTime())
+	it, err := k.proposalsByVotingPeriodEnd.PrefixScan(ctx.KVStore(k.key), nil, timeBytes)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for uint32(len(candidates)) < limit {
+		// See proposalsByVPEnd on why the proposal is declared in the loop.
+		var proposal group.Proposal
+		_, err := it.LoadNext(&proposal)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if proposal.Status != group.PROPOSAL_STATUS_ACCEPTED || proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+			continue
+		}
+
+		policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "group policy")
+		}
+
+		autoExecution := policyInfo.AutoExecution
+		if autoExecution == nil || !autoExecution.Enabled {
+			continue
+		}
+
+		decisionPolicy, err := policyInfo.GetDecisionPolicy()
+		if err != nil {
+			return nil, err
+		}
+
+		// Wait for the min execution period without consuming an attempt.
+		if ctx.BlockTime().Before(proposal.SubmitTime.Add(decisionPolicy.GetMinExecutionPeriod())) {
+			continue
+		}
+
+		attempts, err := k.countExecutionLogs(ctx, proposal.Id)
+		if err != nil {
+			return nil, err
+		}
+		if attempts > uint64(autoExecution.MaxRetries) {
+			continue
+		}
+
+		candidates = append(candidates, autoExecutionCandidate{
+			proposal:       proposal,
+			policyInfo:     policyInfo,
+			decisionPolicy: decisionPolicy,
+			autoExecution:  *autoExecution,
+			attempt:        attempts + 1,
+		})
+	}
+
+	return candidates, nil
+}
+
+// autoExecuteProposal executes an accepted proposal within the automatic
+// execution gas limit, and records the attempt in an execution log. It returns
+// the gas used by the attempt.
+func (k Keeper) autoExecuteProposal(ctx sdk.Context, proposal group.Proposal, policyInfo group.GroupPolicyInfo, decisionPolicy group.DecisionPolicy, autoExecution group.AutoExecution, attempt uint64) (uint64, error) {
+	addr, err := k.accKeeper.StringToBytes(policyInfo.Address)
+	if err != nil {
+		return 0, err
+	}
+
+	// Caching context so that we don't update the store in case of failure.
+	cacheCtx, flush := ctx.CacheContext()
+	gasMeter := storetypes.NewGasMeter(autoExecution.GasLimit)
+
+	var logs string
+	results, err := k.safeExecuteMsgs(cacheCtx.WithGasMeter(gasMeter), proposal, addr, decisionPolicy)
+	if err != nil {
+		proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+		logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, err.Error())
+		k.Logger(ctx).Info("proposal auto execution failed", "cause", err, "proposalID", proposal.Id, "attempt", attempt)
+	} else {
+		proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+		flush()
+
+		for _, res := range results {
+			// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+			ctx.EventManager().EmitEvents(res.GetEvents())
+		}
+	}
+
+	gasUsed := gasMeter.GasConsumedToLimit()
+	store := ctx.KVStore(k.key)
+	if err := k.proposalExecutionLogTable.Create(store, &group.ProposalExecutionLog{
+		ProposalId: proposal.Id,
+		Attempt:    attempt,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime(),
+		Result:     proposal.ExecutorResult,
+		GasUsed:    gasUsed,
+		Log:        logs,
+	}); err != nil {
+		return 0, err
+	}
+
+	// If proposal has successfully run, delete it from state.
+	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
+			return 0, err
+		}
+	} else {
+		if err := k.proposalTable.Update(store, proposal.Id, &proposal); err != nil {
+			return 0, err
+		}
+	}
+
+	return gasUsed, ctx.EventManager().EmitTypedEvent(&group.EventExec{
+		ProposalId: proposal.Id,
+		Logs:       logs,
+		Result:     proposal.ExecutorResult,
+	})
+}
+
+// safeExecuteMsgs executes the proposal messages, turning panics (e.g. out of
+// gas) into errors so that a failing proposal cannot halt the chain.
+func (k Keeper) safeExecuteMsgs(ctx sdk.Context, proposal group.Proposal, groupPolicyAcc sdk.AccAddress, decisionPolicy group.DecisionPolicy) (results []sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+				return
+			}
+
+			err = errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+
+	return k.doExecuteMsgs(ctx, k.router, proposal, groupPolicyAcc, decisionPolicy)
+}
+
+// countExecutionLogs returns the number of automatic execution attempts of a
+// proposal.
+func (k Keeper) countExecutionLogs(ctx sdk.Context, proposalID uint64) (uint64, error) {
+	it, err := k.proposalExecutionLogByProposalIndex.Get(ctx.KVStore(k.key), proposalID)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var count uint64
+	for {
+		var executionLog group.ProposalExecutionLog
+		_, err = it.LoadNext(&executionLog)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// PruneExecutionLogs deletes the automatic execution logs older than the
+// ExecutionLogRetention config.
+func (k Keeper) PruneExecutionLogs(ctx sdk.Context) error {
+	store := ctx.KVStore(k.key)
+	timeBytes := sdk.FormatTimeBytes(ctx.BlockTime().Add(-k.config.ExecutionLogRetention))
+	it, err := k.proposalExecutionLogByTimeIndex.PrefixScan(store, nil, timeBytes)
+	if err != nil {
+		return err
+	}
+
+	var logs []group.ProposalExecutionLog
+	for {
+		var executionLog group.ProposalExecutionLog
+		_, err = it.LoadNext(&executionLog)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			it.Close()
+			return err
+		}
+		logs = append(logs, executionLog)
+	}
+	it.Close()
+
+	for i := range logs {
+		if err := k.proposalExecutionLogTable.Delete(store, &logs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -41,6 +41,14 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.proposalWeightSnapshotTable.Import(ctx.KVStore(k.key), genesisState.ProposalWeightSnapshots, 0); err != nil {
+		panic(errors.Wrap(err, "proposal weight snapshots"))
+	}
+
+	if err := k.proposalExecutionLogTable.Import(ctx.KVStore(k.key), genesisState.ProposalExecutionLogs, 0); err != nil {
+		panic(errors.Wrap(err, "proposal execution logs"))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -87,5 +95,19 @@ func (k Keeper) ExportGenesis(ctx types.Context, cdc codec.JSONCodec) *group.Gen
 	}
 	genesisState.Votes = votes
 
+	var snapshots []*group.ProposalWeightSnapshot
+	_, err = k.proposalWeightSnapshotTable.Export(ctx.KVStore(k.key), &snapshots)
+	if err != nil {
+		panic(errors.Wrap(err, "proposal weight snapshots"))
+	}
+	genesisState.ProposalWeightSnapshots = snapshots
+
+	var executionLogs []*group.ProposalExecutionLog
+	_, err = k.proposalExecutionLogTable.Export(ctx.KVStore(k.key), &executionLogs)
+	if err != nil {
+		panic(errors.Wrap(err, "proposal execution logs"))
+	}
+	genesisState.ProposalExecutionLogs = executionLogs
+
 	return genesisState
 }
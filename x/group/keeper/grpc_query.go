@@ -381,3 +381,54 @@ func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// ProposalExecutionLogs returns the automatic execution logs of a proposal.
+func (k Keeper) ProposalExecutionLogs(goCtx context.Context, request *group.QueryProposalExecutionLogsRequest) (*group.QueryProposalExecutionLogsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	it, err := k.proposalExecutionLogByProposalIndex.GetPaginated(ctx.KVStore(k.key), request.ProposalId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var logs []*group.ProposalExecutionLog
+	pageRes, err := orm.Paginate(it, request.Pagination, &logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalExecutionLogsResponse{
+		Logs:       logs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GroupHierarchy walks the hierarchy of a group, in both directions.
+func (k Keeper) GroupHierarchy(goCtx context.Context, request *group.QueryGroupHierarchyRequest) (*group.QueryGroupHierarchyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getGroupInfo(ctx, request.GroupId); err != nil {
+		return nil, err
+	}
+
+	maxDepth := request.MaxDepth
+	if maxDepth == 0 || maxDepth > MaxGroupHierarchyDepth {
+		maxDepth = MaxGroupHierarchyDepth
+	}
+
+	subGroups, err := k.subGroupEdges(ctx, request.GroupId, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	parentGroups, err := k.parentGroupEdges(ctx, request.GroupId, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupHierarchyResponse{
+		SubGroups:    subGroups,
+		ParentGroups: parentGroups,
+	}, nil
+}
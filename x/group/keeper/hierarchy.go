@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+)
+
+// MaxGroupHierarchyDepth is the maximum depth walked by the GroupHierarchy
+// query, in each direction.
+const MaxGroupHierarchyDepth = 10
+
+// subGroupEdges walks the hierarchy below a group, breadth-first: the groups
+// having a group policy which is a member of the group, then the groups having
+// a group policy which is a member of those, and so on, up to maxDepth.
+func (k Keeper) subGroupEdges(ctx sdk.Context, groupID uint64, maxDepth uint32) ([]group.GroupHierarchyEdge, error) {
+	var edges []group.GroupHierarchyEdge
+	visited := map[uint64]bool{groupID: true}
+	queue := []uint64{groupID}
+	for depth := uint32(1); depth <= maxDepth && len(queue) > 0; depth++ {
+		var next []uint64
+		for _, parentID := range queue {
+			members, err := k.groupMembersByGroup(ctx, parentID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, member := range members {
+				policyInfo, err := k.getGroupPolicyInfo(ctx, member.Member.Address)
+				switch {
+				case sdkerrors.ErrNotFound.Is(err):
+					// The member is not a group policy.
+					continue
+				case err != nil:
+					return nil, err
+				}
+
+				edges = append(edges, group.GroupHierarchyEdge{
+					ParentGroupId:      parentID,
+					ChildGroupId:       policyInfo.GroupId,
+					GroupPolicyAddress: policyInfo.Address,
+					Depth:              depth,
+				})
+
+				if !visited[policyInfo.GroupId] {
+					visited[policyInfo.GroupId] = true
+					next = append(next, policyInfo.GroupId)
+				}
+			}
+		}
+		queue = next
+	}
+
+	return edges, nil
+}
+
+// parentGroupEdges walks the hierarchy above a group, breadth-first: the
+// groups having one of the group's policies as member, then the groups having
+// one of those groups' policies as member, and so on, up to maxDepth.
+func (k Keeper) parentGroupEdges(ctx sdk.Context, groupID uint64, maxDepth uint32) ([]group.GroupHierarchyEdge, error) {
+	var edges []group.GroupHierarchyEdge
+	visited := map[uint64]bool{groupID: true}
+	queue := []uint64{groupID}
+	for depth := uint32(1); depth <= maxDepth && len(queue) > 0; depth++ {
+		var next []uint64
+		for _, childID := range queue {
+			policies, err := k.groupPoliciesByGroup(ctx, childID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, policyInfo := range policies {
+				memberships, err := k.groupMembershipsByMember(ctx, policyInfo.Address)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, membership := range memberships {
+					edges = append(edges, group.GroupHierarchyEdge{
+						ParentGroupId:      membership.GroupId,
+						ChildGroupId:       childID,
+						GroupPolicyAddress: policyInfo.Address,
+						Depth:              depth,
+					})
+
+					if !visited[membership.GroupId] {
+						visited[membership.GroupId] = true
+						next = append(next, membership.GroupId)
+					}
+				}
+			}
+		}
+		queue = next
+	}
+
+	return edges, nil
+}
+
+// groupMembersByGroup returns all the members of a group.
+func (k Keeper) groupMembersByGroup(ctx sdk.Context, groupID uint64) ([]group.GroupMember, error) {
+	it, err := k.groupMemberByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var members []group.GroupMember
+	for {
+		var member group.GroupMember
+		_, err = it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// groupPoliciesByGroup returns all the group policies of a group.
+func (k Keeper) groupPoliciesByGroup(ctx sdk.Context, groupID uint64) ([]group.GroupPolicyInfo, error) {
+	it, err := k.groupPolicyByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var policies []group.GroupPolicyInfo
+	for {
+		var policyInfo group.GroupPolicyInfo
+		_, err = it.LoadNext(&policyInfo)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policyInfo)
+	}
+
+	return policies, nil
+}
+
+// groupMembershipsByMember returns all the group memberships of an address.
+func (k Keeper) groupMembershipsByMember(ctx sdk.Context, address string) ([]group.GroupMember, error) {
+	addr, err := k.accKeeper.StringToBytes(address)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.groupMemberByMemberIndex.Get(ctx.KVStore(k.key), addr)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var memberships []group.GroupMember
+	for {
+		var membership group.GroupMember
+		_, err = it.LoadNext(&membership)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		memberships = append(memberships, membership)
+	}
+
+	return memberships, nil
+}
@@ -44,6 +44,14 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Proposal Weight Snapshot Table
+	ProposalWeightSnapshotTablePrefix byte = 0x50
+
+	// Proposal Execution Log Table
+	ProposalExecutionLogTablePrefix           byte = 0x60
+	ProposalExecutionLogByProposalIndexPrefix byte = 0x61
+	ProposalExecutionLogByTimeIndexPrefix     byte = 0x62
 )
 
 type Keeper struct {
@@ -76,6 +84,18 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Proposal Weight Snapshot Table
+	proposalWeightSnapshotTable orm.PrimaryKeyTable
+
+	// Proposal Execution Log Table
+	proposalExecutionLogTable           orm.PrimaryKeyTable
+	proposalExecutionLogByProposalIndex orm.Index
+	proposalExecutionLogByTimeIndex     orm.Index
+
+	// weightProviders maps weight provider names to their implementation,
+	// used by token weighted decision policies.
+	weightProviders map[string]WeightProvider
+
 	router baseapp.MessageRouter
 
 	config group.Config
@@ -84,9 +104,10 @@ type Keeper struct {
 // NewKeeper creates a new group keeper.
 func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.MessageRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
 	k := Keeper{
-		key:       storeKey,
-		router:    router,
-		accKeeper: accKeeper,
+		key:             storeKey,
+		router:          router,
+		accKeeper:       accKeeper,
+		weightProviders: make(map[string]WeightProvider),
 	}
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
@@ -203,12 +224,64 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.Mes
 	}
 	k.voteTable = *voteTable
 
+	// Proposal Weight Snapshot Table
+	proposalWeightSnapshotTable, err := orm.NewPrimaryKeyTable([2]byte{ProposalWeightSnapshotTablePrefix}, &group.ProposalWeightSnapshot{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalWeightSnapshotTable = *proposalWeightSnapshotTable
+
+	// Proposal Execution Log Table
+	proposalExecutionLogTable, err := orm.NewPrimaryKeyTable([2]byte{ProposalExecutionLogTablePrefix}, &group.ProposalExecutionLog{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalExecutionLogByProposalIndex, err = orm.NewIndex(proposalExecutionLogTable, ProposalExecutionLogByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.ProposalExecutionLog).ProposalId}, nil
+	}, group.ProposalExecutionLog{}.ProposalId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalExecutionLogByTimeIndex, err = orm.NewIndex(proposalExecutionLogTable, ProposalExecutionLogByTimeIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{sdk.FormatTimeBytes(value.(*group.ProposalExecutionLog).Time)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalExecutionLogTable = *proposalExecutionLogTable
+
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
 	}
 	if config.MaxExecutionPeriod == 0 {
 		config.MaxExecutionPeriod = group.DefaultConfig().MaxExecutionPeriod
 	}
+	if config.MaxAutoExecutionGasLimit == 0 {
+		config.MaxAutoExecutionGasLimit = group.DefaultConfig().MaxAutoExecutionGasLimit
+	}
+	if config.MaxAutoExecutionRetries == 0 {
+		config.MaxAutoExecutionRetries = group.DefaultConfig().MaxAutoExecutionRetries
+	}
+	if config.MaxAutoExecutionBlockGas == 0 {
+		config.MaxAutoExecutionBlockGas = group.DefaultConfig().MaxAutoExecutionBlockGas
+	}
+	if config.MaxAutoExecutionsPerBlock == 0 {
+		config.MaxAutoExecutionsPerBlock = group.DefaultConfig().MaxAutoExecutionsPerBlock
+	}
+	if config.ExecutionLogRetention == 0 {
+		config.ExecutionLogRetention = group.DefaultConfig().ExecutionLogRetention
+		if config.ExecutionLogRetention < config.MaxExecutionPeriod {
+			config.ExecutionLogRetention = config.MaxExecutionPeriod
+		}
+	}
+	if config.MaxAutoExecutionBlockGas < config.MaxAutoExecutionGasLimit {
+		panic(fmt.Sprintf("max auto execution block gas %d is lower than max auto execution gas limit %d", config.MaxAutoExecutionBlockGas, config.MaxAutoExecutionGasLimit))
+	}
+	// The automatic execution attempts of a proposal are counted from its
+	// execution logs, so they must outlive the proposal.
+	if config.ExecutionLogRetention < config.MaxExecutionPeriod {
+		panic(fmt.Sprintf("execution log retention %s is lower than max execution period %s", config.ExecutionLogRetention, config.MaxExecutionPeriod))
+	}
 	k.config = config
 
 	return k
@@ -271,6 +344,10 @@ func (k Keeper) pruneProposal(ctx sdk.Context, proposalID uint64) error {
 		return err
 	}
 
+	if err := k.pruneProposalWeightSnapshot(ctx, proposalID); err != nil {
+		return err
+	}
+
 	k.Logger(ctx).Debug(fmt.Sprintf("Pruned proposal %d", proposalID))
 	return nil
 }
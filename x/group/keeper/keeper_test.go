@@ -81,6 +81,8 @@ func (s *TestSuite) SetupTest() {
 
 	config := group.DefaultConfig()
 	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, config)
+	s.groupKeeper.RegisterWeightProvider(keeper.BankWeightProvider, keeper.NewBankWeightProvider(s.bankKeeper))
+	group.RegisterMsgServer(bApp.MsgServiceRouter(), s.groupKeeper)
 	s.ctx = testCtx.Ctx.WithBlockTime(s.blockTime)
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
 
@@ -536,3 +538,310 @@ func (s *TestSuite) TestTallyProposalsAtVPEnd_GroupMemberLeaving() {
 	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
 	s.NotPanics(func() { module.EndBlocker(ctx, s.groupKeeper) })
 }
+
+func (s *TestSuite) TestAutoExecution() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	admin, proposer := s.addrs[0], s.addrs[1]
+	config := group.DefaultConfig()
+
+	invalidSettings := map[string]group.AutoExecution{
+		"no gas limit":      {Enabled: true},
+		"gas limit too big": {Enabled: true, GasLimit: config.MaxAutoExecutionGasLimit + 1},
+		"too many retries":  {Enabled: true, GasLimit: 100_000, MaxRetries: config.MaxAutoExecutionRetries + 1},
+	}
+	for msg, autoExecution := range invalidSettings {
+		_, err := s.groupKeeper.UpdateGroupPolicyAutoExecution(sdkCtx, &group.MsgUpdateGroupPolicyAutoExecution{
+			Admin:              admin.String(),
+			GroupPolicyAddress: s.groupPolicyAddr.String(),
+			AutoExecution:      autoExecution,
+		})
+		s.Require().Error(err, msg)
+	}
+
+	_, err := s.groupKeeper.UpdateGroupPolicyAutoExecution(sdkCtx, &group.MsgUpdateGroupPolicyAutoExecution{
+		Admin:              admin.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		AutoExecution:      group.AutoExecution{Enabled: true, GasLimit: 100_000, MaxRetries: 1},
+	})
+	s.Require().NoError(err)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   proposer.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()}, group.VOTE_OPTION_YES)
+
+	// the voting period ended but not the min execution period: nothing is executed
+	ctx := sdkCtx.WithBlockTime(s.blockTime.Add(2 * time.Second))
+	s.Require().NoError(module.EndBlocker(ctx, s.groupKeeper))
+	logs, err := s.groupKeeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Empty(logs.Logs)
+
+	// the first attempt fails
+	ctx = sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod + time.Second)).WithBlockHeight(10)
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, errors.New("send failed"))
+	s.Require().NoError(module.EndBlocker(ctx, s.groupKeeper))
+
+	proposalRes, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposalRes.Proposal.Status)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposalRes.Proposal.ExecutorResult)
+
+	logs, err = s.groupKeeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(logs.Logs, 1)
+	s.Require().Equal(uint64(1), logs.Logs[0].Attempt)
+	s.Require().Equal(int64(10), logs.Logs[0].Height)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, logs.Logs[0].Result)
+	s.Require().Contains(logs.Logs[0].Log, "send failed")
+
+	// the retry succeeds and the proposal is pruned, its execution logs are kept
+	ctx = ctx.WithBlockHeight(11)
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(&banktypes.MsgSendResponse{}, nil)
+	s.Require().NoError(module.EndBlocker(ctx, s.groupKeeper))
+
+	_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().Error(err)
+
+	logs, err = s.groupKeeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(logs.Logs, 2)
+	s.Require().Equal(uint64(2), logs.Logs[1].Attempt)
+	s.Require().Equal(int64(11), logs.Logs[1].Height)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, logs.Logs[1].Result)
+
+	// the execution logs are pruned after their retention period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(config.ExecutionLogRetention))
+	s.Require().NoError(module.EndBlocker(ctx, s.groupKeeper))
+	logs, err = s.groupKeeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(logs.Logs, 2)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	s.Require().NoError(module.EndBlocker(ctx, s.groupKeeper))
+	logs, err = s.groupKeeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Empty(logs.Logs)
+}
+
+func (s *TestSuite) TestExecutionLogRetention() {
+	key := storetypes.NewKVStoreKey(group.StoreKey)
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{})
+	router := baseapp.NewMsgServiceRouter()
+
+	// the execution logs count the attempts of pending proposals, pruning them
+	// before the proposal would reset its retries
+	config := group.DefaultConfig()
+	config.ExecutionLogRetention = config.MaxExecutionPeriod - time.Second
+	s.Require().Panics(func() {
+		keeper.NewKeeper(key, encCfg.Codec, router, s.accountKeeper, config)
+	})
+
+	// an unset retention covers the max execution period
+	config = group.DefaultConfig()
+	config.MaxExecutionPeriod *= 2
+	config.ExecutionLogRetention = 0
+	s.Require().NotPanics(func() {
+		keeper.NewKeeper(key, encCfg.Codec, router, s.accountKeeper, config)
+	})
+}
+
+func (s *TestSuite) TestAutoExecutionBlockBudget() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	admin, proposer := s.addrs[0], s.addrs[1]
+	config := group.DefaultConfig()
+
+	_, err := s.groupKeeper.UpdateGroupPolicyAutoExecution(sdkCtx, &group.MsgUpdateGroupPolicyAutoExecution{
+		Admin:              admin.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		AutoExecution:      group.AutoExecution{Enabled: true, GasLimit: 100_000},
+	})
+	s.Require().NoError(err)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   proposer.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	numProposals := int(config.MaxAutoExecutionsPerBlock) + 1
+	proposalIDs := make([]uint64, numProposals)
+	for i := range proposalIDs {
+		proposalIDs[i] = submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()}, group.VOTE_OPTION_YES)
+	}
+
+	// only MaxAutoExecutionsPerBlock proposals are executed in the first block
+	ctx := sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod + time.Second))
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(&banktypes.MsgSendResponse{}, nil).Times(int(config.MaxAutoExecutionsPerBlock))
+	s.Require().NoError(module.EndBlocker(ctx, s.groupKeeper))
+
+	proposalRes, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalIDs[numProposals-1]})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposalRes.Proposal.ExecutorResult)
+
+	// the remaining proposal is carried over to the next block
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(&banktypes.MsgSendResponse{}, nil)
+	s.Require().NoError(module.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+1), s.groupKeeper))
+
+	_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalIDs[numProposals-1]})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestAutoExecutionRetriesExhausted() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	admin, proposer := s.addrs[0], s.addrs[1]
+
+	_, err := s.groupKeeper.UpdateGroupPolicyAutoExecution(sdkCtx, &group.MsgUpdateGroupPolicyAutoExecution{
+		Admin:              admin.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		AutoExecution:      group.AutoExecution{Enabled: true, GasLimit: 100_000, MaxRetries: 1},
+	})
+	s.Require().NoError(err)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   proposer.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()}, group.VOTE_OPTION_YES)
+
+	// one attempt and one retry, then the proposal is left for a manual MsgExec
+	ctx := sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod + time.Second))
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, errors.New("send failed")).Times(2)
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(module.EndBlocker(ctx, s.groupKeeper))
+	}
+
+	logs, err := s.groupKeeper.ProposalExecutionLogs(ctx, &group.QueryProposalExecutionLogsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(logs.Logs, 2)
+
+	proposalRes, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposalRes.Proposal.ExecutorResult)
+}
+
+func (s *TestSuite) TestSubDAO() {
+	sdkCtx := s.sdkCtx
+	childMember, parentMember := s.addrs[2], s.addrs[3]
+
+	// child group, whose policy is a member of the parent group
+	childMsg := &group.MsgCreateGroupWithPolicy{
+		Admin:   s.addrs[0].String(),
+		Members: []group.MemberRequest{{Address: childMember.String(), Weight: "1"}},
+	}
+	s.Require().NoError(childMsg.SetDecisionPolicy(group.NewThresholdDecisionPolicy("1", time.Hour, 0)))
+	s.setNextAccount()
+	childRes, err := s.groupKeeper.CreateGroupWithPolicy(sdkCtx, childMsg)
+	s.Require().NoError(err)
+	childPolicyAddr := childRes.GroupPolicyAddress
+
+	parentMsg := &group.MsgCreateGroupWithPolicy{
+		Admin: s.addrs[0].String(),
+		Members: []group.MemberRequest{
+			{Address: parentMember.String(), Weight: "1"},
+			{Address: childPolicyAddr, Weight: "2"},
+		},
+	}
+	s.Require().NoError(parentMsg.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", 2*time.Hour, 0)))
+	s.setNextAccount()
+	parentRes, err := s.groupKeeper.CreateGroupWithPolicy(sdkCtx, parentMsg)
+	s.Require().NoError(err)
+
+	parentProposal, err := s.groupKeeper.SubmitProposal(sdkCtx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: parentRes.GroupPolicyAddress,
+		Proposers:          []string{parentMember.String()},
+	})
+	s.Require().NoError(err)
+
+	specs := map[string]struct {
+		req    *group.MsgSubmitParentVoteProposal
+		expErr string
+	}{
+		"parent proposal not found": {
+			req: &group.MsgSubmitParentVoteProposal{
+				GroupPolicyAddress: childPolicyAddr,
+				Proposers:          []string{childMember.String()},
+				ParentProposalId:   100,
+				Option:             group.VOTE_OPTION_YES,
+			},
+			expErr: "load parent proposal",
+		},
+		"group policy not a member of the parent group": {
+			req: &group.MsgSubmitParentVoteProposal{
+				GroupPolicyAddress: s.groupPolicyAddr.String(),
+				Proposers:          []string{s.addrs[1].String()},
+				ParentProposalId:   parentProposal.ProposalId,
+				Option:             group.VOTE_OPTION_YES,
+			},
+			expErr: "is not a member of parent group",
+		},
+		"own group proposal": {
+			req: &group.MsgSubmitParentVoteProposal{
+				GroupPolicyAddress: parentRes.GroupPolicyAddress,
+				Proposers:          []string{parentMember.String()},
+				ParentProposalId:   parentProposal.ProposalId,
+				Option:             group.VOTE_OPTION_YES,
+			},
+			expErr: "cannot vote on its own group's proposals",
+		},
+		"empty vote option": {
+			req: &group.MsgSubmitParentVoteProposal{
+				GroupPolicyAddress: childPolicyAddr,
+				Proposers:          []string{childMember.String()},
+				ParentProposalId:   parentProposal.ProposalId,
+			},
+			expErr: "vote option",
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			_, err := s.groupKeeper.SubmitParentVoteProposal(sdkCtx, spec.req)
+			s.Require().ErrorContains(err, spec.expErr)
+		})
+	}
+
+	// the child group accepts and executes its internal proposal right away,
+	// casting the child policy's vote on the parent proposal
+	res, err := s.groupKeeper.SubmitParentVoteProposal(sdkCtx, &group.MsgSubmitParentVoteProposal{
+		GroupPolicyAddress: childPolicyAddr,
+		Proposers:          []string{childMember.String()},
+		ParentProposalId:   parentProposal.ProposalId,
+		Option:             group.VOTE_OPTION_YES,
+		VoteMetadata:       "sub-DAO vote",
+		Exec:               group.Exec_EXEC_TRY,
+	})
+	s.Require().NoError(err)
+	s.Require().NotEqual(parentProposal.ProposalId, res.ProposalId)
+
+	voteRes, err := s.groupKeeper.VoteByProposalVoter(sdkCtx, &group.QueryVoteByProposalVoterRequest{
+		ProposalId: parentProposal.ProposalId,
+		Voter:      childPolicyAddr,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(group.VOTE_OPTION_YES, voteRes.Vote.Option)
+	s.Require().Equal("sub-DAO vote", voteRes.Vote.Metadata)
+
+	tally, err := s.groupKeeper.TallyResult(sdkCtx, &group.QueryTallyResultRequest{ProposalId: parentProposal.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal("2", tally.Tally.YesCount)
+
+	// hierarchy queries
+	edge := group.GroupHierarchyEdge{
+		ParentGroupId:      parentRes.GroupId,
+		ChildGroupId:       childRes.GroupId,
+		GroupPolicyAddress: childPolicyAddr,
+		Depth:              1,
+	}
+	hierarchy, err := s.groupKeeper.GroupHierarchy(sdkCtx, &group.QueryGroupHierarchyRequest{GroupId: parentRes.GroupId})
+	s.Require().NoError(err)
+	s.Require().Equal([]group.GroupHierarchyEdge{edge}, hierarchy.SubGroups)
+	s.Require().Empty(hierarchy.ParentGroups)
+
+	hierarchy, err = s.groupKeeper.GroupHierarchy(sdkCtx, &group.QueryGroupHierarchyRequest{GroupId: childRes.GroupId})
+	s.Require().NoError(err)
+	s.Require().Empty(hierarchy.SubGroups)
+	s.Require().Equal([]group.GroupHierarchyEdge{edge}, hierarchy.ParentGroups)
+}
@@ -366,6 +366,10 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, msg *group.MsgCreateGro
 		return nil, err
 	}
 
+	if err := k.validateWeightProvider(policy); err != nil {
+		return nil, err
+	}
+
 	// Generate account address of group policy.
 	var accountAddr sdk.AccAddress
 	// loop here in the rare case where a ADR-028-derived address creates a
@@ -463,6 +467,10 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, msg *grou
 			return err
 		}
 
+		if err := k.validateWeightProvider(policy); err != nil {
+			return err
+		}
+
 		err = groupPolicy.SetDecisionPolicy(policy)
 		if err != nil {
 			return err
@@ -501,6 +509,30 @@ func (k Keeper) UpdateGroupPolicyMetadata(goCtx context.Context, msg *group.MsgU
 	return &group.MsgUpdateGroupPolicyMetadataResponse{}, nil
 }
 
+// UpdateGroupPolicyAutoExecution updates a group policy automatic execution
+// settings.
+func (k Keeper) UpdateGroupPolicyAutoExecution(goCtx context.Context, msg *group.MsgUpdateGroupPolicyAutoExecution) (*group.MsgUpdateGroupPolicyAutoExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	autoExecution := msg.AutoExecution
+
+	if err := autoExecution.Validate(k.config); err != nil {
+		return nil, errorsmod.Wrap(err, "auto execution")
+	}
+
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		groupPolicy.AutoExecution = &autoExecution
+		groupPolicy.Version++
+		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+	}
+
+	err := k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy auto execution updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyAutoExecutionResponse{}, nil
+}
+
 func (k Keeper) SubmitProposal(goCtx context.Context, msg *group.MsgSubmitProposal) (*group.MsgSubmitProposalResponse, error) {
 	if len(msg.Proposers) == 0 {
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposers")
@@ -594,6 +626,11 @@ func (k Keeper) SubmitProposal(goCtx context.Context, msg *group.MsgSubmitPropos
 		return nil, errorsmod.Wrap(err, "create proposal")
 	}
 
+	// Snapshot the members' voting weights for token weighted decision policies.
+	if err := k.snapshotProposalWeights(ctx, id, groupInfo.Id, policy); err != nil {
+		return nil, errorsmod.Wrap(err, "snapshot proposal weights")
+	}
+
 	if err := ctx.EventManager().EmitTypedEvent(&group.EventSubmitProposal{ProposalId: id}); err != nil {
 		return nil, err
 	}
@@ -628,6 +665,100 @@ func (k Keeper) SubmitProposal(goCtx context.Context, msg *group.MsgSubmitPropos
 	return &group.MsgSubmitProposalResponse{ProposalId: id}, nil
 }
 
+// SubmitParentVoteProposal submits an internal proposal on a sub-DAO group
+// policy, whose single message casts the group policy's vote on a proposal of a
+// parent group the group policy is a member of.
+func (k Keeper) SubmitParentVoteProposal(goCtx context.Context, msg *group.MsgSubmitParentVoteProposal) (*group.MsgSubmitParentVoteProposalResponse, error) {
+	if msg.ParentProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "parent proposal id")
+	}
+
+	if msg.Option == group.VOTE_OPTION_UNSPECIFIED {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "vote option")
+	}
+
+	if _, ok := group.VoteOption_name[int32(msg.Option)]; !ok {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "vote option")
+	}
+
+	if err := k.assertMetadataLength(msg.VoteMetadata, "vote metadata"); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	parentProposal, err := k.getProposal(ctx, msg.ParentProposalId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load parent proposal")
+	}
+
+	if parentProposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "parent proposal not open for voting")
+	}
+
+	parentPolicyInfo, err := k.getGroupPolicyInfo(ctx, parentProposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load parent group policy")
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, msg.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "load group policy: %s", msg.GroupPolicyAddress)
+	}
+
+	if policyInfo.GroupId == parentPolicyInfo.GroupId {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "group policy cannot vote on its own group's proposals")
+	}
+
+	// Only group policies which are members of the parent group can vote.
+	if !k.groupMemberTable.Has(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{GroupId: parentPolicyInfo.GroupId, Member: &group.Member{Address: msg.GroupPolicyAddress}})) {
+		return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "group policy %s is not a member of parent group %d", msg.GroupPolicyAddress, parentPolicyInfo.GroupId)
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "group policy decision policy")
+	}
+
+	// Prevent internal proposals that can't be executed before the end of the
+	// parent proposal's voting period.
+	minExecutionDate := ctx.BlockTime().Add(policy.GetMinExecutionPeriod())
+	if minExecutionDate.After(parentProposal.VotingPeriodEnd) {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "internal proposal can't be executed before the parent proposal voting period end %s", parentProposal.VotingPeriodEnd)
+	}
+
+	submitMsg := &group.MsgSubmitProposal{
+		GroupPolicyAddress: msg.GroupPolicyAddress,
+		Proposers:          msg.Proposers,
+		Metadata:           msg.Metadata,
+		Exec:               msg.Exec,
+		Title:              msg.Title,
+		Summary:            msg.Summary,
+	}
+	if err := submitMsg.SetMsgs([]sdk.Msg{&group.MsgVote{
+		ProposalId: msg.ParentProposalId,
+		Voter:      msg.GroupPolicyAddress,
+		Option:     msg.Option,
+		Metadata:   msg.VoteMetadata,
+	}}); err != nil {
+		return nil, err
+	}
+
+	res, err := k.SubmitProposal(ctx, submitMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventSubmitParentVoteProposal{
+		ProposalId:         res.ProposalId,
+		ParentProposalId:   msg.ParentProposalId,
+		GroupPolicyAddress: msg.GroupPolicyAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgSubmitParentVoteProposalResponse{ProposalId: res.ProposalId}, nil
+}
+
 func (k Keeper) WithdrawProposal(goCtx context.Context, msg *group.MsgWithdrawProposal) (*group.MsgWithdrawProposalResponse, error) {
 	if msg.ProposalId == 0 {
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
@@ -765,7 +896,18 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight)
+	// Token weighted decision policies use the total weight snapshotted at
+	// proposal submission instead of the group's total weight.
+	totalWeight := groupInfo.TotalWeight
+	snapshot, found, err := k.getProposalWeightSnapshot(ctx, p.Id)
+	if err != nil {
+		return err
+	}
+	if found {
+		totalWeight = snapshot.TotalWeight
+	}
+
+	result, err := policy.Allow(tallyResult, totalWeight)
 	if err != nil {
 		return errorsmod.Wrap(err, "policy allow")
 	}
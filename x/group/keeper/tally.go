@@ -22,6 +22,14 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 		return p.FinalTallyResult, nil
 	}
 
+	// Proposals submitted to a token weighted decision policy are tallied
+	// using the members' weights snapshotted at submission time.
+	snapshot, hasSnapshot, err := k.getProposalWeightSnapshot(ctx, p.Id)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+	snapshotWeights := snapshot.WeightsByAddress()
+
 	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), p.Id)
 	if err != nil {
 		return group.TallyResult{}, err
@@ -40,6 +48,19 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 			return group.TallyResult{}, err
 		}
 
+		if hasSnapshot {
+			weight, ok := snapshotWeights[vote.Voter]
+			if !ok {
+				// The voter held no weight when the proposal was submitted.
+				continue
+			}
+
+			if err := tallyResult.Add(vote, weight); err != nil {
+				return group.TallyResult{}, errorsmod.Wrap(err, "add new vote")
+			}
+			continue
+		}
+
 		var member group.GroupMember
 		err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
 			GroupId: groupID,
@@ -4,9 +4,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/golang/mock/gomock"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 )
 
 func (s *TestSuite) TestTally() {
@@ -86,3 +89,59 @@ func (s *TestSuite) TestTally() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTallyTokenWeighted() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	voter1, voter2 := s.addrs[4], s.addrs[1]
+
+	// policies with an unknown weight provider are rejected
+	policyReq := &group.MsgCreateGroupPolicy{
+		Admin:   s.addrs[0].String(),
+		GroupId: s.groupID,
+	}
+	err := policyReq.SetDecisionPolicy(group.NewTokenWeightedDecisionPolicy("unknown", "token", "0.5", time.Hour, 0))
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.CreateGroupPolicy(sdkCtx, policyReq)
+	s.Require().ErrorContains(err, "weight provider unknown not registered")
+
+	err = policyReq.SetDecisionPolicy(group.NewTokenWeightedDecisionPolicy(keeper.BankWeightProvider, "token", "0.5", time.Hour, 0))
+	s.Require().NoError(err)
+	s.setNextAccount()
+	policyRes, err := s.groupKeeper.CreateGroupPolicy(sdkCtx, policyReq)
+	s.Require().NoError(err)
+
+	// balances are only read once, when the proposal is submitted
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), voter1, "token").Return(sdk.NewInt64Coin("token", 10)).Times(1)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), voter2, "token").Return(sdk.NewInt64Coin("token", 30)).Times(1)
+
+	res, err := s.groupKeeper.SubmitProposal(sdkCtx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyRes.Address,
+		Proposers:          []string{voter1.String()},
+	})
+	s.Require().NoError(err)
+	proposalID := res.ProposalId
+
+	_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{ProposalId: proposalID, Voter: voter1.String(), Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+
+	tally, err := s.groupKeeper.TallyResult(sdkCtx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("10", tally.Tally.YesCount)
+
+	_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{ProposalId: proposalID, Voter: voter2.String(), Option: group.VOTE_OPTION_NO})
+	s.Require().NoError(err)
+
+	// 10 yes out of a snapshotted total weight of 40 doesn't reach 50%
+	err = s.groupKeeper.TallyProposalsAtVPEnd(sdkCtx.WithBlockTime(s.blockTime.Add(2 * time.Hour)))
+	s.Require().NoError(err)
+
+	proposalRes, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, proposalRes.Proposal.Status)
+	s.Require().Equal(group.TallyResult{
+		YesCount:        "10",
+		NoCount:         "30",
+		AbstainCount:    "0",
+		NoWithVetoCount: "0",
+	}, proposalRes.Proposal.FinalTallyResult)
+}
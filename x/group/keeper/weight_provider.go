@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+)
+
+const (
+	// BankWeightProvider is the name of the weight provider using the members'
+	// bank balance of the policy denom.
+	BankWeightProvider = group.BankWeightProvider
+
+	// StakingWeightProvider is the name of the weight provider using the
+	// members' bonded tokens.
+	StakingWeightProvider = group.StakingWeightProvider
+)
+
+// WeightProvider returns the voting weight of a group member, used by
+// token weighted decision policies. Weights are snapshotted when a proposal
+// is submitted.
+type WeightProvider interface {
+	// GetWeight returns the voting weight of addr. denom is the denomination
+	// set in the decision policy, providers are free to ignore it.
+	GetWeight(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdkmath.Int, error)
+}
+
+// RegisterWeightProvider registers a weight provider under the given name,
+// making it usable by token weighted decision policies. It panics if a
+// provider is already registered under that name.
+func (k Keeper) RegisterWeightProvider(name string, provider WeightProvider) {
+	if _, ok := k.weightProviders[name]; ok {
+		panic(fmt.Sprintf("weight provider %s already registered", name))
+	}
+
+	k.weightProviders[name] = provider
+}
+
+// getWeightProvider returns the weight provider registered under name.
+func (k Keeper) getWeightProvider(name string) (WeightProvider, error) {
+	provider, ok := k.weightProviders[name]
+	if !ok {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "weight provider %s not registered", name)
+	}
+
+	return provider, nil
+}
+
+// validateWeightProvider checks that the weight provider of a token weighted
+// decision policy is registered. Other decision policies are ignored.
+func (k Keeper) validateWeightProvider(policy group.DecisionPolicy) error {
+	tokenPolicy, ok := policy.(*group.TokenWeightedDecisionPolicy)
+	if !ok {
+		return nil
+	}
+
+	_, err := k.getWeightProvider(tokenPolicy.WeightProvider)
+	return err
+}
+
+// snapshotProposalWeights stores the voting weights of all the group members
+// at the time of the proposal submission. It is a no-op for decision policies
+// which are not token weighted.
+func (k Keeper) snapshotProposalWeights(ctx sdk.Context, proposalID, groupID uint64, policy group.DecisionPolicy) error {
+	tokenPolicy, ok := policy.(*group.TokenWeightedDecisionPolicy)
+	if !ok {
+		return nil
+	}
+
+	provider, err := k.getWeightProvider(tokenPolicy.WeightProvider)
+	if err != nil {
+		return err
+	}
+
+	it, err := k.groupMemberByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	snapshot := group.ProposalWeightSnapshot{ProposalId: proposalID}
+	totalWeight := math.NewDecFromInt64(0)
+	for {
+		var member group.GroupMember
+		_, err = it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "snapshot member weight")
+
+		addr, err := k.accKeeper.StringToBytes(member.Member.Address)
+		if err != nil {
+			return errorsmod.Wrap(err, "member address")
+		}
+
+		weight, err := provider.GetWeight(ctx, addr, tokenPolicy.Denom)
+		if err != nil {
+			return errorsmod.Wrapf(err, "weight of member %s", member.Member.Address)
+		}
+		if !weight.IsPositive() {
+			continue
+		}
+
+		weightDec, err := math.NewPositiveDecFromString(weight.String())
+		if err != nil {
+			return err
+		}
+		if totalWeight, err = totalWeight.Add(weightDec); err != nil {
+			return err
+		}
+
+		snapshot.Weights = append(snapshot.Weights, group.MemberWeight{
+			Address: member.Member.Address,
+			Weight:  weightDec.String(),
+		})
+	}
+	snapshot.TotalWeight = totalWeight.String()
+
+	return k.proposalWeightSnapshotTable.Create(ctx.KVStore(k.key), &snapshot)
+}
+
+// getProposalWeightSnapshot returns the weight snapshot of a proposal, and
+// false if the proposal has no snapshot.
+func (k Keeper) getProposalWeightSnapshot(ctx sdk.Context, proposalID uint64) (group.ProposalWeightSnapshot, bool, error) {
+	var snapshot group.ProposalWeightSnapshot
+	err := k.proposalWeightSnapshotTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.ProposalWeightSnapshot{ProposalId: proposalID}), &snapshot)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return group.ProposalWeightSnapshot{}, false, nil
+	case err != nil:
+		return group.ProposalWeightSnapshot{}, false, err
+	}
+
+	return snapshot, true, nil
+}
+
+// pruneProposalWeightSnapshot deletes the weight snapshot of a proposal, if
+// any.
+func (k Keeper) pruneProposalWeightSnapshot(ctx sdk.Context, proposalID uint64) error {
+	snapshot := &group.ProposalWeightSnapshot{ProposalId: proposalID}
+	store := ctx.KVStore(k.key)
+	if !k.proposalWeightSnapshotTable.Has(store, orm.PrimaryKey(snapshot)) {
+		return nil
+	}
+
+	return k.proposalWeightSnapshotTable.Delete(store, snapshot)
+}
+
+type bankWeightProvider struct {
+	bk group.BankKeeper
+}
+
+// NewBankWeightProvider returns a WeightProvider using the members' bank
+// balance of the decision policy denom as voting weight.
+func NewBankWeightProvider(bk group.BankKeeper) WeightProvider {
+	return bankWeightProvider{bk: bk}
+}
+
+// GetWeight implements WeightProvider.
+func (p bankWeightProvider) GetWeight(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdkmath.Int, error) {
+	if denom == "" {
+		return sdkmath.Int{}, errorsmod.Wrap(errors.ErrEmpty, "bank weight provider requires a denom")
+	}
+
+	return p.bk.GetBalance(ctx, addr, denom).Amount, nil
+}
+
+type stakingWeightProvider struct {
+	sk group.StakingKeeper
+}
+
+// NewStakingWeightProvider returns a WeightProvider using the members' bonded
+// tokens as voting weight. The decision policy denom is ignored.
+func NewStakingWeightProvider(sk group.StakingKeeper) WeightProvider {
+	return stakingWeightProvider{sk: sk}
+}
+
+// GetWeight implements WeightProvider.
+func (p stakingWeightProvider) GetWeight(ctx sdk.Context, addr sdk.AccAddress, _ string) (sdkmath.Int, error) {
+	return p.sk.GetDelegatorBonded(ctx, addr), nil
+}
@@ -10,3 +10,13 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+const (
+	// BankWeightProvider is the name of the weight provider using the members'
+	// bank balance of the policy denom.
+	BankWeightProvider = "bank"
+
+	// StakingWeightProvider is the name of the weight provider using the
+	// members' bonded tokens.
+	StakingWeightProvider = "staking"
+)
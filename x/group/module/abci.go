@@ -5,12 +5,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 )
 
-// EndBlocker called at every block, updates proposal's `FinalTallyResult` and
-// prunes expired proposals.
+// EndBlocker called at every block, updates proposal's `FinalTallyResult`,
+// automatically executes accepted proposals and prunes expired proposals and
+// execution logs.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	if err := k.TallyProposalsAtVPEnd(ctx); err != nil {
 		return err
 	}
 
-	return k.PruneProposals(ctx)
+	if err := k.ExecuteAcceptedProposals(ctx); err != nil {
+		return err
+	}
+
+	if err := k.PruneProposals(ctx); err != nil {
+		return err
+	}
+
+	return k.PruneExecutionLogs(ctx)
 }
@@ -206,6 +206,7 @@ type GroupInputs struct {
 	Cdc              codec.Codec
 	AccountKeeper    group.AccountKeeper
 	BankKeeper       group.BankKeeper
+	StakingKeeper    group.StakingKeeper `optional:"true"`
 	Registry         cdctypes.InterfaceRegistry
 	MsgServiceRouter baseapp.MessageRouter
 }
@@ -225,6 +226,11 @@ func ProvideModule(in GroupInputs) GroupOutputs {
 	*/
 
 	k := keeper.NewKeeper(in.Key, in.Cdc, in.MsgServiceRouter, in.AccountKeeper, group.Config{MaxExecutionPeriod: in.Config.MaxExecutionPeriod.AsDuration(), MaxMetadataLen: in.Config.MaxMetadataLen})
+	k.RegisterWeightProvider(keeper.BankWeightProvider, keeper.NewBankWeightProvider(in.BankKeeper))
+	if in.StakingKeeper != nil {
+		k.RegisterWeightProvider(keeper.StakingWeightProvider, keeper.NewStakingWeightProvider(in.StakingKeeper))
+	}
+
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 	return GroupOutputs{GroupKeeper: k, Module: m}
 }
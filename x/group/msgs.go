@@ -157,6 +157,23 @@ func (m MsgCreateGroupWithPolicy) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{admin}
 }
 
+var (
+	_ sdk.Msg            = &MsgUpdateGroupPolicyAutoExecution{}
+	_ legacytx.LegacyMsg = &MsgUpdateGroupPolicyAutoExecution{}
+)
+
+// GetSignBytes Implements Msg.
+func (m MsgUpdateGroupPolicyAutoExecution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupPolicyAutoExecution.
+func (m MsgUpdateGroupPolicyAutoExecution) GetSigners() []sdk.AccAddress {
+	admin := sdk.MustAccAddressFromBech32(m.Admin)
+
+	return []sdk.AccAddress{admin}
+}
+
 var (
 	_ sdk.Msg            = &MsgCreateGroupPolicy{}
 	_ legacytx.LegacyMsg = &MsgCreateGroupPolicy{}
@@ -401,6 +418,26 @@ func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return tx.UnpackInterfaces(unpacker, m.Messages)
 }
 
+var (
+	_ sdk.Msg            = &MsgSubmitParentVoteProposal{}
+	_ legacytx.LegacyMsg = &MsgSubmitParentVoteProposal{}
+)
+
+// GetSignBytes Implements Msg.
+func (m MsgSubmitParentVoteProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitParentVoteProposal.
+func (m MsgSubmitParentVoteProposal) GetSigners() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(m.Proposers))
+	for i, proposer := range m.Proposers {
+		addrs[i] = sdk.MustAccAddressFromBech32(proposer)
+	}
+
+	return addrs
+}
+
 var (
 	_ sdk.Msg            = &MsgWithdrawProposal{}
 	_ legacytx.LegacyMsg = &MsgWithdrawProposal{}
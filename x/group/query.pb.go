@@ -1486,6 +1486,235 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryProposalExecutionLogsRequest is the Query/ProposalExecutionLogs request type.
+//
+// Since: cosmos-sdk 0.48
+type QueryProposalExecutionLogsRequest struct {
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalExecutionLogsRequest) Reset()         { *m = QueryProposalExecutionLogsRequest{} }
+func (m *QueryProposalExecutionLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecutionLogsRequest) ProtoMessage()    {}
+func (*QueryProposalExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecutionLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecutionLogsRequest.Merge(m, src)
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecutionLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecutionLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecutionLogsRequest proto.InternalMessageInfo
+
+func (m *QueryProposalExecutionLogsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryProposalExecutionLogsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalExecutionLogsResponse is the Query/ProposalExecutionLogs response type.
+//
+// Since: cosmos-sdk 0.48
+type QueryProposalExecutionLogsResponse struct {
+	// logs are the automatic execution logs of the proposal.
+	Logs []*ProposalExecutionLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalExecutionLogsResponse) Reset()         { *m = QueryProposalExecutionLogsResponse{} }
+func (m *QueryProposalExecutionLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecutionLogsResponse) ProtoMessage()    {}
+func (*QueryProposalExecutionLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecutionLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecutionLogsResponse.Merge(m, src)
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecutionLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecutionLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecutionLogsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalExecutionLogsResponse) GetLogs() []*ProposalExecutionLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *QueryProposalExecutionLogsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGroupHierarchyRequest is the Query/GroupHierarchy request type.
+//
+// Since: cosmos-sdk 0.48
+type QueryGroupHierarchyRequest struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// max_depth is the maximum depth to walk, in both directions. Defaults to,
+	// and is capped by, the module's maximum hierarchy depth.
+	MaxDepth uint32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+}
+
+func (m *QueryGroupHierarchyRequest) Reset()         { *m = QueryGroupHierarchyRequest{} }
+func (m *QueryGroupHierarchyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupHierarchyRequest) ProtoMessage()    {}
+func (*QueryGroupHierarchyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{30}
+}
+func (m *QueryGroupHierarchyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupHierarchyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupHierarchyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupHierarchyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupHierarchyRequest.Merge(m, src)
+}
+func (m *QueryGroupHierarchyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupHierarchyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupHierarchyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupHierarchyRequest proto.InternalMessageInfo
+
+func (m *QueryGroupHierarchyRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *QueryGroupHierarchyRequest) GetMaxDepth() uint32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+// QueryGroupHierarchyResponse is the Query/GroupHierarchy response type.
+//
+// Since: cosmos-sdk 0.48
+type QueryGroupHierarchyResponse struct {
+	// sub_groups are the edges below the group, in breadth-first order.
+	SubGroups []GroupHierarchyEdge `protobuf:"bytes,1,rep,name=sub_groups,json=subGroups,proto3" json:"sub_groups"`
+	// parent_groups are the edges above the group, in breadth-first order.
+	ParentGroups []GroupHierarchyEdge `protobuf:"bytes,2,rep,name=parent_groups,json=parentGroups,proto3" json:"parent_groups"`
+}
+
+func (m *QueryGroupHierarchyResponse) Reset()         { *m = QueryGroupHierarchyResponse{} }
+func (m *QueryGroupHierarchyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupHierarchyResponse) ProtoMessage()    {}
+func (*QueryGroupHierarchyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{31}
+}
+func (m *QueryGroupHierarchyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupHierarchyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupHierarchyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGroupHierarchyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupHierarchyResponse.Merge(m, src)
+}
+func (m *QueryGroupHierarchyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupHierarchyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupHierarchyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupHierarchyResponse proto.InternalMessageInfo
+
+func (m *QueryGroupHierarchyResponse) GetSubGroups() []GroupHierarchyEdge {
+	if m != nil {
+		return m.SubGroups
+	}
+	return nil
+}
+
+func (m *QueryGroupHierarchyResponse) GetParentGroups() []GroupHierarchyEdge {
+	if m != nil {
+		return m.ParentGroups
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1515,94 +1744,110 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryProposalExecutionLogsRequest)(nil), "cosmos.group.v1.QueryProposalExecutionLogsRequest")
+	proto.RegisterType((*QueryProposalExecutionLogsResponse)(nil), "cosmos.group.v1.QueryProposalExecutionLogsResponse")
+	proto.RegisterType((*QueryGroupHierarchyRequest)(nil), "cosmos.group.v1.QueryGroupHierarchyRequest")
+	proto.RegisterType((*QueryGroupHierarchyResponse)(nil), "cosmos.group.v1.QueryGroupHierarchyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x46, 0x9d, 0xa6, 0x6d, 0xb2, 0x8d, 0x9c, 0xb0, 0x2d,
-	0xf9, 0x9d, 0xdd, 0xd8, 0x49, 0x53, 0x04, 0x14, 0x54, 0x4b, 0x10, 0x72, 0x28, 0x4a, 0x4d, 0x85,
-	0x04, 0x42, 0x8a, 0xd6, 0xf1, 0xc6, 0xac, 0xb0, 0x77, 0x5c, 0xef, 0x26, 0xc2, 0x8a, 0x7c, 0x41,
-	0x82, 0x03, 0xe2, 0x00, 0x2d, 0x42, 0x25, 0xe2, 0xd0, 0x03, 0x12, 0x3d, 0x72, 0x00, 0x21, 0x71,
-	0xeb, 0xad, 0xc7, 0x0a, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x23, 0xb8, 0xa0, 0x9d, 0x79, 0x6b,
-	0xef, 0x6f, 0x6f, 0x84, 0x45, 0x73, 0x69, 0xeb, 0x9d, 0x37, 0xf3, 0x3e, 0xf3, 0x7d, 0x6f, 0x67,
-	0xbf, 0x53, 0xb8, 0xbc, 0xcd, 0xac, 0x2a, 0xb3, 0xd4, 0x72, 0x9d, 0xed, 0xd6, 0xd4, 0xbd, 0xac,
-	0x7a, 0x77, 0x57, 0xaf, 0x37, 0x94, 0x5a, 0x9d, 0xd9, 0x8c, 0x8e, 0x88, 0x41, 0x85, 0x0f, 0x2a,
-	0x7b, 0x59, 0x69, 0xb4, 0xcc, 0xca, 0x8c, 0x8f, 0xa9, 0xce, 0xbf, 0x44, 0x98, 0x34, 0x51, 0x66,
-	0xac, 0x5c, 0xd1, 0x55, 0xad, 0x66, 0xa8, 0x9a, 0x69, 0x32, 0x5b, 0xb3, 0x0d, 0x66, 0x5a, 0x38,
-	0x1a, 0xca, 0x60, 0x37, 0x6a, 0xba, 0x3b, 0x38, 0x8f, 0x83, 0x45, 0xcd, 0xd2, 0x45, 0x6a, 0x75,
-	0x2f, 0x5b, 0xd4, 0x6d, 0x2d, 0xab, 0xd6, 0xb4, 0xb2, 0x61, 0xf2, 0x95, 0x30, 0x76, 0x5c, 0xc4,
-	0x6e, 0x89, 0xfc, 0x88, 0x26, 0x86, 0xce, 0x69, 0x55, 0xc3, 0x64, 0x2a, 0xff, 0x53, 0x3c, 0x92,
-	0x73, 0x70, 0xe1, 0xb6, 0xb3, 0xde, 0xba, 0x93, 0x76, 0xc3, 0xdc, 0x61, 0x05, 0xfd, 0xee, 0xae,
-	0x6e, 0xd9, 0x74, 0x1c, 0x06, 0x39, 0xca, 0x96, 0x51, 0x1a, 0x23, 0x53, 0x64, 0xb6, 0xb7, 0x30,
-	0xc0, 0x7f, 0x6f, 0x94, 0xe4, 0x37, 0xe1, 0x62, 0x70, 0x8e, 0x55, 0x63, 0xa6, 0xa5, 0x53, 0x05,
-	0x7a, 0x0d, 0x73, 0x87, 0xf1, 0x09, 0xc3, 0x39, 0x49, 0x09, 0x08, 0xa3, 0xb4, 0x67, 0xf0, 0x38,
-	0xf9, 0x36, 0x5c, 0x6e, 0xaf, 0xb4, 0xc9, 0x2a, 0xc6, 0x76, 0xc3, 0xcb, 0x90, 0x83, 0x01, 0xad,
-	0x54, 0xaa, 0xeb, 0x96, 0xc5, 0x57, 0x1c, 0xca, 0x8f, 0xfd, 0xfa, 0xe3, 0xd2, 0x28, 0x2e, 0x7a,
-	0x53, 0x8c, 0xbc, 0x6d, 0xd7, 0x0d, 0xb3, 0x5c, 0x70, 0x03, 0xe5, 0x3b, 0x30, 0x11, 0xbd, 0x24,
-	0x22, 0xae, 0xfa, 0x10, 0xa7, 0xa2, 0x11, 0x3d, 0xf3, 0x04, 0x68, 0x13, 0xc6, 0xda, 0xab, 0xde,
-	0xd2, 0xab, 0x45, 0xbd, 0x6e, 0x75, 0x56, 0x8a, 0xbe, 0x01, 0xd0, 0xae, 0xcf, 0xd8, 0x29, 0x9e,
-	0x72, 0xda, 0x4d, 0xe9, 0x14, 0x53, 0x11, 0x7d, 0x84, 0xc5, 0x54, 0x36, 0xb5, 0xb2, 0x8e, 0xcb,
-	0x16, 0x3c, 0x33, 0xe5, 0x6f, 0x09, 0x8c, 0x47, 0xe4, 0xc7, 0x2d, 0xad, 0xc1, 0x40, 0x55, 0x3c,
-	0x1a, 0x23, 0x53, 0xcf, 0xcd, 0x0e, 0xe7, 0x26, 0xa2, 0x77, 0x25, 0xe6, 0x15, 0xdc, 0x60, 0xba,
-	0x1e, 0x41, 0x37, 0xd3, 0x91, 0x4e, 0x24, 0xf5, 0xe1, 0xdd, 0xf7, 0xe1, 0x59, 0xf9, 0xc6, 0xcd,
-	0x52, 0xd5, 0x30, 0x5d, 0x7d, 0x14, 0xe8, 0xd3, 0x9c, 0xdf, 0x1d, 0x6b, 0x28, 0xc2, 0xba, 0x26,
-	0xda, 0x37, 0x04, 0xa4, 0x28, 0x2a, 0x54, 0x2d, 0x07, 0xfd, 0x5c, 0x1e, 0x57, 0xb4, 0xa4, 0x6e,
-	0xc5, 0xc8, 0xee, 0x29, 0xf6, 0x09, 0x81, 0xa9, 0x40, 0x9b, 0x1a, 0xba, 0x95, 0x17, 0x3f, 0xff,
-	0xc7, 0xc6, 0xfa, 0x89, 0xc0, 0xf3, 0x09, 0x1c, 0x28, 0xd5, 0x3a, 0x9c, 0x15, 0x20, 0x35, 0x0c,
-	0x40, 0xc9, 0x3a, 0xbf, 0x3d, 0x67, 0xca, 0xde, 0x75, 0xbb, 0xa7, 0xdf, 0x41, 0x8c, 0x7e, 0x27,
-	0xa2, 0xf1, 0xe2, 0x44, 0xf5, 0xf7, 0xdf, 0xc9, 0x13, 0xf5, 0x3a, 0x8c, 0x72, 0xec, 0xcd, 0x3a,
-	0xab, 0x31, 0x4b, 0xab, 0xb8, 0x3a, 0x4e, 0xc2, 0x70, 0x0d, 0x1f, 0xb5, 0x5b, 0x11, 0xdc, 0x47,
-	0x1b, 0x25, 0xf9, 0x2d, 0xfc, 0x88, 0xb4, 0x27, 0xe2, 0x1e, 0xaf, 0xc1, 0xa0, 0x1b, 0x86, 0x07,
-	0xee, 0x78, 0x68, 0x77, 0xad, 0x49, 0xad, 0x50, 0xf9, 0x21, 0x01, 0xd9, 0xb7, 0xa0, 0xdb, 0x91,
-	0x42, 0x84, 0xff, 0xf0, 0x79, 0xe8, 0x5a, 0x8d, 0xbf, 0x27, 0x70, 0x25, 0x11, 0x11, 0x15, 0xb8,
-	0x0e, 0x43, 0xee, 0xb6, 0xdc, 0x02, 0x27, 0x48, 0xd0, 0x8e, 0xed, 0x5e, 0x55, 0xeb, 0x30, 0xc9,
-	0x41, 0xdf, 0x61, 0xb6, 0x9e, 0x6f, 0xe1, 0x3a, 0xbf, 0xea, 0x69, 0x0b, 0xec, 0xbc, 0x49, 0x7b,
-	0xce, 0x04, 0xce, 0x91, 0xf8, 0x26, 0xf1, 0x30, 0xf9, 0x16, 0xbe, 0x9d, 0x91, 0x39, 0x51, 0x99,
-	0x39, 0xe8, 0x75, 0x82, 0xb1, 0x2f, 0x2e, 0x84, 0x44, 0x71, 0xa2, 0x0b, 0x3c, 0x44, 0xfe, 0x94,
-	0xa0, 0x4f, 0x70, 0x9e, 0x59, 0xf9, 0x63, 0x37, 0x68, 0xd7, 0xaa, 0xfe, 0x15, 0x41, 0x77, 0x11,
-	0x02, 0xc1, 0x4d, 0x2d, 0x08, 0xa1, 0xdc, 0x52, 0xc7, 0xec, 0x4a, 0xc4, 0x74, 0xaf, 0xc4, 0xf7,
-	0x08, 0xda, 0x13, 0xc4, 0xf2, 0x15, 0xb7, 0x55, 0x3b, 0x92, 0xaa, 0x76, 0x5d, 0xd3, 0xea, 0x4b,
-	0xd7, 0x14, 0xf8, 0xa1, 0x9e, 0xa9, 0x50, 0x0f, 0x82, 0x96, 0x00, 0x2d, 0xd1, 0x09, 0x38, 0x50,
-	0x0e, 0x88, 0xd7, 0x0b, 0x7b, 0xd0, 0x4e, 0x82, 0x5d, 0x79, 0x09, 0x2e, 0x71, 0xb6, 0x3b, 0x5a,
-	0xa5, 0xe2, 0x9c, 0x6d, 0xbb, 0x15, 0x3b, 0xf5, 0xc7, 0xe1, 0x5d, 0xec, 0x4d, 0xdf, 0x5c, 0xdc,
-	0xd4, 0x0d, 0xe8, 0xb3, 0x9d, 0xc7, 0x78, 0x08, 0x84, 0x7d, 0xab, 0x67, 0x52, 0x7e, 0xe8, 0xc9,
-	0x1f, 0x93, 0x3d, 0x8f, 0xfe, 0xfe, 0x61, 0x9e, 0x14, 0xc4, 0x2c, 0xf9, 0x7d, 0xa0, 0x1e, 0xc9,
-	0x5c, 0xa2, 0x6e, 0x55, 0xe4, 0x1e, 0x81, 0xf3, 0xbe, 0xe5, 0x4f, 0x40, 0x25, 0x72, 0xff, 0x9c,
-	0x83, 0x3e, 0x0e, 0x45, 0x3f, 0x27, 0x30, 0xd4, 0x4a, 0x44, 0xa7, 0x43, 0x10, 0x91, 0xd7, 0x3a,
-	0x69, 0xa6, 0x63, 0x9c, 0x48, 0x2a, 0x2b, 0x1f, 0xff, 0xf6, 0xd7, 0xfd, 0x53, 0xb3, 0x74, 0x5a,
-	0x0d, 0x5e, 0x4c, 0xd1, 0x93, 0x9a, 0x3b, 0x4c, 0xdd, 0x77, 0xfd, 0x69, 0x93, 0x7e, 0x47, 0x60,
-	0x24, 0x60, 0x54, 0xe8, 0x62, 0x42, 0xb2, 0xd0, 0x6d, 0x4f, 0x5a, 0x4a, 0x19, 0x8d, 0x80, 0xab,
-	0x1c, 0x50, 0xa1, 0x8b, 0x31, 0x80, 0xdc, 0x56, 0x35, 0x90, 0x13, 0xdf, 0xd6, 0x26, 0x7d, 0x40,
-	0xe0, 0xb4, 0xf7, 0x12, 0x45, 0xe7, 0x12, 0xb2, 0xfa, 0x2f, 0x7a, 0xd2, 0x7c, 0x9a, 0x50, 0xa4,
-	0xcb, 0x72, 0xba, 0x05, 0x3a, 0x17, 0x43, 0x87, 0x77, 0x30, 0xaf, 0x82, 0x07, 0x04, 0xce, 0xf8,
-	0xae, 0x2a, 0x34, 0x29, 0x61, 0xc0, 0xec, 0x4a, 0x0b, 0xa9, 0x62, 0x91, 0x6e, 0x99, 0xd3, 0xcd,
-	0xd3, 0xd9, 0x68, 0x3a, 0x6b, 0xab, 0xd8, 0xd8, 0xe2, 0x9e, 0xd8, 0x51, 0xae, 0x6a, 0x98, 0x4d,
-	0xfa, 0x0b, 0x81, 0xd1, 0xa8, 0x3b, 0x02, 0xcd, 0x76, 0xaa, 0x5a, 0xe8, 0x5e, 0x23, 0xe5, 0x8e,
-	0x33, 0x05, 0x89, 0x5f, 0xe6, 0xc4, 0xd7, 0xe8, 0x4a, 0x52, 0xb5, 0x0d, 0x9d, 0x93, 0x8b, 0x21,
-	0x8f, 0xb2, 0x3f, 0x87, 0xe1, 0x85, 0xc0, 0xe9, 0xe0, 0x7d, 0x3a, 0xe7, 0x8e, 0x33, 0x05, 0xe1,
-	0x5f, 0xe4, 0xf0, 0x39, 0xba, 0x9c, 0x02, 0xde, 0x2f, 0xfb, 0x67, 0x04, 0x06, 0x5d, 0x93, 0x41,
-	0x5f, 0x88, 0x4e, 0x1d, 0x70, 0x43, 0xd2, 0x74, 0xa7, 0x30, 0xa4, 0x52, 0x39, 0xd5, 0x1c, 0x9d,
-	0x09, 0x51, 0xb9, 0xa7, 0xb7, 0xba, 0xef, 0x39, 0xda, 0x9b, 0xf4, 0x31, 0x81, 0x8b, 0xd1, 0x76,
-	0x97, 0xae, 0x24, 0xe7, 0x8c, 0xf4, 0xef, 0xd2, 0xea, 0xf1, 0x26, 0x21, 0xf6, 0x2b, 0x1c, 0x7b,
-	0x8d, 0xae, 0xc6, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x98, 0xc0, 0xf9, 0x08,
-	0x57, 0x4a, 0x97, 0xa3, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0x3d, 0xc6, 0x0c, 0x44, 0x7f, 0x9d, 0xa3,
-	0xbf, 0x46, 0x6f, 0x84, 0xd0, 0x1d, 0x9f, 0xe3, 0x50, 0xb7, 0xf4, 0xe6, 0x5e, 0xcc, 0xaf, 0xbf,
-	0xba, 0xcf, 0x1f, 0x36, 0xe9, 0x23, 0x02, 0x23, 0x01, 0x03, 0x1a, 0x77, 0xd4, 0x46, 0x1b, 0xe6,
-	0xb8, 0xa3, 0x36, 0xc6, 0xd5, 0x26, 0xf4, 0x2f, 0xf7, 0x67, 0x5e, 0xf0, 0x40, 0xcb, 0x7c, 0x4d,
-	0xe0, 0xb4, 0xd7, 0xff, 0xc5, 0x1d, 0xb7, 0x11, 0xc6, 0x35, 0xee, 0xb8, 0x8d, 0xb2, 0x93, 0x09,
-	0xbd, 0xdc, 0x22, 0x44, 0x45, 0x51, 0xc3, 0x87, 0x04, 0xce, 0xfa, 0x9d, 0x16, 0xed, 0x70, 0x82,
-	0xfa, 0xac, 0xa2, 0xb4, 0x98, 0x2e, 0x18, 0xf1, 0x56, 0x38, 0xde, 0x12, 0x5d, 0x48, 0x38, 0x6f,
-	0xc5, 0x17, 0xc1, 0xd3, 0xaa, 0x07, 0x04, 0x86, 0x3d, 0xfe, 0x87, 0xce, 0x46, 0xa7, 0x0c, 0x7b,
-	0x32, 0x69, 0x2e, 0x45, 0x24, 0x92, 0xad, 0x71, 0xb2, 0x65, 0xaa, 0xc4, 0xbf, 0x4d, 0x81, 0x2e,
-	0xe4, 0xd6, 0x8b, 0xda, 0xd0, 0x2f, 0xf6, 0x4a, 0xaf, 0x24, 0x29, 0xe1, 0x12, 0x5d, 0x4d, 0x0e,
-	0x42, 0x98, 0x49, 0x0e, 0x33, 0x4e, 0x2f, 0xc5, 0xc8, 0x94, 0x7f, 0xf5, 0xc9, 0x61, 0x86, 0x3c,
-	0x3d, 0xcc, 0x90, 0x3f, 0x0f, 0x33, 0xe4, 0x8b, 0xa3, 0x4c, 0xcf, 0xd3, 0xa3, 0x4c, 0xcf, 0xef,
-	0x47, 0x99, 0x9e, 0xf7, 0xae, 0x96, 0x0d, 0xfb, 0x83, 0xdd, 0xa2, 0xb2, 0xcd, 0xaa, 0xee, 0x64,
-	0xf1, 0xd7, 0x92, 0x55, 0xfa, 0x50, 0xfd, 0x48, 0x2c, 0x50, 0xec, 0xe7, 0xff, 0xe9, 0xbd, 0xf2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0xb3, 0x86, 0xf8, 0xcf, 0x17, 0x00, 0x00,
+	// 1500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xdf, 0x6f, 0x14, 0x55,
+	0x14, 0xc7, 0x7b, 0x6b, 0x81, 0xf6, 0x94, 0x42, 0xbc, 0xb4, 0xb0, 0x9d, 0x36, 0x6d, 0x5d, 0xa0,
+	0xf4, 0xe7, 0x4e, 0x77, 0x5b, 0x40, 0x54, 0x24, 0x6c, 0xc4, 0x42, 0x22, 0x06, 0x16, 0x62, 0xa2,
+	0x31, 0xd9, 0xcc, 0x76, 0x87, 0xe9, 0xc4, 0xdd, 0x99, 0x65, 0x66, 0xb6, 0xe9, 0x86, 0xf4, 0xc5,
+	0x44, 0x1f, 0x0c, 0x0f, 0x0a, 0xc6, 0x60, 0xe3, 0x03, 0x26, 0x26, 0xf2, 0xe8, 0x83, 0xc6, 0xc4,
+	0x37, 0xde, 0x78, 0x24, 0xfa, 0xe2, 0x93, 0x31, 0xad, 0x89, 0xff, 0x86, 0x99, 0x7b, 0xcf, 0xdd,
+	0x9d, 0x99, 0x9d, 0x99, 0x9d, 0xea, 0x06, 0xfb, 0x02, 0xcc, 0xdc, 0x73, 0xce, 0xfd, 0xdc, 0xef,
+	0x39, 0x73, 0xef, 0xb9, 0x0b, 0x8c, 0xad, 0x99, 0x76, 0xd5, 0xb4, 0x65, 0xcd, 0x32, 0xeb, 0x35,
+	0x79, 0x23, 0x2b, 0xdf, 0xad, 0xab, 0x56, 0x23, 0x53, 0xb3, 0x4c, 0xc7, 0xa4, 0x47, 0xf9, 0x60,
+	0x86, 0x0d, 0x66, 0x36, 0xb2, 0xd2, 0xb0, 0x66, 0x6a, 0x26, 0x1b, 0x93, 0xdd, 0x7f, 0x71, 0x33,
+	0x69, 0x5c, 0x33, 0x4d, 0xad, 0xa2, 0xca, 0x4a, 0x4d, 0x97, 0x15, 0xc3, 0x30, 0x1d, 0xc5, 0xd1,
+	0x4d, 0xc3, 0xc6, 0xd1, 0xb6, 0x19, 0x9c, 0x46, 0x4d, 0x15, 0x83, 0x73, 0x38, 0x58, 0x52, 0x6c,
+	0x95, 0x4f, 0x2d, 0x6f, 0x64, 0x4b, 0xaa, 0xa3, 0x64, 0xe5, 0x9a, 0xa2, 0xe9, 0x06, 0x8b, 0x84,
+	0xb6, 0xa3, 0xdc, 0xb6, 0xc8, 0xe7, 0x47, 0x34, 0x3e, 0xf4, 0xb2, 0x52, 0xd5, 0x0d, 0x53, 0x66,
+	0x7f, 0xf2, 0x57, 0xe9, 0x1c, 0x8c, 0xdc, 0x74, 0xe3, 0xad, 0xba, 0xd3, 0x5e, 0x33, 0xee, 0x98,
+	0x05, 0xf5, 0x6e, 0x5d, 0xb5, 0x1d, 0x3a, 0x0a, 0xfd, 0x0c, 0xa5, 0xa8, 0x97, 0x53, 0x64, 0x8a,
+	0xcc, 0xf4, 0x15, 0x0e, 0xb1, 0xe7, 0x6b, 0xe5, 0xf4, 0x55, 0x38, 0x1e, 0xf4, 0xb1, 0x6b, 0xa6,
+	0x61, 0xab, 0x34, 0x03, 0x7d, 0xba, 0x71, 0xc7, 0x64, 0x0e, 0x83, 0x39, 0x29, 0x13, 0x10, 0x26,
+	0xd3, 0xf2, 0x60, 0x76, 0xe9, 0x9b, 0x30, 0xd6, 0x8a, 0x74, 0xc3, 0xac, 0xe8, 0x6b, 0x0d, 0x2f,
+	0x43, 0x0e, 0x0e, 0x29, 0xe5, 0xb2, 0xa5, 0xda, 0x36, 0x8b, 0x38, 0x90, 0x4f, 0xfd, 0xfa, 0xe3,
+	0xe2, 0x30, 0x06, 0xbd, 0xcc, 0x47, 0x6e, 0x39, 0x96, 0x6e, 0x68, 0x05, 0x61, 0x98, 0xbe, 0x0d,
+	0xe3, 0xe1, 0x21, 0x11, 0x71, 0xc5, 0x87, 0x38, 0x15, 0x8e, 0xe8, 0xf1, 0xe3, 0xa0, 0x5b, 0x90,
+	0x6a, 0x45, 0xbd, 0xae, 0x56, 0x4b, 0xaa, 0x65, 0x77, 0x56, 0x8a, 0xbe, 0x0d, 0xd0, 0xca, 0x4f,
+	0xaa, 0x97, 0x4d, 0x39, 0x2d, 0xa6, 0x74, 0x93, 0x99, 0xe1, 0x75, 0x84, 0xc9, 0xcc, 0xdc, 0x50,
+	0x34, 0x15, 0xc3, 0x16, 0x3c, 0x9e, 0xe9, 0x6f, 0x08, 0x8c, 0x86, 0xcc, 0x8f, 0x4b, 0x3a, 0x07,
+	0x87, 0xaa, 0xfc, 0x55, 0x8a, 0x4c, 0xbd, 0x34, 0x33, 0x98, 0x1b, 0x0f, 0x5f, 0x15, 0xf7, 0x2b,
+	0x08, 0x63, 0xba, 0x1a, 0x42, 0x77, 0xa6, 0x23, 0x1d, 0x9f, 0xd4, 0x87, 0xf7, 0xd0, 0x87, 0x67,
+	0xe7, 0x1b, 0x97, 0xcb, 0x55, 0xdd, 0x10, 0xfa, 0x64, 0xe0, 0x80, 0xe2, 0x3e, 0x77, 0xcc, 0x21,
+	0x37, 0xeb, 0x9a, 0x68, 0x5f, 0x13, 0x90, 0xc2, 0xa8, 0x50, 0xb5, 0x1c, 0x1c, 0x64, 0xf2, 0x08,
+	0xd1, 0xe2, 0xaa, 0x15, 0x2d, 0xbb, 0xa7, 0xd8, 0x27, 0x04, 0xa6, 0x02, 0x65, 0xaa, 0xab, 0x76,
+	0x9e, 0x3f, 0xbe, 0xc0, 0xc2, 0xfa, 0x89, 0xc0, 0x2b, 0x31, 0x1c, 0x28, 0xd5, 0x2a, 0x1c, 0xe1,
+	0x20, 0x35, 0x34, 0x40, 0xc9, 0x3a, 0x7f, 0x3d, 0x43, 0x9a, 0x37, 0x6e, 0xf7, 0xf4, 0xdb, 0x8e,
+	0xd0, 0x6f, 0x5f, 0x14, 0x5e, 0x94, 0xa8, 0xfe, 0xfa, 0xdb, 0x7f, 0xa2, 0x9e, 0x87, 0x61, 0x86,
+	0x7d, 0xc3, 0x32, 0x6b, 0xa6, 0xad, 0x54, 0x84, 0x8e, 0x93, 0x30, 0x58, 0xc3, 0x57, 0xad, 0x52,
+	0x04, 0xf1, 0xea, 0x5a, 0x39, 0xfd, 0x2e, 0x8c, 0x04, 0x1c, 0x71, 0x8d, 0x67, 0xa1, 0x5f, 0x98,
+	0xe1, 0x86, 0x3b, 0xda, 0xb6, 0xba, 0xa6, 0x53, 0xd3, 0x34, 0xfd, 0x98, 0x40, 0xda, 0x17, 0x50,
+	0x54, 0x24, 0x17, 0xe1, 0x3f, 0x1c, 0x0f, 0x5d, 0xcb, 0xf1, 0xf7, 0x04, 0x4e, 0xc6, 0x22, 0xa2,
+	0x02, 0xe7, 0x61, 0x40, 0x2c, 0x4b, 0x24, 0x38, 0x46, 0x82, 0x96, 0x6d, 0xf7, 0xb2, 0x6a, 0xc1,
+	0x24, 0x03, 0x7d, 0xcf, 0x74, 0xd4, 0x7c, 0x13, 0xd7, 0x7d, 0xb2, 0x92, 0x26, 0xd8, 0xfd, 0x92,
+	0x36, 0x5c, 0x87, 0x54, 0x6f, 0x07, 0x9d, 0xb9, 0x59, 0xfa, 0x3a, 0x7e, 0x9d, 0xa1, 0x73, 0xa2,
+	0x32, 0xb3, 0xd0, 0xe7, 0x1a, 0x63, 0x5d, 0x8c, 0xb4, 0x89, 0xe2, 0x5a, 0x17, 0x98, 0x49, 0xfa,
+	0x53, 0x82, 0x7d, 0x82, 0xfb, 0xce, 0xce, 0xef, 0xb9, 0x40, 0xbb, 0x96, 0xf5, 0x2f, 0x09, 0x8c,
+	0x87, 0x83, 0xe0, 0xa2, 0xe6, 0xb9, 0x50, 0x22, 0xd5, 0x11, 0xab, 0xe2, 0x36, 0xdd, 0x4b, 0xf1,
+	0x03, 0x82, 0xed, 0x09, 0x62, 0xf9, 0x92, 0xdb, 0xcc, 0x1d, 0x49, 0x94, 0xbb, 0xae, 0x69, 0xf5,
+	0x85, 0x68, 0x0a, 0xfc, 0x50, 0xff, 0xab, 0x50, 0x8f, 0x82, 0x2d, 0x01, 0xb6, 0x44, 0xfb, 0x60,
+	0x43, 0xd9, 0x26, 0x30, 0x16, 0x8a, 0xb6, 0x1f, 0xda, 0x95, 0xd7, 0xe0, 0x04, 0x63, 0xbb, 0xad,
+	0x54, 0x2a, 0xee, 0xde, 0x56, 0xaf, 0x38, 0x89, 0x0f, 0x87, 0xf7, 0x21, 0xd5, 0xee, 0x8b, 0x8b,
+	0xba, 0x08, 0x07, 0x1c, 0xf7, 0x35, 0x6e, 0x02, 0xed, 0x7d, 0xab, 0xc7, 0x29, 0x3f, 0xf0, 0xec,
+	0x8f, 0xc9, 0x9e, 0x27, 0x7f, 0xff, 0x30, 0x47, 0x0a, 0xdc, 0x2b, 0xfd, 0x21, 0x50, 0x8f, 0x64,
+	0x82, 0xa8, 0x5b, 0x19, 0x79, 0x40, 0xe0, 0x98, 0x2f, 0xfc, 0x7e, 0xc8, 0xc4, 0x7d, 0xd1, 0x5b,
+	0x88, 0xad, 0xe7, 0xca, 0xa6, 0xba, 0x56, 0x77, 0x87, 0xde, 0x31, 0x35, 0xfb, 0x85, 0x6f, 0x88,
+	0x4f, 0x82, 0x27, 0x75, 0x00, 0x07, 0x25, 0xbb, 0x00, 0x7d, 0x15, 0x53, 0x13, 0x82, 0x9d, 0x8e,
+	0x3c, 0x00, 0xbd, 0xde, 0x05, 0xe6, 0xd2, 0x3d, 0xe5, 0x6e, 0x7b, 0x3f, 0xfd, 0xab, 0xba, 0x6a,
+	0x29, 0xd6, 0xda, 0x7a, 0x23, 0x41, 0xaf, 0x3d, 0x06, 0x03, 0x55, 0x65, 0xb3, 0x58, 0x56, 0x6b,
+	0xce, 0x3a, 0x03, 0x18, 0x2a, 0xf4, 0x57, 0x95, 0xcd, 0xb7, 0xdc, 0xe7, 0xf4, 0x53, 0xdf, 0x67,
+	0xeb, 0x09, 0x8b, 0x2b, 0xbf, 0x0e, 0x60, 0xd7, 0x4b, 0x45, 0x5f, 0xc1, 0x9c, 0x0c, 0x2f, 0x98,
+	0xa6, 0xf3, 0x95, 0xb2, 0xa6, 0x7a, 0xab, 0x7d, 0xc0, 0xae, 0x97, 0x56, 0x79, 0x1d, 0xdd, 0x82,
+	0xa1, 0x9a, 0x62, 0xa9, 0x86, 0x23, 0x22, 0xf6, 0xfe, 0xab, 0x88, 0x87, 0x79, 0x10, 0x1e, 0x34,
+	0xb7, 0x3b, 0x0c, 0x07, 0xd8, 0x1a, 0xe8, 0x7d, 0x02, 0x03, 0xcd, 0xe2, 0xa5, 0xd3, 0x6d, 0x51,
+	0x43, 0x7f, 0x2a, 0x90, 0xce, 0x74, 0xb4, 0xe3, 0x62, 0xa4, 0x33, 0x1f, 0xff, 0xf6, 0xd7, 0xc3,
+	0xde, 0x19, 0x3a, 0x2d, 0x07, 0x7f, 0xec, 0x40, 0xed, 0x8d, 0x3b, 0xa6, 0x7c, 0x4f, 0xe4, 0x61,
+	0x8b, 0x7e, 0x47, 0xe0, 0x68, 0xa0, 0xf9, 0xa5, 0x0b, 0x31, 0x93, 0xb5, 0xfd, 0x82, 0x20, 0x2d,
+	0x26, 0xb4, 0x46, 0xc0, 0x15, 0x06, 0x98, 0xa1, 0x0b, 0x11, 0x80, 0xac, 0x55, 0x6f, 0x20, 0x27,
+	0x9e, 0x00, 0x5b, 0xf4, 0x11, 0x81, 0xc3, 0xde, 0x8b, 0x39, 0x9d, 0x8d, 0x99, 0xd5, 0xff, 0xe3,
+	0x81, 0x34, 0x97, 0xc4, 0x14, 0xe9, 0xb2, 0x8c, 0x6e, 0x9e, 0xce, 0x46, 0xd0, 0xe1, 0xbd, 0xde,
+	0xab, 0xe0, 0x36, 0x81, 0x21, 0xdf, 0xf5, 0x97, 0xc6, 0x4d, 0x18, 0xb8, 0x40, 0x49, 0xf3, 0x89,
+	0x6c, 0x91, 0x6e, 0x89, 0xd1, 0xcd, 0xd1, 0x99, 0x70, 0x3a, 0xbb, 0x58, 0x6a, 0x14, 0xd9, 0x3d,
+	0xcb, 0x55, 0xae, 0xaa, 0x1b, 0x5b, 0xf4, 0x17, 0x02, 0xc3, 0x61, 0xf7, 0x4e, 0x9a, 0xed, 0x94,
+	0xb5, 0xb6, 0xbb, 0xb2, 0x94, 0xdb, 0x8b, 0x0b, 0x12, 0xbf, 0xce, 0x88, 0xcf, 0xd2, 0xe5, 0xb8,
+	0x6c, 0xeb, 0x2a, 0x23, 0xe7, 0x43, 0x1e, 0x65, 0x7f, 0x6e, 0x87, 0xe7, 0x02, 0x27, 0x83, 0xf7,
+	0xe9, 0x9c, 0xdb, 0x8b, 0x0b, 0xc2, 0xbf, 0xca, 0xe0, 0x73, 0x74, 0x29, 0x01, 0xbc, 0x5f, 0xf6,
+	0xcf, 0x08, 0xf4, 0x8b, 0x0d, 0x97, 0x9e, 0x0e, 0x9f, 0x3a, 0xd0, 0x61, 0x4b, 0xd3, 0x9d, 0xcc,
+	0x90, 0x4a, 0x66, 0x54, 0xb3, 0xf4, 0x4c, 0x1b, 0x95, 0x38, 0x7c, 0xe4, 0x7b, 0x9e, 0x93, 0x69,
+	0x8b, 0x3e, 0x25, 0x70, 0x3c, 0xfc, 0x0a, 0x45, 0x97, 0xe3, 0xe7, 0x0c, 0xbd, 0x13, 0x4a, 0x2b,
+	0x7b, 0x73, 0x42, 0xec, 0x37, 0x18, 0xf6, 0x39, 0xba, 0x12, 0x89, 0xdd, 0x2a, 0x02, 0xdc, 0x04,
+	0x3c, 0xdf, 0xff, 0x53, 0x02, 0xc7, 0x42, 0x6e, 0x3a, 0x74, 0x29, 0x9c, 0x25, 0xfa, 0x22, 0x26,
+	0x65, 0xf7, 0xe0, 0x81, 0xe8, 0x57, 0x18, 0xfa, 0x25, 0x7a, 0xb1, 0x0d, 0xdd, 0xed, 0x9d, 0x5d,
+	0xea, 0xa6, 0xde, 0xee, 0x0b, 0xcb, 0xaf, 0xbf, 0x7c, 0x8f, 0xbd, 0xdc, 0xa2, 0x4f, 0x08, 0x1c,
+	0x0d, 0x5c, 0x6a, 0xa2, 0xb6, 0xda, 0xf0, 0x4b, 0x98, 0xb4, 0x98, 0xd0, 0xba, 0x63, 0xfd, 0xba,
+	0x44, 0xb6, 0x17, 0x3c, 0x50, 0x32, 0x5f, 0x11, 0x38, 0xec, 0xbd, 0x53, 0x44, 0x6d, 0xb7, 0x21,
+	0x97, 0xa1, 0xa8, 0xed, 0x36, 0xec, 0x8a, 0x12, 0x53, 0xcb, 0x4d, 0x42, 0x54, 0x14, 0x35, 0x7c,
+	0x4c, 0xe0, 0x88, 0xbf, 0x7b, 0xa7, 0x1d, 0x76, 0x50, 0xdf, 0xf5, 0x43, 0x5a, 0x48, 0x66, 0x8c,
+	0x78, 0xcb, 0x0c, 0x6f, 0x91, 0xce, 0xc7, 0xec, 0xb7, 0xfc, 0x44, 0xf0, 0x94, 0xea, 0x36, 0x81,
+	0x41, 0x4f, 0x4f, 0x4d, 0x67, 0xc2, 0xa7, 0x6c, 0xef, 0xf3, 0xa5, 0xd9, 0x04, 0x96, 0x48, 0x76,
+	0x8e, 0x91, 0x2d, 0xd1, 0x4c, 0xf4, 0xd7, 0x14, 0xa8, 0x42, 0xd6, 0xce, 0x53, 0x07, 0x0e, 0x62,
+	0x9b, 0x73, 0x32, 0x4e, 0x09, 0x41, 0x74, 0x2a, 0xde, 0x08, 0x61, 0x26, 0x19, 0xcc, 0x28, 0x3d,
+	0x11, 0x21, 0x93, 0xfb, 0xf5, 0x8e, 0x84, 0x76, 0xaf, 0x34, 0x17, 0xbf, 0x97, 0x84, 0x75, 0xde,
+	0xd2, 0xf2, 0x9e, 0x7c, 0x90, 0xf1, 0x12, 0x63, 0xbc, 0x40, 0xcf, 0x27, 0x16, 0x4c, 0x15, 0x71,
+	0x8a, 0xac, 0x49, 0xfe, 0x56, 0x54, 0x5e, 0xb3, 0xe3, 0x8b, 0xad, 0xbc, 0x60, 0xf7, 0x2b, 0x2d,
+	0x24, 0x33, 0x4e, 0xd6, 0x25, 0x79, 0x1b, 0x10, 0x79, 0x5d, 0x78, 0xe7, 0xdf, 0x7c, 0xb6, 0x33,
+	0x41, 0x9e, 0xef, 0x4c, 0x90, 0x3f, 0x77, 0x26, 0xc8, 0xe7, 0xbb, 0x13, 0x3d, 0xcf, 0x77, 0x27,
+	0x7a, 0x7e, 0xdf, 0x9d, 0xe8, 0xf9, 0xe0, 0x94, 0xa6, 0x3b, 0xeb, 0xf5, 0x52, 0x66, 0xcd, 0xac,
+	0x8a, 0x88, 0xfc, 0xaf, 0x45, 0xbb, 0xfc, 0x91, 0xbc, 0xc9, 0xa3, 0x96, 0x0e, 0xb2, 0xff, 0xb0,
+	0x5a, 0xfe, 0x67, 0x00, 0x12, 0x9d, 0x53, 0xb5, 0x8b, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +1896,16 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ProposalExecutionLogs queries the automatic execution logs of a proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalExecutionLogs(ctx context.Context, in *QueryProposalExecutionLogsRequest, opts ...grpc.CallOption) (*QueryProposalExecutionLogsResponse, error)
+	// GroupHierarchy walks the hierarchy of a group, returning the sub-groups
+	// whose policies are members of the group (recursively), and the parent
+	// groups its policies are members of (recursively).
+	//
+	// Since: cosmos-sdk 0.48
+	GroupHierarchy(ctx context.Context, in *QueryGroupHierarchyRequest, opts ...grpc.CallOption) (*QueryGroupHierarchyResponse, error)
 }
 
 type queryClient struct {
@@ -1787,6 +2042,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ProposalExecutionLogs(ctx context.Context, in *QueryProposalExecutionLogsRequest, opts ...grpc.CallOption) (*QueryProposalExecutionLogsResponse, error) {
+	out := new(QueryProposalExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalExecutionLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupHierarchy(ctx context.Context, in *QueryGroupHierarchyRequest, opts ...grpc.CallOption) (*QueryGroupHierarchyResponse, error) {
+	out := new(QueryGroupHierarchyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/GroupHierarchy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1823,6 +2096,16 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ProposalExecutionLogs queries the automatic execution logs of a proposal.
+	//
+	// Since: cosmos-sdk 0.48
+	ProposalExecutionLogs(context.Context, *QueryProposalExecutionLogsRequest) (*QueryProposalExecutionLogsResponse, error)
+	// GroupHierarchy walks the hierarchy of a group, returning the sub-groups
+	// whose policies are members of the group (recursively), and the parent
+	// groups its policies are members of (recursively).
+	//
+	// Since: cosmos-sdk 0.48
+	GroupHierarchy(context.Context, *QueryGroupHierarchyRequest) (*QueryGroupHierarchyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1871,6 +2154,12 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) ProposalExecutionLogs(ctx context.Context, req *QueryProposalExecutionLogsRequest) (*QueryProposalExecutionLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecutionLogs not implemented")
+}
+func (*UnimplementedQueryServer) GroupHierarchy(ctx context.Context, req *QueryGroupHierarchyRequest) (*QueryGroupHierarchyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupHierarchy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2128,6 +2417,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalExecutionLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecutionLogs(ctx, req.(*QueryProposalExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupHierarchyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/GroupHierarchy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupHierarchy(ctx, req.(*QueryGroupHierarchyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2188,6 +2513,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ProposalExecutionLogs",
+			Handler:    _Query_ProposalExecutionLogs_Handler,
+		},
+		{
+			MethodName: "GroupHierarchy",
+			Handler:    _Query_GroupHierarchy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3321,57 +3654,230 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryGroupInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
+func (m *QueryProposalExecutionLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGroupInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryProposalExecutionLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProposalExecutionLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryProposalExecutionLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalExecutionLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalExecutionLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupHierarchyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupHierarchyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupHierarchyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupHierarchyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupHierarchyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupHierarchyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParentGroups) > 0 {
+		for iNdEx := len(m.ParentGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParentGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SubGroups) > 0 {
+		for iNdEx := len(m.SubGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGroupInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryGroupInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -3777,6 +4283,77 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalExecutionLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalExecutionLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupHierarchyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.MaxDepth != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDepth))
+	}
+	return n
+}
+
+func (m *QueryGroupHierarchyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubGroups) > 0 {
+		for _, e := range m.SubGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ParentGroups) > 0 {
+		for _, e := range m.ParentGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6671,6 +7248,437 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalExecutionLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecutionLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecutionLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalExecutionLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecutionLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecutionLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &ProposalExecutionLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupHierarchyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupHierarchyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupHierarchyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepth", wireType)
+			}
+			m.MaxDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupHierarchyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupHierarchyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupHierarchyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubGroups = append(m.SubGroups, GroupHierarchyEdge{})
+			if err := m.SubGroups[len(m.SubGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentGroups = append(m.ParentGroups, GroupHierarchyEdge{})
+			if err := m.ParentGroups[len(m.ParentGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -960,6 +960,115 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGroupPolicyMetadataResponse proto.InternalMessageInfo
 
+// MsgUpdateGroupPolicyAutoExecution is the Msg/UpdateGroupPolicyAutoExecution request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateGroupPolicyAutoExecution struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// auto_execution is the updated group policy automatic execution settings.
+	AutoExecution AutoExecution `protobuf:"bytes,3,opt,name=auto_execution,json=autoExecution,proto3" json:"auto_execution"`
+}
+
+func (m *MsgUpdateGroupPolicyAutoExecution) Reset()         { *m = MsgUpdateGroupPolicyAutoExecution{} }
+func (m *MsgUpdateGroupPolicyAutoExecution) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyAutoExecution) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyAutoExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{18}
+}
+func (m *MsgUpdateGroupPolicyAutoExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyAutoExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyAutoExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyAutoExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyAutoExecution.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyAutoExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyAutoExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyAutoExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyAutoExecution proto.InternalMessageInfo
+
+func (m *MsgUpdateGroupPolicyAutoExecution) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyAutoExecution) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyAutoExecution) GetAutoExecution() AutoExecution {
+	if m != nil {
+		return m.AutoExecution
+	}
+	return AutoExecution{}
+}
+
+// MsgUpdateGroupPolicyAutoExecutionResponse is the Msg/UpdateGroupPolicyAutoExecution response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateGroupPolicyAutoExecutionResponse struct {
+}
+
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) Reset() {
+	*m = MsgUpdateGroupPolicyAutoExecutionResponse{}
+}
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateGroupPolicyAutoExecutionResponse) ProtoMessage() {}
+func (*MsgUpdateGroupPolicyAutoExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{19}
+}
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyAutoExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyAutoExecutionResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyAutoExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyAutoExecutionResponse proto.InternalMessageInfo
+
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
 type MsgSubmitProposal struct {
 	// group_policy_address is the account address of group policy.
@@ -989,7 +1098,7 @@ func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
 func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposal) ProtoMessage()    {}
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{18}
+	return fileDescriptor_6b8d3d629f136420, []int{20}
 }
 func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1137,7 @@ func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResp
 func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{19}
+	return fileDescriptor_6b8d3d629f136420, []int{21}
 }
 func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,6 +1173,181 @@ func (m *MsgSubmitProposalResponse) GetProposalId() uint64 {
 	return 0
 }
 
+// MsgSubmitParentVoteProposal is the Msg/SubmitParentVoteProposal request type.
+// It submits an internal proposal on a sub-DAO group policy, which, once
+// executed, casts the group policy's vote on a proposal of a parent group.
+//
+// Since: cosmos-sdk 0.48
+type MsgSubmitParentVoteProposal struct {
+	// group_policy_address is the account address of the sub-DAO group policy,
+	// which must be a member of the parent proposal's group.
+	GroupPolicyAddress string `protobuf:"bytes,1,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// proposers are the account addresses of the proposers.
+	// Proposers signatures will be counted as yes votes.
+	Proposers []string `protobuf:"bytes,2,rep,name=proposers,proto3" json:"proposers,omitempty"`
+	// parent_proposal_id is the unique ID of the parent group's proposal to vote on.
+	ParentProposalId uint64 `protobuf:"varint,3,opt,name=parent_proposal_id,json=parentProposalId,proto3" json:"parent_proposal_id,omitempty"`
+	// option is the vote option the group policy casts on the parent proposal.
+	Option VoteOption `protobuf:"varint,4,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// metadata is any arbitrary metadata attached to the internal proposal.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// vote_metadata is any arbitrary metadata attached to the vote on the parent
+	// proposal.
+	VoteMetadata string `protobuf:"bytes,6,opt,name=vote_metadata,json=voteMetadata,proto3" json:"vote_metadata,omitempty"`
+	// exec defines the mode of execution of the internal proposal,
+	// whether it should be executed immediately on creation or not.
+	// If so, proposers signatures are considered as Yes votes.
+	Exec Exec `protobuf:"varint,7,opt,name=exec,proto3,enum=cosmos.group.v1.Exec" json:"exec,omitempty"`
+	// title is the title of the internal proposal.
+	Title string `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the internal proposal.
+	Summary string `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (m *MsgSubmitParentVoteProposal) Reset()         { *m = MsgSubmitParentVoteProposal{} }
+func (m *MsgSubmitParentVoteProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitParentVoteProposal) ProtoMessage()    {}
+func (*MsgSubmitParentVoteProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{22}
+}
+func (m *MsgSubmitParentVoteProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitParentVoteProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitParentVoteProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitParentVoteProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitParentVoteProposal.Merge(m, src)
+}
+func (m *MsgSubmitParentVoteProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitParentVoteProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitParentVoteProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitParentVoteProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitParentVoteProposal) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitParentVoteProposal) GetProposers() []string {
+	if m != nil {
+		return m.Proposers
+	}
+	return nil
+}
+
+func (m *MsgSubmitParentVoteProposal) GetParentProposalId() uint64 {
+	if m != nil {
+		return m.ParentProposalId
+	}
+	return 0
+}
+
+func (m *MsgSubmitParentVoteProposal) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *MsgSubmitParentVoteProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgSubmitParentVoteProposal) GetVoteMetadata() string {
+	if m != nil {
+		return m.VoteMetadata
+	}
+	return ""
+}
+
+func (m *MsgSubmitParentVoteProposal) GetExec() Exec {
+	if m != nil {
+		return m.Exec
+	}
+	return Exec_EXEC_UNSPECIFIED
+}
+
+func (m *MsgSubmitParentVoteProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *MsgSubmitParentVoteProposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+// MsgSubmitParentVoteProposalResponse is the Msg/SubmitParentVoteProposal response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSubmitParentVoteProposalResponse struct {
+	// proposal_id is the unique ID of the internal proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgSubmitParentVoteProposalResponse) Reset()         { *m = MsgSubmitParentVoteProposalResponse{} }
+func (m *MsgSubmitParentVoteProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitParentVoteProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitParentVoteProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{23}
+}
+func (m *MsgSubmitParentVoteProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitParentVoteProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitParentVoteProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitParentVoteProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitParentVoteProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitParentVoteProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitParentVoteProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitParentVoteProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitParentVoteProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitParentVoteProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
 // MsgWithdrawProposal is the Msg/WithdrawProposal request type.
 type MsgWithdrawProposal struct {
 	// proposal is the unique ID of the proposal.
@@ -1076,7 +1360,7 @@ func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
 func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposal) ProtoMessage()    {}
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{20}
+	return fileDescriptor_6b8d3d629f136420, []int{24}
 }
 func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1411,7 @@ func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposal
 func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{21}
+	return fileDescriptor_6b8d3d629f136420, []int{25}
 }
 func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1175,7 +1459,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{22}
+	return fileDescriptor_6b8d3d629f136420, []int{26}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1247,7 +1531,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{23}
+	return fileDescriptor_6b8d3d629f136420, []int{27}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1572,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{24}
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1625,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{25}
+	return fileDescriptor_6b8d3d629f136420, []int{29}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1389,7 +1673,7 @@ func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
 func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroup) ProtoMessage()    {}
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+	return fileDescriptor_6b8d3d629f136420, []int{30}
 }
 func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1440,7 +1724,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{31}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,8 +1773,12 @@ func init() {
 	proto.RegisterType((*MsgUpdateGroupPolicyDecisionPolicyResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadata)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadata")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadataResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse")
+	proto.RegisterType((*MsgUpdateGroupPolicyAutoExecution)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecution")
+	proto.RegisterType((*MsgUpdateGroupPolicyAutoExecutionResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyAutoExecutionResponse")
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.group.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.group.v1.MsgSubmitProposalResponse")
+	proto.RegisterType((*MsgSubmitParentVoteProposal)(nil), "cosmos.group.v1.MsgSubmitParentVoteProposal")
+	proto.RegisterType((*MsgSubmitParentVoteProposalResponse)(nil), "cosmos.group.v1.MsgSubmitParentVoteProposalResponse")
 	proto.RegisterType((*MsgWithdrawProposal)(nil), "cosmos.group.v1.MsgWithdrawProposal")
 	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "cosmos.group.v1.MsgWithdrawProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.group.v1.MsgVote")
@@ -1504,98 +1792,108 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0xce, 0xaf, 0x97, 0x6f, 0xdd, 0x64, 0x9b, 0xb4, 0x9b, 0x6d, 0x6b, 0xbb, 0xd3,
-	0xb4, 0x49, 0xad, 0xc6, 0x6e, 0x9c, 0x6f, 0x2b, 0x61, 0x10, 0xa8, 0x49, 0x0d, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xde, 0x6e, 0x57, 0x64, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x3f, 0x2e, 0x80, 0x90, 0x40, 0x82, 0x3f, 0x00, 0x6e, 0x1c, 0x8b, 0xd4, 0x03, 0x37, 0x6e, 0xa8,
-	0x2a, 0x97, 0x8a, 0x13, 0x27, 0x04, 0xad, 0x50, 0x6f, 0xfc, 0x0b, 0xa0, 0x9d, 0xd9, 0x1d, 0xef,
-	0x78, 0x77, 0xbd, 0x5b, 0xcb, 0x82, 0x4b, 0x94, 0x9d, 0xf7, 0x79, 0xbf, 0x3e, 0xef, 0xcd, 0x9b,
-	0x19, 0x83, 0xb4, 0x6b, 0x61, 0xd3, 0xc2, 0x65, 0xbd, 0x63, 0x75, 0xdb, 0xe5, 0xfd, 0xb5, 0xb2,
-	0x7d, 0x50, 0x6a, 0x77, 0x2c, 0xdb, 0x12, 0x8f, 0x52, 0x49, 0x89, 0x48, 0x4a, 0xfb, 0x6b, 0xf2,
-	0xbc, 0x6e, 0xe9, 0x16, 0x91, 0x95, 0x9d, 0xff, 0x28, 0x4c, 0x5e, 0xa4, 0xb0, 0x06, 0x15, 0xb8,
-	0x3a, 0xae, 0x48, 0xb7, 0x2c, 0x7d, 0x4f, 0x2b, 0x93, 0xaf, 0x9d, 0xee, 0xed, 0xb2, 0xda, 0x3a,
-	0x74, 0x45, 0x27, 0x03, 0x6e, 0x0f, 0xdb, 0x9a, 0xa7, 0x77, 0xc2, 0x15, 0x9a, 0x58, 0x77, 0x44,
-	0x26, 0xd6, 0x5d, 0xc1, 0x9c, 0x6a, 0x1a, 0x2d, 0xab, 0x4c, 0xfe, 0xd2, 0x25, 0xf4, 0xb3, 0x00,
-	0xd9, 0x3a, 0xd6, 0x37, 0x3b, 0x9a, 0x6a, 0x6b, 0xaf, 0x38, 0xd6, 0xc4, 0x12, 0x8c, 0xab, 0x4d,
-	0xd3, 0x68, 0x49, 0x42, 0x41, 0x58, 0x99, 0xde, 0x90, 0x7e, 0xb9, 0xbf, 0x3a, 0xef, 0xc6, 0x75,
-	0xb5, 0xd9, 0xec, 0x68, 0x18, 0x5f, 0xb7, 0x3b, 0x46, 0x4b, 0x57, 0x28, 0x4c, 0xdc, 0x84, 0x49,
-	0x53, 0x33, 0x77, 0xb4, 0x0e, 0x96, 0x52, 0x85, 0xf4, 0xca, 0x4c, 0x25, 0x57, 0xea, 0x4b, 0xbd,
-	0x54, 0x27, 0x72, 0x45, 0x7b, 0xbf, 0xab, 0x61, 0x7b, 0x63, 0xfa, 0xc1, 0x6f, 0xf9, 0xb1, 0xef,
-	0x9e, 0xde, 0x2b, 0x0a, 0x8a, 0xa7, 0x29, 0xca, 0x30, 0x65, 0x6a, 0xb6, 0xda, 0x54, 0x6d, 0x55,
-	0x4a, 0x3b, 0x7e, 0x15, 0xf6, 0x5d, 0x5d, 0xf9, 0xe8, 0xe9, 0xbd, 0x22, 0x75, 0xf6, 0xd9, 0xd3,
-	0x7b, 0x45, 0x97, 0xb1, 0x55, 0xdc, 0x7c, 0xaf, 0xcc, 0x87, 0x8e, 0xd6, 0xe1, 0x38, 0xbf, 0xa2,
-	0x68, 0xb8, 0x6d, 0xb5, 0xb0, 0x26, 0x2e, 0xc2, 0x14, 0x89, 0xa6, 0x61, 0x34, 0x49, 0x5e, 0x19,
-	0x65, 0x92, 0x7c, 0x6f, 0x35, 0xd1, 0x9f, 0x02, 0x2c, 0xd4, 0xb1, 0x7e, 0xb3, 0xdd, 0xf4, 0xb4,
-	0xea, 0x6e, 0x50, 0xcf, 0xca, 0x84, 0xdf, 0x49, 0x8a, 0x73, 0x22, 0x6e, 0x43, 0x96, 0xa6, 0xda,
-	0xe8, 0x12, 0x3f, 0x58, 0x4a, 0x3f, 0x2b, 0x57, 0x47, 0xa8, 0x01, 0x1a, 0x27, 0xae, 0x96, 0x79,
-	0x56, 0x0a, 0x3c, 0x2b, 0xc1, 0x6c, 0x50, 0x1e, 0x4e, 0x87, 0x0a, 0x3c, 0x8e, 0xd0, 0x4f, 0x02,
-	0x1c, 0xe3, 0x11, 0x57, 0x49, 0x5a, 0x23, 0xa4, 0xe1, 0x32, 0x4c, 0xb7, 0xb4, 0xbb, 0x0d, 0x6a,
-	0x2e, 0x1d, 0x63, 0x6e, 0xaa, 0xa5, 0xdd, 0x25, 0x11, 0x54, 0x57, 0xf9, 0x5c, 0x73, 0x91, 0xb9,
-	0x12, 0x38, 0x3a, 0x0d, 0x27, 0x43, 0x96, 0x59, 0x9e, 0xdf, 0x0b, 0xa4, 0x4d, 0x38, 0x26, 0x68,
-	0xab, 0x8d, 0x32, 0xd5, 0x41, 0x1d, 0x7d, 0x89, 0xcf, 0xe7, 0xcc, 0x80, 0xda, 0x51, 0x0d, 0x54,
-	0x80, 0x5c, 0xb8, 0x84, 0x65, 0xf5, 0x75, 0x0a, 0xe6, 0xf9, 0xe6, 0xdf, 0xb6, 0xf6, 0x8c, 0xdd,
-	0xc3, 0x7f, 0x29, 0x27, 0x51, 0x85, 0xa3, 0x4d, 0x6d, 0xd7, 0xc0, 0x86, 0xd5, 0x6a, 0xb4, 0x89,
-	0x67, 0x29, 0x53, 0x10, 0x56, 0x66, 0x2a, 0xf3, 0x25, 0x3a, 0xc7, 0x4a, 0xde, 0x1c, 0x2b, 0x5d,
-	0x6d, 0x1d, 0x6e, 0xa0, 0x87, 0xf7, 0x57, 0x73, 0xfd, 0xbd, 0x7f, 0xcd, 0x35, 0x40, 0x23, 0x57,
-	0xb2, 0x4d, 0xee, 0xbb, 0x5a, 0xf9, 0xe4, 0x9b, 0xfc, 0x18, 0x4f, 0x5d, 0x3e, 0x72, 0x18, 0x50,
-	0x1d, 0xa4, 0xc0, 0xa9, 0xb0, 0x75, 0x36, 0x18, 0x2a, 0x30, 0xa9, 0x52, 0x16, 0x62, 0xf9, 0xf1,
-	0x80, 0xe8, 0xe3, 0x14, 0x2c, 0xf2, 0xd5, 0xa0, 0x46, 0x87, 0xdb, 0x2e, 0xaf, 0xc2, 0x3c, 0xe5,
-	0x9b, 0xb2, 0xd6, 0xf0, 0xc2, 0x49, 0xc5, 0xa8, 0x8b, 0xba, 0xdf, 0x33, 0x91, 0x0c, 0xbb, 0xbf,
-	0xd6, 0x79, 0x52, 0x97, 0x22, 0xfb, 0xd1, 0x97, 0x27, 0x3a, 0x0b, 0x67, 0x22, 0x85, 0xac, 0x2b,
-	0x7f, 0x48, 0x83, 0xc4, 0xf3, 0x7f, 0xcb, 0xb0, 0xef, 0x0c, 0xd9, 0x99, 0x23, 0x39, 0x69, 0xce,
-	0x41, 0x96, 0xd2, 0xdd, 0xd7, 0xc9, 0x47, 0x74, 0x6e, 0x12, 0x54, 0x60, 0x81, 0xab, 0x0a, 0x43,
-	0x67, 0x08, 0xfa, 0x98, 0x8f, 0x7c, 0xa6, 0xb3, 0xd6, 0xa7, 0xa3, 0x62, 0xb7, 0x12, 0xe3, 0x05,
-	0x61, 0x65, 0x8a, 0x2f, 0x18, 0xa6, 0xcd, 0x12, 0xb2, 0x6b, 0x26, 0x46, 0xbc, 0x6b, 0xae, 0x04,
-	0x77, 0xcd, 0xd9, 0xc8, 0x5d, 0xd3, 0xab, 0x0e, 0xfa, 0x54, 0x80, 0x42, 0x94, 0x30, 0xc1, 0xb9,
-	0x3a, 0xca, 0xbe, 0x46, 0x3f, 0xa6, 0x00, 0x85, 0x35, 0x1b, 0x9f, 0xfa, 0x7f, 0xba, 0xf5, 0x42,
-	0x2a, 0x99, 0x1e, 0x71, 0x25, 0xab, 0xc1, 0x4a, 0x2e, 0x47, 0x6e, 0x55, 0xde, 0x16, 0xba, 0x08,
-	0xc5, 0x78, 0x02, 0xd9, 0xb6, 0xfd, 0x4b, 0x20, 0x63, 0x33, 0x00, 0x1f, 0xfa, 0xa0, 0x1c, 0x25,
-	0xd3, 0x83, 0x4e, 0xd6, 0x2b, 0x49, 0xe9, 0xe1, 0xf3, 0x41, 0xe7, 0x61, 0x69, 0x90, 0x9c, 0x11,
-	0xf3, 0x47, 0x0a, 0xe6, 0xea, 0x58, 0xbf, 0xde, 0xdd, 0x31, 0x0d, 0x7b, 0xbb, 0x63, 0xb5, 0x2d,
-	0xac, 0xee, 0x45, 0x66, 0x27, 0x0c, 0x91, 0xdd, 0x29, 0x98, 0x6e, 0x13, 0xbb, 0xde, 0x98, 0x9b,
-	0x56, 0x7a, 0x0b, 0x03, 0x4f, 0xe0, 0x4b, 0x8e, 0x0c, 0x63, 0x55, 0xd7, 0xb0, 0x94, 0x21, 0xf3,
-	0x31, 0xb4, 0xf5, 0x14, 0x86, 0x12, 0x2f, 0x40, 0x46, 0x3b, 0xd0, 0x76, 0xc9, 0x7c, 0xca, 0x56,
-	0x16, 0x02, 0xd3, 0xb4, 0x76, 0xa0, 0xed, 0x2a, 0x04, 0x22, 0xce, 0xc3, 0xb8, 0x6d, 0xd8, 0x7b,
-	0x1a, 0x19, 0x4f, 0xd3, 0x0a, 0xfd, 0x10, 0x25, 0x98, 0xc4, 0x5d, 0xd3, 0x54, 0x3b, 0x87, 0xd2,
-	0x24, 0x59, 0xf7, 0x3e, 0xab, 0xcf, 0x79, 0xbd, 0xda, 0x0b, 0xde, 0x29, 0x08, 0xf2, 0x15, 0x84,
-	0x3e, 0x5e, 0x02, 0x6c, 0xa2, 0x17, 0xc8, 0xe9, 0xca, 0x2f, 0xb2, 0x81, 0x93, 0x87, 0x99, 0xb6,
-	0xbb, 0xd6, 0x9b, 0x39, 0xe0, 0x2d, 0x6d, 0x35, 0xd1, 0xb7, 0xf4, 0x16, 0xeb, 0xcc, 0xaa, 0x66,
-	0x47, 0xbd, 0xcb, 0x6a, 0x14, 0xa7, 0xe8, 0xbf, 0x09, 0xa4, 0x12, 0xde, 0x04, 0xaa, 0x97, 0x9d,
-	0x0c, 0xbd, 0xaf, 0xfe, 0xa3, 0x93, 0xe5, 0xd7, 0x1f, 0x8b, 0x7b, 0x41, 0xed, 0x5f, 0x66, 0x4d,
-	0xf6, 0xb7, 0x00, 0x93, 0x75, 0xac, 0xbf, 0x69, 0xd9, 0xf1, 0xf9, 0x3a, 0x3b, 0x71, 0xdf, 0xb2,
-	0xb5, 0x4e, 0x6c, 0xd0, 0x14, 0x26, 0xae, 0xc3, 0x84, 0xd5, 0xb6, 0x0d, 0x8b, 0xde, 0x0f, 0xb2,
-	0x95, 0x93, 0x81, 0xaa, 0x3b, 0x7e, 0xdf, 0x20, 0x10, 0xc5, 0x85, 0x72, 0x6d, 0x97, 0xe9, 0x6b,
-	0xbb, 0xe4, 0x4d, 0x54, 0x5d, 0x26, 0xbb, 0x93, 0xc4, 0xe1, 0x90, 0x25, 0x85, 0x91, 0xe5, 0x78,
-	0x47, 0x73, 0x70, 0xd4, 0xfd, 0x97, 0x91, 0xf2, 0x39, 0x25, 0xc5, 0xb1, 0x16, 0x4f, 0xca, 0xff,
-	0x61, 0xca, 0x71, 0xd8, 0xb5, 0xad, 0x78, 0x5e, 0x18, 0xb2, 0x5a, 0x74, 0xc2, 0x63, 0x9f, 0x91,
-	0x11, 0x3a, 0x21, 0x20, 0x85, 0x44, 0x48, 0x72, 0xf3, 0x5a, 0xf3, 0x25, 0x98, 0xe8, 0x68, 0xb8,
-	0xbb, 0x67, 0x13, 0x97, 0xd9, 0xca, 0x72, 0x80, 0x0a, 0xaf, 0xd2, 0x35, 0xd7, 0x85, 0x42, 0xe0,
-	0x8a, 0xab, 0x86, 0xbe, 0x10, 0xe0, 0x48, 0x1d, 0xeb, 0xaf, 0x69, 0xea, 0xbe, 0xfb, 0x16, 0x1f,
-	0xe2, 0x76, 0x3a, 0xe0, 0xfe, 0x4e, 0xdf, 0x8c, 0xfe, 0x76, 0xcd, 0x85, 0xe5, 0xd7, 0xf3, 0x8f,
-	0x4e, 0x90, 0xa7, 0x71, 0x6f, 0xc1, 0xcb, 0xb5, 0x58, 0x84, 0x4c, 0x8d, 0x8e, 0x85, 0xd9, 0xda,
-	0x5b, 0xb5, 0xcd, 0xc6, 0xcd, 0xd7, 0xaf, 0x6f, 0xd7, 0x36, 0xb7, 0x5e, 0xde, 0xaa, 0x5d, 0x9b,
-	0x1d, 0x13, 0xff, 0x07, 0x53, 0x64, 0xf5, 0x86, 0xf2, 0xf6, 0xac, 0x50, 0x79, 0x38, 0x03, 0xe9,
-	0x3a, 0xd6, 0xc5, 0x5b, 0x30, 0xe3, 0xff, 0x9d, 0x21, 0x1f, 0xbc, 0xbc, 0x71, 0xb7, 0x0d, 0x79,
-	0x39, 0x06, 0xc0, 0x88, 0xdf, 0x03, 0x31, 0xe4, 0xf5, 0x7e, 0x3e, 0x4c, 0x3d, 0x88, 0x93, 0x4b,
-	0xc9, 0x70, 0xcc, 0xdb, 0x6d, 0x98, 0x0d, 0x3c, 0x91, 0x97, 0x62, 0x6c, 0x10, 0x94, 0x7c, 0x31,
-	0x09, 0x8a, 0xf9, 0xb1, 0xe0, 0x58, 0xd8, 0x13, 0x75, 0x39, 0x36, 0x5c, 0x0a, 0x94, 0xcb, 0x09,
-	0x81, 0xcc, 0xa1, 0x01, 0x73, 0xc1, 0xd7, 0xe3, 0xb9, 0x98, 0x22, 0x50, 0x98, 0xbc, 0x9a, 0x08,
-	0xc6, 0x5c, 0x75, 0x61, 0x21, 0xfc, 0x49, 0x70, 0x21, 0xc6, 0x4e, 0x0f, 0x2a, 0xaf, 0x25, 0x86,
-	0x32, 0xb7, 0x07, 0x70, 0x3c, 0xe2, 0xd1, 0x56, 0x8c, 0x21, 0xcb, 0x87, 0x95, 0x2b, 0xc9, 0xb1,
-	0xcc, 0xf3, 0x57, 0x02, 0xe4, 0xe3, 0x6e, 0xaf, 0xeb, 0x89, 0xec, 0xf2, 0x4a, 0xf2, 0xf3, 0x43,
-	0x28, 0xb1, 0xa8, 0x3e, 0x14, 0x60, 0x31, 0xfa, 0x8e, 0xb7, 0x9a, 0xc8, 0x34, 0xeb, 0xb7, 0xcb,
-	0xcf, 0x04, 0x67, 0x31, 0xbc, 0x0b, 0xd9, 0xbe, 0xdb, 0x14, 0x0a, 0x33, 0xc4, 0x63, 0xe4, 0x62,
-	0x3c, 0xc6, 0xbf, 0x61, 0x03, 0xb7, 0x81, 0xd0, 0x0d, 0xdb, 0x8f, 0x0a, 0xdf, 0xb0, 0x51, 0xc7,
-	0xb6, 0xb8, 0x01, 0x19, 0x72, 0x64, 0x4b, 0x61, 0x5a, 0x8e, 0x44, 0x2e, 0x44, 0x49, 0xfc, 0x36,
-	0xc8, 0x5c, 0x0d, 0xb5, 0xe1, 0x48, 0xc2, 0x6d, 0x70, 0xe7, 0xd0, 0x0d, 0x00, 0xdf, 0x11, 0x92,
-	0x0b, 0xc3, 0xf7, 0xe4, 0xf2, 0xf9, 0xc1, 0x72, 0xcf, 0xaa, 0x3c, 0xfe, 0x81, 0xf3, 0x8e, 0xde,
-	0x78, 0xf1, 0xc1, 0xe3, 0x9c, 0xf0, 0xe8, 0x71, 0x4e, 0xf8, 0xfd, 0x71, 0x4e, 0xf8, 0xf2, 0x49,
-	0x6e, 0xec, 0xd1, 0x93, 0xdc, 0xd8, 0xaf, 0x4f, 0x72, 0x63, 0xef, 0x2c, 0xe9, 0x86, 0x7d, 0xa7,
-	0xbb, 0x53, 0xda, 0xb5, 0x4c, 0xf7, 0x77, 0xec, 0xb2, 0xef, 0x74, 0x39, 0xa0, 0xe7, 0xcb, 0xce,
-	0x04, 0xb9, 0x8a, 0xae, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x17, 0xcf, 0x29, 0x39, 0x17,
-	0x00, 0x00,
+	// 1607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdf, 0x6f, 0xdb, 0xd4,
+	0x17, 0xaf, 0x93, 0xb4, 0x4d, 0x4e, 0xd7, 0xb4, 0xf5, 0xda, 0xcd, 0x75, 0xb7, 0x24, 0x73, 0xbb,
+	0xb6, 0xcb, 0xb7, 0x4d, 0xd6, 0x74, 0xdb, 0x57, 0x04, 0x04, 0x5a, 0xbb, 0x0c, 0x15, 0x11, 0xa8,
+	0xbc, 0x8d, 0x01, 0x2f, 0xc1, 0x6d, 0x3c, 0x2f, 0xa2, 0x89, 0x43, 0xae, 0xd3, 0xb5, 0x2f, 0x88,
+	0x1f, 0x2f, 0x80, 0x90, 0x98, 0x04, 0x7f, 0x00, 0xbc, 0xed, 0x71, 0x48, 0x7b, 0xe0, 0x6d, 0x6f,
+	0x68, 0x82, 0x97, 0x89, 0x27, 0x5e, 0x40, 0xb0, 0x09, 0xed, 0x8d, 0x7f, 0x01, 0xe4, 0x7b, 0xed,
+	0x1b, 0xdf, 0xd8, 0x8e, 0xdd, 0x28, 0x62, 0x2f, 0x55, 0x7c, 0xcf, 0xe7, 0xfc, 0xfa, 0x9c, 0xe3,
+	0x73, 0xef, 0x75, 0x41, 0xd8, 0xd5, 0x51, 0x5d, 0x47, 0x79, 0xad, 0xa5, 0xb7, 0x9b, 0xf9, 0xfd,
+	0xb5, 0xbc, 0x71, 0x90, 0x6b, 0xb6, 0x74, 0x43, 0xe7, 0x27, 0x88, 0x24, 0x87, 0x25, 0xb9, 0xfd,
+	0x35, 0x71, 0x5a, 0xd3, 0x35, 0x1d, 0xcb, 0xf2, 0xe6, 0x2f, 0x02, 0x13, 0x67, 0x09, 0xac, 0x42,
+	0x04, 0x96, 0x8e, 0x25, 0xd2, 0x74, 0x5d, 0xdb, 0x53, 0xf3, 0xf8, 0x69, 0xa7, 0x7d, 0x2b, 0xaf,
+	0x34, 0x0e, 0x2d, 0xd1, 0x9c, 0xcb, 0xed, 0x61, 0x53, 0xb5, 0xf5, 0x4e, 0x5a, 0xc2, 0x3a, 0xd2,
+	0x4c, 0x51, 0x1d, 0x69, 0x96, 0x60, 0x4a, 0xa9, 0xd7, 0x1a, 0x7a, 0x1e, 0xff, 0x25, 0x4b, 0xd2,
+	0xcf, 0x1c, 0x24, 0xcb, 0x48, 0xdb, 0x6c, 0xa9, 0x8a, 0xa1, 0xbe, 0x6a, 0x5a, 0xe3, 0x73, 0x30,
+	0xac, 0x54, 0xeb, 0xb5, 0x86, 0xc0, 0x65, 0xb8, 0xe5, 0xc4, 0x86, 0xf0, 0xcb, 0x83, 0xd5, 0x69,
+	0x2b, 0xae, 0xcb, 0xd5, 0x6a, 0x4b, 0x45, 0xe8, 0x9a, 0xd1, 0xaa, 0x35, 0x34, 0x99, 0xc0, 0xf8,
+	0x4d, 0x18, 0xad, 0xab, 0xf5, 0x1d, 0xb5, 0x85, 0x84, 0x48, 0x26, 0xba, 0x3c, 0x56, 0x48, 0xe5,
+	0xba, 0x52, 0xcf, 0x95, 0xb1, 0x5c, 0x56, 0x3f, 0x68, 0xab, 0xc8, 0xd8, 0x48, 0x3c, 0xfa, 0x3d,
+	0x3d, 0x74, 0xef, 0xd9, 0xfd, 0x2c, 0x27, 0xdb, 0x9a, 0xbc, 0x08, 0xf1, 0xba, 0x6a, 0x28, 0x55,
+	0xc5, 0x50, 0x84, 0xa8, 0xe9, 0x57, 0xa6, 0xcf, 0xc5, 0xe5, 0x4f, 0x9e, 0xdd, 0xcf, 0x12, 0x67,
+	0x5f, 0x3c, 0xbb, 0x9f, 0xb5, 0x18, 0x5b, 0x45, 0xd5, 0xf7, 0xf3, 0x6c, 0xe8, 0xd2, 0x3a, 0x9c,
+	0x60, 0x57, 0x64, 0x15, 0x35, 0xf5, 0x06, 0x52, 0xf9, 0x59, 0x88, 0xe3, 0x68, 0x2a, 0xb5, 0x2a,
+	0xce, 0x2b, 0x26, 0x8f, 0xe2, 0xe7, 0xad, 0xaa, 0xf4, 0x17, 0x07, 0x33, 0x65, 0xa4, 0xdd, 0x68,
+	0x56, 0x6d, 0xad, 0xb2, 0x15, 0xd4, 0x51, 0x99, 0x70, 0x3a, 0x89, 0x30, 0x4e, 0xf8, 0x6d, 0x48,
+	0x92, 0x54, 0x2b, 0x6d, 0xec, 0x07, 0x09, 0xd1, 0xa3, 0x72, 0x35, 0x4e, 0x0c, 0x90, 0x38, 0x51,
+	0x31, 0xcf, 0xb2, 0x92, 0x61, 0x59, 0x71, 0x67, 0x23, 0xa5, 0xe1, 0xb4, 0xa7, 0xc0, 0xe6, 0x48,
+	0xfa, 0x91, 0x83, 0xe3, 0x2c, 0xe2, 0x32, 0x4e, 0x6b, 0x80, 0x34, 0x5c, 0x84, 0x44, 0x43, 0xbd,
+	0x53, 0x21, 0xe6, 0xa2, 0x01, 0xe6, 0xe2, 0x0d, 0xf5, 0x0e, 0x8e, 0xa0, 0xb8, 0xca, 0xe6, 0x9a,
+	0xf2, 0xcd, 0x15, 0xc3, 0xa5, 0xd3, 0x30, 0xe7, 0xb1, 0x4c, 0xf3, 0xfc, 0x9e, 0x83, 0x13, 0xac,
+	0xbc, 0x6c, 0xb5, 0xda, 0x20, 0x53, 0xed, 0xd5, 0xd1, 0xe7, 0xd9, 0x7c, 0xce, 0xf4, 0xa8, 0x1d,
+	0xd1, 0x90, 0x32, 0x90, 0xf2, 0x96, 0xd0, 0xac, 0xbe, 0x89, 0xc0, 0x34, 0xdb, 0xfc, 0xdb, 0xfa,
+	0x5e, 0x6d, 0xf7, 0xf0, 0x3f, 0xca, 0x89, 0x57, 0x60, 0xa2, 0xaa, 0xee, 0xd6, 0x50, 0x4d, 0x6f,
+	0x54, 0x9a, 0xd8, 0xb3, 0x10, 0xcb, 0x70, 0xcb, 0x63, 0x85, 0xe9, 0x1c, 0x99, 0x63, 0x39, 0x7b,
+	0x8e, 0xe5, 0x2e, 0x37, 0x0e, 0x37, 0xa4, 0x9f, 0x1e, 0xac, 0xa6, 0xba, 0x7b, 0xff, 0x8a, 0x65,
+	0x80, 0x44, 0x2e, 0x27, 0xab, 0xcc, 0x73, 0xb1, 0xf0, 0xd9, 0xb7, 0xe9, 0x21, 0x96, 0xba, 0xb4,
+	0xef, 0x30, 0x20, 0x3a, 0x92, 0x0c, 0xa7, 0xbc, 0xd6, 0xe9, 0x60, 0x28, 0xc0, 0xa8, 0x42, 0x58,
+	0x08, 0xe4, 0xc7, 0x06, 0x4a, 0x9f, 0x46, 0x60, 0x96, 0xad, 0x06, 0x31, 0xda, 0xdf, 0xeb, 0xf2,
+	0x1a, 0x4c, 0x13, 0xbe, 0x09, 0x6b, 0x15, 0x3b, 0x9c, 0x48, 0x80, 0x3a, 0xaf, 0x39, 0x3d, 0x63,
+	0x49, 0xbf, 0xef, 0xd7, 0x3a, 0x4b, 0xea, 0x82, 0x6f, 0x3f, 0x3a, 0xf2, 0x94, 0xe6, 0xe1, 0x8c,
+	0xaf, 0x90, 0x76, 0xe5, 0x0f, 0x51, 0x10, 0x58, 0xfe, 0x6f, 0xd6, 0x8c, 0xdb, 0x7d, 0x76, 0xe6,
+	0x40, 0x76, 0x9a, 0xb3, 0x90, 0x24, 0x74, 0x77, 0x75, 0xf2, 0xb8, 0xc6, 0x4c, 0x82, 0x02, 0xcc,
+	0x30, 0x55, 0xa1, 0xe8, 0x18, 0x46, 0x1f, 0x77, 0x90, 0x4f, 0x75, 0xd6, 0xba, 0x74, 0x14, 0x64,
+	0x55, 0x62, 0x38, 0xc3, 0x2d, 0xc7, 0xd9, 0x82, 0x21, 0xd2, 0x2c, 0x1e, 0x6f, 0xcd, 0xc8, 0x80,
+	0xdf, 0x9a, 0x4b, 0xee, 0xb7, 0x66, 0xde, 0xf7, 0xad, 0xe9, 0x54, 0x47, 0xfa, 0x9c, 0x83, 0x8c,
+	0x9f, 0x30, 0xc4, 0xbe, 0x3a, 0xc8, 0xbe, 0x96, 0x1e, 0x46, 0x40, 0xf2, 0x6a, 0x36, 0x36, 0xf5,
+	0xe7, 0xfa, 0xea, 0x79, 0x54, 0x32, 0x3a, 0xe0, 0x4a, 0x16, 0xdd, 0x95, 0x5c, 0xf2, 0x7d, 0x55,
+	0x59, 0x5b, 0xd2, 0x0a, 0x64, 0x83, 0x09, 0xa4, 0xaf, 0xed, 0xdf, 0x1c, 0x9c, 0xf2, 0x82, 0xf7,
+	0xbd, 0x51, 0x0e, 0x92, 0xe9, 0x5e, 0x3b, 0xeb, 0xa5, 0xb0, 0xf4, 0xb0, 0xf9, 0x48, 0x8b, 0xb0,
+	0xd0, 0x4b, 0x4e, 0x89, 0xb9, 0x17, 0xf1, 0x99, 0x7a, 0x6d, 0x43, 0x2f, 0x1d, 0xa8, 0xbb, 0x6d,
+	0xa3, 0xa6, 0x3f, 0xdf, 0x2d, 0x60, 0x1b, 0x92, 0x4a, 0xdb, 0xd0, 0x2b, 0xaa, 0x1d, 0x8d, 0xd5,
+	0x86, 0xee, 0x59, 0xc9, 0xc4, 0xcc, 0x9c, 0x34, 0x15, 0xa7, 0xa4, 0x78, 0x91, 0xe5, 0x74, 0xd1,
+	0xff, 0xf4, 0xe5, 0x54, 0x93, 0xfe, 0x07, 0xe7, 0x02, 0x99, 0xa2, 0xbc, 0xfe, 0x19, 0x81, 0xa9,
+	0x32, 0xd2, 0xae, 0xb5, 0x77, 0xea, 0x35, 0x63, 0xbb, 0xa5, 0x37, 0x75, 0xa4, 0xec, 0xf9, 0xf2,
+	0xc2, 0xf5, 0xc1, 0xcb, 0x29, 0x48, 0x34, 0xb1, 0x5d, 0x7b, 0xfb, 0x48, 0xc8, 0x9d, 0x85, 0x9e,
+	0x27, 0x9b, 0xf3, 0xa6, 0x0c, 0x21, 0x45, 0x53, 0x91, 0x10, 0xcb, 0x44, 0xfd, 0x5e, 0x69, 0x99,
+	0xa2, 0xf8, 0x73, 0x10, 0x33, 0xe9, 0xc7, 0x73, 0x3f, 0x59, 0x98, 0x71, 0x31, 0x6f, 0xe6, 0x2f,
+	0x63, 0x08, 0x3f, 0x0d, 0xc3, 0x46, 0xcd, 0xd8, 0x53, 0xf1, 0xd8, 0x4f, 0xc8, 0xe4, 0x81, 0x17,
+	0x60, 0x14, 0xb5, 0xeb, 0x75, 0xa5, 0x75, 0x28, 0x8c, 0xe2, 0x75, 0xfb, 0xb1, 0xf8, 0x82, 0x3d,
+	0x03, 0x3a, 0xc1, 0x9b, 0x45, 0x91, 0x1c, 0x45, 0x21, 0x97, 0x42, 0x17, 0x9b, 0xd2, 0x4b, 0x30,
+	0xeb, 0x5a, 0xa4, 0x83, 0x3c, 0x0d, 0x63, 0x4d, 0x6b, 0xad, 0x33, 0xcb, 0xc1, 0x5e, 0xda, 0xaa,
+	0x4a, 0x0f, 0xa3, 0x30, 0xd7, 0x51, 0x57, 0x5a, 0x6a, 0xc3, 0x78, 0x4b, 0x37, 0xd4, 0xe7, 0x50,
+	0xab, 0x15, 0xe0, 0x9b, 0xd8, 0x7f, 0xc5, 0x19, 0x71, 0x14, 0x47, 0x3c, 0x49, 0x24, 0xdb, 0x34,
+	0x6e, 0x7e, 0x1d, 0x46, 0xf4, 0x26, 0x7e, 0x0f, 0x62, 0xb8, 0x1a, 0x73, 0xae, 0x6a, 0x98, 0x69,
+	0xbc, 0x89, 0x21, 0xb2, 0x05, 0x65, 0xda, 0x61, 0xb8, 0xab, 0x1d, 0xe6, 0x61, 0x7c, 0x5f, 0x37,
+	0xd4, 0xce, 0x89, 0x80, 0x54, 0xee, 0x98, 0xb9, 0x48, 0xe7, 0xa3, 0xdd, 0x01, 0xa3, 0x47, 0xe8,
+	0x80, 0xb8, 0x4f, 0x07, 0x24, 0xd8, 0x0e, 0xf8, 0xbf, 0xbb, 0xfa, 0x0b, 0x3d, 0xaa, 0x4f, 0x2b,
+	0x25, 0x5d, 0x85, 0xf9, 0x1e, 0x05, 0x0c, 0xdf, 0x09, 0xdf, 0x91, 0x7b, 0xa2, 0x79, 0x1a, 0xa8,
+	0xb6, 0x94, 0x3b, 0xb4, 0x03, 0x82, 0x14, 0x9d, 0x67, 0xed, 0x48, 0xc8, 0xb3, 0x36, 0x19, 0x3e,
+	0xf6, 0x93, 0x6f, 0xae, 0xdd, 0xb1, 0x58, 0x57, 0xc0, 0xee, 0x65, 0x3a, 0x6e, 0xfe, 0xe1, 0x60,
+	0xb4, 0x8c, 0x34, 0x33, 0xff, 0xe0, 0xb0, 0x73, 0x30, 0x6c, 0xd6, 0xb6, 0x15, 0x18, 0x34, 0x81,
+	0x39, 0x3a, 0x2e, 0xda, 0x5f, 0xc7, 0xc5, 0xba, 0x3a, 0x2e, 0xfc, 0x38, 0x29, 0x2e, 0xe1, 0x59,
+	0x8d, 0xe3, 0x30, 0xc9, 0x12, 0xbc, 0xc8, 0xc2, 0xcd, 0x30, 0x05, 0x13, 0xd6, 0x4f, 0x4a, 0xca,
+	0x97, 0x84, 0x14, 0xd3, 0x5a, 0x30, 0x29, 0x17, 0x20, 0x4e, 0x76, 0x18, 0x3d, 0x98, 0x17, 0x8a,
+	0x2c, 0x66, 0xcd, 0xf0, 0xe8, 0xa3, 0x6f, 0x84, 0x66, 0x08, 0x92, 0x0c, 0x13, 0xd6, 0x4f, 0xda,
+	0x9a, 0xaf, 0xc0, 0x48, 0x4b, 0x45, 0xed, 0x3d, 0x03, 0xbb, 0x4c, 0x16, 0x96, 0x5c, 0x54, 0xd8,
+	0x95, 0x2e, 0x59, 0x2e, 0x64, 0x0c, 0x97, 0x2d, 0x35, 0xe9, 0x2b, 0x0e, 0xc6, 0xcb, 0x48, 0x7b,
+	0x5d, 0x55, 0xf6, 0xad, 0xaf, 0x5d, 0x7d, 0xdc, 0xff, 0x7a, 0xdc, 0x90, 0xc9, 0x57, 0x19, 0x67,
+	0xbb, 0xa6, 0xbc, 0xf2, 0xeb, 0xf8, 0x97, 0x4e, 0xc2, 0x0c, 0xb3, 0x60, 0xe7, 0x9a, 0xcd, 0x42,
+	0xac, 0x44, 0xc6, 0xc3, 0x64, 0xe9, 0xed, 0xd2, 0x66, 0xe5, 0xc6, 0x1b, 0xd7, 0xb6, 0x4b, 0x9b,
+	0x5b, 0x57, 0xb7, 0x4a, 0x57, 0x26, 0x87, 0xf8, 0x63, 0x10, 0xc7, 0xab, 0xd7, 0xe5, 0x77, 0x26,
+	0xb9, 0xc2, 0x6f, 0xe3, 0x10, 0x2d, 0x23, 0x8d, 0xbf, 0x09, 0x63, 0xce, 0x2f, 0x79, 0x69, 0xf7,
+	0xf5, 0x88, 0x39, 0xcf, 0x8b, 0x4b, 0x01, 0x00, 0x4a, 0xfc, 0x1e, 0xf0, 0x1e, 0xdf, 0xc7, 0x16,
+	0xbd, 0xd4, 0xdd, 0x38, 0x31, 0x17, 0x0e, 0x47, 0xbd, 0xdd, 0x82, 0x49, 0xd7, 0x47, 0xa8, 0x85,
+	0x00, 0x1b, 0x18, 0x25, 0xae, 0x84, 0x41, 0x51, 0x3f, 0x3a, 0x1c, 0xf7, 0xfa, 0x08, 0xb4, 0x14,
+	0x18, 0x2e, 0x01, 0x8a, 0xf9, 0x90, 0x40, 0xea, 0xb0, 0x06, 0x53, 0xee, 0xef, 0x33, 0x67, 0x03,
+	0x8a, 0x40, 0x60, 0xe2, 0x6a, 0x28, 0x18, 0x75, 0xd5, 0x86, 0x19, 0xef, 0x4b, 0xf7, 0xb9, 0x00,
+	0x3b, 0x1d, 0xa8, 0xb8, 0x16, 0x1a, 0x4a, 0xdd, 0x1e, 0xc0, 0x09, 0x9f, 0xcf, 0x22, 0xd9, 0x00,
+	0xb2, 0x1c, 0x58, 0xb1, 0x10, 0x1e, 0x4b, 0x3d, 0x7f, 0xcd, 0x41, 0x3a, 0xe8, 0x7e, 0xb8, 0x1e,
+	0xca, 0x2e, 0xab, 0x24, 0xbe, 0xd8, 0x87, 0x12, 0x8d, 0xea, 0x63, 0x0e, 0x66, 0xfd, 0x6f, 0x51,
+	0xab, 0xa1, 0x4c, 0xd3, 0x7e, 0xbb, 0x78, 0x24, 0x38, 0x8d, 0xe1, 0x2e, 0x07, 0xa9, 0x80, 0x0b,
+	0x4b, 0x48, 0xc2, 0x9d, 0x3a, 0x62, 0xf1, 0xe8, 0x3a, 0x34, 0xa4, 0xf7, 0x20, 0xd9, 0x75, 0xd4,
+	0x97, 0xbc, 0xac, 0xb1, 0x18, 0x31, 0x1b, 0x8c, 0xa1, 0x1e, 0x3e, 0x04, 0xc1, 0xf7, 0xa8, 0xba,
+	0xd2, 0xc3, 0x8e, 0x0b, 0x2d, 0x5e, 0x38, 0x0a, 0xda, 0x39, 0xc3, 0x5c, 0x07, 0x24, 0xcf, 0x19,
+	0xd6, 0x8d, 0x12, 0x57, 0xc2, 0xa0, 0xa8, 0x9f, 0x0d, 0x88, 0xe1, 0x53, 0x8c, 0xe0, 0xa5, 0x65,
+	0x4a, 0xc4, 0x8c, 0x9f, 0xc4, 0x69, 0x03, 0x6f, 0x35, 0x9e, 0x36, 0x4c, 0x89, 0x98, 0xf1, 0x93,
+	0x50, 0x1b, 0xd7, 0x01, 0x1c, 0xbb, 0x6a, 0xca, 0x0b, 0xdf, 0x91, 0x8b, 0x8b, 0xbd, 0xe5, 0xb6,
+	0x55, 0x71, 0xf8, 0x23, 0xf3, 0x42, 0xba, 0xf1, 0xf2, 0xa3, 0x27, 0x29, 0xee, 0xf1, 0x93, 0x14,
+	0xf7, 0xc7, 0x93, 0x14, 0x77, 0xf7, 0x69, 0x6a, 0xe8, 0xf1, 0xd3, 0xd4, 0xd0, 0xaf, 0x4f, 0x53,
+	0x43, 0xef, 0x2e, 0x68, 0x35, 0xe3, 0x76, 0x7b, 0x27, 0xb7, 0xab, 0xd7, 0xad, 0x7f, 0x9e, 0xe5,
+	0x1d, 0x1b, 0xee, 0x01, 0xd9, 0x72, 0x77, 0x46, 0xf0, 0x3d, 0x6d, 0xfd, 0xdf, 0x01, 0x00, 0xa5,
+	0x77, 0x0a, 0x49, 0xae, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1628,8 +1926,18 @@ type MsgClient interface {
 	UpdateGroupPolicyDecisionPolicy(ctx context.Context, in *MsgUpdateGroupPolicyDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdateGroupPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyAutoExecution updates a group policy automatic execution
+	// settings.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateGroupPolicyAutoExecution(ctx context.Context, in *MsgUpdateGroupPolicyAutoExecution, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyAutoExecutionResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
+	// SubmitParentVoteProposal submits a new internal proposal on a group policy
+	// to vote on a proposal of a parent group the group policy is a member of.
+	//
+	// Since: cosmos-sdk 0.48
+	SubmitParentVoteProposal(ctx context.Context, in *MsgSubmitParentVoteProposal, opts ...grpc.CallOption) (*MsgSubmitParentVoteProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
@@ -1729,6 +2037,15 @@ func (c *msgClient) UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdate
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyAutoExecution(ctx context.Context, in *MsgUpdateGroupPolicyAutoExecution, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyAutoExecutionResponse, error) {
+	out := new(MsgUpdateGroupPolicyAutoExecutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UpdateGroupPolicyAutoExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
 	out := new(MsgSubmitProposalResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitProposal", in, out, opts...)
@@ -1738,6 +2055,15 @@ func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, o
 	return out, nil
 }
 
+func (c *msgClient) SubmitParentVoteProposal(ctx context.Context, in *MsgSubmitParentVoteProposal, opts ...grpc.CallOption) (*MsgSubmitParentVoteProposalResponse, error) {
+	out := new(MsgSubmitParentVoteProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitParentVoteProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error) {
 	out := new(MsgWithdrawProposalResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/WithdrawProposal", in, out, opts...)
@@ -1794,8 +2120,18 @@ type MsgServer interface {
 	UpdateGroupPolicyDecisionPolicy(context.Context, *MsgUpdateGroupPolicyDecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyAutoExecution updates a group policy automatic execution
+	// settings.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateGroupPolicyAutoExecution(context.Context, *MsgUpdateGroupPolicyAutoExecution) (*MsgUpdateGroupPolicyAutoExecutionResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
+	// SubmitParentVoteProposal submits a new internal proposal on a group policy
+	// to vote on a proposal of a parent group the group policy is a member of.
+	//
+	// Since: cosmos-sdk 0.48
+	SubmitParentVoteProposal(context.Context, *MsgSubmitParentVoteProposal) (*MsgSubmitParentVoteProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
@@ -1837,9 +2173,15 @@ func (*UnimplementedMsgServer) UpdateGroupPolicyDecisionPolicy(ctx context.Conte
 func (*UnimplementedMsgServer) UpdateGroupPolicyMetadata(ctx context.Context, req *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyMetadata not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGroupPolicyAutoExecution(ctx context.Context, req *MsgUpdateGroupPolicyAutoExecution) (*MsgUpdateGroupPolicyAutoExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyAutoExecution not implemented")
+}
 func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
 }
+func (*UnimplementedMsgServer) SubmitParentVoteProposal(ctx context.Context, req *MsgSubmitParentVoteProposal) (*MsgSubmitParentVoteProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitParentVoteProposal not implemented")
+}
 func (*UnimplementedMsgServer) WithdrawProposal(ctx context.Context, req *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawProposal not implemented")
 }
@@ -2019,6 +2361,24 @@ func _Msg_UpdateGroupPolicyMetadata_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupPolicyAutoExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyAutoExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyAutoExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/UpdateGroupPolicyAutoExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyAutoExecution(ctx, req.(*MsgUpdateGroupPolicyAutoExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitProposal)
 	if err := dec(in); err != nil {
@@ -2037,6 +2397,24 @@ func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitParentVoteProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitParentVoteProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitParentVoteProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/SubmitParentVoteProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitParentVoteProposal(ctx, req.(*MsgSubmitParentVoteProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawProposal)
 	if err := dec(in); err != nil {
@@ -2149,10 +2527,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupPolicyMetadata",
 			Handler:    _Msg_UpdateGroupPolicyMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyAutoExecution",
+			Handler:    _Msg_UpdateGroupPolicyAutoExecution_Handler,
+		},
 		{
 			MethodName: "SubmitProposal",
 			Handler:    _Msg_SubmitProposal_Handler,
 		},
+		{
+			MethodName: "SubmitParentVoteProposal",
+			Handler:    _Msg_SubmitParentVoteProposal_Handler,
+		},
 		{
 			MethodName: "WithdrawProposal",
 			Handler:    _Msg_WithdrawProposal_Handler,
@@ -2860,7 +3246,7 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateGroupPolicyAutoExecution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2870,27 +3256,97 @@ func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupPolicyAutoExecution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateGroupPolicyAutoExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
-		i--
-		dAtA[i] = 0x3a
+	{
+		size, err := m.AutoExecution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -2967,6 +3423,116 @@ func (m *MsgSubmitProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitParentVoteProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitParentVoteProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitParentVoteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Exec != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.VoteMetadata) > 0 {
+		i -= len(m.VoteMetadata)
+		copy(dAtA[i:], m.VoteMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoteMetadata)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Option != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ParentProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ParentProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proposers[iNdEx])
+			copy(dAtA[i:], m.Proposers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proposers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitParentVoteProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitParentVoteProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitParentVoteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgWithdrawProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3533,6 +4099,34 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGroupPolicyAutoExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AutoExecution.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3585,6 +4179,62 @@ func (m *MsgSubmitProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitParentVoteProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proposers) > 0 {
+		for _, s := range m.Proposers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ParentProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ParentProposalId))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VoteMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Exec != 0 {
+		n += 1 + sovTx(uint64(m.Exec))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitParentVoteProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
 func (m *MsgWithdrawProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5652,7 +6302,7 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupPolicyAutoExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5675,15 +6325,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyAutoExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyAutoExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5711,11 +6361,11 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5743,13 +6393,13 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5759,16 +6409,213 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicyAutoExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyAutoExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyAutoExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
@@ -5984,6 +6831,374 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitParentVoteProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitParentVoteProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitParentVoteProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentProposalId", wireType)
+			}
+			m.ParentProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitParentVoteProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitParentVoteProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitParentVoteProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
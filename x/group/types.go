@@ -241,6 +241,67 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &TokenWeightedDecisionPolicy{}
+
+// NewTokenWeightedDecisionPolicy creates a new token weighted DecisionPolicy
+func NewTokenWeightedDecisionPolicy(weightProvider, denom, percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
+	return &TokenWeightedDecisionPolicy{weightProvider, denom, percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// ValidateBasic does basic validation on TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) ValidateBasic() error {
+	if p.WeightProvider == "" {
+		return errorsmod.Wrap(errors.ErrEmpty, "weight provider")
+	}
+
+	// the bank weight provider requires a denom, which other providers may ignore
+	if p.Denom != "" || p.WeightProvider == BankWeightProvider {
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return errorsmod.Wrap(err, "denom")
+		}
+	}
+
+	return p.percentagePolicy().ValidateBasic()
+}
+
+// Validate validates the policy against the group.
+func (p *TokenWeightedDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	return p.percentagePolicy().Validate(g, config)
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds
+// the percentage threshold of the snapshotted total weight before the timeout.
+func (p TokenWeightedDecisionPolicy) Allow(tally TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "total power")
+	}
+
+	// nobody held any tokens when the proposal was submitted, so it can never pass.
+	if totalPowerDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return p.percentagePolicy().Allow(tally, totalPower)
+}
+
+// percentagePolicy returns the PercentageDecisionPolicy applied to the
+// snapshotted weights.
+func (p TokenWeightedDecisionPolicy) percentagePolicy() *PercentageDecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: p.Percentage, Windows: p.Windows}
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -447,6 +508,102 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (s ProposalWeightSnapshot) PrimaryKeyFields() []interface{} {
+	return []interface{}{s.ProposalId}
+}
+
+var _ orm.Validateable = ProposalWeightSnapshot{}
+
+// ValidateBasic does basic validation on proposal weight snapshot.
+func (s ProposalWeightSnapshot) ValidateBasic() error {
+	if s.ProposalId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "snapshot's proposal id")
+	}
+
+	totalWeight, err := math.NewNonNegativeDecFromString(s.TotalWeight)
+	if err != nil {
+		return errorsmod.Wrap(err, "total weight")
+	}
+
+	sum := math.NewDecFromInt64(0)
+	seen := make(map[string]bool, len(s.Weights))
+	for _, w := range s.Weights {
+		if _, err := sdk.AccAddressFromBech32(w.Address); err != nil {
+			return errorsmod.Wrap(err, "member weight address")
+		}
+		if seen[w.Address] {
+			return errorsmod.Wrapf(errors.ErrDuplicate, "member weight address: %s", w.Address)
+		}
+		seen[w.Address] = true
+
+		weight, err := math.NewPositiveDecFromString(w.Weight)
+		if err != nil {
+			return errorsmod.Wrap(err, "member weight")
+		}
+		if sum, err = sum.Add(weight); err != nil {
+			return err
+		}
+	}
+
+	if sum.Cmp(totalWeight) != 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "total weight must be equal to the sum of members' weights")
+	}
+
+	return nil
+}
+
+// WeightsByAddress returns the snapshotted weights keyed by member address.
+func (s ProposalWeightSnapshot) WeightsByAddress() map[string]string {
+	weights := make(map[string]string, len(s.Weights))
+	for _, w := range s.Weights {
+		weights[w.Address] = w.Weight
+	}
+
+	return weights
+}
+
+// Validate validates the automatic execution settings against the group
+// module config.
+func (a AutoExecution) Validate(config Config) error {
+	if !a.Enabled {
+		return nil
+	}
+
+	if a.GasLimit == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "auto execution gas limit")
+	}
+
+	if a.GasLimit > config.MaxAutoExecutionGasLimit {
+		return errorsmod.Wrapf(errors.ErrMaxLimit, "auto execution gas limit: %d > %d", a.GasLimit, config.MaxAutoExecutionGasLimit)
+	}
+
+	if a.MaxRetries > config.MaxAutoExecutionRetries {
+		return errorsmod.Wrapf(errors.ErrMaxLimit, "auto execution max retries: %d > %d", a.MaxRetries, config.MaxAutoExecutionRetries)
+	}
+
+	return nil
+}
+
+func (l ProposalExecutionLog) PrimaryKeyFields() []interface{} {
+	return []interface{}{l.ProposalId, l.Attempt}
+}
+
+var _ orm.Validateable = ProposalExecutionLog{}
+
+// ValidateBasic does basic validation on proposal execution log.
+func (l ProposalExecutionLog) ValidateBasic() error {
+	if l.ProposalId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "execution log's proposal id")
+	}
+	if l.Attempt == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "execution log's attempt")
+	}
+	if l.Result != PROPOSAL_EXECUTOR_RESULT_SUCCESS && l.Result != PROPOSAL_EXECUTOR_RESULT_FAILURE {
+		return errorsmod.Wrap(errors.ErrInvalid, "execution log's result")
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
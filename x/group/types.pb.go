@@ -418,6 +418,90 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// TokenWeightedDecisionPolicy is a decision policy where the voting weight of
+// each group member is derived from its token holdings instead of its static
+// member weight. The weights are snapshotted when a proposal is submitted, and a
+// proposal passes when it satisfies the two following conditions:
+//  1. The percentage of all `YES` voters' snapshotted weights out of the
+//     snapshotted total weight is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+//
+// Since: cosmos-sdk 0.48
+type TokenWeightedDecisionPolicy struct {
+	// weight_provider is the name of the weight provider registered in the group
+	// keeper used to compute the members' voting weight, e.g. "bank" or "staking".
+	WeightProvider string `protobuf:"bytes,1,opt,name=weight_provider,json=weightProvider,proto3" json:"weight_provider,omitempty"`
+	// denom is the denomination of the token holdings used as voting weight.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// percentage is the minimum percentage of the weighted sum of `YES` votes must
+	// meet for a proposal to succeed.
+	Percentage string `protobuf:"bytes,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *TokenWeightedDecisionPolicy) Reset()         { *m = TokenWeightedDecisionPolicy{} }
+func (m *TokenWeightedDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*TokenWeightedDecisionPolicy) ProtoMessage()    {}
+func (*TokenWeightedDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenWeightedDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.Merge(m, src)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenWeightedDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenWeightedDecisionPolicy proto.InternalMessageInfo
+
+func (m *TokenWeightedDecisionPolicy) GetWeightProvider() string {
+	if m != nil {
+		return m.WeightProvider
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -441,7 +525,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +591,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +674,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,13 +734,18 @@ type GroupPolicyInfo struct {
 	DecisionPolicy *types.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
 	// created_at is a timestamp specifying when a group policy was created.
 	CreatedAt time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// auto_execution specifies whether accepted proposals are automatically
+	// executed at the end of their voting period.
+	//
+	// Since: cosmos-sdk 0.48
+	AutoExecution *AutoExecution `protobuf:"bytes,8,opt,name=auto_execution,json=autoExecution,proto3" json:"auto_execution,omitempty"`
 }
 
 func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,6 +774,75 @@ func (m *GroupPolicyInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupPolicyInfo proto.InternalMessageInfo
 
+// AutoExecution defines the automatic execution settings of a group policy.
+// When enabled, accepted proposals are executed in the EndBlocker once their
+// voting period and min execution period have passed.
+//
+// Since: cosmos-sdk 0.48
+type AutoExecution struct {
+	// enabled defines whether accepted proposals are automatically executed.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// gas_limit is the maximum amount of gas an execution attempt can consume.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// max_retries is the number of times a failed execution is retried, in the
+	// following blocks, before giving up.
+	MaxRetries uint32 `protobuf:"varint,3,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+}
+
+func (m *AutoExecution) Reset()         { *m = AutoExecution{} }
+func (m *AutoExecution) String() string { return proto.CompactTextString(m) }
+func (*AutoExecution) ProtoMessage()    {}
+func (*AutoExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+}
+func (m *AutoExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoExecution.Merge(m, src)
+}
+func (m *AutoExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoExecution proto.InternalMessageInfo
+
+func (m *AutoExecution) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *AutoExecution) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *AutoExecution) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
 // Proposal defines a group proposal. Any member of a group can submit a proposal
 // for a group policy to decide upon.
 // A proposal consists of a set of `sdk.Msg`s that will be executed if the proposal
@@ -739,7 +897,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +942,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +989,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -895,173 +1053,538 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
-	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
-	proto.RegisterType((*Member)(nil), "cosmos.group.v1.Member")
-	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
-	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
-	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
-	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
-	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
-	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
-	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
-	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
-	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
-	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+// ProposalWeightSnapshot represents the members' voting weights of a proposal
+// snapshotted at submission time, used by decision policies which do not rely on
+// static member weights.
+//
+// Since: cosmos-sdk 0.48
+type ProposalWeightSnapshot struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// total_weight is the sum of the snapshotted members' weights.
+	TotalWeight string `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	// weights is the list of snapshotted members' weights. Members without any
+	// weight are omitted.
+	Weights []MemberWeight `protobuf:"bytes,3,rep,name=weights,proto3" json:"weights"`
 }
 
-func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
+func (m *ProposalWeightSnapshot) Reset()         { *m = ProposalWeightSnapshot{} }
+func (m *ProposalWeightSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ProposalWeightSnapshot) ProtoMessage()    {}
+func (*ProposalWeightSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{13}
+}
+func (m *ProposalWeightSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalWeightSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalWeightSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalWeightSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalWeightSnapshot.Merge(m, src)
+}
+func (m *ProposalWeightSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalWeightSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalWeightSnapshot.DiscardUnknown(m)
+}
 
-var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0x92, 0x14, 0x1f, 0x87, 0x12, 0x49, 0x8f, 0x75, 0xad, 0x95, 0xe4, 0x4b, 0xea, 0xd2,
-	0xc6, 0xbd, 0x82, 0x2e, 0x4c, 0xda, 0x32, 0x90, 0x00, 0x2e, 0x82, 0x90, 0xd4, 0x3a, 0xa6, 0x60,
-	0x93, 0xc4, 0x72, 0x29, 0xc5, 0x6e, 0x16, 0x2b, 0xee, 0x98, 0x5a, 0x84, 0xbb, 0xc3, 0xec, 0x0e,
-	0x25, 0xf3, 0x1f, 0x18, 0x69, 0xe2, 0x32, 0x4d, 0x00, 0x03, 0x69, 0x52, 0xba, 0x30, 0x52, 0xa4,
-	0x0c, 0x52, 0x18, 0x29, 0x02, 0x23, 0x55, 0xaa, 0x24, 0xb0, 0x0b, 0xa7, 0x4a, 0x95, 0x36, 0x41,
-	0xb0, 0x33, 0xb3, 0x14, 0x1f, 0x12, 0x1d, 0x19, 0x46, 0x1a, 0x41, 0x33, 0xdf, 0x77, 0xce, 0x9c,
-	0xef, 0xbc, 0x48, 0xc2, 0x5a, 0x9b, 0x78, 0x36, 0xf1, 0x8a, 0x1d, 0x97, 0xf4, 0x7b, 0xc5, 0xc3,
-	0x6b, 0x45, 0x3a, 0xe8, 0x61, 0xaf, 0xd0, 0x73, 0x09, 0x25, 0x28, 0xcd, 0xc1, 0x02, 0x03, 0x0b,
-	0x87, 0xd7, 0x56, 0x97, 0x3a, 0xa4, 0x43, 0x18, 0x56, 0xf4, 0xff, 0xe3, 0xb4, 0xd5, 0x6c, 0x87,
-	0x90, 0x4e, 0x17, 0x17, 0xd9, 0x69, 0xbf, 0x7f, 0xbf, 0x68, 0xf6, 0x5d, 0x83, 0x5a, 0xc4, 0x11,
-	0x78, 0x6e, 0x12, 0xa7, 0x96, 0x8d, 0x3d, 0x6a, 0xd8, 0x3d, 0x41, 0x58, 0xe1, 0xef, 0xe8, 0xdc,
-	0xb3, 0x78, 0x54, 0x40, 0x93, 0xb6, 0x86, 0x33, 0x10, 0xd0, 0x39, 0xc3, 0xb6, 0x1c, 0x52, 0x64,
-	0x7f, 0xf9, 0x55, 0xfe, 0x2b, 0x09, 0xa2, 0x77, 0xb0, 0xbd, 0x8f, 0x5d, 0xb4, 0x05, 0x31, 0xc3,
-	0x34, 0x5d, 0xec, 0x79, 0xb2, 0xb4, 0x2e, 0x6d, 0x24, 0xca, 0xf2, 0x0f, 0x4f, 0xaf, 0x2c, 0x09,
-	0xdf, 0x25, 0x8e, 0x34, 0xa9, 0x6b, 0x39, 0x1d, 0x35, 0x20, 0xa2, 0x0b, 0x10, 0x3d, 0xc2, 0x56,
-	0xe7, 0x80, 0xca, 0x21, 0xdf, 0x44, 0x15, 0x27, 0xb4, 0x0a, 0x71, 0x1b, 0x53, 0xc3, 0x34, 0xa8,
-	0x21, 0x87, 0x19, 0x32, 0x3c, 0xa3, 0x6d, 0x88, 0x1b, 0xa6, 0x89, 0x4d, 0xdd, 0xa0, 0x72, 0x64,
-	0x5d, 0xda, 0x48, 0x6e, 0xad, 0x16, 0x78, 0xcc, 0x85, 0x20, 0xe6, 0x82, 0x16, 0xe8, 0x2d, 0x2f,
-	0x3e, 0xfb, 0x29, 0x37, 0xf7, 0xe8, 0xe7, 0x9c, 0xf4, 0xe5, 0xab, 0x27, 0x9b, 0x12, 0x7b, 0x19,
-	0x9b, 0x25, 0x9a, 0x3f, 0x82, 0x45, 0x1e, 0xb7, 0x8a, 0x3f, 0xee, 0x63, 0x8f, 0xfe, 0x53, 0xe1,
-	0xe7, 0xbf, 0x95, 0x60, 0x59, 0x3b, 0x70, 0xb1, 0x77, 0x40, 0xba, 0xe6, 0x36, 0x6e, 0x5b, 0x9e,
-	0x45, 0x9c, 0x06, 0xe9, 0x5a, 0xed, 0x01, 0xba, 0x08, 0x09, 0x1a, 0x40, 0x3c, 0x0a, 0xf5, 0xf8,
-	0x02, 0xbd, 0x0f, 0xb1, 0x23, 0xcb, 0x31, 0xc9, 0x91, 0xc7, 0x9e, 0x4b, 0x6e, 0xfd, 0xb7, 0x30,
-	0xd1, 0x2e, 0x85, 0x71, 0x7f, 0x7b, 0x9c, 0xad, 0x06, 0x66, 0x37, 0xaa, 0xdf, 0x3d, 0xbd, 0x92,
-	0x9d, 0x6d, 0xf3, 0xc9, 0xab, 0x27, 0x9b, 0x79, 0x4e, 0xb9, 0xe2, 0x99, 0x1f, 0x15, 0x4f, 0x09,
-	0x35, 0xff, 0x4c, 0x02, 0xb9, 0x81, 0xdd, 0x36, 0x76, 0xa8, 0xd1, 0xc1, 0x13, 0x3a, 0xb2, 0x00,
-	0xbd, 0x21, 0x26, 0x84, 0x8c, 0xdc, 0xbc, 0x05, 0x25, 0x3b, 0x7f, 0x4f, 0xc9, 0xa5, 0x11, 0x25,
-	0xa7, 0x45, 0x9b, 0xff, 0x46, 0x82, 0x7f, 0x9d, 0xf8, 0x1c, 0xba, 0x03, 0x8b, 0x87, 0x84, 0x5a,
-	0x4e, 0x47, 0xef, 0x61, 0xd7, 0x22, 0xbc, 0x26, 0xc9, 0xad, 0x95, 0xa9, 0x7e, 0xdb, 0x16, 0xf3,
-	0xc7, 0xdb, 0xed, 0xb3, 0x61, 0xbb, 0x2d, 0x70, 0xf3, 0x06, 0xb3, 0x46, 0xf7, 0x60, 0xc9, 0xb6,
-	0x1c, 0x1d, 0x3f, 0xc0, 0xed, 0xbe, 0xcf, 0x0e, 0xbc, 0x86, 0xce, 0xe8, 0x15, 0xd9, 0x96, 0xa3,
-	0x04, 0x4e, 0xb8, 0xef, 0xfc, 0x6f, 0x12, 0x24, 0x3e, 0xf0, 0x13, 0x51, 0x75, 0xee, 0x13, 0x94,
-	0x82, 0x90, 0xc5, 0xa3, 0x8d, 0xa8, 0x21, 0xcb, 0x44, 0x05, 0x98, 0x37, 0x4c, 0xdb, 0x72, 0x78,
-	0x9f, 0xce, 0x68, 0x6d, 0x4e, 0x9b, 0x39, 0x7f, 0x32, 0xc4, 0x0e, 0xb1, 0xeb, 0x27, 0x8b, 0x8d,
-	0x5f, 0x44, 0x0d, 0x8e, 0xe8, 0x3f, 0xb0, 0x40, 0x09, 0x35, 0xba, 0xba, 0x18, 0x8a, 0x79, 0x66,
-	0x99, 0x64, 0x77, 0x7b, 0x7c, 0x32, 0x6e, 0x01, 0xb4, 0x5d, 0x6c, 0x50, 0x3e, 0xbe, 0xd1, 0xb3,
-	0x8e, 0x6f, 0x42, 0x18, 0x97, 0x68, 0xfe, 0x2e, 0x24, 0x99, 0x5e, 0xb1, 0x7d, 0x56, 0x20, 0xce,
-	0xfa, 0x40, 0x1f, 0xea, 0x8e, 0xb1, 0x73, 0xd5, 0x44, 0x45, 0x88, 0xda, 0x8c, 0x24, 0x12, 0xbd,
-	0x3c, 0xd5, 0x6c, 0x62, 0x13, 0x08, 0x5a, 0xfe, 0x8f, 0x10, 0xa4, 0x99, 0x6f, 0xde, 0x0d, 0x2c,
-	0xa3, 0x6f, 0xb2, 0x1e, 0x46, 0x63, 0x0a, 0x8d, 0xc7, 0x34, 0x2c, 0x48, 0xf8, 0xec, 0x05, 0x89,
-	0x9c, 0x5e, 0x90, 0xf9, 0xf1, 0x82, 0x18, 0x90, 0x36, 0x45, 0x63, 0xeb, 0x3d, 0xa6, 0x45, 0xa4,
-	0x7c, 0x69, 0x2a, 0xe5, 0x25, 0x67, 0x50, 0xce, 0xbf, 0x7e, 0xa8, 0xd4, 0x94, 0x39, 0x3e, 0xea,
-	0xe3, 0x05, 0x8d, 0xbd, 0x79, 0x41, 0x6f, 0xc4, 0x1f, 0x3e, 0xce, 0xcd, 0xfd, 0xfa, 0x38, 0x27,
-	0xe5, 0xff, 0x9c, 0x87, 0x78, 0xc3, 0x25, 0x3d, 0xe2, 0x19, 0xdd, 0xa9, 0x56, 0xde, 0x81, 0x25,
-	0x9e, 0x54, 0x2e, 0x48, 0x0f, 0xaa, 0xf2, 0xba, 0xce, 0x46, 0x9d, 0xe3, 0x8a, 0x0a, 0x64, 0x66,
-	0x9b, 0xbf, 0x03, 0x89, 0x1e, 0x8b, 0x01, 0xbb, 0x9e, 0x1c, 0x59, 0x0f, 0xcf, 0x74, 0x7e, 0x4c,
-	0x45, 0x3b, 0x90, 0xf4, 0xfa, 0xfb, 0xb6, 0x45, 0x75, 0xff, 0x43, 0x97, 0x55, 0xe4, 0x4c, 0x19,
-	0x01, 0x6e, 0xed, 0xe3, 0xe8, 0x12, 0x2c, 0x72, 0xad, 0x41, 0x7d, 0xa3, 0x2c, 0x0d, 0x0b, 0xec,
-	0x72, 0x57, 0x14, 0xf9, 0xea, 0x44, 0x42, 0x02, 0x6e, 0x8c, 0x71, 0x47, 0x65, 0x07, 0x16, 0xef,
-	0x42, 0xd4, 0xa3, 0x06, 0xed, 0x7b, 0x72, 0x7c, 0x5d, 0xda, 0x48, 0x6d, 0xe5, 0xa6, 0x06, 0x22,
-	0xc8, 0x7e, 0x93, 0xd1, 0x54, 0x41, 0x47, 0x2d, 0x40, 0xf7, 0x2d, 0xc7, 0xe8, 0xea, 0xd4, 0xe8,
-	0x76, 0x07, 0xba, 0x8b, 0xbd, 0x7e, 0x97, 0xca, 0x09, 0x26, 0xf1, 0xe2, 0x94, 0x13, 0xcd, 0x27,
-	0xa9, 0x8c, 0x53, 0x4e, 0xf8, 0x22, 0xb9, 0xc0, 0x0c, 0x73, 0x31, 0x02, 0xa2, 0x16, 0x9c, 0x1b,
-	0x5b, 0xb3, 0x3a, 0x76, 0x4c, 0x19, 0xce, 0x9a, 0xb8, 0xf4, 0xe8, 0xae, 0x55, 0x1c, 0x13, 0x35,
-	0x20, 0xcd, 0x57, 0x2d, 0x71, 0x83, 0x50, 0x93, 0x4c, 0xef, 0xff, 0x4e, 0xd5, 0xab, 0x08, 0x3e,
-	0x0f, 0x4c, 0x4d, 0xe1, 0xb1, 0x33, 0xba, 0xea, 0xf7, 0x8b, 0xe7, 0x19, 0x1d, 0xec, 0xc9, 0x0b,
-	0xeb, 0xe1, 0xd3, 0x06, 0x49, 0x1d, 0xb2, 0xd0, 0x12, 0xcc, 0x53, 0x8b, 0x76, 0xb1, 0xbc, 0xc8,
-	0xda, 0x8b, 0x1f, 0xfc, 0x89, 0xf5, 0xfa, 0xb6, 0x6d, 0xb8, 0x03, 0x39, 0xc5, 0xee, 0x83, 0xe3,
-	0x8d, 0x88, 0x3f, 0x04, 0xf9, 0xcf, 0x25, 0x48, 0x8e, 0x26, 0x68, 0x0d, 0x12, 0x03, 0xec, 0xe9,
-	0x6d, 0xd2, 0x77, 0xa8, 0xf8, 0x38, 0x8d, 0x0f, 0xb0, 0x57, 0xf1, 0xcf, 0x7e, 0x93, 0x18, 0xfb,
-	0x1e, 0x35, 0x2c, 0x47, 0x10, 0xf8, 0x77, 0x91, 0x05, 0x71, 0xc9, 0x49, 0x2b, 0x10, 0x77, 0x88,
-	0xc0, 0x79, 0xa7, 0xc7, 0x1c, 0xc2, 0xa1, 0xff, 0x03, 0x72, 0x88, 0x7e, 0x64, 0xd1, 0x03, 0xfd,
-	0x10, 0xd3, 0x80, 0xc4, 0x97, 0x4c, 0xda, 0x21, 0x7b, 0x16, 0x3d, 0xd8, 0xc5, 0x94, 0x93, 0x45,
-	0x7c, 0xbf, 0x4b, 0x10, 0xd9, 0x25, 0x14, 0xa3, 0x1c, 0x24, 0x7b, 0x22, 0x75, 0xc7, 0x8b, 0x17,
-	0x82, 0x2b, 0xbe, 0xe7, 0x0e, 0x09, 0x15, 0xab, 0x77, 0xe6, 0x9e, 0x63, 0x34, 0x74, 0x1d, 0xa2,
-	0xa4, 0xe7, 0x7f, 0xac, 0xb1, 0x28, 0x53, 0x5b, 0x6b, 0x53, 0xa5, 0xf2, 0xdf, 0xad, 0x33, 0x8a,
-	0x2a, 0xa8, 0x33, 0x97, 0xe3, 0x5b, 0x1c, 0xc7, 0xcd, 0x4f, 0x25, 0x80, 0xe3, 0xe7, 0xd1, 0x1a,
-	0x2c, 0xef, 0xd6, 0x35, 0x45, 0xaf, 0x37, 0xb4, 0x6a, 0xbd, 0xa6, 0xb7, 0x6a, 0xcd, 0x86, 0x52,
-	0xa9, 0xde, 0xac, 0x2a, 0xdb, 0x99, 0x39, 0x74, 0x1e, 0xd2, 0xa3, 0xe0, 0x5d, 0xa5, 0x99, 0x91,
-	0xd0, 0x32, 0x9c, 0x1f, 0xbd, 0x2c, 0x95, 0x9b, 0x5a, 0xa9, 0x5a, 0xcb, 0x84, 0x10, 0x82, 0xd4,
-	0x28, 0x50, 0xab, 0x67, 0xc2, 0xe8, 0x22, 0xc8, 0xe3, 0x77, 0xfa, 0x5e, 0x55, 0xbb, 0xa5, 0xef,
-	0x2a, 0x5a, 0x3d, 0x13, 0x59, 0x8d, 0x3c, 0xfc, 0x22, 0x3b, 0xb7, 0xf9, 0xbd, 0x04, 0xa9, 0xf1,
-	0x59, 0x45, 0x39, 0x58, 0x6b, 0xa8, 0xf5, 0x46, 0xbd, 0x59, 0xba, 0xad, 0x37, 0xb5, 0x92, 0xd6,
-	0x6a, 0x4e, 0x44, 0xf6, 0x6f, 0x58, 0x99, 0x24, 0x34, 0x5b, 0xe5, 0x3b, 0x55, 0x4d, 0x53, 0xb6,
-	0x33, 0x92, 0xff, 0xec, 0x24, 0x5c, 0xaa, 0x54, 0x94, 0x86, 0x8f, 0x86, 0x4e, 0x42, 0x55, 0x65,
-	0x47, 0xa9, 0xf8, 0x68, 0xd8, 0xcf, 0xc8, 0x94, 0x6d, 0xb9, 0xae, 0xfa, 0x60, 0xe4, 0xa4, 0x77,
-	0x7d, 0x41, 0xdb, 0x6a, 0x69, 0xaf, 0x96, 0x99, 0x17, 0x82, 0xbe, 0x96, 0xe0, 0xc2, 0xc9, 0xc3,
-	0x88, 0x36, 0xe0, 0xf2, 0xd0, 0x5e, 0xf9, 0x50, 0xa9, 0xb4, 0xb4, 0xba, 0xaa, 0xab, 0x4a, 0xb3,
-	0x75, 0x5b, 0x9b, 0x50, 0x78, 0x19, 0xd6, 0x4f, 0x65, 0xd6, 0xea, 0x9a, 0xae, 0xb6, 0x6a, 0x19,
-	0x69, 0x26, 0xab, 0xd9, 0xaa, 0x54, 0x94, 0x66, 0x33, 0x13, 0x9a, 0xc9, 0xba, 0x59, 0xaa, 0xde,
-	0x6e, 0xa9, 0x4a, 0x26, 0xcc, 0x83, 0x2f, 0xbf, 0xf7, 0xec, 0x45, 0x56, 0x7a, 0xfe, 0x22, 0x2b,
-	0xfd, 0xf2, 0x22, 0x2b, 0x3d, 0x7a, 0x99, 0x9d, 0x7b, 0xfe, 0x32, 0x3b, 0xf7, 0xe3, 0xcb, 0xec,
-	0xdc, 0xbd, 0xcb, 0x1d, 0x8b, 0x1e, 0xf4, 0xf7, 0x0b, 0x6d, 0x62, 0x8b, 0x5f, 0x5b, 0xc5, 0x91,
-	0x6f, 0xa6, 0x0f, 0xf8, 0x8f, 0xc1, 0xfd, 0x28, 0x6b, 0xc7, 0xeb, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x98, 0xe9, 0x54, 0xdb, 0x23, 0x0e, 0x00, 0x00,
+var xxx_messageInfo_ProposalWeightSnapshot proto.InternalMessageInfo
+
+func (m *ProposalWeightSnapshot) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
 }
 
-func (this *GroupPolicyInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ProposalWeightSnapshot) GetTotalWeight() string {
+	if m != nil {
+		return m.TotalWeight
 	}
+	return ""
+}
 
-	that1, ok := that.(*GroupPolicyInfo)
-	if !ok {
-		that2, ok := that.(GroupPolicyInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ProposalWeightSnapshot) GetWeights() []MemberWeight {
+	if m != nil {
+		return m.Weights
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+// MemberWeight represents the snapshotted voting weight of a group member.
+//
+// Since: cosmos-sdk 0.48
+type MemberWeight struct {
+	// address is the member's account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the member's snapshotted voting weight.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *MemberWeight) Reset()         { *m = MemberWeight{} }
+func (m *MemberWeight) String() string { return proto.CompactTextString(m) }
+func (*MemberWeight) ProtoMessage()    {}
+func (*MemberWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{14}
+}
+func (m *MemberWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Address != that1.Address {
-		return false
+}
+func (m *MemberWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberWeight.Merge(m, src)
+}
+func (m *MemberWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberWeight proto.InternalMessageInfo
+
+func (m *MemberWeight) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if this.GroupId != that1.GroupId {
-		return false
+	return ""
+}
+
+func (m *MemberWeight) GetWeight() string {
+	if m != nil {
+		return m.Weight
 	}
-	if this.Admin != that1.Admin {
-		return false
+	return ""
+}
+
+// ProposalExecutionLog records an automatic execution attempt of a proposal.
+//
+// Since: cosmos-sdk 0.48
+type ProposalExecutionLog struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// attempt is the execution attempt number, starting at 1.
+	Attempt uint64 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// height is the block height at which the execution was attempted.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the execution was attempted.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// result is the result of the execution attempt.
+	Result ProposalExecutorResult `protobuf:"varint,5,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+	// gas_used is the amount of gas consumed by the execution attempt.
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// log contains the error of a failed execution attempt.
+	Log string `protobuf:"bytes,7,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *ProposalExecutionLog) Reset()         { *m = ProposalExecutionLog{} }
+func (m *ProposalExecutionLog) String() string { return proto.CompactTextString(m) }
+func (*ProposalExecutionLog) ProtoMessage()    {}
+func (*ProposalExecutionLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{15}
+}
+func (m *ProposalExecutionLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalExecutionLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalExecutionLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Metadata != that1.Metadata {
-		return false
+}
+func (m *ProposalExecutionLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalExecutionLog.Merge(m, src)
+}
+func (m *ProposalExecutionLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalExecutionLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalExecutionLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalExecutionLog proto.InternalMessageInfo
+
+func (m *ProposalExecutionLog) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if this.Version != that1.Version {
-		return false
+	return 0
+}
+
+func (m *ProposalExecutionLog) GetAttempt() uint64 {
+	if m != nil {
+		return m.Attempt
 	}
-	if !this.DecisionPolicy.Equal(that1.DecisionPolicy) {
-		return false
+	return 0
+}
+
+func (m *ProposalExecutionLog) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
-		return false
+	return 0
+}
+
+func (m *ProposalExecutionLog) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return true
+	return time.Time{}
 }
-func (m *Member) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ProposalExecutionLog) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
 	}
-	return dAtA[:n], nil
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
 }
 
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProposalExecutionLog) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
 }
 
-func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProposalExecutionLog) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
+}
+
+// GroupHierarchyEdge represents a link between two groups of a hierarchy: a
+// group policy of the child group is a member of the parent group.
+//
+// Since: cosmos-sdk 0.48
+type GroupHierarchyEdge struct {
+	// parent_group_id is the unique ID of the parent group.
+	ParentGroupId uint64 `protobuf:"varint,1,opt,name=parent_group_id,json=parentGroupId,proto3" json:"parent_group_id,omitempty"`
+	// child_group_id is the unique ID of the child group.
+	ChildGroupId uint64 `protobuf:"varint,2,opt,name=child_group_id,json=childGroupId,proto3" json:"child_group_id,omitempty"`
+	// group_policy_address is the account address of the child group's policy
+	// which is a member of the parent group.
+	GroupPolicyAddress string `protobuf:"bytes,3,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// depth is the distance between the queried group and the edge, starting at 1.
+	Depth uint32 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *GroupHierarchyEdge) Reset()         { *m = GroupHierarchyEdge{} }
+func (m *GroupHierarchyEdge) String() string { return proto.CompactTextString(m) }
+func (*GroupHierarchyEdge) ProtoMessage()    {}
+func (*GroupHierarchyEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{16}
+}
+func (m *GroupHierarchyEdge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupHierarchyEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupHierarchyEdge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupHierarchyEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupHierarchyEdge.Merge(m, src)
+}
+func (m *GroupHierarchyEdge) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupHierarchyEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupHierarchyEdge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupHierarchyEdge proto.InternalMessageInfo
+
+func (m *GroupHierarchyEdge) GetParentGroupId() uint64 {
+	if m != nil {
+		return m.ParentGroupId
+	}
+	return 0
+}
+
+func (m *GroupHierarchyEdge) GetChildGroupId() uint64 {
+	if m != nil {
+		return m.ChildGroupId
+	}
+	return 0
+}
+
+func (m *GroupHierarchyEdge) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *GroupHierarchyEdge) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
+	proto.RegisterType((*Member)(nil), "cosmos.group.v1.Member")
+	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
+	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
+	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*TokenWeightedDecisionPolicy)(nil), "cosmos.group.v1.TokenWeightedDecisionPolicy")
+	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
+	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
+	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
+	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
+	proto.RegisterType((*AutoExecution)(nil), "cosmos.group.v1.AutoExecution")
+	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
+	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
+	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*ProposalWeightSnapshot)(nil), "cosmos.group.v1.ProposalWeightSnapshot")
+	proto.RegisterType((*MemberWeight)(nil), "cosmos.group.v1.MemberWeight")
+	proto.RegisterType((*ProposalExecutionLog)(nil), "cosmos.group.v1.ProposalExecutionLog")
+	proto.RegisterType((*GroupHierarchyEdge)(nil), "cosmos.group.v1.GroupHierarchyEdge")
+}
+
+func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
+
+var fileDescriptor_f5bddd15d7a54a9d = []byte{
+	// 1708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x14, 0x3f, 0x1e, 0xc5, 0x8f, 0x4c, 0xd4, 0x78, 0x25, 0x39, 0x94, 0xcb, 0x18,
+	0x8e, 0xe1, 0xc2, 0x64, 0xa2, 0x00, 0x2d, 0x60, 0xa0, 0x1f, 0xa4, 0xb4, 0x89, 0x69, 0xc8, 0x22,
+	0xb1, 0x24, 0xa5, 0xc6, 0x97, 0xc5, 0x88, 0x3b, 0x5e, 0x2e, 0xc2, 0xdd, 0x61, 0x77, 0x87, 0x92,
+	0xf9, 0x1f, 0x04, 0x05, 0x8a, 0xe6, 0x54, 0xf4, 0x52, 0xc0, 0x40, 0x2f, 0x3d, 0xe6, 0x10, 0xf4,
+	0xd0, 0x63, 0x91, 0x83, 0xd1, 0x43, 0x11, 0xf4, 0xd4, 0x53, 0x5b, 0xd8, 0x87, 0xf4, 0xd4, 0x53,
+	0xcf, 0x45, 0x31, 0x1f, 0x4b, 0xf1, 0xc3, 0xa4, 0x2c, 0xc3, 0xc8, 0x45, 0xd0, 0x7b, 0xef, 0xf7,
+	0x66, 0xde, 0xf7, 0x9b, 0x25, 0xec, 0xf4, 0x68, 0xe8, 0xd1, 0xb0, 0xea, 0x04, 0x74, 0x34, 0xac,
+	0x9e, 0x7d, 0x58, 0x65, 0xe3, 0x21, 0x09, 0x2b, 0xc3, 0x80, 0x32, 0x8a, 0x0a, 0x52, 0x58, 0x11,
+	0xc2, 0xca, 0xd9, 0x87, 0xdb, 0x9b, 0x0e, 0x75, 0xa8, 0x90, 0x55, 0xf9, 0x7f, 0x12, 0xb6, 0x5d,
+	0x72, 0x28, 0x75, 0x06, 0xa4, 0x2a, 0xa8, 0xd3, 0xd1, 0xe3, 0xaa, 0x3d, 0x0a, 0x30, 0x73, 0xa9,
+	0xaf, 0xe4, 0xbb, 0xf3, 0x72, 0xe6, 0x7a, 0x24, 0x64, 0xd8, 0x1b, 0x2a, 0xc0, 0x96, 0xbc, 0xc7,
+	0x92, 0x27, 0xab, 0x4b, 0x95, 0x68, 0x5e, 0x17, 0xfb, 0x63, 0x25, 0x7a, 0x0b, 0x7b, 0xae, 0x4f,
+	0xab, 0xe2, 0xaf, 0x64, 0x95, 0xff, 0xa8, 0x41, 0xf2, 0x21, 0xf1, 0x4e, 0x49, 0x80, 0xf6, 0x20,
+	0x85, 0x6d, 0x3b, 0x20, 0x61, 0xa8, 0x6b, 0x37, 0xb4, 0xdb, 0x99, 0xba, 0xfe, 0xb7, 0xaf, 0xee,
+	0x6e, 0xaa, 0xb3, 0x6b, 0x52, 0xd2, 0x66, 0x81, 0xeb, 0x3b, 0x66, 0x04, 0x44, 0xef, 0x40, 0xf2,
+	0x9c, 0xb8, 0x4e, 0x9f, 0xe9, 0x31, 0xae, 0x62, 0x2a, 0x0a, 0x6d, 0x43, 0xda, 0x23, 0x0c, 0xdb,
+	0x98, 0x61, 0x3d, 0x2e, 0x24, 0x13, 0x1a, 0x1d, 0x40, 0x1a, 0xdb, 0x36, 0xb1, 0x2d, 0xcc, 0xf4,
+	0xc4, 0x0d, 0xed, 0x76, 0x76, 0x6f, 0xbb, 0x22, 0x6d, 0xae, 0x44, 0x36, 0x57, 0x3a, 0x91, 0xbf,
+	0xf5, 0xdc, 0xb3, 0x7f, 0xec, 0xae, 0x7d, 0xf1, 0xcf, 0x5d, 0xed, 0x0f, 0xdf, 0x7e, 0x79, 0x47,
+	0x13, 0x37, 0x13, 0xbb, 0xc6, 0xca, 0xe7, 0x90, 0x93, 0x76, 0x9b, 0xe4, 0x17, 0x23, 0x12, 0xb2,
+	0xef, 0xca, 0xfc, 0xf2, 0xd7, 0x1a, 0x5c, 0xeb, 0xf4, 0x03, 0x12, 0xf6, 0xe9, 0xc0, 0x3e, 0x20,
+	0x3d, 0x37, 0x74, 0xa9, 0xdf, 0xa2, 0x03, 0xb7, 0x37, 0x46, 0xd7, 0x21, 0xc3, 0x22, 0x91, 0xb4,
+	0xc2, 0xbc, 0x60, 0xa0, 0x9f, 0x41, 0xea, 0xdc, 0xf5, 0x6d, 0x7a, 0x1e, 0x8a, 0xeb, 0xb2, 0x7b,
+	0xb7, 0x2a, 0x73, 0xe5, 0x52, 0x99, 0x3d, 0xef, 0x44, 0xa2, 0xcd, 0x48, 0xed, 0x5e, 0xe3, 0x2f,
+	0x5f, 0xdd, 0x2d, 0xad, 0xd6, 0xf9, 0xe5, 0xb7, 0x5f, 0xde, 0x29, 0x4b, 0xc8, 0xdd, 0xd0, 0xfe,
+	0xac, 0xba, 0xc4, 0xd4, 0xf2, 0x33, 0x0d, 0xf4, 0x16, 0x09, 0x7a, 0xc4, 0x67, 0xd8, 0x21, 0x73,
+	0x7e, 0x94, 0x00, 0x86, 0x13, 0x99, 0x72, 0x64, 0x8a, 0xf3, 0x06, 0x3c, 0x79, 0xf0, 0x6a, 0x9e,
+	0xbc, 0x37, 0xe5, 0xc9, 0x32, 0x6b, 0xcb, 0xbf, 0x8a, 0xc1, 0x4e, 0x87, 0x7e, 0x46, 0xfc, 0x13,
+	0x91, 0x3d, 0x32, 0x9f, 0x95, 0xf7, 0xa1, 0x20, 0xf3, 0xca, 0xdb, 0xe5, 0xcc, 0xb5, 0x49, 0xa0,
+	0x5c, 0xca, 0x4b, 0x76, 0x4b, 0x71, 0xd1, 0x26, 0xac, 0xdb, 0xc4, 0xa7, 0x9e, 0xaa, 0x06, 0x49,
+	0xcc, 0x05, 0x23, 0xbe, 0x2a, 0x18, 0x89, 0xd7, 0x0b, 0xc6, 0xc3, 0x57, 0x0b, 0xc6, 0xad, 0xe9,
+	0xb4, 0x2e, 0xf7, 0xb7, 0xfc, 0x67, 0x0d, 0xbe, 0xf7, 0xd2, 0x1b, 0xd1, 0x43, 0xc8, 0x9d, 0x51,
+	0xe6, 0xfa, 0x8e, 0x35, 0x24, 0x81, 0x4b, 0x65, 0x8d, 0x66, 0xf7, 0xb6, 0x16, 0xfa, 0xef, 0x40,
+	0xcd, 0x23, 0xd9, 0x7e, 0xbf, 0x9d, 0xb4, 0xdf, 0x86, 0x54, 0x6f, 0x09, 0x6d, 0xf4, 0x08, 0x36,
+	0x3d, 0xd7, 0xb7, 0xc8, 0x13, 0xd2, 0x1b, 0x71, 0x74, 0x74, 0x6a, 0xec, 0x8a, 0xa7, 0x22, 0xcf,
+	0xf5, 0x8d, 0xe8, 0x10, 0x79, 0x76, 0xf9, 0x3f, 0x1a, 0x64, 0x3e, 0xe1, 0xb1, 0x68, 0xf8, 0x8f,
+	0x29, 0xca, 0x43, 0xcc, 0x95, 0xd6, 0x26, 0xcc, 0x98, 0x6b, 0xa3, 0x0a, 0xac, 0x63, 0xdb, 0x73,
+	0x7d, 0x3d, 0x76, 0x49, 0xab, 0x4b, 0xd8, 0xca, 0x79, 0xa4, 0x43, 0xea, 0x8c, 0x04, 0x3c, 0x58,
+	0x22, 0x7f, 0x09, 0x33, 0x22, 0xd1, 0xf7, 0x61, 0x83, 0x51, 0x86, 0x07, 0x96, 0x1a, 0x12, 0xeb,
+	0x42, 0x33, 0x2b, 0x78, 0x32, 0xf6, 0xe8, 0x3e, 0x40, 0x2f, 0x20, 0x98, 0xc9, 0x71, 0x96, 0xbc,
+	0xea, 0x38, 0xcb, 0x28, 0xe5, 0x1a, 0x2b, 0x7f, 0x0a, 0x59, 0xe1, 0xaf, 0x9a, 0xc6, 0x5b, 0x90,
+	0x16, 0xa5, 0x60, 0x4d, 0xfc, 0x4e, 0x09, 0xba, 0x61, 0xa3, 0x2a, 0x24, 0x3d, 0x01, 0x52, 0x81,
+	0xbe, 0xb6, 0x50, 0x6f, 0x6a, 0x32, 0x2a, 0x58, 0xf9, 0xeb, 0x38, 0x14, 0xc4, 0xd9, 0xb2, 0x1a,
+	0x44, 0x44, 0x5f, 0x67, 0x5c, 0x4e, 0xdb, 0x14, 0x9b, 0xb5, 0x69, 0x92, 0x90, 0xf8, 0xd5, 0x13,
+	0x92, 0x58, 0x9e, 0x90, 0xf5, 0xd9, 0x84, 0x60, 0x28, 0xd8, 0xaa, 0xb0, 0xad, 0xa1, 0xf0, 0x45,
+	0x85, 0x7c, 0x73, 0x21, 0xe4, 0x35, 0x7f, 0x5c, 0x2f, 0x5f, 0xde, 0x57, 0x66, 0xde, 0x9e, 0xa1,
+	0xe7, 0x12, 0x9a, 0x7a, 0xfd, 0x84, 0x22, 0x03, 0xf2, 0x78, 0xc4, 0xe8, 0x45, 0x7b, 0xe8, 0x69,
+	0x71, 0x5a, 0x69, 0x21, 0x5d, 0xb5, 0x11, 0xa3, 0x93, 0xfa, 0x37, 0x73, 0x78, 0x9a, 0xbc, 0x97,
+	0xfe, 0xfc, 0xe9, 0xee, 0xda, 0xbf, 0x9f, 0xee, 0x6a, 0x65, 0x0f, 0x72, 0x33, 0x48, 0x1e, 0x28,
+	0xe2, 0xe3, 0xd3, 0x01, 0x91, 0x25, 0x92, 0x36, 0x23, 0x12, 0xed, 0x40, 0xc6, 0xc1, 0xa1, 0x35,
+	0x70, 0x3d, 0x97, 0xa9, 0x54, 0xa5, 0x1d, 0x1c, 0x1e, 0x72, 0x1a, 0xed, 0x42, 0xd6, 0xc3, 0x4f,
+	0xac, 0x80, 0xb0, 0xc0, 0x25, 0xa1, 0xc8, 0x58, 0xce, 0x04, 0x0f, 0x3f, 0x31, 0x25, 0xe7, 0x5e,
+	0x42, 0x5c, 0xf7, 0xbf, 0x75, 0x48, 0xb7, 0x02, 0x3a, 0xa4, 0x21, 0x1e, 0x2c, 0x34, 0xe0, 0x03,
+	0xd8, 0x94, 0xa5, 0x20, 0xd3, 0x60, 0x45, 0xb5, 0x74, 0x59, 0x3f, 0x22, 0xe7, 0xa2, 0x0e, 0x95,
+	0x64, 0x65, 0x73, 0xfe, 0x10, 0x32, 0x43, 0x61, 0x03, 0x09, 0xf8, 0x78, 0x8d, 0xaf, 0x3c, 0xfc,
+	0x02, 0x8a, 0x1e, 0x40, 0x36, 0x1c, 0x9d, 0x7a, 0x2e, 0xb3, 0xf8, 0xd3, 0x49, 0x5f, 0xbf, 0x6a,
+	0x1e, 0x41, 0x6a, 0x73, 0x39, 0x7a, 0x0f, 0x72, 0xd2, 0xd7, 0xa8, 0x2a, 0x93, 0x22, 0x0c, 0x1b,
+	0x82, 0x79, 0xac, 0x4a, 0xf3, 0x83, 0xb9, 0x80, 0x44, 0xd8, 0x94, 0xc0, 0x4e, 0xbb, 0x1d, 0x69,
+	0xfc, 0x08, 0x92, 0x21, 0xc3, 0x6c, 0x14, 0x8a, 0xba, 0xc8, 0xef, 0xed, 0x2e, 0xd4, 0x45, 0x14,
+	0xfd, 0xb6, 0x80, 0x99, 0x0a, 0x8e, 0xba, 0x80, 0x1e, 0xbb, 0x3e, 0x1e, 0x58, 0x0c, 0x0f, 0x06,
+	0x63, 0x2b, 0x20, 0xe1, 0x68, 0xc0, 0xf4, 0x8c, 0x70, 0xf1, 0xfa, 0xc2, 0x21, 0x1d, 0x0e, 0x32,
+	0x05, 0xa6, 0x9e, 0xe1, 0x4e, 0x4a, 0x07, 0x8b, 0xe2, 0x88, 0x29, 0x21, 0xea, 0xc2, 0x5b, 0x33,
+	0xcb, 0xc1, 0x22, 0xbe, 0xad, 0xc3, 0x55, 0x03, 0x57, 0x98, 0xde, 0x10, 0x86, 0x6f, 0xa3, 0x16,
+	0x14, 0x64, 0x07, 0xd0, 0x20, 0x32, 0x35, 0x2b, 0xfc, 0x7d, 0x7f, 0xa9, 0xbf, 0x86, 0xc2, 0x4b,
+	0xc3, 0xcc, 0x3c, 0x99, 0xa1, 0xd1, 0x07, 0xbc, 0x5e, 0xc2, 0x10, 0x3b, 0x24, 0xd4, 0x37, 0x6e,
+	0xc4, 0x97, 0xb5, 0xbf, 0x39, 0x41, 0xf1, 0xc5, 0xce, 0x5c, 0x36, 0x20, 0x7a, 0x4e, 0x2e, 0x76,
+	0x41, 0xf0, 0xf6, 0x09, 0x47, 0x9e, 0x87, 0x83, 0xb1, 0x9e, 0x17, 0xfc, 0x88, 0xbc, 0x97, 0xe0,
+	0x3d, 0x57, 0xfe, 0x9d, 0x06, 0xd9, 0xe9, 0x00, 0xed, 0x40, 0x66, 0x4c, 0x42, 0xab, 0x47, 0x47,
+	0x3e, 0x53, 0x2f, 0x88, 0xf4, 0x98, 0x84, 0xfb, 0x9c, 0xe6, 0x45, 0x82, 0x4f, 0x43, 0x86, 0x5d,
+	0x5f, 0x01, 0xe4, 0x1b, 0x62, 0x43, 0x31, 0x25, 0x68, 0x0b, 0xd2, 0x3e, 0x55, 0x72, 0x59, 0xe9,
+	0x29, 0x9f, 0x4a, 0xd1, 0x0f, 0x00, 0xf9, 0xd4, 0x3a, 0x77, 0x59, 0xdf, 0x3a, 0x23, 0x2c, 0x02,
+	0xc9, 0xd1, 0x58, 0xf0, 0xe9, 0x89, 0xcb, 0xfa, 0xc7, 0x84, 0x49, 0xb0, 0xb2, 0xef, 0xbf, 0x1a,
+	0x24, 0x8e, 0x29, 0x23, 0xbc, 0xa1, 0x87, 0x2a, 0x74, 0x17, 0xeb, 0x02, 0x22, 0x96, 0x9c, 0xce,
+	0x67, 0x94, 0xa9, 0x85, 0xb1, 0x72, 0x3a, 0x0b, 0x18, 0xfa, 0x08, 0x92, 0x74, 0x28, 0x46, 0x56,
+	0x5c, 0xa4, 0x6a, 0x67, 0x21, 0x55, 0xfc, 0xde, 0xa6, 0x80, 0x98, 0x0a, 0xba, 0x72, 0xa4, 0xbf,
+	0xc1, 0x76, 0x2c, 0x3f, 0xd5, 0xe0, 0x9d, 0xa8, 0x52, 0xe4, 0x16, 0x6e, 0xfb, 0x78, 0x18, 0xf6,
+	0x29, 0xbb, 0x3c, 0x10, 0xf3, 0x1b, 0x3d, 0xb6, 0xb8, 0xd1, 0xeb, 0x90, 0x92, 0x42, 0x3e, 0x19,
+	0x79, 0x71, 0xbd, 0xbb, 0x64, 0xbd, 0x4a, 0xfc, 0x74, 0x4f, 0x45, 0x8a, 0xe5, 0x47, 0xb0, 0x31,
+	0x8d, 0x79, 0x93, 0xdf, 0x26, 0xe5, 0xdf, 0xc4, 0x60, 0x73, 0xb6, 0x51, 0x5c, 0xea, 0x1f, 0x52,
+	0xe7, 0x72, 0xe7, 0x75, 0x48, 0x61, 0xc6, 0x88, 0x37, 0x8c, 0x56, 0x42, 0x44, 0xf2, 0xbb, 0xfa,
+	0xf2, 0x2e, 0x9e, 0xef, 0xb8, 0xa9, 0x28, 0xf4, 0x63, 0x48, 0x88, 0x7c, 0x5d, 0xf9, 0x33, 0x4d,
+	0xa8, 0xa1, 0x9f, 0x42, 0x52, 0x75, 0xfc, 0xfa, 0xd5, 0x3a, 0x5e, 0xa9, 0x89, 0x07, 0x07, 0x0e,
+	0xad, 0x51, 0x48, 0x6c, 0x35, 0x74, 0x53, 0x0e, 0x0e, 0xbb, 0x21, 0xb1, 0x51, 0x11, 0xe2, 0x03,
+	0xea, 0x88, 0xf1, 0x9a, 0x31, 0xf9, 0xbf, 0xfc, 0xc3, 0x0c, 0x89, 0x57, 0xce, 0x7d, 0x97, 0x04,
+	0x38, 0xe8, 0xf5, 0xc7, 0x86, 0xed, 0x10, 0x74, 0x0b, 0x0a, 0x43, 0x1c, 0x10, 0x9f, 0x59, 0x73,
+	0xef, 0xa9, 0x9c, 0x64, 0x7f, 0xa2, 0x5e, 0x30, 0x37, 0x21, 0xdf, 0xeb, 0xbb, 0x03, 0xdb, 0x9a,
+	0x7b, 0xe2, 0x6c, 0x08, 0x6e, 0x84, 0x5a, 0xb6, 0xf7, 0xe2, 0xaf, 0xb1, 0xf7, 0xc4, 0xe7, 0xc6,
+	0x90, 0xf5, 0x45, 0x78, 0x73, 0xa6, 0x24, 0xee, 0xfc, 0x5a, 0x03, 0xb8, 0xe8, 0x2e, 0xb4, 0x03,
+	0xd7, 0x8e, 0x9b, 0x1d, 0xc3, 0x6a, 0xb6, 0x3a, 0x8d, 0xe6, 0x91, 0xd5, 0x3d, 0x6a, 0xb7, 0x8c,
+	0xfd, 0xc6, 0xc7, 0x0d, 0xe3, 0xa0, 0xb8, 0x86, 0xde, 0x86, 0xc2, 0xb4, 0xf0, 0x53, 0xa3, 0x5d,
+	0xd4, 0xd0, 0x35, 0x78, 0x7b, 0x9a, 0x59, 0xab, 0xb7, 0x3b, 0xb5, 0xc6, 0x51, 0x31, 0x86, 0x10,
+	0xe4, 0xa7, 0x05, 0x47, 0xcd, 0x62, 0x1c, 0x5d, 0x07, 0x7d, 0x96, 0x67, 0x9d, 0x34, 0x3a, 0xf7,
+	0xad, 0x63, 0xa3, 0xd3, 0x2c, 0x26, 0xb6, 0x13, 0x9f, 0xff, 0xbe, 0xb4, 0x76, 0xe7, 0xaf, 0x1a,
+	0xe4, 0x67, 0x57, 0x11, 0xda, 0x85, 0x9d, 0x96, 0xd9, 0x6c, 0x35, 0xdb, 0xb5, 0x43, 0xab, 0xdd,
+	0xa9, 0x75, 0xba, 0xed, 0x39, 0xcb, 0xde, 0x85, 0xad, 0x79, 0x40, 0xbb, 0x5b, 0x7f, 0xd8, 0xe8,
+	0x74, 0x8c, 0x83, 0xa2, 0xc6, 0xaf, 0x9d, 0x17, 0xd7, 0xf6, 0xf7, 0x8d, 0x16, 0x97, 0xc6, 0x5e,
+	0x26, 0x35, 0x8d, 0x07, 0xc6, 0x3e, 0x97, 0xc6, 0x79, 0x44, 0x16, 0x74, 0xeb, 0x4d, 0x93, 0x0b,
+	0x13, 0x2f, 0xbb, 0x97, 0x3b, 0x74, 0x60, 0xd6, 0x4e, 0x8e, 0x8a, 0xeb, 0xca, 0xa1, 0x3f, 0x4d,
+	0x4d, 0x90, 0xd9, 0xca, 0x43, 0xb7, 0xe1, 0xe6, 0x44, 0xdf, 0xf8, 0xb9, 0xb1, 0xdf, 0xed, 0x34,
+	0x4d, 0xcb, 0x34, 0xda, 0xdd, 0xc3, 0xce, 0x9c, 0x87, 0x37, 0xe1, 0xc6, 0x52, 0xe4, 0x51, 0xb3,
+	0x63, 0x99, 0xdd, 0xa3, 0xa2, 0xb6, 0x12, 0xd5, 0xee, 0xee, 0xef, 0x1b, 0xed, 0x76, 0x31, 0xb6,
+	0x12, 0xf5, 0x71, 0xad, 0x71, 0xd8, 0x35, 0x8d, 0x62, 0x5c, 0x1a, 0x5f, 0xff, 0xc9, 0xb3, 0xe7,
+	0x25, 0xed, 0x9b, 0xe7, 0x25, 0xed, 0x5f, 0xcf, 0x4b, 0xda, 0x17, 0x2f, 0x4a, 0x6b, 0xdf, 0xbc,
+	0x28, 0xad, 0xfd, 0xfd, 0x45, 0x69, 0xed, 0xd1, 0x4d, 0xc7, 0x65, 0xfd, 0xd1, 0x69, 0xa5, 0x47,
+	0x3d, 0xf5, 0x93, 0x50, 0x75, 0xea, 0x8b, 0xf1, 0x89, 0xfc, 0xc5, 0xea, 0x34, 0x29, 0xba, 0xf7,
+	0xa3, 0xff, 0x0f, 0x00, 0xa4, 0xe5, 0x02, 0x8c, 0xc8, 0x12, 0x00, 0x00,
+}
+
+func (this *GroupPolicyInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GroupPolicyInfo)
+	if !ok {
+		that2, ok := that.(GroupPolicyInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.GroupId != that1.GroupId {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.DecisionPolicy.Equal(that1.DecisionPolicy) {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.AutoExecution.Equal(that1.AutoExecution) {
+		return false
+	}
+	return true
+}
+func (this *AutoExecution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoExecution)
+	if !ok {
+		that2, ok := that.(AutoExecution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.MaxRetries != that1.MaxRetries {
+		return false
+	}
+	return true
+}
+func (m *Member) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1226,6 +1749,62 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenWeightedDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenWeightedDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenWeightedDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WeightProvider) > 0 {
+		i -= len(m.WeightProvider)
+		copy(dAtA[i:], m.WeightProvider)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WeightProvider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1246,21 +1825,21 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1285,12 +1864,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1387,12 +1966,24 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	if m.AutoExecution != nil {
+		{
+			size, err := m.AutoExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1441,6 +2032,49 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1494,12 +2128,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1527,12 +2161,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1637,12 +2271,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1672,6 +2306,200 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalWeightSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalWeightSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalWeightSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalWeight) > 0 {
+		i -= len(m.TotalWeight)
+		copy(dAtA[i:], m.TotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalWeight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalExecutionLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalExecutionLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalExecutionLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Result != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x28
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupHierarchyEdge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupHierarchyEdge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupHierarchyEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChildGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ChildGroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParentGroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ParentGroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1761,6 +2589,31 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *TokenWeightedDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WeightProvider)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1849,6 +2702,28 @@ func (m *GroupPolicyInfo) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.AutoExecution != nil {
+		l = m.AutoExecution.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovTypes(uint64(m.MaxRetries))
+	}
 	return n
 }
 
@@ -1960,32 +2835,123 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ProposalWeightSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.TotalWeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MemberWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
+
+func (m *ProposalExecutionLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTypes(uint64(m.Attempt))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Result != 0 {
+		n += 1 + sovTypes(uint64(m.Result))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupHierarchyEdge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.ParentGroupId))
+	}
+	if m.ChildGroupId != 0 {
+		n += 1 + sovTypes(uint64(m.ChildGroupId))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovTypes(uint64(m.Depth))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
@@ -2527,6 +3493,188 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenWeightedDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3197,25 +4345,169 @@ func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExecution", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoExecution == nil {
+				m.AutoExecution = &AutoExecution{}
+			}
+			if err := m.AutoExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *Proposal) Unmarshal(dAtA []byte) error {
@@ -4019,6 +5311,604 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalWeightSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalWeightSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalWeightSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, MemberWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalExecutionLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalExecutionLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalExecutionLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupHierarchyEdge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupHierarchyEdge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupHierarchyEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentGroupId", wireType)
+			}
+			m.ParentGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildGroupId", wireType)
+			}
+			m.ChildGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChildGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -386,3 +386,47 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenWeightedDecisionPolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		{"all good", group.NewTokenWeightedDecisionPolicy("bank", "stake", "0.5", time.Hour, 0), false},
+		{"no denom", group.NewTokenWeightedDecisionPolicy("staking", "", "0.5", time.Hour, 0), false},
+		{"empty weight provider", group.NewTokenWeightedDecisionPolicy("", "stake", "0.5", time.Hour, 0), true},
+		{"invalid denom", group.NewTokenWeightedDecisionPolicy("bank", "1", "0.5", time.Hour, 0), true},
+		{"bank weight provider without denom", group.NewTokenWeightedDecisionPolicy("bank", "", "0.5", time.Hour, 0), true},
+		{"invalid percentage", group.NewTokenWeightedDecisionPolicy("bank", "stake", "1.5", time.Hour, 0), true},
+		{"zero voting period", group.NewTokenWeightedDecisionPolicy("bank", "stake", "0.5", 0, 0), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTokenWeightedDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewTokenWeightedDecisionPolicy("bank", "stake", "0.5", time.Hour, 0)
+	tally := group.TallyResult{YesCount: "20", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"}
+
+	result, err := policy.Allow(tally, "30")
+	require.NoError(t, err)
+	require.Equal(t, group.DecisionPolicyResult{Allow: true, Final: true}, result)
+
+	result, err = policy.Allow(tally, "50")
+	require.NoError(t, err)
+	require.Equal(t, group.DecisionPolicyResult{Allow: false, Final: false}, result)
+
+	// a proposal can't pass when nobody held any weight at submission
+	result, err = policy.Allow(group.DefaultTallyResult(), "0")
+	require.NoError(t, err)
+	require.Equal(t, group.DecisionPolicyResult{Allow: false, Final: true}, result)
+}
@@ -20,12 +20,19 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = ic(ctx, minter, params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	if params.InflationSchedule == types.InflationScheduleBondedRatio {
+		minter.Inflation = ic(ctx, minter, params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	} else {
+		minter = params.ScheduledMinter(ctx.BlockHeight(), totalStakingSupply)
+	}
 	k.SetMinter(ctx, minter)
 
 	// mint coins, update supply
 	mintedCoin := minter.BlockProvision(params)
+	if params.InflationSchedule == types.InflationScheduleHalving {
+		mintedCoin = params.CapProvision(mintedCoin, k.MintDenomSupply(ctx, params.MintDenom))
+	}
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
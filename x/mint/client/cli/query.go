@@ -10,6 +10,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
+// Flags for the projected-supply command.
+const (
+	FlagStepBlocks = "step-blocks"
+	FlagSteps      = "steps"
+)
+
 // GetQueryCmd returns the cli query commands for the minting module.
 func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd := &cobra.Command{
@@ -24,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryProjectedSupply(),
 	)
 
 	return mintingQueryCmd
@@ -115,3 +122,43 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProjectedSupply implements a command to return the projected
+// supply of the mint denom under the current inflation schedule.
+func GetCmdQueryProjectedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-supply",
+		Short: "Query the projected supply under the current inflation schedule",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			stepBlocks, err := cmd.Flags().GetUint64(FlagStepBlocks)
+			if err != nil {
+				return err
+			}
+			steps, err := cmd.Flags().GetUint32(FlagSteps)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryProjectedSupplyRequest{StepBlocks: stepBlocks, Steps: steps}
+			res, err := queryClient.ProjectedSupply(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStepBlocks, 0, "Number of blocks per projection step (defaults to the blocks per year)")
+	cmd.Flags().Uint32(FlagSteps, 0, fmt.Sprintf("Number of projection steps (defaults to %d, at most %d)", types.DefaultProjectionSteps, types.MaxProjectionSteps))
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -45,7 +45,7 @@ func TestGetCmdQueryParams(t *testing.T) {
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", flags.FlagOutput)},
 			`[--height=1 --output=json]`,
-			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0"}`,
+			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0","inflation_schedule":"INFLATION_SCHEDULE_TYPE_UNSPECIFIED","halving_schedule":null,"piecewise_linear_schedule":null,"tail_emission_schedule":null}`,
 		},
 		{
 			"text output",
@@ -53,10 +53,14 @@ func TestGetCmdQueryParams(t *testing.T) {
 			`[--height=1 --output=text]`,
 			`blocks_per_year: "0"
 goal_bonded: "0"
+halving_schedule: null
 inflation_max: "0"
 inflation_min: "0"
 inflation_rate_change: "0"
-mint_denom: ""`,
+inflation_schedule: INFLATION_SCHEDULE_TYPE_UNSPECIFIED
+mint_denom: ""
+piecewise_linear_schedule: null
+tail_emission_schedule: null`,
 		},
 	}
 
@@ -205,3 +209,63 @@ func TestGetCmdQueryAnnualProvisions(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCmdQueryProjectedSupply(t *testing.T) {
+	encCfg := testutilmod.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	kr := keyring.NewInMemory(encCfg.Codec)
+	baseCtx := client.Context{}.
+		WithKeyring(kr).
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithClient(clitestutil.MockCometRPC{Client: rpcclientmock.Client{}}).
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithOutput(io.Discard).
+		WithChainID("test-chain")
+
+	cmd := mintcli.GetCmdQueryProjectedSupply()
+
+	testCases := []struct {
+		name           string
+		flagArgs       []string
+		expCmdOutput   string
+		expectedOutput string
+	}{
+		{
+			"json output",
+			[]string{fmt.Sprintf("--%s=100", mintcli.FlagStepBlocks), fmt.Sprintf("--%s=5", mintcli.FlagSteps), fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			`[--step-blocks=100 --steps=5 --output=json]`,
+			`{"projections":[]}`,
+		},
+		{
+			"text output",
+			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", flags.FlagOutput)},
+			`[--height=1 --output=text]`,
+			`projections: []`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetOut(io.Discard)
+			require.NotNil(t, cmd)
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.flagArgs)
+
+			require.NoError(t, client.SetCmdClientContextHandler(baseCtx, cmd))
+
+			if len(tc.flagArgs) != 0 {
+				require.Contains(t, fmt.Sprint(cmd), "projected-supply [] [] Query the projected supply under the current inflation schedule")
+				require.Contains(t, fmt.Sprint(cmd), tc.expCmdOutput)
+			}
+
+			out, err := clitestutil.ExecTestCLICmd(baseCtx, cmd, tc.flagArgs)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -32,3 +36,47 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// ProjectedSupply returns the projected supply of the mint denom under the
+// current inflation schedule.
+func (k Keeper) ProjectedSupply(c context.Context, req *types.QueryProjectedSupplyRequest) (*types.QueryProjectedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Steps > types.MaxProjectionSteps {
+		return nil, status.Errorf(codes.InvalidArgument, "steps cannot exceed %d", types.MaxProjectionSteps)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	stepBlocks := req.StepBlocks
+	if stepBlocks == 0 {
+		stepBlocks = params.BlocksPerYear
+	}
+	steps := req.Steps
+	if steps == 0 {
+		steps = types.DefaultProjectionSteps
+	}
+
+	// bound the projection so that neither the provision of a step nor the
+	// projected heights overflow
+	if stepBlocks/types.MaxProjectionStepYears > params.BlocksPerYear {
+		return nil, status.Errorf(codes.InvalidArgument, "step blocks cannot exceed %d years of blocks", types.MaxProjectionStepYears)
+	}
+	if stepBlocks > uint64(math.MaxInt64-ctx.BlockHeight())/uint64(steps) {
+		return nil, status.Error(codes.InvalidArgument, "projected height overflows")
+	}
+
+	projections := types.ProjectSupply(
+		params,
+		k.GetMinter(ctx),
+		k.BondedRatio(ctx),
+		ctx.BlockHeight(),
+		k.MintDenomSupply(ctx, params.MintDenom),
+		stepBlocks,
+		steps,
+	)
+
+	return &types.QueryProjectedSupplyResponse{Projections: projections}, nil
+}
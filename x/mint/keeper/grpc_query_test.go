@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	gocontext "context"
+	stdmath "math"
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
@@ -24,9 +27,11 @@ import (
 type MintTestSuite struct {
 	suite.Suite
 
-	ctx         sdk.Context
-	queryClient types.QueryClient
-	mintKeeper  keeper.Keeper
+	ctx           sdk.Context
+	queryClient   types.QueryClient
+	mintKeeper    keeper.Keeper
+	stakingKeeper *minttestutil.MockStakingKeeper
+	bankKeeper    *minttestutil.MockBankKeeper
 }
 
 func (suite *MintTestSuite) SetupTest() {
@@ -53,6 +58,9 @@ func (suite *MintTestSuite) SetupTest() {
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	suite.stakingKeeper = stakingKeeper
+	suite.bankKeeper = bankKeeper
+
 	err := suite.mintKeeper.SetParams(suite.ctx, types.DefaultParams())
 	suite.Require().NoError(err)
 	suite.mintKeeper.SetMinter(suite.ctx, types.DefaultInitialMinter())
@@ -77,6 +85,54 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, suite.mintKeeper.GetMinter(suite.ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCProjectedSupply() {
+	params := types.DefaultParams()
+	params.InflationSchedule = types.InflationScheduleHalving
+	params.HalvingSchedule = &types.HalvingSchedule{
+		EpochBlocks:             params.BlocksPerYear,
+		InitialAnnualProvisions: math.LegacyNewDec(1000 * int64(params.BlocksPerYear)),
+		MaxSupply:               math.NewInt(1_010_000_000_000),
+	}
+	suite.Require().NoError(suite.mintKeeper.SetParams(suite.ctx, params))
+
+	supply := sdk.NewCoin(params.MintDenom, math.NewInt(1_000_000_000_000))
+	suite.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 1)).AnyTimes()
+	suite.bankKeeper.EXPECT().GetSupply(gomock.Any(), params.MintDenom).Return(supply).AnyTimes()
+
+	res, err := suite.queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Projections, types.DefaultProjectionSteps)
+
+	// the block provisions are halved every year until the max supply is reached
+	expSupply := supply.Amount
+	for i, projection := range res.Projections {
+		expProvision := math.NewInt(1000 >> i).Mul(math.NewIntFromUint64(params.BlocksPerYear))
+		expSupply = math.MinInt(expSupply.Add(expProvision), params.HalvingSchedule.MaxSupply)
+		suite.Require().Equal(int64(i+1)*int64(params.BlocksPerYear), projection.Height)
+		suite.Require().Equal(expSupply, projection.Supply)
+	}
+
+	res, err = suite.queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{StepBlocks: 10, Steps: 3})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Projections, 3)
+	suite.Require().Equal(int64(30), res.Projections[2].Height)
+	suite.Require().Equal(supply.Amount.AddRaw(3*10*1000), res.Projections[2].Supply)
+
+	_, err = suite.queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{Steps: types.MaxProjectionSteps + 1})
+	suite.Require().ErrorContains(err, "steps cannot exceed")
+
+	_, err = suite.queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{StepBlocks: params.BlocksPerYear * (types.MaxProjectionStepYears + 1)})
+	suite.Require().ErrorContains(err, "step blocks cannot exceed")
+
+	_, err = suite.queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{StepBlocks: stdmath.MaxUint64})
+	suite.Require().ErrorContains(err, "step blocks cannot exceed")
+
+	params.BlocksPerYear = stdmath.MaxUint64
+	suite.Require().NoError(suite.mintKeeper.SetParams(suite.ctx, params))
+	_, err = suite.queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{})
+	suite.Require().ErrorContains(err, "projected height overflows")
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
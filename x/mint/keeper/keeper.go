@@ -113,6 +113,12 @@ func (k Keeper) BondedRatio(ctx sdk.Context) math.LegacyDec {
 	return k.stakingKeeper.BondedRatio(ctx)
 }
 
+// MintDenomSupply implements an alias call to the underlying supply keeper's
+// GetSupply to be used in BeginBlocker.
+func (k Keeper) MintDenomSupply(ctx sdk.Context, denom string) math.Int {
+	return k.bankKeeper.GetSupply(ctx, denom).Amount
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
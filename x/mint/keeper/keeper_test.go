@@ -130,6 +130,10 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(bondedRatio)
 	s.Require().Equal(s.mintKeeper.BondedRatio(s.ctx), bondedRatio)
 
+	supply := sdk.NewCoin("stake", math.NewInt(5000000))
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, "stake").Return(supply)
+	s.Require().Equal(s.mintKeeper.MintDenomSupply(s.ctx, "stake"), supply.Amount)
+
 	coins := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1000000)))
 	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, coins).Return(nil)
 	s.Require().Equal(s.mintKeeper.MintCoins(s.ctx, sdk.NewCoins()), nil)
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -17,6 +18,7 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+	InflationSchedule   = "inflation_schedule"
 )
 
 // GenInflation randomized Inflation
@@ -44,6 +46,64 @@ func GenGoalBonded(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDecWithPrec(67, 2)
 }
 
+// GenInflationSchedule randomized InflationSchedule
+func GenInflationSchedule(r *rand.Rand) types.InflationScheduleType {
+	return types.InflationScheduleType(r.Intn(len(types.InflationScheduleType_name)))
+}
+
+// GenHalvingSchedule randomized HalvingSchedule
+func GenHalvingSchedule(r *rand.Rand) *types.HalvingSchedule {
+	return &types.HalvingSchedule{
+		StartHeight:             int64(r.Intn(100)),
+		EpochBlocks:             uint64(simtypes.RandIntBetween(r, 1, 1000)),
+		InitialAnnualProvisions: math.LegacyNewDec(r.Int63n(1_000_000_000_000)),
+		MaxSupply:               math.NewInt(r.Int63n(1_000_000_000_000_000) + 1),
+	}
+}
+
+// GenPiecewiseLinearSchedule randomized PiecewiseLinearSchedule
+func GenPiecewiseLinearSchedule(r *rand.Rand) *types.PiecewiseLinearSchedule {
+	points := make([]types.InflationPoint, simtypes.RandIntBetween(r, 1, 5))
+	var height int64
+	for i := range points {
+		height += int64(r.Intn(500))
+		points[i] = types.InflationPoint{Height: height, Inflation: math.LegacyNewDecWithPrec(int64(r.Intn(50)), 2)}
+		height++
+	}
+
+	return &types.PiecewiseLinearSchedule{Points: points}
+}
+
+// GenTailEmissionSchedule randomized TailEmissionSchedule
+func GenTailEmissionSchedule(r *rand.Rand) *types.TailEmissionSchedule {
+	initialInflation := math.LegacyNewDecWithPrec(int64(r.Intn(50)), 2)
+
+	return &types.TailEmissionSchedule{
+		StartHeight:      int64(r.Intn(100)),
+		InitialInflation: initialInflation,
+		AnnualReduction:  math.LegacyNewDecWithPrec(int64(r.Intn(50)), 2),
+		TailInflation:    initialInflation.Mul(math.LegacyNewDecWithPrec(int64(r.Intn(100)), 2)),
+	}
+}
+
+// SetInflationSchedule sets the given inflation schedule in the params, with
+// randomized schedule parameters.
+func SetInflationSchedule(r *rand.Rand, params *types.Params, schedule types.InflationScheduleType) {
+	params.InflationSchedule = schedule
+	params.HalvingSchedule = nil
+	params.PiecewiseLinearSchedule = nil
+	params.TailEmissionSchedule = nil
+
+	switch schedule {
+	case types.InflationScheduleHalving:
+		params.HalvingSchedule = GenHalvingSchedule(r)
+	case types.InflationSchedulePiecewiseLinear:
+		params.PiecewiseLinearSchedule = GenPiecewiseLinearSchedule(r)
+	case types.InflationScheduleTailEmission:
+		params.TailEmissionSchedule = GenTailEmissionSchedule(r)
+	}
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -82,6 +142,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
 	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
 
+	var inflationSchedule types.InflationScheduleType
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationSchedule, &inflationSchedule, simState.Rand,
+		func(r *rand.Rand) { inflationSchedule = GenInflationSchedule(r) },
+	)
+	SetInflationSchedule(simState.Rand, &params, inflationSchedule)
+
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
 	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
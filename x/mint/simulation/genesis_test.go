@@ -55,6 +55,8 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, "0.169999926644441493", mintGenesis.Minter.NextInflationRate(mintGenesis.Params, math.LegacyOneDec()).String())
 	require.Equal(t, "0.170000000000000000", mintGenesis.Minter.Inflation.String())
 	require.Equal(t, "0.000000000000000000", mintGenesis.Minter.AnnualProvisions.String())
+	require.NoError(t, mintGenesis.Params.Validate())
+	require.Equal(t, types.InflationScheduleBondedRatio, mintGenesis.Params.InflationSchedule)
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
@@ -82,3 +84,17 @@ func TestRandomizedGenState1(t *testing.T) {
 		require.Panicsf(t, func() { simulation.RandomizedGenState(&tt.simState) }, tt.panicMsg)
 	}
 }
+
+// TestSetInflationSchedule tests that randomized inflation schedules are valid.
+func TestSetInflationSchedule(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for schedule := range types.InflationScheduleType_name {
+		for i := 0; i < 100; i++ {
+			params := types.DefaultParams()
+			simulation.SetInflationSchedule(r, &params, types.InflationScheduleType(schedule))
+			require.Equal(t, types.InflationScheduleType(schedule), params.InflationSchedule)
+			require.NoError(t, params.Validate())
+		}
+	}
+}
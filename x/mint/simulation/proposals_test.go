@@ -44,4 +44,6 @@ func TestProposalMsgs(t *testing.T) {
 	assert.DeepEqual(t, sdkmath.LegacyNewDecWithPrec(23, 2), msgUpdateParams.Params.InflationMin)
 	assert.DeepEqual(t, sdkmath.LegacyNewDecWithPrec(89, 2), msgUpdateParams.Params.InflationRateChange)
 	assert.Equal(t, "XhhuTSkuxK", msgUpdateParams.Params.MintDenom)
+	assert.Equal(t, types.InflationScheduleBondedRatio, msgUpdateParams.Params.InflationSchedule)
+	assert.NilError(t, msgUpdateParams.Params.Validate())
 }
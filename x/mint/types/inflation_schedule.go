@@ -0,0 +1,290 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultProjectionSteps is the default number of steps of a supply
+	// projection.
+	DefaultProjectionSteps = 10
+
+	// MaxProjectionSteps is the maximum number of steps of a supply projection.
+	MaxProjectionSteps = 100
+
+	// MaxProjectionStepYears is the maximum length of a step of a supply
+	// projection, in years of blocks.
+	MaxProjectionStepYears = 100
+
+	// maxHalvings is the number of epochs after which the annual provisions of
+	// the halving schedule are considered zero.
+	maxHalvings = 128
+)
+
+// ScheduledMinter returns the minter of the block at the given height under
+// the inflation schedule of the params, given the staking token supply. It
+// must not be called for the bonded ratio schedule, whose inflation rate is
+// computed by the InflationCalculationFn of the module.
+func (p Params) ScheduledMinter(height int64, supply math.Int) Minter {
+	switch p.InflationSchedule {
+	case InflationScheduleHalving:
+		annualProvisions := p.HalvingSchedule.AnnualProvisions(height)
+		inflation := math.LegacyZeroDec()
+		if supply.IsPositive() {
+			inflation = annualProvisions.QuoInt(supply)
+		}
+		return NewMinter(inflation, annualProvisions)
+
+	case InflationSchedulePiecewiseLinear:
+		inflation := p.PiecewiseLinearSchedule.Inflation(height)
+		return NewMinter(inflation, inflation.MulInt(supply))
+
+	case InflationScheduleTailEmission:
+		inflation := p.TailEmissionSchedule.Inflation(height, p.BlocksPerYear)
+		return NewMinter(inflation, inflation.MulInt(supply))
+
+	default:
+		panic(fmt.Sprintf("no scheduled minter for inflation schedule %s", p.InflationSchedule))
+	}
+}
+
+// CapProvision caps the given provision so that the supply of the mint denom
+// does not exceed the max supply of the halving schedule, if it is the
+// inflation schedule of the params.
+func (p Params) CapProvision(provision sdk.Coin, mintSupply math.Int) sdk.Coin {
+	if p.InflationSchedule != InflationScheduleHalving {
+		return provision
+	}
+
+	remaining := p.HalvingSchedule.MaxSupply.Sub(mintSupply)
+	if !remaining.IsPositive() {
+		return sdk.NewCoin(provision.Denom, math.ZeroInt())
+	}
+	if provision.Amount.GT(remaining) {
+		return sdk.NewCoin(provision.Denom, remaining)
+	}
+
+	return provision
+}
+
+// AnnualProvisions returns the annual provisions of the epoch of the given
+// height.
+func (s HalvingSchedule) AnnualProvisions(height int64) math.LegacyDec {
+	if height < s.StartHeight {
+		return s.InitialAnnualProvisions
+	}
+
+	epoch := uint64(height-s.StartHeight) / s.EpochBlocks
+	if epoch >= maxHalvings {
+		return math.LegacyZeroDec()
+	}
+
+	divisor := new(big.Int).Lsh(big.NewInt(1), uint(epoch))
+	return s.InitialAnnualProvisions.QuoInt(math.NewIntFromBigInt(divisor))
+}
+
+// Validate performs a basic validation of the halving schedule.
+func (s HalvingSchedule) Validate() error {
+	if s.StartHeight < 0 {
+		return fmt.Errorf("halving schedule start height cannot be negative: %d", s.StartHeight)
+	}
+	if s.EpochBlocks == 0 {
+		return errors.New("halving schedule epoch blocks must be positive")
+	}
+	if s.InitialAnnualProvisions.IsNil() || s.InitialAnnualProvisions.IsNegative() {
+		return fmt.Errorf("halving schedule initial annual provisions cannot be negative: %s", s.InitialAnnualProvisions)
+	}
+	if s.MaxSupply.IsNil() || !s.MaxSupply.IsPositive() {
+		return fmt.Errorf("halving schedule max supply must be positive: %s", s.MaxSupply)
+	}
+
+	return nil
+}
+
+// Inflation returns the inflation rate at the given height, interpolated
+// linearly between the points around it.
+func (s PiecewiseLinearSchedule) Inflation(height int64) math.LegacyDec {
+	first, last := s.Points[0], s.Points[len(s.Points)-1]
+	if height <= first.Height {
+		return first.Inflation
+	}
+	if height >= last.Height {
+		return last.Inflation
+	}
+
+	for i := 1; i < len(s.Points); i++ {
+		next := s.Points[i]
+		if height >= next.Height {
+			continue
+		}
+
+		prev := s.Points[i-1]
+		progress := math.LegacyNewDec(height - prev.Height).QuoInt64(next.Height - prev.Height)
+		return prev.Inflation.Add(next.Inflation.Sub(prev.Inflation).Mul(progress))
+	}
+
+	return last.Inflation
+}
+
+// Validate performs a basic validation of the piecewise-linear schedule.
+func (s PiecewiseLinearSchedule) Validate() error {
+	if len(s.Points) == 0 {
+		return errors.New("piecewise-linear schedule must have at least one point")
+	}
+
+	for i, point := range s.Points {
+		if point.Height < 0 {
+			return fmt.Errorf("piecewise-linear schedule point height cannot be negative: %d", point.Height)
+		}
+		if i > 0 && point.Height <= s.Points[i-1].Height {
+			return fmt.Errorf("piecewise-linear schedule point heights must be increasing: %d after %d", point.Height, s.Points[i-1].Height)
+		}
+		if err := validateRate("piecewise-linear schedule point inflation", point.Inflation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Inflation returns the inflation rate of the year of the given height.
+func (s TailEmissionSchedule) Inflation(height int64, blocksPerYear uint64) math.LegacyDec {
+	var years uint64
+	if height > s.StartHeight {
+		years = uint64(height-s.StartHeight) / blocksPerYear
+	}
+
+	inflation := s.InitialInflation.Mul(math.LegacyOneDec().Sub(s.AnnualReduction).Power(years))
+	if inflation.LT(s.TailInflation) {
+		return s.TailInflation
+	}
+
+	return inflation
+}
+
+// Validate performs a basic validation of the tail emission schedule.
+func (s TailEmissionSchedule) Validate() error {
+	if s.StartHeight < 0 {
+		return fmt.Errorf("tail emission schedule start height cannot be negative: %d", s.StartHeight)
+	}
+	if err := validateRate("tail emission schedule initial inflation", s.InitialInflation); err != nil {
+		return err
+	}
+	if err := validateRate("tail emission schedule annual reduction", s.AnnualReduction); err != nil {
+		return err
+	}
+	if err := validateRate("tail emission schedule tail inflation", s.TailInflation); err != nil {
+		return err
+	}
+	if s.TailInflation.GT(s.InitialInflation) {
+		return fmt.Errorf(
+			"tail emission schedule tail inflation (%s) must be less than or equal to initial inflation (%s)",
+			s.TailInflation, s.InitialInflation,
+		)
+	}
+
+	return nil
+}
+
+// validateInflationSchedule checks that the parameters of the inflation
+// schedule, and only them, are set and valid.
+func validateInflationSchedule(p Params) error {
+	halving := p.InflationSchedule == InflationScheduleHalving
+	piecewiseLinear := p.InflationSchedule == InflationSchedulePiecewiseLinear
+	tailEmission := p.InflationSchedule == InflationScheduleTailEmission
+
+	if _, ok := InflationScheduleType_name[int32(p.InflationSchedule)]; !ok {
+		return fmt.Errorf("unknown inflation schedule: %d", p.InflationSchedule)
+	}
+	if halving != (p.HalvingSchedule != nil) {
+		return fmt.Errorf("halving schedule must be set if and only if the inflation schedule is %s", InflationScheduleHalving)
+	}
+	if piecewiseLinear != (p.PiecewiseLinearSchedule != nil) {
+		return fmt.Errorf("piecewise-linear schedule must be set if and only if the inflation schedule is %s", InflationSchedulePiecewiseLinear)
+	}
+	if tailEmission != (p.TailEmissionSchedule != nil) {
+		return fmt.Errorf("tail emission schedule must be set if and only if the inflation schedule is %s", InflationScheduleTailEmission)
+	}
+
+	switch {
+	case halving:
+		return p.HalvingSchedule.Validate()
+	case piecewiseLinear:
+		return p.PiecewiseLinearSchedule.Validate()
+	case tailEmission:
+		return p.TailEmissionSchedule.Validate()
+	}
+
+	return nil
+}
+
+func validateRate(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s cannot be nil: %s", name, v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s too large: %s", name, v)
+	}
+
+	return nil
+}
+
+// ProjectSupply projects the supply of the mint denom over the given number
+// of steps of stepBlocks blocks following the given height, assuming that the
+// provision of each block of a step is the one of its first block. The bonded
+// ratio schedule is projected with the default inflation calculation and a
+// constant bonded ratio.
+func ProjectSupply(params Params, minter Minter, bondedRatio math.LegacyDec, height int64, supply math.Int, stepBlocks uint64, steps uint32) []SupplyProjection {
+	projections := make([]SupplyProjection, 0, steps)
+	for i := uint32(0); i < steps; i++ {
+		if params.InflationSchedule == InflationScheduleBondedRatio {
+			minter.Inflation = minter.nextInflationRateAfter(params, bondedRatio, stepBlocks)
+			minter.AnnualProvisions = minter.NextAnnualProvisions(params, supply)
+		} else {
+			minter = params.ScheduledMinter(height+1, supply)
+		}
+
+		provision := minter.BlockProvision(params)
+		provision.Amount = provision.Amount.Mul(math.NewIntFromUint64(stepBlocks))
+		supply = supply.Add(params.CapProvision(provision, supply).Amount)
+		height += int64(stepBlocks)
+
+		projections = append(projections, SupplyProjection{
+			Height:           height,
+			Supply:           supply,
+			Inflation:        minter.Inflation,
+			AnnualProvisions: minter.AnnualProvisions,
+		})
+	}
+
+	return projections
+}
+
+// nextInflationRateAfter returns the inflation rate of the bonded ratio
+// schedule after the given number of blocks at a constant bonded ratio.
+func (m Minter) nextInflationRateAfter(params Params, bondedRatio math.LegacyDec, blocks uint64) math.LegacyDec {
+	inflationRateChange := math.LegacyOneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange).
+		MulInt(math.NewIntFromUint64(blocks)).
+		QuoInt(math.NewIntFromUint64(params.BlocksPerYear))
+
+	inflation := m.Inflation.Add(inflationRateChange)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation
+}
@@ -0,0 +1,225 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHalvingSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = InflationScheduleHalving
+	params.HalvingSchedule = &HalvingSchedule{
+		StartHeight:             100,
+		EpochBlocks:             1000,
+		InitialAnnualProvisions: math.LegacyNewDec(8000),
+		MaxSupply:               math.NewInt(1_000_000),
+	}
+	supply := math.NewInt(100_000)
+
+	tests := []struct {
+		height              int64
+		expAnnualProvisions math.LegacyDec
+	}{
+		{0, math.LegacyNewDec(8000)},
+		{1099, math.LegacyNewDec(8000)},
+		{1100, math.LegacyNewDec(4000)},
+		{3100, math.LegacyNewDec(1000)},
+		{100 + 1000*maxHalvings, math.LegacyZeroDec()},
+	}
+	for i, tc := range tests {
+		minter := params.ScheduledMinter(tc.height, supply)
+		require.True(t, tc.expAnnualProvisions.Equal(minter.AnnualProvisions), "test: %v, got: %v", i, minter.AnnualProvisions)
+		require.True(t, tc.expAnnualProvisions.QuoInt(supply).Equal(minter.Inflation), "test: %v, got: %v", i, minter.Inflation)
+	}
+
+	// the provision is capped by the max supply
+	provision := sdk.NewCoin(params.MintDenom, math.NewInt(100))
+	require.Equal(t, provision, params.CapProvision(provision, math.NewInt(999_900)))
+	require.Equal(t, math.NewInt(40), params.CapProvision(provision, math.NewInt(999_960)).Amount)
+	require.True(t, params.CapProvision(provision, math.NewInt(1_000_001)).Amount.IsZero())
+}
+
+func TestPiecewiseLinearSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = InflationSchedulePiecewiseLinear
+	params.PiecewiseLinearSchedule = &PiecewiseLinearSchedule{
+		Points: []InflationPoint{
+			{Height: 100, Inflation: math.LegacyNewDecWithPrec(10, 2)},
+			{Height: 200, Inflation: math.LegacyNewDecWithPrec(20, 2)},
+			{Height: 400, Inflation: math.LegacyNewDecWithPrec(5, 2)},
+		},
+	}
+	supply := math.NewInt(1_000_000)
+
+	tests := []struct {
+		height       int64
+		expInflation math.LegacyDec
+	}{
+		{0, math.LegacyNewDecWithPrec(10, 2)},
+		{100, math.LegacyNewDecWithPrec(10, 2)},
+		{150, math.LegacyNewDecWithPrec(15, 2)},
+		{200, math.LegacyNewDecWithPrec(20, 2)},
+		{300, math.LegacyNewDecWithPrec(125, 3)},
+		{1000, math.LegacyNewDecWithPrec(5, 2)},
+	}
+	for i, tc := range tests {
+		minter := params.ScheduledMinter(tc.height, supply)
+		require.True(t, tc.expInflation.Equal(minter.Inflation), "test: %v, got: %v", i, minter.Inflation)
+		require.True(t, tc.expInflation.MulInt(supply).Equal(minter.AnnualProvisions), "test: %v, got: %v", i, minter.AnnualProvisions)
+	}
+
+	// provisions are not capped outside of the halving schedule
+	provision := sdk.NewCoin(params.MintDenom, math.NewInt(100))
+	require.Equal(t, provision, params.CapProvision(provision, supply))
+}
+
+func TestTailEmissionSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 100
+	params.InflationSchedule = InflationScheduleTailEmission
+	params.TailEmissionSchedule = &TailEmissionSchedule{
+		StartHeight:      10,
+		InitialInflation: math.LegacyNewDecWithPrec(10, 2),
+		AnnualReduction:  math.LegacyNewDecWithPrec(50, 2),
+		TailInflation:    math.LegacyNewDecWithPrec(2, 2),
+	}
+	supply := math.NewInt(1_000_000)
+
+	tests := []struct {
+		height       int64
+		expInflation math.LegacyDec
+	}{
+		{0, math.LegacyNewDecWithPrec(10, 2)},
+		{109, math.LegacyNewDecWithPrec(10, 2)},
+		{110, math.LegacyNewDecWithPrec(5, 2)},
+		{210, math.LegacyNewDecWithPrec(25, 3)},
+		{310, math.LegacyNewDecWithPrec(2, 2)},
+		{10_000, math.LegacyNewDecWithPrec(2, 2)},
+	}
+	for i, tc := range tests {
+		minter := params.ScheduledMinter(tc.height, supply)
+		require.True(t, tc.expInflation.Equal(minter.Inflation), "test: %v, got: %v", i, minter.Inflation)
+		require.True(t, tc.expInflation.MulInt(supply).Equal(minter.AnnualProvisions), "test: %v, got: %v", i, minter.AnnualProvisions)
+	}
+}
+
+func TestValidateInflationSchedule(t *testing.T) {
+	halving := &HalvingSchedule{
+		EpochBlocks:             1000,
+		InitialAnnualProvisions: math.LegacyNewDec(8000),
+		MaxSupply:               math.NewInt(1_000_000),
+	}
+	piecewiseLinear := &PiecewiseLinearSchedule{
+		Points: []InflationPoint{
+			{Height: 0, Inflation: math.LegacyNewDecWithPrec(10, 2)},
+			{Height: 100, Inflation: math.LegacyNewDecWithPrec(5, 2)},
+		},
+	}
+	tailEmission := &TailEmissionSchedule{
+		InitialInflation: math.LegacyNewDecWithPrec(10, 2),
+		AnnualReduction:  math.LegacyNewDecWithPrec(10, 2),
+		TailInflation:    math.LegacyNewDecWithPrec(2, 2),
+	}
+
+	tests := []struct {
+		name     string
+		malleate func(p *Params)
+		expErr   string
+	}{
+		{"bonded ratio", func(p *Params) {}, ""},
+		{"halving", func(p *Params) {
+			p.InflationSchedule, p.HalvingSchedule = InflationScheduleHalving, halving
+		}, ""},
+		{"piecewise-linear", func(p *Params) {
+			p.InflationSchedule, p.PiecewiseLinearSchedule = InflationSchedulePiecewiseLinear, piecewiseLinear
+		}, ""},
+		{"tail emission", func(p *Params) {
+			p.InflationSchedule, p.TailEmissionSchedule = InflationScheduleTailEmission, tailEmission
+		}, ""},
+		{"unknown schedule", func(p *Params) {
+			p.InflationSchedule = 4
+		}, "unknown inflation schedule"},
+		{"missing schedule", func(p *Params) {
+			p.InflationSchedule = InflationScheduleHalving
+		}, "halving schedule must be set"},
+		{"other schedule set", func(p *Params) {
+			p.InflationSchedule, p.HalvingSchedule = InflationScheduleHalving, halving
+			p.TailEmissionSchedule = tailEmission
+		}, "tail emission schedule must be set"},
+		{"zero epoch blocks", func(p *Params) {
+			p.InflationSchedule, p.HalvingSchedule = InflationScheduleHalving, &HalvingSchedule{
+				InitialAnnualProvisions: math.LegacyNewDec(8000),
+				MaxSupply:               math.NewInt(1_000_000),
+			}
+		}, "epoch blocks must be positive"},
+		{"zero max supply", func(p *Params) {
+			p.InflationSchedule, p.HalvingSchedule = InflationScheduleHalving, &HalvingSchedule{
+				EpochBlocks:             1000,
+				InitialAnnualProvisions: math.LegacyNewDec(8000),
+				MaxSupply:               math.ZeroInt(),
+			}
+		}, "max supply must be positive"},
+		{"no points", func(p *Params) {
+			p.InflationSchedule, p.PiecewiseLinearSchedule = InflationSchedulePiecewiseLinear, &PiecewiseLinearSchedule{}
+		}, "at least one point"},
+		{"unordered points", func(p *Params) {
+			p.InflationSchedule, p.PiecewiseLinearSchedule = InflationSchedulePiecewiseLinear, &PiecewiseLinearSchedule{
+				Points: []InflationPoint{piecewiseLinear.Points[1], piecewiseLinear.Points[0]},
+			}
+		}, "must be increasing"},
+		{"point inflation too large", func(p *Params) {
+			p.InflationSchedule, p.PiecewiseLinearSchedule = InflationSchedulePiecewiseLinear, &PiecewiseLinearSchedule{
+				Points: []InflationPoint{{Height: 0, Inflation: math.LegacyNewDec(2)}},
+			}
+		}, "inflation too large"},
+		{"tail above initial", func(p *Params) {
+			p.InflationSchedule, p.TailEmissionSchedule = InflationScheduleTailEmission, &TailEmissionSchedule{
+				InitialInflation: math.LegacyNewDecWithPrec(2, 2),
+				AnnualReduction:  math.LegacyNewDecWithPrec(10, 2),
+				TailInflation:    math.LegacyNewDecWithPrec(10, 2),
+			}
+		}, "must be less than or equal to initial inflation"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestProjectSupply(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 100
+	params.InflationSchedule = InflationSchedulePiecewiseLinear
+	params.PiecewiseLinearSchedule = &PiecewiseLinearSchedule{
+		Points: []InflationPoint{{Height: 0, Inflation: math.LegacyNewDecWithPrec(10, 2)}},
+	}
+
+	projections := ProjectSupply(params, DefaultInitialMinter(), math.LegacyZeroDec(), 0, math.NewInt(1_000_000), 100, 2)
+	require.Len(t, projections, 2)
+	require.Equal(t, int64(100), projections[0].Height)
+	require.Equal(t, math.NewInt(1_100_000), projections[0].Supply)
+	require.Equal(t, int64(200), projections[1].Height)
+	require.Equal(t, math.NewInt(1_210_000), projections[1].Supply)
+
+	// the bonded ratio schedule moves towards the max inflation below the goal bonded ratio
+	params = DefaultParams()
+	params.BlocksPerYear = 100
+	projections = ProjectSupply(params, DefaultInitialMinter(), math.LegacyZeroDec(), 0, math.NewInt(1_000_000), 100, 2)
+	require.Len(t, projections, 2)
+	require.True(t, params.InflationMax.Equal(projections[0].Inflation), projections[0].Inflation)
+	require.Equal(t, math.NewInt(1_200_000), projections[0].Supply)
+	require.Equal(t, math.NewInt(1_440_000), projections[1].Supply)
+}
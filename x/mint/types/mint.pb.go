@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +27,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationScheduleType defines the schedule of the inflation of the mint
+// denom.
+//
+// Since: cosmos-sdk 0.48
+type InflationScheduleType int32
+
+const (
+	// UNSPECIFIED defines an inflation rate adjusted towards the goal bonded
+	// ratio by the InflationCalculationFn of the module.
+	InflationScheduleBondedRatio InflationScheduleType = 0
+	// HALVING defines fixed annual provisions halved every epoch, up to a cap on
+	// the supply of the mint denom.
+	InflationScheduleHalving InflationScheduleType = 1
+	// PIECEWISE_LINEAR defines an inflation rate interpolated linearly between
+	// target inflation rates.
+	InflationSchedulePiecewiseLinear InflationScheduleType = 2
+	// TAIL_EMISSION defines an inflation rate decreasing every year down to a
+	// tail inflation rate.
+	InflationScheduleTailEmission InflationScheduleType = 3
+)
+
+var InflationScheduleType_name = map[int32]string{
+	0: "INFLATION_SCHEDULE_TYPE_UNSPECIFIED",
+	1: "INFLATION_SCHEDULE_TYPE_HALVING",
+	2: "INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR",
+	3: "INFLATION_SCHEDULE_TYPE_TAIL_EMISSION",
+}
+
+var InflationScheduleType_value = map[string]int32{
+	"INFLATION_SCHEDULE_TYPE_UNSPECIFIED":      0,
+	"INFLATION_SCHEDULE_TYPE_HALVING":          1,
+	"INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR": 2,
+	"INFLATION_SCHEDULE_TYPE_TAIL_EMISSION":    3,
+}
+
+func (x InflationScheduleType) String() string {
+	return proto.EnumName(InflationScheduleType_name, int32(x))
+}
+
+func (InflationScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -81,6 +125,27 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// inflation_schedule defines the schedule of the inflation of the mint denom.
+	//
+	// Since: cosmos-sdk 0.48
+	InflationSchedule InflationScheduleType `protobuf:"varint,7,opt,name=inflation_schedule,json=inflationSchedule,proto3,enum=cosmos.mint.v1beta1.InflationScheduleType" json:"inflation_schedule,omitempty"`
+	// halving_schedule defines the parameters of the halving schedule. It must
+	// be set if and only if the inflation schedule is the halving schedule.
+	//
+	// Since: cosmos-sdk 0.48
+	HalvingSchedule *HalvingSchedule `protobuf:"bytes,8,opt,name=halving_schedule,json=halvingSchedule,proto3" json:"halving_schedule,omitempty"`
+	// piecewise_linear_schedule defines the parameters of the piecewise-linear
+	// schedule. It must be set if and only if the inflation schedule is the
+	// piecewise-linear schedule.
+	//
+	// Since: cosmos-sdk 0.48
+	PiecewiseLinearSchedule *PiecewiseLinearSchedule `protobuf:"bytes,9,opt,name=piecewise_linear_schedule,json=piecewiseLinearSchedule,proto3" json:"piecewise_linear_schedule,omitempty"`
+	// tail_emission_schedule defines the parameters of the tail emission
+	// schedule. It must be set if and only if the inflation schedule is the tail
+	// emission schedule.
+	//
+	// Since: cosmos-sdk 0.48
+	TailEmissionSchedule *TailEmissionSchedule `protobuf:"bytes,10,opt,name=tail_emission_schedule,json=tailEmissionSchedule,proto3" json:"tail_emission_schedule,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -130,42 +195,325 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationSchedule() InflationScheduleType {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return InflationScheduleBondedRatio
+}
+
+func (m *Params) GetHalvingSchedule() *HalvingSchedule {
+	if m != nil {
+		return m.HalvingSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetPiecewiseLinearSchedule() *PiecewiseLinearSchedule {
+	if m != nil {
+		return m.PiecewiseLinearSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetTailEmissionSchedule() *TailEmissionSchedule {
+	if m != nil {
+		return m.TailEmissionSchedule
+	}
+	return nil
+}
+
+// HalvingSchedule defines an inflation schedule minting fixed annual
+// provisions, halved at the end of every epoch, until the supply of the mint
+// denom reaches a cap.
+//
+// Since: cosmos-sdk 0.48
+type HalvingSchedule struct {
+	// start_height is the height at which the first epoch starts.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// epoch_blocks is the number of blocks of an epoch.
+	EpochBlocks uint64 `protobuf:"varint,2,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	// initial_annual_provisions is the annual provisions of the first epoch.
+	InitialAnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=initial_annual_provisions,json=initialAnnualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_annual_provisions"`
+	// max_supply is the cap on the supply of the mint denom.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+}
+
+func (m *HalvingSchedule) Reset()         { *m = HalvingSchedule{} }
+func (m *HalvingSchedule) String() string { return proto.CompactTextString(m) }
+func (*HalvingSchedule) ProtoMessage()    {}
+func (*HalvingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *HalvingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HalvingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HalvingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HalvingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HalvingSchedule.Merge(m, src)
+}
+func (m *HalvingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *HalvingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_HalvingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HalvingSchedule proto.InternalMessageInfo
+
+func (m *HalvingSchedule) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *HalvingSchedule) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+// InflationPoint defines a target inflation rate at a height.
+//
+// Since: cosmos-sdk 0.48
+type InflationPoint struct {
+	Height    int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *InflationPoint) Reset()         { *m = InflationPoint{} }
+func (m *InflationPoint) String() string { return proto.CompactTextString(m) }
+func (*InflationPoint) ProtoMessage()    {}
+func (*InflationPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *InflationPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationPoint.Merge(m, src)
+}
+func (m *InflationPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationPoint proto.InternalMessageInfo
+
+func (m *InflationPoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PiecewiseLinearSchedule defines an inflation rate interpolated linearly
+// between target inflation rates at increasing heights. The inflation rate is
+// the one of the first point before it, and the one of the last point after
+// it.
+//
+// Since: cosmos-sdk 0.48
+type PiecewiseLinearSchedule struct {
+	Points []InflationPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+}
+
+func (m *PiecewiseLinearSchedule) Reset()         { *m = PiecewiseLinearSchedule{} }
+func (m *PiecewiseLinearSchedule) String() string { return proto.CompactTextString(m) }
+func (*PiecewiseLinearSchedule) ProtoMessage()    {}
+func (*PiecewiseLinearSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{4}
+}
+func (m *PiecewiseLinearSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PiecewiseLinearSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PiecewiseLinearSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PiecewiseLinearSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PiecewiseLinearSchedule.Merge(m, src)
+}
+func (m *PiecewiseLinearSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PiecewiseLinearSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PiecewiseLinearSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PiecewiseLinearSchedule proto.InternalMessageInfo
+
+func (m *PiecewiseLinearSchedule) GetPoints() []InflationPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// TailEmissionSchedule defines an inflation rate decreasing by a constant
+// fraction every year, down to a constant tail inflation rate.
+//
+// Since: cosmos-sdk 0.48
+type TailEmissionSchedule struct {
+	// start_height is the height at which the first year starts.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// initial_inflation is the inflation rate of the first year.
+	InitialInflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=initial_inflation,json=initialInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_inflation"`
+	// annual_reduction is the fraction by which the inflation rate decreases
+	// every year.
+	AnnualReduction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=annual_reduction,json=annualReduction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_reduction"`
+	// tail_inflation is the inflation rate below which the inflation rate does
+	// not decrease.
+	TailInflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tail_inflation,json=tailInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tail_inflation"`
+}
+
+func (m *TailEmissionSchedule) Reset()         { *m = TailEmissionSchedule{} }
+func (m *TailEmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*TailEmissionSchedule) ProtoMessage()    {}
+func (*TailEmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{5}
+}
+func (m *TailEmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TailEmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TailEmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TailEmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TailEmissionSchedule.Merge(m, src)
+}
+func (m *TailEmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *TailEmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_TailEmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TailEmissionSchedule proto.InternalMessageInfo
+
+func (m *TailEmissionSchedule) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.InflationScheduleType", InflationScheduleType_name, InflationScheduleType_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*HalvingSchedule)(nil), "cosmos.mint.v1beta1.HalvingSchedule")
+	proto.RegisterType((*InflationPoint)(nil), "cosmos.mint.v1beta1.InflationPoint")
+	proto.RegisterType((*PiecewiseLinearSchedule)(nil), "cosmos.mint.v1beta1.PiecewiseLinearSchedule")
+	proto.RegisterType((*TailEmissionSchedule)(nil), "cosmos.mint.v1beta1.TailEmissionSchedule")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0x8a, 0x4d, 0x18, 0xdd, 0xe8, 0xce, 0x6a, 0x52, 0x37, 0xd9, 0xee, 0x66, 0x0f,
-	0x04, 0x49, 0x68, 0x43, 0xbc, 0x19, 0x2f, 0x02, 0x57, 0x12, 0xd2, 0x9b, 0x5c, 0x9a, 0x69, 0x3b,
-	0x94, 0x09, 0xed, 0x0c, 0x99, 0x19, 0x08, 0x7c, 0x05, 0x4f, 0x5e, 0xfd, 0x06, 0x1e, 0x39, 0xf8,
-	0x21, 0xb8, 0x49, 0x3c, 0x19, 0x0f, 0xc4, 0xc0, 0x81, 0xaf, 0x61, 0x3a, 0xd3, 0x14, 0xe3, 0xc1,
-	0x8b, 0x78, 0xe9, 0x9f, 0xe7, 0x79, 0xfb, 0x7b, 0x9e, 0x36, 0x7d, 0xa1, 0x1b, 0x73, 0x99, 0x73,
-	0xe9, 0xe7, 0x94, 0x29, 0x7f, 0xd1, 0x89, 0x88, 0xc2, 0x1d, 0x7d, 0xe3, 0xcd, 0x04, 0x57, 0x1c,
-	0x5d, 0x19, 0xdf, 0xd3, 0x52, 0xe9, 0x5f, 0x3f, 0x4f, 0x79, 0xca, 0xb5, 0xef, 0x17, 0x57, 0x66,
-	0xf4, 0xfa, 0xa5, 0x19, 0x0d, 0x8d, 0x51, 0x3e, 0x67, 0xac, 0x4b, 0x9c, 0x53, 0xc6, 0x7d, 0x7d,
-	0x34, 0xd2, 0xfd, 0x57, 0x00, 0xed, 0x01, 0x65, 0x8a, 0x08, 0x34, 0x82, 0x75, 0xca, 0xc6, 0x19,
-	0x56, 0x94, 0x33, 0x07, 0xdc, 0x81, 0x66, 0xbd, 0xfb, 0x76, 0xb3, 0xbb, 0xb5, 0x7e, 0xec, 0x6e,
-	0x1b, 0x29, 0x55, 0x93, 0x79, 0xe4, 0xc5, 0x3c, 0x2f, 0x89, 0xe5, 0xa9, 0x2d, 0x93, 0xa9, 0xaf,
-	0x56, 0x33, 0x22, 0xbd, 0x3e, 0x89, 0xbf, 0x7d, 0x69, 0xc3, 0x32, 0xb0, 0x4f, 0xe2, 0xe0, 0x84,
-	0x43, 0x14, 0x5e, 0x62, 0xc6, 0xe6, 0x38, 0x2b, 0x6a, 0x2d, 0xa8, 0xa4, 0x9c, 0x49, 0xe7, 0xc1,
-	0x19, 0x32, 0x9e, 0x19, 0xec, 0xb0, 0xa2, 0xde, 0x7f, 0xaa, 0x41, 0x7b, 0x88, 0x05, 0xce, 0x25,
-	0xba, 0x81, 0xb0, 0xf8, 0x60, 0x61, 0x42, 0x18, 0xcf, 0xcd, 0x2b, 0x05, 0xf5, 0x42, 0xe9, 0x17,
-	0x02, 0x9a, 0xc3, 0x17, 0x55, 0xc3, 0x50, 0x60, 0x45, 0xc2, 0x78, 0x82, 0x59, 0x4a, 0xca, 0x62,
-	0xef, 0xfe, 0xa5, 0xd8, 0xe7, 0xe3, 0xba, 0x05, 0x82, 0xab, 0x8a, 0x1f, 0x60, 0x45, 0x7a, 0x9a,
-	0x8e, 0xc6, 0xf0, 0xe2, 0x14, 0x9b, 0xe3, 0xa5, 0xf3, 0xf0, 0x5c, 0x71, 0x4f, 0x2a, 0xee, 0x00,
-	0x2f, 0xff, 0xc8, 0xa1, 0xcc, 0xa9, 0xfd, 0x87, 0x1c, 0xca, 0x50, 0x04, 0x1f, 0xa7, 0x1c, 0x67,
-	0x61, 0xc4, 0x59, 0x42, 0x12, 0xe7, 0xd1, 0xb9, 0x52, 0x60, 0x41, 0xed, 0x6a, 0x28, 0x6a, 0xc0,
-	0xa7, 0x51, 0xc6, 0xe3, 0xa9, 0x0c, 0x67, 0x44, 0x84, 0x2b, 0x82, 0x85, 0x63, 0xdf, 0x81, 0x66,
-	0x2d, 0xb8, 0x30, 0xf2, 0x90, 0x88, 0xf7, 0x04, 0x8b, 0x37, 0x37, 0x1f, 0x8e, 0xeb, 0x96, 0xf3,
-	0x5b, 0xc2, 0xd2, 0xac, 0x94, 0xf9, 0x21, 0xba, 0xbd, 0xcd, 0xde, 0x05, 0xdb, 0xbd, 0x0b, 0x7e,
-	0xee, 0x5d, 0xf0, 0xf1, 0xe0, 0x5a, 0xdb, 0x83, 0x6b, 0x7d, 0x3f, 0xb8, 0xd6, 0xe8, 0xd5, 0x5f,
-	0x7b, 0x96, 0x14, 0x5d, 0x37, 0xb2, 0xf5, 0xe6, 0xbc, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0xf6, 0x61, 0x3e, 0xb4, 0x03, 0x00, 0x00,
+	// 944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x3f, 0x6f, 0xdb, 0x46,
+	0x18, 0xc6, 0x45, 0xdb, 0x55, 0xaa, 0x53, 0x6c, 0xcb, 0x17, 0x3b, 0xa6, 0x85, 0x58, 0x66, 0x94,
+	0x34, 0x50, 0x8c, 0x46, 0x6a, 0xdc, 0xad, 0xe8, 0xa2, 0x3f, 0x74, 0x4d, 0x40, 0x96, 0x05, 0x4a,
+	0x69, 0xe1, 0x2c, 0x87, 0x13, 0x75, 0x11, 0x0f, 0x26, 0x8f, 0x04, 0x79, 0x72, 0xe5, 0xa1, 0x43,
+	0xb7, 0x56, 0x53, 0xbe, 0x80, 0xa7, 0x0e, 0xed, 0x98, 0xa1, 0x1f, 0x22, 0x4b, 0xd1, 0xa0, 0x53,
+	0xd1, 0x21, 0x28, 0xec, 0x21, 0x5f, 0xa3, 0xe0, 0x91, 0xa2, 0x6c, 0x49, 0x0e, 0x0a, 0x44, 0x59,
+	0x24, 0xf1, 0xb9, 0xbb, 0xdf, 0x73, 0xf7, 0xde, 0xfb, 0xbe, 0x22, 0xc8, 0x19, 0x8e, 0x6f, 0x3b,
+	0x7e, 0xc9, 0xa6, 0x8c, 0x97, 0x4e, 0x9f, 0x76, 0x08, 0xc7, 0x4f, 0xc5, 0x43, 0xd1, 0xf5, 0x1c,
+	0xee, 0xc0, 0x3b, 0xe1, 0x78, 0x51, 0x48, 0xd1, 0x78, 0x76, 0xbd, 0xe7, 0xf4, 0x1c, 0x31, 0x5e,
+	0x0a, 0x7e, 0x85, 0x53, 0xb3, 0x5b, 0xe1, 0x54, 0x14, 0x0e, 0x44, 0xeb, 0xc2, 0xa1, 0x35, 0x6c,
+	0x53, 0xe6, 0x94, 0xc4, 0x67, 0x28, 0xe5, 0xff, 0x94, 0x40, 0xf2, 0x90, 0x32, 0x4e, 0x3c, 0xf8,
+	0x1c, 0xa4, 0x28, 0x7b, 0x61, 0x61, 0x4e, 0x1d, 0x26, 0x4b, 0x8a, 0x54, 0x48, 0x55, 0xbe, 0x7e,
+	0xfd, 0x76, 0x27, 0xf1, 0xcf, 0xdb, 0x9d, 0x47, 0x3d, 0xca, 0xcd, 0x7e, 0xa7, 0x68, 0x38, 0x76,
+	0x44, 0x8c, 0xbe, 0x9e, 0xf8, 0xdd, 0x93, 0x12, 0x3f, 0x73, 0x89, 0x5f, 0xac, 0x11, 0xe3, 0xaf,
+	0xdf, 0x9f, 0x80, 0xc8, 0xb0, 0x46, 0x0c, 0x7d, 0x8c, 0x83, 0x14, 0xac, 0x61, 0xc6, 0xfa, 0xd8,
+	0x0a, 0xb6, 0x75, 0x4a, 0x7d, 0xea, 0x30, 0x5f, 0x5e, 0x98, 0x83, 0x47, 0x26, 0xc4, 0x36, 0x63,
+	0x6a, 0xfe, 0xe5, 0x2d, 0x90, 0x6c, 0x62, 0x0f, 0xdb, 0x3e, 0xdc, 0x06, 0x20, 0x08, 0x18, 0xea,
+	0x12, 0xe6, 0xd8, 0xe1, 0x91, 0xf4, 0x54, 0xa0, 0xd4, 0x02, 0x01, 0xf6, 0xc1, 0x46, 0xbc, 0x43,
+	0xe4, 0x61, 0x4e, 0x90, 0x61, 0x62, 0xd6, 0x23, 0xd1, 0xc6, 0xca, 0x1f, 0xb2, 0xb1, 0xdf, 0xde,
+	0xbd, 0xda, 0x95, 0xf4, 0x3b, 0x31, 0x5f, 0xc7, 0x9c, 0x54, 0x05, 0x1d, 0xbe, 0x00, 0xcb, 0x63,
+	0x5b, 0x1b, 0x0f, 0xe4, 0xc5, 0x79, 0xd9, 0xdd, 0x8e, 0xb9, 0x87, 0x78, 0x30, 0xe1, 0x43, 0x99,
+	0xbc, 0xf4, 0x11, 0x7c, 0x28, 0x83, 0x1d, 0x90, 0xee, 0x39, 0xd8, 0x42, 0x1d, 0x87, 0x75, 0x49,
+	0x57, 0xfe, 0x64, 0x5e, 0x2e, 0x20, 0xa0, 0x56, 0x04, 0x14, 0x3e, 0x02, 0xab, 0x1d, 0xcb, 0x31,
+	0x4e, 0x7c, 0xe4, 0x12, 0x0f, 0x9d, 0x11, 0xec, 0xc9, 0x49, 0x45, 0x2a, 0x2c, 0xe9, 0xcb, 0xa1,
+	0xdc, 0x24, 0xde, 0x31, 0xc1, 0x1e, 0x3c, 0x06, 0x70, 0x7c, 0x66, 0xdf, 0x30, 0x49, 0xb7, 0x6f,
+	0x11, 0xf9, 0x96, 0x22, 0x15, 0x56, 0xf6, 0x76, 0x8b, 0x33, 0x8a, 0xa8, 0xa8, 0x8d, 0xa6, 0xb7,
+	0xa2, 0xd9, 0xed, 0x33, 0x97, 0xe8, 0x6b, 0x74, 0x52, 0x86, 0x47, 0x20, 0x63, 0x62, 0xeb, 0x94,
+	0xb2, 0xde, 0x18, 0xfc, 0xa9, 0x22, 0x15, 0xd2, 0x7b, 0x0f, 0x67, 0x82, 0x0f, 0xc2, 0xc9, 0xa3,
+	0xf5, 0xfa, 0xaa, 0x79, 0x5d, 0x80, 0x26, 0xd8, 0x72, 0x29, 0x31, 0xc8, 0xf7, 0xd4, 0x27, 0xc8,
+	0xa2, 0x8c, 0x60, 0x6f, 0x4c, 0x4e, 0x09, 0xf2, 0xe7, 0x33, 0xc9, 0xcd, 0xd1, 0xaa, 0xba, 0x58,
+	0x14, 0x3b, 0x6c, 0xba, 0xb3, 0x07, 0x20, 0x02, 0x77, 0x39, 0xa6, 0x16, 0x22, 0x36, 0xf5, 0xfd,
+	0x6b, 0x91, 0x01, 0xc2, 0xe6, 0xf1, 0x4c, 0x9b, 0x36, 0xa6, 0x96, 0x1a, 0xad, 0x88, 0x3d, 0xd6,
+	0xf9, 0x0c, 0xf5, 0xab, 0xed, 0xe1, 0xbb, 0x57, 0xbb, 0xf2, 0x95, 0x8b, 0x1d, 0x84, 0x9d, 0x2c,
+	0xac, 0xc3, 0xfc, 0xaf, 0x0b, 0x60, 0x75, 0x22, 0x1c, 0xf0, 0x3e, 0xb8, 0xed, 0x73, 0xec, 0x71,
+	0x64, 0x12, 0xda, 0x33, 0xb9, 0xa8, 0xce, 0x45, 0x3d, 0x2d, 0xb4, 0x03, 0x21, 0x05, 0x53, 0x88,
+	0xeb, 0x18, 0x26, 0x0a, 0xef, 0x58, 0x94, 0xe5, 0x92, 0x9e, 0x16, 0x5a, 0x45, 0x48, 0xf0, 0x07,
+	0xb0, 0x45, 0x19, 0xe5, 0x14, 0x5b, 0x68, 0xba, 0xbf, 0xcc, 0xad, 0xae, 0x36, 0x23, 0x8f, 0xf2,
+	0x44, 0xaf, 0x81, 0x47, 0x00, 0xd8, 0x78, 0x80, 0xfc, 0xbe, 0xeb, 0x5a, 0x67, 0x51, 0x7d, 0x7d,
+	0x11, 0xf9, 0x6d, 0x84, 0x14, 0xbf, 0x7b, 0x52, 0xa4, 0x4e, 0xc9, 0xc6, 0xdc, 0x2c, 0x6a, 0x8c,
+	0x5f, 0xc1, 0x6b, 0x8c, 0x87, 0xf8, 0x94, 0x8d, 0x07, 0x2d, 0x81, 0xc8, 0xff, 0x2c, 0x81, 0x95,
+	0x38, 0x23, 0x9b, 0x0e, 0x65, 0x1c, 0xde, 0x05, 0xc9, 0x6b, 0x21, 0x8a, 0x9e, 0x20, 0xba, 0xda,
+	0xae, 0xe7, 0xd6, 0xb1, 0xc6, 0xcc, 0x3c, 0x06, 0x9b, 0x37, 0x64, 0x1a, 0xdc, 0x07, 0x49, 0x37,
+	0xd8, 0x9c, 0x2f, 0x4b, 0xca, 0x62, 0x21, 0xbd, 0xf7, 0xe0, 0xfd, 0xa5, 0x25, 0x0e, 0x52, 0x49,
+	0x05, 0xbb, 0x0b, 0x5d, 0xa2, 0xd5, 0xf9, 0x1f, 0x17, 0xc1, 0xfa, 0xac, 0x34, 0xfb, 0x3f, 0xd9,
+	0xc1, 0xc0, 0xda, 0xe8, 0xea, 0x3f, 0x42, 0x1c, 0x32, 0x11, 0x3b, 0x3e, 0x03, 0xb4, 0x40, 0xf4,
+	0x5f, 0x83, 0x3c, 0xd2, 0xed, 0x1b, 0xc2, 0x6e, 0x6e, 0x19, 0xb6, 0x1a, 0xa2, 0xf5, 0x11, 0x19,
+	0x9a, 0x60, 0x45, 0x94, 0xec, 0xf8, 0x68, 0x73, 0xeb, 0xde, 0xcb, 0x01, 0x38, 0x3e, 0xd7, 0xee,
+	0x1f, 0x0b, 0x60, 0x63, 0x66, 0x13, 0x84, 0x1a, 0x78, 0xa0, 0x35, 0xf6, 0xeb, 0xe5, 0xb6, 0x76,
+	0xd4, 0x40, 0xad, 0xea, 0x81, 0x5a, 0x7b, 0x56, 0x57, 0x51, 0xfb, 0xb8, 0xa9, 0xa2, 0x67, 0x8d,
+	0x56, 0x53, 0xad, 0x6a, 0xfb, 0x9a, 0x5a, 0xcb, 0x24, 0xb2, 0xca, 0xf0, 0x5c, 0xb9, 0x37, 0xc5,
+	0x08, 0x5b, 0xb7, 0x1e, 0x68, 0xb0, 0x0c, 0x76, 0x6e, 0x42, 0x1d, 0x94, 0xeb, 0xdf, 0x6a, 0x8d,
+	0x6f, 0x32, 0x52, 0xf6, 0xde, 0xf0, 0x5c, 0x91, 0xa7, 0x30, 0x51, 0xe3, 0x80, 0x3a, 0x28, 0xdc,
+	0x84, 0x68, 0x6a, 0x6a, 0x55, 0xfd, 0x4e, 0x6b, 0xa9, 0xa8, 0xae, 0x35, 0xd4, 0xb2, 0x9e, 0x59,
+	0xc8, 0x3e, 0x1c, 0x9e, 0x2b, 0xca, 0x14, 0x6b, 0x22, 0x9f, 0x61, 0x1d, 0x7c, 0x76, 0x13, 0xb3,
+	0x5d, 0xd6, 0xea, 0x48, 0x3d, 0xd4, 0x5a, 0x2d, 0xed, 0xa8, 0x91, 0x59, 0xcc, 0xde, 0x1f, 0x9e,
+	0x2b, 0xdb, 0xd3, 0x71, 0xba, 0x92, 0xbc, 0xd9, 0xa5, 0x9f, 0x7e, 0xc9, 0x25, 0x2a, 0xd5, 0xd7,
+	0x17, 0x39, 0xe9, 0xcd, 0x45, 0x4e, 0xfa, 0xf7, 0x22, 0x27, 0xbd, 0xbc, 0xcc, 0x25, 0xde, 0x5c,
+	0xe6, 0x12, 0x7f, 0x5f, 0xe6, 0x12, 0xcf, 0x1f, 0xbf, 0xf7, 0xce, 0xa2, 0x96, 0x29, 0xae, 0xae,
+	0x93, 0x14, 0x6f, 0x67, 0x5f, 0xfe, 0x37, 0x00, 0x0c, 0xa6, 0xe6, 0xed, 0x18, 0x0a, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -231,6 +579,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TailEmissionSchedule != nil {
+		{
+			size, err := m.TailEmissionSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PiecewiseLinearSchedule != nil {
+		{
+			size, err := m.PiecewiseLinearSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.HalvingSchedule != nil {
+		{
+			size, err := m.HalvingSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.InflationSchedule != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationSchedule))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -286,61 +675,810 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HalvingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *HalvingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HalvingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitialAnnualProvisions.Size()
+		i -= size
+		if _, err := m.InitialAnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PiecewiseLinearSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PiecewiseLinearSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PiecewiseLinearSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TailEmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TailEmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TailEmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TailInflation.Size()
+		i -= size
+		if _, err := m.TailInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AnnualReduction.Size()
+		i -= size
+		if _, err := m.AnnualReduction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialInflation.Size()
+		i -= size
+		if _, err := m.InitialInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	if m.InflationSchedule != 0 {
+		n += 1 + sovMint(uint64(m.InflationSchedule))
+	}
+	if m.HalvingSchedule != nil {
+		l = m.HalvingSchedule.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.PiecewiseLinearSchedule != nil {
+		l = m.PiecewiseLinearSchedule.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.TailEmissionSchedule != nil {
+		l = m.TailEmissionSchedule.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *HalvingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EpochBlocks != 0 {
+		n += 1 + sovMint(uint64(m.EpochBlocks))
+	}
+	l = m.InitialAnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *PiecewiseLinearSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TailEmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = m.InitialInflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualReduction.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.TailInflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			m.InflationSchedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationSchedule |= InflationScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HalvingSchedule == nil {
+				m.HalvingSchedule = &HalvingSchedule{}
+			}
+			if err := m.HalvingSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseLinearSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PiecewiseLinearSchedule == nil {
+				m.PiecewiseLinearSchedule = &PiecewiseLinearSchedule{}
+			}
+			if err := m.PiecewiseLinearSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailEmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TailEmissionSchedule == nil {
+				m.TailEmissionSchedule = &TailEmissionSchedule{}
+			}
+			if err := m.TailEmissionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	l = m.InflationRateChange.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMax.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationMin.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.GoalBonded.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *HalvingSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -363,15 +1501,53 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: HalvingSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HalvingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAnnualProvisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -399,13 +1575,13 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialAnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -433,7 +1609,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -458,7 +1634,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *InflationPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -481,17 +1657,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -501,27 +1677,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMint
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMint
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -549,15 +1712,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PiecewiseLinearSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PiecewiseLinearSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PiecewiseLinearSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -567,29 +1780,98 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Points = append(m.Points, InflationPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TailEmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TailEmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TailEmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialInflation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -617,13 +1899,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualReduction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -651,15 +1933,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnnualReduction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailInflation", wireType)
 			}
-			m.BlocksPerYear = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -669,11 +1951,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TailInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -60,6 +60,9 @@ func (p Params) Validate() error {
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if err := validateInflationSchedule(p); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -269,6 +271,164 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryProjectedSupplyRequest is the request type for the
+// Query/ProjectedSupply RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryProjectedSupplyRequest struct {
+	// step_blocks is the number of blocks between two projections, the number of
+	// blocks per year if zero.
+	StepBlocks uint64 `protobuf:"varint,1,opt,name=step_blocks,json=stepBlocks,proto3" json:"step_blocks,omitempty"`
+	// steps is the number of projections, 10 if zero and at most 100.
+	Steps uint32 `protobuf:"varint,2,opt,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (m *QueryProjectedSupplyRequest) Reset()         { *m = QueryProjectedSupplyRequest{} }
+func (m *QueryProjectedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedSupplyRequest) ProtoMessage()    {}
+func (*QueryProjectedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryProjectedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedSupplyRequest.Merge(m, src)
+}
+func (m *QueryProjectedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedSupplyRequest) GetStepBlocks() uint64 {
+	if m != nil {
+		return m.StepBlocks
+	}
+	return 0
+}
+
+func (m *QueryProjectedSupplyRequest) GetSteps() uint32 {
+	if m != nil {
+		return m.Steps
+	}
+	return 0
+}
+
+// QueryProjectedSupplyResponse is the response type for the
+// Query/ProjectedSupply RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryProjectedSupplyResponse struct {
+	Projections []SupplyProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryProjectedSupplyResponse) Reset()         { *m = QueryProjectedSupplyResponse{} }
+func (m *QueryProjectedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedSupplyResponse) ProtoMessage()    {}
+func (*QueryProjectedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryProjectedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedSupplyResponse.Merge(m, src)
+}
+func (m *QueryProjectedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedSupplyResponse) GetProjections() []SupplyProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// SupplyProjection defines the projected supply of the mint denom at the end
+// of a step, and the inflation rate and annual provisions during the step.
+//
+// Since: cosmos-sdk 0.48
+type SupplyProjection struct {
+	Height           int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Supply           cosmossdk_io_math.Int                  `protobuf:"bytes,2,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	Inflation        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+}
+
+func (m *SupplyProjection) Reset()         { *m = SupplyProjection{} }
+func (m *SupplyProjection) String() string { return proto.CompactTextString(m) }
+func (*SupplyProjection) ProtoMessage()    {}
+func (*SupplyProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *SupplyProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyProjection.Merge(m, src)
+}
+func (m *SupplyProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyProjection proto.InternalMessageInfo
+
+func (m *SupplyProjection) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -276,42 +436,58 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryProjectedSupplyRequest)(nil), "cosmos.mint.v1beta1.QueryProjectedSupplyRequest")
+	proto.RegisterType((*QueryProjectedSupplyResponse)(nil), "cosmos.mint.v1beta1.QueryProjectedSupplyResponse")
+	proto.RegisterType((*SupplyProjection)(nil), "cosmos.mint.v1beta1.SupplyProjection")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x85, 0xc8, 0x0b, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0x92, 0x08,
-	0x97, 0x50, 0x20, 0xc8, 0x29, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x41, 0xa9, 0x85, 0xa5, 0xa9,
-	0xc5, 0x25, 0x4a, 0xa1, 0x5c, 0xc2, 0x28, 0xa2, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x76,
-	0x5c, 0x6c, 0x05, 0x60, 0x11, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x2e,
-	0xd7, 0x83, 0x68, 0x72, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41,
-	0x50, 0x5d, 0x4a, 0xe2, 0x5c, 0xa2, 0x60, 0x63, 0x3d, 0xf3, 0xd2, 0x72, 0xc0, 0x0e, 0x87, 0xd9,
-	0x97, 0xcd, 0x25, 0x86, 0x2e, 0x01, 0xb5, 0x32, 0x90, 0x8b, 0x33, 0x13, 0x26, 0x08, 0xb6, 0x95,
-	0xc7, 0xc9, 0x18, 0x64, 0xf0, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x8f, 0x43, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82,
-	0xd4, 0x62, 0x3d, 0x97, 0xd4, 0x64, 0x88, 0x13, 0x10, 0xa6, 0x28, 0xc9, 0x71, 0xc9, 0x80, 0x2d,
-	0x73, 0xcc, 0xcb, 0x2b, 0x4d, 0xcc, 0x09, 0x28, 0xca, 0x2f, 0xcb, 0x2c, 0x06, 0x05, 0x22, 0xcc,
-	0x31, 0x8d, 0x8c, 0x5c, 0xb2, 0x38, 0x14, 0x40, 0x1d, 0x95, 0xc0, 0x25, 0x98, 0x08, 0x96, 0x8b,
-	0x2f, 0x80, 0x4b, 0x52, 0xe2, 0x38, 0x81, 0x44, 0x34, 0x9b, 0x8c, 0x8e, 0x32, 0x73, 0xb1, 0x82,
-	0xdd, 0x20, 0xd4, 0xc0, 0xc8, 0xc5, 0x06, 0x09, 0x51, 0x21, 0x75, 0xac, 0xc1, 0x8d, 0x19, 0x7d,
-	0x52, 0x1a, 0x84, 0x15, 0x42, 0x7c, 0xa2, 0xa4, 0xdc, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x59, 0x21,
-	0x69, 0x7d, 0x6c, 0x49, 0x07, 0x12, 0x6d, 0x42, 0x3d, 0x8c, 0x5c, 0x9c, 0xf0, 0x98, 0x11, 0xd2,
-	0xc2, 0x6d, 0x38, 0x7a, 0xbc, 0x4a, 0x69, 0x13, 0xa5, 0x16, 0xea, 0x16, 0x35, 0xb0, 0x5b, 0x14,
-	0x84, 0xe4, 0xb0, 0xba, 0x05, 0x1e, 0x7f, 0x42, 0x2b, 0x19, 0xb9, 0x04, 0xd0, 0xa3, 0x46, 0xc8,
-	0x10, 0xb7, 0x4d, 0x38, 0xe2, 0x59, 0xca, 0x88, 0x14, 0x2d, 0x50, 0x37, 0xea, 0x81, 0xdd, 0xa8,
-	0x21, 0xa4, 0x86, 0xd5, 0x8d, 0x18, 0x89, 0xc2, 0xc9, 0xf9, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xf1, 0x26, 0x90, 0x0a, 0x88, 0xc1, 0xe0, 0x74, 0x92, 0xc4, 0x06,
-	0xce, 0xaa, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0xdf, 0xd1, 0xc0, 0x49, 0x04, 0x00,
-	0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0xf0, 0xa3, 0x49, 0x5f, 0x35, 0xc2, 0xf0, 0x43, 0x2c, 0xb0, 0x25, 0x6b, 0x80, 0x8a,
+	0x61, 0x97, 0x96, 0xbb, 0x09, 0xd5, 0x83, 0xdc, 0xa0, 0xea, 0xc5, 0x4b, 0xdd, 0x96, 0xb1, 0x5d,
+	0xdb, 0x9d, 0x59, 0x76, 0xa6, 0x44, 0x6e, 0xea, 0xd9, 0x83, 0x89, 0x7f, 0x83, 0x89, 0xde, 0x3c,
+	0xf8, 0x2f, 0x98, 0x70, 0x24, 0x7a, 0x31, 0x1e, 0x88, 0x01, 0x13, 0x4f, 0xfe, 0x0f, 0x66, 0x67,
+	0xa6, 0x50, 0x97, 0x29, 0xda, 0xe8, 0xa5, 0xed, 0xbc, 0xf7, 0xbd, 0xef, 0xfb, 0x66, 0xdf, 0x7b,
+	0x5b, 0xc8, 0xd7, 0x19, 0x0f, 0x18, 0x77, 0x03, 0x9f, 0x0a, 0x77, 0xaf, 0x58, 0x23, 0xc2, 0x2b,
+	0xba, 0xbb, 0x1d, 0x12, 0xed, 0x3b, 0x61, 0xc4, 0x04, 0xc3, 0x13, 0x0a, 0xe0, 0xc4, 0x00, 0x47,
+	0x03, 0x72, 0x93, 0x0d, 0xd6, 0x60, 0x32, 0xef, 0xc6, 0xbf, 0x14, 0x34, 0x37, 0xd7, 0x60, 0xac,
+	0xd1, 0x26, 0xae, 0x17, 0xfa, 0xae, 0x47, 0x29, 0x13, 0x9e, 0xf0, 0x19, 0xe5, 0x3a, 0x6b, 0x99,
+	0x94, 0x24, 0xab, 0xca, 0x8f, 0x7b, 0x81, 0x4f, 0x99, 0x2b, 0x3f, 0x75, 0xe8, 0x9a, 0x2a, 0xa9,
+	0x2a, 0x25, 0x6d, 0x44, 0x1e, 0xec, 0x49, 0xc0, 0xdb, 0xb1, 0xcb, 0x2d, 0x2f, 0xf2, 0x02, 0x5e,
+	0x21, 0xbb, 0x1d, 0xc2, 0x85, 0xfd, 0x00, 0x26, 0x7e, 0x8b, 0xf2, 0x90, 0x51, 0x4e, 0xf0, 0x2d,
+	0x48, 0x87, 0x32, 0x32, 0x83, 0x16, 0x50, 0x21, 0x5b, 0x9a, 0x75, 0x0c, 0x97, 0x72, 0x54, 0x51,
+	0x39, 0x73, 0x70, 0x94, 0x4f, 0xbd, 0xfd, 0xf1, 0x7e, 0x05, 0x55, 0x74, 0x95, 0x7d, 0x15, 0xa6,
+	0x24, 0xed, 0x26, 0x7d, 0xdc, 0x96, 0x77, 0xea, 0xea, 0xb5, 0x60, 0x3a, 0x99, 0xd0, 0x92, 0xdb,
+	0x90, 0xf1, 0xbb, 0x41, 0xa9, 0x7a, 0xa9, 0xbc, 0x1e, 0x13, 0x7f, 0x3d, 0xca, 0x2f, 0x35, 0x7c,
+	0xd1, 0xec, 0xd4, 0x9c, 0x3a, 0x0b, 0xf4, 0x9d, 0xf4, 0xd7, 0x2a, 0xdf, 0x69, 0xb9, 0x62, 0x3f,
+	0x24, 0xdc, 0xb9, 0x43, 0xea, 0xca, 0xc2, 0x19, 0x8b, 0x6d, 0xc1, 0x9c, 0x14, 0xdb, 0xa0, 0xb4,
+	0xe3, 0xb5, 0xb7, 0x22, 0xb6, 0xe7, 0xf3, 0xf8, 0xf9, 0x76, 0xcd, 0x3c, 0x47, 0x30, 0xdf, 0x07,
+	0xa0, 0x4d, 0x3d, 0x82, 0x71, 0x4f, 0xe6, 0xaa, 0xe1, 0x69, 0xf2, 0x5f, 0xcc, 0x8d, 0x79, 0x09,
+	0x25, 0xfb, 0x3e, 0xcc, 0xaa, 0x06, 0x44, 0xec, 0x09, 0xa9, 0x0b, 0xb2, 0x73, 0xaf, 0x13, 0x86,
+	0xed, 0x7d, 0x6d, 0x11, 0xe7, 0x21, 0xcb, 0x05, 0x09, 0xab, 0xb5, 0x36, 0xab, 0xb7, 0x94, 0xf4,
+	0x48, 0x05, 0xe2, 0x50, 0x59, 0x46, 0xf0, 0x24, 0x8c, 0xc6, 0x27, 0x3e, 0x33, 0xb4, 0x80, 0x0a,
+	0x97, 0x2b, 0xea, 0x60, 0x47, 0x30, 0x67, 0x66, 0xd5, 0xf7, 0xaa, 0x40, 0x36, 0x54, 0x29, 0x7d,
+	0xa3, 0xe1, 0x42, 0xb6, 0xb4, 0x68, 0x6c, 0xb2, 0xaa, 0xdc, 0x3a, 0x45, 0xf7, 0xb6, 0xbb, 0x97,
+	0xc4, 0xfe, 0x38, 0x04, 0x63, 0x49, 0x30, 0x9e, 0x86, 0x74, 0x93, 0xf8, 0x8d, 0xa6, 0x90, 0xd6,
+	0x87, 0x2b, 0xfa, 0x84, 0xef, 0x42, 0x9a, 0x4b, 0xac, 0xf4, 0x9d, 0x29, 0xaf, 0xe9, 0xa7, 0x39,
+	0xa5, 0x2c, 0xf0, 0x9d, 0x96, 0xe3, 0x33, 0x37, 0xf0, 0x44, 0xd3, 0xd9, 0xa4, 0xe2, 0xd3, 0x87,
+	0x55, 0xd0, 0xde, 0x36, 0xa9, 0xd0, 0xa3, 0xa6, 0xea, 0x71, 0xb5, 0x77, 0x6e, 0x86, 0x25, 0xd9,
+	0xc6, 0x60, 0xad, 0xe9, 0x61, 0x37, 0x4c, 0x11, 0xa6, 0xa6, 0x19, 0x18, 0xf9, 0x5f, 0x42, 0xe7,
+	0x26, 0xa2, 0xf4, 0x73, 0x04, 0x46, 0x65, 0xf3, 0xf0, 0x33, 0x04, 0x69, 0xb5, 0x63, 0x78, 0xd9,
+	0xd8, 0x9b, 0xf3, 0x0b, 0x9d, 0x2b, 0xfc, 0x19, 0xa8, 0x66, 0xc0, 0xbe, 0xfe, 0xe2, 0xf3, 0xf7,
+	0xd7, 0x43, 0xf3, 0x78, 0xd6, 0x35, 0xbd, 0x67, 0xd4, 0x22, 0xe3, 0x97, 0x08, 0x32, 0xa7, 0xbb,
+	0x8a, 0x57, 0xfa, 0x93, 0x27, 0x37, 0x3d, 0x77, 0xf3, 0xaf, 0xb0, 0xda, 0xcb, 0x92, 0xf4, 0xb2,
+	0x80, 0x2d, 0xa3, 0x97, 0xb3, 0x5e, 0xbc, 0x43, 0x30, 0x96, 0x5c, 0x56, 0x5c, 0xec, 0xaf, 0xd4,
+	0x67, 0xf3, 0x73, 0xa5, 0x41, 0x4a, 0xb4, 0x47, 0x47, 0x7a, 0x2c, 0xe0, 0x25, 0xa3, 0xc7, 0x73,
+	0x23, 0x82, 0xdf, 0x20, 0xb8, 0x92, 0xd8, 0x3f, 0xbc, 0x76, 0x41, 0x77, 0x8c, 0x2f, 0x80, 0x5c,
+	0x71, 0x80, 0x0a, 0x6d, 0x74, 0x55, 0x1a, 0x5d, 0xc6, 0x8b, 0xe6, 0xc6, 0x76, 0xab, 0xaa, 0x6a,
+	0x81, 0xca, 0xb7, 0x0f, 0x8e, 0x2d, 0x74, 0x78, 0x6c, 0xa1, 0x6f, 0xc7, 0x16, 0x7a, 0x75, 0x62,
+	0xa5, 0x0e, 0x4f, 0xac, 0xd4, 0x97, 0x13, 0x2b, 0xf5, 0xf0, 0xc6, 0x85, 0x63, 0xfd, 0x54, 0xf1,
+	0xca, 0xe9, 0xae, 0xa5, 0xe5, 0x9f, 0xcc, 0xfa, 0xaf, 0x01, 0x00, 0x64, 0x2b, 0x70, 0x23, 0x1e,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -332,6 +508,11 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedSupply returns the projected supply of the mint denom over time
+	// under the current parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -369,6 +550,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error) {
+	out := new(QueryProjectedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/ProjectedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -377,6 +567,11 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedSupply returns the projected supply of the mint denom over time
+	// under the current parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	ProjectedSupply(context.Context, *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -392,6 +587,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedSupply(ctx context.Context, req *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -451,6 +649,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/ProjectedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedSupply(ctx, req.(*QueryProjectedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -467,6 +683,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "ProjectedSupply",
+			Handler:    _Query_ProjectedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -640,6 +860,134 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Steps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Steps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StepBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StepBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -711,6 +1059,54 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StepBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.StepBlocks))
+	}
+	if m.Steps != 0 {
+		n += 1 + sovQuery(uint64(m.Steps))
+	}
+	return n
+}
+
+func (m *QueryProjectedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1116,6 +1512,349 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectedSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepBlocks", wireType)
+			}
+			m.StepBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			m.Steps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Steps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, SupplyProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -60,6 +61,33 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) er
 	return h.k.AddPubkey(ctx, consPk)
 }
 
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new
+// consensus public key of a validator and moves its signing info and missed
+// blocks to the new consensus address. The address-pubkey relation of the old
+// key is kept so that evidence of infractions committed with it can still be
+// handled.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.Coin) error {
+	if err := h.k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	// validators which were never bonded have no signing info
+	signingInfo, found := h.k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return nil
+	}
+
+	signingInfo.Address = newConsAddr.String()
+	h.k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+	h.k.deleteValidatorSigningInfo(ctx, oldConsAddr)
+	h.k.moveMissedBlockBitmap(ctx, oldConsAddr, newConsAddr)
+
+	return nil
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
@@ -91,3 +119,7 @@ func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdkmath
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+func (h Hooks) BeforeTokenizeShareRecordOwnerChanged(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.AccAddress) error {
+	return nil
+}
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -41,3 +44,40 @@ func (s *KeeperTestSuite) TestAfterValidatorCreatedOrRemoved() {
 	_, err = keeper.GetPubkey(ctx, addr.Bytes())
 	require.Error(err)
 }
+
+func (s *KeeperTestSuite) TestAfterConsensusPubKeyUpdate() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, oldPubKey, _ := testdata.KeyTestPubAddr()
+	_, newPubKey, _ := testdata.KeyTestPubAddr()
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	require.NoError(keeper.AddPubkey(ctx, oldPubKey))
+	keeper.SetValidatorSigningInfo(ctx, oldConsAddr, slashingtypes.NewValidatorSigningInfo(oldConsAddr, 1, 0, time.Unix(0, 0), false, 1))
+	require.NoError(keeper.SetMissedBlockBitmapValue(ctx, oldConsAddr, 3, true))
+
+	err := keeper.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, sdk.Coin{})
+	require.NoError(err)
+
+	// the signing info and missed blocks follow the validator to its new key
+	require.False(keeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+	info, found := keeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(found)
+	require.Equal(newConsAddr.String(), info.Address)
+	require.Equal(int64(1), info.MissedBlocksCounter)
+
+	missed, err := keeper.GetMissedBlockBitmapValue(ctx, newConsAddr, 3)
+	require.NoError(err)
+	require.True(missed)
+	require.Empty(keeper.GetValidatorMissedBlocks(ctx, oldConsAddr))
+
+	// both keys are still known to handle evidence
+	pk, err := keeper.GetPubkey(ctx, oldPubKey.Address())
+	require.NoError(err)
+	require.Equal(oldPubKey, pk)
+	pk, err = keeper.GetPubkey(ctx, newPubKey.Address())
+	require.NoError(err)
+	require.Equal(newPubKey, pk)
+}
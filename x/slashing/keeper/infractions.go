@@ -24,6 +24,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		return
 	}
 
+	// the validator may have rotated its consensus key, in which case its
+	// signing info is stored under its current consensus address
+	if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil {
+		currentConsAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+		consAddr = currentConsAddr
+	}
+
 	// fetch signing info
 	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
@@ -104,7 +114,17 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx), stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			// A downtime within the lookback period of the end of the previous
+			// downtime jailing is a repeat offense and is penalized more heavily.
+			params := k.GetParams(ctx)
+			repeatOffenses := int64(0)
+			if params.DowntimeLookbackPeriod > 0 && signInfo.DowntimeJailCount > 0 &&
+				!ctx.BlockHeader().Time.After(signInfo.JailedUntil.Add(params.DowntimeLookbackPeriod)) {
+				repeatOffenses = signInfo.DowntimeJailCount
+			}
+			slashFraction, jailDuration := params.DowntimePenalty(repeatOffenses)
+
+			coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -113,11 +133,14 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+					sdk.NewAttribute(types.AttributeKeySlashFraction, slashFraction.String()),
+					sdk.NewAttribute(types.AttributeKeyDowntimeJailCount, fmt.Sprintf("%d", repeatOffenses+1)),
 				),
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+			signInfo.DowntimeJailCount = repeatOffenses + 1
 
 			// We need to reset the counter & bitmap so that the validator won't be
 			// immediately slashed for downtime upon re-bonding.
@@ -131,8 +154,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
+				"downtime_jail_count", signInfo.DowntimeJailCount,
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
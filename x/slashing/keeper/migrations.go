@@ -6,6 +6,7 @@ import (
 	v2 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v2"
 	v3 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -38,3 +39,10 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.Migrate(ctx, m.keeper.cdc, ctx.KVStore(m.keeper.storeKey), m.keeper.GetParams(ctx))
 }
+
+// Migrate4to5 migrates the x/slashing module state from the consensus
+// version 4 to version 5. Specifically, it sets the parameters of the
+// progressive downtime penalties.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
@@ -115,16 +115,61 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid slash fraction downtime multiplier",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeLookbackPeriod:          24 * time.Hour,
+					SlashFractionDowntimeMultiplier: sdkmath.LegacyNewDecWithPrec(5, 1),
+					MaxSlashFractionDowntime:        slashFractionDoubleSign,
+					DowntimeJailDurationMultiplier:  sdkmath.LegacyNewDec(2),
+					MaxDowntimeJailDuration:         time.Duration(34800000000000),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "downtime penalty multiplier cannot be less than one",
+		},
+		{
+			name: "set max slash fraction downtime below slash fraction downtime",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeLookbackPeriod:          24 * time.Hour,
+					SlashFractionDowntimeMultiplier: sdkmath.LegacyNewDec(2),
+					MaxSlashFractionDowntime:        sdkmath.LegacyNewDecWithPrec(1, 3),
+					DowntimeJailDurationMultiplier:  sdkmath.LegacyNewDec(2),
+					MaxDowntimeJailDuration:         time.Duration(34800000000000),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "cannot be less than the downtime slash fraction",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: slashingtypes.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:              int64(750),
+					MinSignedPerWindow:              minSignedPerWindow,
+					DowntimeJailDuration:            time.Duration(34800000000000),
+					SlashFractionDoubleSign:         slashFractionDoubleSign,
+					SlashFractionDowntime:           slashFractionDowntime,
+					DowntimeLookbackPeriod:          24 * time.Hour,
+					SlashFractionDowntimeMultiplier: sdkmath.LegacyNewDec(2),
+					MaxSlashFractionDowntime:        slashFractionDoubleSign,
+					DowntimeJailDurationMultiplier:  sdkmath.LegacyNewDec(2),
+					MaxDowntimeJailDuration:         2 * time.Duration(34800000000000),
 				},
 			},
 			expectErr: false,
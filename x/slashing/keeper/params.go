@@ -39,6 +39,12 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdkmath.LegacyDec) {
 	return k.GetParams(ctx).SlashFractionDowntime
 }
 
+// DowntimeLookbackPeriod - period after a downtime jailing within which a new
+// downtime is a repeat offense
+func (k Keeper) DowntimeLookbackPeriod(ctx sdk.Context) (res time.Duration) {
+	return k.GetParams(ctx).DowntimeLookbackPeriod
+}
+
 // GetParams returns the current x/slashing module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -40,6 +40,12 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(types.ValidatorSigningInfoKey(address), bz)
 }
 
+// deleteValidatorSigningInfo removes the validator signing info of a consensus address
+func (k Keeper) deleteValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorSigningInfoKey(address))
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool),
@@ -183,6 +189,30 @@ func (k Keeper) DeleteMissedBlockBitmap(ctx sdk.Context, addr sdk.ConsAddress) {
 	}
 }
 
+// moveMissedBlockBitmap moves a validator's missed block bitmap from a
+// consensus address to another one.
+func (k Keeper) moveMissedBlockBitmap(ctx sdk.Context, oldAddr, newAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	oldPrefix := types.ValidatorMissedBlockBitmapPrefixKey(oldAddr)
+	newPrefix := types.ValidatorMissedBlockBitmapPrefixKey(newAddr)
+
+	iter := storetypes.KVStorePrefixIterator(store, oldPrefix)
+	defer iter.Close()
+
+	var keys, chunks [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		chunks = append(chunks, iter.Value())
+	}
+
+	for i, key := range keys {
+		// the chunk index suffix of the key is kept as is
+		newKey := append(append([]byte{}, newPrefix...), key[len(oldPrefix):]...)
+		store.Set(newKey, chunks[i])
+		store.Delete(key)
+	}
+}
+
 // IterateMissedBlockBitmap iterates over a validator's signed blocks window
 // bitmap and performs a callback function on each index, i.e. block height, in
 // the range [0, SignedBlocksWindow).
@@ -20,8 +20,17 @@ var ParamsKey = []byte{0x00}
 // and managed by the x/params modules and stores them directly into the x/slashing
 // module state.
 func Migrate(ctx sdk.Context, store storetypes.KVStore, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
-	var currParams types.Params
+	// the legacy subspace only holds the params existing before the migration,
+	// the params added since are set to their default values, without capping
+	// the downtime penalties below the current ones
+	currParams := types.DefaultParams()
 	legacySubspace.GetParamSet(ctx, &currParams)
+	if !currParams.SlashFractionDowntime.IsNil() && currParams.MaxSlashFractionDowntime.LT(currParams.SlashFractionDowntime) {
+		currParams.MaxSlashFractionDowntime = currParams.SlashFractionDowntime
+	}
+	if currParams.MaxDowntimeJailDuration < currParams.DowntimeJailDuration {
+		currParams.MaxDowntimeJailDuration = currParams.DowntimeJailDuration
+	}
 
 	if err := currParams.Validate(); err != nil {
 		return err
@@ -1,10 +1,13 @@
 package v3_test
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -24,8 +27,13 @@ func newMockSubspace(ps types.Params) mockSubspace {
 	return mockSubspace{ps: ps}
 }
 
+// GetParamSet sets the params of the legacy subspace, like the x/params
+// subspace which only holds the params existing before the migration.
 func (ms mockSubspace) GetParamSet(ctx sdk.Context, ps exported.ParamSet) {
-	*ps.(*types.Params) = ms.ps
+	pairs := ms.ps.ParamSetPairs()
+	for i, pair := range ps.ParamSetPairs() {
+		reflect.ValueOf(pair.Value).Elem().Set(reflect.ValueOf(pairs[i].Value).Elem())
+	}
 }
 
 func TestMigrate(t *testing.T) {
@@ -35,11 +43,31 @@ func TestMigrate(t *testing.T) {
 	ctx := testutil.DefaultContext(storeKey, tKey)
 	store := ctx.KVStore(storeKey)
 
-	legacySubspace := newMockSubspace(types.DefaultParams())
+	// the legacy params, with downtime penalties above the default caps
+	legacyParams := types.Params{
+		SignedBlocksWindow:      100,
+		MinSignedPerWindow:      math.LegacyNewDecWithPrec(5, 1),
+		DowntimeJailDuration:    48 * time.Hour,
+		SlashFractionDoubleSign: math.LegacyNewDecWithPrec(5, 2),
+		SlashFractionDowntime:   math.LegacyNewDecWithPrec(1, 1),
+	}
+	legacySubspace := newMockSubspace(legacyParams)
 	require.NoError(t, v3.Migrate(ctx, store, legacySubspace, cdc))
 
 	var res types.Params
 	bz := store.Get(v3.ParamsKey)
 	require.NoError(t, cdc.Unmarshal(bz, &res))
-	require.Equal(t, legacySubspace.ps, res)
+	require.NoError(t, res.Validate())
+	require.Equal(t, legacyParams.SignedBlocksWindow, res.SignedBlocksWindow)
+	require.Equal(t, legacyParams.MinSignedPerWindow, res.MinSignedPerWindow)
+	require.Equal(t, legacyParams.DowntimeJailDuration, res.DowntimeJailDuration)
+	require.Equal(t, legacyParams.SlashFractionDoubleSign, res.SlashFractionDoubleSign)
+	require.Equal(t, legacyParams.SlashFractionDowntime, res.SlashFractionDowntime)
+
+	// the params added since are set to their default values
+	require.Equal(t, types.DefaultDowntimeLookbackPeriod, res.DowntimeLookbackPeriod)
+	require.Equal(t, types.DefaultSlashFractionDowntimeMultiplier, res.SlashFractionDowntimeMultiplier)
+	require.Equal(t, types.DefaultDowntimeJailDurationMultiplier, res.DowntimeJailDurationMultiplier)
+	require.Equal(t, legacyParams.SlashFractionDowntime, res.MaxSlashFractionDowntime)
+	require.Equal(t, legacyParams.DowntimeJailDuration, res.MaxDowntimeJailDuration)
 }
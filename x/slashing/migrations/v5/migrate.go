@@ -0,0 +1,47 @@
+package v5
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// ParamsKey is the store key of the x/slashing module parameters.
+var ParamsKey = []byte{0x00}
+
+// Migrate migrates state to consensus version 5. Specifically, it sets the
+// parameters of the progressive downtime penalties to their defaults. The
+// escalation of the penalties stays disabled until the downtime lookback
+// period is set by governance.
+func Migrate(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	params.DowntimeLookbackPeriod = types.DefaultDowntimeLookbackPeriod
+	params.SlashFractionDowntimeMultiplier = types.DefaultSlashFractionDowntimeMultiplier
+	params.DowntimeJailDurationMultiplier = types.DefaultDowntimeJailDurationMultiplier
+
+	// the escalated penalties cannot be capped below the current ones
+	params.MaxSlashFractionDowntime = types.DefaultMaxSlashFractionDowntime
+	if params.SlashFractionDowntime.IsNil() || params.MaxSlashFractionDowntime.LT(params.SlashFractionDowntime) {
+		params.MaxSlashFractionDowntime = params.SlashFractionDowntime
+	}
+	params.MaxDowntimeJailDuration = types.DefaultMaxDowntimeJailDuration
+	if params.MaxDowntimeJailDuration < params.DowntimeJailDuration {
+		params.MaxDowntimeJailDuration = params.DowntimeJailDuration
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	v5 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v5"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(slashingtypes.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// store the parameters without the progressive downtime penalties
+	oldParams := slashingtypes.Params{
+		SignedBlocksWindow:      100,
+		MinSignedPerWindow:      math.LegacyNewDecWithPrec(5, 1),
+		DowntimeJailDuration:    48 * time.Hour,
+		SlashFractionDoubleSign: math.LegacyNewDecWithPrec(5, 2),
+		SlashFractionDowntime:   math.LegacyNewDecWithPrec(1, 2),
+	}
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v5.Migrate(store, cdc))
+
+	var params slashingtypes.Params
+	cdc.MustUnmarshal(store.Get(v5.ParamsKey), &params)
+	require.NoError(t, params.Validate())
+	require.Equal(t, oldParams.SignedBlocksWindow, params.SignedBlocksWindow)
+	require.Equal(t, oldParams.SlashFractionDowntime, params.SlashFractionDowntime)
+	require.Equal(t, slashingtypes.DefaultDowntimeLookbackPeriod, params.DowntimeLookbackPeriod)
+	require.Equal(t, slashingtypes.DefaultSlashFractionDowntimeMultiplier, params.SlashFractionDowntimeMultiplier)
+	require.Equal(t, slashingtypes.DefaultMaxSlashFractionDowntime, params.MaxSlashFractionDowntime)
+	require.Equal(t, slashingtypes.DefaultDowntimeJailDurationMultiplier, params.DowntimeJailDurationMultiplier)
+
+	// the max jail duration is not capped below the current jail duration
+	require.Equal(t, oldParams.DowntimeJailDuration, params.MaxDowntimeJailDuration)
+}
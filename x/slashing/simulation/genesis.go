@@ -19,6 +19,7 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	DowntimeLookbackPeriod  = "downtime_lookback_period"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -46,6 +47,11 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeLookbackPeriod randomized DowntimeLookbackPeriod
+func GenDowntimeLookbackPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*7)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -78,9 +84,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeLookbackPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeLookbackPeriod, &downtimeLookbackPeriod, simState.Rand,
+		func(r *rand.Rand) { downtimeLookbackPeriod = GenDowntimeLookbackPeriod(r) },
+	)
+
+	// the escalated penalties cannot be capped below the base ones
+	maxSlashFractionDowntime := math.LegacyMaxDec(types.DefaultMaxSlashFractionDowntime, slashFractionDowntime)
+	maxDowntimeJailDuration := types.DefaultMaxDowntimeJailDuration
+	if downtimeJailDuration > maxDowntimeJailDuration {
+		maxDowntimeJailDuration = downtimeJailDuration
+	}
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, downtimeLookbackPeriod,
+		types.DefaultSlashFractionDowntimeMultiplier, maxSlashFractionDowntime,
+		types.DefaultDowntimeJailDurationMultiplier, maxDowntimeJailDuration,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
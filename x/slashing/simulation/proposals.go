@@ -42,6 +42,9 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	params.MinSignedPerWindow = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
 	params.SlashFractionDoubleSign = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
 	params.SlashFractionDowntime = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
+	params.DowntimeLookbackPeriod = time.Duration(simtypes.RandIntBetween(r, 0, 60*60*24*7)) * time.Second
+	params.MaxSlashFractionDowntime = sdkmath.LegacyMaxDec(params.SlashFractionDowntime, params.MaxSlashFractionDowntime)
+	params.MaxDowntimeJailDuration = params.DowntimeJailDuration
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
@@ -13,6 +13,9 @@ const (
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
 
+	AttributeKeySlashFraction     = "slash_fraction"
+	AttributeKeyDowntimeJailCount = "downtime_jail_count"
+
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
 )
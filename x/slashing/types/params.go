@@ -11,25 +11,45 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	// DefaultDowntimeLookbackPeriod disables the escalation of the downtime
+	// penalties.
+	DefaultDowntimeLookbackPeriod  = time.Duration(0)
+	DefaultMaxDowntimeJailDuration = 24 * time.Hour
 )
 
 var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+
+	DefaultSlashFractionDowntimeMultiplier = math.LegacyNewDec(2)
+	DefaultMaxSlashFractionDowntime        = math.LegacyNewDecWithPrec(5, 2)
+	DefaultDowntimeJailDurationMultiplier  = math.LegacyNewDec(2)
+
+	// MaxDowntimePenaltyMultiplier bounds the downtime penalty multipliers so
+	// that multiplying a jail duration by them cannot overflow.
+	MaxDowntimePenaltyMultiplier = math.LegacyNewDec(100)
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
+	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec, downtimeLookbackPeriod time.Duration,
+	slashFractionDowntimeMultiplier, maxSlashFractionDowntime, downtimeJailDurationMultiplier math.LegacyDec,
+	maxDowntimeJailDuration time.Duration,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:              signedBlocksWindow,
+		MinSignedPerWindow:              minSignedPerWindow,
+		DowntimeJailDuration:            downtimeJailDuration,
+		SlashFractionDoubleSign:         slashFractionDoubleSign,
+		SlashFractionDowntime:           slashFractionDowntime,
+		DowntimeLookbackPeriod:          downtimeLookbackPeriod,
+		SlashFractionDowntimeMultiplier: slashFractionDowntimeMultiplier,
+		MaxSlashFractionDowntime:        maxSlashFractionDowntime,
+		DowntimeJailDurationMultiplier:  downtimeJailDurationMultiplier,
+		MaxDowntimeJailDuration:         maxDowntimeJailDuration,
 	}
 }
 
@@ -41,6 +61,11 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultDowntimeLookbackPeriod,
+		DefaultSlashFractionDowntimeMultiplier,
+		DefaultMaxSlashFractionDowntime,
+		DefaultDowntimeJailDurationMultiplier,
+		DefaultMaxDowntimeJailDuration,
 	)
 }
 
@@ -61,9 +86,61 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeLookbackPeriod(p.DowntimeLookbackPeriod); err != nil {
+		return err
+	}
+	if err := validateDowntimePenaltyMultiplier(p.SlashFractionDowntimeMultiplier); err != nil {
+		return err
+	}
+	if err := validateMaxSlashFractionDowntime(p.MaxSlashFractionDowntime); err != nil {
+		return err
+	}
+	if err := validateDowntimePenaltyMultiplier(p.DowntimeJailDurationMultiplier); err != nil {
+		return err
+	}
+	if err := validateMaxDowntimeJailDuration(p.MaxDowntimeJailDuration); err != nil {
+		return err
+	}
+	if p.MaxSlashFractionDowntime.LT(p.SlashFractionDowntime) {
+		return fmt.Errorf("max downtime slash fraction %s cannot be less than the downtime slash fraction %s", p.MaxSlashFractionDowntime, p.SlashFractionDowntime)
+	}
+	if p.MaxDowntimeJailDuration < p.DowntimeJailDuration {
+		return fmt.Errorf("max downtime jail duration %s cannot be less than the downtime jail duration %s", p.MaxDowntimeJailDuration, p.DowntimeJailDuration)
+	}
 	return nil
 }
 
+// DowntimePenalty returns the slash fraction and the jail duration of a
+// downtime of a validator with the given number of preceding repeat offenses.
+// Each repeat offense multiplies the base penalties by their multiplier, up to
+// their maximum.
+func (p Params) DowntimePenalty(repeatOffenses int64) (math.LegacyDec, time.Duration) {
+	slashFraction := p.SlashFractionDowntime
+	jailDuration := p.DowntimeJailDuration
+
+	for i := int64(0); i < repeatOffenses; i++ {
+		capped := true
+		if slashFraction.LT(p.MaxSlashFractionDowntime) {
+			slashFraction = math.LegacyMinDec(slashFraction.Mul(p.SlashFractionDowntimeMultiplier), p.MaxSlashFractionDowntime)
+			capped = false
+		}
+		if jailDuration < p.MaxDowntimeJailDuration {
+			next := math.LegacyNewDec(int64(jailDuration)).Mul(p.DowntimeJailDurationMultiplier)
+			if next.GTE(math.LegacyNewDec(int64(p.MaxDowntimeJailDuration))) {
+				jailDuration = p.MaxDowntimeJailDuration
+			} else {
+				jailDuration = time.Duration(next.TruncateInt64())
+			}
+			capped = false
+		}
+		if capped {
+			break
+		}
+	}
+
+	return slashFraction, jailDuration
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -146,3 +223,67 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeLookbackPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime lookback period cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimePenaltyMultiplier(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("downtime penalty multiplier cannot be nil: %s", v)
+	}
+	if v.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("downtime penalty multiplier cannot be less than one: %s", v)
+	}
+	if v.GT(MaxDowntimePenaltyMultiplier) {
+		return fmt.Errorf("downtime penalty multiplier cannot be greater than %s: %s", MaxDowntimePenaltyMultiplier, v)
+	}
+
+	return nil
+}
+
+func validateMaxSlashFractionDowntime(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max downtime slash fraction cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max downtime slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max downtime slash fraction too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max downtime jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
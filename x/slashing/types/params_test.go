@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDowntimePenalty(t *testing.T) {
+	params := DefaultParams()
+	params.SlashFractionDowntime = math.LegacyNewDecWithPrec(1, 2)
+	params.MaxSlashFractionDowntime = math.LegacyNewDecWithPrec(5, 2)
+	params.DowntimeJailDuration = time.Hour
+	params.MaxDowntimeJailDuration = 6 * time.Hour
+	require.NoError(t, params.Validate())
+
+	testCases := []struct {
+		repeatOffenses   int64
+		expSlashFraction math.LegacyDec
+		expJailDuration  time.Duration
+	}{
+		{0, math.LegacyNewDecWithPrec(1, 2), time.Hour},
+		{1, math.LegacyNewDecWithPrec(2, 2), 2 * time.Hour},
+		{2, math.LegacyNewDecWithPrec(4, 2), 4 * time.Hour},
+		{3, math.LegacyNewDecWithPrec(5, 2), 6 * time.Hour},
+		{1000, math.LegacyNewDecWithPrec(5, 2), 6 * time.Hour},
+	}
+
+	for _, tc := range testCases {
+		slashFraction, jailDuration := params.DowntimePenalty(tc.repeatOffenses)
+		require.Equal(t, tc.expSlashFraction, slashFraction, "repeat offenses %d", tc.repeatOffenses)
+		require.Equal(t, tc.expJailDuration, jailDuration, "repeat offenses %d", tc.repeatOffenses)
+	}
+}
+
+func TestDowntimePenaltyMultiplierBounds(t *testing.T) {
+	params := DefaultParams()
+	params.DowntimeJailDuration = time.Duration(1 << 62)
+	params.MaxDowntimeJailDuration = time.Duration(1<<63 - 1)
+	params.DowntimeJailDurationMultiplier = MaxDowntimePenaltyMultiplier
+	params.SlashFractionDowntimeMultiplier = MaxDowntimePenaltyMultiplier
+	require.NoError(t, params.Validate())
+
+	_, jailDuration := params.DowntimePenalty(1)
+	require.Equal(t, params.MaxDowntimeJailDuration, jailDuration)
+
+	params.DowntimeJailDurationMultiplier = MaxDowntimePenaltyMultiplier.Add(math.LegacyOneDec())
+	require.Error(t, params.Validate())
+
+	params.DowntimeJailDurationMultiplier = DefaultDowntimeJailDurationMultiplier
+	params.SlashFractionDowntimeMultiplier = math.LegacyNewDec(1 << 62)
+	require.Error(t, params.Validate())
+}
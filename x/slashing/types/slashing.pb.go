@@ -50,6 +50,12 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// A counter of the successive downtime jailings of the validator, each
+	// within the downtime_lookback_period of the end of the previous one. It
+	// determines the escalation of the downtime penalties.
+	//
+	// Since: cosmos-sdk 0.48
+	DowntimeJailCount int64 `protobuf:"varint,7,opt,name=downtime_jail_count,json=downtimeJailCount,proto3" json:"downtime_jail_count,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -127,6 +133,13 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeJailCount() int64 {
+	if m != nil {
+		return m.DowntimeJailCount
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -134,6 +147,30 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// downtime_lookback_period is the period after the end of a downtime jailing
+	// within which a new downtime of the validator is a repeat offense. Zero
+	// disables the escalation of the downtime penalties.
+	//
+	// Since: cosmos-sdk 0.48
+	DowntimeLookbackPeriod time.Duration `protobuf:"bytes,6,opt,name=downtime_lookback_period,json=downtimeLookbackPeriod,proto3,stdduration" json:"downtime_lookback_period"`
+	// slash_fraction_downtime_multiplier multiplies the downtime slash fraction
+	// for each repeat offense.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashFractionDowntimeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=slash_fraction_downtime_multiplier,json=slashFractionDowntimeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime_multiplier"`
+	// max_slash_fraction_downtime caps the escalated downtime slash fraction.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxSlashFractionDowntime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_slash_fraction_downtime,json=maxSlashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slash_fraction_downtime"`
+	// downtime_jail_duration_multiplier multiplies the downtime jail duration for
+	// each repeat offense.
+	//
+	// Since: cosmos-sdk 0.48
+	DowntimeJailDurationMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=downtime_jail_duration_multiplier,json=downtimeJailDurationMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_duration_multiplier"`
+	// max_downtime_jail_duration caps the escalated downtime jail duration.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxDowntimeJailDuration time.Duration `protobuf:"bytes,10,opt,name=max_downtime_jail_duration,json=maxDowntimeJailDuration,proto3,stdduration" json:"max_downtime_jail_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -183,6 +220,20 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeLookbackPeriod() time.Duration {
+	if m != nil {
+		return m.DowntimeLookbackPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.MaxDowntimeJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
@@ -193,47 +244,55 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x4f, 0x14, 0x4f,
-	0x18, 0xbe, 0x01, 0x7e, 0xfc, 0x74, 0x0e, 0x13, 0x5d, 0x0f, 0x59, 0x2e, 0xba, 0x77, 0x50, 0x90,
-	0x0b, 0x09, 0xbb, 0x82, 0x1d, 0x56, 0x1e, 0xc4, 0xe0, 0x9f, 0x44, 0x72, 0xf8, 0x27, 0xb1, 0x70,
-	0x32, 0xbb, 0x33, 0xb7, 0x37, 0xb2, 0x3b, 0x73, 0xd9, 0x99, 0x15, 0x88, 0x8d, 0x8d, 0x8d, 0x15,
-	0xa5, 0xb1, 0xb2, 0xa4, 0xa4, 0xf0, 0x0b, 0x58, 0x98, 0x50, 0x12, 0x2b, 0x63, 0x81, 0xe6, 0x28,
-	0xf0, 0x63, 0x98, 0x9d, 0x99, 0x05, 0x02, 0x89, 0x15, 0xcd, 0xdd, 0xee, 0xf3, 0x3c, 0xef, 0xfb,
-	0xbc, 0xf3, 0xcc, 0x9b, 0x85, 0x33, 0x91, 0x90, 0xa9, 0x90, 0x81, 0x4c, 0xb0, 0xec, 0x31, 0x1e,
-	0x07, 0x6f, 0xe6, 0x43, 0xaa, 0xf0, 0xfc, 0x31, 0xe0, 0xf7, 0x33, 0xa1, 0x84, 0x33, 0x61, 0x74,
-	0xfe, 0x31, 0x6c, 0x75, 0xf5, 0x5a, 0x2c, 0x62, 0xa1, 0x35, 0x41, 0xf1, 0x64, 0xe4, 0x75, 0x2f,
-	0x16, 0x22, 0x4e, 0x68, 0xa0, 0xdf, 0xc2, 0xbc, 0x1b, 0x90, 0x3c, 0xc3, 0x8a, 0x09, 0x6e, 0xf9,
-	0xc6, 0x59, 0x5e, 0xb1, 0x94, 0x4a, 0x85, 0xd3, 0xbe, 0x15, 0x4c, 0x1a, 0x3f, 0x64, 0x3a, 0x5b,
-	0x73, 0x43, 0x5d, 0xc3, 0x29, 0xe3, 0x22, 0xd0, 0xbf, 0x06, 0x9a, 0xfe, 0x3a, 0x04, 0x6b, 0xcf,
-	0x71, 0xc2, 0x08, 0x56, 0x22, 0x5b, 0x63, 0x31, 0x67, 0x3c, 0x7e, 0xc0, 0xbb, 0xc2, 0xb9, 0x0b,
-	0xff, 0xc7, 0x84, 0x64, 0x54, 0x4a, 0x17, 0x34, 0x41, 0xeb, 0x72, 0x7b, 0xea, 0xfb, 0x97, 0xb9,
-	0x5b, 0xb6, 0xdd, 0x92, 0xe0, 0x92, 0x72, 0x99, 0xcb, 0x7b, 0x46, 0xb2, 0xa6, 0x32, 0xc6, 0xe3,
-	0x4e, 0x59, 0xe1, 0x4c, 0xc1, 0x31, 0xa9, 0x70, 0xa6, 0x50, 0x8f, 0xb2, 0xb8, 0xa7, 0xdc, 0xa1,
-	0x26, 0x68, 0x0d, 0x77, 0xaa, 0x1a, 0x5b, 0xd1, 0x50, 0x21, 0x61, 0x9c, 0xd0, 0x4d, 0x24, 0xba,
-	0x5d, 0x49, 0x95, 0x3b, 0x6c, 0x24, 0x1a, 0x7b, 0xa2, 0x21, 0xe7, 0x31, 0x1c, 0x7b, 0x8d, 0x59,
-	0x42, 0x09, 0xca, 0xb9, 0x62, 0x89, 0x3b, 0xd2, 0x04, 0xad, 0xea, 0x42, 0xdd, 0x37, 0x09, 0xf8,
-	0x65, 0x02, 0xfe, 0xd3, 0x32, 0x81, 0xf6, 0x95, 0xbd, 0x83, 0x46, 0x65, 0xfb, 0x57, 0x03, 0xec,
-	0x1c, 0xed, 0xce, 0x82, 0x4e, 0xd5, 0x94, 0x3f, 0x2b, 0xaa, 0x1d, 0x0f, 0x42, 0x25, 0xd2, 0x50,
-	0x2a, 0xc1, 0x29, 0x71, 0xff, 0x6b, 0x82, 0xd6, 0xa5, 0xce, 0x29, 0xc4, 0x59, 0x80, 0xe3, 0x29,
-	0x93, 0x92, 0x12, 0x14, 0x26, 0x22, 0x5a, 0x97, 0x28, 0x12, 0x39, 0x57, 0x34, 0x73, 0x47, 0xf5,
-	0x64, 0xd7, 0x0d, 0xd9, 0xd6, 0xdc, 0x92, 0xa1, 0x16, 0x47, 0xfe, 0x7c, 0x6e, 0x80, 0xe9, 0x6f,
-	0x23, 0x70, 0x74, 0x15, 0x67, 0x38, 0x95, 0xce, 0x6d, 0x58, 0x93, 0x2c, 0xe6, 0x27, 0x4d, 0x36,
-	0x18, 0x27, 0x62, 0x43, 0x47, 0x38, 0xdc, 0x71, 0x0c, 0x67, 0x7a, 0xbc, 0xd0, 0x8c, 0xf3, 0xb6,
-	0xb0, 0xe5, 0xc8, 0x56, 0xf5, 0x69, 0x56, 0x96, 0x14, 0x99, 0x8d, 0xb5, 0x57, 0x8a, 0x13, 0xfd,
-	0x3c, 0x68, 0xcc, 0xc4, 0x4c, 0xf5, 0xf2, 0xd0, 0x8f, 0x44, 0x6a, 0xef, 0xd4, 0xfe, 0xcd, 0x49,
-	0xb2, 0x1e, 0xa8, 0xad, 0x3e, 0x95, 0xfe, 0x32, 0x8d, 0x3e, 0x1d, 0xed, 0xce, 0x5e, 0xb5, 0x0b,
-	0x40, 0x68, 0x84, 0xc2, 0x2d, 0x45, 0xa5, 0x09, 0xc3, 0x49, 0x19, 0x5f, 0xd3, 0x2e, 0xab, 0x34,
-	0xb3, 0xe6, 0xaf, 0xe0, 0x0d, 0x22, 0x36, 0x78, 0xb1, 0x42, 0xa8, 0xc8, 0x0a, 0x95, 0xcb, 0xa6,
-	0xaf, 0xa3, 0xba, 0x30, 0x79, 0x2e, 0xeb, 0x65, 0x2b, 0x30, 0x51, 0x7f, 0x3c, 0x8e, 0xba, 0x56,
-	0xf6, 0x79, 0x88, 0x59, 0x52, 0x8a, 0x9c, 0xf7, 0x00, 0xd6, 0xf5, 0xde, 0xa3, 0x6e, 0x86, 0xa3,
-	0x02, 0x42, 0x44, 0xe4, 0x61, 0x42, 0xf5, 0x79, 0xf5, 0x85, 0x5e, 0xe4, 0x11, 0x27, 0xb4, 0xd7,
-	0x7d, 0x6b, 0xb5, 0xac, 0x9d, 0x8a, 0x23, 0x3b, 0xef, 0x00, 0x9c, 0x38, 0x37, 0x87, 0x99, 0x57,
-	0x6f, 0xc2, 0x45, 0x0e, 0x31, 0x7e, 0x66, 0x08, 0x63, 0xb3, 0x38, 0xf5, 0xe1, 0x68, 0x77, 0xf6,
-	0xe6, 0xa9, 0x5e, 0x9b, 0x27, 0x5f, 0x0e, 0xb3, 0x3c, 0xed, 0x47, 0x3b, 0x03, 0x0f, 0xec, 0x0d,
-	0x3c, 0xb0, 0x3f, 0xf0, 0xc0, 0xef, 0x81, 0x07, 0xb6, 0x0f, 0xbd, 0xca, 0xfe, 0xa1, 0x57, 0xf9,
-	0x71, 0xe8, 0x55, 0x5e, 0xce, 0xfd, 0x73, 0xb2, 0x53, 0xdd, 0xf4, 0x90, 0xe1, 0xa8, 0xbe, 0xb2,
-	0x3b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x8c, 0xa5, 0x44, 0xa7, 0x04, 0x00, 0x00,
+	// 767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbf, 0x4f, 0x23, 0x47,
+	0x18, 0xf5, 0xf0, 0xc3, 0xc0, 0x98, 0x48, 0x61, 0x30, 0xf1, 0xe2, 0x24, 0x6b, 0x9b, 0x02, 0x59,
+	0x48, 0xac, 0x03, 0xe9, 0x48, 0x15, 0x63, 0x45, 0x24, 0x21, 0x0a, 0xb2, 0xf3, 0x43, 0x4a, 0x91,
+	0xd5, 0xec, 0xee, 0x78, 0x3d, 0xf1, 0xee, 0x8c, 0xb5, 0x33, 0x1b, 0x8c, 0xd2, 0xa4, 0x89, 0x22,
+	0xa5, 0x42, 0x8a, 0x22, 0x45, 0xa9, 0x52, 0x52, 0x52, 0xdc, 0x1f, 0x41, 0x89, 0xae, 0x3a, 0x5d,
+	0xc1, 0x9d, 0x4c, 0xc1, 0xb5, 0xf7, 0x1f, 0x9c, 0x76, 0x66, 0x6d, 0x7c, 0x60, 0x4e, 0x42, 0x72,
+	0xe3, 0x1f, 0xdf, 0x7b, 0xdf, 0xbc, 0xf7, 0xbd, 0x6f, 0xd6, 0x86, 0x9b, 0x2e, 0x17, 0x21, 0x17,
+	0x35, 0x11, 0x60, 0xd1, 0xa1, 0xcc, 0xaf, 0xfd, 0xba, 0xe3, 0x10, 0x89, 0x77, 0x46, 0x05, 0xab,
+	0x17, 0x71, 0xc9, 0x51, 0x41, 0xf3, 0xac, 0x51, 0x39, 0xe5, 0x15, 0xf3, 0x3e, 0xf7, 0xb9, 0xe2,
+	0xd4, 0x92, 0x4f, 0x9a, 0x5e, 0x34, 0x7d, 0xce, 0xfd, 0x80, 0xd4, 0xd4, 0x37, 0x27, 0x6e, 0xd7,
+	0xbc, 0x38, 0xc2, 0x92, 0x72, 0x96, 0xe2, 0xa5, 0xbb, 0xb8, 0xa4, 0x21, 0x11, 0x12, 0x87, 0xbd,
+	0x94, 0xb0, 0xae, 0xf5, 0x6c, 0x7d, 0x72, 0x2a, 0xae, 0xa1, 0x15, 0x1c, 0x52, 0xc6, 0x6b, 0xea,
+	0x55, 0x97, 0x36, 0x5e, 0xcf, 0xc0, 0xfc, 0x0f, 0x38, 0xa0, 0x1e, 0x96, 0x3c, 0x6a, 0x51, 0x9f,
+	0x51, 0xe6, 0x7f, 0xc9, 0xda, 0x1c, 0x7d, 0x06, 0x17, 0xb0, 0xe7, 0x45, 0x44, 0x08, 0x03, 0x94,
+	0x41, 0x75, 0xa9, 0x5e, 0x79, 0xfa, 0x64, 0xfb, 0xe3, 0xf4, 0xb8, 0x7d, 0xce, 0x04, 0x61, 0x22,
+	0x16, 0x9f, 0x6b, 0x4a, 0x4b, 0x46, 0x94, 0xf9, 0xcd, 0x61, 0x07, 0xaa, 0xc0, 0x65, 0x21, 0x71,
+	0x24, 0xed, 0x0e, 0xa1, 0x7e, 0x47, 0x1a, 0x33, 0x65, 0x50, 0x9d, 0x6d, 0xe6, 0x54, 0xed, 0x40,
+	0x95, 0x12, 0x0a, 0x65, 0x1e, 0xe9, 0xdb, 0xbc, 0xdd, 0x16, 0x44, 0x1a, 0xb3, 0x9a, 0xa2, 0x6a,
+	0xdf, 0xaa, 0x12, 0x3a, 0x84, 0xcb, 0xbf, 0x60, 0x1a, 0x10, 0xcf, 0x8e, 0x99, 0xa4, 0x81, 0x31,
+	0x57, 0x06, 0xd5, 0xdc, 0x6e, 0xd1, 0xd2, 0x09, 0x58, 0xc3, 0x04, 0xac, 0xef, 0x86, 0x09, 0xd4,
+	0xdf, 0xbb, 0xb8, 0x2a, 0x65, 0x4e, 0x5f, 0x94, 0xc0, 0xd9, 0xcd, 0xf9, 0x16, 0x68, 0xe6, 0x74,
+	0xfb, 0xf7, 0x49, 0x37, 0x32, 0x21, 0x94, 0x3c, 0x74, 0x84, 0xe4, 0x8c, 0x78, 0xc6, 0x7c, 0x19,
+	0x54, 0x17, 0x9b, 0x63, 0x15, 0xb4, 0x0b, 0xd7, 0x42, 0x2a, 0x04, 0xf1, 0x6c, 0x27, 0xe0, 0x6e,
+	0x57, 0xd8, 0x2e, 0x8f, 0x99, 0x24, 0x91, 0x91, 0x55, 0xce, 0x56, 0x35, 0x58, 0x57, 0xd8, 0xbe,
+	0x86, 0x90, 0x05, 0x57, 0x3d, 0x7e, 0xcc, 0x92, 0x15, 0xd8, 0x89, 0x96, 0xee, 0x31, 0x16, 0x54,
+	0xc7, 0xca, 0x10, 0xfa, 0x0a, 0xd3, 0x40, 0x75, 0xec, 0xcd, 0xbd, 0xfa, 0xbf, 0x04, 0x36, 0x2e,
+	0x96, 0x60, 0xf6, 0x08, 0x47, 0x38, 0x14, 0xe8, 0x13, 0x98, 0x17, 0xd4, 0x67, 0xb7, 0xa2, 0xc7,
+	0x94, 0x79, 0xfc, 0x58, 0x45, 0x3e, 0xdb, 0x44, 0x1a, 0xd3, 0x9a, 0x3f, 0x2a, 0x04, 0xfd, 0x96,
+	0xd8, 0x64, 0x76, 0xda, 0xd5, 0x23, 0xd1, 0xb0, 0x25, 0xc9, 0x78, 0xb9, 0x7e, 0x90, 0x24, 0xf0,
+	0xfc, 0xaa, 0xb4, 0xe9, 0x53, 0xd9, 0x89, 0x1d, 0xcb, 0xe5, 0x61, 0x7a, 0x07, 0xd2, 0xb7, 0x6d,
+	0xe1, 0x75, 0x6b, 0xf2, 0xa4, 0x47, 0x84, 0xd5, 0x20, 0xee, 0x7f, 0x37, 0xe7, 0x5b, 0xef, 0x6b,
+	0xc0, 0xf6, 0x88, 0x6b, 0x3b, 0x27, 0x92, 0x08, 0x1d, 0x1e, 0x0a, 0x29, 0x6b, 0x29, 0x95, 0x23,
+	0x12, 0xa5, 0xe2, 0x3f, 0xc3, 0x0f, 0xde, 0x9e, 0x77, 0x78, 0x39, 0xd5, 0xfa, 0x72, 0xbb, 0xeb,
+	0xf7, 0x76, 0xd3, 0x48, 0x09, 0x7a, 0x35, 0xff, 0x8e, 0x56, 0x93, 0x1f, 0x0f, 0x67, 0x48, 0x42,
+	0x7f, 0x00, 0x58, 0x54, 0xcf, 0x89, 0xdd, 0x8e, 0xb0, 0x9b, 0x94, 0x6c, 0x8f, 0xc7, 0x4e, 0x40,
+	0xd4, 0xbc, 0xc6, 0xdc, 0x94, 0x47, 0x2c, 0x28, 0xad, 0x2f, 0x52, 0xa9, 0x86, 0x52, 0x4a, 0x46,
+	0x46, 0xbf, 0x03, 0x58, 0xb8, 0xe7, 0x43, 0xfb, 0x35, 0xe6, 0xa7, 0x6c, 0x62, 0xed, 0x8e, 0x09,
+	0x2d, 0x83, 0x1c, 0x68, 0x8c, 0xa2, 0x0e, 0x38, 0xef, 0x3a, 0xd8, 0xed, 0x26, 0xeb, 0xa6, 0xdc,
+	0x33, 0xb2, 0x8f, 0x0c, 0x7b, 0xb4, 0xb4, 0xc3, 0xf4, 0xa0, 0x23, 0x75, 0x0e, 0xfa, 0x07, 0xc0,
+	0x8d, 0x07, 0xc6, 0xb4, 0xc3, 0x38, 0x90, 0xb4, 0x17, 0x50, 0x12, 0x19, 0x0b, 0x53, 0x9e, 0xb8,
+	0x34, 0x71, 0xe2, 0x6f, 0x46, 0x82, 0xe8, 0x4f, 0x00, 0x3f, 0x0c, 0x71, 0xdf, 0x7e, 0x68, 0x05,
+	0x8b, 0x53, 0x36, 0x64, 0x84, 0xb8, 0xdf, 0x9a, 0xb8, 0x85, 0xbf, 0x01, 0xac, 0x4c, 0xbe, 0xf1,
+	0xe3, 0x01, 0x2d, 0x4d, 0xd9, 0x8f, 0x39, 0xe9, 0xe1, 0x18, 0xcb, 0x87, 0xc0, 0x62, 0x12, 0xcf,
+	0x03, 0x8f, 0x22, 0x7c, 0xe4, 0xed, 0x28, 0x84, 0xb8, 0xdf, 0x98, 0x20, 0xb8, 0x57, 0xf9, 0xeb,
+	0xe6, 0x7c, 0xeb, 0xa3, 0x31, 0xef, 0xfd, 0xdb, 0x3f, 0x3b, 0xfd, 0xfb, 0x55, 0xff, 0xfa, 0x6c,
+	0x60, 0x82, 0x8b, 0x81, 0x09, 0x2e, 0x07, 0x26, 0x78, 0x39, 0x30, 0xc1, 0xe9, 0xb5, 0x99, 0xb9,
+	0xbc, 0x36, 0x33, 0xcf, 0xae, 0xcd, 0xcc, 0x4f, 0xdb, 0xef, 0x4c, 0x62, 0xec, 0x34, 0x15, 0x8a,
+	0x93, 0x55, 0x56, 0x3f, 0x7d, 0x33, 0x00, 0x7f, 0xff, 0x0e, 0x4c, 0x5a, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -273,6 +332,9 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.DowntimeJailCount != that1.DowntimeJailCount {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -309,6 +371,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeLookbackPeriod != that1.DowntimeLookbackPeriod {
+		return false
+	}
+	if !this.SlashFractionDowntimeMultiplier.Equal(that1.SlashFractionDowntimeMultiplier) {
+		return false
+	}
+	if !this.MaxSlashFractionDowntime.Equal(that1.MaxSlashFractionDowntime) {
+		return false
+	}
+	if !this.DowntimeJailDurationMultiplier.Equal(that1.DowntimeJailDurationMultiplier) {
+		return false
+	}
+	if this.MaxDowntimeJailDuration != that1.MaxDowntimeJailDuration {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -331,6 +408,11 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeJailCount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeJailCount))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -394,6 +476,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDowntimeJailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DowntimeJailDurationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailDurationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxSlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.MaxSlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SlashFractionDowntimeMultiplier.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntimeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeLookbackPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeLookbackPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -414,12 +542,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -475,6 +603,9 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
+	if m.DowntimeJailCount != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeJailCount))
+	}
 	return n
 }
 
@@ -495,6 +626,16 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeLookbackPeriod)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntimeMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.MaxSlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailDurationMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -675,6 +816,25 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailCount", wireType)
+			}
+			m.DowntimeJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeJailCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -876,6 +1036,171 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeLookbackPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeLookbackPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntimeMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntimeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDurationMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailDurationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
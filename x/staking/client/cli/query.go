@@ -40,6 +40,18 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTokenizeShareRecordByID(),
+		GetCmdQueryTokenizeShareRecordByDenom(),
+		GetCmdQueryTokenizeShareRecordsOwned(),
+		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryUnbondingQueue(),
+		GetCmdQueryRedelegationQueue(),
+		GetCmdQuerySlashRecord(),
+		GetCmdQuerySlashRecords(),
+		GetCmdQueryValidatorSlashRecords(),
+		GetCmdQuerySlashRecordDelegation(),
+		GetCmdQuerySlashRecordDelegations(),
+		GetCmdQuerySlashRecordUnbondingEntries(),
 	)
 
 	return stakingQueryCmd
@@ -736,3 +748,529 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecordByID implements the tokenize share record query by id command.
+func GetCmdQueryTokenizeShareRecordByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-id [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query individual tokenize share record information by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query individual tokenize share record information by id.
+
+Example:
+$ %s query staking tokenize-share-record-by-id 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id argument provided must be a non-negative-integer: %v", err)
+			}
+
+			res, err := queryClient.TokenizeShareRecordByID(cmd.Context(), &types.QueryTokenizeShareRecordByIDRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordByDenom implements the tokenize share record query by denom command.
+func GetCmdQueryTokenizeShareRecordByDenom() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query individual tokenize share record information by share token denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query individual tokenize share record information by share token denom.
+
+Example:
+$ %s query staking tokenize-share-record-by-denom %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordByDenom(cmd.Context(), &types.QueryTokenizeShareRecordByDenomRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query of the tokenize share records owned by an address.
+func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records owned by an address.
+
+Example:
+$ %s query staking tokenize-share-records-owned %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the total liquid staked query command.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the total amount of tokenized bond tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of bond tokens which are tokenized into share tokens.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingQueue implements the query unbonding queue command.
+func GetCmdQueryUnbondingQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-queue",
+		Short: "Query the unbonding delegations queue ordered by completion time",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding delegations queue, grouped by completion time from the earliest.
+
+Example:
+$ %s query staking unbonding-queue
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondingQueue(cmd.Context(), &types.QueryUnbondingQueueRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding queue")
+
+	return cmd
+}
+
+// GetCmdQueryRedelegationQueue implements the query redelegation queue command.
+func GetCmdQueryRedelegationQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegation-queue",
+		Short: "Query the redelegations queue ordered by completion time",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redelegations queue, grouped by completion time from the earliest.
+
+Example:
+$ %s query staking redelegation-queue
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RedelegationQueue(cmd.Context(), &types.QueryRedelegationQueueRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegation queue")
+
+	return cmd
+}
+
+// GetCmdQuerySlashRecord implements the slash record query command.
+func GetCmdQuerySlashRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-record [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a slash record by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a slash record by id.
+
+Example:
+$ %s query staking slash-record 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id argument provided must be a non-negative-integer: %v", err)
+			}
+
+			res, err := queryClient.SlashRecord(cmd.Context(), &types.QuerySlashRecordRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySlashRecords implements the query all slash records command.
+func GetCmdQuerySlashRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-records",
+		Short: "Query all slash records",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all slash records, ordered by id.
+
+Example:
+$ %s query staking slash-records
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashRecords(cmd.Context(), &types.QuerySlashRecordsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash records")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorSlashRecords implements the query validator slash records command.
+func GetCmdQueryValidatorSlashRecords() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-slash-records [validator-addr]",
+		Short: "Query all slash records of a validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the slash records of a validator, ordered by id.
+
+Example:
+$ %s query staking validator-slash-records %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorSlashRecords(cmd.Context(), &types.QueryValidatorSlashRecordsRequest{
+				ValidatorAddr: valAddr.String(),
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator slash records")
+
+	return cmd
+}
+
+// GetCmdQuerySlashRecordDelegation implements the query slash record delegation command.
+func GetCmdQuerySlashRecordDelegation() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "slash-record-delegation [id] [delegator-addr]",
+		Short: "Query the tokens burned from a delegation by a slash",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens burned by a recorded slash from the delegation of a delegator to the slashed validator.
+
+Example:
+$ %s query staking slash-record-delegation 1 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id argument provided must be a non-negative-integer: %v", err)
+			}
+
+			delAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashRecordDelegation(cmd.Context(), &types.QuerySlashRecordDelegationRequest{
+				Id:            id,
+				DelegatorAddr: delAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Delegation)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySlashRecordDelegations implements the query slash record delegations command.
+func GetCmdQuerySlashRecordDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-record-delegations [id]",
+		Short: "Query the tokens burned from each slashed redelegation by a slash",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens burned by a recorded slash from the delegation of each slashed redelegation to its destination validator.
+
+Example:
+$ %s query staking slash-record-delegations 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id argument provided must be a non-negative-integer: %v", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashRecordDelegations(cmd.Context(), &types.QuerySlashRecordDelegationsRequest{
+				Id:         id,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash record delegations")
+
+	return cmd
+}
+
+// GetCmdQuerySlashRecordUnbondingEntries implements the query slash record unbonding entries command.
+func GetCmdQuerySlashRecordUnbondingEntries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-record-unbonding-entries [id]",
+		Short: "Query the tokens burned from each unbonding delegation entry by a slash",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens burned from each unbonding delegation entry by a recorded slash.
+
+Example:
+$ %s query staking slash-record-unbonding-entries 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id argument provided must be a non-negative-integer: %v", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashRecordUnbondingEntries(cmd.Context(), &types.QuerySlashRecordUnbondingEntriesRequest{
+				Id:         id,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash record unbonding entries")
+
+	return cmd
+}
@@ -5,6 +5,8 @@ import (
 
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"cosmossdk.io/math"
+
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -45,9 +47,142 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateSlashRecords(
+		data.SlashRecords, data.LastSlashRecordId, data.SlashedDelegations, data.SlashedUnbondingEntries, data.SlashedDelegationShares,
+	); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastRecordID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+		ids[record.Id] = true
+
+		if record.Id == 0 || record.Id > lastRecordID {
+			return fmt.Errorf("invalid tokenize share record id %d, last tokenize share record id is %d", record.Id, lastRecordID)
+		}
+
+		if record.ModuleAccount != types.TokenizeShareModuleAccountName(record.Id) {
+			return fmt.Errorf("invalid module account %s for tokenize share record %d", record.ModuleAccount, record.Id)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return fmt.Errorf("invalid owner of tokenize share record %d: %w", record.Id, err)
+		}
+
+		if _, err := sdk.ValAddressFromBech32(record.Validator); err != nil {
+			return fmt.Errorf("invalid validator of tokenize share record %d: %w", record.Id, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateSlashRecords(
+	records []types.SlashRecord, lastRecordID uint64,
+	delegations []types.SlashedDelegation, unbondingEntries []types.SlashedUnbondingEntry,
+	delegationShares []types.SlashedDelegationShares,
+) error {
+	// the restorable tokens of a record cannot exceed its burned tokens, the
+	// tokens burned from the delegations to the validator are all restorable
+	burned := make(map[uint64]math.Int, len(records))
+	restorable := make(map[uint64]math.Int, len(records))
+	recordsByID := make(map[uint64]types.SlashRecord, len(records))
+
+	for _, record := range records {
+		if _, ok := burned[record.Id]; ok {
+			return fmt.Errorf("duplicate slash record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id == 0 || record.Id > lastRecordID {
+			return fmt.Errorf("invalid slash record id %d, last slash record id is %d", record.Id, lastRecordID)
+		}
+
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		burned[record.Id] = record.Burned
+		restorable[record.Id] = record.ValidatorBurned
+		recordsByID[record.Id] = record
+	}
+
+	restore := func(id uint64, amount math.Int) error {
+		if _, ok := burned[id]; !ok {
+			return fmt.Errorf("slashed tokens of unknown slash record %d in genesis state", id)
+		}
+
+		restorable[id] = restorable[id].Add(amount)
+		if restorable[id].GT(burned[id]) {
+			return fmt.Errorf("restorable amount of slash record %d exceeds its burned amount %s", id, burned[id])
+		}
+		return nil
+	}
+
+	for _, delegation := range delegations {
+		if err := delegation.Validate(); err != nil {
+			return err
+		}
+		if err := restore(delegation.SlashRecordId, delegation.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range unbondingEntries {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+		if err := restore(entry.SlashRecordId, entry.Amount); err != nil {
+			return err
+		}
+	}
+
+	// the shares held at a slash by the delegations cannot exceed the delegator
+	// shares of the validator at the slash
+	shares := make(map[uint64]math.LegacyDec, len(records))
+	delegators := make(map[uint64]map[string]bool, len(records))
+	for _, delegation := range delegationShares {
+		if err := delegation.Validate(); err != nil {
+			return err
+		}
+
+		id := delegation.SlashRecordId
+		record, ok := recordsByID[id]
+		if !ok {
+			return fmt.Errorf("slashed delegation shares of unknown slash record %d in genesis state", id)
+		}
+		if delegation.ValidatorAddress != record.ValidatorAddress {
+			return fmt.Errorf("slashed delegation shares of slash record %d have validator %s instead of %s", id, delegation.ValidatorAddress, record.ValidatorAddress)
+		}
+
+		if delegators[id] == nil {
+			delegators[id] = make(map[string]bool)
+			shares[id] = math.LegacyZeroDec()
+		}
+		if delegators[id][delegation.DelegatorAddress] {
+			return fmt.Errorf("duplicate slashed delegation shares of slash record %d in genesis state: delegator %s", id, delegation.DelegatorAddress)
+		}
+		delegators[id][delegation.DelegatorAddress] = true
+
+		shares[id] = shares[id].Add(delegation.Shares)
+		if shares[id].GT(record.DelegatorShares) {
+			return fmt.Errorf("slashed delegation shares of slash record %d exceed its delegator shares %s", id, record.DelegatorShares)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
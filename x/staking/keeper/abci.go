@@ -22,5 +22,8 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) {
 func (k *Keeper) EndBlocker(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	return k.BlockValidatorUpdates(sdk.UnwrapSDKContext(ctx)), nil
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.PruneExpiredSlashRecords(sdkCtx)
+
+	return k.BlockValidatorUpdates(sdkCtx), nil
 }
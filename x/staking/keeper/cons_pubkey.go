@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey replaces the consensus public key of a validator by the
+// given one. The rotation fee is charged to the validator operator and burnt.
+// The old consensus address keeps resolving to the validator so that
+// infractions committed with the old key are still slashable, and the
+// validator set update is returned to CometBFT at the end of the block.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	valAddr := validator.GetOperator()
+	if k.exceedsMaxConsPubKeyRotations(ctx, valAddr) {
+		return errorsmod.Wrapf(types.ErrExceedingMaxConsPubKeyRotations, "validator %s already rotated its consensus pubkey within the unbonding period", validator.OperatorAddress)
+	}
+
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+	if _, found := k.GetNewConsAddr(ctx, newConsAddr); found {
+		return types.ErrConsensusPubKeyAlreadyUsed
+	}
+
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	fee := k.KeyRotationFee(ctx)
+	if fee.IsPositive() {
+		coins := sdk.NewCoins(fee)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.NotBondedPoolName, coins); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins); err != nil {
+			return err
+		}
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.GetConsAddress(oldPubKey)
+	validator.ConsensusPubkey = pkAny
+	k.SetValidator(ctx, validator)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorByConsAddrKey(oldConsAddr))
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+	k.SetNewConsAddr(ctx, oldConsAddr, newConsAddr)
+
+	history, err := types.NewConsPubKeyRotationHistory(valAddr, oldPubKey, newPubKey, ctx.BlockHeight(), ctx.BlockTime(), fee)
+	if err != nil {
+		return err
+	}
+	k.SetConsPubKeyRotationHistory(ctx, history)
+	k.setBlockConsPubKeyRotationHistory(ctx, history)
+
+	return k.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, fee)
+}
+
+// exceedsMaxConsPubKeyRotations returns true if the validator already rotated
+// its consensus public key within the unbonding period.
+func (k Keeper) exceedsMaxConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.GetValidatorConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return false
+	}
+
+	var history types.ConsPubKeyRotationHistory
+	k.cdc.MustUnmarshal(iterator.Value(), &history)
+
+	return history.Time.Add(k.UnbondingTime(ctx)).After(ctx.BlockTime())
+}
+
+// GetNewConsAddr returns the consensus address which replaced the given
+// rotated consensus address.
+func (k Keeper) GetNewConsAddr(ctx sdk.Context, oldConsAddr sdk.ConsAddress) (sdk.ConsAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetOldToNewConsAddrKey(oldConsAddr))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// SetNewConsAddr sets the consensus address replacing a rotated consensus address.
+func (k Keeper) SetNewConsAddr(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOldToNewConsAddrKey(oldConsAddr), newConsAddr)
+}
+
+// SetConsPubKeyRotationHistory sets a consensus public key rotation in the
+// history of the validator.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&history)
+	store.Set(types.GetValidatorConsPubKeyRotationHistoryKey(history.GetOperator(), history.Height), bz)
+}
+
+// GetValidatorConsPubKeyRotationHistory returns the consensus public key
+// rotations of a validator, ordered by height.
+func (k Keeper) GetValidatorConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetValidatorConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// GetAllConsPubKeyRotationHistory returns the consensus public key rotations
+// of all the validators.
+func (k Keeper) GetAllConsPubKeyRotationHistory(ctx sdk.Context) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorConsPubKeyRotationHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// setBlockConsPubKeyRotationHistory records a consensus public key rotation
+// to be applied to the validator set at the end of the block.
+func (k Keeper) setBlockConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&history)
+	store.Set(types.GetBlockConsPubKeyRotationHistoryKey(history.Height, history.GetOperator()), bz)
+}
+
+// getBlockConsPubKeyRotationHistory returns the consensus public key rotations
+// that happened at the given height.
+func (k Keeper) getBlockConsPubKeyRotationHistory(ctx sdk.Context, height int64) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetBlockConsPubKeyRotationHistoryPrefix(height))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// deleteBlockConsPubKeyRotationHistory removes the consensus public key
+// rotations recorded for the given height.
+func (k Keeper) deleteBlockConsPubKeyRotationHistory(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetBlockConsPubKeyRotationHistoryPrefix(height))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -108,6 +108,9 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 		panic(err)
 	}
 
+	// record the shares held at the slashes of the validator before they change
+	k.recordSlashedDelegationShares(ctx, delegation, delegatorAddress)
+
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
@@ -128,6 +131,8 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 		return err
 	}
 
+	k.recordSlashedDelegationShares(ctx, delegation, delegatorAddress)
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
 	store.Delete(types.GetDelegationsByValKey(delegation.GetValidatorAddr(), delegatorAddress))
@@ -407,23 +412,76 @@ func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) storetypes.
 // DequeueAllMatureUBDQueue returns a concatenated list of all the timeslices inclusively previous to
 // currTime, and deletes the timeslices from the queue.
 func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (matureUnbonds []types.DVPair) {
+	matureUnbonds, _ = k.DequeueMatureUBDQueue(ctx, currTime, 0)
+	return matureUnbonds
+}
+
+// DequeueMatureUBDQueue returns the oldest maxEntries pairs of the timeslices
+// inclusively previous to currTime, and deletes them from the queue. The
+// mature pairs above maxEntries are kept in the queue. As the queue is not
+// iterated past maxEntries, the returned pending number is a lower bound of
+// the mature pairs left: it is only zero if none are left. A zero maxEntries
+// means no limit.
+func (k Keeper) DequeueMatureUBDQueue(ctx sdk.Context, currTime time.Time, maxEntries uint32) (matureUnbonds []types.DVPair, pending int) {
 	store := ctx.KVStore(k.storeKey)
 
+	var (
+		dequeuedKeys [][]byte
+		carryOverKey []byte
+		carryOver    []types.DVPair
+	)
+
 	// gets an iterator for all timeslices from time 0 until the current Blockheader time
 	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, currTime)
-	defer unbondingTimesliceIterator.Close()
-
 	for ; unbondingTimesliceIterator.Valid(); unbondingTimesliceIterator.Next() {
 		timeslice := types.DVPairs{}
 		value := unbondingTimesliceIterator.Value()
 		k.cdc.MustUnmarshal(value, &timeslice)
 
-		matureUnbonds = append(matureUnbonds, timeslice.Pairs...)
+		n := queueCompletions(len(timeslice.Pairs), len(matureUnbonds), maxEntries)
+		if n == 0 {
+			// the queue is not iterated further once maxEntries are dequeued
+			pending = len(timeslice.Pairs)
+			break
+		}
 
-		store.Delete(unbondingTimesliceIterator.Key())
+		matureUnbonds = append(matureUnbonds, timeslice.Pairs[:n]...)
+		if n < len(timeslice.Pairs) {
+			carryOverKey, carryOver = bytes.Clone(unbondingTimesliceIterator.Key()), timeslice.Pairs[n:]
+			pending = len(carryOver)
+			break
+		}
+		dequeuedKeys = append(dequeuedKeys, bytes.Clone(unbondingTimesliceIterator.Key()))
 	}
+	unbondingTimesliceIterator.Close()
 
-	return matureUnbonds
+	for _, key := range dequeuedKeys {
+		store.Delete(key)
+	}
+	if carryOverKey != nil {
+		store.Set(carryOverKey, k.cdc.MustMarshal(&types.DVPairs{Pairs: carryOver}))
+	}
+
+	return matureUnbonds, pending
+}
+
+// queueCompletions returns the number of entries of a queue timeslice which
+// can be completed once dequeued entries were, given the max number of
+// entries to complete. A zero maxEntries means no limit.
+func queueCompletions(entries, dequeued int, maxEntries uint32) int {
+	if maxEntries == 0 {
+		return entries
+	}
+
+	available := int(maxEntries) - dequeued
+	switch {
+	case available <= 0:
+		return 0
+	case available < entries:
+		return available
+	default:
+		return entries
+	}
 }
 
 // GetRedelegations returns a given amount of all the delegator redelegations.
@@ -645,30 +703,79 @@ func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) st
 // timeslices inclusively previous to currTime, and deletes the timeslices from
 // the queue.
 func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time.Time) (matureRedelegations []types.DVVTriplet) {
+	matureRedelegations, _ = k.DequeueMatureRedelegationQueue(ctx, currTime, 0)
+	return matureRedelegations
+}
+
+// DequeueMatureRedelegationQueue returns the oldest maxEntries triplets of the
+// timeslices inclusively previous to currTime, and deletes them from the
+// queue. The mature triplets above maxEntries are kept in the queue. As the
+// queue is not iterated past maxEntries, the returned pending number is a
+// lower bound of the mature triplets left: it is only zero if none are left.
+// A zero maxEntries means no limit.
+func (k Keeper) DequeueMatureRedelegationQueue(ctx sdk.Context, currTime time.Time, maxEntries uint32) (matureRedelegations []types.DVVTriplet, pending int) {
 	store := ctx.KVStore(k.storeKey)
 
-	// gets an iterator for all timeslices from time 0 until the current Blockheader time
-	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
-	defer redelegationTimesliceIterator.Close()
+	var (
+		dequeuedKeys [][]byte
+		carryOverKey []byte
+		carryOver    []types.DVVTriplet
+	)
 
+	// gets an iterator for all timeslices from time 0 until the current Blockheader time
+	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, currTime)
 	for ; redelegationTimesliceIterator.Valid(); redelegationTimesliceIterator.Next() {
 		timeslice := types.DVVTriplets{}
 		value := redelegationTimesliceIterator.Value()
 		k.cdc.MustUnmarshal(value, &timeslice)
 
-		matureRedelegations = append(matureRedelegations, timeslice.Triplets...)
+		n := queueCompletions(len(timeslice.Triplets), len(matureRedelegations), maxEntries)
+		if n == 0 {
+			// the queue is not iterated further once maxEntries are dequeued
+			pending = len(timeslice.Triplets)
+			break
+		}
 
-		store.Delete(redelegationTimesliceIterator.Key())
+		matureRedelegations = append(matureRedelegations, timeslice.Triplets[:n]...)
+		if n < len(timeslice.Triplets) {
+			carryOverKey, carryOver = bytes.Clone(redelegationTimesliceIterator.Key()), timeslice.Triplets[n:]
+			pending = len(carryOver)
+			break
+		}
+		dequeuedKeys = append(dequeuedKeys, bytes.Clone(redelegationTimesliceIterator.Key()))
 	}
+	redelegationTimesliceIterator.Close()
 
-	return matureRedelegations
+	for _, key := range dequeuedKeys {
+		store.Delete(key)
+	}
+	if carryOverKey != nil {
+		store.Set(carryOverKey, k.cdc.MustMarshal(&types.DVVTriplets{Triplets: carryOver}))
+	}
+
+	return matureRedelegations, pending
 }
 
 // Delegate performs a delegation, set/update everything necessary within the store.
-// tokenSrc indicates the bond status of the incoming funds.
+// tokenSrc indicates the bond status of the incoming funds. The delegation fails
+// if it makes the validator exceed the max validator power ratio.
 func (k Keeper) Delegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc types.BondStatus,
 	validator types.Validator, subtractAccount bool,
+) (newShares math.LegacyDec, err error) {
+	if err := k.checkMaxValidatorPowerRatio(ctx, validator, bondAmt, tokenSrc); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return k.delegate(ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+}
+
+// delegate performs a delegation without checking the max validator power
+// ratio. It is used when the delegated tokens were already delegated to the
+// validator, e.g. when tokenizing shares or refunding a reversed slash.
+func (k Keeper) delegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc types.BondStatus,
+	validator types.Validator, subtractAccount bool,
 ) (newShares math.LegacyDec, err error) {
 	// In some situations, the exchange rate becomes invalid, e.g. if
 	// Validator loses all tokens due to slashing. In this case,
@@ -1183,3 +1183,68 @@ func (s *KeeperTestSuite) TestSetUnbondingDelegationEntry() {
 	// unbondingID comes from a global counter -> gaps in unbondingIDs are OK as long as every unbondingID is unique
 	require.Equal(uint64(2), resUnbonding.Entries[1].UnbondingId)
 }
+
+func (s *KeeperTestSuite) TestDequeueMatureUBDQueue() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(5)
+
+	now := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// three entries mature at two different times, one is not mature yet
+	times := []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour), now.Add(-time.Hour), now.Add(time.Hour)}
+	for i, completionTime := range times {
+		ubd := stakingtypes.NewUnbondingDelegation(delAddrs[i], valAddrs[i], 0, completionTime, math.NewInt(5), 0)
+		keeper.InsertUBDQueue(ctx, ubd, completionTime)
+	}
+
+	// the iteration stops at the first timeslice, the entries of the next one
+	// are reported as pending
+	matureUnbonds, pending := keeper.DequeueMatureUBDQueue(ctx, now, 1)
+	require.Len(matureUnbonds, 1)
+	require.Equal(2, pending)
+	require.Equal(delAddrs[0].String(), matureUnbonds[0].DelegatorAddress)
+	require.Empty(keeper.GetUBDQueueTimeSlice(ctx, times[0]))
+
+	// the second mature entry of the last timeslice is carried over
+	matureUnbonds, pending = keeper.DequeueMatureUBDQueue(ctx, now, 1)
+	require.Len(matureUnbonds, 1)
+	require.Equal(1, pending)
+	require.Equal(delAddrs[1].String(), matureUnbonds[0].DelegatorAddress)
+	require.Len(keeper.GetUBDQueueTimeSlice(ctx, times[1]), 1)
+
+	matureUnbonds, pending = keeper.DequeueMatureUBDQueue(ctx, now, 2)
+	require.Len(matureUnbonds, 1)
+	require.Zero(pending)
+	require.Equal(delAddrs[2].String(), matureUnbonds[0].DelegatorAddress)
+
+	// no limit dequeues all the mature entries
+	require.Empty(keeper.DequeueAllMatureUBDQueue(ctx, now))
+	require.Len(keeper.DequeueAllMatureUBDQueue(ctx, times[3]), 1)
+}
+
+func (s *KeeperTestSuite) TestDequeueMatureRedelegationQueue() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(4)
+
+	now := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	for i := 0; i < 3; i++ {
+		red := stakingtypes.NewRedelegation(delAddrs[i], valAddrs[i], valAddrs[3], 0, now, math.NewInt(5), math.LegacyNewDec(5), 0)
+		keeper.InsertRedelegationQueue(ctx, red, now)
+	}
+
+	matureRedelegations, pending := keeper.DequeueMatureRedelegationQueue(ctx, now, 1)
+	require.Len(matureRedelegations, 1)
+	require.Equal(2, pending)
+
+	matureRedelegations, pending = keeper.DequeueMatureRedelegationQueue(ctx, now, 0)
+	require.Len(matureRedelegations, 2)
+	require.Zero(pending)
+	require.Empty(keeper.GetRedelegationQueueTimeSlice(ctx, now))
+}
@@ -63,6 +63,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, history := range data.RotationHistory {
+		k.SetConsPubKeyRotationHistory(ctx, history)
+
+		oldPk, err := history.OldConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+		newPk, err := history.NewConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+		k.SetNewConsAddr(ctx, sdk.GetConsAddress(oldPk), sdk.GetConsAddress(newPk))
+	}
+
 	for _, delegation := range data.Delegations {
 		delegatorAddress, err := k.authKeeper.StringToBytes(delegation.DelegatorAddress)
 		if err != nil {
@@ -103,6 +117,47 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+
+		// the tokenized shares of a validator are the shares of the delegations
+		// of its tokenize share records
+		valAddr := record.GetValidator()
+		if delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr); found {
+			k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(delegation.Shares))
+		}
+	}
+
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	for _, record := range data.SlashRecords {
+		if err := k.AddSlashRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	k.SetLastSlashRecordID(ctx, data.LastSlashRecordId)
+
+	for _, delegation := range data.SlashedDelegations {
+		if err := k.AddSlashedDelegation(ctx, delegation); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range data.SlashedUnbondingEntries {
+		if err := k.AddSlashedUnbondingEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, delegation := range data.SlashedDelegationShares {
+		if err := k.SetSlashedDelegationShares(ctx, delegation); err != nil {
+			panic(err)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -205,5 +260,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		RotationHistory:      k.GetAllConsPubKeyRotationHistory(ctx),
+
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+
+		SlashRecords:            k.GetAllSlashRecords(ctx),
+		LastSlashRecordId:       k.GetLastSlashRecordID(ctx),
+		SlashedDelegations:      k.GetAllSlashedDelegations(ctx),
+		SlashedUnbondingEntries: k.GetAllSlashedUnbondingEntries(ctx),
+		SlashedDelegationShares: k.GetAllSlashedDelegationShares(ctx),
 	}
 }
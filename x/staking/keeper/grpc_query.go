@@ -503,6 +503,297 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecordByID queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecordByID(c context.Context, req *types.QueryTokenizeShareRecordByIDRequest) (*types.QueryTokenizeShareRecordByIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByIDResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom queries a tokenize share record by its share token denom
+func (k Querier) TokenizeShareRecordByDenom(c context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records owned by an address
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := k.authKeeper.StringToBytes(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := k.GetTokenizeShareRecordsByOwner(ctx, owner)
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records}, nil
+}
+
+// TotalLiquidStaked queries the total amount of tokenized bond tokens
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: k.GetTotalLiquidStakedTokens(ctx)}, nil
+}
+
+// UnbondingQueue queries the unbonding delegations queue ordered by completion time
+func (k Querier) UnbondingQueue(c context.Context, req *types.QueryUnbondingQueueRequest) (*types.QueryUnbondingQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingQueueKey)
+
+	var timeSlices []types.UnbondingQueueTimeSlice
+	pageRes, err := query.Paginate(queueStore, req.Pagination, func(key, value []byte) error {
+		completionTime, err := sdk.ParseTimeBytes(key)
+		if err != nil {
+			return err
+		}
+
+		var pairs types.DVPairs
+		if err := k.cdc.Unmarshal(value, &pairs); err != nil {
+			return err
+		}
+
+		timeSlices = append(timeSlices, types.UnbondingQueueTimeSlice{CompletionTime: completionTime, Pairs: pairs.Pairs})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingQueueResponse{TimeSlices: timeSlices, Pagination: pageRes}, nil
+}
+
+// RedelegationQueue queries the redelegations queue ordered by completion time
+func (k Querier) RedelegationQueue(c context.Context, req *types.QueryRedelegationQueueRequest) (*types.QueryRedelegationQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationQueueKey)
+
+	var timeSlices []types.RedelegationQueueTimeSlice
+	pageRes, err := query.Paginate(queueStore, req.Pagination, func(key, value []byte) error {
+		completionTime, err := sdk.ParseTimeBytes(key)
+		if err != nil {
+			return err
+		}
+
+		var triplets types.DVVTriplets
+		if err := k.cdc.Unmarshal(value, &triplets); err != nil {
+			return err
+		}
+
+		timeSlices = append(timeSlices, types.RedelegationQueueTimeSlice{CompletionTime: completionTime, Triplets: triplets.Triplets})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationQueueResponse{TimeSlices: timeSlices, Pagination: pageRes}, nil
+}
+
+// SlashRecord queries a slash record by its id
+func (k Querier) SlashRecord(c context.Context, req *types.QuerySlashRecordRequest) (*types.QuerySlashRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetSlashRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QuerySlashRecordResponse{Record: record}, nil
+}
+
+// SlashRecords queries all slash records
+func (k Querier) SlashRecords(c context.Context, req *types.QuerySlashRecordsRequest) (*types.QuerySlashRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordPrefix)
+
+	var records []types.SlashRecord
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key, value []byte) error {
+		var record types.SlashRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// ValidatorSlashRecords queries the slash records of a validator
+func (k Querier) ValidatorSlashRecords(c context.Context, req *types.QueryValidatorSlashRecordsRequest) (*types.QueryValidatorSlashRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSlashRecordIDsByValidatorPrefix(valAddr))
+
+	var records []types.SlashRecord
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key, value []byte) error {
+		record, err := k.GetSlashRecord(ctx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorSlashRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// SlashRecordDelegation queries the tokens burned by a slash from a delegation to the slashed validator
+func (k Querier) SlashRecordDelegation(c context.Context, req *types.QuerySlashRecordDelegationRequest) (*types.QuerySlashRecordDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	delAddr, err := k.authKeeper.StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetSlashRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	amount, err := k.GetSlashRecordDelegation(ctx, record, delAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRecordDelegationResponse{
+		Delegation: types.NewSlashedDelegation(record.Id, req.DelegatorAddr, record.ValidatorAddress, amount),
+	}, nil
+}
+
+// SlashRecordDelegations queries the tokens burned by a slash from the delegation of each slashed redelegation
+func (k Querier) SlashRecordDelegations(c context.Context, req *types.QuerySlashRecordDelegationsRequest) (*types.QuerySlashRecordDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.GetSlashRecord(ctx, req.Id); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	delegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSlashedDelegationsPrefix(req.Id))
+
+	var delegations []types.SlashedDelegation
+	pageRes, err := query.Paginate(delegationStore, req.Pagination, func(key, value []byte) error {
+		var delegation types.SlashedDelegation
+		if err := k.cdc.Unmarshal(value, &delegation); err != nil {
+			return err
+		}
+
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRecordDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// SlashRecordUnbondingEntries queries the tokens burned from each unbonding delegation entry by a slash
+func (k Querier) SlashRecordUnbondingEntries(c context.Context, req *types.QuerySlashRecordUnbondingEntriesRequest) (*types.QuerySlashRecordUnbondingEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.GetSlashRecord(ctx, req.Id); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	entryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSlashedUnbondingEntriesPrefix(req.Id))
+
+	var entries []types.SlashedUnbondingEntry
+	pageRes, err := query.Paginate(entryStore, req.Pagination, func(key, value []byte) error {
+		var entry types.SlashedUnbondingEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashRecordUnbondingEntriesResponse{UnbondingEntries: entries, Pagination: pageRes}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.StringToBytes(req.DelegatorAddr)
 	if err != nil {
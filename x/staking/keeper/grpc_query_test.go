@@ -3,8 +3,12 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -60,3 +64,34 @@ func (s *KeeperTestSuite) TestGRPCQueryValidator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryUnbondingQueue() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(3)
+
+	now := time.Unix(1000, 0).UTC()
+	times := []time.Time{now.Add(time.Hour), now, now}
+	for i, completionTime := range times {
+		ubd := types.NewUnbondingDelegation(delAddrs[i], valAddrs[i], 0, completionTime, math.NewInt(5), 0)
+		keeper.InsertUBDQueue(ctx, ubd, completionTime)
+	}
+
+	res, err := queryClient.UnbondingQueue(gocontext.Background(), &types.QueryUnbondingQueueRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(uint64(2), res.Pagination.Total)
+	require.Len(res.TimeSlices, 1)
+	require.Equal(now, res.TimeSlices[0].CompletionTime)
+	require.Len(res.TimeSlices[0].Pairs, 2)
+
+	res, err = queryClient.UnbondingQueue(gocontext.Background(), &types.QueryUnbondingQueueRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(res.TimeSlices, 1)
+	require.Equal(times[0], res.TimeSlices[0].CompletionTime)
+	require.Equal(delAddrs[0].String(), res.TimeSlices[0].Pairs[0].DelegatorAddress)
+}
@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TokenizeShares converts the given amount of tokens delegated by a delegator
+// to a validator into share tokens. The delegation is moved to the module
+// account of a new tokenize share record whose owner receives the rewards of
+// the delegation, and the share tokens are minted to the delegator.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	// tokenizing shares received through a redelegation would allow to escape
+	// slashing for infractions committed on the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares, amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(recordID, owner, valAddr)
+	recordAddr := record.GetModuleAddress()
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	// the tokens go through the delegator account so that the delegated
+	// vesting coins of vesting accounts are tracked
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.Hooks().BeforeTokenizeShareRecordOwnerChanged(ctx, recordAddr, valAddr, owner); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the validator is refreshed as the unbonding modified it
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareToken.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount is too small to be tokenized")
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(newShares))
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens and delegates the corresponding
+// tokens of the tokenize share record delegation from the delegator. The
+// record is removed once all its share tokens are redeemed. If the validator
+// of the record was removed in the meantime, the tokens are returned to the
+// delegator instead.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareToken.Denom)
+	if balance.Amount.LT(shareToken.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, shareToken)
+	}
+
+	valAddr := record.GetValidator()
+	recordAddr := record.GetModuleAddress()
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, recordAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	// the last share tokens redeem the fraction of shares which was truncated
+	// when minting them
+	shares := math.LegacyNewDecFromInt(shareToken.Amount)
+	if k.bankKeeper.GetSupply(ctx, shareToken.Denom).Amount.Equal(shareToken.Amount) {
+		shares = delegation.Shares
+	} else if shares.GT(delegation.Shares) {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, recordAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, delAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SetValidatorLiquidShares(ctx, valAddr, math.LegacyMaxDec(k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares), math.LegacyZeroDec()))
+
+	if _, found := k.GetDelegation(ctx, recordAddr, valAddr); !found {
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+
+	// the validator is removed once unbonded without delegations left
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found || !returnAmount.IsPositive() {
+		return returnCoin, nil
+	}
+
+	if _, err := k.delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return returnCoin, nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record, and thus of the rewards of its delegation, to a new owner.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, recordID uint64, sender, newOwner sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+
+	if !record.GetOwner().Equals(sender) {
+		return errorsmod.Wrapf(types.ErrNotTokenizeShareRecordOwner, "%s is not the owner of record %d", sender, recordID)
+	}
+
+	if err := k.Hooks().BeforeTokenizeShareRecordOwnerChanged(ctx, record.GetModuleAddress(), record.GetValidator(), newOwner); err != nil {
+		return err
+	}
+
+	k.updateTokenizeShareRecordOwner(ctx, record, newOwner)
+
+	return nil
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the given shares and
+// tokens of a validator exceeds the global or the validator liquid staking cap.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares math.LegacyDec, tokens math.Int) error {
+	params := k.GetParams(ctx)
+
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalBonded := k.TotalBondedTokens(ctx)
+		totalLiquid := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+		if totalBonded.IsZero() || math.LegacyNewDecFromInt(totalLiquid).QuoInt(totalBonded).GT(params.GlobalLiquidStakingCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) {
+		liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+		if validator.DelegatorShares.IsZero() || liquidShares.Quo(validator.DelegatorShares).GT(params.ValidatorLiquidStakingCap) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator which
+// are tokenized.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+
+	var shares sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &shares)
+	return shares.Dec
+}
+
+// SetValidatorLiquidShares sets the delegator shares of a validator which are
+// tokenized.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// GetTotalLiquidStakedTokens returns the amount of delegated tokens which are
+// tokenized.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	total := math.LegacyZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		validator, found := k.GetValidator(ctx, types.AddressFromValidatorLiquidSharesKey(iterator.Key()))
+		if !found {
+			continue
+		}
+
+		var shares sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+		total = total.Add(validator.TokensFromShares(shares.Dec))
+	}
+
+	return total.TruncateInt()
+}
@@ -59,6 +59,10 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
 	}
 
+	if _, found := k.GetNewConsAddr(ctx, sdk.GetConsAddress(pk)); found {
+		return nil, types.ErrConsensusPubKeyAlreadyUsed
+	}
+
 	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
 		return nil, types.ErrValidatorPubKeyExists
 	}
@@ -74,22 +78,8 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
-	cp := ctx.ConsensusParams()
-	if cp.Validator != nil {
-		pkType := pk.Type()
-		hasKeyType := false
-		for _, keyType := range cp.Validator.PubKeyTypes {
-			if pkType == keyType {
-				hasKeyType = true
-				break
-			}
-		}
-		if !hasKeyType {
-			return nil, errorsmod.Wrapf(
-				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
-			)
-		}
+	if err := validateConsPubKeyType(ctx, pk); err != nil {
+		return nil, err
 	}
 
 	validator, err := types.NewValidator(valAddr, pk, msg.Description)
@@ -109,6 +99,12 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 
 	validator.MinSelfDelegation = msg.MinSelfDelegation
 
+	// checked before the validator is stored, the self-delegation below checks
+	// it again
+	if err := k.checkMaxValidatorPowerRatio(ctx, validator, msg.Value.Amount, types.Unbonded); err != nil {
+		return nil, err
+	}
+
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByConsAddr(ctx, validator)
 	k.SetNewValidatorByPowerIndex(ctx, validator)
@@ -551,10 +547,241 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	minCommissionRate := k.MinCommissionRate(ctx)
+
 	// store params
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
+	// the validators below a raised min commission rate are brought up to it
+	if msg.Params.MinCommissionRate.GT(minCommissionRate) {
+		if err := k.applyMinCommissionRate(ctx, msg.Params.MinCommissionRate); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RotateConsPubKey defines a method for rotating the consensus public key of a validator
+func (k msgServer) RotateConsPubKey(goCtx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.NewPubkey == nil {
+		return nil, types.ErrEmptyValidatorPubKey
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := validateConsPubKeyType(ctx, pk); err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RotateConsPubKey(ctx, validator, pk); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.GetConsAddress(pk).String()),
+		),
+	})
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
+
+// TokenizeShares defines a method for converting a delegation into share tokens
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	owner, err := k.authKeeper.StringToBytes(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenized share owner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	shareToken, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, valAddr, msg.Amount.Amount, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(k.GetLastTokenizeShareRecordID(ctx), 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenizedShares, shareToken.String()),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareToken,
+	}, nil
+}
+
+// RedeemTokensForShares defines a method for converting share tokens back into a delegation
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid share tokens amount",
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	returnCoin, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokenizedShares, msg.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnCoin.String()),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: returnCoin,
+	}, nil
+}
+
+// TransferTokenizeShareRecord defines a method for transferring the ownership of a tokenize share record
+func (k msgServer) TransferTokenizeShareRecord(goCtx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	sender, err := k.authKeeper.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	newOwner, err := k.authKeeper.StringToBytes(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.TransferTokenizeShareRecord(ctx, msg.TokenizeShareRecordId, sender, newOwner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferTokenizeShareRecord,
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(msg.TokenizeShareRecordId, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.NewOwner),
+		),
+	})
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// ReverseSlash defines a governance operation for reversing a recorded slash
+func (k msgServer) ReverseSlash(goCtx context.Context, msg *types.MsgReverseSlash) (*types.MsgReverseSlashResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	restored, err := k.Keeper.ReverseSlash(ctx, msg.SlashRecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReverseSlash,
+			sdk.NewAttribute(types.AttributeKeySlashRecordID, strconv.FormatUint(msg.SlashRecordId, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, restored.String()),
+		),
+	})
+
+	return &types.MsgReverseSlashResponse{
+		Restored: restored,
+	}, nil
+}
+
+// validateConsPubKeyType checks that the consensus public key type is
+// supported by the consensus params.
+func validateConsPubKeyType(ctx sdk.Context, pk cryptotypes.PubKey) error {
+	cp := ctx.ConsensusParams()
+	if cp.Validator == nil {
+		return nil
+	}
+
+	pkType := pk.Type()
+	for _, keyType := range cp.Validator.PubKeyTypes {
+		if pkType == keyType {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(
+		types.ErrValidatorPubKeyTypeNotSupported,
+		"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+	)
+}
@@ -10,8 +10,10 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -1132,3 +1134,100 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgRotateConsPubKey() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, validator)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	otherValAddr := sdk.ValAddress(PKs[1].Address())
+	otherValidator := testutil.NewValidator(s.T(), otherValAddr, PKs[1])
+	keeper.SetValidator(ctx, otherValidator)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, otherValidator))
+
+	fee := sdk.NewCoins(keeper.KeyRotationFee(ctx))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.NotBondedPoolName, fee).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, fee).Return(nil).Times(1)
+
+	testCases := []struct {
+		name      string
+		malleate  func() sdk.Context
+		valAddr   sdk.ValAddress
+		pubKey    cryptotypes.PubKey
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "validator not found",
+			malleate:  func() sdk.Context { return ctx },
+			valAddr:   sdk.ValAddress(PKs[2].Address()),
+			pubKey:    PKs[3],
+			expErr:    true,
+			expErrMsg: "validator does not exist",
+		},
+		{
+			name:      "pubkey used by another validator",
+			malleate:  func() sdk.Context { return ctx },
+			valAddr:   valAddr,
+			pubKey:    PKs[1],
+			expErr:    true,
+			expErrMsg: "validator already exist for this pubkey",
+		},
+		{
+			name:     "valid rotation",
+			malleate: func() sdk.Context { return ctx },
+			valAddr:  valAddr,
+			pubKey:   PKs[3],
+			expErr:   false,
+		},
+		{
+			name:      "rotated pubkey cannot be reused",
+			malleate:  func() sdk.Context { return ctx.WithBlockHeight(ctx.BlockHeight() + 1) },
+			valAddr:   otherValAddr,
+			pubKey:    PKs[0],
+			expErr:    true,
+			expErrMsg: "consensus pubkey is already used",
+		},
+		{
+			name:      "second rotation within the unbonding period",
+			malleate:  func() sdk.Context { return ctx.WithBlockHeight(ctx.BlockHeight() + 1) },
+			valAddr:   valAddr,
+			pubKey:    PKs[4],
+			expErr:    true,
+			expErrMsg: "exceeding maximum consensus pubkey rotations within unbonding period",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			msg, err := stakingtypes.NewMsgRotateConsPubKey(tc.valAddr, tc.pubKey)
+			require.NoError(err)
+
+			_, err = msgServer.RotateConsPubKey(tc.malleate(), msg)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	// the validator is reachable through both the old and the new consensus address
+	newConsAddr := sdk.GetConsAddress(PKs[3])
+	oldConsAddr := sdk.GetConsAddress(PKs[0])
+	for _, consAddr := range []sdk.ConsAddress{newConsAddr, oldConsAddr} {
+		val, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
+		require.True(found)
+		require.Equal(validator.OperatorAddress, val.OperatorAddress)
+	}
+
+	history := keeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+	require.Len(history, 1)
+	require.Equal(fee[0], history[0].Fee)
+}
@@ -49,6 +49,36 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) math.LegacyDec {
 	return k.GetParams(ctx).MinCommissionRate
 }
 
+// KeyRotationFee - Fee charged for rotating a validator consensus public key
+func (k Keeper) KeyRotationFee(ctx sdk.Context) sdk.Coin {
+	return k.GetParams(ctx).KeyRotationFee
+}
+
+// GlobalLiquidStakingCap - Maximum fraction of the bonded tokens which can be tokenized
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) math.LegacyDec {
+	return k.GetParams(ctx).GlobalLiquidStakingCap
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of the shares of a validator which can be tokenized
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) math.LegacyDec {
+	return k.GetParams(ctx).ValidatorLiquidStakingCap
+}
+
+// MaxValidatorPowerRatio - Maximum fraction of the bonded tokens which can be bonded to a validator
+func (k Keeper) MaxValidatorPowerRatio(ctx sdk.Context) math.LegacyDec {
+	return k.GetParams(ctx).MaxValidatorPowerRatio
+}
+
+// MaxQueueCompletionsPerBlock - Maximum number of mature unbonding delegations and redelegations completed per block
+func (k Keeper) MaxQueueCompletionsPerBlock(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxQueueCompletionsPerBlock
+}
+
+// SlashReversalWindow - Duration after a slash during which it can be reversed
+func (k Keeper) SlashReversalWindow(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).SlashReversalWindow
+}
+
 // SetParams sets the x/staking module parameters.
 // CONTRACT: This method performs no validation of the parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
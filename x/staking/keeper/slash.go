@@ -74,6 +74,12 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	// redelegations, as that stake has since unbonded
 	remainingSlashAmount := slashAmount
 
+	// Record the tokens burned from the delegations, redelegations and unbonding
+	// delegation entries so that the slash can be reversed by governance. The
+	// record is only stored if tokens are burned, in which case it takes the
+	// next id.
+	record := types.NewSlashRecord(k.GetLastSlashRecordID(ctx)+1, operatorAddress, infractionHeight, ctx.BlockHeight(), ctx.BlockTime(), slashFactor)
+
 	switch {
 	case infractionHeight > ctx.BlockHeight():
 		// Can't slash infractions in the future
@@ -93,7 +99,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		// Iterate through unbonding delegations from slashed validator
 		unbondingDelegations := k.GetUnbondingDelegationsFromValidator(ctx, operatorAddress)
 		for _, unbondingDelegation := range unbondingDelegations {
-			amountSlashed := k.SlashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
+			amountSlashed, amountBurned := k.slashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor, &record)
+			record.Burned = record.Burned.Add(amountBurned)
 			if amountSlashed.IsZero() {
 				continue
 			}
@@ -104,7 +111,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		// Iterate through redelegations from slashed source validator
 		redelegations := k.GetRedelegationsFromSrcValidator(ctx, operatorAddress)
 		for _, redelegation := range redelegations {
-			amountSlashed := k.SlashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor)
+			amountSlashed, amountBurned := k.slashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor, &record)
+			record.Burned = record.Burned.Add(amountBurned)
 			if amountSlashed.IsZero() {
 				continue
 			}
@@ -130,6 +138,12 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		}
 	}
 
+	// Record the exchange of the burned tokens and the delegator shares of the
+	// validator, the tokens burned from each delegation are derived from it
+	record.ValidatorBurned = tokensToBurn
+	record.DelegatorShares = validator.DelegatorShares
+	record.Burned = record.Burned.Add(tokensToBurn)
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
@@ -153,6 +167,14 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		"slash_factor", slashFactor.String(),
 		"burned", tokensToBurn,
 	)
+
+	if record.Burned.IsPositive() {
+		k.SetLastSlashRecordID(ctx, record.Id)
+		if err := k.AddSlashRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
 	return tokensToBurn
 }
 
@@ -185,9 +207,20 @@ func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
 	infractionHeight int64, slashFactor math.LegacyDec,
 ) (totalSlashAmount math.Int) {
+	totalSlashAmount, _ = k.slashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor, nil)
+	return totalSlashAmount
+}
+
+// slashUnbondingDelegation slashes an unbonding delegation and returns the
+// amount that would have been slashed along with the amount actually burned.
+// The burned tokens of each entry are recorded under the record if it is not
+// nil.
+func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
+	infractionHeight int64, slashFactor math.LegacyDec, record *types.SlashRecord,
+) (totalSlashAmount, burnedAmount math.Int) {
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = math.ZeroInt()
-	burnedAmount := math.ZeroInt()
+	burnedAmount = math.ZeroInt()
 
 	// perform slashing on all entries within the unbonding delegation
 	for i, entry := range unbondingDelegation.Entries {
@@ -221,13 +254,19 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		entry.Balance = entry.Balance.Sub(unbondingSlashAmount)
 		unbondingDelegation.Entries[i] = entry
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
+
+		if record != nil {
+			if err := k.AddSlashedUnbondingEntry(ctx, types.NewSlashedUnbondingEntry(record.Id, unbondingDelegation, entry, unbondingSlashAmount)); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	if err := k.burnNotBondedTokens(ctx, burnedAmount); err != nil {
 		panic(err)
 	}
 
-	return totalSlashAmount
+	return totalSlashAmount, burnedAmount
 }
 
 // slash a redelegation and update the pool
@@ -239,6 +278,17 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator, redelegation types.Redelegation,
 	infractionHeight int64, slashFactor math.LegacyDec,
 ) (totalSlashAmount math.Int) {
+	totalSlashAmount, _ = k.slashRedelegation(ctx, srcValidator, redelegation, infractionHeight, slashFactor, nil)
+	return totalSlashAmount
+}
+
+// slashRedelegation slashes a redelegation and returns the amount that would
+// have been slashed along with the amount actually burned. The tokens burned
+// from the delegation to the destination validator are recorded under the
+// record if it is not nil.
+func (k Keeper) slashRedelegation(ctx sdk.Context, srcValidator types.Validator, redelegation types.Redelegation,
+	infractionHeight int64, slashFactor math.LegacyDec, record *types.SlashRecord,
+) (totalSlashAmount, burnedAmount math.Int) {
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = math.ZeroInt()
 	bondedBurnedAmount, notBondedBurnedAmount := math.ZeroInt(), math.ZeroInt()
@@ -308,6 +358,13 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 		}
 	}
 
+	burnedAmount = bondedBurnedAmount.Add(notBondedBurnedAmount)
+	if record != nil && burnedAmount.IsPositive() {
+		if err := k.AddSlashedDelegation(ctx, types.NewSlashedDelegation(record.Id, redelegation.DelegatorAddress, redelegation.ValidatorDstAddress, burnedAmount)); err != nil {
+			panic(err)
+		}
+	}
+
 	if err := k.burnBondedTokens(ctx, bondedBurnedAmount); err != nil {
 		panic(err)
 	}
@@ -316,5 +373,5 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 		panic(err)
 	}
 
-	return totalSlashAmount
+	return totalSlashAmount, burnedAmount
 }
@@ -0,0 +1,565 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastSlashRecordID returns the id of the last created slash record.
+func (k Keeper) GetLastSlashRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastSlashRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastSlashRecordID sets the id of the last created slash record.
+func (k Keeper) SetLastSlashRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSlashRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSlashRecord returns a slash record by its id.
+func (k Keeper) GetSlashRecord(ctx sdk.Context, id uint64) (types.SlashRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSlashRecordKey(id))
+	if bz == nil {
+		return types.SlashRecord{}, errorsmod.Wrapf(types.ErrSlashRecordNotExists, "id: %d", id)
+	}
+
+	var record types.SlashRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, nil
+}
+
+// GetValidatorSlashRecords returns the slash records of a validator.
+func (k Keeper) GetValidatorSlashRecords(ctx sdk.Context, valAddr sdk.ValAddress) (records []types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetSlashRecordIDsByValidatorPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetSlashRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllSlashRecords returns all the slash records.
+func (k Keeper) GetAllSlashRecords(ctx sdk.Context) (records []types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SlashRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// AddSlashRecord stores a new slash record along with its validator index.
+func (k Keeper) AddSlashRecord(ctx sdk.Context, record types.SlashRecord) error {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetSlashRecordKey(record.Id)) {
+		return errorsmod.Wrapf(types.ErrSlashRecordAlreadyExists, "id: %d", record.Id)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	k.setSlashRecord(ctx, record)
+	store.Set(types.GetSlashRecordIDByValidatorAndIDKey(valAddr, record.Id), sdk.Uint64ToBigEndian(record.Id))
+	store.Set(types.GetSlashRecordIDByTimeAndIDKey(record.Time, record.Id), sdk.Uint64ToBigEndian(record.Id))
+
+	return nil
+}
+
+// removeSlashRecord removes a slash record along with its indexes.
+func (k Keeper) removeSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store.Delete(types.GetSlashRecordKey(record.Id))
+	store.Delete(types.GetSlashRecordIDByValidatorAndIDKey(valAddr, record.Id))
+	store.Delete(types.GetSlashRecordIDByTimeAndIDKey(record.Time, record.Id))
+	deletePrefix(store, types.GetSlashedDelegationsPrefix(record.Id))
+	deletePrefix(store, types.GetSlashedUnbondingEntriesPrefix(record.Id))
+	deletePrefix(store, types.GetSlashedDelegationSharesPrefix(record.Id))
+}
+
+// deletePrefix deletes all the keys of a store with a prefix.
+func deletePrefix(store storetypes.KVStore, prefix []byte) {
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// PruneExpiredSlashRecords removes the slash records which can no longer be
+// reversed, i.e. the records of the slashes older than the slash reversal
+// window. All the records are removed if the window is zero.
+func (k Keeper) PruneExpiredSlashRecords(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	end := storetypes.PrefixEndBytes(types.SlashRecordIDByTimePrefix)
+	if window := k.SlashReversalWindow(ctx); window > 0 {
+		// a slash can be reversed until its time plus the window included
+		end = types.GetSlashRecordIDsByTimePrefix(ctx.BlockTime().Add(-window))
+	}
+
+	iterator := store.Iterator(types.SlashRecordIDByTimePrefix, end)
+	defer iterator.Close()
+
+	var expired []types.SlashRecord
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetSlashRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+		expired = append(expired, record)
+	}
+
+	for _, record := range expired {
+		k.removeSlashRecord(ctx, record)
+	}
+}
+
+func (k Keeper) setSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetSlashRecordKey(record.Id), bz)
+}
+
+// GetSlashedDelegations returns the tokens burned from the delegation of each
+// slashed redelegation by a slash.
+func (k Keeper) GetSlashedDelegations(ctx sdk.Context, id uint64) (delegations []types.SlashedDelegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetSlashedDelegationsPrefix(id))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.SlashedDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetAllSlashedDelegations returns the tokens burned from the delegation of
+// each slashed redelegation by all the recorded slashes.
+func (k Keeper) GetAllSlashedDelegations(ctx sdk.Context) (delegations []types.SlashedDelegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SlashedDelegationPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.SlashedDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// AddSlashedDelegation adds the tokens burned from a delegation by a slash,
+// summing them with the tokens already recorded for the delegation.
+func (k Keeper) AddSlashedDelegation(ctx sdk.Context, slashed types.SlashedDelegation) error {
+	delAddr, err := k.authKeeper.StringToBytes(slashed.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(slashed.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetSlashedDelegationKey(slashed.SlashRecordId, delAddr, valAddr)
+	if bz := store.Get(key); bz != nil {
+		var existing types.SlashedDelegation
+		k.cdc.MustUnmarshal(bz, &existing)
+		slashed.Amount = slashed.Amount.Add(existing.Amount)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&slashed))
+	return nil
+}
+
+// GetSlashRecordDelegation returns the tokens burned by a slash from the
+// delegation of a delegator to the slashed validator. They are derived from the
+// tokens burned from the validator pro rata to the shares held by the
+// delegation at the slash, and truncated.
+func (k Keeper) GetSlashRecordDelegation(ctx sdk.Context, record types.SlashRecord, delAddr sdk.AccAddress) (math.Int, error) {
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return slashedDelegationAmount(record, k.getSlashedDelegationShares(ctx, record.Id, delAddr, valAddr)), nil
+}
+
+// slashedDelegationAmount returns the tokens burned by a slash from a delegation
+// which held the given shares at the slash. The amount is truncated, the tokens
+// burned from all the delegations can thus sum to less than the tokens burned
+// from the validator.
+func slashedDelegationAmount(record types.SlashRecord, shares math.LegacyDec) math.Int {
+	if !record.DelegatorShares.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	return math.LegacyNewDecFromInt(record.ValidatorBurned).Mul(shares).Quo(record.DelegatorShares).TruncateInt()
+}
+
+// getSlashedDelegationShares returns the shares held at a slash by a delegation
+// to the slashed validator: the recorded shares if the delegation was modified
+// since the slash, its current shares otherwise.
+func (k Keeper) getSlashedDelegationShares(ctx sdk.Context, id uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetSlashedDelegationSharesKey(id, delAddr)); bz != nil {
+		var slashed types.SlashedDelegationShares
+		k.cdc.MustUnmarshal(bz, &slashed)
+		return slashed.Shares
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return math.LegacyZeroDec()
+	}
+
+	return delegation.Shares
+}
+
+// GetSlashedDelegationShares returns the shares held at a slash by each
+// delegation modified since the slash.
+func (k Keeper) GetSlashedDelegationShares(ctx sdk.Context, id uint64) (delegations []types.SlashedDelegationShares) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetSlashedDelegationSharesPrefix(id))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.SlashedDelegationShares
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetAllSlashedDelegationShares returns the shares held at all the recorded
+// slashes by each delegation modified since the slash.
+func (k Keeper) GetAllSlashedDelegationShares(ctx sdk.Context) (delegations []types.SlashedDelegationShares) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SlashedDelegationSharesPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.SlashedDelegationShares
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// SetSlashedDelegationShares sets the shares held at a slash by a delegation.
+func (k Keeper) SetSlashedDelegationShares(ctx sdk.Context, slashed types.SlashedDelegationShares) error {
+	delAddr, err := k.authKeeper.StringToBytes(slashed.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSlashedDelegationSharesKey(slashed.SlashRecordId, delAddr), k.cdc.MustMarshal(&slashed))
+	return nil
+}
+
+// recordSlashedDelegationShares records the shares held by a delegation at the
+// recorded slashes of its validator, unless they were already recorded. It is
+// called before the delegation is modified, so that the tokens burned from the
+// delegation by a slash can still be derived after the delegation changed.
+func (k Keeper) recordSlashedDelegationShares(ctx sdk.Context, delegation types.Delegation, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr := delegation.GetValidatorAddr()
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetSlashRecordIDsByValidatorPrefix(valAddr))
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Value())
+		if !store.Has(types.GetSlashedDelegationSharesKey(id, delAddr)) {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	// a delegation created after the slashes held no shares
+	shares := math.LegacyZeroDec()
+	if existing, found := k.GetDelegation(ctx, delAddr, valAddr); found {
+		shares = existing.Shares
+	}
+
+	for _, id := range ids {
+		slashed := types.NewSlashedDelegationShares(id, delegation.DelegatorAddress, delegation.ValidatorAddress, shares)
+		store.Set(types.GetSlashedDelegationSharesKey(id, delAddr), k.cdc.MustMarshal(&slashed))
+	}
+}
+
+// GetSlashedUnbondingEntries returns the tokens burned from each unbonding
+// delegation entry by a slash.
+func (k Keeper) GetSlashedUnbondingEntries(ctx sdk.Context, id uint64) (entries []types.SlashedUnbondingEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetSlashedUnbondingEntriesPrefix(id))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.SlashedUnbondingEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// GetAllSlashedUnbondingEntries returns the tokens burned from each unbonding
+// delegation entry by all the recorded slashes.
+func (k Keeper) GetAllSlashedUnbondingEntries(ctx sdk.Context) (entries []types.SlashedUnbondingEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SlashedUnbondingEntryPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.SlashedUnbondingEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// AddSlashedUnbondingEntry adds the tokens burned from an unbonding delegation
+// entry by a slash, summing them with the tokens already recorded for the
+// entry.
+func (k Keeper) AddSlashedUnbondingEntry(ctx sdk.Context, slashed types.SlashedUnbondingEntry) error {
+	delAddr, err := k.authKeeper.StringToBytes(slashed.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(slashed.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetSlashedUnbondingEntryKey(slashed.SlashRecordId, delAddr, valAddr, slashed.CreationHeight, slashed.UnbondingId)
+	if bz := store.Get(key); bz != nil {
+		var existing types.SlashedUnbondingEntry
+		k.cdc.MustUnmarshal(bz, &existing)
+		slashed.Amount = slashed.Amount.Add(existing.Amount)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&slashed))
+	return nil
+}
+
+// ReverseSlash reverses a recorded slash within the slash reversal window. The
+// tokens burned from each delegation, including the delegations of the slashed
+// redelegations, are minted and delegated back to its validator, and the
+// tokens burned from each unbonding delegation entry are minted back into the
+// entry. The tokens of a delegation whose validator was removed, or of an
+// unbonding delegation entry which already completed, are minted to the
+// delegator account instead. It returns the amount of tokens restored.
+//
+// The tokens burned from each delegation to the slashed validator are derived
+// from the tokens burned from the validator and truncated, the remainder, less
+// than one token per delegation, is not restored and stays burned.
+func (k Keeper) ReverseSlash(ctx sdk.Context, id uint64) (math.Int, error) {
+	record, err := k.GetSlashRecord(ctx, id)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if record.Reversed {
+		return math.Int{}, errorsmod.Wrapf(types.ErrSlashAlreadyReversed, "id: %d", id)
+	}
+
+	window := k.SlashReversalWindow(ctx)
+	if window == 0 || ctx.BlockTime().After(record.Time.Add(window)) {
+		return math.Int{}, errorsmod.Wrapf(types.ErrSlashReversalWindowExpired, "id: %d, slashed at %s", id, record.Time)
+	}
+
+	// the tokens burned from all the delegations are derived before any of them
+	// is restored, as restoring a delegation modifies the validator
+	delegations, err := k.getSlashRecordDelegations(ctx, record)
+	if err != nil {
+		return math.Int{}, err
+	}
+	delegations = append(delegations, k.GetSlashedDelegations(ctx, id)...)
+
+	restored := math.ZeroInt()
+	for _, delegation := range delegations {
+		if err := k.restoreSlashedDelegation(ctx, delegation); err != nil {
+			return math.Int{}, err
+		}
+		restored = restored.Add(delegation.Amount)
+	}
+
+	for _, entry := range k.GetSlashedUnbondingEntries(ctx, id) {
+		if err := k.restoreSlashedUnbondingEntry(ctx, entry); err != nil {
+			return math.Int{}, err
+		}
+		restored = restored.Add(entry.Amount)
+	}
+
+	record.Reversed = true
+	record.ReversalHeight = ctx.BlockHeight()
+	k.setSlashRecord(ctx, record)
+
+	return restored, nil
+}
+
+// getSlashRecordDelegations returns the tokens burned by a slash from each
+// delegation to the slashed validator, that is from each delegation modified
+// since the slash and from each current delegation to the validator.
+func (k Keeper) getSlashRecordDelegations(ctx sdk.Context, record types.SlashRecord) ([]types.SlashedDelegation, error) {
+	if !record.ValidatorBurned.IsPositive() {
+		return nil, nil
+	}
+
+	var delegations []types.SlashedDelegation
+	modified := make(map[string]bool)
+	for _, slashed := range k.GetSlashedDelegationShares(ctx, record.Id) {
+		modified[slashed.DelegatorAddress] = true
+		if amount := slashedDelegationAmount(record, slashed.Shares); amount.IsPositive() {
+			delegations = append(delegations, types.NewSlashedDelegation(record.Id, slashed.DelegatorAddress, record.ValidatorAddress, amount))
+		}
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delegation := range k.GetValidatorDelegations(ctx, valAddr) {
+		if modified[delegation.DelegatorAddress] {
+			continue
+		}
+		if amount := slashedDelegationAmount(record, delegation.Shares); amount.IsPositive() {
+			delegations = append(delegations, types.NewSlashedDelegation(record.Id, delegation.DelegatorAddress, record.ValidatorAddress, amount))
+		}
+	}
+
+	return delegations, nil
+}
+
+// restoreSlashedDelegation mints the tokens burned from a delegation and
+// delegates them back to its validator.
+func (k Keeper) restoreSlashedDelegation(ctx sdk.Context, slashed types.SlashedDelegation) error {
+	delAddr, err := k.authKeeper.StringToBytes(slashed.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(slashed.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), slashed.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	// the validator may have been removed, or may have lost all its tokens,
+	// since the slash
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found || validator.InvalidExRate() {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, coins)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.NotBondedPoolName, coins); err != nil {
+		return err
+	}
+
+	_, err = k.delegate(ctx, delAddr, slashed.Amount, types.Unbonded, validator, false)
+	return err
+}
+
+// restoreSlashedUnbondingEntry mints the tokens burned from an unbonding
+// delegation entry back into the entry.
+func (k Keeper) restoreSlashedUnbondingEntry(ctx sdk.Context, slashed types.SlashedUnbondingEntry) error {
+	delAddr, err := k.authKeeper.StringToBytes(slashed.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(slashed.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), slashed.Amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if found {
+		for i, entry := range ubd.Entries {
+			if entry.CreationHeight != slashed.CreationHeight || entry.UnbondingId != slashed.UnbondingId {
+				continue
+			}
+
+			entry.Balance = entry.Balance.Add(slashed.Amount)
+			ubd.Entries[i] = entry
+			k.SetUnbondingDelegation(ctx, ubd)
+
+			return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.NotBondedPoolName, coins)
+		}
+	}
+
+	// the unbonding delegation entry completed since the slash
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, coins)
+}
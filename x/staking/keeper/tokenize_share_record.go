@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last created tokenize
+// share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last created tokenize share
+// record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by its id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "id: %d", id)
+	}
+
+	var record types.TokenizeShareRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, nil
+}
+
+// GetTokenizeShareRecordByDenom returns a tokenize share record by the denom
+// of its share tokens.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom: %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by
+// an address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIDsByOwnerPrefix(owner))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetTokenizeShareRecordByIndexKey(record.Id)) {
+		return errorsmod.Wrapf(types.ErrTokenizeShareRecordAlreadyExists, "id: %d", record.Id)
+	}
+
+	k.setTokenizeShareRecord(ctx, record)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.GetOwner(), record.Id), sdk.Uint64ToBigEndian(record.Id))
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.GetOwner(), id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+// updateTokenizeShareRecordOwner changes the owner of a tokenize share record
+// and its owner index.
+func (k Keeper) updateTokenizeShareRecordOwner(ctx sdk.Context, record types.TokenizeShareRecord, newOwner sdk.AccAddress) types.TokenizeShareRecord {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.GetOwner(), record.Id))
+
+	record.Owner = newOwner.String()
+	k.setTokenizeShareRecord(ctx, record)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(newOwner, record.Id), sdk.Uint64ToBigEndian(record.Id))
+
+	return record
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), bz)
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TransferDelegation transfers up to the given amount of tokens delegated by
+// fromAddr to a validator to toAddr, without unbonding them. The receiving
+// redelegation entries covering the transferred shares are transferred along,
+// so that they remain slashable for infractions committed on their source
+// validator. The shares covered by receiving redelegation entries put on hold
+// are not transferred, as these entries cannot move. It returns the amount of
+// tokens transferred.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) (math.Int, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt(), types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return math.ZeroInt(), types.ErrNoDelegation
+	}
+
+	available := delegation.Shares.Sub(k.onHoldReceivingShares(ctx, fromAddr, valAddr))
+	if !available.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	amount := math.MinInt(wantAmt, validator.TokensFromShares(available).TruncateInt())
+	if !amount.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, fromAddr, valAddr, amount)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	k.transferReceivingRedelegations(ctx, fromAddr, toAddr, valAddr, shares)
+
+	// remove the shares from the delegation of the sender
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+
+	// as in Unbond, the validator is jailed if the transfer decreases the
+	// self-delegation of its operator below the minimum
+	if bytes.Equal(fromAddr, valAddr) && !validator.Jailed &&
+		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	if delegation.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+		err = k.Hooks().AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	// add the shares to the delegation of the recipient, the tokens and
+	// shares of the validator are unchanged
+	recipient, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		recipient = types.NewDelegation(toAddr, valAddr, math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	recipient.Shares = recipient.Shares.Add(shares)
+	k.SetDelegation(ctx, recipient)
+
+	if err := k.Hooks().AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return amount, nil
+}
+
+// onHoldReceivingShares returns the shares covered by the entries of the
+// redelegations of delAddr to a validator which are put on hold.
+func (k Keeper) onHoldReceivingShares(ctx sdk.Context, delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) math.LegacyDec {
+	shares := math.LegacyZeroDec()
+	k.IterateDelegatorRedelegations(ctx, delAddr, func(red types.Redelegation) bool {
+		if red.ValidatorDstAddress != valDstAddr.String() {
+			return false
+		}
+
+		for _, entry := range red.Entries {
+			if entry.OnHold() {
+				shares = shares.Add(entry.SharesDst)
+			}
+		}
+		return false
+	})
+
+	return shares
+}
+
+// transferReceivingRedelegations transfers the entries of the redelegations of
+// fromAddr to a validator which cover the given shares to toAddr. An entry
+// covering the shares only partially is split. The entries put on hold are
+// left to fromAddr.
+func (k Keeper) transferReceivingRedelegations(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valDstAddr sdk.ValAddress, shares math.LegacyDec,
+) {
+	var reds []types.Redelegation
+	k.IterateDelegatorRedelegations(ctx, fromAddr, func(red types.Redelegation) bool {
+		if red.ValidatorDstAddress == valDstAddr.String() {
+			reds = append(reds, red)
+		}
+		return false
+	})
+
+	for _, red := range reds {
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		for i := 0; i < len(red.Entries) && shares.IsPositive(); i++ {
+			entry := red.Entries[i]
+			if entry.OnHold() {
+				continue
+			}
+
+			sharesDst, balance := entry.SharesDst, entry.InitialBalance
+			if sharesDst.GT(shares) {
+				sharesDst = shares
+				balance = math.LegacyNewDecFromInt(entry.InitialBalance).Mul(shares).Quo(entry.SharesDst).TruncateInt()
+			}
+			shares = shares.Sub(sharesDst)
+
+			recipient := k.SetRedelegationEntry(ctx, toAddr, valSrcAddr, valDstAddr, entry.CreationHeight, entry.CompletionTime, balance, sharesDst, sharesDst)
+			k.InsertRedelegationQueue(ctx, recipient, entry.CompletionTime)
+
+			if sharesDst.Equal(entry.SharesDst) {
+				red.RemoveEntry(int64(i))
+				i--
+				k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+			} else {
+				entry.SharesDst = entry.SharesDst.Sub(sharesDst)
+				entry.InitialBalance = entry.InitialBalance.Sub(balance)
+				red.Entries[i] = entry
+			}
+		}
+
+		if len(red.Entries) == 0 {
+			k.RemoveRedelegation(ctx, red)
+		} else {
+			k.SetRedelegation(ctx, red)
+		}
+
+		if !shares.IsPositive() {
+			return
+		}
+	}
+}
+
+// TransferUnbonding transfers up to the given amount of tokens unbonding from
+// a validator for fromAddr to toAddr, who receives them when the unbonding
+// completes. The entries put on hold are not transferred. It returns the
+// amount of tokens transferred.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) math.Int {
+	ubd, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return math.ZeroInt()
+	}
+
+	transferred := math.ZeroInt()
+	for i := 0; i < len(ubd.Entries) && transferred.LT(wantAmt); i++ {
+		entry := ubd.Entries[i]
+		if entry.OnHold() {
+			continue
+		}
+
+		amount := math.MinInt(entry.Balance, wantAmt.Sub(transferred))
+		if !amount.IsPositive() {
+			continue
+		}
+		transferred = transferred.Add(amount)
+
+		recipient := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, amount)
+		k.InsertUBDQueue(ctx, recipient, entry.CompletionTime)
+
+		if amount.Equal(entry.Balance) {
+			ubd.RemoveEntry(int64(i))
+			i--
+			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+		} else {
+			entry.Balance = entry.Balance.Sub(amount)
+			entry.InitialBalance = math.MaxInt(entry.InitialBalance.Sub(amount), entry.Balance)
+			ubd.Entries[i] = entry
+		}
+	}
+
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return transferred
+}
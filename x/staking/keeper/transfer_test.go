@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestTransferDelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(3)
+	for _, addr := range delAddrs {
+		s.accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+		s.accountKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+	}
+	from, to := delAddrs[0], delAddrs[1]
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(from, valAddrs[0], issuedShares))
+
+	// half of the delegation was received through a redelegation
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	redTokens := keeper.TokensFromConsensusPower(ctx, 5)
+	keeper.SetRedelegationEntry(ctx, from, valAddrs[1], valAddrs[0], 1, completionTime, redTokens, math.LegacyNewDecFromInt(redTokens), math.LegacyNewDecFromInt(redTokens))
+
+	// the validator must exist
+	_, err := keeper.TransferDelegation(ctx, from, to, valAddrs[2], startTokens)
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	transferAmt := keeper.TokensFromConsensusPower(ctx, 2)
+	transferred, err := keeper.TransferDelegation(ctx, from, to, valAddrs[0], transferAmt)
+	require.NoError(err)
+	require.Equal(transferAmt, transferred)
+
+	delegation, found := keeper.GetDelegation(ctx, from, valAddrs[0])
+	require.True(found)
+	require.Equal(startTokens.Sub(transferAmt), delegation.Shares.TruncateInt())
+	delegation, found = keeper.GetDelegation(ctx, to, valAddrs[0])
+	require.True(found)
+	require.Equal(transferAmt, delegation.Shares.TruncateInt())
+
+	// the tokens of the validator are unchanged
+	resValidator, found := keeper.GetValidator(ctx, valAddrs[0])
+	require.True(found)
+	require.Equal(validator.Tokens, resValidator.Tokens)
+	require.Equal(validator.DelegatorShares, resValidator.DelegatorShares)
+
+	// the redelegation entry covering the transferred shares is split
+	red, found := keeper.GetRedelegation(ctx, from, valAddrs[1], valAddrs[0])
+	require.True(found)
+	require.Len(red.Entries, 1)
+	require.Equal(redTokens.Sub(transferAmt), red.Entries[0].InitialBalance)
+	red, found = keeper.GetRedelegation(ctx, to, valAddrs[1], valAddrs[0])
+	require.True(found)
+	require.Len(red.Entries, 1)
+	require.Equal(transferAmt, red.Entries[0].InitialBalance)
+	require.Equal(completionTime, red.Entries[0].CompletionTime)
+	// the redelegation entry of the recipient matures with the original one
+	require.Equal(to.String(), keeper.GetRedelegationQueueTimeSlice(ctx, completionTime)[0].DelegatorAddress)
+
+	// transferring more than delegated transfers the whole delegation
+	transferred, err = keeper.TransferDelegation(ctx, from, to, valAddrs[0], startTokens)
+	require.NoError(err)
+	require.Equal(startTokens.Sub(transferAmt), transferred)
+
+	_, found = keeper.GetDelegation(ctx, from, valAddrs[0])
+	require.False(found)
+	delegation, found = keeper.GetDelegation(ctx, to, valAddrs[0])
+	require.True(found)
+	require.Equal(startTokens, delegation.Shares.TruncateInt())
+
+	require.False(keeper.HasReceivingRedelegation(ctx, from, valAddrs[0]))
+	red, found = keeper.GetRedelegation(ctx, to, valAddrs[1], valAddrs[0])
+	require.True(found)
+	require.Len(red.Entries, 2)
+
+	_, err = keeper.TransferDelegation(ctx, from, to, valAddrs[0], startTokens)
+	require.ErrorIs(err, stakingtypes.ErrNoDelegation)
+}
+
+func (s *KeeperTestSuite) TestTransferUnbonding() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	for _, addr := range delAddrs {
+		s.accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+		s.accountKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+	}
+	from, to := delAddrs[0], delAddrs[1]
+
+	require.True(keeper.TransferUnbonding(ctx, from, to, valAddrs[0], math.NewInt(10)).IsZero())
+
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	keeper.SetUnbondingDelegationEntry(ctx, from, valAddrs[0], 1, completionTime, math.NewInt(5))
+	keeper.SetUnbondingDelegationEntry(ctx, from, valAddrs[0], 2, completionTime.Add(time.Hour), math.NewInt(5))
+
+	// the first entry is transferred and the second one is split
+	transferred := keeper.TransferUnbonding(ctx, from, to, valAddrs[0], math.NewInt(7))
+	require.Equal(math.NewInt(7), transferred)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, from, valAddrs[0])
+	require.True(found)
+	require.Len(ubd.Entries, 1)
+	require.Equal(math.NewInt(3), ubd.Entries[0].Balance)
+	require.Equal(int64(2), ubd.Entries[0].CreationHeight)
+
+	ubd, found = keeper.GetUnbondingDelegation(ctx, to, valAddrs[0])
+	require.True(found)
+	require.Len(ubd.Entries, 2)
+	require.Equal(math.NewInt(5), ubd.Entries[0].Balance)
+	require.Equal(completionTime, ubd.Entries[0].CompletionTime)
+	require.Equal(math.NewInt(2), ubd.Entries[1].Balance)
+	require.Equal(to.String(), keeper.GetUBDQueueTimeSlice(ctx, completionTime)[0].DelegatorAddress)
+
+	transferred = keeper.TransferUnbonding(ctx, from, to, valAddrs[0], math.NewInt(10))
+	require.Equal(math.NewInt(3), transferred)
+
+	_, found = keeper.GetUnbondingDelegation(ctx, from, valAddrs[0])
+	require.False(found)
+	ubd, found = keeper.GetUnbondingDelegation(ctx, to, valAddrs[0])
+	require.True(found)
+	require.Len(ubd.Entries, 2)
+	require.Equal(math.NewInt(5), ubd.Entries[1].Balance)
+}
+
+func (s *KeeperTestSuite) TestTransferOnHold() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	for _, addr := range delAddrs {
+		s.accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+		s.accountKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+	}
+	from, to := delAddrs[0], delAddrs[1]
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(from, valAddrs[0], issuedShares))
+
+	// the entries put on hold are not transferred
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	redTokens := keeper.TokensFromConsensusPower(ctx, 4)
+	red := keeper.SetRedelegationEntry(ctx, from, valAddrs[1], valAddrs[0], 1, completionTime, redTokens, math.LegacyNewDecFromInt(redTokens), math.LegacyNewDecFromInt(redTokens))
+	red.Entries[0].UnbondingOnHoldRefCount = 1
+	keeper.SetRedelegation(ctx, red)
+
+	transferred, err := keeper.TransferDelegation(ctx, from, to, valAddrs[0], startTokens)
+	require.NoError(err)
+	require.Equal(startTokens.Sub(redTokens), transferred)
+
+	delegation, found := keeper.GetDelegation(ctx, from, valAddrs[0])
+	require.True(found)
+	require.Equal(redTokens, delegation.Shares.TruncateInt())
+	red, found = keeper.GetRedelegation(ctx, from, valAddrs[1], valAddrs[0])
+	require.True(found)
+	require.Len(red.Entries, 1)
+	require.False(keeper.HasReceivingRedelegation(ctx, to, valAddrs[0]))
+
+	transferred, err = keeper.TransferDelegation(ctx, from, to, valAddrs[0], startTokens)
+	require.NoError(err)
+	require.True(transferred.IsZero())
+
+	keeper.SetUnbondingDelegationEntry(ctx, from, valAddrs[0], 1, completionTime, math.NewInt(5))
+	ubd := keeper.SetUnbondingDelegationEntry(ctx, from, valAddrs[0], 2, completionTime, math.NewInt(5))
+	ubd.Entries[0].UnbondingOnHoldRefCount = 1
+	keeper.SetUnbondingDelegation(ctx, ubd)
+
+	require.Equal(math.NewInt(5), keeper.TransferUnbonding(ctx, from, to, valAddrs[0], math.NewInt(10)))
+
+	ubd, found = keeper.GetUnbondingDelegation(ctx, from, valAddrs[0])
+	require.True(found)
+	require.Len(ubd.Entries, 1)
+	require.True(ubd.Entries[0].OnHold())
+}
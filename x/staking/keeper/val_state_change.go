@@ -10,6 +10,8 @@ import (
 
 	"cosmossdk.io/math"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -34,8 +36,13 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
-	// Remove all mature unbonding delegations from the ubd queue.
-	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
+	// Remove the mature unbonding delegations from the ubd queue, up to the max
+	// number of completions per block. The others are completed in the next
+	// blocks.
+	maxCompletions := k.MaxQueueCompletionsPerBlock(ctx)
+	matureUnbonds, pendingUnbonds := k.DequeueMatureUBDQueue(ctx, ctx.BlockHeader().Time, maxCompletions)
+	telemetry.ModuleSetGauge(types.ModuleName, float32(len(matureUnbonds)), "unbonding_queue", "completed")
+	telemetry.ModuleSetGauge(types.ModuleName, float32(pendingUnbonds), "unbonding_queue", "pending")
 	for _, dvPair := range matureUnbonds {
 		addr, err := sdk.ValAddressFromBech32(dvPair.ValidatorAddress)
 		if err != nil {
@@ -61,8 +68,11 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 		)
 	}
 
-	// Remove all mature redelegations from the red queue.
-	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
+	// Remove the mature redelegations from the red queue, up to the max number
+	// of completions per block.
+	matureRedelegations, pendingRedelegations := k.DequeueMatureRedelegationQueue(ctx, ctx.BlockHeader().Time, maxCompletions)
+	telemetry.ModuleSetGauge(types.ModuleName, float32(len(matureRedelegations)), "redelegation_queue", "completed")
+	telemetry.ModuleSetGauge(types.ModuleName, float32(pendingRedelegations), "redelegation_queue", "pending")
 	for _, dvvTriplet := range matureRedelegations {
 		valSrcAddr, err := sdk.ValAddressFromBech32(dvvTriplet.ValidatorSrcAddress)
 		if err != nil {
@@ -128,6 +138,16 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the consensus pubkey rotations of the validators that were
+	// part of the last validator set, for which the old key must be removed.
+	var rotatedBondedVals []types.ConsPubKeyRotationHistory
+	for _, history := range k.getBlockConsPubKeyRotationHistory(ctx, ctx.BlockHeight()) {
+		if _, found := last[history.OperatorAddress]; found {
+			rotatedBondedVals = append(rotatedBondedVals, history)
+		}
+	}
+	k.deleteBlockConsPubKeyRotationHistory(ctx, ctx.BlockHeight())
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -206,6 +226,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
+	updates, err = k.applyConsPubKeyRotations(ctx, updates, rotatedBondedVals)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
@@ -232,6 +257,52 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	return updates, err
 }
 
+// applyConsPubKeyRotations replaces the updates of the rotated validators,
+// which were part of the last validator set, by the removal of their old
+// consensus public key and, if they are still bonded, the addition of their
+// new consensus public key.
+func (k Keeper) applyConsPubKeyRotations(
+	ctx sdk.Context, updates []abci.ValidatorUpdate, rotations []types.ConsPubKeyRotationHistory,
+) ([]abci.ValidatorUpdate, error) {
+	for _, history := range rotations {
+		oldPk, err := history.OldConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		oldCmtPk, err := cryptocodec.ToCmtProtoPublicKey(oldPk)
+		if err != nil {
+			return nil, err
+		}
+
+		newPk, err := history.NewConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		newCmtPk, err := cryptocodec.ToCmtProtoPublicKey(newPk)
+		if err != nil {
+			return nil, err
+		}
+
+		filtered := updates[:0]
+		for _, update := range updates {
+			if !update.PubKey.Equal(newCmtPk) {
+				filtered = append(filtered, update)
+			}
+		}
+		updates = append(filtered, abci.ValidatorUpdate{PubKey: oldCmtPk, Power: 0})
+
+		validator := k.mustGetValidator(ctx, history.GetOperator())
+		if validator.IsBonded() {
+			updates = append(updates, abci.ValidatorUpdate{
+				PubKey: newCmtPk,
+				Power:  validator.ConsensusPower(k.PowerReduction(ctx)),
+			})
+		}
+	}
+
+	return updates, nil
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) (types.Validator, error) {
@@ -6,6 +6,7 @@ import (
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
@@ -41,7 +42,14 @@ func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress
 
 	opAddr := store.Get(types.GetValidatorByConsAddrKey(consAddr))
 	if opAddr == nil {
-		return validator, false
+		// the consensus address may have been rotated, in which case the
+		// validator is found by the consensus address which replaced it
+		newConsAddr, found := k.GetNewConsAddr(ctx, consAddr)
+		if !found {
+			return validator, false
+		}
+
+		return k.GetValidatorByConsAddr(ctx, newConsAddr)
 	}
 
 	return k.GetValidator(ctx, opAddr)
@@ -146,7 +154,7 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	}
 
 	if newRate.LT(k.MinCommissionRate(ctx)) {
-		return commission, fmt.Errorf("cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
+		return commission, errorsmod.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
 	}
 
 	commission.Rate = newRate
@@ -155,6 +163,60 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	return commission, nil
 }
 
+// applyMinCommissionRate raises the commission rate of the validators below
+// the min commission rate to it, and their max rate if needed.
+func (k Keeper) applyMinCommissionRate(ctx sdk.Context, minRate math.LegacyDec) error {
+	for _, validator := range k.GetAllValidators(ctx) {
+		if !validator.Commission.Rate.LT(minRate) {
+			continue
+		}
+
+		if err := k.Hooks().BeforeValidatorModified(ctx, validator.GetOperator()); err != nil {
+			return err
+		}
+
+		validator.Commission.Rate = minRate
+		validator.Commission.MaxRate = math.LegacyMaxDec(validator.Commission.MaxRate, minRate)
+		validator.Commission.UpdateTime = ctx.BlockTime()
+		k.SetValidator(ctx, validator)
+	}
+
+	return nil
+}
+
+// checkMaxValidatorPowerRatio returns an error if bonding the given amount of
+// tokens, coming from tokenSrc, to a validator makes its share of the bonded
+// tokens exceed the max validator power ratio. Tokens coming from a bonded
+// source are already counted in the bonded tokens. The tokens of the validator
+// are counted as bonded even if it is not, as they are once it enters the
+// validator set. The ratio is not enforced while no tokens are bonded, e.g. at
+// genesis.
+func (k Keeper) checkMaxValidatorPowerRatio(ctx sdk.Context, validator types.Validator, amount math.Int, tokenSrc types.BondStatus) error {
+	maxRatio := k.MaxValidatorPowerRatio(ctx)
+	if maxRatio.GTE(math.LegacyOneDec()) {
+		return nil
+	}
+
+	totalBonded := k.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return nil
+	}
+
+	tokens := validator.Tokens.Add(amount)
+	if tokenSrc != types.Bonded {
+		totalBonded = totalBonded.Add(amount)
+	}
+	if !validator.IsBonded() {
+		totalBonded = totalBonded.Add(validator.Tokens)
+	}
+
+	if ratio := math.LegacyNewDecFromInt(tokens).QuoInt(totalBonded); ratio.GT(maxRatio) {
+		return errorsmod.Wrapf(types.ErrValidatorPowerRatioExceeded, "validator power ratio %s is greater than %s", ratio, maxRatio)
+	}
+
+	return nil
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 // TODO, this function panics, and it's not good.
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -417,3 +418,37 @@ func (s *KeeperTestSuite) TestUnbondingValidator() {
 	require.True(found)
 	require.Equal(stakingtypes.Unbonded, validator.Status)
 }
+
+func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesConsPubKeyRotation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 1)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.NotBondedPoolName, gomock.Any())
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any())
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.NoError(keeper.RotateConsPubKey(ctx, validator, PKs[1]))
+
+	// the old key is removed from the validator set and the new key is added
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 2)
+
+	oldPk, err := cryptocodec.ToCmtProtoPublicKey(PKs[0])
+	require.NoError(err)
+	newPk, err := cryptocodec.ToCmtProtoPublicKey(PKs[1])
+	require.NoError(err)
+	require.Equal(abci.ValidatorUpdate{PubKey: oldPk, Power: 0}, updates[0])
+	require.Equal(abci.ValidatorUpdate{PubKey: newPk, Power: 10}, updates[1])
+
+	// the rotation is applied only once
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+}
@@ -38,17 +38,34 @@ func TestMigrateJSON(t *testing.T) {
 	expected := `{
 	"delegations": [],
 	"exported": false,
+	"last_slash_record_id": "0",
+	"last_tokenize_share_record_id": "0",
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
 		"bond_denom": "stake",
+		"global_liquid_staking_cap": "1.000000000000000000",
 		"historical_entries": 10000,
+		"key_rotation_fee": {
+			"amount": "1000000",
+			"denom": "stake"
+		},
 		"max_entries": 7,
+		"max_queue_completions_per_block": 0,
+		"max_validator_power_ratio": "1.000000000000000000",
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
-		"unbonding_time": "1814400s"
+		"slash_reversal_window": "1209600s",
+		"unbonding_time": "1814400s",
+		"validator_liquid_staking_cap": "1.000000000000000000"
 	},
 	"redelegations": [],
+	"rotation_history": [],
+	"slash_records": [],
+	"slashed_delegation_shares": [],
+	"slashed_delegations": [],
+	"slashed_unbonding_entries": [],
+	"tokenize_share_records": [],
 	"unbonding_delegations": [],
 	"validators": []
 }`
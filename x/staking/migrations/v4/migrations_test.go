@@ -1,6 +1,7 @@
 package v4_test
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -27,8 +28,13 @@ func newMockSubspace(ps types.Params) mockSubspace {
 	return mockSubspace{ps: ps}
 }
 
+// GetParamSet sets the params of the legacy subspace, like the x/params
+// subspace which only holds the params existing before the migration.
 func (ms mockSubspace) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
-	*ps.(*types.Params) = ms.ps
+	pairs := ms.ps.ParamSetPairs()
+	for i, pair := range ps.ParamSetPairs() {
+		reflect.ValueOf(pair.Value).Elem().Set(reflect.ValueOf(pairs[i].Value).Elem())
+	}
 }
 
 func TestMigrate(t *testing.T) {
@@ -50,7 +56,7 @@ func TestMigrate(t *testing.T) {
 	err := createOldStateUnbonding(t, duplicateCreationHeight, valAddr, accAddr, cdc, store)
 	require.NoError(t, err)
 
-	legacySubspace := newMockSubspace(types.DefaultParams())
+	legacySubspace := newMockSubspace(legacyParams())
 
 	testCases := []struct {
 		name        string
@@ -77,7 +83,10 @@ func TestMigrate(t *testing.T) {
 				var res types.Params
 				bz := store.Get(v4.ParamsKey)
 				require.NoError(t, cdc.Unmarshal(bz, &res))
-				require.Equal(t, legacySubspace.ps, res)
+				expParams := types.DefaultParams()
+				expParams.BondDenom = legacySubspace.ps.BondDenom
+				expParams.KeyRotationFee = sdk.NewCoin(expParams.BondDenom, types.DefaultKeyRotationFee.Amount)
+				require.Equal(t, expParams, res)
 
 				// checking the updated balance for duplicateCreationHeight
 				for _, ubdEntry := range ubd.Entries {
@@ -95,6 +104,33 @@ func TestMigrate(t *testing.T) {
 	}
 }
 
+func TestMigrateInvalidParams(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+
+	storeKey := storetypes.NewKVStoreKey(v4.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	params := legacyParams()
+	params.BondDenom = ""
+	require.Error(t, v4.MigrateStore(ctx, storeKey, cdc, newMockSubspace(params)))
+	require.Nil(t, ctx.KVStore(storeKey).Get(v4.ParamsKey))
+}
+
+// legacyParams returns the params held by the legacy subspace, which only
+// holds the params existing before the migration.
+func legacyParams() types.Params {
+	params := types.DefaultParams()
+	return types.Params{
+		UnbondingTime:     params.UnbondingTime,
+		MaxValidators:     params.MaxValidators,
+		MaxEntries:        params.MaxEntries,
+		HistoricalEntries: params.HistoricalEntries,
+		BondDenom:         "uatom",
+		MinCommissionRate: params.MinCommissionRate,
+	}
+}
+
 // createOldStateUnbonding will create the ubd entries with duplicate heights
 // 10 duplicate heights and 10 unique ubd with creation height
 func createOldStateUnbonding(t *testing.T, creationHeight int64, valAddr sdk.ValAddress, accAddr sdk.AccAddress, cdc codec.BinaryCodec, store storetypes.KVStore) error {
@@ -30,8 +30,11 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 
 // migrateParams will set the params to store from legacySubspace
 func migrateParams(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, legacySubspace exported.Subspace) error {
-	var legacyParams types.Params
+	// the legacy subspace only holds the params existing before the migration,
+	// the params added since are set to their default values
+	legacyParams := types.DefaultParams()
 	legacySubspace.GetParamSet(ctx, &legacyParams)
+	legacyParams.KeyRotationFee = sdk.Coin{Denom: legacyParams.BondDenom, Amount: types.DefaultKeyRotationFee.Amount}
 
 	if err := legacyParams.Validate(); err != nil {
 		return err
@@ -0,0 +1,14 @@
+package v6
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "staking"
+)
+
+var (
+	// ValidatorsKey is the prefix for each key to a validator
+	ValidatorsKey = []byte{0x21}
+
+	// ParamsKey is the prefix for parameters for module x/staking
+	ParamsKey = []byte{0x51}
+)
@@ -0,0 +1,154 @@
+package v6_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateKeyRotationFee(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	params.BondDenom = "uatom"
+	params.KeyRotationFee = sdk.Coin{}
+	store.Set(v6.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v6.ParamsKey), &res))
+	require.Equal(t, sdk.NewCoin("uatom", types.DefaultKeyRotationFee.Amount), res.KeyRotationFee)
+
+	// an already set fee is kept
+	res.KeyRotationFee = sdk.NewInt64Coin("uatom", 10)
+	store.Set(v6.ParamsKey, cdc.MustMarshal(&res))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+	require.NoError(t, cdc.Unmarshal(store.Get(v6.ParamsKey), &res))
+	require.Equal(t, sdk.NewInt64Coin("uatom", 10), res.KeyRotationFee)
+}
+
+func TestMigrateLiquidStakingCaps(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// the params stored before the introduction of the caps
+	params := types.DefaultParams()
+	store.Set(v6.ParamsKey, stripFields(t, cdc.MustMarshal(&params), 8, 9))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v6.ParamsKey), &res))
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, res.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, res.ValidatorLiquidStakingCap)
+
+	// already set caps are kept
+	res.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	res.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	store.Set(v6.ParamsKey, cdc.MustMarshal(&res))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+	require.NoError(t, cdc.Unmarshal(store.Get(v6.ParamsKey), &res))
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 2), res.GlobalLiquidStakingCap)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), res.ValidatorLiquidStakingCap)
+}
+
+func TestMigrateValidatorsMinCommissionRate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	minRate := math.LegacyNewDecWithPrec(5, 2)
+	params := types.DefaultParams()
+	params.MinCommissionRate = minRate
+	store.Set(v6.ParamsKey, stripFields(t, cdc.MustMarshal(&params), 10))
+
+	rates := []math.LegacyDec{math.LegacyZeroDec(), math.LegacyNewDecWithPrec(1, 1)}
+	valAddrs := make([]sdk.ValAddress, len(rates))
+	for i, rate := range rates {
+		valAddrs[i] = sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+		validator, err := types.NewValidator(valAddrs[i], ed25519.GenPrivKey().PubKey(), types.Description{})
+		require.NoError(t, err)
+		validator.Commission = types.NewCommission(rate, rate, rate)
+		store.Set(types.GetValidatorKey(valAddrs[i]), types.MustMarshalValidator(cdc, &validator))
+	}
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v6.ParamsKey), &res))
+	require.Equal(t, types.DefaultMaxValidatorPowerRatio, res.MaxValidatorPowerRatio)
+
+	// the validator below the min commission rate is raised to it
+	validator := types.MustUnmarshalValidator(cdc, store.Get(types.GetValidatorKey(valAddrs[0])))
+	require.Equal(t, minRate, validator.Commission.Rate)
+	require.Equal(t, minRate, validator.Commission.MaxRate)
+
+	validator = types.MustUnmarshalValidator(cdc, store.Get(types.GetValidatorKey(valAddrs[1])))
+	require.Equal(t, rates[1], validator.Commission.Rate)
+}
+
+func TestMigrateSlashReversalWindow(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// the params stored before the introduction of the window
+	params := types.DefaultParams()
+	store.Set(v6.ParamsKey, stripFields(t, cdc.MustMarshal(&params), 12))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v6.ParamsKey), &res))
+	require.Equal(t, types.DefaultSlashReversalWindow, res.SlashReversalWindow)
+}
+
+// stripFields removes the given fields from an encoded protobuf message.
+func stripFields(t *testing.T, bz []byte, fields ...protowire.Number) []byte {
+	t.Helper()
+
+	var res []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+
+		strip := false
+		for _, field := range fields {
+			strip = strip || field == num
+		}
+		if !strip {
+			res = append(res, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+
+	return res
+}
@@ -0,0 +1,77 @@
+package v6
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6.
+// The migration sets the key rotation fee parameter to its default amount,
+// denominated in the bond denom of the chain, the liquid staking caps and the
+// max validator power ratio to their default values, and raises the commission
+// rate of the validators below the min commission rate to it. The slash
+// reversal window, which did not exist before, is set to its default value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.KeyRotationFee.Validate() != nil {
+		params.KeyRotationFee = sdk.NewCoin(params.BondDenom, types.DefaultKeyRotationFee.Amount)
+	}
+
+	if params.GlobalLiquidStakingCap.IsNil() {
+		params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	}
+
+	if params.ValidatorLiquidStakingCap.IsNil() {
+		params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	}
+
+	if params.MaxValidatorPowerRatio.IsNil() {
+		params.MaxValidatorPowerRatio = types.DefaultMaxValidatorPowerRatio
+	}
+
+	if params.SlashReversalWindow == 0 {
+		params.SlashReversalWindow = types.DefaultSlashReversalWindow
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+
+	migrateValidatorsMinCommissionRate(ctx, store, cdc, params.MinCommissionRate)
+
+	return nil
+}
+
+// migrateValidatorsMinCommissionRate sets the commission rate of the
+// validators created before the min commission rate was enforced to it.
+func migrateValidatorsMinCommissionRate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, minRate math.LegacyDec) {
+	iterator := storetypes.KVStorePrefixIterator(store, ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+		if !validator.Commission.Rate.LT(minRate) {
+			continue
+		}
+
+		validator.Commission.Rate = minRate
+		validator.Commission.MaxRate = math.LegacyMaxDec(validator.Commission.MaxRate, minRate)
+		validator.Commission.UpdateTime = ctx.BlockTime()
+		store.Set(iterator.Key(), types.MustMarshalValidator(cdc, &validator))
+	}
+}
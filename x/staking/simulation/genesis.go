@@ -64,7 +64,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate, sdk.NewCoin(simState.BondDenom, types.DefaultKeyRotationFee.Amount), types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap, types.DefaultMaxValidatorPowerRatio, types.DefaultMaxQueueCompletionsPerBlock, types.DefaultSlashReversalWindow)
 
 	// validators & delegations
 	var (
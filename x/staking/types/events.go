@@ -2,14 +2,19 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding         = "complete_unbonding"
-	EventTypeCompleteRedelegation      = "complete_redelegation"
-	EventTypeCreateValidator           = "create_validator"
-	EventTypeEditValidator             = "edit_validator"
-	EventTypeDelegate                  = "delegate"
-	EventTypeUnbond                    = "unbond"
-	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
-	EventTypeRedelegate                = "redelegate"
+	EventTypeCompleteUnbonding           = "complete_unbonding"
+	EventTypeCompleteRedelegation        = "complete_redelegation"
+	EventTypeCreateValidator             = "create_validator"
+	EventTypeEditValidator               = "edit_validator"
+	EventTypeDelegate                    = "delegate"
+	EventTypeUnbond                      = "unbond"
+	EventTypeCancelUnbondingDelegation   = "cancel_unbonding_delegation"
+	EventTypeRedelegate                  = "redelegate"
+	EventTypeRotateConsPubKey            = "rotate_cons_pubkey"
+	EventTypeTokenizeShares              = "tokenize_shares"
+	EventTypeRedeemShares                = "redeem_shares"
+	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
+	EventTypeReverseSlash                = "reverse_slash"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,4 +25,10 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyOldConsAddress    = "old_consensus_address"
+	AttributeKeyNewConsAddress    = "new_consensus_address"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyTokenizedShares   = "tokenized_shares"
+	AttributeKeySlashRecordID     = "slash_record_id"
 )
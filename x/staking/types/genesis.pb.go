@@ -45,6 +45,43 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// rotation_history defines the consensus public key rotations of the
+	// validators.
+	//
+	// Since: cosmos-sdk 0.48
+	RotationHistory []ConsPubKeyRotationHistory `protobuf:"bytes,9,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
+	// tokenize_share_records defines the tokenized delegations.
+	//
+	// Since: cosmos-sdk 0.48
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,10,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize
+	// share record.
+	//
+	// Since: cosmos-sdk 0.48
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,11,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// slash_records defines the recorded slashes.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashRecords []SlashRecord `protobuf:"bytes,12,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	// last_slash_record_id is the id of the last created slash record.
+	//
+	// Since: cosmos-sdk 0.48
+	LastSlashRecordId uint64 `protobuf:"varint,13,opt,name=last_slash_record_id,json=lastSlashRecordId,proto3" json:"last_slash_record_id,omitempty"`
+	// slashed_delegations defines the tokens burned from the delegations of the
+	// redelegations slashed by the recorded slashes.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashedDelegations []SlashedDelegation `protobuf:"bytes,14,rep,name=slashed_delegations,json=slashedDelegations,proto3" json:"slashed_delegations"`
+	// slashed_unbonding_entries defines the tokens burned from each unbonding
+	// delegation entry by the recorded slashes.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashedUnbondingEntries []SlashedUnbondingEntry `protobuf:"bytes,15,rep,name=slashed_unbonding_entries,json=slashedUnbondingEntries,proto3" json:"slashed_unbonding_entries"`
+	// slashed_delegation_shares defines the shares held at the recorded slashes
+	// by the delegations modified since.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashedDelegationShares []SlashedDelegationShares `protobuf:"bytes,16,rep,name=slashed_delegation_shares,json=slashedDelegationShares,proto3" json:"slashed_delegation_shares"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +166,62 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetRotationHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.RotationHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastSlashRecordId() uint64 {
+	if m != nil {
+		return m.LastSlashRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSlashedDelegations() []SlashedDelegation {
+	if m != nil {
+		return m.SlashedDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashedUnbondingEntries() []SlashedUnbondingEntry {
+	if m != nil {
+		return m.SlashedUnbondingEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashedDelegationShares() []SlashedDelegationShares {
+	if m != nil {
+		return m.SlashedDelegationShares
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +273,53 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcb, 0x4e, 0xdb, 0x4c,
+	0x1c, 0xc5, 0xe3, 0x8f, 0x5b, 0x98, 0x84, 0xdb, 0x10, 0xf8, 0x1c, 0xa4, 0x26, 0x29, 0x45, 0x55,
+	0x4a, 0x4b, 0x2c, 0x60, 0xd7, 0x55, 0x49, 0xaf, 0xa8, 0x48, 0x45, 0x0e, 0x74, 0x51, 0xa9, 0xb2,
+	0x26, 0x78, 0xe4, 0x8c, 0x92, 0x78, 0xa2, 0xf9, 0x4f, 0x28, 0xf4, 0x09, 0xba, 0xaa, 0xfa, 0x08,
+	0x2c, 0xbb, 0xec, 0xa2, 0x0f, 0xc1, 0x12, 0x75, 0x55, 0x75, 0x81, 0x2a, 0x58, 0xb4, 0x8f, 0x51,
+	0x79, 0xc6, 0x09, 0x0e, 0xb1, 0x69, 0x37, 0x49, 0xec, 0xff, 0x39, 0xbf, 0x73, 0x6c, 0x4f, 0xc6,
+	0x68, 0xe5, 0x80, 0x43, 0x9b, 0x83, 0x05, 0x92, 0x34, 0x99, 0xef, 0x59, 0x87, 0xeb, 0x75, 0x2a,
+	0xc9, 0xba, 0xe5, 0x51, 0x9f, 0x02, 0x83, 0x4a, 0x47, 0x70, 0xc9, 0xf1, 0xa2, 0x56, 0x55, 0x42,
+	0x55, 0x25, 0x54, 0x2d, 0xe5, 0x3c, 0xee, 0x71, 0x25, 0xb1, 0x82, 0x5f, 0x5a, 0xbd, 0x94, 0xc4,
+	0xec, 0xb9, 0xb5, 0x2a, 0xaf, 0x55, 0x8e, 0xb6, 0x87, 0x01, 0x7a, 0x34, 0x47, 0xda, 0xcc, 0xe7,
+	0x96, 0xfa, 0xd4, 0xa7, 0x96, 0x3f, 0x66, 0x50, 0xf6, 0xb9, 0xee, 0x54, 0x93, 0x44, 0x52, 0xbc,
+	0x85, 0xc6, 0x3b, 0x44, 0x90, 0x36, 0x98, 0x46, 0xc9, 0x28, 0x67, 0x36, 0x0a, 0x95, 0xf8, 0x8e,
+	0x95, 0x5d, 0xa5, 0xaa, 0x4e, 0x9e, 0x9e, 0x17, 0x53, 0x9f, 0x7f, 0x7d, 0x59, 0x35, 0xec, 0xd0,
+	0x88, 0xdf, 0xa2, 0xd9, 0x16, 0x01, 0xe9, 0x48, 0x2e, 0x49, 0xcb, 0xe9, 0xf0, 0x77, 0x54, 0x98,
+	0xff, 0x95, 0x8c, 0x72, 0xb6, 0xba, 0x19, 0x88, 0x7f, 0x9c, 0x17, 0xef, 0x7a, 0x4c, 0x36, 0xba,
+	0xf5, 0xca, 0x01, 0x6f, 0x87, 0x0d, 0xc3, 0xaf, 0x35, 0x70, 0x9b, 0x96, 0x3c, 0xee, 0x50, 0xa8,
+	0x6c, 0xfb, 0x52, 0x63, 0xa7, 0x03, 0xd8, 0x5e, 0xc0, 0xda, 0x0d, 0x50, 0x98, 0xa1, 0x05, 0x85,
+	0x3f, 0x24, 0x2d, 0xe6, 0x12, 0xc9, 0x85, 0x8e, 0x00, 0x73, 0xa4, 0x34, 0x52, 0xce, 0x6c, 0xac,
+	0x26, 0x15, 0xde, 0x21, 0x20, 0x5f, 0xf7, 0x3c, 0x0a, 0x15, 0x2d, 0x3f, 0xdf, 0x1a, 0x1a, 0x03,
+	0xde, 0x41, 0xa8, 0x9f, 0x02, 0xe6, 0xa8, 0xe2, 0xdf, 0x4e, 0xe2, 0xf7, 0xcd, 0x51, 0x6c, 0xc4,
+	0x8f, 0x5f, 0xa1, 0x8c, 0x4b, 0x5b, 0xd4, 0x23, 0x92, 0x71, 0x1f, 0xcc, 0x31, 0x85, 0x5b, 0x4e,
+	0xc2, 0x3d, 0xe9, 0x4b, 0xa3, 0xbc, 0x28, 0x01, 0x37, 0xd1, 0x42, 0xd7, 0xaf, 0x73, 0xdf, 0x65,
+	0xbe, 0xe7, 0x44, 0xd1, 0xe3, 0x0a, 0x7d, 0x3f, 0x09, 0xbd, 0xdf, 0x33, 0xc5, 0x67, 0xe4, 0xba,
+	0xc3, 0x73, 0xc0, 0xfb, 0x68, 0x4a, 0xd0, 0x68, 0xc8, 0x84, 0x0a, 0x59, 0x49, 0x0a, 0xb1, 0xa9,
+	0x1b, 0x4b, 0x1f, 0xa4, 0xe0, 0x25, 0x94, 0xa6, 0x47, 0x1d, 0x2e, 0x24, 0x75, 0xcd, 0x74, 0xc9,
+	0x28, 0xa7, 0xed, 0xfe, 0x31, 0xf6, 0xd0, 0xac, 0xe0, 0x52, 0x09, 0x9d, 0x06, 0x03, 0xc9, 0xc5,
+	0xb1, 0x39, 0xa9, 0x52, 0xd7, 0x93, 0x52, 0x1f, 0x73, 0x1f, 0x76, 0xbb, 0xf5, 0x97, 0xf4, 0xd8,
+	0x0e, 0x9d, 0x2f, 0xb4, 0x31, 0x5a, 0x61, 0x46, 0x0c, 0xce, 0x70, 0x0b, 0x2d, 0x4a, 0xde, 0xa4,
+	0x3e, 0x7b, 0x4f, 0x1d, 0x68, 0x10, 0x41, 0x1d, 0x41, 0x0f, 0xb8, 0x70, 0xc1, 0x44, 0x37, 0xdf,
+	0xc9, 0xbd, 0xd0, 0x55, 0x0b, 0x4c, 0xb6, 0xf2, 0x0c, 0xdc, 0x49, 0x39, 0x3c, 0x07, 0xfc, 0x08,
+	0xdd, 0x0a, 0xff, 0x1f, 0x31, 0x91, 0x0e, 0x73, 0xcd, 0x4c, 0xc9, 0x28, 0x8f, 0xda, 0x79, 0xbd,
+	0xee, 0x87, 0x00, 0xdb, 0x2e, 0xae, 0xa1, 0x29, 0x68, 0x11, 0x68, 0xf4, 0x6b, 0x66, 0x55, 0xcd,
+	0x3b, 0x49, 0x35, 0x6b, 0x81, 0x78, 0xb8, 0x5e, 0x16, 0xae, 0xce, 0x03, 0xb6, 0x50, 0x4e, 0xd5,
+	0x8a, 0x92, 0x83, 0x36, 0x53, 0xaa, 0xcd, 0x5c, 0x30, 0x8b, 0x70, 0xb6, 0x5d, 0x4c, 0xd1, 0xbc,
+	0xd2, 0x52, 0x77, 0x60, 0xf1, 0x4d, 0xab, 0x2e, 0xf7, 0x6e, 0xec, 0x42, 0xdd, 0xf8, 0xa5, 0x87,
+	0xe1, 0xfa, 0x14, 0xb0, 0x44, 0xf9, 0x5e, 0xcc, 0xd5, 0x6a, 0xa7, 0xbe, 0x14, 0x8c, 0x82, 0x39,
+	0xa3, 0xc2, 0xd6, 0xfe, 0x12, 0xd6, 0x5f, 0xf0, 0x4f, 0x7d, 0x39, 0xb8, 0x14, 0xfe, 0x87, 0x18,
+	0x05, 0xa3, 0x80, 0x0f, 0x51, 0x7e, 0xf8, 0xe2, 0xf4, 0x93, 0x02, 0x73, 0x56, 0xa5, 0x5a, 0xff,
+	0x7c, 0x89, 0xea, 0xe9, 0x41, 0x5c, 0xee, 0x75, 0xcd, 0x72, 0x03, 0xe1, 0xe1, 0x8d, 0x0a, 0x6f,
+	0xa0, 0x09, 0xe2, 0xba, 0x82, 0x82, 0xde, 0x96, 0x27, 0xab, 0xe6, 0xb7, 0xaf, 0x6b, 0xb9, 0x30,
+	0x7e, 0x4b, 0x4f, 0x6a, 0x52, 0x30, 0xdf, 0xb3, 0x7b, 0x42, 0x9c, 0x43, 0x63, 0x57, 0x7b, 0xef,
+	0x88, 0xad, 0x0f, 0x1e, 0xa6, 0x3f, 0x9c, 0x14, 0x53, 0xbf, 0x4f, 0x8a, 0xa9, 0xea, 0xb3, 0xd3,
+	0x8b, 0x82, 0x71, 0x76, 0x51, 0x30, 0x7e, 0x5e, 0x14, 0x8c, 0x4f, 0x97, 0x85, 0xd4, 0xd9, 0x65,
+	0x21, 0xf5, 0xfd, 0xb2, 0x90, 0x7a, 0xf3, 0xe0, 0xc6, 0xed, 0xf9, 0xa8, 0xff, 0x02, 0x52, 0x1b,
+	0x75, 0x7d, 0x5c, 0xbd, 0x49, 0x36, 0xff, 0x0c, 0x00, 0x6f, 0x90, 0xa9, 0xf0, 0xf3, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +342,102 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashedDelegationShares) > 0 {
+		for iNdEx := len(m.SlashedDelegationShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedDelegationShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SlashedUnbondingEntries) > 0 {
+		for iNdEx := len(m.SlashedUnbondingEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedUnbondingEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.SlashedDelegations) > 0 {
+		for iNdEx := len(m.SlashedDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.LastSlashRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashRecordId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RotationHistory) > 0 {
+		for iNdEx := len(m.RotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +630,48 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.RotationHistory) > 0 {
+		for _, e := range m.RotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSlashRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashRecordId))
+	}
+	if len(m.SlashedDelegations) > 0 {
+		for _, e := range m.SlashedDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashedUnbondingEntries) > 0 {
+		for _, e := range m.SlashedUnbondingEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashedDelegationShares) > 0 {
+		for _, e := range m.SlashedDelegationShares {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +982,248 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotationHistory = append(m.RotationHistory, ConsPubKeyRotationHistory{})
+			if err := m.RotationHistory[len(m.RotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashRecordId", wireType)
+			}
+			m.LastSlashRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedDelegations = append(m.SlashedDelegations, SlashedDelegation{})
+			if err := m.SlashedDelegations[len(m.SlashedDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedUnbondingEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedUnbondingEntries = append(m.SlashedUnbondingEntries, SlashedUnbondingEntry{})
+			if err := m.SlashedUnbondingEntries[len(m.SlashedUnbondingEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDelegationShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedDelegationShares = append(m.SlashedDelegationShares, SlashedDelegationShares{})
+			if err := m.SlashedDelegationShares[len(m.SlashedDelegationShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	sdkmath "cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -114,3 +115,21 @@ func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) e
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, rotationFee sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, rotationFee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) BeforeTokenizeShareRecordOwnerChanged(ctx sdk.Context, recordAddr sdk.AccAddress, valAddr sdk.ValAddress, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeTokenizeShareRecordOwnerChanged(ctx, recordAddr, valAddr, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
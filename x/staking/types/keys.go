@@ -54,6 +54,24 @@ var (
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	ValidatorConsPubKeyRotationHistoryKey = []byte{0x71} // prefix for the consensus pubkey rotations of a validator
+	BlockConsPubKeyRotationHistoryKey     = []byte{0x72} // prefix for the consensus pubkey rotations of a block
+	OldToNewConsAddrKey                   = []byte{0x73} // prefix for the index of rotated consensus addresses to their new consensus address
+
+	LastTokenizeShareRecordIDKey       = []byte{0x81} // key for the id of the last tokenize share record
+	TokenizeShareRecordPrefix          = []byte{0x82} // prefix for the tokenize share records
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x83} // prefix for the index of tokenize share records by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x84} // prefix for the index of tokenize share records by share token denom
+	ValidatorLiquidSharesKey           = []byte{0x85} // prefix for the tokenized delegator shares of each validator
+
+	LastSlashRecordIDKey           = []byte{0x91} // key for the id of the last slash record
+	SlashRecordPrefix              = []byte{0x92} // prefix for the slash records
+	SlashRecordIDByValidatorPrefix = []byte{0x93} // prefix for the index of slash records by validator
+	SlashRecordIDByTimePrefix      = []byte{0x94} // prefix for the index of slash records by slash time
+	SlashedDelegationPrefix        = []byte{0x95} // prefix for the tokens burned from each slashed redelegation by a slash
+	SlashedUnbondingEntryPrefix    = []byte{0x96} // prefix for the tokens burned from each unbonding delegation entry by a slash
+	SlashedDelegationSharesPrefix  = []byte{0x97} // prefix for the shares held at a slash by each delegation modified since
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -421,3 +439,149 @@ func GetHistoricalInfoKey(height int64) []byte {
 	binary.BigEndian.PutUint64(heightBytes, uint64(height))
 	return append(HistoricalInfoKey, heightBytes...)
 }
+
+// GetValidatorConsPubKeyRotationHistoryKey returns the key of a consensus
+// pubkey rotation of a validator at a given height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetValidatorConsPubKeyRotationHistoryKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetValidatorConsPubKeyRotationHistoryPrefix(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetValidatorConsPubKeyRotationHistoryPrefix returns the prefix of all the
+// consensus pubkey rotations of a validator.
+func GetValidatorConsPubKeyRotationHistoryPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorConsPubKeyRotationHistoryKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetBlockConsPubKeyRotationHistoryKey returns the key of a consensus pubkey
+// rotation of a validator happening at a given height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetBlockConsPubKeyRotationHistoryKey(height int64, valAddr sdk.ValAddress) []byte {
+	return append(GetBlockConsPubKeyRotationHistoryPrefix(height), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetBlockConsPubKeyRotationHistoryPrefix returns the prefix of all the
+// consensus pubkey rotations happening at a given height.
+func GetBlockConsPubKeyRotationHistoryPrefix(height int64) []byte {
+	return append(BlockConsPubKeyRotationHistoryKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetOldToNewConsAddrKey returns the key of the index of a rotated consensus
+// address to the consensus address replacing it.
+// VALUE: new consensus address ([]byte)
+func GetOldToNewConsAddrKey(oldConsAddr sdk.ConsAddress) []byte {
+	return append(OldToNewConsAddrKey, address.MustLengthPrefix(oldConsAddr)...)
+}
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key of the index of a
+// tokenize share record by its owner.
+// VALUE: tokenize share record id ([]byte)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the prefix of the tokenize
+// share records owned by an address.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key of the index of a
+// tokenize share record by its share token denom.
+// VALUE: tokenize share record id ([]byte)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the tokenized delegator
+// shares of a validator.
+// VALUE: math.LegacyDec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// AddressFromValidatorLiquidSharesKey returns the validator address from a
+// validator liquid shares key.
+func AddressFromValidatorLiquidSharesKey(key []byte) sdk.ValAddress {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// GetSlashRecordKey returns the key of a slash record.
+// VALUE: staking/SlashRecord
+func GetSlashRecordKey(id uint64) []byte {
+	return append(SlashRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSlashRecordIDByValidatorAndIDKey returns the key of the index of a slash
+// record by its validator.
+// VALUE: slash record id ([]byte)
+func GetSlashRecordIDByValidatorAndIDKey(valAddr sdk.ValAddress, id uint64) []byte {
+	return append(GetSlashRecordIDsByValidatorPrefix(valAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSlashRecordIDsByValidatorPrefix returns the prefix of the slash records of
+// a validator.
+func GetSlashRecordIDsByValidatorPrefix(valAddr sdk.ValAddress) []byte {
+	return append(SlashRecordIDByValidatorPrefix, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetSlashRecordIDByTimeAndIDKey returns the key of the index of a slash record
+// by its slash time.
+// VALUE: slash record id ([]byte)
+func GetSlashRecordIDByTimeAndIDKey(slashTime time.Time, id uint64) []byte {
+	return append(GetSlashRecordIDsByTimePrefix(slashTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSlashRecordIDsByTimePrefix returns the prefix of the slash records of a
+// slash time.
+func GetSlashRecordIDsByTimePrefix(slashTime time.Time) []byte {
+	return append(SlashRecordIDByTimePrefix, sdk.FormatTimeBytes(slashTime)...)
+}
+
+// GetSlashedDelegationKey returns the key of the tokens burned from a
+// delegation by a slash.
+// VALUE: staking/SlashedDelegation
+func GetSlashedDelegationKey(id uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(GetSlashedDelegationsPrefix(id), address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetSlashedDelegationsPrefix returns the prefix of the tokens burned from the
+// delegations by a slash.
+func GetSlashedDelegationsPrefix(id uint64) []byte {
+	return append(SlashedDelegationPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSlashedUnbondingEntryKey returns the key of the tokens burned from an
+// unbonding delegation entry by a slash.
+// VALUE: staking/SlashedUnbondingEntry
+func GetSlashedUnbondingEntryKey(id uint64, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, unbondingID uint64) []byte {
+	key := append(append(GetSlashedUnbondingEntriesPrefix(id), address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(creationHeight))...)
+	return append(key, sdk.Uint64ToBigEndian(unbondingID)...)
+}
+
+// GetSlashedUnbondingEntriesPrefix returns the prefix of the tokens burned from
+// the unbonding delegation entries by a slash.
+func GetSlashedUnbondingEntriesPrefix(id uint64) []byte {
+	return append(SlashedUnbondingEntryPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetSlashedDelegationSharesKey returns the key of the shares held at a slash by
+// a delegation to the slashed validator.
+// VALUE: staking/SlashedDelegationShares
+func GetSlashedDelegationSharesKey(id uint64, delAddr sdk.AccAddress) []byte {
+	return append(GetSlashedDelegationSharesPrefix(id), address.MustLengthPrefix(delAddr)...)
+}
+
+// GetSlashedDelegationSharesPrefix returns the prefix of the shares held at a
+// slash by the delegations to the slashed validator.
+func GetSlashedDelegationSharesPrefix(id uint64) []byte {
+	return append(SlashedDelegationSharesPrefix, sdk.Uint64ToBigEndian(id)...)
+}
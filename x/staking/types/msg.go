@@ -20,6 +20,12 @@ var (
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgRotateConsPubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
+	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensForShares{}
+	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
+	_ sdk.Msg                            = &MsgReverseSlash{}
 
 	_ legacytx.LegacyMsg = &MsgCreateValidator{}
 	_ legacytx.LegacyMsg = &MsgEditValidator{}
@@ -28,6 +34,11 @@ var (
 	_ legacytx.LegacyMsg = &MsgBeginRedelegate{}
 	_ legacytx.LegacyMsg = &MsgCancelUnbondingDelegation{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgRotateConsPubKey{}
+	_ legacytx.LegacyMsg = &MsgTokenizeShares{}
+	_ legacytx.LegacyMsg = &MsgRedeemTokensForShares{}
+	_ legacytx.LegacyMsg = &MsgTransferTokenizeShareRecord{}
+	_ legacytx.LegacyMsg = &MsgReverseSlash{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -240,3 +251,115 @@ func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotateConsPubKey{
+		ValidatorAddress: valAddr.String(),
+		NewPubkey:        pkAny,
+	}, nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubkey, &pubKey)
+}
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              amount,
+		TokenizedShareOwner: owner.String(),
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgTransferTokenizeShareRecord creates a new MsgTransferTokenizeShareRecord instance.
+func NewMsgTransferTokenizeShareRecord(recordID uint64, sender, newOwner sdk.AccAddress) *MsgTransferTokenizeShareRecord {
+	return &MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: recordID,
+		Sender:                sender.String(),
+		NewOwner:              newOwner.String(),
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgReverseSlash creates a new MsgReverseSlash instance.
+func NewMsgReverseSlash(authority string, slashRecordID uint64) *MsgReverseSlash {
+	return &MsgReverseSlash{
+		Authority:     authority,
+		SlashRecordId: slashRecordID,
+	}
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgReverseSlash) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgReverseSlash) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
@@ -29,20 +29,57 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultMaxQueueCompletionsPerBlock is set to 0, i.e. no limit
+	DefaultMaxQueueCompletionsPerBlock uint32 = 0
+
+	// DefaultSlashReversalWindow reflects two weeks as the default duration
+	// during which a slash can be reversed by governance.
+	DefaultSlashReversalWindow time.Duration = time.Hour * 24 * 7 * 2
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultKeyRotationFee is the fee charged for rotating a validator consensus key
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultMaxValidatorPowerRatio is set to 100%, i.e. no cap
+	DefaultMaxValidatorPowerRatio = math.LegacyOneDec()
+)
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate math.LegacyDec) Params {
+func NewParams(
+	unbondingTime time.Duration,
+	maxValidators, maxEntries, historicalEntries uint32,
+	bondDenom string,
+	minCommissionRate math.LegacyDec,
+	keyRotationFee sdk.Coin,
+	globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
+	maxValidatorPowerRatio math.LegacyDec,
+	maxQueueCompletionsPerBlock uint32,
+	slashReversalWindow time.Duration,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:               unbondingTime,
+		MaxValidators:               maxValidators,
+		MaxEntries:                  maxEntries,
+		HistoricalEntries:           historicalEntries,
+		BondDenom:                   bondDenom,
+		MinCommissionRate:           minCommissionRate,
+		KeyRotationFee:              keyRotationFee,
+		GlobalLiquidStakingCap:      globalLiquidStakingCap,
+		ValidatorLiquidStakingCap:   validatorLiquidStakingCap,
+		MaxValidatorPowerRatio:      maxValidatorPowerRatio,
+		MaxQueueCompletionsPerBlock: maxQueueCompletionsPerBlock,
+		SlashReversalWindow:         slashReversalWindow,
 	}
 }
 
@@ -55,6 +92,12 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultKeyRotationFee,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultMaxValidatorPowerRatio,
+		DefaultMaxQueueCompletionsPerBlock,
+		DefaultSlashReversalWindow,
 	)
 }
 
@@ -104,6 +147,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("invalid global liquid staking cap: %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("invalid validator liquid staking cap: %w", err)
+	}
+
+	if err := validateMaxValidatorPowerRatio(p.MaxValidatorPowerRatio); err != nil {
+		return err
+	}
+
+	if err := validateSlashReversalWindow(p.SlashReversalWindow); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -203,3 +266,67 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxValidatorPowerRatio(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max validator power ratio cannot be nil: %s", v)
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("max validator power ratio must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max validator power ratio cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashReversalWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("slash reversal window cannot be negative: %d", v)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -35,4 +36,23 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate max validator power ratio
+	params = types.DefaultParams()
+	params.MaxValidatorPowerRatio = math.LegacyZeroDec()
+	require.Error(t, params.Validate())
+
+	params.MaxValidatorPowerRatio = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.MaxValidatorPowerRatio = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, params.Validate())
+
+	// validate slash reversal window
+	params = types.DefaultParams()
+	params.SlashReversalWindow = -time.Second
+	require.Error(t, params.Validate())
+
+	params.SlashReversalWindow = 0
+	require.NoError(t, params.Validate())
 }
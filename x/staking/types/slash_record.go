@@ -0,0 +1,137 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSlashRecord creates a new slash record without burned tokens.
+func NewSlashRecord(
+	id uint64, valAddr sdk.ValAddress, infractionHeight, height int64, slashTime time.Time, slashFactor math.LegacyDec,
+) SlashRecord {
+	return SlashRecord{
+		Id:               id,
+		ValidatorAddress: valAddr.String(),
+		InfractionHeight: infractionHeight,
+		Height:           height,
+		Time:             slashTime,
+		SlashFactor:      slashFactor,
+		Burned:           math.ZeroInt(),
+		ValidatorBurned:  math.ZeroInt(),
+		DelegatorShares:  math.LegacyZeroDec(),
+	}
+}
+
+// NewSlashedDelegation creates the tokens burned from a delegation by a slash.
+func NewSlashedDelegation(id uint64, delAddr, valAddr string, amount math.Int) SlashedDelegation {
+	return SlashedDelegation{
+		SlashRecordId:    id,
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// NewSlashedDelegationShares creates the shares held at a slash by a
+// delegation to the slashed validator.
+func NewSlashedDelegationShares(id uint64, delAddr, valAddr string, shares math.LegacyDec) SlashedDelegationShares {
+	return SlashedDelegationShares{
+		SlashRecordId:    id,
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Shares:           shares,
+	}
+}
+
+// NewSlashedUnbondingEntry creates the tokens burned from an unbonding
+// delegation entry by a slash.
+func NewSlashedUnbondingEntry(id uint64, ubd UnbondingDelegation, entry UnbondingDelegationEntry, amount math.Int) SlashedUnbondingEntry {
+	return SlashedUnbondingEntry{
+		SlashRecordId:    id,
+		DelegatorAddress: ubd.DelegatorAddress,
+		ValidatorAddress: ubd.ValidatorAddress,
+		CreationHeight:   entry.CreationHeight,
+		UnbondingId:      entry.UnbondingId,
+		Amount:           amount,
+	}
+}
+
+// Validate performs a basic validation of the slash record.
+func (r SlashRecord) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator of slash record %d: %w", r.Id, err)
+	}
+
+	if r.SlashFactor.IsNil() || r.SlashFactor.IsNegative() {
+		return fmt.Errorf("invalid slash factor of slash record %d: %s", r.Id, r.SlashFactor)
+	}
+
+	if r.Burned.IsNil() || r.Burned.IsNegative() {
+		return fmt.Errorf("invalid burned amount of slash record %d: %s", r.Id, r.Burned)
+	}
+
+	if r.ValidatorBurned.IsNil() || r.ValidatorBurned.IsNegative() || r.ValidatorBurned.GT(r.Burned) {
+		return fmt.Errorf("invalid validator burned amount of slash record %d: %s", r.Id, r.ValidatorBurned)
+	}
+
+	if r.DelegatorShares.IsNil() || r.DelegatorShares.IsNegative() {
+		return fmt.Errorf("invalid delegator shares of slash record %d: %s", r.Id, r.DelegatorShares)
+	}
+
+	if !r.Reversed && r.ReversalHeight != 0 {
+		return fmt.Errorf("slash record %d is not reversed but has a reversal height", r.Id)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the tokens burned from a delegation.
+func (d SlashedDelegation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator of slash record %d: %w", d.SlashRecordId, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(d.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator of slash record %d: %w", d.SlashRecordId, err)
+	}
+	if d.Amount.IsNil() || !d.Amount.IsPositive() {
+		return fmt.Errorf("invalid delegation amount of slash record %d: %s", d.SlashRecordId, d.Amount)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the shares held at a slash by a
+// delegation.
+func (d SlashedDelegationShares) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator of slash record %d: %w", d.SlashRecordId, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(d.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator of slash record %d: %w", d.SlashRecordId, err)
+	}
+	if d.Shares.IsNil() || d.Shares.IsNegative() {
+		return fmt.Errorf("invalid delegation shares of slash record %d: %s", d.SlashRecordId, d.Shares)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the tokens burned from an unbonding
+// delegation entry.
+func (e SlashedUnbondingEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator of slash record %d: %w", e.SlashRecordId, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(e.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator of slash record %d: %w", e.SlashRecordId, err)
+	}
+	if e.Amount.IsNil() || !e.Amount.IsPositive() {
+		return fmt.Errorf("invalid unbonding entry amount of slash record %d: %s", e.SlashRecordId, e.Amount)
+	}
+
+	return nil
+}
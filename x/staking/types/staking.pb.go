@@ -932,6 +932,38 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// key_rotation_fee is the fee charged to a validator operator for rotating
+	// the validator consensus public key.
+	//
+	// Since: cosmos-sdk 0.48
+	KeyRotationFee types2.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum ratio of the total bonded tokens
+	// that can be tokenized into share tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum ratio of the delegator shares
+	// of a validator that can be tokenized into share tokens.
+	//
+	// Since: cosmos-sdk 0.48
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap"`
+	// max_validator_power_ratio is the maximum ratio of the total bonded tokens
+	// that can be bonded to a single validator. Delegations which would exceed
+	// it are rejected.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxValidatorPowerRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_validator_power_ratio,json=maxValidatorPowerRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_power_ratio"`
+	// max_queue_completions_per_block is the maximum number of mature unbonding
+	// delegations, and of mature redelegations, completed in a block. The mature
+	// entries above it are completed in the next blocks. Zero means no limit.
+	//
+	// Since: cosmos-sdk 0.48
+	MaxQueueCompletionsPerBlock uint32 `protobuf:"varint,11,opt,name=max_queue_completions_per_block,json=maxQueueCompletionsPerBlock,proto3" json:"max_queue_completions_per_block,omitempty"`
+	// slash_reversal_window is the duration after a slash during which it can be
+	// reversed by governance. Zero disables slash reversals.
+	//
+	// Since: cosmos-sdk 0.48
+	SlashReversalWindow time.Duration `protobuf:"bytes,12,opt,name=slash_reversal_window,json=slashReversalWindow,proto3,stdduration" json:"slash_reversal_window"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1002,6 +1034,27 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetKeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+func (m *Params) GetMaxQueueCompletionsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxQueueCompletionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetSlashReversalWindow() time.Duration {
+	if m != nil {
+		return m.SlashReversalWindow
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1245,6 +1298,446 @@ func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
 	return nil
 }
 
+// ConsPubKeyRotationHistory records a rotation of the consensus public key of
+// a validator.
+//
+// Since: cosmos-sdk 0.48
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key of the validator before the rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height defines the block height at which the rotation happened.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time defines the block time at which the rotation happened.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// fee is the fee paid by the validator operator for the rotation.
+	Fee types2.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
+// TokenizeShareRecord represents a delegation which was tokenized into
+// transferable share tokens. The delegation is held by the module account of
+// the record and its rewards accrue to the record owner.
+//
+// Since: cosmos-sdk 0.48
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the address of the validator the tokenized delegation is bonded to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+// SlashRecord records the tokens burned by a slash of a validator so that the
+// slash can be reversed by governance within the slash reversal window. The
+// tokens burned from each slashed redelegation and unbonding delegation entry
+// are stored separately, as SlashedDelegation and SlashedUnbondingEntry. The
+// tokens burned from each delegation to the validator are not stored, they are
+// derived from validator_burned pro rata to the shares of the delegation at the
+// slash, see SlashedDelegationShares.
+//
+// Since: cosmos-sdk 0.48
+type SlashRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator_address is the address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// infraction_height is the height of the infraction of the slash.
+	InfractionHeight int64 `protobuf:"varint,3,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// height is the height of the slash.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the slash.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// slash_factor is the slash factor of the slash.
+	SlashFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_factor,json=slashFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_factor"`
+	// burned is the total amount of tokens burned by the slash.
+	Burned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=burned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burned"`
+	// reversed is true if the slash was reversed.
+	Reversed bool `protobuf:"varint,8,opt,name=reversed,proto3" json:"reversed,omitempty"`
+	// reversal_height is the height at which the slash was reversed.
+	ReversalHeight int64 `protobuf:"varint,9,opt,name=reversal_height,json=reversalHeight,proto3" json:"reversal_height,omitempty"`
+	// validator_burned is the amount of tokens burned from the delegations to
+	// the validator, it is included in burned.
+	ValidatorBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=validator_burned,json=validatorBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_burned"`
+	// delegator_shares is the total delegator shares of the validator at the
+	// slash.
+	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SlashRecord) GetReversed() bool {
+	if m != nil {
+		return m.Reversed
+	}
+	return false
+}
+
+func (m *SlashRecord) GetReversalHeight() int64 {
+	if m != nil {
+		return m.ReversalHeight
+	}
+	return 0
+}
+
+// SlashedDelegation is the amount of tokens burned from a delegation by a
+// slash. It is stored for the delegations of the slashed redelegations to their
+// destination validator, and derived for the delegations to the slashed
+// validator.
+//
+// Since: cosmos-sdk 0.48
+type SlashedDelegation struct {
+	// slash_record_id is the id of the record of the slash.
+	SlashRecordId    uint64                                 `protobuf:"varint,1,opt,name=slash_record_id,json=slashRecordId,proto3" json:"slash_record_id,omitempty"`
+	DelegatorAddress string                                 `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string                                 `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *SlashedDelegation) Reset()         { *m = SlashedDelegation{} }
+func (m *SlashedDelegation) String() string { return proto.CompactTextString(m) }
+func (*SlashedDelegation) ProtoMessage()    {}
+func (*SlashedDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *SlashedDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashedDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashedDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashedDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashedDelegation.Merge(m, src)
+}
+func (m *SlashedDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashedDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashedDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashedDelegation proto.InternalMessageInfo
+
+func (m *SlashedDelegation) GetSlashRecordId() uint64 {
+	if m != nil {
+		return m.SlashRecordId
+	}
+	return 0
+}
+
+func (m *SlashedDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *SlashedDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// SlashedDelegationShares is the shares held at a slash by a delegation to the
+// slashed validator. It is recorded when the delegation is modified for the
+// first time after the slash, the shares of a delegation which was not modified
+// since are its current shares. A delegation created after the slash held no
+// shares.
+//
+// Since: cosmos-sdk 0.48
+type SlashedDelegationShares struct {
+	// slash_record_id is the id of the record of the slash.
+	SlashRecordId    uint64                                 `protobuf:"varint,1,opt,name=slash_record_id,json=slashRecordId,proto3" json:"slash_record_id,omitempty"`
+	DelegatorAddress string                                 `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string                                 `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Shares           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+}
+
+func (m *SlashedDelegationShares) Reset()         { *m = SlashedDelegationShares{} }
+func (m *SlashedDelegationShares) String() string { return proto.CompactTextString(m) }
+func (*SlashedDelegationShares) ProtoMessage()    {}
+func (*SlashedDelegationShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
+}
+func (m *SlashedDelegationShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashedDelegationShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashedDelegationShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashedDelegationShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashedDelegationShares.Merge(m, src)
+}
+func (m *SlashedDelegationShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashedDelegationShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashedDelegationShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashedDelegationShares proto.InternalMessageInfo
+
+func (m *SlashedDelegationShares) GetSlashRecordId() uint64 {
+	if m != nil {
+		return m.SlashRecordId
+	}
+	return 0
+}
+
+func (m *SlashedDelegationShares) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *SlashedDelegationShares) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// SlashedUnbondingEntry is the amount of tokens burned from an unbonding
+// delegation entry by a slash.
+//
+// Since: cosmos-sdk 0.48
+type SlashedUnbondingEntry struct {
+	// slash_record_id is the id of the record of the slash.
+	SlashRecordId    uint64 `protobuf:"varint,1,opt,name=slash_record_id,json=slashRecordId,proto3" json:"slash_record_id,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// creation_height is the creation height of the unbonding delegation entry.
+	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// unbonding_id is the unbonding id of the unbonding delegation entry.
+	UnbondingId uint64                                 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *SlashedUnbondingEntry) Reset()         { *m = SlashedUnbondingEntry{} }
+func (m *SlashedUnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*SlashedUnbondingEntry) ProtoMessage()    {}
+func (*SlashedUnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{26}
+}
+func (m *SlashedUnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashedUnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashedUnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashedUnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashedUnbondingEntry.Merge(m, src)
+}
+func (m *SlashedUnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashedUnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashedUnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashedUnbondingEntry proto.InternalMessageInfo
+
+func (m *SlashedUnbondingEntry) GetSlashRecordId() uint64 {
+	if m != nil {
+		return m.SlashRecordId
+	}
+	return 0
+}
+
+func (m *SlashedUnbondingEntry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *SlashedUnbondingEntry) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *SlashedUnbondingEntry) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *SlashedUnbondingEntry) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1269,6 +1762,12 @@ func init() {
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*SlashRecord)(nil), "cosmos.staking.v1beta1.SlashRecord")
+	proto.RegisterType((*SlashedDelegation)(nil), "cosmos.staking.v1beta1.SlashedDelegation")
+	proto.RegisterType((*SlashedDelegationShares)(nil), "cosmos.staking.v1beta1.SlashedDelegationShares")
+	proto.RegisterType((*SlashedUnbondingEntry)(nil), "cosmos.staking.v1beta1.SlashedUnbondingEntry")
 }
 
 func init() {
@@ -1276,125 +1775,162 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3d, 0x6c, 0x23, 0xc7,
-	0x15, 0xd6, 0x92, 0x32, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0x27, 0xdf, 0xf1, 0x78, 0x89, 0x48, 0xd3,
-	0x17, 0x5b, 0x3e, 0xf8, 0xa8, 0x9c, 0x02, 0xa4, 0x50, 0x8c, 0x04, 0xa2, 0xa8, 0xf3, 0xd1, 0xb1,
-	0x25, 0x61, 0x29, 0x29, 0x71, 0x7e, 0xb0, 0x18, 0xee, 0x8e, 0xa8, 0x89, 0xb8, 0xb3, 0xc4, 0xce,
-	0xf0, 0x2c, 0xb6, 0x41, 0x0a, 0x43, 0x45, 0x62, 0x20, 0x4d, 0x9a, 0x03, 0x0e, 0x48, 0x11, 0xa7,
-	0x73, 0x61, 0xc4, 0x45, 0x90, 0x22, 0x9d, 0x93, 0x34, 0x07, 0x57, 0x41, 0x0a, 0x25, 0xb8, 0x2b,
-	0x6c, 0xa4, 0x0a, 0xd2, 0x25, 0x55, 0x30, 0xb3, 0xb3, 0x3f, 0x14, 0xc5, 0x93, 0x74, 0x50, 0x0c,
-	0x03, 0x6e, 0xc8, 0x9d, 0x99, 0xf7, 0xbe, 0x99, 0xf7, 0xbd, 0x9f, 0xf9, 0x81, 0x9b, 0xb6, 0xc7,
-	0x5d, 0x8f, 0x2f, 0x71, 0x81, 0x0f, 0x28, 0x6b, 0x2f, 0xdd, 0xbf, 0xd3, 0x22, 0x02, 0xdf, 0x09,
-	0xdb, 0xd5, 0xae, 0xef, 0x09, 0x0f, 0x5d, 0x0d, 0xa4, 0xaa, 0x61, 0xaf, 0x96, 0x2a, 0xce, 0xb7,
-	0xbd, 0xb6, 0xa7, 0x44, 0x96, 0xe4, 0x57, 0x20, 0x5d, 0xbc, 0xde, 0xf6, 0xbc, 0x76, 0x87, 0x2c,
-	0xa9, 0x56, 0xab, 0xb7, 0xb7, 0x84, 0x59, 0x5f, 0x0f, 0x2d, 0x9c, 0x1c, 0x72, 0x7a, 0x3e, 0x16,
-	0xd4, 0x63, 0x7a, 0xbc, 0x74, 0x72, 0x5c, 0x50, 0x97, 0x70, 0x81, 0xdd, 0x6e, 0x88, 0x1d, 0xac,
-	0xc4, 0x0a, 0x26, 0xd5, 0xcb, 0xd2, 0xd8, 0xda, 0x94, 0x16, 0xe6, 0x24, 0xb2, 0xc3, 0xf6, 0x68,
-	0x88, 0x3d, 0x87, 0x5d, 0xca, 0xbc, 0x25, 0xf5, 0xab, 0xbb, 0xbe, 0x22, 0x08, 0x73, 0x88, 0xef,
-	0x52, 0x26, 0x96, 0x44, 0xbf, 0x4b, 0x78, 0xf0, 0xab, 0x47, 0x6f, 0x24, 0x46, 0x71, 0xcb, 0xa6,
-	0xc9, 0xc1, 0xca, 0x2f, 0x0d, 0x98, 0xbd, 0x47, 0xb9, 0xf0, 0x7c, 0x6a, 0xe3, 0x4e, 0x83, 0xed,
-	0x79, 0xe8, 0x5b, 0x90, 0xd9, 0x27, 0xd8, 0x21, 0x7e, 0xc1, 0x28, 0x1b, 0x8b, 0xd9, 0xe5, 0x42,
-	0x35, 0x06, 0xa8, 0x06, 0xba, 0xf7, 0xd4, 0x78, 0x6d, 0xea, 0xe3, 0xe3, 0xd2, 0xd8, 0xfb, 0x9f,
-	0x7e, 0x70, 0xcb, 0x30, 0xb5, 0x0a, 0xaa, 0x43, 0xe6, 0x3e, 0xee, 0x70, 0x22, 0x0a, 0xa9, 0x72,
-	0x7a, 0x31, 0xbb, 0xfc, 0x42, 0xf5, 0x74, 0xce, 0xab, 0xbb, 0xb8, 0x43, 0x1d, 0x2c, 0xbc, 0x41,
-	0x94, 0x40, 0xb7, 0xf2, 0x51, 0x0a, 0x72, 0x6b, 0x9e, 0xeb, 0x52, 0xce, 0xa9, 0xc7, 0x4c, 0x2c,
-	0x08, 0x47, 0x3b, 0x30, 0xee, 0x63, 0x41, 0xd4, 0xa2, 0xa6, 0x6a, 0xab, 0x52, 0xe9, 0x6f, 0xc7,
-	0xa5, 0x97, 0xda, 0x54, 0xec, 0xf7, 0x5a, 0x55, 0xdb, 0x73, 0x35, 0x8d, 0xfa, 0xef, 0x36, 0x77,
-	0x0e, 0xb4, 0xa5, 0x75, 0x62, 0x7f, 0xf2, 0xe1, 0x6d, 0xd0, 0x0b, 0xa9, 0x13, 0x3b, 0x98, 0x4c,
-	0xc1, 0xa1, 0x1f, 0xc1, 0xa4, 0x8b, 0x0f, 0x2d, 0x05, 0x9d, 0xba, 0x2c, 0xe8, 0x09, 0x17, 0x1f,
-	0xca, 0x55, 0x23, 0x0a, 0x39, 0x89, 0x6e, 0xef, 0x63, 0xd6, 0x26, 0xc1, 0x24, 0xe9, 0xcb, 0x9a,
-	0x64, 0xc6, 0xc5, 0x87, 0x6b, 0x0a, 0x58, 0x4e, 0xb5, 0x32, 0xfe, 0xd9, 0xc3, 0x92, 0x51, 0xf9,
-	0xa3, 0x01, 0x10, 0x33, 0x87, 0x30, 0xe4, 0xed, 0xa8, 0xa5, 0xe6, 0xe7, 0xda, 0xab, 0x2f, 0x8f,
-	0x72, 0xcc, 0x09, 0xde, 0x6b, 0x33, 0x72, 0xa5, 0x8f, 0x8e, 0x4b, 0x46, 0x30, 0x6b, 0xce, 0x3e,
-	0xe1, 0x97, 0x37, 0x20, 0xdb, 0xeb, 0x3a, 0x58, 0x10, 0x4b, 0x06, 0xb9, 0xe2, 0x30, 0xbb, 0x5c,
-	0xac, 0x06, 0x19, 0x50, 0x0d, 0x33, 0xa0, 0xba, 0x1d, 0x66, 0x40, 0x00, 0xf8, 0xde, 0xdf, 0x43,
-	0x40, 0x08, 0xb4, 0xe5, 0xb8, 0xb6, 0xe1, 0x7d, 0x03, 0xb2, 0x75, 0xc2, 0x6d, 0x9f, 0x76, 0x65,
-	0x4e, 0xa1, 0x02, 0x4c, 0xb8, 0x1e, 0xa3, 0x07, 0x3a, 0x22, 0xa7, 0xcc, 0xb0, 0x89, 0x8a, 0x30,
-	0x49, 0x1d, 0xc2, 0x04, 0x15, 0xfd, 0xc0, 0x79, 0x66, 0xd4, 0x96, 0x5a, 0xef, 0x90, 0x16, 0xa7,
-	0x21, 0xe5, 0x66, 0xd8, 0x44, 0xaf, 0x40, 0x9e, 0x13, 0xbb, 0xe7, 0x53, 0xd1, 0xb7, 0x6c, 0x8f,
-	0x09, 0x6c, 0x8b, 0xc2, 0xb8, 0x12, 0xc9, 0x85, 0xfd, 0x6b, 0x41, 0xb7, 0x04, 0x71, 0x88, 0xc0,
-	0xb4, 0xc3, 0x0b, 0xcf, 0x05, 0x20, 0xba, 0xa9, 0x97, 0xfa, 0xd1, 0x04, 0x4c, 0x45, 0x91, 0x8c,
-	0xd6, 0x20, 0xef, 0x75, 0x89, 0x2f, 0xbf, 0x2d, 0xec, 0x38, 0x3e, 0xe1, 0x5c, 0x87, 0x6b, 0xe1,
-	0x93, 0x0f, 0x6f, 0xcf, 0x6b, 0xc2, 0x57, 0x83, 0x91, 0xa6, 0xf0, 0x29, 0x6b, 0x9b, 0xb9, 0x50,
-	0x43, 0x77, 0xa3, 0xb7, 0xa5, 0xcb, 0x18, 0x27, 0x8c, 0xf7, 0xb8, 0xd5, 0xed, 0xb5, 0x0e, 0x48,
-	0x5f, 0x93, 0x3a, 0x3f, 0x44, 0xea, 0x2a, 0xeb, 0xd7, 0x0a, 0x7f, 0x8e, 0xa1, 0x6d, 0xbf, 0xdf,
-	0x15, 0x5e, 0x75, 0xab, 0xd7, 0xfa, 0x2e, 0xe9, 0x4b, 0x57, 0x69, 0x9c, 0x2d, 0x05, 0x83, 0xae,
-	0x42, 0xe6, 0x27, 0x98, 0x76, 0x88, 0xa3, 0x18, 0x99, 0x34, 0x75, 0x0b, 0xad, 0x40, 0x86, 0x0b,
-	0x2c, 0x7a, 0x5c, 0xd1, 0x30, 0xbb, 0x5c, 0x19, 0x15, 0x1b, 0x35, 0x8f, 0x39, 0x4d, 0x25, 0x69,
-	0x6a, 0x0d, 0xb4, 0x0d, 0x19, 0xe1, 0x1d, 0x10, 0xa6, 0x09, 0xaa, 0xbd, 0x76, 0x81, 0xc0, 0x6e,
-	0x30, 0x91, 0x08, 0xec, 0x06, 0x13, 0xa6, 0xc6, 0x42, 0x6d, 0xc8, 0x3b, 0xa4, 0x43, 0xda, 0x8a,
-	0x4a, 0xbe, 0x8f, 0x7d, 0xc2, 0x0b, 0x99, 0x0b, 0xe3, 0x0f, 0x25, 0x8e, 0x99, 0x8b, 0x50, 0x9b,
-	0x0a, 0x14, 0x6d, 0x41, 0xd6, 0x89, 0x43, 0xad, 0x30, 0xa1, 0x88, 0x7e, 0x71, 0x94, 0xfd, 0x89,
-	0xa8, 0x4c, 0x96, 0xad, 0x24, 0x84, 0x8c, 0xae, 0x1e, 0x6b, 0x79, 0xcc, 0xa1, 0xac, 0x6d, 0xed,
-	0x13, 0xda, 0xde, 0x17, 0x85, 0xc9, 0xb2, 0xb1, 0x98, 0x36, 0x73, 0x51, 0xff, 0x3d, 0xd5, 0x8d,
-	0xb6, 0x60, 0x36, 0x16, 0x55, 0xd9, 0x33, 0x75, 0xd1, 0xec, 0x99, 0x89, 0x00, 0xa4, 0x08, 0x7a,
-	0x0b, 0x20, 0xce, 0xcf, 0x02, 0x28, 0xb4, 0xca, 0xd9, 0x99, 0x9e, 0x34, 0x26, 0x01, 0x80, 0x3a,
-	0x70, 0xc5, 0xa5, 0xcc, 0xe2, 0xa4, 0xb3, 0x67, 0x69, 0xe6, 0x24, 0x6e, 0xf6, 0x12, 0x3c, 0x3d,
-	0xe7, 0x52, 0xd6, 0x24, 0x9d, 0xbd, 0x7a, 0x04, 0x8b, 0x5e, 0x83, 0x1b, 0x31, 0x1d, 0x1e, 0xb3,
-	0xf6, 0xbd, 0x8e, 0x63, 0xf9, 0x64, 0xcf, 0xb2, 0xbd, 0x1e, 0x13, 0x85, 0x69, 0x45, 0xe2, 0xb5,
-	0x48, 0x64, 0x93, 0xdd, 0xf3, 0x3a, 0x8e, 0x49, 0xf6, 0xd6, 0xe4, 0x30, 0x7a, 0x11, 0x62, 0x2e,
-	0x2c, 0xea, 0xf0, 0xc2, 0x4c, 0x39, 0xbd, 0x38, 0x6e, 0x4e, 0x47, 0x9d, 0x0d, 0x87, 0xaf, 0x4c,
-	0xbe, 0xfb, 0xb0, 0x34, 0xf6, 0xd9, 0xc3, 0xd2, 0x58, 0xe5, 0x2e, 0x4c, 0xef, 0xe2, 0x8e, 0x4e,
-	0x3a, 0xc2, 0xd1, 0x37, 0x61, 0x0a, 0x87, 0x8d, 0x82, 0x51, 0x4e, 0x3f, 0x35, 0x69, 0x63, 0xd1,
-	0xca, 0x43, 0x03, 0x32, 0xf5, 0xdd, 0x2d, 0x4c, 0x7d, 0xb4, 0x0e, 0x73, 0x71, 0xd0, 0x9e, 0x37,
-	0xff, 0xe3, 0x38, 0x0f, 0x0b, 0xc0, 0x3a, 0xcc, 0xdd, 0x0f, 0x4b, 0x4a, 0x04, 0x93, 0x3a, 0x0b,
-	0x26, 0x52, 0xd1, 0xfd, 0x09, 0x53, 0xdf, 0x80, 0x89, 0x60, 0x85, 0x1c, 0x7d, 0x07, 0x9e, 0xeb,
-	0xca, 0x0f, 0x65, 0x61, 0x76, 0x79, 0x61, 0x64, 0xa0, 0x2b, 0xf9, 0x64, 0x58, 0x04, 0x7a, 0x95,
-	0xff, 0x18, 0x00, 0xf5, 0xdd, 0xdd, 0x6d, 0x9f, 0x76, 0x3b, 0x44, 0x5c, 0x96, 0xc9, 0x6f, 0xc2,
-	0xf3, 0xb1, 0xc9, 0xdc, 0xb7, 0xcf, 0x6d, 0xf6, 0x95, 0x48, 0xad, 0xe9, 0xdb, 0xa7, 0xa2, 0x39,
-	0x5c, 0x44, 0x68, 0xe9, 0x73, 0xa3, 0xd5, 0xb9, 0x18, 0xe6, 0xf1, 0xfb, 0x90, 0x8d, 0x4d, 0xe7,
-	0xa8, 0x01, 0x93, 0x42, 0x7f, 0x6b, 0x3a, 0x2b, 0xa3, 0xe9, 0x0c, 0xd5, 0x92, 0x94, 0x46, 0xea,
-	0x95, 0xff, 0x4a, 0x56, 0xe3, 0x44, 0xf8, 0x42, 0x05, 0x92, 0xac, 0xf0, 0xba, 0x02, 0xa7, 0x2f,
-	0xa1, 0x02, 0x6b, 0xac, 0x04, 0xad, 0x3f, 0x4b, 0xc1, 0x95, 0x9d, 0x30, 0x49, 0xbf, 0xb0, 0x2c,
-	0xec, 0xc0, 0x04, 0x61, 0xc2, 0xa7, 0x8a, 0x06, 0xe9, 0xec, 0xaf, 0x8f, 0x72, 0xf6, 0x29, 0xb6,
-	0xac, 0x33, 0xe1, 0xf7, 0x93, 0xae, 0x0f, 0xb1, 0x12, 0x34, 0xfc, 0x21, 0x0d, 0x85, 0x51, 0xaa,
-	0xe8, 0x65, 0xc8, 0xd9, 0x3e, 0x51, 0x1d, 0xe1, 0x9e, 0x62, 0xa8, 0x72, 0x38, 0x1b, 0x76, 0xeb,
-	0x2d, 0xc5, 0x04, 0x79, 0x40, 0x93, 0x51, 0x25, 0x45, 0x9f, 0xed, 0x44, 0x36, 0x1b, 0x23, 0xa8,
-	0x4d, 0x85, 0x40, 0x8e, 0x32, 0x2a, 0x28, 0xee, 0x58, 0x2d, 0xdc, 0xc1, 0xcc, 0x26, 0xcf, 0x10,
-	0x09, 0xc3, 0x3b, 0xc0, 0xac, 0x06, 0xad, 0x05, 0x98, 0x68, 0x17, 0x26, 0x42, 0xf8, 0xf1, 0x4b,
-	0x80, 0x0f, 0xc1, 0xd0, 0x0b, 0x30, 0x9d, 0xdc, 0x18, 0xd4, 0x39, 0x65, 0xdc, 0xcc, 0x26, 0xf6,
-	0x85, 0xb3, 0x76, 0x9e, 0xcc, 0x53, 0x77, 0x1e, 0x7d, 0x14, 0xfc, 0x7d, 0x1a, 0xe6, 0x4c, 0xe2,
-	0x7c, 0x09, 0x1d, 0xf7, 0x43, 0x80, 0x20, 0xa9, 0x65, 0xb1, 0x7d, 0x06, 0xdf, 0x0d, 0x17, 0x89,
-	0xa9, 0x00, 0xaf, 0xce, 0xc5, 0xe7, 0xe5, 0xbd, 0xbf, 0xa4, 0x60, 0x3a, 0xe9, 0xbd, 0x2f, 0xc1,
-	0xce, 0x86, 0x36, 0xe2, 0x92, 0x36, 0xae, 0x4a, 0xda, 0x2b, 0xa3, 0x4a, 0xda, 0x50, 0x5c, 0x9f,
-	0x51, 0xcb, 0x7e, 0x93, 0x86, 0xcc, 0x16, 0xf6, 0xb1, 0xcb, 0xd1, 0xe6, 0xd0, 0x19, 0x37, 0xb8,
-	0x7f, 0x5e, 0x1f, 0x0a, 0xeb, 0xba, 0x7e, 0x43, 0x09, 0xa2, 0xfa, 0x57, 0xa3, 0x8e, 0xb8, 0x5f,
-	0x83, 0x59, 0x79, 0xa5, 0x8e, 0x0c, 0x0a, 0xa8, 0x9c, 0x51, 0xd7, 0xe1, 0xe8, 0x2a, 0xc6, 0x51,
-	0x09, 0xb2, 0x52, 0x2c, 0xae, 0xd9, 0x52, 0x06, 0x5c, 0x7c, 0xb8, 0x1e, 0xf4, 0xa0, 0xdb, 0x80,
-	0xf6, 0xa3, 0x87, 0x0f, 0x2b, 0x26, 0x42, 0xca, 0xcd, 0xc5, 0x23, 0xa1, 0xf8, 0x57, 0x01, 0xe4,
-	0x2a, 0x2c, 0x87, 0x30, 0xcf, 0xd5, 0x97, 0xc1, 0x29, 0xd9, 0x53, 0x97, 0x1d, 0xe8, 0x17, 0x46,
-	0x70, 0x54, 0x3e, 0x71, 0xdb, 0xd6, 0x97, 0x16, 0xeb, 0x62, 0xd9, 0xf0, 0xef, 0xe3, 0x52, 0xb1,
-	0x8f, 0xdd, 0xce, 0x4a, 0xe5, 0x14, 0xc8, 0xca, 0x69, 0x6f, 0x01, 0xf2, 0x34, 0x3d, 0x78, 0x71,
-	0x5f, 0xb9, 0x29, 0xe3, 0xfa, 0xe8, 0xd3, 0x0f, 0x6e, 0xdd, 0x48, 0x4c, 0x71, 0x18, 0xbd, 0x90,
-	0x05, 0xee, 0xa9, 0xfc, 0xd6, 0x00, 0x14, 0x6f, 0x36, 0x26, 0xe1, 0x5d, 0x79, 0x67, 0x94, 0xf7,
-	0x88, 0xc4, 0x79, 0xdf, 0x78, 0xfa, 0x3d, 0x22, 0xd6, 0x1f, 0xb8, 0x47, 0x24, 0x92, 0xe9, 0xdb,
-	0x71, 0x69, 0x4f, 0x69, 0xef, 0x6b, 0xac, 0x16, 0xe6, 0x24, 0x71, 0x21, 0xa1, 0x03, 0x10, 0xa1,
-	0x92, 0xca, 0xd1, 0xb1, 0xca, 0xb1, 0x01, 0xd7, 0x87, 0x22, 0x31, 0x5a, 0xb2, 0x0d, 0xc8, 0x4f,
-	0x0c, 0x2a, 0x8f, 0xf6, 0xf5, 0xd2, 0x9f, 0x2d, 0xb0, 0xe7, 0xfc, 0xa1, 0x72, 0xfe, 0x7f, 0xda,
-	0xa3, 0x74, 0x11, 0xfa, 0x93, 0x01, 0xf3, 0xc9, 0x15, 0x45, 0xb6, 0x35, 0x61, 0x3a, 0xb9, 0x16,
-	0x6d, 0xd5, 0xcd, 0xf3, 0x58, 0x95, 0x34, 0x68, 0x00, 0x44, 0xda, 0x12, 0x46, 0x7d, 0xf0, 0x56,
-	0x77, 0xe7, 0xdc, 0x2c, 0x85, 0x0b, 0x3b, 0xb5, 0x0c, 0x04, 0xce, 0xfa, 0x79, 0x0a, 0xc6, 0xb7,
-	0x3c, 0xaf, 0x83, 0x7e, 0x6a, 0xc0, 0x1c, 0xf3, 0x84, 0x25, 0x73, 0x85, 0x38, 0x96, 0x7e, 0x2c,
-	0x08, 0x2a, 0xe9, 0xee, 0xc5, 0xd8, 0xfb, 0xe7, 0x71, 0x69, 0x18, 0x6a, 0x90, 0x52, 0xfd, 0x48,
-	0xc5, 0x3c, 0x51, 0x53, 0x42, 0xdb, 0xc1, 0x7b, 0xc2, 0x3b, 0x30, 0x33, 0x38, 0x7f, 0x50, 0x7e,
-	0xcd, 0x0b, 0xcf, 0x3f, 0x73, 0xe6, 0xdc, 0xd3, 0xad, 0xc4, 0xc4, 0x2b, 0x93, 0xd2, 0xb1, 0xff,
-	0x92, 0xce, 0x7d, 0x1b, 0xf2, 0x51, 0x79, 0xda, 0x51, 0x4f, 0x5e, 0xf2, 0x6c, 0x3a, 0x11, 0xbc,
-	0x7e, 0x85, 0x37, 0x88, 0x72, 0xf2, 0xad, 0x15, 0xb7, 0x6c, 0x5a, 0x3d, 0xa1, 0x33, 0xc0, 0xb8,
-	0xd6, 0xbd, 0xf5, 0x3b, 0x03, 0x20, 0x7e, 0x9a, 0x41, 0xaf, 0xc2, 0xb5, 0xda, 0xe6, 0x46, 0xdd,
-	0x6a, 0x6e, 0xaf, 0x6e, 0xef, 0x34, 0xad, 0x9d, 0x8d, 0xe6, 0xd6, 0xfa, 0x5a, 0xe3, 0x6e, 0x63,
-	0xbd, 0x9e, 0x1f, 0x2b, 0xe6, 0x8e, 0x1e, 0x94, 0xb3, 0x3b, 0x8c, 0x77, 0x89, 0x4d, 0xf7, 0x28,
-	0x71, 0xd0, 0x4b, 0x30, 0x3f, 0x28, 0x2d, 0x5b, 0xeb, 0xf5, 0xbc, 0x51, 0x9c, 0x3e, 0x7a, 0x50,
-	0x9e, 0x0c, 0x8e, 0xa4, 0xc4, 0x41, 0x8b, 0xf0, 0xfc, 0xb0, 0x5c, 0x63, 0xe3, 0xf5, 0x7c, 0xaa,
-	0x38, 0x73, 0xf4, 0xa0, 0x3c, 0x15, 0x9d, 0x5d, 0x51, 0x05, 0x50, 0x52, 0x52, 0xe3, 0xa5, 0x8b,
-	0x70, 0xf4, 0xa0, 0x9c, 0x09, 0xdc, 0x52, 0x1c, 0x7f, 0xf7, 0xd7, 0x0b, 0x63, 0xb7, 0x7e, 0x0c,
-	0xd0, 0x60, 0x7b, 0x3e, 0xb6, 0x55, 0x40, 0x16, 0xe1, 0x6a, 0x63, 0xe3, 0xae, 0xb9, 0xba, 0xb6,
-	0xdd, 0xd8, 0xdc, 0x18, 0x5c, 0xf6, 0x89, 0xb1, 0xfa, 0xe6, 0x4e, 0xed, 0xcd, 0x75, 0xab, 0xd9,
-	0x78, 0x7d, 0x23, 0x6f, 0xa0, 0x6b, 0x70, 0x65, 0x60, 0xec, 0x7b, 0x1b, 0xdb, 0x8d, 0xb7, 0xd6,
-	0xf3, 0xa9, 0xda, 0xdd, 0x8f, 0x1f, 0x2f, 0x18, 0x8f, 0x1e, 0x2f, 0x18, 0xff, 0x78, 0xbc, 0x60,
-	0xbc, 0xf7, 0x64, 0x61, 0xec, 0xd1, 0x93, 0x85, 0xb1, 0xbf, 0x3e, 0x59, 0x18, 0xfb, 0xc1, 0xab,
-	0x4f, 0x75, 0x78, 0x5c, 0x25, 0x95, 0xeb, 0x5b, 0x19, 0xb5, 0x47, 0x7d, 0xe3, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x93, 0xd7, 0xc2, 0x0d, 0x66, 0x18, 0x00, 0x00,
+	// 2478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x34, 0x25, 0x3e, 0x8a, 0x22, 0x35, 0xb2, 0xe5, 0x95, 0x9c, 0x48, 0x0a, 0x93,
+	0x7f, 0xe2, 0xf8, 0x1f, 0x53, 0x8d, 0x0b, 0xe4, 0xa0, 0xa6, 0x0d, 0x44, 0x51, 0x8a, 0x99, 0x3a,
+	0xb2, 0xba, 0x94, 0x94, 0xba, 0x4d, 0xb1, 0x18, 0xee, 0x8e, 0xa8, 0xad, 0x96, 0xbb, 0xcc, 0xce,
+	0xd2, 0x12, 0x8b, 0x9c, 0xda, 0x1e, 0x02, 0x1f, 0xda, 0x00, 0xbd, 0xf4, 0x50, 0x03, 0x06, 0x7a,
+	0x49, 0x6f, 0x41, 0x1b, 0x34, 0x28, 0x8a, 0x1e, 0x7a, 0x6a, 0xda, 0x5e, 0x0c, 0x9f, 0xda, 0x1e,
+	0xd4, 0xc2, 0x3e, 0x24, 0xe8, 0xa9, 0xe8, 0xad, 0x3d, 0x15, 0xf3, 0xb1, 0x1f, 0x14, 0x49, 0x4b,
+	0x72, 0xe9, 0x22, 0x41, 0x2e, 0x12, 0x67, 0xe6, 0xcd, 0x6f, 0xde, 0xbc, 0xf7, 0xe6, 0x7d, 0x2d,
+	0x3c, 0x63, 0xb8, 0xb4, 0xe9, 0xd2, 0x45, 0xea, 0xe3, 0x3d, 0xcb, 0x69, 0x2c, 0xde, 0x7c, 0xb1,
+	0x4e, 0x7c, 0xfc, 0x62, 0x30, 0x2e, 0xb5, 0x3c, 0xd7, 0x77, 0xd1, 0xb4, 0xa0, 0x2a, 0x05, 0xb3,
+	0x92, 0x6a, 0xf6, 0x6c, 0xc3, 0x6d, 0xb8, 0x9c, 0x64, 0x91, 0xfd, 0x12, 0xd4, 0xb3, 0x33, 0x0d,
+	0xd7, 0x6d, 0xd8, 0x64, 0x91, 0x8f, 0xea, 0xed, 0x9d, 0x45, 0xec, 0x74, 0xe4, 0xd2, 0xdc, 0xd1,
+	0x25, 0xb3, 0xed, 0x61, 0xdf, 0x72, 0x1d, 0xb9, 0x3e, 0x7f, 0x74, 0xdd, 0xb7, 0x9a, 0x84, 0xfa,
+	0xb8, 0xd9, 0x0a, 0xb0, 0x05, 0x27, 0xba, 0x38, 0x54, 0xb2, 0x25, 0xb1, 0xe5, 0x55, 0xea, 0x98,
+	0x92, 0xf0, 0x1e, 0x86, 0x6b, 0x05, 0xd8, 0x93, 0xb8, 0x69, 0x39, 0xee, 0x22, 0xff, 0x2b, 0xa7,
+	0x9e, 0xf0, 0x89, 0x63, 0x12, 0xaf, 0x69, 0x39, 0xfe, 0xa2, 0xdf, 0x69, 0x11, 0x2a, 0xfe, 0xca,
+	0xd5, 0x0b, 0xb1, 0x55, 0x5c, 0x37, 0xac, 0xf8, 0x62, 0xf1, 0x47, 0x0a, 0x4c, 0x5c, 0xb5, 0xa8,
+	0xef, 0x7a, 0x96, 0x81, 0xed, 0xaa, 0xb3, 0xe3, 0xa2, 0x2f, 0x41, 0x7a, 0x97, 0x60, 0x93, 0x78,
+	0xaa, 0xb2, 0xa0, 0x5c, 0xcc, 0x5e, 0x51, 0x4b, 0x11, 0x40, 0x49, 0xec, 0xbd, 0xca, 0xd7, 0xcb,
+	0x99, 0x8f, 0x0e, 0xe7, 0x47, 0xde, 0xfb, 0xf8, 0xfd, 0x4b, 0x8a, 0x26, 0xb7, 0xa0, 0x0a, 0xa4,
+	0x6f, 0x62, 0x9b, 0x12, 0x5f, 0x4d, 0x2c, 0x24, 0x2f, 0x66, 0xaf, 0x3c, 0x55, 0xea, 0x2f, 0xf3,
+	0xd2, 0x36, 0xb6, 0x2d, 0x13, 0xfb, 0x6e, 0x37, 0x8a, 0xd8, 0x5b, 0xfc, 0x30, 0x01, 0xf9, 0x15,
+	0xb7, 0xd9, 0xb4, 0x28, 0xb5, 0x5c, 0x47, 0xc3, 0x3e, 0xa1, 0x68, 0x0b, 0x52, 0x1e, 0xf6, 0x09,
+	0x67, 0x2a, 0x53, 0x5e, 0x66, 0x9b, 0xfe, 0x72, 0x38, 0xff, 0x6c, 0xc3, 0xf2, 0x77, 0xdb, 0xf5,
+	0x92, 0xe1, 0x36, 0xa5, 0x18, 0xe5, 0xbf, 0xcb, 0xd4, 0xdc, 0x93, 0x37, 0xad, 0x10, 0xe3, 0xde,
+	0x07, 0x97, 0x41, 0x32, 0x52, 0x21, 0x86, 0x38, 0x8c, 0xc3, 0xa1, 0x37, 0x61, 0xac, 0x89, 0x0f,
+	0x74, 0x0e, 0x9d, 0x18, 0x16, 0xf4, 0x68, 0x13, 0x1f, 0x30, 0xae, 0x91, 0x05, 0x79, 0x86, 0x6e,
+	0xec, 0x62, 0xa7, 0x41, 0xc4, 0x21, 0xc9, 0x61, 0x1d, 0x92, 0x6b, 0xe2, 0x83, 0x15, 0x0e, 0xcc,
+	0x8e, 0x5a, 0x4a, 0x7d, 0x72, 0x67, 0x5e, 0x29, 0xfe, 0x56, 0x01, 0x88, 0x24, 0x87, 0x30, 0x14,
+	0x8c, 0x70, 0xc4, 0xcf, 0xa7, 0x52, 0xab, 0xcf, 0x0d, 0x52, 0xcc, 0x11, 0xb9, 0x97, 0x73, 0x8c,
+	0xd3, 0xbb, 0x87, 0xf3, 0x8a, 0x38, 0x35, 0x6f, 0x1c, 0xd1, 0xcb, 0x6b, 0x90, 0x6d, 0xb7, 0x4c,
+	0xec, 0x13, 0x9d, 0x19, 0x39, 0x97, 0x61, 0xf6, 0xca, 0x6c, 0x49, 0xbc, 0x80, 0x52, 0xf0, 0x02,
+	0x4a, 0x9b, 0xc1, 0x0b, 0x10, 0x80, 0xef, 0xfe, 0x35, 0x00, 0x04, 0xb1, 0x9b, 0xad, 0xcb, 0x3b,
+	0xbc, 0xa7, 0x40, 0xb6, 0x42, 0xa8, 0xe1, 0x59, 0x2d, 0xf6, 0xa6, 0x90, 0x0a, 0xa3, 0x4d, 0xd7,
+	0xb1, 0xf6, 0xa4, 0x45, 0x66, 0xb4, 0x60, 0x88, 0x66, 0x61, 0xcc, 0x32, 0x89, 0xe3, 0x5b, 0x7e,
+	0x47, 0x28, 0x4f, 0x0b, 0xc7, 0x6c, 0xd7, 0x3e, 0xa9, 0x53, 0x2b, 0x10, 0xb9, 0x16, 0x0c, 0xd1,
+	0xf3, 0x50, 0xa0, 0xc4, 0x68, 0x7b, 0x96, 0xdf, 0xd1, 0x0d, 0xd7, 0xf1, 0xb1, 0xe1, 0xab, 0x29,
+	0x4e, 0x92, 0x0f, 0xe6, 0x57, 0xc4, 0x34, 0x03, 0x31, 0x89, 0x8f, 0x2d, 0x9b, 0xaa, 0x67, 0x04,
+	0x88, 0x1c, 0x4a, 0x56, 0x3f, 0x1c, 0x85, 0x4c, 0x68, 0xc9, 0x68, 0x05, 0x0a, 0x6e, 0x8b, 0x78,
+	0xec, 0xb7, 0x8e, 0x4d, 0xd3, 0x23, 0x94, 0x4a, 0x73, 0x55, 0xef, 0x7d, 0x70, 0xf9, 0xac, 0x14,
+	0xf8, 0xb2, 0x58, 0xa9, 0xf9, 0x9e, 0xe5, 0x34, 0xb4, 0x7c, 0xb0, 0x43, 0x4e, 0xa3, 0x1b, 0x4c,
+	0x65, 0x0e, 0x25, 0x0e, 0x6d, 0x53, 0xbd, 0xd5, 0xae, 0xef, 0x91, 0x8e, 0x14, 0xea, 0xd9, 0x1e,
+	0xa1, 0x2e, 0x3b, 0x9d, 0xb2, 0xfa, 0x87, 0x08, 0xda, 0xf0, 0x3a, 0x2d, 0xdf, 0x2d, 0x6d, 0xb4,
+	0xeb, 0x5f, 0x25, 0x1d, 0x2d, 0x1f, 0xe2, 0x6c, 0x70, 0x18, 0x34, 0x0d, 0xe9, 0x6f, 0x63, 0xcb,
+	0x26, 0x26, 0x97, 0xc8, 0x98, 0x26, 0x47, 0x68, 0x09, 0xd2, 0xd4, 0xc7, 0x7e, 0x9b, 0x72, 0x31,
+	0x4c, 0x5c, 0x29, 0x0e, 0xb2, 0x8d, 0xb2, 0xeb, 0x98, 0x35, 0x4e, 0xa9, 0xc9, 0x1d, 0x68, 0x13,
+	0xd2, 0xbe, 0xbb, 0x47, 0x1c, 0x29, 0xa0, 0xf2, 0xcb, 0xa7, 0x30, 0xec, 0xaa, 0xe3, 0xc7, 0x0c,
+	0xbb, 0xea, 0xf8, 0x9a, 0xc4, 0x42, 0x0d, 0x28, 0x98, 0xc4, 0x26, 0x0d, 0x2e, 0x4a, 0xba, 0x8b,
+	0x3d, 0x42, 0xd5, 0xf4, 0xa9, 0xf1, 0x7b, 0x1e, 0x8e, 0x96, 0x0f, 0x51, 0x6b, 0x1c, 0x14, 0x6d,
+	0x40, 0xd6, 0x8c, 0x4c, 0x4d, 0x1d, 0xe5, 0x82, 0x7e, 0x7a, 0xd0, 0xfd, 0x63, 0x56, 0x19, 0x77,
+	0x5b, 0x71, 0x08, 0x66, 0x5d, 0x6d, 0xa7, 0xee, 0x3a, 0xa6, 0xe5, 0x34, 0xf4, 0x5d, 0x62, 0x35,
+	0x76, 0x7d, 0x75, 0x6c, 0x41, 0xb9, 0x98, 0xd4, 0xf2, 0xe1, 0xfc, 0x55, 0x3e, 0x8d, 0x36, 0x60,
+	0x22, 0x22, 0xe5, 0xaf, 0x27, 0x73, 0xda, 0xd7, 0x93, 0x0b, 0x01, 0x18, 0x09, 0x7a, 0x1d, 0x20,
+	0x7a, 0x9f, 0x2a, 0x70, 0xb4, 0xe2, 0xf1, 0x2f, 0x3d, 0x7e, 0x99, 0x18, 0x00, 0xb2, 0x61, 0xaa,
+	0x69, 0x39, 0x3a, 0x25, 0xf6, 0x8e, 0x2e, 0x25, 0xc7, 0x70, 0xb3, 0x43, 0xd0, 0xf4, 0x64, 0xd3,
+	0x72, 0x6a, 0xc4, 0xde, 0xa9, 0x84, 0xb0, 0xe8, 0x65, 0xb8, 0x10, 0x89, 0xc3, 0x75, 0xf4, 0x5d,
+	0xd7, 0x36, 0x75, 0x8f, 0xec, 0xe8, 0x86, 0xdb, 0x76, 0x7c, 0x75, 0x9c, 0x0b, 0xf1, 0x7c, 0x48,
+	0x72, 0xdd, 0xb9, 0xea, 0xda, 0xa6, 0x46, 0x76, 0x56, 0xd8, 0x32, 0x7a, 0x1a, 0x22, 0x59, 0xe8,
+	0x96, 0x49, 0xd5, 0xdc, 0x42, 0xf2, 0x62, 0x4a, 0x1b, 0x0f, 0x27, 0xab, 0x26, 0x5d, 0x1a, 0x7b,
+	0xe7, 0xce, 0xfc, 0xc8, 0x27, 0x77, 0xe6, 0x47, 0x8a, 0x6b, 0x30, 0xbe, 0x8d, 0x6d, 0xf9, 0xe8,
+	0x08, 0x45, 0x2f, 0x41, 0x06, 0x07, 0x03, 0x55, 0x59, 0x48, 0x3e, 0xf4, 0xd1, 0x46, 0xa4, 0xc5,
+	0x3b, 0x0a, 0xa4, 0x2b, 0xdb, 0x1b, 0xd8, 0xf2, 0xd0, 0x2a, 0x4c, 0x46, 0x46, 0x7b, 0xd2, 0xf7,
+	0x1f, 0xd9, 0xb9, 0x9c, 0x67, 0x30, 0x37, 0x03, 0x97, 0x12, 0xc2, 0x24, 0x8e, 0x83, 0x09, 0xb7,
+	0xc8, 0xf9, 0xd8, 0x55, 0x5f, 0x83, 0x51, 0xc1, 0x21, 0x45, 0xaf, 0xc0, 0x99, 0x16, 0xfb, 0xc1,
+	0x6f, 0x98, 0xbd, 0x32, 0x37, 0xd0, 0xd0, 0x39, 0x7d, 0xdc, 0x2c, 0xc4, 0xbe, 0xe2, 0xbf, 0x14,
+	0x80, 0xca, 0xf6, 0xf6, 0xa6, 0x67, 0xb5, 0x6c, 0xe2, 0x0f, 0xeb, 0xca, 0xd7, 0xe0, 0x5c, 0x74,
+	0x65, 0xea, 0x19, 0x27, 0xbe, 0xf6, 0x54, 0xb8, 0xad, 0xe6, 0x19, 0x7d, 0xd1, 0x4c, 0xea, 0x87,
+	0x68, 0xc9, 0x13, 0xa3, 0x55, 0xa8, 0xdf, 0x2b, 0xc7, 0xaf, 0x43, 0x36, 0xba, 0x3a, 0x45, 0x55,
+	0x18, 0xf3, 0xe5, 0x6f, 0x29, 0xce, 0xe2, 0x60, 0x71, 0x06, 0xdb, 0xe2, 0x22, 0x0d, 0xb7, 0x17,
+	0xff, 0xcd, 0xa4, 0x1a, 0x3d, 0x84, 0x4f, 0x95, 0x21, 0x31, 0x0f, 0x2f, 0x3d, 0x70, 0x72, 0x08,
+	0x1e, 0x58, 0x62, 0xc5, 0xc4, 0xfa, 0xfd, 0x04, 0x4c, 0x6d, 0x05, 0x8f, 0xf4, 0x53, 0x2b, 0x85,
+	0x2d, 0x18, 0x25, 0x8e, 0xef, 0x59, 0x5c, 0x0c, 0x4c, 0xd9, 0x5f, 0x18, 0xa4, 0xec, 0x3e, 0x77,
+	0x59, 0x75, 0x7c, 0xaf, 0x13, 0x57, 0x7d, 0x80, 0x15, 0x13, 0xc3, 0x6f, 0x92, 0xa0, 0x0e, 0xda,
+	0x8a, 0x9e, 0x83, 0xbc, 0xe1, 0x11, 0x3e, 0x11, 0xc4, 0x14, 0x85, 0xbb, 0xc3, 0x89, 0x60, 0x5a,
+	0x86, 0x14, 0x0d, 0x58, 0x82, 0xc6, 0xac, 0x8a, 0x91, 0x3e, 0x5a, 0x46, 0x36, 0x11, 0x21, 0xf0,
+	0xa0, 0x42, 0x20, 0x6f, 0x39, 0x96, 0x6f, 0x61, 0x5b, 0xaf, 0x63, 0x1b, 0x3b, 0x06, 0x51, 0x93,
+	0x43, 0x88, 0x00, 0x13, 0x12, 0xb4, 0x2c, 0x30, 0xd1, 0x36, 0x8c, 0x06, 0xf0, 0xa9, 0x21, 0xc0,
+	0x07, 0x60, 0xe8, 0x29, 0x18, 0x8f, 0x07, 0x06, 0x9e, 0xa7, 0xa4, 0xb4, 0x6c, 0x2c, 0x2e, 0x1c,
+	0x17, 0x79, 0xd2, 0x0f, 0x8d, 0x3c, 0x32, 0x15, 0xfc, 0x75, 0x12, 0x26, 0x35, 0x62, 0x7e, 0x0e,
+	0x15, 0xf7, 0x4d, 0x00, 0xf1, 0xa8, 0x99, 0xb3, 0x55, 0x53, 0x43, 0x70, 0x12, 0x19, 0x81, 0x57,
+	0xa1, 0xfe, 0xff, 0x4a, 0x7b, 0x7f, 0x4c, 0xc0, 0x78, 0x5c, 0x7b, 0x9f, 0x83, 0xc8, 0x86, 0xd6,
+	0x23, 0x97, 0x96, 0xe2, 0x2e, 0xed, 0xf9, 0x41, 0x2e, 0xad, 0xc7, 0xae, 0x8f, 0xf1, 0x65, 0x3f,
+	0x19, 0x83, 0xf4, 0x06, 0xf6, 0x70, 0x93, 0xa2, 0xeb, 0x3d, 0x39, 0xae, 0xa8, 0x3f, 0x67, 0x7a,
+	0xcc, 0xba, 0x22, 0x7b, 0x28, 0xc2, 0xaa, 0x7f, 0x3c, 0x28, 0xc5, 0xfd, 0x3f, 0x98, 0x60, 0x25,
+	0x75, 0x78, 0x21, 0x21, 0xca, 0x1c, 0x2f, 0x87, 0xc3, 0x52, 0x8c, 0xa2, 0x79, 0xc8, 0x32, 0xb2,
+	0xc8, 0x67, 0x33, 0x1a, 0x68, 0xe2, 0x83, 0x55, 0x31, 0x83, 0x2e, 0x03, 0xda, 0x0d, 0x1b, 0x1f,
+	0x7a, 0x24, 0x08, 0x46, 0x37, 0x19, 0xad, 0x04, 0xe4, 0x4f, 0x02, 0x30, 0x2e, 0x74, 0x93, 0x38,
+	0x6e, 0x53, 0x16, 0x83, 0x19, 0x36, 0x53, 0x61, 0x13, 0xe8, 0x87, 0x8a, 0x48, 0x95, 0x8f, 0x54,
+	0xdb, 0xb2, 0x68, 0xd1, 0x4f, 0xf7, 0x1a, 0xfe, 0x79, 0x38, 0x3f, 0xdb, 0xc1, 0x4d, 0x7b, 0xa9,
+	0xd8, 0x07, 0xb2, 0xd8, 0xaf, 0x17, 0xc0, 0xb2, 0xe9, 0xee, 0xc2, 0x1d, 0xad, 0x43, 0x61, 0x8f,
+	0x74, 0x74, 0xcf, 0xf5, 0x85, 0xf7, 0xd9, 0x21, 0x44, 0x96, 0x37, 0x33, 0x81, 0x9a, 0x59, 0x8b,
+	0x29, 0x56, 0x0d, 0x58, 0x5d, 0x75, 0xc0, 0xc4, 0x1e, 0xe9, 0x68, 0x72, 0xf3, 0x1a, 0x21, 0xe8,
+	0x6d, 0x98, 0x69, 0xd8, 0x6e, 0x1d, 0xdb, 0xba, 0x6d, 0xbd, 0xd5, 0xb6, 0x4c, 0x5d, 0x1a, 0x89,
+	0x6e, 0xe0, 0x96, 0x3a, 0x36, 0xac, 0xa6, 0xc6, 0xb4, 0x38, 0xe3, 0x1a, 0x3f, 0xa2, 0x26, 0x4e,
+	0x58, 0xc1, 0x2d, 0xf4, 0x5d, 0x05, 0x9e, 0x88, 0x4c, 0xbf, 0x0f, 0x07, 0x99, 0x61, 0x71, 0x30,
+	0x13, 0x1e, 0xd3, 0xc3, 0xc4, 0xdb, 0x30, 0xd3, 0x65, 0x7a, 0x7a, 0xcb, 0xdd, 0x27, 0x9e, 0xce,
+	0xcd, 0x56, 0x85, 0x61, 0x31, 0x30, 0x1d, 0x37, 0xe4, 0x0d, 0x76, 0x82, 0xc6, 0x0e, 0x40, 0x15,
+	0x98, 0x67, 0xa7, 0xbf, 0xd5, 0x26, 0x6d, 0xa2, 0x47, 0x9e, 0x9e, 0xea, 0x2d, 0xe2, 0xe9, 0x75,
+	0xdb, 0x35, 0xf6, 0x78, 0x61, 0x96, 0xd3, 0x2e, 0x34, 0xf1, 0xc1, 0xd7, 0x18, 0xd5, 0x4a, 0x44,
+	0xb4, 0x41, 0xbc, 0x32, 0x23, 0x41, 0x6f, 0xc2, 0x39, 0x6a, 0x63, 0xba, 0xab, 0x7b, 0xe4, 0x26,
+	0xf1, 0x28, 0xb6, 0xf5, 0x7d, 0xcb, 0x31, 0xdd, 0x7d, 0x75, 0x5c, 0xda, 0xc6, 0x49, 0x9f, 0xe5,
+	0x14, 0x87, 0xd1, 0x24, 0xca, 0x1b, 0x1c, 0x64, 0xe9, 0x19, 0xe6, 0x4c, 0x6f, 0x7d, 0xfc, 0xfe,
+	0xa5, 0x0b, 0xb1, 0xdb, 0x1e, 0x84, 0x6d, 0x59, 0xe1, 0x13, 0x8a, 0x3f, 0x53, 0x00, 0x45, 0x19,
+	0x8e, 0x46, 0x68, 0xcb, 0x75, 0x28, 0x2f, 0x5e, 0x63, 0x45, 0xa6, 0xf2, 0xf0, 0xe2, 0x35, 0xda,
+	0xdf, 0x55, 0xbc, 0xc6, 0x3c, 0xf8, 0x57, 0xa2, 0x7c, 0x22, 0x71, 0x0a, 0xbb, 0x0f, 0x36, 0xf1,
+	0xc0, 0x30, 0x52, 0x3c, 0x54, 0x60, 0xa6, 0xc7, 0xfd, 0x85, 0x2c, 0x1b, 0x80, 0xbc, 0xd8, 0x22,
+	0x77, 0x23, 0x1d, 0xc9, 0xfa, 0xa3, 0x79, 0xd3, 0x49, 0xef, 0xe8, 0xea, 0xe3, 0x4a, 0x8c, 0x64,
+	0xe4, 0xfb, 0xbd, 0x02, 0x67, 0xe3, 0x1c, 0x85, 0x77, 0xab, 0xc1, 0x78, 0x9c, 0x17, 0x79, 0xab,
+	0x67, 0x4e, 0x72, 0xab, 0xf8, 0x85, 0xba, 0x40, 0xd8, 0x5d, 0x02, 0x57, 0x2b, 0x1a, 0xc4, 0x2f,
+	0x9e, 0x58, 0x4a, 0x01, 0x63, 0x7d, 0x63, 0x8f, 0x50, 0xd6, 0x0f, 0x12, 0x90, 0xda, 0x70, 0x5d,
+	0x9b, 0xb9, 0x8b, 0x49, 0xc7, 0xf5, 0x75, 0xe6, 0xa0, 0x89, 0xa9, 0xcb, 0x0e, 0x95, 0x08, 0xdf,
+	0xdb, 0xa7, 0x93, 0xde, 0xdf, 0x0f, 0xe7, 0x7b, 0xa1, 0xba, 0x45, 0x2a, 0x3b, 0xa3, 0x8e, 0xeb,
+	0x97, 0x39, 0xd1, 0x26, 0xa7, 0x41, 0xfb, 0x90, 0xeb, 0x3e, 0x5f, 0xc4, 0x7c, 0xed, 0xd4, 0xe7,
+	0xe7, 0x8e, 0x3d, 0x7b, 0xbc, 0x1e, 0x3b, 0x78, 0x69, 0x8c, 0x29, 0xf6, 0x1f, 0x4c, 0xb9, 0x37,
+	0xa0, 0x10, 0xba, 0x92, 0x2d, 0xde, 0x67, 0x65, 0x05, 0xd1, 0xa8, 0x68, 0xb9, 0x06, 0x65, 0xeb,
+	0x42, 0xbc, 0xc1, 0xcf, 0xbe, 0x10, 0x94, 0x8e, 0xec, 0xe9, 0x92, 0xb8, 0xdc, 0x5b, 0xfc, 0x79,
+	0x12, 0x66, 0x56, 0x98, 0x67, 0x11, 0xcd, 0x46, 0x19, 0x29, 0xc4, 0xb7, 0x84, 0x0e, 0xba, 0x36,
+	0xb0, 0x15, 0xfa, 0xd4, 0xbd, 0x0f, 0x2e, 0x3f, 0x29, 0xf9, 0xdf, 0x3e, 0x52, 0x65, 0x0d, 0xea,
+	0x89, 0x6e, 0x43, 0x9e, 0xe5, 0x74, 0x06, 0xf7, 0x76, 0xff, 0x4d, 0x4b, 0x34, 0xe7, 0xda, 0xa6,
+	0x64, 0x9a, 0x35, 0x44, 0xb7, 0x21, 0xef, 0x90, 0xfd, 0x2e, 0xdc, 0xe4, 0xa3, 0xe1, 0x3a, 0x64,
+	0x3f, 0x86, 0x3b, 0xcd, 0x3e, 0xa1, 0xf0, 0x64, 0x3f, 0xc5, 0x93, 0x4f, 0x39, 0x42, 0x5f, 0x86,
+	0x14, 0x4f, 0x81, 0xce, 0x9c, 0x36, 0xb3, 0xe7, 0xdb, 0xd0, 0x4b, 0x90, 0xdc, 0x21, 0x22, 0xa7,
+	0x38, 0xa9, 0x37, 0x63, 0x1b, 0x62, 0x89, 0xd9, 0xef, 0x14, 0x98, 0xe2, 0x46, 0x62, 0x7d, 0x87,
+	0xf0, 0x0e, 0xa8, 0x46, 0x0c, 0xd7, 0x33, 0xd1, 0x04, 0x24, 0x2c, 0x93, 0x2b, 0x28, 0xa5, 0x25,
+	0x2c, 0x13, 0x95, 0xe0, 0x8c, 0xbb, 0xef, 0x10, 0xef, 0xd8, 0x34, 0x55, 0x90, 0xf1, 0xa4, 0xcc,
+	0x35, 0xdb, 0x36, 0xd1, 0xb1, 0x21, 0xb2, 0x6e, 0xd1, 0x73, 0xcf, 0x89, 0xd9, 0x65, 0x31, 0x89,
+	0x5e, 0x81, 0x4c, 0x18, 0x3c, 0xd5, 0xd4, 0x49, 0xcd, 0x21, 0xda, 0x13, 0xbb, 0xc9, 0x9f, 0xcf,
+	0x40, 0xb6, 0x26, 0x22, 0x50, 0xdf, 0x1b, 0xac, 0x0f, 0x2e, 0xfb, 0x4f, 0x70, 0x64, 0x6f, 0xfd,
+	0xff, 0xff, 0x30, 0x69, 0x39, 0x3b, 0x1e, 0x36, 0xe2, 0xa5, 0x5c, 0x92, 0x6b, 0xb7, 0x10, 0x2d,
+	0xc8, 0x62, 0xee, 0x31, 0xe9, 0xdf, 0x84, 0x71, 0x11, 0xbb, 0x77, 0xb0, 0xc1, 0x24, 0x98, 0x1e,
+	0x56, 0xca, 0x91, 0xe5, 0xb0, 0x6b, 0x1c, 0x15, 0xdd, 0x80, 0x74, 0xbd, 0xed, 0x39, 0xc4, 0x54,
+	0x47, 0x4f, 0x8d, 0xdf, 0x13, 0x6d, 0xe4, 0x77, 0x3d, 0x01, 0xc8, 0xbe, 0xd7, 0x88, 0xb4, 0x83,
+	0x98, 0x3c, 0x65, 0x1c, 0xd3, 0xc2, 0x31, 0xab, 0x94, 0xc3, 0x94, 0x44, 0x0a, 0x2f, 0x23, 0x2a,
+	0xe5, 0x60, 0x5a, 0x0a, 0xd7, 0x86, 0x48, 0x3b, 0xba, 0xe4, 0x14, 0x86, 0xc5, 0x69, 0x3e, 0x84,
+	0x2e, 0x0b, 0x96, 0xed, 0x3e, 0x5f, 0x22, 0xb2, 0xc3, 0x92, 0xfb, 0xd1, 0xcf, 0x11, 0x32, 0x8c,
+	0xfd, 0x22, 0x01, 0x93, 0xdc, 0xb6, 0x89, 0x19, 0xeb, 0x87, 0x3d, 0x0b, 0xf9, 0x20, 0x73, 0x63,
+	0x16, 0xaf, 0x87, 0xe6, 0x9e, 0xa3, 0xd1, 0x3b, 0xa8, 0x9a, 0xfd, 0x2b, 0xd7, 0xc4, 0xa9, 0x2b,
+	0xd7, 0xbe, 0x0f, 0x28, 0xf9, 0xe8, 0x0f, 0xe8, 0x06, 0xa4, 0x71, 0x93, 0xbb, 0x86, 0xd4, 0xd0,
+	0xcc, 0x4a, 0x00, 0x4a, 0xa9, 0xfd, 0x2a, 0x01, 0xe7, 0x7b, 0xa4, 0x26, 0x3f, 0xf3, 0x7c, 0xf6,
+	0x65, 0x27, 0x4d, 0x2f, 0x35, 0x2c, 0xd3, 0x0b, 0xfa, 0xb0, 0x42, 0x76, 0xdf, 0x4b, 0xc2, 0x39,
+	0x29, 0xbb, 0xb0, 0x07, 0x29, 0x92, 0xcf, 0xcf, 0xb8, 0xe4, 0xfa, 0xf4, 0xdf, 0x52, 0x7d, 0xfb,
+	0x6f, 0x27, 0xe8, 0x33, 0x45, 0x16, 0x9c, 0x7e, 0x2c, 0x16, 0x7c, 0xe9, 0x97, 0x0a, 0x40, 0xf4,
+	0x89, 0x15, 0xbd, 0x00, 0xe7, 0xcb, 0xd7, 0xd7, 0x2b, 0x7a, 0x6d, 0x73, 0x79, 0x73, 0xab, 0xa6,
+	0x6f, 0xad, 0xd7, 0x36, 0x56, 0x57, 0xaa, 0x6b, 0xd5, 0xd5, 0x4a, 0x61, 0x64, 0x36, 0x7f, 0xeb,
+	0xf6, 0x42, 0x76, 0xcb, 0xa1, 0x2d, 0x62, 0x58, 0x3b, 0x16, 0x31, 0xd1, 0xb3, 0x70, 0xb6, 0x9b,
+	0x9a, 0x8d, 0x56, 0x2b, 0x05, 0x65, 0x76, 0xfc, 0xd6, 0xed, 0x85, 0x31, 0xa1, 0x56, 0x62, 0xa2,
+	0x8b, 0x70, 0xae, 0x97, 0xae, 0xba, 0xfe, 0x6a, 0x21, 0x31, 0x9b, 0xbb, 0x75, 0x7b, 0x21, 0x13,
+	0xea, 0x1f, 0x15, 0x01, 0xc5, 0x29, 0x25, 0x5e, 0x72, 0x16, 0x6e, 0xdd, 0x5e, 0x48, 0x8b, 0x4c,
+	0x77, 0x36, 0xf5, 0xce, 0x4f, 0xe7, 0x46, 0x2e, 0x7d, 0x0b, 0xa0, 0x1a, 0xc6, 0x40, 0x34, 0x0b,
+	0xd3, 0xd5, 0xf5, 0x35, 0x6d, 0x79, 0x65, 0xb3, 0x7a, 0x7d, 0xbd, 0x9b, 0xed, 0x23, 0x6b, 0x95,
+	0xeb, 0x5b, 0xe5, 0x6b, 0xab, 0x7a, 0xad, 0xfa, 0xea, 0x7a, 0x41, 0x41, 0xe7, 0x61, 0xaa, 0x6b,
+	0xed, 0x8d, 0xf5, 0xcd, 0xea, 0xeb, 0xab, 0x85, 0x44, 0x79, 0xed, 0xa3, 0xfb, 0x73, 0xca, 0xdd,
+	0xfb, 0x73, 0xca, 0xdf, 0xee, 0xcf, 0x29, 0xef, 0x3e, 0x98, 0x1b, 0xb9, 0xfb, 0x60, 0x6e, 0xe4,
+	0x4f, 0x0f, 0xe6, 0x46, 0xbe, 0xf1, 0xc2, 0x43, 0x45, 0x1f, 0x15, 0x9e, 0x5c, 0x09, 0xf5, 0x34,
+	0x0f, 0xb4, 0x5f, 0xfc, 0xcf, 0x00, 0x8f, 0x3a, 0x67, 0x68, 0x2e, 0x24, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1403,690 +1939,724 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10925 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x69, 0x90, 0x1c, 0xd7,
-		0x79, 0xd8, 0xce, 0xb1, 0x73, 0x7c, 0x73, 0xf5, 0xbe, 0x5d, 0x00, 0x8b, 0x01, 0xb9, 0xbb, 0x6c,
-		0x1e, 0x38, 0x48, 0x2e, 0x48, 0x90, 0x00, 0xc9, 0x85, 0x28, 0x7a, 0x66, 0x76, 0xb0, 0x18, 0x60,
-		0x2f, 0xf5, 0xcc, 0x82, 0x87, 0x6d, 0xb5, 0x7b, 0x7b, 0xde, 0xee, 0x36, 0x31, 0xd3, 0x3d, 0x9a,
-		0xee, 0x01, 0x76, 0x59, 0xa9, 0x14, 0x6d, 0xd9, 0x8e, 0x8c, 0x38, 0xb6, 0x1c, 0xa7, 0x6c, 0xf9,
-		0x80, 0x43, 0xd9, 0xb1, 0x25, 0x3b, 0x97, 0xaf, 0x58, 0x76, 0x54, 0x76, 0xd9, 0xa9, 0x1c, 0xb2,
-		0x93, 0x4a, 0xc9, 0xae, 0x54, 0xe2, 0x4a, 0x25, 0x8c, 0x4d, 0xb9, 0x2c, 0x45, 0x92, 0x63, 0x5b,
-		0xa1, 0x93, 0x38, 0xaa, 0x1c, 0xf5, 0xae, 0x3e, 0xe6, 0xd8, 0x9e, 0x85, 0x48, 0x46, 0x29, 0xfd,
-		0x01, 0xe6, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0xef, 0x7b, 0xdf, 0xfb, 0xae, 0xf7, 0xfa, 0x2d, 0xfc,
-		0x61, 0x19, 0x16, 0x76, 0x2d, 0x6b, 0xb7, 0x85, 0xcf, 0x77, 0xba, 0x96, 0x63, 0x6d, 0xf7, 0x76,
-		0xce, 0x37, 0xb1, 0xad, 0x77, 0x8d, 0x8e, 0x63, 0x75, 0x17, 0x69, 0x1d, 0x2a, 0x30, 0x88, 0x45,
-		0x01, 0x21, 0xaf, 0xc1, 0xd4, 0x15, 0xa3, 0x85, 0x97, 0x5d, 0xc0, 0x3a, 0x76, 0xd0, 0xb3, 0x10,
-		0xdf, 0x31, 0x5a, 0x78, 0x36, 0xb2, 0x10, 0x3b, 0x93, 0xb9, 0xf0, 0xd0, 0x62, 0x1f, 0xd2, 0x62,
-		0x10, 0x63, 0x93, 0x54, 0x2b, 0x14, 0x43, 0xfe, 0xdf, 0x71, 0x98, 0x1e, 0xd2, 0x8a, 0x10, 0xc4,
-		0x4d, 0xad, 0x4d, 0x28, 0x46, 0xce, 0xa4, 0x15, 0xfa, 0x1b, 0xcd, 0x42, 0xb2, 0xa3, 0xe9, 0x37,
-		0xb5, 0x5d, 0x3c, 0x1b, 0xa5, 0xd5, 0xa2, 0x88, 0xe6, 0x00, 0x9a, 0xb8, 0x83, 0xcd, 0x26, 0x36,
-		0xf5, 0x83, 0xd9, 0xd8, 0x42, 0xec, 0x4c, 0x5a, 0xf1, 0xd5, 0xa0, 0x47, 0x61, 0xaa, 0xd3, 0xdb,
-		0x6e, 0x19, 0xba, 0xea, 0x03, 0x83, 0x85, 0xd8, 0x99, 0x49, 0x45, 0x62, 0x0d, 0xcb, 0x1e, 0xf0,
-		0x69, 0x28, 0xdc, 0xc6, 0xda, 0x4d, 0x3f, 0x68, 0x86, 0x82, 0xe6, 0x49, 0xb5, 0x0f, 0xb0, 0x02,
-		0xd9, 0x36, 0xb6, 0x6d, 0x6d, 0x17, 0xab, 0xce, 0x41, 0x07, 0xcf, 0xc6, 0xe9, 0xec, 0x17, 0x06,
-		0x66, 0xdf, 0x3f, 0xf3, 0x0c, 0xc7, 0x6a, 0x1c, 0x74, 0x30, 0x2a, 0x41, 0x1a, 0x9b, 0xbd, 0x36,
-		0xa3, 0x30, 0x39, 0x82, 0x7f, 0x55, 0xb3, 0xd7, 0xee, 0xa7, 0x92, 0x22, 0x68, 0x9c, 0x44, 0xd2,
-		0xc6, 0xdd, 0x5b, 0x86, 0x8e, 0x67, 0x13, 0x94, 0xc0, 0xe9, 0x01, 0x02, 0x75, 0xd6, 0xde, 0x4f,
-		0x43, 0xe0, 0xa1, 0x0a, 0xa4, 0xf1, 0xbe, 0x83, 0x4d, 0xdb, 0xb0, 0xcc, 0xd9, 0x24, 0x25, 0xf2,
-		0xf0, 0x90, 0x55, 0xc4, 0xad, 0x66, 0x3f, 0x09, 0x0f, 0x0f, 0x5d, 0x82, 0xa4, 0xd5, 0x71, 0x0c,
-		0xcb, 0xb4, 0x67, 0x53, 0x0b, 0x91, 0x33, 0x99, 0x0b, 0xf7, 0x0d, 0x15, 0x84, 0x0d, 0x06, 0xa3,
-		0x08, 0x60, 0x54, 0x03, 0xc9, 0xb6, 0x7a, 0x5d, 0x1d, 0xab, 0xba, 0xd5, 0xc4, 0xaa, 0x61, 0xee,
-		0x58, 0xb3, 0x69, 0x4a, 0x60, 0x7e, 0x70, 0x22, 0x14, 0xb0, 0x62, 0x35, 0x71, 0xcd, 0xdc, 0xb1,
-		0x94, 0xbc, 0x1d, 0x28, 0xa3, 0xe3, 0x90, 0xb0, 0x0f, 0x4c, 0x47, 0xdb, 0x9f, 0xcd, 0x52, 0x09,
-		0xe1, 0x25, 0x22, 0x3a, 0xb8, 0x69, 0x90, 0xee, 0x66, 0x73, 0x4c, 0x74, 0x78, 0x51, 0xfe, 0xb5,
-		0x04, 0x14, 0xc6, 0x11, 0xbe, 0xcb, 0x30, 0xb9, 0x43, 0xe6, 0x3f, 0x1b, 0x3d, 0x0a, 0x77, 0x18,
-		0x4e, 0x90, 0xbd, 0x89, 0x7b, 0x64, 0x6f, 0x09, 0x32, 0x26, 0xb6, 0x1d, 0xdc, 0x64, 0xb2, 0x12,
-		0x1b, 0x53, 0xda, 0x80, 0x21, 0x0d, 0x0a, 0x5b, 0xfc, 0x9e, 0x84, 0xed, 0x25, 0x28, 0xb8, 0x43,
-		0x52, 0xbb, 0x9a, 0xb9, 0x2b, 0xa4, 0xf6, 0x7c, 0xd8, 0x48, 0x16, 0xab, 0x02, 0x4f, 0x21, 0x68,
-		0x4a, 0x1e, 0x07, 0xca, 0x68, 0x19, 0xc0, 0x32, 0xb1, 0xb5, 0xa3, 0x36, 0xb1, 0xde, 0x9a, 0x4d,
-		0x8d, 0xe0, 0xd2, 0x06, 0x01, 0x19, 0xe0, 0x92, 0xc5, 0x6a, 0xf5, 0x16, 0x7a, 0xce, 0x13, 0xc2,
-		0xe4, 0x08, 0x19, 0x5a, 0x63, 0xdb, 0x6f, 0x40, 0x0e, 0xb7, 0x20, 0xdf, 0xc5, 0x64, 0x47, 0xe0,
-		0x26, 0x9f, 0x59, 0x9a, 0x0e, 0x62, 0x31, 0x74, 0x66, 0x0a, 0x47, 0x63, 0x13, 0xcb, 0x75, 0xfd,
-		0x45, 0xf4, 0x20, 0xb8, 0x15, 0x2a, 0x15, 0x2b, 0xa0, 0xfa, 0x29, 0x2b, 0x2a, 0xd7, 0xb5, 0x36,
-		0x2e, 0xbe, 0x06, 0xf9, 0x20, 0x7b, 0xd0, 0x0c, 0x4c, 0xda, 0x8e, 0xd6, 0x75, 0xa8, 0x14, 0x4e,
-		0x2a, 0xac, 0x80, 0x24, 0x88, 0x61, 0xb3, 0x49, 0xf5, 0xdf, 0xa4, 0x42, 0x7e, 0xa2, 0x6f, 0xf2,
-		0x26, 0x1c, 0xa3, 0x13, 0x7e, 0x64, 0x70, 0x45, 0x03, 0x94, 0xfb, 0xe7, 0x5d, 0x7c, 0x06, 0x72,
-		0x81, 0x09, 0x8c, 0xdb, 0xb5, 0xfc, 0x57, 0xe0, 0xd8, 0x50, 0xd2, 0xe8, 0x25, 0x98, 0xe9, 0x99,
-		0x86, 0xe9, 0xe0, 0x6e, 0xa7, 0x8b, 0x89, 0xc4, 0xb2, 0xae, 0x66, 0x3f, 0x9f, 0x1c, 0x21, 0x73,
-		0x5b, 0x7e, 0x68, 0x46, 0x45, 0x99, 0xee, 0x0d, 0x56, 0x9e, 0x4b, 0xa7, 0xbe, 0x90, 0x94, 0x5e,
-		0x7f, 0xfd, 0xf5, 0xd7, 0xa3, 0xf2, 0x6f, 0x25, 0x60, 0x66, 0xd8, 0x9e, 0x19, 0xba, 0x7d, 0x8f,
-		0x43, 0xc2, 0xec, 0xb5, 0xb7, 0x71, 0x97, 0x32, 0x69, 0x52, 0xe1, 0x25, 0x54, 0x82, 0xc9, 0x96,
-		0xb6, 0x8d, 0x5b, 0xb3, 0xf1, 0x85, 0xc8, 0x99, 0xfc, 0x85, 0x47, 0xc7, 0xda, 0x95, 0x8b, 0xab,
-		0x04, 0x45, 0x61, 0x98, 0xe8, 0xfd, 0x10, 0xe7, 0xca, 0x9b, 0x50, 0x38, 0x37, 0x1e, 0x05, 0xb2,
-		0x97, 0x14, 0x8a, 0x87, 0x4e, 0x41, 0x9a, 0xfc, 0xcf, 0x64, 0x23, 0x41, 0xc7, 0x9c, 0x22, 0x15,
-		0x44, 0x2e, 0x50, 0x11, 0x52, 0x74, 0x9b, 0x34, 0xb1, 0x30, 0x7a, 0x6e, 0x99, 0x08, 0x56, 0x13,
-		0xef, 0x68, 0xbd, 0x96, 0xa3, 0xde, 0xd2, 0x5a, 0x3d, 0x4c, 0x05, 0x3e, 0xad, 0x64, 0x79, 0xe5,
-		0x0d, 0x52, 0x87, 0xe6, 0x21, 0xc3, 0x76, 0x95, 0x61, 0x36, 0xf1, 0x3e, 0xd5, 0xab, 0x93, 0x0a,
-		0xdb, 0x68, 0x35, 0x52, 0x43, 0xba, 0x7f, 0xd5, 0xb6, 0x4c, 0x21, 0x9a, 0xb4, 0x0b, 0x52, 0x41,
-		0xbb, 0x7f, 0xa6, 0x5f, 0xa5, 0xdf, 0x3f, 0x7c, 0x7a, 0x03, 0x7b, 0xe9, 0x34, 0x14, 0x28, 0xc4,
-		0x53, 0x7c, 0xe9, 0xb5, 0xd6, 0xec, 0xd4, 0x42, 0xe4, 0x4c, 0x4a, 0xc9, 0xb3, 0xea, 0x0d, 0x5e,
-		0x2b, 0xff, 0x4a, 0x14, 0xe2, 0x54, 0xb1, 0x14, 0x20, 0xd3, 0x78, 0x79, 0xb3, 0xaa, 0x2e, 0x6f,
-		0x6c, 0x95, 0x57, 0xab, 0x52, 0x04, 0xe5, 0x01, 0x68, 0xc5, 0x95, 0xd5, 0x8d, 0x52, 0x43, 0x8a,
-		0xba, 0xe5, 0xda, 0x7a, 0xe3, 0xd2, 0xd3, 0x52, 0xcc, 0x45, 0xd8, 0x62, 0x15, 0x71, 0x3f, 0xc0,
-		0x53, 0x17, 0xa4, 0x49, 0x24, 0x41, 0x96, 0x11, 0xa8, 0xbd, 0x54, 0x5d, 0xbe, 0xf4, 0xb4, 0x94,
-		0x08, 0xd6, 0x3c, 0x75, 0x41, 0x4a, 0xa2, 0x1c, 0xa4, 0x69, 0x4d, 0x79, 0x63, 0x63, 0x55, 0x4a,
-		0xb9, 0x34, 0xeb, 0x0d, 0xa5, 0xb6, 0xbe, 0x22, 0xa5, 0x5d, 0x9a, 0x2b, 0xca, 0xc6, 0xd6, 0xa6,
-		0x04, 0x2e, 0x85, 0xb5, 0x6a, 0xbd, 0x5e, 0x5a, 0xa9, 0x4a, 0x19, 0x17, 0xa2, 0xfc, 0x72, 0xa3,
-		0x5a, 0x97, 0xb2, 0x81, 0x61, 0x3d, 0x75, 0x41, 0xca, 0xb9, 0x5d, 0x54, 0xd7, 0xb7, 0xd6, 0xa4,
-		0x3c, 0x9a, 0x82, 0x1c, 0xeb, 0x42, 0x0c, 0xa2, 0xd0, 0x57, 0x75, 0xe9, 0x69, 0x49, 0xf2, 0x06,
-		0xc2, 0xa8, 0x4c, 0x05, 0x2a, 0x2e, 0x3d, 0x2d, 0x21, 0xb9, 0x02, 0x93, 0x54, 0x0c, 0x11, 0x82,
-		0xfc, 0x6a, 0xa9, 0x5c, 0x5d, 0x55, 0x37, 0x36, 0x1b, 0xb5, 0x8d, 0xf5, 0xd2, 0xaa, 0x14, 0xf1,
-		0xea, 0x94, 0xea, 0x07, 0xb6, 0x6a, 0x4a, 0x75, 0x59, 0x8a, 0xfa, 0xeb, 0x36, 0xab, 0xa5, 0x46,
-		0x75, 0x59, 0x8a, 0xc9, 0x3a, 0xcc, 0x0c, 0x53, 0xa8, 0x43, 0xb7, 0x90, 0x4f, 0x16, 0xa2, 0x23,
-		0x64, 0x81, 0xd2, 0xea, 0x97, 0x05, 0xf9, 0x73, 0x51, 0x98, 0x1e, 0x62, 0x54, 0x86, 0x76, 0xf2,
-		0x02, 0x4c, 0x32, 0x59, 0x66, 0x66, 0xf6, 0xec, 0x50, 0xeb, 0x44, 0x25, 0x7b, 0xc0, 0xd4, 0x52,
-		0x3c, 0xbf, 0x13, 0x12, 0x1b, 0xe1, 0x84, 0x10, 0x12, 0x03, 0x02, 0xfb, 0xad, 0x03, 0xca, 0x9f,
-		0xd9, 0xc7, 0x4b, 0xe3, 0xd8, 0x47, 0x5a, 0x77, 0x34, 0x23, 0x30, 0x39, 0xc4, 0x08, 0x5c, 0x86,
-		0xa9, 0x01, 0x42, 0x63, 0x2b, 0xe3, 0x0f, 0x47, 0x60, 0x76, 0x14, 0x73, 0x42, 0x54, 0x62, 0x34,
-		0xa0, 0x12, 0x2f, 0xf7, 0x73, 0xf0, 0x81, 0xd1, 0x8b, 0x30, 0xb0, 0xd6, 0x9f, 0x88, 0xc0, 0xf1,
-		0xe1, 0xce, 0xe6, 0xd0, 0x31, 0xbc, 0x1f, 0x12, 0x6d, 0xec, 0xec, 0x59, 0xc2, 0xad, 0x7a, 0x64,
-		0x88, 0xb1, 0x26, 0xcd, 0xfd, 0x8b, 0xcd, 0xb1, 0xfc, 0xd6, 0x3e, 0x36, 0xca, 0x63, 0x64, 0xa3,
-		0x19, 0x18, 0xe9, 0xf7, 0x44, 0xe1, 0xd8, 0x50, 0xe2, 0x43, 0x07, 0x7a, 0x3f, 0x80, 0x61, 0x76,
-		0x7a, 0x0e, 0x73, 0x9d, 0x98, 0x26, 0x4e, 0xd3, 0x1a, 0xaa, 0xbc, 0x88, 0x96, 0xed, 0x39, 0x6e,
-		0x7b, 0x8c, 0xb6, 0x03, 0xab, 0xa2, 0x00, 0xcf, 0x7a, 0x03, 0x8d, 0xd3, 0x81, 0xce, 0x8d, 0x98,
-		0xe9, 0x80, 0x60, 0x3e, 0x01, 0x92, 0xde, 0x32, 0xb0, 0xe9, 0xa8, 0xb6, 0xd3, 0xc5, 0x5a, 0xdb,
-		0x30, 0x77, 0xa9, 0xa9, 0x49, 0x2d, 0x4d, 0xee, 0x68, 0x2d, 0x1b, 0x2b, 0x05, 0xd6, 0x5c, 0x17,
-		0xad, 0x04, 0x83, 0x0a, 0x50, 0xd7, 0x87, 0x91, 0x08, 0x60, 0xb0, 0x66, 0x17, 0x43, 0xfe, 0x81,
-		0x34, 0x64, 0x7c, 0xae, 0x39, 0x7a, 0x00, 0xb2, 0xaf, 0x6a, 0xb7, 0x34, 0x55, 0x84, 0x5b, 0x8c,
-		0x13, 0x19, 0x52, 0xb7, 0xc9, 0x43, 0xae, 0x27, 0x60, 0x86, 0x82, 0x58, 0x3d, 0x07, 0x77, 0x55,
-		0xbd, 0xa5, 0xd9, 0x36, 0x65, 0x5a, 0x8a, 0x82, 0x22, 0xd2, 0xb6, 0x41, 0x9a, 0x2a, 0xa2, 0x05,
-		0x5d, 0x84, 0x69, 0x8a, 0xd1, 0xee, 0xb5, 0x1c, 0xa3, 0xd3, 0xc2, 0x2a, 0x09, 0x00, 0x6d, 0x6a,
-		0x72, 0xdc, 0x91, 0x4d, 0x11, 0x88, 0x35, 0x0e, 0x40, 0x46, 0x64, 0xa3, 0x65, 0xb8, 0x9f, 0xa2,
-		0xed, 0x62, 0x13, 0x77, 0x35, 0x07, 0xab, 0xf8, 0x43, 0x3d, 0xad, 0x65, 0xab, 0x9a, 0xd9, 0x54,
-		0xf7, 0x34, 0x7b, 0x6f, 0x76, 0x86, 0x10, 0x28, 0x47, 0x67, 0x23, 0xca, 0x49, 0x02, 0xb8, 0xc2,
-		0xe1, 0xaa, 0x14, 0xac, 0x64, 0x36, 0xaf, 0x6a, 0xf6, 0x1e, 0x5a, 0x82, 0xe3, 0x94, 0x8a, 0xed,
-		0x74, 0x0d, 0x73, 0x57, 0xd5, 0xf7, 0xb0, 0x7e, 0x53, 0xed, 0x39, 0x3b, 0xcf, 0xce, 0x9e, 0xf2,
-		0xf7, 0x4f, 0x47, 0x58, 0xa7, 0x30, 0x15, 0x02, 0xb2, 0xe5, 0xec, 0x3c, 0x8b, 0xea, 0x90, 0x25,
-		0x8b, 0xd1, 0x36, 0x5e, 0xc3, 0xea, 0x8e, 0xd5, 0xa5, 0x36, 0x34, 0x3f, 0x44, 0x35, 0xf9, 0x38,
-		0xb8, 0xb8, 0xc1, 0x11, 0xd6, 0xac, 0x26, 0x5e, 0x9a, 0xac, 0x6f, 0x56, 0xab, 0xcb, 0x4a, 0x46,
-		0x50, 0xb9, 0x62, 0x75, 0x89, 0x40, 0xed, 0x5a, 0x2e, 0x83, 0x33, 0x4c, 0xa0, 0x76, 0x2d, 0xc1,
-		0xde, 0x8b, 0x30, 0xad, 0xeb, 0x6c, 0xce, 0x86, 0xae, 0xf2, 0x30, 0xcd, 0x9e, 0x95, 0x02, 0xcc,
-		0xd2, 0xf5, 0x15, 0x06, 0xc0, 0x65, 0xdc, 0x46, 0xcf, 0xc1, 0x31, 0x8f, 0x59, 0x7e, 0xc4, 0xa9,
-		0x81, 0x59, 0xf6, 0xa3, 0x5e, 0x84, 0xe9, 0xce, 0xc1, 0x20, 0x22, 0x0a, 0xf4, 0xd8, 0x39, 0xe8,
-		0x47, 0x7b, 0x06, 0x66, 0x3a, 0x7b, 0x9d, 0x41, 0xbc, 0x73, 0x7e, 0x3c, 0xd4, 0xd9, 0xeb, 0xf4,
-		0x23, 0x3e, 0x4c, 0x63, 0xf6, 0x2e, 0xd6, 0x35, 0x07, 0x37, 0x67, 0x4f, 0xf8, 0xc1, 0x7d, 0x0d,
-		0x68, 0x11, 0x24, 0x5d, 0x57, 0xb1, 0xa9, 0x6d, 0xb7, 0xb0, 0xaa, 0x75, 0xb1, 0xa9, 0xd9, 0xb3,
-		0xf3, 0x14, 0x38, 0xee, 0x74, 0x7b, 0x58, 0xc9, 0xeb, 0x7a, 0x95, 0x36, 0x96, 0x68, 0x1b, 0x3a,
-		0x07, 0x53, 0xd6, 0xf6, 0xab, 0x3a, 0x93, 0x48, 0xb5, 0xd3, 0xc5, 0x3b, 0xc6, 0xfe, 0xec, 0x43,
-		0x94, 0xbd, 0x05, 0xd2, 0x40, 0xe5, 0x71, 0x93, 0x56, 0xa3, 0xb3, 0x20, 0xe9, 0xf6, 0x9e, 0xd6,
-		0xed, 0x50, 0x95, 0x6c, 0x77, 0x34, 0x1d, 0xcf, 0x3e, 0xcc, 0x40, 0x59, 0xfd, 0xba, 0xa8, 0x26,
-		0x3b, 0xc2, 0xbe, 0x6d, 0xec, 0x38, 0x82, 0xe2, 0x69, 0xb6, 0x23, 0x68, 0x1d, 0xa7, 0x76, 0x06,
-		0x24, 0xc2, 0x89, 0x40, 0xc7, 0x67, 0x28, 0x58, 0xbe, 0xb3, 0xd7, 0xf1, 0xf7, 0xfb, 0x20, 0xe4,
-		0x08, 0xa4, 0xd7, 0xe9, 0x59, 0xe6, 0xb8, 0x75, 0xf6, 0x7c, 0x3d, 0x3e, 0x0d, 0xc7, 0x09, 0x50,
-		0x1b, 0x3b, 0x5a, 0x53, 0x73, 0x34, 0x1f, 0xf4, 0x63, 0x14, 0x9a, 0xb0, 0x7d, 0x8d, 0x37, 0x06,
-		0xc6, 0xd9, 0xed, 0x6d, 0x1f, 0xb8, 0x82, 0xf5, 0x38, 0x1b, 0x27, 0xa9, 0x13, 0xa2, 0xf5, 0xae,
-		0x39, 0xe7, 0xf2, 0x12, 0x64, 0xfd, 0x72, 0x8f, 0xd2, 0xc0, 0x24, 0x5f, 0x8a, 0x10, 0x27, 0xa8,
-		0xb2, 0xb1, 0x4c, 0xdc, 0x97, 0x57, 0xaa, 0x52, 0x94, 0xb8, 0x51, 0xab, 0xb5, 0x46, 0x55, 0x55,
-		0xb6, 0xd6, 0x1b, 0xb5, 0xb5, 0xaa, 0x14, 0xf3, 0x39, 0xf6, 0xd7, 0xe2, 0xa9, 0x47, 0xa4, 0xd3,
-		0xf2, 0xa7, 0x63, 0x90, 0x0f, 0x46, 0x6a, 0xe8, 0x7d, 0x70, 0x42, 0x24, 0x5c, 0x6c, 0xec, 0xa8,
-		0xb7, 0x8d, 0x2e, 0xdd, 0x90, 0x6d, 0x8d, 0x19, 0x47, 0x57, 0x7e, 0x66, 0x38, 0x54, 0x1d, 0x3b,
-		0x2f, 0x1a, 0x5d, 0xb2, 0xdd, 0xda, 0x9a, 0x83, 0x56, 0x61, 0xde, 0xb4, 0x54, 0xdb, 0xd1, 0xcc,
-		0xa6, 0xd6, 0x6d, 0xaa, 0x5e, 0xaa, 0x4b, 0xd5, 0x74, 0x1d, 0xdb, 0xb6, 0xc5, 0x0c, 0xa1, 0x4b,
-		0xe5, 0x3e, 0xd3, 0xaa, 0x73, 0x60, 0xcf, 0x42, 0x94, 0x38, 0x68, 0x9f, 0xf8, 0xc6, 0x46, 0x89,
-		0xef, 0x29, 0x48, 0xb7, 0xb5, 0x8e, 0x8a, 0x4d, 0xa7, 0x7b, 0x40, 0xfd, 0xf3, 0x94, 0x92, 0x6a,
-		0x6b, 0x9d, 0x2a, 0x29, 0xa3, 0x1b, 0xf0, 0x88, 0x07, 0xaa, 0xb6, 0xf0, 0xae, 0xa6, 0x1f, 0xa8,
-		0xd4, 0x19, 0xa7, 0x69, 0x03, 0x55, 0xb7, 0xcc, 0x9d, 0x96, 0xa1, 0x3b, 0x36, 0xd5, 0x0f, 0x4c,
-		0xc7, 0xc9, 0x1e, 0xc6, 0x2a, 0x45, 0xb8, 0x66, 0x5b, 0x26, 0xf5, 0xc1, 0x2b, 0x02, 0xfa, 0x3d,
-		0x09, 0xbf, 0xae, 0xc5, 0x53, 0x71, 0x69, 0xf2, 0x5a, 0x3c, 0x35, 0x29, 0x25, 0xae, 0xc5, 0x53,
-		0x09, 0x29, 0x79, 0x2d, 0x9e, 0x4a, 0x49, 0xe9, 0x6b, 0xf1, 0x54, 0x5a, 0x02, 0xf9, 0x53, 0x29,
-		0xc8, 0xfa, 0x23, 0x03, 0x12, 0x68, 0xe9, 0xd4, 0x36, 0x46, 0xa8, 0xf6, 0x7c, 0xf0, 0xd0, 0x38,
-		0x62, 0xb1, 0x42, 0x8c, 0xe6, 0x52, 0x82, 0xb9, 0xe1, 0x0a, 0xc3, 0x24, 0x0e, 0x0b, 0x11, 0x6b,
-		0xcc, 0xdc, 0x9e, 0x94, 0xc2, 0x4b, 0x68, 0x05, 0x12, 0xaf, 0xda, 0x94, 0x76, 0x82, 0xd2, 0x7e,
-		0xe8, 0x70, 0xda, 0xd7, 0xea, 0x94, 0x78, 0xfa, 0x5a, 0x5d, 0x5d, 0xdf, 0x50, 0xd6, 0x4a, 0xab,
-		0x0a, 0x47, 0x47, 0x27, 0x21, 0xde, 0xd2, 0x5e, 0x3b, 0x08, 0x9a, 0x57, 0x5a, 0x85, 0x16, 0xa1,
-		0xd0, 0x33, 0x6f, 0xe1, 0xae, 0xb1, 0x63, 0x90, 0xa5, 0x22, 0x50, 0x05, 0x3f, 0x54, 0xde, 0x6b,
-		0x5d, 0x25, 0xf0, 0x63, 0x8a, 0xc7, 0x49, 0x88, 0xdf, 0xc6, 0xda, 0xcd, 0xa0, 0x11, 0xa4, 0x55,
-		0xe8, 0x0c, 0x64, 0x9b, 0x78, 0xbb, 0xb7, 0xab, 0x76, 0x71, 0x53, 0xd3, 0x9d, 0xa0, 0xea, 0xcf,
-		0xd0, 0x26, 0x85, 0xb6, 0xa0, 0xeb, 0x90, 0x26, 0x6b, 0x64, 0xd2, 0x35, 0x9e, 0xa2, 0x2c, 0x78,
-		0xfc, 0x70, 0x16, 0xf0, 0x25, 0x16, 0x48, 0x8a, 0x87, 0x8f, 0xae, 0x40, 0xc2, 0xd1, 0xba, 0xbb,
-		0xd8, 0xa1, 0x9a, 0x3f, 0x3f, 0x24, 0xf9, 0x31, 0x84, 0x52, 0x83, 0x62, 0xd0, 0x98, 0x96, 0x63,
-		0xbf, 0x8b, 0x5a, 0xe6, 0x3c, 0x4c, 0x52, 0xf1, 0x40, 0x00, 0x5c, 0x40, 0xa4, 0x09, 0x94, 0x82,
-		0x78, 0x65, 0x43, 0x21, 0x9a, 0x46, 0x82, 0x2c, 0xab, 0x55, 0x37, 0x6b, 0xd5, 0x4a, 0x55, 0x8a,
-		0xca, 0x17, 0x21, 0xc1, 0xd6, 0x9c, 0x68, 0x21, 0x77, 0xd5, 0xa5, 0x09, 0x5e, 0xe4, 0x34, 0x22,
-		0xa2, 0x75, 0x6b, 0xad, 0x5c, 0x55, 0xa4, 0xa8, 0xbc, 0x05, 0x85, 0x3e, 0x3e, 0xa1, 0x63, 0x30,
-		0xa5, 0x54, 0x1b, 0xd5, 0x75, 0x12, 0x67, 0xa9, 0x5b, 0xeb, 0xd7, 0xd7, 0x37, 0x5e, 0x5c, 0x97,
-		0x26, 0x82, 0xd5, 0x42, 0xa5, 0x45, 0xd0, 0x0c, 0x48, 0x5e, 0x75, 0x7d, 0x63, 0x4b, 0xa1, 0xa3,
-		0xf9, 0xde, 0x28, 0x48, 0xfd, 0x5c, 0x43, 0x27, 0x60, 0xba, 0x51, 0x52, 0x56, 0xaa, 0x0d, 0x95,
-		0xc5, 0x8e, 0x2e, 0xe9, 0x19, 0x90, 0xfc, 0x0d, 0x57, 0x6a, 0x34, 0x34, 0x9e, 0x87, 0x53, 0xfe,
-		0xda, 0xea, 0x4b, 0x8d, 0xea, 0x7a, 0x9d, 0x76, 0x5e, 0x5a, 0x5f, 0x21, 0xfa, 0xb5, 0x8f, 0x9e,
-		0x88, 0x56, 0x63, 0x64, 0xa8, 0x41, 0x7a, 0xd5, 0xd5, 0x65, 0x29, 0xde, 0x5f, 0xbd, 0xb1, 0x5e,
-		0xdd, 0xb8, 0x22, 0x4d, 0xf6, 0xf7, 0x4e, 0x23, 0xd8, 0x04, 0x2a, 0xc2, 0xf1, 0xfe, 0x5a, 0xb5,
-		0xba, 0xde, 0x50, 0x5e, 0x96, 0x92, 0xfd, 0x1d, 0xd7, 0xab, 0xca, 0x8d, 0x5a, 0xa5, 0x2a, 0xa5,
-		0xd0, 0x71, 0x40, 0xc1, 0x11, 0x35, 0xae, 0x6e, 0x2c, 0x4b, 0xe9, 0x01, 0x8d, 0x22, 0xdb, 0x90,
-		0xf5, 0x87, 0x91, 0xef, 0x4d, 0x2e, 0xe9, 0x63, 0x51, 0xc8, 0xf8, 0xc2, 0x42, 0xe2, 0xcf, 0x6b,
-		0xad, 0x96, 0x75, 0x5b, 0xd5, 0x5a, 0x86, 0x66, 0x73, 0x7d, 0x03, 0xb4, 0xaa, 0x44, 0x6a, 0xc6,
-		0xdd, 0xdf, 0xe3, 0x6b, 0xf8, 0xc4, 0xd7, 0xa3, 0x86, 0x9f, 0x94, 0x12, 0xf2, 0x4f, 0x44, 0x40,
-		0xea, 0x8f, 0xf7, 0xfa, 0xa6, 0x1f, 0x19, 0x35, 0xfd, 0xf7, 0x64, 0xed, 0x7e, 0x3c, 0x02, 0xf9,
-		0x60, 0x90, 0xd7, 0x37, 0xbc, 0x07, 0xfe, 0x9f, 0x0e, 0xef, 0x0f, 0xa2, 0x90, 0x0b, 0x84, 0x76,
-		0xe3, 0x8e, 0xee, 0x43, 0x30, 0x65, 0x34, 0x71, 0xbb, 0x63, 0x39, 0xd8, 0xd4, 0x0f, 0xd4, 0x16,
-		0xbe, 0x85, 0x5b, 0xb3, 0x32, 0x55, 0xca, 0xe7, 0x0f, 0x0f, 0x1e, 0x17, 0x6b, 0x1e, 0xde, 0x2a,
-		0x41, 0x5b, 0x9a, 0xae, 0x2d, 0x57, 0xd7, 0x36, 0x37, 0x1a, 0xd5, 0xf5, 0xca, 0xcb, 0x42, 0xbb,
-		0x28, 0x92, 0xd1, 0x07, 0xf6, 0x2e, 0x2a, 0xed, 0x4d, 0x90, 0xfa, 0x07, 0x45, 0x74, 0xc5, 0x90,
-		0x61, 0x49, 0x13, 0x68, 0x1a, 0x0a, 0xeb, 0x1b, 0x6a, 0xbd, 0xb6, 0x5c, 0x55, 0xab, 0x57, 0xae,
-		0x54, 0x2b, 0x8d, 0x3a, 0x4b, 0x07, 0xba, 0xd0, 0x0d, 0x29, 0xea, 0x67, 0xf1, 0x8f, 0xc6, 0x60,
-		0x7a, 0xc8, 0x48, 0x50, 0x89, 0x07, 0xf2, 0x2c, 0xb7, 0xf0, 0xf8, 0x38, 0xa3, 0x5f, 0x24, 0xae,
-		0xf4, 0xa6, 0xd6, 0x75, 0x78, 0xdc, 0x7f, 0x16, 0x08, 0x97, 0x4c, 0x87, 0x58, 0xf6, 0x2e, 0x4f,
-		0xb3, 0xb2, 0xe8, 0xbe, 0xe0, 0xd5, 0xb3, 0x4c, 0xeb, 0x63, 0x80, 0x3a, 0x96, 0x6d, 0x38, 0xc6,
-		0x2d, 0xac, 0x1a, 0xa6, 0xc8, 0xc9, 0x92, 0x68, 0x3f, 0xae, 0x48, 0xa2, 0xa5, 0x66, 0x3a, 0x2e,
-		0xb4, 0x89, 0x77, 0xb5, 0x3e, 0x68, 0xe2, 0x79, 0xc4, 0x14, 0x49, 0xb4, 0xb8, 0xd0, 0x0f, 0x40,
-		0xb6, 0x69, 0xf5, 0x48, 0x08, 0xc4, 0xe0, 0x88, 0xb6, 0x88, 0x28, 0x19, 0x56, 0xe7, 0x82, 0xf0,
-		0xe0, 0xd6, 0x4b, 0x06, 0x67, 0x95, 0x0c, 0xab, 0x63, 0x20, 0xa7, 0xa1, 0xa0, 0xed, 0xee, 0x76,
-		0x09, 0x71, 0x41, 0x88, 0x85, 0xeb, 0x79, 0xb7, 0x9a, 0x02, 0x16, 0xaf, 0x41, 0x4a, 0xf0, 0x81,
-		0x78, 0xb0, 0x84, 0x13, 0x6a, 0x87, 0xe5, 0xa0, 0xa2, 0x67, 0xd2, 0x4a, 0xca, 0x14, 0x8d, 0x0f,
-		0x40, 0xd6, 0xb0, 0x55, 0xef, 0x6c, 0x2b, 0xba, 0x10, 0x3d, 0x93, 0x52, 0x32, 0x86, 0xed, 0x9e,
-		0x0b, 0xc8, 0x9f, 0x88, 0x42, 0x3e, 0x78, 0x6a, 0x87, 0x96, 0x21, 0xd5, 0xb2, 0x74, 0x8d, 0x8a,
-		0x16, 0x3b, 0x32, 0x3e, 0x13, 0x72, 0xd0, 0xb7, 0xb8, 0xca, 0xe1, 0x15, 0x17, 0xb3, 0xf8, 0xaf,
-		0x23, 0x90, 0x12, 0xd5, 0xe8, 0x38, 0xc4, 0x3b, 0x9a, 0xb3, 0x47, 0xc9, 0x4d, 0x96, 0xa3, 0x52,
-		0x44, 0xa1, 0x65, 0x52, 0x6f, 0x77, 0x34, 0x93, 0x8a, 0x00, 0xaf, 0x27, 0x65, 0xb2, 0xae, 0x2d,
-		0xac, 0x35, 0x69, 0x2e, 0xc0, 0x6a, 0xb7, 0xb1, 0xe9, 0xd8, 0x62, 0x5d, 0x79, 0x7d, 0x85, 0x57,
-		0xa3, 0x47, 0x61, 0xca, 0xe9, 0x6a, 0x46, 0x2b, 0x00, 0x1b, 0xa7, 0xb0, 0x92, 0x68, 0x70, 0x81,
-		0x97, 0xe0, 0xa4, 0xa0, 0xdb, 0xc4, 0x8e, 0xa6, 0xef, 0xe1, 0xa6, 0x87, 0x94, 0xa0, 0x39, 0xbf,
-		0x13, 0x1c, 0x60, 0x99, 0xb7, 0x0b, 0x5c, 0xf9, 0xb3, 0x51, 0x98, 0x12, 0xd9, 0x8b, 0xa6, 0xcb,
-		0xac, 0x35, 0x00, 0xcd, 0x34, 0x2d, 0xc7, 0xcf, 0xae, 0x41, 0x51, 0x1e, 0xc0, 0x5b, 0x2c, 0xb9,
-		0x48, 0x8a, 0x8f, 0x40, 0xf1, 0x4b, 0x11, 0x00, 0xaf, 0x69, 0x24, 0xdf, 0xe6, 0x21, 0xc3, 0xcf,
-		0x64, 0xe9, 0xc1, 0x3e, 0x4b, 0x78, 0x01, 0xab, 0xba, 0x62, 0xb4, 0x68, 0x5a, 0x72, 0x1b, 0xef,
-		0x1a, 0x26, 0x3f, 0x4f, 0x61, 0x05, 0x91, 0x96, 0x8c, 0x7b, 0xc7, 0x53, 0x0a, 0xa4, 0x6c, 0xdc,
-		0xd6, 0x4c, 0xc7, 0xd0, 0xf9, 0x09, 0xc9, 0xa5, 0x23, 0x0d, 0x7e, 0xb1, 0xce, 0xb1, 0x15, 0x97,
-		0x8e, 0x7c, 0x06, 0x52, 0xa2, 0x96, 0x38, 0x7e, 0xeb, 0x1b, 0xeb, 0x55, 0x69, 0x02, 0x25, 0x21,
-		0x56, 0xaf, 0x36, 0xa4, 0x08, 0x09, 0x3b, 0x4b, 0xab, 0xb5, 0x52, 0x5d, 0x8a, 0x96, 0xff, 0x2a,
-		0x4c, 0xeb, 0x56, 0xbb, 0xbf, 0xc3, 0xb2, 0xd4, 0x97, 0xf2, 0xb3, 0xaf, 0x46, 0x5e, 0x79, 0x9c,
-		0x03, 0xed, 0x5a, 0x2d, 0xcd, 0xdc, 0x5d, 0xb4, 0xba, 0xbb, 0xde, 0xb5, 0x08, 0x12, 0x1d, 0xd8,
-		0xbe, 0xcb, 0x11, 0x9d, 0xed, 0xff, 0x11, 0x89, 0xfc, 0x64, 0x34, 0xb6, 0xb2, 0x59, 0xfe, 0xb9,
-		0x68, 0x71, 0x85, 0x21, 0x6e, 0x8a, 0xe9, 0x28, 0x78, 0xa7, 0x85, 0x75, 0x32, 0x78, 0xf8, 0x93,
-		0x47, 0x61, 0x66, 0xd7, 0xda, 0xb5, 0x28, 0xa5, 0xf3, 0xe4, 0x17, 0xbf, 0x57, 0x91, 0x76, 0x6b,
-		0x8b, 0xa1, 0x97, 0x30, 0x96, 0xd6, 0x61, 0x9a, 0x03, 0xab, 0xf4, 0xf8, 0x96, 0x25, 0x17, 0xd0,
-		0xa1, 0x99, 0xed, 0xd9, 0x5f, 0xf8, 0x23, 0xea, 0x95, 0x28, 0x53, 0x1c, 0x95, 0xb4, 0xb1, 0xfc,
-		0xc3, 0x92, 0x02, 0xc7, 0x02, 0xf4, 0x98, 0x8e, 0xc0, 0xdd, 0x10, 0x8a, 0xff, 0x8c, 0x53, 0x9c,
-		0xf6, 0x51, 0xac, 0x73, 0xd4, 0xa5, 0x0a, 0xe4, 0x8e, 0x42, 0xeb, 0x9f, 0x73, 0x5a, 0x59, 0xec,
-		0x27, 0xb2, 0x02, 0x05, 0x4a, 0x44, 0xef, 0xd9, 0x8e, 0xd5, 0xa6, 0x0a, 0xf8, 0x70, 0x32, 0xff,
-		0xe2, 0x8f, 0xd8, 0xa6, 0xcd, 0x13, 0xb4, 0x8a, 0x8b, 0xb5, 0xb4, 0x04, 0xf4, 0xc4, 0xba, 0x89,
-		0xf5, 0x56, 0x08, 0x85, 0xcf, 0xf0, 0x81, 0xb8, 0xf0, 0x4b, 0x37, 0x60, 0x86, 0xfc, 0xa6, 0xfa,
-		0xd1, 0x3f, 0x92, 0xf0, 0x34, 0xf8, 0xec, 0xef, 0x7e, 0x98, 0xe9, 0x85, 0x69, 0x97, 0x80, 0x6f,
-		0x4c, 0xbe, 0x55, 0xdc, 0xc5, 0x8e, 0x83, 0xbb, 0xb6, 0xaa, 0xb5, 0x86, 0x0d, 0xcf, 0x97, 0x47,
-		0x9c, 0xfd, 0x91, 0x2f, 0x07, 0x57, 0x71, 0x85, 0x61, 0x96, 0x5a, 0xad, 0xa5, 0x2d, 0x38, 0x31,
-		0x44, 0x2a, 0xc6, 0xa0, 0xf9, 0xa3, 0x9c, 0xe6, 0xcc, 0x80, 0x64, 0x10, 0xb2, 0x9b, 0x20, 0xea,
-		0xdd, 0xb5, 0x1c, 0x83, 0xe6, 0x8f, 0x71, 0x9a, 0x88, 0xe3, 0x8a, 0x25, 0x25, 0x14, 0xaf, 0xc1,
-		0xd4, 0x2d, 0xdc, 0xdd, 0xb6, 0x6c, 0x9e, 0xbb, 0x1d, 0x83, 0xdc, 0x8f, 0x73, 0x72, 0x05, 0x8e,
-		0x48, 0x93, 0xb9, 0x84, 0xd6, 0x73, 0x90, 0xda, 0xd1, 0x74, 0x3c, 0x06, 0x89, 0xbb, 0x9c, 0x44,
-		0x92, 0xc0, 0x13, 0xd4, 0x12, 0x64, 0x77, 0x2d, 0x6e, 0x22, 0xc3, 0xd1, 0x7f, 0x82, 0xa3, 0x67,
-		0x04, 0x0e, 0x27, 0xd1, 0xb1, 0x3a, 0xbd, 0x16, 0xb1, 0x9f, 0xe1, 0x24, 0xfe, 0xb6, 0x20, 0x21,
-		0x70, 0x38, 0x89, 0x23, 0xb0, 0xf5, 0x0d, 0x41, 0xc2, 0xf6, 0xf1, 0xf3, 0x05, 0xc8, 0x58, 0x66,
-		0xeb, 0xc0, 0x32, 0xc7, 0x19, 0xc4, 0xc7, 0x39, 0x05, 0xe0, 0x28, 0x84, 0xc0, 0x65, 0x48, 0x8f,
-		0xbb, 0x10, 0x3f, 0xfd, 0x65, 0xb1, 0x3d, 0xc4, 0x0a, 0xac, 0x40, 0x41, 0x28, 0x28, 0xc3, 0x32,
-		0xc7, 0x20, 0xf1, 0x33, 0x9c, 0x44, 0xde, 0x87, 0xc6, 0xa7, 0xe1, 0x60, 0xdb, 0xd9, 0xc5, 0xe3,
-		0x10, 0xf9, 0x84, 0x98, 0x06, 0x47, 0xe1, 0xac, 0xdc, 0xc6, 0xa6, 0xbe, 0x37, 0x1e, 0x85, 0x4f,
-		0x0a, 0x56, 0x0a, 0x1c, 0x42, 0xa2, 0x02, 0xb9, 0xb6, 0xd6, 0xb5, 0xf7, 0xb4, 0xd6, 0x58, 0xcb,
-		0xf1, 0xb3, 0x9c, 0x46, 0xd6, 0x45, 0xe2, 0x1c, 0xe9, 0x99, 0x47, 0x21, 0xf3, 0x73, 0x82, 0x23,
-		0x3e, 0x34, 0xbe, 0xf5, 0x6c, 0x87, 0x26, 0xba, 0x8f, 0x42, 0xed, 0xef, 0x8a, 0xad, 0xc7, 0x70,
-		0xd7, 0xfc, 0x14, 0x2f, 0x43, 0xda, 0x36, 0x5e, 0x1b, 0x8b, 0xcc, 0xdf, 0x13, 0x2b, 0x4d, 0x11,
-		0x08, 0xf2, 0xcb, 0x70, 0x72, 0xa8, 0x99, 0x18, 0x83, 0xd8, 0xdf, 0xe7, 0xc4, 0x8e, 0x0f, 0x31,
-		0x15, 0x5c, 0x25, 0x1c, 0x95, 0xe4, 0x3f, 0x10, 0x2a, 0x01, 0xf7, 0xd1, 0xda, 0x24, 0x41, 0x8b,
-		0xad, 0xed, 0x1c, 0x8d, 0x6b, 0xff, 0x50, 0x70, 0x8d, 0xe1, 0x06, 0xb8, 0xd6, 0x80, 0xe3, 0x9c,
-		0xe2, 0xd1, 0xd6, 0xf5, 0xe7, 0x85, 0x62, 0x65, 0xd8, 0x5b, 0xc1, 0xd5, 0xfd, 0x66, 0x28, 0xba,
-		0xec, 0x14, 0xde, 0xb1, 0xad, 0xb6, 0xb5, 0xce, 0x18, 0x94, 0x7f, 0x81, 0x53, 0x16, 0x1a, 0xdf,
-		0x75, 0xaf, 0xed, 0x35, 0xad, 0x43, 0x88, 0xbf, 0x04, 0xb3, 0x82, 0x78, 0xcf, 0xec, 0x62, 0xdd,
-		0xda, 0x35, 0x8d, 0xd7, 0x70, 0x73, 0x0c, 0xd2, 0xbf, 0xd8, 0xb7, 0x54, 0x5b, 0x3e, 0x74, 0x42,
-		0xb9, 0x06, 0x92, 0xeb, 0xab, 0xa8, 0x46, 0xbb, 0x63, 0x75, 0x9d, 0x10, 0x8a, 0xbf, 0x24, 0x56,
-		0xca, 0xc5, 0xab, 0x51, 0xb4, 0xa5, 0x2a, 0xb0, 0xdb, 0x1f, 0xe3, 0x8a, 0xe4, 0x2f, 0x73, 0x42,
-		0x39, 0x0f, 0x8b, 0x2b, 0x0e, 0xdd, 0x6a, 0x77, 0xb4, 0xee, 0x38, 0xfa, 0xef, 0x1f, 0x09, 0xc5,
-		0xc1, 0x51, 0xb8, 0xe2, 0x20, 0x1e, 0x1d, 0xb1, 0xf6, 0x63, 0x50, 0xf8, 0x15, 0xa1, 0x38, 0x04,
-		0x0e, 0x27, 0x21, 0x1c, 0x86, 0x31, 0x48, 0x7c, 0x4a, 0x90, 0x10, 0x38, 0x84, 0xc4, 0x07, 0x3c,
-		0x43, 0xdb, 0xc5, 0xbb, 0x86, 0xed, 0x74, 0x99, 0x4b, 0x7e, 0x38, 0xa9, 0x5f, 0xfd, 0x72, 0xd0,
-		0x09, 0x53, 0x7c, 0xa8, 0x44, 0x13, 0xf1, 0xa3, 0x0f, 0x1a, 0xb2, 0x85, 0x0f, 0xec, 0xd7, 0x84,
-		0x26, 0xf2, 0xa1, 0x91, 0xb1, 0xf9, 0x3c, 0x44, 0xc2, 0x76, 0x9d, 0x04, 0x2a, 0x63, 0x90, 0xfb,
-		0xc7, 0x7d, 0x83, 0xab, 0x0b, 0x5c, 0x42, 0xd3, 0xe7, 0xff, 0xf4, 0xcc, 0x9b, 0xf8, 0x60, 0x2c,
-		0xe9, 0xfc, 0x74, 0x9f, 0xff, 0xb3, 0xc5, 0x30, 0x99, 0x0e, 0x29, 0xf4, 0xf9, 0x53, 0x28, 0xec,
-		0xae, 0xdf, 0xec, 0xb7, 0xbf, 0xcd, 0xe7, 0x1b, 0x74, 0xa7, 0x96, 0x56, 0x89, 0x90, 0x07, 0x9d,
-		0x9e, 0x70, 0x62, 0x1f, 0x7e, 0xdb, 0x95, 0xf3, 0x80, 0xcf, 0xb3, 0x74, 0x05, 0x72, 0x01, 0x87,
-		0x27, 0x9c, 0xd4, 0x77, 0x72, 0x52, 0x59, 0xbf, 0xbf, 0xb3, 0x74, 0x11, 0xe2, 0xc4, 0x79, 0x09,
-		0x47, 0xff, 0x2e, 0x8e, 0x4e, 0xc1, 0x97, 0x9e, 0x87, 0x94, 0x70, 0x5a, 0xc2, 0x51, 0xbf, 0x9b,
-		0xa3, 0xba, 0x28, 0x04, 0x5d, 0x38, 0x2c, 0xe1, 0xe8, 0x7f, 0x4d, 0xa0, 0x0b, 0x14, 0x82, 0x3e,
-		0x3e, 0x0b, 0x7f, 0xf3, 0xaf, 0xc7, 0xb9, 0xd1, 0x11, 0xbc, 0xbb, 0x0c, 0x49, 0xee, 0xa9, 0x84,
-		0x63, 0x7f, 0x0f, 0xef, 0x5c, 0x60, 0x2c, 0x3d, 0x03, 0x93, 0x63, 0x32, 0xfc, 0x6f, 0x70, 0x54,
-		0x06, 0xbf, 0x54, 0x81, 0x8c, 0xcf, 0x3b, 0x09, 0x47, 0xff, 0x3e, 0x8e, 0xee, 0xc7, 0x22, 0x43,
-		0xe7, 0xde, 0x49, 0x38, 0x81, 0xef, 0x17, 0x43, 0xe7, 0x18, 0x84, 0x6d, 0xc2, 0x31, 0x09, 0xc7,
-		0xfe, 0xa8, 0xe0, 0xba, 0x40, 0x59, 0x7a, 0x01, 0xd2, 0xae, 0xb1, 0x09, 0xc7, 0xff, 0x01, 0x8e,
-		0xef, 0xe1, 0x10, 0x0e, 0xf8, 0x8c, 0x5d, 0x38, 0x89, 0xbf, 0x29, 0x38, 0xe0, 0xc3, 0x22, 0xdb,
-		0xa8, 0xdf, 0x81, 0x09, 0xa7, 0xf4, 0x83, 0x62, 0x1b, 0xf5, 0xf9, 0x2f, 0x64, 0x35, 0xa9, 0xce,
-		0x0f, 0x27, 0xf1, 0xb7, 0xc4, 0x6a, 0x52, 0x78, 0x32, 0x8c, 0x7e, 0x8f, 0x20, 0x9c, 0xc6, 0x0f,
-		0x8b, 0x61, 0xf4, 0x39, 0x04, 0x4b, 0x9b, 0x80, 0x06, 0xbd, 0x81, 0x70, 0x7a, 0x1f, 0xe3, 0xf4,
-		0xa6, 0x06, 0x9c, 0x81, 0xa5, 0x17, 0xe1, 0xf8, 0x70, 0x4f, 0x20, 0x9c, 0xea, 0x8f, 0xbc, 0xdd,
-		0x17, 0xbb, 0xf9, 0x1d, 0x81, 0xa5, 0x86, 0x67, 0x52, 0xfc, 0x5e, 0x40, 0x38, 0xd9, 0x1f, 0x7d,
-		0x3b, 0xa8, 0xb8, 0xfd, 0x4e, 0xc0, 0x52, 0x09, 0xc0, 0x33, 0xc0, 0xe1, 0xb4, 0x7e, 0x9c, 0xd3,
-		0xf2, 0x21, 0x91, 0xad, 0xc1, 0xed, 0x6f, 0x38, 0xfe, 0x5d, 0xb1, 0x35, 0x38, 0x06, 0xd9, 0x1a,
-		0xc2, 0xf4, 0x86, 0x63, 0xff, 0x84, 0xd8, 0x1a, 0x02, 0x85, 0x48, 0xb6, 0xcf, 0xba, 0x85, 0x53,
-		0xf8, 0xb8, 0x90, 0x6c, 0x1f, 0xd6, 0xd2, 0x3a, 0x4c, 0x0d, 0x18, 0xc4, 0x70, 0x52, 0x3f, 0xc9,
-		0x49, 0x49, 0xfd, 0xf6, 0xd0, 0x6f, 0xbc, 0xb8, 0x31, 0x0c, 0xa7, 0xf6, 0x53, 0x7d, 0xc6, 0x8b,
-		0xdb, 0xc2, 0xa5, 0xcb, 0x90, 0x32, 0x7b, 0xad, 0x16, 0xd9, 0x3c, 0xe8, 0xf0, 0xfb, 0xb9, 0xb3,
-		0xff, 0xf9, 0xab, 0x9c, 0x3b, 0x02, 0x61, 0xe9, 0x22, 0x4c, 0xe2, 0xf6, 0x36, 0x6e, 0x86, 0x61,
-		0x7e, 0xf1, 0xab, 0x42, 0x61, 0x12, 0xe8, 0xa5, 0x17, 0x00, 0x58, 0x6a, 0x84, 0x1e, 0x9c, 0x87,
-		0xe0, 0x7e, 0xe9, 0xab, 0xfc, 0x42, 0x9c, 0x87, 0xe2, 0x11, 0x60, 0xd7, 0xeb, 0x0e, 0x27, 0xf0,
-		0xe5, 0x20, 0x01, 0xba, 0x22, 0xcf, 0x41, 0xf2, 0x55, 0xdb, 0x32, 0x1d, 0x6d, 0x37, 0x0c, 0xfb,
-		0x4f, 0x38, 0xb6, 0x80, 0x27, 0x0c, 0x6b, 0x5b, 0x5d, 0xec, 0x68, 0xbb, 0x76, 0x18, 0xee, 0x7f,
-		0xe1, 0xb8, 0x2e, 0x02, 0x41, 0xd6, 0x35, 0xdb, 0x19, 0x67, 0xde, 0x7f, 0x2a, 0x90, 0x05, 0x02,
-		0x19, 0x34, 0xf9, 0x7d, 0x13, 0x1f, 0x84, 0xe1, 0xfe, 0x99, 0x18, 0x34, 0x87, 0x5f, 0x7a, 0x1e,
-		0xd2, 0xe4, 0x27, 0xbb, 0xe5, 0x1a, 0x82, 0xfc, 0xe7, 0x1c, 0xd9, 0xc3, 0x20, 0x3d, 0xdb, 0x4e,
-		0xd3, 0x31, 0xc2, 0x99, 0xfd, 0x15, 0xbe, 0xd2, 0x02, 0x7e, 0xa9, 0x04, 0x19, 0xdb, 0x69, 0x36,
-		0x7b, 0xdc, 0x3f, 0x0d, 0x41, 0xff, 0xaf, 0x5f, 0x75, 0x53, 0x16, 0x2e, 0x0e, 0x59, 0xed, 0xdb,
-		0x37, 0x9d, 0x8e, 0x45, 0xcf, 0x5b, 0xc2, 0x28, 0xbc, 0xcd, 0x29, 0xf8, 0x50, 0x96, 0x2a, 0x90,
-		0x25, 0x73, 0xe9, 0xe2, 0x0e, 0xa6, 0x87, 0x63, 0x21, 0x24, 0xfe, 0x82, 0x33, 0x20, 0x80, 0x54,
-		0xfe, 0xb6, 0xcf, 0xbc, 0x35, 0x17, 0xf9, 0xec, 0x5b, 0x73, 0x91, 0x3f, 0x78, 0x6b, 0x2e, 0xf2,
-		0xd1, 0xcf, 0xcd, 0x4d, 0x7c, 0xf6, 0x73, 0x73, 0x13, 0xbf, 0xff, 0xb9, 0xb9, 0x89, 0xe1, 0x59,
-		0x62, 0x58, 0xb1, 0x56, 0x2c, 0x96, 0x1f, 0x7e, 0xe5, 0xe1, 0x5d, 0xc3, 0xd9, 0xeb, 0x6d, 0x2f,
-		0xea, 0x56, 0xfb, 0xbc, 0x6e, 0xd9, 0x6d, 0xcb, 0x3e, 0x1f, 0xcc, 0xeb, 0xd2, 0x5f, 0xf0, 0xbf,
-		0x22, 0x24, 0x66, 0x0e, 0xa6, 0x73, 0x35, 0xf3, 0x60, 0xd4, 0xc7, 0x74, 0x97, 0x20, 0x56, 0x32,
-		0x0f, 0xd0, 0x49, 0xa6, 0xe0, 0xd4, 0x5e, 0xb7, 0xc5, 0xaf, 0x5a, 0x26, 0x49, 0x79, 0xab, 0xdb,
-		0x42, 0x33, 0xde, 0x7d, 0xe8, 0xc8, 0x99, 0x2c, 0xbf, 0xe4, 0x5c, 0xfe, 0xbe, 0xc8, 0xd1, 0x66,
-		0x92, 0x2a, 0x99, 0x07, 0x74, 0x22, 0x9b, 0x91, 0x57, 0x1e, 0x0b, 0xcd, 0x73, 0xdf, 0x34, 0xad,
-		0xdb, 0x26, 0x19, 0x76, 0x67, 0x5b, 0xe4, 0xb8, 0xe7, 0xfa, 0x73, 0xdc, 0x2f, 0xe2, 0x56, 0xeb,
-		0x3a, 0x81, 0x6b, 0x10, 0x94, 0xed, 0x04, 0xbb, 0xd5, 0x0f, 0x3f, 0x18, 0x85, 0xb9, 0x81, 0x74,
-		0x36, 0x17, 0x82, 0x51, 0x4c, 0x58, 0x82, 0xd4, 0xb2, 0x90, 0xad, 0x59, 0x48, 0xda, 0x58, 0xb7,
-		0xcc, 0xa6, 0x4d, 0x19, 0x11, 0x53, 0x44, 0x91, 0x30, 0xc2, 0xd4, 0x4c, 0xcb, 0xe6, 0x97, 0x95,
-		0x59, 0xa1, 0xfc, 0x63, 0x47, 0x64, 0x44, 0x4e, 0xf4, 0x24, 0xb8, 0xf1, 0xe4, 0x98, 0xdc, 0x10,
-		0x93, 0x08, 0x64, 0xfe, 0xc7, 0xe5, 0xca, 0x0f, 0x47, 0x61, 0xbe, 0x9f, 0x2b, 0x64, 0x67, 0xd9,
-		0x8e, 0xd6, 0xee, 0x8c, 0x62, 0xcb, 0x65, 0x48, 0x37, 0x04, 0xcc, 0x91, 0xf9, 0x72, 0xf7, 0x88,
-		0x7c, 0xc9, 0xbb, 0x5d, 0x09, 0xc6, 0x5c, 0x18, 0x93, 0x31, 0xee, 0x3c, 0xee, 0x89, 0x33, 0x7f,
-		0x99, 0x80, 0x93, 0x6c, 0x3b, 0xa9, 0x6c, 0x2b, 0xb1, 0x02, 0xe7, 0x49, 0xd6, 0xdf, 0x14, 0x7e,
-		0x4e, 0x22, 0x5f, 0x87, 0xe9, 0x1a, 0xd1, 0x16, 0x24, 0x0a, 0xf2, 0x4e, 0x78, 0x86, 0xde, 0xe7,
-		0x5e, 0x08, 0x38, 0xfc, 0xfc, 0x7c, 0xcb, 0x5f, 0x25, 0x7f, 0x7b, 0x04, 0xa4, 0xba, 0xae, 0xb5,
-		0xb4, 0xee, 0xd7, 0x4a, 0x0a, 0x3d, 0x03, 0xc0, 0xae, 0x7b, 0xb8, 0x1f, 0xee, 0xe5, 0x2f, 0xcc,
-		0x2e, 0xfa, 0x27, 0xb7, 0xc8, 0x7a, 0xa2, 0x37, 0xa8, 0xd2, 0x14, 0x96, 0xfc, 0x3c, 0xf7, 0x12,
-		0x80, 0xd7, 0x80, 0x4e, 0xc1, 0x89, 0x7a, 0xa5, 0xb4, 0x5a, 0x52, 0xc4, 0x25, 0xa1, 0xfa, 0x66,
-		0xb5, 0x52, 0xbb, 0x52, 0xab, 0x2e, 0x4b, 0x13, 0xe8, 0x38, 0x20, 0x7f, 0xa3, 0x7b, 0xa9, 0xe9,
-		0x18, 0x4c, 0xf9, 0xeb, 0xd9, 0x57, 0x2a, 0x51, 0xe2, 0x29, 0x1a, 0xed, 0x4e, 0x0b, 0xd3, 0x93,
-		0x47, 0xd5, 0x10, 0x5c, 0x0b, 0x77, 0x42, 0x7e, 0xfb, 0xdf, 0xb2, 0x2f, 0x17, 0xa6, 0x3d, 0x74,
-		0x97, 0xe7, 0x4b, 0xab, 0x30, 0xa5, 0xe9, 0x3a, 0xee, 0x04, 0x48, 0x86, 0xa8, 0x6a, 0x42, 0x90,
-		0x9e, 0xa5, 0x72, 0x4c, 0x8f, 0xda, 0x33, 0x90, 0xb0, 0xe9, 0xec, 0xc3, 0x48, 0xfc, 0x0e, 0x27,
-		0xc1, 0xc1, 0x97, 0x4c, 0x98, 0x22, 0x9e, 0x9f, 0xd6, 0xc5, 0xbe, 0x61, 0x1c, 0x9e, 0x67, 0xf8,
-		0xf5, 0x5f, 0x7a, 0x82, 0x9e, 0xac, 0x3e, 0x10, 0x5c, 0x96, 0x21, 0xe2, 0xa4, 0x48, 0x9c, 0xb6,
-		0x37, 0x50, 0x0c, 0x79, 0xd1, 0x1f, 0x1f, 0xf0, 0xe1, 0x9d, 0xfd, 0x06, 0xef, 0x6c, 0x6e, 0x98,
-		0x0c, 0xf8, 0x7a, 0xca, 0x71, 0xaa, 0xac, 0xa1, 0x5c, 0x1d, 0xb5, 0xa7, 0x5f, 0x79, 0x74, 0xd0,
-		0x3a, 0xb1, 0xff, 0x1e, 0xa7, 0x94, 0x2f, 0xfb, 0xbb, 0x71, 0xf7, 0xde, 0x8f, 0xc5, 0x61, 0x4a,
-		0x6b, 0x1b, 0xa6, 0x75, 0x9e, 0xfe, 0xcb, 0xf7, 0xdc, 0x24, 0x2d, 0x8c, 0x71, 0x28, 0x79, 0x89,
-		0x6d, 0x85, 0x70, 0x89, 0xf9, 0xf3, 0xef, 0xfd, 0x99, 0x49, 0x6f, 0xbb, 0x2c, 0xad, 0x81, 0x24,
-		0x2e, 0xf1, 0x62, 0x53, 0xb7, 0x9a, 0x63, 0x65, 0x29, 0xbe, 0x22, 0x68, 0x88, 0xfc, 0x56, 0x95,
-		0xa3, 0x2e, 0xbd, 0x0f, 0x52, 0x2e, 0x99, 0x30, 0xcf, 0x44, 0x10, 0x71, 0x31, 0x88, 0x5f, 0xc2,
-		0x76, 0xe6, 0x38, 0x5e, 0xe8, 0xdb, 0x02, 0x9f, 0xed, 0xd0, 0x75, 0x32, 0x9b, 0x15, 0xc8, 0x37,
-		0x2d, 0xd3, 0x51, 0xad, 0xb6, 0xe1, 0xe0, 0x76, 0xc7, 0x09, 0xf5, 0xeb, 0xfe, 0x82, 0x11, 0x49,
-		0x29, 0x39, 0x82, 0xb7, 0x21, 0xd0, 0xc8, 0x48, 0xd8, 0x77, 0x7a, 0xe3, 0x8c, 0xe4, 0xbf, 0xb9,
-		0x23, 0xa1, 0x38, 0x64, 0x24, 0xf7, 0x24, 0x1d, 0x76, 0xf3, 0x26, 0x57, 0xe6, 0xce, 0x3e, 0x93,
-		0x02, 0x57, 0x3a, 0xfe, 0x34, 0x06, 0x73, 0x1c, 0x78, 0x5b, 0xb3, 0xf1, 0xf9, 0x5b, 0x4f, 0x6e,
-		0x63, 0x47, 0x7b, 0xf2, 0xbc, 0x6e, 0x19, 0xc2, 0x92, 0x4f, 0x73, 0x65, 0x4d, 0xda, 0x17, 0x79,
-		0x7b, 0x71, 0xe8, 0x71, 0x77, 0x71, 0xb4, 0x92, 0x2f, 0x0e, 0xca, 0xa0, 0xfc, 0x0a, 0xc4, 0x2b,
-		0x96, 0x61, 0x12, 0xdb, 0xd6, 0xc4, 0xa6, 0xd5, 0xe6, 0xea, 0x96, 0x15, 0xd0, 0x25, 0x48, 0x68,
-		0x6d, 0xab, 0x67, 0x3a, 0x4c, 0xd5, 0x96, 0xe7, 0x3e, 0xf3, 0xe6, 0xfc, 0xc4, 0xbf, 0x7f, 0x73,
-		0x3e, 0x56, 0x33, 0x9d, 0xdf, 0xfb, 0xe5, 0xc7, 0x81, 0x53, 0xaf, 0x99, 0xce, 0x27, 0x3f, 0xff,
-		0xf3, 0xe7, 0x22, 0x0a, 0x87, 0x5e, 0x8a, 0x7f, 0xe1, 0x8d, 0xf9, 0x88, 0xfc, 0x12, 0x24, 0x97,
-		0xb1, 0x7e, 0x08, 0xf9, 0x27, 0xfb, 0xc8, 0x9f, 0x14, 0xe4, 0x97, 0xb1, 0xee, 0x23, 0xbf, 0x8c,
-		0xf5, 0x3e, 0xca, 0xcf, 0x40, 0xaa, 0x66, 0x3a, 0xec, 0x13, 0xa2, 0x47, 0x21, 0x66, 0x98, 0xec,
-		0x56, 0xba, 0x8f, 0xc2, 0xc0, 0x00, 0x15, 0x02, 0x45, 0x10, 0x97, 0xb1, 0xee, 0x22, 0x36, 0xb1,
-		0xde, 0x8f, 0x38, 0xd8, 0x35, 0x81, 0x2a, 0x2f, 0xff, 0xfe, 0x1f, 0xce, 0x4d, 0xbc, 0xfe, 0xd6,
-		0xdc, 0xc4, 0xc8, 0xa5, 0x97, 0xc3, 0x97, 0xde, 0x5d, 0xf1, 0x9f, 0x89, 0xc3, 0xfd, 0xf4, 0xcb,
-		0xd2, 0x6e, 0xdb, 0x30, 0x9d, 0xf3, 0x7a, 0xf7, 0xa0, 0xe3, 0x58, 0x44, 0x01, 0x58, 0x3b, 0x7c,
-		0xc1, 0xa7, 0xbc, 0xe6, 0x45, 0xd6, 0x3c, 0x7c, 0xb9, 0xe5, 0x1d, 0x98, 0xdc, 0x24, 0x78, 0x84,
-		0xc5, 0x8e, 0xe5, 0x68, 0x2d, 0xee, 0xb5, 0xb0, 0x02, 0xa9, 0x65, 0x5f, 0xa3, 0x46, 0x59, 0xad,
-		0x21, 0x3e, 0x44, 0x6d, 0x61, 0x6d, 0x87, 0x7d, 0xd4, 0x13, 0xa3, 0xee, 0x6e, 0x8a, 0x54, 0xd0,
-		0xef, 0x77, 0x66, 0x60, 0x52, 0xeb, 0xb1, 0x8b, 0x37, 0x31, 0xe2, 0x07, 0xd3, 0x82, 0x7c, 0x1d,
-		0x92, 0xfc, 0xfc, 0x1d, 0x49, 0x10, 0xbb, 0x89, 0x0f, 0x68, 0x3f, 0x59, 0x85, 0xfc, 0x44, 0x8b,
-		0x30, 0x49, 0x07, 0xcf, 0xbf, 0x56, 0x9c, 0x5d, 0x1c, 0x18, 0xfd, 0x22, 0x1d, 0xa4, 0xc2, 0xc0,
-		0xe4, 0x6b, 0x90, 0x5a, 0xb6, 0x88, 0x14, 0x06, 0xa9, 0xa5, 0x19, 0x35, 0x3a, 0xe6, 0x4e, 0x8f,
-		0x4b, 0x85, 0xc2, 0x0a, 0xe8, 0x38, 0x24, 0xd8, 0x47, 0x5e, 0xfc, 0xf2, 0x10, 0x2f, 0xc9, 0x15,
-		0x48, 0x52, 0xda, 0x1b, 0x1d, 0xe2, 0x32, 0xb8, 0xf7, 0xde, 0xd3, 0xfc, 0x93, 0x5f, 0x4e, 0x3e,
-		0xea, 0x0d, 0x16, 0x41, 0xbc, 0xa9, 0x39, 0x1a, 0x9f, 0x37, 0xfd, 0x2d, 0xbf, 0x1f, 0x52, 0x9c,
-		0x88, 0x8d, 0x2e, 0x40, 0xcc, 0xea, 0xd8, 0xfc, 0xfa, 0x4f, 0x71, 0xd4, 0x54, 0x36, 0x3a, 0xe5,
-		0x38, 0x91, 0x19, 0x85, 0x00, 0x97, 0xd7, 0x47, 0x8a, 0xc5, 0xd3, 0x01, 0xb1, 0x68, 0x63, 0x67,
-		0x7b, 0xc7, 0xf1, 0x7e, 0xb0, 0xe5, 0x1c, 0x10, 0x05, 0x57, 0x50, 0xee, 0x46, 0x61, 0xce, 0xd7,
-		0x7a, 0x0b, 0x77, 0x6d, 0xc3, 0x32, 0x99, 0x34, 0x71, 0x49, 0x41, 0xbe, 0x01, 0xf2, 0xf6, 0x11,
-		0xa2, 0xf2, 0x3c, 0xc4, 0x4a, 0x9d, 0x0e, 0x2a, 0x42, 0x8a, 0x96, 0x75, 0x8b, 0xc9, 0x4a, 0x5c,
-		0x71, 0xcb, 0xa4, 0xcd, 0xb6, 0x76, 0x9c, 0xdb, 0x5a, 0xd7, 0xfd, 0x06, 0x5a, 0x94, 0xe5, 0xe7,
-		0x20, 0x5d, 0xb1, 0x4c, 0x1b, 0x9b, 0x76, 0x8f, 0xfa, 0xc2, 0xdb, 0x2d, 0x4b, 0xbf, 0xc9, 0x29,
-		0xb0, 0x02, 0x61, 0xb6, 0xd6, 0xe9, 0x50, 0xcc, 0xb8, 0x42, 0x7e, 0xb2, 0xfd, 0x5a, 0xde, 0x18,
-		0xc9, 0x9e, 0x8b, 0x47, 0x63, 0x0f, 0x9f, 0xa0, 0xe7, 0xd4, 0x46, 0xe0, 0xbe, 0xc1, 0x8d, 0x74,
-		0x13, 0x1f, 0xd8, 0x47, 0xdd, 0x47, 0x2f, 0x41, 0x7a, 0x93, 0x3e, 0x4f, 0x72, 0x1d, 0x1f, 0xa0,
-		0x22, 0x24, 0x71, 0xf3, 0xc2, 0xc5, 0x8b, 0x4f, 0x3e, 0xc7, 0xa4, 0xfc, 0xea, 0x84, 0x22, 0x2a,
-		0xd0, 0x1c, 0xa4, 0x6d, 0xac, 0x77, 0x2e, 0x5c, 0xbc, 0x74, 0xf3, 0x49, 0x26, 0x56, 0x57, 0x27,
-		0x14, 0xaf, 0x6a, 0x29, 0x45, 0x66, 0xfc, 0x85, 0x8f, 0xcf, 0x47, 0xca, 0x93, 0x10, 0xb3, 0x7b,
-		0xed, 0x77, 0x4d, 0x36, 0x7e, 0x68, 0x12, 0x16, 0x7c, 0xad, 0xcc, 0xb8, 0xdc, 0xd2, 0x5a, 0x46,
-		0x53, 0xf3, 0x1e, 0x95, 0x91, 0x7c, 0xf3, 0xa7, 0x10, 0x23, 0xac, 0xc6, 0xa1, 0x5c, 0x94, 0x7f,
-		0x31, 0x02, 0xd9, 0x1b, 0x82, 0x72, 0x1d, 0x3b, 0xe8, 0x32, 0x80, 0xdb, 0x93, 0xd8, 0x2a, 0xa7,
-		0x16, 0xfb, 0xfb, 0x5a, 0x74, 0x71, 0x14, 0x1f, 0x38, 0x7a, 0x86, 0x0a, 0x60, 0xc7, 0xb2, 0xf9,
-		0xf7, 0xb0, 0x21, 0xa8, 0x2e, 0x30, 0x7a, 0x0c, 0x10, 0xd5, 0x6a, 0xea, 0x2d, 0xcb, 0x31, 0xcc,
-		0x5d, 0xb5, 0x63, 0xdd, 0xe6, 0xaf, 0x0c, 0xc4, 0x14, 0x89, 0xb6, 0xdc, 0xa0, 0x0d, 0x9b, 0xa4,
-		0x9e, 0x0c, 0x3a, 0xed, 0x52, 0x21, 0x61, 0x9d, 0xd6, 0x6c, 0x76, 0xb1, 0x6d, 0x73, 0xc5, 0x25,
-		0x8a, 0xe8, 0x32, 0x24, 0x3b, 0xbd, 0x6d, 0x55, 0x68, 0x89, 0xcc, 0x85, 0xfb, 0x86, 0xed, 0x79,
-		0x21, 0x1b, 0x7c, 0xd7, 0x27, 0x3a, 0xbd, 0x6d, 0x22, 0x29, 0x0f, 0x40, 0x76, 0xc8, 0x60, 0x32,
-		0xb7, 0xbc, 0x71, 0xd0, 0x17, 0x71, 0xf8, 0x0c, 0xd4, 0x4e, 0xd7, 0xb0, 0xba, 0x86, 0x73, 0x40,
-		0xaf, 0xed, 0xc5, 0x14, 0x49, 0x34, 0x6c, 0xf2, 0x7a, 0xf9, 0x26, 0x14, 0xea, 0xd4, 0xdd, 0xf7,
-		0x46, 0x7e, 0xd1, 0x1b, 0x5f, 0x24, 0x7c, 0x7c, 0x23, 0x47, 0x16, 0x1d, 0x18, 0x59, 0x79, 0x6d,
-		0xa4, 0x64, 0x3e, 0x75, 0x34, 0xc9, 0x0c, 0x5a, 0xb7, 0x2f, 0x9d, 0x0c, 0x6c, 0x4a, 0xee, 0xf5,
-		0xf8, 0x54, 0xd6, 0xb8, 0x42, 0x19, 0x16, 0xc9, 0x17, 0x0f, 0x37, 0xa2, 0xc5, 0x10, 0xd5, 0x59,
-		0x0c, 0xdd, 0x3e, 0xf2, 0x73, 0x90, 0xdb, 0xd4, 0xba, 0x4e, 0x1d, 0x3b, 0x57, 0xb1, 0xd6, 0xc4,
-		0xdd, 0xa0, 0x95, 0xcd, 0x09, 0x2b, 0x8b, 0x20, 0x4e, 0x4d, 0x29, 0xb3, 0x32, 0xf4, 0xb7, 0xbc,
-		0x07, 0x71, 0x7a, 0x6f, 0xd7, 0xb5, 0xc0, 0x1c, 0x83, 0x59, 0x60, 0xa2, 0x3f, 0x0f, 0x1c, 0x6c,
-		0x8b, 0x64, 0x13, 0x2d, 0xa0, 0xa7, 0x85, 0x1d, 0x8d, 0x1d, 0x6e, 0x47, 0xb9, 0x10, 0x72, 0x6b,
-		0xda, 0x82, 0x64, 0x99, 0xa8, 0xdf, 0xda, 0xb2, 0x3b, 0x90, 0x88, 0x37, 0x10, 0xb4, 0x06, 0x85,
-		0x8e, 0xd6, 0x75, 0xe8, 0x77, 0x7c, 0x7b, 0x74, 0x16, 0x5c, 0xce, 0xe7, 0x07, 0x77, 0x5d, 0x60,
-		0xb2, 0xbc, 0x97, 0x5c, 0xc7, 0x5f, 0x29, 0xff, 0x71, 0x1c, 0x12, 0x9c, 0x19, 0xcf, 0x43, 0x92,
-		0xb3, 0x95, 0x4b, 0xe6, 0xfd, 0x8b, 0x83, 0xc6, 0x68, 0xd1, 0x35, 0x1a, 0x9c, 0x9e, 0xc0, 0x41,
-		0x8f, 0x40, 0x4a, 0xdf, 0xd3, 0x0c, 0x53, 0x35, 0x9a, 0xdc, 0x01, 0xcc, 0xbc, 0xf5, 0xe6, 0x7c,
-		0xb2, 0x42, 0xea, 0x6a, 0xcb, 0x4a, 0x92, 0x36, 0xd6, 0x9a, 0xc4, 0xf2, 0xef, 0x61, 0x63, 0x77,
-		0xcf, 0xe1, 0xbb, 0x8b, 0x97, 0xd0, 0xb3, 0x10, 0x27, 0x02, 0xc1, 0xbf, 0xf2, 0x2e, 0x0e, 0xf8,
-		0xf1, 0x6e, 0xa2, 0xa5, 0x9c, 0x22, 0x1d, 0x7f, 0xf4, 0x3f, 0xcd, 0x47, 0x14, 0x8a, 0x81, 0x2a,
-		0x90, 0x6b, 0x69, 0xb6, 0xa3, 0x52, 0xab, 0x45, 0xba, 0x9f, 0xa4, 0x24, 0x4e, 0x0e, 0x32, 0x84,
-		0x33, 0x96, 0x0f, 0x3d, 0x43, 0xb0, 0x58, 0x55, 0x13, 0x9d, 0x01, 0x89, 0x12, 0xd1, 0xad, 0x76,
-		0xdb, 0x70, 0x98, 0x2f, 0x95, 0xa0, 0x7c, 0xcf, 0x93, 0xfa, 0x0a, 0xad, 0xa6, 0x1e, 0xd5, 0x29,
-		0x48, 0xd3, 0xef, 0x4a, 0x29, 0x08, 0xbb, 0x2c, 0x9e, 0x22, 0x15, 0xb4, 0xf1, 0x34, 0x14, 0x3c,
-		0xdd, 0xc8, 0x40, 0x52, 0x8c, 0x8a, 0x57, 0x4d, 0x01, 0x9f, 0x80, 0x19, 0x13, 0xef, 0xd3, 0xeb,
-		0xeb, 0x01, 0xe8, 0x34, 0x85, 0x46, 0xa4, 0xed, 0x46, 0x10, 0xe3, 0x61, 0xc8, 0xeb, 0x82, 0xf9,
-		0x0c, 0x16, 0x28, 0x6c, 0xce, 0xad, 0xa5, 0x60, 0x27, 0x21, 0xa5, 0x75, 0x3a, 0x0c, 0x20, 0xc3,
-		0x75, 0x63, 0xa7, 0x43, 0x9b, 0xce, 0xc1, 0x14, 0x9d, 0x63, 0x17, 0xdb, 0xbd, 0x96, 0xc3, 0x89,
-		0x64, 0x29, 0x4c, 0x81, 0x34, 0x28, 0xac, 0x9e, 0xc2, 0x3e, 0x08, 0x39, 0x7c, 0xcb, 0x68, 0x62,
-		0x53, 0xc7, 0x0c, 0x2e, 0x47, 0xe1, 0xb2, 0xa2, 0x92, 0x02, 0x9d, 0x05, 0x57, 0xe7, 0xa9, 0x42,
-		0x1f, 0xe7, 0x19, 0x3d, 0x51, 0x5f, 0x62, 0xd5, 0xf2, 0x2c, 0xc4, 0x97, 0x35, 0x47, 0x23, 0x4e,
-		0x85, 0xb3, 0xcf, 0x8c, 0x4c, 0x56, 0x21, 0x3f, 0xe5, 0x2f, 0x44, 0x21, 0x7e, 0xc3, 0x72, 0x30,
-		0x7a, 0xca, 0xe7, 0xf0, 0xe5, 0x87, 0xc9, 0x73, 0xdd, 0xd8, 0x35, 0x71, 0x73, 0xcd, 0xde, 0xf5,
-		0x3d, 0x02, 0xe3, 0x89, 0x53, 0x34, 0x20, 0x4e, 0x33, 0x30, 0xd9, 0xb5, 0x7a, 0x66, 0x53, 0x5c,
-		0xb3, 0xa6, 0x05, 0x54, 0x85, 0x94, 0x2b, 0x25, 0xf1, 0x30, 0x29, 0x29, 0x10, 0x29, 0x21, 0x32,
-		0xcc, 0x2b, 0x94, 0xe4, 0x36, 0x17, 0x96, 0x32, 0xa4, 0x5d, 0xe5, 0xc5, 0xa5, 0x6d, 0x3c, 0x81,
-		0xf5, 0xd0, 0x88, 0x21, 0x71, 0xd7, 0xde, 0x65, 0x1e, 0x93, 0x38, 0xc9, 0x6d, 0xe0, 0xdc, 0x0b,
-		0x88, 0x15, 0x7f, 0x90, 0x26, 0x49, 0xe7, 0xe5, 0x89, 0x15, 0x7b, 0x94, 0xe6, 0x3e, 0x48, 0xdb,
-		0xc6, 0xae, 0xa9, 0x39, 0xbd, 0x2e, 0xe6, 0x92, 0xe7, 0x55, 0xc8, 0xbf, 0x19, 0x81, 0x04, 0x93,
-		0x64, 0x1f, 0xdf, 0x22, 0xc3, 0xf9, 0x16, 0x1d, 0xc5, 0xb7, 0xd8, 0xbd, 0xf3, 0xad, 0x04, 0xe0,
-		0x0e, 0xc6, 0xe6, 0xef, 0x84, 0x0c, 0xf1, 0x16, 0xd8, 0x10, 0xeb, 0xc6, 0x2e, 0xdf, 0xa8, 0x3e,
-		0x24, 0xf9, 0x3f, 0x46, 0x88, 0xe3, 0xca, 0xdb, 0x51, 0x09, 0x72, 0x62, 0x5c, 0xea, 0x4e, 0x4b,
-		0xdb, 0xe5, 0xb2, 0x73, 0xff, 0xc8, 0xc1, 0x5d, 0x69, 0x69, 0xbb, 0x4a, 0x86, 0x8f, 0x87, 0x14,
-		0x86, 0xaf, 0x43, 0x74, 0xc4, 0x3a, 0x04, 0x16, 0x3e, 0x76, 0x6f, 0x0b, 0x1f, 0x58, 0xa2, 0x78,
-		0xff, 0x12, 0xfd, 0x52, 0x94, 0x06, 0x2f, 0x1d, 0xcb, 0xd6, 0x5a, 0xef, 0xc5, 0x8e, 0x38, 0x05,
-		0xe9, 0x8e, 0xd5, 0x52, 0x59, 0x0b, 0xfb, 0xfc, 0x20, 0xd5, 0xb1, 0x5a, 0xca, 0xc0, 0xb2, 0x4f,
-		0xbe, 0x43, 0xdb, 0x25, 0xf1, 0x0e, 0x70, 0x2d, 0xd9, 0xcf, 0xb5, 0x2e, 0x64, 0x19, 0x2b, 0xb8,
-		0x2d, 0x7b, 0x82, 0xf0, 0x80, 0x1a, 0xc7, 0xc8, 0xa0, 0xed, 0x65, 0xc3, 0x66, 0x90, 0x0a, 0x87,
-		0x23, 0x18, 0x4c, 0xf5, 0x0f, 0x8b, 0x7a, 0xfd, 0x62, 0xa9, 0x70, 0x38, 0xf9, 0x87, 0x22, 0x00,
-		0xab, 0x84, 0xb3, 0x74, 0xbe, 0xc4, 0x0a, 0xd9, 0x74, 0x08, 0x6a, 0xa0, 0xe7, 0xb9, 0x51, 0x8b,
-		0xc6, 0xfb, 0xcf, 0xda, 0xfe, 0x71, 0x57, 0x20, 0xe7, 0x09, 0xa3, 0x8d, 0xc5, 0x60, 0xe6, 0x0e,
-		0xf1, 0xa8, 0xeb, 0xd8, 0x51, 0xb2, 0xb7, 0x7c, 0x25, 0xf9, 0x9f, 0x44, 0x20, 0x4d, 0xc7, 0xb4,
-		0x86, 0x1d, 0x2d, 0xb0, 0x86, 0x91, 0x7b, 0x5f, 0xc3, 0xfb, 0x01, 0x18, 0x19, 0xdb, 0x78, 0x0d,
-		0x73, 0xc9, 0x4a, 0xd3, 0x9a, 0xba, 0xf1, 0x1a, 0x46, 0x97, 0x5c, 0x86, 0xc7, 0x0e, 0x67, 0xb8,
-		0xf0, 0xb8, 0x39, 0xdb, 0x4f, 0x40, 0x92, 0xbe, 0xab, 0xb7, 0x6f, 0x73, 0x27, 0x3a, 0x61, 0xf6,
-		0xda, 0x8d, 0x7d, 0x5b, 0x7e, 0x15, 0x92, 0x8d, 0x7d, 0x96, 0x0b, 0x39, 0x05, 0xe9, 0xae, 0x65,
-		0x71, 0x9b, 0xcc, 0x7c, 0xa1, 0x14, 0xa9, 0xa0, 0x26, 0x48, 0xc4, 0xff, 0x51, 0x2f, 0xfe, 0xf7,
-		0x12, 0x18, 0xb1, 0xb1, 0x12, 0x18, 0xe7, 0xfe, 0x5d, 0x04, 0x32, 0x3e, 0xfd, 0x80, 0x9e, 0x84,
-		0x63, 0xe5, 0xd5, 0x8d, 0xca, 0x75, 0xb5, 0xb6, 0xac, 0x5e, 0x59, 0x2d, 0xad, 0x78, 0x5f, 0xd8,
-		0x15, 0x8f, 0xdf, 0xb9, 0xbb, 0x80, 0x7c, 0xb0, 0x5b, 0x26, 0x3d, 0xcd, 0x41, 0xe7, 0x61, 0x26,
-		0x88, 0x52, 0x2a, 0xd7, 0xab, 0xeb, 0x0d, 0x29, 0x52, 0x3c, 0x76, 0xe7, 0xee, 0xc2, 0x94, 0x0f,
-		0xa3, 0xb4, 0x6d, 0x63, 0xd3, 0x19, 0x44, 0xa8, 0x6c, 0xac, 0xad, 0xd5, 0x1a, 0x52, 0x74, 0x00,
-		0x81, 0x2b, 0xec, 0xb3, 0x30, 0x15, 0x44, 0x58, 0xaf, 0xad, 0x4a, 0xb1, 0x22, 0xba, 0x73, 0x77,
-		0x21, 0xef, 0x83, 0x5e, 0x37, 0x5a, 0xc5, 0xd4, 0x47, 0x7e, 0x6a, 0x6e, 0xe2, 0x93, 0x7f, 0x67,
-		0x2e, 0x42, 0x66, 0x96, 0x0b, 0xe8, 0x08, 0xf4, 0x18, 0x9c, 0xa8, 0xd7, 0x56, 0xd6, 0xab, 0xcb,
-		0xea, 0x5a, 0x7d, 0xa5, 0xef, 0xa3, 0xe9, 0x62, 0xe1, 0xce, 0xdd, 0x85, 0x0c, 0x9f, 0xd2, 0x28,
-		0xe8, 0x4d, 0xa5, 0x7a, 0x63, 0xa3, 0x51, 0x95, 0x22, 0x0c, 0x7a, 0xb3, 0x8b, 0x6f, 0x59, 0x0e,
-		0x7b, 0x92, 0xf3, 0x09, 0x38, 0x39, 0x04, 0xda, 0x9d, 0xd8, 0xd4, 0x9d, 0xbb, 0x0b, 0xb9, 0xcd,
-		0x2e, 0x66, 0xfb, 0x87, 0x62, 0x2c, 0xc2, 0xec, 0x20, 0xc6, 0xc6, 0xe6, 0x46, 0xbd, 0xb4, 0x2a,
-		0x2d, 0x14, 0xa5, 0x3b, 0x77, 0x17, 0xb2, 0x42, 0x19, 0x12, 0x78, 0x6f, 0x66, 0xef, 0x56, 0xb4,
-		0xf3, 0xdd, 0xc9, 0x40, 0x2e, 0x8f, 0xc5, 0x11, 0x1d, 0xad, 0xab, 0xb5, 0x8f, 0x1a, 0xee, 0x84,
-		0x1c, 0xe7, 0xca, 0xaf, 0x47, 0xa1, 0xe0, 0x3a, 0xd3, 0x9b, 0xb4, 0x07, 0xf4, 0x94, 0x3f, 0x0f,
-		0x93, 0x19, 0x69, 0xc6, 0x18, 0xb4, 0x48, 0xd3, 0xbc, 0x0f, 0x52, 0xc2, 0x29, 0xe3, 0xea, 0x62,
-		0x61, 0x10, 0xaf, 0xca, 0x21, 0x38, 0xaa, 0x8b, 0x81, 0x5e, 0x80, 0xb4, 0xab, 0x3c, 0xdc, 0x67,
-		0xab, 0x46, 0x6b, 0x1b, 0x8e, 0xef, 0xe1, 0xa0, 0xe7, 0xbc, 0xb0, 0x21, 0x3e, 0x2a, 0x10, 0xb9,
-		0xc1, 0x00, 0x38, 0xb2, 0x80, 0x97, 0x6b, 0x7c, 0xdb, 0xf1, 0xd9, 0xd3, 0x27, 0x3f, 0xf6, 0x55,
-		0x16, 0x49, 0x31, 0xaf, 0x24, 0xd5, 0xd6, 0xf6, 0xcb, 0x34, 0x98, 0x3a, 0x01, 0x49, 0xd2, 0xb8,
-		0xcb, 0x3f, 0x2a, 0x8f, 0x29, 0x89, 0xb6, 0xb6, 0xbf, 0xa2, 0xd9, 0xd7, 0xe2, 0xa9, 0x98, 0x14,
-		0x97, 0x7f, 0x36, 0x02, 0xf9, 0xe0, 0x1c, 0xd1, 0xa3, 0x80, 0x08, 0x86, 0xb6, 0x8b, 0x55, 0xa2,
-		0x62, 0x28, 0xb3, 0x04, 0xdd, 0x42, 0x5b, 0xdb, 0x2f, 0xed, 0xe2, 0xf5, 0x5e, 0x9b, 0x0e, 0xc0,
-		0x46, 0x6b, 0x20, 0x09, 0x60, 0xb1, 0x4e, 0x9c, 0x99, 0x27, 0x07, 0x1f, 0xb1, 0xe4, 0x00, 0xcc,
-		0x50, 0x7d, 0x8c, 0x18, 0xaa, 0x3c, 0xa3, 0xe7, 0x1e, 0xc7, 0x07, 0xa6, 0x12, 0x0b, 0x4e, 0x45,
-		0x7e, 0x01, 0x0a, 0x7d, 0xfc, 0x44, 0x32, 0xe4, 0x78, 0x56, 0x80, 0x1e, 0x75, 0x32, 0xff, 0x38,
-		0xad, 0x64, 0x58, 0xf4, 0x4f, 0x8f, 0x7e, 0x97, 0x52, 0xbf, 0xfa, 0xc6, 0x7c, 0x84, 0x26, 0xcc,
-		0x1f, 0x85, 0x5c, 0x80, 0xa3, 0x22, 0x53, 0x17, 0xf1, 0x32, 0x75, 0x1e, 0xf0, 0x2b, 0x90, 0x25,
-		0x8a, 0x12, 0x37, 0x39, 0xec, 0x23, 0x50, 0x60, 0x8a, 0xbc, 0x9f, 0xd7, 0xcc, 0x93, 0x5a, 0x13,
-		0x0c, 0x97, 0x85, 0x6b, 0x15, 0x64, 0x7b, 0x46, 0x40, 0xad, 0x68, 0x76, 0xf9, 0x03, 0x9f, 0x7c,
-		0x6b, 0x2e, 0xf2, 0xee, 0x6c, 0xc4, 0xdf, 0xfd, 0x00, 0x9c, 0xf2, 0x35, 0x6a, 0xdb, 0xba, 0x11,
-		0xc8, 0x3a, 0x14, 0x7c, 0x42, 0x46, 0x1a, 0xc3, 0xb2, 0x07, 0x87, 0xe6, 0x30, 0x0e, 0x4f, 0x98,
-		0x15, 0x0f, 0xd7, 0x08, 0xe1, 0x89, 0x8d, 0xe1, 0x39, 0xca, 0xff, 0x93, 0x82, 0xa4, 0x82, 0x3f,
-		0xd4, 0xc3, 0xb6, 0x83, 0x2e, 0x40, 0x1c, 0xeb, 0x7b, 0xd6, 0xb0, 0x94, 0x10, 0x99, 0xdc, 0x22,
-		0x87, 0xab, 0xea, 0x7b, 0xd6, 0xd5, 0x09, 0x85, 0xc2, 0xa2, 0x8b, 0x30, 0xb9, 0xd3, 0xea, 0xf1,
-		0x3c, 0x45, 0x9f, 0xb2, 0xf0, 0x23, 0x5d, 0x21, 0x40, 0x57, 0x27, 0x14, 0x06, 0x4d, 0xba, 0xa2,
-		0x0f, 0x05, 0xc7, 0x0e, 0xef, 0xaa, 0x66, 0xee, 0xd0, 0xae, 0x08, 0x2c, 0x2a, 0x03, 0x18, 0xa6,
-		0xe1, 0xa8, 0x34, 0x86, 0xe7, 0x9e, 0xe0, 0x03, 0xa3, 0x31, 0x0d, 0x87, 0x46, 0xfd, 0x57, 0x27,
-		0x94, 0xb4, 0x21, 0x0a, 0x64, 0xb8, 0x1f, 0xea, 0xe1, 0xee, 0x01, 0x77, 0x00, 0x47, 0x0e, 0xf7,
-		0x03, 0x04, 0x88, 0x0c, 0x97, 0x42, 0xa3, 0x2a, 0x64, 0xe8, 0x17, 0xb2, 0x6c, 0xff, 0xf2, 0xa7,
-		0x69, 0xe5, 0x51, 0xc8, 0x65, 0x02, 0x4a, 0xb7, 0xf4, 0xd5, 0x09, 0x05, 0xb6, 0xdd, 0x12, 0x51,
-		0x92, 0xec, 0xe9, 0x32, 0x67, 0x9f, 0x3f, 0xc8, 0x39, 0x3f, 0x8a, 0x06, 0x7d, 0xbf, 0xac, 0xb1,
-		0x7f, 0x75, 0x42, 0x49, 0xea, 0xec, 0x27, 0x99, 0x7f, 0x13, 0xb7, 0x8c, 0x5b, 0xb8, 0x4b, 0xf0,
-		0xd3, 0x87, 0xcf, 0x7f, 0x99, 0x41, 0x52, 0x0a, 0xe9, 0xa6, 0x28, 0x10, 0x45, 0x8b, 0xcd, 0x26,
-		0x9f, 0x06, 0x0c, 0xea, 0xe9, 0xc0, 0x3a, 0x9b, 0x4d, 0x31, 0x89, 0x14, 0xe6, 0xbf, 0xd1, 0xb3,
-		0xae, 0x87, 0x9a, 0x19, 0x74, 0x0a, 0x03, 0x13, 0x60, 0xb9, 0x8a, 0x09, 0xe1, 0xa9, 0xa2, 0x75,
-		0xc8, 0xb7, 0x0c, 0xdb, 0x51, 0x6d, 0x53, 0xeb, 0xd8, 0x7b, 0x96, 0x63, 0xd3, 0xa0, 0x3f, 0x73,
-		0xe1, 0xe1, 0x51, 0x14, 0x56, 0x0d, 0xdb, 0xa9, 0x0b, 0xe0, 0xab, 0x13, 0x4a, 0xae, 0xe5, 0xaf,
-		0x20, 0xf4, 0xac, 0x9d, 0x1d, 0xdc, 0x75, 0x09, 0xd2, 0xe4, 0xc0, 0x21, 0xf4, 0x36, 0x08, 0xb4,
-		0xc0, 0x27, 0xf4, 0x2c, 0x7f, 0x05, 0xfa, 0x66, 0x98, 0x6e, 0x59, 0x5a, 0xd3, 0x25, 0xa7, 0xea,
-		0x7b, 0x3d, 0xf3, 0x26, 0xcd, 0x24, 0x64, 0x2e, 0x9c, 0x1d, 0x39, 0x48, 0x4b, 0x6b, 0x0a, 0x12,
-		0x15, 0x82, 0x70, 0x75, 0x42, 0x99, 0x6a, 0xf5, 0x57, 0xa2, 0x0f, 0xc2, 0x8c, 0xd6, 0xe9, 0xb4,
-		0x0e, 0xfa, 0xa9, 0x17, 0x28, 0xf5, 0x73, 0xa3, 0xa8, 0x97, 0x08, 0x4e, 0x3f, 0x79, 0xa4, 0x0d,
-		0xd4, 0xa2, 0x06, 0x48, 0x9d, 0x2e, 0xa6, 0x1f, 0xd5, 0x74, 0xb8, 0xab, 0x42, 0x9f, 0x1c, 0xca,
-		0x5c, 0x38, 0x3d, 0x8a, 0xf6, 0x26, 0x83, 0x17, 0x9e, 0xcd, 0xd5, 0x09, 0xa5, 0xd0, 0x09, 0x56,
-		0x31, 0xaa, 0x96, 0x8e, 0xe9, 0x8b, 0x68, 0x9c, 0xea, 0x54, 0x18, 0x55, 0x0a, 0x1f, 0xa4, 0x1a,
-		0xa8, 0x2a, 0x27, 0xf9, 0xa5, 0x38, 0xfe, 0x62, 0xcc, 0x69, 0xc8, 0xf8, 0x14, 0x0b, 0x9a, 0x85,
-		0x24, 0xbf, 0x24, 0x20, 0x2e, 0xd3, 0xf1, 0xa2, 0x9c, 0x87, 0xac, 0x5f, 0x99, 0xc8, 0x1f, 0x8d,
-		0xb8, 0x98, 0xf4, 0x53, 0xfb, 0xd9, 0x60, 0xea, 0x30, 0xed, 0x65, 0x05, 0x1f, 0x14, 0x56, 0x44,
-		0xb4, 0xb3, 0xd3, 0xa4, 0x2c, 0xad, 0xe4, 0x46, 0x0c, 0xcd, 0x43, 0xa6, 0x73, 0xa1, 0xe3, 0x82,
-		0xc4, 0x28, 0x08, 0x74, 0x2e, 0x74, 0x04, 0xc0, 0x03, 0x90, 0x25, 0x33, 0x55, 0xfd, 0x8e, 0x46,
-		0x5a, 0xc9, 0x90, 0x3a, 0x0e, 0x22, 0xff, 0xab, 0x28, 0x48, 0xfd, 0x0a, 0xc8, 0xcd, 0x29, 0x46,
-		0x8e, 0x9c, 0x53, 0x3c, 0xd9, 0x9f, 0xcd, 0xf4, 0x12, 0x98, 0xab, 0x20, 0x79, 0x79, 0x38, 0x66,
-		0x08, 0x46, 0x3b, 0x4e, 0x7d, 0x1e, 0x9e, 0x52, 0xd0, 0xfb, 0x5c, 0xbe, 0x2b, 0x81, 0xb3, 0x17,
-		0xf1, 0x12, 0x7e, 0xff, 0x12, 0xbb, 0xfe, 0xc2, 0x56, 0xa7, 0xa9, 0x39, 0x58, 0xe4, 0x45, 0x7c,
-		0xc7, 0x30, 0x8f, 0x40, 0x41, 0xeb, 0x74, 0x54, 0xdb, 0xd1, 0x1c, 0xcc, 0xcd, 0xfa, 0x24, 0x4b,
-		0x0f, 0x6a, 0x9d, 0x4e, 0x9d, 0xd4, 0x32, 0xb3, 0xfe, 0x30, 0xe4, 0x89, 0x4e, 0x36, 0xb4, 0x96,
-		0xca, 0xb3, 0x04, 0x09, 0x66, 0xfd, 0x79, 0xed, 0x55, 0x5a, 0x29, 0x37, 0xdd, 0x15, 0xa7, 0xfa,
-		0xd8, 0x0d, 0xb3, 0x22, 0xbe, 0x30, 0x0b, 0xf1, 0x27, 0x10, 0x18, 0x7f, 0xc4, 0xb3, 0x11, 0xc3,
-		0xb3, 0xbb, 0x33, 0x34, 0x24, 0xbb, 0xc5, 0x12, 0x1e, 0x29, 0x85, 0x15, 0xe4, 0x0f, 0x47, 0x61,
-		0x6a, 0x40, 0x73, 0x0f, 0x4d, 0x7b, 0x7b, 0xf1, 0x65, 0xf4, 0x48, 0xf1, 0xe5, 0xf5, 0x60, 0x5a,
-		0xd7, 0x67, 0xf9, 0x4e, 0x0d, 0x30, 0x99, 0xe9, 0x4d, 0x22, 0xd0, 0x9c, 0x88, 0x2f, 0xf3, 0x4b,
-		0xc5, 0x7c, 0x0b, 0x66, 0xb6, 0x0f, 0x5e, 0xd3, 0x4c, 0xc7, 0x30, 0xb1, 0x3a, 0xb0, 0x6a, 0x83,
-		0xa6, 0x74, 0xcd, 0xb0, 0xb7, 0xf1, 0x9e, 0x76, 0xcb, 0xb0, 0xc4, 0xb0, 0xa6, 0x5d, 0x7c, 0x2f,
-		0xb9, 0x2b, 0x2b, 0x90, 0x0f, 0x9a, 0x1e, 0x94, 0x87, 0xa8, 0xb3, 0xcf, 0xe7, 0x1f, 0x75, 0xf6,
-		0xd1, 0x13, 0x3c, 0x0f, 0x14, 0xa5, 0x79, 0xa0, 0xc1, 0x8e, 0x38, 0x9e, 0x97, 0x04, 0x92, 0x65,
-		0x77, 0x37, 0xb8, 0xe6, 0xa8, 0x9f, 0xaa, 0x7c, 0x16, 0x0a, 0x7d, 0xf6, 0x66, 0x54, 0x56, 0x50,
-		0x2e, 0x40, 0x2e, 0x60, 0x5c, 0xe4, 0xe3, 0x30, 0x33, 0xcc, 0x56, 0xc8, 0x7b, 0x6e, 0x7d, 0x40,
-		0xe7, 0xa3, 0x8b, 0x90, 0x72, 0x8d, 0xc5, 0x90, 0x2c, 0x04, 0x9d, 0x85, 0x00, 0x56, 0x5c, 0xd0,
-		0x40, 0x32, 0x3b, 0x1a, 0x48, 0x66, 0xcb, 0xdf, 0x06, 0xb3, 0xa3, 0x0c, 0x41, 0xdf, 0x34, 0xe2,
-		0xae, 0x14, 0x1e, 0x87, 0x04, 0x7f, 0xf6, 0x30, 0x4a, 0x8f, 0x6f, 0x78, 0x89, 0x48, 0x27, 0x33,
-		0x0a, 0x31, 0x76, 0xaa, 0x43, 0x0b, 0xb2, 0x0a, 0x27, 0x47, 0x1a, 0x83, 0xd1, 0x07, 0x41, 0x8c,
-		0x10, 0x3f, 0x08, 0xd2, 0xc5, 0x70, 0x6c, 0x3a, 0x57, 0x71, 0xd9, 0x81, 0x95, 0xe4, 0x8f, 0xc5,
-		0xe0, 0xf8, 0x70, 0x93, 0x80, 0x16, 0x20, 0x4b, 0xfc, 0x6e, 0x27, 0xe8, 0xa2, 0x43, 0x5b, 0xdb,
-		0x6f, 0x70, 0xff, 0x9c, 0x27, 0xd2, 0xa3, 0x6e, 0x22, 0x1d, 0x6d, 0xc1, 0x54, 0xcb, 0xd2, 0xb5,
-		0x96, 0xea, 0x93, 0x78, 0x2e, 0xec, 0x0f, 0x0e, 0x30, 0xbb, 0xca, 0x1e, 0x43, 0x6f, 0x0e, 0x08,
-		0x7d, 0x81, 0xd2, 0x58, 0x75, 0x25, 0x1f, 0x2d, 0x43, 0xa6, 0xed, 0x09, 0xf2, 0x11, 0x84, 0xdd,
-		0x8f, 0xe6, 0x5b, 0x92, 0xc9, 0xa1, 0xc7, 0x3e, 0x89, 0x23, 0xab, 0xe8, 0x51, 0x27, 0x28, 0xc9,
-		0x91, 0x27, 0x28, 0xc3, 0x8e, 0x2b, 0x52, 0xc3, 0x8f, 0x2b, 0x3e, 0xe2, 0x5f, 0x9a, 0x80, 0x11,
-		0x1d, 0x3c, 0xc1, 0x40, 0x75, 0x98, 0xe1, 0xf8, 0xcd, 0x00, 0xef, 0xa3, 0xe3, 0x2a, 0x1a, 0x24,
-		0xd0, 0x47, 0xb3, 0x3d, 0x76, 0x6f, 0x6c, 0x17, 0xba, 0x34, 0xee, 0xd3, 0xa5, 0xff, 0x9f, 0x2d,
-		0xc5, 0xbf, 0x49, 0x43, 0x4a, 0xc1, 0x76, 0x87, 0x18, 0x4e, 0x54, 0x86, 0x34, 0xde, 0xd7, 0x71,
-		0xc7, 0xf1, 0x8e, 0x29, 0x87, 0x05, 0x03, 0x0c, 0xba, 0x2a, 0x20, 0x89, 0x27, 0xee, 0xa2, 0xa1,
-		0xa7, 0x78, 0xb0, 0x35, 0x3a, 0x6e, 0xe2, 0xe8, 0xfe, 0x68, 0xeb, 0x92, 0x88, 0xb6, 0x62, 0x23,
-		0x9d, 0x6f, 0x86, 0xd5, 0x17, 0x6e, 0x3d, 0xc5, 0xc3, 0xad, 0x78, 0x48, 0x67, 0x81, 0x78, 0xab,
-		0x12, 0x88, 0xb7, 0x12, 0x21, 0xd3, 0x1c, 0x11, 0x70, 0x5d, 0x12, 0x01, 0x57, 0x32, 0x64, 0xc4,
-		0x7d, 0x11, 0xd7, 0x95, 0x60, 0xc4, 0x95, 0x1a, 0xa1, 0x40, 0x04, 0xf6, 0xc8, 0x90, 0xeb, 0x79,
-		0x5f, 0xc8, 0x95, 0x1e, 0x19, 0xef, 0x30, 0x22, 0x43, 0x62, 0xae, 0x4a, 0x20, 0xe6, 0x82, 0x10,
-		0x1e, 0x8c, 0x08, 0xba, 0xbe, 0xc9, 0x1f, 0x74, 0x65, 0x46, 0xc6, 0x6d, 0x7c, 0xbd, 0x87, 0x45,
-		0x5d, 0xcf, 0xb9, 0x51, 0x57, 0x76, 0x64, 0xd8, 0xc8, 0xe7, 0xd0, 0x1f, 0x76, 0x6d, 0x0c, 0x84,
-		0x5d, 0x39, 0xfe, 0x77, 0x46, 0x46, 0x91, 0x08, 0x89, 0xbb, 0x36, 0x06, 0xe2, 0xae, 0x7c, 0x08,
-		0xc1, 0x90, 0xc0, 0xeb, 0x5b, 0x86, 0x07, 0x5e, 0xa3, 0x43, 0x23, 0x3e, 0xcc, 0xf1, 0x22, 0x2f,
-		0x75, 0x44, 0xe4, 0xc5, 0xa2, 0xa3, 0x47, 0x47, 0x92, 0x1f, 0x3b, 0xf4, 0xda, 0x1a, 0x12, 0x7a,
-		0xb1, 0x20, 0xe9, 0xcc, 0x48, 0xe2, 0x63, 0xc4, 0x5e, 0x5b, 0x43, 0x62, 0x2f, 0x14, 0x4a, 0xf6,
-		0x28, 0xc1, 0xd7, 0xa4, 0x94, 0x90, 0xcf, 0x12, 0xd7, 0xb7, 0x4f, 0x4f, 0x11, 0xff, 0x01, 0x77,
-		0xbb, 0x56, 0x57, 0xdc, 0xac, 0xa5, 0x05, 0xf9, 0x0c, 0x71, 0xc6, 0x3d, 0x9d, 0x74, 0x48, 0xa0,
-		0x46, 0xfd, 0x34, 0x9f, 0x1e, 0x92, 0x7f, 0x35, 0xe2, 0xe1, 0x52, 0x1f, 0xd6, 0xef, 0xc8, 0xa7,
-		0xb9, 0x23, 0xef, 0x0b, 0xdf, 0xa2, 0xc1, 0xf0, 0x6d, 0x1e, 0x32, 0xc4, 0xff, 0xea, 0x8b, 0xcc,
-		0xb4, 0x8e, 0x1b, 0x99, 0x89, 0x2b, 0x05, 0x2c, 0xc8, 0xe3, 0x66, 0x85, 0x9d, 0xe4, 0x14, 0xdc,
-		0xeb, 0x15, 0x2c, 0xa6, 0x40, 0x8f, 0xc3, 0xb4, 0x0f, 0xd6, 0xf5, 0xeb, 0x58, 0x98, 0x22, 0xb9,
-		0xd0, 0x25, 0xee, 0xe0, 0xfd, 0xd3, 0x88, 0xc7, 0x21, 0x2f, 0xa4, 0x1b, 0x16, 0x7d, 0x45, 0xde,
-		0xa1, 0xe8, 0x2b, 0x7a, 0xcf, 0xd1, 0x97, 0xdf, 0x4f, 0x8d, 0x05, 0xfd, 0xd4, 0xff, 0x1e, 0xf1,
-		0xd6, 0xc4, 0x8d, 0xa5, 0x74, 0xab, 0x89, 0xb9, 0xe7, 0x48, 0x7f, 0x13, 0xa7, 0xa2, 0x65, 0xed,
-		0x72, 0xff, 0x90, 0xfc, 0x24, 0x50, 0xae, 0xe1, 0x48, 0x73, 0xbb, 0xe0, 0x3a, 0x9d, 0x93, 0xfe,
-		0xfb, 0xbf, 0xfc, 0x52, 0x6c, 0xc2, 0xbb, 0x14, 0xeb, 0x7e, 0xfc, 0x96, 0xf4, 0x7d, 0xfc, 0x86,
-		0x9e, 0x85, 0x34, 0xcd, 0x88, 0xaa, 0x56, 0x47, 0xfc, 0x55, 0x9a, 0x53, 0xa3, 0x2f, 0xc4, 0xda,
-		0xf4, 0xaa, 0x1e, 0xbb, 0x44, 0xeb, 0x79, 0x0c, 0xe9, 0x80, 0xc7, 0x70, 0x1f, 0xa4, 0xc9, 0xe8,
-		0xd9, 0xeb, 0xea, 0xc0, 0xbf, 0x9c, 0x14, 0x15, 0xf2, 0x07, 0x01, 0x0d, 0x1a, 0x09, 0x74, 0x15,
-		0x12, 0xf8, 0x16, 0x7d, 0xd5, 0x8f, 0x5d, 0x34, 0x3c, 0x3e, 0xe8, 0x9a, 0x92, 0xe6, 0xf2, 0x2c,
-		0x61, 0xf2, 0x17, 0xdf, 0x9c, 0x97, 0x18, 0xf4, 0x63, 0xee, 0x87, 0x02, 0x0a, 0xc7, 0x97, 0xff,
-		0x43, 0x94, 0x04, 0x30, 0x01, 0x03, 0x32, 0x94, 0xb7, 0xc3, 0x8e, 0x08, 0xc7, 0xe3, 0xf7, 0x1c,
-		0xc0, 0xae, 0x66, 0xab, 0xb7, 0x35, 0xd3, 0xc1, 0x4d, 0xce, 0x74, 0x5f, 0x0d, 0x2a, 0x42, 0x8a,
-		0x94, 0x7a, 0x36, 0x6e, 0xf2, 0x30, 0xda, 0x2d, 0xfb, 0xe6, 0x99, 0xfc, 0xda, 0xe6, 0x19, 0xe4,
-		0x72, 0xaa, 0x8f, 0xcb, 0xbe, 0xe0, 0x22, 0xed, 0x0f, 0x2e, 0xd8, 0xc5, 0x60, 0x7e, 0x3f, 0x11,
-		0xd8, 0xd8, 0x44, 0x19, 0x3d, 0x08, 0xb9, 0x36, 0x6e, 0x77, 0x2c, 0xab, 0xa5, 0x32, 0x75, 0xc3,
-		0xfe, 0x8c, 0x42, 0x96, 0x57, 0x56, 0xa9, 0xd6, 0xf9, 0xae, 0xa8, 0xb7, 0xff, 0xbc, 0x20, 0xf2,
-		0x1b, 0x8e, 0xc1, 0xf4, 0xdd, 0xe9, 0x7e, 0x17, 0x01, 0xd5, 0xfd, 0xb7, 0x46, 0x7a, 0x54, 0x2d,
-		0x08, 0x81, 0x1e, 0x57, 0x7f, 0x78, 0xb7, 0x4b, 0x58, 0xb5, 0x8d, 0x5e, 0x86, 0x13, 0x7d, 0xba,
-		0xcd, 0x25, 0x1d, 0x1d, 0x57, 0xc5, 0x1d, 0x0b, 0xaa, 0x38, 0x41, 0xda, 0x63, 0x56, 0xec, 0x6b,
-		0xdc, 0x75, 0x35, 0xc8, 0x07, 0x3d, 0x9e, 0xa1, 0xcb, 0x4f, 0xff, 0x6c, 0x8f, 0xa3, 0x19, 0xa6,
-		0x1a, 0x48, 0x07, 0x65, 0x59, 0x25, 0x4f, 0x32, 0x6d, 0xc2, 0xb1, 0xa1, 0x9e, 0x0f, 0x7a, 0x06,
-		0xd2, 0x9e, 0xd3, 0xc4, 0xb8, 0x7a, 0x48, 0xba, 0xc0, 0x83, 0x95, 0x7f, 0x23, 0xe2, 0x91, 0x0c,
-		0x26, 0x20, 0xaa, 0x90, 0x60, 0xd7, 0xde, 0xf8, 0x65, 0x9a, 0xc7, 0xc7, 0xf3, 0x99, 0x16, 0xd9,
-		0x9d, 0x38, 0x85, 0x23, 0xcb, 0x1f, 0x84, 0x04, 0xab, 0x41, 0x19, 0x48, 0x7a, 0x0f, 0xef, 0x02,
-		0x24, 0x4a, 0x95, 0x4a, 0x75, 0x53, 0xbc, 0xa2, 0x59, 0xde, 0x50, 0x1a, 0x52, 0x94, 0x54, 0x2b,
-		0xd5, 0x6b, 0xd5, 0x4a, 0x43, 0x8a, 0xa1, 0x29, 0xc8, 0xb1, 0xdf, 0xea, 0x95, 0x0d, 0x65, 0xad,
-		0xd4, 0x90, 0xe2, 0xbe, 0xaa, 0x7a, 0x75, 0x7d, 0xb9, 0xaa, 0x48, 0x93, 0xf2, 0x93, 0x70, 0x72,
-		0xa4, 0x97, 0xe5, 0x65, 0x17, 0x22, 0xbe, 0xec, 0x82, 0xfc, 0xb1, 0x28, 0x14, 0x47, 0xbb, 0x4e,
-		0xe8, 0x5a, 0xdf, 0xc4, 0x2f, 0x1c, 0xc1, 0xef, 0xea, 0x9b, 0x3d, 0x7a, 0x18, 0xf2, 0x5d, 0xbc,
-		0x83, 0x1d, 0x7d, 0x8f, 0xb9, 0x72, 0xcc, 0x64, 0xe6, 0x94, 0x1c, 0xaf, 0xa5, 0x48, 0x36, 0x03,
-		0x7b, 0x15, 0xeb, 0x8e, 0xca, 0x74, 0x91, 0xcd, 0xff, 0xb2, 0x68, 0x8e, 0xd5, 0xd6, 0x59, 0xa5,
-		0xfc, 0x6d, 0x47, 0xe2, 0x65, 0x1a, 0x26, 0x95, 0x6a, 0x43, 0x79, 0x59, 0x8a, 0x21, 0x04, 0x79,
-		0xfa, 0x53, 0xad, 0xaf, 0x97, 0x36, 0xeb, 0x57, 0x37, 0x08, 0x2f, 0xa7, 0xa1, 0x20, 0x78, 0x29,
-		0x2a, 0x27, 0xe5, 0x47, 0xe1, 0xc4, 0x08, 0xbf, 0x6f, 0xc8, 0x3d, 0xc4, 0x8f, 0x47, 0xfc, 0xd0,
-		0xc1, 0x98, 0x7f, 0x03, 0x12, 0xb6, 0xa3, 0x39, 0x3d, 0x9b, 0x33, 0xf1, 0x99, 0x71, 0x1d, 0xc1,
-		0x45, 0xf1, 0xa3, 0x4e, 0xd1, 0x15, 0x4e, 0x46, 0xbe, 0x08, 0xf9, 0x60, 0xcb, 0x68, 0x1e, 0x78,
-		0x42, 0x14, 0x95, 0x5f, 0x06, 0xf0, 0xe5, 0x23, 0xdd, 0x1b, 0x5d, 0x11, 0xff, 0x8d, 0xae, 0x8b,
-		0x30, 0x79, 0xcb, 0x62, 0x3a, 0x63, 0xf8, 0xc6, 0xb9, 0x61, 0x39, 0xd8, 0x97, 0x7c, 0x60, 0xd0,
-		0xb2, 0x01, 0x68, 0x30, 0x27, 0x34, 0xa2, 0x8b, 0xe7, 0x83, 0x5d, 0x3c, 0x30, 0x32, 0xbb, 0x34,
-		0xbc, 0xab, 0xd7, 0x60, 0x92, 0x6a, 0x9b, 0xa1, 0x9f, 0xf8, 0x7c, 0x2b, 0x80, 0xe6, 0x38, 0x5d,
-		0x63, 0xbb, 0xe7, 0x75, 0x30, 0x3f, 0x5c, 0x5b, 0x95, 0x04, 0x5c, 0xf9, 0x3e, 0xae, 0xb6, 0x66,
-		0x3c, 0x54, 0x9f, 0xea, 0xf2, 0x11, 0x94, 0xd7, 0x21, 0x1f, 0xc4, 0x1d, 0xfe, 0xc9, 0x92, 0xf7,
-		0x76, 0x40, 0x5a, 0xb8, 0x4f, 0xae, 0xf3, 0xc5, 0xdf, 0xf3, 0xa0, 0x05, 0xf9, 0x4e, 0x04, 0x52,
-		0x8d, 0x7d, 0x2e, 0xc7, 0x87, 0x5c, 0xaa, 0xf4, 0xbe, 0xdb, 0x72, 0x93, 0x85, 0x2c, 0x1f, 0x1b,
-		0x73, 0xb3, 0xbc, 0xdf, 0xe4, 0xee, 0xd4, 0xf8, 0xb8, 0xd1, 0xae, 0xc8, 0x76, 0x73, 0xed, 0x74,
-		0x79, 0xbc, 0x6f, 0x24, 0x66, 0x60, 0xd2, 0xff, 0x7d, 0x03, 0x2b, 0xc8, 0x4d, 0xdf, 0xb5, 0x04,
-		0x66, 0x36, 0xfc, 0x1f, 0x53, 0x44, 0x8e, 0xfc, 0x31, 0x85, 0xdb, 0x4b, 0xd4, 0xdf, 0xcb, 0x2d,
-		0x48, 0x09, 0xa1, 0x40, 0xef, 0xf7, 0xdf, 0x3d, 0x11, 0x67, 0x34, 0x23, 0x8d, 0x27, 0x27, 0xef,
-		0xbb, 0x7a, 0x72, 0x0e, 0xa6, 0xf8, 0x95, 0x3b, 0x2f, 0xae, 0xe0, 0x7f, 0x72, 0xa0, 0xc0, 0x1a,
-		0x56, 0x45, 0x50, 0x21, 0xff, 0x74, 0x04, 0xa4, 0x7e, 0xa9, 0x7c, 0x2f, 0x07, 0x40, 0x94, 0x22,
-		0x91, 0x7e, 0xdf, 0x9b, 0xdf, 0x6c, 0xe5, 0x73, 0xa4, 0xd6, 0x7b, 0xf5, 0xfb, 0xc3, 0x51, 0xc8,
-		0xf8, 0x72, 0x7a, 0xe8, 0xe9, 0xc0, 0x15, 0xd0, 0x85, 0xc3, 0xf2, 0x7f, 0xbe, 0x3b, 0xa0, 0x81,
-		0x89, 0x45, 0x8f, 0x3e, 0xb1, 0x77, 0xfe, 0x92, 0xfe, 0xf0, 0xaf, 0x7d, 0x26, 0x47, 0x7c, 0xed,
-		0xf3, 0x1d, 0x11, 0x48, 0xb9, 0xa6, 0xfb, 0xa8, 0xd9, 0xfc, 0xe3, 0x90, 0xe0, 0xd6, 0x89, 0xa5,
-		0xf3, 0x79, 0x69, 0x68, 0x2e, 0xb4, 0x08, 0x29, 0xf1, 0x87, 0xa2, 0x78, 0x20, 0xea, 0x96, 0xcf,
-		0x3d, 0x07, 0x19, 0xdf, 0xc1, 0x0a, 0xd1, 0x13, 0xeb, 0xd5, 0x17, 0xa5, 0x89, 0x62, 0xf2, 0xce,
-		0xdd, 0x85, 0xd8, 0x3a, 0xbe, 0x4d, 0x76, 0x98, 0x52, 0xad, 0x5c, 0xad, 0x56, 0xae, 0x4b, 0x91,
-		0x62, 0xe6, 0xce, 0xdd, 0x85, 0xa4, 0x82, 0x69, 0xfa, 0xea, 0xdc, 0x75, 0x28, 0xf4, 0x2d, 0x4c,
-		0x50, 0xbf, 0x23, 0xc8, 0x2f, 0x6f, 0x6d, 0xae, 0xd6, 0x2a, 0xa5, 0x46, 0x55, 0x65, 0xd7, 0xe9,
-		0xd0, 0x09, 0x98, 0x5e, 0xad, 0xad, 0x5c, 0x6d, 0xa8, 0x95, 0xd5, 0x5a, 0x75, 0xbd, 0xa1, 0x96,
-		0x1a, 0x8d, 0x52, 0xe5, 0xba, 0x14, 0xbd, 0xf0, 0x55, 0x80, 0x42, 0xa9, 0x5c, 0xa9, 0x11, 0xfb,
-		0x6c, 0xf0, 0x67, 0xdb, 0x2b, 0x10, 0xa7, 0xa9, 0x80, 0x43, 0xaf, 0x8a, 0x14, 0x0f, 0xcf, 0x6d,
-		0xa2, 0x2b, 0x30, 0x49, 0xb3, 0x04, 0xe8, 0xf0, 0xbb, 0x23, 0xc5, 0x90, 0x64, 0x27, 0x19, 0x0c,
-		0xdd, 0x4e, 0x87, 0x5e, 0x26, 0x29, 0x1e, 0x9e, 0xfb, 0x44, 0x0a, 0xa4, 0xbd, 0x28, 0x23, 0xfc,
-		0x72, 0x45, 0x71, 0x0c, 0xed, 0x88, 0x56, 0x21, 0x29, 0x02, 0xc3, 0xb0, 0xeb, 0x1e, 0xc5, 0xd0,
-		0xe4, 0x24, 0x61, 0x17, 0x0b, 0xe0, 0x0f, 0xbf, 0xbb, 0x52, 0x0c, 0xc9, 0xb4, 0xa2, 0x9a, 0x7b,
-		0x09, 0x3f, 0xe4, 0x0a, 0x47, 0x31, 0x2c, 0xd9, 0x48, 0x98, 0xe6, 0xa5, 0x46, 0xc2, 0x6f, 0xe4,
-		0x14, 0xc7, 0x48, 0x22, 0xa3, 0x2d, 0x00, 0x5f, 0xb8, 0x3e, 0xc6, 0x55, 0x9b, 0xe2, 0x38, 0xc9,
-		0x61, 0xb4, 0x01, 0x29, 0x37, 0x7a, 0x0a, 0xbd, 0xf8, 0x52, 0x0c, 0xcf, 0xd2, 0xa2, 0x0f, 0x42,
-		0x2e, 0x18, 0x35, 0x8c, 0x77, 0x9d, 0xa5, 0x38, 0x66, 0xfa, 0x95, 0xd0, 0x0f, 0x86, 0x10, 0xe3,
-		0x5d, 0x6f, 0x29, 0x8e, 0x99, 0x8d, 0x45, 0xaf, 0xc2, 0xd4, 0xa0, 0x8b, 0x3f, 0xfe, 0x6d, 0x97,
-		0xe2, 0x11, 0xf2, 0xb3, 0xa8, 0x0d, 0x68, 0x48, 0x68, 0x70, 0x84, 0xcb, 0x2f, 0xc5, 0xa3, 0xa4,
-		0x6b, 0x51, 0x13, 0x0a, 0xfd, 0xfe, 0xf6, 0xb8, 0x97, 0x61, 0x8a, 0x63, 0xa7, 0x6e, 0x59, 0x2f,
-		0x41, 0x3f, 0x7d, 0xdc, 0xcb, 0x31, 0xc5, 0xb1, 0x33, 0xb9, 0xe5, 0xd2, 0xc8, 0xfb, 0x8d, 0xa7,
-		0x0f, 0xbd, 0xdf, 0xe8, 0xdd, 0x58, 0x74, 0xef, 0x34, 0xfe, 0xf1, 0x13, 0xf0, 0x10, 0x7f, 0x4c,
-		0xc0, 0x76, 0xb4, 0x9b, 0x86, 0xb9, 0xeb, 0xbe, 0x0e, 0xc1, 0xcb, 0xfc, 0x72, 0xe3, 0x71, 0xfe,
-		0x60, 0x81, 0xa8, 0x0d, 0x79, 0x23, 0x62, 0xe4, 0xc3, 0x59, 0x61, 0x97, 0x90, 0xc3, 0xaf, 0x2e,
-		0x1e, 0xf2, 0xfe, 0x44, 0xc8, 0x2b, 0x17, 0x43, 0xde, 0xa7, 0x08, 0xb9, 0x84, 0x79, 0xd8, 0x7d,
-		0x4f, 0xf9, 0x07, 0x23, 0x90, 0xbf, 0x6a, 0xd8, 0x8e, 0xd5, 0x35, 0x74, 0xad, 0x45, 0x2d, 0xc6,
-		0xe5, 0x71, 0x3f, 0xf2, 0x28, 0xa7, 0x89, 0x33, 0xc2, 0x1f, 0xb5, 0xe0, 0x17, 0x43, 0x96, 0x21,
-		0x71, 0x4b, 0x6b, 0xb1, 0x4f, 0x2c, 0xfc, 0xef, 0xd7, 0xf4, 0xf3, 0xdc, 0xe7, 0x25, 0xf9, 0xa9,
-		0x30, 0x5c, 0xf9, 0x53, 0xf4, 0x12, 0x77, 0xbb, 0x6d, 0xd8, 0xec, 0x4f, 0xb9, 0x3b, 0xd8, 0x46,
-		0x5b, 0x10, 0xef, 0x6a, 0x0e, 0x0f, 0x6a, 0xca, 0x25, 0xfe, 0x14, 0xc5, 0x23, 0xe1, 0x0f, 0x4a,
-		0x2c, 0x0e, 0xbc, 0x56, 0xc1, 0x3a, 0xa3, 0xe4, 0xd0, 0xb7, 0x40, 0xaa, 0xad, 0xed, 0xab, 0x94,
-		0x74, 0xf4, 0x9d, 0x22, 0x9d, 0x6c, 0x6b, 0xfb, 0x64, 0xd4, 0xc8, 0x80, 0x02, 0xa1, 0xae, 0xef,
-		0x69, 0xe6, 0x2e, 0x66, 0x9d, 0xc4, 0xde, 0xa9, 0x4e, 0x72, 0x6d, 0x6d, 0xbf, 0x42, 0x09, 0x93,
-		0xae, 0xf8, 0xa3, 0x1f, 0xbf, 0x15, 0xe1, 0xa1, 0x2c, 0xe5, 0x1c, 0xd2, 0x40, 0xd2, 0xdd, 0x12,
-		0xed, 0x5f, 0xa4, 0xf5, 0x4f, 0x8f, 0x5a, 0x98, 0x3e, 0xbe, 0x97, 0x73, 0x64, 0xa4, 0x9f, 0x7d,
-		0x73, 0x3e, 0xc2, 0x7a, 0x2d, 0xe8, 0x7d, 0xeb, 0x72, 0x0d, 0x32, 0x2c, 0x9b, 0xa6, 0x52, 0x17,
-		0x36, 0x1a, 0xea, 0xc2, 0xe6, 0x84, 0x0b, 0xcb, 0x08, 0x02, 0xc3, 0x26, 0xed, 0x7c, 0x0e, 0x9f,
-		0x8c, 0x40, 0x66, 0xd9, 0xf7, 0x5c, 0xd5, 0x2c, 0x24, 0xdb, 0x96, 0x69, 0xdc, 0xc4, 0x5d, 0xf7,
-		0x5c, 0x86, 0x15, 0x89, 0x9b, 0xc9, 0xfe, 0xea, 0x91, 0x73, 0x20, 0x5e, 0x62, 0x10, 0x65, 0x82,
-		0x75, 0x1b, 0x6f, 0xdb, 0x86, 0x60, 0xb9, 0x22, 0x8a, 0xe8, 0x2c, 0x48, 0x36, 0xd6, 0x7b, 0x5d,
-		0xc3, 0x39, 0x50, 0x75, 0xcb, 0x74, 0x34, 0xdd, 0xe1, 0xd9, 0xd3, 0x82, 0xa8, 0xaf, 0xb0, 0x6a,
-		0x42, 0xa4, 0x89, 0x1d, 0xcd, 0x68, 0xb1, 0x6b, 0x5f, 0x69, 0x45, 0x14, 0xf9, 0x50, 0x3f, 0x95,
-		0xf4, 0x87, 0x86, 0x15, 0x90, 0xac, 0x0e, 0xee, 0x06, 0x3e, 0x79, 0x63, 0xe2, 0x3a, 0xfb, 0x7b,
-		0xbf, 0xfc, 0xf8, 0x0c, 0x67, 0x38, 0x3f, 0x80, 0x67, 0xaf, 0x33, 0x2b, 0x05, 0x81, 0x21, 0xbe,
-		0x85, 0x7b, 0x39, 0x70, 0x12, 0xd3, 0xdb, 0xf6, 0x3e, 0xb9, 0x9f, 0x19, 0x60, 0x6a, 0xc9, 0x3c,
-		0x28, 0xcf, 0xfe, 0x8e, 0x47, 0xda, 0x0b, 0x1d, 0xaf, 0xe3, 0x03, 0xff, 0xb1, 0x0c, 0x25, 0x43,
-		0x3c, 0xf8, 0x57, 0x35, 0xa3, 0x25, 0xfe, 0x40, 0x9c, 0xc2, 0x4b, 0x68, 0xc9, 0x4d, 0xc3, 0xc4,
-		0x69, 0x38, 0x24, 0x8f, 0x92, 0x8d, 0xb2, 0x65, 0x36, 0x83, 0x19, 0x17, 0xd4, 0x80, 0x84, 0x63,
-		0xdd, 0xc4, 0x26, 0x67, 0x50, 0xf9, 0x7d, 0x47, 0x10, 0xec, 0xc1, 0xf7, 0x67, 0x38, 0x2d, 0xb4,
-		0x0b, 0x52, 0x13, 0xb7, 0xf0, 0x2e, 0xfb, 0x60, 0x6b, 0x4f, 0xeb, 0x62, 0xf6, 0x11, 0xe7, 0xd1,
-		0xe8, 0x0f, 0x3e, 0x53, 0x53, 0x70, 0xa9, 0xd6, 0x29, 0x51, 0xb4, 0x19, 0x7c, 0x2c, 0x2d, 0xc9,
-		0x4f, 0xe5, 0x47, 0xcc, 0xdf, 0x27, 0x95, 0x7e, 0xb5, 0x15, 0x78, 0x5c, 0xed, 0x2c, 0x48, 0x3d,
-		0x73, 0xdb, 0x32, 0xe9, 0xdf, 0x5c, 0xe2, 0x01, 0x56, 0x8a, 0x9d, 0xdc, 0xb9, 0xf5, 0xfc, 0xe4,
-		0x6e, 0x13, 0xf2, 0x1e, 0x28, 0xdd, 0x3d, 0xe9, 0xa3, 0xee, 0x9e, 0x9c, 0x4b, 0x80, 0x80, 0xa0,
-		0x35, 0x00, 0x6f, 0x7f, 0xba, 0xa7, 0xfb, 0xa1, 0x3b, 0xdd, 0x3f, 0x19, 0x1f, 0x01, 0xd4, 0x82,
-		0xe9, 0xb6, 0x61, 0xaa, 0x36, 0x6e, 0xed, 0xa8, 0x9c, 0x73, 0x84, 0x6e, 0xe6, 0x1d, 0x58, 0xe9,
-		0xa9, 0xb6, 0x61, 0xd6, 0x71, 0x6b, 0x67, 0xd9, 0x25, 0x8b, 0xde, 0x07, 0xa7, 0x3c, 0x76, 0x58,
-		0xa6, 0xba, 0x67, 0xb5, 0x9a, 0x6a, 0x17, 0xef, 0xa8, 0x3a, 0x7d, 0xfe, 0x28, 0x4b, 0x99, 0x78,
-		0xc2, 0x05, 0xd9, 0x30, 0xaf, 0x5a, 0xad, 0xa6, 0x82, 0x77, 0x2a, 0xa4, 0x19, 0x3d, 0x08, 0x1e,
-		0x2f, 0x54, 0xa3, 0x69, 0xcf, 0xe6, 0x16, 0x62, 0x67, 0xe2, 0x4a, 0xd6, 0xad, 0xac, 0x35, 0xed,
-		0xa5, 0xd4, 0x47, 0xde, 0x98, 0x9f, 0xf8, 0xc2, 0x1b, 0xf3, 0x13, 0xf2, 0x15, 0xfa, 0x58, 0x07,
-		0xdf, 0x74, 0xd8, 0x46, 0x97, 0x20, 0xad, 0x89, 0x02, 0xfb, 0x4c, 0xe4, 0x90, 0x4d, 0xeb, 0x81,
-		0xca, 0x6f, 0x44, 0x20, 0xb1, 0x7c, 0x63, 0x53, 0x33, 0xba, 0xa8, 0x0a, 0x53, 0x9e, 0xd0, 0x8e,
-		0xbb, 0xff, 0x3d, 0x39, 0x17, 0x0a, 0xa0, 0x3a, 0xea, 0xcb, 0xd9, 0x43, 0xc9, 0xf4, 0x7f, 0x53,
-		0xeb, 0x9b, 0xea, 0x35, 0x48, 0xb2, 0x11, 0xda, 0xe8, 0x05, 0x98, 0xec, 0x90, 0x1f, 0x3c, 0xfb,
-		0x3f, 0x37, 0x52, 0xd0, 0x29, 0xbc, 0x5f, 0x2c, 0x18, 0x9e, 0xfc, 0x97, 0x11, 0x80, 0xe5, 0x1b,
-		0x37, 0x1a, 0x5d, 0xa3, 0xd3, 0xc2, 0xce, 0x3b, 0x35, 0xe5, 0x55, 0x38, 0xe6, 0xfb, 0x3e, 0xb3,
-		0xab, 0x8f, 0x3d, 0xed, 0x69, 0xef, 0x0b, 0xcd, 0xae, 0x3e, 0x94, 0x5a, 0xd3, 0x76, 0x5c, 0x6a,
-		0xb1, 0xb1, 0xa9, 0x2d, 0xdb, 0xce, 0x20, 0x1f, 0x5f, 0x82, 0x8c, 0x37, 0x75, 0x1b, 0xd5, 0x20,
-		0xe5, 0xf0, 0xdf, 0x9c, 0x9d, 0xf2, 0x68, 0x76, 0x0a, 0x34, 0x3f, 0x4b, 0x5d, 0x74, 0xf9, 0x7f,
-		0x12, 0xae, 0x7a, 0x1b, 0xe1, 0xeb, 0x4a, 0x90, 0x88, 0x86, 0xe7, 0x1a, 0x38, 0xf6, 0x0e, 0x68,
-		0x60, 0x4e, 0xcb, 0xc7, 0xd6, 0xef, 0x8c, 0xc2, 0xf4, 0x96, 0xd8, 0xa4, 0x5f, 0xb7, 0x5c, 0xd8,
-		0x82, 0x24, 0x36, 0x9d, 0xae, 0x81, 0xc5, 0x51, 0xdf, 0x13, 0xa3, 0x16, 0x7b, 0xc8, 0x5c, 0xe8,
-		0x9f, 0xd7, 0xf6, 0x2f, 0xbd, 0xa0, 0xe5, 0x63, 0xc3, 0xaf, 0xc7, 0x60, 0x76, 0x14, 0x2a, 0x3a,
-		0x0d, 0x05, 0xbd, 0x8b, 0x69, 0x85, 0x1a, 0x48, 0x85, 0xe7, 0x45, 0x35, 0x37, 0x29, 0x0a, 0x10,
-		0x07, 0x8d, 0x48, 0x15, 0x01, 0xbd, 0x37, 0x8f, 0x2c, 0xef, 0x51, 0xa0, 0x46, 0x05, 0x43, 0x41,
-		0xdc, 0x6d, 0xdf, 0xd6, 0x5a, 0x9a, 0xa9, 0xe3, 0x7b, 0x90, 0x84, 0x41, 0x0b, 0x20, 0x2e, 0xcc,
-		0x97, 0x19, 0x4d, 0x74, 0x03, 0x92, 0x82, 0x7c, 0xfc, 0x1d, 0x20, 0x2f, 0x88, 0xa1, 0x07, 0x20,
-		0xeb, 0x37, 0x0c, 0xd4, 0x4f, 0x89, 0x2b, 0x19, 0x9f, 0x5d, 0x08, 0xb3, 0x3c, 0x89, 0x43, 0x2d,
-		0x0f, 0x77, 0x05, 0x3f, 0x1d, 0x83, 0x29, 0x05, 0x37, 0xbf, 0x01, 0x17, 0xee, 0x9b, 0x01, 0xd8,
-		0xa6, 0x26, 0xca, 0xf6, 0x1e, 0xd6, 0x6e, 0x50, 0x49, 0xa4, 0x19, 0xbd, 0x65, 0xdb, 0x79, 0xaf,
-		0x56, 0xef, 0x5f, 0x46, 0x21, 0xeb, 0x5f, 0xbd, 0x6f, 0x00, 0xcb, 0x86, 0xd6, 0x3d, 0x95, 0xc6,
-		0x6e, 0x9f, 0x9f, 0x1d, 0xa5, 0xd2, 0x06, 0xe4, 0x3a, 0x44, 0x97, 0x7d, 0x22, 0x06, 0x09, 0x7e,
-		0x15, 0x6c, 0x63, 0xc0, 0xc7, 0x8d, 0x84, 0x7d, 0xff, 0x9b, 0x13, 0xdf, 0xff, 0x0e, 0x75, 0x71,
-		0x1f, 0x86, 0x3c, 0x09, 0xa9, 0x03, 0xf7, 0xcb, 0x22, 0x67, 0x72, 0x34, 0x1c, 0xf6, 0x6e, 0x43,
-		0xa3, 0x79, 0xc8, 0x10, 0x30, 0x4f, 0x67, 0x13, 0x18, 0x68, 0x6b, 0xfb, 0x55, 0x56, 0x83, 0x1e,
-		0x07, 0xb4, 0xe7, 0x26, 0x3e, 0x54, 0x8f, 0x11, 0x04, 0x6e, 0xca, 0x6b, 0x11, 0xe0, 0xf7, 0x03,
-		0x90, 0x51, 0xa8, 0xec, 0x85, 0x4e, 0xfe, 0xda, 0x29, 0xa9, 0x59, 0xa6, 0xaf, 0x74, 0x7e, 0x7f,
-		0x84, 0xb9, 0xca, 0x7d, 0xd1, 0x36, 0x0f, 0x5a, 0xd4, 0xa3, 0xed, 0x86, 0xaf, 0xbc, 0x39, 0x5f,
-		0x3c, 0xd0, 0xda, 0xad, 0x25, 0x79, 0x08, 0x49, 0x79, 0x58, 0x2e, 0x80, 0x78, 0xd3, 0xc1, 0xc0,
-		0x7d, 0xe9, 0x21, 0x22, 0xd7, 0x77, 0x3e, 0xff, 0xf3, 0xe7, 0x4e, 0xf9, 0xba, 0xd8, 0x77, 0x33,
-		0x64, 0x6c, 0x79, 0xe4, 0x9f, 0x8d, 0x00, 0xf2, 0x8c, 0x8d, 0x7b, 0x1f, 0x7c, 0x8d, 0xde, 0x12,
-		0x16, 0xfe, 0x7e, 0xe4, 0xf0, 0x38, 0xc2, 0xc3, 0x0f, 0xc4, 0x11, 0xbe, 0xcd, 0xf4, 0x7e, 0x4f,
-		0xb5, 0x8b, 0xaf, 0xbf, 0x87, 0xbc, 0xd5, 0xba, 0x58, 0xb1, 0x8c, 0x00, 0x09, 0x81, 0x44, 0xf7,
-		0xe8, 0x84, 0xfc, 0x66, 0x04, 0x4e, 0x0e, 0x48, 0xa2, 0x3b, 0x64, 0x1d, 0x50, 0xd7, 0xd7, 0x48,
-		0x57, 0x54, 0x9c, 0xaf, 0xde, 0x9b, 0x60, 0x4f, 0x75, 0x07, 0xd4, 0xf9, 0xbb, 0x64, 0xa3, 0xb8,
-		0x12, 0xfa, 0xed, 0x08, 0xcc, 0xf8, 0x47, 0xe4, 0xce, 0xad, 0x0e, 0x59, 0xff, 0x58, 0xf8, 0xac,
-		0x1e, 0x1a, 0x67, 0x56, 0xfe, 0x09, 0x05, 0x88, 0x90, 0xb9, 0x08, 0xa9, 0x67, 0xb9, 0xba, 0x27,
-		0xc7, 0xe6, 0x92, 0x7b, 0x0e, 0x31, 0x4c, 0x0d, 0xb0, 0xc5, 0xfa, 0xbe, 0x28, 0xc4, 0x37, 0x2d,
-		0xab, 0x85, 0xbe, 0x23, 0x02, 0x53, 0xa6, 0xe5, 0xa8, 0x64, 0xaf, 0xe0, 0xa6, 0xca, 0x93, 0x05,
-		0x4c, 0x93, 0xde, 0x38, 0x1a, 0xf7, 0xbe, 0xf8, 0xe6, 0xfc, 0x20, 0xa9, 0x61, 0x4f, 0xec, 0x16,
-		0x4c, 0xcb, 0x29, 0x53, 0xa0, 0x06, 0xcb, 0x27, 0xdc, 0x86, 0x5c, 0xb0, 0x7f, 0xa6, 0x7e, 0x95,
-		0x23, 0xf7, 0x9f, 0x0b, 0xed, 0x3b, 0xbb, 0xed, 0xeb, 0x98, 0x3d, 0x74, 0xf9, 0x67, 0x64, 0x71,
-		0x5f, 0x06, 0xe9, 0x46, 0xff, 0xcd, 0xb4, 0x2a, 0x24, 0x8f, 0x7a, 0xc9, 0xcd, 0xcf, 0x71, 0x8e,
-		0x7b, 0xee, 0x57, 0x22, 0x00, 0x5e, 0x6a, 0x06, 0x3d, 0x06, 0x27, 0xca, 0x1b, 0xeb, 0xcb, 0x6a,
-		0xbd, 0x51, 0x6a, 0x6c, 0xd5, 0x83, 0xef, 0xb2, 0x8b, 0xb7, 0x48, 0xec, 0x0e, 0xd6, 0x8d, 0x1d,
-		0x03, 0x37, 0xd1, 0x23, 0x30, 0x13, 0x84, 0x26, 0xa5, 0xea, 0xb2, 0x14, 0x29, 0x66, 0xef, 0xdc,
-		0x5d, 0x48, 0x31, 0x97, 0x14, 0x37, 0xd1, 0x19, 0x38, 0x36, 0x08, 0x57, 0x5b, 0x5f, 0x91, 0xa2,
-		0xc5, 0xdc, 0x9d, 0xbb, 0x0b, 0x69, 0xd7, 0x77, 0x45, 0x32, 0x20, 0x3f, 0x24, 0xa7, 0x17, 0x2b,
-		0xc2, 0x9d, 0xbb, 0x0b, 0x09, 0xb6, 0x2c, 0xc5, 0xf8, 0x47, 0x7e, 0x6a, 0x6e, 0xe2, 0xdc, 0xb7,
-		0x02, 0xd4, 0xcc, 0x9d, 0xae, 0x46, 0xff, 0x38, 0x31, 0x2a, 0xc2, 0xf1, 0xda, 0xfa, 0x15, 0xa5,
-		0x54, 0x69, 0xd4, 0x36, 0xd6, 0xfb, 0x9e, 0x93, 0x0f, 0xb6, 0x2d, 0x6f, 0x6c, 0x95, 0x57, 0xab,
-		0x6a, 0xbd, 0xb6, 0xb2, 0xce, 0x4e, 0x78, 0x03, 0x6d, 0x2f, 0xae, 0x37, 0x6a, 0x6b, 0x55, 0x29,
-		0x5a, 0xbe, 0x32, 0xf2, 0x8c, 0xe1, 0xb1, 0x43, 0x17, 0xdc, 0xd3, 0x92, 0x81, 0x83, 0x86, 0xff,
-		0x1b, 0x00, 0x00, 0xff, 0xff, 0xd9, 0xcb, 0xff, 0x85, 0xa2, 0x9a, 0x00, 0x00,
+		// 11462 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6d, 0x90, 0x5c, 0x57,
+		0x56, 0xd8, 0xf4, 0xe7, 0x74, 0x9f, 0xfe, 0x7a, 0xf3, 0x66, 0x24, 0xb5, 0x5a, 0xb6, 0x34, 0x7e,
+		0xfe, 0x92, 0x65, 0x7b, 0x64, 0xcb, 0x96, 0x6c, 0x8f, 0xd7, 0x6b, 0xba, 0x7b, 0x5a, 0x52, 0xcb,
+		0xf3, 0xe5, 0xd7, 0x33, 0xf2, 0x07, 0xb0, 0x8f, 0x37, 0xdd, 0x77, 0x66, 0x9e, 0xd5, 0xfd, 0x5e,
+		0xbb, 0xdf, 0x6b, 0x69, 0xc6, 0x45, 0xa5, 0x0c, 0x06, 0x02, 0x0a, 0x81, 0x25, 0xa4, 0x60, 0x81,
+		0x15, 0xd9, 0x85, 0x00, 0x0b, 0x49, 0x08, 0xb0, 0x1b, 0x16, 0x42, 0x41, 0x41, 0x2a, 0x09, 0x1f,
+		0x49, 0xa5, 0x16, 0x2a, 0x95, 0x90, 0x54, 0xe2, 0x80, 0x97, 0x0a, 0x1b, 0x76, 0x09, 0xb0, 0x31,
+		0x49, 0xc8, 0x56, 0x3e, 0xea, 0xdc, 0x8f, 0xf7, 0xd1, 0x1f, 0xf3, 0x7a, 0xb4, 0xb2, 0xb3, 0x5b,
+		0xfb, 0x47, 0xea, 0x77, 0xef, 0x39, 0xe7, 0xde, 0x7b, 0xee, 0xb9, 0xe7, 0x9e, 0x73, 0xee, 0xb9,
+		0x77, 0xe0, 0x0f, 0x2b, 0x30, 0xbf, 0x63, 0x59, 0x3b, 0x6d, 0x72, 0xb6, 0xdb, 0xb3, 0x1c, 0x6b,
+		0xab, 0xbf, 0x7d, 0xb6, 0x45, 0xec, 0x66, 0xcf, 0xe8, 0x3a, 0x56, 0x6f, 0x81, 0x96, 0xc9, 0x05,
+		0x06, 0xb1, 0x20, 0x20, 0x94, 0x15, 0x98, 0xb9, 0x68, 0xb4, 0xc9, 0x92, 0x0b, 0xd8, 0x20, 0x8e,
+		0xfc, 0x34, 0xc4, 0xb7, 0x8d, 0x36, 0x29, 0x46, 0xe6, 0x63, 0xa7, 0x33, 0xe7, 0xee, 0x5b, 0x18,
+		0x40, 0x5a, 0x08, 0x62, 0xac, 0x63, 0xb1, 0x4a, 0x31, 0x94, 0xff, 0x13, 0x87, 0xd9, 0x11, 0xb5,
+		0xb2, 0x0c, 0x71, 0x53, 0xef, 0x20, 0xc5, 0xc8, 0xe9, 0xb4, 0x4a, 0x7f, 0xcb, 0x45, 0x98, 0xee,
+		0xea, 0xcd, 0x6b, 0xfa, 0x0e, 0x29, 0x46, 0x69, 0xb1, 0xf8, 0x94, 0x4f, 0x02, 0xb4, 0x48, 0x97,
+		0x98, 0x2d, 0x62, 0x36, 0xf7, 0x8b, 0xb1, 0xf9, 0xd8, 0xe9, 0xb4, 0xea, 0x2b, 0x91, 0x1f, 0x86,
+		0x99, 0x6e, 0x7f, 0xab, 0x6d, 0x34, 0x35, 0x1f, 0x18, 0xcc, 0xc7, 0x4e, 0x27, 0x54, 0x89, 0x55,
+		0x2c, 0x79, 0xc0, 0x0f, 0x42, 0xe1, 0x06, 0xd1, 0xaf, 0xf9, 0x41, 0x33, 0x14, 0x34, 0x8f, 0xc5,
+		0x3e, 0xc0, 0x2a, 0x64, 0x3b, 0xc4, 0xb6, 0xf5, 0x1d, 0xa2, 0x39, 0xfb, 0x5d, 0x52, 0x8c, 0xd3,
+		0xd1, 0xcf, 0x0f, 0x8d, 0x7e, 0x70, 0xe4, 0x19, 0x8e, 0xb5, 0xb1, 0xdf, 0x25, 0x72, 0x19, 0xd2,
+		0xc4, 0xec, 0x77, 0x18, 0x85, 0xc4, 0x18, 0xfe, 0xd5, 0xcc, 0x7e, 0x67, 0x90, 0x4a, 0x0a, 0xd1,
+		0x38, 0x89, 0x69, 0x9b, 0xf4, 0xae, 0x1b, 0x4d, 0x52, 0x4c, 0x52, 0x02, 0x0f, 0x0e, 0x11, 0x68,
+		0xb0, 0xfa, 0x41, 0x1a, 0x02, 0x4f, 0xae, 0x42, 0x9a, 0xec, 0x39, 0xc4, 0xb4, 0x0d, 0xcb, 0x2c,
+		0x4e, 0x53, 0x22, 0xf7, 0x8f, 0x98, 0x45, 0xd2, 0x6e, 0x0d, 0x92, 0xf0, 0xf0, 0xe4, 0x0b, 0x30,
+		0x6d, 0x75, 0x1d, 0xc3, 0x32, 0xed, 0x62, 0x6a, 0x3e, 0x72, 0x3a, 0x73, 0xee, 0xae, 0x91, 0x82,
+		0xb0, 0xc6, 0x60, 0x54, 0x01, 0x2c, 0xd7, 0x41, 0xb2, 0xad, 0x7e, 0xaf, 0x49, 0xb4, 0xa6, 0xd5,
+		0x22, 0x9a, 0x61, 0x6e, 0x5b, 0xc5, 0x34, 0x25, 0x70, 0x6a, 0x78, 0x20, 0x14, 0xb0, 0x6a, 0xb5,
+		0x48, 0xdd, 0xdc, 0xb6, 0xd4, 0xbc, 0x1d, 0xf8, 0x96, 0x8f, 0x42, 0xd2, 0xde, 0x37, 0x1d, 0x7d,
+		0xaf, 0x98, 0xa5, 0x12, 0xc2, 0xbf, 0x50, 0x74, 0x48, 0xcb, 0xc0, 0xe6, 0x8a, 0x39, 0x26, 0x3a,
+		0xfc, 0x53, 0xf9, 0xe5, 0x24, 0x14, 0x26, 0x11, 0xbe, 0x67, 0x21, 0xb1, 0x8d, 0xe3, 0x2f, 0x46,
+		0x0f, 0xc3, 0x1d, 0x86, 0x13, 0x64, 0x6f, 0xf2, 0x36, 0xd9, 0x5b, 0x86, 0x8c, 0x49, 0x6c, 0x87,
+		0xb4, 0x98, 0xac, 0xc4, 0x26, 0x94, 0x36, 0x60, 0x48, 0xc3, 0xc2, 0x16, 0xbf, 0x2d, 0x61, 0x7b,
+		0x19, 0x0a, 0x6e, 0x97, 0xb4, 0x9e, 0x6e, 0xee, 0x08, 0xa9, 0x3d, 0x1b, 0xd6, 0x93, 0x85, 0x9a,
+		0xc0, 0x53, 0x11, 0x4d, 0xcd, 0x93, 0xc0, 0xb7, 0xbc, 0x04, 0x60, 0x99, 0xc4, 0xda, 0xd6, 0x5a,
+		0xa4, 0xd9, 0x2e, 0xa6, 0xc6, 0x70, 0x69, 0x0d, 0x41, 0x86, 0xb8, 0x64, 0xb1, 0xd2, 0x66, 0x5b,
+		0x7e, 0xc6, 0x13, 0xc2, 0xe9, 0x31, 0x32, 0xb4, 0xc2, 0x96, 0xdf, 0x90, 0x1c, 0x6e, 0x42, 0xbe,
+		0x47, 0x70, 0x45, 0x90, 0x16, 0x1f, 0x59, 0x9a, 0x76, 0x62, 0x21, 0x74, 0x64, 0x2a, 0x47, 0x63,
+		0x03, 0xcb, 0xf5, 0xfc, 0x9f, 0xf2, 0xbd, 0xe0, 0x16, 0x68, 0x54, 0xac, 0x80, 0xea, 0xa7, 0xac,
+		0x28, 0x5c, 0xd5, 0x3b, 0xa4, 0xf4, 0x06, 0xe4, 0x83, 0xec, 0x91, 0xe7, 0x20, 0x61, 0x3b, 0x7a,
+		0xcf, 0xa1, 0x52, 0x98, 0x50, 0xd9, 0x87, 0x2c, 0x41, 0x8c, 0x98, 0x2d, 0xaa, 0xff, 0x12, 0x2a,
+		0xfe, 0x94, 0xbf, 0xce, 0x1b, 0x70, 0x8c, 0x0e, 0xf8, 0x81, 0xe1, 0x19, 0x0d, 0x50, 0x1e, 0x1c,
+		0x77, 0xe9, 0x29, 0xc8, 0x05, 0x06, 0x30, 0x69, 0xd3, 0xca, 0x37, 0xc3, 0x91, 0x91, 0xa4, 0xe5,
+		0x97, 0x61, 0xae, 0x6f, 0x1a, 0xa6, 0x43, 0x7a, 0xdd, 0x1e, 0x41, 0x89, 0x65, 0x4d, 0x15, 0xff,
+		0x78, 0x7a, 0x8c, 0xcc, 0x6d, 0xfa, 0xa1, 0x19, 0x15, 0x75, 0xb6, 0x3f, 0x5c, 0x78, 0x26, 0x9d,
+		0xfa, 0xdc, 0xb4, 0xf4, 0xe6, 0x9b, 0x6f, 0xbe, 0x19, 0x55, 0x7e, 0x23, 0x09, 0x73, 0xa3, 0xd6,
+		0xcc, 0xc8, 0xe5, 0x7b, 0x14, 0x92, 0x66, 0xbf, 0xb3, 0x45, 0x7a, 0x94, 0x49, 0x09, 0x95, 0x7f,
+		0xc9, 0x65, 0x48, 0xb4, 0xf5, 0x2d, 0xd2, 0x2e, 0xc6, 0xe7, 0x23, 0xa7, 0xf3, 0xe7, 0x1e, 0x9e,
+		0x68, 0x55, 0x2e, 0x2c, 0x23, 0x8a, 0xca, 0x30, 0xe5, 0x0f, 0x42, 0x9c, 0x2b, 0x6f, 0xa4, 0x70,
+		0x66, 0x32, 0x0a, 0xb8, 0x96, 0x54, 0x8a, 0x27, 0x9f, 0x80, 0x34, 0xfe, 0xcf, 0x64, 0x23, 0x49,
+		0xfb, 0x9c, 0xc2, 0x02, 0x94, 0x0b, 0xb9, 0x04, 0x29, 0xba, 0x4c, 0x5a, 0x44, 0x6c, 0x7a, 0xee,
+		0x37, 0x0a, 0x56, 0x8b, 0x6c, 0xeb, 0xfd, 0xb6, 0xa3, 0x5d, 0xd7, 0xdb, 0x7d, 0x42, 0x05, 0x3e,
+		0xad, 0x66, 0x79, 0xe1, 0x55, 0x2c, 0x93, 0x4f, 0x41, 0x86, 0xad, 0x2a, 0xc3, 0x6c, 0x91, 0x3d,
+		0xaa, 0x57, 0x13, 0x2a, 0x5b, 0x68, 0x75, 0x2c, 0xc1, 0xe6, 0x5f, 0xb3, 0x2d, 0x53, 0x88, 0x26,
+		0x6d, 0x02, 0x0b, 0x68, 0xf3, 0x4f, 0x0d, 0xaa, 0xf4, 0xbb, 0x47, 0x0f, 0x6f, 0x68, 0x2d, 0x3d,
+		0x08, 0x05, 0x0a, 0xf1, 0x04, 0x9f, 0x7a, 0xbd, 0x5d, 0x9c, 0x99, 0x8f, 0x9c, 0x4e, 0xa9, 0x79,
+		0x56, 0xbc, 0xc6, 0x4b, 0x95, 0x5f, 0x8c, 0x42, 0x9c, 0x2a, 0x96, 0x02, 0x64, 0x36, 0x5e, 0x59,
+		0xaf, 0x69, 0x4b, 0x6b, 0x9b, 0x95, 0xe5, 0x9a, 0x14, 0x91, 0xf3, 0x00, 0xb4, 0xe0, 0xe2, 0xf2,
+		0x5a, 0x79, 0x43, 0x8a, 0xba, 0xdf, 0xf5, 0xd5, 0x8d, 0x0b, 0x4f, 0x4a, 0x31, 0x17, 0x61, 0x93,
+		0x15, 0xc4, 0xfd, 0x00, 0x4f, 0x9c, 0x93, 0x12, 0xb2, 0x04, 0x59, 0x46, 0xa0, 0xfe, 0x72, 0x6d,
+		0xe9, 0xc2, 0x93, 0x52, 0x32, 0x58, 0xf2, 0xc4, 0x39, 0x69, 0x5a, 0xce, 0x41, 0x9a, 0x96, 0x54,
+		0xd6, 0xd6, 0x96, 0xa5, 0x94, 0x4b, 0xb3, 0xb1, 0xa1, 0xd6, 0x57, 0x2f, 0x49, 0x69, 0x97, 0xe6,
+		0x25, 0x75, 0x6d, 0x73, 0x5d, 0x02, 0x97, 0xc2, 0x4a, 0xad, 0xd1, 0x28, 0x5f, 0xaa, 0x49, 0x19,
+		0x17, 0xa2, 0xf2, 0xca, 0x46, 0xad, 0x21, 0x65, 0x03, 0xdd, 0x7a, 0xe2, 0x9c, 0x94, 0x73, 0x9b,
+		0xa8, 0xad, 0x6e, 0xae, 0x48, 0x79, 0x79, 0x06, 0x72, 0xac, 0x09, 0xd1, 0x89, 0xc2, 0x40, 0xd1,
+		0x85, 0x27, 0x25, 0xc9, 0xeb, 0x08, 0xa3, 0x32, 0x13, 0x28, 0xb8, 0xf0, 0xa4, 0x24, 0x2b, 0x55,
+		0x48, 0x50, 0x31, 0x94, 0x65, 0xc8, 0x2f, 0x97, 0x2b, 0xb5, 0x65, 0x6d, 0x6d, 0x7d, 0xa3, 0xbe,
+		0xb6, 0x5a, 0x5e, 0x96, 0x22, 0x5e, 0x99, 0x5a, 0x7b, 0x71, 0xb3, 0xae, 0xd6, 0x96, 0xa4, 0xa8,
+		0xbf, 0x6c, 0xbd, 0x56, 0xde, 0xa8, 0x2d, 0x49, 0x31, 0xa5, 0x09, 0x73, 0xa3, 0x14, 0xea, 0xc8,
+		0x25, 0xe4, 0x93, 0x85, 0xe8, 0x18, 0x59, 0xa0, 0xb4, 0x06, 0x65, 0x41, 0xf9, 0x6c, 0x14, 0x66,
+		0x47, 0x6c, 0x2a, 0x23, 0x1b, 0x79, 0x1e, 0x12, 0x4c, 0x96, 0xd9, 0x36, 0xfb, 0xd0, 0xc8, 0xdd,
+		0x89, 0x4a, 0xf6, 0xd0, 0x56, 0x4b, 0xf1, 0xfc, 0x46, 0x48, 0x6c, 0x8c, 0x11, 0x82, 0x24, 0x86,
+		0x04, 0xf6, 0x1b, 0x87, 0x94, 0x3f, 0xdb, 0x1f, 0x2f, 0x4c, 0xb2, 0x3f, 0xd2, 0xb2, 0xc3, 0x6d,
+		0x02, 0x89, 0x11, 0x9b, 0xc0, 0xb3, 0x30, 0x33, 0x44, 0x68, 0x62, 0x65, 0xfc, 0x56, 0x04, 0x8a,
+		0xe3, 0x98, 0x13, 0xa2, 0x12, 0xa3, 0x01, 0x95, 0xf8, 0xec, 0x20, 0x07, 0xef, 0x19, 0x3f, 0x09,
+		0x43, 0x73, 0xfd, 0x53, 0x11, 0x38, 0x3a, 0xda, 0xd8, 0x1c, 0xd9, 0x87, 0x0f, 0x42, 0xb2, 0x43,
+		0x9c, 0x5d, 0x4b, 0x98, 0x55, 0x0f, 0x8c, 0xd8, 0xac, 0xb1, 0x7a, 0x70, 0xb2, 0x39, 0x96, 0xfc,
+		0xcc, 0x60, 0x5f, 0x4f, 0x8d, 0x33, 0x7d, 0x87, 0x7a, 0xfa, 0x5d, 0x51, 0x38, 0x32, 0x92, 0xf8,
+		0xc8, 0x8e, 0xde, 0x0d, 0x60, 0x98, 0xdd, 0xbe, 0xc3, 0x4c, 0x27, 0xa6, 0x89, 0xd3, 0xb4, 0x84,
+		0x2a, 0x2f, 0xd4, 0xb2, 0x7d, 0xc7, 0xad, 0x8f, 0xd1, 0x7a, 0x60, 0x45, 0x14, 0xe0, 0x69, 0xaf,
+		0xa3, 0x71, 0xda, 0xd1, 0x93, 0x63, 0x46, 0x3a, 0x24, 0x98, 0x8f, 0x81, 0xd4, 0x6c, 0x1b, 0xc4,
+		0x74, 0x34, 0xdb, 0xe9, 0x11, 0xbd, 0x63, 0x98, 0x3b, 0x74, 0xab, 0x49, 0x2d, 0x26, 0xb6, 0xf5,
+		0xb6, 0x4d, 0xd4, 0x02, 0xab, 0x6e, 0x88, 0x5a, 0xc4, 0xa0, 0x02, 0xd4, 0xf3, 0x61, 0x24, 0x03,
+		0x18, 0xac, 0xda, 0xc5, 0x50, 0xbe, 0x2f, 0x0d, 0x19, 0x9f, 0x69, 0x2e, 0xdf, 0x03, 0xd9, 0xd7,
+		0xf4, 0xeb, 0xba, 0x26, 0xdc, 0x2d, 0xc6, 0x89, 0x0c, 0x96, 0xad, 0xb3, 0x22, 0xf9, 0x31, 0x98,
+		0xa3, 0x20, 0x56, 0xdf, 0x21, 0x3d, 0xad, 0xd9, 0xd6, 0x6d, 0x9b, 0x32, 0x2d, 0x45, 0x41, 0x65,
+		0xac, 0x5b, 0xc3, 0xaa, 0xaa, 0xa8, 0x91, 0xcf, 0xc3, 0x2c, 0xc5, 0xe8, 0xf4, 0xdb, 0x8e, 0xd1,
+		0x6d, 0x13, 0x0d, 0x1d, 0x40, 0xbb, 0x08, 0xfe, 0x9e, 0xcd, 0x20, 0xc4, 0x0a, 0x07, 0xc0, 0x1e,
+		0xd9, 0xf2, 0x12, 0xdc, 0x4d, 0xd1, 0x76, 0x88, 0x49, 0x7a, 0xba, 0x43, 0x34, 0xf2, 0x7a, 0x5f,
+		0x6f, 0xdb, 0x9a, 0x6e, 0xb6, 0xb4, 0x5d, 0xdd, 0xde, 0x2d, 0xce, 0x21, 0x81, 0x4a, 0xb4, 0x18,
+		0x51, 0x8f, 0x23, 0xe0, 0x25, 0x0e, 0x57, 0xa3, 0x60, 0x65, 0xb3, 0x75, 0x59, 0xb7, 0x77, 0xe5,
+		0x45, 0x38, 0x4a, 0xa9, 0xd8, 0x4e, 0xcf, 0x30, 0x77, 0xb4, 0xe6, 0x2e, 0x69, 0x5e, 0xd3, 0xfa,
+		0xce, 0xf6, 0xd3, 0xc5, 0x13, 0xfe, 0xf6, 0x69, 0x0f, 0x1b, 0x14, 0xa6, 0x8a, 0x20, 0x9b, 0xce,
+		0xf6, 0xd3, 0x72, 0x03, 0xb2, 0x38, 0x19, 0x1d, 0xe3, 0x0d, 0xa2, 0x6d, 0x5b, 0x3d, 0xba, 0x87,
+		0xe6, 0x47, 0xa8, 0x26, 0x1f, 0x07, 0x17, 0xd6, 0x38, 0xc2, 0x8a, 0xd5, 0x22, 0x8b, 0x89, 0xc6,
+		0x7a, 0xad, 0xb6, 0xa4, 0x66, 0x04, 0x95, 0x8b, 0x56, 0x0f, 0x05, 0x6a, 0xc7, 0x72, 0x19, 0x9c,
+		0x61, 0x02, 0xb5, 0x63, 0x09, 0xf6, 0x9e, 0x87, 0xd9, 0x66, 0x93, 0x8d, 0xd9, 0x68, 0x6a, 0xdc,
+		0x4d, 0xb3, 0x8b, 0x52, 0x80, 0x59, 0xcd, 0xe6, 0x25, 0x06, 0xc0, 0x65, 0xdc, 0x96, 0x9f, 0x81,
+		0x23, 0x1e, 0xb3, 0xfc, 0x88, 0x33, 0x43, 0xa3, 0x1c, 0x44, 0x3d, 0x0f, 0xb3, 0xdd, 0xfd, 0x61,
+		0x44, 0x39, 0xd0, 0x62, 0x77, 0x7f, 0x10, 0xed, 0x29, 0x98, 0xeb, 0xee, 0x76, 0x87, 0xf1, 0xce,
+		0xf8, 0xf1, 0xe4, 0xee, 0x6e, 0x77, 0x10, 0xf1, 0x7e, 0xea, 0xb3, 0xf7, 0x48, 0x53, 0x77, 0x48,
+		0xab, 0x78, 0xcc, 0x0f, 0xee, 0xab, 0x90, 0x17, 0x40, 0x6a, 0x36, 0x35, 0x62, 0xea, 0x5b, 0x6d,
+		0xa2, 0xe9, 0x3d, 0x62, 0xea, 0x76, 0xf1, 0x14, 0x05, 0x8e, 0x3b, 0xbd, 0x3e, 0x51, 0xf3, 0xcd,
+		0x66, 0x8d, 0x56, 0x96, 0x69, 0x9d, 0x7c, 0x06, 0x66, 0xac, 0xad, 0xd7, 0x9a, 0x4c, 0x22, 0xb5,
+		0x6e, 0x8f, 0x6c, 0x1b, 0x7b, 0xc5, 0xfb, 0x28, 0x7b, 0x0b, 0x58, 0x41, 0xe5, 0x71, 0x9d, 0x16,
+		0xcb, 0x0f, 0x81, 0xd4, 0xb4, 0x77, 0xf5, 0x5e, 0x97, 0xaa, 0x64, 0xbb, 0xab, 0x37, 0x49, 0xf1,
+		0x7e, 0x06, 0xca, 0xca, 0x57, 0x45, 0x31, 0xae, 0x08, 0xfb, 0x86, 0xb1, 0xed, 0x08, 0x8a, 0x0f,
+		0xb2, 0x15, 0x41, 0xcb, 0x38, 0xb5, 0xd3, 0x20, 0x21, 0x27, 0x02, 0x0d, 0x9f, 0xa6, 0x60, 0xf9,
+		0xee, 0x6e, 0xd7, 0xdf, 0xee, 0xbd, 0x90, 0xeb, 0xee, 0xfa, 0x1b, 0x7d, 0x88, 0x19, 0x6e, 0xdd,
+		0x5d, 0x5f, 0x8b, 0x4f, 0xc2, 0x51, 0x04, 0xea, 0x10, 0x47, 0x6f, 0xe9, 0x8e, 0xee, 0x83, 0x7e,
+		0x84, 0x42, 0x23, 0xdb, 0x57, 0x78, 0x65, 0xa0, 0x9f, 0xbd, 0xfe, 0xd6, 0xbe, 0x2b, 0x58, 0x8f,
+		0xb2, 0x7e, 0x62, 0x99, 0x10, 0xad, 0xf7, 0xcc, 0x38, 0x57, 0x16, 0x21, 0xeb, 0x97, 0x7b, 0x39,
+		0x0d, 0x4c, 0xf2, 0xa5, 0x08, 0x1a, 0x41, 0xd5, 0xb5, 0x25, 0x34, 0x5f, 0x5e, 0xad, 0x49, 0x51,
+		0x34, 0xa3, 0x96, 0xeb, 0x1b, 0x35, 0x4d, 0xdd, 0x5c, 0xdd, 0xa8, 0xaf, 0xd4, 0xa4, 0x98, 0xcf,
+		0xb0, 0xbf, 0x12, 0x4f, 0x3d, 0x20, 0x3d, 0xa8, 0xfc, 0x4a, 0x0c, 0xf2, 0x41, 0x4f, 0x4d, 0xfe,
+		0x00, 0x1c, 0x13, 0x01, 0x17, 0x9b, 0x38, 0xda, 0x0d, 0xa3, 0x47, 0x17, 0x64, 0x47, 0x67, 0x9b,
+		0xa3, 0x2b, 0x3f, 0x73, 0x1c, 0xaa, 0x41, 0x9c, 0x97, 0x8c, 0x1e, 0x2e, 0xb7, 0x8e, 0xee, 0xc8,
+		0xcb, 0x70, 0xca, 0xb4, 0x34, 0xdb, 0xd1, 0xcd, 0x96, 0xde, 0x6b, 0x69, 0x5e, 0xa8, 0x4b, 0xd3,
+		0x9b, 0x4d, 0x62, 0xdb, 0x16, 0xdb, 0x08, 0x5d, 0x2a, 0x77, 0x99, 0x56, 0x83, 0x03, 0x7b, 0x3b,
+		0x44, 0x99, 0x83, 0x0e, 0x88, 0x6f, 0x6c, 0x9c, 0xf8, 0x9e, 0x80, 0x74, 0x47, 0xef, 0x6a, 0xc4,
+		0x74, 0x7a, 0xfb, 0xd4, 0x3e, 0x4f, 0xa9, 0xa9, 0x8e, 0xde, 0xad, 0xe1, 0xb7, 0x7c, 0x15, 0x1e,
+		0xf0, 0x40, 0xb5, 0x36, 0xd9, 0xd1, 0x9b, 0xfb, 0x1a, 0x35, 0xc6, 0x69, 0xd8, 0x40, 0x6b, 0x5a,
+		0xe6, 0x76, 0xdb, 0x68, 0x3a, 0x76, 0x31, 0xe3, 0xea, 0x38, 0xc5, 0xc3, 0x58, 0xa6, 0x08, 0x57,
+		0x6c, 0xcb, 0xa4, 0x36, 0x78, 0x55, 0x40, 0xbf, 0x2f, 0xee, 0xd7, 0x95, 0x78, 0x2a, 0x2e, 0x25,
+		0xae, 0xc4, 0x53, 0x09, 0x29, 0x79, 0x25, 0x9e, 0x4a, 0x4a, 0xd3, 0x57, 0xe2, 0xa9, 0x94, 0x94,
+		0xbe, 0x12, 0x4f, 0xa5, 0x25, 0x50, 0x3e, 0x9d, 0x82, 0xac, 0xdf, 0x33, 0x40, 0x47, 0xab, 0x49,
+		0xf7, 0xc6, 0x08, 0xd5, 0x9e, 0xf7, 0x1e, 0xe8, 0x47, 0x2c, 0x54, 0x71, 0xd3, 0x5c, 0x4c, 0x32,
+		0x33, 0x5c, 0x65, 0x98, 0x68, 0xb0, 0xa0, 0x58, 0x13, 0x66, 0xf6, 0xa4, 0x54, 0xfe, 0x25, 0x5f,
+		0x82, 0xe4, 0x6b, 0x36, 0xa5, 0x9d, 0xa4, 0xb4, 0xef, 0x3b, 0x98, 0xf6, 0x95, 0x06, 0x25, 0x9e,
+		0xbe, 0xd2, 0xd0, 0x56, 0xd7, 0xd4, 0x95, 0xf2, 0xb2, 0xca, 0xd1, 0xe5, 0xe3, 0x10, 0x6f, 0xeb,
+		0x6f, 0xec, 0x07, 0xb7, 0x57, 0x5a, 0x24, 0x2f, 0x40, 0xa1, 0x6f, 0x5e, 0x27, 0x3d, 0x63, 0xdb,
+		0xc0, 0xa9, 0x42, 0xa8, 0x82, 0x1f, 0x2a, 0xef, 0xd5, 0x2e, 0x23, 0xfc, 0x84, 0xe2, 0x71, 0x1c,
+		0xe2, 0x18, 0x54, 0x0c, 0x6e, 0x82, 0xb4, 0x48, 0x3e, 0x0d, 0xd9, 0x16, 0xd9, 0xea, 0xef, 0x68,
+		0x3d, 0xd2, 0xd2, 0x9b, 0x4e, 0x50, 0xf5, 0x67, 0x68, 0x95, 0x4a, 0x6b, 0xe4, 0x17, 0x20, 0x8d,
+		0x73, 0x64, 0xd2, 0x39, 0x9e, 0xa1, 0x2c, 0x78, 0xf4, 0x60, 0x16, 0xf0, 0x29, 0x16, 0x48, 0xaa,
+		0x87, 0x2f, 0x5f, 0x84, 0xa4, 0xa3, 0xf7, 0x76, 0x88, 0x43, 0x35, 0x7f, 0xfe, 0xdc, 0xc2, 0x24,
+		0x94, 0x36, 0x28, 0x06, 0xf5, 0x69, 0x39, 0xf6, 0x7b, 0xa8, 0x65, 0xce, 0x42, 0x82, 0x8a, 0x87,
+		0x0c, 0xc0, 0x05, 0x44, 0x9a, 0x92, 0x53, 0x10, 0xaf, 0xae, 0xa9, 0xa8, 0x69, 0x24, 0xc8, 0xb2,
+		0x52, 0x6d, 0xbd, 0x5e, 0xab, 0xd6, 0xa4, 0xa8, 0x72, 0x1e, 0x92, 0x6c, 0xce, 0x51, 0x0b, 0xb9,
+		0xb3, 0x2e, 0x4d, 0xf1, 0x4f, 0x4e, 0x23, 0x22, 0x6a, 0x37, 0x57, 0x2a, 0x35, 0x55, 0x8a, 0x2a,
+		0x9b, 0x50, 0x18, 0xe0, 0x93, 0x7c, 0x04, 0x66, 0xd4, 0xda, 0x46, 0x6d, 0x15, 0xfd, 0x2c, 0x6d,
+		0x73, 0xf5, 0x85, 0xd5, 0xb5, 0x97, 0x56, 0xa5, 0xa9, 0x60, 0xb1, 0x50, 0x69, 0x11, 0x79, 0x0e,
+		0x24, 0xaf, 0xb8, 0xb1, 0xb6, 0xa9, 0xd2, 0xde, 0x7c, 0x77, 0x14, 0xa4, 0x41, 0xae, 0xc9, 0xc7,
+		0x60, 0x76, 0xa3, 0xac, 0x5e, 0xaa, 0x6d, 0x68, 0xcc, 0x77, 0x74, 0x49, 0xcf, 0x81, 0xe4, 0xaf,
+		0xb8, 0x58, 0xa7, 0xae, 0xf1, 0x29, 0x38, 0xe1, 0x2f, 0xad, 0xbd, 0xbc, 0x51, 0x5b, 0x6d, 0xd0,
+		0xc6, 0xcb, 0xab, 0x97, 0x50, 0xbf, 0x0e, 0xd0, 0x13, 0xde, 0x6a, 0x0c, 0xbb, 0x1a, 0xa4, 0x57,
+		0x5b, 0x5e, 0x92, 0xe2, 0x83, 0xc5, 0x6b, 0xab, 0xb5, 0xb5, 0x8b, 0x52, 0x62, 0xb0, 0x75, 0xea,
+		0xc1, 0x26, 0xe5, 0x12, 0x1c, 0x1d, 0x2c, 0xd5, 0x6a, 0xab, 0x1b, 0xea, 0x2b, 0xd2, 0xf4, 0x60,
+		0xc3, 0x8d, 0x9a, 0x7a, 0xb5, 0x5e, 0xad, 0x49, 0x29, 0xf9, 0x28, 0xc8, 0xc1, 0x1e, 0x6d, 0x5c,
+		0x5e, 0x5b, 0x92, 0xd2, 0x43, 0x1a, 0x45, 0xb1, 0x21, 0xeb, 0x77, 0x23, 0xdf, 0x9f, 0x58, 0xd2,
+		0x47, 0xa2, 0x90, 0xf1, 0xb9, 0x85, 0x68, 0xcf, 0xeb, 0xed, 0xb6, 0x75, 0x43, 0xd3, 0xdb, 0x86,
+		0x6e, 0x73, 0x7d, 0x03, 0xb4, 0xa8, 0x8c, 0x25, 0x93, 0xae, 0xef, 0xc9, 0x35, 0x7c, 0xf2, 0x2b,
+		0x51, 0xc3, 0x27, 0xa4, 0xa4, 0xf2, 0xa3, 0x11, 0x90, 0x06, 0xfd, 0xbd, 0x81, 0xe1, 0x47, 0xc6,
+		0x0d, 0xff, 0x7d, 0x99, 0xbb, 0x8f, 0x46, 0x20, 0x1f, 0x74, 0xf2, 0x06, 0xba, 0x77, 0xcf, 0xff,
+		0xd7, 0xee, 0xfd, 0x41, 0x14, 0x72, 0x01, 0xd7, 0x6e, 0xd2, 0xde, 0xbd, 0x0e, 0x33, 0x46, 0x8b,
+		0x74, 0xba, 0x96, 0x83, 0xa7, 0x4d, 0x5a, 0x9b, 0x5c, 0x27, 0xed, 0xa2, 0x42, 0x95, 0xf2, 0xd9,
+		0x83, 0x9d, 0xc7, 0x85, 0xba, 0x87, 0xb7, 0x8c, 0x68, 0x8b, 0xb3, 0xf5, 0xa5, 0xda, 0xca, 0xfa,
+		0xda, 0x46, 0x6d, 0xb5, 0xfa, 0x8a, 0xd0, 0x2e, 0xaa, 0x64, 0x0c, 0x80, 0xbd, 0x87, 0x4a, 0x7b,
+		0x1d, 0xa4, 0xc1, 0x4e, 0xa1, 0xae, 0x18, 0xd1, 0x2d, 0x69, 0x4a, 0x9e, 0x85, 0xc2, 0xea, 0x9a,
+		0xd6, 0xa8, 0x2f, 0xd5, 0xb4, 0xda, 0xc5, 0x8b, 0xb5, 0xea, 0x46, 0x83, 0x85, 0x03, 0x5d, 0xe8,
+		0x0d, 0x29, 0xea, 0x67, 0xf1, 0x0f, 0xc7, 0x60, 0x76, 0x44, 0x4f, 0xe4, 0x32, 0x77, 0xe4, 0x59,
+		0x6c, 0xe1, 0xd1, 0x49, 0x7a, 0xbf, 0x80, 0xa6, 0xf4, 0xba, 0xde, 0x73, 0xb8, 0xdf, 0xff, 0x10,
+		0x20, 0x97, 0x4c, 0x07, 0x77, 0xf6, 0x1e, 0x0f, 0xb3, 0x32, 0xef, 0xbe, 0xe0, 0x95, 0xb3, 0x48,
+		0xeb, 0x23, 0x20, 0x77, 0x2d, 0xdb, 0x70, 0x8c, 0xeb, 0x78, 0x86, 0x25, 0x62, 0xb2, 0xe8, 0xed,
+		0xc7, 0x55, 0x49, 0xd4, 0xd4, 0x4d, 0xc7, 0x85, 0x36, 0xc9, 0x8e, 0x3e, 0x00, 0x8d, 0x96, 0x47,
+		0x4c, 0x95, 0x44, 0x8d, 0x0b, 0x7d, 0x0f, 0x64, 0x5b, 0x56, 0x1f, 0x5d, 0x20, 0x06, 0x87, 0xda,
+		0x22, 0xa2, 0x66, 0x58, 0x99, 0x0b, 0xc2, 0x9d, 0x5b, 0x2f, 0x18, 0x9c, 0x55, 0x33, 0xac, 0x8c,
+		0x81, 0x3c, 0x08, 0x05, 0x7d, 0x67, 0xa7, 0x87, 0xc4, 0x05, 0x21, 0xe6, 0xae, 0xe7, 0xdd, 0x62,
+		0x0a, 0x58, 0xba, 0x02, 0x29, 0xc1, 0x07, 0xb4, 0x60, 0x91, 0x13, 0x5a, 0x97, 0xc5, 0xa0, 0xa2,
+		0x18, 0x1f, 0x36, 0x45, 0xe5, 0x3d, 0x90, 0x35, 0x6c, 0xcd, 0x3b, 0xdb, 0x8a, 0xce, 0x47, 0x4f,
+		0xa7, 0xd4, 0x8c, 0x61, 0xbb, 0xe7, 0x02, 0xca, 0x4f, 0x45, 0x21, 0x1f, 0x3c, 0xb5, 0x93, 0x97,
+		0x20, 0xd5, 0xb6, 0x9a, 0x3a, 0x15, 0x2d, 0x76, 0x64, 0x7c, 0x3a, 0xe4, 0xa0, 0x6f, 0x61, 0x99,
+		0xc3, 0xab, 0x2e, 0x66, 0xe9, 0x5f, 0x45, 0x20, 0x25, 0x8a, 0xe5, 0xa3, 0x10, 0xef, 0xea, 0xce,
+		0x2e, 0x25, 0x97, 0xa8, 0x44, 0xa5, 0x88, 0x4a, 0xbf, 0xb1, 0xdc, 0xee, 0xea, 0x66, 0x31, 0xea,
+		0x95, 0xe3, 0x37, 0xce, 0x6b, 0x9b, 0xe8, 0x2d, 0x1a, 0x0b, 0xb0, 0x3a, 0x1d, 0x62, 0x3a, 0xb6,
+		0x98, 0x57, 0x5e, 0x5e, 0xe5, 0xc5, 0x78, 0x78, 0xec, 0xf4, 0x74, 0xa3, 0x1d, 0x80, 0x8d, 0x53,
+		0x58, 0x49, 0x54, 0xb8, 0xc0, 0x8b, 0x70, 0x5c, 0xd0, 0x6d, 0x11, 0x47, 0x6f, 0xee, 0x92, 0x96,
+		0x87, 0x94, 0xa4, 0x31, 0xbf, 0x63, 0x1c, 0x60, 0x89, 0xd7, 0x0b, 0x5c, 0xe5, 0x33, 0x51, 0x98,
+		0x11, 0xd1, 0x8b, 0x96, 0xcb, 0xac, 0x15, 0x00, 0xdd, 0x34, 0x2d, 0xc7, 0xcf, 0xae, 0x61, 0x51,
+		0x1e, 0xc2, 0x5b, 0x28, 0xbb, 0x48, 0xaa, 0x8f, 0x40, 0xe9, 0xf3, 0x11, 0x00, 0xaf, 0x6a, 0x2c,
+		0xdf, 0x4e, 0x41, 0x86, 0x9f, 0xc9, 0xd2, 0x83, 0x7d, 0x16, 0xf0, 0x02, 0x56, 0x84, 0x71, 0x0e,
+		0x0c, 0x4b, 0x6e, 0x91, 0x1d, 0xc3, 0xe4, 0xe7, 0x29, 0xec, 0x43, 0x84, 0x25, 0xe3, 0xde, 0xf1,
+		0x94, 0x0a, 0x29, 0x9b, 0x74, 0x74, 0xd3, 0x31, 0x9a, 0xfc, 0x84, 0xe4, 0xc2, 0xa1, 0x3a, 0xbf,
+		0xd0, 0xe0, 0xd8, 0xaa, 0x4b, 0x47, 0x39, 0x0d, 0x29, 0x51, 0x8a, 0x86, 0xdf, 0xea, 0xda, 0x6a,
+		0x4d, 0x9a, 0x92, 0xa7, 0x21, 0xd6, 0xa8, 0x6d, 0x48, 0x11, 0x74, 0x3b, 0xcb, 0xcb, 0xf5, 0x72,
+		0x43, 0x8a, 0x56, 0xfe, 0x1a, 0xcc, 0x36, 0xad, 0xce, 0x60, 0x83, 0x15, 0x69, 0x20, 0xe4, 0x67,
+		0x5f, 0x8e, 0xbc, 0xfa, 0x28, 0x07, 0xda, 0xb1, 0xda, 0xba, 0xb9, 0xb3, 0x60, 0xf5, 0x76, 0xbc,
+		0xb4, 0x08, 0xf4, 0x0e, 0x6c, 0x5f, 0x72, 0x44, 0x77, 0xeb, 0x7f, 0x46, 0x22, 0x3f, 0x16, 0x8d,
+		0x5d, 0x5a, 0xaf, 0xfc, 0x4c, 0xb4, 0x74, 0x89, 0x21, 0xae, 0x8b, 0xe1, 0xa8, 0x64, 0xbb, 0x4d,
+		0x9a, 0xd8, 0x79, 0xf8, 0xd3, 0x87, 0x61, 0x6e, 0xc7, 0xda, 0xb1, 0x28, 0xa5, 0xb3, 0xf8, 0x8b,
+		0x75, 0x42, 0x4e, 0xbb, 0xa5, 0xa5, 0xd0, 0x24, 0x8c, 0xc5, 0x55, 0x98, 0xe5, 0xc0, 0x1a, 0x3d,
+		0xbe, 0x65, 0xc1, 0x05, 0xf9, 0xc0, 0xc8, 0x76, 0xf1, 0xe7, 0xff, 0x88, 0x5a, 0x25, 0xea, 0x0c,
+		0x47, 0xc5, 0x3a, 0x16, 0x7f, 0x58, 0x54, 0xe1, 0x48, 0x80, 0x1e, 0xd3, 0x11, 0xa4, 0x17, 0x42,
+		0xf1, 0x9f, 0x71, 0x8a, 0xb3, 0x3e, 0x8a, 0x0d, 0x8e, 0xba, 0x58, 0x85, 0xdc, 0x61, 0x68, 0xfd,
+		0x73, 0x4e, 0x2b, 0x4b, 0xfc, 0x44, 0x2e, 0x41, 0x81, 0x12, 0x69, 0xf6, 0x6d, 0xc7, 0xea, 0x50,
+		0x05, 0x7c, 0x30, 0x99, 0xdf, 0xfc, 0x23, 0xb6, 0x68, 0xf3, 0x88, 0x56, 0x75, 0xb1, 0x16, 0x17,
+		0x81, 0x9e, 0x58, 0xe3, 0x49, 0x72, 0x08, 0x85, 0xdf, 0xe2, 0x1d, 0x71, 0xe1, 0x17, 0xaf, 0xc2,
+		0x1c, 0xfe, 0xa6, 0xfa, 0xd1, 0xdf, 0x93, 0xf0, 0x30, 0x78, 0xf1, 0x77, 0xdf, 0x62, 0x7a, 0x61,
+		0xd6, 0x25, 0xe0, 0xeb, 0x93, 0x6f, 0x16, 0x77, 0x88, 0xe3, 0x90, 0x9e, 0xad, 0xe9, 0xed, 0x51,
+		0xdd, 0xf3, 0xc5, 0x11, 0x8b, 0x3f, 0xf4, 0x85, 0xe0, 0x2c, 0x5e, 0x62, 0x98, 0xe5, 0x76, 0x7b,
+		0x71, 0x13, 0x8e, 0x8d, 0x90, 0x8a, 0x09, 0x68, 0xfe, 0x30, 0xa7, 0x39, 0x37, 0x24, 0x19, 0x48,
+		0x76, 0x1d, 0x44, 0xb9, 0x3b, 0x97, 0x13, 0xd0, 0xfc, 0x11, 0x4e, 0x53, 0xe6, 0xb8, 0x62, 0x4a,
+		0x91, 0xe2, 0x15, 0x98, 0xb9, 0x4e, 0x7a, 0x5b, 0x96, 0xcd, 0x63, 0xb7, 0x13, 0x90, 0xfb, 0x28,
+		0x27, 0x57, 0xe0, 0x88, 0x34, 0x98, 0x8b, 0xb4, 0x9e, 0x81, 0xd4, 0xb6, 0xde, 0x24, 0x13, 0x90,
+		0xb8, 0xc5, 0x49, 0x4c, 0x23, 0x3c, 0xa2, 0x96, 0x21, 0xbb, 0x63, 0xf1, 0x2d, 0x32, 0x1c, 0xfd,
+		0x47, 0x39, 0x7a, 0x46, 0xe0, 0x70, 0x12, 0x5d, 0xab, 0xdb, 0x6f, 0xe3, 0xfe, 0x19, 0x4e, 0xe2,
+		0xef, 0x08, 0x12, 0x02, 0x87, 0x93, 0x38, 0x04, 0x5b, 0x3f, 0x26, 0x48, 0xd8, 0x3e, 0x7e, 0x3e,
+		0x8f, 0x47, 0xba, 0xed, 0x7d, 0xcb, 0x9c, 0xa4, 0x13, 0x1f, 0xe7, 0x14, 0x80, 0xa3, 0x20, 0x81,
+		0x67, 0x21, 0x3d, 0xe9, 0x44, 0xfc, 0xc4, 0x17, 0xc4, 0xf2, 0x10, 0x33, 0x70, 0x09, 0x0a, 0x42,
+		0x41, 0x61, 0x0a, 0x48, 0x38, 0x89, 0x9f, 0xe4, 0x24, 0xf2, 0x3e, 0x34, 0x3e, 0x0c, 0x87, 0xd8,
+		0xce, 0x0e, 0x99, 0x84, 0xc8, 0x4f, 0x89, 0x61, 0x70, 0x14, 0xce, 0xca, 0x2d, 0x62, 0x36, 0x77,
+		0x27, 0xa3, 0xf0, 0x09, 0xc1, 0x4a, 0x81, 0x83, 0x24, 0xaa, 0x90, 0xeb, 0xe8, 0x3d, 0x7b, 0x57,
+		0x6f, 0x4f, 0x34, 0x1d, 0x3f, 0xcd, 0x69, 0x64, 0x5d, 0x24, 0xce, 0x91, 0xbe, 0x79, 0x18, 0x32,
+		0x3f, 0x23, 0x38, 0xd2, 0x37, 0x03, 0x84, 0xd6, 0x61, 0xce, 0x76, 0x68, 0xa0, 0xfb, 0x30, 0xd4,
+		0xfe, 0x9e, 0x58, 0x7a, 0x0c, 0x77, 0xc5, 0x4f, 0xf1, 0x59, 0x48, 0xdb, 0xc6, 0x1b, 0x13, 0x91,
+		0xf9, 0xfb, 0x62, 0xa6, 0x29, 0x02, 0x22, 0xbf, 0x02, 0xc7, 0x47, 0x6e, 0x13, 0x13, 0x10, 0xfb,
+		0x07, 0x9c, 0xd8, 0xd1, 0x11, 0x5b, 0x05, 0x57, 0x09, 0x87, 0x25, 0xf9, 0xb3, 0x42, 0x25, 0x90,
+		0x01, 0x5a, 0xeb, 0xe8, 0xb4, 0xd8, 0xfa, 0xf6, 0xe1, 0xb8, 0xf6, 0x0f, 0x05, 0xd7, 0x18, 0x6e,
+		0x80, 0x6b, 0x1b, 0x70, 0x94, 0x53, 0x3c, 0xdc, 0xbc, 0xfe, 0x9c, 0x50, 0xac, 0x0c, 0x7b, 0x33,
+		0x38, 0xbb, 0x5f, 0x0f, 0x25, 0x97, 0x9d, 0xc2, 0x3a, 0xb6, 0x35, 0x8c, 0x0e, 0x87, 0x53, 0xfe,
+		0x79, 0x4e, 0x59, 0x68, 0x7c, 0xd7, 0xbc, 0xb6, 0x57, 0xf4, 0x2e, 0x12, 0x7f, 0x19, 0x8a, 0x82,
+		0x78, 0xdf, 0xec, 0x91, 0xa6, 0xb5, 0x63, 0x1a, 0x6f, 0x90, 0xd6, 0x04, 0xa4, 0x7f, 0x61, 0x60,
+		0xaa, 0x36, 0x7d, 0xe8, 0x48, 0xb9, 0x0e, 0x92, 0x6b, 0xab, 0x68, 0x46, 0xa7, 0x6b, 0xf5, 0x9c,
+		0x10, 0x8a, 0x9f, 0x14, 0x33, 0xe5, 0xe2, 0xd5, 0x29, 0xda, 0x62, 0x0d, 0x58, 0xf6, 0xc7, 0xa4,
+		0x22, 0xf9, 0x29, 0x4e, 0x28, 0xe7, 0x61, 0x71, 0xc5, 0xd1, 0xb4, 0x3a, 0x5d, 0xbd, 0x37, 0x89,
+		0xfe, 0xfb, 0x47, 0x42, 0x71, 0x70, 0x14, 0xae, 0x38, 0xd0, 0xa2, 0xc3, 0xdd, 0x7e, 0x02, 0x0a,
+		0xbf, 0x28, 0x14, 0x87, 0xc0, 0xe1, 0x24, 0x84, 0xc1, 0x30, 0x01, 0x89, 0x4f, 0x0b, 0x12, 0x02,
+		0x07, 0x49, 0xbc, 0xe8, 0x6d, 0xb4, 0x3d, 0xb2, 0x63, 0xd8, 0x4e, 0x8f, 0x99, 0xe4, 0x07, 0x93,
+		0xfa, 0xa5, 0x2f, 0x04, 0x8d, 0x30, 0xd5, 0x87, 0x8a, 0x9a, 0x88, 0x1f, 0x7d, 0x50, 0x97, 0x2d,
+		0xbc, 0x63, 0xbf, 0x2c, 0x34, 0x91, 0x0f, 0x0d, 0xfb, 0xe6, 0xb3, 0x10, 0x91, 0xed, 0x4d, 0x74,
+		0x54, 0x26, 0x20, 0xf7, 0x8f, 0x07, 0x3a, 0xd7, 0x10, 0xb8, 0x48, 0xd3, 0x67, 0xff, 0xf4, 0xcd,
+		0x6b, 0x64, 0x7f, 0x22, 0xe9, 0xfc, 0x95, 0x01, 0xfb, 0x67, 0x93, 0x61, 0x32, 0x1d, 0x52, 0x18,
+		0xb0, 0xa7, 0xe4, 0xb0, 0x5c, 0xbf, 0xe2, 0xb7, 0xbc, 0xcb, 0xc7, 0x1b, 0x34, 0xa7, 0x16, 0x97,
+		0x41, 0xe2, 0x25, 0x9e, 0x01, 0x1b, 0x4a, 0xec, 0xad, 0x77, 0x5d, 0x39, 0x0f, 0xd8, 0x3c, 0x8b,
+		0x17, 0x21, 0x17, 0x30, 0x78, 0xc2, 0x49, 0x7d, 0x1b, 0x27, 0x95, 0xf5, 0xdb, 0x3b, 0x8b, 0xe7,
+		0x21, 0x8e, 0xc6, 0x4b, 0x38, 0xfa, 0xb7, 0x73, 0x74, 0x0a, 0xbe, 0xf8, 0x1c, 0xa4, 0x84, 0xd1,
+		0x12, 0x8e, 0xfa, 0x1d, 0x1c, 0xd5, 0x45, 0x41, 0x74, 0x61, 0xb0, 0x84, 0xa3, 0xff, 0x75, 0x81,
+		0x2e, 0x50, 0x10, 0x7d, 0x72, 0x16, 0xfe, 0xfa, 0xdf, 0x88, 0x33, 0x74, 0x81, 0xb2, 0x88, 0xd9,
+		0x27, 0xcc, 0x52, 0x09, 0xc7, 0xfe, 0x2e, 0xde, 0xb8, 0xc0, 0x58, 0x7c, 0x0a, 0x12, 0x13, 0x32,
+		0xfc, 0x6f, 0x72, 0x54, 0x06, 0xbf, 0x58, 0x85, 0x8c, 0xcf, 0x3a, 0x09, 0x47, 0xff, 0x1e, 0x8e,
+		0xee, 0xc7, 0xc2, 0xae, 0x73, 0xeb, 0x24, 0x9c, 0xc0, 0xf7, 0x8a, 0xae, 0x73, 0x0c, 0x64, 0x9b,
+		0x30, 0x4c, 0xc2, 0xb1, 0x3f, 0x2c, 0xb8, 0x2e, 0x50, 0x16, 0x9f, 0x87, 0xb4, 0xbb, 0xd9, 0x84,
+		0xe3, 0x7f, 0x1f, 0xc7, 0xf7, 0x70, 0x90, 0x03, 0x7d, 0xf3, 0x10, 0x24, 0xfe, 0x96, 0xe0, 0x80,
+		0x0f, 0x0b, 0x97, 0xd1, 0xa0, 0x01, 0x13, 0x4e, 0xe9, 0xfb, 0xc5, 0x32, 0x1a, 0xb0, 0x5f, 0x70,
+		0x36, 0xa9, 0xce, 0x0f, 0x27, 0xf1, 0xb7, 0xc5, 0x6c, 0x52, 0x78, 0xec, 0xc6, 0xa0, 0x45, 0x10,
+		0x4e, 0xe3, 0x07, 0x45, 0x37, 0x06, 0x0c, 0x82, 0xc5, 0x75, 0x90, 0x87, 0xad, 0x81, 0x70, 0x7a,
+		0x1f, 0xe1, 0xf4, 0x66, 0x86, 0x8c, 0x81, 0xc5, 0x97, 0xe0, 0xe8, 0x68, 0x4b, 0x20, 0x9c, 0xea,
+		0x0f, 0xbd, 0x3b, 0xe0, 0xbb, 0xf9, 0x0d, 0x81, 0xc5, 0x0d, 0x98, 0x1b, 0x65, 0x05, 0x84, 0x93,
+		0xfd, 0xe1, 0x77, 0x83, 0x8a, 0xdb, 0x6f, 0x04, 0x2c, 0x96, 0x01, 0xbc, 0x0d, 0x38, 0x9c, 0xd6,
+		0x47, 0x39, 0x2d, 0x1f, 0x12, 0x2e, 0x0d, 0xbe, 0xff, 0x86, 0xe3, 0xdf, 0x12, 0x4b, 0x83, 0x63,
+		0xe0, 0xd2, 0x10, 0x5b, 0x6f, 0x38, 0xf6, 0x8f, 0x8a, 0xa5, 0x21, 0x50, 0x50, 0xb2, 0x7d, 0xbb,
+		0x5b, 0x38, 0x85, 0x8f, 0x0b, 0xc9, 0xf6, 0x61, 0x2d, 0xae, 0xc2, 0xcc, 0xd0, 0x86, 0x18, 0x4e,
+		0xea, 0xc7, 0x38, 0x29, 0x69, 0x70, 0x3f, 0xf4, 0x6f, 0x5e, 0x7c, 0x33, 0x0c, 0xa7, 0xf6, 0xe3,
+		0x03, 0x9b, 0x17, 0xdf, 0x0b, 0x17, 0x9f, 0x85, 0x94, 0xd9, 0x6f, 0xb7, 0x71, 0xf1, 0xc8, 0x07,
+		0xe7, 0xe7, 0x16, 0xff, 0xcb, 0x97, 0x38, 0x77, 0x04, 0xc2, 0xe2, 0x79, 0x48, 0x90, 0xce, 0x16,
+		0x69, 0x85, 0x61, 0xfe, 0xc9, 0x97, 0x84, 0xc2, 0x44, 0xe8, 0xc5, 0xe7, 0x01, 0x58, 0x68, 0x84,
+		0x1e, 0x9c, 0x87, 0xe0, 0x7e, 0xfe, 0x4b, 0x3c, 0x21, 0xce, 0x43, 0xf1, 0x08, 0xb0, 0xf4, 0xba,
+		0x83, 0x09, 0x7c, 0x21, 0x48, 0x80, 0xce, 0xc8, 0x33, 0x30, 0x8d, 0x07, 0x69, 0x8e, 0xbe, 0x13,
+		0x86, 0xfd, 0xa7, 0x1c, 0x5b, 0xc0, 0x23, 0xc3, 0x3a, 0x56, 0x8f, 0x38, 0xfa, 0x8e, 0x1d, 0x86,
+		0xfb, 0x5f, 0x39, 0xae, 0x8b, 0x80, 0xc8, 0x4d, 0xdd, 0x76, 0x26, 0x19, 0xf7, 0x9f, 0x09, 0x64,
+		0x81, 0x80, 0x9d, 0xc6, 0xdf, 0xd7, 0xc8, 0x7e, 0x18, 0xee, 0x9f, 0x8b, 0x4e, 0x73, 0xf8, 0xc5,
+		0xe7, 0x20, 0x8d, 0x3f, 0x59, 0x96, 0x6b, 0x08, 0xf2, 0x5f, 0x70, 0x64, 0x0f, 0x03, 0x5b, 0xb6,
+		0x9d, 0x96, 0x63, 0x84, 0x33, 0xfb, 0x8b, 0x7c, 0xa6, 0x05, 0xfc, 0x62, 0x19, 0x32, 0xb6, 0xd3,
+		0x6a, 0xf5, 0xb9, 0x7d, 0x1a, 0x82, 0xfe, 0xdf, 0xbe, 0xe4, 0x86, 0x2c, 0x5c, 0x1c, 0x9c, 0xed,
+		0x1b, 0xd7, 0x9c, 0xae, 0x45, 0xcf, 0x5b, 0xc2, 0x28, 0xbc, 0xcb, 0x29, 0xf8, 0x50, 0x16, 0xab,
+		0x90, 0xc5, 0xb1, 0xf4, 0x48, 0x97, 0xd0, 0xc3, 0xb1, 0x10, 0x12, 0x7f, 0xc9, 0x19, 0x10, 0x40,
+		0xaa, 0x7c, 0xd3, 0x6f, 0xbd, 0x73, 0x32, 0xf2, 0x99, 0x77, 0x4e, 0x46, 0xfe, 0xe0, 0x9d, 0x93,
+		0x91, 0x0f, 0x7f, 0xf6, 0xe4, 0xd4, 0x67, 0x3e, 0x7b, 0x72, 0xea, 0xf7, 0x3f, 0x7b, 0x72, 0x6a,
+		0x74, 0x94, 0x18, 0x2e, 0x59, 0x97, 0x2c, 0x16, 0x1f, 0x7e, 0xf5, 0xfe, 0x1d, 0xc3, 0xd9, 0xed,
+		0x6f, 0x2d, 0x34, 0xad, 0xce, 0xd9, 0xa6, 0x65, 0x77, 0x2c, 0xfb, 0x6c, 0x30, 0xae, 0x4b, 0x7f,
+		0xc1, 0xff, 0x8e, 0xc0, 0x71, 0x46, 0xc6, 0x0b, 0xe7, 0xea, 0xe6, 0xfe, 0xb8, 0xcb, 0x74, 0x17,
+		0x20, 0x56, 0x36, 0xf7, 0xe5, 0xe3, 0x4c, 0xc1, 0x69, 0xfd, 0x5e, 0x9b, 0xa7, 0x5a, 0x4e, 0xe3,
+		0xf7, 0x66, 0xaf, 0x8d, 0x61, 0x76, 0x91, 0x0f, 0x8d, 0xc7, 0x39, 0xec, 0xa3, 0xf2, 0x3d, 0x91,
+		0xc3, 0x8d, 0x24, 0x55, 0x36, 0xf7, 0xe9, 0x40, 0xd6, 0x23, 0xaf, 0x3e, 0x12, 0x1a, 0xe7, 0xbe,
+		0x66, 0x5a, 0x37, 0x4c, 0xec, 0x76, 0x77, 0x4b, 0xc4, 0xb8, 0x4f, 0x0e, 0xc6, 0xb8, 0x5f, 0x22,
+		0xed, 0xf6, 0x0b, 0x08, 0x87, 0xe9, 0x0b, 0xf6, 0x56, 0x92, 0x65, 0xf5, 0xc3, 0xf7, 0x47, 0xe1,
+		0xe4, 0x50, 0x38, 0x9b, 0x0b, 0xc1, 0x38, 0x26, 0x2c, 0x42, 0x6a, 0x49, 0xc8, 0x56, 0x11, 0xaf,
+		0xb2, 0x35, 0x2d, 0xb3, 0x65, 0x53, 0x46, 0xc4, 0x54, 0xf1, 0x89, 0x8c, 0x30, 0x75, 0xd3, 0xb2,
+		0x79, 0xb2, 0x32, 0xfb, 0xa8, 0xfc, 0xc8, 0x21, 0x19, 0x91, 0x13, 0x2d, 0x09, 0x6e, 0x3c, 0x3e,
+		0x21, 0x37, 0xc4, 0x20, 0x02, 0x91, 0xff, 0x49, 0xb9, 0xf2, 0x83, 0x51, 0x38, 0x35, 0xc8, 0x15,
+		0x5c, 0x59, 0xb6, 0xa3, 0x77, 0xba, 0xe3, 0xd8, 0xf2, 0x2c, 0xa4, 0x37, 0x04, 0xcc, 0xa1, 0xf9,
+		0x72, 0xeb, 0x90, 0x7c, 0xc9, 0xbb, 0x4d, 0x09, 0xc6, 0x9c, 0x9b, 0x90, 0x31, 0xee, 0x38, 0x6e,
+		0x8b, 0x33, 0x7f, 0x95, 0x84, 0xe3, 0x6c, 0x39, 0x69, 0x6c, 0x29, 0xb1, 0x0f, 0xce, 0x93, 0xac,
+		0xbf, 0x2a, 0xfc, 0x9c, 0x44, 0x79, 0x01, 0x66, 0xeb, 0xa8, 0x2d, 0xd0, 0x0b, 0xf2, 0x4e, 0x78,
+		0x46, 0xe6, 0x73, 0xcf, 0x07, 0x0c, 0x7e, 0x7e, 0xbe, 0xe5, 0x2f, 0x52, 0xbe, 0x25, 0x02, 0x52,
+		0xa3, 0xa9, 0xb7, 0xf5, 0xde, 0x97, 0x4b, 0x4a, 0x7e, 0x0a, 0x80, 0xa5, 0x7b, 0xb8, 0x17, 0xf7,
+		0xf2, 0xe7, 0x8a, 0x0b, 0xfe, 0xc1, 0x2d, 0xb0, 0x96, 0x68, 0x06, 0x55, 0x9a, 0xc2, 0xe2, 0xcf,
+		0x33, 0x2f, 0x03, 0x78, 0x15, 0xf2, 0x09, 0x38, 0xd6, 0xa8, 0x96, 0x97, 0xcb, 0xaa, 0x48, 0x12,
+		0x6a, 0xac, 0xd7, 0xaa, 0xf5, 0x8b, 0xf5, 0xda, 0x92, 0x34, 0x85, 0xf9, 0x35, 0xfe, 0x4a, 0x37,
+		0xa9, 0xe9, 0x08, 0xcc, 0xf8, 0xcb, 0xd9, 0x2d, 0x95, 0x28, 0x5a, 0x8a, 0x46, 0xa7, 0xdb, 0x26,
+		0xf4, 0xe4, 0x51, 0x33, 0x04, 0xd7, 0xc2, 0x8d, 0x90, 0xdf, 0xfe, 0x37, 0xec, 0xe6, 0xc2, 0xac,
+		0x87, 0xee, 0xf2, 0x7c, 0x71, 0x19, 0x66, 0x30, 0x97, 0xb2, 0x1b, 0x20, 0x19, 0xa2, 0xaa, 0x91,
+		0x20, 0x3d, 0x4b, 0xe5, 0x98, 0x1e, 0xb5, 0xa7, 0x20, 0x69, 0xd3, 0xd1, 0x87, 0x91, 0xf8, 0x1d,
+		0x4e, 0x82, 0x83, 0x2f, 0x9a, 0x30, 0x83, 0x96, 0x1f, 0x06, 0x88, 0xbc, 0x6e, 0x1c, 0x1c, 0x67,
+		0xf8, 0xd5, 0x4f, 0x3e, 0x46, 0x4f, 0x56, 0xef, 0x09, 0x4e, 0xcb, 0x08, 0x71, 0x52, 0x25, 0x4e,
+		0xdb, 0xeb, 0x28, 0x81, 0xbc, 0x68, 0x8f, 0x77, 0xf8, 0xe0, 0xc6, 0x7e, 0x8d, 0x37, 0x76, 0x72,
+		0x94, 0x0c, 0xf8, 0x5a, 0xca, 0x71, 0xaa, 0xac, 0xa2, 0x52, 0x1b, 0xb7, 0xa6, 0x5f, 0x7d, 0x78,
+		0x78, 0x77, 0x62, 0xff, 0x3d, 0x4a, 0x29, 0x3f, 0xeb, 0x6f, 0xc6, 0x5d, 0x7b, 0x3f, 0x12, 0x87,
+		0x19, 0x4c, 0xff, 0xb7, 0xce, 0xd2, 0x7f, 0xf9, 0x9a, 0x4b, 0xd0, 0x8f, 0x09, 0x0e, 0x25, 0x2f,
+		0xb0, 0xa5, 0x10, 0x2e, 0x31, 0x7f, 0xf1, 0xdd, 0x3f, 0x99, 0xf0, 0x96, 0xcb, 0xe2, 0x0a, 0x48,
+		0x22, 0x89, 0x97, 0x98, 0x4d, 0xab, 0x35, 0x51, 0x94, 0xe2, 0x8b, 0x82, 0x86, 0x88, 0x6f, 0xd5,
+		0x38, 0xea, 0xe2, 0x07, 0xf0, 0xa4, 0x8f, 0x93, 0x09, 0xb3, 0x4c, 0x04, 0x11, 0x17, 0x03, 0xed,
+		0x12, 0xb6, 0x32, 0x27, 0xb1, 0x42, 0xdf, 0x15, 0xf8, 0x6c, 0x85, 0x62, 0x86, 0xc4, 0xe2, 0x25,
+		0xc8, 0xb7, 0x2c, 0xd3, 0xd1, 0xac, 0x8e, 0xe1, 0x90, 0x4e, 0xd7, 0x09, 0xb5, 0xeb, 0xfe, 0x92,
+		0x11, 0x49, 0xa9, 0x39, 0xc4, 0x5b, 0x13, 0x68, 0xd8, 0x13, 0x76, 0x4f, 0x6f, 0x92, 0x9e, 0xfc,
+		0x77, 0xb7, 0x27, 0x14, 0x07, 0x7b, 0x72, 0x5b, 0xd2, 0x61, 0xb7, 0xae, 0x71, 0x65, 0xee, 0xec,
+		0x31, 0x29, 0x70, 0xa5, 0xe3, 0xcf, 0x62, 0x70, 0x92, 0x03, 0x6f, 0xe9, 0x36, 0x39, 0x7b, 0xfd,
+		0xf1, 0x2d, 0xe2, 0xe8, 0x8f, 0x9f, 0x6d, 0x5a, 0x86, 0xd8, 0xc9, 0x67, 0x59, 0xfd, 0x02, 0xd6,
+		0x2f, 0xf0, 0xfa, 0xd2, 0xc8, 0xe3, 0xee, 0xd2, 0x78, 0x25, 0x5f, 0x1a, 0x96, 0x41, 0xe5, 0x55,
+		0x88, 0x57, 0x2d, 0xc3, 0xc4, 0xbd, 0xad, 0x45, 0x4c, 0xab, 0xc3, 0xd5, 0x2d, 0xfb, 0x90, 0x2f,
+		0x40, 0x52, 0xef, 0x58, 0x7d, 0xd3, 0x61, 0xaa, 0xb6, 0x72, 0xf2, 0xb7, 0xde, 0x3e, 0x35, 0xf5,
+		0xef, 0xdf, 0x3e, 0x15, 0xab, 0x9b, 0xce, 0xef, 0x7d, 0xea, 0x51, 0xe0, 0xd4, 0xeb, 0xa6, 0xf3,
+		0x89, 0x3f, 0xfe, 0xb9, 0x33, 0x11, 0x95, 0x43, 0x2f, 0xc6, 0x3f, 0xf7, 0xb1, 0x53, 0x11, 0xe5,
+		0x65, 0x98, 0x5e, 0x22, 0xcd, 0x03, 0xc8, 0x3f, 0x3e, 0x40, 0xfe, 0xb8, 0x20, 0xbf, 0x44, 0x9a,
+		0x3e, 0xf2, 0x4b, 0xa4, 0x39, 0x40, 0xf9, 0x29, 0x48, 0xd5, 0x4d, 0x87, 0x5d, 0x21, 0x7a, 0x18,
+		0x62, 0x86, 0xc9, 0xb2, 0xd2, 0x7d, 0x14, 0x86, 0x3a, 0xa8, 0x22, 0x14, 0x22, 0x2e, 0x91, 0xa6,
+		0x8b, 0xd8, 0x22, 0xcd, 0x62, 0x24, 0xac, 0x69, 0x84, 0xaa, 0x2c, 0xfd, 0xfe, 0x1f, 0x9e, 0x9c,
+		0x7a, 0xf3, 0x9d, 0x93, 0x53, 0x63, 0xa7, 0x5e, 0x09, 0x9f, 0x7a, 0x77, 0xc6, 0x7f, 0x32, 0x0e,
+		0x77, 0xd3, 0x9b, 0xa5, 0xbd, 0x8e, 0x61, 0x3a, 0x67, 0x9b, 0xbd, 0xfd, 0xae, 0x63, 0xa1, 0x02,
+		0xb0, 0xb6, 0xf9, 0x84, 0xcf, 0x78, 0xd5, 0x0b, 0xac, 0x7a, 0xf4, 0x74, 0x2b, 0xdb, 0x90, 0x58,
+		0x47, 0x3c, 0x64, 0xb1, 0x63, 0x39, 0x7a, 0x9b, 0x5b, 0x2d, 0xec, 0x03, 0x4b, 0xd9, 0x6d, 0xd4,
+		0x28, 0x2b, 0x35, 0xc4, 0x45, 0xd4, 0x36, 0xd1, 0xb7, 0xd9, 0xa5, 0x9e, 0x18, 0x35, 0x77, 0x53,
+		0x58, 0x40, 0xef, 0xef, 0xcc, 0x41, 0x42, 0xef, 0xb3, 0xc4, 0x9b, 0x18, 0xda, 0xc1, 0xf4, 0x43,
+		0x79, 0x01, 0xa6, 0xf9, 0xf9, 0x3b, 0x66, 0x9e, 0x5c, 0x23, 0xfb, 0xb4, 0x9d, 0xac, 0x8a, 0x3f,
+		0xe5, 0x05, 0x48, 0xd0, 0xce, 0xf3, 0xdb, 0x8a, 0xc5, 0x85, 0xa1, 0xde, 0x2f, 0xd0, 0x4e, 0xaa,
+		0x0c, 0x4c, 0xb9, 0x02, 0xa9, 0x25, 0x0b, 0xa5, 0x30, 0x48, 0x2d, 0xcd, 0xa8, 0xd1, 0x3e, 0x77,
+		0xfb, 0x5c, 0x2a, 0x54, 0xf6, 0x81, 0x29, 0xe9, 0xec, 0x92, 0x17, 0x4f, 0x1e, 0xe2, 0x5f, 0x4a,
+		0x15, 0xa6, 0x29, 0xed, 0xb5, 0x2e, 0x9a, 0x0c, 0x6e, 0xde, 0x7b, 0x9a, 0x5f, 0xf9, 0xe5, 0xe4,
+		0xa3, 0x5e, 0x67, 0x65, 0x88, 0xe3, 0x45, 0x0e, 0x3e, 0x6e, 0xfa, 0x5b, 0xf9, 0x20, 0xa4, 0x38,
+		0x11, 0x5b, 0x3e, 0x07, 0x31, 0xab, 0x6b, 0xf3, 0xf4, 0x9f, 0xd2, 0xb8, 0xa1, 0xac, 0x75, 0x2b,
+		0x71, 0x94, 0x19, 0x15, 0x81, 0x2b, 0xab, 0x63, 0xc5, 0xe2, 0xc9, 0x80, 0x58, 0x74, 0x88, 0xb3,
+		0xb5, 0xed, 0x78, 0x3f, 0xd8, 0x74, 0x0e, 0x89, 0x82, 0x2b, 0x28, 0xb7, 0xa2, 0x70, 0xd2, 0x57,
+		0x7b, 0x9d, 0xf4, 0x30, 0x00, 0xc5, 0xa4, 0x89, 0x4b, 0x8a, 0xec, 0xeb, 0x20, 0xaf, 0x1f, 0x23,
+		0x2a, 0xcf, 0x41, 0xac, 0xdc, 0xed, 0xe2, 0x3d, 0x67, 0xfa, 0xdd, 0xb4, 0x98, 0xac, 0xc4, 0x55,
+		0xf7, 0x1b, 0xeb, 0x6c, 0x6b, 0xdb, 0xb9, 0xa1, 0xf7, 0xdc, 0x3b, 0xd0, 0xe2, 0x5b, 0x79, 0x06,
+		0xd2, 0x55, 0xcb, 0xb4, 0x89, 0x69, 0xf7, 0xa9, 0x2d, 0xbc, 0xd5, 0xb6, 0x9a, 0xd7, 0x38, 0x05,
+		0xf6, 0x81, 0xcc, 0xd6, 0xbb, 0x5d, 0x8a, 0x19, 0x57, 0xf1, 0x27, 0x5b, 0xaf, 0x95, 0xb5, 0xb1,
+		0xec, 0x39, 0x7f, 0x38, 0xf6, 0xf0, 0x01, 0x7a, 0x46, 0x6d, 0x04, 0xee, 0x1a, 0x5e, 0x48, 0xd7,
+		0xc8, 0xbe, 0x7d, 0xd8, 0x75, 0xf4, 0x32, 0xa4, 0xd7, 0xe9, 0xf3, 0x24, 0x2f, 0x90, 0x7d, 0xb9,
+		0x84, 0x6f, 0x58, 0x9c, 0x3b, 0x7f, 0xfe, 0xf1, 0x67, 0x98, 0x94, 0x5f, 0x9e, 0x52, 0x45, 0x81,
+		0x7c, 0x12, 0xd2, 0x36, 0x69, 0x76, 0xcf, 0x9d, 0xbf, 0x70, 0xed, 0x71, 0x26, 0x56, 0x97, 0xa7,
+		0x54, 0xaf, 0x68, 0x31, 0x85, 0x23, 0xfe, 0xdc, 0xc7, 0x4f, 0x45, 0x2a, 0x09, 0x88, 0xd9, 0xfd,
+		0xce, 0x7b, 0x26, 0x1b, 0x3f, 0x90, 0x80, 0x79, 0x5f, 0x2d, 0xdb, 0x5c, 0xae, 0xeb, 0x6d, 0xa3,
+		0xa5, 0x7b, 0x8f, 0xca, 0x48, 0xbe, 0xf1, 0x53, 0x88, 0x31, 0xbb, 0xc6, 0x81, 0x5c, 0x54, 0x7e,
+		0x21, 0x02, 0xd9, 0xab, 0x82, 0x32, 0xbe, 0x42, 0xf3, 0x2c, 0x80, 0xdb, 0x92, 0x58, 0x2a, 0x27,
+		0x16, 0x06, 0xdb, 0x5a, 0x70, 0x71, 0x54, 0x1f, 0xb8, 0xfc, 0x14, 0x15, 0xc0, 0xae, 0x65, 0xf3,
+		0xfb, 0xb0, 0x21, 0xa8, 0x2e, 0x30, 0x26, 0x72, 0x52, 0xad, 0xa6, 0x5d, 0xb7, 0x1c, 0x4c, 0x2d,
+		0xe9, 0x5a, 0x37, 0xf8, 0x2b, 0x03, 0x31, 0x55, 0xa2, 0x35, 0x57, 0x69, 0xc5, 0x3a, 0x96, 0x63,
+		0xa7, 0xd3, 0x2e, 0x15, 0x74, 0xeb, 0xf4, 0x56, 0xab, 0x47, 0x6c, 0x9b, 0x2b, 0x2e, 0xf1, 0x89,
+		0x97, 0x70, 0xbb, 0xfd, 0x2d, 0x4d, 0x68, 0x09, 0xbc, 0xc6, 0x3c, 0x62, 0xcd, 0x0b, 0xd9, 0xe0,
+		0xab, 0x3e, 0xd9, 0xed, 0x6f, 0xa1, 0xa4, 0xdc, 0x03, 0xd9, 0x11, 0x9d, 0xc9, 0x5c, 0xf7, 0xfa,
+		0x41, 0x5f, 0xc4, 0xe1, 0x23, 0xd0, 0xba, 0x3d, 0xc3, 0xea, 0x19, 0xce, 0x3e, 0x4d, 0xdb, 0x8b,
+		0xa9, 0x92, 0xa8, 0x58, 0xe7, 0xe5, 0xca, 0x35, 0x28, 0x34, 0xa8, 0xb9, 0xef, 0xf5, 0xfc, 0xbc,
+		0xd7, 0xbf, 0x48, 0x78, 0xff, 0xc6, 0xf6, 0x2c, 0x3a, 0xd4, 0xb3, 0xca, 0xca, 0x58, 0xc9, 0x7c,
+		0xe2, 0x70, 0x92, 0x19, 0xdc, 0xdd, 0x3e, 0x7f, 0x1c, 0xee, 0x1a, 0xac, 0x0c, 0xa8, 0xac, 0x49,
+		0x85, 0x32, 0xcc, 0x93, 0x2f, 0x1d, 0xbc, 0x89, 0x96, 0x42, 0x54, 0x67, 0x29, 0x74, 0xf9, 0x28,
+		0xcf, 0x40, 0x0e, 0x93, 0x6f, 0x1b, 0xc4, 0xb9, 0x4c, 0xf4, 0x16, 0xe9, 0x05, 0x77, 0xd9, 0x9c,
+		0xd8, 0x65, 0x65, 0x88, 0xd3, 0xad, 0x94, 0xed, 0x32, 0xf4, 0xb7, 0xb2, 0x0b, 0x71, 0x44, 0xf5,
+		0x76, 0x60, 0x8e, 0x41, 0x3f, 0xb0, 0x74, 0x6b, 0xdf, 0x21, 0x36, 0x47, 0x61, 0x1f, 0xf2, 0x93,
+		0x62, 0x1f, 0x8d, 0x1d, 0xbc, 0x8f, 0x72, 0x21, 0xe4, 0xbb, 0x69, 0x1b, 0xa6, 0x2b, 0xa8, 0x7e,
+		0xeb, 0x4b, 0x6e, 0x47, 0x22, 0x5e, 0x47, 0xe4, 0x15, 0x28, 0x74, 0xf5, 0x9e, 0x43, 0xef, 0xf1,
+		0xed, 0xd2, 0x51, 0x70, 0x39, 0x3f, 0x35, 0xbc, 0xea, 0x02, 0x83, 0xe5, 0xad, 0xe4, 0xba, 0xfe,
+		0x42, 0xe5, 0x3f, 0xc7, 0x21, 0xc9, 0x7e, 0xca, 0xcf, 0xc1, 0x34, 0x67, 0x2b, 0x97, 0xcc, 0xbb,
+		0x17, 0x86, 0x37, 0xa3, 0x05, 0x77, 0xd3, 0xe0, 0xf4, 0x04, 0x8e, 0xfc, 0x00, 0xa4, 0x9a, 0xbb,
+		0xba, 0x61, 0x6a, 0x46, 0x8b, 0x1b, 0x80, 0x99, 0x77, 0xde, 0x3e, 0x35, 0x5d, 0xc5, 0xb2, 0xfa,
+		0x92, 0x3a, 0x4d, 0x2b, 0xeb, 0x2d, 0xdc, 0xf9, 0x77, 0x89, 0xb1, 0xb3, 0xeb, 0xf0, 0xd5, 0xc5,
+		0xbf, 0xf0, 0x29, 0x2c, 0x14, 0x08, 0x7e, 0xcb, 0xbb, 0x34, 0x64, 0xc7, 0xbb, 0x81, 0x96, 0x4a,
+		0x0a, 0x1b, 0xfe, 0xf0, 0x7f, 0x3a, 0x15, 0x51, 0x29, 0x86, 0x5c, 0x85, 0x5c, 0x5b, 0xb7, 0x1d,
+		0x8d, 0xee, 0x5a, 0xd8, 0x7c, 0x82, 0x92, 0x38, 0x3e, 0xcc, 0x10, 0xce, 0x58, 0xde, 0xf5, 0x0c,
+		0x62, 0xb1, 0xa2, 0x16, 0x5e, 0x42, 0xa5, 0x44, 0x30, 0xe7, 0xd8, 0x70, 0x98, 0x2d, 0x95, 0xa4,
+		0x7c, 0xcf, 0x63, 0x79, 0x95, 0x16, 0x53, 0x8b, 0xea, 0x04, 0xa4, 0xe9, 0xbd, 0x52, 0x0a, 0xc2,
+		0x92, 0xc5, 0x53, 0x58, 0x40, 0x2b, 0x1f, 0x84, 0x82, 0xa7, 0x1b, 0x19, 0x48, 0x8a, 0x51, 0xf1,
+		0x8a, 0x29, 0xe0, 0x63, 0x30, 0x67, 0x92, 0x3d, 0x47, 0xf3, 0x8a, 0x19, 0x74, 0x9a, 0x42, 0xcb,
+		0x58, 0x77, 0x35, 0x88, 0x71, 0x3f, 0xe4, 0x9b, 0x82, 0xf9, 0x0c, 0x16, 0x28, 0x6c, 0xce, 0x2d,
+		0xa5, 0x60, 0xc7, 0x21, 0xa5, 0x77, 0xbb, 0x0c, 0x20, 0xc3, 0x75, 0x63, 0xb7, 0x4b, 0xab, 0xce,
+		0xc0, 0x0c, 0x1d, 0x63, 0x8f, 0xd8, 0xfd, 0xb6, 0xc3, 0x89, 0x64, 0x29, 0x4c, 0x01, 0x2b, 0x54,
+		0x56, 0x4e, 0x61, 0xef, 0x85, 0x1c, 0xb9, 0x8e, 0x99, 0xfa, 0x4d, 0xc2, 0xe0, 0x72, 0x14, 0x2e,
+		0x2b, 0x0a, 0x29, 0xd0, 0x43, 0xe0, 0xea, 0x3c, 0x4d, 0xe8, 0xe3, 0x3c, 0xa3, 0x27, 0xca, 0xcb,
+		0xac, 0x58, 0x29, 0x42, 0x7c, 0x49, 0x77, 0x74, 0x34, 0x2a, 0x9c, 0x3d, 0xb6, 0xc9, 0x64, 0x55,
+		0xfc, 0xa9, 0x7c, 0x2e, 0x0a, 0xf1, 0xab, 0x96, 0x43, 0xe4, 0x27, 0x7c, 0x06, 0x5f, 0x7e, 0x94,
+		0x3c, 0x37, 0x8c, 0x1d, 0x93, 0xb4, 0x56, 0xec, 0x1d, 0xdf, 0x23, 0x30, 0x9e, 0x38, 0x45, 0x03,
+		0xe2, 0x34, 0x07, 0x89, 0x9e, 0xd5, 0x37, 0x5b, 0x22, 0xcd, 0x9a, 0x7e, 0xc8, 0x35, 0x48, 0xb9,
+		0x52, 0x12, 0x0f, 0x93, 0x92, 0x02, 0x4a, 0x09, 0xca, 0x30, 0x2f, 0x50, 0xa7, 0xb7, 0xb8, 0xb0,
+		0x54, 0x20, 0xed, 0x2a, 0xaf, 0x62, 0xe2, 0x10, 0x02, 0xeb, 0xa1, 0xe1, 0x46, 0xe2, 0xce, 0xbd,
+		0xcb, 0x3c, 0x26, 0x71, 0x92, 0x5b, 0xc1, 0xb9, 0x17, 0x10, 0x2b, 0xfe, 0x20, 0xcd, 0x34, 0x1d,
+		0x97, 0x27, 0x56, 0xec, 0x51, 0x9a, 0xbb, 0x30, 0x6f, 0x6d, 0xc7, 0xd4, 0x9d, 0x7e, 0x8f, 0x70,
+		0xc9, 0xf3, 0x0a, 0x94, 0x5f, 0x8f, 0x40, 0x92, 0x49, 0xb2, 0x8f, 0x6f, 0x91, 0xd1, 0x7c, 0x8b,
+		0x8e, 0xe3, 0x5b, 0xec, 0xf6, 0xf9, 0x56, 0x06, 0x70, 0x3b, 0x63, 0xf3, 0x77, 0x42, 0x46, 0x58,
+		0x0b, 0xac, 0x8b, 0x0d, 0x63, 0x87, 0x2f, 0x54, 0x1f, 0x92, 0xf2, 0x1f, 0x23, 0x90, 0x76, 0xeb,
+		0xe5, 0x32, 0xe4, 0x44, 0xbf, 0xb4, 0xed, 0xb6, 0xbe, 0xc3, 0x65, 0xe7, 0xee, 0xb1, 0x9d, 0xbb,
+		0xd8, 0xd6, 0x77, 0xd4, 0x0c, 0xef, 0x0f, 0x7e, 0x8c, 0x9e, 0x87, 0xe8, 0x98, 0x79, 0x08, 0x4c,
+		0x7c, 0xec, 0xf6, 0x26, 0x3e, 0x30, 0x45, 0xf1, 0xc1, 0x29, 0xfa, 0x64, 0x94, 0x3a, 0x2f, 0x5d,
+		0xcb, 0xd6, 0xdb, 0xef, 0xc7, 0x8a, 0x38, 0x01, 0xe9, 0xae, 0xd5, 0xd6, 0x58, 0x0d, 0xbb, 0x7e,
+		0x90, 0xea, 0x5a, 0x6d, 0x75, 0x68, 0xda, 0x13, 0x77, 0x68, 0xb9, 0x24, 0xef, 0x00, 0xd7, 0xa6,
+		0x07, 0xb9, 0xd6, 0x83, 0x2c, 0x63, 0x05, 0xdf, 0xcb, 0x1e, 0x43, 0x1e, 0xe0, 0xaf, 0x62, 0x64,
+		0x78, 0xef, 0x65, 0xdd, 0x66, 0x90, 0x6a, 0x72, 0xd7, 0xc5, 0x60, 0xaa, 0xbf, 0x18, 0x1d, 0x87,
+		0xc1, 0xc4, 0x4e, 0xe5, 0x70, 0xca, 0x0f, 0x44, 0x00, 0x96, 0x91, 0xb3, 0x74, 0xbc, 0xb8, 0x0b,
+		0xd9, 0xb4, 0x0b, 0x5a, 0xa0, 0xe5, 0x93, 0xe3, 0x26, 0x8d, 0xb7, 0x9f, 0xb5, 0xfd, 0xfd, 0xae,
+		0x42, 0xce, 0x13, 0x46, 0x9b, 0x88, 0xce, 0x9c, 0x3c, 0xc0, 0xa2, 0x6e, 0x10, 0x47, 0xcd, 0x5e,
+		0xf7, 0x7d, 0x29, 0xff, 0x24, 0x02, 0x69, 0xda, 0x27, 0x7c, 0xe5, 0x20, 0x30, 0x87, 0x91, 0xdb,
+		0x9f, 0xc3, 0xbb, 0x01, 0x18, 0x19, 0x3c, 0xc5, 0xe7, 0x92, 0x95, 0xa6, 0x25, 0x78, 0x36, 0x8f,
+		0xb1, 0x25, 0x3e, 0xec, 0xd8, 0xc1, 0x0c, 0x17, 0x16, 0x37, 0x67, 0xfb, 0x31, 0x98, 0xa6, 0xef,
+		0xea, 0xed, 0xd9, 0xdc, 0x88, 0xc6, 0xc7, 0x74, 0x36, 0xf6, 0x6c, 0xe5, 0x35, 0x98, 0xde, 0xd8,
+		0x63, 0xb1, 0x90, 0x13, 0x90, 0xee, 0x59, 0x16, 0xdf, 0x93, 0x99, 0x2d, 0x94, 0xc2, 0x02, 0xba,
+		0x05, 0x09, 0xff, 0x3f, 0xea, 0xf9, 0xff, 0x5e, 0x00, 0x23, 0x36, 0x51, 0x00, 0xe3, 0xcc, 0xbf,
+		0x8d, 0x40, 0xc6, 0xa7, 0x1f, 0xe4, 0xc7, 0xe1, 0x48, 0x65, 0x79, 0xad, 0xfa, 0x82, 0x56, 0x5f,
+		0xd2, 0x2e, 0x2e, 0x97, 0x2f, 0x79, 0x37, 0xec, 0x4a, 0x47, 0x6f, 0xde, 0x9a, 0x97, 0x7d, 0xb0,
+		0x9b, 0x26, 0x3d, 0xcd, 0x91, 0xcf, 0xc2, 0x5c, 0x10, 0xa5, 0x5c, 0x69, 0xe0, 0x75, 0xbb, 0x48,
+		0xe9, 0xc8, 0xcd, 0x5b, 0xf3, 0x33, 0x3e, 0x8c, 0xf2, 0x96, 0x4d, 0x4c, 0x67, 0x18, 0xa1, 0xba,
+		0xb6, 0xb2, 0x52, 0xdf, 0x90, 0xa2, 0x43, 0x08, 0x5c, 0x61, 0x3f, 0x04, 0x33, 0x41, 0x84, 0xd5,
+		0xfa, 0xb2, 0x14, 0x2b, 0xc9, 0x37, 0x6f, 0xcd, 0xe7, 0x7d, 0xd0, 0xab, 0x46, 0xbb, 0x94, 0xfa,
+		0xce, 0x1f, 0x3f, 0x39, 0xf5, 0x89, 0xbf, 0x7b, 0x32, 0x82, 0x23, 0xcb, 0x05, 0x74, 0x84, 0xfc,
+		0x08, 0x1c, 0x6b, 0xd4, 0x2f, 0xad, 0xd6, 0x96, 0xb4, 0x95, 0xc6, 0xa5, 0x81, 0x4b, 0xd3, 0xa5,
+		0xc2, 0xcd, 0x5b, 0xf3, 0x19, 0x3e, 0xa4, 0x71, 0xd0, 0xeb, 0x6a, 0xed, 0xea, 0xda, 0x46, 0x4d,
+		0x8a, 0x30, 0xe8, 0xf5, 0x1e, 0xb9, 0x6e, 0x39, 0xec, 0x49, 0xce, 0xc7, 0xe0, 0xf8, 0x08, 0x68,
+		0x77, 0x60, 0x33, 0x37, 0x6f, 0xcd, 0xe7, 0xd6, 0x7b, 0x84, 0xad, 0x1f, 0x8a, 0xb1, 0x00, 0xc5,
+		0x61, 0x8c, 0xb5, 0xf5, 0xb5, 0x46, 0x79, 0x59, 0x9a, 0x2f, 0x49, 0x37, 0x6f, 0xcd, 0x67, 0x85,
+		0x32, 0x44, 0x78, 0x6f, 0x64, 0xef, 0x95, 0xb7, 0xf3, 0x1d, 0xd3, 0x70, 0xf7, 0x60, 0xe5, 0xd9,
+		0xae, 0xde, 0xd3, 0x3b, 0x87, 0x75, 0x77, 0x42, 0x8e, 0x73, 0x95, 0x37, 0xa3, 0x50, 0x70, 0x8d,
+		0xe9, 0x75, 0xda, 0x82, 0xfc, 0x84, 0x3f, 0x0e, 0x93, 0x19, 0xbb, 0x8d, 0x31, 0x68, 0x11, 0xa6,
+		0xc1, 0x40, 0x3e, 0x37, 0xca, 0xb8, 0xba, 0x98, 0x1f, 0xc6, 0xab, 0x71, 0x08, 0x8e, 0xea, 0x62,
+		0xc8, 0xcf, 0x43, 0xda, 0x55, 0x1e, 0xee, 0xb3, 0x55, 0xe3, 0xb5, 0x0d, 0xc7, 0xf7, 0x70, 0xf0,
+		0x25, 0x29, 0xe1, 0x36, 0xc4, 0xc7, 0x39, 0x22, 0x57, 0x19, 0x00, 0x47, 0x16, 0xf0, 0x4a, 0x9d,
+		0x2f, 0x3b, 0x3e, 0x7a, 0xfa, 0xe4, 0xc7, 0x9e, 0xc6, 0x3c, 0x29, 0x66, 0x95, 0xa4, 0x3a, 0xfa,
+		0x5e, 0x05, 0xbf, 0x51, 0x51, 0x60, 0xe5, 0x0e, 0xbf, 0x54, 0x1e, 0x53, 0x93, 0x1d, 0x7d, 0xef,
+		0x92, 0x6e, 0x5f, 0x89, 0xa7, 0x62, 0x52, 0x5c, 0xf9, 0xe9, 0x08, 0xe4, 0x83, 0x63, 0x94, 0x1f,
+		0x06, 0x19, 0x31, 0xf0, 0xbc, 0x04, 0x55, 0x0c, 0x65, 0x96, 0xa0, 0x5b, 0xe8, 0xe8, 0x7b, 0xe5,
+		0x1d, 0xb2, 0xda, 0xef, 0xd0, 0x0e, 0xd8, 0x32, 0x1e, 0xae, 0x70, 0x60, 0x31, 0x4f, 0x9c, 0x99,
+		0xc7, 0x87, 0x1f, 0xb1, 0xe4, 0x00, 0x6c, 0xa3, 0xfa, 0x08, 0x6e, 0x54, 0x79, 0x46, 0x4f, 0xd4,
+		0x04, 0x87, 0x12, 0x0b, 0x0e, 0x45, 0x79, 0x1e, 0x0a, 0x03, 0xfc, 0x94, 0x15, 0xc8, 0xf1, 0xa8,
+		0x00, 0x3d, 0xea, 0x64, 0xf6, 0x71, 0x5a, 0xcd, 0x30, 0xef, 0x9f, 0x1e, 0xfd, 0x2e, 0xa6, 0x7e,
+		0x09, 0x43, 0x51, 0x18, 0x30, 0x7f, 0x18, 0x72, 0x01, 0x8e, 0x8a, 0x48, 0x5d, 0xc4, 0x8b, 0xd4,
+		0x79, 0xc0, 0xaf, 0x42, 0x16, 0x15, 0x25, 0x69, 0x71, 0xd8, 0x07, 0xa0, 0xc0, 0x14, 0xf9, 0x20,
+		0xaf, 0x99, 0x25, 0xb5, 0x22, 0x18, 0xae, 0x40, 0xce, 0x83, 0xf3, 0xd8, 0x9e, 0x11, 0x50, 0x97,
+		0x74, 0xbb, 0xf2, 0xe2, 0x27, 0xde, 0x39, 0x19, 0x79, 0x6f, 0x16, 0xe2, 0xef, 0xbe, 0x08, 0x27,
+		0x7c, 0x95, 0xfa, 0x56, 0xd3, 0x08, 0x44, 0x1d, 0x0a, 0x5e, 0xe5, 0x02, 0x56, 0x86, 0x45, 0x0f,
+		0x0e, 0x8c, 0x61, 0x1c, 0x1c, 0x30, 0x2b, 0x1d, 0xac, 0x11, 0xc2, 0x03, 0x1b, 0xa3, 0x63, 0x94,
+		0xff, 0x37, 0x05, 0xd3, 0x2a, 0x79, 0xbd, 0x4f, 0x6c, 0x47, 0x3e, 0x07, 0x71, 0xd2, 0xdc, 0xb5,
+		0x46, 0x85, 0x84, 0x70, 0x70, 0x0b, 0x1c, 0xae, 0xd6, 0xdc, 0xb5, 0x2e, 0x4f, 0xa9, 0x14, 0x56,
+		0x3e, 0x0f, 0x89, 0xed, 0x76, 0x9f, 0xc7, 0x29, 0x06, 0x94, 0x85, 0x1f, 0xe9, 0x22, 0x02, 0x5d,
+		0x9e, 0x52, 0x19, 0x34, 0x36, 0x45, 0x1f, 0x0a, 0x8e, 0x1d, 0xdc, 0x14, 0xde, 0x26, 0xc5, 0xa6,
+		0x10, 0x56, 0xae, 0x00, 0x18, 0xa6, 0xe1, 0x68, 0xd4, 0x87, 0x2f, 0x26, 0x86, 0xb5, 0x44, 0x10,
+		0xd3, 0x70, 0xa8, 0xd7, 0x8f, 0x91, 0x54, 0x43, 0x7c, 0x60, 0x77, 0x5f, 0xef, 0x93, 0xde, 0x7e,
+		0x31, 0x79, 0x70, 0x77, 0x5f, 0x44, 0x20, 0xec, 0x2e, 0x85, 0x96, 0x6b, 0x90, 0xa1, 0x37, 0x64,
+		0xd9, 0xfa, 0xe5, 0x4f, 0xd3, 0x2a, 0xe3, 0x90, 0x2b, 0x08, 0x4a, 0x97, 0xf4, 0xe5, 0x29, 0x15,
+		0xb6, 0xdc, 0x2f, 0x54, 0x92, 0xec, 0xe9, 0x32, 0x67, 0xaf, 0x98, 0x1a, 0x56, 0x53, 0x7e, 0x1a,
+		0xf4, 0xfd, 0xb2, 0x8d, 0x3d, 0x8c, 0x12, 0x37, 0xd9, 0x4f, 0x1c, 0x7f, 0x8b, 0xb4, 0x0d, 0x7c,
+		0x19, 0xce, 0xd9, 0x2b, 0xa6, 0x0f, 0x1e, 0xff, 0x12, 0x83, 0xa4, 0x14, 0xd2, 0x2d, 0xf1, 0x81,
+		0x8a, 0x96, 0x98, 0x2d, 0x3e, 0x0c, 0x18, 0xd6, 0xd3, 0x81, 0x79, 0x36, 0x5b, 0x62, 0x10, 0x29,
+		0xc2, 0x7f, 0xcb, 0x4f, 0xbb, 0x16, 0x6a, 0x66, 0xd8, 0x28, 0x0c, 0x0c, 0x80, 0xc5, 0x2a, 0xa6,
+		0x84, 0xa5, 0x2a, 0xaf, 0x42, 0xbe, 0x6d, 0xd8, 0x8e, 0x66, 0x9b, 0x7a, 0xd7, 0xde, 0xb5, 0x1c,
+		0x9b, 0x3a, 0xfd, 0xf8, 0x48, 0xf0, 0x18, 0x0a, 0xcb, 0x86, 0xed, 0x34, 0x04, 0xf0, 0xe5, 0x29,
+		0x35, 0xd7, 0xf6, 0x17, 0x20, 0x3d, 0x6b, 0x7b, 0x9b, 0xf4, 0x5c, 0x82, 0xc5, 0xdc, 0xc1, 0xf4,
+		0xd6, 0x10, 0x5a, 0xe0, 0x23, 0x3d, 0xcb, 0x5f, 0x20, 0x7f, 0x3d, 0xcc, 0xb6, 0x2d, 0xbd, 0xe5,
+		0x92, 0xd3, 0x9a, 0xbb, 0x7d, 0xf3, 0x1a, 0x8d, 0x24, 0xe0, 0x4b, 0x96, 0xe3, 0x3a, 0x69, 0xe9,
+		0x2d, 0x41, 0xa2, 0x8a, 0x08, 0x97, 0xa7, 0xd4, 0x99, 0xf6, 0x60, 0xa1, 0xfc, 0x21, 0x98, 0xd3,
+		0xbb, 0xdd, 0xf6, 0xfe, 0x20, 0xf5, 0x02, 0xa5, 0x7e, 0x66, 0x1c, 0xf5, 0x32, 0xe2, 0x0c, 0x92,
+		0x97, 0xf5, 0xa1, 0x52, 0x79, 0x03, 0x63, 0x20, 0x84, 0x5e, 0xaa, 0xe9, 0x72, 0x53, 0x85, 0x3e,
+		0x39, 0x84, 0xaf, 0x89, 0x8f, 0xa1, 0xbd, 0xce, 0xe0, 0x85, 0x65, 0x73, 0x79, 0x0a, 0xc3, 0x25,
+		0x81, 0x22, 0x46, 0xd5, 0x6a, 0x12, 0xfa, 0x22, 0x1a, 0xa7, 0x3a, 0x13, 0x46, 0x95, 0xc2, 0x07,
+		0xa9, 0x06, 0x8a, 0x2a, 0xd3, 0x3c, 0x29, 0x8e, 0xbf, 0x18, 0xf3, 0x20, 0x64, 0x7c, 0x8a, 0x05,
+		0x83, 0xea, 0x3c, 0x49, 0x40, 0x24, 0xd3, 0xf1, 0x4f, 0x25, 0x0f, 0x59, 0xbf, 0x32, 0x51, 0x3e,
+		0x1c, 0x81, 0x8c, 0x4f, 0x4f, 0x20, 0xa6, 0x3f, 0x74, 0x98, 0xf6, 0xa2, 0x82, 0xf7, 0x8a, 0x5d,
+		0x44, 0xd4, 0xb3, 0xd3, 0xa4, 0x2c, 0x2d, 0xe4, 0x9b, 0x18, 0xde, 0x99, 0xef, 0x9e, 0xeb, 0xba,
+		0x20, 0x31, 0x0a, 0x02, 0xdd, 0x73, 0x5d, 0x01, 0x70, 0x0f, 0x64, 0x71, 0xa4, 0x9a, 0xdf, 0xd0,
+		0x48, 0xab, 0x19, 0x2c, 0xe3, 0x20, 0xca, 0xbf, 0x8c, 0x82, 0x34, 0xa8, 0x80, 0xdc, 0x98, 0x62,
+		0xe4, 0xd0, 0x31, 0xc5, 0xe3, 0x83, 0xd1, 0x4c, 0x2f, 0x80, 0xb9, 0x0c, 0x92, 0x17, 0x87, 0x63,
+		0x1b, 0xc1, 0x78, 0xc3, 0x69, 0xc0, 0xc2, 0x53, 0x0b, 0xcd, 0x60, 0x81, 0x7c, 0x31, 0x70, 0xf6,
+		0x22, 0x5e, 0xc2, 0x1f, 0x9c, 0x62, 0xd7, 0x5e, 0xd8, 0xec, 0xb6, 0x74, 0x87, 0x88, 0xb8, 0x88,
+		0x87, 0x89, 0xdb, 0x3a, 0x86, 0xfd, 0x6c, 0x47, 0x77, 0x08, 0xdf, 0xd6, 0x13, 0x2c, 0x3c, 0xa8,
+		0x77, 0xbb, 0x0d, 0x2c, 0x65, 0xdb, 0xfa, 0xfd, 0x90, 0x47, 0x9d, 0x6c, 0xe8, 0x6d, 0x8d, 0x47,
+		0x09, 0x92, 0x6c, 0xf7, 0xe7, 0xa5, 0x97, 0x69, 0xa1, 0xd2, 0x82, 0xac, 0x5f, 0x1f, 0xbb, 0x6e,
+		0x56, 0xc4, 0xe7, 0x66, 0xc9, 0xfc, 0x09, 0x04, 0xc6, 0x1f, 0xf1, 0x6c, 0xc4, 0xe8, 0xe8, 0xee,
+		0x1c, 0x75, 0xc9, 0xae, 0xb3, 0x80, 0x47, 0x4a, 0x65, 0x1f, 0xca, 0x5b, 0x51, 0x98, 0x19, 0xd2,
+		0xdc, 0x23, 0xc3, 0xde, 0x9e, 0x7f, 0x19, 0x3d, 0x94, 0x7f, 0xf9, 0x42, 0x30, 0xac, 0xeb, 0xdb,
+		0xf9, 0x4e, 0x0c, 0x31, 0x99, 0xe9, 0x4d, 0x14, 0x68, 0x4e, 0xc4, 0x17, 0xf9, 0xc5, 0x52, 0x79,
+		0x13, 0xe6, 0xb6, 0xf6, 0xdf, 0xd0, 0x4d, 0xc7, 0x30, 0x89, 0x36, 0x34, 0x6b, 0xc3, 0x5b, 0xe9,
+		0x8a, 0x61, 0x6f, 0x91, 0x5d, 0xfd, 0xba, 0x61, 0x89, 0x6e, 0xcd, 0xba, 0xf8, 0x5e, 0x70, 0x57,
+		0x51, 0x21, 0x1f, 0xdc, 0x7a, 0xe4, 0x3c, 0x44, 0x9d, 0x3d, 0x3e, 0xfe, 0xa8, 0xb3, 0x27, 0x3f,
+		0xc6, 0xe3, 0x40, 0x51, 0x1a, 0x07, 0x1a, 0x6e, 0x88, 0xe3, 0x79, 0x41, 0x20, 0x45, 0x01, 0x69,
+		0x70, 0x3b, 0x1a, 0xa4, 0xaa, 0x3c, 0x04, 0x85, 0x81, 0xfd, 0x66, 0x5c, 0x54, 0x50, 0x29, 0x40,
+		0x8e, 0x83, 0x32, 0x76, 0x28, 0x47, 0x61, 0x6e, 0xd4, 0x5e, 0xa1, 0xec, 0xc2, 0xdc, 0x28, 0x9d,
+		0x2f, 0x9f, 0x87, 0x94, 0xbb, 0x59, 0x8c, 0x88, 0x42, 0xd0, 0x51, 0x08, 0x60, 0xd5, 0x05, 0x0d,
+		0x04, 0xb3, 0xa3, 0x81, 0x60, 0xb6, 0xf2, 0x4d, 0x50, 0x1c, 0xb7, 0x11, 0x0c, 0x0c, 0x23, 0xee,
+		0x4a, 0xe1, 0x51, 0x48, 0xf2, 0x67, 0x0f, 0xa3, 0xf4, 0xf8, 0x86, 0x7f, 0xa1, 0x74, 0xb2, 0x4d,
+		0x21, 0x46, 0x8b, 0xd9, 0x87, 0xa2, 0xc1, 0xf1, 0xb1, 0x9b, 0xc1, 0xf8, 0x83, 0x20, 0x46, 0x88,
+		0x1f, 0x04, 0x35, 0x45, 0x77, 0x6c, 0x3a, 0x56, 0x91, 0xec, 0xc0, 0xbe, 0x94, 0x8f, 0xc4, 0xe0,
+		0xe8, 0xe8, 0x2d, 0x41, 0x9e, 0x87, 0x2c, 0xda, 0xdd, 0x4e, 0xd0, 0x44, 0x87, 0x8e, 0xbe, 0xb7,
+		0xc1, 0xed, 0x73, 0x1e, 0x48, 0x8f, 0xba, 0x81, 0x74, 0x79, 0x13, 0x66, 0xda, 0x56, 0x53, 0x6f,
+		0x6b, 0x3e, 0x89, 0xe7, 0xc2, 0x7e, 0xef, 0x10, 0xb3, 0x6b, 0xec, 0x31, 0xf4, 0xd6, 0x90, 0xd0,
+		0x17, 0x28, 0x8d, 0x65, 0x57, 0xf2, 0xe5, 0x25, 0xc8, 0x74, 0x3c, 0x41, 0x3e, 0x84, 0xb0, 0xfb,
+		0xd1, 0x7c, 0x53, 0x92, 0x18, 0x79, 0xec, 0x93, 0x3c, 0xb4, 0x8a, 0x1e, 0x77, 0x82, 0x32, 0x3d,
+		0xf6, 0x04, 0x65, 0xd4, 0x71, 0x45, 0x6a, 0xf4, 0x71, 0xc5, 0x77, 0xfa, 0xa7, 0x26, 0xb0, 0x89,
+		0x0e, 0x9f, 0x60, 0xc8, 0x0d, 0x98, 0xe3, 0xf8, 0xad, 0x00, 0xef, 0xa3, 0x93, 0x2a, 0x1a, 0x59,
+		0xa0, 0x8f, 0x67, 0x7b, 0xec, 0xf6, 0xd8, 0x2e, 0x74, 0x69, 0xdc, 0xa7, 0x4b, 0xbf, 0xca, 0xa6,
+		0xe2, 0x5f, 0xa7, 0x21, 0xa5, 0x12, 0xbb, 0x8b, 0x1b, 0x27, 0x86, 0x92, 0xc9, 0x5e, 0x93, 0x74,
+		0x1d, 0xef, 0x98, 0x72, 0x94, 0x33, 0xc0, 0xa0, 0x6b, 0x02, 0x12, 0x2d, 0x71, 0x17, 0x0d, 0xa3,
+		0xea, 0xd4, 0xd9, 0x1a, 0xef, 0x37, 0x71, 0x74, 0xbf, 0xb7, 0x75, 0x41, 0x78, 0x5b, 0xb1, 0xb1,
+		0xc6, 0x37, 0xc3, 0x1a, 0x70, 0xb7, 0x9e, 0xe0, 0xee, 0x56, 0x3c, 0xa4, 0xb1, 0x80, 0xbf, 0x55,
+		0x0d, 0xf8, 0x5b, 0xc9, 0x90, 0x61, 0x8e, 0x71, 0xb8, 0x2e, 0x08, 0x87, 0x6b, 0x3a, 0xa4, 0xc7,
+		0x03, 0x1e, 0xd7, 0xc5, 0xa0, 0xc7, 0x95, 0x1a, 0xa3, 0x40, 0x04, 0xf6, 0x58, 0x97, 0xeb, 0x39,
+		0x9f, 0xcb, 0x95, 0x1e, 0xeb, 0xef, 0x30, 0x22, 0x23, 0x7c, 0xae, 0x6a, 0xc0, 0xe7, 0x82, 0x10,
+		0x1e, 0x8c, 0x71, 0xba, 0xbe, 0xce, 0xef, 0x74, 0x65, 0xc6, 0xfa, 0x6d, 0x7c, 0xbe, 0x47, 0x79,
+		0x5d, 0xcf, 0xb8, 0x5e, 0x57, 0x76, 0xac, 0xdb, 0xc8, 0xc7, 0x30, 0xe8, 0x76, 0xad, 0x0d, 0xb9,
+		0x5d, 0x39, 0xfe, 0x77, 0x46, 0xc6, 0x91, 0x08, 0xf1, 0xbb, 0xd6, 0x86, 0xfc, 0xae, 0x7c, 0x08,
+		0xc1, 0x10, 0xc7, 0xeb, 0x1b, 0x46, 0x3b, 0x5e, 0xe3, 0x5d, 0x23, 0xde, 0xcd, 0xc9, 0x3c, 0x2f,
+		0x6d, 0x8c, 0xe7, 0xc5, 0xbc, 0xa3, 0x87, 0xc7, 0x92, 0x9f, 0xd8, 0xf5, 0xda, 0x1c, 0xe1, 0x7a,
+		0x31, 0x27, 0xe9, 0xf4, 0x58, 0xe2, 0x13, 0xf8, 0x5e, 0x9b, 0x23, 0x7c, 0x2f, 0x39, 0x94, 0xec,
+		0x61, 0x9c, 0x2f, 0x7c, 0x1e, 0xf2, 0x21, 0x98, 0x11, 0xc8, 0xae, 0x9e, 0x42, 0xfb, 0x81, 0xf4,
+		0x7a, 0x56, 0x4f, 0x64, 0xd6, 0xd2, 0x0f, 0xe5, 0x34, 0x64, 0x5d, 0xd0, 0x83, 0x1d, 0x35, 0x6a,
+		0xa7, 0xf9, 0xf4, 0x90, 0xf2, 0x4b, 0x11, 0xc8, 0xfa, 0x55, 0x4c, 0xc0, 0x90, 0x4f, 0x73, 0x43,
+		0xde, 0xe7, 0xbe, 0x45, 0x83, 0xee, 0x1b, 0x3e, 0xe7, 0xd9, 0x1d, 0xf2, 0xcc, 0xf4, 0xae, 0xeb,
+		0x99, 0x89, 0x94, 0x02, 0xe6, 0xe4, 0xf1, 0x6d, 0x85, 0x9d, 0xe4, 0x14, 0xdc, 0xf4, 0x0a, 0xe6,
+		0x53, 0xc8, 0x8f, 0xc2, 0xac, 0x0f, 0xd6, 0xb5, 0xeb, 0x98, 0x9b, 0x22, 0xb9, 0xd0, 0x65, 0x6e,
+		0xe0, 0xfd, 0xd3, 0x08, 0xcc, 0x0c, 0xa9, 0xb8, 0x91, 0xde, 0x57, 0xe4, 0x0e, 0x79, 0x5f, 0xd1,
+		0xdb, 0xf6, 0xbe, 0xfc, 0x76, 0x6a, 0x2c, 0x68, 0xa7, 0xfe, 0x8f, 0x08, 0xe4, 0x02, 0x9a, 0x16,
+		0xa7, 0xa0, 0x69, 0xb5, 0x08, 0xb7, 0x1c, 0xe9, 0x6f, 0x34, 0x2a, 0xda, 0xd6, 0x0e, 0xb7, 0x0f,
+		0xf1, 0x27, 0x42, 0xb9, 0x1b, 0x47, 0x9a, 0xef, 0x0b, 0xae, 0xd1, 0x99, 0xf0, 0xe7, 0xff, 0xf2,
+		0xa4, 0xd8, 0xa4, 0x97, 0x14, 0xeb, 0x5e, 0x7e, 0x9b, 0xf6, 0x5d, 0x7e, 0x93, 0x9f, 0x86, 0x34,
+		0x8d, 0x88, 0x6a, 0x98, 0x10, 0x9b, 0x1a, 0xb6, 0x4d, 0x82, 0x09, 0xb1, 0x36, 0x4d, 0xd5, 0xa3,
+		0xbf, 0x7c, 0x16, 0x43, 0x3a, 0x60, 0x31, 0xdc, 0x05, 0x69, 0xec, 0x3d, 0x7b, 0x5d, 0x1d, 0xf8,
+		0xcd, 0x49, 0x51, 0xa0, 0x7c, 0x08, 0xe4, 0xe1, 0x4d, 0x42, 0xbe, 0x0c, 0x49, 0x72, 0x9d, 0xbe,
+		0xea, 0xc7, 0x12, 0x0d, 0x8f, 0x0e, 0x9b, 0xa6, 0x58, 0x5d, 0x29, 0x22, 0x93, 0xff, 0xe4, 0xed,
+		0x53, 0x12, 0x83, 0x7e, 0xc4, 0xbd, 0x28, 0xa0, 0x72, 0x7c, 0xe5, 0x3f, 0x44, 0xa1, 0x20, 0x1a,
+		0x10, 0x9e, 0xd3, 0x28, 0xde, 0x8e, 0x3a, 0x22, 0x9c, 0x8c, 0xdf, 0x27, 0x01, 0x76, 0x74, 0x5b,
+		0xbb, 0xa1, 0x9b, 0x0e, 0x69, 0x71, 0xa6, 0xfb, 0x4a, 0x30, 0xc1, 0x16, 0xbf, 0xfa, 0x36, 0x69,
+		0x71, 0x37, 0xda, 0xfd, 0xf6, 0x8d, 0x73, 0xfa, 0xcb, 0x1b, 0x67, 0x90, 0xcb, 0xa9, 0x01, 0x2e,
+		0xfb, 0x9c, 0x8b, 0xb4, 0xdf, 0xb9, 0x60, 0x89, 0xc1, 0x3c, 0x3f, 0x11, 0x58, 0xdf, 0xc4, 0x37,
+		0x46, 0x65, 0x3a, 0xa4, 0xd3, 0xb5, 0xac, 0xb6, 0xc6, 0xd4, 0x0d, 0xfb, 0x33, 0x0a, 0x59, 0x5e,
+		0x58, 0xa3, 0x5a, 0xe7, 0xdb, 0xa3, 0x30, 0x33, 0xb4, 0xbd, 0x7e, 0xed, 0x31, 0x98, 0xbe, 0x3b,
+		0x3d, 0x68, 0x22, 0xc8, 0x0d, 0x7f, 0xd6, 0x48, 0x9f, 0xaa, 0x05, 0x21, 0xd0, 0x93, 0xea, 0x0f,
+		0xe9, 0x7a, 0xb0, 0xd8, 0x96, 0x5f, 0x81, 0x63, 0x03, 0xba, 0xcd, 0x25, 0x1d, 0x9d, 0x54, 0xc5,
+		0x1d, 0x09, 0xaa, 0x38, 0x41, 0xda, 0x63, 0x56, 0xec, 0xcb, 0x5c, 0x75, 0x75, 0xc8, 0x0b, 0x6e,
+		0x70, 0x4f, 0x65, 0xd4, 0xf4, 0xd3, 0x3f, 0xdb, 0xe3, 0x60, 0x00, 0x2d, 0x10, 0x0e, 0xca, 0xb2,
+		0x42, 0x1e, 0x64, 0x5a, 0x87, 0x23, 0x23, 0x2d, 0x1f, 0xf9, 0x29, 0x48, 0x7b, 0x46, 0x13, 0xe3,
+		0xea, 0x01, 0xe1, 0x02, 0x0f, 0x56, 0xf9, 0xb5, 0x08, 0x1c, 0x19, 0x69, 0xfb, 0xc8, 0x35, 0x48,
+		0xb2, 0xb4, 0x37, 0x9e, 0x4c, 0xf3, 0xe8, 0x64, 0x36, 0xd3, 0x02, 0xcb, 0x89, 0x53, 0x39, 0xb2,
+		0xf2, 0x21, 0x48, 0xb2, 0x12, 0x39, 0x03, 0xd3, 0xde, 0xc3, 0xbb, 0x00, 0xc9, 0x72, 0xb5, 0x5a,
+		0x5b, 0x17, 0xaf, 0x68, 0x56, 0xd6, 0x54, 0xfc, 0xcb, 0x5b, 0x00, 0x49, 0xb5, 0x76, 0xa5, 0x56,
+		0xdd, 0x90, 0x62, 0xf8, 0xb7, 0xaa, 0xd8, 0x6f, 0xed, 0x22, 0x3e, 0xa3, 0xbe, 0x21, 0xc5, 0x7d,
+		0x45, 0x8d, 0xda, 0xea, 0x52, 0x4d, 0x95, 0x12, 0xca, 0xe3, 0x70, 0x5c, 0xf4, 0x63, 0x38, 0xac,
+		0xe1, 0x46, 0x17, 0x22, 0xbe, 0xe8, 0x02, 0x3e, 0xc1, 0x5d, 0x1a, 0x6f, 0x3a, 0xc9, 0x57, 0x06,
+		0x06, 0x7e, 0xee, 0x10, 0x76, 0xd7, 0xc0, 0xe8, 0x31, 0x78, 0xd8, 0x23, 0xdb, 0xc4, 0x69, 0xee,
+		0x32, 0x53, 0x8e, 0x6d, 0x99, 0x39, 0x35, 0xc7, 0x4b, 0x29, 0x92, 0xcd, 0xc0, 0x5e, 0x23, 0x4d,
+		0x47, 0x63, 0xba, 0xc8, 0xe6, 0x7f, 0x59, 0x34, 0xc7, 0x4a, 0x1b, 0xac, 0x50, 0xf9, 0xa6, 0x43,
+		0xf1, 0x32, 0x0d, 0x09, 0xb5, 0x86, 0x6f, 0xa5, 0xc7, 0xf0, 0x0f, 0x72, 0xd1, 0x9f, 0x5a, 0x63,
+		0xb5, 0xbc, 0xde, 0xb8, 0xbc, 0x86, 0xbc, 0x9c, 0x85, 0x82, 0xe0, 0xa5, 0x28, 0x4c, 0x28, 0x0f,
+		0xc3, 0xb1, 0x31, 0x76, 0xdf, 0x88, 0x3c, 0xc4, 0x8f, 0x47, 0xfc, 0xd0, 0x41, 0x9f, 0x7f, 0x0d,
+		0x92, 0xb6, 0xa3, 0x3b, 0x7d, 0x9b, 0x33, 0xf1, 0xa9, 0x49, 0x0d, 0xc1, 0x05, 0xf1, 0xa3, 0x41,
+		0xd1, 0x55, 0x4e, 0x46, 0x39, 0x0f, 0xf9, 0x60, 0xcd, 0x78, 0x1e, 0x78, 0x42, 0x14, 0x55, 0x5e,
+		0x01, 0xf0, 0xc5, 0x23, 0xdd, 0x8c, 0xae, 0x88, 0x3f, 0xa3, 0xeb, 0x3c, 0x24, 0xae, 0x5b, 0x4c,
+		0x67, 0x8c, 0x5e, 0x38, 0x98, 0x6c, 0xe9, 0x0b, 0x3e, 0x30, 0x68, 0xc5, 0x00, 0x79, 0x38, 0x26,
+		0x34, 0xa6, 0x89, 0xe7, 0x82, 0x4d, 0xdc, 0x33, 0x36, 0xba, 0x34, 0xba, 0xa9, 0x37, 0x20, 0x41,
+		0xb5, 0xcd, 0xc8, 0x2b, 0x3e, 0xdf, 0x08, 0xa0, 0x3b, 0x4e, 0xcf, 0xd8, 0xea, 0x7b, 0x0d, 0x9c,
+		0x1a, 0xad, 0xad, 0xca, 0x02, 0xae, 0x72, 0x17, 0x57, 0x5b, 0x73, 0x1e, 0xaa, 0x4f, 0x75, 0xf9,
+		0x08, 0x2a, 0xab, 0x90, 0x0f, 0xe2, 0x8e, 0xbe, 0xb2, 0xe4, 0xbd, 0x1d, 0x90, 0x16, 0xe6, 0x93,
+		0x6b, 0x7c, 0xf1, 0xf7, 0x3c, 0xe8, 0x87, 0x72, 0x33, 0x02, 0xa9, 0x8d, 0x3d, 0x2e, 0xc7, 0x07,
+		0x24, 0x55, 0x7a, 0xf7, 0xb6, 0xdc, 0x60, 0x21, 0x8b, 0xc7, 0xc6, 0xdc, 0x28, 0xef, 0xd7, 0xb9,
+		0x2b, 0x35, 0x3e, 0xa9, 0xb7, 0x2b, 0xa2, 0xdd, 0x5c, 0x3b, 0x3d, 0x3b, 0xd9, 0x1d, 0x09, 0x0c,
+		0xc6, 0xfb, 0xee, 0x37, 0xb0, 0x0f, 0xa5, 0xe5, 0x4b, 0x4b, 0x60, 0xdb, 0x86, 0xff, 0x32, 0x45,
+		0xe4, 0xd0, 0x97, 0x29, 0xdc, 0x56, 0xa2, 0xfe, 0x56, 0xae, 0x43, 0x4a, 0x08, 0x85, 0xfc, 0x41,
+		0x7f, 0xee, 0x89, 0x38, 0xa3, 0x19, 0xbb, 0x79, 0x72, 0xf2, 0x1e, 0x0a, 0x3a, 0x1f, 0x3c, 0xe5,
+		0xce, 0xf3, 0x2b, 0xf8, 0x9f, 0x1c, 0x28, 0xb0, 0x8a, 0x65, 0xe1, 0x54, 0x28, 0x3f, 0x81, 0xaf,
+		0xf1, 0x0f, 0x48, 0xe5, 0xfb, 0xd9, 0x01, 0x54, 0x8a, 0x28, 0xfd, 0xbe, 0x37, 0xbf, 0xd9, 0xcc,
+		0xe7, 0xb0, 0xd4, 0x7b, 0xf5, 0xfb, 0xad, 0x28, 0x64, 0x7c, 0x31, 0x3d, 0xf9, 0xc9, 0x40, 0x0a,
+		0xe8, 0xfc, 0x41, 0xf1, 0x3f, 0x5f, 0x0e, 0x68, 0x60, 0x60, 0xd1, 0xc3, 0x0f, 0xec, 0xce, 0x27,
+		0xe9, 0x8f, 0xbe, 0xed, 0x93, 0x18, 0x73, 0xdb, 0xe7, 0x5b, 0x23, 0x90, 0x72, 0xb7, 0xee, 0xc3,
+		0x46, 0xf3, 0x8f, 0x42, 0x92, 0xef, 0x4e, 0x2c, 0x9c, 0xcf, 0xbf, 0x46, 0xc6, 0x42, 0x4b, 0x90,
+		0x12, 0x7f, 0x28, 0x8a, 0x3b, 0xa2, 0xee, 0xf7, 0x99, 0x67, 0x20, 0xe3, 0x3b, 0x58, 0x41, 0x3d,
+		0xb1, 0x5a, 0x7b, 0x49, 0x9a, 0x2a, 0x4d, 0xdf, 0xbc, 0x35, 0x1f, 0x5b, 0x25, 0x37, 0x70, 0x85,
+		0xa9, 0xb5, 0xea, 0xe5, 0x5a, 0xf5, 0x05, 0x29, 0x52, 0xca, 0xdc, 0xbc, 0x35, 0x3f, 0xad, 0x12,
+		0x1a, 0xbe, 0x3a, 0xf3, 0x02, 0x14, 0x06, 0x26, 0x26, 0xa8, 0xdf, 0x65, 0xc8, 0x2f, 0x6d, 0xae,
+		0x2f, 0xd7, 0xab, 0xe5, 0x8d, 0x9a, 0xc6, 0xd2, 0xe9, 0xf0, 0x55, 0xff, 0xe5, 0xfa, 0xa5, 0xcb,
+		0x1b, 0x5a, 0x75, 0xb9, 0x5e, 0x5b, 0xdd, 0xd0, 0xca, 0x1b, 0x1b, 0xe5, 0xea, 0x0b, 0x52, 0xf4,
+		0xdc, 0x97, 0x00, 0x0a, 0xe5, 0x4a, 0xb5, 0x8e, 0xfb, 0xb3, 0xc1, 0x9f, 0x6d, 0xaf, 0x42, 0x9c,
+		0x86, 0x02, 0x0e, 0x4c, 0x15, 0x29, 0x1d, 0x1c, 0xdb, 0x94, 0x2f, 0x42, 0x82, 0x46, 0x09, 0xe4,
+		0x83, 0x73, 0x47, 0x4a, 0x21, 0xc1, 0x4e, 0xec, 0x0c, 0x5d, 0x4e, 0x07, 0x26, 0x93, 0x94, 0x0e,
+		0x8e, 0x7d, 0xca, 0x2a, 0xa4, 0x3d, 0x2f, 0x23, 0x3c, 0xb9, 0xa2, 0x34, 0x81, 0x76, 0x94, 0x97,
+		0x61, 0x5a, 0x38, 0x86, 0x61, 0xe9, 0x1e, 0xa5, 0xd0, 0xe0, 0x24, 0xb2, 0x8b, 0x39, 0xf0, 0x07,
+		0xe7, 0xae, 0x94, 0x42, 0x22, 0xad, 0x72, 0xdd, 0x4d, 0xc2, 0x0f, 0x49, 0xe1, 0x28, 0x85, 0x05,
+		0x1b, 0x91, 0x69, 0x5e, 0x68, 0x24, 0x3c, 0x23, 0xa7, 0x34, 0x41, 0x10, 0x59, 0xde, 0x04, 0xf0,
+		0xb9, 0xeb, 0x13, 0xa4, 0xda, 0x94, 0x26, 0x09, 0x0e, 0xcb, 0x6b, 0x90, 0x72, 0xbd, 0xa7, 0xd0,
+		0xc4, 0x97, 0x52, 0x78, 0x94, 0x56, 0xfe, 0x10, 0xe4, 0x82, 0x5e, 0xc3, 0x64, 0xe9, 0x2c, 0xa5,
+		0x09, 0xc3, 0xaf, 0x48, 0x3f, 0xe8, 0x42, 0x4c, 0x96, 0xde, 0x52, 0x9a, 0x30, 0x1a, 0x2b, 0xbf,
+		0x06, 0x33, 0xc3, 0x26, 0xfe, 0xe4, 0xd9, 0x2e, 0xa5, 0x43, 0xc4, 0x67, 0xe5, 0x0e, 0xc8, 0x23,
+		0x5c, 0x83, 0x43, 0x24, 0xbf, 0x94, 0x0e, 0x13, 0xae, 0x95, 0x5b, 0x50, 0x18, 0xb4, 0xb7, 0x27,
+		0x4d, 0x86, 0x29, 0x4d, 0x1c, 0xba, 0x65, 0xad, 0x04, 0xed, 0xf4, 0x49, 0x93, 0x63, 0x4a, 0x13,
+		0x47, 0x72, 0x2b, 0xe5, 0xb1, 0xf9, 0x8d, 0x0f, 0x1e, 0x98, 0xdf, 0xe8, 0x65, 0x2c, 0xba, 0x39,
+		0x8d, 0x3f, 0x7b, 0x19, 0xee, 0xe3, 0x8f, 0x09, 0xd8, 0x8e, 0x7e, 0xcd, 0x30, 0x77, 0xdc, 0xd7,
+		0x21, 0xf8, 0x37, 0x4f, 0x6e, 0x3c, 0xca, 0xa0, 0x16, 0x44, 0x69, 0xc8, 0x1b, 0x11, 0x63, 0x1f,
+		0xce, 0x0a, 0x4b, 0x42, 0x0e, 0x4f, 0x5d, 0x3c, 0xe0, 0xfd, 0x89, 0x90, 0x57, 0x2e, 0x46, 0xbc,
+		0x4f, 0x11, 0x92, 0x84, 0x79, 0x50, 0xbe, 0xa7, 0xf2, 0xfd, 0x11, 0xc8, 0x5f, 0x36, 0x6c, 0xc7,
+		0xea, 0x19, 0x4d, 0xbd, 0x4d, 0x77, 0x8c, 0x67, 0x27, 0xbd, 0xe4, 0x51, 0x49, 0xa3, 0x31, 0xc2,
+		0x1f, 0xb5, 0x60, 0x28, 0xf2, 0x12, 0x24, 0xaf, 0xeb, 0x6d, 0x76, 0xc5, 0xc2, 0xff, 0x7e, 0xcd,
+		0x20, 0xcf, 0x7d, 0x56, 0x92, 0x9f, 0x0a, 0xc3, 0x55, 0x3e, 0x4d, 0x93, 0xb8, 0x3b, 0x1d, 0xc3,
+		0x66, 0x7f, 0xca, 0xdd, 0x21, 0x78, 0x0c, 0x1f, 0xef, 0xe9, 0x0e, 0x77, 0x6a, 0x2a, 0x65, 0xfe,
+		0x14, 0xc5, 0x03, 0xe1, 0x0f, 0x4a, 0x2c, 0x0c, 0xbd, 0x56, 0xc1, 0x1a, 0xa3, 0xe4, 0xe4, 0x6f,
+		0x00, 0xcc, 0x20, 0xd6, 0x28, 0xe9, 0xe8, 0x9d, 0x22, 0x8d, 0x39, 0xd5, 0xd8, 0x6b, 0xd9, 0x00,
+		0x4c, 0x89, 0xc6, 0x03, 0x47, 0x73, 0x87, 0xb0, 0x46, 0x62, 0x77, 0xaa, 0x91, 0x5c, 0x47, 0xdf,
+		0xab, 0x52, 0xc2, 0xd8, 0x14, 0x7f, 0xf4, 0xe3, 0x37, 0x22, 0x00, 0x1e, 0xe7, 0x64, 0x1d, 0xc3,
+		0xfa, 0xe2, 0x8b, 0xb6, 0x2f, 0xc2, 0xfa, 0x0f, 0x8e, 0x9b, 0x98, 0x01, 0xbe, 0x57, 0x72, 0xd8,
+		0xd3, 0xcf, 0xbc, 0x7d, 0x2a, 0xc2, 0x5a, 0x2d, 0x34, 0x07, 0xe6, 0xe5, 0x0a, 0x64, 0x58, 0x34,
+		0x4d, 0xa3, 0x26, 0x6c, 0x34, 0xd4, 0x84, 0xcd, 0x09, 0x13, 0x96, 0x11, 0x04, 0x86, 0x8d, 0xf5,
+		0x7c, 0x0c, 0x9f, 0x88, 0x40, 0x66, 0xc9, 0xf7, 0x5c, 0x15, 0x9e, 0xcb, 0x58, 0xa6, 0x71, 0x8d,
+		0xf4, 0xdc, 0x73, 0x19, 0xf6, 0x89, 0x66, 0x26, 0xfb, 0xab, 0x47, 0xce, 0xbe, 0x78, 0x89, 0x41,
+		0x7c, 0x23, 0xd6, 0x0d, 0xb2, 0x65, 0x1b, 0x82, 0xe5, 0xaa, 0xf8, 0xc4, 0x43, 0x72, 0x9b, 0x34,
+		0xfb, 0x18, 0xb2, 0xc5, 0x3f, 0x78, 0xe6, 0xe0, 0x5f, 0x33, 0x64, 0xd1, 0xd3, 0x82, 0x28, 0xaf,
+		0xb2, 0x62, 0x24, 0xd2, 0x22, 0x8e, 0x6e, 0xb4, 0x59, 0xda, 0x57, 0x5a, 0x15, 0x9f, 0xbc, 0xab,
+		0x9f, 0x9e, 0xf6, 0xbb, 0x86, 0x55, 0x90, 0xac, 0x2e, 0xe9, 0x05, 0xae, 0xbc, 0x31, 0x71, 0x2d,
+		0xfe, 0xde, 0xa7, 0x1e, 0x9d, 0xe3, 0x0c, 0xe7, 0x07, 0xf0, 0xec, 0x75, 0x66, 0xb5, 0x20, 0x30,
+		0x78, 0xb1, 0xfc, 0x4a, 0xe0, 0x24, 0xa6, 0xbf, 0xe5, 0x5d, 0xb9, 0x9f, 0x1b, 0x62, 0x6a, 0xd9,
+		0xdc, 0xaf, 0x14, 0x7f, 0xc7, 0x23, 0xed, 0xb9, 0x8e, 0x78, 0xc9, 0xdd, 0x77, 0x2c, 0x43, 0xc9,
+		0xa0, 0x05, 0xff, 0x9a, 0x6e, 0xb4, 0xc5, 0x1f, 0x88, 0x53, 0xf9, 0x97, 0xbc, 0xe8, 0x86, 0x61,
+		0xe2, 0xd4, 0x1d, 0x52, 0xc6, 0xc9, 0x46, 0xc5, 0x32, 0x5b, 0xc1, 0x88, 0x8b, 0xbc, 0x01, 0x49,
+		0xc7, 0xba, 0x46, 0x4c, 0xce, 0xa0, 0xca, 0x07, 0x0e, 0x21, 0xd8, 0xc3, 0xef, 0xcf, 0x70, 0x5a,
+		0xf2, 0x0e, 0x48, 0x2d, 0xd2, 0x26, 0x3b, 0x94, 0x95, 0xf8, 0xa7, 0x6f, 0x09, 0xbb, 0xc4, 0x79,
+		0x38, 0xfa, 0xc3, 0xcf, 0xd4, 0x14, 0x5c, 0xaa, 0x0d, 0x4a, 0x54, 0x5e, 0x0f, 0x3e, 0x96, 0x36,
+		0xcd, 0x4f, 0xe5, 0xc7, 0x8c, 0xdf, 0x27, 0x95, 0x7e, 0xb5, 0xe5, 0x27, 0x81, 0xd2, 0xd5, 0x37,
+		0xb7, 0x2c, 0x93, 0xfe, 0xcd, 0x25, 0xee, 0x60, 0xa5, 0xd8, 0xc9, 0x9d, 0x5b, 0xce, 0x4f, 0xee,
+		0xd6, 0x21, 0xef, 0x81, 0xd2, 0xd5, 0x93, 0x3e, 0xec, 0xea, 0xc9, 0xb9, 0x04, 0x10, 0x04, 0xff,
+		0x38, 0x93, 0xb7, 0x3e, 0xdd, 0xd3, 0xfd, 0xd0, 0x95, 0xee, 0x1f, 0x8c, 0x8f, 0x80, 0xdc, 0x86,
+		0xd9, 0x8e, 0x61, 0x6a, 0x36, 0x69, 0x6f, 0x6b, 0x9c, 0x73, 0x48, 0x37, 0x73, 0x07, 0x66, 0x7a,
+		0xa6, 0x63, 0x98, 0x0d, 0xd2, 0xde, 0x5e, 0x72, 0xc9, 0xca, 0x1f, 0x80, 0x13, 0x1e, 0x3b, 0x2c,
+		0x53, 0xdb, 0xb5, 0xda, 0x2d, 0xad, 0x47, 0xb6, 0xb5, 0x26, 0x7d, 0xfe, 0x28, 0x4b, 0x99, 0x78,
+		0xcc, 0x05, 0x59, 0x33, 0x2f, 0x5b, 0xed, 0x96, 0x4a, 0xb6, 0xab, 0x58, 0x8d, 0xa1, 0x71, 0x0f,
+		0xdb, 0x68, 0x61, 0x56, 0x40, 0x0c, 0x53, 0x62, 0xdd, 0xc2, 0x7a, 0xcb, 0x5e, 0x4c, 0x7d, 0xe7,
+		0xc7, 0x4e, 0x4d, 0x7d, 0xee, 0x63, 0xa7, 0xa6, 0x94, 0x8b, 0xf4, 0xb1, 0x0e, 0xbe, 0xe8, 0x88,
+		0x2d, 0x5f, 0x80, 0xb4, 0x2e, 0x3e, 0xd8, 0x35, 0x91, 0x03, 0x16, 0xad, 0x07, 0xaa, 0x7c, 0x2c,
+		0x02, 0xc9, 0xa5, 0xab, 0xeb, 0xba, 0xd1, 0x93, 0x6b, 0xf8, 0xcc, 0x9b, 0x10, 0xda, 0x49, 0xd7,
+		0xbf, 0x27, 0xe7, 0xbc, 0x1c, 0xc9, 0x8c, 0xbe, 0x39, 0x7b, 0x20, 0x99, 0xc1, 0x3b, 0xb5, 0xbe,
+		0xa1, 0x5e, 0x81, 0x69, 0xd6, 0x43, 0x5b, 0x7e, 0x1e, 0x12, 0x5d, 0xfc, 0x51, 0x8c, 0x04, 0x1e,
+		0x7c, 0x1b, 0x16, 0x74, 0x0a, 0xef, 0x17, 0x0b, 0x86, 0xa7, 0xfc, 0x55, 0x04, 0x60, 0xe9, 0xea,
+		0xd5, 0x8d, 0x9e, 0xd1, 0x6d, 0x13, 0xe7, 0x4e, 0x0d, 0x79, 0x19, 0x8e, 0x78, 0x43, 0xb6, 0x7b,
+		0xcd, 0x89, 0x87, 0x3d, 0xeb, 0xa2, 0x35, 0x7a, 0xcd, 0x91, 0xd4, 0x5a, 0xb6, 0xe3, 0x52, 0x8b,
+		0x4d, 0x4c, 0x6d, 0xc9, 0x76, 0x86, 0xf9, 0xf8, 0x32, 0x64, 0xbc, 0xa1, 0xdb, 0x72, 0x1d, 0x52,
+		0x0e, 0xff, 0xcd, 0xd9, 0xa9, 0x8c, 0x67, 0xa7, 0x40, 0xf3, 0xb3, 0xd4, 0x45, 0x57, 0xfe, 0x17,
+		0x72, 0xd5, 0x5b, 0x08, 0x5f, 0x51, 0x82, 0x84, 0x1a, 0x9e, 0x6b, 0xe0, 0xd8, 0x1d, 0xd0, 0xc0,
+		0x9c, 0x96, 0x8f, 0xad, 0xdf, 0x16, 0xc5, 0xbf, 0x96, 0xc8, 0x17, 0xe9, 0x57, 0x2c, 0x17, 0x36,
+		0x61, 0x9a, 0x98, 0x4e, 0xcf, 0x20, 0xe2, 0xa8, 0xef, 0xb1, 0x71, 0x93, 0x3d, 0x62, 0x2c, 0xf4,
+		0xcf, 0x6b, 0xfb, 0xa7, 0x5e, 0xd0, 0xf2, 0xb1, 0xe1, 0x57, 0x63, 0x50, 0x1c, 0x87, 0x8a, 0x0f,
+		0x15, 0x34, 0x7b, 0x84, 0x16, 0x68, 0x81, 0x50, 0x78, 0x5e, 0x14, 0xf3, 0x2d, 0x45, 0x05, 0x34,
+		0xd0, 0x50, 0xaa, 0x10, 0xf4, 0xf6, 0x2c, 0xb2, 0xbc, 0x47, 0x01, 0x61, 0x64, 0x02, 0x05, 0x91,
+		0xdb, 0xbe, 0xa5, 0xb7, 0x75, 0xbc, 0x10, 0x19, 0xbb, 0x03, 0x3b, 0x80, 0x48, 0x98, 0xaf, 0x30,
+		0x9a, 0xf2, 0x55, 0x98, 0x16, 0xe4, 0xe3, 0x77, 0x80, 0xbc, 0x20, 0x86, 0xb7, 0x1c, 0xfc, 0x1b,
+		0x03, 0xb5, 0x53, 0xe2, 0x6a, 0xc6, 0x2d, 0xab, 0xb7, 0xc2, 0x76, 0x9e, 0xe4, 0x81, 0x3b, 0x0f,
+		0x37, 0x05, 0x7f, 0x25, 0x86, 0xe7, 0xfa, 0xad, 0xaf, 0xc1, 0x89, 0xfb, 0x7a, 0x00, 0xb6, 0xa8,
+		0x51, 0xd9, 0x16, 0xe3, 0x77, 0x40, 0x49, 0xa4, 0x19, 0xbd, 0x25, 0xdb, 0x79, 0xbf, 0x66, 0xef,
+		0x5f, 0x44, 0x21, 0xeb, 0x9f, 0xbd, 0xaf, 0x81, 0x9d, 0x4d, 0x5e, 0xf5, 0x54, 0x1a, 0xcb, 0x3e,
+		0x7f, 0x68, 0x9c, 0x4a, 0x1b, 0x92, 0xeb, 0x10, 0x5d, 0xf6, 0xd1, 0x14, 0x24, 0x79, 0x2a, 0xd8,
+		0xda, 0x90, 0x8d, 0x1b, 0x09, 0xbb, 0xff, 0x9b, 0x13, 0xf7, 0x7f, 0x47, 0x9a, 0xb8, 0xf7, 0x03,
+		0xde, 0x0a, 0xd6, 0x02, 0xf9, 0x65, 0x78, 0x1c, 0x81, 0xee, 0xb0, 0x97, 0x0d, 0x8d, 0x29, 0x76,
+		0x08, 0xe6, 0xe9, 0x6c, 0x84, 0xc1, 0x44, 0xff, 0x1a, 0x2b, 0x91, 0x1f, 0x05, 0x79, 0xd7, 0x0d,
+		0x7c, 0x68, 0x1e, 0x23, 0x10, 0x6e, 0xc6, 0xab, 0x11, 0xe0, 0xf8, 0x50, 0x83, 0x65, 0xb6, 0x34,
+		0xf6, 0x42, 0x27, 0x7f, 0xed, 0x14, 0x4b, 0x96, 0xb0, 0x40, 0xfe, 0xde, 0x08, 0x33, 0x95, 0x07,
+		0xbc, 0x6d, 0xee, 0xb4, 0x68, 0x87, 0x5b, 0x0d, 0x5f, 0x7c, 0xfb, 0x54, 0x69, 0x5f, 0xef, 0xb4,
+		0x17, 0x95, 0x11, 0x24, 0x95, 0x51, 0xb1, 0x00, 0xb4, 0xa6, 0x83, 0x8e, 0xbb, 0xbc, 0x0a, 0x12,
+		0xde, 0x7b, 0xee, 0xf1, 0xbf, 0x5b, 0xaa, 0x6d, 0x13, 0xc2, 0xdd, 0x9b, 0xe3, 0x0b, 0x23, 0x1e,
+		0x4a, 0x5d, 0xc0, 0x27, 0x48, 0xfd, 0xd3, 0x9a, 0xbf, 0x46, 0xf6, 0x55, 0x8e, 0x7c, 0x91, 0x10,
+		0xf9, 0x9b, 0xe1, 0xf8, 0x4e, 0xdb, 0xda, 0xc2, 0x7b, 0x10, 0xc6, 0xeb, 0x7d, 0xa3, 0xa5, 0x71,
+		0x21, 0xd1, 0x9a, 0x7a, 0xb7, 0x98, 0xba, 0x53, 0x41, 0x8d, 0xa3, 0xac, 0x8d, 0x65, 0xda, 0x44,
+		0x83, 0xb5, 0x50, 0xd5, 0xbb, 0xf2, 0xb7, 0x46, 0xe0, 0x2e, 0x4f, 0xf4, 0x47, 0xf4, 0x20, 0x7d,
+		0xa7, 0x7a, 0x70, 0xdc, 0x6d, 0x66, 0xa8, 0x13, 0xdf, 0x0c, 0xc7, 0x03, 0xa2, 0xc7, 0x4e, 0xdb,
+		0x34, 0x2a, 0xb6, 0x45, 0xb8, 0x53, 0x1d, 0x38, 0xea, 0x17, 0x64, 0x7a, 0x6e, 0xa7, 0x62, 0x03,
+		0xf2, 0x12, 0x9c, 0xc2, 0xd6, 0x5f, 0xef, 0x13, 0xfc, 0x3b, 0xa0, 0xae, 0xa6, 0xb7, 0xb5, 0x2e,
+		0xe9, 0xf9, 0x52, 0xb1, 0x73, 0xea, 0x89, 0x8e, 0xbe, 0xf7, 0x22, 0x42, 0x55, 0x3d, 0xa0, 0x75,
+		0xd2, 0x63, 0xe1, 0xfd, 0x6f, 0x80, 0x23, 0x76, 0x5b, 0xb7, 0x77, 0xb5, 0x1e, 0xc1, 0xf4, 0x53,
+		0xbd, 0xad, 0xdd, 0x30, 0xcc, 0x96, 0x75, 0xa3, 0x98, 0x3d, 0xe4, 0xb2, 0x9c, 0xa5, 0x64, 0x54,
+		0x4e, 0xe5, 0x25, 0x4a, 0x64, 0xf1, 0x3e, 0x54, 0xa6, 0x37, 0xff, 0xf8, 0xe7, 0xce, 0x9c, 0xf0,
+		0x8d, 0x76, 0xcf, 0x0d, 0xcb, 0x32, 0x9d, 0x80, 0xaf, 0x0a, 0xc8, 0x9e, 0x85, 0xe3, 0x5e, 0x42,
+		0x58, 0xa1, 0xa9, 0xe9, 0xbc, 0xd4, 0xbd, 0x85, 0x30, 0xd6, 0x15, 0x17, 0x90, 0x01, 0xe7, 0xd5,
+		0x23, 0x20, 0x7f, 0xd0, 0xb3, 0x27, 0xa2, 0x87, 0x90, 0x7b, 0x81, 0x44, 0x37, 0x86, 0x29, 0xe5,
+		0xed, 0x08, 0xa6, 0x0e, 0x0d, 0xa8, 0x3f, 0xb7, 0xcb, 0x4d, 0x90, 0x7b, 0xbe, 0x4a, 0xaa, 0x46,
+		0xc4, 0xa1, 0xfe, 0xed, 0x69, 0xd3, 0x99, 0xde, 0x60, 0xed, 0x7b, 0x65, 0x18, 0xf1, 0x9d, 0xef,
+		0xb7, 0x23, 0x78, 0xb7, 0xac, 0x35, 0x3c, 0x1d, 0x0d, 0xc8, 0xfa, 0xfb, 0xc2, 0x47, 0x75, 0xdf,
+		0x24, 0xa3, 0xf2, 0x0f, 0x28, 0x40, 0x04, 0xc7, 0x22, 0x54, 0x2d, 0x0b, 0x10, 0x3f, 0x3e, 0x31,
+		0x97, 0xdc, 0xc3, 0xaf, 0x51, 0x7b, 0x0f, 0x9b, 0xac, 0xef, 0x89, 0x42, 0x7c, 0xdd, 0xb2, 0xda,
+		0xa8, 0x2e, 0x66, 0x4c, 0xcb, 0xd1, 0x50, 0x41, 0x93, 0x96, 0xc6, 0x23, 0x54, 0x6c, 0xfb, 0xbe,
+		0x7a, 0x38, 0xee, 0xfd, 0xc9, 0xdb, 0xa7, 0x86, 0x49, 0x8d, 0x7a, 0xd7, 0xb9, 0x60, 0x5a, 0x4e,
+		0x85, 0x02, 0x6d, 0x50, 0x18, 0xf9, 0x06, 0xe4, 0x82, 0xed, 0xb3, 0x3d, 0x5f, 0x3d, 0x74, 0xfb,
+		0xb9, 0xd0, 0xb6, 0xb3, 0x5b, 0xbe, 0x86, 0xd9, 0xeb, 0xaa, 0x7f, 0x8e, 0x93, 0xfb, 0x0a, 0x48,
+		0x57, 0x07, 0xd3, 0x21, 0x6b, 0x30, 0x7d, 0xd8, 0xcc, 0x4a, 0x3f, 0xc7, 0x39, 0xae, 0xf2, 0x0b,
+		0x31, 0x38, 0x8e, 0x59, 0x92, 0x3c, 0xd8, 0xc8, 0x77, 0x0a, 0x76, 0x96, 0xb0, 0x8f, 0xf9, 0xe4,
+		0x63, 0x42, 0xa1, 0xf7, 0xfc, 0xde, 0xa7, 0x1e, 0xbd, 0x9b, 0xf7, 0xff, 0xea, 0x80, 0x97, 0x35,
+		0x2e, 0x26, 0x7a, 0x15, 0x0a, 0x68, 0xd3, 0x35, 0xa9, 0xb6, 0xfb, 0x72, 0x42, 0xa2, 0x39, 0xab,
+		0xdd, 0xe2, 0x9d, 0xc6, 0x80, 0xe8, 0x55, 0x28, 0x98, 0xe4, 0x46, 0x80, 0x6e, 0xec, 0xf6, 0xe8,
+		0x9a, 0xe4, 0x86, 0x8f, 0xae, 0x97, 0x5a, 0x11, 0x0f, 0xe4, 0x79, 0x3c, 0xc7, 0xf3, 0x3c, 0x12,
+		0x87, 0xb5, 0xec, 0x29, 0x9a, 0x7c, 0x01, 0x62, 0xdb, 0x84, 0xd9, 0x14, 0x93, 0x6a, 0x33, 0x44,
+		0xf0, 0x19, 0x66, 0xbf, 0x19, 0x81, 0x59, 0x2a, 0x24, 0xc6, 0x1b, 0x84, 0x46, 0x40, 0x55, 0xd2,
+		0xb4, 0x7a, 0x2d, 0xcc, 0x99, 0xe2, 0xef, 0x58, 0xc5, 0xd5, 0xa8, 0xd1, 0xc2, 0xa7, 0x9e, 0xac,
+		0x1b, 0x26, 0x4f, 0x32, 0x3a, 0xc8, 0xb0, 0x64, 0x60, 0xd4, 0x28, 0xb3, 0x5a, 0xfd, 0x36, 0xd1,
+		0xf4, 0x26, 0xb3, 0xba, 0x59, 0xcc, 0x3d, 0xc7, 0x4a, 0xcb, 0xac, 0x30, 0xf8, 0x2a, 0x4e, 0x7c,
+		0x52, 0x71, 0xf0, 0x70, 0x7c, 0x23, 0xf9, 0x77, 0x09, 0xc8, 0x34, 0xd8, 0x0e, 0x34, 0x72, 0x04,
+		0xab, 0xe3, 0xdd, 0xfe, 0x09, 0x9a, 0x1c, 0xf6, 0xff, 0x1f, 0x86, 0x19, 0xc3, 0xdc, 0xee, 0xe9,
+		0x4d, 0xbf, 0x2b, 0xc7, 0x5f, 0xd5, 0xf5, 0x2a, 0x2e, 0xbb, 0x29, 0x34, 0xef, 0xc5, 0xfc, 0xb7,
+		0x20, 0xcb, 0xf6, 0xee, 0x6d, 0xbd, 0x89, 0x1c, 0x4c, 0xde, 0x29, 0x93, 0x23, 0x43, 0xc9, 0x5e,
+		0xa4, 0x54, 0xe5, 0x57, 0x20, 0xb9, 0xd5, 0xef, 0x99, 0xa4, 0x55, 0x9c, 0x3e, 0x34, 0xfd, 0x71,
+		0x4f, 0xde, 0x33, 0x82, 0x78, 0x5e, 0xc3, 0xcc, 0x0e, 0xd2, 0xa2, 0x26, 0x63, 0x4a, 0x75, 0xbf,
+		0xd1, 0x53, 0x76, 0x4d, 0x92, 0xc0, 0xad, 0x88, 0xbc, 0x28, 0xe6, 0xcc, 0x6d, 0x83, 0x37, 0x3b,
+		0x1a, 0xef, 0x29, 0xdc, 0xa9, 0x9e, 0x7a, 0xef, 0x41, 0x56, 0x58, 0x97, 0xdb, 0x23, 0x4e, 0x22,
+		0x32, 0x77, 0x8a, 0xef, 0x83, 0xc7, 0x11, 0x7c, 0x1b, 0xfb, 0x64, 0x14, 0x66, 0xa8, 0x6c, 0x93,
+		0x96, 0x2f, 0x1e, 0xf6, 0x00, 0x14, 0x84, 0xe5, 0x86, 0x12, 0xaf, 0xb9, 0xe2, 0x9e, 0xb3, 0xbd,
+		0x75, 0x50, 0x6f, 0x8d, 0xf6, 0x5c, 0xa3, 0x87, 0xf6, 0x5c, 0x47, 0x2e, 0xa0, 0xd8, 0xed, 0x2f,
+		0xa0, 0x57, 0xdc, 0xbf, 0x63, 0x10, 0xbf, 0x63, 0x62, 0xe5, 0xfb, 0x7b, 0x07, 0x53, 0xca, 0x2f,
+		0x47, 0xe1, 0xd8, 0x10, 0xd7, 0xf8, 0x31, 0xcf, 0x57, 0x3f, 0xef, 0xb8, 0xe8, 0xc5, 0xef, 0x94,
+		0xe8, 0x89, 0x38, 0x2c, 0xe3, 0xdd, 0x5b, 0x31, 0x38, 0xc2, 0x79, 0xe7, 0xc6, 0x20, 0x99, 0xf1,
+		0xf9, 0x55, 0xce, 0xb9, 0x11, 0xf1, 0xb7, 0xf8, 0xc8, 0xf8, 0xdb, 0x04, 0x71, 0x26, 0x4f, 0x82,
+		0x93, 0xef, 0x89, 0x04, 0x9f, 0xf9, 0xc5, 0x08, 0x80, 0x77, 0xc4, 0x8a, 0xaf, 0x04, 0x56, 0xd6,
+		0x56, 0x97, 0xb4, 0xc6, 0x46, 0x79, 0x63, 0xb3, 0x11, 0xfc, 0xfb, 0x4a, 0xe2, 0x4d, 0x41, 0xbb,
+		0x4b, 0x9a, 0xc6, 0xb6, 0x41, 0x5a, 0xf2, 0x03, 0x30, 0x17, 0x84, 0xc6, 0xaf, 0xda, 0x92, 0x14,
+		0x29, 0x65, 0x6f, 0xde, 0x9a, 0x4f, 0xb1, 0x69, 0x25, 0xf8, 0x22, 0xf3, 0x91, 0x61, 0x38, 0xfc,
+		0xdb, 0x4c, 0xd1, 0x52, 0xee, 0xe6, 0xad, 0xf9, 0xb4, 0x3b, 0xff, 0xb2, 0x02, 0xb2, 0x1f, 0x92,
+		0xd3, 0x8b, 0x95, 0xe0, 0xe6, 0xad, 0xf9, 0x24, 0xb3, 0x74, 0x4b, 0x71, 0x7c, 0x39, 0xf0, 0xcc,
+		0x37, 0x02, 0xd4, 0xdd, 0x3d, 0x50, 0x2e, 0xc1, 0xd1, 0xfa, 0xea, 0x45, 0xb5, 0x5c, 0xdd, 0xa8,
+		0xaf, 0xad, 0x06, 0xbb, 0x3d, 0x50, 0xb7, 0xb4, 0xb6, 0x59, 0x59, 0xae, 0x69, 0xf8, 0x56, 0x21,
+		0xcb, 0xd4, 0x0c, 0xd4, 0xbd, 0xb4, 0xba, 0x51, 0x5f, 0xa9, 0x49, 0xd1, 0xca, 0xc5, 0xb1, 0xb9,
+		0x42, 0x8f, 0x1c, 0xc8, 0x7a, 0xcf, 0xf1, 0x0c, 0x24, 0x0c, 0xfd, 0xbf, 0x01, 0x00, 0x45, 0x22,
+		0xa8, 0xab, 0x6a, 0xa6, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2310,6 +2880,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.MaxValidatorPowerRatio.Equal(that1.MaxValidatorPowerRatio) {
+		return false
+	}
+	if this.MaxQueueCompletionsPerBlock != that1.MaxQueueCompletionsPerBlock {
+		return false
+	}
+	if this.SlashReversalWindow != that1.SlashReversalWindow {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3203,24 +3791,77 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashReversalWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashReversalWindow):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x62
+	if m.MaxQueueCompletionsPerBlock != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxQueueCompletionsPerBlock))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
-		size := m.MinCommissionRate.Size()
+		size := m.MaxValidatorPowerRatio.Size()
 		i -= size
-		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxValidatorPowerRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.BondDenom) > 0 {
-		i -= len(m.BondDenom)
-		copy(dAtA[i:], m.BondDenom)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.HistoricalEntries != 0 {
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HistoricalEntries != 0 {
 		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
 		i--
 		dAtA[i] = 0x20
@@ -3235,12 +3876,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3459,251 +4100,651 @@ func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
-}
-
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err20 != nil {
+		return 0, err20
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n20
+	i = encodeVarintStaking(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.ConsensusPubkey != nil {
-		l = m.ConsensusPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.Jailed {
-		n += 2
-	}
-	if m.Status != 0 {
-		n += 1 + sovStaking(uint64(m.Status))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingHeight != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.UnbondingIds) > 0 {
-		l = 0
-		for _, e := range m.UnbondingIds {
-			l += sovStaking(uint64(e))
-		}
-		n += 1 + sovStaking(uint64(l)) + l
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValAddresses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DVPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorBurned.Size()
+		i -= size
+		if _, err := m.ValidatorBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x52
+	if m.ReversalHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ReversalHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Reversed {
+		i--
+		if m.Reversed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashFactor.Size()
+		i -= size
+		if _, err := m.SlashFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintStaking(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DVPairs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashedDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashedDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SlashRecordId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SlashRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashedDelegationShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashedDelegationShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashedDelegationShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.SlashRecordId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SlashRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DVVTriplets) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashedUnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashedUnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashedUnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Triplets) > 0 {
-		for _, e := range m.Triplets {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x32
+	if m.UnbondingId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SlashRecordId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SlashRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Size() (n int) {
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = m.Shares.Size()
+	l = m.Header.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *UnbondingDelegation) Size() (n int) {
+func (m *CommissionRates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	if len(m.UnbondingIds) > 0 {
+		l = 0
+		for _, e := range m.UnbondingIds {
+			l += sovStaking(uint64(e))
+		}
+		n += 1 + sovStaking(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ValAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVVTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVVTriplets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *UnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
@@ -3815,6 +4856,19 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxValidatorPowerRatio.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxQueueCompletionsPerBlock != 0 {
+		n += 1 + sovStaking(uint64(m.MaxQueueCompletionsPerBlock))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashReversalWindow)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3858,44 +4912,1783 @@ func (m *RedelegationResponse) Size() (n int) {
 			n += 1 + l + sovStaking(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NotBondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.BondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NotBondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovStaking(uint64(m.InfractionHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.SlashFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.Reversed {
+		n += 2
+	}
+	if m.ReversalHeight != 0 {
+		n += 1 + sovStaking(uint64(m.ReversalHeight))
+	}
+	l = m.ValidatorBurned.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *SlashedDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashRecordId != 0 {
+		n += 1 + sovStaking(uint64(m.SlashRecordId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *SlashedDelegationShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashRecordId != 0 {
+		n += 1 + sovStaking(uint64(m.SlashRecordId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *SlashedUnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashRecordId != 0 {
+		n += 1 + sovStaking(uint64(m.SlashRecordId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valset = append(m.Valset, Validator{})
+			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingIds = append(m.UnbondingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStaking
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStaking
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingIds) == 0 {
+					m.UnbondingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStaking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingIds = append(m.UnbondingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ValidatorUpdates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+func (m *Delegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3918,17 +6711,17 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3938,30 +6731,29 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3971,23 +6763,55 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valset = append(m.Valset, Validator{})
-			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4012,7 +6836,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4035,15 +6859,15 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4071,13 +6895,11 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4105,15 +6927,13 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4123,23 +6943,23 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4164,7 +6984,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4187,15 +7007,34 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4222,15 +7061,15 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4240,25 +7079,98 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4280,7 +7192,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4303,17 +7215,17 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			var stringLen uint64
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4323,29 +7235,16 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4355,27 +7254,28 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4403,11 +7303,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4435,13 +7337,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
 			}
-			var stringLen uint64
+			m.UnbondingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4451,24 +7355,30 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UnbondingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4490,7 +7400,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4513,15 +7423,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4549,13 +7459,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4565,70 +7475,27 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types1.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Jailed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4656,15 +7523,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4674,29 +7539,79 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4723,15 +7638,34 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			m.UnbondingHeight = 0
+			m.MaxEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4741,16 +7675,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
+				m.MaxEntries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			var msglen int
+			m.HistoricalEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4760,30 +7694,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4793,28 +7713,27 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4842,15 +7761,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4860,140 +7779,28 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.UnbondingIds = append(m.UnbondingIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStaking
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthStaking
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.UnbondingIds) == 0 {
-					m.UnbondingIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStaking
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.UnbondingIds = append(m.UnbondingIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5013,69 +7820,21 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			intStringLen := int(stringLen)
 			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVPair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5103,11 +7862,13 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5135,61 +7896,32 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MaxValidatorPowerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueueCompletionsPerBlock", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxQueueCompletionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueueCompletionsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashReversalWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5216,8 +7948,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashReversalWindow, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5242,7 +7973,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5265,17 +7996,17 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5285,29 +8016,30 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5317,55 +8049,24 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5388,7 +8089,7 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5411,15 +8112,15 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5446,8 +8147,41 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5472,7 +8206,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5495,17 +8229,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5515,61 +8249,30 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5579,23 +8282,23 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5620,7 +8323,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5643,15 +8346,15 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5679,11 +8382,13 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5711,11 +8416,63 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5742,8 +8499,8 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Updates = append(m.Updates, types3.ValidatorUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5768,7 +8525,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5791,17 +8548,17 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5811,14 +8568,27 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5845,15 +8615,18 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5863,31 +8636,33 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5897,31 +8672,16 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			m.UnbondingId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5931,16 +8691,30 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5950,11 +8724,25 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5976,7 +8764,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5999,17 +8787,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.CreationHeight = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6019,47 +8807,14 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6087,13 +8842,11 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6118,37 +8871,16 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingOnHoldRefCount = 0
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6158,11 +8890,24 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6184,7 +8929,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6207,17 +8952,17 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6227,27 +8972,14 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6275,13 +9007,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
 			}
-			var stringLen uint64
+			m.InfractionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6291,27 +9023,33 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.InfractionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6338,66 +9076,15 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6407,30 +9094,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
-			m.MaxValidators = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6440,16 +9128,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reversed", wireType)
 			}
-			m.MaxEntries = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6459,16 +9162,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Reversed = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReversalHeight", wireType)
 			}
-			m.HistoricalEntries = 0
+			m.ReversalHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6478,14 +9182,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				m.ReversalHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBurned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6513,11 +9217,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6545,7 +9251,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6570,7 +9276,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *SlashedDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6593,17 +9299,36 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashedDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordId", wireType)
+			}
+			m.SlashRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6613,30 +9338,61 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6646,22 +9402,23 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6686,7 +9443,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *SlashedDelegationShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6709,17 +9466,17 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashedDelegationShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashedDelegationShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordId", wireType)
 			}
-			var msglen int
+			m.SlashRecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6729,28 +9486,14 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SlashRecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6778,65 +9521,13 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6846,30 +9537,29 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6879,23 +9569,23 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6920,7 +9610,7 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *SlashedUnbondingEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6943,15 +9633,34 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashedUnbondingEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashedUnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecordId", wireType)
+			}
+			m.SlashRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6979,13 +9688,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7013,65 +9720,51 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7081,23 +9774,23 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, types3.ValidatorUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
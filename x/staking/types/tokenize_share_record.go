@@ -0,0 +1,55 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: TokenizeShareModuleAccountName(id),
+		Validator:     valAddr.String(),
+	}
+}
+
+// TokenizeShareModuleAccountName returns the name of the module account
+// holding the tokenized delegation of a record.
+func TokenizeShareModuleAccountName(id uint64) string {
+	return TokenizeShareModuleAccountPrefix + strconv.FormatUint(id, 10)
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return strings.ToLower(r.Validator) + "/" + strconv.FormatUint(r.Id, 10)
+}
+
+// GetOwner returns the address of the record owner.
+func (r TokenizeShareRecord) GetOwner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.Owner)
+}
+
+// GetValidator returns the operator address of the validator the tokenized
+// delegation is bonded to.
+func (r TokenizeShareRecord) GetValidator() sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(r.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return valAddr
+}
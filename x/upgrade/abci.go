@@ -13,9 +13,12 @@ import (
 )
 
 // BeginBlock will check if there is a scheduled plan and if it is ready to be executed.
+// If there is no scheduled plan, it will first promote the next queued plan whose dependencies have been applied.
+// It then postpones the plan if, shortly before its height, too few validators signaled they are ready for it.
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
-// If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
+// If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise),
+// unless the plan was promoted from the queue, as the binary applying a queued plan may handle the next ones.
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
@@ -23,6 +26,14 @@ import (
 func BeginBlocker(k *keeper.Keeper, ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	// Without a current plan, the next queued plan whose dependencies are all
+	// applied becomes the current plan.
+	k.PromoteQueuedPlan(ctx)
+
+	// The current plan is postponed if too few validators signaled they are
+	// ready for it shortly before its height.
+	k.PostponeUnreadyUpgrade(ctx)
+
 	plan, found := k.GetUpgradePlan(ctx)
 
 	if !k.DowngradeVerified() {
@@ -86,8 +97,9 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context) {
 	}
 
 	// if we have a pending upgrade, but it is not yet time, make sure we did not
-	// set the handler already
-	if k.HasHandler(plan.Name) {
+	// set the handler already. A plan promoted from the queue may be handled by
+	// the binary which applied the upgrades before it.
+	if k.HasHandler(plan.Name) && !k.IsPromotedPlan(ctx, plan.Name) {
 		downgradeMsg := fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE \"%s\" - in binary but not executed on chain. Downgrade your binary", plan.Name)
 		ctx.Logger().Error(downgradeMsg)
 		panic(downgradeMsg)
@@ -53,7 +53,7 @@ func setupTest(t *testing.T, height int64, skip map[int64]bool) *TestSuite {
 		s.encCfg.TxConfig.TxDecoder(),
 	)
 
-	s.keeper = keeper.NewKeeper(skip, key, s.encCfg.Codec, t.TempDir(), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	s.keeper = keeper.NewKeeper(skip, key, s.encCfg.Codec, t.TempDir(), nil, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	s.keeper.SetVersionSetter(s.baseApp)
 
 	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now(), Height: height})
@@ -98,6 +98,87 @@ func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	VerifyDoUpgrade(t)
 }
 
+func TestDoTimeUpgrade(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	t.Log("Verify can schedule an upgrade at a block time")
+	upgradeTime := s.ctx.BlockTime().Add(time.Hour)
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Time: upgradeTime}}) //nolint:staticcheck // we're testing deprecated code
+	require.NoError(t, err)
+
+	t.Log("Verify that the upgrade is not due before its time")
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 100).WithBlockTime(upgradeTime.Add(-time.Second)))
+	})
+
+	VerifyDoUpgradeWithCtx(t, s.ctx.WithBlockHeight(s.ctx.BlockHeight()+101).WithBlockTime(upgradeTime), "test")
+}
+
+func TestDoQueuedUpgrades(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	height := s.ctx.BlockHeight()
+	t.Log("Verify can queue an upgrade depending on a scheduled one")
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "first", Height: height + 1}}) //nolint:staticcheck // we're testing deprecated code
+	require.NoError(t, err)
+	require.NoError(t, s.keeper.QueueUpgrade(s.ctx, types.Plan{Name: "second", Height: height + 3, DependsOn: []string{"first"}}))
+
+	s.keeper.SetUpgradeHandler("first", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	firstCtx := s.ctx.WithBlockHeight(height + 1)
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(firstCtx)
+	})
+	VerifyDone(t, firstCtx, "first")
+
+	t.Log("Verify that the queued upgrade is only promoted in the next block")
+	_, found := s.keeper.GetUpgradePlan(firstCtx)
+	require.False(t, found)
+
+	t.Log("Verify that a promoted upgrade may be handled by the binary before its height")
+	s.keeper.SetUpgradeHandler("second", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	promoteCtx := s.ctx.WithBlockHeight(height + 2)
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(promoteCtx)
+	})
+	plan, found := s.keeper.GetUpgradePlan(promoteCtx)
+	require.True(t, found)
+	require.Equal(t, "second", plan.Name)
+
+	secondCtx := s.ctx.WithBlockHeight(height + 3)
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(secondCtx)
+	})
+	VerifyDone(t, secondCtx, "second")
+	VerifyCleared(t, secondCtx)
+}
+
+func TestDropDueQueuedUpgrades(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	height := s.ctx.BlockHeight()
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "first", Height: height + 1}}) //nolint:staticcheck // we're testing deprecated code
+	require.NoError(t, err)
+	require.NoError(t, s.keeper.QueueUpgrade(s.ctx, types.Plan{Name: "second", Height: height + 2, DependsOn: []string{"first"}}))
+	require.NoError(t, s.keeper.QueueUpgrade(s.ctx, types.Plan{Name: "third", Height: height + 10, DependsOn: []string{"second"}}))
+
+	s.keeper.SetUpgradeHandler("first", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(s.ctx.WithBlockHeight(height + 1))
+	})
+
+	t.Log("Verify that a queued upgrade already due when promoted is dropped along with its dependents")
+	newCtx := s.ctx.WithBlockHeight(height + 2)
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx)
+	})
+	VerifyNotDone(t, newCtx, "second")
+	VerifyCleared(t, newCtx)
+	require.Empty(t, s.keeper.GetQueuedPlans(newCtx))
+}
+
 func VerifyDoUpgrade(t *testing.T) {
 	t.Log("Verify that a panic happens at the upgrade height")
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
@@ -475,7 +556,7 @@ func TestDowngradeVerification(t *testing.T) {
 
 	skip := map[int64]bool{}
 	tempDir := t.TempDir()
-	k := keeper.NewKeeper(skip, key, encCfg.Codec, tempDir, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	k := keeper.NewKeeper(skip, key, encCfg.Codec, tempDir, nil, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	m := upgrade.NewAppModule(k, addresscodec.NewBech32Codec("cosmos"))
 	handler := upgrade.NewSoftwareUpgradeProposalHandler(k)
 
@@ -524,7 +605,7 @@ func TestDowngradeVerification(t *testing.T) {
 		ctx, _ := ctx.CacheContext()
 
 		// downgrade. now keeper does not have the handler.
-		k := keeper.NewKeeper(skip, key, encCfg.Codec, tempDir, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+		k := keeper.NewKeeper(skip, key, encCfg.Codec, tempDir, nil, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 		m := upgrade.NewAppModule(k, addresscodec.NewBech32Codec("cosmos"))
 
 		// assertions
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"time"
+
 	"cosmossdk.io/x/upgrade/types"
 	"github.com/spf13/pflag"
 )
@@ -16,5 +19,21 @@ func parsePlan(fs *pflag.FlagSet, name string) (types.Plan, error) {
 		return types.Plan{}, err
 	}
 
-	return types.Plan{Name: name, Height: height, Info: info}, nil
+	var upgradeTime time.Time
+	timeStr, err := fs.GetString(FlagUpgradeTime)
+	if err != nil {
+		return types.Plan{}, err
+	}
+	if timeStr != "" {
+		if upgradeTime, err = time.Parse(time.RFC3339, timeStr); err != nil {
+			return types.Plan{}, fmt.Errorf("invalid upgrade time %q: %w", timeStr, err)
+		}
+	}
+
+	dependsOn, err := fs.GetStringSlice(FlagDependsOn)
+	if err != nil {
+		return types.Plan{}, err
+	}
+
+	return types.Plan{Name: name, Time: upgradeTime, Height: height, Info: info, DependsOn: dependsOn}, nil
 }
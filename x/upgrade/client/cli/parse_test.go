@@ -3,6 +3,7 @@ package cli
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/stretchr/testify/require"
@@ -23,10 +24,28 @@ func TestParsePlan(t *testing.T) {
 
 	fs.Set(FlagUpgradeHeight, strconv.FormatInt(proposal.Plan.Height, 10))
 	fs.Set(FlagUpgradeInfo, proposal.Plan.Info)
+	fs.Set(FlagDependsOn, "first,second")
 
 	p, err := parsePlan(fs, proposal.Plan.Name)
 	require.NoError(t, err)
 	require.Equal(t, p.Name, proposal.Plan.Name)
 	require.Equal(t, p.Height, proposal.Plan.Height)
 	require.Equal(t, p.Info, proposal.Plan.Info)
+	require.Equal(t, []string{"first", "second"}, p.DependsOn)
+	require.True(t, p.Time.IsZero())
+}
+
+func TestParseTimePlan(t *testing.T) {
+	fs := NewCmdSubmitUpgradeProposal(addresscodec.NewBech32Codec("cosmos")).Flags()
+
+	fs.Set(FlagUpgradeTime, "2023-05-01T12:00:00Z")
+
+	p, err := parsePlan(fs, "plan name")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC), p.Time)
+	require.Equal(t, int64(0), p.Height)
+
+	fs.Set(FlagUpgradeTime, "tomorrow")
+	_, err = parsePlan(fs, "plan name")
+	require.ErrorContains(t, err, "invalid upgrade time")
 }
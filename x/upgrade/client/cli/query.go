@@ -21,8 +21,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCurrentPlanCmd(),
+		GetPendingPlansCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetUpgradeReadinessCmd(),
+		GetParamsCmd(),
 	)
 
 	return cmd
@@ -61,6 +64,90 @@ func GetCurrentPlanCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPendingPlansCmd returns the query pending upgrade plans command.
+func GetPendingPlansCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-plans",
+		Short: "get the pending upgrade plans",
+		Long:  "Gets the currently scheduled upgrade plan, if one exists, followed by the queued upgrade plans",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingPlans(cmd.Context(), &types.QueryPendingPlansRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetUpgradeReadinessCmd returns the readiness signals for a pending upgrade.
+func GetUpgradeReadinessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "readiness [upgrade-name]",
+		Short: "get the readiness of the validators for a pending upgrade",
+		Long: "Gets the readiness signals sent by the validators for a pending upgrade,\n" +
+			"along with the fraction of the bonded voting power they represent.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UpgradeReadiness(cmd.Context(), &types.QueryUpgradeReadinessRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetParamsCmd returns the upgrade module parameters.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "get the upgrade module parameters",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAppliedPlanCmd returns information about the block at which a completed
 // upgrade was applied.
 func GetAppliedPlanCmd() *cobra.Command {
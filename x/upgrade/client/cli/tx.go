@@ -20,7 +20,11 @@ import (
 
 const (
 	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeTime   = "upgrade-time"
 	FlagUpgradeInfo   = "upgrade-info"
+	FlagDependsOn     = "depends-on"
+	FlagQueue         = "queue"
+	FlagUpgradeName   = "upgrade-name"
 	FlagNoValidate    = "no-validate"
 	FlagDaemonName    = "daemon-name"
 	FlagAuthority     = "authority"
@@ -36,6 +40,7 @@ func GetTxCmd(ac addresscodec.Codec) *cobra.Command {
 	cmd.AddCommand(
 		NewCmdSubmitUpgradeProposal(ac),
 		NewCmdSubmitCancelUpgradeProposal(ac),
+		NewCmdSignalUpgradeReady(),
 	)
 
 	return cmd
@@ -44,12 +49,14 @@ func GetTxCmd(ac addresscodec.Codec) *cobra.Command {
 // NewCmdSubmitUpgradeProposal implements a command handler for submitting a software upgrade proposal transaction.
 func NewCmdSubmitUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "software-upgrade [name] (--upgrade-height [height]) (--upgrade-info [info]) [flags]",
+		Use:   "software-upgrade [name] (--upgrade-height [height] | --upgrade-time [time]) (--upgrade-info [info]) [flags]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Submit a software upgrade proposal",
 		Long: "Submit a software upgrade along with an initial deposit.\n" +
-			"Please specify a unique name and height for the upgrade to take effect.\n" +
-			"You may include info to reference a binary download link, in a format compatible with: https://docs.cosmos.network/main/tooling/cosmovisor",
+			"Please specify a unique name and either a height or a block time for the upgrade to take effect.\n" +
+			"You may include info to reference a binary download link, in a format compatible with: https://docs.cosmos.network/main/tooling/cosmovisor\n" +
+			"With --queue, the upgrade is queued after the pending upgrades instead of replacing the current plan, " +
+			"and becomes the current plan once the upgrades listed with --depends-on are applied.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -96,13 +103,19 @@ func NewCmdSubmitUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 				authority = sdk.AccAddress(address.Module("gov")).String()
 			}
 
-			if err := proposal.SetMsgs([]sdk.Msg{
-				&types.MsgSoftwareUpgrade{
+			var msg sdk.Msg = &types.MsgSoftwareUpgrade{
+				Authority: authority,
+				Plan:      p,
+			}
+			if queue, _ := cmd.Flags().GetBool(FlagQueue); queue {
+				msg = &types.MsgQueueUpgrade{
 					Authority: authority,
 					Plan:      p,
-				},
-			}); err != nil {
-				return fmt.Errorf("failed to create cancel upgrade message: %w", err)
+				}
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create software upgrade message: %w", err)
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
@@ -110,7 +123,10 @@ func NewCmdSubmitUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen")
+	cmd.Flags().String(FlagUpgradeTime, "", "The block time (RFC3339) at or after which the upgrade must happen, instead of a height")
 	cmd.Flags().String(FlagUpgradeInfo, "", "Info for the upgrade plan such as new version download urls, etc.")
+	cmd.Flags().StringSlice(FlagDependsOn, nil, "The names of the upgrades which must be applied before this upgrade")
+	cmd.Flags().Bool(FlagQueue, false, "Queue the upgrade after the pending upgrades instead of replacing the current plan")
 	cmd.Flags().Bool(FlagNoValidate, false, "Skip validation of the upgrade info (dangerous!)")
 	cmd.Flags().String(FlagDaemonName, getDefaultDaemonName(), "The name of the executable being upgraded (for upgrade-info validation). Default is the DAEMON_NAME env var if set, or else this executable")
 	cmd.Flags().String(FlagAuthority, "", "The address of the upgrade module authority (defaults to gov)")
@@ -129,7 +145,9 @@ func NewCmdSubmitCancelUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 		Use:   "cancel-software-upgrade [flags]",
 		Args:  cobra.ExactArgs(0),
 		Short: "Cancel the current software upgrade proposal",
-		Long:  "Cancel a software upgrade along with an initial deposit.",
+		Long: "Cancel a software upgrade along with an initial deposit.\n" +
+			"With --upgrade-name, the pending upgrade with that name is cancelled, either the current plan or a queued plan, " +
+			"along with the queued upgrades depending on it.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -150,9 +168,12 @@ func NewCmdSubmitCancelUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 				authority = sdk.AccAddress(address.Module("gov")).String()
 			}
 
+			name, _ := cmd.Flags().GetString(FlagUpgradeName)
+
 			if err := proposal.SetMsgs([]sdk.Msg{
 				&types.MsgCancelUpgrade{
 					Authority: authority,
+					Name:      name,
 				},
 			}); err != nil {
 				return fmt.Errorf("failed to create cancel upgrade message: %w", err)
@@ -163,6 +184,7 @@ func NewCmdSubmitCancelUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(FlagAuthority, "", "The address of the upgrade module authority (defaults to gov)")
+	cmd.Flags().String(FlagUpgradeName, "", "The name of the pending upgrade to cancel (defaults to the current plan)")
 
 	// add common proposal flags
 	flags.AddTxFlagsToCmd(cmd)
@@ -172,6 +194,35 @@ func NewCmdSubmitCancelUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 	return cmd
 }
 
+// NewCmdSignalUpgradeReady implements a command handler for signaling that the binary handling a pending upgrade is installed.
+func NewCmdSignalUpgradeReady() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-ready [name] [version] --from [validator]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Signal that the binary handling a pending upgrade is installed",
+		Long: "Signal, as a validator operator, that the binary version handling the pending upgrade with the given name is installed.\n" +
+			"The signals are weighted by the voting power of the validators, and a plan may be postponed when too few validators are ready for it.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSignalUpgradeReady{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				Name:             args[0],
+				Version:          args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getDefaultDaemonName gets the default name to use for the daemon.
 // If a DAEMON_NAME env var is set, that is used.
 // Otherwise, the last part of the currently running executable is used.
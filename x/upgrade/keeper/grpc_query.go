@@ -4,6 +4,7 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -72,6 +73,52 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 	}, nil
 }
 
+// PendingPlans implements the Query/PendingPlans gRPC method
+func (k Keeper) PendingPlans(c context.Context, req *types.QueryPendingPlansRequest) (*types.QueryPendingPlansResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var plans []types.Plan
+	if plan, found := k.GetUpgradePlan(ctx); found {
+		plans = append(plans, plan)
+	}
+
+	return &types.QueryPendingPlansResponse{Plans: append(plans, k.GetQueuedPlans(ctx)...)}, nil
+}
+
+// UpgradeReadiness implements the Query/UpgradeReadiness gRPC method
+func (k Keeper) UpgradeReadiness(c context.Context, req *types.QueryUpgradeReadinessRequest) (*types.QueryUpgradeReadinessResponse, error) {
+	if req == nil || req.Name == "" {
+		return nil, errorsmod.Wrap(errors.ErrInvalidRequest, "name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	signals, readyPower, totalPower, err := k.GetReadiness(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	readyFraction := math.LegacyZeroDec()
+	if totalPower > 0 {
+		readyFraction = math.LegacyNewDec(readyPower).QuoInt64(totalPower)
+	}
+
+	return &types.QueryUpgradeReadinessResponse{
+		Signals:       signals,
+		ReadyPower:    readyPower,
+		TotalPower:    totalPower,
+		ReadyFraction: readyFraction,
+		Postponements: k.GetPostponements(ctx, req.Name),
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
 // Authority implements the Query/Authority gRPC method, returning the account capable of performing upgrades
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
@@ -38,7 +38,7 @@ func (suite *UpgradeTestSuite) SetupTest() {
 
 	skipUpgradeHeights := make(map[int64]bool)
 
-	suite.upgradeKeeper = keeper.NewKeeper(skipUpgradeHeights, key, suite.encCfg.Codec, "", nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	suite.upgradeKeeper = keeper.NewKeeper(skipUpgradeHeights, key, suite.encCfg.Codec, "", nil, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	suite.upgradeKeeper.SetModuleVersionMap(suite.ctx, module.VersionMap{
 		"bank": 0,
 	})
@@ -99,6 +99,34 @@ func (suite *UpgradeTestSuite) TestQueryCurrentPlan() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestQueryPendingPlans() {
+	res, err := suite.queryClient.PendingPlans(context.Background(), &types.QueryPendingPlansRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Plans)
+
+	current := types.Plan{Name: "current", Height: 5}
+	queued := types.Plan{Name: "queued", Height: 10, DependsOn: []string{"current"}}
+	suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, current))
+	suite.Require().NoError(suite.upgradeKeeper.QueueUpgrade(suite.ctx, queued))
+
+	res, err = suite.queryClient.PendingPlans(context.Background(), &types.QueryPendingPlansRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Plan{current, queued}, res.Plans)
+}
+
+func (suite *UpgradeTestSuite) TestQueryParams() {
+	res, err := suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+
+	params := types.NewParams(sdk.NewDecWithPrec(67, 2), 100, 50, 2)
+	suite.upgradeKeeper.SetParams(suite.ctx, params)
+
+	res, err = suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
+
 func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 	var (
 		req       *types.QueryAppliedPlanRequest
@@ -38,6 +38,7 @@ type Keeper struct {
 	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
 	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
+	stakingKeeper      types.StakingKeeper             // used to weigh the readiness signals by voting power, may be nil
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
 }
@@ -48,7 +49,9 @@ type Keeper struct {
 // cdc - the app-wide binary codec
 // homePath - root directory of the application's config
 // vs - the interface implemented by baseapp which allows setting baseapp's protocol version field
-func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, homePath string, vs xp.ProtocolVersionSetter, authority string) *Keeper {
+// sk - the staking keeper used to weigh the readiness signals by voting power, nil disables readiness signaling
+// authority - the address capable of executing and canceling an upgrade
+func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, homePath string, vs xp.ProtocolVersionSetter, sk types.StakingKeeper, authority string) *Keeper {
 	k := &Keeper{
 		homePath:           homePath,
 		skipUpgradeHeights: skipUpgradeHeights,
@@ -56,6 +59,7 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey,
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionSetter:      vs,
+		stakingKeeper:      sk,
 		authority:          authority,
 	}
 
@@ -197,7 +201,8 @@ func (k Keeper) getModuleVersion(ctx sdk.Context, name string) (uint64, bool) {
 }
 
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
-// If there is another Plan already scheduled, it will cancel and overwrite it.
+// If there is another Plan already scheduled, it will cancel and overwrite it,
+// along with the queued plans depending on it.
 // ScheduleUpgrade will also write the upgraded IBC ClientState to the upgraded client
 // path if it is specified in the plan.
 func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
@@ -207,7 +212,7 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 
 	// NOTE: allow for the possibility of chains to schedule upgrades in begin block of the same block
 	// as a strategy for emergency hard fork recoveries
-	if plan.Height < ctx.BlockHeight() {
+	if plan.IsPast(ctx) {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "upgrade cannot be scheduled in the past")
 	}
 
@@ -215,20 +220,41 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
-	store := ctx.KVStore(k.storeKey)
+	if _, _, found := k.getQueuedPlan(ctx, plan.Name); found {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s is already queued", plan.Name)
+	}
+
+	for _, dep := range plan.DependsOn {
+		if k.GetDoneHeight(ctx, dep) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade %s must be applied before %s, queue the upgrade instead", dep, plan.Name)
+		}
+	}
 
 	// clear any old IBC state stored by previous plan
 	oldPlan, found := k.GetUpgradePlan(ctx)
 	if found {
 		k.ClearIBCState(ctx, oldPlan.Height)
+		if oldPlan.Name != plan.Name {
+			k.clearReadiness(ctx, oldPlan.Name)
+			k.cancelQueuedDependents(ctx, oldPlan.Name)
+		}
 	}
 
+	k.setUpgradePlan(ctx, plan)
+	ctx.KVStore(k.storeKey).Delete(types.PromotedPlanKey())
+
+	return nil
+}
+
+// setUpgradePlan sets the current plan without any validation.
+func (k Keeper) setUpgradePlan(ctx sdk.Context, plan types.Plan) {
+	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&plan)
 	store.Set(types.PlanKey(), bz)
 
-	telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, []metrics.Label{telemetry.NewLabel("upgrade_height", strconv.FormatInt(plan.Height, 10))})
-
-	return nil
+	if !plan.IsTimeBased() {
+		telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, []metrics.Label{telemetry.NewLabel("upgrade_height", strconv.FormatInt(plan.Height, 10))})
+	}
 }
 
 // SetUpgradedClient sets the expected upgraded client for the next version of this chain at the last height the current chain will commit.
@@ -319,16 +345,18 @@ func (k Keeper) ClearIBCState(ctx sdk.Context, lastHeight int64) {
 	store.Delete(types.UpgradedConsStateKey(lastHeight))
 }
 
-// ClearUpgradePlan clears any schedule upgrade and associated IBC states.
+// ClearUpgradePlan clears any schedule upgrade and associated IBC states and readiness signals.
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
 	// clear IBC states everytime upgrade plan is removed
 	oldPlan, found := k.GetUpgradePlan(ctx)
 	if found {
 		k.ClearIBCState(ctx, oldPlan.Height)
+		k.clearReadiness(ctx, oldPlan.Name)
 	}
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.PlanKey())
+	store.Delete(types.PromotedPlanKey())
 }
 
 // Logger returns a module-specific logger.
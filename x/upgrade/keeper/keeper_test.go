@@ -30,6 +30,7 @@ type KeeperTestSuite struct {
 	key           *storetypes.KVStoreKey
 	baseApp       *baseapp.BaseApp
 	upgradeKeeper *keeper.Keeper
+	stakingKeeper *mockStakingKeeper
 	homeDir       string
 	ctx           sdk.Context
 	msgSrvr       types.MsgServer
@@ -53,7 +54,8 @@ func (s *KeeperTestSuite) SetupTest() {
 	skipUpgradeHeights := make(map[int64]bool)
 
 	homeDir := filepath.Join(s.T().TempDir(), "x_upgrade_keeper_test")
-	s.upgradeKeeper = keeper.NewKeeper(skipUpgradeHeights, key, s.encCfg.Codec, homeDir, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	s.stakingKeeper = &mockStakingKeeper{powers: map[string]int64{}}
+	s.upgradeKeeper = keeper.NewKeeper(skipUpgradeHeights, key, s.encCfg.Codec, homeDir, nil, s.stakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	s.upgradeKeeper.SetVersionSetter(s.baseApp)
 
 	vs := s.upgradeKeeper.GetVersionSetter()
@@ -180,6 +182,85 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueuedPlanPromotion() {
+	s.upgradeKeeper.SetUpgradeHandler("first", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+
+	first := types.Plan{Name: "first", Height: 20}
+	second := types.Plan{Name: "second", Height: 30, DependsOn: []string{"first"}}
+	third := types.Plan{Name: "third", Time: s.ctx.BlockTime().Add(time.Hour)}
+
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, first))
+	s.Require().NoError(s.upgradeKeeper.QueueUpgrade(s.ctx, second))
+	s.Require().NoError(s.upgradeKeeper.QueueUpgrade(s.ctx, third))
+	s.Require().Equal([]types.Plan{second, third}, s.upgradeKeeper.GetQueuedPlans(s.ctx))
+
+	// nothing is promoted while there is a current plan
+	s.Require().False(s.upgradeKeeper.PromoteQueuedPlan(s.ctx))
+
+	// a dependent upgrade cannot be scheduled before its dependencies are applied
+	err := s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "fourth", Height: 40, DependsOn: []string{"second"}})
+	s.Require().ErrorContains(err, "upgrade second must be applied before fourth")
+
+	// once the first upgrade is applied, the second one, queued before the
+	// third one, is promoted in the next block
+	s.upgradeKeeper.ApplyUpgrade(s.ctx.WithBlockHeight(first.Height), first)
+	_, found := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().False(found)
+	s.Require().False(s.upgradeKeeper.IsPromotedPlan(s.ctx, first.Name))
+
+	s.Require().True(s.upgradeKeeper.PromoteQueuedPlan(s.ctx))
+	plan, found := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().True(found)
+	s.Require().Equal(second, plan)
+	s.Require().True(s.upgradeKeeper.IsPromotedPlan(s.ctx, second.Name))
+	s.Require().Equal([]types.Plan{third}, s.upgradeKeeper.GetQueuedPlans(s.ctx))
+
+	// once the second upgrade is cancelled, the third one is promoted
+	s.Require().NoError(s.upgradeKeeper.CancelUpgrade(s.ctx, second.Name))
+	s.Require().False(s.upgradeKeeper.IsPromotedPlan(s.ctx, second.Name))
+	s.Require().True(s.upgradeKeeper.PromoteQueuedPlan(s.ctx))
+	plan, found = s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().True(found)
+	s.Require().Equal(third, plan)
+	s.Require().Empty(s.upgradeKeeper.GetQueuedPlans(s.ctx))
+
+	// a scheduled upgrade is not a promoted one
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, third))
+	s.Require().False(s.upgradeKeeper.IsPromotedPlan(s.ctx, third.Name))
+}
+
+func (s *KeeperTestSuite) TestPromoteDueQueuedPlan() {
+	// without a current plan, queued plans are only promoted by the BeginBlocker
+	due := types.Plan{Name: "due", Height: s.ctx.BlockHeight() + 1}
+	dependent := types.Plan{Name: "dependent", Height: s.ctx.BlockHeight() + 10, DependsOn: []string{"due"}}
+	other := types.Plan{Name: "other", Height: s.ctx.BlockHeight() + 20}
+	s.Require().NoError(s.upgradeKeeper.QueueUpgrade(s.ctx, due))
+	s.Require().NoError(s.upgradeKeeper.QueueUpgrade(s.ctx, dependent))
+	s.Require().NoError(s.upgradeKeeper.QueueUpgrade(s.ctx, other))
+
+	// the plan due when promoted is dropped along with its dependents
+	ctx := s.ctx.WithBlockHeight(due.Height)
+	s.Require().True(s.upgradeKeeper.PromoteQueuedPlan(ctx))
+	plan, found := s.upgradeKeeper.GetUpgradePlan(ctx)
+	s.Require().True(found)
+	s.Require().Equal(other, plan)
+	s.Require().Empty(s.upgradeKeeper.GetQueuedPlans(ctx))
+}
+
+func (s *KeeperTestSuite) TestScheduleUpgradeCancelsQueuedDependents() {
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "first", Height: 20}))
+	s.Require().NoError(s.upgradeKeeper.QueueUpgrade(s.ctx, types.Plan{Name: "second", Height: 30, DependsOn: []string{"first"}}))
+	s.Require().NoError(s.upgradeKeeper.QueueUpgrade(s.ctx, types.Plan{Name: "third", Height: 40, DependsOn: []string{"second"}}))
+	s.Require().NoError(s.upgradeKeeper.QueueUpgrade(s.ctx, types.Plan{Name: "other", Height: 50}))
+
+	// overwriting the current plan cancels the queued plans depending on it,
+	// directly or not
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "replacement", Height: 25}))
+	s.Require().Equal([]types.Plan{{Name: "other", Height: 50}}, s.upgradeKeeper.GetQueuedPlans(s.ctx))
+}
+
 func (s *KeeperTestSuite) TestSetUpgradedClient() {
 	cs := []byte("IBC client state")
 
@@ -228,7 +309,7 @@ func (s *KeeperTestSuite) TestIsSkipHeight() {
 	ok := s.upgradeKeeper.IsSkipHeight(11)
 	s.Require().False(ok)
 	skip := map[int64]bool{skipOne: true}
-	upgradeKeeper := keeper.NewKeeper(skip, s.key, s.encCfg.Codec, s.T().TempDir(), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	upgradeKeeper := keeper.NewKeeper(skip, s.key, s.encCfg.Codec, s.T().TempDir(), nil, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	upgradeKeeper.SetVersionSetter(s.baseApp)
 	s.Require().True(upgradeKeeper.IsSkipHeight(9))
 	s.Require().False(upgradeKeeper.IsSkipHeight(10))
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -50,7 +51,66 @@ func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrad
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	k.ClearUpgradePlan(sdkCtx)
+	if err := k.Keeper.CancelUpgrade(sdkCtx, msg.Name); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// QueueUpgrade implements the Msg/QueueUpgrade Msg service.
+func (k msgServer) QueueUpgrade(goCtx context.Context, msg *types.MsgQueueUpgrade) (*types.MsgQueueUpgradeResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(gov.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Plan.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "plan")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.QueueUpgrade(ctx, msg.Plan); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgQueueUpgradeResponse{}, nil
+}
+
+// SignalUpgradeReady implements the Msg/SignalUpgradeReady Msg service.
+func (k msgServer) SignalUpgradeReady(goCtx context.Context, msg *types.MsgSignalUpgradeReady) (*types.MsgSignalUpgradeReadyResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.Name == "" {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "name cannot be empty")
+	}
+
+	if msg.Version == "" {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "version cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SignalUpgradeReady(ctx, valAddr, msg.Name, msg.Version); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignalUpgradeReadyResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(gov.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
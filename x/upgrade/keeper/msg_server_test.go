@@ -136,3 +136,222 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueueUpgrade() {
+	govAccAddr := sdk.AccAddress(address.Module("gov")).String()
+
+	first := types.Plan{Name: "first", Height: 123450000}
+	second := types.Plan{Name: "second", Height: 123460000, DependsOn: []string{"first"}}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgQueueUpgrade
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unauthorized authority address",
+			&types.MsgQueueUpgrade{
+				Authority: s.addrs[0].String(),
+				Plan:      first,
+			},
+			true,
+			"expected gov account as only signer for proposal message",
+		},
+		{
+			"invalid plan",
+			&types.MsgQueueUpgrade{
+				Authority: govAccAddr,
+				Plan:      types.Plan{Name: "no-due-at"},
+			},
+			true,
+			"height must be greater than 0 or time must be set",
+		},
+		{
+			"unknown dependency",
+			&types.MsgQueueUpgrade{
+				Authority: govAccAddr,
+				Plan:      types.Plan{Name: "orphan", Height: 123450000, DependsOn: []string{"unknown"}},
+			},
+			true,
+			"depends on unknown upgrade unknown",
+		},
+		{
+			"first upgrade queued successfully",
+			&types.MsgQueueUpgrade{
+				Authority: govAccAddr,
+				Plan:      first,
+			},
+			false,
+			"",
+		},
+		{
+			"dependent upgrade queued successfully",
+			&types.MsgQueueUpgrade{
+				Authority: govAccAddr,
+				Plan:      second,
+			},
+			false,
+			"",
+		},
+		{
+			"upgrade already pending",
+			&types.MsgQueueUpgrade{
+				Authority: govAccAddr,
+				Plan:      second,
+			},
+			true,
+			"upgrade with name second is already pending",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.QueueUpgrade(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// the upgrades stay queued until the start of the next block, where the
+	// first upgrade is promoted to the current plan while the second one waits
+	// for it to be applied
+	_, found := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().False(found)
+	s.Require().Equal([]types.Plan{first, second}, s.upgradeKeeper.GetQueuedPlans(s.ctx))
+
+	s.Require().True(s.upgradeKeeper.PromoteQueuedPlan(s.ctx))
+	plan, found := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().True(found)
+	s.Require().Equal(first, plan)
+	s.Require().Equal([]types.Plan{second}, s.upgradeKeeper.GetQueuedPlans(s.ctx))
+
+	// cancelling the first upgrade cancels the second one as well
+	_, err := s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: govAccAddr, Name: first.Name})
+	s.Require().NoError(err)
+	_, found = s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().False(found)
+	s.Require().Empty(s.upgradeKeeper.GetQueuedPlans(s.ctx))
+
+	_, err = s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: govAccAddr, Name: first.Name})
+	s.Require().ErrorContains(err, "upgrade first is not pending")
+}
+
+func (s *KeeperTestSuite) TestSignalUpgradeReady() {
+	govAccAddr := sdk.AccAddress(address.Module("gov")).String()
+	valAddr := sdk.ValAddress(s.addrs[0])
+	s.stakingKeeper.setPower(valAddr, 10)
+
+	_, err := s.msgSrvr.SoftwareUpgrade(s.ctx, &types.MsgSoftwareUpgrade{
+		Authority: govAccAddr,
+		Plan:      types.Plan{Name: "v2", Height: 123450000},
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgSignalUpgradeReady
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"invalid validator address",
+			&types.MsgSignalUpgradeReady{ValidatorAddress: "invalid", Name: "v2", Version: "v2.0.0"},
+			true,
+			"invalid validator address",
+		},
+		{
+			"empty name",
+			&types.MsgSignalUpgradeReady{ValidatorAddress: valAddr.String(), Version: "v2.0.0"},
+			true,
+			"name cannot be empty",
+		},
+		{
+			"empty version",
+			&types.MsgSignalUpgradeReady{ValidatorAddress: valAddr.String(), Name: "v2"},
+			true,
+			"version cannot be empty",
+		},
+		{
+			"unknown validator",
+			&types.MsgSignalUpgradeReady{ValidatorAddress: sdk.ValAddress([]byte("unknown_validator___")).String(), Name: "v2", Version: "v2.0.0"},
+			true,
+			"validator does not exist",
+		},
+		{
+			"upgrade not pending",
+			&types.MsgSignalUpgradeReady{ValidatorAddress: valAddr.String(), Name: "v3", Version: "v3.0.0"},
+			true,
+			"upgrade v3 is not pending",
+		},
+		{
+			"successful signal",
+			&types.MsgSignalUpgradeReady{ValidatorAddress: valAddr.String(), Name: "v2", Version: "v2.0.0"},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.SignalUpgradeReady(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().Equal(
+		[]types.ReadinessSignal{{ValidatorAddress: valAddr.String(), Version: "v2.0.0", Height: s.ctx.BlockHeight()}},
+		s.upgradeKeeper.GetReadinessSignals(s.ctx, "v2"),
+	)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	govAccAddr := sdk.AccAddress(address.Module("gov")).String()
+	params := types.NewParams(sdk.NewDecWithPrec(67, 2), 100, 50, 2)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateParams
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unauthorized authority address",
+			&types.MsgUpdateParams{Authority: s.addrs[0].String(), Params: params},
+			true,
+			"expected gov account as only signer for proposal message",
+		},
+		{
+			"invalid params",
+			&types.MsgUpdateParams{Authority: govAccAddr, Params: types.NewParams(sdk.NewDecWithPrec(67, 2), 100, 0, 2)},
+			true,
+			"postpone blocks must be positive",
+		},
+		{
+			"successful update",
+			&types.MsgUpdateParams{Authority: govAccAddr, Params: params},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.UpdateParams(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().Equal(params, s.upgradeKeeper.GetParams(s.ctx))
+}
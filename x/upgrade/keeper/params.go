@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the current x/upgrade module parameters, or the default
+// parameters if they were never set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey())
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the x/upgrade module parameters.
+// CONTRACT: This method performs no validation of the parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey(), k.cdc.MustMarshal(&params))
+}
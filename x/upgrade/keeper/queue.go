@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// QueueUpgrade queues an upgrade based on the specified plan, after the
+// current plan and the other queued plans. Unlike ScheduleUpgrade, it does not
+// overwrite the current plan. The plan is promoted to the current plan by the
+// BeginBlocker, see PromoteQueuedPlan. The upgrades it depends on must either
+// be applied already or be pending.
+func (k Keeper) QueueUpgrade(ctx sdk.Context, plan types.Plan) error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if plan.IsPast(ctx) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "upgrade cannot be queued in the past")
+	}
+
+	if k.GetDoneHeight(ctx, plan.Name) != 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
+	}
+
+	if k.isPending(ctx, plan.Name) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s is already pending", plan.Name)
+	}
+
+	// the upgrades depended on being applied or pending, the dependencies
+	// cannot form a cycle
+	for _, dep := range plan.DependsOn {
+		if k.GetDoneHeight(ctx, dep) == 0 && !k.isPending(ctx, dep) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade %s depends on unknown upgrade %s", plan.Name, dep)
+		}
+	}
+
+	sequence := k.getQueuedPlanSequence(ctx) + 1
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.QueuedPlanKey(sequence), k.cdc.MustMarshal(&plan))
+	k.setQueuedPlanSequence(ctx, sequence)
+
+	return nil
+}
+
+// CancelUpgrade cancels the pending upgrade with the given name, either the
+// current plan or a queued plan, along with the queued plans depending on it.
+// An empty name cancels the current plan, if any.
+func (k Keeper) CancelUpgrade(ctx sdk.Context, name string) error {
+	plan, found := k.GetUpgradePlan(ctx)
+	switch {
+	case found && (name == "" || plan.Name == name):
+		k.ClearUpgradePlan(ctx)
+		k.cancelQueuedDependents(ctx, plan.Name)
+
+	case name == "":
+		return nil
+
+	default:
+		sequence, _, found := k.getQueuedPlan(ctx, name)
+		if !found {
+			return errorsmod.Wrapf(sdkerrors.ErrNotFound, "upgrade %s is not pending", name)
+		}
+
+		ctx.KVStore(k.storeKey).Delete(types.QueuedPlanKey(sequence))
+		k.clearReadiness(ctx, name)
+		k.cancelQueuedDependents(ctx, name)
+	}
+
+	return nil
+}
+
+// PromoteQueuedPlan makes the first queued plan whose dependencies are all
+// applied the current plan, if there is no current plan. A plan waiting for
+// its dependencies thus does not hold back the plans queued after it. It is
+// called at the start of each block, so a plan is promoted at the earliest in
+// the block following the application of the upgrades it depends on.
+//
+// A queued plan which is already due when it would be promoted is dropped,
+// along with the queued plans depending on it, instead of being executed
+// without notice. It returns true if a plan was promoted.
+func (k Keeper) PromoteQueuedPlan(ctx sdk.Context) bool {
+	if _, found := k.GetUpgradePlan(ctx); found {
+		return false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for {
+		sequence, next, ready := k.nextReadyQueuedPlan(ctx)
+		if !ready {
+			return false
+		}
+
+		store.Delete(types.QueuedPlanKey(sequence))
+
+		if next.ShouldExecute(ctx) {
+			k.Logger(ctx).Error("dropped queued upgrade plan; already due when promoted", "name", next.Name, "due_at", next.DueAt())
+			k.clearReadiness(ctx, next.Name)
+			k.cancelQueuedDependents(ctx, next.Name)
+			continue
+		}
+
+		k.setUpgradePlan(ctx, next)
+		store.Set(types.PromotedPlanKey(), []byte(next.Name))
+
+		k.Logger(ctx).Info("promoted queued upgrade plan", "name", next.Name, "due_at", next.DueAt())
+
+		return true
+	}
+}
+
+// IsPromotedPlan returns true if the current plan has the given name and was
+// promoted from the queue.
+func (k Keeper) IsPromotedPlan(ctx sdk.Context, name string) bool {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found || plan.Name != name {
+		return false
+	}
+
+	return string(ctx.KVStore(k.storeKey).Get(types.PromotedPlanKey())) == name
+}
+
+// nextReadyQueuedPlan returns the first queued plan whose dependencies are all
+// applied, along with its queueing sequence.
+func (k Keeper) nextReadyQueuedPlan(ctx sdk.Context) (sequence uint64, next types.Plan, ready bool) {
+	k.iterateQueuedPlans(ctx, func(seq uint64, plan types.Plan) (stop bool) {
+		for _, dep := range plan.DependsOn {
+			if k.GetDoneHeight(ctx, dep) == 0 {
+				return false
+			}
+		}
+
+		sequence, next, ready = seq, plan, true
+		return true
+	})
+
+	return sequence, next, ready
+}
+
+// GetQueuedPlans returns the queued plans in the order they were queued.
+func (k Keeper) GetQueuedPlans(ctx sdk.Context) []types.Plan {
+	plans := []types.Plan{}
+	k.iterateQueuedPlans(ctx, func(_ uint64, plan types.Plan) (stop bool) {
+		plans = append(plans, plan)
+		return false
+	})
+
+	return plans
+}
+
+// iterateQueuedPlans iterates over the queued plans in the order they were
+// queued, until the callback returns true.
+func (k Keeper) iterateQueuedPlans(ctx sdk.Context, cb func(sequence uint64, plan types.Plan) (stop bool)) {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.QueuedPlanByte})
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var plan types.Plan
+		k.cdc.MustUnmarshal(iter.Value(), &plan)
+
+		if cb(binary.BigEndian.Uint64(iter.Key()[1:]), plan) {
+			break
+		}
+	}
+}
+
+// getQueuedPlan returns the queued plan with the given name and its queueing sequence.
+func (k Keeper) getQueuedPlan(ctx sdk.Context, name string) (sequence uint64, plan types.Plan, found bool) {
+	k.iterateQueuedPlans(ctx, func(seq uint64, p types.Plan) (stop bool) {
+		if p.Name == name {
+			sequence, plan, found = seq, p, true
+			return true
+		}
+		return false
+	})
+
+	return sequence, plan, found
+}
+
+// isPending returns true if the upgrade with the given name is either the current plan or queued.
+func (k Keeper) isPending(ctx sdk.Context, name string) bool {
+	if plan, found := k.GetUpgradePlan(ctx); found && plan.Name == name {
+		return true
+	}
+
+	_, _, found := k.getQueuedPlan(ctx, name)
+	return found
+}
+
+// cancelQueuedDependents removes the queued plans depending, directly or not,
+// on the upgrade with the given name.
+func (k Keeper) cancelQueuedDependents(ctx sdk.Context, name string) {
+	var (
+		sequences []uint64
+		names     []string
+	)
+	k.iterateQueuedPlans(ctx, func(seq uint64, plan types.Plan) (stop bool) {
+		for _, dep := range plan.DependsOn {
+			if dep == name {
+				sequences = append(sequences, seq)
+				names = append(names, plan.Name)
+				break
+			}
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, seq := range sequences {
+		store.Delete(types.QueuedPlanKey(seq))
+	}
+
+	for _, dependent := range names {
+		k.clearReadiness(ctx, dependent)
+		k.cancelQueuedDependents(ctx, dependent)
+	}
+}
+
+// getQueuedPlanSequence returns the sequence of the last queued plan.
+func (k Keeper) getQueuedPlanSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.QueuedPlanSequenceKey())
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setQueuedPlanSequence sets the sequence of the last queued plan.
+func (k Keeper) setQueuedPlanSequence(ctx sdk.Context, sequence uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, sequence)
+	ctx.KVStore(k.storeKey).Set(types.QueuedPlanSequenceKey(), bz)
+}
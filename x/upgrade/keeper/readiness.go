@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SignalUpgradeReady records that the operator of the given validator
+// installed the given binary version handling the pending upgrade with the
+// given name. A new signal of the same validator for the same upgrade replaces
+// the previous one.
+func (k Keeper) SignalUpgradeReady(ctx sdk.Context, valAddr sdk.ValAddress, name, version string) error {
+	if k.stakingKeeper == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "readiness signaling requires a staking keeper")
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return errorsmod.Wrapf(stakingtypes.ErrNoValidatorFound, "validator %s", valAddr)
+	}
+
+	if !k.isPending(ctx, name) {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "upgrade %s is not pending", name)
+	}
+
+	signal := types.ReadinessSignal{
+		ValidatorAddress: valAddr.String(),
+		Version:          version,
+		Height:           ctx.BlockHeight(),
+	}
+	ctx.KVStore(k.storeKey).Set(types.ReadinessSignalKey(name, valAddr), k.cdc.MustMarshal(&signal))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSignalUpgradeReady,
+			sdk.NewAttribute(types.AttributeKeyValidator, signal.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyVersion, version),
+		),
+	)
+
+	return nil
+}
+
+// GetReadinessSignals returns the readiness signals for the upgrade with the
+// given name, ordered by validator address.
+func (k Keeper) GetReadinessSignals(ctx sdk.Context, name string) []types.ReadinessSignal {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReadinessSignalsPrefix(name))
+	defer iter.Close()
+
+	signals := []types.ReadinessSignal{}
+	for ; iter.Valid(); iter.Next() {
+		var signal types.ReadinessSignal
+		k.cdc.MustUnmarshal(iter.Value(), &signal)
+		signals = append(signals, signal)
+	}
+
+	return signals
+}
+
+// GetReadiness returns the readiness signals for the upgrade with the given
+// name, the last voting power of the validators which sent them and the last
+// total voting power.
+func (k Keeper) GetReadiness(ctx sdk.Context, name string) (signals []types.ReadinessSignal, readyPower, totalPower int64, err error) {
+	if k.stakingKeeper == nil {
+		return nil, 0, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "readiness signaling requires a staking keeper")
+	}
+
+	signals = k.GetReadinessSignals(ctx, name)
+	for _, signal := range signals {
+		valAddr, err := sdk.ValAddressFromBech32(signal.ValidatorAddress)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		readyPower += k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	}
+
+	return signals, readyPower, k.stakingKeeper.GetLastTotalPower(ctx).Int64(), nil
+}
+
+// PostponeUnreadyUpgrade postpones the current height-based plan by the
+// postpone blocks parameter if, readiness window blocks before its height,
+// the validators which signaled their readiness for it hold less than the
+// readiness threshold of the total voting power. The readiness is checked once
+// per plan height, in the first block within the readiness window, so that a
+// plan scheduled or promoted within the window is still checked. A plan is
+// postponed at most max postponements times. It returns true if the plan was
+// postponed.
+func (k Keeper) PostponeUnreadyUpgrade(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	if !params.PostponementsEnabled() || k.stakingKeeper == nil {
+		return false
+	}
+
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found || plan.IsTimeBased() || ctx.BlockHeight() < plan.Height-params.ReadinessWindow {
+		return false
+	}
+
+	if k.getReadinessCheckedHeight(ctx, plan.Name) == plan.Height {
+		return false
+	}
+	k.setReadinessCheckedHeight(ctx, plan.Name, plan.Height)
+
+	postponements := k.GetPostponements(ctx, plan.Name)
+	if postponements >= params.MaxPostponements {
+		return false
+	}
+
+	_, readyPower, totalPower, err := k.GetReadiness(ctx, plan.Name)
+	if err != nil || totalPower <= 0 {
+		return false
+	}
+
+	readyFraction := math.LegacyNewDec(readyPower).QuoInt64(totalPower)
+	if readyFraction.GTE(params.ReadinessThreshold) {
+		return false
+	}
+
+	oldHeight := plan.Height
+	plan.Height += params.PostponeBlocks
+
+	// the upgraded IBC state is stored by plan height
+	if bz, found := k.GetUpgradedClient(ctx, oldHeight); found {
+		_ = k.SetUpgradedClient(ctx, plan.Height, bz)
+	}
+	if bz, found := k.GetUpgradedConsensusState(ctx, oldHeight); found {
+		_ = k.SetUpgradedConsensusState(ctx, plan.Height, bz)
+	}
+	k.ClearIBCState(ctx, oldHeight)
+
+	k.setUpgradePlan(ctx, plan)
+	k.setPostponements(ctx, plan.Name, postponements+1)
+
+	k.Logger(ctx).Info(
+		"postponed upgrade plan because of a low readiness",
+		"name", plan.Name,
+		"ready_fraction", readyFraction,
+		"height", plan.Height,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePostponeUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(plan.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyReadyFraction, readyFraction.String()),
+		),
+	)
+
+	return true
+}
+
+// GetPostponements returns the number of times the upgrade with the given
+// name has been postponed.
+func (k Keeper) GetPostponements(ctx sdk.Context, name string) uint32 {
+	bz := ctx.KVStore(k.storeKey).Get(types.PostponementsKey(name))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(bz)
+}
+
+// setPostponements sets the number of times the upgrade with the given name
+// has been postponed.
+func (k Keeper) setPostponements(ctx sdk.Context, name string, postponements uint32) {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, postponements)
+	ctx.KVStore(k.storeKey).Set(types.PostponementsKey(name), bz)
+}
+
+// getReadinessCheckedHeight returns the plan height at which the readiness of
+// the upgrade with the given name was last checked, or zero.
+func (k Keeper) getReadinessCheckedHeight(ctx sdk.Context, name string) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ReadinessCheckedKey(name))
+	if bz == nil {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// setReadinessCheckedHeight sets the plan height at which the readiness of the
+// upgrade with the given name was last checked.
+func (k Keeper) setReadinessCheckedHeight(ctx sdk.Context, name string, height int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	ctx.KVStore(k.storeKey).Set(types.ReadinessCheckedKey(name), bz)
+}
+
+// clearReadiness removes the readiness signals, the postponement count and the
+// readiness check of the upgrade with the given name, once it is not pending
+// anymore.
+func (k Keeper) clearReadiness(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := storetypes.KVStorePrefixIterator(store, types.ReadinessSignalsPrefix(name))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	store.Delete(types.PostponementsKey(name))
+	store.Delete(types.ReadinessCheckedKey(name))
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// mockStakingKeeper is a staking keeper whose validators have fixed last powers.
+type mockStakingKeeper struct {
+	powers map[string]int64
+}
+
+var _ types.StakingKeeper = (*mockStakingKeeper)(nil)
+
+func (m *mockStakingKeeper) setPower(valAddr sdk.ValAddress, power int64) {
+	m.powers[valAddr.String()] = power
+}
+
+func (m *mockStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	if _, ok := m.powers[addr.String()]; !ok {
+		return stakingtypes.Validator{}, false
+	}
+
+	return stakingtypes.Validator{OperatorAddress: addr.String()}, true
+}
+
+func (m *mockStakingKeeper) GetLastValidatorPower(_ sdk.Context, operator sdk.ValAddress) int64 {
+	return m.powers[operator.String()]
+}
+
+func (m *mockStakingKeeper) GetLastTotalPower(_ sdk.Context) math.Int {
+	var total int64
+	for _, power := range m.powers {
+		total += power
+	}
+
+	return math.NewInt(total)
+}
+
+func (s *KeeperTestSuite) TestUpgradeReadiness() {
+	ready, unready := sdk.ValAddress([]byte("ready_validator_____")), sdk.ValAddress([]byte("unready_validator___"))
+	s.stakingKeeper.setPower(ready, 30)
+	s.stakingKeeper.setPower(unready, 70)
+
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "v2", Height: 100}))
+	s.Require().NoError(s.upgradeKeeper.SignalUpgradeReady(s.ctx, ready, "v2", "v2.0.0-rc1"))
+	s.Require().NoError(s.upgradeKeeper.SignalUpgradeReady(s.ctx, ready, "v2", "v2.0.0"))
+
+	res, err := s.upgradeKeeper.UpgradeReadiness(s.ctx, &types.QueryUpgradeReadinessRequest{Name: "v2"})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ReadinessSignal{{ValidatorAddress: ready.String(), Version: "v2.0.0", Height: 10}}, res.Signals)
+	s.Require().Equal(int64(30), res.ReadyPower)
+	s.Require().Equal(int64(100), res.TotalPower)
+	s.Require().Equal(math.LegacyNewDecWithPrec(3, 1), res.ReadyFraction)
+
+	_, err = s.upgradeKeeper.UpgradeReadiness(s.ctx, &types.QueryUpgradeReadinessRequest{})
+	s.Require().ErrorContains(err, "name cannot be empty")
+
+	// the signals are removed along with the plan
+	s.Require().NoError(s.upgradeKeeper.CancelUpgrade(s.ctx, "v2"))
+	s.Require().Empty(s.upgradeKeeper.GetReadinessSignals(s.ctx, "v2"))
+}
+
+func (s *KeeperTestSuite) TestPostponeUnreadyUpgrade() {
+	ready, unready := sdk.ValAddress([]byte("ready_validator_____")), sdk.ValAddress([]byte("unready_validator___"))
+	s.stakingKeeper.setPower(ready, 60)
+	s.stakingKeeper.setPower(unready, 40)
+
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "v2", Height: 100}))
+	s.Require().NoError(s.upgradeKeeper.SignalUpgradeReady(s.ctx, ready, "v2", "v2.0.0"))
+
+	planHeight := func() int64 {
+		plan, found := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+		s.Require().True(found)
+		return plan.Height
+	}
+
+	// postponements are disabled by default
+	s.Require().False(s.upgradeKeeper.PostponeUnreadyUpgrade(s.ctx.WithBlockHeight(90)))
+
+	s.upgradeKeeper.SetParams(s.ctx, types.NewParams(math.LegacyNewDecWithPrec(67, 2), 10, 50, 2))
+
+	// the readiness is only checked readiness window blocks before the plan height
+	s.Require().False(s.upgradeKeeper.PostponeUnreadyUpgrade(s.ctx.WithBlockHeight(89)))
+	s.Require().True(s.upgradeKeeper.PostponeUnreadyUpgrade(s.ctx.WithBlockHeight(90)))
+	s.Require().Equal(int64(150), planHeight())
+	s.Require().Equal(uint32(1), s.upgradeKeeper.GetPostponements(s.ctx, "v2"))
+
+	// the readiness is checked once per plan height, even when the first block
+	// of the readiness window is missed
+	s.Require().True(s.upgradeKeeper.PostponeUnreadyUpgrade(s.ctx.WithBlockHeight(145)))
+	s.Require().Equal(int64(200), planHeight())
+	s.Require().False(s.upgradeKeeper.PostponeUnreadyUpgrade(s.ctx.WithBlockHeight(146)))
+
+	// the plan is postponed at most max postponements times
+	s.Require().False(s.upgradeKeeper.PostponeUnreadyUpgrade(s.ctx.WithBlockHeight(190)))
+	s.Require().Equal(int64(200), planHeight())
+
+	// enough voting power is ready
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "v3", Height: 300}))
+	s.upgradeKeeper.SetParams(s.ctx, types.NewParams(math.LegacyNewDecWithPrec(6, 1), 10, 50, 5))
+	s.Require().NoError(s.upgradeKeeper.SignalUpgradeReady(s.ctx, ready, "v3", "v3.0.0"))
+	s.Require().False(s.upgradeKeeper.PostponeUnreadyUpgrade(s.ctx.WithBlockHeight(290)))
+	s.Require().Equal(int64(300), planHeight())
+}
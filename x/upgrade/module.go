@@ -182,7 +182,8 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AppOpts servertypes.AppOptions `optional:"true"`
+	AppOpts       servertypes.AppOptions `optional:"true"`
+	StakingKeeper types.StakingKeeper    `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -215,7 +216,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 
 	// set the governance module account as the authority for conducting upgrades
-	k := keeper.NewKeeper(skipUpgradeHeights, in.Key, in.Cdc, homePath, nil, authority.String())
+	k := keeper.NewKeeper(skipUpgradeHeights, in.Key, in.Cdc, homePath, nil, in.StakingKeeper, authority.String())
 	baseappOpt := func(app *baseapp.BaseApp) {
 		k.SetVersionSetter(app)
 	}
@@ -16,10 +16,14 @@ import (
 // RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Plan{}, "cosmos-sdk/Plan", nil)
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/upgrade/Params", nil)
 	cdc.RegisterConcrete(&SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal", nil)
 	cdc.RegisterConcrete(&CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgSoftwareUpgrade{}, "cosmos-sdk/MsgSoftwareUpgrade")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUpgrade{}, "cosmos-sdk/MsgCancelUpgrade")
+	legacy.RegisterAminoMsg(cdc, &MsgQueueUpgrade{}, "cosmos-sdk/MsgQueueUpgrade")
+	legacy.RegisterAminoMsg(cdc, &MsgSignalUpgradeReady{}, "cosmos-sdk/MsgSignalUpgradeReady")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/upgrade/MsgUpdateParams")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -33,6 +37,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSoftwareUpgrade{},
 		&MsgCancelUpgrade{},
+		&MsgQueueUpgrade{},
+		&MsgSignalUpgradeReady{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,13 @@
+package types
+
+// x/upgrade module event types
+const (
+	EventTypeSignalUpgradeReady = "signal_upgrade_ready"
+	EventTypePostponeUpgrade    = "postpone_upgrade"
+
+	AttributeKeyValidator     = "validator"
+	AttributeKeyName          = "name"
+	AttributeKeyVersion       = "version"
+	AttributeKeyHeight        = "height"
+	AttributeKeyReadyFraction = "ready_fraction"
+)
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to weigh the
+// readiness signals of the validators by their voting power.
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) (power int64)
+	GetLastTotalPower(ctx sdk.Context) math.Int
+}
@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -26,6 +31,27 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// QueuedPlanByte is a prefix to look up the queued upgrade plans by their queueing sequence
+	QueuedPlanByte = 0x4
+
+	// QueuedPlanSequenceByte specifies the Byte under which the last queueing sequence is stored
+	QueuedPlanSequenceByte = 0x5
+
+	// ParamsByte specifies the Byte under which the module parameters are stored
+	ParamsByte = 0x6
+
+	// ReadinessSignalByte is a prefix to look up the readiness signals by upgrade name and validator
+	ReadinessSignalByte = 0x7
+
+	// PostponementsByte is a prefix to look up the number of times an upgrade has been postponed by upgrade name
+	PostponementsByte = 0x8
+
+	// PromotedPlanByte specifies the Byte under which the name of the current plan is stored if it was promoted from the queue
+	PromotedPlanByte = 0x9
+
+	// ReadinessCheckedByte is a prefix to look up the plan height at which the readiness of an upgrade was last checked by upgrade name
+	ReadinessCheckedByte = 0xa
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -42,6 +68,51 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// QueuedPlanKey is the key under which the queued plan with the given queueing sequence is saved
+func QueuedPlanKey(sequence uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = QueuedPlanByte
+	binary.BigEndian.PutUint64(key[1:], sequence)
+	return key
+}
+
+// QueuedPlanSequenceKey is the key under which the last queueing sequence is saved
+func QueuedPlanSequenceKey() []byte {
+	return []byte{QueuedPlanSequenceByte}
+}
+
+// ParamsKey is the key under which the module parameters are saved
+func ParamsKey() []byte {
+	return []byte{ParamsByte}
+}
+
+// ReadinessSignalsPrefix is the prefix under which the readiness signals for the given upgrade are saved
+func ReadinessSignalsPrefix(name string) []byte {
+	// the name is prefixed by its varint encoded length, so that no name prefixes another one
+	key := binary.AppendUvarint([]byte{ReadinessSignalByte}, uint64(len(name)))
+	return append(key, name...)
+}
+
+// ReadinessSignalKey is the key under which the readiness signal of the given validator for the given upgrade is saved
+func ReadinessSignalKey(name string, valAddr sdk.ValAddress) []byte {
+	return append(ReadinessSignalsPrefix(name), valAddr...)
+}
+
+// PostponementsKey is the key under which the number of times the given upgrade has been postponed is saved
+func PostponementsKey(name string) []byte {
+	return append([]byte{PostponementsByte}, name...)
+}
+
+// PromotedPlanKey is the key under which the name of the current plan is saved if it was promoted from the queue
+func PromotedPlanKey() []byte {
+	return []byte{PromotedPlanByte}
+}
+
+// ReadinessCheckedKey is the key under which the plan height at which the readiness of the given upgrade was last checked is saved
+func ReadinessCheckedKey(name string) []byte {
+	return append([]byte{ReadinessCheckedByte}, name...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -6,8 +6,8 @@ import (
 )
 
 var (
-	_, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
-	_, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
+	_, _, _, _, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgQueueUpgrade{}, &MsgSignalUpgradeReady{}, &MsgUpdateParams{}
+	_, _, _, _, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgQueueUpgrade{}, &MsgSignalUpgradeReady{}, &MsgUpdateParams{}
 )
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -31,3 +31,36 @@ func (m *MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgQueueUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for MsgQueueUpgrade.
+func (m *MsgQueueUpgrade) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSignalUpgradeReady) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for MsgSignalUpgradeReady.
+func (m *MsgSignalUpgradeReady) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for MsgUpdateParams.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
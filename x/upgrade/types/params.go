@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(readinessThreshold math.LegacyDec, readinessWindow, postponeBlocks int64, maxPostponements uint32) Params {
+	return Params{
+		ReadinessThreshold: readinessThreshold,
+		ReadinessWindow:    readinessWindow,
+		PostponeBlocks:     postponeBlocks,
+		MaxPostponements:   maxPostponements,
+	}
+}
+
+// DefaultParams returns default x/upgrade module parameters, which never
+// postpone an upgrade.
+func DefaultParams() Params {
+	return Params{
+		ReadinessThreshold: math.LegacyZeroDec(),
+		ReadinessWindow:    0,
+		PostponeBlocks:     0,
+		MaxPostponements:   0,
+	}
+}
+
+// PostponementsEnabled returns true if upgrades with a low readiness are postponed.
+func (p Params) PostponementsEnabled() bool {
+	return !p.ReadinessThreshold.IsNil() && p.ReadinessThreshold.IsPositive()
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.ReadinessThreshold.IsNil() {
+		return errors.New("readiness threshold cannot be nil")
+	}
+	if p.ReadinessThreshold.IsNegative() {
+		return fmt.Errorf("readiness threshold cannot be negative: %s", p.ReadinessThreshold)
+	}
+	if p.ReadinessThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("readiness threshold too large: %s", p.ReadinessThreshold)
+	}
+	if p.ReadinessWindow < 0 {
+		return fmt.Errorf("readiness window cannot be negative: %d", p.ReadinessWindow)
+	}
+	if p.PostponeBlocks < 0 {
+		return fmt.Errorf("postpone blocks cannot be negative: %d", p.PostponeBlocks)
+	}
+
+	if !p.PostponementsEnabled() {
+		return nil
+	}
+
+	if p.ReadinessWindow == 0 {
+		return errors.New("readiness window must be positive when the readiness threshold is set")
+	}
+	if p.PostponeBlocks == 0 {
+		return errors.New("postpone blocks must be positive when the readiness threshold is set")
+	}
+	if p.MaxPostponements == 0 {
+		return errors.New("max postponements must be positive when the readiness threshold is set")
+	}
+
+	return nil
+}
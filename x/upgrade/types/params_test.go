@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/upgrade/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	cases := map[string]struct {
+		params types.Params
+		errMsg string
+	}{
+		"default": {
+			params: types.DefaultParams(),
+		},
+		"enabled": {
+			params: types.NewParams(math.LegacyNewDecWithPrec(67, 2), 100, 50, 2),
+		},
+		"disabled with other values set": {
+			params: types.NewParams(math.LegacyZeroDec(), 100, 50, 0),
+		},
+		"nil threshold": {
+			params: types.Params{},
+			errMsg: "readiness threshold cannot be nil",
+		},
+		"negative threshold": {
+			params: types.NewParams(math.LegacyNewDec(-1), 100, 50, 2),
+			errMsg: "readiness threshold cannot be negative",
+		},
+		"threshold too large": {
+			params: types.NewParams(math.LegacyNewDecWithPrec(11, 1), 100, 50, 2),
+			errMsg: "readiness threshold too large",
+		},
+		"negative window": {
+			params: types.NewParams(math.LegacyZeroDec(), -1, 0, 0),
+			errMsg: "readiness window cannot be negative",
+		},
+		"negative postpone blocks": {
+			params: types.NewParams(math.LegacyZeroDec(), 0, -1, 0),
+			errMsg: "postpone blocks cannot be negative",
+		},
+		"enabled without window": {
+			params: types.NewParams(math.LegacyNewDecWithPrec(67, 2), 0, 50, 2),
+			errMsg: "readiness window must be positive",
+		},
+		"enabled without postpone blocks": {
+			params: types.NewParams(math.LegacyNewDecWithPrec(67, 2), 100, 0, 2),
+			errMsg: "postpone blocks must be positive",
+		},
+		"enabled without max postponements": {
+			params: types.NewParams(math.LegacyNewDecWithPrec(67, 2), 100, 50, 0),
+			errMsg: "max postponements must be positive",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -14,31 +15,66 @@ const UpgradeInfoFilename = "upgrade-info.json"
 
 // ValidateBasic does basic validation of a Plan
 func (p Plan) ValidateBasic() error {
-	if !p.Time.IsZero() {
-		return sdkerrors.ErrInvalidRequest.Wrap("time-based upgrades have been deprecated in the SDK")
-	}
 	if p.UpgradedClientState != nil {
 		return sdkerrors.ErrInvalidRequest.Wrap("upgrade logic for IBC has been moved to the IBC module")
 	}
 	if len(p.Name) == 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "name cannot be empty")
 	}
-	if p.Height <= 0 {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
+	if p.Height < 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "height cannot be negative")
+	}
+	if p.Height == 0 && p.Time.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0 or time must be set")
+	}
+	if p.Height > 0 && !p.Time.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "only one of height and time can be set")
+	}
+
+	seen := make(map[string]bool, len(p.DependsOn))
+	for _, dep := range p.DependsOn {
+		switch {
+		case len(dep) == 0:
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "dependency name cannot be empty")
+		case dep == p.Name:
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade %s cannot depend on itself", p.Name)
+		case seen[dep]:
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate dependency %s", dep)
+		}
+		seen[dep] = true
 	}
 
 	return nil
 }
 
+// IsTimeBased returns true if the Plan is due at a block time rather than at a height
+func (p Plan) IsTimeBased() bool {
+	return p.Height == 0 && !p.Time.IsZero()
+}
+
 // ShouldExecute returns true if the Plan is ready to execute given the current context
 func (p Plan) ShouldExecute(ctx sdk.Context) bool {
 	if p.Height > 0 {
 		return p.Height <= ctx.BlockHeight()
 	}
+	if p.IsTimeBased() {
+		return !ctx.BlockTime().Before(p.Time)
+	}
 	return false
 }
 
+// IsPast returns true if the Plan is due before the current block
+func (p Plan) IsPast(ctx sdk.Context) bool {
+	if p.IsTimeBased() {
+		return p.Time.Before(ctx.BlockTime())
+	}
+	return p.Height < ctx.BlockHeight()
+}
+
 // DueAt is a string representation of when this plan is due to be executed
 func (p Plan) DueAt() string {
+	if p.IsTimeBased() {
+		return fmt.Sprintf("time: %s", p.Time.UTC().Format(time.RFC3339))
+	}
 	return fmt.Sprintf("height: %d", p.Height)
 }
@@ -70,11 +70,47 @@ func TestPlanValid(t *testing.T) {
 				Height: 123450000,
 			},
 		},
-		"time-base upgrade": {
+		"time-base upgrade without name": {
 			p: types.Plan{
 				Time: time.Now(),
 			},
 		},
+		"proper by time": {
+			p: types.Plan{
+				Name: "all-good",
+				Time: mustParseTime("2019-07-08T11:32:00Z"),
+			},
+			valid: true,
+		},
+		"both height and time": {
+			p: types.Plan{
+				Name:   "both",
+				Height: 123450000,
+				Time:   mustParseTime("2019-07-08T11:32:00Z"),
+			},
+		},
+		"proper with dependencies": {
+			p: types.Plan{
+				Name:      "all-good",
+				Height:    123450000,
+				DependsOn: []string{"first", "second"},
+			},
+			valid: true,
+		},
+		"depends on itself": {
+			p: types.Plan{
+				Name:      "self",
+				Height:    123450000,
+				DependsOn: []string{"self"},
+			},
+		},
+		"duplicate dependency": {
+			p: types.Plan{
+				Name:      "dup",
+				Height:    123450000,
+				DependsOn: []string{"first", "first"},
+			},
+		},
 		"IBC upgrade": {
 			p: types.Plan{
 				Height:              123450000,
@@ -142,6 +178,33 @@ func TestShouldExecute(t *testing.T) {
 			ctxHeight: 1235,
 			expected:  true,
 		},
+		"before time": {
+			p: types.Plan{
+				Name: "do-good",
+				Time: mustParseTime("2019-07-08T11:33:00Z"),
+			},
+			ctxTime:   mustParseTime("2019-07-08T11:32:00Z"),
+			ctxHeight: 100000,
+			expected:  false,
+		},
+		"on time": {
+			p: types.Plan{
+				Name: "do-good",
+				Time: mustParseTime("2019-07-08T11:32:00Z"),
+			},
+			ctxTime:   mustParseTime("2019-07-08T11:32:00Z"),
+			ctxHeight: 1,
+			expected:  true,
+		},
+		"after time": {
+			p: types.Plan{
+				Name: "do-good",
+				Time: mustParseTime("2019-07-08T11:31:00Z"),
+			},
+			ctxTime:   mustParseTime("2019-07-08T11:32:00Z"),
+			ctxHeight: 1,
+			expected:  true,
+		},
 	}
 
 	for name, tc := range cases {
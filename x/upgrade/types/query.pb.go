@@ -6,6 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -406,6 +410,312 @@ func (m *QueryModuleVersionsResponse) GetModuleVersions() []*ModuleVersion {
 	return nil
 }
 
+// QueryPendingPlansRequest is the request type for the Query/PendingPlans RPC
+// method.
+//
+// Since: cosmos-sdk 0.48
+type QueryPendingPlansRequest struct {
+}
+
+func (m *QueryPendingPlansRequest) Reset()         { *m = QueryPendingPlansRequest{} }
+func (m *QueryPendingPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPlansRequest) ProtoMessage()    {}
+func (*QueryPendingPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
+}
+func (m *QueryPendingPlansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPlansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPlansRequest.Merge(m, src)
+}
+func (m *QueryPendingPlansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPlansRequest proto.InternalMessageInfo
+
+// QueryPendingPlansResponse is the response type for the Query/PendingPlans RPC
+// method.
+//
+// Since: cosmos-sdk 0.48
+type QueryPendingPlansResponse struct {
+	// plans is the current upgrade plan, if any, followed by the queued upgrade
+	// plans in the order they were queued.
+	Plans []Plan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans"`
+}
+
+func (m *QueryPendingPlansResponse) Reset()         { *m = QueryPendingPlansResponse{} }
+func (m *QueryPendingPlansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPlansResponse) ProtoMessage()    {}
+func (*QueryPendingPlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{9}
+}
+func (m *QueryPendingPlansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPlansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPlansResponse.Merge(m, src)
+}
+func (m *QueryPendingPlansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPlansResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPlansResponse) GetPlans() []Plan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+// QueryUpgradeReadinessRequest is the request type for the
+// Query/UpgradeReadiness RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryUpgradeReadinessRequest struct {
+	// name is the name of the pending upgrade.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryUpgradeReadinessRequest) Reset()         { *m = QueryUpgradeReadinessRequest{} }
+func (m *QueryUpgradeReadinessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessRequest) ProtoMessage()    {}
+func (*QueryUpgradeReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryUpgradeReadinessResponse is the response type for the
+// Query/UpgradeReadiness RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryUpgradeReadinessResponse struct {
+	// signals are the readiness signals for the upgrade.
+	Signals []ReadinessSignal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals"`
+	// ready_power is the voting power of the validators which signaled their
+	// readiness.
+	ReadyPower int64 `protobuf:"varint,2,opt,name=ready_power,json=readyPower,proto3" json:"ready_power,omitempty"`
+	// total_power is the total bonded voting power.
+	TotalPower int64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// ready_fraction is the fraction of the total bonded voting power which
+	// signaled its readiness.
+	ReadyFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=ready_fraction,json=readyFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ready_fraction"`
+	// postponements is the number of times the upgrade has been postponed
+	// because of a low readiness.
+	Postponements uint32 `protobuf:"varint,5,opt,name=postponements,proto3" json:"postponements,omitempty"`
+}
+
+func (m *QueryUpgradeReadinessResponse) Reset()         { *m = QueryUpgradeReadinessResponse{} }
+func (m *QueryUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessResponse) ProtoMessage()    {}
+func (*QueryUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessResponse) GetSignals() []ReadinessSignal {
+	if m != nil {
+		return m.Signals
+	}
+	return nil
+}
+
+func (m *QueryUpgradeReadinessResponse) GetReadyPower() int64 {
+	if m != nil {
+		return m.ReadyPower
+	}
+	return 0
+}
+
+func (m *QueryUpgradeReadinessResponse) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *QueryUpgradeReadinessResponse) GetPostponements() uint32 {
+	if m != nil {
+		return m.Postponements
+	}
+	return 0
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+//
+// Since: cosmos-sdk 0.48
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{13}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryAuthorityRequest is the request type for Query/Authority
 //
 // Since: cosmos-sdk 0.46
@@ -416,7 +726,7 @@ func (m *QueryAuthorityRequest) Reset()         { *m = QueryAuthorityRequest{} }
 func (m *QueryAuthorityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityRequest) ProtoMessage()    {}
 func (*QueryAuthorityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{8}
+	return fileDescriptor_4a334d07ad8374f0, []int{14}
 }
 func (m *QueryAuthorityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +766,7 @@ func (m *QueryAuthorityResponse) Reset()         { *m = QueryAuthorityResponse{}
 func (m *QueryAuthorityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityResponse) ProtoMessage()    {}
 func (*QueryAuthorityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{9}
+	return fileDescriptor_4a334d07ad8374f0, []int{15}
 }
 func (m *QueryAuthorityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,6 +811,12 @@ func init() {
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
 	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*QueryPendingPlansRequest)(nil), "cosmos.upgrade.v1beta1.QueryPendingPlansRequest")
+	proto.RegisterType((*QueryPendingPlansResponse)(nil), "cosmos.upgrade.v1beta1.QueryPendingPlansResponse")
+	proto.RegisterType((*QueryUpgradeReadinessRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessRequest")
+	proto.RegisterType((*QueryUpgradeReadinessResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.upgrade.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.upgrade.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
 }
@@ -510,47 +826,70 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0x66, 0x16, 0x44, 0x79, 0x6b, 0xd0, 0x4c, 0xe2, 0x52, 0x2b, 0x59, 0x71, 0x40, 0x85, 0x28,
-	0x1d, 0x58, 0x12, 0x63, 0x30, 0x1a, 0x95, 0x8b, 0x18, 0x25, 0xba, 0x46, 0x0f, 0x5e, 0x36, 0x03,
-	0x9d, 0x2c, 0x8d, 0xdd, 0x4e, 0xe9, 0x4c, 0x89, 0x84, 0x70, 0xf1, 0xe4, 0xd1, 0xc4, 0x78, 0xf5,
-	0xe6, 0xc5, 0x5f, 0xe2, 0x91, 0xc4, 0x8b, 0x07, 0x0f, 0x06, 0xfc, 0x09, 0xfe, 0x00, 0xd3, 0xe9,
-	0x94, 0x74, 0xa1, 0x2d, 0xe8, 0x6d, 0x3b, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0xce, 0xf7, 0xb6, 0x40,
-	0xd6, 0x84, 0xec, 0x09, 0x49, 0xe3, 0xb0, 0x1b, 0x31, 0x97, 0xd3, 0xcd, 0xf9, 0x55, 0xae, 0xd8,
-	0x3c, 0xdd, 0x88, 0x79, 0xb4, 0xe5, 0x84, 0x91, 0x50, 0x02, 0x37, 0x52, 0x8c, 0x63, 0x30, 0x8e,
-	0xc1, 0xd8, 0xe3, 0x5d, 0x21, 0xba, 0x3e, 0xa7, 0x2c, 0xf4, 0x28, 0x0b, 0x02, 0xa1, 0x98, 0xf2,
-	0x44, 0x20, 0x53, 0x96, 0x3d, 0x55, 0xa2, 0x9c, 0xa9, 0x68, 0x14, 0xb9, 0x08, 0x63, 0xcf, 0x93,
-	0x56, 0x4b, 0x71, 0x14, 0xf1, 0x40, 0x3d, 0xf3, 0x59, 0xd0, 0xe6, 0x1b, 0x31, 0x97, 0x8a, 0x3c,
-	0x01, 0xeb, 0x68, 0x49, 0x86, 0x22, 0x90, 0x1c, 0xcf, 0xc1, 0x50, 0xe8, 0xb3, 0xc0, 0x42, 0x13,
-	0x68, 0xba, 0xde, 0x1a, 0x77, 0x8a, 0x1d, 0x3a, 0x9a, 0xa3, 0x91, 0x64, 0xd6, 0x34, 0x7a, 0x10,
-	0x86, 0xbe, 0xc7, 0xdd, 0x5c, 0x23, 0x8c, 0x61, 0x28, 0x60, 0x3d, 0xae, 0xc5, 0x46, 0xda, 0xfa,
-	0x37, 0x69, 0x99, 0xe6, 0x7d, 0x70, 0xd3, 0xbc, 0x01, 0xc3, 0xeb, 0xdc, 0xeb, 0xae, 0x2b, 0xcd,
-	0x18, 0x6c, 0x9b, 0x27, 0xb2, 0x0c, 0x44, 0x73, 0x5e, 0xa6, 0x2e, 0xdc, 0xa5, 0x04, 0x1d, 0xc8,
-	0x58, 0xbe, 0x50, 0x4c, 0xf1, 0xac, 0xdb, 0x65, 0xa8, 0xfb, 0x4c, 0xaa, 0x4e, 0x9f, 0x04, 0x24,
-	0x47, 0x8f, 0xf4, 0xc9, 0x62, 0xcd, 0x42, 0xc4, 0x83, 0xc9, 0x4a, 0x29, 0xe3, 0xe4, 0x36, 0x58,
-	0x66, 0x64, 0xb7, 0xb3, 0x96, 0x41, 0x3a, 0x32, 0xc1, 0x58, 0xb5, 0x09, 0x34, 0x7d, 0xb6, 0xdd,
-	0x88, 0x0b, 0x15, 0x92, 0x26, 0x8f, 0x87, 0xce, 0xa0, 0xf3, 0x35, 0x72, 0x17, 0x6c, 0xdd, 0xea,
-	0xa9, 0x70, 0x63, 0x9f, 0xbf, 0xe2, 0x91, 0x4c, 0x2e, 0x31, 0xe7, 0xb6, 0xa7, 0x0b, 0x9d, 0xdc,
-	0x2b, 0x82, 0xf4, 0x68, 0x25, 0x79, 0x51, 0x3d, 0xb8, 0x54, 0x48, 0x37, 0x0e, 0x57, 0xe0, 0x9c,
-	0xe1, 0x6f, 0x9a, 0x92, 0x85, 0x26, 0x06, 0xa7, 0xeb, 0xad, 0xab, 0x65, 0x77, 0xd6, 0x27, 0xd4,
-	0x1e, 0xed, 0xf5, 0xe9, 0x92, 0x31, 0xb8, 0x90, 0xde, 0x4b, 0xac, 0xd6, 0x45, 0xe4, 0xa9, 0xad,
-	0x2c, 0x2d, 0x2d, 0x68, 0x1c, 0x2e, 0x18, 0x0b, 0x16, 0x9c, 0x66, 0xae, 0x1b, 0x71, 0x29, 0x8d,
-	0xfd, 0xec, 0xb1, 0xf5, 0x67, 0x18, 0x4e, 0x69, 0x12, 0xfe, 0x8c, 0xa0, 0x9e, 0xcb, 0x19, 0xa6,
-	0x65, 0xee, 0x4a, 0xc2, 0x6a, 0xcf, 0x9d, 0x9c, 0x90, 0xda, 0x22, 0x37, 0xdf, 0x7d, 0xff, 0xfd,
-	0xb1, 0x76, 0x0d, 0x4f, 0xd1, 0x92, 0x45, 0x59, 0x4b, 0x49, 0x9d, 0x24, 0xbe, 0xf8, 0x0b, 0x82,
-	0x7a, 0x2e, 0x8b, 0xc7, 0x18, 0x3c, 0x1a, 0xf2, 0x63, 0x0c, 0x16, 0xc4, 0x9c, 0x2c, 0x68, 0x83,
-	0xb3, 0xf8, 0x46, 0x99, 0x41, 0x96, 0x92, 0xb4, 0x41, 0xba, 0x9d, 0xe4, 0x63, 0x07, 0xff, 0x44,
-	0xd0, 0x28, 0x0e, 0x2d, 0x5e, 0xac, 0x74, 0x50, 0xb9, 0x34, 0xf6, 0x9d, 0xff, 0xe2, 0x9a, 0x41,
-	0x96, 0xf5, 0x20, 0xf7, 0xf1, 0x3d, 0x5a, 0xfd, 0x97, 0x74, 0x64, 0x87, 0xe8, 0x76, 0x6e, 0x53,
-	0x77, 0xde, 0xd7, 0x10, 0xfe, 0x8a, 0x60, 0xb4, 0x3f, 0xe9, 0xb8, 0x55, 0x69, 0xad, 0x70, 0xab,
-	0xec, 0x85, 0x7f, 0xe2, 0x98, 0x31, 0xa8, 0x1e, 0x63, 0x06, 0x5f, 0x2f, 0x1b, 0xe3, 0xd0, 0xa2,
-	0xe1, 0x4f, 0x08, 0x46, 0x0e, 0xd6, 0x01, 0xcf, 0x56, 0x07, 0xe0, 0xd0, 0x3e, 0xd9, 0xce, 0x49,
-	0xe1, 0xc6, 0xdd, 0x8c, 0x76, 0x37, 0x89, 0xaf, 0x94, 0xa6, 0x25, 0xa3, 0x3c, 0xbc, 0xf5, 0x6d,
-	0xaf, 0x89, 0x76, 0xf7, 0x9a, 0xe8, 0xd7, 0x5e, 0x13, 0x7d, 0xd8, 0x6f, 0x0e, 0xec, 0xee, 0x37,
-	0x07, 0x7e, 0xec, 0x37, 0x07, 0x5e, 0x8f, 0xa7, 0x5c, 0xe9, 0xbe, 0x71, 0x3c, 0x41, 0xdf, 0x1e,
-	0x68, 0xa8, 0xad, 0x90, 0xcb, 0xd5, 0x61, 0xfd, 0xc9, 0x58, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0x77, 0x58, 0xe1, 0xb4, 0x06, 0x00, 0x00,
+	// 995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x69, 0x4a, 0x9e, 0x9b, 0x50, 0x86, 0xe2, 0x6e, 0x96, 0xe0, 0x98, 0x6d, 0x9a,
+	0xb8, 0x29, 0xf6, 0x26, 0x0e, 0x20, 0x54, 0x54, 0x44, 0xd2, 0x0a, 0x51, 0x54, 0xaa, 0xe0, 0x0a,
+	0x84, 0x7a, 0xb1, 0x26, 0xde, 0xc1, 0x5e, 0xd5, 0xbb, 0xb3, 0xdd, 0x99, 0x2d, 0x44, 0x55, 0x2f,
+	0x9c, 0xe0, 0x86, 0x84, 0xb8, 0x72, 0x40, 0x42, 0x42, 0x9c, 0x40, 0xe2, 0xce, 0xb5, 0xc7, 0x0a,
+	0x2e, 0x80, 0x50, 0x85, 0x12, 0x24, 0xfe, 0x0d, 0x34, 0x3f, 0x36, 0x5a, 0x3b, 0xde, 0xc5, 0xe1,
+	0x92, 0xec, 0xbe, 0xfd, 0xbe, 0xf7, 0xbe, 0x37, 0xef, 0xed, 0xb7, 0x06, 0xa7, 0xcb, 0x78, 0xc0,
+	0xb8, 0x9b, 0x44, 0xbd, 0x98, 0x78, 0xd4, 0xbd, 0xbf, 0xb9, 0x47, 0x05, 0xd9, 0x74, 0xef, 0x25,
+	0x34, 0xde, 0x6f, 0x46, 0x31, 0x13, 0x0c, 0x57, 0x34, 0xa6, 0x69, 0x30, 0x4d, 0x83, 0xb1, 0x97,
+	0x7a, 0x8c, 0xf5, 0x06, 0xd4, 0x25, 0x91, 0xef, 0x92, 0x30, 0x64, 0x82, 0x08, 0x9f, 0x85, 0x5c,
+	0xb3, 0xec, 0x95, 0x9c, 0xcc, 0x69, 0x16, 0x8d, 0x3a, 0xd7, 0x63, 0x3d, 0xa6, 0x2e, 0x5d, 0x79,
+	0x65, 0xa2, 0xcf, 0x90, 0xc0, 0x0f, 0x99, 0xab, 0xfe, 0x9a, 0xd0, 0xa2, 0x4e, 0xd7, 0xd1, 0x58,
+	0xa3, 0x48, 0xdd, 0x38, 0x8b, 0x70, 0xfe, 0x3d, 0x29, 0xf7, 0x5a, 0x12, 0xc7, 0x34, 0x14, 0xbb,
+	0x03, 0x12, 0xb6, 0xe9, 0xbd, 0x84, 0x72, 0xe1, 0xdc, 0x04, 0xeb, 0xf8, 0x23, 0x1e, 0xb1, 0x90,
+	0x53, 0xbc, 0x01, 0x33, 0xd1, 0x80, 0x84, 0x16, 0xaa, 0xa1, 0x7a, 0xb9, 0xb5, 0xd4, 0x1c, 0xdf,
+	0x65, 0x53, 0x71, 0x14, 0xd2, 0x69, 0x98, 0x42, 0xdb, 0x51, 0x34, 0xf0, 0xa9, 0x97, 0x29, 0x84,
+	0x31, 0xcc, 0x84, 0x24, 0xa0, 0x2a, 0xd9, 0x5c, 0x5b, 0x5d, 0x3b, 0x2d, 0xb0, 0x8e, 0xc3, 0x4d,
+	0xf1, 0x0a, 0xcc, 0xf6, 0xa9, 0xdf, 0xeb, 0x0b, 0xc5, 0x98, 0x6e, 0x9b, 0x3b, 0xe7, 0x06, 0x38,
+	0x8a, 0xf3, 0xbe, 0x56, 0xe1, 0x5d, 0x93, 0xe8, 0x90, 0x27, 0xfc, 0xb6, 0x20, 0x82, 0xa6, 0xd5,
+	0x96, 0xa1, 0x3c, 0x20, 0x5c, 0x74, 0x86, 0x52, 0x80, 0x0c, 0xbd, 0xad, 0x22, 0x57, 0x4a, 0x16,
+	0x72, 0x7c, 0xb8, 0x50, 0x98, 0xca, 0x28, 0x79, 0x0d, 0x2c, 0xd3, 0xb2, 0xd7, 0xe9, 0xa6, 0x90,
+	0x0e, 0x97, 0x18, 0xab, 0x54, 0x43, 0xf5, 0x33, 0xed, 0x4a, 0x32, 0x36, 0x83, 0x2c, 0xf2, 0xce,
+	0xcc, 0x53, 0xe8, 0x6c, 0xc9, 0xb9, 0x0a, 0xb6, 0x2a, 0xf5, 0x2e, 0xf3, 0x92, 0x01, 0xfd, 0x80,
+	0xc6, 0x5c, 0x2e, 0x42, 0x46, 0x6d, 0xa0, 0x1e, 0x74, 0x32, 0x47, 0x04, 0x3a, 0x74, 0x4b, 0x1e,
+	0x54, 0x00, 0xcf, 0x8f, 0xa5, 0x1b, 0x85, 0xb7, 0xe0, 0x69, 0xc3, 0xbf, 0x6f, 0x1e, 0x59, 0xa8,
+	0x36, 0x5d, 0x2f, 0xb7, 0x2e, 0xe6, 0xcd, 0x6c, 0x28, 0x51, 0x7b, 0x21, 0x18, 0xca, 0xeb, 0xd8,
+	0x66, 0x2e, 0xbb, 0x34, 0xf4, 0xfc, 0xb0, 0x27, 0xe7, 0x92, 0x6a, 0x75, 0xee, 0xc0, 0xe2, 0x98,
+	0x67, 0x46, 0xc8, 0x55, 0x38, 0x25, 0xf7, 0x20, 0x2d, 0x5f, 0xb8, 0x32, 0x3b, 0x73, 0x8f, 0x9e,
+	0x2c, 0x4f, 0x7d, 0xf7, 0xcf, 0x0f, 0xeb, 0xa8, 0xad, 0x59, 0x4e, 0x0b, 0x96, 0xb2, 0x03, 0x69,
+	0x53, 0xe2, 0xf9, 0x21, 0xe5, 0xbc, 0x68, 0x87, 0x7e, 0x2e, 0xc1, 0x0b, 0x39, 0x24, 0x23, 0xea,
+	0x26, 0x9c, 0xe6, 0x7e, 0x2f, 0x24, 0x83, 0x54, 0xd6, 0x5a, 0x9e, 0xac, 0x23, 0xee, 0x6d, 0x85,
+	0xcf, 0x2a, 0x4c, 0x53, 0xc8, 0x59, 0xc5, 0x94, 0x78, 0xfb, 0x9d, 0x88, 0x7d, 0x4c, 0x63, 0xb5,
+	0x00, 0xd3, 0x6d, 0x50, 0xa1, 0x5d, 0x19, 0x91, 0x00, 0xc1, 0x04, 0x19, 0x18, 0xc0, 0xb4, 0x06,
+	0xa8, 0x90, 0x06, 0xf4, 0x61, 0x41, 0x67, 0xf8, 0x28, 0x26, 0x5d, 0x69, 0x08, 0xd6, 0x8c, 0xec,
+	0x67, 0x67, 0x5b, 0x56, 0xfb, 0xe3, 0xc9, 0xf2, 0x6a, 0xcf, 0x17, 0xfd, 0x64, 0xaf, 0xd9, 0x65,
+	0x81, 0x79, 0x8d, 0xcd, 0xbf, 0x06, 0xf7, 0xee, 0xba, 0x62, 0x3f, 0xa2, 0xbc, 0x79, 0x9d, 0x76,
+	0x7f, 0xf9, 0xa9, 0x01, 0xa6, 0x8f, 0xeb, 0xb4, 0xab, 0x55, 0xce, 0xab, 0xc4, 0x6f, 0x99, 0xbc,
+	0x78, 0x05, 0xe6, 0x23, 0xc6, 0x45, 0xc4, 0x42, 0x1a, 0xd0, 0x50, 0x70, 0xeb, 0x54, 0x0d, 0xd5,
+	0xe7, 0xdb, 0xc3, 0x41, 0xe7, 0x1c, 0x60, 0x3d, 0x51, 0x12, 0x93, 0xe0, 0x68, 0xce, 0x1f, 0xc2,
+	0xb3, 0x43, 0x51, 0x73, 0x98, 0xdb, 0x30, 0x1b, 0xa9, 0x88, 0x71, 0x85, 0x6a, 0xee, 0x88, 0x15,
+	0x2a, 0x7b, 0x84, 0x86, 0xe8, 0x9c, 0x87, 0xe7, 0xf4, 0x5b, 0x9f, 0x88, 0x3e, 0x8b, 0x7d, 0xb1,
+	0x9f, 0x96, 0x6c, 0x41, 0x65, 0xf4, 0x81, 0xa9, 0x6a, 0xc1, 0x69, 0xe2, 0x79, 0x31, 0xe5, 0xdc,
+	0xcc, 0x3e, 0xbd, 0x6d, 0xfd, 0x0e, 0x70, 0x4a, 0x91, 0xf0, 0xd7, 0x08, 0xca, 0x19, 0x17, 0xc3,
+	0x6e, 0x9e, 0xb2, 0x1c, 0x2b, 0xb4, 0x37, 0x26, 0x27, 0x68, 0x59, 0xce, 0x4b, 0x9f, 0xfe, 0xfa,
+	0xf7, 0x97, 0xa5, 0x55, 0xbc, 0xe2, 0xe6, 0x58, 0x79, 0x57, 0x93, 0x3a, 0x72, 0xbd, 0xf1, 0xb7,
+	0x08, 0xca, 0x19, 0xa7, 0xfb, 0x0f, 0x81, 0xc7, 0x2d, 0xd4, 0xde, 0x98, 0x9c, 0x60, 0x04, 0x6e,
+	0x29, 0x81, 0x0d, 0x7c, 0x39, 0x4f, 0x20, 0xd1, 0x24, 0x25, 0xd0, 0x7d, 0x20, 0x5f, 0xa8, 0x87,
+	0xf8, 0x4f, 0x04, 0x95, 0xf1, 0x96, 0x88, 0xaf, 0x14, 0x2a, 0x28, 0xb4, 0x64, 0xfb, 0xf5, 0xff,
+	0xc5, 0x35, 0x8d, 0xdc, 0x50, 0x8d, 0xbc, 0x89, 0xdf, 0x70, 0x8b, 0x3f, 0x9a, 0xc7, 0x1c, 0xda,
+	0x7d, 0x90, 0xf9, 0x0e, 0x3c, 0xfc, 0xac, 0x84, 0xf0, 0xf7, 0x08, 0x16, 0x86, 0x7d, 0x14, 0xb7,
+	0x0a, 0xa5, 0x8d, 0xf5, 0x6c, 0x7b, 0xeb, 0x44, 0x1c, 0xd3, 0x86, 0xab, 0xda, 0xb8, 0x84, 0xd7,
+	0xf2, 0xda, 0x18, 0xb1, 0x71, 0xfc, 0x0d, 0x82, 0x33, 0x59, 0xa7, 0xc5, 0xc5, 0x3b, 0x30, 0xc6,
+	0xb0, 0xed, 0xcd, 0x13, 0x30, 0x8c, 0xcc, 0x86, 0x92, 0xb9, 0x86, 0x2f, 0xe6, 0xc9, 0x8c, 0x34,
+	0x4b, 0xad, 0x0d, 0xc7, 0x3f, 0x22, 0x38, 0x3b, 0xea, 0xbe, 0xf8, 0xe5, 0x49, 0xc6, 0x3d, 0xea,
+	0xf0, 0xf6, 0x2b, 0x27, 0x64, 0x19, 0xc1, 0x1b, 0x4a, 0xf0, 0x3a, 0xae, 0xe7, 0x09, 0x8e, 0x53,
+	0x4a, 0xba, 0xe4, 0x9f, 0x23, 0x98, 0xd5, 0x16, 0x85, 0xd7, 0x8b, 0x0f, 0x28, 0xeb, 0x8a, 0xf6,
+	0xe5, 0x89, 0xb0, 0x46, 0xd5, 0xaa, 0x52, 0x55, 0xc3, 0xd5, 0xdc, 0x63, 0xd4, 0x02, 0xbe, 0x42,
+	0x30, 0x77, 0xe4, 0x79, 0xb8, 0x51, 0xfc, 0x96, 0x8f, 0x98, 0xa6, 0xdd, 0x9c, 0x14, 0x6e, 0x44,
+	0x5d, 0x52, 0xa2, 0x2e, 0xe0, 0x17, 0x73, 0x2d, 0x21, 0xa5, 0xec, 0xbc, 0xfa, 0xe8, 0xa0, 0x8a,
+	0x1e, 0x1f, 0x54, 0xd1, 0x5f, 0x07, 0x55, 0xf4, 0xc5, 0x61, 0x75, 0xea, 0xf1, 0x61, 0x75, 0xea,
+	0xb7, 0xc3, 0xea, 0xd4, 0x9d, 0x25, 0xcd, 0xe5, 0xde, 0xdd, 0xa6, 0xcf, 0xdc, 0x4f, 0x8e, 0x72,
+	0xa8, 0x8f, 0xd3, 0xde, 0xac, 0xfa, 0xd5, 0xb9, 0xf5, 0xef, 0x00, 0x3e, 0xba, 0xac, 0x61, 0x3b,
+	0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -580,6 +919,20 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// PendingPlans queries the current upgrade plan followed by the queued
+	// upgrade plans.
+	//
+	// Since: cosmos-sdk 0.48
+	PendingPlans(ctx context.Context, in *QueryPendingPlansRequest, opts ...grpc.CallOption) (*QueryPendingPlansResponse, error)
+	// UpgradeReadiness queries the readiness signals for a pending upgrade and
+	// the fraction of the bonded voting power they represent.
+	//
+	// Since: cosmos-sdk 0.48
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
+	// Params queries the x/upgrade module parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -631,6 +984,33 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) PendingPlans(ctx context.Context, in *QueryPendingPlansRequest, opts ...grpc.CallOption) (*QueryPendingPlansResponse, error) {
+	out := new(QueryPendingPlansResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/PendingPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error) {
 	out := new(QueryAuthorityResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/Authority", in, out, opts...)
@@ -657,6 +1037,20 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// PendingPlans queries the current upgrade plan followed by the queued
+	// upgrade plans.
+	//
+	// Since: cosmos-sdk 0.48
+	PendingPlans(context.Context, *QueryPendingPlansRequest) (*QueryPendingPlansResponse, error)
+	// UpgradeReadiness queries the readiness signals for a pending upgrade and
+	// the fraction of the bonded voting power they represent.
+	//
+	// Since: cosmos-sdk 0.48
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
+	// Params queries the x/upgrade module parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -679,6 +1073,15 @@ func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req
 func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (*UnimplementedQueryServer) PendingPlans(ctx context.Context, req *QueryPendingPlansRequest) (*QueryPendingPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPlans not implemented")
+}
+func (*UnimplementedQueryServer) UpgradeReadiness(ctx context.Context, req *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
@@ -759,6 +1162,60 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/PendingPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPlans(ctx, req.(*QueryPendingPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAuthorityRequest)
 	if err := dec(in); err != nil {
@@ -797,6 +1254,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "PendingPlans",
+			Handler:    _Query_PendingPlans_Handler,
+		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
@@ -1047,7 +1516,7 @@ func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuthorityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingPlansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1057,12 +1526,12 @@ func (m *QueryAuthorityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAuthorityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingPlansRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1070,7 +1539,7 @@ func (m *QueryAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuthorityResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingPlansResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1080,23 +1549,231 @@ func (m *QueryAuthorityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingPlansResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeReadinessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Postponements != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Postponements))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ReadyFraction.Size()
+		i -= size
+		if _, err := m.ReadyFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReadyPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReadyPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1211,6 +1888,89 @@ func (m *QueryModuleVersionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUpgradeReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ReadyPower != 0 {
+		n += 1 + sovQuery(uint64(m.ReadyPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	l = m.ReadyFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Postponements != 0 {
+		n += 1 + sovQuery(uint64(m.Postponements))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryAuthorityRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1845,6 +2605,530 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingPlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, Plan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signals = append(m.Signals, ReadinessSignal{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyPower", wireType)
+			}
+			m.ReadyPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadyFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Postponements", wireType)
+			}
+			m.Postponements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Postponements |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAuthorityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -133,6 +133,12 @@ var xxx_messageInfo_MsgSoftwareUpgradeResponse proto.InternalMessageInfo
 type MsgCancelUpgrade struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the upgrade to cancel, either the current plan or a
+	// queued plan. The queued plans depending on it are cancelled as well. If
+	// empty, the current plan is cancelled.
+	//
+	// Since: cosmos-sdk 0.48
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *MsgCancelUpgrade) Reset()         { *m = MsgCancelUpgrade{} }
@@ -175,6 +181,13 @@ func (m *MsgCancelUpgrade) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgCancelUpgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // MsgCancelUpgradeResponse is the Msg/CancelUpgrade response type.
 //
 // Since: cosmos-sdk 0.46
@@ -214,42 +227,362 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgQueueUpgrade is the Msg/QueueUpgrade request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgQueueUpgrade struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// plan is the upgrade plan.
+	Plan Plan `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan"`
+}
+
+func (m *MsgQueueUpgrade) Reset()         { *m = MsgQueueUpgrade{} }
+func (m *MsgQueueUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgQueueUpgrade) ProtoMessage()    {}
+func (*MsgQueueUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgQueueUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgQueueUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgQueueUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgQueueUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgQueueUpgrade.Merge(m, src)
+}
+func (m *MsgQueueUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgQueueUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgQueueUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgQueueUpgrade proto.InternalMessageInfo
+
+func (m *MsgQueueUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgQueueUpgrade) GetPlan() Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return Plan{}
+}
+
+// MsgQueueUpgradeResponse is the Msg/QueueUpgrade response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgQueueUpgradeResponse struct {
+}
+
+func (m *MsgQueueUpgradeResponse) Reset()         { *m = MsgQueueUpgradeResponse{} }
+func (m *MsgQueueUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgQueueUpgradeResponse) ProtoMessage()    {}
+func (*MsgQueueUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgQueueUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgQueueUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgQueueUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgQueueUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgQueueUpgradeResponse.Merge(m, src)
+}
+func (m *MsgQueueUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgQueueUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgQueueUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgQueueUpgradeResponse proto.InternalMessageInfo
+
+// MsgSignalUpgradeReady is the Msg/SignalUpgradeReady request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSignalUpgradeReady struct {
+	// validator_address is the operator address of the signaling validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// name is the name of the pending upgrade.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the version of the installed binary.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgSignalUpgradeReady) Reset()         { *m = MsgSignalUpgradeReady{} }
+func (m *MsgSignalUpgradeReady) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReady) ProtoMessage()    {}
+func (*MsgSignalUpgradeReady) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{6}
+}
+func (m *MsgSignalUpgradeReady) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReady) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReady.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgradeReady) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReady.Merge(m, src)
+}
+func (m *MsgSignalUpgradeReady) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReady) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReady.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeReady proto.InternalMessageInfo
+
+func (m *MsgSignalUpgradeReady) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSignalUpgradeReady) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgSignalUpgradeReady) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// MsgSignalUpgradeReadyResponse is the Msg/SignalUpgradeReady response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgSignalUpgradeReadyResponse struct {
+}
+
+func (m *MsgSignalUpgradeReadyResponse) Reset()         { *m = MsgSignalUpgradeReadyResponse{} }
+func (m *MsgSignalUpgradeReadyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeReadyResponse) ProtoMessage()    {}
+func (*MsgSignalUpgradeReadyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{7}
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeReadyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeReadyResponse.Merge(m, src)
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeReadyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeReadyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeReadyResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/upgrade parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse is the Msg/UpdateParams response type.
+//
+// Since: cosmos-sdk 0.48
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{9}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgQueueUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgQueueUpgrade")
+	proto.RegisterType((*MsgQueueUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgQueueUpgradeResponse")
+	proto.RegisterType((*MsgSignalUpgradeReady)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgradeReady")
+	proto.RegisterType((*MsgSignalUpgradeReadyResponse)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgradeReadyResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.upgrade.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.upgrade.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0xda, 0xcd, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x19, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xac, 0xeb, 0xf9, 0x06, 0x2d, 0x59, 0x88,
-	0x01, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x98, 0xce, 0x54, 0x92, 0xe1, 0x92, 0xc2, 0x14, 0x0d, 0x4a,
-	0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x55, 0xaa, 0xe2, 0x12, 0xf0, 0x2d, 0x4e, 0x77, 0x4e, 0xcc,
-	0x4b, 0x4e, 0xcd, 0xa1, 0xd0, 0x63, 0x56, 0x7a, 0x98, 0x6e, 0x93, 0x46, 0x75, 0x1b, 0x8a, 0x3d,
-	0x4a, 0x52, 0x5c, 0x12, 0xe8, 0x62, 0x30, 0x77, 0x19, 0xbd, 0x61, 0xe4, 0x62, 0xf6, 0x2d, 0x4e,
-	0x17, 0x2a, 0xe4, 0xe2, 0x47, 0x0f, 0x77, 0x2d, 0x5c, 0x21, 0x86, 0xe9, 0x4d, 0x29, 0x23, 0xe2,
-	0xd5, 0xc2, 0xac, 0x16, 0xca, 0xe6, 0xe2, 0x45, 0x0d, 0x0f, 0x0d, 0x3c, 0x86, 0xa0, 0xa8, 0x94,
-	0x32, 0x20, 0x56, 0x25, 0xcc, 0x32, 0x29, 0xd6, 0x06, 0x50, 0xe4, 0x3a, 0x99, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x0c, 0xc4, 0xc4, 0xe2, 0x94, 0x6c, 0xbd, 0xcc,
-	0x7c, 0xfd, 0x0a, 0x78, 0xb2, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xa7, 0x50, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0x8c, 0x8c, 0xfe, 0x5f, 0x03, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x63, 0x1a, 0x8a, 0x72, 0x80, 0xda, 0x5a, 0x85, 0xba, 0x26, 0x75, 0x82, 0x55, 0x89,
+	0x28, 0x52, 0xec, 0x26, 0x88, 0x22, 0xa5, 0x53, 0xc3, 0x1c, 0x54, 0x52, 0x95, 0x81, 0xa5, 0xba,
+	0xd6, 0x87, 0x6b, 0x25, 0xf1, 0x19, 0x9f, 0x13, 0x1a, 0x26, 0xc4, 0xc8, 0x80, 0xf8, 0x18, 0x8c,
+	0x19, 0xca, 0x04, 0x2b, 0x52, 0xc7, 0x2a, 0x13, 0x13, 0x42, 0xc9, 0x90, 0xaf, 0x81, 0xec, 0x3b,
+	0x3b, 0xfe, 0x93, 0x44, 0x01, 0x86, 0x2e, 0xad, 0xf3, 0xde, 0xef, 0xde, 0xf7, 0x79, 0xf2, 0xdc,
+	0x39, 0x20, 0x77, 0x8a, 0x49, 0x1b, 0x13, 0xb5, 0x63, 0xe9, 0x36, 0xd4, 0x90, 0xda, 0x2d, 0x9f,
+	0x20, 0x07, 0x96, 0x55, 0xe7, 0x5c, 0xb1, 0x6c, 0xec, 0x60, 0xfe, 0x3e, 0x05, 0x14, 0x06, 0x28,
+	0x0c, 0x10, 0xd7, 0x75, 0xac, 0x63, 0x0f, 0x51, 0xdd, 0x27, 0x4a, 0x8b, 0x9b, 0x94, 0x3e, 0xa6,
+	0x0b, 0x6c, 0x2b, 0x5d, 0xda, 0x9e, 0x31, 0xc9, 0x6f, 0x4c, 0xa9, 0x0d, 0x46, 0xb5, 0x89, 0xae,
+	0x76, 0xcb, 0xee, 0x3f, 0xb6, 0xb0, 0x06, 0xdb, 0x86, 0x89, 0x55, 0xef, 0x2f, 0x2d, 0xc9, 0xdf,
+	0x38, 0xc0, 0xd7, 0x89, 0x7e, 0x88, 0x5f, 0x3b, 0x6f, 0xa1, 0x8d, 0x8e, 0x68, 0x23, 0x7e, 0x17,
+	0x64, 0x60, 0xc7, 0x39, 0xc3, 0xb6, 0xe1, 0xf4, 0x04, 0x2e, 0xcf, 0x15, 0x32, 0x35, 0x61, 0x70,
+	0x51, 0x5a, 0x67, 0x6a, 0xf6, 0x35, 0xcd, 0x46, 0x84, 0x1c, 0x3a, 0xb6, 0x61, 0xea, 0x8d, 0x09,
+	0xca, 0xef, 0x81, 0xb4, 0xd5, 0x82, 0xa6, 0x70, 0x23, 0xcf, 0x15, 0x6e, 0x57, 0xb2, 0xca, 0x74,
+	0xe3, 0xca, 0x41, 0x0b, 0x9a, 0xb5, 0xcc, 0xe5, 0xaf, 0x5c, 0xea, 0xcb, 0xb8, 0x5f, 0xe4, 0x1a,
+	0xde, 0xa6, 0xea, 0xce, 0x87, 0x71, 0xbf, 0x38, 0x69, 0xf6, 0x71, 0xdc, 0x2f, 0x6e, 0xd1, 0x06,
+	0x25, 0xa2, 0x35, 0xd5, 0xa4, 0x4c, 0x39, 0x0b, 0xc4, 0x64, 0xb5, 0x81, 0x88, 0x85, 0x4d, 0x82,
+	0xe4, 0x4f, 0x1c, 0x58, 0xad, 0x13, 0xfd, 0x19, 0x34, 0x4f, 0x51, 0xeb, 0x7f, 0x9d, 0xf1, 0x20,
+	0x6d, 0xc2, 0x36, 0xf2, 0x9c, 0x65, 0x1a, 0xde, 0x73, 0x55, 0x49, 0x0a, 0x7e, 0x10, 0x15, 0x1c,
+	0x99, 0x2d, 0x8b, 0x40, 0x88, 0xd7, 0x02, 0xb1, 0x5f, 0x39, 0xb0, 0x52, 0x27, 0xfa, 0x8b, 0x0e,
+	0xea, 0x5c, 0x6f, 0x0a, 0xa5, 0xa4, 0x29, 0x31, 0x6a, 0x2a, 0xac, 0x51, 0xde, 0x04, 0x1b, 0xb1,
+	0x52, 0x60, 0x69, 0xc0, 0x81, 0x7b, 0x6e, 0x3c, 0x86, 0x6e, 0xc2, 0x89, 0x5f, 0xa8, 0xf5, 0xf8,
+	0xe7, 0x60, 0xad, 0x0b, 0x5b, 0x86, 0x06, 0x1d, 0x6c, 0x1f, 0x43, 0x6a, 0x83, 0x19, 0x7c, 0x38,
+	0xb8, 0x28, 0xb1, 0xd4, 0x95, 0x97, 0x3e, 0x13, 0x75, 0xba, 0xda, 0x8d, 0xd5, 0xa7, 0x85, 0xc3,
+	0x0b, 0xe0, 0x56, 0x17, 0xd9, 0xc4, 0xc0, 0xa6, 0xb0, 0xe4, 0x95, 0xfd, 0x8f, 0xd5, 0x3d, 0xd7,
+	0x61, 0x52, 0x80, 0xeb, 0x34, 0x1f, 0x3b, 0x6f, 0x09, 0xe9, 0x72, 0x0e, 0x6c, 0x4d, 0x5d, 0x08,
+	0x5c, 0xff, 0xa0, 0x41, 0x1e, 0x59, 0x1a, 0x74, 0xd0, 0x01, 0xb4, 0x61, 0x9b, 0xfc, 0x73, 0x90,
+	0xfb, 0x60, 0xd9, 0xf2, 0x3a, 0xb0, 0x28, 0xa5, 0x99, 0x51, 0x7a, 0x54, 0x38, 0x4c, 0xb6, 0xb1,
+	0xfa, 0x34, 0x19, 0xe7, 0x76, 0xc8, 0xe4, 0x79, 0xf0, 0x2e, 0x89, 0x69, 0x66, 0xc1, 0x86, 0x4b,
+	0xbe, 0xc5, 0xca, 0xf7, 0x34, 0x58, 0xaa, 0x13, 0x9d, 0x7f, 0x03, 0x56, 0xe2, 0x2f, 0x8e, 0xe2,
+	0x2c, 0x85, 0xc9, 0x7b, 0x2a, 0x56, 0x16, 0x67, 0xfd, 0xd1, 0x7c, 0x13, 0xdc, 0x8d, 0xde, 0xe7,
+	0xc2, 0x9c, 0x26, 0x11, 0x52, 0xdc, 0x59, 0x94, 0x0c, 0x86, 0x9d, 0x81, 0x3b, 0x91, 0xfb, 0xf8,
+	0x68, 0x4e, 0x87, 0x30, 0x28, 0xaa, 0x0b, 0x82, 0xc1, 0xa4, 0x77, 0x80, 0x9f, 0x72, 0x4d, 0x4a,
+	0xf3, 0xbe, 0xa0, 0x04, 0x2e, 0x3e, 0xf9, 0x2b, 0x3c, 0xec, 0x32, 0x72, 0x58, 0xe7, 0xb9, 0x0c,
+	0x83, 0xa2, 0xba, 0x20, 0xe8, 0x4f, 0x12, 0x6f, 0xbe, 0x77, 0x8f, 0x66, 0x6d, 0xf7, 0x72, 0x28,
+	0x71, 0x57, 0x43, 0x89, 0xfb, 0x3d, 0x94, 0xb8, 0xcf, 0x23, 0x29, 0x75, 0x35, 0x92, 0x52, 0x3f,
+	0x47, 0x52, 0xea, 0x55, 0x96, 0x36, 0x24, 0x5a, 0x53, 0x31, 0x70, 0xe8, 0x6c, 0x3a, 0x3d, 0x0b,
+	0x91, 0x93, 0x65, 0xef, 0x27, 0xeb, 0xf1, 0x9f, 0x01, 0x00, 0xc4, 0x03, 0xe2, 0x2b, 0x70, 0x07,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +606,22 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// QueueUpgrade is a governance operation for queueing a software upgrade
+	// after the current plan and the other queued plans, without overwriting
+	// them.
+	//
+	// Since: cosmos-sdk 0.48
+	QueueUpgrade(ctx context.Context, in *MsgQueueUpgrade, opts ...grpc.CallOption) (*MsgQueueUpgradeResponse, error)
+	// SignalUpgradeReady is an operation for a validator operator to signal
+	// that the binary handling a pending upgrade is installed.
+	//
+	// Since: cosmos-sdk 0.48
+	SignalUpgradeReady(ctx context.Context, in *MsgSignalUpgradeReady, opts ...grpc.CallOption) (*MsgSignalUpgradeReadyResponse, error)
+	// UpdateParams is a governance operation for updating the x/upgrade module
+	// parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -301,6 +650,33 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) QueueUpgrade(ctx context.Context, in *MsgQueueUpgrade, opts ...grpc.CallOption) (*MsgQueueUpgradeResponse, error) {
+	out := new(MsgQueueUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/QueueUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SignalUpgradeReady(ctx context.Context, in *MsgSignalUpgradeReady, opts ...grpc.CallOption) (*MsgSignalUpgradeReadyResponse, error) {
+	out := new(MsgSignalUpgradeReadyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -312,6 +688,22 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// QueueUpgrade is a governance operation for queueing a software upgrade
+	// after the current plan and the other queued plans, without overwriting
+	// them.
+	//
+	// Since: cosmos-sdk 0.48
+	QueueUpgrade(context.Context, *MsgQueueUpgrade) (*MsgQueueUpgradeResponse, error)
+	// SignalUpgradeReady is an operation for a validator operator to signal
+	// that the binary handling a pending upgrade is installed.
+	//
+	// Since: cosmos-sdk 0.48
+	SignalUpgradeReady(context.Context, *MsgSignalUpgradeReady) (*MsgSignalUpgradeReadyResponse, error)
+	// UpdateParams is a governance operation for updating the x/upgrade module
+	// parameters.
+	//
+	// Since: cosmos-sdk 0.48
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -324,6 +716,15 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) QueueUpgrade(ctx context.Context, req *MsgQueueUpgrade) (*MsgQueueUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) SignalUpgradeReady(ctx context.Context, req *MsgSignalUpgradeReady) (*MsgSignalUpgradeReadyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalUpgradeReady not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -365,6 +766,60 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_QueueUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgQueueUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).QueueUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/QueueUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).QueueUpgrade(ctx, req.(*MsgQueueUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SignalUpgradeReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalUpgradeReady)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalUpgradeReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/SignalUpgradeReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalUpgradeReady(ctx, req.(*MsgSignalUpgradeReady))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -377,6 +832,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "QueueUpgrade",
+			Handler:    _Msg_QueueUpgrade_Handler,
+		},
+		{
+			MethodName: "SignalUpgradeReady",
+			Handler:    _Msg_SignalUpgradeReady_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -465,6 +932,13 @@ func (m *MsgCancelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -498,6 +972,199 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgQueueUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgQueueUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgQueueUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgQueueUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgQueueUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgQueueUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalUpgradeReady) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgradeReady) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeReady) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalUpgradeReadyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgradeReadyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeReadyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -543,6 +1210,10 @@ func (m *MsgCancelUpgrade) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -555,6 +1226,84 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgQueueUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgQueueUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSignalUpgradeReady) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalUpgradeReadyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -787,13 +1536,45 @@ func (m *MsgCancelUpgrade) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -858,6 +1639,532 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgQueueUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgQueueUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgQueueUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgQueueUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgQueueUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgQueueUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalUpgradeReady) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReady: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReady: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalUpgradeReadyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgradeReadyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
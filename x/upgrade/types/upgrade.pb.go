@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -40,11 +41,11 @@ type Plan struct {
 	// assumed that the software is out-of-date when the upgrade Time or Height is
 	// reached and the software will exit.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Deprecated: Time based upgrades have been deprecated. Time based upgrade logic
-	// has been removed from the SDK.
-	// If this field is not empty, an error will be thrown.
-	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"` // Deprecated: Do not use.
-	// The height at which the upgrade must be performed.
+	// The block time at or after which the upgrade must be performed. Exactly
+	// one of time and height must be set.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// The height at which the upgrade must be performed. Exactly one of time and
+	// height must be set.
 	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 	// Any application specific upgrade info to be included on-chain
 	// such as a git commit that validators could automatically upgrade to
@@ -53,6 +54,11 @@ type Plan struct {
 	// moved to the IBC module in the sub module 02-client.
 	// If this field is not empty, an error will be thrown.
 	UpgradedClientState *types.Any `protobuf:"bytes,5,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty"` // Deprecated: Do not use.
+	// depends_on is the list of the names of the upgrades which must be applied
+	// before this upgrade can become the current plan.
+	//
+	// Since: cosmos-sdk 0.48
+	DependsOn []string `protobuf:"bytes,6,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -225,11 +231,111 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// Params defines the parameters for the x/upgrade module.
+//
+// Since: cosmos-sdk 0.48
+type Params struct {
+	// readiness_threshold is the minimum fraction of the bonded voting power
+	// which must have signaled readiness for the current plan
+	// readiness_window blocks before its height. Below it, the plan height is
+	// postponed. Zero disables postponements.
+	ReadinessThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=readiness_threshold,json=readinessThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"readiness_threshold"`
+	// readiness_window is the number of blocks before the plan height at which
+	// the readiness is checked.
+	ReadinessWindow int64 `protobuf:"varint,2,opt,name=readiness_window,json=readinessWindow,proto3" json:"readiness_window,omitempty"`
+	// postpone_blocks is the number of blocks the plan height is postponed by
+	// when the readiness is below readiness_threshold.
+	PostponeBlocks int64 `protobuf:"varint,3,opt,name=postpone_blocks,json=postponeBlocks,proto3" json:"postpone_blocks,omitempty"`
+	// max_postponements is the maximum number of times a plan can be postponed.
+	MaxPostponements uint32 `protobuf:"varint,4,opt,name=max_postponements,json=maxPostponements,proto3" json:"max_postponements,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// ReadinessSignal is the signal of a validator operator that the binary
+// handling an upgrade is installed.
+//
+// Since: cosmos-sdk 0.48
+type ReadinessSignal struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// version is the version of the installed binary.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// height is the height at which the signal was sent.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReadinessSignal) Reset()         { *m = ReadinessSignal{} }
+func (m *ReadinessSignal) String() string { return proto.CompactTextString(m) }
+func (*ReadinessSignal) ProtoMessage()    {}
+func (*ReadinessSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{5}
+}
+func (m *ReadinessSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadinessSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadinessSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadinessSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadinessSignal.Merge(m, src)
+}
+func (m *ReadinessSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadinessSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadinessSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadinessSignal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*Params)(nil), "cosmos.upgrade.v1beta1.Params")
+	proto.RegisterType((*ReadinessSignal)(nil), "cosmos.upgrade.v1beta1.ReadinessSignal")
 }
 
 func init() {
@@ -237,40 +343,54 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xf7, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0x94, 0x6b, 0x54, 0x9c, 0xc8, 0x62, 0x88,
-	0x2a, 0xd5, 0x56, 0xcb, 0x66, 0x06, 0x44, 0x32, 0x02, 0x52, 0x71, 0x81, 0x81, 0x25, 0xba, 0xc4,
-	0x17, 0xf7, 0x54, 0xfb, 0xce, 0xf2, 0x5d, 0x02, 0x79, 0x05, 0xa6, 0x3e, 0x02, 0x23, 0x62, 0xea,
-	0xc0, 0x43, 0x44, 0x4c, 0x1d, 0x91, 0x90, 0xf8, 0x93, 0x0c, 0x65, 0xe3, 0x15, 0xd0, 0xdd, 0xd9,
-	0x55, 0x04, 0x05, 0x31, 0x74, 0xb1, 0xbe, 0xef, 0xbb, 0xef, 0xe7, 0xdf, 0x1f, 0x9f, 0xe1, 0xdd,
-	0x21, 0x17, 0x19, 0x17, 0xc1, 0x38, 0x4f, 0x0a, 0x1c, 0x93, 0x60, 0xb2, 0x37, 0x20, 0x12, 0xef,
-	0x55, 0xbd, 0x9f, 0x17, 0x5c, 0x72, 0x67, 0xd3, 0x6c, 0xf9, 0xd5, 0xb4, 0xdc, 0x6a, 0x6e, 0x25,
-	0x9c, 0x27, 0x29, 0x09, 0xf4, 0xd6, 0x60, 0x3c, 0x0a, 0x30, 0x9b, 0x1a, 0x48, 0xb3, 0x91, 0xf0,
-	0x84, 0xeb, 0x32, 0x50, 0x55, 0x39, 0x6d, 0xfd, 0x0e, 0x90, 0x34, 0x23, 0x42, 0xe2, 0x2c, 0x2f,
-	0x17, 0xb6, 0x0c, 0x53, 0xdf, 0x20, 0x4b, 0x5a, 0x73, 0x74, 0x03, 0x67, 0x94, 0xf1, 0x40, 0x3f,
-	0xcd, 0xc8, 0xfb, 0x09, 0xa0, 0x7d, 0x90, 0x62, 0xe6, 0x38, 0xd0, 0x66, 0x38, 0x23, 0x08, 0xb4,
-	0x41, 0xa7, 0x16, 0xe9, 0xda, 0x79, 0x00, 0x6d, 0xf5, 0x76, 0xb4, 0xd2, 0x06, 0x9d, 0xfa, 0x7e,
-	0xd3, 0x37, 0xd4, 0x7e, 0x45, 0xed, 0x3f, 0xab, 0xa8, 0xbb, 0x1b, 0xb3, 0x2f, 0x2d, 0xeb, 0xe4,
-	0x6b, 0x0b, 0xbc, 0x3b, 0x3f, 0xdd, 0x01, 0x08, 0x44, 0x1a, 0xe8, 0x6c, 0xc2, 0xf5, 0x23, 0x42,
-	0x93, 0x23, 0x89, 0x56, 0xdb, 0xa0, 0xb3, 0x1a, 0x95, 0x9d, 0x22, 0xa3, 0x6c, 0xc4, 0x91, 0x6d,
-	0xc8, 0x54, 0xed, 0x3c, 0x86, 0xb7, 0xca, 0x70, 0xe2, 0xfe, 0x30, 0xa5, 0x84, 0xc9, 0xbe, 0x90,
-	0x58, 0x12, 0xb4, 0xa6, 0xd9, 0x1b, 0x7f, 0xb0, 0x3f, 0x64, 0xd3, 0xee, 0x0a, 0x02, 0xd1, 0xcd,
-	0x0a, 0xd6, 0xd3, 0xa8, 0x43, 0x05, 0x0a, 0xd1, 0x8f, 0xb7, 0x2d, 0xf0, 0xe6, 0xfc, 0x74, 0x67,
-	0xc3, 0x24, 0xb0, 0x2b, 0xe2, 0xe3, 0x40, 0x19, 0xf5, 0x3e, 0x03, 0x78, 0xfb, 0x90, 0x8f, 0xe4,
-	0x2b, 0x5c, 0x90, 0xe7, 0x06, 0x79, 0x50, 0xf0, 0x9c, 0x0b, 0x9c, 0x3a, 0x0d, 0xb8, 0x26, 0xa9,
-	0x4c, 0xab, 0x14, 0x4c, 0xe3, 0xb4, 0x61, 0x3d, 0x26, 0x62, 0x58, 0xd0, 0x5c, 0x52, 0xce, 0x74,
-	0x1a, 0xb5, 0x68, 0x79, 0xe4, 0xdc, 0x87, 0x76, 0x9e, 0x62, 0xa6, 0x5d, 0xd6, 0xf7, 0xb7, 0xfd,
-	0xcb, 0x3f, 0xb6, 0xaf, 0xf8, 0xbb, 0x35, 0x15, 0x95, 0x8e, 0x29, 0xd2, 0xa0, 0xf0, 0x91, 0x92,
-	0xfa, 0xf1, 0xc3, 0x6e, 0xb3, 0x44, 0x25, 0x7c, 0x72, 0x81, 0xe8, 0x71, 0x26, 0x09, 0x93, 0xca,
-	0x88, 0xb7, 0x64, 0xe4, 0x2f, 0xfa, 0x11, 0xf0, 0xde, 0x03, 0x78, 0xa7, 0x87, 0xd9, 0x90, 0xa4,
-	0x57, 0xec, 0x31, 0x7c, 0xfa, 0x7f, 0x32, 0x3b, 0x4b, 0x32, 0xff, 0x29, 0x04, 0x01, 0xaf, 0x07,
-	0xaf, 0x3f, 0xe1, 0xf1, 0x38, 0x25, 0x2f, 0x48, 0x21, 0x54, 0x8e, 0x97, 0x5d, 0x42, 0x04, 0xaf,
-	0x4d, 0xcc, 0xb1, 0x56, 0x65, 0x47, 0x55, 0x1b, 0xda, 0x4a, 0x51, 0x37, 0x9c, 0x7d, 0x77, 0xad,
-	0xd9, 0xdc, 0x05, 0x67, 0x73, 0x17, 0x7c, 0x9b, 0xbb, 0xe0, 0x64, 0xe1, 0x5a, 0x67, 0x0b, 0xd7,
-	0xfa, 0xb4, 0x70, 0xad, 0x97, 0xdb, 0x46, 0x8e, 0x88, 0x8f, 0x7d, 0xca, 0x83, 0xd7, 0x17, 0x7f,
-	0xa9, 0x9c, 0xe6, 0x44, 0x0c, 0xd6, 0xf5, 0x65, 0xba, 0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x89,
-	0x0e, 0x9c, 0x59, 0xc4, 0x03, 0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0xe3, 0x46,
+	0x1c, 0xcd, 0x24, 0x21, 0x55, 0x06, 0xd1, 0xc0, 0x40, 0xa9, 0x49, 0x21, 0x49, 0x23, 0xd4, 0xa6,
+	0x54, 0xb1, 0x05, 0xbd, 0xa5, 0xea, 0x81, 0x84, 0x5b, 0xff, 0xa5, 0x0e, 0xa5, 0x52, 0x2f, 0xd6,
+	0xc4, 0x1e, 0x9c, 0x11, 0xf6, 0x8c, 0xe5, 0x99, 0x04, 0xf8, 0x0a, 0x55, 0x0f, 0x5c, 0x7b, 0xab,
+	0xd4, 0x4b, 0xd5, 0x13, 0x07, 0x3e, 0x04, 0xea, 0x09, 0x71, 0xaa, 0x5a, 0x89, 0xee, 0xc2, 0x81,
+	0xfd, 0x18, 0x2b, 0xcf, 0xd8, 0xd9, 0x88, 0x85, 0xd5, 0x1e, 0xf6, 0x62, 0xcd, 0x3c, 0xff, 0xde,
+	0xbc, 0xf7, 0x7b, 0xf3, 0x07, 0x6e, 0xba, 0x5c, 0x84, 0x5c, 0x58, 0xe3, 0xc8, 0x8f, 0xb1, 0x47,
+	0xac, 0xc9, 0xf6, 0x90, 0x48, 0xbc, 0x9d, 0xcd, 0xcd, 0x28, 0xe6, 0x92, 0xa3, 0x55, 0x5d, 0x65,
+	0x66, 0x68, 0x5a, 0x55, 0x5d, 0xf3, 0x39, 0xf7, 0x03, 0x62, 0xa9, 0xaa, 0xe1, 0xf8, 0xd0, 0xc2,
+	0xec, 0x54, 0x53, 0xaa, 0x2b, 0x3e, 0xf7, 0xb9, 0x1a, 0x5a, 0xc9, 0x28, 0x45, 0xeb, 0x0f, 0x09,
+	0x92, 0x86, 0x44, 0x48, 0x1c, 0x46, 0x69, 0xc1, 0x9a, 0x56, 0x72, 0x34, 0x33, 0x95, 0xd5, 0xbf,
+	0x96, 0x70, 0x48, 0x19, 0xb7, 0xd4, 0x57, 0x43, 0xcd, 0x5f, 0xf3, 0xb0, 0xd8, 0x0f, 0x30, 0x43,
+	0x08, 0x16, 0x19, 0x0e, 0x89, 0x01, 0x1a, 0xa0, 0x55, 0xb6, 0xd5, 0x18, 0x7d, 0x05, 0x8b, 0xc9,
+	0xea, 0x46, 0xbe, 0x01, 0x5a, 0xf3, 0x3b, 0x55, 0x53, 0x4b, 0x9b, 0x99, 0xb4, 0xb9, 0x9f, 0x49,
+	0x77, 0x17, 0x2e, 0x6f, 0xea, 0xb9, 0xb3, 0xff, 0xeb, 0xe0, 0xcf, 0xfb, 0xf3, 0x2d, 0x60, 0x2b,
+	0x1a, 0x5a, 0x85, 0xa5, 0x11, 0xa1, 0xfe, 0x48, 0x1a, 0x85, 0x06, 0x68, 0x15, 0xec, 0x74, 0x96,
+	0x48, 0x51, 0x76, 0xc8, 0x8d, 0xa2, 0x96, 0x4a, 0xc6, 0xe8, 0x1b, 0xf8, 0x41, 0x1a, 0x8d, 0xe7,
+	0xb8, 0x01, 0x25, 0x4c, 0x3a, 0x42, 0x62, 0x49, 0x8c, 0x39, 0xa5, 0xbd, 0xf2, 0x9a, 0xf6, 0x2e,
+	0x3b, 0xed, 0xe6, 0x0d, 0x60, 0x2f, 0x67, 0xb4, 0x9e, 0x62, 0x0d, 0x12, 0x12, 0xda, 0x80, 0xd0,
+	0x23, 0x11, 0x61, 0x9e, 0x70, 0x38, 0x33, 0x4a, 0x8d, 0x42, 0xab, 0x6c, 0x97, 0x53, 0xe4, 0x7b,
+	0xd6, 0x31, 0x5e, 0xfc, 0x5e, 0x07, 0xbf, 0xdc, 0x9f, 0x6f, 0x55, 0x74, 0x3c, 0x6d, 0xe1, 0x1d,
+	0x59, 0x49, 0x0a, 0xcd, 0xff, 0x00, 0xfc, 0x70, 0xc0, 0x0f, 0xe5, 0x31, 0x8e, 0xc9, 0x8f, 0x7a,
+	0xe1, 0x7e, 0xcc, 0x23, 0x2e, 0x70, 0x80, 0x56, 0xe0, 0x9c, 0xa4, 0x32, 0xc8, 0x22, 0xd2, 0x13,
+	0xd4, 0x80, 0xf3, 0x1e, 0x11, 0x6e, 0x4c, 0x23, 0x49, 0x39, 0x53, 0x51, 0x95, 0xed, 0x59, 0x08,
+	0x7d, 0x09, 0x8b, 0x51, 0x80, 0x99, 0x0a, 0x61, 0x7e, 0x67, 0xdd, 0x7c, 0xfc, 0x24, 0x98, 0x89,
+	0x7e, 0xb7, 0x9c, 0xe4, 0x98, 0x66, 0x98, 0x90, 0x3a, 0x5f, 0x27, 0x56, 0xff, 0xbe, 0x68, 0x57,
+	0x53, 0x96, 0xcf, 0x27, 0x53, 0x46, 0x8f, 0x33, 0x49, 0x98, 0x4c, 0x1a, 0x69, 0xce, 0x34, 0xf2,
+	0x84, 0x7f, 0x03, 0x34, 0xff, 0x02, 0x70, 0xa3, 0x87, 0x99, 0x4b, 0x82, 0x77, 0xdc, 0x63, 0xe7,
+	0x87, 0xb7, 0xb3, 0xd9, 0x9a, 0xb1, 0xf9, 0x46, 0x23, 0x06, 0x68, 0xf6, 0xe0, 0xc2, 0xb7, 0xdc,
+	0x1b, 0x07, 0xe4, 0x80, 0xc4, 0x82, 0xf2, 0xc7, 0x4f, 0xa8, 0x01, 0xdf, 0x9b, 0xe8, 0xdf, 0xca,
+	0x55, 0xd1, 0xce, 0xa6, 0x9d, 0x62, 0xe2, 0xa8, 0xf9, 0x47, 0x1e, 0x96, 0xfa, 0x38, 0xc6, 0xa1,
+	0x40, 0x31, 0x5c, 0x8e, 0x09, 0xf6, 0x28, 0x23, 0x42, 0x38, 0x72, 0x14, 0x13, 0x31, 0xe2, 0x81,
+	0xa7, 0x57, 0xeb, 0xee, 0x26, 0xb9, 0xff, 0x7b, 0x53, 0xff, 0xc4, 0xa7, 0x72, 0x34, 0x1e, 0x9a,
+	0x2e, 0x0f, 0xd3, 0xab, 0x63, 0xcd, 0x58, 0x96, 0xa7, 0x11, 0x11, 0xe6, 0x1e, 0x71, 0xaf, 0x2f,
+	0xda, 0x30, 0xed, 0x74, 0x8f, 0xb8, 0x7a, 0xbf, 0xd0, 0x74, 0xf5, 0xfd, 0x6c, 0x71, 0xf4, 0x19,
+	0x5c, 0x7c, 0xa5, 0x79, 0x4c, 0x99, 0xc7, 0x8f, 0x95, 0xcf, 0x82, 0x5d, 0x99, 0xe2, 0x3f, 0x29,
+	0x18, 0x7d, 0x0a, 0x2b, 0x11, 0x17, 0x32, 0xe2, 0x8c, 0x38, 0xc3, 0x80, 0xbb, 0x47, 0x22, 0xbd,
+	0x35, 0xef, 0x67, 0x70, 0x57, 0xa1, 0xe8, 0x73, 0xb8, 0x14, 0xe2, 0x13, 0x27, 0x43, 0x43, 0xc2,
+	0xa4, 0x50, 0x57, 0x69, 0xc1, 0x5e, 0x0c, 0xf1, 0x49, 0x7f, 0x16, 0xef, 0x6c, 0x66, 0x27, 0xfd,
+	0xa3, 0x99, 0x36, 0x4e, 0xa6, 0x6f, 0x95, 0x8e, 0xa6, 0xf9, 0x1b, 0x80, 0x15, 0x3b, 0xf3, 0x33,
+	0xa0, 0x3e, 0xc3, 0x01, 0xfa, 0x0e, 0x2e, 0x4d, 0x70, 0x40, 0x3d, 0x2c, 0x79, 0xec, 0x60, 0xcf,
+	0x8b, 0x89, 0x10, 0x69, 0x58, 0x1f, 0x5f, 0x5f, 0xb4, 0x37, 0xd2, 0xf6, 0x0f, 0xb2, 0x9a, 0x5d,
+	0x5d, 0x32, 0x90, 0x31, 0x65, 0xbe, 0xbd, 0x38, 0x79, 0x80, 0x3f, 0xdc, 0xa9, 0xf2, 0x74, 0xa7,
+	0x9e, 0x7a, 0x26, 0xf4, 0x0e, 0x76, 0x3b, 0x97, 0xcf, 0x6b, 0xb9, 0xcb, 0xdb, 0x1a, 0xb8, 0xba,
+	0xad, 0x81, 0x67, 0xb7, 0x35, 0x70, 0x76, 0x57, 0xcb, 0x5d, 0xdd, 0xd5, 0x72, 0xff, 0xdc, 0xd5,
+	0x72, 0x3f, 0xaf, 0x6b, 0x1b, 0xc2, 0x3b, 0x32, 0x29, 0x9f, 0x69, 0x4c, 0xed, 0xd4, 0xb0, 0xa4,
+	0x5e, 0x8b, 0x2f, 0x5e, 0x0e, 0x00, 0x34, 0x16, 0xe6, 0x0d, 0xa3, 0x05, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -307,6 +427,14 @@ func (this *Plan) Equal(that interface{}) bool {
 	if !this.UpgradedClientState.Equal(that1.UpgradedClientState) {
 		return false
 	}
+	if len(this.DependsOn) != len(that1.DependsOn) {
+		return false
+	}
+	for i := range this.DependsOn {
+		if this.DependsOn[i] != that1.DependsOn[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -393,6 +521,69 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ReadinessThreshold.Equal(that1.ReadinessThreshold) {
+		return false
+	}
+	if this.ReadinessWindow != that1.ReadinessWindow {
+		return false
+	}
+	if this.PostponeBlocks != that1.PostponeBlocks {
+		return false
+	}
+	if this.MaxPostponements != that1.MaxPostponements {
+		return false
+	}
+	return true
+}
+func (this *ReadinessSignal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReadinessSignal)
+	if !ok {
+		that2, ok := that.(ReadinessSignal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -413,6 +604,15 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintUpgrade(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.UpgradedClientState != nil {
 		{
 			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
@@ -574,6 +774,96 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPostponements != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.MaxPostponements))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PostponeBlocks != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.PostponeBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReadinessWindow != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.ReadinessWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ReadinessThreshold.Size()
+		i -= size
+		if _, err := m.ReadinessThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadinessSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadinessSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -608,6 +898,12 @@ func (m *Plan) Size() (n int) {
 		l = m.UpgradedClientState.Size()
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -663,6 +959,46 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReadinessThreshold.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.ReadinessWindow != 0 {
+		n += 1 + sovUpgrade(uint64(m.ReadinessWindow))
+	}
+	if m.PostponeBlocks != 0 {
+		n += 1 + sovUpgrade(uint64(m.PostponeBlocks))
+	}
+	if m.MaxPostponements != 0 {
+		n += 1 + sovUpgrade(uint64(m.MaxPostponements))
+	}
+	return n
+}
+
+func (m *ReadinessSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -850,6 +1186,38 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])
@@ -1233,6 +1601,280 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadinessThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessWindow", wireType)
+			}
+			m.ReadinessWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadinessWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostponeBlocks", wireType)
+			}
+			m.PostponeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostponeBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPostponements", wireType)
+			}
+			m.MaxPostponements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPostponements |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadinessSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadinessSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadinessSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0